@@ -2133,6 +2133,2955 @@ func (x *ListApprovalTasksResponse) GetTotal() int32 {
 	return 0
 }
 
+type FieldFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Op            string                 `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	ValueTo       string                 `protobuf:"bytes,4,opt,name=value_to,json=valueTo,proto3" json:"value_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldFilter) Reset() {
+	*x = FieldFilter{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldFilter) ProtoMessage() {}
+
+func (x *FieldFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldFilter.ProtoReflect.Descriptor instead.
+func (*FieldFilter) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *FieldFilter) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *FieldFilter) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *FieldFilter) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FieldFilter) GetValueTo() string {
+	if x != nil {
+		return x.ValueTo
+	}
+	return ""
+}
+
+type SearchInstancesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	ProcessDefId  string                 `protobuf:"bytes,2,opt,name=process_def_id,json=processDefId,proto3" json:"process_def_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	ApplicantId   string                 `protobuf:"bytes,4,opt,name=applicant_id,json=applicantId,proto3" json:"applicant_id,omitempty"`
+	FieldFilters  []*FieldFilter         `protobuf:"bytes,5,rep,name=field_filters,json=fieldFilters,proto3" json:"field_filters,omitempty"`
+	StartDate     string                 `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	SortBy        string                 `protobuf:"bytes,8,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	SortDesc      bool                   `protobuf:"varint,9,opt,name=sort_desc,json=sortDesc,proto3" json:"sort_desc,omitempty"`
+	Cursor        string                 `protobuf:"bytes,10,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      int32                  `protobuf:"varint,11,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchInstancesRequest) Reset() {
+	*x = SearchInstancesRequest{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchInstancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchInstancesRequest) ProtoMessage() {}
+
+func (x *SearchInstancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchInstancesRequest.ProtoReflect.Descriptor instead.
+func (*SearchInstancesRequest) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SearchInstancesRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchInstancesRequest) GetProcessDefId() string {
+	if x != nil {
+		return x.ProcessDefId
+	}
+	return ""
+}
+
+func (x *SearchInstancesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SearchInstancesRequest) GetApplicantId() string {
+	if x != nil {
+		return x.ApplicantId
+	}
+	return ""
+}
+
+func (x *SearchInstancesRequest) GetFieldFilters() []*FieldFilter {
+	if x != nil {
+		return x.FieldFilters
+	}
+	return nil
+}
+
+func (x *SearchInstancesRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *SearchInstancesRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *SearchInstancesRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *SearchInstancesRequest) GetSortDesc() bool {
+	if x != nil {
+		return x.SortDesc
+	}
+	return false
+}
+
+func (x *SearchInstancesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchInstancesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type SearchInstancesResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Items         []*ProcessInstanceResponse `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	PageSize      int32                      `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	HasNext       bool                       `protobuf:"varint,3,opt,name=has_next,json=hasNext,proto3" json:"has_next,omitempty"`
+	NextCursor    string                     `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchInstancesResponse) Reset() {
+	*x = SearchInstancesResponse{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchInstancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchInstancesResponse) ProtoMessage() {}
+
+func (x *SearchInstancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchInstancesResponse.ProtoReflect.Descriptor instead.
+func (*SearchInstancesResponse) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SearchInstancesResponse) GetItems() []*ProcessInstanceResponse {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SearchInstancesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchInstancesResponse) GetHasNext() bool {
+	if x != nil {
+		return x.HasNext
+	}
+	return false
+}
+
+func (x *SearchInstancesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetSearchFieldsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSearchFieldsRequest) Reset() {
+	*x = GetSearchFieldsRequest{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSearchFieldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSearchFieldsRequest) ProtoMessage() {}
+
+func (x *GetSearchFieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSearchFieldsRequest.ProtoReflect.Descriptor instead.
+func (*GetSearchFieldsRequest) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetSearchFieldsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SearchFieldConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FieldKey      string                 `protobuf:"bytes,1,opt,name=field_key,json=fieldKey,proto3" json:"field_key,omitempty"`
+	FieldLabel    string                 `protobuf:"bytes,2,opt,name=field_label,json=fieldLabel,proto3" json:"field_label,omitempty"`
+	FieldType     string                 `protobuf:"bytes,3,opt,name=field_type,json=fieldType,proto3" json:"field_type,omitempty"`
+	Sort          int32                  `protobuf:"varint,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchFieldConfig) Reset() {
+	*x = SearchFieldConfig{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFieldConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFieldConfig) ProtoMessage() {}
+
+func (x *SearchFieldConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFieldConfig.ProtoReflect.Descriptor instead.
+func (*SearchFieldConfig) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SearchFieldConfig) GetFieldKey() string {
+	if x != nil {
+		return x.FieldKey
+	}
+	return ""
+}
+
+func (x *SearchFieldConfig) GetFieldLabel() string {
+	if x != nil {
+		return x.FieldLabel
+	}
+	return ""
+}
+
+func (x *SearchFieldConfig) GetFieldType() string {
+	if x != nil {
+		return x.FieldType
+	}
+	return ""
+}
+
+func (x *SearchFieldConfig) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+type ConfigureSearchFieldsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fields        []*SearchFieldConfig   `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigureSearchFieldsRequest) Reset() {
+	*x = ConfigureSearchFieldsRequest{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigureSearchFieldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureSearchFieldsRequest) ProtoMessage() {}
+
+func (x *ConfigureSearchFieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureSearchFieldsRequest.ProtoReflect.Descriptor instead.
+func (*ConfigureSearchFieldsRequest) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ConfigureSearchFieldsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ConfigureSearchFieldsRequest) GetFields() []*SearchFieldConfig {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type SearchFieldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProcessDefId  string                 `protobuf:"bytes,2,opt,name=process_def_id,json=processDefId,proto3" json:"process_def_id,omitempty"`
+	FieldKey      string                 `protobuf:"bytes,3,opt,name=field_key,json=fieldKey,proto3" json:"field_key,omitempty"`
+	FieldLabel    string                 `protobuf:"bytes,4,opt,name=field_label,json=fieldLabel,proto3" json:"field_label,omitempty"`
+	FieldType     string                 `protobuf:"bytes,5,opt,name=field_type,json=fieldType,proto3" json:"field_type,omitempty"`
+	Sort          int32                  `protobuf:"varint,6,opt,name=sort,proto3" json:"sort,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchFieldResponse) Reset() {
+	*x = SearchFieldResponse{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFieldResponse) ProtoMessage() {}
+
+func (x *SearchFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFieldResponse.ProtoReflect.Descriptor instead.
+func (*SearchFieldResponse) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SearchFieldResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SearchFieldResponse) GetProcessDefId() string {
+	if x != nil {
+		return x.ProcessDefId
+	}
+	return ""
+}
+
+func (x *SearchFieldResponse) GetFieldKey() string {
+	if x != nil {
+		return x.FieldKey
+	}
+	return ""
+}
+
+func (x *SearchFieldResponse) GetFieldLabel() string {
+	if x != nil {
+		return x.FieldLabel
+	}
+	return ""
+}
+
+func (x *SearchFieldResponse) GetFieldType() string {
+	if x != nil {
+		return x.FieldType
+	}
+	return ""
+}
+
+func (x *SearchFieldResponse) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *SearchFieldResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SearchFieldResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type SearchFieldsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*SearchFieldResponse `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchFieldsResponse) Reset() {
+	*x = SearchFieldsResponse{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFieldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFieldsResponse) ProtoMessage() {}
+
+func (x *SearchFieldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFieldsResponse.ProtoReflect.Descriptor instead.
+func (*SearchFieldsResponse) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SearchFieldsResponse) GetItems() []*SearchFieldResponse {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ReindexProcessDefinitionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReindexProcessDefinitionRequest) Reset() {
+	*x = ReindexProcessDefinitionRequest{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReindexProcessDefinitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexProcessDefinitionRequest) ProtoMessage() {}
+
+func (x *ReindexProcessDefinitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexProcessDefinitionRequest.ProtoReflect.Descriptor instead.
+func (*ReindexProcessDefinitionRequest) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ReindexProcessDefinitionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ReindexProcessDefinitionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Indexed       int32                  `protobuf:"varint,1,opt,name=indexed,proto3" json:"indexed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReindexProcessDefinitionResponse) Reset() {
+	*x = ReindexProcessDefinitionResponse{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReindexProcessDefinitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexProcessDefinitionResponse) ProtoMessage() {}
+
+func (x *ReindexProcessDefinitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexProcessDefinitionResponse.ProtoReflect.Descriptor instead.
+func (*ReindexProcessDefinitionResponse) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ReindexProcessDefinitionResponse) GetIndexed() int32 {
+	if x != nil {
+		return x.Indexed
+	}
+	return 0
+}
+
+type VerifyHistoryChainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyHistoryChainRequest) Reset() {
+	*x = VerifyHistoryChainRequest{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyHistoryChainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyHistoryChainRequest) ProtoMessage() {}
+
+func (x *VerifyHistoryChainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyHistoryChainRequest.ProtoReflect.Descriptor instead.
+func (*VerifyHistoryChainRequest) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *VerifyHistoryChainRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type HistoryChainIssue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Sequence      int64                  `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	HistoryId     string                 `protobuf:"bytes,3,opt,name=history_id,json=historyId,proto3" json:"history_id,omitempty"`
+	Detail        string                 `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryChainIssue) Reset() {
+	*x = HistoryChainIssue{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryChainIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryChainIssue) ProtoMessage() {}
+
+func (x *HistoryChainIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryChainIssue.ProtoReflect.Descriptor instead.
+func (*HistoryChainIssue) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *HistoryChainIssue) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *HistoryChainIssue) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *HistoryChainIssue) GetHistoryId() string {
+	if x != nil {
+		return x.HistoryId
+	}
+	return ""
+}
+
+func (x *HistoryChainIssue) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type HistoryChainReportResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProcessInstanceId string                 `protobuf:"bytes,1,opt,name=process_instance_id,json=processInstanceId,proto3" json:"process_instance_id,omitempty"`
+	Valid             bool                   `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	Total             int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	HeadSequence      int64                  `protobuf:"varint,4,opt,name=head_sequence,json=headSequence,proto3" json:"head_sequence,omitempty"`
+	HeadHash          string                 `protobuf:"bytes,5,opt,name=head_hash,json=headHash,proto3" json:"head_hash,omitempty"`
+	Issues            []*HistoryChainIssue   `protobuf:"bytes,6,rep,name=issues,proto3" json:"issues,omitempty"`
+	VerifiedAt        string                 `protobuf:"bytes,7,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *HistoryChainReportResponse) Reset() {
+	*x = HistoryChainReportResponse{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryChainReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryChainReportResponse) ProtoMessage() {}
+
+func (x *HistoryChainReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryChainReportResponse.ProtoReflect.Descriptor instead.
+func (*HistoryChainReportResponse) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *HistoryChainReportResponse) GetProcessInstanceId() string {
+	if x != nil {
+		return x.ProcessInstanceId
+	}
+	return ""
+}
+
+func (x *HistoryChainReportResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *HistoryChainReportResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *HistoryChainReportResponse) GetHeadSequence() int64 {
+	if x != nil {
+		return x.HeadSequence
+	}
+	return 0
+}
+
+func (x *HistoryChainReportResponse) GetHeadHash() string {
+	if x != nil {
+		return x.HeadHash
+	}
+	return ""
+}
+
+func (x *HistoryChainReportResponse) GetIssues() []*HistoryChainIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *HistoryChainReportResponse) GetVerifiedAt() string {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return ""
+}
+
+type ExportDossierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportDossierRequest) Reset() {
+	*x = ExportDossierRequest{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportDossierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportDossierRequest) ProtoMessage() {}
+
+func (x *ExportDossierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportDossierRequest.ProtoReflect.Descriptor instead.
+func (*ExportDossierRequest) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ExportDossierRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExportDossierRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type DossierExportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	Size          int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	ChainValid    bool                   `protobuf:"varint,5,opt,name=chain_valid,json=chainValid,proto3" json:"chain_valid,omitempty"`
+	DownloadUrl   string                 `protobuf:"bytes,6,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DossierExportResponse) Reset() {
+	*x = DossierExportResponse{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DossierExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DossierExportResponse) ProtoMessage() {}
+
+func (x *DossierExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DossierExportResponse.ProtoReflect.Descriptor instead.
+func (*DossierExportResponse) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DossierExportResponse) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *DossierExportResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *DossierExportResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *DossierExportResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *DossierExportResponse) GetChainValid() bool {
+	if x != nil {
+		return x.ChainValid
+	}
+	return false
+}
+
+func (x *DossierExportResponse) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+type ApprovalStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProcessDefId  string                 `protobuf:"bytes,1,opt,name=process_def_id,json=processDefId,proto3" json:"process_def_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Weeks         int32                  `protobuf:"varint,3,opt,name=weeks,proto3" json:"weeks,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	MinTasks      int32                  `protobuf:"varint,5,opt,name=min_tasks,json=minTasks,proto3" json:"min_tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovalStatsRequest) Reset() {
+	*x = ApprovalStatsRequest{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalStatsRequest) ProtoMessage() {}
+
+func (x *ApprovalStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalStatsRequest.ProtoReflect.Descriptor instead.
+func (*ApprovalStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ApprovalStatsRequest) GetProcessDefId() string {
+	if x != nil {
+		return x.ProcessDefId
+	}
+	return ""
+}
+
+func (x *ApprovalStatsRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ApprovalStatsRequest) GetWeeks() int32 {
+	if x != nil {
+		return x.Weeks
+	}
+	return 0
+}
+
+func (x *ApprovalStatsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ApprovalStatsRequest) GetMinTasks() int32 {
+	if x != nil {
+		return x.MinTasks
+	}
+	return 0
+}
+
+type NodeWeeklyStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProcessDefId  string                 `protobuf:"bytes,1,opt,name=process_def_id,json=processDefId,proto3" json:"process_def_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeName      string                 `protobuf:"bytes,3,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	WeekStart     string                 `protobuf:"bytes,4,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"`
+	TaskCount     int32                  `protobuf:"varint,5,opt,name=task_count,json=taskCount,proto3" json:"task_count,omitempty"`
+	ApprovedCount int32                  `protobuf:"varint,6,opt,name=approved_count,json=approvedCount,proto3" json:"approved_count,omitempty"`
+	RejectedCount int32                  `protobuf:"varint,7,opt,name=rejected_count,json=rejectedCount,proto3" json:"rejected_count,omitempty"`
+	AvgSeconds    float64                `protobuf:"fixed64,8,opt,name=avg_seconds,json=avgSeconds,proto3" json:"avg_seconds,omitempty"`
+	MedianSeconds float64                `protobuf:"fixed64,9,opt,name=median_seconds,json=medianSeconds,proto3" json:"median_seconds,omitempty"`
+	P90Seconds    float64                `protobuf:"fixed64,10,opt,name=p90_seconds,json=p90Seconds,proto3" json:"p90_seconds,omitempty"`
+	RefreshedAt   string                 `protobuf:"bytes,11,opt,name=refreshed_at,json=refreshedAt,proto3" json:"refreshed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeWeeklyStats) Reset() {
+	*x = NodeWeeklyStats{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeWeeklyStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeWeeklyStats) ProtoMessage() {}
+
+func (x *NodeWeeklyStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeWeeklyStats.ProtoReflect.Descriptor instead.
+func (*NodeWeeklyStats) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *NodeWeeklyStats) GetProcessDefId() string {
+	if x != nil {
+		return x.ProcessDefId
+	}
+	return ""
+}
+
+func (x *NodeWeeklyStats) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *NodeWeeklyStats) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *NodeWeeklyStats) GetWeekStart() string {
+	if x != nil {
+		return x.WeekStart
+	}
+	return ""
+}
+
+func (x *NodeWeeklyStats) GetTaskCount() int32 {
+	if x != nil {
+		return x.TaskCount
+	}
+	return 0
+}
+
+func (x *NodeWeeklyStats) GetApprovedCount() int32 {
+	if x != nil {
+		return x.ApprovedCount
+	}
+	return 0
+}
+
+func (x *NodeWeeklyStats) GetRejectedCount() int32 {
+	if x != nil {
+		return x.RejectedCount
+	}
+	return 0
+}
+
+func (x *NodeWeeklyStats) GetAvgSeconds() float64 {
+	if x != nil {
+		return x.AvgSeconds
+	}
+	return 0
+}
+
+func (x *NodeWeeklyStats) GetMedianSeconds() float64 {
+	if x != nil {
+		return x.MedianSeconds
+	}
+	return 0
+}
+
+func (x *NodeWeeklyStats) GetP90Seconds() float64 {
+	if x != nil {
+		return x.P90Seconds
+	}
+	return 0
+}
+
+func (x *NodeWeeklyStats) GetRefreshedAt() string {
+	if x != nil {
+		return x.RefreshedAt
+	}
+	return ""
+}
+
+type NodeStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*NodeWeeklyStats     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeStatsResponse) Reset() {
+	*x = NodeStatsResponse{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeStatsResponse) ProtoMessage() {}
+
+func (x *NodeStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeStatsResponse.ProtoReflect.Descriptor instead.
+func (*NodeStatsResponse) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *NodeStatsResponse) GetItems() []*NodeWeeklyStats {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type QueueSnapshot struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ProcessDefId         string                 `protobuf:"bytes,1,opt,name=process_def_id,json=processDefId,proto3" json:"process_def_id,omitempty"`
+	NodeId               string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeName             string                 `protobuf:"bytes,3,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	SnapshotAt           string                 `protobuf:"bytes,4,opt,name=snapshot_at,json=snapshotAt,proto3" json:"snapshot_at,omitempty"`
+	PendingCount         int32                  `protobuf:"varint,5,opt,name=pending_count,json=pendingCount,proto3" json:"pending_count,omitempty"`
+	OldestPendingSeconds float64                `protobuf:"fixed64,6,opt,name=oldest_pending_seconds,json=oldestPendingSeconds,proto3" json:"oldest_pending_seconds,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *QueueSnapshot) Reset() {
+	*x = QueueSnapshot{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueSnapshot) ProtoMessage() {}
+
+func (x *QueueSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueSnapshot.ProtoReflect.Descriptor instead.
+func (*QueueSnapshot) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *QueueSnapshot) GetProcessDefId() string {
+	if x != nil {
+		return x.ProcessDefId
+	}
+	return ""
+}
+
+func (x *QueueSnapshot) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *QueueSnapshot) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *QueueSnapshot) GetSnapshotAt() string {
+	if x != nil {
+		return x.SnapshotAt
+	}
+	return ""
+}
+
+func (x *QueueSnapshot) GetPendingCount() int32 {
+	if x != nil {
+		return x.PendingCount
+	}
+	return 0
+}
+
+func (x *QueueSnapshot) GetOldestPendingSeconds() float64 {
+	if x != nil {
+		return x.OldestPendingSeconds
+	}
+	return 0
+}
+
+type QueueTrendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*QueueSnapshot       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueTrendResponse) Reset() {
+	*x = QueueTrendResponse{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueTrendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueTrendResponse) ProtoMessage() {}
+
+func (x *QueueTrendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueTrendResponse.ProtoReflect.Descriptor instead.
+func (*QueueTrendResponse) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *QueueTrendResponse) GetItems() []*QueueSnapshot {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ApproverWeeklyStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssigneeId    string                 `protobuf:"bytes,1,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	AssigneeName  string                 `protobuf:"bytes,2,opt,name=assignee_name,json=assigneeName,proto3" json:"assignee_name,omitempty"`
+	WeekStart     string                 `protobuf:"bytes,3,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"`
+	TaskCount     int32                  `protobuf:"varint,4,opt,name=task_count,json=taskCount,proto3" json:"task_count,omitempty"`
+	AvgSeconds    float64                `protobuf:"fixed64,5,opt,name=avg_seconds,json=avgSeconds,proto3" json:"avg_seconds,omitempty"`
+	P90Seconds    float64                `protobuf:"fixed64,6,opt,name=p90_seconds,json=p90Seconds,proto3" json:"p90_seconds,omitempty"`
+	RefreshedAt   string                 `protobuf:"bytes,7,opt,name=refreshed_at,json=refreshedAt,proto3" json:"refreshed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproverWeeklyStats) Reset() {
+	*x = ApproverWeeklyStats{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproverWeeklyStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproverWeeklyStats) ProtoMessage() {}
+
+func (x *ApproverWeeklyStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproverWeeklyStats.ProtoReflect.Descriptor instead.
+func (*ApproverWeeklyStats) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ApproverWeeklyStats) GetAssigneeId() string {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return ""
+}
+
+func (x *ApproverWeeklyStats) GetAssigneeName() string {
+	if x != nil {
+		return x.AssigneeName
+	}
+	return ""
+}
+
+func (x *ApproverWeeklyStats) GetWeekStart() string {
+	if x != nil {
+		return x.WeekStart
+	}
+	return ""
+}
+
+func (x *ApproverWeeklyStats) GetTaskCount() int32 {
+	if x != nil {
+		return x.TaskCount
+	}
+	return 0
+}
+
+func (x *ApproverWeeklyStats) GetAvgSeconds() float64 {
+	if x != nil {
+		return x.AvgSeconds
+	}
+	return 0
+}
+
+func (x *ApproverWeeklyStats) GetP90Seconds() float64 {
+	if x != nil {
+		return x.P90Seconds
+	}
+	return 0
+}
+
+func (x *ApproverWeeklyStats) GetRefreshedAt() string {
+	if x != nil {
+		return x.RefreshedAt
+	}
+	return ""
+}
+
+type SlowApproversResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ApproverWeeklyStats `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlowApproversResponse) Reset() {
+	*x = SlowApproversResponse{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlowApproversResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlowApproversResponse) ProtoMessage() {}
+
+func (x *SlowApproversResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlowApproversResponse.ProtoReflect.Descriptor instead.
+func (*SlowApproversResponse) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SlowApproversResponse) GetItems() []*ApproverWeeklyStats {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type RejectionReasonStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProcessDefId  string                 `protobuf:"bytes,1,opt,name=process_def_id,json=processDefId,proto3" json:"process_def_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeName      string                 `protobuf:"bytes,3,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	WeekStart     string                 `protobuf:"bytes,4,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Count         int32                  `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectionReasonStats) Reset() {
+	*x = RejectionReasonStats{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectionReasonStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectionReasonStats) ProtoMessage() {}
+
+func (x *RejectionReasonStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectionReasonStats.ProtoReflect.Descriptor instead.
+func (*RejectionReasonStats) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RejectionReasonStats) GetProcessDefId() string {
+	if x != nil {
+		return x.ProcessDefId
+	}
+	return ""
+}
+
+func (x *RejectionReasonStats) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *RejectionReasonStats) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *RejectionReasonStats) GetWeekStart() string {
+	if x != nil {
+		return x.WeekStart
+	}
+	return ""
+}
+
+func (x *RejectionReasonStats) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RejectionReasonStats) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type RejectionReasonsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Items         []*RejectionReasonStats `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectionReasonsResponse) Reset() {
+	*x = RejectionReasonsResponse{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectionReasonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectionReasonsResponse) ProtoMessage() {}
+
+func (x *RejectionReasonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectionReasonsResponse.ProtoReflect.Descriptor instead.
+func (*RejectionReasonsResponse) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RejectionReasonsResponse) GetItems() []*RejectionReasonStats {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ProcessWeeklyStats struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProcessDefId    string                 `protobuf:"bytes,1,opt,name=process_def_id,json=processDefId,proto3" json:"process_def_id,omitempty"`
+	ProcessDefName  string                 `protobuf:"bytes,2,opt,name=process_def_name,json=processDefName,proto3" json:"process_def_name,omitempty"`
+	WeekStart       string                 `protobuf:"bytes,3,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"`
+	StartedCount    int32                  `protobuf:"varint,4,opt,name=started_count,json=startedCount,proto3" json:"started_count,omitempty"`
+	CompletedCount  int32                  `protobuf:"varint,5,opt,name=completed_count,json=completedCount,proto3" json:"completed_count,omitempty"`
+	ApprovedCount   int32                  `protobuf:"varint,6,opt,name=approved_count,json=approvedCount,proto3" json:"approved_count,omitempty"`
+	RejectedCount   int32                  `protobuf:"varint,7,opt,name=rejected_count,json=rejectedCount,proto3" json:"rejected_count,omitempty"`
+	AvgCycleSeconds float64                `protobuf:"fixed64,8,opt,name=avg_cycle_seconds,json=avgCycleSeconds,proto3" json:"avg_cycle_seconds,omitempty"`
+	P90CycleSeconds float64                `protobuf:"fixed64,9,opt,name=p90_cycle_seconds,json=p90CycleSeconds,proto3" json:"p90_cycle_seconds,omitempty"`
+	RefreshedAt     string                 `protobuf:"bytes,10,opt,name=refreshed_at,json=refreshedAt,proto3" json:"refreshed_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ProcessWeeklyStats) Reset() {
+	*x = ProcessWeeklyStats{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessWeeklyStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessWeeklyStats) ProtoMessage() {}
+
+func (x *ProcessWeeklyStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessWeeklyStats.ProtoReflect.Descriptor instead.
+func (*ProcessWeeklyStats) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ProcessWeeklyStats) GetProcessDefId() string {
+	if x != nil {
+		return x.ProcessDefId
+	}
+	return ""
+}
+
+func (x *ProcessWeeklyStats) GetProcessDefName() string {
+	if x != nil {
+		return x.ProcessDefName
+	}
+	return ""
+}
+
+func (x *ProcessWeeklyStats) GetWeekStart() string {
+	if x != nil {
+		return x.WeekStart
+	}
+	return ""
+}
+
+func (x *ProcessWeeklyStats) GetStartedCount() int32 {
+	if x != nil {
+		return x.StartedCount
+	}
+	return 0
+}
+
+func (x *ProcessWeeklyStats) GetCompletedCount() int32 {
+	if x != nil {
+		return x.CompletedCount
+	}
+	return 0
+}
+
+func (x *ProcessWeeklyStats) GetApprovedCount() int32 {
+	if x != nil {
+		return x.ApprovedCount
+	}
+	return 0
+}
+
+func (x *ProcessWeeklyStats) GetRejectedCount() int32 {
+	if x != nil {
+		return x.RejectedCount
+	}
+	return 0
+}
+
+func (x *ProcessWeeklyStats) GetAvgCycleSeconds() float64 {
+	if x != nil {
+		return x.AvgCycleSeconds
+	}
+	return 0
+}
+
+func (x *ProcessWeeklyStats) GetP90CycleSeconds() float64 {
+	if x != nil {
+		return x.P90CycleSeconds
+	}
+	return 0
+}
+
+func (x *ProcessWeeklyStats) GetRefreshedAt() string {
+	if x != nil {
+		return x.RefreshedAt
+	}
+	return ""
+}
+
+type ProcessTrend struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProcessDefId   string                 `protobuf:"bytes,1,opt,name=process_def_id,json=processDefId,proto3" json:"process_def_id,omitempty"`
+	ProcessDefName string                 `protobuf:"bytes,2,opt,name=process_def_name,json=processDefName,proto3" json:"process_def_name,omitempty"`
+	Weeks          []*ProcessWeeklyStats  `protobuf:"bytes,3,rep,name=weeks,proto3" json:"weeks,omitempty"`
+	Current        *ProcessWeeklyStats    `protobuf:"bytes,4,opt,name=current,proto3" json:"current,omitempty"`
+	Previous       *ProcessWeeklyStats    `protobuf:"bytes,5,opt,name=previous,proto3" json:"previous,omitempty"`
+	// 环比变化率（上周同期为 0 时为空）
+	StartedChange   *float64 `protobuf:"fixed64,6,opt,name=started_change,json=startedChange,proto3,oneof" json:"started_change,omitempty"`
+	CompletedChange *float64 `protobuf:"fixed64,7,opt,name=completed_change,json=completedChange,proto3,oneof" json:"completed_change,omitempty"`
+	CycleTimeChange *float64 `protobuf:"fixed64,8,opt,name=cycle_time_change,json=cycleTimeChange,proto3,oneof" json:"cycle_time_change,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ProcessTrend) Reset() {
+	*x = ProcessTrend{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessTrend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessTrend) ProtoMessage() {}
+
+func (x *ProcessTrend) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessTrend.ProtoReflect.Descriptor instead.
+func (*ProcessTrend) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ProcessTrend) GetProcessDefId() string {
+	if x != nil {
+		return x.ProcessDefId
+	}
+	return ""
+}
+
+func (x *ProcessTrend) GetProcessDefName() string {
+	if x != nil {
+		return x.ProcessDefName
+	}
+	return ""
+}
+
+func (x *ProcessTrend) GetWeeks() []*ProcessWeeklyStats {
+	if x != nil {
+		return x.Weeks
+	}
+	return nil
+}
+
+func (x *ProcessTrend) GetCurrent() *ProcessWeeklyStats {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+func (x *ProcessTrend) GetPrevious() *ProcessWeeklyStats {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *ProcessTrend) GetStartedChange() float64 {
+	if x != nil && x.StartedChange != nil {
+		return *x.StartedChange
+	}
+	return 0
+}
+
+func (x *ProcessTrend) GetCompletedChange() float64 {
+	if x != nil && x.CompletedChange != nil {
+		return *x.CompletedChange
+	}
+	return 0
+}
+
+func (x *ProcessTrend) GetCycleTimeChange() float64 {
+	if x != nil && x.CycleTimeChange != nil {
+		return *x.CycleTimeChange
+	}
+	return 0
+}
+
+type ProcessTrendsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ProcessTrend        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessTrendsResponse) Reset() {
+	*x = ProcessTrendsResponse{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessTrendsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessTrendsResponse) ProtoMessage() {}
+
+func (x *ProcessTrendsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessTrendsResponse.ProtoReflect.Descriptor instead.
+func (*ProcessTrendsResponse) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ProcessTrendsResponse) GetItems() []*ProcessTrend {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type RefreshStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Weeks         int32                  `protobuf:"varint,1,opt,name=weeks,proto3" json:"weeks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshStatsRequest) Reset() {
+	*x = RefreshStatsRequest{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshStatsRequest) ProtoMessage() {}
+
+func (x *RefreshStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshStatsRequest.ProtoReflect.Descriptor instead.
+func (*RefreshStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RefreshStatsRequest) GetWeeks() int32 {
+	if x != nil {
+		return x.Weeks
+	}
+	return 0
+}
+
+type RefreshStatsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Since          string                 `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
+	QueueSnapshots int64                  `protobuf:"varint,2,opt,name=queue_snapshots,json=queueSnapshots,proto3" json:"queue_snapshots,omitempty"`
+	RefreshedAt    string                 `protobuf:"bytes,3,opt,name=refreshed_at,json=refreshedAt,proto3" json:"refreshed_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RefreshStatsResponse) Reset() {
+	*x = RefreshStatsResponse{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshStatsResponse) ProtoMessage() {}
+
+func (x *RefreshStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshStatsResponse.ProtoReflect.Descriptor instead.
+func (*RefreshStatsResponse) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RefreshStatsResponse) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *RefreshStatsResponse) GetQueueSnapshots() int64 {
+	if x != nil {
+		return x.QueueSnapshots
+	}
+	return 0
+}
+
+func (x *RefreshStatsResponse) GetRefreshedAt() string {
+	if x != nil {
+		return x.RefreshedAt
+	}
+	return ""
+}
+
+type SubmitReassignTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromUserId    string                 `protobuf:"bytes,1,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId      string                 `protobuf:"bytes,2,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	ToUserName    string                 `protobuf:"bytes,3,opt,name=to_user_name,json=toUserName,proto3" json:"to_user_name,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitReassignTasksRequest) Reset() {
+	*x = SubmitReassignTasksRequest{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitReassignTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitReassignTasksRequest) ProtoMessage() {}
+
+func (x *SubmitReassignTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitReassignTasksRequest.ProtoReflect.Descriptor instead.
+func (*SubmitReassignTasksRequest) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SubmitReassignTasksRequest) GetFromUserId() string {
+	if x != nil {
+		return x.FromUserId
+	}
+	return ""
+}
+
+func (x *SubmitReassignTasksRequest) GetToUserId() string {
+	if x != nil {
+		return x.ToUserId
+	}
+	return ""
+}
+
+func (x *SubmitReassignTasksRequest) GetToUserName() string {
+	if x != nil {
+		return x.ToUserName
+	}
+	return ""
+}
+
+func (x *SubmitReassignTasksRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SubmitInstanceJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstanceIds   []string               `protobuf:"bytes,1,rep,name=instance_ids,json=instanceIds,proto3" json:"instance_ids,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitInstanceJobRequest) Reset() {
+	*x = SubmitInstanceJobRequest{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitInstanceJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitInstanceJobRequest) ProtoMessage() {}
+
+func (x *SubmitInstanceJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitInstanceJobRequest.ProtoReflect.Descriptor instead.
+func (*SubmitInstanceJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SubmitInstanceJobRequest) GetInstanceIds() []string {
+	if x != nil {
+		return x.InstanceIds
+	}
+	return nil
+}
+
+func (x *SubmitInstanceJobRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SubmitInstanceJobRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdminJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Params        map[string]string      `protobuf:"bytes,5,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Total         int32                  `protobuf:"varint,7,opt,name=total,proto3" json:"total,omitempty"`
+	Processed     int32                  `protobuf:"varint,8,opt,name=processed,proto3" json:"processed,omitempty"`
+	Succeeded     int32                  `protobuf:"varint,9,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,10,opt,name=failed,proto3" json:"failed,omitempty"`
+	Skipped       int32                  `protobuf:"varint,11,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Error         string                 `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,13,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	StartedAt     string                 `protobuf:"bytes,15,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    string                 `protobuf:"bytes,16,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Progress      float64                `protobuf:"fixed64,18,opt,name=progress,proto3" json:"progress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminJobResponse) Reset() {
+	*x = AdminJobResponse{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminJobResponse) ProtoMessage() {}
+
+func (x *AdminJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminJobResponse.ProtoReflect.Descriptor instead.
+func (*AdminJobResponse) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *AdminJobResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AdminJobResponse) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *AdminJobResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AdminJobResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminJobResponse) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *AdminJobResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AdminJobResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AdminJobResponse) GetProcessed() int32 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *AdminJobResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *AdminJobResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *AdminJobResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *AdminJobResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AdminJobResponse) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *AdminJobResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminJobResponse) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *AdminJobResponse) GetFinishedAt() string {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return ""
+}
+
+func (x *AdminJobResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *AdminJobResponse) GetProgress() float64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+type ListAdminJobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAdminJobsRequest) Reset() {
+	*x = ListAdminJobsRequest{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdminJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdminJobsRequest) ProtoMessage() {}
+
+func (x *ListAdminJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdminJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListAdminJobsRequest) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListAdminJobsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAdminJobsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListAdminJobsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*AdminJobResponse    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAdminJobsResponse) Reset() {
+	*x = ListAdminJobsResponse{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdminJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdminJobsResponse) ProtoMessage() {}
+
+func (x *ListAdminJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdminJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListAdminJobsResponse) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListAdminJobsResponse) GetItems() []*AdminJobResponse {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetAdminJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAdminJobRequest) Reset() {
+	*x = GetAdminJobRequest{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdminJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdminJobRequest) ProtoMessage() {}
+
+func (x *GetAdminJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdminJobRequest.ProtoReflect.Descriptor instead.
+func (*GetAdminJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetAdminJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListAdminJobItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAdminJobItemsRequest) Reset() {
+	*x = ListAdminJobItemsRequest{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdminJobItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdminJobItemsRequest) ProtoMessage() {}
+
+func (x *ListAdminJobItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdminJobItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListAdminJobItemsRequest) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListAdminJobItemsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type AdminJobItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	TargetId      string                 `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	ProcessedAt   string                 `protobuf:"bytes,6,opt,name=processed_at,json=processedAt,proto3" json:"processed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminJobItem) Reset() {
+	*x = AdminJobItem{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminJobItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminJobItem) ProtoMessage() {}
+
+func (x *AdminJobItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminJobItem.ProtoReflect.Descriptor instead.
+func (*AdminJobItem) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *AdminJobItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AdminJobItem) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *AdminJobItem) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *AdminJobItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminJobItem) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AdminJobItem) GetProcessedAt() string {
+	if x != nil {
+		return x.ProcessedAt
+	}
+	return ""
+}
+
+type ListAdminJobItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*AdminJobItem        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAdminJobItemsResponse) Reset() {
+	*x = ListAdminJobItemsResponse{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdminJobItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdminJobItemsResponse) ProtoMessage() {}
+
+func (x *ListAdminJobItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdminJobItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListAdminJobItemsResponse) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListAdminJobItemsResponse) GetItems() []*AdminJobItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type PreviewActionLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewActionLinkRequest) Reset() {
+	*x = PreviewActionLinkRequest{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewActionLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewActionLinkRequest) ProtoMessage() {}
+
+func (x *PreviewActionLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewActionLinkRequest.ProtoReflect.Descriptor instead.
+func (*PreviewActionLinkRequest) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *PreviewActionLinkRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ActionLinkPreviewResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TaskId            string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	ProcessInstanceId string                 `protobuf:"bytes,2,opt,name=process_instance_id,json=processInstanceId,proto3" json:"process_instance_id,omitempty"`
+	NodeName          string                 `protobuf:"bytes,3,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	Action            string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	TaskStatus        string                 `protobuf:"bytes,5,opt,name=task_status,json=taskStatus,proto3" json:"task_status,omitempty"`
+	ExpiresAt         string                 `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ActionLinkPreviewResponse) Reset() {
+	*x = ActionLinkPreviewResponse{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActionLinkPreviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionLinkPreviewResponse) ProtoMessage() {}
+
+func (x *ActionLinkPreviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionLinkPreviewResponse.ProtoReflect.Descriptor instead.
+func (*ActionLinkPreviewResponse) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ActionLinkPreviewResponse) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ActionLinkPreviewResponse) GetProcessInstanceId() string {
+	if x != nil {
+		return x.ProcessInstanceId
+	}
+	return ""
+}
+
+func (x *ActionLinkPreviewResponse) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *ActionLinkPreviewResponse) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ActionLinkPreviewResponse) GetTaskStatus() string {
+	if x != nil {
+		return x.TaskStatus
+	}
+	return ""
+}
+
+func (x *ActionLinkPreviewResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type ExecuteActionLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Comment       *string                `protobuf:"bytes,2,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecuteActionLinkRequest) Reset() {
+	*x = ExecuteActionLinkRequest{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteActionLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteActionLinkRequest) ProtoMessage() {}
+
+func (x *ExecuteActionLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteActionLinkRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteActionLinkRequest) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ExecuteActionLinkRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ExecuteActionLinkRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+type InboundReplyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Subject       string                 `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Nonce         string                 `protobuf:"bytes,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Signature     string                 `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InboundReplyRequest) Reset() {
+	*x = InboundReplyRequest{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InboundReplyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InboundReplyRequest) ProtoMessage() {}
+
+func (x *InboundReplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InboundReplyRequest.ProtoReflect.Descriptor instead.
+func (*InboundReplyRequest) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *InboundReplyRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *InboundReplyRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *InboundReplyRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *InboundReplyRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *InboundReplyRequest) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *InboundReplyRequest) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *InboundReplyRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type ActionLinkResultResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Processed     bool                   `protobuf:"varint,3,opt,name=processed,proto3" json:"processed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActionLinkResultResponse) Reset() {
+	*x = ActionLinkResultResponse{}
+	mi := &file_api_approval_v1_approval_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActionLinkResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionLinkResultResponse) ProtoMessage() {}
+
+func (x *ActionLinkResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_approval_v1_approval_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionLinkResultResponse.ProtoReflect.Descriptor instead.
+func (*ActionLinkResultResponse) Descriptor() ([]byte, []int) {
+	return file_api_approval_v1_approval_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ActionLinkResultResponse) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ActionLinkResultResponse) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ActionLinkResultResponse) GetProcessed() bool {
+	if x != nil {
+		return x.Processed
+	}
+	return false
+}
+
 var File_api_approval_v1_approval_proto protoreflect.FileDescriptor
 
 const file_api_approval_v1_approval_proto_rawDesc = "" +
@@ -2308,7 +5257,270 @@ const file_api_approval_v1_approval_proto_rawDesc = "" +
 	"updated_at\x18\r \x01(\tR\tupdatedAt\"n\n" +
 	"\x19ListApprovalTasksResponse\x12;\n" +
 	"\x05items\x18\x01 \x03(\v2%.api.approval.v1.ApprovalTaskResponseR\x05items\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total2\xa1\t\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x84\x01\n" +
+	"\vFieldFilter\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
+	"\x02op\x18\x02 \x01(\tB\"\xfaB\x1fr\x1dR\x02eqR\x04likeR\x03gteR\x03lteR\abetweenR\x02op\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x19\n" +
+	"\bvalue_to\x18\x04 \x01(\tR\avalueTo\"\xfb\x02\n" +
+	"\x16SearchInstancesRequest\x12\x18\n" +
+	"\akeyword\x18\x01 \x01(\tR\akeyword\x12$\n" +
+	"\x0eprocess_def_id\x18\x02 \x01(\tR\fprocessDefId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12!\n" +
+	"\fapplicant_id\x18\x04 \x01(\tR\vapplicantId\x12A\n" +
+	"\rfield_filters\x18\x05 \x03(\v2\x1c.api.approval.v1.FieldFilterR\ffieldFilters\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x06 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\a \x01(\tR\aendDate\x12\x17\n" +
+	"\asort_by\x18\b \x01(\tR\x06sortBy\x12\x1b\n" +
+	"\tsort_desc\x18\t \x01(\bR\bsortDesc\x12\x16\n" +
+	"\x06cursor\x18\n" +
+	" \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\v \x01(\x05R\bpageSize\"\xb2\x01\n" +
+	"\x17SearchInstancesResponse\x12>\n" +
+	"\x05items\x18\x01 \x03(\v2(.api.approval.v1.ProcessInstanceResponseR\x05items\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x19\n" +
+	"\bhas_next\x18\x03 \x01(\bR\ahasNext\x12\x1f\n" +
+	"\vnext_cursor\x18\x04 \x01(\tR\n" +
+	"nextCursor\"2\n" +
+	"\x16GetSearchFieldsRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\"\x8f\x01\n" +
+	"\x11SearchFieldConfig\x12&\n" +
+	"\tfield_key\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\bfieldKey\x12\x1f\n" +
+	"\vfield_label\x18\x02 \x01(\tR\n" +
+	"fieldLabel\x12\x1d\n" +
+	"\n" +
+	"field_type\x18\x03 \x01(\tR\tfieldType\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\x05R\x04sort\"t\n" +
+	"\x1cConfigureSearchFieldsRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x12:\n" +
+	"\x06fields\x18\x02 \x03(\v2\".api.approval.v1.SearchFieldConfigR\x06fields\"\xfa\x01\n" +
+	"\x13SearchFieldResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12$\n" +
+	"\x0eprocess_def_id\x18\x02 \x01(\tR\fprocessDefId\x12\x1b\n" +
+	"\tfield_key\x18\x03 \x01(\tR\bfieldKey\x12\x1f\n" +
+	"\vfield_label\x18\x04 \x01(\tR\n" +
+	"fieldLabel\x12\x1d\n" +
+	"\n" +
+	"field_type\x18\x05 \x01(\tR\tfieldType\x12\x12\n" +
+	"\x04sort\x18\x06 \x01(\x05R\x04sort\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\"R\n" +
+	"\x14SearchFieldsResponse\x12:\n" +
+	"\x05items\x18\x01 \x03(\v2$.api.approval.v1.SearchFieldResponseR\x05items\";\n" +
+	"\x1fReindexProcessDefinitionRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\"<\n" +
+	" ReindexProcessDefinitionResponse\x12\x18\n" +
+	"\aindexed\x18\x01 \x01(\x05R\aindexed\"5\n" +
+	"\x19VerifyHistoryChainRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\"z\n" +
+	"\x11HistoryChainIssue\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x03R\bsequence\x12\x1d\n" +
+	"\n" +
+	"history_id\x18\x03 \x01(\tR\thistoryId\x12\x16\n" +
+	"\x06detail\x18\x04 \x01(\tR\x06detail\"\x97\x02\n" +
+	"\x1aHistoryChainReportResponse\x12.\n" +
+	"\x13process_instance_id\x18\x01 \x01(\tR\x11processInstanceId\x12\x14\n" +
+	"\x05valid\x18\x02 \x01(\bR\x05valid\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12#\n" +
+	"\rhead_sequence\x18\x04 \x01(\x03R\fheadSequence\x12\x1b\n" +
+	"\thead_hash\x18\x05 \x01(\tR\bheadHash\x12:\n" +
+	"\x06issues\x18\x06 \x03(\v2\".api.approval.v1.HistoryChainIssueR\x06issues\x12\x1f\n" +
+	"\vverified_at\x18\a \x01(\tR\n" +
+	"verifiedAt\"H\n" +
+	"\x14ExportDossierRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\"\xbc\x01\n" +
+	"\x15DossierExportResponse\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x12\x1f\n" +
+	"\vchain_valid\x18\x05 \x01(\bR\n" +
+	"chainValid\x12!\n" +
+	"\fdownload_url\x18\x06 \x01(\tR\vdownloadUrl\"\x9e\x01\n" +
+	"\x14ApprovalStatsRequest\x12$\n" +
+	"\x0eprocess_def_id\x18\x01 \x01(\tR\fprocessDefId\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x14\n" +
+	"\x05weeks\x18\x03 \x01(\x05R\x05weeks\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x1b\n" +
+	"\tmin_tasks\x18\x05 \x01(\x05R\bminTasks\"\x85\x03\n" +
+	"\x0fNodeWeeklyStats\x12$\n" +
+	"\x0eprocess_def_id\x18\x01 \x01(\tR\fprocessDefId\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x1b\n" +
+	"\tnode_name\x18\x03 \x01(\tR\bnodeName\x12\x1d\n" +
+	"\n" +
+	"week_start\x18\x04 \x01(\tR\tweekStart\x12\x1d\n" +
+	"\n" +
+	"task_count\x18\x05 \x01(\x05R\ttaskCount\x12%\n" +
+	"\x0eapproved_count\x18\x06 \x01(\x05R\rapprovedCount\x12%\n" +
+	"\x0erejected_count\x18\a \x01(\x05R\rrejectedCount\x12\x1f\n" +
+	"\vavg_seconds\x18\b \x01(\x01R\n" +
+	"avgSeconds\x12%\n" +
+	"\x0emedian_seconds\x18\t \x01(\x01R\rmedianSeconds\x12\x1f\n" +
+	"\vp90_seconds\x18\n" +
+	" \x01(\x01R\n" +
+	"p90Seconds\x12!\n" +
+	"\frefreshed_at\x18\v \x01(\tR\vrefreshedAt\"K\n" +
+	"\x11NodeStatsResponse\x126\n" +
+	"\x05items\x18\x01 \x03(\v2 .api.approval.v1.NodeWeeklyStatsR\x05items\"\xe7\x01\n" +
+	"\rQueueSnapshot\x12$\n" +
+	"\x0eprocess_def_id\x18\x01 \x01(\tR\fprocessDefId\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x1b\n" +
+	"\tnode_name\x18\x03 \x01(\tR\bnodeName\x12\x1f\n" +
+	"\vsnapshot_at\x18\x04 \x01(\tR\n" +
+	"snapshotAt\x12#\n" +
+	"\rpending_count\x18\x05 \x01(\x05R\fpendingCount\x124\n" +
+	"\x16oldest_pending_seconds\x18\x06 \x01(\x01R\x14oldestPendingSeconds\"J\n" +
+	"\x12QueueTrendResponse\x124\n" +
+	"\x05items\x18\x01 \x03(\v2\x1e.api.approval.v1.QueueSnapshotR\x05items\"\xfe\x01\n" +
+	"\x13ApproverWeeklyStats\x12\x1f\n" +
+	"\vassignee_id\x18\x01 \x01(\tR\n" +
+	"assigneeId\x12#\n" +
+	"\rassignee_name\x18\x02 \x01(\tR\fassigneeName\x12\x1d\n" +
+	"\n" +
+	"week_start\x18\x03 \x01(\tR\tweekStart\x12\x1d\n" +
+	"\n" +
+	"task_count\x18\x04 \x01(\x05R\ttaskCount\x12\x1f\n" +
+	"\vavg_seconds\x18\x05 \x01(\x01R\n" +
+	"avgSeconds\x12\x1f\n" +
+	"\vp90_seconds\x18\x06 \x01(\x01R\n" +
+	"p90Seconds\x12!\n" +
+	"\frefreshed_at\x18\a \x01(\tR\vrefreshedAt\"S\n" +
+	"\x15SlowApproversResponse\x12:\n" +
+	"\x05items\x18\x01 \x03(\v2$.api.approval.v1.ApproverWeeklyStatsR\x05items\"\xbf\x01\n" +
+	"\x14RejectionReasonStats\x12$\n" +
+	"\x0eprocess_def_id\x18\x01 \x01(\tR\fprocessDefId\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x1b\n" +
+	"\tnode_name\x18\x03 \x01(\tR\bnodeName\x12\x1d\n" +
+	"\n" +
+	"week_start\x18\x04 \x01(\tR\tweekStart\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05count\x18\x06 \x01(\x05R\x05count\"W\n" +
+	"\x18RejectionReasonsResponse\x12;\n" +
+	"\x05items\x18\x01 \x03(\v2%.api.approval.v1.RejectionReasonStatsR\x05items\"\x9a\x03\n" +
+	"\x12ProcessWeeklyStats\x12$\n" +
+	"\x0eprocess_def_id\x18\x01 \x01(\tR\fprocessDefId\x12(\n" +
+	"\x10process_def_name\x18\x02 \x01(\tR\x0eprocessDefName\x12\x1d\n" +
+	"\n" +
+	"week_start\x18\x03 \x01(\tR\tweekStart\x12#\n" +
+	"\rstarted_count\x18\x04 \x01(\x05R\fstartedCount\x12'\n" +
+	"\x0fcompleted_count\x18\x05 \x01(\x05R\x0ecompletedCount\x12%\n" +
+	"\x0eapproved_count\x18\x06 \x01(\x05R\rapprovedCount\x12%\n" +
+	"\x0erejected_count\x18\a \x01(\x05R\rrejectedCount\x12*\n" +
+	"\x11avg_cycle_seconds\x18\b \x01(\x01R\x0favgCycleSeconds\x12*\n" +
+	"\x11p90_cycle_seconds\x18\t \x01(\x01R\x0fp90CycleSeconds\x12!\n" +
+	"\frefreshed_at\x18\n" +
+	" \x01(\tR\vrefreshedAt\"\xe4\x03\n" +
+	"\fProcessTrend\x12$\n" +
+	"\x0eprocess_def_id\x18\x01 \x01(\tR\fprocessDefId\x12(\n" +
+	"\x10process_def_name\x18\x02 \x01(\tR\x0eprocessDefName\x129\n" +
+	"\x05weeks\x18\x03 \x03(\v2#.api.approval.v1.ProcessWeeklyStatsR\x05weeks\x12=\n" +
+	"\acurrent\x18\x04 \x01(\v2#.api.approval.v1.ProcessWeeklyStatsR\acurrent\x12?\n" +
+	"\bprevious\x18\x05 \x01(\v2#.api.approval.v1.ProcessWeeklyStatsR\bprevious\x12*\n" +
+	"\x0estarted_change\x18\x06 \x01(\x01H\x00R\rstartedChange\x88\x01\x01\x12.\n" +
+	"\x10completed_change\x18\a \x01(\x01H\x01R\x0fcompletedChange\x88\x01\x01\x12/\n" +
+	"\x11cycle_time_change\x18\b \x01(\x01H\x02R\x0fcycleTimeChange\x88\x01\x01B\x11\n" +
+	"\x0f_started_changeB\x13\n" +
+	"\x11_completed_changeB\x14\n" +
+	"\x12_cycle_time_change\"L\n" +
+	"\x15ProcessTrendsResponse\x123\n" +
+	"\x05items\x18\x01 \x03(\v2\x1d.api.approval.v1.ProcessTrendR\x05items\"+\n" +
+	"\x13RefreshStatsRequest\x12\x14\n" +
+	"\x05weeks\x18\x01 \x01(\x05R\x05weeks\"x\n" +
+	"\x14RefreshStatsResponse\x12\x14\n" +
+	"\x05since\x18\x01 \x01(\tR\x05since\x12'\n" +
+	"\x0fqueue_snapshots\x18\x02 \x01(\x03R\x0equeueSnapshots\x12!\n" +
+	"\frefreshed_at\x18\x03 \x01(\tR\vrefreshedAt\"\xb3\x01\n" +
+	"\x1aSubmitReassignTasksRequest\x12*\n" +
+	"\ffrom_user_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"fromUserId\x12&\n" +
+	"\n" +
+	"to_user_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\btoUserId\x12 \n" +
+	"\fto_user_name\x18\x03 \x01(\tR\n" +
+	"toUserName\x12\x1f\n" +
+	"\x06reason\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06reason\"\x80\x01\n" +
+	"\x18SubmitInstanceJobRequest\x12+\n" +
+	"\finstance_ids\x18\x01 \x03(\tB\b\xfaB\x05\x92\x01\x02\b\x01R\vinstanceIds\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1f\n" +
+	"\x06reason\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06reason\"\xd8\x04\n" +
+	"\x10AdminJobResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12E\n" +
+	"\x06params\x18\x05 \x03(\v2-.api.approval.v1.AdminJobResponse.ParamsEntryR\x06params\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05total\x18\a \x01(\x05R\x05total\x12\x1c\n" +
+	"\tprocessed\x18\b \x01(\x05R\tprocessed\x12\x1c\n" +
+	"\tsucceeded\x18\t \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\n" +
+	" \x01(\x05R\x06failed\x12\x18\n" +
+	"\askipped\x18\v \x01(\x05R\askipped\x12\x14\n" +
+	"\x05error\x18\f \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\r \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x0f \x01(\tR\tstartedAt\x12\x1f\n" +
+	"\vfinished_at\x18\x10 \x01(\tR\n" +
+	"finishedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x11 \x01(\tR\tupdatedAt\x12\x1a\n" +
+	"\bprogress\x18\x12 \x01(\x01R\bprogress\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"D\n" +
+	"\x14ListAdminJobsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\"P\n" +
+	"\x15ListAdminJobsResponse\x127\n" +
+	"\x05items\x18\x01 \x03(\v2!.api.approval.v1.AdminJobResponseR\x05items\".\n" +
+	"\x12GetAdminJobRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\"4\n" +
+	"\x18ListAdminJobItemsRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\"\xa7\x01\n" +
+	"\fAdminJobItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\tR\x05jobId\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\tR\btargetId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12!\n" +
+	"\fprocessed_at\x18\x06 \x01(\tR\vprocessedAt\"P\n" +
+	"\x19ListAdminJobItemsResponse\x123\n" +
+	"\x05items\x18\x01 \x03(\v2\x1d.api.approval.v1.AdminJobItemR\x05items\"0\n" +
+	"\x18PreviewActionLinkRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xd9\x01\n" +
+	"\x19ActionLinkPreviewResponse\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12.\n" +
+	"\x13process_instance_id\x18\x02 \x01(\tR\x11processInstanceId\x12\x1b\n" +
+	"\tnode_name\x18\x03 \x01(\tR\bnodeName\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x1f\n" +
+	"\vtask_status\x18\x05 \x01(\tR\n" +
+	"taskStatus\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\tR\texpiresAt\"[\n" +
+	"\x18ExecuteActionLinkRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\acomment\x18\x02 \x01(\tH\x00R\acomment\x88\x01\x01B\n" +
+	"\n" +
+	"\b_comment\"\xb9\x01\n" +
+	"\x13InboundReplyRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\x12\x1c\n" +
+	"\ttimestamp\x18\x05 \x01(\tR\ttimestamp\x12\x14\n" +
+	"\x05nonce\x18\x06 \x01(\tR\x05nonce\x12\x1c\n" +
+	"\tsignature\x18\a \x01(\tR\tsignature\"i\n" +
+	"\x18ActionLinkResultResponse\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x1c\n" +
+	"\tprocessed\x18\x03 \x01(\bR\tprocessed2\xa1\t\n" +
 	"\x18ProcessDefinitionService\x12\x94\x01\n" +
 	"\x17CreateProcessDefinition\x12/.api.approval.v1.CreateProcessDefinitionRequest\x1a*.api.approval.v1.ProcessDefinitionResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/v1/processes\x12\x99\x01\n" +
 	"\x17UpdateProcessDefinition\x12/.api.approval.v1.UpdateProcessDefinitionRequest\x1a*.api.approval.v1.ProcessDefinitionResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\x1a\x16/api/v1/processes/{id}\x12\x90\x01\n" +
@@ -2333,7 +5545,34 @@ const file_api_approval_v1_approval_proto_rawDesc = "" +
 	"\vProcessTask\x12#.api.approval.v1.ProcessTaskRequest\x1a\x16.google.protobuf.Empty\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/approval-tasks/{id}/process\x12\x9b\x01\n" +
 	"\x11BatchProcessTasks\x12).api.approval.v1.BatchProcessTasksRequest\x1a*.api.approval.v1.BatchProcessTasksResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/approval-tasks/batch-process\x12}\n" +
 	"\fTransferTask\x12$.api.approval.v1.TransferTaskRequest\x1a\x16.google.protobuf.Empty\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/approval-tasks/{id}/transfer\x12}\n" +
-	"\fDelegateTask\x12$.api.approval.v1.DelegateTaskRequest\x1a\x16.google.protobuf.Empty\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/approval-tasks/{id}/delegateB\xc2\x01\n" +
+	"\fDelegateTask\x12$.api.approval.v1.DelegateTaskRequest\x1a\x16.google.protobuf.Empty\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/approval-tasks/{id}/delegate2\x98\x05\n" +
+	"\x14ProcessSearchService\x12\x91\x01\n" +
+	"\x0fSearchInstances\x12'.api.approval.v1.SearchInstancesRequest\x1a(.api.approval.v1.SearchInstancesResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/process-instances/search\x12\x8f\x01\n" +
+	"\x0fGetSearchFields\x12'.api.approval.v1.GetSearchFieldsRequest\x1a%.api.approval.v1.SearchFieldsResponse\",\x82\xd3\xe4\x93\x02&\x12$/api/v1/processes/{id}/search-fields\x12\x9e\x01\n" +
+	"\x15ConfigureSearchFields\x12-.api.approval.v1.ConfigureSearchFieldsRequest\x1a%.api.approval.v1.SearchFieldsResponse\"/\x82\xd3\xe4\x93\x02):\x01*\x1a$/api/v1/processes/{id}/search-fields\x12\xb8\x01\n" +
+	"\x18ReindexProcessDefinition\x120.api.approval.v1.ReindexProcessDefinitionRequest\x1a1.api.approval.v1.ReindexProcessDefinitionResponse\"7\x82\xd3\xe4\x93\x021:\x01*\",/api/v1/processes/{id}/search-fields/reindex2\xd0\x02\n" +
+	"\x13ProcessAuditService\x12\xa4\x01\n" +
+	"\x12VerifyHistoryChain\x12*.api.approval.v1.VerifyHistoryChainRequest\x1a+.api.approval.v1.HistoryChainReportResponse\"5\x82\xd3\xe4\x93\x02/\x12-/api/v1/process-instances/{id}/history/verify\x12\x91\x01\n" +
+	"\rExportDossier\x12%.api.approval.v1.ExportDossierRequest\x1a&.api.approval.v1.DossierExportResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/process-instances/{id}/dossier2\xdd\x06\n" +
+	"\x13ProcessStatsService\x12\x7f\n" +
+	"\fGetNodeStats\x12%.api.approval.v1.ApprovalStatsRequest\x1a\".api.approval.v1.NodeStatsResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/approval-stats/nodes\x12\x81\x01\n" +
+	"\rGetQueueTrend\x12%.api.approval.v1.ApprovalStatsRequest\x1a#.api.approval.v1.QueueTrendResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/approval-stats/queue\x12\x90\x01\n" +
+	"\x10GetSlowApprovers\x12%.api.approval.v1.ApprovalStatsRequest\x1a&.api.approval.v1.SlowApproversResponse\"-\x82\xd3\xe4\x93\x02'\x12%/api/v1/approval-stats/slow-approvers\x12\x99\x01\n" +
+	"\x13GetRejectionReasons\x12%.api.approval.v1.ApprovalStatsRequest\x1a).api.approval.v1.RejectionReasonsResponse\"0\x82\xd3\xe4\x93\x02*\x12(/api/v1/approval-stats/rejection-reasons\x12\x88\x01\n" +
+	"\x10GetProcessTrends\x12%.api.approval.v1.ApprovalStatsRequest\x1a&.api.approval.v1.ProcessTrendsResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/approval-stats/trends\x12\x86\x01\n" +
+	"\fRefreshStats\x12$.api.approval.v1.RefreshStatsRequest\x1a%.api.approval.v1.RefreshStatsResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/approval-stats/refresh2\xa2\b\n" +
+	"\x16ProcessAdminJobService\x12\x96\x01\n" +
+	"\x13SubmitReassignTasks\x12+.api.approval.v1.SubmitReassignTasksRequest\x1a!.api.approval.v1.AdminJobResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/approval-admin/jobs/reassign\x12\x94\x01\n" +
+	"\x15SubmitCancelInstances\x12).api.approval.v1.SubmitInstanceJobRequest\x1a!.api.approval.v1.AdminJobResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/approval-admin/jobs/cancel\x12\x9a\x01\n" +
+	"\x13SubmitForceComplete\x12).api.approval.v1.SubmitInstanceJobRequest\x1a!.api.approval.v1.AdminJobResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/approval-admin/jobs/force-complete\x12\x96\x01\n" +
+	"\x14SubmitRetriggerTasks\x12).api.approval.v1.SubmitInstanceJobRequest\x1a!.api.approval.v1.AdminJobResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/approval-admin/jobs/retrigger\x12\x83\x01\n" +
+	"\rListAdminJobs\x12%.api.approval.v1.ListAdminJobsRequest\x1a&.api.approval.v1.ListAdminJobsResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/approval-admin/jobs\x12\x7f\n" +
+	"\vGetAdminJob\x12#.api.approval.v1.GetAdminJobRequest\x1a!.api.approval.v1.AdminJobResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/approval-admin/jobs/{id}\x12\x9a\x01\n" +
+	"\x11ListAdminJobItems\x12).api.approval.v1.ListAdminJobItemsRequest\x1a*.api.approval.v1.ListAdminJobItemsResponse\".\x82\xd3\xe4\x93\x02(\x12&/api/v1/approval-admin/jobs/{id}/items2\xd2\x03\n" +
+	"\x11ActionLinkService\x12\x92\x01\n" +
+	"\x11PreviewActionLink\x12).api.approval.v1.PreviewActionLinkRequest\x1a*.api.approval.v1.ActionLinkPreviewResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/approval-links/preview\x12\x94\x01\n" +
+	"\x11ExecuteActionLink\x12).api.approval.v1.ExecuteActionLinkRequest\x1a).api.approval.v1.ActionLinkResultResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/approval-links/execute\x12\x90\x01\n" +
+	"\fInboundReply\x12$.api.approval.v1.InboundReplyRequest\x1a).api.approval.v1.ActionLinkResultResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/approval-links/inbound-emailB\xc2\x01\n" +
 	"\x13com.api.approval.v1B\rApprovalProtoP\x01Z>github.com/lk2023060901/go-next-erp/api/approval/v1;approvalv1\xa2\x02\x03AAX\xaa\x02\x0fApi.Approval.V1\xca\x02\x0fApi\\Approval\\V1\xe2\x02\x1bApi\\Approval\\V1\\GPBMetadata\xea\x02\x11Api::Approval::V1b\x06proto3"
 
 var (
@@ -2348,101 +5587,205 @@ func file_api_approval_v1_approval_proto_rawDescGZIP() []byte {
 	return file_api_approval_v1_approval_proto_rawDescData
 }
 
-var file_api_approval_v1_approval_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_api_approval_v1_approval_proto_msgTypes = make([]protoimpl.MessageInfo, 79)
 var file_api_approval_v1_approval_proto_goTypes = []any{
-	(*CreateProcessDefinitionRequest)(nil),  // 0: api.approval.v1.CreateProcessDefinitionRequest
-	(*UpdateProcessDefinitionRequest)(nil),  // 1: api.approval.v1.UpdateProcessDefinitionRequest
-	(*GetProcessDefinitionRequest)(nil),     // 2: api.approval.v1.GetProcessDefinitionRequest
-	(*ListProcessDefinitionsRequest)(nil),   // 3: api.approval.v1.ListProcessDefinitionsRequest
-	(*DeleteProcessDefinitionRequest)(nil),  // 4: api.approval.v1.DeleteProcessDefinitionRequest
-	(*EnableProcessDefinitionRequest)(nil),  // 5: api.approval.v1.EnableProcessDefinitionRequest
-	(*DisableProcessDefinitionRequest)(nil), // 6: api.approval.v1.DisableProcessDefinitionRequest
-	(*GetProcessStatsRequest)(nil),          // 7: api.approval.v1.GetProcessStatsRequest
-	(*ProcessDefinitionResponse)(nil),       // 8: api.approval.v1.ProcessDefinitionResponse
-	(*ListProcessDefinitionsResponse)(nil),  // 9: api.approval.v1.ListProcessDefinitionsResponse
-	(*ProcessStatsResponse)(nil),            // 10: api.approval.v1.ProcessStatsResponse
-	(*StartProcessRequest)(nil),             // 11: api.approval.v1.StartProcessRequest
-	(*GetProcessInstanceRequest)(nil),       // 12: api.approval.v1.GetProcessInstanceRequest
-	(*ListMyApplicationsRequest)(nil),       // 13: api.approval.v1.ListMyApplicationsRequest
-	(*WithdrawProcessRequest)(nil),          // 14: api.approval.v1.WithdrawProcessRequest
-	(*CancelProcessRequest)(nil),            // 15: api.approval.v1.CancelProcessRequest
-	(*ListProcessInstancesRequest)(nil),     // 16: api.approval.v1.ListProcessInstancesRequest
-	(*ProcessInstanceResponse)(nil),         // 17: api.approval.v1.ProcessInstanceResponse
-	(*ListProcessInstancesResponse)(nil),    // 18: api.approval.v1.ListProcessInstancesResponse
-	(*InstanceStatsSummaryResponse)(nil),    // 19: api.approval.v1.InstanceStatsSummaryResponse
-	(*GetInstanceStatsSummaryRequest)(nil),  // 20: api.approval.v1.GetInstanceStatsSummaryRequest
-	(*GetApprovalTaskRequest)(nil),          // 21: api.approval.v1.GetApprovalTaskRequest
-	(*ListMyTasksRequest)(nil),              // 22: api.approval.v1.ListMyTasksRequest
-	(*CountPendingTasksRequest)(nil),        // 23: api.approval.v1.CountPendingTasksRequest
-	(*CountPendingTasksResponse)(nil),       // 24: api.approval.v1.CountPendingTasksResponse
-	(*ProcessTaskRequest)(nil),              // 25: api.approval.v1.ProcessTaskRequest
-	(*BatchProcessTasksRequest)(nil),        // 26: api.approval.v1.BatchProcessTasksRequest
-	(*BatchProcessTasksResponse)(nil),       // 27: api.approval.v1.BatchProcessTasksResponse
-	(*BatchProcessResult)(nil),              // 28: api.approval.v1.BatchProcessResult
-	(*TransferTaskRequest)(nil),             // 29: api.approval.v1.TransferTaskRequest
-	(*DelegateTaskRequest)(nil),             // 30: api.approval.v1.DelegateTaskRequest
-	(*ApprovalTaskResponse)(nil),            // 31: api.approval.v1.ApprovalTaskResponse
-	(*ListApprovalTasksResponse)(nil),       // 32: api.approval.v1.ListApprovalTasksResponse
-	nil,                                     // 33: api.approval.v1.StartProcessRequest.FormDataEntry
-	nil,                                     // 34: api.approval.v1.InstanceStatsSummaryResponse.ByStatusEntry
-	(*emptypb.Empty)(nil),                   // 35: google.protobuf.Empty
+	(*CreateProcessDefinitionRequest)(nil),   // 0: api.approval.v1.CreateProcessDefinitionRequest
+	(*UpdateProcessDefinitionRequest)(nil),   // 1: api.approval.v1.UpdateProcessDefinitionRequest
+	(*GetProcessDefinitionRequest)(nil),      // 2: api.approval.v1.GetProcessDefinitionRequest
+	(*ListProcessDefinitionsRequest)(nil),    // 3: api.approval.v1.ListProcessDefinitionsRequest
+	(*DeleteProcessDefinitionRequest)(nil),   // 4: api.approval.v1.DeleteProcessDefinitionRequest
+	(*EnableProcessDefinitionRequest)(nil),   // 5: api.approval.v1.EnableProcessDefinitionRequest
+	(*DisableProcessDefinitionRequest)(nil),  // 6: api.approval.v1.DisableProcessDefinitionRequest
+	(*GetProcessStatsRequest)(nil),           // 7: api.approval.v1.GetProcessStatsRequest
+	(*ProcessDefinitionResponse)(nil),        // 8: api.approval.v1.ProcessDefinitionResponse
+	(*ListProcessDefinitionsResponse)(nil),   // 9: api.approval.v1.ListProcessDefinitionsResponse
+	(*ProcessStatsResponse)(nil),             // 10: api.approval.v1.ProcessStatsResponse
+	(*StartProcessRequest)(nil),              // 11: api.approval.v1.StartProcessRequest
+	(*GetProcessInstanceRequest)(nil),        // 12: api.approval.v1.GetProcessInstanceRequest
+	(*ListMyApplicationsRequest)(nil),        // 13: api.approval.v1.ListMyApplicationsRequest
+	(*WithdrawProcessRequest)(nil),           // 14: api.approval.v1.WithdrawProcessRequest
+	(*CancelProcessRequest)(nil),             // 15: api.approval.v1.CancelProcessRequest
+	(*ListProcessInstancesRequest)(nil),      // 16: api.approval.v1.ListProcessInstancesRequest
+	(*ProcessInstanceResponse)(nil),          // 17: api.approval.v1.ProcessInstanceResponse
+	(*ListProcessInstancesResponse)(nil),     // 18: api.approval.v1.ListProcessInstancesResponse
+	(*InstanceStatsSummaryResponse)(nil),     // 19: api.approval.v1.InstanceStatsSummaryResponse
+	(*GetInstanceStatsSummaryRequest)(nil),   // 20: api.approval.v1.GetInstanceStatsSummaryRequest
+	(*GetApprovalTaskRequest)(nil),           // 21: api.approval.v1.GetApprovalTaskRequest
+	(*ListMyTasksRequest)(nil),               // 22: api.approval.v1.ListMyTasksRequest
+	(*CountPendingTasksRequest)(nil),         // 23: api.approval.v1.CountPendingTasksRequest
+	(*CountPendingTasksResponse)(nil),        // 24: api.approval.v1.CountPendingTasksResponse
+	(*ProcessTaskRequest)(nil),               // 25: api.approval.v1.ProcessTaskRequest
+	(*BatchProcessTasksRequest)(nil),         // 26: api.approval.v1.BatchProcessTasksRequest
+	(*BatchProcessTasksResponse)(nil),        // 27: api.approval.v1.BatchProcessTasksResponse
+	(*BatchProcessResult)(nil),               // 28: api.approval.v1.BatchProcessResult
+	(*TransferTaskRequest)(nil),              // 29: api.approval.v1.TransferTaskRequest
+	(*DelegateTaskRequest)(nil),              // 30: api.approval.v1.DelegateTaskRequest
+	(*ApprovalTaskResponse)(nil),             // 31: api.approval.v1.ApprovalTaskResponse
+	(*ListApprovalTasksResponse)(nil),        // 32: api.approval.v1.ListApprovalTasksResponse
+	(*FieldFilter)(nil),                      // 33: api.approval.v1.FieldFilter
+	(*SearchInstancesRequest)(nil),           // 34: api.approval.v1.SearchInstancesRequest
+	(*SearchInstancesResponse)(nil),          // 35: api.approval.v1.SearchInstancesResponse
+	(*GetSearchFieldsRequest)(nil),           // 36: api.approval.v1.GetSearchFieldsRequest
+	(*SearchFieldConfig)(nil),                // 37: api.approval.v1.SearchFieldConfig
+	(*ConfigureSearchFieldsRequest)(nil),     // 38: api.approval.v1.ConfigureSearchFieldsRequest
+	(*SearchFieldResponse)(nil),              // 39: api.approval.v1.SearchFieldResponse
+	(*SearchFieldsResponse)(nil),             // 40: api.approval.v1.SearchFieldsResponse
+	(*ReindexProcessDefinitionRequest)(nil),  // 41: api.approval.v1.ReindexProcessDefinitionRequest
+	(*ReindexProcessDefinitionResponse)(nil), // 42: api.approval.v1.ReindexProcessDefinitionResponse
+	(*VerifyHistoryChainRequest)(nil),        // 43: api.approval.v1.VerifyHistoryChainRequest
+	(*HistoryChainIssue)(nil),                // 44: api.approval.v1.HistoryChainIssue
+	(*HistoryChainReportResponse)(nil),       // 45: api.approval.v1.HistoryChainReportResponse
+	(*ExportDossierRequest)(nil),             // 46: api.approval.v1.ExportDossierRequest
+	(*DossierExportResponse)(nil),            // 47: api.approval.v1.DossierExportResponse
+	(*ApprovalStatsRequest)(nil),             // 48: api.approval.v1.ApprovalStatsRequest
+	(*NodeWeeklyStats)(nil),                  // 49: api.approval.v1.NodeWeeklyStats
+	(*NodeStatsResponse)(nil),                // 50: api.approval.v1.NodeStatsResponse
+	(*QueueSnapshot)(nil),                    // 51: api.approval.v1.QueueSnapshot
+	(*QueueTrendResponse)(nil),               // 52: api.approval.v1.QueueTrendResponse
+	(*ApproverWeeklyStats)(nil),              // 53: api.approval.v1.ApproverWeeklyStats
+	(*SlowApproversResponse)(nil),            // 54: api.approval.v1.SlowApproversResponse
+	(*RejectionReasonStats)(nil),             // 55: api.approval.v1.RejectionReasonStats
+	(*RejectionReasonsResponse)(nil),         // 56: api.approval.v1.RejectionReasonsResponse
+	(*ProcessWeeklyStats)(nil),               // 57: api.approval.v1.ProcessWeeklyStats
+	(*ProcessTrend)(nil),                     // 58: api.approval.v1.ProcessTrend
+	(*ProcessTrendsResponse)(nil),            // 59: api.approval.v1.ProcessTrendsResponse
+	(*RefreshStatsRequest)(nil),              // 60: api.approval.v1.RefreshStatsRequest
+	(*RefreshStatsResponse)(nil),             // 61: api.approval.v1.RefreshStatsResponse
+	(*SubmitReassignTasksRequest)(nil),       // 62: api.approval.v1.SubmitReassignTasksRequest
+	(*SubmitInstanceJobRequest)(nil),         // 63: api.approval.v1.SubmitInstanceJobRequest
+	(*AdminJobResponse)(nil),                 // 64: api.approval.v1.AdminJobResponse
+	(*ListAdminJobsRequest)(nil),             // 65: api.approval.v1.ListAdminJobsRequest
+	(*ListAdminJobsResponse)(nil),            // 66: api.approval.v1.ListAdminJobsResponse
+	(*GetAdminJobRequest)(nil),               // 67: api.approval.v1.GetAdminJobRequest
+	(*ListAdminJobItemsRequest)(nil),         // 68: api.approval.v1.ListAdminJobItemsRequest
+	(*AdminJobItem)(nil),                     // 69: api.approval.v1.AdminJobItem
+	(*ListAdminJobItemsResponse)(nil),        // 70: api.approval.v1.ListAdminJobItemsResponse
+	(*PreviewActionLinkRequest)(nil),         // 71: api.approval.v1.PreviewActionLinkRequest
+	(*ActionLinkPreviewResponse)(nil),        // 72: api.approval.v1.ActionLinkPreviewResponse
+	(*ExecuteActionLinkRequest)(nil),         // 73: api.approval.v1.ExecuteActionLinkRequest
+	(*InboundReplyRequest)(nil),              // 74: api.approval.v1.InboundReplyRequest
+	(*ActionLinkResultResponse)(nil),         // 75: api.approval.v1.ActionLinkResultResponse
+	nil,                                      // 76: api.approval.v1.StartProcessRequest.FormDataEntry
+	nil,                                      // 77: api.approval.v1.InstanceStatsSummaryResponse.ByStatusEntry
+	nil,                                      // 78: api.approval.v1.AdminJobResponse.ParamsEntry
+	(*emptypb.Empty)(nil),                    // 79: google.protobuf.Empty
 }
 var file_api_approval_v1_approval_proto_depIdxs = []int32{
 	8,  // 0: api.approval.v1.ListProcessDefinitionsResponse.items:type_name -> api.approval.v1.ProcessDefinitionResponse
-	33, // 1: api.approval.v1.StartProcessRequest.form_data:type_name -> api.approval.v1.StartProcessRequest.FormDataEntry
+	76, // 1: api.approval.v1.StartProcessRequest.form_data:type_name -> api.approval.v1.StartProcessRequest.FormDataEntry
 	17, // 2: api.approval.v1.ListProcessInstancesResponse.items:type_name -> api.approval.v1.ProcessInstanceResponse
-	34, // 3: api.approval.v1.InstanceStatsSummaryResponse.by_status:type_name -> api.approval.v1.InstanceStatsSummaryResponse.ByStatusEntry
+	77, // 3: api.approval.v1.InstanceStatsSummaryResponse.by_status:type_name -> api.approval.v1.InstanceStatsSummaryResponse.ByStatusEntry
 	28, // 4: api.approval.v1.BatchProcessTasksResponse.results:type_name -> api.approval.v1.BatchProcessResult
 	31, // 5: api.approval.v1.ListApprovalTasksResponse.items:type_name -> api.approval.v1.ApprovalTaskResponse
-	0,  // 6: api.approval.v1.ProcessDefinitionService.CreateProcessDefinition:input_type -> api.approval.v1.CreateProcessDefinitionRequest
-	1,  // 7: api.approval.v1.ProcessDefinitionService.UpdateProcessDefinition:input_type -> api.approval.v1.UpdateProcessDefinitionRequest
-	2,  // 8: api.approval.v1.ProcessDefinitionService.GetProcessDefinition:input_type -> api.approval.v1.GetProcessDefinitionRequest
-	3,  // 9: api.approval.v1.ProcessDefinitionService.ListProcessDefinitions:input_type -> api.approval.v1.ListProcessDefinitionsRequest
-	4,  // 10: api.approval.v1.ProcessDefinitionService.DeleteProcessDefinition:input_type -> api.approval.v1.DeleteProcessDefinitionRequest
-	5,  // 11: api.approval.v1.ProcessDefinitionService.EnableProcessDefinition:input_type -> api.approval.v1.EnableProcessDefinitionRequest
-	6,  // 12: api.approval.v1.ProcessDefinitionService.DisableProcessDefinition:input_type -> api.approval.v1.DisableProcessDefinitionRequest
-	7,  // 13: api.approval.v1.ProcessDefinitionService.GetProcessStats:input_type -> api.approval.v1.GetProcessStatsRequest
-	11, // 14: api.approval.v1.ProcessInstanceService.StartProcess:input_type -> api.approval.v1.StartProcessRequest
-	12, // 15: api.approval.v1.ProcessInstanceService.GetProcessInstance:input_type -> api.approval.v1.GetProcessInstanceRequest
-	13, // 16: api.approval.v1.ProcessInstanceService.ListMyApplications:input_type -> api.approval.v1.ListMyApplicationsRequest
-	14, // 17: api.approval.v1.ProcessInstanceService.WithdrawProcess:input_type -> api.approval.v1.WithdrawProcessRequest
-	15, // 18: api.approval.v1.ProcessInstanceService.CancelProcess:input_type -> api.approval.v1.CancelProcessRequest
-	16, // 19: api.approval.v1.ProcessInstanceService.ListProcessInstances:input_type -> api.approval.v1.ListProcessInstancesRequest
-	20, // 20: api.approval.v1.ProcessInstanceService.GetInstanceStatsSummary:input_type -> api.approval.v1.GetInstanceStatsSummaryRequest
-	21, // 21: api.approval.v1.ApprovalTaskService.GetApprovalTask:input_type -> api.approval.v1.GetApprovalTaskRequest
-	22, // 22: api.approval.v1.ApprovalTaskService.ListMyTasks:input_type -> api.approval.v1.ListMyTasksRequest
-	23, // 23: api.approval.v1.ApprovalTaskService.CountPendingTasks:input_type -> api.approval.v1.CountPendingTasksRequest
-	25, // 24: api.approval.v1.ApprovalTaskService.ProcessTask:input_type -> api.approval.v1.ProcessTaskRequest
-	26, // 25: api.approval.v1.ApprovalTaskService.BatchProcessTasks:input_type -> api.approval.v1.BatchProcessTasksRequest
-	29, // 26: api.approval.v1.ApprovalTaskService.TransferTask:input_type -> api.approval.v1.TransferTaskRequest
-	30, // 27: api.approval.v1.ApprovalTaskService.DelegateTask:input_type -> api.approval.v1.DelegateTaskRequest
-	8,  // 28: api.approval.v1.ProcessDefinitionService.CreateProcessDefinition:output_type -> api.approval.v1.ProcessDefinitionResponse
-	8,  // 29: api.approval.v1.ProcessDefinitionService.UpdateProcessDefinition:output_type -> api.approval.v1.ProcessDefinitionResponse
-	8,  // 30: api.approval.v1.ProcessDefinitionService.GetProcessDefinition:output_type -> api.approval.v1.ProcessDefinitionResponse
-	9,  // 31: api.approval.v1.ProcessDefinitionService.ListProcessDefinitions:output_type -> api.approval.v1.ListProcessDefinitionsResponse
-	35, // 32: api.approval.v1.ProcessDefinitionService.DeleteProcessDefinition:output_type -> google.protobuf.Empty
-	35, // 33: api.approval.v1.ProcessDefinitionService.EnableProcessDefinition:output_type -> google.protobuf.Empty
-	35, // 34: api.approval.v1.ProcessDefinitionService.DisableProcessDefinition:output_type -> google.protobuf.Empty
-	10, // 35: api.approval.v1.ProcessDefinitionService.GetProcessStats:output_type -> api.approval.v1.ProcessStatsResponse
-	17, // 36: api.approval.v1.ProcessInstanceService.StartProcess:output_type -> api.approval.v1.ProcessInstanceResponse
-	17, // 37: api.approval.v1.ProcessInstanceService.GetProcessInstance:output_type -> api.approval.v1.ProcessInstanceResponse
-	18, // 38: api.approval.v1.ProcessInstanceService.ListMyApplications:output_type -> api.approval.v1.ListProcessInstancesResponse
-	35, // 39: api.approval.v1.ProcessInstanceService.WithdrawProcess:output_type -> google.protobuf.Empty
-	35, // 40: api.approval.v1.ProcessInstanceService.CancelProcess:output_type -> google.protobuf.Empty
-	18, // 41: api.approval.v1.ProcessInstanceService.ListProcessInstances:output_type -> api.approval.v1.ListProcessInstancesResponse
-	19, // 42: api.approval.v1.ProcessInstanceService.GetInstanceStatsSummary:output_type -> api.approval.v1.InstanceStatsSummaryResponse
-	31, // 43: api.approval.v1.ApprovalTaskService.GetApprovalTask:output_type -> api.approval.v1.ApprovalTaskResponse
-	32, // 44: api.approval.v1.ApprovalTaskService.ListMyTasks:output_type -> api.approval.v1.ListApprovalTasksResponse
-	24, // 45: api.approval.v1.ApprovalTaskService.CountPendingTasks:output_type -> api.approval.v1.CountPendingTasksResponse
-	35, // 46: api.approval.v1.ApprovalTaskService.ProcessTask:output_type -> google.protobuf.Empty
-	27, // 47: api.approval.v1.ApprovalTaskService.BatchProcessTasks:output_type -> api.approval.v1.BatchProcessTasksResponse
-	35, // 48: api.approval.v1.ApprovalTaskService.TransferTask:output_type -> google.protobuf.Empty
-	35, // 49: api.approval.v1.ApprovalTaskService.DelegateTask:output_type -> google.protobuf.Empty
-	28, // [28:50] is the sub-list for method output_type
-	6,  // [6:28] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	33, // 6: api.approval.v1.SearchInstancesRequest.field_filters:type_name -> api.approval.v1.FieldFilter
+	17, // 7: api.approval.v1.SearchInstancesResponse.items:type_name -> api.approval.v1.ProcessInstanceResponse
+	37, // 8: api.approval.v1.ConfigureSearchFieldsRequest.fields:type_name -> api.approval.v1.SearchFieldConfig
+	39, // 9: api.approval.v1.SearchFieldsResponse.items:type_name -> api.approval.v1.SearchFieldResponse
+	44, // 10: api.approval.v1.HistoryChainReportResponse.issues:type_name -> api.approval.v1.HistoryChainIssue
+	49, // 11: api.approval.v1.NodeStatsResponse.items:type_name -> api.approval.v1.NodeWeeklyStats
+	51, // 12: api.approval.v1.QueueTrendResponse.items:type_name -> api.approval.v1.QueueSnapshot
+	53, // 13: api.approval.v1.SlowApproversResponse.items:type_name -> api.approval.v1.ApproverWeeklyStats
+	55, // 14: api.approval.v1.RejectionReasonsResponse.items:type_name -> api.approval.v1.RejectionReasonStats
+	57, // 15: api.approval.v1.ProcessTrend.weeks:type_name -> api.approval.v1.ProcessWeeklyStats
+	57, // 16: api.approval.v1.ProcessTrend.current:type_name -> api.approval.v1.ProcessWeeklyStats
+	57, // 17: api.approval.v1.ProcessTrend.previous:type_name -> api.approval.v1.ProcessWeeklyStats
+	58, // 18: api.approval.v1.ProcessTrendsResponse.items:type_name -> api.approval.v1.ProcessTrend
+	78, // 19: api.approval.v1.AdminJobResponse.params:type_name -> api.approval.v1.AdminJobResponse.ParamsEntry
+	64, // 20: api.approval.v1.ListAdminJobsResponse.items:type_name -> api.approval.v1.AdminJobResponse
+	69, // 21: api.approval.v1.ListAdminJobItemsResponse.items:type_name -> api.approval.v1.AdminJobItem
+	0,  // 22: api.approval.v1.ProcessDefinitionService.CreateProcessDefinition:input_type -> api.approval.v1.CreateProcessDefinitionRequest
+	1,  // 23: api.approval.v1.ProcessDefinitionService.UpdateProcessDefinition:input_type -> api.approval.v1.UpdateProcessDefinitionRequest
+	2,  // 24: api.approval.v1.ProcessDefinitionService.GetProcessDefinition:input_type -> api.approval.v1.GetProcessDefinitionRequest
+	3,  // 25: api.approval.v1.ProcessDefinitionService.ListProcessDefinitions:input_type -> api.approval.v1.ListProcessDefinitionsRequest
+	4,  // 26: api.approval.v1.ProcessDefinitionService.DeleteProcessDefinition:input_type -> api.approval.v1.DeleteProcessDefinitionRequest
+	5,  // 27: api.approval.v1.ProcessDefinitionService.EnableProcessDefinition:input_type -> api.approval.v1.EnableProcessDefinitionRequest
+	6,  // 28: api.approval.v1.ProcessDefinitionService.DisableProcessDefinition:input_type -> api.approval.v1.DisableProcessDefinitionRequest
+	7,  // 29: api.approval.v1.ProcessDefinitionService.GetProcessStats:input_type -> api.approval.v1.GetProcessStatsRequest
+	11, // 30: api.approval.v1.ProcessInstanceService.StartProcess:input_type -> api.approval.v1.StartProcessRequest
+	12, // 31: api.approval.v1.ProcessInstanceService.GetProcessInstance:input_type -> api.approval.v1.GetProcessInstanceRequest
+	13, // 32: api.approval.v1.ProcessInstanceService.ListMyApplications:input_type -> api.approval.v1.ListMyApplicationsRequest
+	14, // 33: api.approval.v1.ProcessInstanceService.WithdrawProcess:input_type -> api.approval.v1.WithdrawProcessRequest
+	15, // 34: api.approval.v1.ProcessInstanceService.CancelProcess:input_type -> api.approval.v1.CancelProcessRequest
+	16, // 35: api.approval.v1.ProcessInstanceService.ListProcessInstances:input_type -> api.approval.v1.ListProcessInstancesRequest
+	20, // 36: api.approval.v1.ProcessInstanceService.GetInstanceStatsSummary:input_type -> api.approval.v1.GetInstanceStatsSummaryRequest
+	21, // 37: api.approval.v1.ApprovalTaskService.GetApprovalTask:input_type -> api.approval.v1.GetApprovalTaskRequest
+	22, // 38: api.approval.v1.ApprovalTaskService.ListMyTasks:input_type -> api.approval.v1.ListMyTasksRequest
+	23, // 39: api.approval.v1.ApprovalTaskService.CountPendingTasks:input_type -> api.approval.v1.CountPendingTasksRequest
+	25, // 40: api.approval.v1.ApprovalTaskService.ProcessTask:input_type -> api.approval.v1.ProcessTaskRequest
+	26, // 41: api.approval.v1.ApprovalTaskService.BatchProcessTasks:input_type -> api.approval.v1.BatchProcessTasksRequest
+	29, // 42: api.approval.v1.ApprovalTaskService.TransferTask:input_type -> api.approval.v1.TransferTaskRequest
+	30, // 43: api.approval.v1.ApprovalTaskService.DelegateTask:input_type -> api.approval.v1.DelegateTaskRequest
+	34, // 44: api.approval.v1.ProcessSearchService.SearchInstances:input_type -> api.approval.v1.SearchInstancesRequest
+	36, // 45: api.approval.v1.ProcessSearchService.GetSearchFields:input_type -> api.approval.v1.GetSearchFieldsRequest
+	38, // 46: api.approval.v1.ProcessSearchService.ConfigureSearchFields:input_type -> api.approval.v1.ConfigureSearchFieldsRequest
+	41, // 47: api.approval.v1.ProcessSearchService.ReindexProcessDefinition:input_type -> api.approval.v1.ReindexProcessDefinitionRequest
+	43, // 48: api.approval.v1.ProcessAuditService.VerifyHistoryChain:input_type -> api.approval.v1.VerifyHistoryChainRequest
+	46, // 49: api.approval.v1.ProcessAuditService.ExportDossier:input_type -> api.approval.v1.ExportDossierRequest
+	48, // 50: api.approval.v1.ProcessStatsService.GetNodeStats:input_type -> api.approval.v1.ApprovalStatsRequest
+	48, // 51: api.approval.v1.ProcessStatsService.GetQueueTrend:input_type -> api.approval.v1.ApprovalStatsRequest
+	48, // 52: api.approval.v1.ProcessStatsService.GetSlowApprovers:input_type -> api.approval.v1.ApprovalStatsRequest
+	48, // 53: api.approval.v1.ProcessStatsService.GetRejectionReasons:input_type -> api.approval.v1.ApprovalStatsRequest
+	48, // 54: api.approval.v1.ProcessStatsService.GetProcessTrends:input_type -> api.approval.v1.ApprovalStatsRequest
+	60, // 55: api.approval.v1.ProcessStatsService.RefreshStats:input_type -> api.approval.v1.RefreshStatsRequest
+	62, // 56: api.approval.v1.ProcessAdminJobService.SubmitReassignTasks:input_type -> api.approval.v1.SubmitReassignTasksRequest
+	63, // 57: api.approval.v1.ProcessAdminJobService.SubmitCancelInstances:input_type -> api.approval.v1.SubmitInstanceJobRequest
+	63, // 58: api.approval.v1.ProcessAdminJobService.SubmitForceComplete:input_type -> api.approval.v1.SubmitInstanceJobRequest
+	63, // 59: api.approval.v1.ProcessAdminJobService.SubmitRetriggerTasks:input_type -> api.approval.v1.SubmitInstanceJobRequest
+	65, // 60: api.approval.v1.ProcessAdminJobService.ListAdminJobs:input_type -> api.approval.v1.ListAdminJobsRequest
+	67, // 61: api.approval.v1.ProcessAdminJobService.GetAdminJob:input_type -> api.approval.v1.GetAdminJobRequest
+	68, // 62: api.approval.v1.ProcessAdminJobService.ListAdminJobItems:input_type -> api.approval.v1.ListAdminJobItemsRequest
+	71, // 63: api.approval.v1.ActionLinkService.PreviewActionLink:input_type -> api.approval.v1.PreviewActionLinkRequest
+	73, // 64: api.approval.v1.ActionLinkService.ExecuteActionLink:input_type -> api.approval.v1.ExecuteActionLinkRequest
+	74, // 65: api.approval.v1.ActionLinkService.InboundReply:input_type -> api.approval.v1.InboundReplyRequest
+	8,  // 66: api.approval.v1.ProcessDefinitionService.CreateProcessDefinition:output_type -> api.approval.v1.ProcessDefinitionResponse
+	8,  // 67: api.approval.v1.ProcessDefinitionService.UpdateProcessDefinition:output_type -> api.approval.v1.ProcessDefinitionResponse
+	8,  // 68: api.approval.v1.ProcessDefinitionService.GetProcessDefinition:output_type -> api.approval.v1.ProcessDefinitionResponse
+	9,  // 69: api.approval.v1.ProcessDefinitionService.ListProcessDefinitions:output_type -> api.approval.v1.ListProcessDefinitionsResponse
+	79, // 70: api.approval.v1.ProcessDefinitionService.DeleteProcessDefinition:output_type -> google.protobuf.Empty
+	79, // 71: api.approval.v1.ProcessDefinitionService.EnableProcessDefinition:output_type -> google.protobuf.Empty
+	79, // 72: api.approval.v1.ProcessDefinitionService.DisableProcessDefinition:output_type -> google.protobuf.Empty
+	10, // 73: api.approval.v1.ProcessDefinitionService.GetProcessStats:output_type -> api.approval.v1.ProcessStatsResponse
+	17, // 74: api.approval.v1.ProcessInstanceService.StartProcess:output_type -> api.approval.v1.ProcessInstanceResponse
+	17, // 75: api.approval.v1.ProcessInstanceService.GetProcessInstance:output_type -> api.approval.v1.ProcessInstanceResponse
+	18, // 76: api.approval.v1.ProcessInstanceService.ListMyApplications:output_type -> api.approval.v1.ListProcessInstancesResponse
+	79, // 77: api.approval.v1.ProcessInstanceService.WithdrawProcess:output_type -> google.protobuf.Empty
+	79, // 78: api.approval.v1.ProcessInstanceService.CancelProcess:output_type -> google.protobuf.Empty
+	18, // 79: api.approval.v1.ProcessInstanceService.ListProcessInstances:output_type -> api.approval.v1.ListProcessInstancesResponse
+	19, // 80: api.approval.v1.ProcessInstanceService.GetInstanceStatsSummary:output_type -> api.approval.v1.InstanceStatsSummaryResponse
+	31, // 81: api.approval.v1.ApprovalTaskService.GetApprovalTask:output_type -> api.approval.v1.ApprovalTaskResponse
+	32, // 82: api.approval.v1.ApprovalTaskService.ListMyTasks:output_type -> api.approval.v1.ListApprovalTasksResponse
+	24, // 83: api.approval.v1.ApprovalTaskService.CountPendingTasks:output_type -> api.approval.v1.CountPendingTasksResponse
+	79, // 84: api.approval.v1.ApprovalTaskService.ProcessTask:output_type -> google.protobuf.Empty
+	27, // 85: api.approval.v1.ApprovalTaskService.BatchProcessTasks:output_type -> api.approval.v1.BatchProcessTasksResponse
+	79, // 86: api.approval.v1.ApprovalTaskService.TransferTask:output_type -> google.protobuf.Empty
+	79, // 87: api.approval.v1.ApprovalTaskService.DelegateTask:output_type -> google.protobuf.Empty
+	35, // 88: api.approval.v1.ProcessSearchService.SearchInstances:output_type -> api.approval.v1.SearchInstancesResponse
+	40, // 89: api.approval.v1.ProcessSearchService.GetSearchFields:output_type -> api.approval.v1.SearchFieldsResponse
+	40, // 90: api.approval.v1.ProcessSearchService.ConfigureSearchFields:output_type -> api.approval.v1.SearchFieldsResponse
+	42, // 91: api.approval.v1.ProcessSearchService.ReindexProcessDefinition:output_type -> api.approval.v1.ReindexProcessDefinitionResponse
+	45, // 92: api.approval.v1.ProcessAuditService.VerifyHistoryChain:output_type -> api.approval.v1.HistoryChainReportResponse
+	47, // 93: api.approval.v1.ProcessAuditService.ExportDossier:output_type -> api.approval.v1.DossierExportResponse
+	50, // 94: api.approval.v1.ProcessStatsService.GetNodeStats:output_type -> api.approval.v1.NodeStatsResponse
+	52, // 95: api.approval.v1.ProcessStatsService.GetQueueTrend:output_type -> api.approval.v1.QueueTrendResponse
+	54, // 96: api.approval.v1.ProcessStatsService.GetSlowApprovers:output_type -> api.approval.v1.SlowApproversResponse
+	56, // 97: api.approval.v1.ProcessStatsService.GetRejectionReasons:output_type -> api.approval.v1.RejectionReasonsResponse
+	59, // 98: api.approval.v1.ProcessStatsService.GetProcessTrends:output_type -> api.approval.v1.ProcessTrendsResponse
+	61, // 99: api.approval.v1.ProcessStatsService.RefreshStats:output_type -> api.approval.v1.RefreshStatsResponse
+	64, // 100: api.approval.v1.ProcessAdminJobService.SubmitReassignTasks:output_type -> api.approval.v1.AdminJobResponse
+	64, // 101: api.approval.v1.ProcessAdminJobService.SubmitCancelInstances:output_type -> api.approval.v1.AdminJobResponse
+	64, // 102: api.approval.v1.ProcessAdminJobService.SubmitForceComplete:output_type -> api.approval.v1.AdminJobResponse
+	64, // 103: api.approval.v1.ProcessAdminJobService.SubmitRetriggerTasks:output_type -> api.approval.v1.AdminJobResponse
+	66, // 104: api.approval.v1.ProcessAdminJobService.ListAdminJobs:output_type -> api.approval.v1.ListAdminJobsResponse
+	64, // 105: api.approval.v1.ProcessAdminJobService.GetAdminJob:output_type -> api.approval.v1.AdminJobResponse
+	70, // 106: api.approval.v1.ProcessAdminJobService.ListAdminJobItems:output_type -> api.approval.v1.ListAdminJobItemsResponse
+	72, // 107: api.approval.v1.ActionLinkService.PreviewActionLink:output_type -> api.approval.v1.ActionLinkPreviewResponse
+	75, // 108: api.approval.v1.ActionLinkService.ExecuteActionLink:output_type -> api.approval.v1.ActionLinkResultResponse
+	75, // 109: api.approval.v1.ActionLinkService.InboundReply:output_type -> api.approval.v1.ActionLinkResultResponse
+	66, // [66:110] is the sub-list for method output_type
+	22, // [22:66] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_api_approval_v1_approval_proto_init() }
@@ -2450,15 +5793,17 @@ func file_api_approval_v1_approval_proto_init() {
 	if File_api_approval_v1_approval_proto != nil {
 		return
 	}
+	file_api_approval_v1_approval_proto_msgTypes[58].OneofWrappers = []any{}
+	file_api_approval_v1_approval_proto_msgTypes[73].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_approval_v1_approval_proto_rawDesc), len(file_api_approval_v1_approval_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   35,
+			NumMessages:   79,
 			NumExtensions: 0,
-			NumServices:   3,
+			NumServices:   8,
 		},
 		GoTypes:           file_api_approval_v1_approval_proto_goTypes,
 		DependencyIndexes: file_api_approval_v1_approval_proto_depIdxs,
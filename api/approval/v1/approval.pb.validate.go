@@ -4156,3 +4156,5414 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ListApprovalTasksResponseValidationError{}
+
+// Validate checks the field values on FieldFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FieldFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FieldFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FieldFilterMultiError, or
+// nil if none found.
+func (m *FieldFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FieldFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if _, ok := _FieldFilter_Op_InLookup[m.GetOp()]; !ok {
+		err := FieldFilterValidationError{
+			field:  "Op",
+			reason: "value must be in list [eq like gte lte between]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Value
+
+	// no validation rules for ValueTo
+
+	if len(errors) > 0 {
+		return FieldFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// FieldFilterMultiError is an error wrapping multiple validation errors
+// returned by FieldFilter.ValidateAll() if the designated constraints aren't met.
+type FieldFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FieldFilterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FieldFilterMultiError) AllErrors() []error { return m }
+
+// FieldFilterValidationError is the validation error returned by
+// FieldFilter.Validate if the designated constraints aren't met.
+type FieldFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FieldFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FieldFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FieldFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FieldFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FieldFilterValidationError) ErrorName() string { return "FieldFilterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FieldFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFieldFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FieldFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FieldFilterValidationError{}
+
+var _FieldFilter_Op_InLookup = map[string]struct{}{
+	"eq":      {},
+	"like":    {},
+	"gte":     {},
+	"lte":     {},
+	"between": {},
+}
+
+// Validate checks the field values on SearchInstancesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchInstancesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchInstancesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchInstancesRequestMultiError, or nil if none found.
+func (m *SearchInstancesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchInstancesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Keyword
+
+	// no validation rules for ProcessDefId
+
+	// no validation rules for Status
+
+	// no validation rules for ApplicantId
+
+	for idx, item := range m.GetFieldFilters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchInstancesRequestValidationError{
+						field:  fmt.Sprintf("FieldFilters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchInstancesRequestValidationError{
+						field:  fmt.Sprintf("FieldFilters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchInstancesRequestValidationError{
+					field:  fmt.Sprintf("FieldFilters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for StartDate
+
+	// no validation rules for EndDate
+
+	// no validation rules for SortBy
+
+	// no validation rules for SortDesc
+
+	// no validation rules for Cursor
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return SearchInstancesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchInstancesRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchInstancesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchInstancesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchInstancesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchInstancesRequestMultiError) AllErrors() []error { return m }
+
+// SearchInstancesRequestValidationError is the validation error returned by
+// SearchInstancesRequest.Validate if the designated constraints aren't met.
+type SearchInstancesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchInstancesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchInstancesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchInstancesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchInstancesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchInstancesRequestValidationError) ErrorName() string {
+	return "SearchInstancesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchInstancesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchInstancesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchInstancesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchInstancesRequestValidationError{}
+
+// Validate checks the field values on SearchInstancesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchInstancesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchInstancesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchInstancesResponseMultiError, or nil if none found.
+func (m *SearchInstancesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchInstancesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchInstancesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchInstancesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchInstancesResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PageSize
+
+	// no validation rules for HasNext
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return SearchInstancesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchInstancesResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchInstancesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchInstancesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchInstancesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchInstancesResponseMultiError) AllErrors() []error { return m }
+
+// SearchInstancesResponseValidationError is the validation error returned by
+// SearchInstancesResponse.Validate if the designated constraints aren't met.
+type SearchInstancesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchInstancesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchInstancesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchInstancesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchInstancesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchInstancesResponseValidationError) ErrorName() string {
+	return "SearchInstancesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchInstancesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchInstancesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchInstancesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchInstancesResponseValidationError{}
+
+// Validate checks the field values on GetSearchFieldsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSearchFieldsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSearchFieldsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSearchFieldsRequestMultiError, or nil if none found.
+func (m *GetSearchFieldsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSearchFieldsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = GetSearchFieldsRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetSearchFieldsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetSearchFieldsRequest) _validateUuid(uuid string) error {
+	if matched := _approval_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetSearchFieldsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSearchFieldsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetSearchFieldsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSearchFieldsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSearchFieldsRequestMultiError) AllErrors() []error { return m }
+
+// GetSearchFieldsRequestValidationError is the validation error returned by
+// GetSearchFieldsRequest.Validate if the designated constraints aren't met.
+type GetSearchFieldsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSearchFieldsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSearchFieldsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSearchFieldsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSearchFieldsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSearchFieldsRequestValidationError) ErrorName() string {
+	return "GetSearchFieldsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSearchFieldsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSearchFieldsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSearchFieldsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSearchFieldsRequestValidationError{}
+
+// Validate checks the field values on SearchFieldConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SearchFieldConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchFieldConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchFieldConfigMultiError, or nil if none found.
+func (m *SearchFieldConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchFieldConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetFieldKey()); l < 1 || l > 100 {
+		err := SearchFieldConfigValidationError{
+			field:  "FieldKey",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for FieldLabel
+
+	// no validation rules for FieldType
+
+	// no validation rules for Sort
+
+	if len(errors) > 0 {
+		return SearchFieldConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchFieldConfigMultiError is an error wrapping multiple validation errors
+// returned by SearchFieldConfig.ValidateAll() if the designated constraints
+// aren't met.
+type SearchFieldConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchFieldConfigMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchFieldConfigMultiError) AllErrors() []error { return m }
+
+// SearchFieldConfigValidationError is the validation error returned by
+// SearchFieldConfig.Validate if the designated constraints aren't met.
+type SearchFieldConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchFieldConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchFieldConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchFieldConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchFieldConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchFieldConfigValidationError) ErrorName() string {
+	return "SearchFieldConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchFieldConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchFieldConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchFieldConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchFieldConfigValidationError{}
+
+// Validate checks the field values on ConfigureSearchFieldsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfigureSearchFieldsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfigureSearchFieldsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfigureSearchFieldsRequestMultiError, or nil if none found.
+func (m *ConfigureSearchFieldsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfigureSearchFieldsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = ConfigureSearchFieldsRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetFields() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConfigureSearchFieldsRequestValidationError{
+						field:  fmt.Sprintf("Fields[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConfigureSearchFieldsRequestValidationError{
+						field:  fmt.Sprintf("Fields[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfigureSearchFieldsRequestValidationError{
+					field:  fmt.Sprintf("Fields[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ConfigureSearchFieldsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ConfigureSearchFieldsRequest) _validateUuid(uuid string) error {
+	if matched := _approval_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ConfigureSearchFieldsRequestMultiError is an error wrapping multiple
+// validation errors returned by ConfigureSearchFieldsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ConfigureSearchFieldsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfigureSearchFieldsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfigureSearchFieldsRequestMultiError) AllErrors() []error { return m }
+
+// ConfigureSearchFieldsRequestValidationError is the validation error returned
+// by ConfigureSearchFieldsRequest.Validate if the designated constraints
+// aren't met.
+type ConfigureSearchFieldsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfigureSearchFieldsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfigureSearchFieldsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfigureSearchFieldsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfigureSearchFieldsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfigureSearchFieldsRequestValidationError) ErrorName() string {
+	return "ConfigureSearchFieldsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfigureSearchFieldsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfigureSearchFieldsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfigureSearchFieldsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfigureSearchFieldsRequestValidationError{}
+
+// Validate checks the field values on SearchFieldResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchFieldResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchFieldResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchFieldResponseMultiError, or nil if none found.
+func (m *SearchFieldResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchFieldResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ProcessDefId
+
+	// no validation rules for FieldKey
+
+	// no validation rules for FieldLabel
+
+	// no validation rules for FieldType
+
+	// no validation rules for Sort
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return SearchFieldResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchFieldResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchFieldResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchFieldResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchFieldResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchFieldResponseMultiError) AllErrors() []error { return m }
+
+// SearchFieldResponseValidationError is the validation error returned by
+// SearchFieldResponse.Validate if the designated constraints aren't met.
+type SearchFieldResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchFieldResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchFieldResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchFieldResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchFieldResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchFieldResponseValidationError) ErrorName() string {
+	return "SearchFieldResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchFieldResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchFieldResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchFieldResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchFieldResponseValidationError{}
+
+// Validate checks the field values on SearchFieldsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchFieldsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchFieldsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchFieldsResponseMultiError, or nil if none found.
+func (m *SearchFieldsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchFieldsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchFieldsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchFieldsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchFieldsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchFieldsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchFieldsResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchFieldsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchFieldsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchFieldsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchFieldsResponseMultiError) AllErrors() []error { return m }
+
+// SearchFieldsResponseValidationError is the validation error returned by
+// SearchFieldsResponse.Validate if the designated constraints aren't met.
+type SearchFieldsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchFieldsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchFieldsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchFieldsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchFieldsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchFieldsResponseValidationError) ErrorName() string {
+	return "SearchFieldsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchFieldsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchFieldsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchFieldsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchFieldsResponseValidationError{}
+
+// Validate checks the field values on ReindexProcessDefinitionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReindexProcessDefinitionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReindexProcessDefinitionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ReindexProcessDefinitionRequestMultiError, or nil if none found.
+func (m *ReindexProcessDefinitionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReindexProcessDefinitionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = ReindexProcessDefinitionRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReindexProcessDefinitionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ReindexProcessDefinitionRequest) _validateUuid(uuid string) error {
+	if matched := _approval_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ReindexProcessDefinitionRequestMultiError is an error wrapping multiple
+// validation errors returned by ReindexProcessDefinitionRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ReindexProcessDefinitionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReindexProcessDefinitionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReindexProcessDefinitionRequestMultiError) AllErrors() []error { return m }
+
+// ReindexProcessDefinitionRequestValidationError is the validation error
+// returned by ReindexProcessDefinitionRequest.Validate if the designated
+// constraints aren't met.
+type ReindexProcessDefinitionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReindexProcessDefinitionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReindexProcessDefinitionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReindexProcessDefinitionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReindexProcessDefinitionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReindexProcessDefinitionRequestValidationError) ErrorName() string {
+	return "ReindexProcessDefinitionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReindexProcessDefinitionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReindexProcessDefinitionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReindexProcessDefinitionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReindexProcessDefinitionRequestValidationError{}
+
+// Validate checks the field values on ReindexProcessDefinitionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ReindexProcessDefinitionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReindexProcessDefinitionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ReindexProcessDefinitionResponseMultiError, or nil if none found.
+func (m *ReindexProcessDefinitionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReindexProcessDefinitionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Indexed
+
+	if len(errors) > 0 {
+		return ReindexProcessDefinitionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReindexProcessDefinitionResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ReindexProcessDefinitionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReindexProcessDefinitionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReindexProcessDefinitionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReindexProcessDefinitionResponseMultiError) AllErrors() []error { return m }
+
+// ReindexProcessDefinitionResponseValidationError is the validation error
+// returned by ReindexProcessDefinitionResponse.Validate if the designated
+// constraints aren't met.
+type ReindexProcessDefinitionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReindexProcessDefinitionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReindexProcessDefinitionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReindexProcessDefinitionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReindexProcessDefinitionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReindexProcessDefinitionResponseValidationError) ErrorName() string {
+	return "ReindexProcessDefinitionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReindexProcessDefinitionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReindexProcessDefinitionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReindexProcessDefinitionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReindexProcessDefinitionResponseValidationError{}
+
+// Validate checks the field values on VerifyHistoryChainRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyHistoryChainRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyHistoryChainRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyHistoryChainRequestMultiError, or nil if none found.
+func (m *VerifyHistoryChainRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyHistoryChainRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = VerifyHistoryChainRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return VerifyHistoryChainRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *VerifyHistoryChainRequest) _validateUuid(uuid string) error {
+	if matched := _approval_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// VerifyHistoryChainRequestMultiError is an error wrapping multiple validation
+// errors returned by VerifyHistoryChainRequest.ValidateAll() if the
+// designated constraints aren't met.
+type VerifyHistoryChainRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyHistoryChainRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyHistoryChainRequestMultiError) AllErrors() []error { return m }
+
+// VerifyHistoryChainRequestValidationError is the validation error returned by
+// VerifyHistoryChainRequest.Validate if the designated constraints aren't met.
+type VerifyHistoryChainRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyHistoryChainRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyHistoryChainRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyHistoryChainRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyHistoryChainRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyHistoryChainRequestValidationError) ErrorName() string {
+	return "VerifyHistoryChainRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyHistoryChainRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyHistoryChainRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyHistoryChainRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyHistoryChainRequestValidationError{}
+
+// Validate checks the field values on HistoryChainIssue with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *HistoryChainIssue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HistoryChainIssue with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HistoryChainIssueMultiError, or nil if none found.
+func (m *HistoryChainIssue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HistoryChainIssue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Sequence
+
+	// no validation rules for HistoryId
+
+	// no validation rules for Detail
+
+	if len(errors) > 0 {
+		return HistoryChainIssueMultiError(errors)
+	}
+
+	return nil
+}
+
+// HistoryChainIssueMultiError is an error wrapping multiple validation errors
+// returned by HistoryChainIssue.ValidateAll() if the designated constraints
+// aren't met.
+type HistoryChainIssueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HistoryChainIssueMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HistoryChainIssueMultiError) AllErrors() []error { return m }
+
+// HistoryChainIssueValidationError is the validation error returned by
+// HistoryChainIssue.Validate if the designated constraints aren't met.
+type HistoryChainIssueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HistoryChainIssueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HistoryChainIssueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HistoryChainIssueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HistoryChainIssueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HistoryChainIssueValidationError) ErrorName() string {
+	return "HistoryChainIssueValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HistoryChainIssueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHistoryChainIssue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HistoryChainIssueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HistoryChainIssueValidationError{}
+
+// Validate checks the field values on HistoryChainReportResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *HistoryChainReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HistoryChainReportResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HistoryChainReportResponseMultiError, or nil if none found.
+func (m *HistoryChainReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HistoryChainReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProcessInstanceId
+
+	// no validation rules for Valid
+
+	// no validation rules for Total
+
+	// no validation rules for HeadSequence
+
+	// no validation rules for HeadHash
+
+	for idx, item := range m.GetIssues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HistoryChainReportResponseValidationError{
+						field:  fmt.Sprintf("Issues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HistoryChainReportResponseValidationError{
+						field:  fmt.Sprintf("Issues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HistoryChainReportResponseValidationError{
+					field:  fmt.Sprintf("Issues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for VerifiedAt
+
+	if len(errors) > 0 {
+		return HistoryChainReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// HistoryChainReportResponseMultiError is an error wrapping multiple
+// validation errors returned by HistoryChainReportResponse.ValidateAll() if
+// the designated constraints aren't met.
+type HistoryChainReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HistoryChainReportResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HistoryChainReportResponseMultiError) AllErrors() []error { return m }
+
+// HistoryChainReportResponseValidationError is the validation error returned
+// by HistoryChainReportResponse.Validate if the designated constraints aren't met.
+type HistoryChainReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HistoryChainReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HistoryChainReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HistoryChainReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HistoryChainReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HistoryChainReportResponseValidationError) ErrorName() string {
+	return "HistoryChainReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HistoryChainReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHistoryChainReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HistoryChainReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HistoryChainReportResponseValidationError{}
+
+// Validate checks the field values on ExportDossierRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportDossierRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportDossierRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportDossierRequestMultiError, or nil if none found.
+func (m *ExportDossierRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportDossierRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = ExportDossierRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Format
+
+	if len(errors) > 0 {
+		return ExportDossierRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ExportDossierRequest) _validateUuid(uuid string) error {
+	if matched := _approval_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ExportDossierRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportDossierRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportDossierRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportDossierRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportDossierRequestMultiError) AllErrors() []error { return m }
+
+// ExportDossierRequestValidationError is the validation error returned by
+// ExportDossierRequest.Validate if the designated constraints aren't met.
+type ExportDossierRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportDossierRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportDossierRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportDossierRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportDossierRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportDossierRequestValidationError) ErrorName() string {
+	return "ExportDossierRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportDossierRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportDossierRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportDossierRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportDossierRequestValidationError{}
+
+// Validate checks the field values on DossierExportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DossierExportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DossierExportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DossierExportResponseMultiError, or nil if none found.
+func (m *DossierExportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DossierExportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FileId
+
+	// no validation rules for Filename
+
+	// no validation rules for Format
+
+	// no validation rules for Size
+
+	// no validation rules for ChainValid
+
+	// no validation rules for DownloadUrl
+
+	if len(errors) > 0 {
+		return DossierExportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DossierExportResponseMultiError is an error wrapping multiple validation
+// errors returned by DossierExportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DossierExportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DossierExportResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DossierExportResponseMultiError) AllErrors() []error { return m }
+
+// DossierExportResponseValidationError is the validation error returned by
+// DossierExportResponse.Validate if the designated constraints aren't met.
+type DossierExportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DossierExportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DossierExportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DossierExportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DossierExportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DossierExportResponseValidationError) ErrorName() string {
+	return "DossierExportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DossierExportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDossierExportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DossierExportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DossierExportResponseValidationError{}
+
+// Validate checks the field values on ApprovalStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApprovalStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApprovalStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApprovalStatsRequestMultiError, or nil if none found.
+func (m *ApprovalStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApprovalStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProcessDefId
+
+	// no validation rules for NodeId
+
+	// no validation rules for Weeks
+
+	// no validation rules for Limit
+
+	// no validation rules for MinTasks
+
+	if len(errors) > 0 {
+		return ApprovalStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApprovalStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by ApprovalStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ApprovalStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApprovalStatsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApprovalStatsRequestMultiError) AllErrors() []error { return m }
+
+// ApprovalStatsRequestValidationError is the validation error returned by
+// ApprovalStatsRequest.Validate if the designated constraints aren't met.
+type ApprovalStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApprovalStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApprovalStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApprovalStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApprovalStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApprovalStatsRequestValidationError) ErrorName() string {
+	return "ApprovalStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApprovalStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApprovalStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApprovalStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApprovalStatsRequestValidationError{}
+
+// Validate checks the field values on NodeWeeklyStats with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *NodeWeeklyStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NodeWeeklyStats with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NodeWeeklyStatsMultiError, or nil if none found.
+func (m *NodeWeeklyStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NodeWeeklyStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProcessDefId
+
+	// no validation rules for NodeId
+
+	// no validation rules for NodeName
+
+	// no validation rules for WeekStart
+
+	// no validation rules for TaskCount
+
+	// no validation rules for ApprovedCount
+
+	// no validation rules for RejectedCount
+
+	// no validation rules for AvgSeconds
+
+	// no validation rules for MedianSeconds
+
+	// no validation rules for P90Seconds
+
+	// no validation rules for RefreshedAt
+
+	if len(errors) > 0 {
+		return NodeWeeklyStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// NodeWeeklyStatsMultiError is an error wrapping multiple validation errors
+// returned by NodeWeeklyStats.ValidateAll() if the designated constraints
+// aren't met.
+type NodeWeeklyStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NodeWeeklyStatsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NodeWeeklyStatsMultiError) AllErrors() []error { return m }
+
+// NodeWeeklyStatsValidationError is the validation error returned by
+// NodeWeeklyStats.Validate if the designated constraints aren't met.
+type NodeWeeklyStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NodeWeeklyStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NodeWeeklyStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NodeWeeklyStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NodeWeeklyStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NodeWeeklyStatsValidationError) ErrorName() string { return "NodeWeeklyStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NodeWeeklyStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNodeWeeklyStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NodeWeeklyStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NodeWeeklyStatsValidationError{}
+
+// Validate checks the field values on NodeStatsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *NodeStatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NodeStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NodeStatsResponseMultiError, or nil if none found.
+func (m *NodeStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NodeStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NodeStatsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NodeStatsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NodeStatsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return NodeStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// NodeStatsResponseMultiError is an error wrapping multiple validation errors
+// returned by NodeStatsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type NodeStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NodeStatsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NodeStatsResponseMultiError) AllErrors() []error { return m }
+
+// NodeStatsResponseValidationError is the validation error returned by
+// NodeStatsResponse.Validate if the designated constraints aren't met.
+type NodeStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NodeStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NodeStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NodeStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NodeStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NodeStatsResponseValidationError) ErrorName() string {
+	return "NodeStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NodeStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNodeStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NodeStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NodeStatsResponseValidationError{}
+
+// Validate checks the field values on QueueSnapshot with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QueueSnapshot) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueueSnapshot with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QueueSnapshotMultiError, or
+// nil if none found.
+func (m *QueueSnapshot) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueueSnapshot) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProcessDefId
+
+	// no validation rules for NodeId
+
+	// no validation rules for NodeName
+
+	// no validation rules for SnapshotAt
+
+	// no validation rules for PendingCount
+
+	// no validation rules for OldestPendingSeconds
+
+	if len(errors) > 0 {
+		return QueueSnapshotMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueueSnapshotMultiError is an error wrapping multiple validation errors
+// returned by QueueSnapshot.ValidateAll() if the designated constraints
+// aren't met.
+type QueueSnapshotMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueueSnapshotMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueueSnapshotMultiError) AllErrors() []error { return m }
+
+// QueueSnapshotValidationError is the validation error returned by
+// QueueSnapshot.Validate if the designated constraints aren't met.
+type QueueSnapshotValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueueSnapshotValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueueSnapshotValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueueSnapshotValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueueSnapshotValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueueSnapshotValidationError) ErrorName() string { return "QueueSnapshotValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QueueSnapshotValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueueSnapshot.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueueSnapshotValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueueSnapshotValidationError{}
+
+// Validate checks the field values on QueueTrendResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueueTrendResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueueTrendResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueueTrendResponseMultiError, or nil if none found.
+func (m *QueueTrendResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueueTrendResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueueTrendResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueueTrendResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueueTrendResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return QueueTrendResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueueTrendResponseMultiError is an error wrapping multiple validation errors
+// returned by QueueTrendResponse.ValidateAll() if the designated constraints
+// aren't met.
+type QueueTrendResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueueTrendResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueueTrendResponseMultiError) AllErrors() []error { return m }
+
+// QueueTrendResponseValidationError is the validation error returned by
+// QueueTrendResponse.Validate if the designated constraints aren't met.
+type QueueTrendResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueueTrendResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueueTrendResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueueTrendResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueueTrendResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueueTrendResponseValidationError) ErrorName() string {
+	return "QueueTrendResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueueTrendResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueueTrendResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueueTrendResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueueTrendResponseValidationError{}
+
+// Validate checks the field values on ApproverWeeklyStats with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproverWeeklyStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproverWeeklyStats with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproverWeeklyStatsMultiError, or nil if none found.
+func (m *ApproverWeeklyStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproverWeeklyStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AssigneeId
+
+	// no validation rules for AssigneeName
+
+	// no validation rules for WeekStart
+
+	// no validation rules for TaskCount
+
+	// no validation rules for AvgSeconds
+
+	// no validation rules for P90Seconds
+
+	// no validation rules for RefreshedAt
+
+	if len(errors) > 0 {
+		return ApproverWeeklyStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproverWeeklyStatsMultiError is an error wrapping multiple validation
+// errors returned by ApproverWeeklyStats.ValidateAll() if the designated
+// constraints aren't met.
+type ApproverWeeklyStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproverWeeklyStatsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproverWeeklyStatsMultiError) AllErrors() []error { return m }
+
+// ApproverWeeklyStatsValidationError is the validation error returned by
+// ApproverWeeklyStats.Validate if the designated constraints aren't met.
+type ApproverWeeklyStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproverWeeklyStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproverWeeklyStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproverWeeklyStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproverWeeklyStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproverWeeklyStatsValidationError) ErrorName() string {
+	return "ApproverWeeklyStatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproverWeeklyStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproverWeeklyStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproverWeeklyStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproverWeeklyStatsValidationError{}
+
+// Validate checks the field values on SlowApproversResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SlowApproversResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SlowApproversResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SlowApproversResponseMultiError, or nil if none found.
+func (m *SlowApproversResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SlowApproversResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SlowApproversResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SlowApproversResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SlowApproversResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SlowApproversResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SlowApproversResponseMultiError is an error wrapping multiple validation
+// errors returned by SlowApproversResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SlowApproversResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SlowApproversResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SlowApproversResponseMultiError) AllErrors() []error { return m }
+
+// SlowApproversResponseValidationError is the validation error returned by
+// SlowApproversResponse.Validate if the designated constraints aren't met.
+type SlowApproversResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SlowApproversResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SlowApproversResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SlowApproversResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SlowApproversResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SlowApproversResponseValidationError) ErrorName() string {
+	return "SlowApproversResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SlowApproversResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSlowApproversResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SlowApproversResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SlowApproversResponseValidationError{}
+
+// Validate checks the field values on RejectionReasonStats with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectionReasonStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectionReasonStats with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectionReasonStatsMultiError, or nil if none found.
+func (m *RejectionReasonStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectionReasonStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProcessDefId
+
+	// no validation rules for NodeId
+
+	// no validation rules for NodeName
+
+	// no validation rules for WeekStart
+
+	// no validation rules for Reason
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return RejectionReasonStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectionReasonStatsMultiError is an error wrapping multiple validation
+// errors returned by RejectionReasonStats.ValidateAll() if the designated
+// constraints aren't met.
+type RejectionReasonStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectionReasonStatsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectionReasonStatsMultiError) AllErrors() []error { return m }
+
+// RejectionReasonStatsValidationError is the validation error returned by
+// RejectionReasonStats.Validate if the designated constraints aren't met.
+type RejectionReasonStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectionReasonStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectionReasonStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectionReasonStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectionReasonStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectionReasonStatsValidationError) ErrorName() string {
+	return "RejectionReasonStatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectionReasonStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectionReasonStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectionReasonStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectionReasonStatsValidationError{}
+
+// Validate checks the field values on RejectionReasonsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectionReasonsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectionReasonsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectionReasonsResponseMultiError, or nil if none found.
+func (m *RejectionReasonsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectionReasonsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RejectionReasonsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RejectionReasonsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RejectionReasonsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RejectionReasonsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectionReasonsResponseMultiError is an error wrapping multiple validation
+// errors returned by RejectionReasonsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RejectionReasonsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectionReasonsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectionReasonsResponseMultiError) AllErrors() []error { return m }
+
+// RejectionReasonsResponseValidationError is the validation error returned by
+// RejectionReasonsResponse.Validate if the designated constraints aren't met.
+type RejectionReasonsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectionReasonsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectionReasonsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectionReasonsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectionReasonsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectionReasonsResponseValidationError) ErrorName() string {
+	return "RejectionReasonsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectionReasonsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectionReasonsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectionReasonsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectionReasonsResponseValidationError{}
+
+// Validate checks the field values on ProcessWeeklyStats with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProcessWeeklyStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProcessWeeklyStats with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProcessWeeklyStatsMultiError, or nil if none found.
+func (m *ProcessWeeklyStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProcessWeeklyStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProcessDefId
+
+	// no validation rules for ProcessDefName
+
+	// no validation rules for WeekStart
+
+	// no validation rules for StartedCount
+
+	// no validation rules for CompletedCount
+
+	// no validation rules for ApprovedCount
+
+	// no validation rules for RejectedCount
+
+	// no validation rules for AvgCycleSeconds
+
+	// no validation rules for P90CycleSeconds
+
+	// no validation rules for RefreshedAt
+
+	if len(errors) > 0 {
+		return ProcessWeeklyStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProcessWeeklyStatsMultiError is an error wrapping multiple validation errors
+// returned by ProcessWeeklyStats.ValidateAll() if the designated constraints
+// aren't met.
+type ProcessWeeklyStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProcessWeeklyStatsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProcessWeeklyStatsMultiError) AllErrors() []error { return m }
+
+// ProcessWeeklyStatsValidationError is the validation error returned by
+// ProcessWeeklyStats.Validate if the designated constraints aren't met.
+type ProcessWeeklyStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProcessWeeklyStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProcessWeeklyStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProcessWeeklyStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProcessWeeklyStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProcessWeeklyStatsValidationError) ErrorName() string {
+	return "ProcessWeeklyStatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProcessWeeklyStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProcessWeeklyStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProcessWeeklyStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProcessWeeklyStatsValidationError{}
+
+// Validate checks the field values on ProcessTrend with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ProcessTrend) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProcessTrend with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProcessTrendMultiError, or
+// nil if none found.
+func (m *ProcessTrend) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProcessTrend) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProcessDefId
+
+	// no validation rules for ProcessDefName
+
+	for idx, item := range m.GetWeeks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProcessTrendValidationError{
+						field:  fmt.Sprintf("Weeks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProcessTrendValidationError{
+						field:  fmt.Sprintf("Weeks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProcessTrendValidationError{
+					field:  fmt.Sprintf("Weeks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetCurrent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProcessTrendValidationError{
+					field:  "Current",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProcessTrendValidationError{
+					field:  "Current",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCurrent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProcessTrendValidationError{
+				field:  "Current",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPrevious()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProcessTrendValidationError{
+					field:  "Previous",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProcessTrendValidationError{
+					field:  "Previous",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrevious()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProcessTrendValidationError{
+				field:  "Previous",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.StartedChange != nil {
+		// no validation rules for StartedChange
+	}
+
+	if m.CompletedChange != nil {
+		// no validation rules for CompletedChange
+	}
+
+	if m.CycleTimeChange != nil {
+		// no validation rules for CycleTimeChange
+	}
+
+	if len(errors) > 0 {
+		return ProcessTrendMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProcessTrendMultiError is an error wrapping multiple validation errors
+// returned by ProcessTrend.ValidateAll() if the designated constraints aren't met.
+type ProcessTrendMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProcessTrendMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProcessTrendMultiError) AllErrors() []error { return m }
+
+// ProcessTrendValidationError is the validation error returned by
+// ProcessTrend.Validate if the designated constraints aren't met.
+type ProcessTrendValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProcessTrendValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProcessTrendValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProcessTrendValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProcessTrendValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProcessTrendValidationError) ErrorName() string { return "ProcessTrendValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProcessTrendValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProcessTrend.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProcessTrendValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProcessTrendValidationError{}
+
+// Validate checks the field values on ProcessTrendsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProcessTrendsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProcessTrendsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProcessTrendsResponseMultiError, or nil if none found.
+func (m *ProcessTrendsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProcessTrendsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProcessTrendsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProcessTrendsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProcessTrendsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ProcessTrendsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProcessTrendsResponseMultiError is an error wrapping multiple validation
+// errors returned by ProcessTrendsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ProcessTrendsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProcessTrendsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProcessTrendsResponseMultiError) AllErrors() []error { return m }
+
+// ProcessTrendsResponseValidationError is the validation error returned by
+// ProcessTrendsResponse.Validate if the designated constraints aren't met.
+type ProcessTrendsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProcessTrendsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProcessTrendsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProcessTrendsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProcessTrendsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProcessTrendsResponseValidationError) ErrorName() string {
+	return "ProcessTrendsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProcessTrendsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProcessTrendsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProcessTrendsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProcessTrendsResponseValidationError{}
+
+// Validate checks the field values on RefreshStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefreshStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefreshStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefreshStatsRequestMultiError, or nil if none found.
+func (m *RefreshStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefreshStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Weeks
+
+	if len(errors) > 0 {
+		return RefreshStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefreshStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by RefreshStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RefreshStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefreshStatsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefreshStatsRequestMultiError) AllErrors() []error { return m }
+
+// RefreshStatsRequestValidationError is the validation error returned by
+// RefreshStatsRequest.Validate if the designated constraints aren't met.
+type RefreshStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefreshStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefreshStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefreshStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefreshStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefreshStatsRequestValidationError) ErrorName() string {
+	return "RefreshStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefreshStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefreshStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefreshStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefreshStatsRequestValidationError{}
+
+// Validate checks the field values on RefreshStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefreshStatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefreshStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefreshStatsResponseMultiError, or nil if none found.
+func (m *RefreshStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefreshStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Since
+
+	// no validation rules for QueueSnapshots
+
+	// no validation rules for RefreshedAt
+
+	if len(errors) > 0 {
+		return RefreshStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefreshStatsResponseMultiError is an error wrapping multiple validation
+// errors returned by RefreshStatsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RefreshStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefreshStatsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefreshStatsResponseMultiError) AllErrors() []error { return m }
+
+// RefreshStatsResponseValidationError is the validation error returned by
+// RefreshStatsResponse.Validate if the designated constraints aren't met.
+type RefreshStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefreshStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefreshStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefreshStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefreshStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefreshStatsResponseValidationError) ErrorName() string {
+	return "RefreshStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefreshStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefreshStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefreshStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefreshStatsResponseValidationError{}
+
+// Validate checks the field values on SubmitReassignTasksRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitReassignTasksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitReassignTasksRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitReassignTasksRequestMultiError, or nil if none found.
+func (m *SubmitReassignTasksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitReassignTasksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetFromUserId()); err != nil {
+		err = SubmitReassignTasksRequestValidationError{
+			field:  "FromUserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetToUserId()); err != nil {
+		err = SubmitReassignTasksRequestValidationError{
+			field:  "ToUserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ToUserName
+
+	if utf8.RuneCountInString(m.GetReason()) < 1 {
+		err := SubmitReassignTasksRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SubmitReassignTasksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SubmitReassignTasksRequest) _validateUuid(uuid string) error {
+	if matched := _approval_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// SubmitReassignTasksRequestMultiError is an error wrapping multiple
+// validation errors returned by SubmitReassignTasksRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SubmitReassignTasksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitReassignTasksRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitReassignTasksRequestMultiError) AllErrors() []error { return m }
+
+// SubmitReassignTasksRequestValidationError is the validation error returned
+// by SubmitReassignTasksRequest.Validate if the designated constraints aren't met.
+type SubmitReassignTasksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitReassignTasksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitReassignTasksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitReassignTasksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitReassignTasksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitReassignTasksRequestValidationError) ErrorName() string {
+	return "SubmitReassignTasksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitReassignTasksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitReassignTasksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitReassignTasksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitReassignTasksRequestValidationError{}
+
+// Validate checks the field values on SubmitInstanceJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitInstanceJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitInstanceJobRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitInstanceJobRequestMultiError, or nil if none found.
+func (m *SubmitInstanceJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitInstanceJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetInstanceIds()) < 1 {
+		err := SubmitInstanceJobRequestValidationError{
+			field:  "InstanceIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Status
+
+	if utf8.RuneCountInString(m.GetReason()) < 1 {
+		err := SubmitInstanceJobRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SubmitInstanceJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitInstanceJobRequestMultiError is an error wrapping multiple validation
+// errors returned by SubmitInstanceJobRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SubmitInstanceJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitInstanceJobRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitInstanceJobRequestMultiError) AllErrors() []error { return m }
+
+// SubmitInstanceJobRequestValidationError is the validation error returned by
+// SubmitInstanceJobRequest.Validate if the designated constraints aren't met.
+type SubmitInstanceJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitInstanceJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitInstanceJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitInstanceJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitInstanceJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitInstanceJobRequestValidationError) ErrorName() string {
+	return "SubmitInstanceJobRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitInstanceJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitInstanceJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitInstanceJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitInstanceJobRequestValidationError{}
+
+// Validate checks the field values on AdminJobResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminJobResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminJobResponseMultiError, or nil if none found.
+func (m *AdminJobResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminJobResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TenantId
+
+	// no validation rules for Type
+
+	// no validation rules for Status
+
+	// no validation rules for Params
+
+	// no validation rules for Reason
+
+	// no validation rules for Total
+
+	// no validation rules for Processed
+
+	// no validation rules for Succeeded
+
+	// no validation rules for Failed
+
+	// no validation rules for Skipped
+
+	// no validation rules for Error
+
+	// no validation rules for CreatedBy
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for StartedAt
+
+	// no validation rules for FinishedAt
+
+	// no validation rules for UpdatedAt
+
+	// no validation rules for Progress
+
+	if len(errors) > 0 {
+		return AdminJobResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminJobResponseMultiError is an error wrapping multiple validation errors
+// returned by AdminJobResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AdminJobResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminJobResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminJobResponseMultiError) AllErrors() []error { return m }
+
+// AdminJobResponseValidationError is the validation error returned by
+// AdminJobResponse.Validate if the designated constraints aren't met.
+type AdminJobResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminJobResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminJobResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminJobResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminJobResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminJobResponseValidationError) ErrorName() string { return "AdminJobResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminJobResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminJobResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminJobResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminJobResponseValidationError{}
+
+// Validate checks the field values on ListAdminJobsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAdminJobsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAdminJobsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAdminJobsRequestMultiError, or nil if none found.
+func (m *ListAdminJobsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAdminJobsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return ListAdminJobsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAdminJobsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAdminJobsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAdminJobsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAdminJobsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAdminJobsRequestMultiError) AllErrors() []error { return m }
+
+// ListAdminJobsRequestValidationError is the validation error returned by
+// ListAdminJobsRequest.Validate if the designated constraints aren't met.
+type ListAdminJobsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAdminJobsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAdminJobsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAdminJobsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAdminJobsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAdminJobsRequestValidationError) ErrorName() string {
+	return "ListAdminJobsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAdminJobsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAdminJobsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAdminJobsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAdminJobsRequestValidationError{}
+
+// Validate checks the field values on ListAdminJobsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAdminJobsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAdminJobsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAdminJobsResponseMultiError, or nil if none found.
+func (m *ListAdminJobsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAdminJobsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAdminJobsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAdminJobsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAdminJobsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListAdminJobsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAdminJobsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAdminJobsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAdminJobsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAdminJobsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAdminJobsResponseMultiError) AllErrors() []error { return m }
+
+// ListAdminJobsResponseValidationError is the validation error returned by
+// ListAdminJobsResponse.Validate if the designated constraints aren't met.
+type ListAdminJobsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAdminJobsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAdminJobsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAdminJobsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAdminJobsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAdminJobsResponseValidationError) ErrorName() string {
+	return "ListAdminJobsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAdminJobsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAdminJobsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAdminJobsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAdminJobsResponseValidationError{}
+
+// Validate checks the field values on GetAdminJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAdminJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAdminJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAdminJobRequestMultiError, or nil if none found.
+func (m *GetAdminJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAdminJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = GetAdminJobRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetAdminJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetAdminJobRequest) _validateUuid(uuid string) error {
+	if matched := _approval_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetAdminJobRequestMultiError is an error wrapping multiple validation errors
+// returned by GetAdminJobRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetAdminJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAdminJobRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAdminJobRequestMultiError) AllErrors() []error { return m }
+
+// GetAdminJobRequestValidationError is the validation error returned by
+// GetAdminJobRequest.Validate if the designated constraints aren't met.
+type GetAdminJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAdminJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAdminJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAdminJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAdminJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAdminJobRequestValidationError) ErrorName() string {
+	return "GetAdminJobRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAdminJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAdminJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAdminJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAdminJobRequestValidationError{}
+
+// Validate checks the field values on ListAdminJobItemsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAdminJobItemsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAdminJobItemsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAdminJobItemsRequestMultiError, or nil if none found.
+func (m *ListAdminJobItemsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAdminJobItemsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = ListAdminJobItemsRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListAdminJobItemsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListAdminJobItemsRequest) _validateUuid(uuid string) error {
+	if matched := _approval_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListAdminJobItemsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAdminJobItemsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAdminJobItemsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAdminJobItemsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAdminJobItemsRequestMultiError) AllErrors() []error { return m }
+
+// ListAdminJobItemsRequestValidationError is the validation error returned by
+// ListAdminJobItemsRequest.Validate if the designated constraints aren't met.
+type ListAdminJobItemsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAdminJobItemsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAdminJobItemsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAdminJobItemsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAdminJobItemsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAdminJobItemsRequestValidationError) ErrorName() string {
+	return "ListAdminJobItemsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAdminJobItemsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAdminJobItemsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAdminJobItemsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAdminJobItemsRequestValidationError{}
+
+// Validate checks the field values on AdminJobItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminJobItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminJobItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminJobItemMultiError, or
+// nil if none found.
+func (m *AdminJobItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminJobItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for JobId
+
+	// no validation rules for TargetId
+
+	// no validation rules for Status
+
+	// no validation rules for Message
+
+	// no validation rules for ProcessedAt
+
+	if len(errors) > 0 {
+		return AdminJobItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminJobItemMultiError is an error wrapping multiple validation errors
+// returned by AdminJobItem.ValidateAll() if the designated constraints aren't met.
+type AdminJobItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminJobItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminJobItemMultiError) AllErrors() []error { return m }
+
+// AdminJobItemValidationError is the validation error returned by
+// AdminJobItem.Validate if the designated constraints aren't met.
+type AdminJobItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminJobItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminJobItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminJobItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminJobItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminJobItemValidationError) ErrorName() string { return "AdminJobItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminJobItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminJobItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminJobItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminJobItemValidationError{}
+
+// Validate checks the field values on ListAdminJobItemsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAdminJobItemsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAdminJobItemsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAdminJobItemsResponseMultiError, or nil if none found.
+func (m *ListAdminJobItemsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAdminJobItemsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAdminJobItemsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAdminJobItemsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAdminJobItemsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListAdminJobItemsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAdminJobItemsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAdminJobItemsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListAdminJobItemsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAdminJobItemsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAdminJobItemsResponseMultiError) AllErrors() []error { return m }
+
+// ListAdminJobItemsResponseValidationError is the validation error returned by
+// ListAdminJobItemsResponse.Validate if the designated constraints aren't met.
+type ListAdminJobItemsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAdminJobItemsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAdminJobItemsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAdminJobItemsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAdminJobItemsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAdminJobItemsResponseValidationError) ErrorName() string {
+	return "ListAdminJobItemsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAdminJobItemsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAdminJobItemsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAdminJobItemsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAdminJobItemsResponseValidationError{}
+
+// Validate checks the field values on PreviewActionLinkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreviewActionLinkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreviewActionLinkRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreviewActionLinkRequestMultiError, or nil if none found.
+func (m *PreviewActionLinkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreviewActionLinkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if len(errors) > 0 {
+		return PreviewActionLinkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreviewActionLinkRequestMultiError is an error wrapping multiple validation
+// errors returned by PreviewActionLinkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PreviewActionLinkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreviewActionLinkRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreviewActionLinkRequestMultiError) AllErrors() []error { return m }
+
+// PreviewActionLinkRequestValidationError is the validation error returned by
+// PreviewActionLinkRequest.Validate if the designated constraints aren't met.
+type PreviewActionLinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreviewActionLinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreviewActionLinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreviewActionLinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreviewActionLinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreviewActionLinkRequestValidationError) ErrorName() string {
+	return "PreviewActionLinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreviewActionLinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreviewActionLinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreviewActionLinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreviewActionLinkRequestValidationError{}
+
+// Validate checks the field values on ActionLinkPreviewResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ActionLinkPreviewResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ActionLinkPreviewResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ActionLinkPreviewResponseMultiError, or nil if none found.
+func (m *ActionLinkPreviewResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ActionLinkPreviewResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TaskId
+
+	// no validation rules for ProcessInstanceId
+
+	// no validation rules for NodeName
+
+	// no validation rules for Action
+
+	// no validation rules for TaskStatus
+
+	// no validation rules for ExpiresAt
+
+	if len(errors) > 0 {
+		return ActionLinkPreviewResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ActionLinkPreviewResponseMultiError is an error wrapping multiple validation
+// errors returned by ActionLinkPreviewResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ActionLinkPreviewResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ActionLinkPreviewResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ActionLinkPreviewResponseMultiError) AllErrors() []error { return m }
+
+// ActionLinkPreviewResponseValidationError is the validation error returned by
+// ActionLinkPreviewResponse.Validate if the designated constraints aren't met.
+type ActionLinkPreviewResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ActionLinkPreviewResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ActionLinkPreviewResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ActionLinkPreviewResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ActionLinkPreviewResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ActionLinkPreviewResponseValidationError) ErrorName() string {
+	return "ActionLinkPreviewResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ActionLinkPreviewResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sActionLinkPreviewResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ActionLinkPreviewResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ActionLinkPreviewResponseValidationError{}
+
+// Validate checks the field values on ExecuteActionLinkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExecuteActionLinkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExecuteActionLinkRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExecuteActionLinkRequestMultiError, or nil if none found.
+func (m *ExecuteActionLinkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExecuteActionLinkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if m.Comment != nil {
+		// no validation rules for Comment
+	}
+
+	if len(errors) > 0 {
+		return ExecuteActionLinkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExecuteActionLinkRequestMultiError is an error wrapping multiple validation
+// errors returned by ExecuteActionLinkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExecuteActionLinkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExecuteActionLinkRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExecuteActionLinkRequestMultiError) AllErrors() []error { return m }
+
+// ExecuteActionLinkRequestValidationError is the validation error returned by
+// ExecuteActionLinkRequest.Validate if the designated constraints aren't met.
+type ExecuteActionLinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExecuteActionLinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExecuteActionLinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExecuteActionLinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExecuteActionLinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExecuteActionLinkRequestValidationError) ErrorName() string {
+	return "ExecuteActionLinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExecuteActionLinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExecuteActionLinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExecuteActionLinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExecuteActionLinkRequestValidationError{}
+
+// Validate checks the field values on InboundReplyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InboundReplyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InboundReplyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InboundReplyRequestMultiError, or nil if none found.
+func (m *InboundReplyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InboundReplyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	// no validation rules for Subject
+
+	// no validation rules for Text
+
+	// no validation rules for Timestamp
+
+	// no validation rules for Nonce
+
+	// no validation rules for Signature
+
+	if len(errors) > 0 {
+		return InboundReplyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InboundReplyRequestMultiError is an error wrapping multiple validation
+// errors returned by InboundReplyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InboundReplyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InboundReplyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InboundReplyRequestMultiError) AllErrors() []error { return m }
+
+// InboundReplyRequestValidationError is the validation error returned by
+// InboundReplyRequest.Validate if the designated constraints aren't met.
+type InboundReplyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InboundReplyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InboundReplyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InboundReplyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InboundReplyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InboundReplyRequestValidationError) ErrorName() string {
+	return "InboundReplyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InboundReplyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInboundReplyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InboundReplyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InboundReplyRequestValidationError{}
+
+// Validate checks the field values on ActionLinkResultResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ActionLinkResultResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ActionLinkResultResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ActionLinkResultResponseMultiError, or nil if none found.
+func (m *ActionLinkResultResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ActionLinkResultResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TaskId
+
+	// no validation rules for Action
+
+	// no validation rules for Processed
+
+	if len(errors) > 0 {
+		return ActionLinkResultResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ActionLinkResultResponseMultiError is an error wrapping multiple validation
+// errors returned by ActionLinkResultResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ActionLinkResultResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ActionLinkResultResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ActionLinkResultResponseMultiError) AllErrors() []error { return m }
+
+// ActionLinkResultResponseValidationError is the validation error returned by
+// ActionLinkResultResponse.Validate if the designated constraints aren't met.
+type ActionLinkResultResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ActionLinkResultResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ActionLinkResultResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ActionLinkResultResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ActionLinkResultResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ActionLinkResultResponseValidationError) ErrorName() string {
+	return "ActionLinkResultResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ActionLinkResultResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sActionLinkResultResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ActionLinkResultResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ActionLinkResultResponseValidationError{}
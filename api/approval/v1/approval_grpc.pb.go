@@ -998,3 +998,1079 @@ var ApprovalTaskService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/approval/v1/approval.proto",
 }
+
+// ProcessSearchServiceClient is the client API for ProcessSearchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProcessSearchServiceClient interface {
+	// 检索流程实例（全文 + 表单字段过滤 + 游标分页）
+	SearchInstances(ctx context.Context, in *SearchInstancesRequest, opts ...grpc.CallOption) (*SearchInstancesResponse, error)
+	// 获取流程可检索字段
+	GetSearchFields(ctx context.Context, in *GetSearchFieldsRequest, opts ...grpc.CallOption) (*SearchFieldsResponse, error)
+	// 配置流程可检索字段
+	ConfigureSearchFields(ctx context.Context, in *ConfigureSearchFieldsRequest, opts ...grpc.CallOption) (*SearchFieldsResponse, error)
+	// 重建流程定义下所有实例的检索索引
+	ReindexProcessDefinition(ctx context.Context, in *ReindexProcessDefinitionRequest, opts ...grpc.CallOption) (*ReindexProcessDefinitionResponse, error)
+}
+
+type processSearchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProcessSearchServiceClient(cc grpc.ClientConnInterface) ProcessSearchServiceClient {
+	return &processSearchServiceClient{cc}
+}
+
+func (c *processSearchServiceClient) SearchInstances(ctx context.Context, in *SearchInstancesRequest, opts ...grpc.CallOption) (*SearchInstancesResponse, error) {
+	out := new(SearchInstancesResponse)
+	err := c.cc.Invoke(ctx, "/api.approval.v1.ProcessSearchService/SearchInstances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processSearchServiceClient) GetSearchFields(ctx context.Context, in *GetSearchFieldsRequest, opts ...grpc.CallOption) (*SearchFieldsResponse, error) {
+	out := new(SearchFieldsResponse)
+	err := c.cc.Invoke(ctx, "/api.approval.v1.ProcessSearchService/GetSearchFields", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processSearchServiceClient) ConfigureSearchFields(ctx context.Context, in *ConfigureSearchFieldsRequest, opts ...grpc.CallOption) (*SearchFieldsResponse, error) {
+	out := new(SearchFieldsResponse)
+	err := c.cc.Invoke(ctx, "/api.approval.v1.ProcessSearchService/ConfigureSearchFields", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processSearchServiceClient) ReindexProcessDefinition(ctx context.Context, in *ReindexProcessDefinitionRequest, opts ...grpc.CallOption) (*ReindexProcessDefinitionResponse, error) {
+	out := new(ReindexProcessDefinitionResponse)
+	err := c.cc.Invoke(ctx, "/api.approval.v1.ProcessSearchService/ReindexProcessDefinition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProcessSearchServiceServer is the server API for ProcessSearchService service.
+// All implementations should embed UnimplementedProcessSearchServiceServer
+// for forward compatibility
+type ProcessSearchServiceServer interface {
+	// 检索流程实例（全文 + 表单字段过滤 + 游标分页）
+	SearchInstances(context.Context, *SearchInstancesRequest) (*SearchInstancesResponse, error)
+	// 获取流程可检索字段
+	GetSearchFields(context.Context, *GetSearchFieldsRequest) (*SearchFieldsResponse, error)
+	// 配置流程可检索字段
+	ConfigureSearchFields(context.Context, *ConfigureSearchFieldsRequest) (*SearchFieldsResponse, error)
+	// 重建流程定义下所有实例的检索索引
+	ReindexProcessDefinition(context.Context, *ReindexProcessDefinitionRequest) (*ReindexProcessDefinitionResponse, error)
+}
+
+// UnimplementedProcessSearchServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedProcessSearchServiceServer struct {
+}
+
+func (UnimplementedProcessSearchServiceServer) SearchInstances(context.Context, *SearchInstancesRequest) (*SearchInstancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchInstances not implemented")
+}
+func (UnimplementedProcessSearchServiceServer) GetSearchFields(context.Context, *GetSearchFieldsRequest) (*SearchFieldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSearchFields not implemented")
+}
+func (UnimplementedProcessSearchServiceServer) ConfigureSearchFields(context.Context, *ConfigureSearchFieldsRequest) (*SearchFieldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigureSearchFields not implemented")
+}
+func (UnimplementedProcessSearchServiceServer) ReindexProcessDefinition(context.Context, *ReindexProcessDefinitionRequest) (*ReindexProcessDefinitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReindexProcessDefinition not implemented")
+}
+
+// UnsafeProcessSearchServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProcessSearchServiceServer will
+// result in compilation errors.
+type UnsafeProcessSearchServiceServer interface {
+	mustEmbedUnimplementedProcessSearchServiceServer()
+}
+
+func RegisterProcessSearchServiceServer(s grpc.ServiceRegistrar, srv ProcessSearchServiceServer) {
+	s.RegisterService(&ProcessSearchService_ServiceDesc, srv)
+}
+
+func _ProcessSearchService_SearchInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchInstancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessSearchServiceServer).SearchInstances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.approval.v1.ProcessSearchService/SearchInstances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessSearchServiceServer).SearchInstances(ctx, req.(*SearchInstancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessSearchService_GetSearchFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSearchFieldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessSearchServiceServer).GetSearchFields(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.approval.v1.ProcessSearchService/GetSearchFields",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessSearchServiceServer).GetSearchFields(ctx, req.(*GetSearchFieldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessSearchService_ConfigureSearchFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigureSearchFieldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessSearchServiceServer).ConfigureSearchFields(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.approval.v1.ProcessSearchService/ConfigureSearchFields",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessSearchServiceServer).ConfigureSearchFields(ctx, req.(*ConfigureSearchFieldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessSearchService_ReindexProcessDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReindexProcessDefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessSearchServiceServer).ReindexProcessDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.approval.v1.ProcessSearchService/ReindexProcessDefinition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessSearchServiceServer).ReindexProcessDefinition(ctx, req.(*ReindexProcessDefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProcessSearchService_ServiceDesc is the grpc.ServiceDesc for ProcessSearchService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProcessSearchService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.approval.v1.ProcessSearchService",
+	HandlerType: (*ProcessSearchServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SearchInstances",
+			Handler:    _ProcessSearchService_SearchInstances_Handler,
+		},
+		{
+			MethodName: "GetSearchFields",
+			Handler:    _ProcessSearchService_GetSearchFields_Handler,
+		},
+		{
+			MethodName: "ConfigureSearchFields",
+			Handler:    _ProcessSearchService_ConfigureSearchFields_Handler,
+		},
+		{
+			MethodName: "ReindexProcessDefinition",
+			Handler:    _ProcessSearchService_ReindexProcessDefinition_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/approval/v1/approval.proto",
+}
+
+// ProcessAuditServiceClient is the client API for ProcessAuditService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProcessAuditServiceClient interface {
+	// 校验流程实例历史记录哈希链
+	VerifyHistoryChain(ctx context.Context, in *VerifyHistoryChainRequest, opts ...grpc.CallOption) (*HistoryChainReportResponse, error)
+	// 导出流程实例审批档案（PDF/JSON）
+	ExportDossier(ctx context.Context, in *ExportDossierRequest, opts ...grpc.CallOption) (*DossierExportResponse, error)
+}
+
+type processAuditServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProcessAuditServiceClient(cc grpc.ClientConnInterface) ProcessAuditServiceClient {
+	return &processAuditServiceClient{cc}
+}
+
+func (c *processAuditServiceClient) VerifyHistoryChain(ctx context.Context, in *VerifyHistoryChainRequest, opts ...grpc.CallOption) (*HistoryChainReportResponse, error) {
+	out := new(HistoryChainReportResponse)
+	err := c.cc.Invoke(ctx, "/api.approval.v1.ProcessAuditService/VerifyHistoryChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processAuditServiceClient) ExportDossier(ctx context.Context, in *ExportDossierRequest, opts ...grpc.CallOption) (*DossierExportResponse, error) {
+	out := new(DossierExportResponse)
+	err := c.cc.Invoke(ctx, "/api.approval.v1.ProcessAuditService/ExportDossier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProcessAuditServiceServer is the server API for ProcessAuditService service.
+// All implementations should embed UnimplementedProcessAuditServiceServer
+// for forward compatibility
+type ProcessAuditServiceServer interface {
+	// 校验流程实例历史记录哈希链
+	VerifyHistoryChain(context.Context, *VerifyHistoryChainRequest) (*HistoryChainReportResponse, error)
+	// 导出流程实例审批档案（PDF/JSON）
+	ExportDossier(context.Context, *ExportDossierRequest) (*DossierExportResponse, error)
+}
+
+// UnimplementedProcessAuditServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedProcessAuditServiceServer struct {
+}
+
+func (UnimplementedProcessAuditServiceServer) VerifyHistoryChain(context.Context, *VerifyHistoryChainRequest) (*HistoryChainReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyHistoryChain not implemented")
+}
+func (UnimplementedProcessAuditServiceServer) ExportDossier(context.Context, *ExportDossierRequest) (*DossierExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportDossier not implemented")
+}
+
+// UnsafeProcessAuditServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProcessAuditServiceServer will
+// result in compilation errors.
+type UnsafeProcessAuditServiceServer interface {
+	mustEmbedUnimplementedProcessAuditServiceServer()
+}
+
+func RegisterProcessAuditServiceServer(s grpc.ServiceRegistrar, srv ProcessAuditServiceServer) {
+	s.RegisterService(&ProcessAuditService_ServiceDesc, srv)
+}
+
+func _ProcessAuditService_VerifyHistoryChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyHistoryChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessAuditServiceServer).VerifyHistoryChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.approval.v1.ProcessAuditService/VerifyHistoryChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessAuditServiceServer).VerifyHistoryChain(ctx, req.(*VerifyHistoryChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessAuditService_ExportDossier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportDossierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessAuditServiceServer).ExportDossier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.approval.v1.ProcessAuditService/ExportDossier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessAuditServiceServer).ExportDossier(ctx, req.(*ExportDossierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProcessAuditService_ServiceDesc is the grpc.ServiceDesc for ProcessAuditService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProcessAuditService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.approval.v1.ProcessAuditService",
+	HandlerType: (*ProcessAuditServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "VerifyHistoryChain",
+			Handler:    _ProcessAuditService_VerifyHistoryChain_Handler,
+		},
+		{
+			MethodName: "ExportDossier",
+			Handler:    _ProcessAuditService_ExportDossier_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/approval/v1/approval.proto",
+}
+
+// ProcessStatsServiceClient is the client API for ProcessStatsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProcessStatsServiceClient interface {
+	// 节点周度处理时长统计
+	GetNodeStats(ctx context.Context, in *ApprovalStatsRequest, opts ...grpc.CallOption) (*NodeStatsResponse, error)
+	// 节点待办队列长度变化
+	GetQueueTrend(ctx context.Context, in *ApprovalStatsRequest, opts ...grpc.CallOption) (*QueueTrendResponse, error)
+	// 处理最慢的审批人排行
+	GetSlowApprovers(ctx context.Context, in *ApprovalStatsRequest, opts ...grpc.CallOption) (*SlowApproversResponse, error)
+	// 节点拒绝原因排行
+	GetRejectionReasons(ctx context.Context, in *ApprovalStatsRequest, opts ...grpc.CallOption) (*RejectionReasonsResponse, error)
+	// 流程定义周度趋势与环比
+	GetProcessTrends(ctx context.Context, in *ApprovalStatsRequest, opts ...grpc.CallOption) (*ProcessTrendsResponse, error)
+	// 立即重算本租户最近 N 周统计
+	RefreshStats(ctx context.Context, in *RefreshStatsRequest, opts ...grpc.CallOption) (*RefreshStatsResponse, error)
+}
+
+type processStatsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProcessStatsServiceClient(cc grpc.ClientConnInterface) ProcessStatsServiceClient {
+	return &processStatsServiceClient{cc}
+}
+
+func (c *processStatsServiceClient) GetNodeStats(ctx context.Context, in *ApprovalStatsRequest, opts ...grpc.CallOption) (*NodeStatsResponse, error) {
+	out := new(NodeStatsResponse)
+	err := c.cc.Invoke(ctx, "/api.approval.v1.ProcessStatsService/GetNodeStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processStatsServiceClient) GetQueueTrend(ctx context.Context, in *ApprovalStatsRequest, opts ...grpc.CallOption) (*QueueTrendResponse, error) {
+	out := new(QueueTrendResponse)
+	err := c.cc.Invoke(ctx, "/api.approval.v1.ProcessStatsService/GetQueueTrend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processStatsServiceClient) GetSlowApprovers(ctx context.Context, in *ApprovalStatsRequest, opts ...grpc.CallOption) (*SlowApproversResponse, error) {
+	out := new(SlowApproversResponse)
+	err := c.cc.Invoke(ctx, "/api.approval.v1.ProcessStatsService/GetSlowApprovers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processStatsServiceClient) GetRejectionReasons(ctx context.Context, in *ApprovalStatsRequest, opts ...grpc.CallOption) (*RejectionReasonsResponse, error) {
+	out := new(RejectionReasonsResponse)
+	err := c.cc.Invoke(ctx, "/api.approval.v1.ProcessStatsService/GetRejectionReasons", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processStatsServiceClient) GetProcessTrends(ctx context.Context, in *ApprovalStatsRequest, opts ...grpc.CallOption) (*ProcessTrendsResponse, error) {
+	out := new(ProcessTrendsResponse)
+	err := c.cc.Invoke(ctx, "/api.approval.v1.ProcessStatsService/GetProcessTrends", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processStatsServiceClient) RefreshStats(ctx context.Context, in *RefreshStatsRequest, opts ...grpc.CallOption) (*RefreshStatsResponse, error) {
+	out := new(RefreshStatsResponse)
+	err := c.cc.Invoke(ctx, "/api.approval.v1.ProcessStatsService/RefreshStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProcessStatsServiceServer is the server API for ProcessStatsService service.
+// All implementations should embed UnimplementedProcessStatsServiceServer
+// for forward compatibility
+type ProcessStatsServiceServer interface {
+	// 节点周度处理时长统计
+	GetNodeStats(context.Context, *ApprovalStatsRequest) (*NodeStatsResponse, error)
+	// 节点待办队列长度变化
+	GetQueueTrend(context.Context, *ApprovalStatsRequest) (*QueueTrendResponse, error)
+	// 处理最慢的审批人排行
+	GetSlowApprovers(context.Context, *ApprovalStatsRequest) (*SlowApproversResponse, error)
+	// 节点拒绝原因排行
+	GetRejectionReasons(context.Context, *ApprovalStatsRequest) (*RejectionReasonsResponse, error)
+	// 流程定义周度趋势与环比
+	GetProcessTrends(context.Context, *ApprovalStatsRequest) (*ProcessTrendsResponse, error)
+	// 立即重算本租户最近 N 周统计
+	RefreshStats(context.Context, *RefreshStatsRequest) (*RefreshStatsResponse, error)
+}
+
+// UnimplementedProcessStatsServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedProcessStatsServiceServer struct {
+}
+
+func (UnimplementedProcessStatsServiceServer) GetNodeStats(context.Context, *ApprovalStatsRequest) (*NodeStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeStats not implemented")
+}
+func (UnimplementedProcessStatsServiceServer) GetQueueTrend(context.Context, *ApprovalStatsRequest) (*QueueTrendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueueTrend not implemented")
+}
+func (UnimplementedProcessStatsServiceServer) GetSlowApprovers(context.Context, *ApprovalStatsRequest) (*SlowApproversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSlowApprovers not implemented")
+}
+func (UnimplementedProcessStatsServiceServer) GetRejectionReasons(context.Context, *ApprovalStatsRequest) (*RejectionReasonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRejectionReasons not implemented")
+}
+func (UnimplementedProcessStatsServiceServer) GetProcessTrends(context.Context, *ApprovalStatsRequest) (*ProcessTrendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProcessTrends not implemented")
+}
+func (UnimplementedProcessStatsServiceServer) RefreshStats(context.Context, *RefreshStatsRequest) (*RefreshStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshStats not implemented")
+}
+
+// UnsafeProcessStatsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProcessStatsServiceServer will
+// result in compilation errors.
+type UnsafeProcessStatsServiceServer interface {
+	mustEmbedUnimplementedProcessStatsServiceServer()
+}
+
+func RegisterProcessStatsServiceServer(s grpc.ServiceRegistrar, srv ProcessStatsServiceServer) {
+	s.RegisterService(&ProcessStatsService_ServiceDesc, srv)
+}
+
+func _ProcessStatsService_GetNodeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApprovalStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessStatsServiceServer).GetNodeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.approval.v1.ProcessStatsService/GetNodeStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessStatsServiceServer).GetNodeStats(ctx, req.(*ApprovalStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessStatsService_GetQueueTrend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApprovalStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessStatsServiceServer).GetQueueTrend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.approval.v1.ProcessStatsService/GetQueueTrend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessStatsServiceServer).GetQueueTrend(ctx, req.(*ApprovalStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessStatsService_GetSlowApprovers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApprovalStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessStatsServiceServer).GetSlowApprovers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.approval.v1.ProcessStatsService/GetSlowApprovers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessStatsServiceServer).GetSlowApprovers(ctx, req.(*ApprovalStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessStatsService_GetRejectionReasons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApprovalStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessStatsServiceServer).GetRejectionReasons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.approval.v1.ProcessStatsService/GetRejectionReasons",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessStatsServiceServer).GetRejectionReasons(ctx, req.(*ApprovalStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessStatsService_GetProcessTrends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApprovalStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessStatsServiceServer).GetProcessTrends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.approval.v1.ProcessStatsService/GetProcessTrends",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessStatsServiceServer).GetProcessTrends(ctx, req.(*ApprovalStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessStatsService_RefreshStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessStatsServiceServer).RefreshStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.approval.v1.ProcessStatsService/RefreshStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessStatsServiceServer).RefreshStats(ctx, req.(*RefreshStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProcessStatsService_ServiceDesc is the grpc.ServiceDesc for ProcessStatsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProcessStatsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.approval.v1.ProcessStatsService",
+	HandlerType: (*ProcessStatsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetNodeStats",
+			Handler:    _ProcessStatsService_GetNodeStats_Handler,
+		},
+		{
+			MethodName: "GetQueueTrend",
+			Handler:    _ProcessStatsService_GetQueueTrend_Handler,
+		},
+		{
+			MethodName: "GetSlowApprovers",
+			Handler:    _ProcessStatsService_GetSlowApprovers_Handler,
+		},
+		{
+			MethodName: "GetRejectionReasons",
+			Handler:    _ProcessStatsService_GetRejectionReasons_Handler,
+		},
+		{
+			MethodName: "GetProcessTrends",
+			Handler:    _ProcessStatsService_GetProcessTrends_Handler,
+		},
+		{
+			MethodName: "RefreshStats",
+			Handler:    _ProcessStatsService_RefreshStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/approval/v1/approval.proto",
+}
+
+// ProcessAdminJobServiceClient is the client API for ProcessAdminJobService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProcessAdminJobServiceClient interface {
+	// 提交离职人员待办转交作业
+	SubmitReassignTasks(ctx context.Context, in *SubmitReassignTasksRequest, opts ...grpc.CallOption) (*AdminJobResponse, error)
+	// 提交批量取消流程作业
+	SubmitCancelInstances(ctx context.Context, in *SubmitInstanceJobRequest, opts ...grpc.CallOption) (*AdminJobResponse, error)
+	// 提交批量强制完结作业
+	SubmitForceComplete(ctx context.Context, in *SubmitInstanceJobRequest, opts ...grpc.CallOption) (*AdminJobResponse, error)
+	// 提交重新生成审批任务作业
+	SubmitRetriggerTasks(ctx context.Context, in *SubmitInstanceJobRequest, opts ...grpc.CallOption) (*AdminJobResponse, error)
+	// 批量作业列表
+	ListAdminJobs(ctx context.Context, in *ListAdminJobsRequest, opts ...grpc.CallOption) (*ListAdminJobsResponse, error)
+	// 查询批量作业进度
+	GetAdminJob(ctx context.Context, in *GetAdminJobRequest, opts ...grpc.CallOption) (*AdminJobResponse, error)
+	// 批量作业逐条处理结果
+	ListAdminJobItems(ctx context.Context, in *ListAdminJobItemsRequest, opts ...grpc.CallOption) (*ListAdminJobItemsResponse, error)
+}
+
+type processAdminJobServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProcessAdminJobServiceClient(cc grpc.ClientConnInterface) ProcessAdminJobServiceClient {
+	return &processAdminJobServiceClient{cc}
+}
+
+func (c *processAdminJobServiceClient) SubmitReassignTasks(ctx context.Context, in *SubmitReassignTasksRequest, opts ...grpc.CallOption) (*AdminJobResponse, error) {
+	out := new(AdminJobResponse)
+	err := c.cc.Invoke(ctx, "/api.approval.v1.ProcessAdminJobService/SubmitReassignTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processAdminJobServiceClient) SubmitCancelInstances(ctx context.Context, in *SubmitInstanceJobRequest, opts ...grpc.CallOption) (*AdminJobResponse, error) {
+	out := new(AdminJobResponse)
+	err := c.cc.Invoke(ctx, "/api.approval.v1.ProcessAdminJobService/SubmitCancelInstances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processAdminJobServiceClient) SubmitForceComplete(ctx context.Context, in *SubmitInstanceJobRequest, opts ...grpc.CallOption) (*AdminJobResponse, error) {
+	out := new(AdminJobResponse)
+	err := c.cc.Invoke(ctx, "/api.approval.v1.ProcessAdminJobService/SubmitForceComplete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processAdminJobServiceClient) SubmitRetriggerTasks(ctx context.Context, in *SubmitInstanceJobRequest, opts ...grpc.CallOption) (*AdminJobResponse, error) {
+	out := new(AdminJobResponse)
+	err := c.cc.Invoke(ctx, "/api.approval.v1.ProcessAdminJobService/SubmitRetriggerTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processAdminJobServiceClient) ListAdminJobs(ctx context.Context, in *ListAdminJobsRequest, opts ...grpc.CallOption) (*ListAdminJobsResponse, error) {
+	out := new(ListAdminJobsResponse)
+	err := c.cc.Invoke(ctx, "/api.approval.v1.ProcessAdminJobService/ListAdminJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processAdminJobServiceClient) GetAdminJob(ctx context.Context, in *GetAdminJobRequest, opts ...grpc.CallOption) (*AdminJobResponse, error) {
+	out := new(AdminJobResponse)
+	err := c.cc.Invoke(ctx, "/api.approval.v1.ProcessAdminJobService/GetAdminJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processAdminJobServiceClient) ListAdminJobItems(ctx context.Context, in *ListAdminJobItemsRequest, opts ...grpc.CallOption) (*ListAdminJobItemsResponse, error) {
+	out := new(ListAdminJobItemsResponse)
+	err := c.cc.Invoke(ctx, "/api.approval.v1.ProcessAdminJobService/ListAdminJobItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProcessAdminJobServiceServer is the server API for ProcessAdminJobService service.
+// All implementations should embed UnimplementedProcessAdminJobServiceServer
+// for forward compatibility
+type ProcessAdminJobServiceServer interface {
+	// 提交离职人员待办转交作业
+	SubmitReassignTasks(context.Context, *SubmitReassignTasksRequest) (*AdminJobResponse, error)
+	// 提交批量取消流程作业
+	SubmitCancelInstances(context.Context, *SubmitInstanceJobRequest) (*AdminJobResponse, error)
+	// 提交批量强制完结作业
+	SubmitForceComplete(context.Context, *SubmitInstanceJobRequest) (*AdminJobResponse, error)
+	// 提交重新生成审批任务作业
+	SubmitRetriggerTasks(context.Context, *SubmitInstanceJobRequest) (*AdminJobResponse, error)
+	// 批量作业列表
+	ListAdminJobs(context.Context, *ListAdminJobsRequest) (*ListAdminJobsResponse, error)
+	// 查询批量作业进度
+	GetAdminJob(context.Context, *GetAdminJobRequest) (*AdminJobResponse, error)
+	// 批量作业逐条处理结果
+	ListAdminJobItems(context.Context, *ListAdminJobItemsRequest) (*ListAdminJobItemsResponse, error)
+}
+
+// UnimplementedProcessAdminJobServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedProcessAdminJobServiceServer struct {
+}
+
+func (UnimplementedProcessAdminJobServiceServer) SubmitReassignTasks(context.Context, *SubmitReassignTasksRequest) (*AdminJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitReassignTasks not implemented")
+}
+func (UnimplementedProcessAdminJobServiceServer) SubmitCancelInstances(context.Context, *SubmitInstanceJobRequest) (*AdminJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitCancelInstances not implemented")
+}
+func (UnimplementedProcessAdminJobServiceServer) SubmitForceComplete(context.Context, *SubmitInstanceJobRequest) (*AdminJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitForceComplete not implemented")
+}
+func (UnimplementedProcessAdminJobServiceServer) SubmitRetriggerTasks(context.Context, *SubmitInstanceJobRequest) (*AdminJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitRetriggerTasks not implemented")
+}
+func (UnimplementedProcessAdminJobServiceServer) ListAdminJobs(context.Context, *ListAdminJobsRequest) (*ListAdminJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAdminJobs not implemented")
+}
+func (UnimplementedProcessAdminJobServiceServer) GetAdminJob(context.Context, *GetAdminJobRequest) (*AdminJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdminJob not implemented")
+}
+func (UnimplementedProcessAdminJobServiceServer) ListAdminJobItems(context.Context, *ListAdminJobItemsRequest) (*ListAdminJobItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAdminJobItems not implemented")
+}
+
+// UnsafeProcessAdminJobServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProcessAdminJobServiceServer will
+// result in compilation errors.
+type UnsafeProcessAdminJobServiceServer interface {
+	mustEmbedUnimplementedProcessAdminJobServiceServer()
+}
+
+func RegisterProcessAdminJobServiceServer(s grpc.ServiceRegistrar, srv ProcessAdminJobServiceServer) {
+	s.RegisterService(&ProcessAdminJobService_ServiceDesc, srv)
+}
+
+func _ProcessAdminJobService_SubmitReassignTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitReassignTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessAdminJobServiceServer).SubmitReassignTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.approval.v1.ProcessAdminJobService/SubmitReassignTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessAdminJobServiceServer).SubmitReassignTasks(ctx, req.(*SubmitReassignTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessAdminJobService_SubmitCancelInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitInstanceJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessAdminJobServiceServer).SubmitCancelInstances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.approval.v1.ProcessAdminJobService/SubmitCancelInstances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessAdminJobServiceServer).SubmitCancelInstances(ctx, req.(*SubmitInstanceJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessAdminJobService_SubmitForceComplete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitInstanceJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessAdminJobServiceServer).SubmitForceComplete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.approval.v1.ProcessAdminJobService/SubmitForceComplete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessAdminJobServiceServer).SubmitForceComplete(ctx, req.(*SubmitInstanceJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessAdminJobService_SubmitRetriggerTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitInstanceJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessAdminJobServiceServer).SubmitRetriggerTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.approval.v1.ProcessAdminJobService/SubmitRetriggerTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessAdminJobServiceServer).SubmitRetriggerTasks(ctx, req.(*SubmitInstanceJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessAdminJobService_ListAdminJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAdminJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessAdminJobServiceServer).ListAdminJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.approval.v1.ProcessAdminJobService/ListAdminJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessAdminJobServiceServer).ListAdminJobs(ctx, req.(*ListAdminJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessAdminJobService_GetAdminJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAdminJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessAdminJobServiceServer).GetAdminJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.approval.v1.ProcessAdminJobService/GetAdminJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessAdminJobServiceServer).GetAdminJob(ctx, req.(*GetAdminJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessAdminJobService_ListAdminJobItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAdminJobItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessAdminJobServiceServer).ListAdminJobItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.approval.v1.ProcessAdminJobService/ListAdminJobItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessAdminJobServiceServer).ListAdminJobItems(ctx, req.(*ListAdminJobItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProcessAdminJobService_ServiceDesc is the grpc.ServiceDesc for ProcessAdminJobService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProcessAdminJobService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.approval.v1.ProcessAdminJobService",
+	HandlerType: (*ProcessAdminJobServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitReassignTasks",
+			Handler:    _ProcessAdminJobService_SubmitReassignTasks_Handler,
+		},
+		{
+			MethodName: "SubmitCancelInstances",
+			Handler:    _ProcessAdminJobService_SubmitCancelInstances_Handler,
+		},
+		{
+			MethodName: "SubmitForceComplete",
+			Handler:    _ProcessAdminJobService_SubmitForceComplete_Handler,
+		},
+		{
+			MethodName: "SubmitRetriggerTasks",
+			Handler:    _ProcessAdminJobService_SubmitRetriggerTasks_Handler,
+		},
+		{
+			MethodName: "ListAdminJobs",
+			Handler:    _ProcessAdminJobService_ListAdminJobs_Handler,
+		},
+		{
+			MethodName: "GetAdminJob",
+			Handler:    _ProcessAdminJobService_GetAdminJob_Handler,
+		},
+		{
+			MethodName: "ListAdminJobItems",
+			Handler:    _ProcessAdminJobService_ListAdminJobItems_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/approval/v1/approval.proto",
+}
+
+// ActionLinkServiceClient is the client API for ActionLinkService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ActionLinkServiceClient interface {
+	// 预览快捷链接（只校验不执行）
+	PreviewActionLink(ctx context.Context, in *PreviewActionLinkRequest, opts ...grpc.CallOption) (*ActionLinkPreviewResponse, error)
+	// 执行快捷链接审批
+	ExecuteActionLink(ctx context.Context, in *ExecuteActionLinkRequest, opts ...grpc.CallOption) (*ActionLinkResultResponse, error)
+	// 入站邮件回复 Webhook
+	InboundReply(ctx context.Context, in *InboundReplyRequest, opts ...grpc.CallOption) (*ActionLinkResultResponse, error)
+}
+
+type actionLinkServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewActionLinkServiceClient(cc grpc.ClientConnInterface) ActionLinkServiceClient {
+	return &actionLinkServiceClient{cc}
+}
+
+func (c *actionLinkServiceClient) PreviewActionLink(ctx context.Context, in *PreviewActionLinkRequest, opts ...grpc.CallOption) (*ActionLinkPreviewResponse, error) {
+	out := new(ActionLinkPreviewResponse)
+	err := c.cc.Invoke(ctx, "/api.approval.v1.ActionLinkService/PreviewActionLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *actionLinkServiceClient) ExecuteActionLink(ctx context.Context, in *ExecuteActionLinkRequest, opts ...grpc.CallOption) (*ActionLinkResultResponse, error) {
+	out := new(ActionLinkResultResponse)
+	err := c.cc.Invoke(ctx, "/api.approval.v1.ActionLinkService/ExecuteActionLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *actionLinkServiceClient) InboundReply(ctx context.Context, in *InboundReplyRequest, opts ...grpc.CallOption) (*ActionLinkResultResponse, error) {
+	out := new(ActionLinkResultResponse)
+	err := c.cc.Invoke(ctx, "/api.approval.v1.ActionLinkService/InboundReply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ActionLinkServiceServer is the server API for ActionLinkService service.
+// All implementations should embed UnimplementedActionLinkServiceServer
+// for forward compatibility
+type ActionLinkServiceServer interface {
+	// 预览快捷链接（只校验不执行）
+	PreviewActionLink(context.Context, *PreviewActionLinkRequest) (*ActionLinkPreviewResponse, error)
+	// 执行快捷链接审批
+	ExecuteActionLink(context.Context, *ExecuteActionLinkRequest) (*ActionLinkResultResponse, error)
+	// 入站邮件回复 Webhook
+	InboundReply(context.Context, *InboundReplyRequest) (*ActionLinkResultResponse, error)
+}
+
+// UnimplementedActionLinkServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedActionLinkServiceServer struct {
+}
+
+func (UnimplementedActionLinkServiceServer) PreviewActionLink(context.Context, *PreviewActionLinkRequest) (*ActionLinkPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewActionLink not implemented")
+}
+func (UnimplementedActionLinkServiceServer) ExecuteActionLink(context.Context, *ExecuteActionLinkRequest) (*ActionLinkResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteActionLink not implemented")
+}
+func (UnimplementedActionLinkServiceServer) InboundReply(context.Context, *InboundReplyRequest) (*ActionLinkResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InboundReply not implemented")
+}
+
+// UnsafeActionLinkServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ActionLinkServiceServer will
+// result in compilation errors.
+type UnsafeActionLinkServiceServer interface {
+	mustEmbedUnimplementedActionLinkServiceServer()
+}
+
+func RegisterActionLinkServiceServer(s grpc.ServiceRegistrar, srv ActionLinkServiceServer) {
+	s.RegisterService(&ActionLinkService_ServiceDesc, srv)
+}
+
+func _ActionLinkService_PreviewActionLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewActionLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActionLinkServiceServer).PreviewActionLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.approval.v1.ActionLinkService/PreviewActionLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActionLinkServiceServer).PreviewActionLink(ctx, req.(*PreviewActionLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActionLinkService_ExecuteActionLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteActionLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActionLinkServiceServer).ExecuteActionLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.approval.v1.ActionLinkService/ExecuteActionLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActionLinkServiceServer).ExecuteActionLink(ctx, req.(*ExecuteActionLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActionLinkService_InboundReply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InboundReplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActionLinkServiceServer).InboundReply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.approval.v1.ActionLinkService/InboundReply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActionLinkServiceServer).InboundReply(ctx, req.(*InboundReplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ActionLinkService_ServiceDesc is the grpc.ServiceDesc for ActionLinkService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ActionLinkService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.approval.v1.ActionLinkService",
+	HandlerType: (*ActionLinkServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PreviewActionLink",
+			Handler:    _ActionLinkService_PreviewActionLink_Handler,
+		},
+		{
+			MethodName: "ExecuteActionLink",
+			Handler:    _ActionLinkService_ExecuteActionLink_Handler,
+		},
+		{
+			MethodName: "InboundReply",
+			Handler:    _ActionLinkService_InboundReply_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/approval/v1/approval.proto",
+}
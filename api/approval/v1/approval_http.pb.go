@@ -1000,3 +1000,1010 @@ func (c *ApprovalTaskServiceHTTPClientImpl) TransferTask(ctx context.Context, in
 	}
 	return &out, nil
 }
+
+const OperationProcessSearchServiceConfigureSearchFields = "/api.approval.v1.ProcessSearchService/ConfigureSearchFields"
+const OperationProcessSearchServiceGetSearchFields = "/api.approval.v1.ProcessSearchService/GetSearchFields"
+const OperationProcessSearchServiceReindexProcessDefinition = "/api.approval.v1.ProcessSearchService/ReindexProcessDefinition"
+const OperationProcessSearchServiceSearchInstances = "/api.approval.v1.ProcessSearchService/SearchInstances"
+
+type ProcessSearchServiceHTTPServer interface {
+	// ConfigureSearchFields 配置流程可检索字段
+	ConfigureSearchFields(context.Context, *ConfigureSearchFieldsRequest) (*SearchFieldsResponse, error)
+	// GetSearchFields 获取流程可检索字段
+	GetSearchFields(context.Context, *GetSearchFieldsRequest) (*SearchFieldsResponse, error)
+	// ReindexProcessDefinition 重建流程定义下所有实例的检索索引
+	ReindexProcessDefinition(context.Context, *ReindexProcessDefinitionRequest) (*ReindexProcessDefinitionResponse, error)
+	// SearchInstances 检索流程实例（全文 + 表单字段过滤 + 游标分页）
+	SearchInstances(context.Context, *SearchInstancesRequest) (*SearchInstancesResponse, error)
+}
+
+func RegisterProcessSearchServiceHTTPServer(s *http.Server, srv ProcessSearchServiceHTTPServer) {
+	r := s.Route("/")
+	r.POST("/api/v1/process-instances/search", _ProcessSearchService_SearchInstances0_HTTP_Handler(srv))
+	r.GET("/api/v1/processes/{id}/search-fields", _ProcessSearchService_GetSearchFields0_HTTP_Handler(srv))
+	r.PUT("/api/v1/processes/{id}/search-fields", _ProcessSearchService_ConfigureSearchFields0_HTTP_Handler(srv))
+	r.POST("/api/v1/processes/{id}/search-fields/reindex", _ProcessSearchService_ReindexProcessDefinition0_HTTP_Handler(srv))
+}
+
+func _ProcessSearchService_SearchInstances0_HTTP_Handler(srv ProcessSearchServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SearchInstancesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProcessSearchServiceSearchInstances)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SearchInstances(ctx, req.(*SearchInstancesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SearchInstancesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ProcessSearchService_GetSearchFields0_HTTP_Handler(srv ProcessSearchServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetSearchFieldsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProcessSearchServiceGetSearchFields)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSearchFields(ctx, req.(*GetSearchFieldsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SearchFieldsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ProcessSearchService_ConfigureSearchFields0_HTTP_Handler(srv ProcessSearchServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ConfigureSearchFieldsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProcessSearchServiceConfigureSearchFields)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ConfigureSearchFields(ctx, req.(*ConfigureSearchFieldsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SearchFieldsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ProcessSearchService_ReindexProcessDefinition0_HTTP_Handler(srv ProcessSearchServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReindexProcessDefinitionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProcessSearchServiceReindexProcessDefinition)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReindexProcessDefinition(ctx, req.(*ReindexProcessDefinitionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReindexProcessDefinitionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type ProcessSearchServiceHTTPClient interface {
+	// ConfigureSearchFields 配置流程可检索字段
+	ConfigureSearchFields(ctx context.Context, req *ConfigureSearchFieldsRequest, opts ...http.CallOption) (rsp *SearchFieldsResponse, err error)
+	// GetSearchFields 获取流程可检索字段
+	GetSearchFields(ctx context.Context, req *GetSearchFieldsRequest, opts ...http.CallOption) (rsp *SearchFieldsResponse, err error)
+	// ReindexProcessDefinition 重建流程定义下所有实例的检索索引
+	ReindexProcessDefinition(ctx context.Context, req *ReindexProcessDefinitionRequest, opts ...http.CallOption) (rsp *ReindexProcessDefinitionResponse, err error)
+	// SearchInstances 检索流程实例（全文 + 表单字段过滤 + 游标分页）
+	SearchInstances(ctx context.Context, req *SearchInstancesRequest, opts ...http.CallOption) (rsp *SearchInstancesResponse, err error)
+}
+
+type ProcessSearchServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewProcessSearchServiceHTTPClient(client *http.Client) ProcessSearchServiceHTTPClient {
+	return &ProcessSearchServiceHTTPClientImpl{client}
+}
+
+// ConfigureSearchFields 配置流程可检索字段
+func (c *ProcessSearchServiceHTTPClientImpl) ConfigureSearchFields(ctx context.Context, in *ConfigureSearchFieldsRequest, opts ...http.CallOption) (*SearchFieldsResponse, error) {
+	var out SearchFieldsResponse
+	pattern := "/api/v1/processes/{id}/search-fields"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProcessSearchServiceConfigureSearchFields))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetSearchFields 获取流程可检索字段
+func (c *ProcessSearchServiceHTTPClientImpl) GetSearchFields(ctx context.Context, in *GetSearchFieldsRequest, opts ...http.CallOption) (*SearchFieldsResponse, error) {
+	var out SearchFieldsResponse
+	pattern := "/api/v1/processes/{id}/search-fields"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationProcessSearchServiceGetSearchFields))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReindexProcessDefinition 重建流程定义下所有实例的检索索引
+func (c *ProcessSearchServiceHTTPClientImpl) ReindexProcessDefinition(ctx context.Context, in *ReindexProcessDefinitionRequest, opts ...http.CallOption) (*ReindexProcessDefinitionResponse, error) {
+	var out ReindexProcessDefinitionResponse
+	pattern := "/api/v1/processes/{id}/search-fields/reindex"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProcessSearchServiceReindexProcessDefinition))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SearchInstances 检索流程实例（全文 + 表单字段过滤 + 游标分页）
+func (c *ProcessSearchServiceHTTPClientImpl) SearchInstances(ctx context.Context, in *SearchInstancesRequest, opts ...http.CallOption) (*SearchInstancesResponse, error) {
+	var out SearchInstancesResponse
+	pattern := "/api/v1/process-instances/search"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProcessSearchServiceSearchInstances))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+const OperationProcessAuditServiceExportDossier = "/api.approval.v1.ProcessAuditService/ExportDossier"
+const OperationProcessAuditServiceVerifyHistoryChain = "/api.approval.v1.ProcessAuditService/VerifyHistoryChain"
+
+type ProcessAuditServiceHTTPServer interface {
+	// ExportDossier 导出流程实例审批档案（PDF/JSON）
+	ExportDossier(context.Context, *ExportDossierRequest) (*DossierExportResponse, error)
+	// VerifyHistoryChain 校验流程实例历史记录哈希链
+	VerifyHistoryChain(context.Context, *VerifyHistoryChainRequest) (*HistoryChainReportResponse, error)
+}
+
+func RegisterProcessAuditServiceHTTPServer(s *http.Server, srv ProcessAuditServiceHTTPServer) {
+	r := s.Route("/")
+	r.GET("/api/v1/process-instances/{id}/history/verify", _ProcessAuditService_VerifyHistoryChain0_HTTP_Handler(srv))
+	r.POST("/api/v1/process-instances/{id}/dossier", _ProcessAuditService_ExportDossier0_HTTP_Handler(srv))
+}
+
+func _ProcessAuditService_VerifyHistoryChain0_HTTP_Handler(srv ProcessAuditServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in VerifyHistoryChainRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProcessAuditServiceVerifyHistoryChain)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifyHistoryChain(ctx, req.(*VerifyHistoryChainRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*HistoryChainReportResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ProcessAuditService_ExportDossier0_HTTP_Handler(srv ProcessAuditServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExportDossierRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProcessAuditServiceExportDossier)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExportDossier(ctx, req.(*ExportDossierRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DossierExportResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type ProcessAuditServiceHTTPClient interface {
+	// ExportDossier 导出流程实例审批档案（PDF/JSON）
+	ExportDossier(ctx context.Context, req *ExportDossierRequest, opts ...http.CallOption) (rsp *DossierExportResponse, err error)
+	// VerifyHistoryChain 校验流程实例历史记录哈希链
+	VerifyHistoryChain(ctx context.Context, req *VerifyHistoryChainRequest, opts ...http.CallOption) (rsp *HistoryChainReportResponse, err error)
+}
+
+type ProcessAuditServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewProcessAuditServiceHTTPClient(client *http.Client) ProcessAuditServiceHTTPClient {
+	return &ProcessAuditServiceHTTPClientImpl{client}
+}
+
+// ExportDossier 导出流程实例审批档案（PDF/JSON）
+func (c *ProcessAuditServiceHTTPClientImpl) ExportDossier(ctx context.Context, in *ExportDossierRequest, opts ...http.CallOption) (*DossierExportResponse, error) {
+	var out DossierExportResponse
+	pattern := "/api/v1/process-instances/{id}/dossier"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProcessAuditServiceExportDossier))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// VerifyHistoryChain 校验流程实例历史记录哈希链
+func (c *ProcessAuditServiceHTTPClientImpl) VerifyHistoryChain(ctx context.Context, in *VerifyHistoryChainRequest, opts ...http.CallOption) (*HistoryChainReportResponse, error) {
+	var out HistoryChainReportResponse
+	pattern := "/api/v1/process-instances/{id}/history/verify"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationProcessAuditServiceVerifyHistoryChain))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+const OperationProcessStatsServiceGetNodeStats = "/api.approval.v1.ProcessStatsService/GetNodeStats"
+const OperationProcessStatsServiceGetProcessTrends = "/api.approval.v1.ProcessStatsService/GetProcessTrends"
+const OperationProcessStatsServiceGetQueueTrend = "/api.approval.v1.ProcessStatsService/GetQueueTrend"
+const OperationProcessStatsServiceGetRejectionReasons = "/api.approval.v1.ProcessStatsService/GetRejectionReasons"
+const OperationProcessStatsServiceGetSlowApprovers = "/api.approval.v1.ProcessStatsService/GetSlowApprovers"
+const OperationProcessStatsServiceRefreshStats = "/api.approval.v1.ProcessStatsService/RefreshStats"
+
+type ProcessStatsServiceHTTPServer interface {
+	// GetNodeStats 节点周度处理时长统计
+	GetNodeStats(context.Context, *ApprovalStatsRequest) (*NodeStatsResponse, error)
+	// GetProcessTrends 流程定义周度趋势与环比
+	GetProcessTrends(context.Context, *ApprovalStatsRequest) (*ProcessTrendsResponse, error)
+	// GetQueueTrend 节点待办队列长度变化
+	GetQueueTrend(context.Context, *ApprovalStatsRequest) (*QueueTrendResponse, error)
+	// GetRejectionReasons 节点拒绝原因排行
+	GetRejectionReasons(context.Context, *ApprovalStatsRequest) (*RejectionReasonsResponse, error)
+	// GetSlowApprovers 处理最慢的审批人排行
+	GetSlowApprovers(context.Context, *ApprovalStatsRequest) (*SlowApproversResponse, error)
+	// RefreshStats 立即重算本租户最近 N 周统计
+	RefreshStats(context.Context, *RefreshStatsRequest) (*RefreshStatsResponse, error)
+}
+
+func RegisterProcessStatsServiceHTTPServer(s *http.Server, srv ProcessStatsServiceHTTPServer) {
+	r := s.Route("/")
+	r.GET("/api/v1/approval-stats/nodes", _ProcessStatsService_GetNodeStats0_HTTP_Handler(srv))
+	r.GET("/api/v1/approval-stats/queue", _ProcessStatsService_GetQueueTrend0_HTTP_Handler(srv))
+	r.GET("/api/v1/approval-stats/slow-approvers", _ProcessStatsService_GetSlowApprovers0_HTTP_Handler(srv))
+	r.GET("/api/v1/approval-stats/rejection-reasons", _ProcessStatsService_GetRejectionReasons0_HTTP_Handler(srv))
+	r.GET("/api/v1/approval-stats/trends", _ProcessStatsService_GetProcessTrends0_HTTP_Handler(srv))
+	r.POST("/api/v1/approval-stats/refresh", _ProcessStatsService_RefreshStats0_HTTP_Handler(srv))
+}
+
+func _ProcessStatsService_GetNodeStats0_HTTP_Handler(srv ProcessStatsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ApprovalStatsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProcessStatsServiceGetNodeStats)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetNodeStats(ctx, req.(*ApprovalStatsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*NodeStatsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ProcessStatsService_GetQueueTrend0_HTTP_Handler(srv ProcessStatsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ApprovalStatsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProcessStatsServiceGetQueueTrend)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetQueueTrend(ctx, req.(*ApprovalStatsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*QueueTrendResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ProcessStatsService_GetSlowApprovers0_HTTP_Handler(srv ProcessStatsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ApprovalStatsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProcessStatsServiceGetSlowApprovers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSlowApprovers(ctx, req.(*ApprovalStatsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SlowApproversResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ProcessStatsService_GetRejectionReasons0_HTTP_Handler(srv ProcessStatsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ApprovalStatsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProcessStatsServiceGetRejectionReasons)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetRejectionReasons(ctx, req.(*ApprovalStatsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RejectionReasonsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ProcessStatsService_GetProcessTrends0_HTTP_Handler(srv ProcessStatsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ApprovalStatsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProcessStatsServiceGetProcessTrends)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetProcessTrends(ctx, req.(*ApprovalStatsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ProcessTrendsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ProcessStatsService_RefreshStats0_HTTP_Handler(srv ProcessStatsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RefreshStatsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProcessStatsServiceRefreshStats)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RefreshStats(ctx, req.(*RefreshStatsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RefreshStatsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type ProcessStatsServiceHTTPClient interface {
+	// GetNodeStats 节点周度处理时长统计
+	GetNodeStats(ctx context.Context, req *ApprovalStatsRequest, opts ...http.CallOption) (rsp *NodeStatsResponse, err error)
+	// GetProcessTrends 流程定义周度趋势与环比
+	GetProcessTrends(ctx context.Context, req *ApprovalStatsRequest, opts ...http.CallOption) (rsp *ProcessTrendsResponse, err error)
+	// GetQueueTrend 节点待办队列长度变化
+	GetQueueTrend(ctx context.Context, req *ApprovalStatsRequest, opts ...http.CallOption) (rsp *QueueTrendResponse, err error)
+	// GetRejectionReasons 节点拒绝原因排行
+	GetRejectionReasons(ctx context.Context, req *ApprovalStatsRequest, opts ...http.CallOption) (rsp *RejectionReasonsResponse, err error)
+	// GetSlowApprovers 处理最慢的审批人排行
+	GetSlowApprovers(ctx context.Context, req *ApprovalStatsRequest, opts ...http.CallOption) (rsp *SlowApproversResponse, err error)
+	// RefreshStats 立即重算本租户最近 N 周统计
+	RefreshStats(ctx context.Context, req *RefreshStatsRequest, opts ...http.CallOption) (rsp *RefreshStatsResponse, err error)
+}
+
+type ProcessStatsServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewProcessStatsServiceHTTPClient(client *http.Client) ProcessStatsServiceHTTPClient {
+	return &ProcessStatsServiceHTTPClientImpl{client}
+}
+
+// GetNodeStats 节点周度处理时长统计
+func (c *ProcessStatsServiceHTTPClientImpl) GetNodeStats(ctx context.Context, in *ApprovalStatsRequest, opts ...http.CallOption) (*NodeStatsResponse, error) {
+	var out NodeStatsResponse
+	pattern := "/api/v1/approval-stats/nodes"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationProcessStatsServiceGetNodeStats))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetProcessTrends 流程定义周度趋势与环比
+func (c *ProcessStatsServiceHTTPClientImpl) GetProcessTrends(ctx context.Context, in *ApprovalStatsRequest, opts ...http.CallOption) (*ProcessTrendsResponse, error) {
+	var out ProcessTrendsResponse
+	pattern := "/api/v1/approval-stats/trends"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationProcessStatsServiceGetProcessTrends))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetQueueTrend 节点待办队列长度变化
+func (c *ProcessStatsServiceHTTPClientImpl) GetQueueTrend(ctx context.Context, in *ApprovalStatsRequest, opts ...http.CallOption) (*QueueTrendResponse, error) {
+	var out QueueTrendResponse
+	pattern := "/api/v1/approval-stats/queue"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationProcessStatsServiceGetQueueTrend))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetRejectionReasons 节点拒绝原因排行
+func (c *ProcessStatsServiceHTTPClientImpl) GetRejectionReasons(ctx context.Context, in *ApprovalStatsRequest, opts ...http.CallOption) (*RejectionReasonsResponse, error) {
+	var out RejectionReasonsResponse
+	pattern := "/api/v1/approval-stats/rejection-reasons"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationProcessStatsServiceGetRejectionReasons))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetSlowApprovers 处理最慢的审批人排行
+func (c *ProcessStatsServiceHTTPClientImpl) GetSlowApprovers(ctx context.Context, in *ApprovalStatsRequest, opts ...http.CallOption) (*SlowApproversResponse, error) {
+	var out SlowApproversResponse
+	pattern := "/api/v1/approval-stats/slow-approvers"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationProcessStatsServiceGetSlowApprovers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RefreshStats 立即重算本租户最近 N 周统计
+func (c *ProcessStatsServiceHTTPClientImpl) RefreshStats(ctx context.Context, in *RefreshStatsRequest, opts ...http.CallOption) (*RefreshStatsResponse, error) {
+	var out RefreshStatsResponse
+	pattern := "/api/v1/approval-stats/refresh"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProcessStatsServiceRefreshStats))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+const OperationProcessAdminJobServiceGetAdminJob = "/api.approval.v1.ProcessAdminJobService/GetAdminJob"
+const OperationProcessAdminJobServiceListAdminJobItems = "/api.approval.v1.ProcessAdminJobService/ListAdminJobItems"
+const OperationProcessAdminJobServiceListAdminJobs = "/api.approval.v1.ProcessAdminJobService/ListAdminJobs"
+const OperationProcessAdminJobServiceSubmitCancelInstances = "/api.approval.v1.ProcessAdminJobService/SubmitCancelInstances"
+const OperationProcessAdminJobServiceSubmitForceComplete = "/api.approval.v1.ProcessAdminJobService/SubmitForceComplete"
+const OperationProcessAdminJobServiceSubmitReassignTasks = "/api.approval.v1.ProcessAdminJobService/SubmitReassignTasks"
+const OperationProcessAdminJobServiceSubmitRetriggerTasks = "/api.approval.v1.ProcessAdminJobService/SubmitRetriggerTasks"
+
+type ProcessAdminJobServiceHTTPServer interface {
+	// GetAdminJob 查询批量作业进度
+	GetAdminJob(context.Context, *GetAdminJobRequest) (*AdminJobResponse, error)
+	// ListAdminJobItems 批量作业逐条处理结果
+	ListAdminJobItems(context.Context, *ListAdminJobItemsRequest) (*ListAdminJobItemsResponse, error)
+	// ListAdminJobs 批量作业列表
+	ListAdminJobs(context.Context, *ListAdminJobsRequest) (*ListAdminJobsResponse, error)
+	// SubmitCancelInstances 提交批量取消流程作业
+	SubmitCancelInstances(context.Context, *SubmitInstanceJobRequest) (*AdminJobResponse, error)
+	// SubmitForceComplete 提交批量强制完结作业
+	SubmitForceComplete(context.Context, *SubmitInstanceJobRequest) (*AdminJobResponse, error)
+	// SubmitReassignTasks 提交离职人员待办转交作业
+	SubmitReassignTasks(context.Context, *SubmitReassignTasksRequest) (*AdminJobResponse, error)
+	// SubmitRetriggerTasks 提交重新生成审批任务作业
+	SubmitRetriggerTasks(context.Context, *SubmitInstanceJobRequest) (*AdminJobResponse, error)
+}
+
+func RegisterProcessAdminJobServiceHTTPServer(s *http.Server, srv ProcessAdminJobServiceHTTPServer) {
+	r := s.Route("/")
+	r.POST("/api/v1/approval-admin/jobs/reassign", _ProcessAdminJobService_SubmitReassignTasks0_HTTP_Handler(srv))
+	r.POST("/api/v1/approval-admin/jobs/cancel", _ProcessAdminJobService_SubmitCancelInstances0_HTTP_Handler(srv))
+	r.POST("/api/v1/approval-admin/jobs/force-complete", _ProcessAdminJobService_SubmitForceComplete0_HTTP_Handler(srv))
+	r.POST("/api/v1/approval-admin/jobs/retrigger", _ProcessAdminJobService_SubmitRetriggerTasks0_HTTP_Handler(srv))
+	r.GET("/api/v1/approval-admin/jobs", _ProcessAdminJobService_ListAdminJobs0_HTTP_Handler(srv))
+	r.GET("/api/v1/approval-admin/jobs/{id}", _ProcessAdminJobService_GetAdminJob0_HTTP_Handler(srv))
+	r.GET("/api/v1/approval-admin/jobs/{id}/items", _ProcessAdminJobService_ListAdminJobItems0_HTTP_Handler(srv))
+}
+
+func _ProcessAdminJobService_SubmitReassignTasks0_HTTP_Handler(srv ProcessAdminJobServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SubmitReassignTasksRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProcessAdminJobServiceSubmitReassignTasks)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SubmitReassignTasks(ctx, req.(*SubmitReassignTasksRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminJobResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ProcessAdminJobService_SubmitCancelInstances0_HTTP_Handler(srv ProcessAdminJobServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SubmitInstanceJobRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProcessAdminJobServiceSubmitCancelInstances)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SubmitCancelInstances(ctx, req.(*SubmitInstanceJobRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminJobResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ProcessAdminJobService_SubmitForceComplete0_HTTP_Handler(srv ProcessAdminJobServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SubmitInstanceJobRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProcessAdminJobServiceSubmitForceComplete)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SubmitForceComplete(ctx, req.(*SubmitInstanceJobRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminJobResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ProcessAdminJobService_SubmitRetriggerTasks0_HTTP_Handler(srv ProcessAdminJobServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SubmitInstanceJobRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProcessAdminJobServiceSubmitRetriggerTasks)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SubmitRetriggerTasks(ctx, req.(*SubmitInstanceJobRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminJobResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ProcessAdminJobService_ListAdminJobs0_HTTP_Handler(srv ProcessAdminJobServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAdminJobsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProcessAdminJobServiceListAdminJobs)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAdminJobs(ctx, req.(*ListAdminJobsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListAdminJobsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ProcessAdminJobService_GetAdminJob0_HTTP_Handler(srv ProcessAdminJobServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetAdminJobRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProcessAdminJobServiceGetAdminJob)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetAdminJob(ctx, req.(*GetAdminJobRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminJobResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ProcessAdminJobService_ListAdminJobItems0_HTTP_Handler(srv ProcessAdminJobServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAdminJobItemsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProcessAdminJobServiceListAdminJobItems)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAdminJobItems(ctx, req.(*ListAdminJobItemsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListAdminJobItemsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type ProcessAdminJobServiceHTTPClient interface {
+	// GetAdminJob 查询批量作业进度
+	GetAdminJob(ctx context.Context, req *GetAdminJobRequest, opts ...http.CallOption) (rsp *AdminJobResponse, err error)
+	// ListAdminJobItems 批量作业逐条处理结果
+	ListAdminJobItems(ctx context.Context, req *ListAdminJobItemsRequest, opts ...http.CallOption) (rsp *ListAdminJobItemsResponse, err error)
+	// ListAdminJobs 批量作业列表
+	ListAdminJobs(ctx context.Context, req *ListAdminJobsRequest, opts ...http.CallOption) (rsp *ListAdminJobsResponse, err error)
+	// SubmitCancelInstances 提交批量取消流程作业
+	SubmitCancelInstances(ctx context.Context, req *SubmitInstanceJobRequest, opts ...http.CallOption) (rsp *AdminJobResponse, err error)
+	// SubmitForceComplete 提交批量强制完结作业
+	SubmitForceComplete(ctx context.Context, req *SubmitInstanceJobRequest, opts ...http.CallOption) (rsp *AdminJobResponse, err error)
+	// SubmitReassignTasks 提交离职人员待办转交作业
+	SubmitReassignTasks(ctx context.Context, req *SubmitReassignTasksRequest, opts ...http.CallOption) (rsp *AdminJobResponse, err error)
+	// SubmitRetriggerTasks 提交重新生成审批任务作业
+	SubmitRetriggerTasks(ctx context.Context, req *SubmitInstanceJobRequest, opts ...http.CallOption) (rsp *AdminJobResponse, err error)
+}
+
+type ProcessAdminJobServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewProcessAdminJobServiceHTTPClient(client *http.Client) ProcessAdminJobServiceHTTPClient {
+	return &ProcessAdminJobServiceHTTPClientImpl{client}
+}
+
+// GetAdminJob 查询批量作业进度
+func (c *ProcessAdminJobServiceHTTPClientImpl) GetAdminJob(ctx context.Context, in *GetAdminJobRequest, opts ...http.CallOption) (*AdminJobResponse, error) {
+	var out AdminJobResponse
+	pattern := "/api/v1/approval-admin/jobs/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationProcessAdminJobServiceGetAdminJob))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListAdminJobItems 批量作业逐条处理结果
+func (c *ProcessAdminJobServiceHTTPClientImpl) ListAdminJobItems(ctx context.Context, in *ListAdminJobItemsRequest, opts ...http.CallOption) (*ListAdminJobItemsResponse, error) {
+	var out ListAdminJobItemsResponse
+	pattern := "/api/v1/approval-admin/jobs/{id}/items"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationProcessAdminJobServiceListAdminJobItems))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListAdminJobs 批量作业列表
+func (c *ProcessAdminJobServiceHTTPClientImpl) ListAdminJobs(ctx context.Context, in *ListAdminJobsRequest, opts ...http.CallOption) (*ListAdminJobsResponse, error) {
+	var out ListAdminJobsResponse
+	pattern := "/api/v1/approval-admin/jobs"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationProcessAdminJobServiceListAdminJobs))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SubmitCancelInstances 提交批量取消流程作业
+func (c *ProcessAdminJobServiceHTTPClientImpl) SubmitCancelInstances(ctx context.Context, in *SubmitInstanceJobRequest, opts ...http.CallOption) (*AdminJobResponse, error) {
+	var out AdminJobResponse
+	pattern := "/api/v1/approval-admin/jobs/cancel"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProcessAdminJobServiceSubmitCancelInstances))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SubmitForceComplete 提交批量强制完结作业
+func (c *ProcessAdminJobServiceHTTPClientImpl) SubmitForceComplete(ctx context.Context, in *SubmitInstanceJobRequest, opts ...http.CallOption) (*AdminJobResponse, error) {
+	var out AdminJobResponse
+	pattern := "/api/v1/approval-admin/jobs/force-complete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProcessAdminJobServiceSubmitForceComplete))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SubmitReassignTasks 提交离职人员待办转交作业
+func (c *ProcessAdminJobServiceHTTPClientImpl) SubmitReassignTasks(ctx context.Context, in *SubmitReassignTasksRequest, opts ...http.CallOption) (*AdminJobResponse, error) {
+	var out AdminJobResponse
+	pattern := "/api/v1/approval-admin/jobs/reassign"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProcessAdminJobServiceSubmitReassignTasks))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SubmitRetriggerTasks 提交重新生成审批任务作业
+func (c *ProcessAdminJobServiceHTTPClientImpl) SubmitRetriggerTasks(ctx context.Context, in *SubmitInstanceJobRequest, opts ...http.CallOption) (*AdminJobResponse, error) {
+	var out AdminJobResponse
+	pattern := "/api/v1/approval-admin/jobs/retrigger"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProcessAdminJobServiceSubmitRetriggerTasks))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+const OperationActionLinkServiceExecuteActionLink = "/api.approval.v1.ActionLinkService/ExecuteActionLink"
+const OperationActionLinkServiceInboundReply = "/api.approval.v1.ActionLinkService/InboundReply"
+const OperationActionLinkServicePreviewActionLink = "/api.approval.v1.ActionLinkService/PreviewActionLink"
+
+type ActionLinkServiceHTTPServer interface {
+	// ExecuteActionLink 执行快捷链接审批
+	ExecuteActionLink(context.Context, *ExecuteActionLinkRequest) (*ActionLinkResultResponse, error)
+	// InboundReply 入站邮件回复 Webhook
+	InboundReply(context.Context, *InboundReplyRequest) (*ActionLinkResultResponse, error)
+	// PreviewActionLink 预览快捷链接（只校验不执行）
+	PreviewActionLink(context.Context, *PreviewActionLinkRequest) (*ActionLinkPreviewResponse, error)
+}
+
+func RegisterActionLinkServiceHTTPServer(s *http.Server, srv ActionLinkServiceHTTPServer) {
+	r := s.Route("/")
+	r.GET("/api/v1/approval-links/preview", _ActionLinkService_PreviewActionLink0_HTTP_Handler(srv))
+	r.POST("/api/v1/approval-links/execute", _ActionLinkService_ExecuteActionLink0_HTTP_Handler(srv))
+	r.POST("/api/v1/approval-links/inbound-email", _ActionLinkService_InboundReply0_HTTP_Handler(srv))
+}
+
+func _ActionLinkService_PreviewActionLink0_HTTP_Handler(srv ActionLinkServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PreviewActionLinkRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationActionLinkServicePreviewActionLink)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PreviewActionLink(ctx, req.(*PreviewActionLinkRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ActionLinkPreviewResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ActionLinkService_ExecuteActionLink0_HTTP_Handler(srv ActionLinkServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExecuteActionLinkRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationActionLinkServiceExecuteActionLink)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExecuteActionLink(ctx, req.(*ExecuteActionLinkRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ActionLinkResultResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ActionLinkService_InboundReply0_HTTP_Handler(srv ActionLinkServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in InboundReplyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationActionLinkServiceInboundReply)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.InboundReply(ctx, req.(*InboundReplyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ActionLinkResultResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type ActionLinkServiceHTTPClient interface {
+	// ExecuteActionLink 执行快捷链接审批
+	ExecuteActionLink(ctx context.Context, req *ExecuteActionLinkRequest, opts ...http.CallOption) (rsp *ActionLinkResultResponse, err error)
+	// InboundReply 入站邮件回复 Webhook
+	InboundReply(ctx context.Context, req *InboundReplyRequest, opts ...http.CallOption) (rsp *ActionLinkResultResponse, err error)
+	// PreviewActionLink 预览快捷链接（只校验不执行）
+	PreviewActionLink(ctx context.Context, req *PreviewActionLinkRequest, opts ...http.CallOption) (rsp *ActionLinkPreviewResponse, err error)
+}
+
+type ActionLinkServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewActionLinkServiceHTTPClient(client *http.Client) ActionLinkServiceHTTPClient {
+	return &ActionLinkServiceHTTPClientImpl{client}
+}
+
+// ExecuteActionLink 执行快捷链接审批
+func (c *ActionLinkServiceHTTPClientImpl) ExecuteActionLink(ctx context.Context, in *ExecuteActionLinkRequest, opts ...http.CallOption) (*ActionLinkResultResponse, error) {
+	var out ActionLinkResultResponse
+	pattern := "/api/v1/approval-links/execute"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationActionLinkServiceExecuteActionLink))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// InboundReply 入站邮件回复 Webhook
+func (c *ActionLinkServiceHTTPClientImpl) InboundReply(ctx context.Context, in *InboundReplyRequest, opts ...http.CallOption) (*ActionLinkResultResponse, error) {
+	var out ActionLinkResultResponse
+	pattern := "/api/v1/approval-links/inbound-email"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationActionLinkServiceInboundReply))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PreviewActionLink 预览快捷链接（只校验不执行）
+func (c *ActionLinkServiceHTTPClientImpl) PreviewActionLink(ctx context.Context, in *PreviewActionLinkRequest, opts ...http.CallOption) (*ActionLinkPreviewResponse, error) {
+	var out ActionLinkPreviewResponse
+	pattern := "/api/v1/approval-links/preview"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationActionLinkServicePreviewActionLink))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
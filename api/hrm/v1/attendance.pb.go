@@ -3206,31 +3206,34 @@ func (x *ListAttendanceRulesRequest) GetPageSize() int32 {
 }
 
 type AttendanceRuleResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TenantId         string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Code             string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
-	Name             string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Description      string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	ApplyType        string                 `protobuf:"bytes,6,opt,name=apply_type,json=applyType,proto3" json:"apply_type,omitempty"`
-	DepartmentIds    []string               `protobuf:"bytes,7,rep,name=department_ids,json=departmentIds,proto3" json:"department_ids,omitempty"`
-	EmployeeIds      []string               `protobuf:"bytes,8,rep,name=employee_ids,json=employeeIds,proto3" json:"employee_ids,omitempty"`
-	WorkdayType      string                 `protobuf:"bytes,9,opt,name=workday_type,json=workdayType,proto3" json:"workday_type,omitempty"`
-	WeekendDays      []int32                `protobuf:"varint,10,rep,packed,name=weekend_days,json=weekendDays,proto3" json:"weekend_days,omitempty"`
-	DefaultShiftId   string                 `protobuf:"bytes,11,opt,name=default_shift_id,json=defaultShiftId,proto3" json:"default_shift_id,omitempty"`
-	LocationRequired bool                   `protobuf:"varint,12,opt,name=location_required,json=locationRequired,proto3" json:"location_required,omitempty"`
-	AllowedLocations []*AllowedLocationInfo `protobuf:"bytes,13,rep,name=allowed_locations,json=allowedLocations,proto3" json:"allowed_locations,omitempty"`
-	WifiRequired     bool                   `protobuf:"varint,14,opt,name=wifi_required,json=wifiRequired,proto3" json:"wifi_required,omitempty"`
-	AllowedWifi      []string               `protobuf:"bytes,15,rep,name=allowed_wifi,json=allowedWifi,proto3" json:"allowed_wifi,omitempty"`
-	FaceRequired     bool                   `protobuf:"varint,16,opt,name=face_required,json=faceRequired,proto3" json:"face_required,omitempty"`
-	FaceThreshold    float64                `protobuf:"fixed64,17,opt,name=face_threshold,json=faceThreshold,proto3" json:"face_threshold,omitempty"`
-	AllowFieldWork   bool                   `protobuf:"varint,18,opt,name=allow_field_work,json=allowFieldWork,proto3" json:"allow_field_work,omitempty"`
-	IsActive         bool                   `protobuf:"varint,19,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	Priority         int32                  `protobuf:"varint,20,opt,name=priority,proto3" json:"priority,omitempty"`
-	CreatedAt        string                 `protobuf:"bytes,21,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt        string                 `protobuf:"bytes,22,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId          string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Code              string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Name              string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	ApplyType         string                 `protobuf:"bytes,6,opt,name=apply_type,json=applyType,proto3" json:"apply_type,omitempty"`
+	DepartmentIds     []string               `protobuf:"bytes,7,rep,name=department_ids,json=departmentIds,proto3" json:"department_ids,omitempty"`
+	EmployeeIds       []string               `protobuf:"bytes,8,rep,name=employee_ids,json=employeeIds,proto3" json:"employee_ids,omitempty"`
+	WorkdayType       string                 `protobuf:"bytes,9,opt,name=workday_type,json=workdayType,proto3" json:"workday_type,omitempty"`
+	WeekendDays       []int32                `protobuf:"varint,10,rep,packed,name=weekend_days,json=weekendDays,proto3" json:"weekend_days,omitempty"`
+	DefaultShiftId    string                 `protobuf:"bytes,11,opt,name=default_shift_id,json=defaultShiftId,proto3" json:"default_shift_id,omitempty"`
+	LocationRequired  bool                   `protobuf:"varint,12,opt,name=location_required,json=locationRequired,proto3" json:"location_required,omitempty"`
+	AllowedLocations  []*AllowedLocationInfo `protobuf:"bytes,13,rep,name=allowed_locations,json=allowedLocations,proto3" json:"allowed_locations,omitempty"`
+	WifiRequired      bool                   `protobuf:"varint,14,opt,name=wifi_required,json=wifiRequired,proto3" json:"wifi_required,omitempty"`
+	AllowedWifi       []string               `protobuf:"bytes,15,rep,name=allowed_wifi,json=allowedWifi,proto3" json:"allowed_wifi,omitempty"`
+	FaceRequired      bool                   `protobuf:"varint,16,opt,name=face_required,json=faceRequired,proto3" json:"face_required,omitempty"`
+	FaceThreshold     float64                `protobuf:"fixed64,17,opt,name=face_threshold,json=faceThreshold,proto3" json:"face_threshold,omitempty"`
+	AllowFieldWork    bool                   `protobuf:"varint,18,opt,name=allow_field_work,json=allowFieldWork,proto3" json:"allow_field_work,omitempty"`
+	IsActive          bool                   `protobuf:"varint,19,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Priority          int32                  `protobuf:"varint,20,opt,name=priority,proto3" json:"priority,omitempty"`
+	CreatedAt         string                 `protobuf:"bytes,21,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         string                 `protobuf:"bytes,22,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	HolidayCalendarId string                 `protobuf:"bytes,23,opt,name=holiday_calendar_id,json=holidayCalendarId,proto3" json:"holiday_calendar_id,omitempty"` // 关联假期日历
+	OvertimePolicyId  string                 `protobuf:"bytes,24,opt,name=overtime_policy_id,json=overtimePolicyId,proto3" json:"overtime_policy_id,omitempty"`    // 关联加班政策
+	ClockLocationIds  []string               `protobuf:"bytes,25,rep,name=clock_location_ids,json=clockLocationIds,proto3" json:"clock_location_ids,omitempty"`    // 关联打卡地点
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *AttendanceRuleResponse) Reset() {
@@ -3417,6 +3420,27 @@ func (x *AttendanceRuleResponse) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *AttendanceRuleResponse) GetHolidayCalendarId() string {
+	if x != nil {
+		return x.HolidayCalendarId
+	}
+	return ""
+}
+
+func (x *AttendanceRuleResponse) GetOvertimePolicyId() string {
+	if x != nil {
+		return x.OvertimePolicyId
+	}
+	return ""
+}
+
+func (x *AttendanceRuleResponse) GetClockLocationIds() []string {
+	if x != nil {
+		return x.ClockLocationIds
+	}
+	return nil
+}
+
 type DeleteAttendanceRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -3840,7 +3864,7 @@ const file_api_hrm_v1_attendance_proto_rawDesc = "" +
 	"apply_type\x18\x02 \x01(\tR\tapplyType\x12\x1b\n" +
 	"\tis_active\x18\x03 \x01(\bR\bisActive\x12\x12\n" +
 	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\"\x98\x06\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\"\xa4\a\n" +
 	"\x16AttendanceRuleResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x12\n" +
@@ -3867,7 +3891,10 @@ const file_api_hrm_v1_attendance_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x15 \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x16 \x01(\tR\tupdatedAt\"8\n" +
+	"updated_at\x18\x16 \x01(\tR\tupdatedAt\x12.\n" +
+	"\x13holiday_calendar_id\x18\x17 \x01(\tR\x11holidayCalendarId\x12,\n" +
+	"\x12overtime_policy_id\x18\x18 \x01(\tR\x10overtimePolicyId\x12,\n" +
+	"\x12clock_location_ids\x18\x19 \x03(\tR\x10clockLocationIds\"8\n" +
 	"\x1cDeleteAttendanceRuleResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"m\n" +
 	"\x1bListAttendanceRulesResponse\x128\n" +
@@ -4561,6 +4561,10 @@ func (m *AttendanceRuleResponse) validate(all bool) error {
 
 	// no validation rules for UpdatedAt
 
+	// no validation rules for HolidayCalendarId
+
+	// no validation rules for OvertimePolicyId
+
 	if len(errors) > 0 {
 		return AttendanceRuleResponseMultiError(errors)
 	}
@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -1351,7 +1352,7 @@ type SubmitTripReportRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	BusinessTripId string                 `protobuf:"bytes,1,opt,name=business_trip_id,json=businessTripId,proto3" json:"business_trip_id,omitempty"`
 	Report         string                 `protobuf:"bytes,2,opt,name=report,proto3" json:"report,omitempty"`
-	ActualCost     float64                `protobuf:"fixed64,3,opt,name=actual_cost,json=actualCost,proto3" json:"actual_cost,omitempty"`
+	ActualCost     float64                `protobuf:"fixed64,3,opt,name=actual_cost,json=actualCost,proto3" json:"actual_cost,omitempty"` // 已废弃：实际费用由已审批的报销单汇总，该字段被忽略
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -1407,12 +1408,1309 @@ func (x *SubmitTripReportRequest) GetActualCost() float64 {
 	return 0
 }
 
+// 报销明细
+type TripExpenseResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId       string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	TripId         string                 `protobuf:"bytes,3,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	Category       string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Amount         float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency       string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	ExpenseDate    string                 `protobuf:"bytes,7,opt,name=expense_date,json=expenseDate,proto3" json:"expense_date,omitempty"` // YYYY-MM-DD
+	Nights         int32                  `protobuf:"varint,8,opt,name=nights,proto3" json:"nights,omitempty"`                             // 住宿晚数（住宿类必填）
+	Description    string                 `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
+	ReceiptFileIds []string               `protobuf:"bytes,10,rep,name=receipt_file_ids,json=receiptFileIds,proto3" json:"receipt_file_ids,omitempty"`
+	// 标准校验结果（每次明细变动后按适用标准重新计算）
+	PolicyCap        *float64               `protobuf:"fixed64,11,opt,name=policy_cap,json=policyCap,proto3,oneof" json:"policy_cap,omitempty"` // 适用限额（补助为当日限额，住宿为限额×晚数）
+	OverPolicy       bool                   `protobuf:"varint,12,opt,name=over_policy,json=overPolicy,proto3" json:"over_policy,omitempty"`
+	OverPolicyAmount float64                `protobuf:"fixed64,13,opt,name=over_policy_amount,json=overPolicyAmount,proto3" json:"over_policy_amount,omitempty"`
+	OverPolicyNote   string                 `protobuf:"bytes,14,opt,name=over_policy_note,json=overPolicyNote,proto3" json:"over_policy_note,omitempty"` // 超标说明（员工填写）
+	CreatedBy        string                 `protobuf:"bytes,15,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TripExpenseResponse) Reset() {
+	*x = TripExpenseResponse{}
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripExpenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripExpenseResponse) ProtoMessage() {}
+
+func (x *TripExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripExpenseResponse.ProtoReflect.Descriptor instead.
+func (*TripExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_business_trip_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TripExpenseResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TripExpenseResponse) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TripExpenseResponse) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *TripExpenseResponse) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *TripExpenseResponse) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TripExpenseResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TripExpenseResponse) GetExpenseDate() string {
+	if x != nil {
+		return x.ExpenseDate
+	}
+	return ""
+}
+
+func (x *TripExpenseResponse) GetNights() int32 {
+	if x != nil {
+		return x.Nights
+	}
+	return 0
+}
+
+func (x *TripExpenseResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TripExpenseResponse) GetReceiptFileIds() []string {
+	if x != nil {
+		return x.ReceiptFileIds
+	}
+	return nil
+}
+
+func (x *TripExpenseResponse) GetPolicyCap() float64 {
+	if x != nil && x.PolicyCap != nil {
+		return *x.PolicyCap
+	}
+	return 0
+}
+
+func (x *TripExpenseResponse) GetOverPolicy() bool {
+	if x != nil {
+		return x.OverPolicy
+	}
+	return false
+}
+
+func (x *TripExpenseResponse) GetOverPolicyAmount() float64 {
+	if x != nil {
+		return x.OverPolicyAmount
+	}
+	return 0
+}
+
+func (x *TripExpenseResponse) GetOverPolicyNote() string {
+	if x != nil {
+		return x.OverPolicyNote
+	}
+	return ""
+}
+
+func (x *TripExpenseResponse) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *TripExpenseResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TripExpenseResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// 出差报销单（每次出差一张）
+type TripExpenseClaimResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TripId     string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	TenantId   string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	EmployeeId string                 `protobuf:"bytes,3,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	Status     string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // draft, pending, approved, rejected
+	// 汇总（提交时计算）
+	Currency         string  `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	TotalAmount      float64 `protobuf:"fixed64,6,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	OverPolicyAmount float64 `protobuf:"fixed64,7,opt,name=over_policy_amount,json=overPolicyAmount,proto3" json:"over_policy_amount,omitempty"`
+	OverPolicyCount  int32   `protobuf:"varint,8,opt,name=over_policy_count,json=overPolicyCount,proto3" json:"over_policy_count,omitempty"`
+	// 适用标准（提交时快照）
+	PolicyId        string `protobuf:"bytes,9,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	DestinationTier string `protobuf:"bytes,10,opt,name=destination_tier,json=destinationTier,proto3" json:"destination_tier,omitempty"`
+	// 审批
+	ApprovalInstanceId string                 `protobuf:"bytes,11,opt,name=approval_instance_id,json=approvalInstanceId,proto3" json:"approval_instance_id,omitempty"`
+	SubmittedBy        string                 `protobuf:"bytes,12,opt,name=submitted_by,json=submittedBy,proto3" json:"submitted_by,omitempty"`
+	SubmittedAt        *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	DecidedAt          *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	Items              []*TripExpenseResponse `protobuf:"bytes,15,rep,name=items,proto3" json:"items,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TripExpenseClaimResponse) Reset() {
+	*x = TripExpenseClaimResponse{}
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripExpenseClaimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripExpenseClaimResponse) ProtoMessage() {}
+
+func (x *TripExpenseClaimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripExpenseClaimResponse.ProtoReflect.Descriptor instead.
+func (*TripExpenseClaimResponse) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_business_trip_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TripExpenseClaimResponse) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *TripExpenseClaimResponse) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TripExpenseClaimResponse) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *TripExpenseClaimResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TripExpenseClaimResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TripExpenseClaimResponse) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *TripExpenseClaimResponse) GetOverPolicyAmount() float64 {
+	if x != nil {
+		return x.OverPolicyAmount
+	}
+	return 0
+}
+
+func (x *TripExpenseClaimResponse) GetOverPolicyCount() int32 {
+	if x != nil {
+		return x.OverPolicyCount
+	}
+	return 0
+}
+
+func (x *TripExpenseClaimResponse) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
+}
+
+func (x *TripExpenseClaimResponse) GetDestinationTier() string {
+	if x != nil {
+		return x.DestinationTier
+	}
+	return ""
+}
+
+func (x *TripExpenseClaimResponse) GetApprovalInstanceId() string {
+	if x != nil {
+		return x.ApprovalInstanceId
+	}
+	return ""
+}
+
+func (x *TripExpenseClaimResponse) GetSubmittedBy() string {
+	if x != nil {
+		return x.SubmittedBy
+	}
+	return ""
+}
+
+func (x *TripExpenseClaimResponse) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+func (x *TripExpenseClaimResponse) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+func (x *TripExpenseClaimResponse) GetItems() []*TripExpenseResponse {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *TripExpenseClaimResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TripExpenseClaimResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// 差旅标准：按目的地级别和职级设置每日补助、住宿上限
+type TripExpensePolicyResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId         string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	DestinationTier  string                 `protobuf:"bytes,4,opt,name=destination_tier,json=destinationTier,proto3" json:"destination_tier,omitempty"`
+	MinPositionLevel int32                  `protobuf:"varint,5,opt,name=min_position_level,json=minPositionLevel,proto3" json:"min_position_level,omitempty"`    // 0 表示不限
+	MaxPositionLevel int32                  `protobuf:"varint,6,opt,name=max_position_level,json=maxPositionLevel,proto3" json:"max_position_level,omitempty"`    // 0 表示不限
+	PerDiemCap       float64                `protobuf:"fixed64,7,opt,name=per_diem_cap,json=perDiemCap,proto3" json:"per_diem_cap,omitempty"`                     // 每日餐饮+补助上限（0 表示不限）
+	HotelCapPerNight float64                `protobuf:"fixed64,8,opt,name=hotel_cap_per_night,json=hotelCapPerNight,proto3" json:"hotel_cap_per_night,omitempty"` // 每晚住宿上限（0 表示不限）
+	Currency         string                 `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
+	IsActive         bool                   `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedBy        string                 `protobuf:"bytes,11,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy        string                 `protobuf:"bytes,12,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TripExpensePolicyResponse) Reset() {
+	*x = TripExpensePolicyResponse{}
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripExpensePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripExpensePolicyResponse) ProtoMessage() {}
+
+func (x *TripExpensePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripExpensePolicyResponse.ProtoReflect.Descriptor instead.
+func (*TripExpensePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_business_trip_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TripExpensePolicyResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TripExpensePolicyResponse) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TripExpensePolicyResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TripExpensePolicyResponse) GetDestinationTier() string {
+	if x != nil {
+		return x.DestinationTier
+	}
+	return ""
+}
+
+func (x *TripExpensePolicyResponse) GetMinPositionLevel() int32 {
+	if x != nil {
+		return x.MinPositionLevel
+	}
+	return 0
+}
+
+func (x *TripExpensePolicyResponse) GetMaxPositionLevel() int32 {
+	if x != nil {
+		return x.MaxPositionLevel
+	}
+	return 0
+}
+
+func (x *TripExpensePolicyResponse) GetPerDiemCap() float64 {
+	if x != nil {
+		return x.PerDiemCap
+	}
+	return 0
+}
+
+func (x *TripExpensePolicyResponse) GetHotelCapPerNight() float64 {
+	if x != nil {
+		return x.HotelCapPerNight
+	}
+	return 0
+}
+
+func (x *TripExpensePolicyResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TripExpensePolicyResponse) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *TripExpensePolicyResponse) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *TripExpensePolicyResponse) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *TripExpensePolicyResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TripExpensePolicyResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// 目的地级别（出差目的地包含关键字即匹配）
+type TripDestinationTier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Destination   string                 `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	Tier          string                 `protobuf:"bytes,3,opt,name=tier,proto3" json:"tier,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripDestinationTier) Reset() {
+	*x = TripDestinationTier{}
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripDestinationTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripDestinationTier) ProtoMessage() {}
+
+func (x *TripDestinationTier) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripDestinationTier.ProtoReflect.Descriptor instead.
+func (*TripDestinationTier) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_business_trip_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TripDestinationTier) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TripDestinationTier) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *TripDestinationTier) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *TripDestinationTier) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// 新增/修改报销明细（金额币种默认 CNY）
+type TripExpenseRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TripId         string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	Id             string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Category       string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Amount         float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency       string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	ExpenseDate    string                 `protobuf:"bytes,6,opt,name=expense_date,json=expenseDate,proto3" json:"expense_date,omitempty"` // YYYY-MM-DD
+	Nights         int32                  `protobuf:"varint,7,opt,name=nights,proto3" json:"nights,omitempty"`
+	Description    string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	OverPolicyNote string                 `protobuf:"bytes,9,opt,name=over_policy_note,json=overPolicyNote,proto3" json:"over_policy_note,omitempty"`
+	ReceiptFileIds []string               `protobuf:"bytes,10,rep,name=receipt_file_ids,json=receiptFileIds,proto3" json:"receipt_file_ids,omitempty"` // 仅新增时使用，文件需先通过文件模块上传
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TripExpenseRequest) Reset() {
+	*x = TripExpenseRequest{}
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripExpenseRequest) ProtoMessage() {}
+
+func (x *TripExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripExpenseRequest.ProtoReflect.Descriptor instead.
+func (*TripExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_business_trip_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TripExpenseRequest) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *TripExpenseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TripExpenseRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *TripExpenseRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TripExpenseRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TripExpenseRequest) GetExpenseDate() string {
+	if x != nil {
+		return x.ExpenseDate
+	}
+	return ""
+}
+
+func (x *TripExpenseRequest) GetNights() int32 {
+	if x != nil {
+		return x.Nights
+	}
+	return 0
+}
+
+func (x *TripExpenseRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TripExpenseRequest) GetOverPolicyNote() string {
+	if x != nil {
+		return x.OverPolicyNote
+	}
+	return ""
+}
+
+func (x *TripExpenseRequest) GetReceiptFileIds() []string {
+	if x != nil {
+		return x.ReceiptFileIds
+	}
+	return nil
+}
+
+type DeleteTripExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTripExpenseRequest) Reset() {
+	*x = DeleteTripExpenseRequest{}
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTripExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTripExpenseRequest) ProtoMessage() {}
+
+func (x *DeleteTripExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTripExpenseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTripExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_business_trip_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteTripExpenseRequest) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *DeleteTripExpenseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// 关联/取消关联票据
+type TripExpenseReceiptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	FileId        string                 `protobuf:"bytes,3,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripExpenseReceiptRequest) Reset() {
+	*x = TripExpenseReceiptRequest{}
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripExpenseReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripExpenseReceiptRequest) ProtoMessage() {}
+
+func (x *TripExpenseReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripExpenseReceiptRequest.ProtoReflect.Descriptor instead.
+func (*TripExpenseReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_business_trip_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TripExpenseReceiptRequest) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *TripExpenseReceiptRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TripExpenseReceiptRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+type TripIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripIDRequest) Reset() {
+	*x = TripIDRequest{}
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripIDRequest) ProtoMessage() {}
+
+func (x *TripIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripIDRequest.ProtoReflect.Descriptor instead.
+func (*TripIDRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_business_trip_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TripIDRequest) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+// 出差适用的差旅标准（policy 为空表示无标准，不做超标校验）
+type ResolveTripExpensePolicyResponse struct {
+	state           protoimpl.MessageState     `protogen:"open.v1"`
+	DestinationTier string                     `protobuf:"bytes,1,opt,name=destination_tier,json=destinationTier,proto3" json:"destination_tier,omitempty"`
+	Policy          *TripExpensePolicyResponse `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ResolveTripExpensePolicyResponse) Reset() {
+	*x = ResolveTripExpensePolicyResponse{}
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveTripExpensePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveTripExpensePolicyResponse) ProtoMessage() {}
+
+func (x *ResolveTripExpensePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveTripExpensePolicyResponse.ProtoReflect.Descriptor instead.
+func (*ResolveTripExpensePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_business_trip_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ResolveTripExpensePolicyResponse) GetDestinationTier() string {
+	if x != nil {
+		return x.DestinationTier
+	}
+	return ""
+}
+
+func (x *ResolveTripExpensePolicyResponse) GetPolicy() *TripExpensePolicyResponse {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// 创建/更新差旅标准
+type TripExpensePolicyRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DestinationTier  string                 `protobuf:"bytes,3,opt,name=destination_tier,json=destinationTier,proto3" json:"destination_tier,omitempty"`
+	MinPositionLevel int32                  `protobuf:"varint,4,opt,name=min_position_level,json=minPositionLevel,proto3" json:"min_position_level,omitempty"`
+	MaxPositionLevel int32                  `protobuf:"varint,5,opt,name=max_position_level,json=maxPositionLevel,proto3" json:"max_position_level,omitempty"`
+	PerDiemCap       float64                `protobuf:"fixed64,6,opt,name=per_diem_cap,json=perDiemCap,proto3" json:"per_diem_cap,omitempty"`
+	HotelCapPerNight float64                `protobuf:"fixed64,7,opt,name=hotel_cap_per_night,json=hotelCapPerNight,proto3" json:"hotel_cap_per_night,omitempty"`
+	Currency         string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	IsActive         *bool                  `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TripExpensePolicyRequest) Reset() {
+	*x = TripExpensePolicyRequest{}
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripExpensePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripExpensePolicyRequest) ProtoMessage() {}
+
+func (x *TripExpensePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripExpensePolicyRequest.ProtoReflect.Descriptor instead.
+func (*TripExpensePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_business_trip_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TripExpensePolicyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TripExpensePolicyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TripExpensePolicyRequest) GetDestinationTier() string {
+	if x != nil {
+		return x.DestinationTier
+	}
+	return ""
+}
+
+func (x *TripExpensePolicyRequest) GetMinPositionLevel() int32 {
+	if x != nil {
+		return x.MinPositionLevel
+	}
+	return 0
+}
+
+func (x *TripExpensePolicyRequest) GetMaxPositionLevel() int32 {
+	if x != nil {
+		return x.MaxPositionLevel
+	}
+	return 0
+}
+
+func (x *TripExpensePolicyRequest) GetPerDiemCap() float64 {
+	if x != nil {
+		return x.PerDiemCap
+	}
+	return 0
+}
+
+func (x *TripExpensePolicyRequest) GetHotelCapPerNight() float64 {
+	if x != nil {
+		return x.HotelCapPerNight
+	}
+	return 0
+}
+
+func (x *TripExpensePolicyRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TripExpensePolicyRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+type GetTripExpensePolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTripExpensePolicyRequest) Reset() {
+	*x = GetTripExpensePolicyRequest{}
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTripExpensePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTripExpensePolicyRequest) ProtoMessage() {}
+
+func (x *GetTripExpensePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTripExpensePolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetTripExpensePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_business_trip_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetTripExpensePolicyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteTripExpensePolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTripExpensePolicyRequest) Reset() {
+	*x = DeleteTripExpensePolicyRequest{}
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTripExpensePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTripExpensePolicyRequest) ProtoMessage() {}
+
+func (x *DeleteTripExpensePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTripExpensePolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTripExpensePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_business_trip_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteTripExpensePolicyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListTripExpensePoliciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTripExpensePoliciesRequest) Reset() {
+	*x = ListTripExpensePoliciesRequest{}
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTripExpensePoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTripExpensePoliciesRequest) ProtoMessage() {}
+
+func (x *ListTripExpensePoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTripExpensePoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListTripExpensePoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_business_trip_proto_rawDescGZIP(), []int{29}
+}
+
+type ListTripExpensePoliciesResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Items         []*TripExpensePolicyResponse `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTripExpensePoliciesResponse) Reset() {
+	*x = ListTripExpensePoliciesResponse{}
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTripExpensePoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTripExpensePoliciesResponse) ProtoMessage() {}
+
+func (x *ListTripExpensePoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTripExpensePoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListTripExpensePoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_business_trip_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListTripExpensePoliciesResponse) GetItems() []*TripExpensePolicyResponse {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ListTripDestinationTiersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTripDestinationTiersRequest) Reset() {
+	*x = ListTripDestinationTiersRequest{}
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTripDestinationTiersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTripDestinationTiersRequest) ProtoMessage() {}
+
+func (x *ListTripDestinationTiersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTripDestinationTiersRequest.ProtoReflect.Descriptor instead.
+func (*ListTripDestinationTiersRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_business_trip_proto_rawDescGZIP(), []int{31}
+}
+
+// 整体替换目的地级别
+type SetTripDestinationTiersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tiers         []*TripDestinationTier `protobuf:"bytes,1,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTripDestinationTiersRequest) Reset() {
+	*x = SetTripDestinationTiersRequest{}
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTripDestinationTiersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTripDestinationTiersRequest) ProtoMessage() {}
+
+func (x *SetTripDestinationTiersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTripDestinationTiersRequest.ProtoReflect.Descriptor instead.
+func (*SetTripDestinationTiersRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_business_trip_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetTripDestinationTiersRequest) GetTiers() []*TripDestinationTier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+type TripDestinationTiersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*TripDestinationTier `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripDestinationTiersResponse) Reset() {
+	*x = TripDestinationTiersResponse{}
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripDestinationTiersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripDestinationTiersResponse) ProtoMessage() {}
+
+func (x *TripDestinationTiersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_business_trip_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripDestinationTiersResponse.ProtoReflect.Descriptor instead.
+func (*TripDestinationTiersResponse) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_business_trip_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *TripDestinationTiersResponse) GetItems() []*TripDestinationTier {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
 var File_api_hrm_v1_business_trip_proto protoreflect.FileDescriptor
 
 const file_api_hrm_v1_business_trip_proto_rawDesc = "" +
 	"\n" +
 	"\x1eapi/hrm/v1/business_trip.proto\x12\n" +
-	"api.hrm.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcf\a\n" +
+	"api.hrm.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcf\a\n" +
 	"\x14BusinessTripResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x1f\n" +
@@ -1552,7 +2850,131 @@ const file_api_hrm_v1_business_trip_proto_rawDesc = "" +
 	"\x10business_trip_id\x18\x01 \x01(\tR\x0ebusinessTripId\x12\x16\n" +
 	"\x06report\x18\x02 \x01(\tR\x06report\x12\x1f\n" +
 	"\vactual_cost\x18\x03 \x01(\x01R\n" +
-	"actualCost2\xa3\r\n" +
+	"actualCost\"\xf3\x04\n" +
+	"\x13TripExpenseResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x17\n" +
+	"\atrip_id\x18\x03 \x01(\tR\x06tripId\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12!\n" +
+	"\fexpense_date\x18\a \x01(\tR\vexpenseDate\x12\x16\n" +
+	"\x06nights\x18\b \x01(\x05R\x06nights\x12 \n" +
+	"\vdescription\x18\t \x01(\tR\vdescription\x12(\n" +
+	"\x10receipt_file_ids\x18\n" +
+	" \x03(\tR\x0ereceiptFileIds\x12\"\n" +
+	"\n" +
+	"policy_cap\x18\v \x01(\x01H\x00R\tpolicyCap\x88\x01\x01\x12\x1f\n" +
+	"\vover_policy\x18\f \x01(\bR\n" +
+	"overPolicy\x12,\n" +
+	"\x12over_policy_amount\x18\r \x01(\x01R\x10overPolicyAmount\x12(\n" +
+	"\x10over_policy_note\x18\x0e \x01(\tR\x0eoverPolicyNote\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x0f \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\r\n" +
+	"\v_policy_cap\"\xe6\x05\n" +
+	"\x18TripExpenseClaimResponse\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\tR\x06tripId\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x1f\n" +
+	"\vemployee_id\x18\x03 \x01(\tR\n" +
+	"employeeId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12!\n" +
+	"\ftotal_amount\x18\x06 \x01(\x01R\vtotalAmount\x12,\n" +
+	"\x12over_policy_amount\x18\a \x01(\x01R\x10overPolicyAmount\x12*\n" +
+	"\x11over_policy_count\x18\b \x01(\x05R\x0foverPolicyCount\x12\x1b\n" +
+	"\tpolicy_id\x18\t \x01(\tR\bpolicyId\x12)\n" +
+	"\x10destination_tier\x18\n" +
+	" \x01(\tR\x0fdestinationTier\x120\n" +
+	"\x14approval_instance_id\x18\v \x01(\tR\x12approvalInstanceId\x12!\n" +
+	"\fsubmitted_by\x18\f \x01(\tR\vsubmittedBy\x12=\n" +
+	"\fsubmitted_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\x129\n" +
+	"\n" +
+	"decided_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tdecidedAt\x125\n" +
+	"\x05items\x18\x0f \x03(\v2\x1f.api.hrm.v1.TripExpenseResponseR\x05items\x129\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa1\x04\n" +
+	"\x19TripExpensePolicyResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12)\n" +
+	"\x10destination_tier\x18\x04 \x01(\tR\x0fdestinationTier\x12,\n" +
+	"\x12min_position_level\x18\x05 \x01(\x05R\x10minPositionLevel\x12,\n" +
+	"\x12max_position_level\x18\x06 \x01(\x05R\x10maxPositionLevel\x12 \n" +
+	"\fper_diem_cap\x18\a \x01(\x01R\n" +
+	"perDiemCap\x12-\n" +
+	"\x13hotel_cap_per_night\x18\b \x01(\x01R\x10hotelCapPerNight\x12\x1a\n" +
+	"\bcurrency\x18\t \x01(\tR\bcurrency\x12\x1b\n" +
+	"\tis_active\x18\n" +
+	" \x01(\bR\bisActive\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\v \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\f \x01(\tR\tupdatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x96\x01\n" +
+	"\x13TripDestinationTier\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\vdestination\x18\x02 \x01(\tR\vdestination\x12\x12\n" +
+	"\x04tier\x18\x03 \x01(\tR\x04tier\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xbe\x02\n" +
+	"\x12TripExpenseRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\tR\x06tripId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12!\n" +
+	"\fexpense_date\x18\x06 \x01(\tR\vexpenseDate\x12\x16\n" +
+	"\x06nights\x18\a \x01(\x05R\x06nights\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x12(\n" +
+	"\x10over_policy_note\x18\t \x01(\tR\x0eoverPolicyNote\x12(\n" +
+	"\x10receipt_file_ids\x18\n" +
+	" \x03(\tR\x0ereceiptFileIds\"C\n" +
+	"\x18DeleteTripExpenseRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\tR\x06tripId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"]\n" +
+	"\x19TripExpenseReceiptRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\tR\x06tripId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x17\n" +
+	"\afile_id\x18\x03 \x01(\tR\x06fileId\"(\n" +
+	"\rTripIDRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\tR\x06tripId\"\x8c\x01\n" +
+	" ResolveTripExpensePolicyResponse\x12)\n" +
+	"\x10destination_tier\x18\x01 \x01(\tR\x0fdestinationTier\x12=\n" +
+	"\x06policy\x18\x02 \x01(\v2%.api.hrm.v1.TripExpensePolicyResponseR\x06policy\"\xe2\x02\n" +
+	"\x18TripExpensePolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12)\n" +
+	"\x10destination_tier\x18\x03 \x01(\tR\x0fdestinationTier\x12,\n" +
+	"\x12min_position_level\x18\x04 \x01(\x05R\x10minPositionLevel\x12,\n" +
+	"\x12max_position_level\x18\x05 \x01(\x05R\x10maxPositionLevel\x12 \n" +
+	"\fper_diem_cap\x18\x06 \x01(\x01R\n" +
+	"perDiemCap\x12-\n" +
+	"\x13hotel_cap_per_night\x18\a \x01(\x01R\x10hotelCapPerNight\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12 \n" +
+	"\tis_active\x18\t \x01(\bH\x00R\bisActive\x88\x01\x01B\f\n" +
+	"\n" +
+	"_is_active\"-\n" +
+	"\x1bGetTripExpensePolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"0\n" +
+	"\x1eDeleteTripExpensePolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\" \n" +
+	"\x1eListTripExpensePoliciesRequest\"^\n" +
+	"\x1fListTripExpensePoliciesResponse\x12;\n" +
+	"\x05items\x18\x01 \x03(\v2%.api.hrm.v1.TripExpensePolicyResponseR\x05items\"!\n" +
+	"\x1fListTripDestinationTiersRequest\"W\n" +
+	"\x1eSetTripDestinationTiersRequest\x125\n" +
+	"\x05tiers\x18\x01 \x03(\v2\x1f.api.hrm.v1.TripDestinationTierR\x05tiers\"U\n" +
+	"\x1cTripDestinationTiersResponse\x125\n" +
+	"\x05items\x18\x01 \x03(\v2\x1f.api.hrm.v1.TripDestinationTierR\x05items2\xa3\r\n" +
 	"\x13BusinessTripService\x12\x84\x01\n" +
 	"\x12CreateBusinessTrip\x12%.api.hrm.v1.CreateBusinessTripRequest\x1a .api.hrm.v1.BusinessTripResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/hrm/business-trips\x12\x89\x01\n" +
 	"\x12UpdateBusinessTrip\x12%.api.hrm.v1.UpdateBusinessTripRequest\x1a .api.hrm.v1.BusinessTripResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\x1a\x1f/api/v1/hrm/business-trips/{id}\x12\x8c\x01\n" +
@@ -1564,7 +2986,23 @@ const file_api_hrm_v1_business_trip_proto_rawDesc = "" +
 	"\x12SubmitBusinessTrip\x12%.api.hrm.v1.SubmitBusinessTripRequest\x1a&.api.hrm.v1.SubmitBusinessTripResponse\"?\x82\xd3\xe4\x93\x029:\x01*\"4/api/v1/hrm/business-trips/{business_trip_id}/submit\x12\xa8\x01\n" +
 	"\x13ApproveBusinessTrip\x12&.api.hrm.v1.ApproveBusinessTripRequest\x1a'.api.hrm.v1.ApproveBusinessTripResponse\"@\x82\xd3\xe4\x93\x02::\x01*\"5/api/v1/hrm/business-trips/{business_trip_id}/approve\x12\xa4\x01\n" +
 	"\x12RejectBusinessTrip\x12%.api.hrm.v1.RejectBusinessTripRequest\x1a&.api.hrm.v1.RejectBusinessTripResponse\"?\x82\xd3\xe4\x93\x029:\x01*\"4/api/v1/hrm/business-trips/{business_trip_id}/reject\x12\x9a\x01\n" +
-	"\x10SubmitTripReport\x12#.api.hrm.v1.SubmitTripReportRequest\x1a .api.hrm.v1.BusinessTripResponse\"?\x82\xd3\xe4\x93\x029:\x01*\"4/api/v1/hrm/business-trips/{business_trip_id}/reportB\xa3\x01\n" +
+	"\x10SubmitTripReport\x12#.api.hrm.v1.SubmitTripReportRequest\x1a .api.hrm.v1.BusinessTripResponse\"?\x82\xd3\xe4\x93\x029:\x01*\"4/api/v1/hrm/business-trips/{business_trip_id}/report2\xae\x12\n" +
+	"\x12TripExpenseService\x12\x8b\x01\n" +
+	"\x0eAddTripExpense\x12\x1e.api.hrm.v1.TripExpenseRequest\x1a\x1f.api.hrm.v1.TripExpenseResponse\"8\x82\xd3\xe4\x93\x022:\x01*\"-/api/v1/hrm/business-trips/{trip_id}/expenses\x12\x93\x01\n" +
+	"\x11UpdateTripExpense\x12\x1e.api.hrm.v1.TripExpenseRequest\x1a\x1f.api.hrm.v1.TripExpenseResponse\"=\x82\xd3\xe4\x93\x027:\x01*\x1a2/api/v1/hrm/business-trips/{trip_id}/expenses/{id}\x12\x8d\x01\n" +
+	"\x11DeleteTripExpense\x12$.api.hrm.v1.DeleteTripExpenseRequest\x1a\x16.google.protobuf.Empty\":\x82\xd3\xe4\x93\x024*2/api/v1/hrm/business-trips/{trip_id}/expenses/{id}\x12\xa1\x01\n" +
+	"\x18AttachTripExpenseReceipt\x12%.api.hrm.v1.TripExpenseReceiptRequest\x1a\x16.google.protobuf.Empty\"F\x82\xd3\xe4\x93\x02@:\x01*\";/api/v1/hrm/business-trips/{trip_id}/expenses/{id}/receipts\x12\xa8\x01\n" +
+	"\x18DetachTripExpenseReceipt\x12%.api.hrm.v1.TripExpenseReceiptRequest\x1a\x16.google.protobuf.Empty\"M\x82\xd3\xe4\x93\x02G*E/api/v1/hrm/business-trips/{trip_id}/expenses/{id}/receipts/{file_id}\x12\x92\x01\n" +
+	"\x13GetTripExpenseClaim\x12\x19.api.hrm.v1.TripIDRequest\x1a$.api.hrm.v1.TripExpenseClaimResponse\":\x82\xd3\xe4\x93\x024\x122/api/v1/hrm/business-trips/{trip_id}/expense-claim\x12\x9f\x01\n" +
+	"\x16SubmitTripExpenseClaim\x12\x19.api.hrm.v1.TripIDRequest\x1a$.api.hrm.v1.TripExpenseClaimResponse\"D\x82\xd3\xe4\x93\x02>:\x01*\"9/api/v1/hrm/business-trips/{trip_id}/expense-claim/submit\x12\xa0\x01\n" +
+	"\x18ResolveTripExpensePolicy\x12\x19.api.hrm.v1.TripIDRequest\x1a,.api.hrm.v1.ResolveTripExpensePolicyResponse\";\x82\xd3\xe4\x93\x025\x123/api/v1/hrm/business-trips/{trip_id}/expense-policy\x12\x94\x01\n" +
+	"\x17CreateTripExpensePolicy\x12$.api.hrm.v1.TripExpensePolicyRequest\x1a%.api.hrm.v1.TripExpensePolicyResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/hrm/trip-expense-policies\x12\x9d\x01\n" +
+	"\x17ListTripExpensePolicies\x12*.api.hrm.v1.ListTripExpensePoliciesRequest\x1a+.api.hrm.v1.ListTripExpensePoliciesResponse\")\x82\xd3\xe4\x93\x02#\x12!/api/v1/hrm/trip-expense-policies\x12\x96\x01\n" +
+	"\x14GetTripExpensePolicy\x12'.api.hrm.v1.GetTripExpensePolicyRequest\x1a%.api.hrm.v1.TripExpensePolicyResponse\".\x82\xd3\xe4\x93\x02(\x12&/api/v1/hrm/trip-expense-policies/{id}\x12\x99\x01\n" +
+	"\x17UpdateTripExpensePolicy\x12$.api.hrm.v1.TripExpensePolicyRequest\x1a%.api.hrm.v1.TripExpensePolicyResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\x1a&/api/v1/hrm/trip-expense-policies/{id}\x12\x8d\x01\n" +
+	"\x17DeleteTripExpensePolicy\x12*.api.hrm.v1.DeleteTripExpensePolicyRequest\x1a\x16.google.protobuf.Empty\".\x82\xd3\xe4\x93\x02(*&/api/v1/hrm/trip-expense-policies/{id}\x12\x9d\x01\n" +
+	"\x18ListTripDestinationTiers\x12+.api.hrm.v1.ListTripDestinationTiersRequest\x1a(.api.hrm.v1.TripDestinationTiersResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/hrm/trip-destination-tiers\x12\x9e\x01\n" +
+	"\x17SetTripDestinationTiers\x12*.api.hrm.v1.SetTripDestinationTiersRequest\x1a(.api.hrm.v1.TripDestinationTiersResponse\"-\x82\xd3\xe4\x93\x02':\x01*\x1a\"/api/v1/hrm/trip-destination-tiersB\xa3\x01\n" +
 	"\x0ecom.api.hrm.v1B\x11BusinessTripProtoP\x01Z4github.com/lk2023060901/go-next-erp/api/hrm/v1;hrmv1\xa2\x02\x03AHX\xaa\x02\n" +
 	"Api.Hrm.V1\xca\x02\n" +
 	"Api\\Hrm\\V1\xe2\x02\x16Api\\Hrm\\V1\\GPBMetadata\xea\x02\fApi::Hrm::V1b\x06proto3"
@@ -1581,7 +3019,7 @@ func file_api_hrm_v1_business_trip_proto_rawDescGZIP() []byte {
 	return file_api_hrm_v1_business_trip_proto_rawDescData
 }
 
-var file_api_hrm_v1_business_trip_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_api_hrm_v1_business_trip_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_api_hrm_v1_business_trip_proto_goTypes = []any{
 	(*BusinessTripResponse)(nil),             // 0: api.hrm.v1.BusinessTripResponse
 	(*CreateBusinessTripRequest)(nil),        // 1: api.hrm.v1.CreateBusinessTripRequest
@@ -1600,51 +3038,113 @@ var file_api_hrm_v1_business_trip_proto_goTypes = []any{
 	(*RejectBusinessTripRequest)(nil),        // 14: api.hrm.v1.RejectBusinessTripRequest
 	(*RejectBusinessTripResponse)(nil),       // 15: api.hrm.v1.RejectBusinessTripResponse
 	(*SubmitTripReportRequest)(nil),          // 16: api.hrm.v1.SubmitTripReportRequest
-	(*timestamppb.Timestamp)(nil),            // 17: google.protobuf.Timestamp
+	(*TripExpenseResponse)(nil),              // 17: api.hrm.v1.TripExpenseResponse
+	(*TripExpenseClaimResponse)(nil),         // 18: api.hrm.v1.TripExpenseClaimResponse
+	(*TripExpensePolicyResponse)(nil),        // 19: api.hrm.v1.TripExpensePolicyResponse
+	(*TripDestinationTier)(nil),              // 20: api.hrm.v1.TripDestinationTier
+	(*TripExpenseRequest)(nil),               // 21: api.hrm.v1.TripExpenseRequest
+	(*DeleteTripExpenseRequest)(nil),         // 22: api.hrm.v1.DeleteTripExpenseRequest
+	(*TripExpenseReceiptRequest)(nil),        // 23: api.hrm.v1.TripExpenseReceiptRequest
+	(*TripIDRequest)(nil),                    // 24: api.hrm.v1.TripIDRequest
+	(*ResolveTripExpensePolicyResponse)(nil), // 25: api.hrm.v1.ResolveTripExpensePolicyResponse
+	(*TripExpensePolicyRequest)(nil),         // 26: api.hrm.v1.TripExpensePolicyRequest
+	(*GetTripExpensePolicyRequest)(nil),      // 27: api.hrm.v1.GetTripExpensePolicyRequest
+	(*DeleteTripExpensePolicyRequest)(nil),   // 28: api.hrm.v1.DeleteTripExpensePolicyRequest
+	(*ListTripExpensePoliciesRequest)(nil),   // 29: api.hrm.v1.ListTripExpensePoliciesRequest
+	(*ListTripExpensePoliciesResponse)(nil),  // 30: api.hrm.v1.ListTripExpensePoliciesResponse
+	(*ListTripDestinationTiersRequest)(nil),  // 31: api.hrm.v1.ListTripDestinationTiersRequest
+	(*SetTripDestinationTiersRequest)(nil),   // 32: api.hrm.v1.SetTripDestinationTiersRequest
+	(*TripDestinationTiersResponse)(nil),     // 33: api.hrm.v1.TripDestinationTiersResponse
+	(*timestamppb.Timestamp)(nil),            // 34: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                    // 35: google.protobuf.Empty
 }
 var file_api_hrm_v1_business_trip_proto_depIdxs = []int32{
-	17, // 0: api.hrm.v1.BusinessTripResponse.start_time:type_name -> google.protobuf.Timestamp
-	17, // 1: api.hrm.v1.BusinessTripResponse.end_time:type_name -> google.protobuf.Timestamp
-	17, // 2: api.hrm.v1.BusinessTripResponse.approved_at:type_name -> google.protobuf.Timestamp
-	17, // 3: api.hrm.v1.BusinessTripResponse.report_at:type_name -> google.protobuf.Timestamp
-	17, // 4: api.hrm.v1.BusinessTripResponse.created_at:type_name -> google.protobuf.Timestamp
-	17, // 5: api.hrm.v1.BusinessTripResponse.updated_at:type_name -> google.protobuf.Timestamp
-	17, // 6: api.hrm.v1.CreateBusinessTripRequest.start_time:type_name -> google.protobuf.Timestamp
-	17, // 7: api.hrm.v1.CreateBusinessTripRequest.end_time:type_name -> google.protobuf.Timestamp
-	17, // 8: api.hrm.v1.UpdateBusinessTripRequest.start_time:type_name -> google.protobuf.Timestamp
-	17, // 9: api.hrm.v1.UpdateBusinessTripRequest.end_time:type_name -> google.protobuf.Timestamp
-	17, // 10: api.hrm.v1.ListBusinessTripsRequest.start_date:type_name -> google.protobuf.Timestamp
-	17, // 11: api.hrm.v1.ListBusinessTripsRequest.end_date:type_name -> google.protobuf.Timestamp
+	34, // 0: api.hrm.v1.BusinessTripResponse.start_time:type_name -> google.protobuf.Timestamp
+	34, // 1: api.hrm.v1.BusinessTripResponse.end_time:type_name -> google.protobuf.Timestamp
+	34, // 2: api.hrm.v1.BusinessTripResponse.approved_at:type_name -> google.protobuf.Timestamp
+	34, // 3: api.hrm.v1.BusinessTripResponse.report_at:type_name -> google.protobuf.Timestamp
+	34, // 4: api.hrm.v1.BusinessTripResponse.created_at:type_name -> google.protobuf.Timestamp
+	34, // 5: api.hrm.v1.BusinessTripResponse.updated_at:type_name -> google.protobuf.Timestamp
+	34, // 6: api.hrm.v1.CreateBusinessTripRequest.start_time:type_name -> google.protobuf.Timestamp
+	34, // 7: api.hrm.v1.CreateBusinessTripRequest.end_time:type_name -> google.protobuf.Timestamp
+	34, // 8: api.hrm.v1.UpdateBusinessTripRequest.start_time:type_name -> google.protobuf.Timestamp
+	34, // 9: api.hrm.v1.UpdateBusinessTripRequest.end_time:type_name -> google.protobuf.Timestamp
+	34, // 10: api.hrm.v1.ListBusinessTripsRequest.start_date:type_name -> google.protobuf.Timestamp
+	34, // 11: api.hrm.v1.ListBusinessTripsRequest.end_date:type_name -> google.protobuf.Timestamp
 	0,  // 12: api.hrm.v1.ListBusinessTripsResponse.items:type_name -> api.hrm.v1.BusinessTripResponse
-	17, // 13: api.hrm.v1.ListEmployeeBusinessTripsRequest.start_date:type_name -> google.protobuf.Timestamp
-	17, // 14: api.hrm.v1.ListEmployeeBusinessTripsRequest.end_date:type_name -> google.protobuf.Timestamp
-	1,  // 15: api.hrm.v1.BusinessTripService.CreateBusinessTrip:input_type -> api.hrm.v1.CreateBusinessTripRequest
-	2,  // 16: api.hrm.v1.BusinessTripService.UpdateBusinessTrip:input_type -> api.hrm.v1.UpdateBusinessTripRequest
-	3,  // 17: api.hrm.v1.BusinessTripService.DeleteBusinessTrip:input_type -> api.hrm.v1.DeleteBusinessTripRequest
-	5,  // 18: api.hrm.v1.BusinessTripService.GetBusinessTrip:input_type -> api.hrm.v1.GetBusinessTripRequest
-	6,  // 19: api.hrm.v1.BusinessTripService.ListBusinessTrips:input_type -> api.hrm.v1.ListBusinessTripsRequest
-	8,  // 20: api.hrm.v1.BusinessTripService.ListEmployeeBusinessTrips:input_type -> api.hrm.v1.ListEmployeeBusinessTripsRequest
-	9,  // 21: api.hrm.v1.BusinessTripService.ListPendingBusinessTrips:input_type -> api.hrm.v1.ListPendingBusinessTripsRequest
-	10, // 22: api.hrm.v1.BusinessTripService.SubmitBusinessTrip:input_type -> api.hrm.v1.SubmitBusinessTripRequest
-	12, // 23: api.hrm.v1.BusinessTripService.ApproveBusinessTrip:input_type -> api.hrm.v1.ApproveBusinessTripRequest
-	14, // 24: api.hrm.v1.BusinessTripService.RejectBusinessTrip:input_type -> api.hrm.v1.RejectBusinessTripRequest
-	16, // 25: api.hrm.v1.BusinessTripService.SubmitTripReport:input_type -> api.hrm.v1.SubmitTripReportRequest
-	0,  // 26: api.hrm.v1.BusinessTripService.CreateBusinessTrip:output_type -> api.hrm.v1.BusinessTripResponse
-	0,  // 27: api.hrm.v1.BusinessTripService.UpdateBusinessTrip:output_type -> api.hrm.v1.BusinessTripResponse
-	4,  // 28: api.hrm.v1.BusinessTripService.DeleteBusinessTrip:output_type -> api.hrm.v1.DeleteBusinessTripResponse
-	0,  // 29: api.hrm.v1.BusinessTripService.GetBusinessTrip:output_type -> api.hrm.v1.BusinessTripResponse
-	7,  // 30: api.hrm.v1.BusinessTripService.ListBusinessTrips:output_type -> api.hrm.v1.ListBusinessTripsResponse
-	7,  // 31: api.hrm.v1.BusinessTripService.ListEmployeeBusinessTrips:output_type -> api.hrm.v1.ListBusinessTripsResponse
-	7,  // 32: api.hrm.v1.BusinessTripService.ListPendingBusinessTrips:output_type -> api.hrm.v1.ListBusinessTripsResponse
-	11, // 33: api.hrm.v1.BusinessTripService.SubmitBusinessTrip:output_type -> api.hrm.v1.SubmitBusinessTripResponse
-	13, // 34: api.hrm.v1.BusinessTripService.ApproveBusinessTrip:output_type -> api.hrm.v1.ApproveBusinessTripResponse
-	15, // 35: api.hrm.v1.BusinessTripService.RejectBusinessTrip:output_type -> api.hrm.v1.RejectBusinessTripResponse
-	0,  // 36: api.hrm.v1.BusinessTripService.SubmitTripReport:output_type -> api.hrm.v1.BusinessTripResponse
-	26, // [26:37] is the sub-list for method output_type
-	15, // [15:26] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	34, // 13: api.hrm.v1.ListEmployeeBusinessTripsRequest.start_date:type_name -> google.protobuf.Timestamp
+	34, // 14: api.hrm.v1.ListEmployeeBusinessTripsRequest.end_date:type_name -> google.protobuf.Timestamp
+	34, // 15: api.hrm.v1.TripExpenseResponse.created_at:type_name -> google.protobuf.Timestamp
+	34, // 16: api.hrm.v1.TripExpenseResponse.updated_at:type_name -> google.protobuf.Timestamp
+	34, // 17: api.hrm.v1.TripExpenseClaimResponse.submitted_at:type_name -> google.protobuf.Timestamp
+	34, // 18: api.hrm.v1.TripExpenseClaimResponse.decided_at:type_name -> google.protobuf.Timestamp
+	17, // 19: api.hrm.v1.TripExpenseClaimResponse.items:type_name -> api.hrm.v1.TripExpenseResponse
+	34, // 20: api.hrm.v1.TripExpenseClaimResponse.created_at:type_name -> google.protobuf.Timestamp
+	34, // 21: api.hrm.v1.TripExpenseClaimResponse.updated_at:type_name -> google.protobuf.Timestamp
+	34, // 22: api.hrm.v1.TripExpensePolicyResponse.created_at:type_name -> google.protobuf.Timestamp
+	34, // 23: api.hrm.v1.TripExpensePolicyResponse.updated_at:type_name -> google.protobuf.Timestamp
+	34, // 24: api.hrm.v1.TripDestinationTier.created_at:type_name -> google.protobuf.Timestamp
+	19, // 25: api.hrm.v1.ResolveTripExpensePolicyResponse.policy:type_name -> api.hrm.v1.TripExpensePolicyResponse
+	19, // 26: api.hrm.v1.ListTripExpensePoliciesResponse.items:type_name -> api.hrm.v1.TripExpensePolicyResponse
+	20, // 27: api.hrm.v1.SetTripDestinationTiersRequest.tiers:type_name -> api.hrm.v1.TripDestinationTier
+	20, // 28: api.hrm.v1.TripDestinationTiersResponse.items:type_name -> api.hrm.v1.TripDestinationTier
+	1,  // 29: api.hrm.v1.BusinessTripService.CreateBusinessTrip:input_type -> api.hrm.v1.CreateBusinessTripRequest
+	2,  // 30: api.hrm.v1.BusinessTripService.UpdateBusinessTrip:input_type -> api.hrm.v1.UpdateBusinessTripRequest
+	3,  // 31: api.hrm.v1.BusinessTripService.DeleteBusinessTrip:input_type -> api.hrm.v1.DeleteBusinessTripRequest
+	5,  // 32: api.hrm.v1.BusinessTripService.GetBusinessTrip:input_type -> api.hrm.v1.GetBusinessTripRequest
+	6,  // 33: api.hrm.v1.BusinessTripService.ListBusinessTrips:input_type -> api.hrm.v1.ListBusinessTripsRequest
+	8,  // 34: api.hrm.v1.BusinessTripService.ListEmployeeBusinessTrips:input_type -> api.hrm.v1.ListEmployeeBusinessTripsRequest
+	9,  // 35: api.hrm.v1.BusinessTripService.ListPendingBusinessTrips:input_type -> api.hrm.v1.ListPendingBusinessTripsRequest
+	10, // 36: api.hrm.v1.BusinessTripService.SubmitBusinessTrip:input_type -> api.hrm.v1.SubmitBusinessTripRequest
+	12, // 37: api.hrm.v1.BusinessTripService.ApproveBusinessTrip:input_type -> api.hrm.v1.ApproveBusinessTripRequest
+	14, // 38: api.hrm.v1.BusinessTripService.RejectBusinessTrip:input_type -> api.hrm.v1.RejectBusinessTripRequest
+	16, // 39: api.hrm.v1.BusinessTripService.SubmitTripReport:input_type -> api.hrm.v1.SubmitTripReportRequest
+	21, // 40: api.hrm.v1.TripExpenseService.AddTripExpense:input_type -> api.hrm.v1.TripExpenseRequest
+	21, // 41: api.hrm.v1.TripExpenseService.UpdateTripExpense:input_type -> api.hrm.v1.TripExpenseRequest
+	22, // 42: api.hrm.v1.TripExpenseService.DeleteTripExpense:input_type -> api.hrm.v1.DeleteTripExpenseRequest
+	23, // 43: api.hrm.v1.TripExpenseService.AttachTripExpenseReceipt:input_type -> api.hrm.v1.TripExpenseReceiptRequest
+	23, // 44: api.hrm.v1.TripExpenseService.DetachTripExpenseReceipt:input_type -> api.hrm.v1.TripExpenseReceiptRequest
+	24, // 45: api.hrm.v1.TripExpenseService.GetTripExpenseClaim:input_type -> api.hrm.v1.TripIDRequest
+	24, // 46: api.hrm.v1.TripExpenseService.SubmitTripExpenseClaim:input_type -> api.hrm.v1.TripIDRequest
+	24, // 47: api.hrm.v1.TripExpenseService.ResolveTripExpensePolicy:input_type -> api.hrm.v1.TripIDRequest
+	26, // 48: api.hrm.v1.TripExpenseService.CreateTripExpensePolicy:input_type -> api.hrm.v1.TripExpensePolicyRequest
+	29, // 49: api.hrm.v1.TripExpenseService.ListTripExpensePolicies:input_type -> api.hrm.v1.ListTripExpensePoliciesRequest
+	27, // 50: api.hrm.v1.TripExpenseService.GetTripExpensePolicy:input_type -> api.hrm.v1.GetTripExpensePolicyRequest
+	26, // 51: api.hrm.v1.TripExpenseService.UpdateTripExpensePolicy:input_type -> api.hrm.v1.TripExpensePolicyRequest
+	28, // 52: api.hrm.v1.TripExpenseService.DeleteTripExpensePolicy:input_type -> api.hrm.v1.DeleteTripExpensePolicyRequest
+	31, // 53: api.hrm.v1.TripExpenseService.ListTripDestinationTiers:input_type -> api.hrm.v1.ListTripDestinationTiersRequest
+	32, // 54: api.hrm.v1.TripExpenseService.SetTripDestinationTiers:input_type -> api.hrm.v1.SetTripDestinationTiersRequest
+	0,  // 55: api.hrm.v1.BusinessTripService.CreateBusinessTrip:output_type -> api.hrm.v1.BusinessTripResponse
+	0,  // 56: api.hrm.v1.BusinessTripService.UpdateBusinessTrip:output_type -> api.hrm.v1.BusinessTripResponse
+	4,  // 57: api.hrm.v1.BusinessTripService.DeleteBusinessTrip:output_type -> api.hrm.v1.DeleteBusinessTripResponse
+	0,  // 58: api.hrm.v1.BusinessTripService.GetBusinessTrip:output_type -> api.hrm.v1.BusinessTripResponse
+	7,  // 59: api.hrm.v1.BusinessTripService.ListBusinessTrips:output_type -> api.hrm.v1.ListBusinessTripsResponse
+	7,  // 60: api.hrm.v1.BusinessTripService.ListEmployeeBusinessTrips:output_type -> api.hrm.v1.ListBusinessTripsResponse
+	7,  // 61: api.hrm.v1.BusinessTripService.ListPendingBusinessTrips:output_type -> api.hrm.v1.ListBusinessTripsResponse
+	11, // 62: api.hrm.v1.BusinessTripService.SubmitBusinessTrip:output_type -> api.hrm.v1.SubmitBusinessTripResponse
+	13, // 63: api.hrm.v1.BusinessTripService.ApproveBusinessTrip:output_type -> api.hrm.v1.ApproveBusinessTripResponse
+	15, // 64: api.hrm.v1.BusinessTripService.RejectBusinessTrip:output_type -> api.hrm.v1.RejectBusinessTripResponse
+	0,  // 65: api.hrm.v1.BusinessTripService.SubmitTripReport:output_type -> api.hrm.v1.BusinessTripResponse
+	17, // 66: api.hrm.v1.TripExpenseService.AddTripExpense:output_type -> api.hrm.v1.TripExpenseResponse
+	17, // 67: api.hrm.v1.TripExpenseService.UpdateTripExpense:output_type -> api.hrm.v1.TripExpenseResponse
+	35, // 68: api.hrm.v1.TripExpenseService.DeleteTripExpense:output_type -> google.protobuf.Empty
+	35, // 69: api.hrm.v1.TripExpenseService.AttachTripExpenseReceipt:output_type -> google.protobuf.Empty
+	35, // 70: api.hrm.v1.TripExpenseService.DetachTripExpenseReceipt:output_type -> google.protobuf.Empty
+	18, // 71: api.hrm.v1.TripExpenseService.GetTripExpenseClaim:output_type -> api.hrm.v1.TripExpenseClaimResponse
+	18, // 72: api.hrm.v1.TripExpenseService.SubmitTripExpenseClaim:output_type -> api.hrm.v1.TripExpenseClaimResponse
+	25, // 73: api.hrm.v1.TripExpenseService.ResolveTripExpensePolicy:output_type -> api.hrm.v1.ResolveTripExpensePolicyResponse
+	19, // 74: api.hrm.v1.TripExpenseService.CreateTripExpensePolicy:output_type -> api.hrm.v1.TripExpensePolicyResponse
+	30, // 75: api.hrm.v1.TripExpenseService.ListTripExpensePolicies:output_type -> api.hrm.v1.ListTripExpensePoliciesResponse
+	19, // 76: api.hrm.v1.TripExpenseService.GetTripExpensePolicy:output_type -> api.hrm.v1.TripExpensePolicyResponse
+	19, // 77: api.hrm.v1.TripExpenseService.UpdateTripExpensePolicy:output_type -> api.hrm.v1.TripExpensePolicyResponse
+	35, // 78: api.hrm.v1.TripExpenseService.DeleteTripExpensePolicy:output_type -> google.protobuf.Empty
+	33, // 79: api.hrm.v1.TripExpenseService.ListTripDestinationTiers:output_type -> api.hrm.v1.TripDestinationTiersResponse
+	33, // 80: api.hrm.v1.TripExpenseService.SetTripDestinationTiers:output_type -> api.hrm.v1.TripDestinationTiersResponse
+	55, // [55:81] is the sub-list for method output_type
+	29, // [29:55] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_api_hrm_v1_business_trip_proto_init() }
@@ -1652,15 +3152,17 @@ func file_api_hrm_v1_business_trip_proto_init() {
 	if File_api_hrm_v1_business_trip_proto != nil {
 		return
 	}
+	file_api_hrm_v1_business_trip_proto_msgTypes[17].OneofWrappers = []any{}
+	file_api_hrm_v1_business_trip_proto_msgTypes[26].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_hrm_v1_business_trip_proto_rawDesc), len(file_api_hrm_v1_business_trip_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   34,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_api_hrm_v1_business_trip_proto_goTypes,
 		DependencyIndexes: file_api_hrm_v1_business_trip_proto_depIdxs,
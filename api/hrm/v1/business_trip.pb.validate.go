@@ -2367,3 +2367,2311 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = SubmitTripReportRequestValidationError{}
+
+// Validate checks the field values on TripExpenseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TripExpenseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TripExpenseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TripExpenseResponseMultiError, or nil if none found.
+func (m *TripExpenseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TripExpenseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TenantId
+
+	// no validation rules for TripId
+
+	// no validation rules for Category
+
+	// no validation rules for Amount
+
+	// no validation rules for Currency
+
+	// no validation rules for ExpenseDate
+
+	// no validation rules for Nights
+
+	// no validation rules for Description
+
+	// no validation rules for OverPolicy
+
+	// no validation rules for OverPolicyAmount
+
+	// no validation rules for OverPolicyNote
+
+	// no validation rules for CreatedBy
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TripExpenseResponseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TripExpenseResponseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TripExpenseResponseValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TripExpenseResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TripExpenseResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TripExpenseResponseValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.PolicyCap != nil {
+		// no validation rules for PolicyCap
+	}
+
+	if len(errors) > 0 {
+		return TripExpenseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TripExpenseResponseMultiError is an error wrapping multiple validation
+// errors returned by TripExpenseResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TripExpenseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TripExpenseResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TripExpenseResponseMultiError) AllErrors() []error { return m }
+
+// TripExpenseResponseValidationError is the validation error returned by
+// TripExpenseResponse.Validate if the designated constraints aren't met.
+type TripExpenseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TripExpenseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TripExpenseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TripExpenseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TripExpenseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TripExpenseResponseValidationError) ErrorName() string {
+	return "TripExpenseResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TripExpenseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTripExpenseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TripExpenseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TripExpenseResponseValidationError{}
+
+// Validate checks the field values on TripExpenseClaimResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TripExpenseClaimResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TripExpenseClaimResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TripExpenseClaimResponseMultiError, or nil if none found.
+func (m *TripExpenseClaimResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TripExpenseClaimResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TripId
+
+	// no validation rules for TenantId
+
+	// no validation rules for EmployeeId
+
+	// no validation rules for Status
+
+	// no validation rules for Currency
+
+	// no validation rules for TotalAmount
+
+	// no validation rules for OverPolicyAmount
+
+	// no validation rules for OverPolicyCount
+
+	// no validation rules for PolicyId
+
+	// no validation rules for DestinationTier
+
+	// no validation rules for ApprovalInstanceId
+
+	// no validation rules for SubmittedBy
+
+	if all {
+		switch v := interface{}(m.GetSubmittedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TripExpenseClaimResponseValidationError{
+					field:  "SubmittedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TripExpenseClaimResponseValidationError{
+					field:  "SubmittedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubmittedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TripExpenseClaimResponseValidationError{
+				field:  "SubmittedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDecidedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TripExpenseClaimResponseValidationError{
+					field:  "DecidedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TripExpenseClaimResponseValidationError{
+					field:  "DecidedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDecidedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TripExpenseClaimResponseValidationError{
+				field:  "DecidedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TripExpenseClaimResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TripExpenseClaimResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TripExpenseClaimResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TripExpenseClaimResponseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TripExpenseClaimResponseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TripExpenseClaimResponseValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TripExpenseClaimResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TripExpenseClaimResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TripExpenseClaimResponseValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TripExpenseClaimResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TripExpenseClaimResponseMultiError is an error wrapping multiple validation
+// errors returned by TripExpenseClaimResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TripExpenseClaimResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TripExpenseClaimResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TripExpenseClaimResponseMultiError) AllErrors() []error { return m }
+
+// TripExpenseClaimResponseValidationError is the validation error returned by
+// TripExpenseClaimResponse.Validate if the designated constraints aren't met.
+type TripExpenseClaimResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TripExpenseClaimResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TripExpenseClaimResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TripExpenseClaimResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TripExpenseClaimResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TripExpenseClaimResponseValidationError) ErrorName() string {
+	return "TripExpenseClaimResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TripExpenseClaimResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTripExpenseClaimResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TripExpenseClaimResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TripExpenseClaimResponseValidationError{}
+
+// Validate checks the field values on TripExpensePolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TripExpensePolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TripExpensePolicyResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TripExpensePolicyResponseMultiError, or nil if none found.
+func (m *TripExpensePolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TripExpensePolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TenantId
+
+	// no validation rules for Name
+
+	// no validation rules for DestinationTier
+
+	// no validation rules for MinPositionLevel
+
+	// no validation rules for MaxPositionLevel
+
+	// no validation rules for PerDiemCap
+
+	// no validation rules for HotelCapPerNight
+
+	// no validation rules for Currency
+
+	// no validation rules for IsActive
+
+	// no validation rules for CreatedBy
+
+	// no validation rules for UpdatedBy
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TripExpensePolicyResponseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TripExpensePolicyResponseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TripExpensePolicyResponseValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TripExpensePolicyResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TripExpensePolicyResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TripExpensePolicyResponseValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TripExpensePolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TripExpensePolicyResponseMultiError is an error wrapping multiple validation
+// errors returned by TripExpensePolicyResponse.ValidateAll() if the
+// designated constraints aren't met.
+type TripExpensePolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TripExpensePolicyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TripExpensePolicyResponseMultiError) AllErrors() []error { return m }
+
+// TripExpensePolicyResponseValidationError is the validation error returned by
+// TripExpensePolicyResponse.Validate if the designated constraints aren't met.
+type TripExpensePolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TripExpensePolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TripExpensePolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TripExpensePolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TripExpensePolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TripExpensePolicyResponseValidationError) ErrorName() string {
+	return "TripExpensePolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TripExpensePolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTripExpensePolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TripExpensePolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TripExpensePolicyResponseValidationError{}
+
+// Validate checks the field values on TripDestinationTier with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TripDestinationTier) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TripDestinationTier with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TripDestinationTierMultiError, or nil if none found.
+func (m *TripDestinationTier) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TripDestinationTier) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Destination
+
+	// no validation rules for Tier
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TripDestinationTierValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TripDestinationTierValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TripDestinationTierValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TripDestinationTierMultiError(errors)
+	}
+
+	return nil
+}
+
+// TripDestinationTierMultiError is an error wrapping multiple validation
+// errors returned by TripDestinationTier.ValidateAll() if the designated
+// constraints aren't met.
+type TripDestinationTierMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TripDestinationTierMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TripDestinationTierMultiError) AllErrors() []error { return m }
+
+// TripDestinationTierValidationError is the validation error returned by
+// TripDestinationTier.Validate if the designated constraints aren't met.
+type TripDestinationTierValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TripDestinationTierValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TripDestinationTierValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TripDestinationTierValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TripDestinationTierValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TripDestinationTierValidationError) ErrorName() string {
+	return "TripDestinationTierValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TripDestinationTierValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTripDestinationTier.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TripDestinationTierValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TripDestinationTierValidationError{}
+
+// Validate checks the field values on TripExpenseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TripExpenseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TripExpenseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TripExpenseRequestMultiError, or nil if none found.
+func (m *TripExpenseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TripExpenseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TripId
+
+	// no validation rules for Id
+
+	// no validation rules for Category
+
+	// no validation rules for Amount
+
+	// no validation rules for Currency
+
+	// no validation rules for ExpenseDate
+
+	// no validation rules for Nights
+
+	// no validation rules for Description
+
+	// no validation rules for OverPolicyNote
+
+	if len(errors) > 0 {
+		return TripExpenseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TripExpenseRequestMultiError is an error wrapping multiple validation errors
+// returned by TripExpenseRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TripExpenseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TripExpenseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TripExpenseRequestMultiError) AllErrors() []error { return m }
+
+// TripExpenseRequestValidationError is the validation error returned by
+// TripExpenseRequest.Validate if the designated constraints aren't met.
+type TripExpenseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TripExpenseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TripExpenseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TripExpenseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TripExpenseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TripExpenseRequestValidationError) ErrorName() string {
+	return "TripExpenseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TripExpenseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTripExpenseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TripExpenseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TripExpenseRequestValidationError{}
+
+// Validate checks the field values on DeleteTripExpenseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTripExpenseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTripExpenseRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTripExpenseRequestMultiError, or nil if none found.
+func (m *DeleteTripExpenseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTripExpenseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TripId
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteTripExpenseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTripExpenseRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteTripExpenseRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTripExpenseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTripExpenseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTripExpenseRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTripExpenseRequestValidationError is the validation error returned by
+// DeleteTripExpenseRequest.Validate if the designated constraints aren't met.
+type DeleteTripExpenseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTripExpenseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTripExpenseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTripExpenseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTripExpenseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTripExpenseRequestValidationError) ErrorName() string {
+	return "DeleteTripExpenseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTripExpenseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTripExpenseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTripExpenseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTripExpenseRequestValidationError{}
+
+// Validate checks the field values on TripExpenseReceiptRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TripExpenseReceiptRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TripExpenseReceiptRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TripExpenseReceiptRequestMultiError, or nil if none found.
+func (m *TripExpenseReceiptRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TripExpenseReceiptRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TripId
+
+	// no validation rules for Id
+
+	// no validation rules for FileId
+
+	if len(errors) > 0 {
+		return TripExpenseReceiptRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TripExpenseReceiptRequestMultiError is an error wrapping multiple validation
+// errors returned by TripExpenseReceiptRequest.ValidateAll() if the
+// designated constraints aren't met.
+type TripExpenseReceiptRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TripExpenseReceiptRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TripExpenseReceiptRequestMultiError) AllErrors() []error { return m }
+
+// TripExpenseReceiptRequestValidationError is the validation error returned by
+// TripExpenseReceiptRequest.Validate if the designated constraints aren't met.
+type TripExpenseReceiptRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TripExpenseReceiptRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TripExpenseReceiptRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TripExpenseReceiptRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TripExpenseReceiptRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TripExpenseReceiptRequestValidationError) ErrorName() string {
+	return "TripExpenseReceiptRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TripExpenseReceiptRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTripExpenseReceiptRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TripExpenseReceiptRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TripExpenseReceiptRequestValidationError{}
+
+// Validate checks the field values on TripIDRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TripIDRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TripIDRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TripIDRequestMultiError, or
+// nil if none found.
+func (m *TripIDRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TripIDRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TripId
+
+	if len(errors) > 0 {
+		return TripIDRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TripIDRequestMultiError is an error wrapping multiple validation errors
+// returned by TripIDRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TripIDRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TripIDRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TripIDRequestMultiError) AllErrors() []error { return m }
+
+// TripIDRequestValidationError is the validation error returned by
+// TripIDRequest.Validate if the designated constraints aren't met.
+type TripIDRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TripIDRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TripIDRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TripIDRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TripIDRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TripIDRequestValidationError) ErrorName() string { return "TripIDRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TripIDRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTripIDRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TripIDRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TripIDRequestValidationError{}
+
+// Validate checks the field values on ResolveTripExpensePolicyResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ResolveTripExpensePolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResolveTripExpensePolicyResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ResolveTripExpensePolicyResponseMultiError, or nil if none found.
+func (m *ResolveTripExpensePolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResolveTripExpensePolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DestinationTier
+
+	if all {
+		switch v := interface{}(m.GetPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResolveTripExpensePolicyResponseValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResolveTripExpensePolicyResponseValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResolveTripExpensePolicyResponseValidationError{
+				field:  "Policy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ResolveTripExpensePolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResolveTripExpensePolicyResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ResolveTripExpensePolicyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ResolveTripExpensePolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResolveTripExpensePolicyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResolveTripExpensePolicyResponseMultiError) AllErrors() []error { return m }
+
+// ResolveTripExpensePolicyResponseValidationError is the validation error
+// returned by ResolveTripExpensePolicyResponse.Validate if the designated
+// constraints aren't met.
+type ResolveTripExpensePolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResolveTripExpensePolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResolveTripExpensePolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResolveTripExpensePolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResolveTripExpensePolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResolveTripExpensePolicyResponseValidationError) ErrorName() string {
+	return "ResolveTripExpensePolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResolveTripExpensePolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResolveTripExpensePolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResolveTripExpensePolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResolveTripExpensePolicyResponseValidationError{}
+
+// Validate checks the field values on TripExpensePolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TripExpensePolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TripExpensePolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TripExpensePolicyRequestMultiError, or nil if none found.
+func (m *TripExpensePolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TripExpensePolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for DestinationTier
+
+	// no validation rules for MinPositionLevel
+
+	// no validation rules for MaxPositionLevel
+
+	// no validation rules for PerDiemCap
+
+	// no validation rules for HotelCapPerNight
+
+	// no validation rules for Currency
+
+	if m.IsActive != nil {
+		// no validation rules for IsActive
+	}
+
+	if len(errors) > 0 {
+		return TripExpensePolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TripExpensePolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by TripExpensePolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TripExpensePolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TripExpensePolicyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TripExpensePolicyRequestMultiError) AllErrors() []error { return m }
+
+// TripExpensePolicyRequestValidationError is the validation error returned by
+// TripExpensePolicyRequest.Validate if the designated constraints aren't met.
+type TripExpensePolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TripExpensePolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TripExpensePolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TripExpensePolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TripExpensePolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TripExpensePolicyRequestValidationError) ErrorName() string {
+	return "TripExpensePolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TripExpensePolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTripExpensePolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TripExpensePolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TripExpensePolicyRequestValidationError{}
+
+// Validate checks the field values on GetTripExpensePolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTripExpensePolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTripExpensePolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTripExpensePolicyRequestMultiError, or nil if none found.
+func (m *GetTripExpensePolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTripExpensePolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetTripExpensePolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTripExpensePolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by GetTripExpensePolicyRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetTripExpensePolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTripExpensePolicyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTripExpensePolicyRequestMultiError) AllErrors() []error { return m }
+
+// GetTripExpensePolicyRequestValidationError is the validation error returned
+// by GetTripExpensePolicyRequest.Validate if the designated constraints
+// aren't met.
+type GetTripExpensePolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTripExpensePolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTripExpensePolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTripExpensePolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTripExpensePolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTripExpensePolicyRequestValidationError) ErrorName() string {
+	return "GetTripExpensePolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTripExpensePolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTripExpensePolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTripExpensePolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTripExpensePolicyRequestValidationError{}
+
+// Validate checks the field values on DeleteTripExpensePolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTripExpensePolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTripExpensePolicyRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteTripExpensePolicyRequestMultiError, or nil if none found.
+func (m *DeleteTripExpensePolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTripExpensePolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteTripExpensePolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTripExpensePolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteTripExpensePolicyRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteTripExpensePolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTripExpensePolicyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTripExpensePolicyRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTripExpensePolicyRequestValidationError is the validation error
+// returned by DeleteTripExpensePolicyRequest.Validate if the designated
+// constraints aren't met.
+type DeleteTripExpensePolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTripExpensePolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTripExpensePolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTripExpensePolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTripExpensePolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTripExpensePolicyRequestValidationError) ErrorName() string {
+	return "DeleteTripExpensePolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTripExpensePolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTripExpensePolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTripExpensePolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTripExpensePolicyRequestValidationError{}
+
+// Validate checks the field values on ListTripExpensePoliciesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTripExpensePoliciesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTripExpensePoliciesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListTripExpensePoliciesRequestMultiError, or nil if none found.
+func (m *ListTripExpensePoliciesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTripExpensePoliciesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListTripExpensePoliciesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTripExpensePoliciesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListTripExpensePoliciesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListTripExpensePoliciesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTripExpensePoliciesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTripExpensePoliciesRequestMultiError) AllErrors() []error { return m }
+
+// ListTripExpensePoliciesRequestValidationError is the validation error
+// returned by ListTripExpensePoliciesRequest.Validate if the designated
+// constraints aren't met.
+type ListTripExpensePoliciesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTripExpensePoliciesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTripExpensePoliciesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTripExpensePoliciesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTripExpensePoliciesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTripExpensePoliciesRequestValidationError) ErrorName() string {
+	return "ListTripExpensePoliciesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTripExpensePoliciesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTripExpensePoliciesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTripExpensePoliciesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTripExpensePoliciesRequestValidationError{}
+
+// Validate checks the field values on ListTripExpensePoliciesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTripExpensePoliciesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTripExpensePoliciesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListTripExpensePoliciesResponseMultiError, or nil if none found.
+func (m *ListTripExpensePoliciesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTripExpensePoliciesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTripExpensePoliciesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTripExpensePoliciesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTripExpensePoliciesResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTripExpensePoliciesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTripExpensePoliciesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListTripExpensePoliciesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListTripExpensePoliciesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTripExpensePoliciesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTripExpensePoliciesResponseMultiError) AllErrors() []error { return m }
+
+// ListTripExpensePoliciesResponseValidationError is the validation error
+// returned by ListTripExpensePoliciesResponse.Validate if the designated
+// constraints aren't met.
+type ListTripExpensePoliciesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTripExpensePoliciesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTripExpensePoliciesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTripExpensePoliciesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTripExpensePoliciesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTripExpensePoliciesResponseValidationError) ErrorName() string {
+	return "ListTripExpensePoliciesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTripExpensePoliciesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTripExpensePoliciesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTripExpensePoliciesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTripExpensePoliciesResponseValidationError{}
+
+// Validate checks the field values on ListTripDestinationTiersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTripDestinationTiersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTripDestinationTiersRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListTripDestinationTiersRequestMultiError, or nil if none found.
+func (m *ListTripDestinationTiersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTripDestinationTiersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListTripDestinationTiersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTripDestinationTiersRequestMultiError is an error wrapping multiple
+// validation errors returned by ListTripDestinationTiersRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListTripDestinationTiersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTripDestinationTiersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTripDestinationTiersRequestMultiError) AllErrors() []error { return m }
+
+// ListTripDestinationTiersRequestValidationError is the validation error
+// returned by ListTripDestinationTiersRequest.Validate if the designated
+// constraints aren't met.
+type ListTripDestinationTiersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTripDestinationTiersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTripDestinationTiersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTripDestinationTiersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTripDestinationTiersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTripDestinationTiersRequestValidationError) ErrorName() string {
+	return "ListTripDestinationTiersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTripDestinationTiersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTripDestinationTiersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTripDestinationTiersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTripDestinationTiersRequestValidationError{}
+
+// Validate checks the field values on SetTripDestinationTiersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetTripDestinationTiersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetTripDestinationTiersRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetTripDestinationTiersRequestMultiError, or nil if none found.
+func (m *SetTripDestinationTiersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetTripDestinationTiersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTiers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SetTripDestinationTiersRequestValidationError{
+						field:  fmt.Sprintf("Tiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SetTripDestinationTiersRequestValidationError{
+						field:  fmt.Sprintf("Tiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SetTripDestinationTiersRequestValidationError{
+					field:  fmt.Sprintf("Tiers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SetTripDestinationTiersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetTripDestinationTiersRequestMultiError is an error wrapping multiple
+// validation errors returned by SetTripDestinationTiersRequest.ValidateAll()
+// if the designated constraints aren't met.
+type SetTripDestinationTiersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetTripDestinationTiersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetTripDestinationTiersRequestMultiError) AllErrors() []error { return m }
+
+// SetTripDestinationTiersRequestValidationError is the validation error
+// returned by SetTripDestinationTiersRequest.Validate if the designated
+// constraints aren't met.
+type SetTripDestinationTiersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetTripDestinationTiersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetTripDestinationTiersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetTripDestinationTiersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetTripDestinationTiersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetTripDestinationTiersRequestValidationError) ErrorName() string {
+	return "SetTripDestinationTiersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetTripDestinationTiersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetTripDestinationTiersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetTripDestinationTiersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetTripDestinationTiersRequestValidationError{}
+
+// Validate checks the field values on TripDestinationTiersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TripDestinationTiersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TripDestinationTiersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TripDestinationTiersResponseMultiError, or nil if none found.
+func (m *TripDestinationTiersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TripDestinationTiersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TripDestinationTiersResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TripDestinationTiersResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TripDestinationTiersResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TripDestinationTiersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TripDestinationTiersResponseMultiError is an error wrapping multiple
+// validation errors returned by TripDestinationTiersResponse.ValidateAll() if
+// the designated constraints aren't met.
+type TripDestinationTiersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TripDestinationTiersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TripDestinationTiersResponseMultiError) AllErrors() []error { return m }
+
+// TripDestinationTiersResponseValidationError is the validation error returned
+// by TripDestinationTiersResponse.Validate if the designated constraints
+// aren't met.
+type TripDestinationTiersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TripDestinationTiersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TripDestinationTiersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TripDestinationTiersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TripDestinationTiersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TripDestinationTiersResponseValidationError) ErrorName() string {
+	return "TripDestinationTiersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TripDestinationTiersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTripDestinationTiersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TripDestinationTiersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TripDestinationTiersResponseValidationError{}
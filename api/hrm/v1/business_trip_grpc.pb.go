@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -483,3 +484,621 @@ var BusinessTripService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/hrm/v1/business_trip.proto",
 }
+
+// TripExpenseServiceClient is the client API for TripExpenseService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TripExpenseServiceClient interface {
+	// 新增出差报销明细（出差需已批准，报销单审批中或已通过时不可修改）
+	AddTripExpense(ctx context.Context, in *TripExpenseRequest, opts ...grpc.CallOption) (*TripExpenseResponse, error)
+	// 修改出差报销明细（票据通过单独接口维护）
+	UpdateTripExpense(ctx context.Context, in *TripExpenseRequest, opts ...grpc.CallOption) (*TripExpenseResponse, error)
+	// 删除出差报销明细，同时取消票据关联
+	DeleteTripExpense(ctx context.Context, in *DeleteTripExpenseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 为报销明细关联票据文件
+	AttachTripExpenseReceipt(ctx context.Context, in *TripExpenseReceiptRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 取消报销明细的票据关联（文件本身不删除）
+	DetachTripExpenseReceipt(ctx context.Context, in *TripExpenseReceiptRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 获取出差报销单（含明细、超标标记和票据）
+	GetTripExpenseClaim(ctx context.Context, in *TripIDRequest, opts ...grpc.CallOption) (*TripExpenseClaimResponse, error)
+	// 提交出差报销单审批
+	SubmitTripExpenseClaim(ctx context.Context, in *TripIDRequest, opts ...grpc.CallOption) (*TripExpenseClaimResponse, error)
+	// 查询出差适用的差旅标准
+	ResolveTripExpensePolicy(ctx context.Context, in *TripIDRequest, opts ...grpc.CallOption) (*ResolveTripExpensePolicyResponse, error)
+	// 创建差旅标准
+	CreateTripExpensePolicy(ctx context.Context, in *TripExpensePolicyRequest, opts ...grpc.CallOption) (*TripExpensePolicyResponse, error)
+	// 租户差旅标准列表
+	ListTripExpensePolicies(ctx context.Context, in *ListTripExpensePoliciesRequest, opts ...grpc.CallOption) (*ListTripExpensePoliciesResponse, error)
+	// 获取差旅标准
+	GetTripExpensePolicy(ctx context.Context, in *GetTripExpensePolicyRequest, opts ...grpc.CallOption) (*TripExpensePolicyResponse, error)
+	// 更新差旅标准（只影响之后变动的报销明细，已提交的报销单不重算）
+	UpdateTripExpensePolicy(ctx context.Context, in *TripExpensePolicyRequest, opts ...grpc.CallOption) (*TripExpensePolicyResponse, error)
+	// 删除差旅标准
+	DeleteTripExpensePolicy(ctx context.Context, in *DeleteTripExpensePolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 目的地级别列表
+	ListTripDestinationTiers(ctx context.Context, in *ListTripDestinationTiersRequest, opts ...grpc.CallOption) (*TripDestinationTiersResponse, error)
+	// 整体替换目的地级别（未匹配任何关键字的目的地按 default 级别）
+	SetTripDestinationTiers(ctx context.Context, in *SetTripDestinationTiersRequest, opts ...grpc.CallOption) (*TripDestinationTiersResponse, error)
+}
+
+type tripExpenseServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTripExpenseServiceClient(cc grpc.ClientConnInterface) TripExpenseServiceClient {
+	return &tripExpenseServiceClient{cc}
+}
+
+func (c *tripExpenseServiceClient) AddTripExpense(ctx context.Context, in *TripExpenseRequest, opts ...grpc.CallOption) (*TripExpenseResponse, error) {
+	out := new(TripExpenseResponse)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.TripExpenseService/AddTripExpense", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripExpenseServiceClient) UpdateTripExpense(ctx context.Context, in *TripExpenseRequest, opts ...grpc.CallOption) (*TripExpenseResponse, error) {
+	out := new(TripExpenseResponse)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.TripExpenseService/UpdateTripExpense", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripExpenseServiceClient) DeleteTripExpense(ctx context.Context, in *DeleteTripExpenseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.TripExpenseService/DeleteTripExpense", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripExpenseServiceClient) AttachTripExpenseReceipt(ctx context.Context, in *TripExpenseReceiptRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.TripExpenseService/AttachTripExpenseReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripExpenseServiceClient) DetachTripExpenseReceipt(ctx context.Context, in *TripExpenseReceiptRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.TripExpenseService/DetachTripExpenseReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripExpenseServiceClient) GetTripExpenseClaim(ctx context.Context, in *TripIDRequest, opts ...grpc.CallOption) (*TripExpenseClaimResponse, error) {
+	out := new(TripExpenseClaimResponse)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.TripExpenseService/GetTripExpenseClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripExpenseServiceClient) SubmitTripExpenseClaim(ctx context.Context, in *TripIDRequest, opts ...grpc.CallOption) (*TripExpenseClaimResponse, error) {
+	out := new(TripExpenseClaimResponse)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.TripExpenseService/SubmitTripExpenseClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripExpenseServiceClient) ResolveTripExpensePolicy(ctx context.Context, in *TripIDRequest, opts ...grpc.CallOption) (*ResolveTripExpensePolicyResponse, error) {
+	out := new(ResolveTripExpensePolicyResponse)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.TripExpenseService/ResolveTripExpensePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripExpenseServiceClient) CreateTripExpensePolicy(ctx context.Context, in *TripExpensePolicyRequest, opts ...grpc.CallOption) (*TripExpensePolicyResponse, error) {
+	out := new(TripExpensePolicyResponse)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.TripExpenseService/CreateTripExpensePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripExpenseServiceClient) ListTripExpensePolicies(ctx context.Context, in *ListTripExpensePoliciesRequest, opts ...grpc.CallOption) (*ListTripExpensePoliciesResponse, error) {
+	out := new(ListTripExpensePoliciesResponse)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.TripExpenseService/ListTripExpensePolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripExpenseServiceClient) GetTripExpensePolicy(ctx context.Context, in *GetTripExpensePolicyRequest, opts ...grpc.CallOption) (*TripExpensePolicyResponse, error) {
+	out := new(TripExpensePolicyResponse)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.TripExpenseService/GetTripExpensePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripExpenseServiceClient) UpdateTripExpensePolicy(ctx context.Context, in *TripExpensePolicyRequest, opts ...grpc.CallOption) (*TripExpensePolicyResponse, error) {
+	out := new(TripExpensePolicyResponse)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.TripExpenseService/UpdateTripExpensePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripExpenseServiceClient) DeleteTripExpensePolicy(ctx context.Context, in *DeleteTripExpensePolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.TripExpenseService/DeleteTripExpensePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripExpenseServiceClient) ListTripDestinationTiers(ctx context.Context, in *ListTripDestinationTiersRequest, opts ...grpc.CallOption) (*TripDestinationTiersResponse, error) {
+	out := new(TripDestinationTiersResponse)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.TripExpenseService/ListTripDestinationTiers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripExpenseServiceClient) SetTripDestinationTiers(ctx context.Context, in *SetTripDestinationTiersRequest, opts ...grpc.CallOption) (*TripDestinationTiersResponse, error) {
+	out := new(TripDestinationTiersResponse)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.TripExpenseService/SetTripDestinationTiers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TripExpenseServiceServer is the server API for TripExpenseService service.
+// All implementations should embed UnimplementedTripExpenseServiceServer
+// for forward compatibility
+type TripExpenseServiceServer interface {
+	// 新增出差报销明细（出差需已批准，报销单审批中或已通过时不可修改）
+	AddTripExpense(context.Context, *TripExpenseRequest) (*TripExpenseResponse, error)
+	// 修改出差报销明细（票据通过单独接口维护）
+	UpdateTripExpense(context.Context, *TripExpenseRequest) (*TripExpenseResponse, error)
+	// 删除出差报销明细，同时取消票据关联
+	DeleteTripExpense(context.Context, *DeleteTripExpenseRequest) (*emptypb.Empty, error)
+	// 为报销明细关联票据文件
+	AttachTripExpenseReceipt(context.Context, *TripExpenseReceiptRequest) (*emptypb.Empty, error)
+	// 取消报销明细的票据关联（文件本身不删除）
+	DetachTripExpenseReceipt(context.Context, *TripExpenseReceiptRequest) (*emptypb.Empty, error)
+	// 获取出差报销单（含明细、超标标记和票据）
+	GetTripExpenseClaim(context.Context, *TripIDRequest) (*TripExpenseClaimResponse, error)
+	// 提交出差报销单审批
+	SubmitTripExpenseClaim(context.Context, *TripIDRequest) (*TripExpenseClaimResponse, error)
+	// 查询出差适用的差旅标准
+	ResolveTripExpensePolicy(context.Context, *TripIDRequest) (*ResolveTripExpensePolicyResponse, error)
+	// 创建差旅标准
+	CreateTripExpensePolicy(context.Context, *TripExpensePolicyRequest) (*TripExpensePolicyResponse, error)
+	// 租户差旅标准列表
+	ListTripExpensePolicies(context.Context, *ListTripExpensePoliciesRequest) (*ListTripExpensePoliciesResponse, error)
+	// 获取差旅标准
+	GetTripExpensePolicy(context.Context, *GetTripExpensePolicyRequest) (*TripExpensePolicyResponse, error)
+	// 更新差旅标准（只影响之后变动的报销明细，已提交的报销单不重算）
+	UpdateTripExpensePolicy(context.Context, *TripExpensePolicyRequest) (*TripExpensePolicyResponse, error)
+	// 删除差旅标准
+	DeleteTripExpensePolicy(context.Context, *DeleteTripExpensePolicyRequest) (*emptypb.Empty, error)
+	// 目的地级别列表
+	ListTripDestinationTiers(context.Context, *ListTripDestinationTiersRequest) (*TripDestinationTiersResponse, error)
+	// 整体替换目的地级别（未匹配任何关键字的目的地按 default 级别）
+	SetTripDestinationTiers(context.Context, *SetTripDestinationTiersRequest) (*TripDestinationTiersResponse, error)
+}
+
+// UnimplementedTripExpenseServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedTripExpenseServiceServer struct {
+}
+
+func (UnimplementedTripExpenseServiceServer) AddTripExpense(context.Context, *TripExpenseRequest) (*TripExpenseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTripExpense not implemented")
+}
+func (UnimplementedTripExpenseServiceServer) UpdateTripExpense(context.Context, *TripExpenseRequest) (*TripExpenseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTripExpense not implemented")
+}
+func (UnimplementedTripExpenseServiceServer) DeleteTripExpense(context.Context, *DeleteTripExpenseRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTripExpense not implemented")
+}
+func (UnimplementedTripExpenseServiceServer) AttachTripExpenseReceipt(context.Context, *TripExpenseReceiptRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachTripExpenseReceipt not implemented")
+}
+func (UnimplementedTripExpenseServiceServer) DetachTripExpenseReceipt(context.Context, *TripExpenseReceiptRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachTripExpenseReceipt not implemented")
+}
+func (UnimplementedTripExpenseServiceServer) GetTripExpenseClaim(context.Context, *TripIDRequest) (*TripExpenseClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTripExpenseClaim not implemented")
+}
+func (UnimplementedTripExpenseServiceServer) SubmitTripExpenseClaim(context.Context, *TripIDRequest) (*TripExpenseClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTripExpenseClaim not implemented")
+}
+func (UnimplementedTripExpenseServiceServer) ResolveTripExpensePolicy(context.Context, *TripIDRequest) (*ResolveTripExpensePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveTripExpensePolicy not implemented")
+}
+func (UnimplementedTripExpenseServiceServer) CreateTripExpensePolicy(context.Context, *TripExpensePolicyRequest) (*TripExpensePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTripExpensePolicy not implemented")
+}
+func (UnimplementedTripExpenseServiceServer) ListTripExpensePolicies(context.Context, *ListTripExpensePoliciesRequest) (*ListTripExpensePoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTripExpensePolicies not implemented")
+}
+func (UnimplementedTripExpenseServiceServer) GetTripExpensePolicy(context.Context, *GetTripExpensePolicyRequest) (*TripExpensePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTripExpensePolicy not implemented")
+}
+func (UnimplementedTripExpenseServiceServer) UpdateTripExpensePolicy(context.Context, *TripExpensePolicyRequest) (*TripExpensePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTripExpensePolicy not implemented")
+}
+func (UnimplementedTripExpenseServiceServer) DeleteTripExpensePolicy(context.Context, *DeleteTripExpensePolicyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTripExpensePolicy not implemented")
+}
+func (UnimplementedTripExpenseServiceServer) ListTripDestinationTiers(context.Context, *ListTripDestinationTiersRequest) (*TripDestinationTiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTripDestinationTiers not implemented")
+}
+func (UnimplementedTripExpenseServiceServer) SetTripDestinationTiers(context.Context, *SetTripDestinationTiersRequest) (*TripDestinationTiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTripDestinationTiers not implemented")
+}
+
+// UnsafeTripExpenseServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TripExpenseServiceServer will
+// result in compilation errors.
+type UnsafeTripExpenseServiceServer interface {
+	mustEmbedUnimplementedTripExpenseServiceServer()
+}
+
+func RegisterTripExpenseServiceServer(s grpc.ServiceRegistrar, srv TripExpenseServiceServer) {
+	s.RegisterService(&TripExpenseService_ServiceDesc, srv)
+}
+
+func _TripExpenseService_AddTripExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TripExpenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripExpenseServiceServer).AddTripExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.TripExpenseService/AddTripExpense",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripExpenseServiceServer).AddTripExpense(ctx, req.(*TripExpenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripExpenseService_UpdateTripExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TripExpenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripExpenseServiceServer).UpdateTripExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.TripExpenseService/UpdateTripExpense",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripExpenseServiceServer).UpdateTripExpense(ctx, req.(*TripExpenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripExpenseService_DeleteTripExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTripExpenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripExpenseServiceServer).DeleteTripExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.TripExpenseService/DeleteTripExpense",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripExpenseServiceServer).DeleteTripExpense(ctx, req.(*DeleteTripExpenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripExpenseService_AttachTripExpenseReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TripExpenseReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripExpenseServiceServer).AttachTripExpenseReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.TripExpenseService/AttachTripExpenseReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripExpenseServiceServer).AttachTripExpenseReceipt(ctx, req.(*TripExpenseReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripExpenseService_DetachTripExpenseReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TripExpenseReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripExpenseServiceServer).DetachTripExpenseReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.TripExpenseService/DetachTripExpenseReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripExpenseServiceServer).DetachTripExpenseReceipt(ctx, req.(*TripExpenseReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripExpenseService_GetTripExpenseClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TripIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripExpenseServiceServer).GetTripExpenseClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.TripExpenseService/GetTripExpenseClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripExpenseServiceServer).GetTripExpenseClaim(ctx, req.(*TripIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripExpenseService_SubmitTripExpenseClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TripIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripExpenseServiceServer).SubmitTripExpenseClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.TripExpenseService/SubmitTripExpenseClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripExpenseServiceServer).SubmitTripExpenseClaim(ctx, req.(*TripIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripExpenseService_ResolveTripExpensePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TripIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripExpenseServiceServer).ResolveTripExpensePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.TripExpenseService/ResolveTripExpensePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripExpenseServiceServer).ResolveTripExpensePolicy(ctx, req.(*TripIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripExpenseService_CreateTripExpensePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TripExpensePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripExpenseServiceServer).CreateTripExpensePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.TripExpenseService/CreateTripExpensePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripExpenseServiceServer).CreateTripExpensePolicy(ctx, req.(*TripExpensePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripExpenseService_ListTripExpensePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTripExpensePoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripExpenseServiceServer).ListTripExpensePolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.TripExpenseService/ListTripExpensePolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripExpenseServiceServer).ListTripExpensePolicies(ctx, req.(*ListTripExpensePoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripExpenseService_GetTripExpensePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTripExpensePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripExpenseServiceServer).GetTripExpensePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.TripExpenseService/GetTripExpensePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripExpenseServiceServer).GetTripExpensePolicy(ctx, req.(*GetTripExpensePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripExpenseService_UpdateTripExpensePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TripExpensePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripExpenseServiceServer).UpdateTripExpensePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.TripExpenseService/UpdateTripExpensePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripExpenseServiceServer).UpdateTripExpensePolicy(ctx, req.(*TripExpensePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripExpenseService_DeleteTripExpensePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTripExpensePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripExpenseServiceServer).DeleteTripExpensePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.TripExpenseService/DeleteTripExpensePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripExpenseServiceServer).DeleteTripExpensePolicy(ctx, req.(*DeleteTripExpensePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripExpenseService_ListTripDestinationTiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTripDestinationTiersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripExpenseServiceServer).ListTripDestinationTiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.TripExpenseService/ListTripDestinationTiers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripExpenseServiceServer).ListTripDestinationTiers(ctx, req.(*ListTripDestinationTiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripExpenseService_SetTripDestinationTiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTripDestinationTiersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripExpenseServiceServer).SetTripDestinationTiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.TripExpenseService/SetTripDestinationTiers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripExpenseServiceServer).SetTripDestinationTiers(ctx, req.(*SetTripDestinationTiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TripExpenseService_ServiceDesc is the grpc.ServiceDesc for TripExpenseService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TripExpenseService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.hrm.v1.TripExpenseService",
+	HandlerType: (*TripExpenseServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddTripExpense",
+			Handler:    _TripExpenseService_AddTripExpense_Handler,
+		},
+		{
+			MethodName: "UpdateTripExpense",
+			Handler:    _TripExpenseService_UpdateTripExpense_Handler,
+		},
+		{
+			MethodName: "DeleteTripExpense",
+			Handler:    _TripExpenseService_DeleteTripExpense_Handler,
+		},
+		{
+			MethodName: "AttachTripExpenseReceipt",
+			Handler:    _TripExpenseService_AttachTripExpenseReceipt_Handler,
+		},
+		{
+			MethodName: "DetachTripExpenseReceipt",
+			Handler:    _TripExpenseService_DetachTripExpenseReceipt_Handler,
+		},
+		{
+			MethodName: "GetTripExpenseClaim",
+			Handler:    _TripExpenseService_GetTripExpenseClaim_Handler,
+		},
+		{
+			MethodName: "SubmitTripExpenseClaim",
+			Handler:    _TripExpenseService_SubmitTripExpenseClaim_Handler,
+		},
+		{
+			MethodName: "ResolveTripExpensePolicy",
+			Handler:    _TripExpenseService_ResolveTripExpensePolicy_Handler,
+		},
+		{
+			MethodName: "CreateTripExpensePolicy",
+			Handler:    _TripExpenseService_CreateTripExpensePolicy_Handler,
+		},
+		{
+			MethodName: "ListTripExpensePolicies",
+			Handler:    _TripExpenseService_ListTripExpensePolicies_Handler,
+		},
+		{
+			MethodName: "GetTripExpensePolicy",
+			Handler:    _TripExpenseService_GetTripExpensePolicy_Handler,
+		},
+		{
+			MethodName: "UpdateTripExpensePolicy",
+			Handler:    _TripExpenseService_UpdateTripExpensePolicy_Handler,
+		},
+		{
+			MethodName: "DeleteTripExpensePolicy",
+			Handler:    _TripExpenseService_DeleteTripExpensePolicy_Handler,
+		},
+		{
+			MethodName: "ListTripDestinationTiers",
+			Handler:    _TripExpenseService_ListTripDestinationTiers_Handler,
+		},
+		{
+			MethodName: "SetTripDestinationTiers",
+			Handler:    _TripExpenseService_SetTripDestinationTiers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/hrm/v1/business_trip.proto",
+}
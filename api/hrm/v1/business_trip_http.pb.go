@@ -10,6 +10,7 @@ import (
 	context "context"
 	http "github.com/go-kratos/kratos/v2/transport/http"
 	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -508,3 +509,661 @@ func (c *BusinessTripServiceHTTPClientImpl) UpdateBusinessTrip(ctx context.Conte
 	}
 	return &out, nil
 }
+
+const OperationTripExpenseServiceAddTripExpense = "/api.hrm.v1.TripExpenseService/AddTripExpense"
+const OperationTripExpenseServiceAttachTripExpenseReceipt = "/api.hrm.v1.TripExpenseService/AttachTripExpenseReceipt"
+const OperationTripExpenseServiceCreateTripExpensePolicy = "/api.hrm.v1.TripExpenseService/CreateTripExpensePolicy"
+const OperationTripExpenseServiceDeleteTripExpense = "/api.hrm.v1.TripExpenseService/DeleteTripExpense"
+const OperationTripExpenseServiceDeleteTripExpensePolicy = "/api.hrm.v1.TripExpenseService/DeleteTripExpensePolicy"
+const OperationTripExpenseServiceDetachTripExpenseReceipt = "/api.hrm.v1.TripExpenseService/DetachTripExpenseReceipt"
+const OperationTripExpenseServiceGetTripExpenseClaim = "/api.hrm.v1.TripExpenseService/GetTripExpenseClaim"
+const OperationTripExpenseServiceGetTripExpensePolicy = "/api.hrm.v1.TripExpenseService/GetTripExpensePolicy"
+const OperationTripExpenseServiceListTripDestinationTiers = "/api.hrm.v1.TripExpenseService/ListTripDestinationTiers"
+const OperationTripExpenseServiceListTripExpensePolicies = "/api.hrm.v1.TripExpenseService/ListTripExpensePolicies"
+const OperationTripExpenseServiceResolveTripExpensePolicy = "/api.hrm.v1.TripExpenseService/ResolveTripExpensePolicy"
+const OperationTripExpenseServiceSetTripDestinationTiers = "/api.hrm.v1.TripExpenseService/SetTripDestinationTiers"
+const OperationTripExpenseServiceSubmitTripExpenseClaim = "/api.hrm.v1.TripExpenseService/SubmitTripExpenseClaim"
+const OperationTripExpenseServiceUpdateTripExpense = "/api.hrm.v1.TripExpenseService/UpdateTripExpense"
+const OperationTripExpenseServiceUpdateTripExpensePolicy = "/api.hrm.v1.TripExpenseService/UpdateTripExpensePolicy"
+
+type TripExpenseServiceHTTPServer interface {
+	// AddTripExpense 新增出差报销明细（出差需已批准，报销单审批中或已通过时不可修改）
+	AddTripExpense(context.Context, *TripExpenseRequest) (*TripExpenseResponse, error)
+	// AttachTripExpenseReceipt 为报销明细关联票据文件
+	AttachTripExpenseReceipt(context.Context, *TripExpenseReceiptRequest) (*emptypb.Empty, error)
+	// CreateTripExpensePolicy 创建差旅标准
+	CreateTripExpensePolicy(context.Context, *TripExpensePolicyRequest) (*TripExpensePolicyResponse, error)
+	// DeleteTripExpense 删除出差报销明细，同时取消票据关联
+	DeleteTripExpense(context.Context, *DeleteTripExpenseRequest) (*emptypb.Empty, error)
+	// DeleteTripExpensePolicy 删除差旅标准
+	DeleteTripExpensePolicy(context.Context, *DeleteTripExpensePolicyRequest) (*emptypb.Empty, error)
+	// DetachTripExpenseReceipt 取消报销明细的票据关联（文件本身不删除）
+	DetachTripExpenseReceipt(context.Context, *TripExpenseReceiptRequest) (*emptypb.Empty, error)
+	// GetTripExpenseClaim 获取出差报销单（含明细、超标标记和票据）
+	GetTripExpenseClaim(context.Context, *TripIDRequest) (*TripExpenseClaimResponse, error)
+	// GetTripExpensePolicy 获取差旅标准
+	GetTripExpensePolicy(context.Context, *GetTripExpensePolicyRequest) (*TripExpensePolicyResponse, error)
+	// ListTripDestinationTiers 目的地级别列表
+	ListTripDestinationTiers(context.Context, *ListTripDestinationTiersRequest) (*TripDestinationTiersResponse, error)
+	// ListTripExpensePolicies 租户差旅标准列表
+	ListTripExpensePolicies(context.Context, *ListTripExpensePoliciesRequest) (*ListTripExpensePoliciesResponse, error)
+	// ResolveTripExpensePolicy 查询出差适用的差旅标准
+	ResolveTripExpensePolicy(context.Context, *TripIDRequest) (*ResolveTripExpensePolicyResponse, error)
+	// SetTripDestinationTiers 整体替换目的地级别（未匹配任何关键字的目的地按 default 级别）
+	SetTripDestinationTiers(context.Context, *SetTripDestinationTiersRequest) (*TripDestinationTiersResponse, error)
+	// SubmitTripExpenseClaim 提交出差报销单审批
+	SubmitTripExpenseClaim(context.Context, *TripIDRequest) (*TripExpenseClaimResponse, error)
+	// UpdateTripExpense 修改出差报销明细（票据通过单独接口维护）
+	UpdateTripExpense(context.Context, *TripExpenseRequest) (*TripExpenseResponse, error)
+	// UpdateTripExpensePolicy 更新差旅标准（只影响之后变动的报销明细，已提交的报销单不重算）
+	UpdateTripExpensePolicy(context.Context, *TripExpensePolicyRequest) (*TripExpensePolicyResponse, error)
+}
+
+func RegisterTripExpenseServiceHTTPServer(s *http.Server, srv TripExpenseServiceHTTPServer) {
+	r := s.Route("/")
+	r.POST("/api/v1/hrm/business-trips/{trip_id}/expenses", _TripExpenseService_AddTripExpense0_HTTP_Handler(srv))
+	r.PUT("/api/v1/hrm/business-trips/{trip_id}/expenses/{id}", _TripExpenseService_UpdateTripExpense0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/hrm/business-trips/{trip_id}/expenses/{id}", _TripExpenseService_DeleteTripExpense0_HTTP_Handler(srv))
+	r.POST("/api/v1/hrm/business-trips/{trip_id}/expenses/{id}/receipts", _TripExpenseService_AttachTripExpenseReceipt0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/hrm/business-trips/{trip_id}/expenses/{id}/receipts/{file_id}", _TripExpenseService_DetachTripExpenseReceipt0_HTTP_Handler(srv))
+	r.GET("/api/v1/hrm/business-trips/{trip_id}/expense-claim", _TripExpenseService_GetTripExpenseClaim0_HTTP_Handler(srv))
+	r.POST("/api/v1/hrm/business-trips/{trip_id}/expense-claim/submit", _TripExpenseService_SubmitTripExpenseClaim0_HTTP_Handler(srv))
+	r.GET("/api/v1/hrm/business-trips/{trip_id}/expense-policy", _TripExpenseService_ResolveTripExpensePolicy0_HTTP_Handler(srv))
+	r.POST("/api/v1/hrm/trip-expense-policies", _TripExpenseService_CreateTripExpensePolicy0_HTTP_Handler(srv))
+	r.GET("/api/v1/hrm/trip-expense-policies", _TripExpenseService_ListTripExpensePolicies0_HTTP_Handler(srv))
+	r.GET("/api/v1/hrm/trip-expense-policies/{id}", _TripExpenseService_GetTripExpensePolicy0_HTTP_Handler(srv))
+	r.PUT("/api/v1/hrm/trip-expense-policies/{id}", _TripExpenseService_UpdateTripExpensePolicy0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/hrm/trip-expense-policies/{id}", _TripExpenseService_DeleteTripExpensePolicy0_HTTP_Handler(srv))
+	r.GET("/api/v1/hrm/trip-destination-tiers", _TripExpenseService_ListTripDestinationTiers0_HTTP_Handler(srv))
+	r.PUT("/api/v1/hrm/trip-destination-tiers", _TripExpenseService_SetTripDestinationTiers0_HTTP_Handler(srv))
+}
+
+func _TripExpenseService_AddTripExpense0_HTTP_Handler(srv TripExpenseServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TripExpenseRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTripExpenseServiceAddTripExpense)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddTripExpense(ctx, req.(*TripExpenseRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TripExpenseResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TripExpenseService_UpdateTripExpense0_HTTP_Handler(srv TripExpenseServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TripExpenseRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTripExpenseServiceUpdateTripExpense)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateTripExpense(ctx, req.(*TripExpenseRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TripExpenseResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TripExpenseService_DeleteTripExpense0_HTTP_Handler(srv TripExpenseServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteTripExpenseRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTripExpenseServiceDeleteTripExpense)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteTripExpense(ctx, req.(*DeleteTripExpenseRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TripExpenseService_AttachTripExpenseReceipt0_HTTP_Handler(srv TripExpenseServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TripExpenseReceiptRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTripExpenseServiceAttachTripExpenseReceipt)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AttachTripExpenseReceipt(ctx, req.(*TripExpenseReceiptRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TripExpenseService_DetachTripExpenseReceipt0_HTTP_Handler(srv TripExpenseServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TripExpenseReceiptRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTripExpenseServiceDetachTripExpenseReceipt)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DetachTripExpenseReceipt(ctx, req.(*TripExpenseReceiptRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TripExpenseService_GetTripExpenseClaim0_HTTP_Handler(srv TripExpenseServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TripIDRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTripExpenseServiceGetTripExpenseClaim)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTripExpenseClaim(ctx, req.(*TripIDRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TripExpenseClaimResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TripExpenseService_SubmitTripExpenseClaim0_HTTP_Handler(srv TripExpenseServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TripIDRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTripExpenseServiceSubmitTripExpenseClaim)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SubmitTripExpenseClaim(ctx, req.(*TripIDRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TripExpenseClaimResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TripExpenseService_ResolveTripExpensePolicy0_HTTP_Handler(srv TripExpenseServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TripIDRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTripExpenseServiceResolveTripExpensePolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResolveTripExpensePolicy(ctx, req.(*TripIDRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResolveTripExpensePolicyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TripExpenseService_CreateTripExpensePolicy0_HTTP_Handler(srv TripExpenseServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TripExpensePolicyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTripExpenseServiceCreateTripExpensePolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateTripExpensePolicy(ctx, req.(*TripExpensePolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TripExpensePolicyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TripExpenseService_ListTripExpensePolicies0_HTTP_Handler(srv TripExpenseServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTripExpensePoliciesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTripExpenseServiceListTripExpensePolicies)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTripExpensePolicies(ctx, req.(*ListTripExpensePoliciesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTripExpensePoliciesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TripExpenseService_GetTripExpensePolicy0_HTTP_Handler(srv TripExpenseServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTripExpensePolicyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTripExpenseServiceGetTripExpensePolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTripExpensePolicy(ctx, req.(*GetTripExpensePolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TripExpensePolicyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TripExpenseService_UpdateTripExpensePolicy0_HTTP_Handler(srv TripExpenseServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TripExpensePolicyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTripExpenseServiceUpdateTripExpensePolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateTripExpensePolicy(ctx, req.(*TripExpensePolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TripExpensePolicyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TripExpenseService_DeleteTripExpensePolicy0_HTTP_Handler(srv TripExpenseServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteTripExpensePolicyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTripExpenseServiceDeleteTripExpensePolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteTripExpensePolicy(ctx, req.(*DeleteTripExpensePolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TripExpenseService_ListTripDestinationTiers0_HTTP_Handler(srv TripExpenseServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTripDestinationTiersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTripExpenseServiceListTripDestinationTiers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTripDestinationTiers(ctx, req.(*ListTripDestinationTiersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TripDestinationTiersResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TripExpenseService_SetTripDestinationTiers0_HTTP_Handler(srv TripExpenseServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetTripDestinationTiersRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTripExpenseServiceSetTripDestinationTiers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetTripDestinationTiers(ctx, req.(*SetTripDestinationTiersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TripDestinationTiersResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type TripExpenseServiceHTTPClient interface {
+	// AddTripExpense 新增出差报销明细（出差需已批准，报销单审批中或已通过时不可修改）
+	AddTripExpense(ctx context.Context, req *TripExpenseRequest, opts ...http.CallOption) (rsp *TripExpenseResponse, err error)
+	// AttachTripExpenseReceipt 为报销明细关联票据文件
+	AttachTripExpenseReceipt(ctx context.Context, req *TripExpenseReceiptRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// CreateTripExpensePolicy 创建差旅标准
+	CreateTripExpensePolicy(ctx context.Context, req *TripExpensePolicyRequest, opts ...http.CallOption) (rsp *TripExpensePolicyResponse, err error)
+	// DeleteTripExpense 删除出差报销明细，同时取消票据关联
+	DeleteTripExpense(ctx context.Context, req *DeleteTripExpenseRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// DeleteTripExpensePolicy 删除差旅标准
+	DeleteTripExpensePolicy(ctx context.Context, req *DeleteTripExpensePolicyRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// DetachTripExpenseReceipt 取消报销明细的票据关联（文件本身不删除）
+	DetachTripExpenseReceipt(ctx context.Context, req *TripExpenseReceiptRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// GetTripExpenseClaim 获取出差报销单（含明细、超标标记和票据）
+	GetTripExpenseClaim(ctx context.Context, req *TripIDRequest, opts ...http.CallOption) (rsp *TripExpenseClaimResponse, err error)
+	// GetTripExpensePolicy 获取差旅标准
+	GetTripExpensePolicy(ctx context.Context, req *GetTripExpensePolicyRequest, opts ...http.CallOption) (rsp *TripExpensePolicyResponse, err error)
+	// ListTripDestinationTiers 目的地级别列表
+	ListTripDestinationTiers(ctx context.Context, req *ListTripDestinationTiersRequest, opts ...http.CallOption) (rsp *TripDestinationTiersResponse, err error)
+	// ListTripExpensePolicies 租户差旅标准列表
+	ListTripExpensePolicies(ctx context.Context, req *ListTripExpensePoliciesRequest, opts ...http.CallOption) (rsp *ListTripExpensePoliciesResponse, err error)
+	// ResolveTripExpensePolicy 查询出差适用的差旅标准
+	ResolveTripExpensePolicy(ctx context.Context, req *TripIDRequest, opts ...http.CallOption) (rsp *ResolveTripExpensePolicyResponse, err error)
+	// SetTripDestinationTiers 整体替换目的地级别（未匹配任何关键字的目的地按 default 级别）
+	SetTripDestinationTiers(ctx context.Context, req *SetTripDestinationTiersRequest, opts ...http.CallOption) (rsp *TripDestinationTiersResponse, err error)
+	// SubmitTripExpenseClaim 提交出差报销单审批
+	SubmitTripExpenseClaim(ctx context.Context, req *TripIDRequest, opts ...http.CallOption) (rsp *TripExpenseClaimResponse, err error)
+	// UpdateTripExpense 修改出差报销明细（票据通过单独接口维护）
+	UpdateTripExpense(ctx context.Context, req *TripExpenseRequest, opts ...http.CallOption) (rsp *TripExpenseResponse, err error)
+	// UpdateTripExpensePolicy 更新差旅标准（只影响之后变动的报销明细，已提交的报销单不重算）
+	UpdateTripExpensePolicy(ctx context.Context, req *TripExpensePolicyRequest, opts ...http.CallOption) (rsp *TripExpensePolicyResponse, err error)
+}
+
+type TripExpenseServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewTripExpenseServiceHTTPClient(client *http.Client) TripExpenseServiceHTTPClient {
+	return &TripExpenseServiceHTTPClientImpl{client}
+}
+
+// AddTripExpense 新增出差报销明细（出差需已批准，报销单审批中或已通过时不可修改）
+func (c *TripExpenseServiceHTTPClientImpl) AddTripExpense(ctx context.Context, in *TripExpenseRequest, opts ...http.CallOption) (*TripExpenseResponse, error) {
+	var out TripExpenseResponse
+	pattern := "/api/v1/hrm/business-trips/{trip_id}/expenses"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTripExpenseServiceAddTripExpense))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AttachTripExpenseReceipt 为报销明细关联票据文件
+func (c *TripExpenseServiceHTTPClientImpl) AttachTripExpenseReceipt(ctx context.Context, in *TripExpenseReceiptRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/v1/hrm/business-trips/{trip_id}/expenses/{id}/receipts"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTripExpenseServiceAttachTripExpenseReceipt))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateTripExpensePolicy 创建差旅标准
+func (c *TripExpenseServiceHTTPClientImpl) CreateTripExpensePolicy(ctx context.Context, in *TripExpensePolicyRequest, opts ...http.CallOption) (*TripExpensePolicyResponse, error) {
+	var out TripExpensePolicyResponse
+	pattern := "/api/v1/hrm/trip-expense-policies"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTripExpenseServiceCreateTripExpensePolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteTripExpense 删除出差报销明细，同时取消票据关联
+func (c *TripExpenseServiceHTTPClientImpl) DeleteTripExpense(ctx context.Context, in *DeleteTripExpenseRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/v1/hrm/business-trips/{trip_id}/expenses/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTripExpenseServiceDeleteTripExpense))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteTripExpensePolicy 删除差旅标准
+func (c *TripExpenseServiceHTTPClientImpl) DeleteTripExpensePolicy(ctx context.Context, in *DeleteTripExpensePolicyRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/v1/hrm/trip-expense-policies/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTripExpenseServiceDeleteTripExpensePolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DetachTripExpenseReceipt 取消报销明细的票据关联（文件本身不删除）
+func (c *TripExpenseServiceHTTPClientImpl) DetachTripExpenseReceipt(ctx context.Context, in *TripExpenseReceiptRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/v1/hrm/business-trips/{trip_id}/expenses/{id}/receipts/{file_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTripExpenseServiceDetachTripExpenseReceipt))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetTripExpenseClaim 获取出差报销单（含明细、超标标记和票据）
+func (c *TripExpenseServiceHTTPClientImpl) GetTripExpenseClaim(ctx context.Context, in *TripIDRequest, opts ...http.CallOption) (*TripExpenseClaimResponse, error) {
+	var out TripExpenseClaimResponse
+	pattern := "/api/v1/hrm/business-trips/{trip_id}/expense-claim"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTripExpenseServiceGetTripExpenseClaim))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetTripExpensePolicy 获取差旅标准
+func (c *TripExpenseServiceHTTPClientImpl) GetTripExpensePolicy(ctx context.Context, in *GetTripExpensePolicyRequest, opts ...http.CallOption) (*TripExpensePolicyResponse, error) {
+	var out TripExpensePolicyResponse
+	pattern := "/api/v1/hrm/trip-expense-policies/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTripExpenseServiceGetTripExpensePolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListTripDestinationTiers 目的地级别列表
+func (c *TripExpenseServiceHTTPClientImpl) ListTripDestinationTiers(ctx context.Context, in *ListTripDestinationTiersRequest, opts ...http.CallOption) (*TripDestinationTiersResponse, error) {
+	var out TripDestinationTiersResponse
+	pattern := "/api/v1/hrm/trip-destination-tiers"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTripExpenseServiceListTripDestinationTiers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListTripExpensePolicies 租户差旅标准列表
+func (c *TripExpenseServiceHTTPClientImpl) ListTripExpensePolicies(ctx context.Context, in *ListTripExpensePoliciesRequest, opts ...http.CallOption) (*ListTripExpensePoliciesResponse, error) {
+	var out ListTripExpensePoliciesResponse
+	pattern := "/api/v1/hrm/trip-expense-policies"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTripExpenseServiceListTripExpensePolicies))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ResolveTripExpensePolicy 查询出差适用的差旅标准
+func (c *TripExpenseServiceHTTPClientImpl) ResolveTripExpensePolicy(ctx context.Context, in *TripIDRequest, opts ...http.CallOption) (*ResolveTripExpensePolicyResponse, error) {
+	var out ResolveTripExpensePolicyResponse
+	pattern := "/api/v1/hrm/business-trips/{trip_id}/expense-policy"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTripExpenseServiceResolveTripExpensePolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetTripDestinationTiers 整体替换目的地级别（未匹配任何关键字的目的地按 default 级别）
+func (c *TripExpenseServiceHTTPClientImpl) SetTripDestinationTiers(ctx context.Context, in *SetTripDestinationTiersRequest, opts ...http.CallOption) (*TripDestinationTiersResponse, error) {
+	var out TripDestinationTiersResponse
+	pattern := "/api/v1/hrm/trip-destination-tiers"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTripExpenseServiceSetTripDestinationTiers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SubmitTripExpenseClaim 提交出差报销单审批
+func (c *TripExpenseServiceHTTPClientImpl) SubmitTripExpenseClaim(ctx context.Context, in *TripIDRequest, opts ...http.CallOption) (*TripExpenseClaimResponse, error) {
+	var out TripExpenseClaimResponse
+	pattern := "/api/v1/hrm/business-trips/{trip_id}/expense-claim/submit"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTripExpenseServiceSubmitTripExpenseClaim))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateTripExpense 修改出差报销明细（票据通过单独接口维护）
+func (c *TripExpenseServiceHTTPClientImpl) UpdateTripExpense(ctx context.Context, in *TripExpenseRequest, opts ...http.CallOption) (*TripExpenseResponse, error) {
+	var out TripExpenseResponse
+	pattern := "/api/v1/hrm/business-trips/{trip_id}/expenses/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTripExpenseServiceUpdateTripExpense))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateTripExpensePolicy 更新差旅标准（只影响之后变动的报销明细，已提交的报销单不重算）
+func (c *TripExpenseServiceHTTPClientImpl) UpdateTripExpensePolicy(ctx context.Context, in *TripExpensePolicyRequest, opts ...http.CallOption) (*TripExpensePolicyResponse, error) {
+	var out TripExpensePolicyResponse
+	pattern := "/api/v1/hrm/trip-expense-policies/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTripExpenseServiceUpdateTripExpensePolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
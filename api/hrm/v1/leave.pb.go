@@ -871,42 +871,35 @@ func (x *QuotaResponse) GetExpiredAt() *timestamppb.Timestamp {
 	return nil
 }
 
-// 创建请假类型
-type CreateLeaveTypeRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	TenantId         string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Code             string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	IsPaid           bool                   `protobuf:"varint,5,opt,name=is_paid,json=isPaid,proto3" json:"is_paid,omitempty"`
-	RequiresApproval bool                   `protobuf:"varint,6,opt,name=requires_approval,json=requiresApproval,proto3" json:"requires_approval,omitempty"`
-	RequiresProof    bool                   `protobuf:"varint,7,opt,name=requires_proof,json=requiresProof,proto3" json:"requires_proof,omitempty"`
-	DeductQuota      bool                   `protobuf:"varint,8,opt,name=deduct_quota,json=deductQuota,proto3" json:"deduct_quota,omitempty"`
-	Unit             string                 `protobuf:"bytes,9,opt,name=unit,proto3" json:"unit,omitempty"`
-	MinDuration      float64                `protobuf:"fixed64,10,opt,name=min_duration,json=minDuration,proto3" json:"min_duration,omitempty"`
-	MaxDuration      float64                `protobuf:"fixed64,11,opt,name=max_duration,json=maxDuration,proto3" json:"max_duration,omitempty"`
-	AdvanceDays      int32                  `protobuf:"varint,12,opt,name=advance_days,json=advanceDays,proto3" json:"advance_days,omitempty"`
-	ApprovalRules    *ApprovalRules         `protobuf:"bytes,13,opt,name=approval_rules,json=approvalRules,proto3" json:"approval_rules,omitempty"` // 审批规则配置
-	Color            string                 `protobuf:"bytes,14,opt,name=color,proto3" json:"color,omitempty"`
-	Sort             int32                  `protobuf:"varint,15,opt,name=sort,proto3" json:"sort,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// 请假某天的时长
+type LeaveDayDuration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`                      // YYYY-MM-DD
+	DayType       string                 `protobuf:"bytes,2,opt,name=day_type,json=dayType,proto3" json:"day_type,omitempty"` // workday, weekend, holiday
+	ShiftId       string                 `protobuf:"bytes,3,opt,name=shift_id,json=shiftId,proto3" json:"shift_id,omitempty"`
+	ShiftName     string                 `protobuf:"bytes,4,opt,name=shift_name,json=shiftName,proto3" json:"shift_name,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // 当天请假起止（已与班次求交）
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Hours         float64                `protobuf:"fixed64,7,opt,name=hours,proto3" json:"hours,omitempty"`       // 扣除休息时间后的请假小时数
+	Duration      float64                `protobuf:"fixed64,8,opt,name=duration,proto3" json:"duration,omitempty"` // 按请假单位取整后的时长
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateLeaveTypeRequest) Reset() {
-	*x = CreateLeaveTypeRequest{}
+func (x *LeaveDayDuration) Reset() {
+	*x = LeaveDayDuration{}
 	mi := &file_api_hrm_v1_leave_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateLeaveTypeRequest) String() string {
+func (x *LeaveDayDuration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateLeaveTypeRequest) ProtoMessage() {}
+func (*LeaveDayDuration) ProtoMessage() {}
 
-func (x *CreateLeaveTypeRequest) ProtoReflect() protoreflect.Message {
+func (x *LeaveDayDuration) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hrm_v1_leave_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -918,153 +911,250 @@ func (x *CreateLeaveTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateLeaveTypeRequest.ProtoReflect.Descriptor instead.
-func (*CreateLeaveTypeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LeaveDayDuration.ProtoReflect.Descriptor instead.
+func (*LeaveDayDuration) Descriptor() ([]byte, []int) {
 	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CreateLeaveTypeRequest) GetTenantId() string {
+func (x *LeaveDayDuration) GetDate() string {
 	if x != nil {
-		return x.TenantId
+		return x.Date
 	}
 	return ""
 }
 
-func (x *CreateLeaveTypeRequest) GetCode() string {
+func (x *LeaveDayDuration) GetDayType() string {
 	if x != nil {
-		return x.Code
+		return x.DayType
 	}
 	return ""
 }
 
-func (x *CreateLeaveTypeRequest) GetName() string {
+func (x *LeaveDayDuration) GetShiftId() string {
 	if x != nil {
-		return x.Name
+		return x.ShiftId
 	}
 	return ""
 }
 
-func (x *CreateLeaveTypeRequest) GetDescription() string {
+func (x *LeaveDayDuration) GetShiftName() string {
 	if x != nil {
-		return x.Description
+		return x.ShiftName
 	}
 	return ""
 }
 
-func (x *CreateLeaveTypeRequest) GetIsPaid() bool {
+func (x *LeaveDayDuration) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.IsPaid
+		return x.StartTime
 	}
-	return false
+	return nil
 }
 
-func (x *CreateLeaveTypeRequest) GetRequiresApproval() bool {
+func (x *LeaveDayDuration) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RequiresApproval
+		return x.EndTime
 	}
-	return false
+	return nil
 }
 
-func (x *CreateLeaveTypeRequest) GetRequiresProof() bool {
+func (x *LeaveDayDuration) GetHours() float64 {
 	if x != nil {
-		return x.RequiresProof
+		return x.Hours
 	}
-	return false
+	return 0
 }
 
-func (x *CreateLeaveTypeRequest) GetDeductQuota() bool {
+func (x *LeaveDayDuration) GetDuration() float64 {
 	if x != nil {
-		return x.DeductQuota
+		return x.Duration
 	}
-	return false
+	return 0
 }
 
-func (x *CreateLeaveTypeRequest) GetUnit() string {
+// 请假在某个月的时长（跨月请假按月拆分）
+type LeaveMonthDuration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month         int32                  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	Hours         float64                `protobuf:"fixed64,3,opt,name=hours,proto3" json:"hours,omitempty"`
+	Duration      float64                `protobuf:"fixed64,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveMonthDuration) Reset() {
+	*x = LeaveMonthDuration{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveMonthDuration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveMonthDuration) ProtoMessage() {}
+
+func (x *LeaveMonthDuration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[9]
 	if x != nil {
-		return x.Unit
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateLeaveTypeRequest) GetMinDuration() float64 {
+// Deprecated: Use LeaveMonthDuration.ProtoReflect.Descriptor instead.
+func (*LeaveMonthDuration) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *LeaveMonthDuration) GetYear() int32 {
 	if x != nil {
-		return x.MinDuration
+		return x.Year
 	}
 	return 0
 }
 
-func (x *CreateLeaveTypeRequest) GetMaxDuration() float64 {
+func (x *LeaveMonthDuration) GetMonth() int32 {
 	if x != nil {
-		return x.MaxDuration
+		return x.Month
 	}
 	return 0
 }
 
-func (x *CreateLeaveTypeRequest) GetAdvanceDays() int32 {
+func (x *LeaveMonthDuration) GetHours() float64 {
 	if x != nil {
-		return x.AdvanceDays
+		return x.Hours
 	}
 	return 0
 }
 
-func (x *CreateLeaveTypeRequest) GetApprovalRules() *ApprovalRules {
+func (x *LeaveMonthDuration) GetDuration() float64 {
 	if x != nil {
-		return x.ApprovalRules
+		return x.Duration
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateLeaveTypeRequest) GetColor() string {
+// 请假时长计算结果
+type LeaveDurationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Unit          string                 `protobuf:"bytes,1,opt,name=unit,proto3" json:"unit,omitempty"`
+	Duration      float64                `protobuf:"fixed64,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	Hours         float64                `protobuf:"fixed64,3,opt,name=hours,proto3" json:"hours,omitempty"`
+	Days          []*LeaveDayDuration    `protobuf:"bytes,4,rep,name=days,proto3" json:"days,omitempty"`
+	Months        []*LeaveMonthDuration  `protobuf:"bytes,5,rep,name=months,proto3" json:"months,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveDurationResponse) Reset() {
+	*x = LeaveDurationResponse{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveDurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveDurationResponse) ProtoMessage() {}
+
+func (x *LeaveDurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[10]
 	if x != nil {
-		return x.Color
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveDurationResponse.ProtoReflect.Descriptor instead.
+func (*LeaveDurationResponse) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LeaveDurationResponse) GetUnit() string {
+	if x != nil {
+		return x.Unit
 	}
 	return ""
 }
 
-func (x *CreateLeaveTypeRequest) GetSort() int32 {
+func (x *LeaveDurationResponse) GetDuration() float64 {
 	if x != nil {
-		return x.Sort
+		return x.Duration
 	}
 	return 0
 }
 
-// 更新请假类型
-type UpdateLeaveTypeRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description      string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	IsPaid           bool                   `protobuf:"varint,4,opt,name=is_paid,json=isPaid,proto3" json:"is_paid,omitempty"`
-	RequiresApproval bool                   `protobuf:"varint,5,opt,name=requires_approval,json=requiresApproval,proto3" json:"requires_approval,omitempty"`
-	RequiresProof    bool                   `protobuf:"varint,6,opt,name=requires_proof,json=requiresProof,proto3" json:"requires_proof,omitempty"`
-	DeductQuota      bool                   `protobuf:"varint,7,opt,name=deduct_quota,json=deductQuota,proto3" json:"deduct_quota,omitempty"`
-	Unit             string                 `protobuf:"bytes,8,opt,name=unit,proto3" json:"unit,omitempty"`
-	MinDuration      float64                `protobuf:"fixed64,9,opt,name=min_duration,json=minDuration,proto3" json:"min_duration,omitempty"`
-	MaxDuration      float64                `protobuf:"fixed64,10,opt,name=max_duration,json=maxDuration,proto3" json:"max_duration,omitempty"`
-	AdvanceDays      int32                  `protobuf:"varint,11,opt,name=advance_days,json=advanceDays,proto3" json:"advance_days,omitempty"`
-	ApprovalRules    *ApprovalRules         `protobuf:"bytes,12,opt,name=approval_rules,json=approvalRules,proto3" json:"approval_rules,omitempty"` // 审批规则配置
-	Color            string                 `protobuf:"bytes,13,opt,name=color,proto3" json:"color,omitempty"`
-	IsActive         bool                   `protobuf:"varint,14,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	Sort             int32                  `protobuf:"varint,15,opt,name=sort,proto3" json:"sort,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *LeaveDurationResponse) GetHours() float64 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
 }
 
-func (x *UpdateLeaveTypeRequest) Reset() {
-	*x = UpdateLeaveTypeRequest{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[9]
+func (x *LeaveDurationResponse) GetDays() []*LeaveDayDuration {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *LeaveDurationResponse) GetMonths() []*LeaveMonthDuration {
+	if x != nil {
+		return x.Months
+	}
+	return nil
+}
+
+// 额度流水
+type LeaveQuotaLedgerEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	EmployeeId    string                 `protobuf:"bytes,3,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	LeaveTypeId   string                 `protobuf:"bytes,4,opt,name=leave_type_id,json=leaveTypeId,proto3" json:"leave_type_id,omitempty"`
+	QuotaId       string                 `protobuf:"bytes,5,opt,name=quota_id,json=quotaId,proto3" json:"quota_id,omitempty"`
+	Year          int32                  `protobuf:"varint,6,opt,name=year,proto3" json:"year,omitempty"`
+	EntryType     string                 `protobuf:"bytes,7,opt,name=entry_type,json=entryType,proto3" json:"entry_type,omitempty"`
+	Amount        float64                `protobuf:"fixed64,8,opt,name=amount,proto3" json:"amount,omitempty"`                                 // 变动数量（正为增加，负为减少）
+	BalanceAfter  float64                `protobuf:"fixed64,9,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"` // 变动后的剩余额度
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`           // 本笔额度的过期时间（结转、调休）
+	Reason        string                 `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"`
+	SourceType    string                 `protobuf:"bytes,12,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"` // leave_request, overtime, ledger, policy, manual
+	SourceId      string                 `protobuf:"bytes,13,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	OperatorId    string                 `protobuf:"bytes,14,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveQuotaLedgerEntry) Reset() {
+	*x = LeaveQuotaLedgerEntry{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateLeaveTypeRequest) String() string {
+func (x *LeaveQuotaLedgerEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateLeaveTypeRequest) ProtoMessage() {}
+func (*LeaveQuotaLedgerEntry) ProtoMessage() {}
 
-func (x *UpdateLeaveTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[9]
+func (x *LeaveQuotaLedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1075,139 +1165,682 @@ func (x *UpdateLeaveTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateLeaveTypeRequest.ProtoReflect.Descriptor instead.
-func (*UpdateLeaveTypeRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use LeaveQuotaLedgerEntry.ProtoReflect.Descriptor instead.
+func (*LeaveQuotaLedgerEntry) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *UpdateLeaveTypeRequest) GetId() string {
+func (x *LeaveQuotaLedgerEntry) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateLeaveTypeRequest) GetName() string {
+func (x *LeaveQuotaLedgerEntry) GetTenantId() string {
 	if x != nil {
-		return x.Name
+		return x.TenantId
 	}
 	return ""
 }
 
-func (x *UpdateLeaveTypeRequest) GetDescription() string {
+func (x *LeaveQuotaLedgerEntry) GetEmployeeId() string {
 	if x != nil {
-		return x.Description
+		return x.EmployeeId
 	}
 	return ""
 }
 
-func (x *UpdateLeaveTypeRequest) GetIsPaid() bool {
-	if x != nil {
-		return x.IsPaid
-	}
-	return false
-}
-
-func (x *UpdateLeaveTypeRequest) GetRequiresApproval() bool {
+func (x *LeaveQuotaLedgerEntry) GetLeaveTypeId() string {
 	if x != nil {
-		return x.RequiresApproval
+		return x.LeaveTypeId
 	}
-	return false
+	return ""
 }
 
-func (x *UpdateLeaveTypeRequest) GetRequiresProof() bool {
+func (x *LeaveQuotaLedgerEntry) GetQuotaId() string {
 	if x != nil {
-		return x.RequiresProof
+		return x.QuotaId
 	}
-	return false
+	return ""
 }
 
-func (x *UpdateLeaveTypeRequest) GetDeductQuota() bool {
+func (x *LeaveQuotaLedgerEntry) GetYear() int32 {
 	if x != nil {
-		return x.DeductQuota
+		return x.Year
 	}
-	return false
+	return 0
 }
 
-func (x *UpdateLeaveTypeRequest) GetUnit() string {
+func (x *LeaveQuotaLedgerEntry) GetEntryType() string {
 	if x != nil {
-		return x.Unit
+		return x.EntryType
 	}
 	return ""
 }
 
-func (x *UpdateLeaveTypeRequest) GetMinDuration() float64 {
+func (x *LeaveQuotaLedgerEntry) GetAmount() float64 {
 	if x != nil {
-		return x.MinDuration
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *UpdateLeaveTypeRequest) GetMaxDuration() float64 {
+func (x *LeaveQuotaLedgerEntry) GetBalanceAfter() float64 {
 	if x != nil {
-		return x.MaxDuration
+		return x.BalanceAfter
 	}
 	return 0
 }
 
-func (x *UpdateLeaveTypeRequest) GetAdvanceDays() int32 {
+func (x *LeaveQuotaLedgerEntry) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.AdvanceDays
+		return x.ExpiresAt
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateLeaveTypeRequest) GetApprovalRules() *ApprovalRules {
+func (x *LeaveQuotaLedgerEntry) GetReason() string {
 	if x != nil {
-		return x.ApprovalRules
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateLeaveTypeRequest) GetColor() string {
+func (x *LeaveQuotaLedgerEntry) GetSourceType() string {
 	if x != nil {
-		return x.Color
+		return x.SourceType
 	}
 	return ""
 }
 
-func (x *UpdateLeaveTypeRequest) GetIsActive() bool {
+func (x *LeaveQuotaLedgerEntry) GetSourceId() string {
 	if x != nil {
-		return x.IsActive
+		return x.SourceId
 	}
-	return false
+	return ""
 }
 
-func (x *UpdateLeaveTypeRequest) GetSort() int32 {
+func (x *LeaveQuotaLedgerEntry) GetOperatorId() string {
 	if x != nil {
-		return x.Sort
+		return x.OperatorId
 	}
-	return 0
-}
-
-// 删除请假类型
-type DeleteLeaveTypeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *DeleteLeaveTypeRequest) Reset() {
-	*x = DeleteLeaveTypeRequest{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[10]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *LeaveQuotaLedgerEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *DeleteLeaveTypeRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// 创建请假类型
+type CreateLeaveTypeRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TenantId         string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Code             string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	IsPaid           bool                   `protobuf:"varint,5,opt,name=is_paid,json=isPaid,proto3" json:"is_paid,omitempty"`
+	RequiresApproval bool                   `protobuf:"varint,6,opt,name=requires_approval,json=requiresApproval,proto3" json:"requires_approval,omitempty"`
+	RequiresProof    bool                   `protobuf:"varint,7,opt,name=requires_proof,json=requiresProof,proto3" json:"requires_proof,omitempty"`
+	DeductQuota      bool                   `protobuf:"varint,8,opt,name=deduct_quota,json=deductQuota,proto3" json:"deduct_quota,omitempty"`
+	Unit             string                 `protobuf:"bytes,9,opt,name=unit,proto3" json:"unit,omitempty"`
+	MinDuration      float64                `protobuf:"fixed64,10,opt,name=min_duration,json=minDuration,proto3" json:"min_duration,omitempty"`
+	MaxDuration      float64                `protobuf:"fixed64,11,opt,name=max_duration,json=maxDuration,proto3" json:"max_duration,omitempty"`
+	AdvanceDays      int32                  `protobuf:"varint,12,opt,name=advance_days,json=advanceDays,proto3" json:"advance_days,omitempty"`
+	ApprovalRules    *ApprovalRules         `protobuf:"bytes,13,opt,name=approval_rules,json=approvalRules,proto3" json:"approval_rules,omitempty"` // 审批规则配置
+	Color            string                 `protobuf:"bytes,14,opt,name=color,proto3" json:"color,omitempty"`
+	Sort             int32                  `protobuf:"varint,15,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateLeaveTypeRequest) Reset() {
+	*x = CreateLeaveTypeRequest{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLeaveTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLeaveTypeRequest) ProtoMessage() {}
+
+func (x *CreateLeaveTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLeaveTypeRequest.ProtoReflect.Descriptor instead.
+func (*CreateLeaveTypeRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateLeaveTypeRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CreateLeaveTypeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateLeaveTypeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateLeaveTypeRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateLeaveTypeRequest) GetIsPaid() bool {
+	if x != nil {
+		return x.IsPaid
+	}
+	return false
+}
+
+func (x *CreateLeaveTypeRequest) GetRequiresApproval() bool {
+	if x != nil {
+		return x.RequiresApproval
+	}
+	return false
+}
+
+func (x *CreateLeaveTypeRequest) GetRequiresProof() bool {
+	if x != nil {
+		return x.RequiresProof
+	}
+	return false
+}
+
+func (x *CreateLeaveTypeRequest) GetDeductQuota() bool {
+	if x != nil {
+		return x.DeductQuota
+	}
+	return false
+}
+
+func (x *CreateLeaveTypeRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *CreateLeaveTypeRequest) GetMinDuration() float64 {
+	if x != nil {
+		return x.MinDuration
+	}
+	return 0
+}
+
+func (x *CreateLeaveTypeRequest) GetMaxDuration() float64 {
+	if x != nil {
+		return x.MaxDuration
+	}
+	return 0
+}
+
+func (x *CreateLeaveTypeRequest) GetAdvanceDays() int32 {
+	if x != nil {
+		return x.AdvanceDays
+	}
+	return 0
+}
+
+func (x *CreateLeaveTypeRequest) GetApprovalRules() *ApprovalRules {
+	if x != nil {
+		return x.ApprovalRules
+	}
+	return nil
+}
+
+func (x *CreateLeaveTypeRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *CreateLeaveTypeRequest) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+// 更新请假类型
+type UpdateLeaveTypeRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	IsPaid           bool                   `protobuf:"varint,4,opt,name=is_paid,json=isPaid,proto3" json:"is_paid,omitempty"`
+	RequiresApproval bool                   `protobuf:"varint,5,opt,name=requires_approval,json=requiresApproval,proto3" json:"requires_approval,omitempty"`
+	RequiresProof    bool                   `protobuf:"varint,6,opt,name=requires_proof,json=requiresProof,proto3" json:"requires_proof,omitempty"`
+	DeductQuota      bool                   `protobuf:"varint,7,opt,name=deduct_quota,json=deductQuota,proto3" json:"deduct_quota,omitempty"`
+	Unit             string                 `protobuf:"bytes,8,opt,name=unit,proto3" json:"unit,omitempty"`
+	MinDuration      float64                `protobuf:"fixed64,9,opt,name=min_duration,json=minDuration,proto3" json:"min_duration,omitempty"`
+	MaxDuration      float64                `protobuf:"fixed64,10,opt,name=max_duration,json=maxDuration,proto3" json:"max_duration,omitempty"`
+	AdvanceDays      int32                  `protobuf:"varint,11,opt,name=advance_days,json=advanceDays,proto3" json:"advance_days,omitempty"`
+	ApprovalRules    *ApprovalRules         `protobuf:"bytes,12,opt,name=approval_rules,json=approvalRules,proto3" json:"approval_rules,omitempty"` // 审批规则配置
+	Color            string                 `protobuf:"bytes,13,opt,name=color,proto3" json:"color,omitempty"`
+	IsActive         bool                   `protobuf:"varint,14,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Sort             int32                  `protobuf:"varint,15,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateLeaveTypeRequest) Reset() {
+	*x = UpdateLeaveTypeRequest{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLeaveTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLeaveTypeRequest) ProtoMessage() {}
+
+func (x *UpdateLeaveTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLeaveTypeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLeaveTypeRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateLeaveTypeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateLeaveTypeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateLeaveTypeRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateLeaveTypeRequest) GetIsPaid() bool {
+	if x != nil {
+		return x.IsPaid
+	}
+	return false
+}
+
+func (x *UpdateLeaveTypeRequest) GetRequiresApproval() bool {
+	if x != nil {
+		return x.RequiresApproval
+	}
+	return false
+}
+
+func (x *UpdateLeaveTypeRequest) GetRequiresProof() bool {
+	if x != nil {
+		return x.RequiresProof
+	}
+	return false
+}
+
+func (x *UpdateLeaveTypeRequest) GetDeductQuota() bool {
+	if x != nil {
+		return x.DeductQuota
+	}
+	return false
+}
+
+func (x *UpdateLeaveTypeRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *UpdateLeaveTypeRequest) GetMinDuration() float64 {
+	if x != nil {
+		return x.MinDuration
+	}
+	return 0
+}
+
+func (x *UpdateLeaveTypeRequest) GetMaxDuration() float64 {
+	if x != nil {
+		return x.MaxDuration
+	}
+	return 0
+}
+
+func (x *UpdateLeaveTypeRequest) GetAdvanceDays() int32 {
+	if x != nil {
+		return x.AdvanceDays
+	}
+	return 0
+}
+
+func (x *UpdateLeaveTypeRequest) GetApprovalRules() *ApprovalRules {
+	if x != nil {
+		return x.ApprovalRules
+	}
+	return nil
+}
+
+func (x *UpdateLeaveTypeRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *UpdateLeaveTypeRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *UpdateLeaveTypeRequest) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+// 删除请假类型
+type DeleteLeaveTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLeaveTypeRequest) Reset() {
+	*x = DeleteLeaveTypeRequest{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLeaveTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLeaveTypeRequest) ProtoMessage() {}
+
+func (x *DeleteLeaveTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLeaveTypeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLeaveTypeRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteLeaveTypeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteLeaveTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLeaveTypeResponse) Reset() {
+	*x = DeleteLeaveTypeResponse{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLeaveTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLeaveTypeResponse) ProtoMessage() {}
+
+func (x *DeleteLeaveTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLeaveTypeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLeaveTypeResponse) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteLeaveTypeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 获取请假类型
+type GetLeaveTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeaveTypeRequest) Reset() {
+	*x = GetLeaveTypeRequest{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaveTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaveTypeRequest) ProtoMessage() {}
+
+func (x *GetLeaveTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaveTypeRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaveTypeRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetLeaveTypeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// 列表查询请假类型
+type ListLeaveTypesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	IsActive      bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	RequiresProof bool                   `protobuf:"varint,3,opt,name=requires_proof,json=requiresProof,proto3" json:"requires_proof,omitempty"`
+	DeductQuota   bool                   `protobuf:"varint,4,opt,name=deduct_quota,json=deductQuota,proto3" json:"deduct_quota,omitempty"`
+	Keyword       string                 `protobuf:"bytes,5,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Page          int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLeaveTypesRequest) Reset() {
+	*x = ListLeaveTypesRequest{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLeaveTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLeaveTypesRequest) ProtoMessage() {}
+
+func (x *ListLeaveTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLeaveTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListLeaveTypesRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListLeaveTypesRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListLeaveTypesRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *ListLeaveTypesRequest) GetRequiresProof() bool {
+	if x != nil {
+		return x.RequiresProof
+	}
+	return false
+}
+
+func (x *ListLeaveTypesRequest) GetDeductQuota() bool {
+	if x != nil {
+		return x.DeductQuota
+	}
+	return false
+}
+
+func (x *ListLeaveTypesRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
 }
 
-func (*DeleteLeaveTypeRequest) ProtoMessage() {}
+func (x *ListLeaveTypesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
 
-func (x *DeleteLeaveTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[10]
+func (x *ListLeaveTypesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListLeaveTypesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*LeaveTypeResponse   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLeaveTypesResponse) Reset() {
+	*x = ListLeaveTypesResponse{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLeaveTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLeaveTypesResponse) ProtoMessage() {}
+
+func (x *ListLeaveTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1218,40 +1851,62 @@ func (x *DeleteLeaveTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteLeaveTypeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteLeaveTypeRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListLeaveTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListLeaveTypesResponse) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *DeleteLeaveTypeRequest) GetId() string {
+func (x *ListLeaveTypesResponse) GetItems() []*LeaveTypeResponse {
 	if x != nil {
-		return x.Id
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-type DeleteLeaveTypeResponse struct {
+func (x *ListLeaveTypesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListLeaveTypesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListLeaveTypesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 获取启用的请假类型
+type ListActiveLeaveTypesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteLeaveTypeResponse) Reset() {
-	*x = DeleteLeaveTypeResponse{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[11]
+func (x *ListActiveLeaveTypesRequest) Reset() {
+	*x = ListActiveLeaveTypesRequest{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteLeaveTypeResponse) String() string {
+func (x *ListActiveLeaveTypesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteLeaveTypeResponse) ProtoMessage() {}
+func (*ListActiveLeaveTypesRequest) ProtoMessage() {}
 
-func (x *DeleteLeaveTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[11]
+func (x *ListActiveLeaveTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1262,41 +1917,40 @@ func (x *DeleteLeaveTypeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteLeaveTypeResponse.ProtoReflect.Descriptor instead.
-func (*DeleteLeaveTypeResponse) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListActiveLeaveTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListActiveLeaveTypesRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *DeleteLeaveTypeResponse) GetSuccess() bool {
+func (x *ListActiveLeaveTypesRequest) GetTenantId() string {
 	if x != nil {
-		return x.Success
+		return x.TenantId
 	}
-	return false
+	return ""
 }
 
-// 获取请假类型
-type GetLeaveTypeRequest struct {
+type ListActiveLeaveTypesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Items         []*LeaveTypeResponse   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLeaveTypeRequest) Reset() {
-	*x = GetLeaveTypeRequest{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[12]
+func (x *ListActiveLeaveTypesResponse) Reset() {
+	*x = ListActiveLeaveTypesResponse{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLeaveTypeRequest) String() string {
+func (x *ListActiveLeaveTypesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeaveTypeRequest) ProtoMessage() {}
+func (*ListActiveLeaveTypesResponse) ProtoMessage() {}
 
-func (x *GetLeaveTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[12]
+func (x *ListActiveLeaveTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1307,47 +1961,50 @@ func (x *GetLeaveTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeaveTypeRequest.ProtoReflect.Descriptor instead.
-func (*GetLeaveTypeRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListActiveLeaveTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListActiveLeaveTypesResponse) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetLeaveTypeRequest) GetId() string {
+func (x *ListActiveLeaveTypesResponse) GetItems() []*LeaveTypeResponse {
 	if x != nil {
-		return x.Id
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-// 列表查询请假类型
-type ListLeaveTypesRequest struct {
+// 创建请假申请
+type CreateLeaveRequestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	IsActive      bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	RequiresProof bool                   `protobuf:"varint,3,opt,name=requires_proof,json=requiresProof,proto3" json:"requires_proof,omitempty"`
-	DeductQuota   bool                   `protobuf:"varint,4,opt,name=deduct_quota,json=deductQuota,proto3" json:"deduct_quota,omitempty"`
-	Keyword       string                 `protobuf:"bytes,5,opt,name=keyword,proto3" json:"keyword,omitempty"`
-	Page          int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	EmployeeId    string                 `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	EmployeeName  string                 `protobuf:"bytes,3,opt,name=employee_name,json=employeeName,proto3" json:"employee_name,omitempty"`
+	DepartmentId  string                 `protobuf:"bytes,4,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	LeaveTypeId   string                 `protobuf:"bytes,5,opt,name=leave_type_id,json=leaveTypeId,proto3" json:"leave_type_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Duration      float64                `protobuf:"fixed64,8,opt,name=duration,proto3" json:"duration,omitempty"`
+	Reason        string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	ProofUrls     []string               `protobuf:"bytes,10,rep,name=proof_urls,json=proofUrls,proto3" json:"proof_urls,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListLeaveTypesRequest) Reset() {
-	*x = ListLeaveTypesRequest{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[13]
+func (x *CreateLeaveRequestRequest) Reset() {
+	*x = CreateLeaveRequestRequest{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLeaveTypesRequest) String() string {
+func (x *CreateLeaveRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLeaveTypesRequest) ProtoMessage() {}
+func (*CreateLeaveRequestRequest) ProtoMessage() {}
 
-func (x *ListLeaveTypesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[13]
+func (x *CreateLeaveRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1358,85 +2015,109 @@ func (x *ListLeaveTypesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLeaveTypesRequest.ProtoReflect.Descriptor instead.
-func (*ListLeaveTypesRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CreateLeaveRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreateLeaveRequestRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ListLeaveTypesRequest) GetTenantId() string {
+func (x *CreateLeaveRequestRequest) GetTenantId() string {
 	if x != nil {
 		return x.TenantId
 	}
 	return ""
 }
 
-func (x *ListLeaveTypesRequest) GetIsActive() bool {
+func (x *CreateLeaveRequestRequest) GetEmployeeId() string {
 	if x != nil {
-		return x.IsActive
+		return x.EmployeeId
 	}
-	return false
+	return ""
 }
 
-func (x *ListLeaveTypesRequest) GetRequiresProof() bool {
+func (x *CreateLeaveRequestRequest) GetEmployeeName() string {
 	if x != nil {
-		return x.RequiresProof
+		return x.EmployeeName
 	}
-	return false
+	return ""
 }
 
-func (x *ListLeaveTypesRequest) GetDeductQuota() bool {
+func (x *CreateLeaveRequestRequest) GetDepartmentId() string {
 	if x != nil {
-		return x.DeductQuota
+		return x.DepartmentId
 	}
-	return false
+	return ""
 }
 
-func (x *ListLeaveTypesRequest) GetKeyword() string {
+func (x *CreateLeaveRequestRequest) GetLeaveTypeId() string {
 	if x != nil {
-		return x.Keyword
+		return x.LeaveTypeId
 	}
 	return ""
 }
 
-func (x *ListLeaveTypesRequest) GetPage() int32 {
+func (x *CreateLeaveRequestRequest) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Page
+		return x.StartTime
 	}
-	return 0
+	return nil
 }
 
-func (x *ListLeaveTypesRequest) GetPageSize() int32 {
+func (x *CreateLeaveRequestRequest) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PageSize
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CreateLeaveRequestRequest) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
 	}
 	return 0
 }
 
-type ListLeaveTypesResponse struct {
+func (x *CreateLeaveRequestRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CreateLeaveRequestRequest) GetProofUrls() []string {
+	if x != nil {
+		return x.ProofUrls
+	}
+	return nil
+}
+
+// 更新请假申请
+type UpdateLeaveRequestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*LeaveTypeResponse   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Duration      float64                `protobuf:"fixed64,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	ProofUrls     []string               `protobuf:"bytes,6,rep,name=proof_urls,json=proofUrls,proto3" json:"proof_urls,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListLeaveTypesResponse) Reset() {
-	*x = ListLeaveTypesResponse{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[14]
+func (x *UpdateLeaveRequestRequest) Reset() {
+	*x = UpdateLeaveRequestRequest{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLeaveTypesResponse) String() string {
+func (x *UpdateLeaveRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLeaveTypesResponse) ProtoMessage() {}
+func (*UpdateLeaveRequestRequest) ProtoMessage() {}
 
-func (x *ListLeaveTypesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[14]
+func (x *UpdateLeaveRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1447,62 +2128,77 @@ func (x *ListLeaveTypesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLeaveTypesResponse.ProtoReflect.Descriptor instead.
-func (*ListLeaveTypesResponse) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use UpdateLeaveRequestRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLeaveRequestRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ListLeaveTypesResponse) GetItems() []*LeaveTypeResponse {
+func (x *UpdateLeaveRequestRequest) GetId() string {
 	if x != nil {
-		return x.Items
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateLeaveRequestRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
 	}
 	return nil
 }
 
-func (x *ListLeaveTypesResponse) GetTotal() int32 {
+func (x *UpdateLeaveRequestRequest) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Total
+		return x.EndTime
 	}
-	return 0
+	return nil
 }
 
-func (x *ListLeaveTypesResponse) GetPage() int32 {
+func (x *UpdateLeaveRequestRequest) GetDuration() float64 {
 	if x != nil {
-		return x.Page
+		return x.Duration
 	}
 	return 0
 }
 
-func (x *ListLeaveTypesResponse) GetPageSize() int32 {
+func (x *UpdateLeaveRequestRequest) GetReason() string {
 	if x != nil {
-		return x.PageSize
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-// 获取启用的请假类型
-type ListActiveLeaveTypesRequest struct {
+func (x *UpdateLeaveRequestRequest) GetProofUrls() []string {
+	if x != nil {
+		return x.ProofUrls
+	}
+	return nil
+}
+
+// 提交请假申请
+type SubmitLeaveRequestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	SubmitterId   string                 `protobuf:"bytes,2,opt,name=submitter_id,json=submitterId,proto3" json:"submitter_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListActiveLeaveTypesRequest) Reset() {
-	*x = ListActiveLeaveTypesRequest{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[15]
+func (x *SubmitLeaveRequestRequest) Reset() {
+	*x = SubmitLeaveRequestRequest{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListActiveLeaveTypesRequest) String() string {
+func (x *SubmitLeaveRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListActiveLeaveTypesRequest) ProtoMessage() {}
+func (*SubmitLeaveRequestRequest) ProtoMessage() {}
 
-func (x *ListActiveLeaveTypesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[15]
+func (x *SubmitLeaveRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1513,40 +2209,48 @@ func (x *ListActiveLeaveTypesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListActiveLeaveTypesRequest.ProtoReflect.Descriptor instead.
-func (*ListActiveLeaveTypesRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use SubmitLeaveRequestRequest.ProtoReflect.Descriptor instead.
+func (*SubmitLeaveRequestRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ListActiveLeaveTypesRequest) GetTenantId() string {
+func (x *SubmitLeaveRequestRequest) GetRequestId() string {
 	if x != nil {
-		return x.TenantId
+		return x.RequestId
 	}
 	return ""
 }
 
-type ListActiveLeaveTypesResponse struct {
+func (x *SubmitLeaveRequestRequest) GetSubmitterId() string {
+	if x != nil {
+		return x.SubmitterId
+	}
+	return ""
+}
+
+type SubmitLeaveRequestResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*LeaveTypeResponse   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListActiveLeaveTypesResponse) Reset() {
-	*x = ListActiveLeaveTypesResponse{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[16]
+func (x *SubmitLeaveRequestResponse) Reset() {
+	*x = SubmitLeaveRequestResponse{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListActiveLeaveTypesResponse) String() string {
+func (x *SubmitLeaveRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListActiveLeaveTypesResponse) ProtoMessage() {}
+func (*SubmitLeaveRequestResponse) ProtoMessage() {}
 
-func (x *ListActiveLeaveTypesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[16]
+func (x *SubmitLeaveRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1557,50 +2261,49 @@ func (x *ListActiveLeaveTypesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListActiveLeaveTypesResponse.ProtoReflect.Descriptor instead.
-func (*ListActiveLeaveTypesResponse) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use SubmitLeaveRequestResponse.ProtoReflect.Descriptor instead.
+func (*SubmitLeaveRequestResponse) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ListActiveLeaveTypesResponse) GetItems() []*LeaveTypeResponse {
+func (x *SubmitLeaveRequestResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Items
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-// 创建请假申请
-type CreateLeaveRequestRequest struct {
+func (x *SubmitLeaveRequestResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 撤回请假申请
+type WithdrawLeaveRequestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	EmployeeId    string                 `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
-	EmployeeName  string                 `protobuf:"bytes,3,opt,name=employee_name,json=employeeName,proto3" json:"employee_name,omitempty"`
-	DepartmentId  string                 `protobuf:"bytes,4,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
-	LeaveTypeId   string                 `protobuf:"bytes,5,opt,name=leave_type_id,json=leaveTypeId,proto3" json:"leave_type_id,omitempty"`
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Duration      float64                `protobuf:"fixed64,8,opt,name=duration,proto3" json:"duration,omitempty"`
-	Reason        string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
-	ProofUrls     []string               `protobuf:"bytes,10,rep,name=proof_urls,json=proofUrls,proto3" json:"proof_urls,omitempty"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	OperatorId    string                 `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateLeaveRequestRequest) Reset() {
-	*x = CreateLeaveRequestRequest{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[17]
+func (x *WithdrawLeaveRequestRequest) Reset() {
+	*x = WithdrawLeaveRequestRequest{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateLeaveRequestRequest) String() string {
+func (x *WithdrawLeaveRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateLeaveRequestRequest) ProtoMessage() {}
+func (*WithdrawLeaveRequestRequest) ProtoMessage() {}
 
-func (x *CreateLeaveRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[17]
+func (x *WithdrawLeaveRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1611,109 +2314,94 @@ func (x *CreateLeaveRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateLeaveRequestRequest.ProtoReflect.Descriptor instead.
-func (*CreateLeaveRequestRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *CreateLeaveRequestRequest) GetTenantId() string {
-	if x != nil {
-		return x.TenantId
-	}
-	return ""
+// Deprecated: Use WithdrawLeaveRequestRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawLeaveRequestRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *CreateLeaveRequestRequest) GetEmployeeId() string {
+func (x *WithdrawLeaveRequestRequest) GetRequestId() string {
 	if x != nil {
-		return x.EmployeeId
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *CreateLeaveRequestRequest) GetEmployeeName() string {
+func (x *WithdrawLeaveRequestRequest) GetOperatorId() string {
 	if x != nil {
-		return x.EmployeeName
+		return x.OperatorId
 	}
 	return ""
 }
 
-func (x *CreateLeaveRequestRequest) GetDepartmentId() string {
-	if x != nil {
-		return x.DepartmentId
-	}
-	return ""
+type WithdrawLeaveRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateLeaveRequestRequest) GetLeaveTypeId() string {
-	if x != nil {
-		return x.LeaveTypeId
-	}
-	return ""
+func (x *WithdrawLeaveRequestResponse) Reset() {
+	*x = WithdrawLeaveRequestResponse{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateLeaveRequestRequest) GetStartTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartTime
-	}
-	return nil
+func (x *WithdrawLeaveRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateLeaveRequestRequest) GetEndTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EndTime
-	}
-	return nil
-}
+func (*WithdrawLeaveRequestResponse) ProtoMessage() {}
 
-func (x *CreateLeaveRequestRequest) GetDuration() float64 {
+func (x *WithdrawLeaveRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[26]
 	if x != nil {
-		return x.Duration
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CreateLeaveRequestRequest) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
+// Deprecated: Use WithdrawLeaveRequestResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawLeaveRequestResponse) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *CreateLeaveRequestRequest) GetProofUrls() []string {
+func (x *WithdrawLeaveRequestResponse) GetSuccess() bool {
 	if x != nil {
-		return x.ProofUrls
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-// 更新请假申请
-type UpdateLeaveRequestRequest struct {
+// 取消请假
+type CancelLeaveRequestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Duration      float64                `protobuf:"fixed64,4,opt,name=duration,proto3" json:"duration,omitempty"`
-	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
-	ProofUrls     []string               `protobuf:"bytes,6,rep,name=proof_urls,json=proofUrls,proto3" json:"proof_urls,omitempty"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	OperatorId    string                 `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateLeaveRequestRequest) Reset() {
-	*x = UpdateLeaveRequestRequest{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[18]
+func (x *CancelLeaveRequestRequest) Reset() {
+	*x = CancelLeaveRequestRequest{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateLeaveRequestRequest) String() string {
+func (x *CancelLeaveRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateLeaveRequestRequest) ProtoMessage() {}
+func (*CancelLeaveRequestRequest) ProtoMessage() {}
 
-func (x *UpdateLeaveRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[18]
+func (x *CancelLeaveRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1724,77 +2412,99 @@ func (x *UpdateLeaveRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateLeaveRequestRequest.ProtoReflect.Descriptor instead.
-func (*UpdateLeaveRequestRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use CancelLeaveRequestRequest.ProtoReflect.Descriptor instead.
+func (*CancelLeaveRequestRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *UpdateLeaveRequestRequest) GetId() string {
+func (x *CancelLeaveRequestRequest) GetRequestId() string {
 	if x != nil {
-		return x.Id
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *UpdateLeaveRequestRequest) GetStartTime() *timestamppb.Timestamp {
+func (x *CancelLeaveRequestRequest) GetOperatorId() string {
 	if x != nil {
-		return x.StartTime
+		return x.OperatorId
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateLeaveRequestRequest) GetEndTime() *timestamppb.Timestamp {
+func (x *CancelLeaveRequestRequest) GetReason() string {
 	if x != nil {
-		return x.EndTime
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateLeaveRequestRequest) GetDuration() float64 {
-	if x != nil {
-		return x.Duration
-	}
-	return 0
+type CancelLeaveRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateLeaveRequestRequest) GetReason() string {
+func (x *CancelLeaveRequestResponse) Reset() {
+	*x = CancelLeaveRequestResponse{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelLeaveRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelLeaveRequestResponse) ProtoMessage() {}
+
+func (x *CancelLeaveRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[28]
 	if x != nil {
-		return x.Reason
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateLeaveRequestRequest) GetProofUrls() []string {
+// Deprecated: Use CancelLeaveRequestResponse.ProtoReflect.Descriptor instead.
+func (*CancelLeaveRequestResponse) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CancelLeaveRequestResponse) GetSuccess() bool {
 	if x != nil {
-		return x.ProofUrls
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-// 提交请假申请
-type SubmitLeaveRequestRequest struct {
+// 获取请假详情
+type GetLeaveRequestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	SubmitterId   string                 `protobuf:"bytes,2,opt,name=submitter_id,json=submitterId,proto3" json:"submitter_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitLeaveRequestRequest) Reset() {
-	*x = SubmitLeaveRequestRequest{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[19]
+func (x *GetLeaveRequestRequest) Reset() {
+	*x = GetLeaveRequestRequest{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitLeaveRequestRequest) String() string {
+func (x *GetLeaveRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitLeaveRequestRequest) ProtoMessage() {}
+func (*GetLeaveRequestRequest) ProtoMessage() {}
 
-func (x *SubmitLeaveRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[19]
+func (x *GetLeaveRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1805,48 +2515,48 @@ func (x *SubmitLeaveRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitLeaveRequestRequest.ProtoReflect.Descriptor instead.
-func (*SubmitLeaveRequestRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetLeaveRequestRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaveRequestRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *SubmitLeaveRequestRequest) GetRequestId() string {
+func (x *GetLeaveRequestRequest) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-func (x *SubmitLeaveRequestRequest) GetSubmitterId() string {
-	if x != nil {
-		return x.SubmitterId
-	}
-	return ""
-}
-
-type SubmitLeaveRequestResponse struct {
+// 查询我的请假记录
+type ListMyLeaveRequestsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	EmployeeId    string                 `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	LeaveTypeId   string                 `protobuf:"bytes,3,opt,name=leave_type_id,json=leaveTypeId,proto3" json:"leave_type_id,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Page          int32                  `protobuf:"varint,7,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitLeaveRequestResponse) Reset() {
-	*x = SubmitLeaveRequestResponse{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[20]
+func (x *ListMyLeaveRequestsRequest) Reset() {
+	*x = ListMyLeaveRequestsRequest{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitLeaveRequestResponse) String() string {
+func (x *ListMyLeaveRequestsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitLeaveRequestResponse) ProtoMessage() {}
+func (*ListMyLeaveRequestsRequest) ProtoMessage() {}
 
-func (x *SubmitLeaveRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[20]
+func (x *ListMyLeaveRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1857,49 +2567,98 @@ func (x *SubmitLeaveRequestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitLeaveRequestResponse.ProtoReflect.Descriptor instead.
-func (*SubmitLeaveRequestResponse) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ListMyLeaveRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyLeaveRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *SubmitLeaveRequestResponse) GetSuccess() bool {
+func (x *ListMyLeaveRequestsRequest) GetTenantId() string {
 	if x != nil {
-		return x.Success
+		return x.TenantId
 	}
-	return false
+	return ""
 }
 
-func (x *SubmitLeaveRequestResponse) GetMessage() string {
+func (x *ListMyLeaveRequestsRequest) GetEmployeeId() string {
 	if x != nil {
-		return x.Message
+		return x.EmployeeId
 	}
 	return ""
 }
 
-// 撤回请假申请
-type WithdrawLeaveRequestRequest struct {
+func (x *ListMyLeaveRequestsRequest) GetLeaveTypeId() string {
+	if x != nil {
+		return x.LeaveTypeId
+	}
+	return ""
+}
+
+func (x *ListMyLeaveRequestsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListMyLeaveRequestsRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ListMyLeaveRequestsRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ListMyLeaveRequestsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMyLeaveRequestsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 查询请假记录
+type ListLeaveRequestsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	OperatorId    string                 `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	LeaveTypeId   string                 `protobuf:"bytes,2,opt,name=leave_type_id,json=leaveTypeId,proto3" json:"leave_type_id,omitempty"`
+	DepartmentId  string                 `protobuf:"bytes,3,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Keyword       string                 `protobuf:"bytes,7,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Page          int32                  `protobuf:"varint,8,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,9,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WithdrawLeaveRequestRequest) Reset() {
-	*x = WithdrawLeaveRequestRequest{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[21]
+func (x *ListLeaveRequestsRequest) Reset() {
+	*x = ListLeaveRequestsRequest{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WithdrawLeaveRequestRequest) String() string {
+func (x *ListLeaveRequestsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawLeaveRequestRequest) ProtoMessage() {}
+func (*ListLeaveRequestsRequest) ProtoMessage() {}
 
-func (x *WithdrawLeaveRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[21]
+func (x *ListLeaveRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1910,94 +2669,99 @@ func (x *WithdrawLeaveRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawLeaveRequestRequest.ProtoReflect.Descriptor instead.
-func (*WithdrawLeaveRequestRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ListLeaveRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListLeaveRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *WithdrawLeaveRequestRequest) GetRequestId() string {
+func (x *ListLeaveRequestsRequest) GetTenantId() string {
 	if x != nil {
-		return x.RequestId
+		return x.TenantId
 	}
 	return ""
 }
 
-func (x *WithdrawLeaveRequestRequest) GetOperatorId() string {
+func (x *ListLeaveRequestsRequest) GetLeaveTypeId() string {
 	if x != nil {
-		return x.OperatorId
+		return x.LeaveTypeId
 	}
 	return ""
 }
 
-type WithdrawLeaveRequestResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListLeaveRequestsRequest) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
 }
 
-func (x *WithdrawLeaveRequestResponse) Reset() {
-	*x = WithdrawLeaveRequestResponse{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[22]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ListLeaveRequestsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListLeaveRequestsRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
 }
 
-func (x *WithdrawLeaveRequestResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ListLeaveRequestsRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
 }
 
-func (*WithdrawLeaveRequestResponse) ProtoMessage() {}
-
-func (x *WithdrawLeaveRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[22]
+func (x *ListLeaveRequestsRequest) GetKeyword() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Keyword
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use WithdrawLeaveRequestResponse.ProtoReflect.Descriptor instead.
-func (*WithdrawLeaveRequestResponse) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{22}
+func (x *ListLeaveRequestsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
 }
 
-func (x *WithdrawLeaveRequestResponse) GetSuccess() bool {
+func (x *ListLeaveRequestsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Success
+		return x.PageSize
 	}
-	return false
+	return 0
 }
 
-// 取消请假
-type CancelLeaveRequestRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	OperatorId    string                 `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
-	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+type ListLeaveRequestsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Items         []*LeaveRequestResponse `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         int32                   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                   `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                   `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelLeaveRequestRequest) Reset() {
-	*x = CancelLeaveRequestRequest{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[23]
+func (x *ListLeaveRequestsResponse) Reset() {
+	*x = ListLeaveRequestsResponse{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelLeaveRequestRequest) String() string {
+func (x *ListLeaveRequestsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelLeaveRequestRequest) ProtoMessage() {}
+func (*ListLeaveRequestsResponse) ProtoMessage() {}
 
-func (x *CancelLeaveRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[23]
+func (x *ListLeaveRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2008,54 +2772,65 @@ func (x *CancelLeaveRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelLeaveRequestRequest.ProtoReflect.Descriptor instead.
-func (*CancelLeaveRequestRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ListLeaveRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListLeaveRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *CancelLeaveRequestRequest) GetRequestId() string {
+func (x *ListLeaveRequestsResponse) GetItems() []*LeaveRequestResponse {
 	if x != nil {
-		return x.RequestId
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *CancelLeaveRequestRequest) GetOperatorId() string {
+func (x *ListLeaveRequestsResponse) GetTotal() int32 {
 	if x != nil {
-		return x.OperatorId
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-func (x *CancelLeaveRequestRequest) GetReason() string {
+func (x *ListLeaveRequestsResponse) GetPage() int32 {
 	if x != nil {
-		return x.Reason
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-type CancelLeaveRequestResponse struct {
+func (x *ListLeaveRequestsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 查询待审批的请假
+type ListPendingApprovalsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	ApproverId    string                 `protobuf:"bytes,2,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelLeaveRequestResponse) Reset() {
-	*x = CancelLeaveRequestResponse{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[24]
+func (x *ListPendingApprovalsRequest) Reset() {
+	*x = ListPendingApprovalsRequest{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelLeaveRequestResponse) String() string {
+func (x *ListPendingApprovalsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelLeaveRequestResponse) ProtoMessage() {}
+func (*ListPendingApprovalsRequest) ProtoMessage() {}
 
-func (x *CancelLeaveRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[24]
+func (x *ListPendingApprovalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2066,93 +2841,64 @@ func (x *CancelLeaveRequestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelLeaveRequestResponse.ProtoReflect.Descriptor instead.
-func (*CancelLeaveRequestResponse) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ListPendingApprovalsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingApprovalsRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *CancelLeaveRequestResponse) GetSuccess() bool {
+func (x *ListPendingApprovalsRequest) GetTenantId() string {
 	if x != nil {
-		return x.Success
+		return x.TenantId
 	}
-	return false
-}
-
-// 获取请假详情
-type GetLeaveRequestRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetLeaveRequestRequest) Reset() {
-	*x = GetLeaveRequestRequest{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[25]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetLeaveRequestRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*GetLeaveRequestRequest) ProtoMessage() {}
-
-func (x *GetLeaveRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[25]
+func (x *ListPendingApprovalsRequest) GetApproverId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ApproverId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetLeaveRequestRequest.ProtoReflect.Descriptor instead.
-func (*GetLeaveRequestRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{25}
+func (x *ListPendingApprovalsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
 }
 
-func (x *GetLeaveRequestRequest) GetRequestId() string {
+func (x *ListPendingApprovalsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.RequestId
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-// 查询我的请假记录
-type ListMyLeaveRequestsRequest struct {
+// 批准请假
+type ApproveLeaveRequestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	EmployeeId    string                 `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
-	LeaveTypeId   string                 `protobuf:"bytes,3,opt,name=leave_type_id,json=leaveTypeId,proto3" json:"leave_type_id,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	StartDate     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Page          int32                  `protobuf:"varint,7,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ApproverId    string                 `protobuf:"bytes,2,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMyLeaveRequestsRequest) Reset() {
-	*x = ListMyLeaveRequestsRequest{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[26]
+func (x *ApproveLeaveRequestRequest) Reset() {
+	*x = ApproveLeaveRequestRequest{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMyLeaveRequestsRequest) String() string {
+func (x *ApproveLeaveRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMyLeaveRequestsRequest) ProtoMessage() {}
+func (*ApproveLeaveRequestRequest) ProtoMessage() {}
 
-func (x *ListMyLeaveRequestsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[26]
+func (x *ApproveLeaveRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2163,98 +2909,109 @@ func (x *ListMyLeaveRequestsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMyLeaveRequestsRequest.ProtoReflect.Descriptor instead.
-func (*ListMyLeaveRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ApproveLeaveRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveLeaveRequestRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ListMyLeaveRequestsRequest) GetTenantId() string {
+func (x *ApproveLeaveRequestRequest) GetRequestId() string {
 	if x != nil {
-		return x.TenantId
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *ListMyLeaveRequestsRequest) GetEmployeeId() string {
+func (x *ApproveLeaveRequestRequest) GetApproverId() string {
 	if x != nil {
-		return x.EmployeeId
+		return x.ApproverId
 	}
 	return ""
 }
 
-func (x *ListMyLeaveRequestsRequest) GetLeaveTypeId() string {
+func (x *ApproveLeaveRequestRequest) GetComment() string {
 	if x != nil {
-		return x.LeaveTypeId
+		return x.Comment
 	}
 	return ""
 }
 
-func (x *ListMyLeaveRequestsRequest) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+type ApproveLeaveRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMyLeaveRequestsRequest) GetStartDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartDate
-	}
-	return nil
+func (x *ApproveLeaveRequestResponse) Reset() {
+	*x = ApproveLeaveRequestResponse{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMyLeaveRequestsRequest) GetEndDate() *timestamppb.Timestamp {
+func (x *ApproveLeaveRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveLeaveRequestResponse) ProtoMessage() {}
+
+func (x *ApproveLeaveRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[35]
 	if x != nil {
-		return x.EndDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ListMyLeaveRequestsRequest) GetPage() int32 {
+// Deprecated: Use ApproveLeaveRequestResponse.ProtoReflect.Descriptor instead.
+func (*ApproveLeaveRequestResponse) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ApproveLeaveRequestResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Page
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *ListMyLeaveRequestsRequest) GetPageSize() int32 {
+func (x *ApproveLeaveRequestResponse) GetMessage() string {
 	if x != nil {
-		return x.PageSize
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-// 查询请假记录
-type ListLeaveRequestsRequest struct {
+// 拒绝请假
+type RejectLeaveRequestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	LeaveTypeId   string                 `protobuf:"bytes,2,opt,name=leave_type_id,json=leaveTypeId,proto3" json:"leave_type_id,omitempty"`
-	DepartmentId  string                 `protobuf:"bytes,3,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	StartDate     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Keyword       string                 `protobuf:"bytes,7,opt,name=keyword,proto3" json:"keyword,omitempty"`
-	Page          int32                  `protobuf:"varint,8,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,9,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ApproverId    string                 `protobuf:"bytes,2,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListLeaveRequestsRequest) Reset() {
-	*x = ListLeaveRequestsRequest{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[27]
+func (x *RejectLeaveRequestRequest) Reset() {
+	*x = RejectLeaveRequestRequest{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLeaveRequestsRequest) String() string {
+func (x *RejectLeaveRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLeaveRequestsRequest) ProtoMessage() {}
+func (*RejectLeaveRequestRequest) ProtoMessage() {}
 
-func (x *ListLeaveRequestsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[27]
+func (x *RejectLeaveRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2265,99 +3022,109 @@ func (x *ListLeaveRequestsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLeaveRequestsRequest.ProtoReflect.Descriptor instead.
-func (*ListLeaveRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use RejectLeaveRequestRequest.ProtoReflect.Descriptor instead.
+func (*RejectLeaveRequestRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ListLeaveRequestsRequest) GetTenantId() string {
+func (x *RejectLeaveRequestRequest) GetRequestId() string {
 	if x != nil {
-		return x.TenantId
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *ListLeaveRequestsRequest) GetLeaveTypeId() string {
+func (x *RejectLeaveRequestRequest) GetApproverId() string {
 	if x != nil {
-		return x.LeaveTypeId
+		return x.ApproverId
 	}
 	return ""
 }
 
-func (x *ListLeaveRequestsRequest) GetDepartmentId() string {
+func (x *RejectLeaveRequestRequest) GetComment() string {
 	if x != nil {
-		return x.DepartmentId
+		return x.Comment
 	}
 	return ""
 }
 
-func (x *ListLeaveRequestsRequest) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+type RejectLeaveRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListLeaveRequestsRequest) GetStartDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartDate
-	}
-	return nil
+func (x *RejectLeaveRequestResponse) Reset() {
+	*x = RejectLeaveRequestResponse{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLeaveRequestsRequest) GetEndDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EndDate
-	}
-	return nil
+func (x *RejectLeaveRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ListLeaveRequestsRequest) GetKeyword() string {
+func (*RejectLeaveRequestResponse) ProtoMessage() {}
+
+func (x *RejectLeaveRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[37]
 	if x != nil {
-		return x.Keyword
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ListLeaveRequestsRequest) GetPage() int32 {
+// Deprecated: Use RejectLeaveRequestResponse.ProtoReflect.Descriptor instead.
+func (*RejectLeaveRequestResponse) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RejectLeaveRequestResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Page
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *ListLeaveRequestsRequest) GetPageSize() int32 {
+func (x *RejectLeaveRequestResponse) GetMessage() string {
 	if x != nil {
-		return x.PageSize
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type ListLeaveRequestsResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Items         []*LeaveRequestResponse `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	Total         int32                   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                   `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                   `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+// 初始化员工额度
+type InitEmployeeQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	EmployeeId    string                 `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	Year          int32                  `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListLeaveRequestsResponse) Reset() {
-	*x = ListLeaveRequestsResponse{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[28]
+func (x *InitEmployeeQuotaRequest) Reset() {
+	*x = InitEmployeeQuotaRequest{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLeaveRequestsResponse) String() string {
+func (x *InitEmployeeQuotaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLeaveRequestsResponse) ProtoMessage() {}
+func (*InitEmployeeQuotaRequest) ProtoMessage() {}
 
-func (x *ListLeaveRequestsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[28]
+func (x *InitEmployeeQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2368,65 +3135,54 @@ func (x *ListLeaveRequestsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLeaveRequestsResponse.ProtoReflect.Descriptor instead.
-func (*ListLeaveRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{28}
-}
-
-func (x *ListLeaveRequestsResponse) GetItems() []*LeaveRequestResponse {
-	if x != nil {
-		return x.Items
-	}
-	return nil
+// Deprecated: Use InitEmployeeQuotaRequest.ProtoReflect.Descriptor instead.
+func (*InitEmployeeQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ListLeaveRequestsResponse) GetTotal() int32 {
+func (x *InitEmployeeQuotaRequest) GetTenantId() string {
 	if x != nil {
-		return x.Total
+		return x.TenantId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListLeaveRequestsResponse) GetPage() int32 {
+func (x *InitEmployeeQuotaRequest) GetEmployeeId() string {
 	if x != nil {
-		return x.Page
+		return x.EmployeeId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListLeaveRequestsResponse) GetPageSize() int32 {
+func (x *InitEmployeeQuotaRequest) GetYear() int32 {
 	if x != nil {
-		return x.PageSize
+		return x.Year
 	}
 	return 0
 }
 
-// 查询待审批的请假
-type ListPendingApprovalsRequest struct {
+type InitEmployeeQuotaResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	ApproverId    string                 `protobuf:"bytes,2,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPendingApprovalsRequest) Reset() {
-	*x = ListPendingApprovalsRequest{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[29]
+func (x *InitEmployeeQuotaResponse) Reset() {
+	*x = InitEmployeeQuotaResponse{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPendingApprovalsRequest) String() string {
+func (x *InitEmployeeQuotaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPendingApprovalsRequest) ProtoMessage() {}
+func (*InitEmployeeQuotaResponse) ProtoMessage() {}
 
-func (x *ListPendingApprovalsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[29]
+func (x *InitEmployeeQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2437,64 +3193,96 @@ func (x *ListPendingApprovalsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPendingApprovalsRequest.ProtoReflect.Descriptor instead.
-func (*ListPendingApprovalsRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use InitEmployeeQuotaResponse.ProtoReflect.Descriptor instead.
+func (*InitEmployeeQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *ListPendingApprovalsRequest) GetTenantId() string {
+func (x *InitEmployeeQuotaResponse) GetSuccess() bool {
 	if x != nil {
-		return x.TenantId
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *ListPendingApprovalsRequest) GetApproverId() string {
+// 更新额度
+type UpdateQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TotalQuota    float64                `protobuf:"fixed64,2,opt,name=total_quota,json=totalQuota,proto3" json:"total_quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateQuotaRequest) Reset() {
+	*x = UpdateQuotaRequest{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateQuotaRequest) ProtoMessage() {}
+
+func (x *UpdateQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[40]
 	if x != nil {
-		return x.ApproverId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ListPendingApprovalsRequest) GetPage() int32 {
+// Deprecated: Use UpdateQuotaRequest.ProtoReflect.Descriptor instead.
+func (*UpdateQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpdateQuotaRequest) GetId() string {
 	if x != nil {
-		return x.Page
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *ListPendingApprovalsRequest) GetPageSize() int32 {
+func (x *UpdateQuotaRequest) GetTotalQuota() float64 {
 	if x != nil {
-		return x.PageSize
+		return x.TotalQuota
 	}
 	return 0
 }
 
-// 批准请假
-type ApproveLeaveRequestRequest struct {
+// 获取员工额度
+type GetEmployeeQuotasRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	ApproverId    string                 `protobuf:"bytes,2,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`
-	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	EmployeeId    string                 `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	Year          int32                  `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApproveLeaveRequestRequest) Reset() {
-	*x = ApproveLeaveRequestRequest{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[30]
+func (x *GetEmployeeQuotasRequest) Reset() {
+	*x = GetEmployeeQuotasRequest{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApproveLeaveRequestRequest) String() string {
+func (x *GetEmployeeQuotasRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApproveLeaveRequestRequest) ProtoMessage() {}
+func (*GetEmployeeQuotasRequest) ProtoMessage() {}
 
-func (x *ApproveLeaveRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[30]
+func (x *GetEmployeeQuotasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2505,55 +3293,54 @@ func (x *ApproveLeaveRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApproveLeaveRequestRequest.ProtoReflect.Descriptor instead.
-func (*ApproveLeaveRequestRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use GetEmployeeQuotasRequest.ProtoReflect.Descriptor instead.
+func (*GetEmployeeQuotasRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *ApproveLeaveRequestRequest) GetRequestId() string {
+func (x *GetEmployeeQuotasRequest) GetTenantId() string {
 	if x != nil {
-		return x.RequestId
+		return x.TenantId
 	}
 	return ""
 }
 
-func (x *ApproveLeaveRequestRequest) GetApproverId() string {
+func (x *GetEmployeeQuotasRequest) GetEmployeeId() string {
 	if x != nil {
-		return x.ApproverId
+		return x.EmployeeId
 	}
 	return ""
 }
 
-func (x *ApproveLeaveRequestRequest) GetComment() string {
+func (x *GetEmployeeQuotasRequest) GetYear() int32 {
 	if x != nil {
-		return x.Comment
+		return x.Year
 	}
-	return ""
+	return 0
 }
 
-type ApproveLeaveRequestResponse struct {
+type GetEmployeeQuotasResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Items         []*QuotaResponse       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApproveLeaveRequestResponse) Reset() {
-	*x = ApproveLeaveRequestResponse{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[31]
+func (x *GetEmployeeQuotasResponse) Reset() {
+	*x = GetEmployeeQuotasResponse{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApproveLeaveRequestResponse) String() string {
+func (x *GetEmployeeQuotasResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApproveLeaveRequestResponse) ProtoMessage() {}
+func (*GetEmployeeQuotasResponse) ProtoMessage() {}
 
-func (x *ApproveLeaveRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[31]
+func (x *GetEmployeeQuotasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2564,50 +3351,44 @@ func (x *ApproveLeaveRequestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApproveLeaveRequestResponse.ProtoReflect.Descriptor instead.
-func (*ApproveLeaveRequestResponse) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{31}
-}
-
-func (x *ApproveLeaveRequestResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use GetEmployeeQuotasResponse.ProtoReflect.Descriptor instead.
+func (*GetEmployeeQuotasResponse) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ApproveLeaveRequestResponse) GetMessage() string {
+func (x *GetEmployeeQuotasResponse) GetItems() []*QuotaResponse {
 	if x != nil {
-		return x.Message
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-// 拒绝请假
-type RejectLeaveRequestRequest struct {
+// 请假时长预览
+type CalculateLeaveDurationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	ApproverId    string                 `protobuf:"bytes,2,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`
-	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	EmployeeId    string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	LeaveTypeId   string                 `protobuf:"bytes,2,opt,name=leave_type_id,json=leaveTypeId,proto3" json:"leave_type_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RejectLeaveRequestRequest) Reset() {
-	*x = RejectLeaveRequestRequest{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[32]
+func (x *CalculateLeaveDurationRequest) Reset() {
+	*x = CalculateLeaveDurationRequest{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RejectLeaveRequestRequest) String() string {
+func (x *CalculateLeaveDurationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RejectLeaveRequestRequest) ProtoMessage() {}
+func (*CalculateLeaveDurationRequest) ProtoMessage() {}
 
-func (x *RejectLeaveRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[32]
+func (x *CalculateLeaveDurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2618,55 +3399,61 @@ func (x *RejectLeaveRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RejectLeaveRequestRequest.ProtoReflect.Descriptor instead.
-func (*RejectLeaveRequestRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use CalculateLeaveDurationRequest.ProtoReflect.Descriptor instead.
+func (*CalculateLeaveDurationRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *RejectLeaveRequestRequest) GetRequestId() string {
+func (x *CalculateLeaveDurationRequest) GetEmployeeId() string {
 	if x != nil {
-		return x.RequestId
+		return x.EmployeeId
 	}
 	return ""
 }
 
-func (x *RejectLeaveRequestRequest) GetApproverId() string {
+func (x *CalculateLeaveDurationRequest) GetLeaveTypeId() string {
 	if x != nil {
-		return x.ApproverId
+		return x.LeaveTypeId
 	}
 	return ""
 }
 
-func (x *RejectLeaveRequestRequest) GetComment() string {
+func (x *CalculateLeaveDurationRequest) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Comment
+		return x.StartTime
 	}
-	return ""
+	return nil
 }
 
-type RejectLeaveRequestResponse struct {
+func (x *CalculateLeaveDurationRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type GetLeaveDurationBreakdownRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RejectLeaveRequestResponse) Reset() {
-	*x = RejectLeaveRequestResponse{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[33]
+func (x *GetLeaveDurationBreakdownRequest) Reset() {
+	*x = GetLeaveDurationBreakdownRequest{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RejectLeaveRequestResponse) String() string {
+func (x *GetLeaveDurationBreakdownRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RejectLeaveRequestResponse) ProtoMessage() {}
+func (*GetLeaveDurationBreakdownRequest) ProtoMessage() {}
 
-func (x *RejectLeaveRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[33]
+func (x *GetLeaveDurationBreakdownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2677,50 +3464,42 @@ func (x *RejectLeaveRequestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RejectLeaveRequestResponse.ProtoReflect.Descriptor instead.
-func (*RejectLeaveRequestResponse) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *RejectLeaveRequestResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use GetLeaveDurationBreakdownRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaveDurationBreakdownRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *RejectLeaveRequestResponse) GetMessage() string {
+func (x *GetLeaveDurationBreakdownRequest) GetId() string {
 	if x != nil {
-		return x.Message
+		return x.Id
 	}
 	return ""
 }
 
-// 初始化员工额度
-type InitEmployeeQuotaRequest struct {
+// 员工某年度
+type EmployeeYearRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	EmployeeId    string                 `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
-	Year          int32                  `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
+	EmployeeId    string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	Year          int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InitEmployeeQuotaRequest) Reset() {
-	*x = InitEmployeeQuotaRequest{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[34]
+func (x *EmployeeYearRequest) Reset() {
+	*x = EmployeeYearRequest{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InitEmployeeQuotaRequest) String() string {
+func (x *EmployeeYearRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InitEmployeeQuotaRequest) ProtoMessage() {}
+func (*EmployeeYearRequest) ProtoMessage() {}
 
-func (x *InitEmployeeQuotaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[34]
+func (x *EmployeeYearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2731,54 +3510,47 @@ func (x *InitEmployeeQuotaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InitEmployeeQuotaRequest.ProtoReflect.Descriptor instead.
-func (*InitEmployeeQuotaRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{34}
-}
-
-func (x *InitEmployeeQuotaRequest) GetTenantId() string {
-	if x != nil {
-		return x.TenantId
-	}
-	return ""
+// Deprecated: Use EmployeeYearRequest.ProtoReflect.Descriptor instead.
+func (*EmployeeYearRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *InitEmployeeQuotaRequest) GetEmployeeId() string {
+func (x *EmployeeYearRequest) GetEmployeeId() string {
 	if x != nil {
 		return x.EmployeeId
 	}
 	return ""
 }
 
-func (x *InitEmployeeQuotaRequest) GetYear() int32 {
+func (x *EmployeeYearRequest) GetYear() int32 {
 	if x != nil {
 		return x.Year
 	}
 	return 0
 }
 
-type InitEmployeeQuotaResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+type ListLeaveQuotaLedgerResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Items         []*LeaveQuotaLedgerEntry `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InitEmployeeQuotaResponse) Reset() {
-	*x = InitEmployeeQuotaResponse{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[35]
+func (x *ListLeaveQuotaLedgerResponse) Reset() {
+	*x = ListLeaveQuotaLedgerResponse{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InitEmployeeQuotaResponse) String() string {
+func (x *ListLeaveQuotaLedgerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InitEmployeeQuotaResponse) ProtoMessage() {}
+func (*ListLeaveQuotaLedgerResponse) ProtoMessage() {}
 
-func (x *InitEmployeeQuotaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[35]
+func (x *ListLeaveQuotaLedgerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2789,42 +3561,43 @@ func (x *InitEmployeeQuotaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InitEmployeeQuotaResponse.ProtoReflect.Descriptor instead.
-func (*InitEmployeeQuotaResponse) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ListLeaveQuotaLedgerResponse.ProtoReflect.Descriptor instead.
+func (*ListLeaveQuotaLedgerResponse) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *InitEmployeeQuotaResponse) GetSuccess() bool {
+func (x *ListLeaveQuotaLedgerResponse) GetItems() []*LeaveQuotaLedgerEntry {
 	if x != nil {
-		return x.Success
+		return x.Items
 	}
-	return false
+	return nil
 }
 
-// 更新额度
-type UpdateQuotaRequest struct {
+// 人工调整额度
+type AdjustLeaveQuotaRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TotalQuota    float64                `protobuf:"fixed64,2,opt,name=total_quota,json=totalQuota,proto3" json:"total_quota,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"` // 正数增加、负数扣减
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateQuotaRequest) Reset() {
-	*x = UpdateQuotaRequest{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[36]
+func (x *AdjustLeaveQuotaRequest) Reset() {
+	*x = AdjustLeaveQuotaRequest{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateQuotaRequest) String() string {
+func (x *AdjustLeaveQuotaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateQuotaRequest) ProtoMessage() {}
+func (*AdjustLeaveQuotaRequest) ProtoMessage() {}
 
-func (x *UpdateQuotaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[36]
+func (x *AdjustLeaveQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2835,50 +3608,54 @@ func (x *UpdateQuotaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateQuotaRequest.ProtoReflect.Descriptor instead.
-func (*UpdateQuotaRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use AdjustLeaveQuotaRequest.ProtoReflect.Descriptor instead.
+func (*AdjustLeaveQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *UpdateQuotaRequest) GetId() string {
+func (x *AdjustLeaveQuotaRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateQuotaRequest) GetTotalQuota() float64 {
+func (x *AdjustLeaveQuotaRequest) GetAmount() float64 {
 	if x != nil {
-		return x.TotalQuota
+		return x.Amount
 	}
 	return 0
 }
 
-// 获取员工额度
-type GetEmployeeQuotasRequest struct {
+func (x *AdjustLeaveQuotaRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CarryOverLeaveQuotasRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	EmployeeId    string                 `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
-	Year          int32                  `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEmployeeQuotasRequest) Reset() {
-	*x = GetEmployeeQuotasRequest{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[37]
+func (x *CarryOverLeaveQuotasRequest) Reset() {
+	*x = CarryOverLeaveQuotasRequest{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEmployeeQuotasRequest) String() string {
+func (x *CarryOverLeaveQuotasRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEmployeeQuotasRequest) ProtoMessage() {}
+func (*CarryOverLeaveQuotasRequest) ProtoMessage() {}
 
-func (x *GetEmployeeQuotasRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[37]
+func (x *CarryOverLeaveQuotasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2889,54 +3666,41 @@ func (x *GetEmployeeQuotasRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEmployeeQuotasRequest.ProtoReflect.Descriptor instead.
-func (*GetEmployeeQuotasRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{37}
-}
-
-func (x *GetEmployeeQuotasRequest) GetTenantId() string {
-	if x != nil {
-		return x.TenantId
-	}
-	return ""
-}
-
-func (x *GetEmployeeQuotasRequest) GetEmployeeId() string {
-	if x != nil {
-		return x.EmployeeId
-	}
-	return ""
+// Deprecated: Use CarryOverLeaveQuotasRequest.ProtoReflect.Descriptor instead.
+func (*CarryOverLeaveQuotasRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *GetEmployeeQuotasRequest) GetYear() int32 {
+func (x *CarryOverLeaveQuotasRequest) GetYear() int32 {
 	if x != nil {
 		return x.Year
 	}
 	return 0
 }
 
-type GetEmployeeQuotasResponse struct {
+// 额度发放/结转结果
+type LeaveAccrualResultResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*QuotaResponse       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Changed       int32                  `protobuf:"varint,1,opt,name=changed,proto3" json:"changed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEmployeeQuotasResponse) Reset() {
-	*x = GetEmployeeQuotasResponse{}
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[38]
+func (x *LeaveAccrualResultResponse) Reset() {
+	*x = LeaveAccrualResultResponse{}
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEmployeeQuotasResponse) String() string {
+func (x *LeaveAccrualResultResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEmployeeQuotasResponse) ProtoMessage() {}
+func (*LeaveAccrualResultResponse) ProtoMessage() {}
 
-func (x *GetEmployeeQuotasResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_leave_proto_msgTypes[38]
+func (x *LeaveAccrualResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_leave_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2947,16 +3711,16 @@ func (x *GetEmployeeQuotasResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEmployeeQuotasResponse.ProtoReflect.Descriptor instead.
-func (*GetEmployeeQuotasResponse) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use LeaveAccrualResultResponse.ProtoReflect.Descriptor instead.
+func (*LeaveAccrualResultResponse) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_leave_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *GetEmployeeQuotasResponse) GetItems() []*QuotaResponse {
+func (x *LeaveAccrualResultResponse) GetChanged() int32 {
 	if x != nil {
-		return x.Items
+		return x.Changed
 	}
-	return nil
+	return 0
 }
 
 var File_api_hrm_v1_leave_proto protoreflect.FileDescriptor
@@ -3058,7 +3822,52 @@ const file_api_hrm_v1_leave_proto_rawDesc = "" +
 	"\rpending_quota\x18\v \x01(\x01R\fpendingQuota\x12'\n" +
 	"\x0fremaining_quota\x18\f \x01(\x01R\x0eremainingQuota\x129\n" +
 	"\n" +
-	"expired_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\texpiredAt\"\xf8\x03\n" +
+	"expired_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\texpiredAt\"\x9f\x02\n" +
+	"\x10LeaveDayDuration\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x19\n" +
+	"\bday_type\x18\x02 \x01(\tR\adayType\x12\x19\n" +
+	"\bshift_id\x18\x03 \x01(\tR\ashiftId\x12\x1d\n" +
+	"\n" +
+	"shift_name\x18\x04 \x01(\tR\tshiftName\x129\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x14\n" +
+	"\x05hours\x18\a \x01(\x01R\x05hours\x12\x1a\n" +
+	"\bduration\x18\b \x01(\x01R\bduration\"p\n" +
+	"\x12LeaveMonthDuration\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\x05R\x05month\x12\x14\n" +
+	"\x05hours\x18\x03 \x01(\x01R\x05hours\x12\x1a\n" +
+	"\bduration\x18\x04 \x01(\x01R\bduration\"\xc7\x01\n" +
+	"\x15LeaveDurationResponse\x12\x12\n" +
+	"\x04unit\x18\x01 \x01(\tR\x04unit\x12\x1a\n" +
+	"\bduration\x18\x02 \x01(\x01R\bduration\x12\x14\n" +
+	"\x05hours\x18\x03 \x01(\x01R\x05hours\x120\n" +
+	"\x04days\x18\x04 \x03(\v2\x1c.api.hrm.v1.LeaveDayDurationR\x04days\x126\n" +
+	"\x06months\x18\x05 \x03(\v2\x1e.api.hrm.v1.LeaveMonthDurationR\x06months\"\x81\x04\n" +
+	"\x15LeaveQuotaLedgerEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x1f\n" +
+	"\vemployee_id\x18\x03 \x01(\tR\n" +
+	"employeeId\x12\"\n" +
+	"\rleave_type_id\x18\x04 \x01(\tR\vleaveTypeId\x12\x19\n" +
+	"\bquota_id\x18\x05 \x01(\tR\aquotaId\x12\x12\n" +
+	"\x04year\x18\x06 \x01(\x05R\x04year\x12\x1d\n" +
+	"\n" +
+	"entry_type\x18\a \x01(\tR\tentryType\x12\x16\n" +
+	"\x06amount\x18\b \x01(\x01R\x06amount\x12#\n" +
+	"\rbalance_after\x18\t \x01(\x01R\fbalanceAfter\x129\n" +
+	"\n" +
+	"expires_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x16\n" +
+	"\x06reason\x18\v \x01(\tR\x06reason\x12\x1f\n" +
+	"\vsource_type\x18\f \x01(\tR\n" +
+	"sourceType\x12\x1b\n" +
+	"\tsource_id\x18\r \x01(\tR\bsourceId\x12\x1f\n" +
+	"\voperator_id\x18\x0e \x01(\tR\n" +
+	"operatorId\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xf8\x03\n" +
 	"\x16CreateLeaveTypeRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
@@ -3233,14 +4042,37 @@ const file_api_hrm_v1_leave_proto_rawDesc = "" +
 	"employeeId\x12\x12\n" +
 	"\x04year\x18\x03 \x01(\x05R\x04year\"L\n" +
 	"\x19GetEmployeeQuotasResponse\x12/\n" +
-	"\x05items\x18\x01 \x03(\v2\x19.api.hrm.v1.QuotaResponseR\x05items2\x92\x06\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.api.hrm.v1.QuotaResponseR\x05items\"\xd6\x01\n" +
+	"\x1dCalculateLeaveDurationRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\tR\n" +
+	"employeeId\x12\"\n" +
+	"\rleave_type_id\x18\x02 \x01(\tR\vleaveTypeId\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"2\n" +
+	" GetLeaveDurationBreakdownRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"J\n" +
+	"\x13EmployeeYearRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\tR\n" +
+	"employeeId\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\"W\n" +
+	"\x1cListLeaveQuotaLedgerResponse\x127\n" +
+	"\x05items\x18\x01 \x03(\v2!.api.hrm.v1.LeaveQuotaLedgerEntryR\x05items\"Y\n" +
+	"\x17AdjustLeaveQuotaRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"1\n" +
+	"\x1bCarryOverLeaveQuotasRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\"6\n" +
+	"\x1aLeaveAccrualResultResponse\x12\x18\n" +
+	"\achanged\x18\x01 \x01(\x05R\achanged2\x92\x06\n" +
 	"\x10LeaveTypeService\x12x\n" +
 	"\x0fCreateLeaveType\x12\".api.hrm.v1.CreateLeaveTypeRequest\x1a\x1d.api.hrm.v1.LeaveTypeResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/hrm/leave-types\x12}\n" +
 	"\x0fUpdateLeaveType\x12\".api.hrm.v1.UpdateLeaveTypeRequest\x1a\x1d.api.hrm.v1.LeaveTypeResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\x1a\x1c/api/v1/hrm/leave-types/{id}\x12\x80\x01\n" +
 	"\x0fDeleteLeaveType\x12\".api.hrm.v1.DeleteLeaveTypeRequest\x1a#.api.hrm.v1.DeleteLeaveTypeResponse\"$\x82\xd3\xe4\x93\x02\x1e*\x1c/api/v1/hrm/leave-types/{id}\x12t\n" +
 	"\fGetLeaveType\x12\x1f.api.hrm.v1.GetLeaveTypeRequest\x1a\x1d.api.hrm.v1.LeaveTypeResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/hrm/leave-types/{id}\x12x\n" +
 	"\x0eListLeaveTypes\x12!.api.hrm.v1.ListLeaveTypesRequest\x1a\".api.hrm.v1.ListLeaveTypesResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/hrm/leave-types\x12\x91\x01\n" +
-	"\x14ListActiveLeaveTypes\x12'.api.hrm.v1.ListActiveLeaveTypesRequest\x1a(.api.hrm.v1.ListActiveLeaveTypesResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/hrm/leave-types/active2\x9e\r\n" +
+	"\x14ListActiveLeaveTypes\x12'.api.hrm.v1.ListActiveLeaveTypesRequest\x1a(.api.hrm.v1.ListActiveLeaveTypesResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/hrm/leave-types/active2\xd8\x0f\n" +
 	"\x13LeaveRequestService\x12\x84\x01\n" +
 	"\x12CreateLeaveRequest\x12%.api.hrm.v1.CreateLeaveRequestRequest\x1a .api.hrm.v1.LeaveRequestResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/hrm/leave-requests\x12\x89\x01\n" +
 	"\x12UpdateLeaveRequest\x12%.api.hrm.v1.UpdateLeaveRequestRequest\x1a .api.hrm.v1.LeaveRequestResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\x1a\x1f/api/v1/hrm/leave-requests/{id}\x12\x9e\x01\n" +
@@ -3252,11 +4084,17 @@ const file_api_hrm_v1_leave_proto_rawDesc = "" +
 	"\x11ListLeaveRequests\x12$.api.hrm.v1.ListLeaveRequestsRequest\x1a%.api.hrm.v1.ListLeaveRequestsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/hrm/leave-requests\x12\x9c\x01\n" +
 	"\x14ListPendingApprovals\x12'.api.hrm.v1.ListPendingApprovalsRequest\x1a%.api.hrm.v1.ListLeaveRequestsResponse\"4\x82\xd3\xe4\x93\x02.\x12,/api/v1/hrm/leave-requests/pending-approvals\x12\xa2\x01\n" +
 	"\x13ApproveLeaveRequest\x12&.api.hrm.v1.ApproveLeaveRequestRequest\x1a'.api.hrm.v1.ApproveLeaveRequestResponse\":\x82\xd3\xe4\x93\x024:\x01*\"//api/v1/hrm/leave-requests/{request_id}/approve\x12\x9e\x01\n" +
-	"\x12RejectLeaveRequest\x12%.api.hrm.v1.RejectLeaveRequestRequest\x1a&.api.hrm.v1.RejectLeaveRequestResponse\"9\x82\xd3\xe4\x93\x023:\x01*\"./api/v1/hrm/leave-requests/{request_id}/reject2\xb1\x03\n" +
+	"\x12RejectLeaveRequest\x12%.api.hrm.v1.RejectLeaveRequestRequest\x1a&.api.hrm.v1.RejectLeaveRequestResponse\"9\x82\xd3\xe4\x93\x023:\x01*\"./api/v1/hrm/leave-requests/{request_id}/reject\x12\x96\x01\n" +
+	"\x16CalculateLeaveDuration\x12).api.hrm.v1.CalculateLeaveDurationRequest\x1a!.api.hrm.v1.LeaveDurationResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/hrm/leave-requests/duration\x12\x9e\x01\n" +
+	"\x19GetLeaveDurationBreakdown\x12,.api.hrm.v1.GetLeaveDurationBreakdownRequest\x1a!.api.hrm.v1.LeaveDurationResponse\"0\x82\xd3\xe4\x93\x02*\x12(/api/v1/hrm/leave-requests/{id}/duration2\xb8\b\n" +
 	"\x11LeaveQuotaService\x12\x8a\x01\n" +
 	"\x11InitEmployeeQuota\x12$.api.hrm.v1.InitEmployeeQuotaRequest\x1a%.api.hrm.v1.InitEmployeeQuotaResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/hrm/leave-quotas/init\x12r\n" +
 	"\vUpdateQuota\x12\x1e.api.hrm.v1.UpdateQuotaRequest\x1a\x19.api.hrm.v1.QuotaResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\x1a\x1d/api/v1/hrm/leave-quotas/{id}\x12\x9a\x01\n" +
-	"\x11GetEmployeeQuotas\x12$.api.hrm.v1.GetEmployeeQuotasRequest\x1a%.api.hrm.v1.GetEmployeeQuotasResponse\"8\x82\xd3\xe4\x93\x022\x120/api/v1/hrm/employees/{employee_id}/leave-quotasB\x9c\x01\n" +
+	"\x11GetEmployeeQuotas\x12$.api.hrm.v1.GetEmployeeQuotasRequest\x1a%.api.hrm.v1.GetEmployeeQuotasResponse\"8\x82\xd3\xe4\x93\x022\x120/api/v1/hrm/employees/{employee_id}/leave-quotas\x12\xa9\x01\n" +
+	"\x14ListLeaveQuotaLedger\x12\x1f.api.hrm.v1.EmployeeYearRequest\x1a(.api.hrm.v1.ListLeaveQuotaLedgerResponse\"F\x82\xd3\xe4\x93\x02@\x12>/api/v1/hrm/employees/{employee_id}/leave-quotas/{year}/ledger\x12\xa9\x01\n" +
+	"\x13AccrueEmployeeQuota\x12\x1f.api.hrm.v1.EmployeeYearRequest\x1a&.api.hrm.v1.LeaveAccrualResultResponse\"I\x82\xd3\xe4\x93\x02C:\x01*\">/api/v1/hrm/employees/{employee_id}/leave-quotas/{year}/accrue\x12\x8b\x01\n" +
+	"\x10AdjustLeaveQuota\x12#.api.hrm.v1.AdjustLeaveQuotaRequest\x1a!.api.hrm.v1.LeaveQuotaLedgerEntry\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/hrm/leave-quotas/{id}/adjust\x12\x9e\x01\n" +
+	"\x14CarryOverLeaveQuotas\x12'.api.hrm.v1.CarryOverLeaveQuotasRequest\x1a&.api.hrm.v1.LeaveAccrualResultResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/hrm/leave-quotas/carry-over/{year}B\x9c\x01\n" +
 	"\x0ecom.api.hrm.v1B\n" +
 	"LeaveProtoP\x01Z4github.com/lk2023060901/go-next-erp/api/hrm/v1;hrmv1\xa2\x02\x03AHX\xaa\x02\n" +
 	"Api.Hrm.V1\xca\x02\n" +
@@ -3274,123 +4112,155 @@ func file_api_hrm_v1_leave_proto_rawDescGZIP() []byte {
 	return file_api_hrm_v1_leave_proto_rawDescData
 }
 
-var file_api_hrm_v1_leave_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
+var file_api_hrm_v1_leave_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
 var file_api_hrm_v1_leave_proto_goTypes = []any{
-	(*ApprovalNode)(nil),                 // 0: api.hrm.v1.ApprovalNode
-	(*DurationRule)(nil),                 // 1: api.hrm.v1.DurationRule
-	(*ApprovalRules)(nil),                // 2: api.hrm.v1.ApprovalRules
-	(*LeaveTypeResponse)(nil),            // 3: api.hrm.v1.LeaveTypeResponse
-	(*LeaveRequestResponse)(nil),         // 4: api.hrm.v1.LeaveRequestResponse
-	(*LeaveRequestDetailResponse)(nil),   // 5: api.hrm.v1.LeaveRequestDetailResponse
-	(*ApprovalResponse)(nil),             // 6: api.hrm.v1.ApprovalResponse
-	(*QuotaResponse)(nil),                // 7: api.hrm.v1.QuotaResponse
-	(*CreateLeaveTypeRequest)(nil),       // 8: api.hrm.v1.CreateLeaveTypeRequest
-	(*UpdateLeaveTypeRequest)(nil),       // 9: api.hrm.v1.UpdateLeaveTypeRequest
-	(*DeleteLeaveTypeRequest)(nil),       // 10: api.hrm.v1.DeleteLeaveTypeRequest
-	(*DeleteLeaveTypeResponse)(nil),      // 11: api.hrm.v1.DeleteLeaveTypeResponse
-	(*GetLeaveTypeRequest)(nil),          // 12: api.hrm.v1.GetLeaveTypeRequest
-	(*ListLeaveTypesRequest)(nil),        // 13: api.hrm.v1.ListLeaveTypesRequest
-	(*ListLeaveTypesResponse)(nil),       // 14: api.hrm.v1.ListLeaveTypesResponse
-	(*ListActiveLeaveTypesRequest)(nil),  // 15: api.hrm.v1.ListActiveLeaveTypesRequest
-	(*ListActiveLeaveTypesResponse)(nil), // 16: api.hrm.v1.ListActiveLeaveTypesResponse
-	(*CreateLeaveRequestRequest)(nil),    // 17: api.hrm.v1.CreateLeaveRequestRequest
-	(*UpdateLeaveRequestRequest)(nil),    // 18: api.hrm.v1.UpdateLeaveRequestRequest
-	(*SubmitLeaveRequestRequest)(nil),    // 19: api.hrm.v1.SubmitLeaveRequestRequest
-	(*SubmitLeaveRequestResponse)(nil),   // 20: api.hrm.v1.SubmitLeaveRequestResponse
-	(*WithdrawLeaveRequestRequest)(nil),  // 21: api.hrm.v1.WithdrawLeaveRequestRequest
-	(*WithdrawLeaveRequestResponse)(nil), // 22: api.hrm.v1.WithdrawLeaveRequestResponse
-	(*CancelLeaveRequestRequest)(nil),    // 23: api.hrm.v1.CancelLeaveRequestRequest
-	(*CancelLeaveRequestResponse)(nil),   // 24: api.hrm.v1.CancelLeaveRequestResponse
-	(*GetLeaveRequestRequest)(nil),       // 25: api.hrm.v1.GetLeaveRequestRequest
-	(*ListMyLeaveRequestsRequest)(nil),   // 26: api.hrm.v1.ListMyLeaveRequestsRequest
-	(*ListLeaveRequestsRequest)(nil),     // 27: api.hrm.v1.ListLeaveRequestsRequest
-	(*ListLeaveRequestsResponse)(nil),    // 28: api.hrm.v1.ListLeaveRequestsResponse
-	(*ListPendingApprovalsRequest)(nil),  // 29: api.hrm.v1.ListPendingApprovalsRequest
-	(*ApproveLeaveRequestRequest)(nil),   // 30: api.hrm.v1.ApproveLeaveRequestRequest
-	(*ApproveLeaveRequestResponse)(nil),  // 31: api.hrm.v1.ApproveLeaveRequestResponse
-	(*RejectLeaveRequestRequest)(nil),    // 32: api.hrm.v1.RejectLeaveRequestRequest
-	(*RejectLeaveRequestResponse)(nil),   // 33: api.hrm.v1.RejectLeaveRequestResponse
-	(*InitEmployeeQuotaRequest)(nil),     // 34: api.hrm.v1.InitEmployeeQuotaRequest
-	(*InitEmployeeQuotaResponse)(nil),    // 35: api.hrm.v1.InitEmployeeQuotaResponse
-	(*UpdateQuotaRequest)(nil),           // 36: api.hrm.v1.UpdateQuotaRequest
-	(*GetEmployeeQuotasRequest)(nil),     // 37: api.hrm.v1.GetEmployeeQuotasRequest
-	(*GetEmployeeQuotasResponse)(nil),    // 38: api.hrm.v1.GetEmployeeQuotasResponse
-	(*timestamppb.Timestamp)(nil),        // 39: google.protobuf.Timestamp
+	(*ApprovalNode)(nil),                     // 0: api.hrm.v1.ApprovalNode
+	(*DurationRule)(nil),                     // 1: api.hrm.v1.DurationRule
+	(*ApprovalRules)(nil),                    // 2: api.hrm.v1.ApprovalRules
+	(*LeaveTypeResponse)(nil),                // 3: api.hrm.v1.LeaveTypeResponse
+	(*LeaveRequestResponse)(nil),             // 4: api.hrm.v1.LeaveRequestResponse
+	(*LeaveRequestDetailResponse)(nil),       // 5: api.hrm.v1.LeaveRequestDetailResponse
+	(*ApprovalResponse)(nil),                 // 6: api.hrm.v1.ApprovalResponse
+	(*QuotaResponse)(nil),                    // 7: api.hrm.v1.QuotaResponse
+	(*LeaveDayDuration)(nil),                 // 8: api.hrm.v1.LeaveDayDuration
+	(*LeaveMonthDuration)(nil),               // 9: api.hrm.v1.LeaveMonthDuration
+	(*LeaveDurationResponse)(nil),            // 10: api.hrm.v1.LeaveDurationResponse
+	(*LeaveQuotaLedgerEntry)(nil),            // 11: api.hrm.v1.LeaveQuotaLedgerEntry
+	(*CreateLeaveTypeRequest)(nil),           // 12: api.hrm.v1.CreateLeaveTypeRequest
+	(*UpdateLeaveTypeRequest)(nil),           // 13: api.hrm.v1.UpdateLeaveTypeRequest
+	(*DeleteLeaveTypeRequest)(nil),           // 14: api.hrm.v1.DeleteLeaveTypeRequest
+	(*DeleteLeaveTypeResponse)(nil),          // 15: api.hrm.v1.DeleteLeaveTypeResponse
+	(*GetLeaveTypeRequest)(nil),              // 16: api.hrm.v1.GetLeaveTypeRequest
+	(*ListLeaveTypesRequest)(nil),            // 17: api.hrm.v1.ListLeaveTypesRequest
+	(*ListLeaveTypesResponse)(nil),           // 18: api.hrm.v1.ListLeaveTypesResponse
+	(*ListActiveLeaveTypesRequest)(nil),      // 19: api.hrm.v1.ListActiveLeaveTypesRequest
+	(*ListActiveLeaveTypesResponse)(nil),     // 20: api.hrm.v1.ListActiveLeaveTypesResponse
+	(*CreateLeaveRequestRequest)(nil),        // 21: api.hrm.v1.CreateLeaveRequestRequest
+	(*UpdateLeaveRequestRequest)(nil),        // 22: api.hrm.v1.UpdateLeaveRequestRequest
+	(*SubmitLeaveRequestRequest)(nil),        // 23: api.hrm.v1.SubmitLeaveRequestRequest
+	(*SubmitLeaveRequestResponse)(nil),       // 24: api.hrm.v1.SubmitLeaveRequestResponse
+	(*WithdrawLeaveRequestRequest)(nil),      // 25: api.hrm.v1.WithdrawLeaveRequestRequest
+	(*WithdrawLeaveRequestResponse)(nil),     // 26: api.hrm.v1.WithdrawLeaveRequestResponse
+	(*CancelLeaveRequestRequest)(nil),        // 27: api.hrm.v1.CancelLeaveRequestRequest
+	(*CancelLeaveRequestResponse)(nil),       // 28: api.hrm.v1.CancelLeaveRequestResponse
+	(*GetLeaveRequestRequest)(nil),           // 29: api.hrm.v1.GetLeaveRequestRequest
+	(*ListMyLeaveRequestsRequest)(nil),       // 30: api.hrm.v1.ListMyLeaveRequestsRequest
+	(*ListLeaveRequestsRequest)(nil),         // 31: api.hrm.v1.ListLeaveRequestsRequest
+	(*ListLeaveRequestsResponse)(nil),        // 32: api.hrm.v1.ListLeaveRequestsResponse
+	(*ListPendingApprovalsRequest)(nil),      // 33: api.hrm.v1.ListPendingApprovalsRequest
+	(*ApproveLeaveRequestRequest)(nil),       // 34: api.hrm.v1.ApproveLeaveRequestRequest
+	(*ApproveLeaveRequestResponse)(nil),      // 35: api.hrm.v1.ApproveLeaveRequestResponse
+	(*RejectLeaveRequestRequest)(nil),        // 36: api.hrm.v1.RejectLeaveRequestRequest
+	(*RejectLeaveRequestResponse)(nil),       // 37: api.hrm.v1.RejectLeaveRequestResponse
+	(*InitEmployeeQuotaRequest)(nil),         // 38: api.hrm.v1.InitEmployeeQuotaRequest
+	(*InitEmployeeQuotaResponse)(nil),        // 39: api.hrm.v1.InitEmployeeQuotaResponse
+	(*UpdateQuotaRequest)(nil),               // 40: api.hrm.v1.UpdateQuotaRequest
+	(*GetEmployeeQuotasRequest)(nil),         // 41: api.hrm.v1.GetEmployeeQuotasRequest
+	(*GetEmployeeQuotasResponse)(nil),        // 42: api.hrm.v1.GetEmployeeQuotasResponse
+	(*CalculateLeaveDurationRequest)(nil),    // 43: api.hrm.v1.CalculateLeaveDurationRequest
+	(*GetLeaveDurationBreakdownRequest)(nil), // 44: api.hrm.v1.GetLeaveDurationBreakdownRequest
+	(*EmployeeYearRequest)(nil),              // 45: api.hrm.v1.EmployeeYearRequest
+	(*ListLeaveQuotaLedgerResponse)(nil),     // 46: api.hrm.v1.ListLeaveQuotaLedgerResponse
+	(*AdjustLeaveQuotaRequest)(nil),          // 47: api.hrm.v1.AdjustLeaveQuotaRequest
+	(*CarryOverLeaveQuotasRequest)(nil),      // 48: api.hrm.v1.CarryOverLeaveQuotasRequest
+	(*LeaveAccrualResultResponse)(nil),       // 49: api.hrm.v1.LeaveAccrualResultResponse
+	(*timestamppb.Timestamp)(nil),            // 50: google.protobuf.Timestamp
 }
 var file_api_hrm_v1_leave_proto_depIdxs = []int32{
 	0,  // 0: api.hrm.v1.DurationRule.approval_chain:type_name -> api.hrm.v1.ApprovalNode
 	0,  // 1: api.hrm.v1.ApprovalRules.default_chain:type_name -> api.hrm.v1.ApprovalNode
 	1,  // 2: api.hrm.v1.ApprovalRules.duration_rules:type_name -> api.hrm.v1.DurationRule
 	2,  // 3: api.hrm.v1.LeaveTypeResponse.approval_rules:type_name -> api.hrm.v1.ApprovalRules
-	39, // 4: api.hrm.v1.LeaveTypeResponse.created_at:type_name -> google.protobuf.Timestamp
-	39, // 5: api.hrm.v1.LeaveRequestResponse.start_time:type_name -> google.protobuf.Timestamp
-	39, // 6: api.hrm.v1.LeaveRequestResponse.end_time:type_name -> google.protobuf.Timestamp
-	39, // 7: api.hrm.v1.LeaveRequestResponse.submitted_at:type_name -> google.protobuf.Timestamp
-	39, // 8: api.hrm.v1.LeaveRequestResponse.created_at:type_name -> google.protobuf.Timestamp
+	50, // 4: api.hrm.v1.LeaveTypeResponse.created_at:type_name -> google.protobuf.Timestamp
+	50, // 5: api.hrm.v1.LeaveRequestResponse.start_time:type_name -> google.protobuf.Timestamp
+	50, // 6: api.hrm.v1.LeaveRequestResponse.end_time:type_name -> google.protobuf.Timestamp
+	50, // 7: api.hrm.v1.LeaveRequestResponse.submitted_at:type_name -> google.protobuf.Timestamp
+	50, // 8: api.hrm.v1.LeaveRequestResponse.created_at:type_name -> google.protobuf.Timestamp
 	4,  // 9: api.hrm.v1.LeaveRequestDetailResponse.request:type_name -> api.hrm.v1.LeaveRequestResponse
 	6,  // 10: api.hrm.v1.LeaveRequestDetailResponse.approvals:type_name -> api.hrm.v1.ApprovalResponse
-	39, // 11: api.hrm.v1.ApprovalResponse.approved_at:type_name -> google.protobuf.Timestamp
-	39, // 12: api.hrm.v1.ApprovalResponse.created_at:type_name -> google.protobuf.Timestamp
-	39, // 13: api.hrm.v1.QuotaResponse.expired_at:type_name -> google.protobuf.Timestamp
-	2,  // 14: api.hrm.v1.CreateLeaveTypeRequest.approval_rules:type_name -> api.hrm.v1.ApprovalRules
-	2,  // 15: api.hrm.v1.UpdateLeaveTypeRequest.approval_rules:type_name -> api.hrm.v1.ApprovalRules
-	3,  // 16: api.hrm.v1.ListLeaveTypesResponse.items:type_name -> api.hrm.v1.LeaveTypeResponse
-	3,  // 17: api.hrm.v1.ListActiveLeaveTypesResponse.items:type_name -> api.hrm.v1.LeaveTypeResponse
-	39, // 18: api.hrm.v1.CreateLeaveRequestRequest.start_time:type_name -> google.protobuf.Timestamp
-	39, // 19: api.hrm.v1.CreateLeaveRequestRequest.end_time:type_name -> google.protobuf.Timestamp
-	39, // 20: api.hrm.v1.UpdateLeaveRequestRequest.start_time:type_name -> google.protobuf.Timestamp
-	39, // 21: api.hrm.v1.UpdateLeaveRequestRequest.end_time:type_name -> google.protobuf.Timestamp
-	39, // 22: api.hrm.v1.ListMyLeaveRequestsRequest.start_date:type_name -> google.protobuf.Timestamp
-	39, // 23: api.hrm.v1.ListMyLeaveRequestsRequest.end_date:type_name -> google.protobuf.Timestamp
-	39, // 24: api.hrm.v1.ListLeaveRequestsRequest.start_date:type_name -> google.protobuf.Timestamp
-	39, // 25: api.hrm.v1.ListLeaveRequestsRequest.end_date:type_name -> google.protobuf.Timestamp
-	4,  // 26: api.hrm.v1.ListLeaveRequestsResponse.items:type_name -> api.hrm.v1.LeaveRequestResponse
-	7,  // 27: api.hrm.v1.GetEmployeeQuotasResponse.items:type_name -> api.hrm.v1.QuotaResponse
-	8,  // 28: api.hrm.v1.LeaveTypeService.CreateLeaveType:input_type -> api.hrm.v1.CreateLeaveTypeRequest
-	9,  // 29: api.hrm.v1.LeaveTypeService.UpdateLeaveType:input_type -> api.hrm.v1.UpdateLeaveTypeRequest
-	10, // 30: api.hrm.v1.LeaveTypeService.DeleteLeaveType:input_type -> api.hrm.v1.DeleteLeaveTypeRequest
-	12, // 31: api.hrm.v1.LeaveTypeService.GetLeaveType:input_type -> api.hrm.v1.GetLeaveTypeRequest
-	13, // 32: api.hrm.v1.LeaveTypeService.ListLeaveTypes:input_type -> api.hrm.v1.ListLeaveTypesRequest
-	15, // 33: api.hrm.v1.LeaveTypeService.ListActiveLeaveTypes:input_type -> api.hrm.v1.ListActiveLeaveTypesRequest
-	17, // 34: api.hrm.v1.LeaveRequestService.CreateLeaveRequest:input_type -> api.hrm.v1.CreateLeaveRequestRequest
-	18, // 35: api.hrm.v1.LeaveRequestService.UpdateLeaveRequest:input_type -> api.hrm.v1.UpdateLeaveRequestRequest
-	19, // 36: api.hrm.v1.LeaveRequestService.SubmitLeaveRequest:input_type -> api.hrm.v1.SubmitLeaveRequestRequest
-	21, // 37: api.hrm.v1.LeaveRequestService.WithdrawLeaveRequest:input_type -> api.hrm.v1.WithdrawLeaveRequestRequest
-	23, // 38: api.hrm.v1.LeaveRequestService.CancelLeaveRequest:input_type -> api.hrm.v1.CancelLeaveRequestRequest
-	25, // 39: api.hrm.v1.LeaveRequestService.GetLeaveRequest:input_type -> api.hrm.v1.GetLeaveRequestRequest
-	26, // 40: api.hrm.v1.LeaveRequestService.ListMyLeaveRequests:input_type -> api.hrm.v1.ListMyLeaveRequestsRequest
-	27, // 41: api.hrm.v1.LeaveRequestService.ListLeaveRequests:input_type -> api.hrm.v1.ListLeaveRequestsRequest
-	29, // 42: api.hrm.v1.LeaveRequestService.ListPendingApprovals:input_type -> api.hrm.v1.ListPendingApprovalsRequest
-	30, // 43: api.hrm.v1.LeaveRequestService.ApproveLeaveRequest:input_type -> api.hrm.v1.ApproveLeaveRequestRequest
-	32, // 44: api.hrm.v1.LeaveRequestService.RejectLeaveRequest:input_type -> api.hrm.v1.RejectLeaveRequestRequest
-	34, // 45: api.hrm.v1.LeaveQuotaService.InitEmployeeQuota:input_type -> api.hrm.v1.InitEmployeeQuotaRequest
-	36, // 46: api.hrm.v1.LeaveQuotaService.UpdateQuota:input_type -> api.hrm.v1.UpdateQuotaRequest
-	37, // 47: api.hrm.v1.LeaveQuotaService.GetEmployeeQuotas:input_type -> api.hrm.v1.GetEmployeeQuotasRequest
-	3,  // 48: api.hrm.v1.LeaveTypeService.CreateLeaveType:output_type -> api.hrm.v1.LeaveTypeResponse
-	3,  // 49: api.hrm.v1.LeaveTypeService.UpdateLeaveType:output_type -> api.hrm.v1.LeaveTypeResponse
-	11, // 50: api.hrm.v1.LeaveTypeService.DeleteLeaveType:output_type -> api.hrm.v1.DeleteLeaveTypeResponse
-	3,  // 51: api.hrm.v1.LeaveTypeService.GetLeaveType:output_type -> api.hrm.v1.LeaveTypeResponse
-	14, // 52: api.hrm.v1.LeaveTypeService.ListLeaveTypes:output_type -> api.hrm.v1.ListLeaveTypesResponse
-	16, // 53: api.hrm.v1.LeaveTypeService.ListActiveLeaveTypes:output_type -> api.hrm.v1.ListActiveLeaveTypesResponse
-	4,  // 54: api.hrm.v1.LeaveRequestService.CreateLeaveRequest:output_type -> api.hrm.v1.LeaveRequestResponse
-	4,  // 55: api.hrm.v1.LeaveRequestService.UpdateLeaveRequest:output_type -> api.hrm.v1.LeaveRequestResponse
-	20, // 56: api.hrm.v1.LeaveRequestService.SubmitLeaveRequest:output_type -> api.hrm.v1.SubmitLeaveRequestResponse
-	22, // 57: api.hrm.v1.LeaveRequestService.WithdrawLeaveRequest:output_type -> api.hrm.v1.WithdrawLeaveRequestResponse
-	24, // 58: api.hrm.v1.LeaveRequestService.CancelLeaveRequest:output_type -> api.hrm.v1.CancelLeaveRequestResponse
-	5,  // 59: api.hrm.v1.LeaveRequestService.GetLeaveRequest:output_type -> api.hrm.v1.LeaveRequestDetailResponse
-	28, // 60: api.hrm.v1.LeaveRequestService.ListMyLeaveRequests:output_type -> api.hrm.v1.ListLeaveRequestsResponse
-	28, // 61: api.hrm.v1.LeaveRequestService.ListLeaveRequests:output_type -> api.hrm.v1.ListLeaveRequestsResponse
-	28, // 62: api.hrm.v1.LeaveRequestService.ListPendingApprovals:output_type -> api.hrm.v1.ListLeaveRequestsResponse
-	31, // 63: api.hrm.v1.LeaveRequestService.ApproveLeaveRequest:output_type -> api.hrm.v1.ApproveLeaveRequestResponse
-	33, // 64: api.hrm.v1.LeaveRequestService.RejectLeaveRequest:output_type -> api.hrm.v1.RejectLeaveRequestResponse
-	35, // 65: api.hrm.v1.LeaveQuotaService.InitEmployeeQuota:output_type -> api.hrm.v1.InitEmployeeQuotaResponse
-	7,  // 66: api.hrm.v1.LeaveQuotaService.UpdateQuota:output_type -> api.hrm.v1.QuotaResponse
-	38, // 67: api.hrm.v1.LeaveQuotaService.GetEmployeeQuotas:output_type -> api.hrm.v1.GetEmployeeQuotasResponse
-	48, // [48:68] is the sub-list for method output_type
-	28, // [28:48] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	50, // 11: api.hrm.v1.ApprovalResponse.approved_at:type_name -> google.protobuf.Timestamp
+	50, // 12: api.hrm.v1.ApprovalResponse.created_at:type_name -> google.protobuf.Timestamp
+	50, // 13: api.hrm.v1.QuotaResponse.expired_at:type_name -> google.protobuf.Timestamp
+	50, // 14: api.hrm.v1.LeaveDayDuration.start_time:type_name -> google.protobuf.Timestamp
+	50, // 15: api.hrm.v1.LeaveDayDuration.end_time:type_name -> google.protobuf.Timestamp
+	8,  // 16: api.hrm.v1.LeaveDurationResponse.days:type_name -> api.hrm.v1.LeaveDayDuration
+	9,  // 17: api.hrm.v1.LeaveDurationResponse.months:type_name -> api.hrm.v1.LeaveMonthDuration
+	50, // 18: api.hrm.v1.LeaveQuotaLedgerEntry.expires_at:type_name -> google.protobuf.Timestamp
+	50, // 19: api.hrm.v1.LeaveQuotaLedgerEntry.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 20: api.hrm.v1.CreateLeaveTypeRequest.approval_rules:type_name -> api.hrm.v1.ApprovalRules
+	2,  // 21: api.hrm.v1.UpdateLeaveTypeRequest.approval_rules:type_name -> api.hrm.v1.ApprovalRules
+	3,  // 22: api.hrm.v1.ListLeaveTypesResponse.items:type_name -> api.hrm.v1.LeaveTypeResponse
+	3,  // 23: api.hrm.v1.ListActiveLeaveTypesResponse.items:type_name -> api.hrm.v1.LeaveTypeResponse
+	50, // 24: api.hrm.v1.CreateLeaveRequestRequest.start_time:type_name -> google.protobuf.Timestamp
+	50, // 25: api.hrm.v1.CreateLeaveRequestRequest.end_time:type_name -> google.protobuf.Timestamp
+	50, // 26: api.hrm.v1.UpdateLeaveRequestRequest.start_time:type_name -> google.protobuf.Timestamp
+	50, // 27: api.hrm.v1.UpdateLeaveRequestRequest.end_time:type_name -> google.protobuf.Timestamp
+	50, // 28: api.hrm.v1.ListMyLeaveRequestsRequest.start_date:type_name -> google.protobuf.Timestamp
+	50, // 29: api.hrm.v1.ListMyLeaveRequestsRequest.end_date:type_name -> google.protobuf.Timestamp
+	50, // 30: api.hrm.v1.ListLeaveRequestsRequest.start_date:type_name -> google.protobuf.Timestamp
+	50, // 31: api.hrm.v1.ListLeaveRequestsRequest.end_date:type_name -> google.protobuf.Timestamp
+	4,  // 32: api.hrm.v1.ListLeaveRequestsResponse.items:type_name -> api.hrm.v1.LeaveRequestResponse
+	7,  // 33: api.hrm.v1.GetEmployeeQuotasResponse.items:type_name -> api.hrm.v1.QuotaResponse
+	50, // 34: api.hrm.v1.CalculateLeaveDurationRequest.start_time:type_name -> google.protobuf.Timestamp
+	50, // 35: api.hrm.v1.CalculateLeaveDurationRequest.end_time:type_name -> google.protobuf.Timestamp
+	11, // 36: api.hrm.v1.ListLeaveQuotaLedgerResponse.items:type_name -> api.hrm.v1.LeaveQuotaLedgerEntry
+	12, // 37: api.hrm.v1.LeaveTypeService.CreateLeaveType:input_type -> api.hrm.v1.CreateLeaveTypeRequest
+	13, // 38: api.hrm.v1.LeaveTypeService.UpdateLeaveType:input_type -> api.hrm.v1.UpdateLeaveTypeRequest
+	14, // 39: api.hrm.v1.LeaveTypeService.DeleteLeaveType:input_type -> api.hrm.v1.DeleteLeaveTypeRequest
+	16, // 40: api.hrm.v1.LeaveTypeService.GetLeaveType:input_type -> api.hrm.v1.GetLeaveTypeRequest
+	17, // 41: api.hrm.v1.LeaveTypeService.ListLeaveTypes:input_type -> api.hrm.v1.ListLeaveTypesRequest
+	19, // 42: api.hrm.v1.LeaveTypeService.ListActiveLeaveTypes:input_type -> api.hrm.v1.ListActiveLeaveTypesRequest
+	21, // 43: api.hrm.v1.LeaveRequestService.CreateLeaveRequest:input_type -> api.hrm.v1.CreateLeaveRequestRequest
+	22, // 44: api.hrm.v1.LeaveRequestService.UpdateLeaveRequest:input_type -> api.hrm.v1.UpdateLeaveRequestRequest
+	23, // 45: api.hrm.v1.LeaveRequestService.SubmitLeaveRequest:input_type -> api.hrm.v1.SubmitLeaveRequestRequest
+	25, // 46: api.hrm.v1.LeaveRequestService.WithdrawLeaveRequest:input_type -> api.hrm.v1.WithdrawLeaveRequestRequest
+	27, // 47: api.hrm.v1.LeaveRequestService.CancelLeaveRequest:input_type -> api.hrm.v1.CancelLeaveRequestRequest
+	29, // 48: api.hrm.v1.LeaveRequestService.GetLeaveRequest:input_type -> api.hrm.v1.GetLeaveRequestRequest
+	30, // 49: api.hrm.v1.LeaveRequestService.ListMyLeaveRequests:input_type -> api.hrm.v1.ListMyLeaveRequestsRequest
+	31, // 50: api.hrm.v1.LeaveRequestService.ListLeaveRequests:input_type -> api.hrm.v1.ListLeaveRequestsRequest
+	33, // 51: api.hrm.v1.LeaveRequestService.ListPendingApprovals:input_type -> api.hrm.v1.ListPendingApprovalsRequest
+	34, // 52: api.hrm.v1.LeaveRequestService.ApproveLeaveRequest:input_type -> api.hrm.v1.ApproveLeaveRequestRequest
+	36, // 53: api.hrm.v1.LeaveRequestService.RejectLeaveRequest:input_type -> api.hrm.v1.RejectLeaveRequestRequest
+	43, // 54: api.hrm.v1.LeaveRequestService.CalculateLeaveDuration:input_type -> api.hrm.v1.CalculateLeaveDurationRequest
+	44, // 55: api.hrm.v1.LeaveRequestService.GetLeaveDurationBreakdown:input_type -> api.hrm.v1.GetLeaveDurationBreakdownRequest
+	38, // 56: api.hrm.v1.LeaveQuotaService.InitEmployeeQuota:input_type -> api.hrm.v1.InitEmployeeQuotaRequest
+	40, // 57: api.hrm.v1.LeaveQuotaService.UpdateQuota:input_type -> api.hrm.v1.UpdateQuotaRequest
+	41, // 58: api.hrm.v1.LeaveQuotaService.GetEmployeeQuotas:input_type -> api.hrm.v1.GetEmployeeQuotasRequest
+	45, // 59: api.hrm.v1.LeaveQuotaService.ListLeaveQuotaLedger:input_type -> api.hrm.v1.EmployeeYearRequest
+	45, // 60: api.hrm.v1.LeaveQuotaService.AccrueEmployeeQuota:input_type -> api.hrm.v1.EmployeeYearRequest
+	47, // 61: api.hrm.v1.LeaveQuotaService.AdjustLeaveQuota:input_type -> api.hrm.v1.AdjustLeaveQuotaRequest
+	48, // 62: api.hrm.v1.LeaveQuotaService.CarryOverLeaveQuotas:input_type -> api.hrm.v1.CarryOverLeaveQuotasRequest
+	3,  // 63: api.hrm.v1.LeaveTypeService.CreateLeaveType:output_type -> api.hrm.v1.LeaveTypeResponse
+	3,  // 64: api.hrm.v1.LeaveTypeService.UpdateLeaveType:output_type -> api.hrm.v1.LeaveTypeResponse
+	15, // 65: api.hrm.v1.LeaveTypeService.DeleteLeaveType:output_type -> api.hrm.v1.DeleteLeaveTypeResponse
+	3,  // 66: api.hrm.v1.LeaveTypeService.GetLeaveType:output_type -> api.hrm.v1.LeaveTypeResponse
+	18, // 67: api.hrm.v1.LeaveTypeService.ListLeaveTypes:output_type -> api.hrm.v1.ListLeaveTypesResponse
+	20, // 68: api.hrm.v1.LeaveTypeService.ListActiveLeaveTypes:output_type -> api.hrm.v1.ListActiveLeaveTypesResponse
+	4,  // 69: api.hrm.v1.LeaveRequestService.CreateLeaveRequest:output_type -> api.hrm.v1.LeaveRequestResponse
+	4,  // 70: api.hrm.v1.LeaveRequestService.UpdateLeaveRequest:output_type -> api.hrm.v1.LeaveRequestResponse
+	24, // 71: api.hrm.v1.LeaveRequestService.SubmitLeaveRequest:output_type -> api.hrm.v1.SubmitLeaveRequestResponse
+	26, // 72: api.hrm.v1.LeaveRequestService.WithdrawLeaveRequest:output_type -> api.hrm.v1.WithdrawLeaveRequestResponse
+	28, // 73: api.hrm.v1.LeaveRequestService.CancelLeaveRequest:output_type -> api.hrm.v1.CancelLeaveRequestResponse
+	5,  // 74: api.hrm.v1.LeaveRequestService.GetLeaveRequest:output_type -> api.hrm.v1.LeaveRequestDetailResponse
+	32, // 75: api.hrm.v1.LeaveRequestService.ListMyLeaveRequests:output_type -> api.hrm.v1.ListLeaveRequestsResponse
+	32, // 76: api.hrm.v1.LeaveRequestService.ListLeaveRequests:output_type -> api.hrm.v1.ListLeaveRequestsResponse
+	32, // 77: api.hrm.v1.LeaveRequestService.ListPendingApprovals:output_type -> api.hrm.v1.ListLeaveRequestsResponse
+	35, // 78: api.hrm.v1.LeaveRequestService.ApproveLeaveRequest:output_type -> api.hrm.v1.ApproveLeaveRequestResponse
+	37, // 79: api.hrm.v1.LeaveRequestService.RejectLeaveRequest:output_type -> api.hrm.v1.RejectLeaveRequestResponse
+	10, // 80: api.hrm.v1.LeaveRequestService.CalculateLeaveDuration:output_type -> api.hrm.v1.LeaveDurationResponse
+	10, // 81: api.hrm.v1.LeaveRequestService.GetLeaveDurationBreakdown:output_type -> api.hrm.v1.LeaveDurationResponse
+	39, // 82: api.hrm.v1.LeaveQuotaService.InitEmployeeQuota:output_type -> api.hrm.v1.InitEmployeeQuotaResponse
+	7,  // 83: api.hrm.v1.LeaveQuotaService.UpdateQuota:output_type -> api.hrm.v1.QuotaResponse
+	42, // 84: api.hrm.v1.LeaveQuotaService.GetEmployeeQuotas:output_type -> api.hrm.v1.GetEmployeeQuotasResponse
+	46, // 85: api.hrm.v1.LeaveQuotaService.ListLeaveQuotaLedger:output_type -> api.hrm.v1.ListLeaveQuotaLedgerResponse
+	49, // 86: api.hrm.v1.LeaveQuotaService.AccrueEmployeeQuota:output_type -> api.hrm.v1.LeaveAccrualResultResponse
+	11, // 87: api.hrm.v1.LeaveQuotaService.AdjustLeaveQuota:output_type -> api.hrm.v1.LeaveQuotaLedgerEntry
+	49, // 88: api.hrm.v1.LeaveQuotaService.CarryOverLeaveQuotas:output_type -> api.hrm.v1.LeaveAccrualResultResponse
+	63, // [63:89] is the sub-list for method output_type
+	37, // [37:63] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_api_hrm_v1_leave_proto_init() }
@@ -3404,7 +4274,7 @@ func file_api_hrm_v1_leave_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_hrm_v1_leave_proto_rawDesc), len(file_api_hrm_v1_leave_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   39,
+			NumMessages:   50,
 			NumExtensions: 0,
 			NumServices:   3,
 		},
@@ -1373,99 +1373,112 @@ var _ interface {
 	ErrorName() string
 } = QuotaResponseValidationError{}
 
-// Validate checks the field values on CreateLeaveTypeRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateLeaveTypeRequest) Validate() error {
+// Validate checks the field values on LeaveDayDuration with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LeaveDayDuration) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateLeaveTypeRequest with the rules
+// ValidateAll checks the field values on LeaveDayDuration with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateLeaveTypeRequestMultiError, or nil if none found.
-func (m *CreateLeaveTypeRequest) ValidateAll() error {
+// LeaveDayDurationMultiError, or nil if none found.
+func (m *LeaveDayDuration) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateLeaveTypeRequest) validate(all bool) error {
+func (m *LeaveDayDuration) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TenantId
-
-	// no validation rules for Code
-
-	// no validation rules for Name
-
-	// no validation rules for Description
-
-	// no validation rules for IsPaid
-
-	// no validation rules for RequiresApproval
-
-	// no validation rules for RequiresProof
-
-	// no validation rules for DeductQuota
+	// no validation rules for Date
 
-	// no validation rules for Unit
+	// no validation rules for DayType
 
-	// no validation rules for MinDuration
+	// no validation rules for ShiftId
 
-	// no validation rules for MaxDuration
+	// no validation rules for ShiftName
 
-	// no validation rules for AdvanceDays
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LeaveDayDurationValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LeaveDayDurationValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LeaveDayDurationValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if all {
-		switch v := interface{}(m.GetApprovalRules()).(type) {
+		switch v := interface{}(m.GetEndTime()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateLeaveTypeRequestValidationError{
-					field:  "ApprovalRules",
+				errors = append(errors, LeaveDayDurationValidationError{
+					field:  "EndTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateLeaveTypeRequestValidationError{
-					field:  "ApprovalRules",
+				errors = append(errors, LeaveDayDurationValidationError{
+					field:  "EndTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetApprovalRules()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateLeaveTypeRequestValidationError{
-				field:  "ApprovalRules",
+			return LeaveDayDurationValidationError{
+				field:  "EndTime",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for Color
+	// no validation rules for Hours
 
-	// no validation rules for Sort
+	// no validation rules for Duration
 
 	if len(errors) > 0 {
-		return CreateLeaveTypeRequestMultiError(errors)
+		return LeaveDayDurationMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateLeaveTypeRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateLeaveTypeRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateLeaveTypeRequestMultiError []error
+// LeaveDayDurationMultiError is an error wrapping multiple validation errors
+// returned by LeaveDayDuration.ValidateAll() if the designated constraints
+// aren't met.
+type LeaveDayDurationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateLeaveTypeRequestMultiError) Error() string {
+func (m LeaveDayDurationMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1474,11 +1487,11 @@ func (m CreateLeaveTypeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateLeaveTypeRequestMultiError) AllErrors() []error { return m }
+func (m LeaveDayDurationMultiError) AllErrors() []error { return m }
 
-// CreateLeaveTypeRequestValidationError is the validation error returned by
-// CreateLeaveTypeRequest.Validate if the designated constraints aren't met.
-type CreateLeaveTypeRequestValidationError struct {
+// LeaveDayDurationValidationError is the validation error returned by
+// LeaveDayDuration.Validate if the designated constraints aren't met.
+type LeaveDayDurationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1486,24 +1499,22 @@ type CreateLeaveTypeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateLeaveTypeRequestValidationError) Field() string { return e.field }
+func (e LeaveDayDurationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateLeaveTypeRequestValidationError) Reason() string { return e.reason }
+func (e LeaveDayDurationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateLeaveTypeRequestValidationError) Cause() error { return e.cause }
+func (e LeaveDayDurationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateLeaveTypeRequestValidationError) Key() bool { return e.key }
+func (e LeaveDayDurationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateLeaveTypeRequestValidationError) ErrorName() string {
-	return "CreateLeaveTypeRequestValidationError"
-}
+func (e LeaveDayDurationValidationError) ErrorName() string { return "LeaveDayDurationValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateLeaveTypeRequestValidationError) Error() string {
+func (e LeaveDayDurationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1515,14 +1526,14 @@ func (e CreateLeaveTypeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateLeaveTypeRequest.%s: %s%s",
+		"invalid %sLeaveDayDuration.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateLeaveTypeRequestValidationError{}
+var _ error = LeaveDayDurationValidationError{}
 
 var _ interface {
 	Field() string
@@ -1530,101 +1541,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateLeaveTypeRequestValidationError{}
+} = LeaveDayDurationValidationError{}
 
-// Validate checks the field values on UpdateLeaveTypeRequest with the rules
+// Validate checks the field values on LeaveMonthDuration with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateLeaveTypeRequest) Validate() error {
+func (m *LeaveMonthDuration) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateLeaveTypeRequest with the rules
+// ValidateAll checks the field values on LeaveMonthDuration with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateLeaveTypeRequestMultiError, or nil if none found.
-func (m *UpdateLeaveTypeRequest) ValidateAll() error {
+// LeaveMonthDurationMultiError, or nil if none found.
+func (m *LeaveMonthDuration) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateLeaveTypeRequest) validate(all bool) error {
+func (m *LeaveMonthDuration) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Name
-
-	// no validation rules for Description
-
-	// no validation rules for IsPaid
-
-	// no validation rules for RequiresApproval
-
-	// no validation rules for RequiresProof
-
-	// no validation rules for DeductQuota
-
-	// no validation rules for Unit
-
-	// no validation rules for MinDuration
-
-	// no validation rules for MaxDuration
-
-	// no validation rules for AdvanceDays
-
-	if all {
-		switch v := interface{}(m.GetApprovalRules()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateLeaveTypeRequestValidationError{
-					field:  "ApprovalRules",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateLeaveTypeRequestValidationError{
-					field:  "ApprovalRules",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetApprovalRules()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateLeaveTypeRequestValidationError{
-				field:  "ApprovalRules",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Year
 
-	// no validation rules for Color
+	// no validation rules for Month
 
-	// no validation rules for IsActive
+	// no validation rules for Hours
 
-	// no validation rules for Sort
+	// no validation rules for Duration
 
 	if len(errors) > 0 {
-		return UpdateLeaveTypeRequestMultiError(errors)
+		return LeaveMonthDurationMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateLeaveTypeRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateLeaveTypeRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateLeaveTypeRequestMultiError []error
+// LeaveMonthDurationMultiError is an error wrapping multiple validation errors
+// returned by LeaveMonthDuration.ValidateAll() if the designated constraints
+// aren't met.
+type LeaveMonthDurationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateLeaveTypeRequestMultiError) Error() string {
+func (m LeaveMonthDurationMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1633,11 +1595,11 @@ func (m UpdateLeaveTypeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateLeaveTypeRequestMultiError) AllErrors() []error { return m }
+func (m LeaveMonthDurationMultiError) AllErrors() []error { return m }
 
-// UpdateLeaveTypeRequestValidationError is the validation error returned by
-// UpdateLeaveTypeRequest.Validate if the designated constraints aren't met.
-type UpdateLeaveTypeRequestValidationError struct {
+// LeaveMonthDurationValidationError is the validation error returned by
+// LeaveMonthDuration.Validate if the designated constraints aren't met.
+type LeaveMonthDurationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1645,24 +1607,24 @@ type UpdateLeaveTypeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateLeaveTypeRequestValidationError) Field() string { return e.field }
+func (e LeaveMonthDurationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateLeaveTypeRequestValidationError) Reason() string { return e.reason }
+func (e LeaveMonthDurationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateLeaveTypeRequestValidationError) Cause() error { return e.cause }
+func (e LeaveMonthDurationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateLeaveTypeRequestValidationError) Key() bool { return e.key }
+func (e LeaveMonthDurationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateLeaveTypeRequestValidationError) ErrorName() string {
-	return "UpdateLeaveTypeRequestValidationError"
+func (e LeaveMonthDurationValidationError) ErrorName() string {
+	return "LeaveMonthDurationValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateLeaveTypeRequestValidationError) Error() string {
+func (e LeaveMonthDurationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1674,14 +1636,14 @@ func (e UpdateLeaveTypeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateLeaveTypeRequest.%s: %s%s",
+		"invalid %sLeaveMonthDuration.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateLeaveTypeRequestValidationError{}
+var _ error = LeaveMonthDurationValidationError{}
 
 var _ interface {
 	Field() string
@@ -1689,46 +1651,118 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateLeaveTypeRequestValidationError{}
+} = LeaveMonthDurationValidationError{}
 
-// Validate checks the field values on DeleteLeaveTypeRequest with the rules
+// Validate checks the field values on LeaveDurationResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteLeaveTypeRequest) Validate() error {
+func (m *LeaveDurationResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteLeaveTypeRequest with the rules
+// ValidateAll checks the field values on LeaveDurationResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteLeaveTypeRequestMultiError, or nil if none found.
-func (m *DeleteLeaveTypeRequest) ValidateAll() error {
+// LeaveDurationResponseMultiError, or nil if none found.
+func (m *LeaveDurationResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteLeaveTypeRequest) validate(all bool) error {
+func (m *LeaveDurationResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Unit
+
+	// no validation rules for Duration
+
+	// no validation rules for Hours
+
+	for idx, item := range m.GetDays() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LeaveDurationResponseValidationError{
+						field:  fmt.Sprintf("Days[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LeaveDurationResponseValidationError{
+						field:  fmt.Sprintf("Days[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LeaveDurationResponseValidationError{
+					field:  fmt.Sprintf("Days[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetMonths() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LeaveDurationResponseValidationError{
+						field:  fmt.Sprintf("Months[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LeaveDurationResponseValidationError{
+						field:  fmt.Sprintf("Months[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LeaveDurationResponseValidationError{
+					field:  fmt.Sprintf("Months[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return DeleteLeaveTypeRequestMultiError(errors)
+		return LeaveDurationResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteLeaveTypeRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteLeaveTypeRequest.ValidateAll() if the designated
+// LeaveDurationResponseMultiError is an error wrapping multiple validation
+// errors returned by LeaveDurationResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteLeaveTypeRequestMultiError []error
+type LeaveDurationResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteLeaveTypeRequestMultiError) Error() string {
+func (m LeaveDurationResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1737,11 +1771,11 @@ func (m DeleteLeaveTypeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteLeaveTypeRequestMultiError) AllErrors() []error { return m }
+func (m LeaveDurationResponseMultiError) AllErrors() []error { return m }
 
-// DeleteLeaveTypeRequestValidationError is the validation error returned by
-// DeleteLeaveTypeRequest.Validate if the designated constraints aren't met.
-type DeleteLeaveTypeRequestValidationError struct {
+// LeaveDurationResponseValidationError is the validation error returned by
+// LeaveDurationResponse.Validate if the designated constraints aren't met.
+type LeaveDurationResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1749,24 +1783,24 @@ type DeleteLeaveTypeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteLeaveTypeRequestValidationError) Field() string { return e.field }
+func (e LeaveDurationResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteLeaveTypeRequestValidationError) Reason() string { return e.reason }
+func (e LeaveDurationResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteLeaveTypeRequestValidationError) Cause() error { return e.cause }
+func (e LeaveDurationResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteLeaveTypeRequestValidationError) Key() bool { return e.key }
+func (e LeaveDurationResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteLeaveTypeRequestValidationError) ErrorName() string {
-	return "DeleteLeaveTypeRequestValidationError"
+func (e LeaveDurationResponseValidationError) ErrorName() string {
+	return "LeaveDurationResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteLeaveTypeRequestValidationError) Error() string {
+func (e LeaveDurationResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1778,14 +1812,14 @@ func (e DeleteLeaveTypeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteLeaveTypeRequest.%s: %s%s",
+		"invalid %sLeaveDurationResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteLeaveTypeRequestValidationError{}
+var _ error = LeaveDurationResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1793,46 +1827,128 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteLeaveTypeRequestValidationError{}
+} = LeaveDurationResponseValidationError{}
 
-// Validate checks the field values on DeleteLeaveTypeResponse with the rules
+// Validate checks the field values on LeaveQuotaLedgerEntry with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteLeaveTypeResponse) Validate() error {
+func (m *LeaveQuotaLedgerEntry) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteLeaveTypeResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on LeaveQuotaLedgerEntry with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteLeaveTypeResponseMultiError, or nil if none found.
-func (m *DeleteLeaveTypeResponse) ValidateAll() error {
+// LeaveQuotaLedgerEntryMultiError, or nil if none found.
+func (m *LeaveQuotaLedgerEntry) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteLeaveTypeResponse) validate(all bool) error {
+func (m *LeaveQuotaLedgerEntry) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	// no validation rules for Id
+
+	// no validation rules for TenantId
+
+	// no validation rules for EmployeeId
+
+	// no validation rules for LeaveTypeId
+
+	// no validation rules for QuotaId
+
+	// no validation rules for Year
+
+	// no validation rules for EntryType
+
+	// no validation rules for Amount
+
+	// no validation rules for BalanceAfter
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LeaveQuotaLedgerEntryValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LeaveQuotaLedgerEntryValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LeaveQuotaLedgerEntryValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Reason
+
+	// no validation rules for SourceType
+
+	// no validation rules for SourceId
+
+	// no validation rules for OperatorId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LeaveQuotaLedgerEntryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LeaveQuotaLedgerEntryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LeaveQuotaLedgerEntryValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeleteLeaveTypeResponseMultiError(errors)
+		return LeaveQuotaLedgerEntryMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteLeaveTypeResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteLeaveTypeResponse.ValidateAll() if the designated
+// LeaveQuotaLedgerEntryMultiError is an error wrapping multiple validation
+// errors returned by LeaveQuotaLedgerEntry.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteLeaveTypeResponseMultiError []error
+type LeaveQuotaLedgerEntryMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteLeaveTypeResponseMultiError) Error() string {
+func (m LeaveQuotaLedgerEntryMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1841,11 +1957,11 @@ func (m DeleteLeaveTypeResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteLeaveTypeResponseMultiError) AllErrors() []error { return m }
+func (m LeaveQuotaLedgerEntryMultiError) AllErrors() []error { return m }
 
-// DeleteLeaveTypeResponseValidationError is the validation error returned by
-// DeleteLeaveTypeResponse.Validate if the designated constraints aren't met.
-type DeleteLeaveTypeResponseValidationError struct {
+// LeaveQuotaLedgerEntryValidationError is the validation error returned by
+// LeaveQuotaLedgerEntry.Validate if the designated constraints aren't met.
+type LeaveQuotaLedgerEntryValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1853,24 +1969,24 @@ type DeleteLeaveTypeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteLeaveTypeResponseValidationError) Field() string { return e.field }
+func (e LeaveQuotaLedgerEntryValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteLeaveTypeResponseValidationError) Reason() string { return e.reason }
+func (e LeaveQuotaLedgerEntryValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteLeaveTypeResponseValidationError) Cause() error { return e.cause }
+func (e LeaveQuotaLedgerEntryValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteLeaveTypeResponseValidationError) Key() bool { return e.key }
+func (e LeaveQuotaLedgerEntryValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteLeaveTypeResponseValidationError) ErrorName() string {
-	return "DeleteLeaveTypeResponseValidationError"
+func (e LeaveQuotaLedgerEntryValidationError) ErrorName() string {
+	return "LeaveQuotaLedgerEntryValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteLeaveTypeResponseValidationError) Error() string {
+func (e LeaveQuotaLedgerEntryValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1882,14 +1998,14 @@ func (e DeleteLeaveTypeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteLeaveTypeResponse.%s: %s%s",
+		"invalid %sLeaveQuotaLedgerEntry.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteLeaveTypeResponseValidationError{}
+var _ error = LeaveQuotaLedgerEntryValidationError{}
 
 var _ interface {
 	Field() string
@@ -1897,46 +2013,101 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteLeaveTypeResponseValidationError{}
+} = LeaveQuotaLedgerEntryValidationError{}
 
-// Validate checks the field values on GetLeaveTypeRequest with the rules
+// Validate checks the field values on CreateLeaveTypeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetLeaveTypeRequest) Validate() error {
+func (m *CreateLeaveTypeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetLeaveTypeRequest with the rules
+// ValidateAll checks the field values on CreateLeaveTypeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetLeaveTypeRequestMultiError, or nil if none found.
-func (m *GetLeaveTypeRequest) ValidateAll() error {
+// CreateLeaveTypeRequestMultiError, or nil if none found.
+func (m *CreateLeaveTypeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetLeaveTypeRequest) validate(all bool) error {
+func (m *CreateLeaveTypeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for TenantId
+
+	// no validation rules for Code
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for IsPaid
+
+	// no validation rules for RequiresApproval
+
+	// no validation rules for RequiresProof
+
+	// no validation rules for DeductQuota
+
+	// no validation rules for Unit
+
+	// no validation rules for MinDuration
+
+	// no validation rules for MaxDuration
+
+	// no validation rules for AdvanceDays
+
+	if all {
+		switch v := interface{}(m.GetApprovalRules()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateLeaveTypeRequestValidationError{
+					field:  "ApprovalRules",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateLeaveTypeRequestValidationError{
+					field:  "ApprovalRules",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApprovalRules()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateLeaveTypeRequestValidationError{
+				field:  "ApprovalRules",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Color
+
+	// no validation rules for Sort
 
 	if len(errors) > 0 {
-		return GetLeaveTypeRequestMultiError(errors)
+		return CreateLeaveTypeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetLeaveTypeRequestMultiError is an error wrapping multiple validation
-// errors returned by GetLeaveTypeRequest.ValidateAll() if the designated
+// CreateLeaveTypeRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateLeaveTypeRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetLeaveTypeRequestMultiError []error
+type CreateLeaveTypeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetLeaveTypeRequestMultiError) Error() string {
+func (m CreateLeaveTypeRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1945,11 +2116,11 @@ func (m GetLeaveTypeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetLeaveTypeRequestMultiError) AllErrors() []error { return m }
+func (m CreateLeaveTypeRequestMultiError) AllErrors() []error { return m }
 
-// GetLeaveTypeRequestValidationError is the validation error returned by
-// GetLeaveTypeRequest.Validate if the designated constraints aren't met.
-type GetLeaveTypeRequestValidationError struct {
+// CreateLeaveTypeRequestValidationError is the validation error returned by
+// CreateLeaveTypeRequest.Validate if the designated constraints aren't met.
+type CreateLeaveTypeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1957,24 +2128,24 @@ type GetLeaveTypeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetLeaveTypeRequestValidationError) Field() string { return e.field }
+func (e CreateLeaveTypeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetLeaveTypeRequestValidationError) Reason() string { return e.reason }
+func (e CreateLeaveTypeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetLeaveTypeRequestValidationError) Cause() error { return e.cause }
+func (e CreateLeaveTypeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetLeaveTypeRequestValidationError) Key() bool { return e.key }
+func (e CreateLeaveTypeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetLeaveTypeRequestValidationError) ErrorName() string {
-	return "GetLeaveTypeRequestValidationError"
+func (e CreateLeaveTypeRequestValidationError) ErrorName() string {
+	return "CreateLeaveTypeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetLeaveTypeRequestValidationError) Error() string {
+func (e CreateLeaveTypeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1986,14 +2157,14 @@ func (e GetLeaveTypeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetLeaveTypeRequest.%s: %s%s",
+		"invalid %sCreateLeaveTypeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetLeaveTypeRequestValidationError{}
+var _ error = CreateLeaveTypeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2001,71 +2172,114 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetLeaveTypeRequestValidationError{}
+} = CreateLeaveTypeRequestValidationError{}
 
-// Validate checks the field values on ListLeaveTypesRequest with the rules
+// Validate checks the field values on UpdateLeaveTypeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListLeaveTypesRequest) Validate() error {
+func (m *UpdateLeaveTypeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListLeaveTypesRequest with the rules
+// ValidateAll checks the field values on UpdateLeaveTypeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListLeaveTypesRequestMultiError, or nil if none found.
-func (m *ListLeaveTypesRequest) ValidateAll() error {
+// UpdateLeaveTypeRequestMultiError, or nil if none found.
+func (m *UpdateLeaveTypeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListLeaveTypesRequest) validate(all bool) error {
+func (m *UpdateLeaveTypeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TenantId
-
-	// no validation rules for IsActive
+	// no validation rules for Id
 
-	// no validation rules for RequiresProof
+	// no validation rules for Name
 
-	// no validation rules for DeductQuota
+	// no validation rules for Description
 
-	// no validation rules for Keyword
+	// no validation rules for IsPaid
 
-	// no validation rules for Page
+	// no validation rules for RequiresApproval
 
-	// no validation rules for PageSize
+	// no validation rules for RequiresProof
 
-	if len(errors) > 0 {
-		return ListLeaveTypesRequestMultiError(errors)
-	}
+	// no validation rules for DeductQuota
 
-	return nil
-}
+	// no validation rules for Unit
 
-// ListLeaveTypesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListLeaveTypesRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListLeaveTypesRequestMultiError []error
+	// no validation rules for MinDuration
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m ListLeaveTypesRequestMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
+	// no validation rules for MaxDuration
 
-// AllErrors returns a list of validation violation errors.
-func (m ListLeaveTypesRequestMultiError) AllErrors() []error { return m }
+	// no validation rules for AdvanceDays
 
-// ListLeaveTypesRequestValidationError is the validation error returned by
-// ListLeaveTypesRequest.Validate if the designated constraints aren't met.
-type ListLeaveTypesRequestValidationError struct {
+	if all {
+		switch v := interface{}(m.GetApprovalRules()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateLeaveTypeRequestValidationError{
+					field:  "ApprovalRules",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateLeaveTypeRequestValidationError{
+					field:  "ApprovalRules",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApprovalRules()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateLeaveTypeRequestValidationError{
+				field:  "ApprovalRules",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Color
+
+	// no validation rules for IsActive
+
+	// no validation rules for Sort
+
+	if len(errors) > 0 {
+		return UpdateLeaveTypeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateLeaveTypeRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateLeaveTypeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateLeaveTypeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateLeaveTypeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateLeaveTypeRequestMultiError) AllErrors() []error { return m }
+
+// UpdateLeaveTypeRequestValidationError is the validation error returned by
+// UpdateLeaveTypeRequest.Validate if the designated constraints aren't met.
+type UpdateLeaveTypeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2073,24 +2287,24 @@ type ListLeaveTypesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListLeaveTypesRequestValidationError) Field() string { return e.field }
+func (e UpdateLeaveTypeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListLeaveTypesRequestValidationError) Reason() string { return e.reason }
+func (e UpdateLeaveTypeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListLeaveTypesRequestValidationError) Cause() error { return e.cause }
+func (e UpdateLeaveTypeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListLeaveTypesRequestValidationError) Key() bool { return e.key }
+func (e UpdateLeaveTypeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListLeaveTypesRequestValidationError) ErrorName() string {
-	return "ListLeaveTypesRequestValidationError"
+func (e UpdateLeaveTypeRequestValidationError) ErrorName() string {
+	return "UpdateLeaveTypeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListLeaveTypesRequestValidationError) Error() string {
+func (e UpdateLeaveTypeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2102,14 +2316,14 @@ func (e ListLeaveTypesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListLeaveTypesRequest.%s: %s%s",
+		"invalid %sUpdateLeaveTypeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListLeaveTypesRequestValidationError{}
+var _ error = UpdateLeaveTypeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2117,84 +2331,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListLeaveTypesRequestValidationError{}
+} = UpdateLeaveTypeRequestValidationError{}
 
-// Validate checks the field values on ListLeaveTypesResponse with the rules
+// Validate checks the field values on DeleteLeaveTypeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListLeaveTypesResponse) Validate() error {
+func (m *DeleteLeaveTypeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListLeaveTypesResponse with the rules
+// ValidateAll checks the field values on DeleteLeaveTypeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListLeaveTypesResponseMultiError, or nil if none found.
-func (m *ListLeaveTypesResponse) ValidateAll() error {
+// DeleteLeaveTypeRequestMultiError, or nil if none found.
+func (m *DeleteLeaveTypeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListLeaveTypesResponse) validate(all bool) error {
+func (m *DeleteLeaveTypeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetItems() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListLeaveTypesResponseValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListLeaveTypesResponseValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListLeaveTypesResponseValidationError{
-					field:  fmt.Sprintf("Items[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for Total
-
-	// no validation rules for Page
-
-	// no validation rules for PageSize
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return ListLeaveTypesResponseMultiError(errors)
+		return DeleteLeaveTypeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListLeaveTypesResponseMultiError is an error wrapping multiple validation
-// errors returned by ListLeaveTypesResponse.ValidateAll() if the designated
+// DeleteLeaveTypeRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteLeaveTypeRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListLeaveTypesResponseMultiError []error
+type DeleteLeaveTypeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListLeaveTypesResponseMultiError) Error() string {
+func (m DeleteLeaveTypeRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2203,11 +2379,11 @@ func (m ListLeaveTypesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListLeaveTypesResponseMultiError) AllErrors() []error { return m }
+func (m DeleteLeaveTypeRequestMultiError) AllErrors() []error { return m }
 
-// ListLeaveTypesResponseValidationError is the validation error returned by
-// ListLeaveTypesResponse.Validate if the designated constraints aren't met.
-type ListLeaveTypesResponseValidationError struct {
+// DeleteLeaveTypeRequestValidationError is the validation error returned by
+// DeleteLeaveTypeRequest.Validate if the designated constraints aren't met.
+type DeleteLeaveTypeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2215,24 +2391,24 @@ type ListLeaveTypesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListLeaveTypesResponseValidationError) Field() string { return e.field }
+func (e DeleteLeaveTypeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListLeaveTypesResponseValidationError) Reason() string { return e.reason }
+func (e DeleteLeaveTypeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListLeaveTypesResponseValidationError) Cause() error { return e.cause }
+func (e DeleteLeaveTypeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListLeaveTypesResponseValidationError) Key() bool { return e.key }
+func (e DeleteLeaveTypeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListLeaveTypesResponseValidationError) ErrorName() string {
-	return "ListLeaveTypesResponseValidationError"
+func (e DeleteLeaveTypeRequestValidationError) ErrorName() string {
+	return "DeleteLeaveTypeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListLeaveTypesResponseValidationError) Error() string {
+func (e DeleteLeaveTypeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2244,14 +2420,14 @@ func (e ListLeaveTypesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListLeaveTypesResponse.%s: %s%s",
+		"invalid %sDeleteLeaveTypeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListLeaveTypesResponseValidationError{}
+var _ error = DeleteLeaveTypeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2259,46 +2435,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListLeaveTypesResponseValidationError{}
+} = DeleteLeaveTypeRequestValidationError{}
 
-// Validate checks the field values on ListActiveLeaveTypesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteLeaveTypeResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListActiveLeaveTypesRequest) Validate() error {
+func (m *DeleteLeaveTypeResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListActiveLeaveTypesRequest with the
+// ValidateAll checks the field values on DeleteLeaveTypeResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListActiveLeaveTypesRequestMultiError, or nil if none found.
-func (m *ListActiveLeaveTypesRequest) ValidateAll() error {
+// DeleteLeaveTypeResponseMultiError, or nil if none found.
+func (m *DeleteLeaveTypeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListActiveLeaveTypesRequest) validate(all bool) error {
+func (m *DeleteLeaveTypeResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TenantId
+	// no validation rules for Success
 
 	if len(errors) > 0 {
-		return ListActiveLeaveTypesRequestMultiError(errors)
+		return DeleteLeaveTypeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListActiveLeaveTypesRequestMultiError is an error wrapping multiple
-// validation errors returned by ListActiveLeaveTypesRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ListActiveLeaveTypesRequestMultiError []error
+// DeleteLeaveTypeResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteLeaveTypeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteLeaveTypeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListActiveLeaveTypesRequestMultiError) Error() string {
+func (m DeleteLeaveTypeResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2307,12 +2483,11 @@ func (m ListActiveLeaveTypesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListActiveLeaveTypesRequestMultiError) AllErrors() []error { return m }
+func (m DeleteLeaveTypeResponseMultiError) AllErrors() []error { return m }
 
-// ListActiveLeaveTypesRequestValidationError is the validation error returned
-// by ListActiveLeaveTypesRequest.Validate if the designated constraints
-// aren't met.
-type ListActiveLeaveTypesRequestValidationError struct {
+// DeleteLeaveTypeResponseValidationError is the validation error returned by
+// DeleteLeaveTypeResponse.Validate if the designated constraints aren't met.
+type DeleteLeaveTypeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2320,24 +2495,24 @@ type ListActiveLeaveTypesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListActiveLeaveTypesRequestValidationError) Field() string { return e.field }
+func (e DeleteLeaveTypeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListActiveLeaveTypesRequestValidationError) Reason() string { return e.reason }
+func (e DeleteLeaveTypeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListActiveLeaveTypesRequestValidationError) Cause() error { return e.cause }
+func (e DeleteLeaveTypeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListActiveLeaveTypesRequestValidationError) Key() bool { return e.key }
+func (e DeleteLeaveTypeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListActiveLeaveTypesRequestValidationError) ErrorName() string {
-	return "ListActiveLeaveTypesRequestValidationError"
+func (e DeleteLeaveTypeResponseValidationError) ErrorName() string {
+	return "DeleteLeaveTypeResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListActiveLeaveTypesRequestValidationError) Error() string {
+func (e DeleteLeaveTypeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2349,14 +2524,14 @@ func (e ListActiveLeaveTypesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListActiveLeaveTypesRequest.%s: %s%s",
+		"invalid %sDeleteLeaveTypeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListActiveLeaveTypesRequestValidationError{}
+var _ error = DeleteLeaveTypeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2364,46 +2539,266 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListActiveLeaveTypesRequestValidationError{}
+} = DeleteLeaveTypeResponseValidationError{}
 
-// Validate checks the field values on ListActiveLeaveTypesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetLeaveTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListActiveLeaveTypesResponse) Validate() error {
+func (m *GetLeaveTypeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListActiveLeaveTypesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetLeaveTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListActiveLeaveTypesResponseMultiError, or nil if none found.
-func (m *ListActiveLeaveTypesResponse) ValidateAll() error {
+// GetLeaveTypeRequestMultiError, or nil if none found.
+func (m *GetLeaveTypeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListActiveLeaveTypesResponse) validate(all bool) error {
+func (m *GetLeaveTypeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetItems() {
-		_, _ = idx, item
+	// no validation rules for Id
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListActiveLeaveTypesResponseValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
+	if len(errors) > 0 {
+		return GetLeaveTypeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLeaveTypeRequestMultiError is an error wrapping multiple validation
+// errors returned by GetLeaveTypeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetLeaveTypeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLeaveTypeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLeaveTypeRequestMultiError) AllErrors() []error { return m }
+
+// GetLeaveTypeRequestValidationError is the validation error returned by
+// GetLeaveTypeRequest.Validate if the designated constraints aren't met.
+type GetLeaveTypeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLeaveTypeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLeaveTypeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLeaveTypeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLeaveTypeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLeaveTypeRequestValidationError) ErrorName() string {
+	return "GetLeaveTypeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetLeaveTypeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLeaveTypeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLeaveTypeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLeaveTypeRequestValidationError{}
+
+// Validate checks the field values on ListLeaveTypesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLeaveTypesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLeaveTypesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLeaveTypesRequestMultiError, or nil if none found.
+func (m *ListLeaveTypesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLeaveTypesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for IsActive
+
+	// no validation rules for RequiresProof
+
+	// no validation rules for DeductQuota
+
+	// no validation rules for Keyword
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListLeaveTypesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLeaveTypesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListLeaveTypesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListLeaveTypesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLeaveTypesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLeaveTypesRequestMultiError) AllErrors() []error { return m }
+
+// ListLeaveTypesRequestValidationError is the validation error returned by
+// ListLeaveTypesRequest.Validate if the designated constraints aren't met.
+type ListLeaveTypesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLeaveTypesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLeaveTypesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLeaveTypesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLeaveTypesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLeaveTypesRequestValidationError) ErrorName() string {
+	return "ListLeaveTypesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLeaveTypesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLeaveTypesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLeaveTypesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLeaveTypesRequestValidationError{}
+
+// Validate checks the field values on ListLeaveTypesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLeaveTypesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLeaveTypesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLeaveTypesResponseMultiError, or nil if none found.
+func (m *ListLeaveTypesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLeaveTypesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListLeaveTypesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListActiveLeaveTypesResponseValidationError{
+					errors = append(errors, ListLeaveTypesResponseValidationError{
 						field:  fmt.Sprintf("Items[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -2412,7 +2807,7 @@ func (m *ListActiveLeaveTypesResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListActiveLeaveTypesResponseValidationError{
+				return ListLeaveTypesResponseValidationError{
 					field:  fmt.Sprintf("Items[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2422,20 +2817,1140 @@ func (m *ListActiveLeaveTypesResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListLeaveTypesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLeaveTypesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListLeaveTypesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListLeaveTypesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLeaveTypesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLeaveTypesResponseMultiError) AllErrors() []error { return m }
+
+// ListLeaveTypesResponseValidationError is the validation error returned by
+// ListLeaveTypesResponse.Validate if the designated constraints aren't met.
+type ListLeaveTypesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLeaveTypesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLeaveTypesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLeaveTypesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLeaveTypesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLeaveTypesResponseValidationError) ErrorName() string {
+	return "ListLeaveTypesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLeaveTypesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLeaveTypesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLeaveTypesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLeaveTypesResponseValidationError{}
+
+// Validate checks the field values on ListActiveLeaveTypesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListActiveLeaveTypesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListActiveLeaveTypesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListActiveLeaveTypesRequestMultiError, or nil if none found.
+func (m *ListActiveLeaveTypesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListActiveLeaveTypesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	if len(errors) > 0 {
+		return ListActiveLeaveTypesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListActiveLeaveTypesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListActiveLeaveTypesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListActiveLeaveTypesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListActiveLeaveTypesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListActiveLeaveTypesRequestMultiError) AllErrors() []error { return m }
+
+// ListActiveLeaveTypesRequestValidationError is the validation error returned
+// by ListActiveLeaveTypesRequest.Validate if the designated constraints
+// aren't met.
+type ListActiveLeaveTypesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListActiveLeaveTypesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListActiveLeaveTypesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListActiveLeaveTypesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListActiveLeaveTypesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListActiveLeaveTypesRequestValidationError) ErrorName() string {
+	return "ListActiveLeaveTypesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListActiveLeaveTypesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListActiveLeaveTypesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListActiveLeaveTypesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListActiveLeaveTypesRequestValidationError{}
+
+// Validate checks the field values on ListActiveLeaveTypesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListActiveLeaveTypesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListActiveLeaveTypesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListActiveLeaveTypesResponseMultiError, or nil if none found.
+func (m *ListActiveLeaveTypesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListActiveLeaveTypesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListActiveLeaveTypesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListActiveLeaveTypesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListActiveLeaveTypesResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListActiveLeaveTypesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListActiveLeaveTypesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListActiveLeaveTypesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListActiveLeaveTypesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListActiveLeaveTypesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListActiveLeaveTypesResponseMultiError) AllErrors() []error { return m }
+
+// ListActiveLeaveTypesResponseValidationError is the validation error returned
+// by ListActiveLeaveTypesResponse.Validate if the designated constraints
+// aren't met.
+type ListActiveLeaveTypesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListActiveLeaveTypesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListActiveLeaveTypesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListActiveLeaveTypesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListActiveLeaveTypesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListActiveLeaveTypesResponseValidationError) ErrorName() string {
+	return "ListActiveLeaveTypesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListActiveLeaveTypesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListActiveLeaveTypesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListActiveLeaveTypesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListActiveLeaveTypesResponseValidationError{}
+
+// Validate checks the field values on CreateLeaveRequestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateLeaveRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateLeaveRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateLeaveRequestRequestMultiError, or nil if none found.
+func (m *CreateLeaveRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateLeaveRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for EmployeeId
+
+	// no validation rules for EmployeeName
+
+	// no validation rules for DepartmentId
+
+	// no validation rules for LeaveTypeId
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateLeaveRequestRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateLeaveRequestRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateLeaveRequestRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateLeaveRequestRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateLeaveRequestRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateLeaveRequestRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Duration
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return CreateLeaveRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateLeaveRequestRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateLeaveRequestRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateLeaveRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateLeaveRequestRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateLeaveRequestRequestMultiError) AllErrors() []error { return m }
+
+// CreateLeaveRequestRequestValidationError is the validation error returned by
+// CreateLeaveRequestRequest.Validate if the designated constraints aren't met.
+type CreateLeaveRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateLeaveRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateLeaveRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateLeaveRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateLeaveRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateLeaveRequestRequestValidationError) ErrorName() string {
+	return "CreateLeaveRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateLeaveRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateLeaveRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateLeaveRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateLeaveRequestRequestValidationError{}
+
+// Validate checks the field values on UpdateLeaveRequestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateLeaveRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateLeaveRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateLeaveRequestRequestMultiError, or nil if none found.
+func (m *UpdateLeaveRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateLeaveRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateLeaveRequestRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateLeaveRequestRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateLeaveRequestRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateLeaveRequestRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateLeaveRequestRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateLeaveRequestRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Duration
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return UpdateLeaveRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateLeaveRequestRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateLeaveRequestRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateLeaveRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateLeaveRequestRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateLeaveRequestRequestMultiError) AllErrors() []error { return m }
+
+// UpdateLeaveRequestRequestValidationError is the validation error returned by
+// UpdateLeaveRequestRequest.Validate if the designated constraints aren't met.
+type UpdateLeaveRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateLeaveRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateLeaveRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateLeaveRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateLeaveRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateLeaveRequestRequestValidationError) ErrorName() string {
+	return "UpdateLeaveRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateLeaveRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateLeaveRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateLeaveRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateLeaveRequestRequestValidationError{}
+
+// Validate checks the field values on SubmitLeaveRequestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitLeaveRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitLeaveRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitLeaveRequestRequestMultiError, or nil if none found.
+func (m *SubmitLeaveRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitLeaveRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RequestId
+
+	// no validation rules for SubmitterId
+
+	if len(errors) > 0 {
+		return SubmitLeaveRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitLeaveRequestRequestMultiError is an error wrapping multiple validation
+// errors returned by SubmitLeaveRequestRequest.ValidateAll() if the
+// designated constraints aren't met.
+type SubmitLeaveRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitLeaveRequestRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitLeaveRequestRequestMultiError) AllErrors() []error { return m }
+
+// SubmitLeaveRequestRequestValidationError is the validation error returned by
+// SubmitLeaveRequestRequest.Validate if the designated constraints aren't met.
+type SubmitLeaveRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitLeaveRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitLeaveRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitLeaveRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitLeaveRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitLeaveRequestRequestValidationError) ErrorName() string {
+	return "SubmitLeaveRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitLeaveRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitLeaveRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitLeaveRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitLeaveRequestRequestValidationError{}
+
+// Validate checks the field values on SubmitLeaveRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitLeaveRequestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitLeaveRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitLeaveRequestResponseMultiError, or nil if none found.
+func (m *SubmitLeaveRequestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitLeaveRequestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return SubmitLeaveRequestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitLeaveRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by SubmitLeaveRequestResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SubmitLeaveRequestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitLeaveRequestResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitLeaveRequestResponseMultiError) AllErrors() []error { return m }
+
+// SubmitLeaveRequestResponseValidationError is the validation error returned
+// by SubmitLeaveRequestResponse.Validate if the designated constraints aren't met.
+type SubmitLeaveRequestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitLeaveRequestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitLeaveRequestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitLeaveRequestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitLeaveRequestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitLeaveRequestResponseValidationError) ErrorName() string {
+	return "SubmitLeaveRequestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitLeaveRequestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitLeaveRequestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitLeaveRequestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitLeaveRequestResponseValidationError{}
+
+// Validate checks the field values on WithdrawLeaveRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawLeaveRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawLeaveRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawLeaveRequestRequestMultiError, or nil if none found.
+func (m *WithdrawLeaveRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawLeaveRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RequestId
+
+	// no validation rules for OperatorId
+
+	if len(errors) > 0 {
+		return WithdrawLeaveRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawLeaveRequestRequestMultiError is an error wrapping multiple
+// validation errors returned by WithdrawLeaveRequestRequest.ValidateAll() if
+// the designated constraints aren't met.
+type WithdrawLeaveRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawLeaveRequestRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawLeaveRequestRequestMultiError) AllErrors() []error { return m }
+
+// WithdrawLeaveRequestRequestValidationError is the validation error returned
+// by WithdrawLeaveRequestRequest.Validate if the designated constraints
+// aren't met.
+type WithdrawLeaveRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawLeaveRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawLeaveRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawLeaveRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawLeaveRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawLeaveRequestRequestValidationError) ErrorName() string {
+	return "WithdrawLeaveRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawLeaveRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawLeaveRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawLeaveRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawLeaveRequestRequestValidationError{}
+
+// Validate checks the field values on WithdrawLeaveRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawLeaveRequestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawLeaveRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawLeaveRequestResponseMultiError, or nil if none found.
+func (m *WithdrawLeaveRequestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawLeaveRequestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return WithdrawLeaveRequestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawLeaveRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by WithdrawLeaveRequestResponse.ValidateAll() if
+// the designated constraints aren't met.
+type WithdrawLeaveRequestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawLeaveRequestResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawLeaveRequestResponseMultiError) AllErrors() []error { return m }
+
+// WithdrawLeaveRequestResponseValidationError is the validation error returned
+// by WithdrawLeaveRequestResponse.Validate if the designated constraints
+// aren't met.
+type WithdrawLeaveRequestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawLeaveRequestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawLeaveRequestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawLeaveRequestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawLeaveRequestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawLeaveRequestResponseValidationError) ErrorName() string {
+	return "WithdrawLeaveRequestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawLeaveRequestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawLeaveRequestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawLeaveRequestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawLeaveRequestResponseValidationError{}
+
+// Validate checks the field values on CancelLeaveRequestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelLeaveRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelLeaveRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelLeaveRequestRequestMultiError, or nil if none found.
+func (m *CancelLeaveRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelLeaveRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RequestId
+
+	// no validation rules for OperatorId
+
+	// no validation rules for Reason
+
 	if len(errors) > 0 {
-		return ListActiveLeaveTypesResponseMultiError(errors)
+		return CancelLeaveRequestRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListActiveLeaveTypesResponseMultiError is an error wrapping multiple
-// validation errors returned by ListActiveLeaveTypesResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ListActiveLeaveTypesResponseMultiError []error
+// CancelLeaveRequestRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelLeaveRequestRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CancelLeaveRequestRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListActiveLeaveTypesResponseMultiError) Error() string {
+func (m CancelLeaveRequestRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2444,12 +3959,11 @@ func (m ListActiveLeaveTypesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListActiveLeaveTypesResponseMultiError) AllErrors() []error { return m }
+func (m CancelLeaveRequestRequestMultiError) AllErrors() []error { return m }
 
-// ListActiveLeaveTypesResponseValidationError is the validation error returned
-// by ListActiveLeaveTypesResponse.Validate if the designated constraints
-// aren't met.
-type ListActiveLeaveTypesResponseValidationError struct {
+// CancelLeaveRequestRequestValidationError is the validation error returned by
+// CancelLeaveRequestRequest.Validate if the designated constraints aren't met.
+type CancelLeaveRequestRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2457,24 +3971,24 @@ type ListActiveLeaveTypesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListActiveLeaveTypesResponseValidationError) Field() string { return e.field }
+func (e CancelLeaveRequestRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListActiveLeaveTypesResponseValidationError) Reason() string { return e.reason }
+func (e CancelLeaveRequestRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListActiveLeaveTypesResponseValidationError) Cause() error { return e.cause }
+func (e CancelLeaveRequestRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListActiveLeaveTypesResponseValidationError) Key() bool { return e.key }
+func (e CancelLeaveRequestRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListActiveLeaveTypesResponseValidationError) ErrorName() string {
-	return "ListActiveLeaveTypesResponseValidationError"
+func (e CancelLeaveRequestRequestValidationError) ErrorName() string {
+	return "CancelLeaveRequestRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListActiveLeaveTypesResponseValidationError) Error() string {
+func (e CancelLeaveRequestRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2486,14 +4000,14 @@ func (e ListActiveLeaveTypesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListActiveLeaveTypesResponse.%s: %s%s",
+		"invalid %sCancelLeaveRequestRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListActiveLeaveTypesResponseValidationError{}
+var _ error = CancelLeaveRequestRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2501,116 +4015,150 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListActiveLeaveTypesResponseValidationError{}
+} = CancelLeaveRequestRequestValidationError{}
 
-// Validate checks the field values on CreateLeaveRequestRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CancelLeaveRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateLeaveRequestRequest) Validate() error {
+func (m *CancelLeaveRequestResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateLeaveRequestRequest with the
+// ValidateAll checks the field values on CancelLeaveRequestResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateLeaveRequestRequestMultiError, or nil if none found.
-func (m *CreateLeaveRequestRequest) ValidateAll() error {
+// CancelLeaveRequestResponseMultiError, or nil if none found.
+func (m *CancelLeaveRequestResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateLeaveRequestRequest) validate(all bool) error {
+func (m *CancelLeaveRequestResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TenantId
+	// no validation rules for Success
 
-	// no validation rules for EmployeeId
+	if len(errors) > 0 {
+		return CancelLeaveRequestResponseMultiError(errors)
+	}
 
-	// no validation rules for EmployeeName
+	return nil
+}
 
-	// no validation rules for DepartmentId
+// CancelLeaveRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by CancelLeaveRequestResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CancelLeaveRequestResponseMultiError []error
 
-	// no validation rules for LeaveTypeId
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelLeaveRequestResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
 
-	if all {
-		switch v := interface{}(m.GetStartTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateLeaveRequestRequestValidationError{
-					field:  "StartTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateLeaveRequestRequestValidationError{
-					field:  "StartTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateLeaveRequestRequestValidationError{
-				field:  "StartTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+// AllErrors returns a list of validation violation errors.
+func (m CancelLeaveRequestResponseMultiError) AllErrors() []error { return m }
+
+// CancelLeaveRequestResponseValidationError is the validation error returned
+// by CancelLeaveRequestResponse.Validate if the designated constraints aren't met.
+type CancelLeaveRequestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelLeaveRequestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelLeaveRequestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelLeaveRequestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelLeaveRequestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelLeaveRequestResponseValidationError) ErrorName() string {
+	return "CancelLeaveRequestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelLeaveRequestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	if all {
-		switch v := interface{}(m.GetEndTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateLeaveRequestRequestValidationError{
-					field:  "EndTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateLeaveRequestRequestValidationError{
-					field:  "EndTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateLeaveRequestRequestValidationError{
-				field:  "EndTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	key := ""
+	if e.key {
+		key = "key for "
 	}
 
-	// no validation rules for Duration
+	return fmt.Sprintf(
+		"invalid %sCancelLeaveRequestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
 
-	// no validation rules for Reason
+var _ error = CancelLeaveRequestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelLeaveRequestResponseValidationError{}
+
+// Validate checks the field values on GetLeaveRequestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetLeaveRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLeaveRequestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetLeaveRequestRequestMultiError, or nil if none found.
+func (m *GetLeaveRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLeaveRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RequestId
 
 	if len(errors) > 0 {
-		return CreateLeaveRequestRequestMultiError(errors)
+		return GetLeaveRequestRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateLeaveRequestRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateLeaveRequestRequest.ValidateAll() if the
-// designated constraints aren't met.
-type CreateLeaveRequestRequestMultiError []error
+// GetLeaveRequestRequestMultiError is an error wrapping multiple validation
+// errors returned by GetLeaveRequestRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetLeaveRequestRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateLeaveRequestRequestMultiError) Error() string {
+func (m GetLeaveRequestRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2619,11 +4167,11 @@ func (m CreateLeaveRequestRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateLeaveRequestRequestMultiError) AllErrors() []error { return m }
+func (m GetLeaveRequestRequestMultiError) AllErrors() []error { return m }
 
-// CreateLeaveRequestRequestValidationError is the validation error returned by
-// CreateLeaveRequestRequest.Validate if the designated constraints aren't met.
-type CreateLeaveRequestRequestValidationError struct {
+// GetLeaveRequestRequestValidationError is the validation error returned by
+// GetLeaveRequestRequest.Validate if the designated constraints aren't met.
+type GetLeaveRequestRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2631,24 +4179,24 @@ type CreateLeaveRequestRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateLeaveRequestRequestValidationError) Field() string { return e.field }
+func (e GetLeaveRequestRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateLeaveRequestRequestValidationError) Reason() string { return e.reason }
+func (e GetLeaveRequestRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateLeaveRequestRequestValidationError) Cause() error { return e.cause }
+func (e GetLeaveRequestRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateLeaveRequestRequestValidationError) Key() bool { return e.key }
+func (e GetLeaveRequestRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateLeaveRequestRequestValidationError) ErrorName() string {
-	return "CreateLeaveRequestRequestValidationError"
+func (e GetLeaveRequestRequestValidationError) ErrorName() string {
+	return "GetLeaveRequestRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateLeaveRequestRequestValidationError) Error() string {
+func (e GetLeaveRequestRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2660,14 +4208,14 @@ func (e CreateLeaveRequestRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateLeaveRequestRequest.%s: %s%s",
+		"invalid %sGetLeaveRequestRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateLeaveRequestRequestValidationError{}
+var _ error = GetLeaveRequestRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2675,55 +4223,61 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateLeaveRequestRequestValidationError{}
+} = GetLeaveRequestRequestValidationError{}
 
-// Validate checks the field values on UpdateLeaveRequestRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListMyLeaveRequestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateLeaveRequestRequest) Validate() error {
+func (m *ListMyLeaveRequestsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateLeaveRequestRequest with the
+// ValidateAll checks the field values on ListMyLeaveRequestsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateLeaveRequestRequestMultiError, or nil if none found.
-func (m *UpdateLeaveRequestRequest) ValidateAll() error {
+// ListMyLeaveRequestsRequestMultiError, or nil if none found.
+func (m *ListMyLeaveRequestsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateLeaveRequestRequest) validate(all bool) error {
+func (m *ListMyLeaveRequestsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for TenantId
+
+	// no validation rules for EmployeeId
+
+	// no validation rules for LeaveTypeId
+
+	// no validation rules for Status
 
 	if all {
-		switch v := interface{}(m.GetStartTime()).(type) {
+		switch v := interface{}(m.GetStartDate()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateLeaveRequestRequestValidationError{
-					field:  "StartTime",
+				errors = append(errors, ListMyLeaveRequestsRequestValidationError{
+					field:  "StartDate",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateLeaveRequestRequestValidationError{
-					field:  "StartTime",
+				errors = append(errors, ListMyLeaveRequestsRequestValidationError{
+					field:  "StartDate",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetStartDate()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateLeaveRequestRequestValidationError{
-				field:  "StartTime",
+			return ListMyLeaveRequestsRequestValidationError{
+				field:  "StartDate",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2731,52 +4285,52 @@ func (m *UpdateLeaveRequestRequest) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetEndTime()).(type) {
+		switch v := interface{}(m.GetEndDate()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateLeaveRequestRequestValidationError{
-					field:  "EndTime",
+				errors = append(errors, ListMyLeaveRequestsRequestValidationError{
+					field:  "EndDate",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateLeaveRequestRequestValidationError{
-					field:  "EndTime",
+				errors = append(errors, ListMyLeaveRequestsRequestValidationError{
+					field:  "EndDate",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetEndDate()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateLeaveRequestRequestValidationError{
-				field:  "EndTime",
+			return ListMyLeaveRequestsRequestValidationError{
+				field:  "EndDate",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for Duration
+	// no validation rules for Page
 
-	// no validation rules for Reason
+	// no validation rules for PageSize
 
 	if len(errors) > 0 {
-		return UpdateLeaveRequestRequestMultiError(errors)
+		return ListMyLeaveRequestsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateLeaveRequestRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateLeaveRequestRequest.ValidateAll() if the
-// designated constraints aren't met.
-type UpdateLeaveRequestRequestMultiError []error
+// ListMyLeaveRequestsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListMyLeaveRequestsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListMyLeaveRequestsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateLeaveRequestRequestMultiError) Error() string {
+func (m ListMyLeaveRequestsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2785,11 +4339,11 @@ func (m UpdateLeaveRequestRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateLeaveRequestRequestMultiError) AllErrors() []error { return m }
+func (m ListMyLeaveRequestsRequestMultiError) AllErrors() []error { return m }
 
-// UpdateLeaveRequestRequestValidationError is the validation error returned by
-// UpdateLeaveRequestRequest.Validate if the designated constraints aren't met.
-type UpdateLeaveRequestRequestValidationError struct {
+// ListMyLeaveRequestsRequestValidationError is the validation error returned
+// by ListMyLeaveRequestsRequest.Validate if the designated constraints aren't met.
+type ListMyLeaveRequestsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2797,24 +4351,24 @@ type UpdateLeaveRequestRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateLeaveRequestRequestValidationError) Field() string { return e.field }
+func (e ListMyLeaveRequestsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateLeaveRequestRequestValidationError) Reason() string { return e.reason }
+func (e ListMyLeaveRequestsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateLeaveRequestRequestValidationError) Cause() error { return e.cause }
+func (e ListMyLeaveRequestsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateLeaveRequestRequestValidationError) Key() bool { return e.key }
+func (e ListMyLeaveRequestsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateLeaveRequestRequestValidationError) ErrorName() string {
-	return "UpdateLeaveRequestRequestValidationError"
+func (e ListMyLeaveRequestsRequestValidationError) ErrorName() string {
+	return "ListMyLeaveRequestsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateLeaveRequestRequestValidationError) Error() string {
+func (e ListMyLeaveRequestsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2826,14 +4380,14 @@ func (e UpdateLeaveRequestRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateLeaveRequestRequest.%s: %s%s",
+		"invalid %sListMyLeaveRequestsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateLeaveRequestRequestValidationError{}
+var _ error = ListMyLeaveRequestsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2841,154 +4395,116 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateLeaveRequestRequestValidationError{}
+} = ListMyLeaveRequestsRequestValidationError{}
 
-// Validate checks the field values on SubmitLeaveRequestRequest with the rules
+// Validate checks the field values on ListLeaveRequestsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SubmitLeaveRequestRequest) Validate() error {
+func (m *ListLeaveRequestsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SubmitLeaveRequestRequest with the
+// ValidateAll checks the field values on ListLeaveRequestsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SubmitLeaveRequestRequestMultiError, or nil if none found.
-func (m *SubmitLeaveRequestRequest) ValidateAll() error {
+// ListLeaveRequestsRequestMultiError, or nil if none found.
+func (m *ListLeaveRequestsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SubmitLeaveRequestRequest) validate(all bool) error {
+func (m *ListLeaveRequestsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RequestId
-
-	// no validation rules for SubmitterId
-
-	if len(errors) > 0 {
-		return SubmitLeaveRequestRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-// SubmitLeaveRequestRequestMultiError is an error wrapping multiple validation
-// errors returned by SubmitLeaveRequestRequest.ValidateAll() if the
-// designated constraints aren't met.
-type SubmitLeaveRequestRequestMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m SubmitLeaveRequestRequestMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m SubmitLeaveRequestRequestMultiError) AllErrors() []error { return m }
-
-// SubmitLeaveRequestRequestValidationError is the validation error returned by
-// SubmitLeaveRequestRequest.Validate if the designated constraints aren't met.
-type SubmitLeaveRequestRequestValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e SubmitLeaveRequestRequestValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e SubmitLeaveRequestRequestValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e SubmitLeaveRequestRequestValidationError) Cause() error { return e.cause }
+	// no validation rules for TenantId
 
-// Key function returns key value.
-func (e SubmitLeaveRequestRequestValidationError) Key() bool { return e.key }
+	// no validation rules for LeaveTypeId
 
-// ErrorName returns error name.
-func (e SubmitLeaveRequestRequestValidationError) ErrorName() string {
-	return "SubmitLeaveRequestRequestValidationError"
-}
+	// no validation rules for DepartmentId
 
-// Error satisfies the builtin error interface
-func (e SubmitLeaveRequestRequestValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
+	// no validation rules for Status
 
-	key := ""
-	if e.key {
-		key = "key for "
+	if all {
+		switch v := interface{}(m.GetStartDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListLeaveRequestsRequestValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListLeaveRequestsRequestValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListLeaveRequestsRequestValidationError{
+				field:  "StartDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	return fmt.Sprintf(
-		"invalid %sSubmitLeaveRequestRequest.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = SubmitLeaveRequestRequestValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = SubmitLeaveRequestRequestValidationError{}
-
-// Validate checks the field values on SubmitLeaveRequestResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SubmitLeaveRequestResponse) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on SubmitLeaveRequestResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SubmitLeaveRequestResponseMultiError, or nil if none found.
-func (m *SubmitLeaveRequestResponse) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *SubmitLeaveRequestResponse) validate(all bool) error {
-	if m == nil {
-		return nil
+	if all {
+		switch v := interface{}(m.GetEndDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListLeaveRequestsRequestValidationError{
+					field:  "EndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListLeaveRequestsRequestValidationError{
+					field:  "EndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListLeaveRequestsRequestValidationError{
+				field:  "EndDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	var errors []error
+	// no validation rules for Keyword
 
-	// no validation rules for Success
+	// no validation rules for Page
 
-	// no validation rules for Message
+	// no validation rules for PageSize
 
 	if len(errors) > 0 {
-		return SubmitLeaveRequestResponseMultiError(errors)
+		return ListLeaveRequestsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SubmitLeaveRequestResponseMultiError is an error wrapping multiple
-// validation errors returned by SubmitLeaveRequestResponse.ValidateAll() if
-// the designated constraints aren't met.
-type SubmitLeaveRequestResponseMultiError []error
+// ListLeaveRequestsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListLeaveRequestsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListLeaveRequestsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SubmitLeaveRequestResponseMultiError) Error() string {
+func (m ListLeaveRequestsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2997,11 +4513,11 @@ func (m SubmitLeaveRequestResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SubmitLeaveRequestResponseMultiError) AllErrors() []error { return m }
+func (m ListLeaveRequestsRequestMultiError) AllErrors() []error { return m }
 
-// SubmitLeaveRequestResponseValidationError is the validation error returned
-// by SubmitLeaveRequestResponse.Validate if the designated constraints aren't met.
-type SubmitLeaveRequestResponseValidationError struct {
+// ListLeaveRequestsRequestValidationError is the validation error returned by
+// ListLeaveRequestsRequest.Validate if the designated constraints aren't met.
+type ListLeaveRequestsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3009,24 +4525,24 @@ type SubmitLeaveRequestResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SubmitLeaveRequestResponseValidationError) Field() string { return e.field }
+func (e ListLeaveRequestsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SubmitLeaveRequestResponseValidationError) Reason() string { return e.reason }
+func (e ListLeaveRequestsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SubmitLeaveRequestResponseValidationError) Cause() error { return e.cause }
+func (e ListLeaveRequestsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SubmitLeaveRequestResponseValidationError) Key() bool { return e.key }
+func (e ListLeaveRequestsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SubmitLeaveRequestResponseValidationError) ErrorName() string {
-	return "SubmitLeaveRequestResponseValidationError"
+func (e ListLeaveRequestsRequestValidationError) ErrorName() string {
+	return "ListLeaveRequestsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SubmitLeaveRequestResponseValidationError) Error() string {
+func (e ListLeaveRequestsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3038,14 +4554,14 @@ func (e SubmitLeaveRequestResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSubmitLeaveRequestResponse.%s: %s%s",
+		"invalid %sListLeaveRequestsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SubmitLeaveRequestResponseValidationError{}
+var _ error = ListLeaveRequestsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3053,48 +4569,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SubmitLeaveRequestResponseValidationError{}
+} = ListLeaveRequestsRequestValidationError{}
 
-// Validate checks the field values on WithdrawLeaveRequestRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListLeaveRequestsResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WithdrawLeaveRequestRequest) Validate() error {
+func (m *ListLeaveRequestsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawLeaveRequestRequest with the
+// ValidateAll checks the field values on ListLeaveRequestsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WithdrawLeaveRequestRequestMultiError, or nil if none found.
-func (m *WithdrawLeaveRequestRequest) ValidateAll() error {
+// ListLeaveRequestsResponseMultiError, or nil if none found.
+func (m *ListLeaveRequestsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawLeaveRequestRequest) validate(all bool) error {
+func (m *ListLeaveRequestsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RequestId
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
 
-	// no validation rules for OperatorId
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListLeaveRequestsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListLeaveRequestsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListLeaveRequestsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
 
 	if len(errors) > 0 {
-		return WithdrawLeaveRequestRequestMultiError(errors)
+		return ListLeaveRequestsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawLeaveRequestRequestMultiError is an error wrapping multiple
-// validation errors returned by WithdrawLeaveRequestRequest.ValidateAll() if
-// the designated constraints aren't met.
-type WithdrawLeaveRequestRequestMultiError []error
+// ListLeaveRequestsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListLeaveRequestsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListLeaveRequestsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawLeaveRequestRequestMultiError) Error() string {
+func (m ListLeaveRequestsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3103,12 +4655,11 @@ func (m WithdrawLeaveRequestRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawLeaveRequestRequestMultiError) AllErrors() []error { return m }
+func (m ListLeaveRequestsResponseMultiError) AllErrors() []error { return m }
 
-// WithdrawLeaveRequestRequestValidationError is the validation error returned
-// by WithdrawLeaveRequestRequest.Validate if the designated constraints
-// aren't met.
-type WithdrawLeaveRequestRequestValidationError struct {
+// ListLeaveRequestsResponseValidationError is the validation error returned by
+// ListLeaveRequestsResponse.Validate if the designated constraints aren't met.
+type ListLeaveRequestsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3116,24 +4667,24 @@ type WithdrawLeaveRequestRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawLeaveRequestRequestValidationError) Field() string { return e.field }
+func (e ListLeaveRequestsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawLeaveRequestRequestValidationError) Reason() string { return e.reason }
+func (e ListLeaveRequestsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawLeaveRequestRequestValidationError) Cause() error { return e.cause }
+func (e ListLeaveRequestsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawLeaveRequestRequestValidationError) Key() bool { return e.key }
+func (e ListLeaveRequestsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawLeaveRequestRequestValidationError) ErrorName() string {
-	return "WithdrawLeaveRequestRequestValidationError"
+func (e ListLeaveRequestsResponseValidationError) ErrorName() string {
+	return "ListLeaveRequestsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WithdrawLeaveRequestRequestValidationError) Error() string {
+func (e ListLeaveRequestsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3145,14 +4696,14 @@ func (e WithdrawLeaveRequestRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawLeaveRequestRequest.%s: %s%s",
+		"invalid %sListLeaveRequestsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawLeaveRequestRequestValidationError{}
+var _ error = ListLeaveRequestsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3160,46 +4711,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawLeaveRequestRequestValidationError{}
+} = ListLeaveRequestsResponseValidationError{}
 
-// Validate checks the field values on WithdrawLeaveRequestResponse with the
+// Validate checks the field values on ListPendingApprovalsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WithdrawLeaveRequestResponse) Validate() error {
+func (m *ListPendingApprovalsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawLeaveRequestResponse with the
+// ValidateAll checks the field values on ListPendingApprovalsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WithdrawLeaveRequestResponseMultiError, or nil if none found.
-func (m *WithdrawLeaveRequestResponse) ValidateAll() error {
+// ListPendingApprovalsRequestMultiError, or nil if none found.
+func (m *ListPendingApprovalsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawLeaveRequestResponse) validate(all bool) error {
+func (m *ListPendingApprovalsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	// no validation rules for TenantId
+
+	// no validation rules for ApproverId
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
 
 	if len(errors) > 0 {
-		return WithdrawLeaveRequestResponseMultiError(errors)
+		return ListPendingApprovalsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawLeaveRequestResponseMultiError is an error wrapping multiple
-// validation errors returned by WithdrawLeaveRequestResponse.ValidateAll() if
+// ListPendingApprovalsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListPendingApprovalsRequest.ValidateAll() if
 // the designated constraints aren't met.
-type WithdrawLeaveRequestResponseMultiError []error
+type ListPendingApprovalsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawLeaveRequestResponseMultiError) Error() string {
+func (m ListPendingApprovalsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3208,12 +4765,12 @@ func (m WithdrawLeaveRequestResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawLeaveRequestResponseMultiError) AllErrors() []error { return m }
+func (m ListPendingApprovalsRequestMultiError) AllErrors() []error { return m }
 
-// WithdrawLeaveRequestResponseValidationError is the validation error returned
-// by WithdrawLeaveRequestResponse.Validate if the designated constraints
+// ListPendingApprovalsRequestValidationError is the validation error returned
+// by ListPendingApprovalsRequest.Validate if the designated constraints
 // aren't met.
-type WithdrawLeaveRequestResponseValidationError struct {
+type ListPendingApprovalsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3221,24 +4778,24 @@ type WithdrawLeaveRequestResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawLeaveRequestResponseValidationError) Field() string { return e.field }
+func (e ListPendingApprovalsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawLeaveRequestResponseValidationError) Reason() string { return e.reason }
+func (e ListPendingApprovalsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawLeaveRequestResponseValidationError) Cause() error { return e.cause }
+func (e ListPendingApprovalsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawLeaveRequestResponseValidationError) Key() bool { return e.key }
+func (e ListPendingApprovalsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawLeaveRequestResponseValidationError) ErrorName() string {
-	return "WithdrawLeaveRequestResponseValidationError"
+func (e ListPendingApprovalsRequestValidationError) ErrorName() string {
+	return "ListPendingApprovalsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WithdrawLeaveRequestResponseValidationError) Error() string {
+func (e ListPendingApprovalsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3250,14 +4807,14 @@ func (e WithdrawLeaveRequestResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawLeaveRequestResponse.%s: %s%s",
+		"invalid %sListPendingApprovalsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawLeaveRequestResponseValidationError{}
+var _ error = ListPendingApprovalsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3265,24 +4822,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawLeaveRequestResponseValidationError{}
+} = ListPendingApprovalsRequestValidationError{}
 
-// Validate checks the field values on CancelLeaveRequestRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ApproveLeaveRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelLeaveRequestRequest) Validate() error {
+func (m *ApproveLeaveRequestRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelLeaveRequestRequest with the
+// ValidateAll checks the field values on ApproveLeaveRequestRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CancelLeaveRequestRequestMultiError, or nil if none found.
-func (m *CancelLeaveRequestRequest) ValidateAll() error {
+// ApproveLeaveRequestRequestMultiError, or nil if none found.
+func (m *ApproveLeaveRequestRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelLeaveRequestRequest) validate(all bool) error {
+func (m *ApproveLeaveRequestRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3291,24 +4848,24 @@ func (m *CancelLeaveRequestRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
-	// no validation rules for OperatorId
+	// no validation rules for ApproverId
 
-	// no validation rules for Reason
+	// no validation rules for Comment
 
 	if len(errors) > 0 {
-		return CancelLeaveRequestRequestMultiError(errors)
+		return ApproveLeaveRequestRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelLeaveRequestRequestMultiError is an error wrapping multiple validation
-// errors returned by CancelLeaveRequestRequest.ValidateAll() if the
-// designated constraints aren't met.
-type CancelLeaveRequestRequestMultiError []error
+// ApproveLeaveRequestRequestMultiError is an error wrapping multiple
+// validation errors returned by ApproveLeaveRequestRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ApproveLeaveRequestRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelLeaveRequestRequestMultiError) Error() string {
+func (m ApproveLeaveRequestRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3317,11 +4874,11 @@ func (m CancelLeaveRequestRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelLeaveRequestRequestMultiError) AllErrors() []error { return m }
+func (m ApproveLeaveRequestRequestMultiError) AllErrors() []error { return m }
 
-// CancelLeaveRequestRequestValidationError is the validation error returned by
-// CancelLeaveRequestRequest.Validate if the designated constraints aren't met.
-type CancelLeaveRequestRequestValidationError struct {
+// ApproveLeaveRequestRequestValidationError is the validation error returned
+// by ApproveLeaveRequestRequest.Validate if the designated constraints aren't met.
+type ApproveLeaveRequestRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3329,24 +4886,24 @@ type CancelLeaveRequestRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelLeaveRequestRequestValidationError) Field() string { return e.field }
+func (e ApproveLeaveRequestRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelLeaveRequestRequestValidationError) Reason() string { return e.reason }
+func (e ApproveLeaveRequestRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelLeaveRequestRequestValidationError) Cause() error { return e.cause }
+func (e ApproveLeaveRequestRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelLeaveRequestRequestValidationError) Key() bool { return e.key }
+func (e ApproveLeaveRequestRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelLeaveRequestRequestValidationError) ErrorName() string {
-	return "CancelLeaveRequestRequestValidationError"
+func (e ApproveLeaveRequestRequestValidationError) ErrorName() string {
+	return "ApproveLeaveRequestRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CancelLeaveRequestRequestValidationError) Error() string {
+func (e ApproveLeaveRequestRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3358,14 +4915,14 @@ func (e CancelLeaveRequestRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelLeaveRequestRequest.%s: %s%s",
+		"invalid %sApproveLeaveRequestRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelLeaveRequestRequestValidationError{}
+var _ error = ApproveLeaveRequestRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3373,24 +4930,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelLeaveRequestRequestValidationError{}
+} = ApproveLeaveRequestRequestValidationError{}
 
-// Validate checks the field values on CancelLeaveRequestResponse with the
+// Validate checks the field values on ApproveLeaveRequestResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelLeaveRequestResponse) Validate() error {
+func (m *ApproveLeaveRequestResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelLeaveRequestResponse with the
+// ValidateAll checks the field values on ApproveLeaveRequestResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CancelLeaveRequestResponseMultiError, or nil if none found.
-func (m *CancelLeaveRequestResponse) ValidateAll() error {
+// ApproveLeaveRequestResponseMultiError, or nil if none found.
+func (m *ApproveLeaveRequestResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelLeaveRequestResponse) validate(all bool) error {
+func (m *ApproveLeaveRequestResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3399,20 +4956,22 @@ func (m *CancelLeaveRequestResponse) validate(all bool) error {
 
 	// no validation rules for Success
 
+	// no validation rules for Message
+
 	if len(errors) > 0 {
-		return CancelLeaveRequestResponseMultiError(errors)
+		return ApproveLeaveRequestResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelLeaveRequestResponseMultiError is an error wrapping multiple
-// validation errors returned by CancelLeaveRequestResponse.ValidateAll() if
+// ApproveLeaveRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by ApproveLeaveRequestResponse.ValidateAll() if
 // the designated constraints aren't met.
-type CancelLeaveRequestResponseMultiError []error
+type ApproveLeaveRequestResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelLeaveRequestResponseMultiError) Error() string {
+func (m ApproveLeaveRequestResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3421,11 +4980,12 @@ func (m CancelLeaveRequestResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelLeaveRequestResponseMultiError) AllErrors() []error { return m }
+func (m ApproveLeaveRequestResponseMultiError) AllErrors() []error { return m }
 
-// CancelLeaveRequestResponseValidationError is the validation error returned
-// by CancelLeaveRequestResponse.Validate if the designated constraints aren't met.
-type CancelLeaveRequestResponseValidationError struct {
+// ApproveLeaveRequestResponseValidationError is the validation error returned
+// by ApproveLeaveRequestResponse.Validate if the designated constraints
+// aren't met.
+type ApproveLeaveRequestResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3433,24 +4993,24 @@ type CancelLeaveRequestResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelLeaveRequestResponseValidationError) Field() string { return e.field }
+func (e ApproveLeaveRequestResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelLeaveRequestResponseValidationError) Reason() string { return e.reason }
+func (e ApproveLeaveRequestResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelLeaveRequestResponseValidationError) Cause() error { return e.cause }
+func (e ApproveLeaveRequestResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelLeaveRequestResponseValidationError) Key() bool { return e.key }
+func (e ApproveLeaveRequestResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelLeaveRequestResponseValidationError) ErrorName() string {
-	return "CancelLeaveRequestResponseValidationError"
+func (e ApproveLeaveRequestResponseValidationError) ErrorName() string {
+	return "ApproveLeaveRequestResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CancelLeaveRequestResponseValidationError) Error() string {
+func (e ApproveLeaveRequestResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3462,14 +5022,14 @@ func (e CancelLeaveRequestResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelLeaveRequestResponse.%s: %s%s",
+		"invalid %sApproveLeaveRequestResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelLeaveRequestResponseValidationError{}
+var _ error = ApproveLeaveRequestResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3477,24 +5037,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelLeaveRequestResponseValidationError{}
+} = ApproveLeaveRequestResponseValidationError{}
 
-// Validate checks the field values on GetLeaveRequestRequest with the rules
+// Validate checks the field values on RejectLeaveRequestRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetLeaveRequestRequest) Validate() error {
+func (m *RejectLeaveRequestRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetLeaveRequestRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RejectLeaveRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetLeaveRequestRequestMultiError, or nil if none found.
-func (m *GetLeaveRequestRequest) ValidateAll() error {
+// RejectLeaveRequestRequestMultiError, or nil if none found.
+func (m *RejectLeaveRequestRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetLeaveRequestRequest) validate(all bool) error {
+func (m *RejectLeaveRequestRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3503,20 +5063,24 @@ func (m *GetLeaveRequestRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for ApproverId
+
+	// no validation rules for Comment
+
 	if len(errors) > 0 {
-		return GetLeaveRequestRequestMultiError(errors)
+		return RejectLeaveRequestRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetLeaveRequestRequestMultiError is an error wrapping multiple validation
-// errors returned by GetLeaveRequestRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetLeaveRequestRequestMultiError []error
+// RejectLeaveRequestRequestMultiError is an error wrapping multiple validation
+// errors returned by RejectLeaveRequestRequest.ValidateAll() if the
+// designated constraints aren't met.
+type RejectLeaveRequestRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetLeaveRequestRequestMultiError) Error() string {
+func (m RejectLeaveRequestRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3525,11 +5089,11 @@ func (m GetLeaveRequestRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetLeaveRequestRequestMultiError) AllErrors() []error { return m }
+func (m RejectLeaveRequestRequestMultiError) AllErrors() []error { return m }
 
-// GetLeaveRequestRequestValidationError is the validation error returned by
-// GetLeaveRequestRequest.Validate if the designated constraints aren't met.
-type GetLeaveRequestRequestValidationError struct {
+// RejectLeaveRequestRequestValidationError is the validation error returned by
+// RejectLeaveRequestRequest.Validate if the designated constraints aren't met.
+type RejectLeaveRequestRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3537,24 +5101,24 @@ type GetLeaveRequestRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetLeaveRequestRequestValidationError) Field() string { return e.field }
+func (e RejectLeaveRequestRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetLeaveRequestRequestValidationError) Reason() string { return e.reason }
+func (e RejectLeaveRequestRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetLeaveRequestRequestValidationError) Cause() error { return e.cause }
+func (e RejectLeaveRequestRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetLeaveRequestRequestValidationError) Key() bool { return e.key }
+func (e RejectLeaveRequestRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetLeaveRequestRequestValidationError) ErrorName() string {
-	return "GetLeaveRequestRequestValidationError"
+func (e RejectLeaveRequestRequestValidationError) ErrorName() string {
+	return "RejectLeaveRequestRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetLeaveRequestRequestValidationError) Error() string {
+func (e RejectLeaveRequestRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3566,14 +5130,14 @@ func (e GetLeaveRequestRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetLeaveRequestRequest.%s: %s%s",
+		"invalid %sRejectLeaveRequestRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetLeaveRequestRequestValidationError{}
+var _ error = RejectLeaveRequestRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3581,114 +5145,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetLeaveRequestRequestValidationError{}
+} = RejectLeaveRequestRequestValidationError{}
 
-// Validate checks the field values on ListMyLeaveRequestsRequest with the
+// Validate checks the field values on RejectLeaveRequestResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListMyLeaveRequestsRequest) Validate() error {
+func (m *RejectLeaveRequestResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListMyLeaveRequestsRequest with the
+// ValidateAll checks the field values on RejectLeaveRequestResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListMyLeaveRequestsRequestMultiError, or nil if none found.
-func (m *ListMyLeaveRequestsRequest) ValidateAll() error {
+// RejectLeaveRequestResponseMultiError, or nil if none found.
+func (m *RejectLeaveRequestResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListMyLeaveRequestsRequest) validate(all bool) error {
+func (m *RejectLeaveRequestResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TenantId
-
-	// no validation rules for EmployeeId
-
-	// no validation rules for LeaveTypeId
-
-	// no validation rules for Status
-
-	if all {
-		switch v := interface{}(m.GetStartDate()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListMyLeaveRequestsRequestValidationError{
-					field:  "StartDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ListMyLeaveRequestsRequestValidationError{
-					field:  "StartDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetStartDate()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListMyLeaveRequestsRequestValidationError{
-				field:  "StartDate",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetEndDate()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListMyLeaveRequestsRequestValidationError{
-					field:  "EndDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ListMyLeaveRequestsRequestValidationError{
-					field:  "EndDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetEndDate()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListMyLeaveRequestsRequestValidationError{
-				field:  "EndDate",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for Page
+	// no validation rules for Success
 
-	// no validation rules for PageSize
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return ListMyLeaveRequestsRequestMultiError(errors)
+		return RejectLeaveRequestResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListMyLeaveRequestsRequestMultiError is an error wrapping multiple
-// validation errors returned by ListMyLeaveRequestsRequest.ValidateAll() if
+// RejectLeaveRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by RejectLeaveRequestResponse.ValidateAll() if
 // the designated constraints aren't met.
-type ListMyLeaveRequestsRequestMultiError []error
+type RejectLeaveRequestResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListMyLeaveRequestsRequestMultiError) Error() string {
+func (m RejectLeaveRequestResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3697,11 +5195,11 @@ func (m ListMyLeaveRequestsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListMyLeaveRequestsRequestMultiError) AllErrors() []error { return m }
+func (m RejectLeaveRequestResponseMultiError) AllErrors() []error { return m }
 
-// ListMyLeaveRequestsRequestValidationError is the validation error returned
-// by ListMyLeaveRequestsRequest.Validate if the designated constraints aren't met.
-type ListMyLeaveRequestsRequestValidationError struct {
+// RejectLeaveRequestResponseValidationError is the validation error returned
+// by RejectLeaveRequestResponse.Validate if the designated constraints aren't met.
+type RejectLeaveRequestResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3709,24 +5207,24 @@ type ListMyLeaveRequestsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListMyLeaveRequestsRequestValidationError) Field() string { return e.field }
+func (e RejectLeaveRequestResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListMyLeaveRequestsRequestValidationError) Reason() string { return e.reason }
+func (e RejectLeaveRequestResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListMyLeaveRequestsRequestValidationError) Cause() error { return e.cause }
+func (e RejectLeaveRequestResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListMyLeaveRequestsRequestValidationError) Key() bool { return e.key }
+func (e RejectLeaveRequestResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListMyLeaveRequestsRequestValidationError) ErrorName() string {
-	return "ListMyLeaveRequestsRequestValidationError"
+func (e RejectLeaveRequestResponseValidationError) ErrorName() string {
+	return "RejectLeaveRequestResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListMyLeaveRequestsRequestValidationError) Error() string {
+func (e RejectLeaveRequestResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3738,14 +5236,14 @@ func (e ListMyLeaveRequestsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListMyLeaveRequestsRequest.%s: %s%s",
+		"invalid %sRejectLeaveRequestResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListMyLeaveRequestsRequestValidationError{}
+var _ error = RejectLeaveRequestResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3753,24 +5251,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListMyLeaveRequestsRequestValidationError{}
+} = RejectLeaveRequestResponseValidationError{}
 
-// Validate checks the field values on ListLeaveRequestsRequest with the rules
+// Validate checks the field values on InitEmployeeQuotaRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListLeaveRequestsRequest) Validate() error {
+func (m *InitEmployeeQuotaRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListLeaveRequestsRequest with the
+// ValidateAll checks the field values on InitEmployeeQuotaRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListLeaveRequestsRequestMultiError, or nil if none found.
-func (m *ListLeaveRequestsRequest) ValidateAll() error {
+// InitEmployeeQuotaRequestMultiError, or nil if none found.
+func (m *InitEmployeeQuotaRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListLeaveRequestsRequest) validate(all bool) error {
+func (m *InitEmployeeQuotaRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3779,90 +5277,24 @@ func (m *ListLeaveRequestsRequest) validate(all bool) error {
 
 	// no validation rules for TenantId
 
-	// no validation rules for LeaveTypeId
-
-	// no validation rules for DepartmentId
-
-	// no validation rules for Status
-
-	if all {
-		switch v := interface{}(m.GetStartDate()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListLeaveRequestsRequestValidationError{
-					field:  "StartDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ListLeaveRequestsRequestValidationError{
-					field:  "StartDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetStartDate()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListLeaveRequestsRequestValidationError{
-				field:  "StartDate",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetEndDate()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListLeaveRequestsRequestValidationError{
-					field:  "EndDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ListLeaveRequestsRequestValidationError{
-					field:  "EndDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetEndDate()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListLeaveRequestsRequestValidationError{
-				field:  "EndDate",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for Keyword
-
-	// no validation rules for Page
+	// no validation rules for EmployeeId
 
-	// no validation rules for PageSize
+	// no validation rules for Year
 
 	if len(errors) > 0 {
-		return ListLeaveRequestsRequestMultiError(errors)
+		return InitEmployeeQuotaRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListLeaveRequestsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListLeaveRequestsRequest.ValidateAll() if the designated
+// InitEmployeeQuotaRequestMultiError is an error wrapping multiple validation
+// errors returned by InitEmployeeQuotaRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListLeaveRequestsRequestMultiError []error
+type InitEmployeeQuotaRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListLeaveRequestsRequestMultiError) Error() string {
+func (m InitEmployeeQuotaRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3871,11 +5303,11 @@ func (m ListLeaveRequestsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListLeaveRequestsRequestMultiError) AllErrors() []error { return m }
+func (m InitEmployeeQuotaRequestMultiError) AllErrors() []error { return m }
 
-// ListLeaveRequestsRequestValidationError is the validation error returned by
-// ListLeaveRequestsRequest.Validate if the designated constraints aren't met.
-type ListLeaveRequestsRequestValidationError struct {
+// InitEmployeeQuotaRequestValidationError is the validation error returned by
+// InitEmployeeQuotaRequest.Validate if the designated constraints aren't met.
+type InitEmployeeQuotaRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3883,24 +5315,24 @@ type ListLeaveRequestsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListLeaveRequestsRequestValidationError) Field() string { return e.field }
+func (e InitEmployeeQuotaRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListLeaveRequestsRequestValidationError) Reason() string { return e.reason }
+func (e InitEmployeeQuotaRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListLeaveRequestsRequestValidationError) Cause() error { return e.cause }
+func (e InitEmployeeQuotaRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListLeaveRequestsRequestValidationError) Key() bool { return e.key }
+func (e InitEmployeeQuotaRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListLeaveRequestsRequestValidationError) ErrorName() string {
-	return "ListLeaveRequestsRequestValidationError"
+func (e InitEmployeeQuotaRequestValidationError) ErrorName() string {
+	return "InitEmployeeQuotaRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListLeaveRequestsRequestValidationError) Error() string {
+func (e InitEmployeeQuotaRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3912,14 +5344,14 @@ func (e ListLeaveRequestsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListLeaveRequestsRequest.%s: %s%s",
+		"invalid %sInitEmployeeQuotaRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListLeaveRequestsRequestValidationError{}
+var _ error = InitEmployeeQuotaRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3927,84 +5359,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListLeaveRequestsRequestValidationError{}
+} = InitEmployeeQuotaRequestValidationError{}
 
-// Validate checks the field values on ListLeaveRequestsResponse with the rules
+// Validate checks the field values on InitEmployeeQuotaResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListLeaveRequestsResponse) Validate() error {
+func (m *InitEmployeeQuotaResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListLeaveRequestsResponse with the
+// ValidateAll checks the field values on InitEmployeeQuotaResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListLeaveRequestsResponseMultiError, or nil if none found.
-func (m *ListLeaveRequestsResponse) ValidateAll() error {
+// InitEmployeeQuotaResponseMultiError, or nil if none found.
+func (m *InitEmployeeQuotaResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListLeaveRequestsResponse) validate(all bool) error {
+func (m *InitEmployeeQuotaResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetItems() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListLeaveRequestsResponseValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListLeaveRequestsResponseValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListLeaveRequestsResponseValidationError{
-					field:  fmt.Sprintf("Items[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for Total
-
-	// no validation rules for Page
-
-	// no validation rules for PageSize
+	// no validation rules for Success
 
 	if len(errors) > 0 {
-		return ListLeaveRequestsResponseMultiError(errors)
+		return InitEmployeeQuotaResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListLeaveRequestsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListLeaveRequestsResponse.ValidateAll() if the
+// InitEmployeeQuotaResponseMultiError is an error wrapping multiple validation
+// errors returned by InitEmployeeQuotaResponse.ValidateAll() if the
 // designated constraints aren't met.
-type ListLeaveRequestsResponseMultiError []error
+type InitEmployeeQuotaResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListLeaveRequestsResponseMultiError) Error() string {
+func (m InitEmployeeQuotaResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4013,11 +5407,11 @@ func (m ListLeaveRequestsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListLeaveRequestsResponseMultiError) AllErrors() []error { return m }
+func (m InitEmployeeQuotaResponseMultiError) AllErrors() []error { return m }
 
-// ListLeaveRequestsResponseValidationError is the validation error returned by
-// ListLeaveRequestsResponse.Validate if the designated constraints aren't met.
-type ListLeaveRequestsResponseValidationError struct {
+// InitEmployeeQuotaResponseValidationError is the validation error returned by
+// InitEmployeeQuotaResponse.Validate if the designated constraints aren't met.
+type InitEmployeeQuotaResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4025,24 +5419,24 @@ type ListLeaveRequestsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListLeaveRequestsResponseValidationError) Field() string { return e.field }
+func (e InitEmployeeQuotaResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListLeaveRequestsResponseValidationError) Reason() string { return e.reason }
+func (e InitEmployeeQuotaResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListLeaveRequestsResponseValidationError) Cause() error { return e.cause }
+func (e InitEmployeeQuotaResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListLeaveRequestsResponseValidationError) Key() bool { return e.key }
+func (e InitEmployeeQuotaResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListLeaveRequestsResponseValidationError) ErrorName() string {
-	return "ListLeaveRequestsResponseValidationError"
+func (e InitEmployeeQuotaResponseValidationError) ErrorName() string {
+	return "InitEmployeeQuotaResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListLeaveRequestsResponseValidationError) Error() string {
+func (e InitEmployeeQuotaResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4054,14 +5448,14 @@ func (e ListLeaveRequestsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListLeaveRequestsResponse.%s: %s%s",
+		"invalid %sInitEmployeeQuotaResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListLeaveRequestsResponseValidationError{}
+var _ error = InitEmployeeQuotaResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4069,52 +5463,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListLeaveRequestsResponseValidationError{}
+} = InitEmployeeQuotaResponseValidationError{}
 
-// Validate checks the field values on ListPendingApprovalsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListPendingApprovalsRequest) Validate() error {
+func (m *UpdateQuotaRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListPendingApprovalsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListPendingApprovalsRequestMultiError, or nil if none found.
-func (m *ListPendingApprovalsRequest) ValidateAll() error {
+// UpdateQuotaRequestMultiError, or nil if none found.
+func (m *UpdateQuotaRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListPendingApprovalsRequest) validate(all bool) error {
+func (m *UpdateQuotaRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TenantId
-
-	// no validation rules for ApproverId
-
-	// no validation rules for Page
+	// no validation rules for Id
 
-	// no validation rules for PageSize
+	// no validation rules for TotalQuota
 
 	if len(errors) > 0 {
-		return ListPendingApprovalsRequestMultiError(errors)
+		return UpdateQuotaRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListPendingApprovalsRequestMultiError is an error wrapping multiple
-// validation errors returned by ListPendingApprovalsRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ListPendingApprovalsRequestMultiError []error
+// UpdateQuotaRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateQuotaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateQuotaRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListPendingApprovalsRequestMultiError) Error() string {
+func (m UpdateQuotaRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4123,12 +5513,11 @@ func (m ListPendingApprovalsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListPendingApprovalsRequestMultiError) AllErrors() []error { return m }
+func (m UpdateQuotaRequestMultiError) AllErrors() []error { return m }
 
-// ListPendingApprovalsRequestValidationError is the validation error returned
-// by ListPendingApprovalsRequest.Validate if the designated constraints
-// aren't met.
-type ListPendingApprovalsRequestValidationError struct {
+// UpdateQuotaRequestValidationError is the validation error returned by
+// UpdateQuotaRequest.Validate if the designated constraints aren't met.
+type UpdateQuotaRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4136,24 +5525,24 @@ type ListPendingApprovalsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListPendingApprovalsRequestValidationError) Field() string { return e.field }
+func (e UpdateQuotaRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListPendingApprovalsRequestValidationError) Reason() string { return e.reason }
+func (e UpdateQuotaRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListPendingApprovalsRequestValidationError) Cause() error { return e.cause }
+func (e UpdateQuotaRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListPendingApprovalsRequestValidationError) Key() bool { return e.key }
+func (e UpdateQuotaRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListPendingApprovalsRequestValidationError) ErrorName() string {
-	return "ListPendingApprovalsRequestValidationError"
+func (e UpdateQuotaRequestValidationError) ErrorName() string {
+	return "UpdateQuotaRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListPendingApprovalsRequestValidationError) Error() string {
+func (e UpdateQuotaRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4165,14 +5554,14 @@ func (e ListPendingApprovalsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListPendingApprovalsRequest.%s: %s%s",
+		"invalid %sUpdateQuotaRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListPendingApprovalsRequestValidationError{}
+var _ error = UpdateQuotaRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4180,50 +5569,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListPendingApprovalsRequestValidationError{}
+} = UpdateQuotaRequestValidationError{}
 
-// Validate checks the field values on ApproveLeaveRequestRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetEmployeeQuotasRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ApproveLeaveRequestRequest) Validate() error {
+func (m *GetEmployeeQuotasRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ApproveLeaveRequestRequest with the
+// ValidateAll checks the field values on GetEmployeeQuotasRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ApproveLeaveRequestRequestMultiError, or nil if none found.
-func (m *ApproveLeaveRequestRequest) ValidateAll() error {
+// GetEmployeeQuotasRequestMultiError, or nil if none found.
+func (m *GetEmployeeQuotasRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ApproveLeaveRequestRequest) validate(all bool) error {
+func (m *GetEmployeeQuotasRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RequestId
+	// no validation rules for TenantId
 
-	// no validation rules for ApproverId
+	// no validation rules for EmployeeId
 
-	// no validation rules for Comment
+	// no validation rules for Year
 
 	if len(errors) > 0 {
-		return ApproveLeaveRequestRequestMultiError(errors)
+		return GetEmployeeQuotasRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ApproveLeaveRequestRequestMultiError is an error wrapping multiple
-// validation errors returned by ApproveLeaveRequestRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ApproveLeaveRequestRequestMultiError []error
+// GetEmployeeQuotasRequestMultiError is an error wrapping multiple validation
+// errors returned by GetEmployeeQuotasRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetEmployeeQuotasRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ApproveLeaveRequestRequestMultiError) Error() string {
+func (m GetEmployeeQuotasRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4232,11 +5621,11 @@ func (m ApproveLeaveRequestRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ApproveLeaveRequestRequestMultiError) AllErrors() []error { return m }
+func (m GetEmployeeQuotasRequestMultiError) AllErrors() []error { return m }
 
-// ApproveLeaveRequestRequestValidationError is the validation error returned
-// by ApproveLeaveRequestRequest.Validate if the designated constraints aren't met.
-type ApproveLeaveRequestRequestValidationError struct {
+// GetEmployeeQuotasRequestValidationError is the validation error returned by
+// GetEmployeeQuotasRequest.Validate if the designated constraints aren't met.
+type GetEmployeeQuotasRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4244,24 +5633,24 @@ type ApproveLeaveRequestRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ApproveLeaveRequestRequestValidationError) Field() string { return e.field }
+func (e GetEmployeeQuotasRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ApproveLeaveRequestRequestValidationError) Reason() string { return e.reason }
+func (e GetEmployeeQuotasRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ApproveLeaveRequestRequestValidationError) Cause() error { return e.cause }
+func (e GetEmployeeQuotasRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ApproveLeaveRequestRequestValidationError) Key() bool { return e.key }
+func (e GetEmployeeQuotasRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ApproveLeaveRequestRequestValidationError) ErrorName() string {
-	return "ApproveLeaveRequestRequestValidationError"
+func (e GetEmployeeQuotasRequestValidationError) ErrorName() string {
+	return "GetEmployeeQuotasRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ApproveLeaveRequestRequestValidationError) Error() string {
+func (e GetEmployeeQuotasRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4273,14 +5662,14 @@ func (e ApproveLeaveRequestRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sApproveLeaveRequestRequest.%s: %s%s",
+		"invalid %sGetEmployeeQuotasRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ApproveLeaveRequestRequestValidationError{}
+var _ error = GetEmployeeQuotasRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4288,48 +5677,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ApproveLeaveRequestRequestValidationError{}
+} = GetEmployeeQuotasRequestValidationError{}
 
-// Validate checks the field values on ApproveLeaveRequestResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetEmployeeQuotasResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ApproveLeaveRequestResponse) Validate() error {
+func (m *GetEmployeeQuotasResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ApproveLeaveRequestResponse with the
+// ValidateAll checks the field values on GetEmployeeQuotasResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ApproveLeaveRequestResponseMultiError, or nil if none found.
-func (m *ApproveLeaveRequestResponse) ValidateAll() error {
+// GetEmployeeQuotasResponseMultiError, or nil if none found.
+func (m *GetEmployeeQuotasResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ApproveLeaveRequestResponse) validate(all bool) error {
+func (m *GetEmployeeQuotasResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
 
-	// no validation rules for Message
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetEmployeeQuotasResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetEmployeeQuotasResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetEmployeeQuotasResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return ApproveLeaveRequestResponseMultiError(errors)
+		return GetEmployeeQuotasResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ApproveLeaveRequestResponseMultiError is an error wrapping multiple
-// validation errors returned by ApproveLeaveRequestResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ApproveLeaveRequestResponseMultiError []error
+// GetEmployeeQuotasResponseMultiError is an error wrapping multiple validation
+// errors returned by GetEmployeeQuotasResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetEmployeeQuotasResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ApproveLeaveRequestResponseMultiError) Error() string {
+func (m GetEmployeeQuotasResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4338,12 +5757,11 @@ func (m ApproveLeaveRequestResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ApproveLeaveRequestResponseMultiError) AllErrors() []error { return m }
+func (m GetEmployeeQuotasResponseMultiError) AllErrors() []error { return m }
 
-// ApproveLeaveRequestResponseValidationError is the validation error returned
-// by ApproveLeaveRequestResponse.Validate if the designated constraints
-// aren't met.
-type ApproveLeaveRequestResponseValidationError struct {
+// GetEmployeeQuotasResponseValidationError is the validation error returned by
+// GetEmployeeQuotasResponse.Validate if the designated constraints aren't met.
+type GetEmployeeQuotasResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4351,24 +5769,24 @@ type ApproveLeaveRequestResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ApproveLeaveRequestResponseValidationError) Field() string { return e.field }
+func (e GetEmployeeQuotasResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ApproveLeaveRequestResponseValidationError) Reason() string { return e.reason }
+func (e GetEmployeeQuotasResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ApproveLeaveRequestResponseValidationError) Cause() error { return e.cause }
+func (e GetEmployeeQuotasResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ApproveLeaveRequestResponseValidationError) Key() bool { return e.key }
+func (e GetEmployeeQuotasResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ApproveLeaveRequestResponseValidationError) ErrorName() string {
-	return "ApproveLeaveRequestResponseValidationError"
+func (e GetEmployeeQuotasResponseValidationError) ErrorName() string {
+	return "GetEmployeeQuotasResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ApproveLeaveRequestResponseValidationError) Error() string {
+func (e GetEmployeeQuotasResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4380,14 +5798,14 @@ func (e ApproveLeaveRequestResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sApproveLeaveRequestResponse.%s: %s%s",
+		"invalid %sGetEmployeeQuotasResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ApproveLeaveRequestResponseValidationError{}
+var _ error = GetEmployeeQuotasResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4395,50 +5813,106 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ApproveLeaveRequestResponseValidationError{}
+} = GetEmployeeQuotasResponseValidationError{}
 
-// Validate checks the field values on RejectLeaveRequestRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CalculateLeaveDurationRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RejectLeaveRequestRequest) Validate() error {
+func (m *CalculateLeaveDurationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RejectLeaveRequestRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RejectLeaveRequestRequestMultiError, or nil if none found.
-func (m *RejectLeaveRequestRequest) ValidateAll() error {
+// ValidateAll checks the field values on CalculateLeaveDurationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CalculateLeaveDurationRequestMultiError, or nil if none found.
+func (m *CalculateLeaveDurationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RejectLeaveRequestRequest) validate(all bool) error {
+func (m *CalculateLeaveDurationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RequestId
+	// no validation rules for EmployeeId
 
-	// no validation rules for ApproverId
+	// no validation rules for LeaveTypeId
 
-	// no validation rules for Comment
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CalculateLeaveDurationRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CalculateLeaveDurationRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CalculateLeaveDurationRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CalculateLeaveDurationRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CalculateLeaveDurationRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CalculateLeaveDurationRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return RejectLeaveRequestRequestMultiError(errors)
+		return CalculateLeaveDurationRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RejectLeaveRequestRequestMultiError is an error wrapping multiple validation
-// errors returned by RejectLeaveRequestRequest.ValidateAll() if the
-// designated constraints aren't met.
-type RejectLeaveRequestRequestMultiError []error
+// CalculateLeaveDurationRequestMultiError is an error wrapping multiple
+// validation errors returned by CalculateLeaveDurationRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CalculateLeaveDurationRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RejectLeaveRequestRequestMultiError) Error() string {
+func (m CalculateLeaveDurationRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4447,11 +5921,12 @@ func (m RejectLeaveRequestRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RejectLeaveRequestRequestMultiError) AllErrors() []error { return m }
+func (m CalculateLeaveDurationRequestMultiError) AllErrors() []error { return m }
 
-// RejectLeaveRequestRequestValidationError is the validation error returned by
-// RejectLeaveRequestRequest.Validate if the designated constraints aren't met.
-type RejectLeaveRequestRequestValidationError struct {
+// CalculateLeaveDurationRequestValidationError is the validation error
+// returned by CalculateLeaveDurationRequest.Validate if the designated
+// constraints aren't met.
+type CalculateLeaveDurationRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4459,24 +5934,24 @@ type RejectLeaveRequestRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RejectLeaveRequestRequestValidationError) Field() string { return e.field }
+func (e CalculateLeaveDurationRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RejectLeaveRequestRequestValidationError) Reason() string { return e.reason }
+func (e CalculateLeaveDurationRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RejectLeaveRequestRequestValidationError) Cause() error { return e.cause }
+func (e CalculateLeaveDurationRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RejectLeaveRequestRequestValidationError) Key() bool { return e.key }
+func (e CalculateLeaveDurationRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RejectLeaveRequestRequestValidationError) ErrorName() string {
-	return "RejectLeaveRequestRequestValidationError"
+func (e CalculateLeaveDurationRequestValidationError) ErrorName() string {
+	return "CalculateLeaveDurationRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RejectLeaveRequestRequestValidationError) Error() string {
+func (e CalculateLeaveDurationRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4488,14 +5963,14 @@ func (e RejectLeaveRequestRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRejectLeaveRequestRequest.%s: %s%s",
+		"invalid %sCalculateLeaveDurationRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RejectLeaveRequestRequestValidationError{}
+var _ error = CalculateLeaveDurationRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4503,48 +5978,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RejectLeaveRequestRequestValidationError{}
+} = CalculateLeaveDurationRequestValidationError{}
 
-// Validate checks the field values on RejectLeaveRequestResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RejectLeaveRequestResponse) Validate() error {
+// Validate checks the field values on GetLeaveDurationBreakdownRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetLeaveDurationBreakdownRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RejectLeaveRequestResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RejectLeaveRequestResponseMultiError, or nil if none found.
-func (m *RejectLeaveRequestResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetLeaveDurationBreakdownRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetLeaveDurationBreakdownRequestMultiError, or nil if none found.
+func (m *GetLeaveDurationBreakdownRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RejectLeaveRequestResponse) validate(all bool) error {
+func (m *GetLeaveDurationBreakdownRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
-
-	// no validation rules for Message
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return RejectLeaveRequestResponseMultiError(errors)
+		return GetLeaveDurationBreakdownRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RejectLeaveRequestResponseMultiError is an error wrapping multiple
-// validation errors returned by RejectLeaveRequestResponse.ValidateAll() if
-// the designated constraints aren't met.
-type RejectLeaveRequestResponseMultiError []error
+// GetLeaveDurationBreakdownRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetLeaveDurationBreakdownRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetLeaveDurationBreakdownRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RejectLeaveRequestResponseMultiError) Error() string {
+func (m GetLeaveDurationBreakdownRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4553,11 +6028,12 @@ func (m RejectLeaveRequestResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RejectLeaveRequestResponseMultiError) AllErrors() []error { return m }
+func (m GetLeaveDurationBreakdownRequestMultiError) AllErrors() []error { return m }
 
-// RejectLeaveRequestResponseValidationError is the validation error returned
-// by RejectLeaveRequestResponse.Validate if the designated constraints aren't met.
-type RejectLeaveRequestResponseValidationError struct {
+// GetLeaveDurationBreakdownRequestValidationError is the validation error
+// returned by GetLeaveDurationBreakdownRequest.Validate if the designated
+// constraints aren't met.
+type GetLeaveDurationBreakdownRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4565,24 +6041,24 @@ type RejectLeaveRequestResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RejectLeaveRequestResponseValidationError) Field() string { return e.field }
+func (e GetLeaveDurationBreakdownRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RejectLeaveRequestResponseValidationError) Reason() string { return e.reason }
+func (e GetLeaveDurationBreakdownRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RejectLeaveRequestResponseValidationError) Cause() error { return e.cause }
+func (e GetLeaveDurationBreakdownRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RejectLeaveRequestResponseValidationError) Key() bool { return e.key }
+func (e GetLeaveDurationBreakdownRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RejectLeaveRequestResponseValidationError) ErrorName() string {
-	return "RejectLeaveRequestResponseValidationError"
+func (e GetLeaveDurationBreakdownRequestValidationError) ErrorName() string {
+	return "GetLeaveDurationBreakdownRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RejectLeaveRequestResponseValidationError) Error() string {
+func (e GetLeaveDurationBreakdownRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4594,14 +6070,14 @@ func (e RejectLeaveRequestResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRejectLeaveRequestResponse.%s: %s%s",
+		"invalid %sGetLeaveDurationBreakdownRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RejectLeaveRequestResponseValidationError{}
+var _ error = GetLeaveDurationBreakdownRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4609,50 +6085,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RejectLeaveRequestResponseValidationError{}
+} = GetLeaveDurationBreakdownRequestValidationError{}
 
-// Validate checks the field values on InitEmployeeQuotaRequest with the rules
+// Validate checks the field values on EmployeeYearRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *InitEmployeeQuotaRequest) Validate() error {
+func (m *EmployeeYearRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InitEmployeeQuotaRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on EmployeeYearRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// InitEmployeeQuotaRequestMultiError, or nil if none found.
-func (m *InitEmployeeQuotaRequest) ValidateAll() error {
+// EmployeeYearRequestMultiError, or nil if none found.
+func (m *EmployeeYearRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InitEmployeeQuotaRequest) validate(all bool) error {
+func (m *EmployeeYearRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TenantId
-
 	// no validation rules for EmployeeId
 
 	// no validation rules for Year
 
 	if len(errors) > 0 {
-		return InitEmployeeQuotaRequestMultiError(errors)
+		return EmployeeYearRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// InitEmployeeQuotaRequestMultiError is an error wrapping multiple validation
-// errors returned by InitEmployeeQuotaRequest.ValidateAll() if the designated
+// EmployeeYearRequestMultiError is an error wrapping multiple validation
+// errors returned by EmployeeYearRequest.ValidateAll() if the designated
 // constraints aren't met.
-type InitEmployeeQuotaRequestMultiError []error
+type EmployeeYearRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InitEmployeeQuotaRequestMultiError) Error() string {
+func (m EmployeeYearRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4661,11 +6135,11 @@ func (m InitEmployeeQuotaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InitEmployeeQuotaRequestMultiError) AllErrors() []error { return m }
+func (m EmployeeYearRequestMultiError) AllErrors() []error { return m }
 
-// InitEmployeeQuotaRequestValidationError is the validation error returned by
-// InitEmployeeQuotaRequest.Validate if the designated constraints aren't met.
-type InitEmployeeQuotaRequestValidationError struct {
+// EmployeeYearRequestValidationError is the validation error returned by
+// EmployeeYearRequest.Validate if the designated constraints aren't met.
+type EmployeeYearRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4673,24 +6147,24 @@ type InitEmployeeQuotaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e InitEmployeeQuotaRequestValidationError) Field() string { return e.field }
+func (e EmployeeYearRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InitEmployeeQuotaRequestValidationError) Reason() string { return e.reason }
+func (e EmployeeYearRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InitEmployeeQuotaRequestValidationError) Cause() error { return e.cause }
+func (e EmployeeYearRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InitEmployeeQuotaRequestValidationError) Key() bool { return e.key }
+func (e EmployeeYearRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InitEmployeeQuotaRequestValidationError) ErrorName() string {
-	return "InitEmployeeQuotaRequestValidationError"
+func (e EmployeeYearRequestValidationError) ErrorName() string {
+	return "EmployeeYearRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e InitEmployeeQuotaRequestValidationError) Error() string {
+func (e EmployeeYearRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4702,14 +6176,14 @@ func (e InitEmployeeQuotaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInitEmployeeQuotaRequest.%s: %s%s",
+		"invalid %sEmployeeYearRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InitEmployeeQuotaRequestValidationError{}
+var _ error = EmployeeYearRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4717,46 +6191,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InitEmployeeQuotaRequestValidationError{}
+} = EmployeeYearRequestValidationError{}
 
-// Validate checks the field values on InitEmployeeQuotaResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListLeaveQuotaLedgerResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *InitEmployeeQuotaResponse) Validate() error {
+func (m *ListLeaveQuotaLedgerResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InitEmployeeQuotaResponse with the
+// ValidateAll checks the field values on ListLeaveQuotaLedgerResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// InitEmployeeQuotaResponseMultiError, or nil if none found.
-func (m *InitEmployeeQuotaResponse) ValidateAll() error {
+// ListLeaveQuotaLedgerResponseMultiError, or nil if none found.
+func (m *ListLeaveQuotaLedgerResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InitEmployeeQuotaResponse) validate(all bool) error {
+func (m *ListLeaveQuotaLedgerResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListLeaveQuotaLedgerResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListLeaveQuotaLedgerResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListLeaveQuotaLedgerResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return InitEmployeeQuotaResponseMultiError(errors)
+		return ListLeaveQuotaLedgerResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// InitEmployeeQuotaResponseMultiError is an error wrapping multiple validation
-// errors returned by InitEmployeeQuotaResponse.ValidateAll() if the
-// designated constraints aren't met.
-type InitEmployeeQuotaResponseMultiError []error
+// ListLeaveQuotaLedgerResponseMultiError is an error wrapping multiple
+// validation errors returned by ListLeaveQuotaLedgerResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListLeaveQuotaLedgerResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InitEmployeeQuotaResponseMultiError) Error() string {
+func (m ListLeaveQuotaLedgerResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4765,11 +6271,12 @@ func (m InitEmployeeQuotaResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InitEmployeeQuotaResponseMultiError) AllErrors() []error { return m }
+func (m ListLeaveQuotaLedgerResponseMultiError) AllErrors() []error { return m }
 
-// InitEmployeeQuotaResponseValidationError is the validation error returned by
-// InitEmployeeQuotaResponse.Validate if the designated constraints aren't met.
-type InitEmployeeQuotaResponseValidationError struct {
+// ListLeaveQuotaLedgerResponseValidationError is the validation error returned
+// by ListLeaveQuotaLedgerResponse.Validate if the designated constraints
+// aren't met.
+type ListLeaveQuotaLedgerResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4777,24 +6284,24 @@ type InitEmployeeQuotaResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e InitEmployeeQuotaResponseValidationError) Field() string { return e.field }
+func (e ListLeaveQuotaLedgerResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InitEmployeeQuotaResponseValidationError) Reason() string { return e.reason }
+func (e ListLeaveQuotaLedgerResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InitEmployeeQuotaResponseValidationError) Cause() error { return e.cause }
+func (e ListLeaveQuotaLedgerResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InitEmployeeQuotaResponseValidationError) Key() bool { return e.key }
+func (e ListLeaveQuotaLedgerResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InitEmployeeQuotaResponseValidationError) ErrorName() string {
-	return "InitEmployeeQuotaResponseValidationError"
+func (e ListLeaveQuotaLedgerResponseValidationError) ErrorName() string {
+	return "ListLeaveQuotaLedgerResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e InitEmployeeQuotaResponseValidationError) Error() string {
+func (e ListLeaveQuotaLedgerResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4806,14 +6313,14 @@ func (e InitEmployeeQuotaResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInitEmployeeQuotaResponse.%s: %s%s",
+		"invalid %sListLeaveQuotaLedgerResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InitEmployeeQuotaResponseValidationError{}
+var _ error = ListLeaveQuotaLedgerResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4821,24 +6328,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InitEmployeeQuotaResponseValidationError{}
+} = ListLeaveQuotaLedgerResponseValidationError{}
 
-// Validate checks the field values on UpdateQuotaRequest with the rules
+// Validate checks the field values on AdjustLeaveQuotaRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateQuotaRequest) Validate() error {
+func (m *AdjustLeaveQuotaRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateQuotaRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdjustLeaveQuotaRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateQuotaRequestMultiError, or nil if none found.
-func (m *UpdateQuotaRequest) ValidateAll() error {
+// AdjustLeaveQuotaRequestMultiError, or nil if none found.
+func (m *AdjustLeaveQuotaRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateQuotaRequest) validate(all bool) error {
+func (m *AdjustLeaveQuotaRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4847,22 +6354,24 @@ func (m *UpdateQuotaRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for TotalQuota
+	// no validation rules for Amount
+
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return UpdateQuotaRequestMultiError(errors)
+		return AdjustLeaveQuotaRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateQuotaRequestMultiError is an error wrapping multiple validation errors
-// returned by UpdateQuotaRequest.ValidateAll() if the designated constraints
-// aren't met.
-type UpdateQuotaRequestMultiError []error
+// AdjustLeaveQuotaRequestMultiError is an error wrapping multiple validation
+// errors returned by AdjustLeaveQuotaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdjustLeaveQuotaRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateQuotaRequestMultiError) Error() string {
+func (m AdjustLeaveQuotaRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4871,11 +6380,11 @@ func (m UpdateQuotaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateQuotaRequestMultiError) AllErrors() []error { return m }
+func (m AdjustLeaveQuotaRequestMultiError) AllErrors() []error { return m }
 
-// UpdateQuotaRequestValidationError is the validation error returned by
-// UpdateQuotaRequest.Validate if the designated constraints aren't met.
-type UpdateQuotaRequestValidationError struct {
+// AdjustLeaveQuotaRequestValidationError is the validation error returned by
+// AdjustLeaveQuotaRequest.Validate if the designated constraints aren't met.
+type AdjustLeaveQuotaRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4883,24 +6392,24 @@ type UpdateQuotaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateQuotaRequestValidationError) Field() string { return e.field }
+func (e AdjustLeaveQuotaRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateQuotaRequestValidationError) Reason() string { return e.reason }
+func (e AdjustLeaveQuotaRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateQuotaRequestValidationError) Cause() error { return e.cause }
+func (e AdjustLeaveQuotaRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateQuotaRequestValidationError) Key() bool { return e.key }
+func (e AdjustLeaveQuotaRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateQuotaRequestValidationError) ErrorName() string {
-	return "UpdateQuotaRequestValidationError"
+func (e AdjustLeaveQuotaRequestValidationError) ErrorName() string {
+	return "AdjustLeaveQuotaRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateQuotaRequestValidationError) Error() string {
+func (e AdjustLeaveQuotaRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4912,14 +6421,14 @@ func (e UpdateQuotaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateQuotaRequest.%s: %s%s",
+		"invalid %sAdjustLeaveQuotaRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateQuotaRequestValidationError{}
+var _ error = AdjustLeaveQuotaRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4927,50 +6436,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateQuotaRequestValidationError{}
+} = AdjustLeaveQuotaRequestValidationError{}
 
-// Validate checks the field values on GetEmployeeQuotasRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CarryOverLeaveQuotasRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetEmployeeQuotasRequest) Validate() error {
+func (m *CarryOverLeaveQuotasRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetEmployeeQuotasRequest with the
+// ValidateAll checks the field values on CarryOverLeaveQuotasRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetEmployeeQuotasRequestMultiError, or nil if none found.
-func (m *GetEmployeeQuotasRequest) ValidateAll() error {
+// CarryOverLeaveQuotasRequestMultiError, or nil if none found.
+func (m *CarryOverLeaveQuotasRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetEmployeeQuotasRequest) validate(all bool) error {
+func (m *CarryOverLeaveQuotasRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TenantId
-
-	// no validation rules for EmployeeId
-
 	// no validation rules for Year
 
 	if len(errors) > 0 {
-		return GetEmployeeQuotasRequestMultiError(errors)
+		return CarryOverLeaveQuotasRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetEmployeeQuotasRequestMultiError is an error wrapping multiple validation
-// errors returned by GetEmployeeQuotasRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetEmployeeQuotasRequestMultiError []error
+// CarryOverLeaveQuotasRequestMultiError is an error wrapping multiple
+// validation errors returned by CarryOverLeaveQuotasRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CarryOverLeaveQuotasRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetEmployeeQuotasRequestMultiError) Error() string {
+func (m CarryOverLeaveQuotasRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4979,11 +6484,12 @@ func (m GetEmployeeQuotasRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetEmployeeQuotasRequestMultiError) AllErrors() []error { return m }
+func (m CarryOverLeaveQuotasRequestMultiError) AllErrors() []error { return m }
 
-// GetEmployeeQuotasRequestValidationError is the validation error returned by
-// GetEmployeeQuotasRequest.Validate if the designated constraints aren't met.
-type GetEmployeeQuotasRequestValidationError struct {
+// CarryOverLeaveQuotasRequestValidationError is the validation error returned
+// by CarryOverLeaveQuotasRequest.Validate if the designated constraints
+// aren't met.
+type CarryOverLeaveQuotasRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4991,24 +6497,24 @@ type GetEmployeeQuotasRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetEmployeeQuotasRequestValidationError) Field() string { return e.field }
+func (e CarryOverLeaveQuotasRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetEmployeeQuotasRequestValidationError) Reason() string { return e.reason }
+func (e CarryOverLeaveQuotasRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetEmployeeQuotasRequestValidationError) Cause() error { return e.cause }
+func (e CarryOverLeaveQuotasRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetEmployeeQuotasRequestValidationError) Key() bool { return e.key }
+func (e CarryOverLeaveQuotasRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetEmployeeQuotasRequestValidationError) ErrorName() string {
-	return "GetEmployeeQuotasRequestValidationError"
+func (e CarryOverLeaveQuotasRequestValidationError) ErrorName() string {
+	return "CarryOverLeaveQuotasRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetEmployeeQuotasRequestValidationError) Error() string {
+func (e CarryOverLeaveQuotasRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5020,14 +6526,14 @@ func (e GetEmployeeQuotasRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetEmployeeQuotasRequest.%s: %s%s",
+		"invalid %sCarryOverLeaveQuotasRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetEmployeeQuotasRequestValidationError{}
+var _ error = CarryOverLeaveQuotasRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5035,78 +6541,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetEmployeeQuotasRequestValidationError{}
+} = CarryOverLeaveQuotasRequestValidationError{}
 
-// Validate checks the field values on GetEmployeeQuotasResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on LeaveAccrualResultResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetEmployeeQuotasResponse) Validate() error {
+func (m *LeaveAccrualResultResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetEmployeeQuotasResponse with the
+// ValidateAll checks the field values on LeaveAccrualResultResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetEmployeeQuotasResponseMultiError, or nil if none found.
-func (m *GetEmployeeQuotasResponse) ValidateAll() error {
+// LeaveAccrualResultResponseMultiError, or nil if none found.
+func (m *LeaveAccrualResultResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetEmployeeQuotasResponse) validate(all bool) error {
+func (m *LeaveAccrualResultResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetItems() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetEmployeeQuotasResponseValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetEmployeeQuotasResponseValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetEmployeeQuotasResponseValidationError{
-					field:  fmt.Sprintf("Items[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for Changed
 
 	if len(errors) > 0 {
-		return GetEmployeeQuotasResponseMultiError(errors)
+		return LeaveAccrualResultResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetEmployeeQuotasResponseMultiError is an error wrapping multiple validation
-// errors returned by GetEmployeeQuotasResponse.ValidateAll() if the
-// designated constraints aren't met.
-type GetEmployeeQuotasResponseMultiError []error
+// LeaveAccrualResultResponseMultiError is an error wrapping multiple
+// validation errors returned by LeaveAccrualResultResponse.ValidateAll() if
+// the designated constraints aren't met.
+type LeaveAccrualResultResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetEmployeeQuotasResponseMultiError) Error() string {
+func (m LeaveAccrualResultResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5115,11 +6589,11 @@ func (m GetEmployeeQuotasResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetEmployeeQuotasResponseMultiError) AllErrors() []error { return m }
+func (m LeaveAccrualResultResponseMultiError) AllErrors() []error { return m }
 
-// GetEmployeeQuotasResponseValidationError is the validation error returned by
-// GetEmployeeQuotasResponse.Validate if the designated constraints aren't met.
-type GetEmployeeQuotasResponseValidationError struct {
+// LeaveAccrualResultResponseValidationError is the validation error returned
+// by LeaveAccrualResultResponse.Validate if the designated constraints aren't met.
+type LeaveAccrualResultResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5127,24 +6601,24 @@ type GetEmployeeQuotasResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetEmployeeQuotasResponseValidationError) Field() string { return e.field }
+func (e LeaveAccrualResultResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetEmployeeQuotasResponseValidationError) Reason() string { return e.reason }
+func (e LeaveAccrualResultResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetEmployeeQuotasResponseValidationError) Cause() error { return e.cause }
+func (e LeaveAccrualResultResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetEmployeeQuotasResponseValidationError) Key() bool { return e.key }
+func (e LeaveAccrualResultResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetEmployeeQuotasResponseValidationError) ErrorName() string {
-	return "GetEmployeeQuotasResponseValidationError"
+func (e LeaveAccrualResultResponseValidationError) ErrorName() string {
+	return "LeaveAccrualResultResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetEmployeeQuotasResponseValidationError) Error() string {
+func (e LeaveAccrualResultResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5156,14 +6630,14 @@ func (e GetEmployeeQuotasResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetEmployeeQuotasResponse.%s: %s%s",
+		"invalid %sLeaveAccrualResultResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetEmployeeQuotasResponseValidationError{}
+var _ error = LeaveAccrualResultResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5171,4 +6645,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetEmployeeQuotasResponseValidationError{}
+} = LeaveAccrualResultResponseValidationError{}
@@ -320,6 +320,10 @@ type LeaveRequestServiceClient interface {
 	ApproveLeaveRequest(ctx context.Context, in *ApproveLeaveRequestRequest, opts ...grpc.CallOption) (*ApproveLeaveRequestResponse, error)
 	// 拒绝请假
 	RejectLeaveRequest(ctx context.Context, in *RejectLeaveRequestRequest, opts ...grpc.CallOption) (*RejectLeaveRequestResponse, error)
+	// 按排班和请假类型规则预览请假时长（含按天、按月拆分）
+	CalculateLeaveDuration(ctx context.Context, in *CalculateLeaveDurationRequest, opts ...grpc.CallOption) (*LeaveDurationResponse, error)
+	// 获取请假申请保存的按天拆分
+	GetLeaveDurationBreakdown(ctx context.Context, in *GetLeaveDurationBreakdownRequest, opts ...grpc.CallOption) (*LeaveDurationResponse, error)
 }
 
 type leaveRequestServiceClient struct {
@@ -429,6 +433,24 @@ func (c *leaveRequestServiceClient) RejectLeaveRequest(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *leaveRequestServiceClient) CalculateLeaveDuration(ctx context.Context, in *CalculateLeaveDurationRequest, opts ...grpc.CallOption) (*LeaveDurationResponse, error) {
+	out := new(LeaveDurationResponse)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.LeaveRequestService/CalculateLeaveDuration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveRequestServiceClient) GetLeaveDurationBreakdown(ctx context.Context, in *GetLeaveDurationBreakdownRequest, opts ...grpc.CallOption) (*LeaveDurationResponse, error) {
+	out := new(LeaveDurationResponse)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.LeaveRequestService/GetLeaveDurationBreakdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LeaveRequestServiceServer is the server API for LeaveRequestService service.
 // All implementations should embed UnimplementedLeaveRequestServiceServer
 // for forward compatibility
@@ -455,6 +477,10 @@ type LeaveRequestServiceServer interface {
 	ApproveLeaveRequest(context.Context, *ApproveLeaveRequestRequest) (*ApproveLeaveRequestResponse, error)
 	// 拒绝请假
 	RejectLeaveRequest(context.Context, *RejectLeaveRequestRequest) (*RejectLeaveRequestResponse, error)
+	// 按排班和请假类型规则预览请假时长（含按天、按月拆分）
+	CalculateLeaveDuration(context.Context, *CalculateLeaveDurationRequest) (*LeaveDurationResponse, error)
+	// 获取请假申请保存的按天拆分
+	GetLeaveDurationBreakdown(context.Context, *GetLeaveDurationBreakdownRequest) (*LeaveDurationResponse, error)
 }
 
 // UnimplementedLeaveRequestServiceServer should be embedded to have forward compatible implementations.
@@ -494,6 +520,12 @@ func (UnimplementedLeaveRequestServiceServer) ApproveLeaveRequest(context.Contex
 func (UnimplementedLeaveRequestServiceServer) RejectLeaveRequest(context.Context, *RejectLeaveRequestRequest) (*RejectLeaveRequestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RejectLeaveRequest not implemented")
 }
+func (UnimplementedLeaveRequestServiceServer) CalculateLeaveDuration(context.Context, *CalculateLeaveDurationRequest) (*LeaveDurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateLeaveDuration not implemented")
+}
+func (UnimplementedLeaveRequestServiceServer) GetLeaveDurationBreakdown(context.Context, *GetLeaveDurationBreakdownRequest) (*LeaveDurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaveDurationBreakdown not implemented")
+}
 
 // UnsafeLeaveRequestServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to LeaveRequestServiceServer will
@@ -704,6 +736,42 @@ func _LeaveRequestService_RejectLeaveRequest_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LeaveRequestService_CalculateLeaveDuration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateLeaveDurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveRequestServiceServer).CalculateLeaveDuration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.LeaveRequestService/CalculateLeaveDuration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveRequestServiceServer).CalculateLeaveDuration(ctx, req.(*CalculateLeaveDurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveRequestService_GetLeaveDurationBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaveDurationBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveRequestServiceServer).GetLeaveDurationBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.LeaveRequestService/GetLeaveDurationBreakdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveRequestServiceServer).GetLeaveDurationBreakdown(ctx, req.(*GetLeaveDurationBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LeaveRequestService_ServiceDesc is the grpc.ServiceDesc for LeaveRequestService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -755,6 +823,14 @@ var LeaveRequestService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RejectLeaveRequest",
 			Handler:    _LeaveRequestService_RejectLeaveRequest_Handler,
 		},
+		{
+			MethodName: "CalculateLeaveDuration",
+			Handler:    _LeaveRequestService_CalculateLeaveDuration_Handler,
+		},
+		{
+			MethodName: "GetLeaveDurationBreakdown",
+			Handler:    _LeaveRequestService_GetLeaveDurationBreakdown_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/hrm/v1/leave.proto",
@@ -770,6 +846,14 @@ type LeaveQuotaServiceClient interface {
 	UpdateQuota(ctx context.Context, in *UpdateQuotaRequest, opts ...grpc.CallOption) (*QuotaResponse, error)
 	// 获取员工额度
 	GetEmployeeQuotas(ctx context.Context, in *GetEmployeeQuotasRequest, opts ...grpc.CallOption) (*GetEmployeeQuotasResponse, error)
+	// 查询员工某年度的额度流水
+	ListLeaveQuotaLedger(ctx context.Context, in *EmployeeYearRequest, opts ...grpc.CallOption) (*ListLeaveQuotaLedgerResponse, error)
+	// 按发放规则立即补齐员工某年度的额度
+	AccrueEmployeeQuota(ctx context.Context, in *EmployeeYearRequest, opts ...grpc.CallOption) (*LeaveAccrualResultResponse, error)
+	// 人工调整额度（必须填写原因，记入额度流水）
+	AdjustLeaveQuota(ctx context.Context, in *AdjustLeaveQuotaRequest, opts ...grpc.CallOption) (*LeaveQuotaLedgerEntry, error)
+	// 立即执行某年度的年末结转（可重复执行）
+	CarryOverLeaveQuotas(ctx context.Context, in *CarryOverLeaveQuotasRequest, opts ...grpc.CallOption) (*LeaveAccrualResultResponse, error)
 }
 
 type leaveQuotaServiceClient struct {
@@ -807,6 +891,42 @@ func (c *leaveQuotaServiceClient) GetEmployeeQuotas(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *leaveQuotaServiceClient) ListLeaveQuotaLedger(ctx context.Context, in *EmployeeYearRequest, opts ...grpc.CallOption) (*ListLeaveQuotaLedgerResponse, error) {
+	out := new(ListLeaveQuotaLedgerResponse)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.LeaveQuotaService/ListLeaveQuotaLedger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveQuotaServiceClient) AccrueEmployeeQuota(ctx context.Context, in *EmployeeYearRequest, opts ...grpc.CallOption) (*LeaveAccrualResultResponse, error) {
+	out := new(LeaveAccrualResultResponse)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.LeaveQuotaService/AccrueEmployeeQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveQuotaServiceClient) AdjustLeaveQuota(ctx context.Context, in *AdjustLeaveQuotaRequest, opts ...grpc.CallOption) (*LeaveQuotaLedgerEntry, error) {
+	out := new(LeaveQuotaLedgerEntry)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.LeaveQuotaService/AdjustLeaveQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveQuotaServiceClient) CarryOverLeaveQuotas(ctx context.Context, in *CarryOverLeaveQuotasRequest, opts ...grpc.CallOption) (*LeaveAccrualResultResponse, error) {
+	out := new(LeaveAccrualResultResponse)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.LeaveQuotaService/CarryOverLeaveQuotas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LeaveQuotaServiceServer is the server API for LeaveQuotaService service.
 // All implementations should embed UnimplementedLeaveQuotaServiceServer
 // for forward compatibility
@@ -817,6 +937,14 @@ type LeaveQuotaServiceServer interface {
 	UpdateQuota(context.Context, *UpdateQuotaRequest) (*QuotaResponse, error)
 	// 获取员工额度
 	GetEmployeeQuotas(context.Context, *GetEmployeeQuotasRequest) (*GetEmployeeQuotasResponse, error)
+	// 查询员工某年度的额度流水
+	ListLeaveQuotaLedger(context.Context, *EmployeeYearRequest) (*ListLeaveQuotaLedgerResponse, error)
+	// 按发放规则立即补齐员工某年度的额度
+	AccrueEmployeeQuota(context.Context, *EmployeeYearRequest) (*LeaveAccrualResultResponse, error)
+	// 人工调整额度（必须填写原因，记入额度流水）
+	AdjustLeaveQuota(context.Context, *AdjustLeaveQuotaRequest) (*LeaveQuotaLedgerEntry, error)
+	// 立即执行某年度的年末结转（可重复执行）
+	CarryOverLeaveQuotas(context.Context, *CarryOverLeaveQuotasRequest) (*LeaveAccrualResultResponse, error)
 }
 
 // UnimplementedLeaveQuotaServiceServer should be embedded to have forward compatible implementations.
@@ -832,6 +960,18 @@ func (UnimplementedLeaveQuotaServiceServer) UpdateQuota(context.Context, *Update
 func (UnimplementedLeaveQuotaServiceServer) GetEmployeeQuotas(context.Context, *GetEmployeeQuotasRequest) (*GetEmployeeQuotasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEmployeeQuotas not implemented")
 }
+func (UnimplementedLeaveQuotaServiceServer) ListLeaveQuotaLedger(context.Context, *EmployeeYearRequest) (*ListLeaveQuotaLedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLeaveQuotaLedger not implemented")
+}
+func (UnimplementedLeaveQuotaServiceServer) AccrueEmployeeQuota(context.Context, *EmployeeYearRequest) (*LeaveAccrualResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccrueEmployeeQuota not implemented")
+}
+func (UnimplementedLeaveQuotaServiceServer) AdjustLeaveQuota(context.Context, *AdjustLeaveQuotaRequest) (*LeaveQuotaLedgerEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustLeaveQuota not implemented")
+}
+func (UnimplementedLeaveQuotaServiceServer) CarryOverLeaveQuotas(context.Context, *CarryOverLeaveQuotasRequest) (*LeaveAccrualResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CarryOverLeaveQuotas not implemented")
+}
 
 // UnsafeLeaveQuotaServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to LeaveQuotaServiceServer will
@@ -898,6 +1038,78 @@ func _LeaveQuotaService_GetEmployeeQuotas_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LeaveQuotaService_ListLeaveQuotaLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmployeeYearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveQuotaServiceServer).ListLeaveQuotaLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.LeaveQuotaService/ListLeaveQuotaLedger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveQuotaServiceServer).ListLeaveQuotaLedger(ctx, req.(*EmployeeYearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveQuotaService_AccrueEmployeeQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmployeeYearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveQuotaServiceServer).AccrueEmployeeQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.LeaveQuotaService/AccrueEmployeeQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveQuotaServiceServer).AccrueEmployeeQuota(ctx, req.(*EmployeeYearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveQuotaService_AdjustLeaveQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustLeaveQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveQuotaServiceServer).AdjustLeaveQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.LeaveQuotaService/AdjustLeaveQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveQuotaServiceServer).AdjustLeaveQuota(ctx, req.(*AdjustLeaveQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveQuotaService_CarryOverLeaveQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CarryOverLeaveQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveQuotaServiceServer).CarryOverLeaveQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.LeaveQuotaService/CarryOverLeaveQuotas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveQuotaServiceServer).CarryOverLeaveQuotas(ctx, req.(*CarryOverLeaveQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LeaveQuotaService_ServiceDesc is the grpc.ServiceDesc for LeaveQuotaService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -917,6 +1129,22 @@ var LeaveQuotaService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEmployeeQuotas",
 			Handler:    _LeaveQuotaService_GetEmployeeQuotas_Handler,
 		},
+		{
+			MethodName: "ListLeaveQuotaLedger",
+			Handler:    _LeaveQuotaService_ListLeaveQuotaLedger_Handler,
+		},
+		{
+			MethodName: "AccrueEmployeeQuota",
+			Handler:    _LeaveQuotaService_AccrueEmployeeQuota_Handler,
+		},
+		{
+			MethodName: "AdjustLeaveQuota",
+			Handler:    _LeaveQuotaService_AdjustLeaveQuota_Handler,
+		},
+		{
+			MethodName: "CarryOverLeaveQuotas",
+			Handler:    _LeaveQuotaService_CarryOverLeaveQuotas_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/hrm/v1/leave.proto",
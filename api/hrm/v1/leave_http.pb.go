@@ -288,8 +288,10 @@ func (c *LeaveTypeServiceHTTPClientImpl) UpdateLeaveType(ctx context.Context, in
 }
 
 const OperationLeaveRequestServiceApproveLeaveRequest = "/api.hrm.v1.LeaveRequestService/ApproveLeaveRequest"
+const OperationLeaveRequestServiceCalculateLeaveDuration = "/api.hrm.v1.LeaveRequestService/CalculateLeaveDuration"
 const OperationLeaveRequestServiceCancelLeaveRequest = "/api.hrm.v1.LeaveRequestService/CancelLeaveRequest"
 const OperationLeaveRequestServiceCreateLeaveRequest = "/api.hrm.v1.LeaveRequestService/CreateLeaveRequest"
+const OperationLeaveRequestServiceGetLeaveDurationBreakdown = "/api.hrm.v1.LeaveRequestService/GetLeaveDurationBreakdown"
 const OperationLeaveRequestServiceGetLeaveRequest = "/api.hrm.v1.LeaveRequestService/GetLeaveRequest"
 const OperationLeaveRequestServiceListLeaveRequests = "/api.hrm.v1.LeaveRequestService/ListLeaveRequests"
 const OperationLeaveRequestServiceListMyLeaveRequests = "/api.hrm.v1.LeaveRequestService/ListMyLeaveRequests"
@@ -302,10 +304,14 @@ const OperationLeaveRequestServiceWithdrawLeaveRequest = "/api.hrm.v1.LeaveReque
 type LeaveRequestServiceHTTPServer interface {
 	// ApproveLeaveRequest 批准请假
 	ApproveLeaveRequest(context.Context, *ApproveLeaveRequestRequest) (*ApproveLeaveRequestResponse, error)
+	// CalculateLeaveDuration 按排班和请假类型规则预览请假时长（含按天、按月拆分）
+	CalculateLeaveDuration(context.Context, *CalculateLeaveDurationRequest) (*LeaveDurationResponse, error)
 	// CancelLeaveRequest 取消请假
 	CancelLeaveRequest(context.Context, *CancelLeaveRequestRequest) (*CancelLeaveRequestResponse, error)
 	// CreateLeaveRequest 创建请假申请
 	CreateLeaveRequest(context.Context, *CreateLeaveRequestRequest) (*LeaveRequestResponse, error)
+	// GetLeaveDurationBreakdown 获取请假申请保存的按天拆分
+	GetLeaveDurationBreakdown(context.Context, *GetLeaveDurationBreakdownRequest) (*LeaveDurationResponse, error)
 	// GetLeaveRequest 获取请假详情
 	GetLeaveRequest(context.Context, *GetLeaveRequestRequest) (*LeaveRequestDetailResponse, error)
 	// ListLeaveRequests 查询请假记录（管理员）
@@ -337,6 +343,8 @@ func RegisterLeaveRequestServiceHTTPServer(s *http.Server, srv LeaveRequestServi
 	r.GET("/api/v1/hrm/leave-requests/pending-approvals", _LeaveRequestService_ListPendingApprovals0_HTTP_Handler(srv))
 	r.POST("/api/v1/hrm/leave-requests/{request_id}/approve", _LeaveRequestService_ApproveLeaveRequest0_HTTP_Handler(srv))
 	r.POST("/api/v1/hrm/leave-requests/{request_id}/reject", _LeaveRequestService_RejectLeaveRequest0_HTTP_Handler(srv))
+	r.POST("/api/v1/hrm/leave-requests/duration", _LeaveRequestService_CalculateLeaveDuration0_HTTP_Handler(srv))
+	r.GET("/api/v1/hrm/leave-requests/{id}/duration", _LeaveRequestService_GetLeaveDurationBreakdown0_HTTP_Handler(srv))
 }
 
 func _LeaveRequestService_CreateLeaveRequest0_HTTP_Handler(srv LeaveRequestServiceHTTPServer) func(ctx http.Context) error {
@@ -590,13 +598,61 @@ func _LeaveRequestService_RejectLeaveRequest0_HTTP_Handler(srv LeaveRequestServi
 	}
 }
 
+func _LeaveRequestService_CalculateLeaveDuration0_HTTP_Handler(srv LeaveRequestServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CalculateLeaveDurationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLeaveRequestServiceCalculateLeaveDuration)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CalculateLeaveDuration(ctx, req.(*CalculateLeaveDurationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LeaveDurationResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _LeaveRequestService_GetLeaveDurationBreakdown0_HTTP_Handler(srv LeaveRequestServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetLeaveDurationBreakdownRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLeaveRequestServiceGetLeaveDurationBreakdown)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetLeaveDurationBreakdown(ctx, req.(*GetLeaveDurationBreakdownRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LeaveDurationResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type LeaveRequestServiceHTTPClient interface {
 	// ApproveLeaveRequest 批准请假
 	ApproveLeaveRequest(ctx context.Context, req *ApproveLeaveRequestRequest, opts ...http.CallOption) (rsp *ApproveLeaveRequestResponse, err error)
+	// CalculateLeaveDuration 按排班和请假类型规则预览请假时长（含按天、按月拆分）
+	CalculateLeaveDuration(ctx context.Context, req *CalculateLeaveDurationRequest, opts ...http.CallOption) (rsp *LeaveDurationResponse, err error)
 	// CancelLeaveRequest 取消请假
 	CancelLeaveRequest(ctx context.Context, req *CancelLeaveRequestRequest, opts ...http.CallOption) (rsp *CancelLeaveRequestResponse, err error)
 	// CreateLeaveRequest 创建请假申请
 	CreateLeaveRequest(ctx context.Context, req *CreateLeaveRequestRequest, opts ...http.CallOption) (rsp *LeaveRequestResponse, err error)
+	// GetLeaveDurationBreakdown 获取请假申请保存的按天拆分
+	GetLeaveDurationBreakdown(ctx context.Context, req *GetLeaveDurationBreakdownRequest, opts ...http.CallOption) (rsp *LeaveDurationResponse, err error)
 	// GetLeaveRequest 获取请假详情
 	GetLeaveRequest(ctx context.Context, req *GetLeaveRequestRequest, opts ...http.CallOption) (rsp *LeaveRequestDetailResponse, err error)
 	// ListLeaveRequests 查询请假记录（管理员）
@@ -637,6 +693,20 @@ func (c *LeaveRequestServiceHTTPClientImpl) ApproveLeaveRequest(ctx context.Cont
 	return &out, nil
 }
 
+// CalculateLeaveDuration 按排班和请假类型规则预览请假时长（含按天、按月拆分）
+func (c *LeaveRequestServiceHTTPClientImpl) CalculateLeaveDuration(ctx context.Context, in *CalculateLeaveDurationRequest, opts ...http.CallOption) (*LeaveDurationResponse, error) {
+	var out LeaveDurationResponse
+	pattern := "/api/v1/hrm/leave-requests/duration"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLeaveRequestServiceCalculateLeaveDuration))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // CancelLeaveRequest 取消请假
 func (c *LeaveRequestServiceHTTPClientImpl) CancelLeaveRequest(ctx context.Context, in *CancelLeaveRequestRequest, opts ...http.CallOption) (*CancelLeaveRequestResponse, error) {
 	var out CancelLeaveRequestResponse
@@ -665,6 +735,20 @@ func (c *LeaveRequestServiceHTTPClientImpl) CreateLeaveRequest(ctx context.Conte
 	return &out, nil
 }
 
+// GetLeaveDurationBreakdown 获取请假申请保存的按天拆分
+func (c *LeaveRequestServiceHTTPClientImpl) GetLeaveDurationBreakdown(ctx context.Context, in *GetLeaveDurationBreakdownRequest, opts ...http.CallOption) (*LeaveDurationResponse, error) {
+	var out LeaveDurationResponse
+	pattern := "/api/v1/hrm/leave-requests/{id}/duration"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationLeaveRequestServiceGetLeaveDurationBreakdown))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetLeaveRequest 获取请假详情
 func (c *LeaveRequestServiceHTTPClientImpl) GetLeaveRequest(ctx context.Context, in *GetLeaveRequestRequest, opts ...http.CallOption) (*LeaveRequestDetailResponse, error) {
 	var out LeaveRequestDetailResponse
@@ -777,15 +861,27 @@ func (c *LeaveRequestServiceHTTPClientImpl) WithdrawLeaveRequest(ctx context.Con
 	return &out, nil
 }
 
+const OperationLeaveQuotaServiceAccrueEmployeeQuota = "/api.hrm.v1.LeaveQuotaService/AccrueEmployeeQuota"
+const OperationLeaveQuotaServiceAdjustLeaveQuota = "/api.hrm.v1.LeaveQuotaService/AdjustLeaveQuota"
+const OperationLeaveQuotaServiceCarryOverLeaveQuotas = "/api.hrm.v1.LeaveQuotaService/CarryOverLeaveQuotas"
 const OperationLeaveQuotaServiceGetEmployeeQuotas = "/api.hrm.v1.LeaveQuotaService/GetEmployeeQuotas"
 const OperationLeaveQuotaServiceInitEmployeeQuota = "/api.hrm.v1.LeaveQuotaService/InitEmployeeQuota"
+const OperationLeaveQuotaServiceListLeaveQuotaLedger = "/api.hrm.v1.LeaveQuotaService/ListLeaveQuotaLedger"
 const OperationLeaveQuotaServiceUpdateQuota = "/api.hrm.v1.LeaveQuotaService/UpdateQuota"
 
 type LeaveQuotaServiceHTTPServer interface {
+	// AccrueEmployeeQuota 按发放规则立即补齐员工某年度的额度
+	AccrueEmployeeQuota(context.Context, *EmployeeYearRequest) (*LeaveAccrualResultResponse, error)
+	// AdjustLeaveQuota 人工调整额度（必须填写原因，记入额度流水）
+	AdjustLeaveQuota(context.Context, *AdjustLeaveQuotaRequest) (*LeaveQuotaLedgerEntry, error)
+	// CarryOverLeaveQuotas 立即执行某年度的年末结转（可重复执行）
+	CarryOverLeaveQuotas(context.Context, *CarryOverLeaveQuotasRequest) (*LeaveAccrualResultResponse, error)
 	// GetEmployeeQuotas 获取员工额度
 	GetEmployeeQuotas(context.Context, *GetEmployeeQuotasRequest) (*GetEmployeeQuotasResponse, error)
 	// InitEmployeeQuota 初始化员工额度
 	InitEmployeeQuota(context.Context, *InitEmployeeQuotaRequest) (*InitEmployeeQuotaResponse, error)
+	// ListLeaveQuotaLedger 查询员工某年度的额度流水
+	ListLeaveQuotaLedger(context.Context, *EmployeeYearRequest) (*ListLeaveQuotaLedgerResponse, error)
 	// UpdateQuota 更新额度
 	UpdateQuota(context.Context, *UpdateQuotaRequest) (*QuotaResponse, error)
 }
@@ -795,6 +891,10 @@ func RegisterLeaveQuotaServiceHTTPServer(s *http.Server, srv LeaveQuotaServiceHT
 	r.POST("/api/v1/hrm/leave-quotas/init", _LeaveQuotaService_InitEmployeeQuota0_HTTP_Handler(srv))
 	r.PUT("/api/v1/hrm/leave-quotas/{id}", _LeaveQuotaService_UpdateQuota0_HTTP_Handler(srv))
 	r.GET("/api/v1/hrm/employees/{employee_id}/leave-quotas", _LeaveQuotaService_GetEmployeeQuotas0_HTTP_Handler(srv))
+	r.GET("/api/v1/hrm/employees/{employee_id}/leave-quotas/{year}/ledger", _LeaveQuotaService_ListLeaveQuotaLedger0_HTTP_Handler(srv))
+	r.POST("/api/v1/hrm/employees/{employee_id}/leave-quotas/{year}/accrue", _LeaveQuotaService_AccrueEmployeeQuota0_HTTP_Handler(srv))
+	r.POST("/api/v1/hrm/leave-quotas/{id}/adjust", _LeaveQuotaService_AdjustLeaveQuota0_HTTP_Handler(srv))
+	r.POST("/api/v1/hrm/leave-quotas/carry-over/{year}", _LeaveQuotaService_CarryOverLeaveQuotas0_HTTP_Handler(srv))
 }
 
 func _LeaveQuotaService_InitEmployeeQuota0_HTTP_Handler(srv LeaveQuotaServiceHTTPServer) func(ctx http.Context) error {
@@ -866,11 +966,116 @@ func _LeaveQuotaService_GetEmployeeQuotas0_HTTP_Handler(srv LeaveQuotaServiceHTT
 	}
 }
 
+func _LeaveQuotaService_ListLeaveQuotaLedger0_HTTP_Handler(srv LeaveQuotaServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EmployeeYearRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLeaveQuotaServiceListLeaveQuotaLedger)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListLeaveQuotaLedger(ctx, req.(*EmployeeYearRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListLeaveQuotaLedgerResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _LeaveQuotaService_AccrueEmployeeQuota0_HTTP_Handler(srv LeaveQuotaServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EmployeeYearRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLeaveQuotaServiceAccrueEmployeeQuota)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AccrueEmployeeQuota(ctx, req.(*EmployeeYearRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LeaveAccrualResultResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _LeaveQuotaService_AdjustLeaveQuota0_HTTP_Handler(srv LeaveQuotaServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdjustLeaveQuotaRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLeaveQuotaServiceAdjustLeaveQuota)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdjustLeaveQuota(ctx, req.(*AdjustLeaveQuotaRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LeaveQuotaLedgerEntry)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _LeaveQuotaService_CarryOverLeaveQuotas0_HTTP_Handler(srv LeaveQuotaServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CarryOverLeaveQuotasRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLeaveQuotaServiceCarryOverLeaveQuotas)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CarryOverLeaveQuotas(ctx, req.(*CarryOverLeaveQuotasRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LeaveAccrualResultResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type LeaveQuotaServiceHTTPClient interface {
+	// AccrueEmployeeQuota 按发放规则立即补齐员工某年度的额度
+	AccrueEmployeeQuota(ctx context.Context, req *EmployeeYearRequest, opts ...http.CallOption) (rsp *LeaveAccrualResultResponse, err error)
+	// AdjustLeaveQuota 人工调整额度（必须填写原因，记入额度流水）
+	AdjustLeaveQuota(ctx context.Context, req *AdjustLeaveQuotaRequest, opts ...http.CallOption) (rsp *LeaveQuotaLedgerEntry, err error)
+	// CarryOverLeaveQuotas 立即执行某年度的年末结转（可重复执行）
+	CarryOverLeaveQuotas(ctx context.Context, req *CarryOverLeaveQuotasRequest, opts ...http.CallOption) (rsp *LeaveAccrualResultResponse, err error)
 	// GetEmployeeQuotas 获取员工额度
 	GetEmployeeQuotas(ctx context.Context, req *GetEmployeeQuotasRequest, opts ...http.CallOption) (rsp *GetEmployeeQuotasResponse, err error)
 	// InitEmployeeQuota 初始化员工额度
 	InitEmployeeQuota(ctx context.Context, req *InitEmployeeQuotaRequest, opts ...http.CallOption) (rsp *InitEmployeeQuotaResponse, err error)
+	// ListLeaveQuotaLedger 查询员工某年度的额度流水
+	ListLeaveQuotaLedger(ctx context.Context, req *EmployeeYearRequest, opts ...http.CallOption) (rsp *ListLeaveQuotaLedgerResponse, err error)
 	// UpdateQuota 更新额度
 	UpdateQuota(ctx context.Context, req *UpdateQuotaRequest, opts ...http.CallOption) (rsp *QuotaResponse, err error)
 }
@@ -883,6 +1088,48 @@ func NewLeaveQuotaServiceHTTPClient(client *http.Client) LeaveQuotaServiceHTTPCl
 	return &LeaveQuotaServiceHTTPClientImpl{client}
 }
 
+// AccrueEmployeeQuota 按发放规则立即补齐员工某年度的额度
+func (c *LeaveQuotaServiceHTTPClientImpl) AccrueEmployeeQuota(ctx context.Context, in *EmployeeYearRequest, opts ...http.CallOption) (*LeaveAccrualResultResponse, error) {
+	var out LeaveAccrualResultResponse
+	pattern := "/api/v1/hrm/employees/{employee_id}/leave-quotas/{year}/accrue"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLeaveQuotaServiceAccrueEmployeeQuota))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdjustLeaveQuota 人工调整额度（必须填写原因，记入额度流水）
+func (c *LeaveQuotaServiceHTTPClientImpl) AdjustLeaveQuota(ctx context.Context, in *AdjustLeaveQuotaRequest, opts ...http.CallOption) (*LeaveQuotaLedgerEntry, error) {
+	var out LeaveQuotaLedgerEntry
+	pattern := "/api/v1/hrm/leave-quotas/{id}/adjust"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLeaveQuotaServiceAdjustLeaveQuota))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CarryOverLeaveQuotas 立即执行某年度的年末结转（可重复执行）
+func (c *LeaveQuotaServiceHTTPClientImpl) CarryOverLeaveQuotas(ctx context.Context, in *CarryOverLeaveQuotasRequest, opts ...http.CallOption) (*LeaveAccrualResultResponse, error) {
+	var out LeaveAccrualResultResponse
+	pattern := "/api/v1/hrm/leave-quotas/carry-over/{year}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLeaveQuotaServiceCarryOverLeaveQuotas))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetEmployeeQuotas 获取员工额度
 func (c *LeaveQuotaServiceHTTPClientImpl) GetEmployeeQuotas(ctx context.Context, in *GetEmployeeQuotasRequest, opts ...http.CallOption) (*GetEmployeeQuotasResponse, error) {
 	var out GetEmployeeQuotasResponse
@@ -911,6 +1158,20 @@ func (c *LeaveQuotaServiceHTTPClientImpl) InitEmployeeQuota(ctx context.Context,
 	return &out, nil
 }
 
+// ListLeaveQuotaLedger 查询员工某年度的额度流水
+func (c *LeaveQuotaServiceHTTPClientImpl) ListLeaveQuotaLedger(ctx context.Context, in *EmployeeYearRequest, opts ...http.CallOption) (*ListLeaveQuotaLedgerResponse, error) {
+	var out ListLeaveQuotaLedgerResponse
+	pattern := "/api/v1/hrm/employees/{employee_id}/leave-quotas/{year}/ledger"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationLeaveQuotaServiceListLeaveQuotaLedger))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // UpdateQuota 更新额度
 func (c *LeaveQuotaServiceHTTPClientImpl) UpdateQuota(ctx context.Context, in *UpdateQuotaRequest, opts ...http.CallOption) (*QuotaResponse, error) {
 	var out QuotaResponse
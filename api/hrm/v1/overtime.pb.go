@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -23,6 +24,280 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 某一加班类型的计算规则
+type OvertimeTypeRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                                      // workday, weekend, holiday
+	PayRate       float64                `protobuf:"fixed64,2,opt,name=pay_rate,json=payRate,proto3" json:"pay_rate,omitempty"`               // 加班费倍率
+	CompOffRate   float64                `protobuf:"fixed64,3,opt,name=comp_off_rate,json=compOffRate,proto3" json:"comp_off_rate,omitempty"` // 调休折算倍率：1 小时加班可调休的小时数
+	Compensation  string                 `protobuf:"bytes,4,opt,name=compensation,proto3" json:"compensation,omitempty"`                      // money, leave, either
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OvertimeTypeRule) Reset() {
+	*x = OvertimeTypeRule{}
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OvertimeTypeRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OvertimeTypeRule) ProtoMessage() {}
+
+func (x *OvertimeTypeRule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OvertimeTypeRule.ProtoReflect.Descriptor instead.
+func (*OvertimeTypeRule) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OvertimeTypeRule) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *OvertimeTypeRule) GetPayRate() float64 {
+	if x != nil {
+		return x.PayRate
+	}
+	return 0
+}
+
+func (x *OvertimeTypeRule) GetCompOffRate() float64 {
+	if x != nil {
+		return x.CompOffRate
+	}
+	return 0
+}
+
+func (x *OvertimeTypeRule) GetCompensation() string {
+	if x != nil {
+		return x.Compensation
+	}
+	return ""
+}
+
+// 加班政策
+type OvertimePolicyResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId            string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Code                string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Name                string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description         string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Rules               []*OvertimeTypeRule    `protobuf:"bytes,6,rep,name=rules,proto3" json:"rules,omitempty"`                                                        // 未配置的类型按法定标准
+	HoursPerDay         float64                `protobuf:"fixed64,7,opt,name=hours_per_day,json=hoursPerDay,proto3" json:"hours_per_day,omitempty"`                     // 调休折算 1 天的小时数
+	MinDurationMinutes  int32                  `protobuf:"varint,8,opt,name=min_duration_minutes,json=minDurationMinutes,proto3" json:"min_duration_minutes,omitempty"` // 单次不足该时长不计加班
+	UnitMinutes         int32                  `protobuf:"varint,9,opt,name=unit_minutes,json=unitMinutes,proto3" json:"unit_minutes,omitempty"`                        // 最小计量单位，0 表示按实际时长
+	Rounding            string                 `protobuf:"bytes,10,opt,name=rounding,proto3" json:"rounding,omitempty"`                                                 // down, up, nearest
+	MonthlyCapHours     float64                `protobuf:"fixed64,11,opt,name=monthly_cap_hours,json=monthlyCapHours,proto3" json:"monthly_cap_hours,omitempty"`        // 0 表示不限
+	YearlyCapHours      float64                `protobuf:"fixed64,12,opt,name=yearly_cap_hours,json=yearlyCapHours,proto3" json:"yearly_cap_hours,omitempty"`
+	BlockOverCap        bool                   `protobuf:"varint,13,opt,name=block_over_cap,json=blockOverCap,proto3" json:"block_over_cap,omitempty"`                      // 超出上限时拒绝
+	AutoDetect          bool                   `protobuf:"varint,14,opt,name=auto_detect,json=autoDetect,proto3" json:"auto_detect,omitempty"`                              // 下班打卡晚于班次结束时自动生成加班记录
+	AutoRequireApproval bool                   `protobuf:"varint,15,opt,name=auto_require_approval,json=autoRequireApproval,proto3" json:"auto_require_approval,omitempty"` // 自动识别的加班是否需要审批
+	IsDefault           bool                   `protobuf:"varint,16,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	IsActive            bool                   `protobuf:"varint,17,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedBy           string                 `protobuf:"bytes,18,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy           string                 `protobuf:"bytes,19,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *OvertimePolicyResponse) Reset() {
+	*x = OvertimePolicyResponse{}
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OvertimePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OvertimePolicyResponse) ProtoMessage() {}
+
+func (x *OvertimePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OvertimePolicyResponse.ProtoReflect.Descriptor instead.
+func (*OvertimePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OvertimePolicyResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OvertimePolicyResponse) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *OvertimePolicyResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *OvertimePolicyResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OvertimePolicyResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *OvertimePolicyResponse) GetRules() []*OvertimeTypeRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *OvertimePolicyResponse) GetHoursPerDay() float64 {
+	if x != nil {
+		return x.HoursPerDay
+	}
+	return 0
+}
+
+func (x *OvertimePolicyResponse) GetMinDurationMinutes() int32 {
+	if x != nil {
+		return x.MinDurationMinutes
+	}
+	return 0
+}
+
+func (x *OvertimePolicyResponse) GetUnitMinutes() int32 {
+	if x != nil {
+		return x.UnitMinutes
+	}
+	return 0
+}
+
+func (x *OvertimePolicyResponse) GetRounding() string {
+	if x != nil {
+		return x.Rounding
+	}
+	return ""
+}
+
+func (x *OvertimePolicyResponse) GetMonthlyCapHours() float64 {
+	if x != nil {
+		return x.MonthlyCapHours
+	}
+	return 0
+}
+
+func (x *OvertimePolicyResponse) GetYearlyCapHours() float64 {
+	if x != nil {
+		return x.YearlyCapHours
+	}
+	return 0
+}
+
+func (x *OvertimePolicyResponse) GetBlockOverCap() bool {
+	if x != nil {
+		return x.BlockOverCap
+	}
+	return false
+}
+
+func (x *OvertimePolicyResponse) GetAutoDetect() bool {
+	if x != nil {
+		return x.AutoDetect
+	}
+	return false
+}
+
+func (x *OvertimePolicyResponse) GetAutoRequireApproval() bool {
+	if x != nil {
+		return x.AutoRequireApproval
+	}
+	return false
+}
+
+func (x *OvertimePolicyResponse) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *OvertimePolicyResponse) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *OvertimePolicyResponse) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *OvertimePolicyResponse) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *OvertimePolicyResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OvertimePolicyResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
 // 加班记录
 type OvertimeResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -55,7 +330,7 @@ type OvertimeResponse struct {
 
 func (x *OvertimeResponse) Reset() {
 	*x = OvertimeResponse{}
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[0]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -67,7 +342,7 @@ func (x *OvertimeResponse) String() string {
 func (*OvertimeResponse) ProtoMessage() {}
 
 func (x *OvertimeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[0]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -80,7 +355,7 @@ func (x *OvertimeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OvertimeResponse.ProtoReflect.Descriptor instead.
 func (*OvertimeResponse) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{0}
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *OvertimeResponse) GetId() string {
@@ -265,7 +540,7 @@ type CreateOvertimeRequest struct {
 
 func (x *CreateOvertimeRequest) Reset() {
 	*x = CreateOvertimeRequest{}
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[1]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -277,7 +552,7 @@ func (x *CreateOvertimeRequest) String() string {
 func (*CreateOvertimeRequest) ProtoMessage() {}
 
 func (x *CreateOvertimeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[1]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -290,7 +565,7 @@ func (x *CreateOvertimeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOvertimeRequest.ProtoReflect.Descriptor instead.
 func (*CreateOvertimeRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{1}
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateOvertimeRequest) GetTenantId() string {
@@ -395,7 +670,7 @@ type UpdateOvertimeRequest struct {
 
 func (x *UpdateOvertimeRequest) Reset() {
 	*x = UpdateOvertimeRequest{}
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[2]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -407,7 +682,7 @@ func (x *UpdateOvertimeRequest) String() string {
 func (*UpdateOvertimeRequest) ProtoMessage() {}
 
 func (x *UpdateOvertimeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[2]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -420,7 +695,7 @@ func (x *UpdateOvertimeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateOvertimeRequest.ProtoReflect.Descriptor instead.
 func (*UpdateOvertimeRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{2}
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UpdateOvertimeRequest) GetId() string {
@@ -496,7 +771,7 @@ type DeleteOvertimeRequest struct {
 
 func (x *DeleteOvertimeRequest) Reset() {
 	*x = DeleteOvertimeRequest{}
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[3]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -508,7 +783,7 @@ func (x *DeleteOvertimeRequest) String() string {
 func (*DeleteOvertimeRequest) ProtoMessage() {}
 
 func (x *DeleteOvertimeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[3]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -521,7 +796,7 @@ func (x *DeleteOvertimeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteOvertimeRequest.ProtoReflect.Descriptor instead.
 func (*DeleteOvertimeRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{3}
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *DeleteOvertimeRequest) GetId() string {
@@ -541,7 +816,7 @@ type DeleteOvertimeResponse struct {
 
 func (x *DeleteOvertimeResponse) Reset() {
 	*x = DeleteOvertimeResponse{}
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[4]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -553,7 +828,7 @@ func (x *DeleteOvertimeResponse) String() string {
 func (*DeleteOvertimeResponse) ProtoMessage() {}
 
 func (x *DeleteOvertimeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[4]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -566,7 +841,7 @@ func (x *DeleteOvertimeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteOvertimeResponse.ProtoReflect.Descriptor instead.
 func (*DeleteOvertimeResponse) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{4}
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DeleteOvertimeResponse) GetSuccess() bool {
@@ -593,7 +868,7 @@ type GetOvertimeRequest struct {
 
 func (x *GetOvertimeRequest) Reset() {
 	*x = GetOvertimeRequest{}
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[5]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -605,7 +880,7 @@ func (x *GetOvertimeRequest) String() string {
 func (*GetOvertimeRequest) ProtoMessage() {}
 
 func (x *GetOvertimeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[5]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -618,7 +893,7 @@ func (x *GetOvertimeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOvertimeRequest.ProtoReflect.Descriptor instead.
 func (*GetOvertimeRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{5}
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetOvertimeRequest) GetId() string {
@@ -647,7 +922,7 @@ type ListOvertimesRequest struct {
 
 func (x *ListOvertimesRequest) Reset() {
 	*x = ListOvertimesRequest{}
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[6]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -659,7 +934,7 @@ func (x *ListOvertimesRequest) String() string {
 func (*ListOvertimesRequest) ProtoMessage() {}
 
 func (x *ListOvertimesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[6]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -672,7 +947,7 @@ func (x *ListOvertimesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOvertimesRequest.ProtoReflect.Descriptor instead.
 func (*ListOvertimesRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{6}
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListOvertimesRequest) GetTenantId() string {
@@ -757,7 +1032,7 @@ type ListOvertimesResponse struct {
 
 func (x *ListOvertimesResponse) Reset() {
 	*x = ListOvertimesResponse{}
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[7]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -769,7 +1044,7 @@ func (x *ListOvertimesResponse) String() string {
 func (*ListOvertimesResponse) ProtoMessage() {}
 
 func (x *ListOvertimesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[7]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -782,7 +1057,7 @@ func (x *ListOvertimesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOvertimesResponse.ProtoReflect.Descriptor instead.
 func (*ListOvertimesResponse) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{7}
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListOvertimesResponse) GetItems() []*OvertimeResponse {
@@ -825,7 +1100,7 @@ type ListEmployeeOvertimesRequest struct {
 
 func (x *ListEmployeeOvertimesRequest) Reset() {
 	*x = ListEmployeeOvertimesRequest{}
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[8]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -837,7 +1112,7 @@ func (x *ListEmployeeOvertimesRequest) String() string {
 func (*ListEmployeeOvertimesRequest) ProtoMessage() {}
 
 func (x *ListEmployeeOvertimesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[8]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -850,7 +1125,7 @@ func (x *ListEmployeeOvertimesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEmployeeOvertimesRequest.ProtoReflect.Descriptor instead.
 func (*ListEmployeeOvertimesRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{8}
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListEmployeeOvertimesRequest) GetTenantId() string {
@@ -884,7 +1159,7 @@ type ListPendingOvertimesRequest struct {
 
 func (x *ListPendingOvertimesRequest) Reset() {
 	*x = ListPendingOvertimesRequest{}
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[9]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -896,7 +1171,7 @@ func (x *ListPendingOvertimesRequest) String() string {
 func (*ListPendingOvertimesRequest) ProtoMessage() {}
 
 func (x *ListPendingOvertimesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[9]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -909,7 +1184,7 @@ func (x *ListPendingOvertimesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPendingOvertimesRequest.ProtoReflect.Descriptor instead.
 func (*ListPendingOvertimesRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{9}
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListPendingOvertimesRequest) GetTenantId() string {
@@ -930,7 +1205,7 @@ type SubmitOvertimeRequest struct {
 
 func (x *SubmitOvertimeRequest) Reset() {
 	*x = SubmitOvertimeRequest{}
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[10]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -942,7 +1217,7 @@ func (x *SubmitOvertimeRequest) String() string {
 func (*SubmitOvertimeRequest) ProtoMessage() {}
 
 func (x *SubmitOvertimeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[10]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -955,7 +1230,7 @@ func (x *SubmitOvertimeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitOvertimeRequest.ProtoReflect.Descriptor instead.
 func (*SubmitOvertimeRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{10}
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SubmitOvertimeRequest) GetOvertimeId() string {
@@ -982,7 +1257,7 @@ type SubmitOvertimeResponse struct {
 
 func (x *SubmitOvertimeResponse) Reset() {
 	*x = SubmitOvertimeResponse{}
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[11]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -994,7 +1269,7 @@ func (x *SubmitOvertimeResponse) String() string {
 func (*SubmitOvertimeResponse) ProtoMessage() {}
 
 func (x *SubmitOvertimeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[11]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1007,7 +1282,7 @@ func (x *SubmitOvertimeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitOvertimeResponse.ProtoReflect.Descriptor instead.
 func (*SubmitOvertimeResponse) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{11}
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *SubmitOvertimeResponse) GetSuccess() bool {
@@ -1035,7 +1310,7 @@ type ApproveOvertimeRequest struct {
 
 func (x *ApproveOvertimeRequest) Reset() {
 	*x = ApproveOvertimeRequest{}
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[12]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1047,7 +1322,7 @@ func (x *ApproveOvertimeRequest) String() string {
 func (*ApproveOvertimeRequest) ProtoMessage() {}
 
 func (x *ApproveOvertimeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[12]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1060,7 +1335,7 @@ func (x *ApproveOvertimeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApproveOvertimeRequest.ProtoReflect.Descriptor instead.
 func (*ApproveOvertimeRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{12}
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ApproveOvertimeRequest) GetOvertimeId() string {
@@ -1087,7 +1362,7 @@ type ApproveOvertimeResponse struct {
 
 func (x *ApproveOvertimeResponse) Reset() {
 	*x = ApproveOvertimeResponse{}
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[13]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1099,7 +1374,7 @@ func (x *ApproveOvertimeResponse) String() string {
 func (*ApproveOvertimeResponse) ProtoMessage() {}
 
 func (x *ApproveOvertimeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[13]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1112,7 +1387,7 @@ func (x *ApproveOvertimeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApproveOvertimeResponse.ProtoReflect.Descriptor instead.
 func (*ApproveOvertimeResponse) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{13}
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ApproveOvertimeResponse) GetSuccess() bool {
@@ -1141,7 +1416,7 @@ type RejectOvertimeRequest struct {
 
 func (x *RejectOvertimeRequest) Reset() {
 	*x = RejectOvertimeRequest{}
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[14]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1153,7 +1428,7 @@ func (x *RejectOvertimeRequest) String() string {
 func (*RejectOvertimeRequest) ProtoMessage() {}
 
 func (x *RejectOvertimeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[14]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1166,7 +1441,7 @@ func (x *RejectOvertimeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RejectOvertimeRequest.ProtoReflect.Descriptor instead.
 func (*RejectOvertimeRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{14}
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *RejectOvertimeRequest) GetOvertimeId() string {
@@ -1200,7 +1475,7 @@ type RejectOvertimeResponse struct {
 
 func (x *RejectOvertimeResponse) Reset() {
 	*x = RejectOvertimeResponse{}
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[15]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1212,7 +1487,7 @@ func (x *RejectOvertimeResponse) String() string {
 func (*RejectOvertimeResponse) ProtoMessage() {}
 
 func (x *RejectOvertimeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[15]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1225,7 +1500,7 @@ func (x *RejectOvertimeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RejectOvertimeResponse.ProtoReflect.Descriptor instead.
 func (*RejectOvertimeResponse) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{15}
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *RejectOvertimeResponse) GetSuccess() bool {
@@ -1255,7 +1530,7 @@ type SumOvertimeHoursRequest struct {
 
 func (x *SumOvertimeHoursRequest) Reset() {
 	*x = SumOvertimeHoursRequest{}
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[16]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1267,7 +1542,7 @@ func (x *SumOvertimeHoursRequest) String() string {
 func (*SumOvertimeHoursRequest) ProtoMessage() {}
 
 func (x *SumOvertimeHoursRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[16]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1280,7 +1555,7 @@ func (x *SumOvertimeHoursRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SumOvertimeHoursRequest.ProtoReflect.Descriptor instead.
 func (*SumOvertimeHoursRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{16}
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *SumOvertimeHoursRequest) GetTenantId() string {
@@ -1320,7 +1595,7 @@ type SumOvertimeHoursResponse struct {
 
 func (x *SumOvertimeHoursResponse) Reset() {
 	*x = SumOvertimeHoursResponse{}
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[17]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1332,7 +1607,7 @@ func (x *SumOvertimeHoursResponse) String() string {
 func (*SumOvertimeHoursResponse) ProtoMessage() {}
 
 func (x *SumOvertimeHoursResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[17]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1345,7 +1620,7 @@ func (x *SumOvertimeHoursResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SumOvertimeHoursResponse.ProtoReflect.Descriptor instead.
 func (*SumOvertimeHoursResponse) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{17}
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SumOvertimeHoursResponse) GetTotalHours() float64 {
@@ -1366,7 +1641,7 @@ type GetCompOffDaysRequest struct {
 
 func (x *GetCompOffDaysRequest) Reset() {
 	*x = GetCompOffDaysRequest{}
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[18]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1378,7 +1653,7 @@ func (x *GetCompOffDaysRequest) String() string {
 func (*GetCompOffDaysRequest) ProtoMessage() {}
 
 func (x *GetCompOffDaysRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[18]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1391,7 +1666,7 @@ func (x *GetCompOffDaysRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCompOffDaysRequest.ProtoReflect.Descriptor instead.
 func (*GetCompOffDaysRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{18}
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetCompOffDaysRequest) GetTenantId() string {
@@ -1417,7 +1692,7 @@ type GetCompOffDaysResponse struct {
 
 func (x *GetCompOffDaysResponse) Reset() {
 	*x = GetCompOffDaysResponse{}
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[19]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1429,7 +1704,7 @@ func (x *GetCompOffDaysResponse) String() string {
 func (*GetCompOffDaysResponse) ProtoMessage() {}
 
 func (x *GetCompOffDaysResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[19]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1442,7 +1717,7 @@ func (x *GetCompOffDaysResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCompOffDaysResponse.ProtoReflect.Descriptor instead.
 func (*GetCompOffDaysResponse) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{19}
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetCompOffDaysResponse) GetAvailableDays() float64 {
@@ -1464,7 +1739,7 @@ type UseCompOffDaysRequest struct {
 
 func (x *UseCompOffDaysRequest) Reset() {
 	*x = UseCompOffDaysRequest{}
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[20]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1476,7 +1751,7 @@ func (x *UseCompOffDaysRequest) String() string {
 func (*UseCompOffDaysRequest) ProtoMessage() {}
 
 func (x *UseCompOffDaysRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[20]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1489,7 +1764,7 @@ func (x *UseCompOffDaysRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UseCompOffDaysRequest.ProtoReflect.Descriptor instead.
 func (*UseCompOffDaysRequest) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{20}
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *UseCompOffDaysRequest) GetTenantId() string {
@@ -1524,7 +1799,7 @@ type UseCompOffDaysResponse struct {
 
 func (x *UseCompOffDaysResponse) Reset() {
 	*x = UseCompOffDaysResponse{}
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[21]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1536,7 +1811,7 @@ func (x *UseCompOffDaysResponse) String() string {
 func (*UseCompOffDaysResponse) ProtoMessage() {}
 
 func (x *UseCompOffDaysResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hrm_v1_overtime_proto_msgTypes[21]
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1549,7 +1824,7 @@ func (x *UseCompOffDaysResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UseCompOffDaysResponse.ProtoReflect.Descriptor instead.
 func (*UseCompOffDaysResponse) Descriptor() ([]byte, []int) {
-	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{21}
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *UseCompOffDaysResponse) GetSuccess() bool {
@@ -1573,12 +1848,476 @@ func (x *UseCompOffDaysResponse) GetRemainingDays() float64 {
 	return 0
 }
 
+// 创建/更新加班政策
+type OvertimePolicyRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code                string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name                string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description         string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Rules               []*OvertimeTypeRule    `protobuf:"bytes,5,rep,name=rules,proto3" json:"rules,omitempty"`
+	HoursPerDay         float64                `protobuf:"fixed64,6,opt,name=hours_per_day,json=hoursPerDay,proto3" json:"hours_per_day,omitempty"`
+	MinDurationMinutes  int32                  `protobuf:"varint,7,opt,name=min_duration_minutes,json=minDurationMinutes,proto3" json:"min_duration_minutes,omitempty"`
+	UnitMinutes         int32                  `protobuf:"varint,8,opt,name=unit_minutes,json=unitMinutes,proto3" json:"unit_minutes,omitempty"`
+	Rounding            string                 `protobuf:"bytes,9,opt,name=rounding,proto3" json:"rounding,omitempty"`
+	MonthlyCapHours     float64                `protobuf:"fixed64,10,opt,name=monthly_cap_hours,json=monthlyCapHours,proto3" json:"monthly_cap_hours,omitempty"`
+	YearlyCapHours      float64                `protobuf:"fixed64,11,opt,name=yearly_cap_hours,json=yearlyCapHours,proto3" json:"yearly_cap_hours,omitempty"`
+	BlockOverCap        bool                   `protobuf:"varint,12,opt,name=block_over_cap,json=blockOverCap,proto3" json:"block_over_cap,omitempty"`
+	AutoDetect          bool                   `protobuf:"varint,13,opt,name=auto_detect,json=autoDetect,proto3" json:"auto_detect,omitempty"`
+	AutoRequireApproval *bool                  `protobuf:"varint,14,opt,name=auto_require_approval,json=autoRequireApproval,proto3,oneof" json:"auto_require_approval,omitempty"` // 不传时默认需要审批
+	IsDefault           bool                   `protobuf:"varint,15,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	IsActive            *bool                  `protobuf:"varint,16,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *OvertimePolicyRequest) Reset() {
+	*x = OvertimePolicyRequest{}
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OvertimePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OvertimePolicyRequest) ProtoMessage() {}
+
+func (x *OvertimePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OvertimePolicyRequest.ProtoReflect.Descriptor instead.
+func (*OvertimePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *OvertimePolicyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OvertimePolicyRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *OvertimePolicyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OvertimePolicyRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *OvertimePolicyRequest) GetRules() []*OvertimeTypeRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *OvertimePolicyRequest) GetHoursPerDay() float64 {
+	if x != nil {
+		return x.HoursPerDay
+	}
+	return 0
+}
+
+func (x *OvertimePolicyRequest) GetMinDurationMinutes() int32 {
+	if x != nil {
+		return x.MinDurationMinutes
+	}
+	return 0
+}
+
+func (x *OvertimePolicyRequest) GetUnitMinutes() int32 {
+	if x != nil {
+		return x.UnitMinutes
+	}
+	return 0
+}
+
+func (x *OvertimePolicyRequest) GetRounding() string {
+	if x != nil {
+		return x.Rounding
+	}
+	return ""
+}
+
+func (x *OvertimePolicyRequest) GetMonthlyCapHours() float64 {
+	if x != nil {
+		return x.MonthlyCapHours
+	}
+	return 0
+}
+
+func (x *OvertimePolicyRequest) GetYearlyCapHours() float64 {
+	if x != nil {
+		return x.YearlyCapHours
+	}
+	return 0
+}
+
+func (x *OvertimePolicyRequest) GetBlockOverCap() bool {
+	if x != nil {
+		return x.BlockOverCap
+	}
+	return false
+}
+
+func (x *OvertimePolicyRequest) GetAutoDetect() bool {
+	if x != nil {
+		return x.AutoDetect
+	}
+	return false
+}
+
+func (x *OvertimePolicyRequest) GetAutoRequireApproval() bool {
+	if x != nil && x.AutoRequireApproval != nil {
+		return *x.AutoRequireApproval
+	}
+	return false
+}
+
+func (x *OvertimePolicyRequest) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *OvertimePolicyRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+type GetOvertimePolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOvertimePolicyRequest) Reset() {
+	*x = GetOvertimePolicyRequest{}
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOvertimePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOvertimePolicyRequest) ProtoMessage() {}
+
+func (x *GetOvertimePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOvertimePolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetOvertimePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetOvertimePolicyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteOvertimePolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOvertimePolicyRequest) Reset() {
+	*x = DeleteOvertimePolicyRequest{}
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOvertimePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOvertimePolicyRequest) ProtoMessage() {}
+
+func (x *DeleteOvertimePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOvertimePolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOvertimePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteOvertimePolicyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListOvertimePoliciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOvertimePoliciesRequest) Reset() {
+	*x = ListOvertimePoliciesRequest{}
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOvertimePoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOvertimePoliciesRequest) ProtoMessage() {}
+
+func (x *ListOvertimePoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOvertimePoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListOvertimePoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{27}
+}
+
+type ListOvertimePoliciesResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Items         []*OvertimePolicyResponse `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOvertimePoliciesResponse) Reset() {
+	*x = ListOvertimePoliciesResponse{}
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOvertimePoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOvertimePoliciesResponse) ProtoMessage() {}
+
+func (x *ListOvertimePoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOvertimePoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListOvertimePoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListOvertimePoliciesResponse) GetItems() []*OvertimePolicyResponse {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 考勤规则关联加班政策（policy_id 为空表示取消关联）
+type AssignOvertimePolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PolicyId      string                 `protobuf:"bytes,2,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignOvertimePolicyRequest) Reset() {
+	*x = AssignOvertimePolicyRequest{}
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignOvertimePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignOvertimePolicyRequest) ProtoMessage() {}
+
+func (x *AssignOvertimePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignOvertimePolicyRequest.ProtoReflect.Descriptor instead.
+func (*AssignOvertimePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AssignOvertimePolicyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AssignOvertimePolicyRequest) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
+}
+
+type ResolveOvertimePolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveOvertimePolicyRequest) Reset() {
+	*x = ResolveOvertimePolicyRequest{}
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveOvertimePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveOvertimePolicyRequest) ProtoMessage() {}
+
+func (x *ResolveOvertimePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_overtime_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveOvertimePolicyRequest.ProtoReflect.Descriptor instead.
+func (*ResolveOvertimePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_overtime_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ResolveOvertimePolicyRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
 var File_api_hrm_v1_overtime_proto protoreflect.FileDescriptor
 
 const file_api_hrm_v1_overtime_proto_rawDesc = "" +
 	"\n" +
 	"\x19api/hrm/v1/overtime.proto\x12\n" +
-	"api.hrm.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8c\a\n" +
+	"api.hrm.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bapi/hrm/v1/attendance.proto\"\x89\x01\n" +
+	"\x10OvertimeTypeRule\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x19\n" +
+	"\bpay_rate\x18\x02 \x01(\x01R\apayRate\x12\"\n" +
+	"\rcomp_off_rate\x18\x03 \x01(\x01R\vcompOffRate\x12\"\n" +
+	"\fcompensation\x18\x04 \x01(\tR\fcompensation\"\x99\x06\n" +
+	"\x16OvertimePolicyResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x122\n" +
+	"\x05rules\x18\x06 \x03(\v2\x1c.api.hrm.v1.OvertimeTypeRuleR\x05rules\x12\"\n" +
+	"\rhours_per_day\x18\a \x01(\x01R\vhoursPerDay\x120\n" +
+	"\x14min_duration_minutes\x18\b \x01(\x05R\x12minDurationMinutes\x12!\n" +
+	"\funit_minutes\x18\t \x01(\x05R\vunitMinutes\x12\x1a\n" +
+	"\brounding\x18\n" +
+	" \x01(\tR\brounding\x12*\n" +
+	"\x11monthly_cap_hours\x18\v \x01(\x01R\x0fmonthlyCapHours\x12(\n" +
+	"\x10yearly_cap_hours\x18\f \x01(\x01R\x0eyearlyCapHours\x12$\n" +
+	"\x0eblock_over_cap\x18\r \x01(\bR\fblockOverCap\x12\x1f\n" +
+	"\vauto_detect\x18\x0e \x01(\bR\n" +
+	"autoDetect\x122\n" +
+	"\x15auto_require_approval\x18\x0f \x01(\bR\x13autoRequireApproval\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x10 \x01(\bR\tisDefault\x12\x1b\n" +
+	"\tis_active\x18\x11 \x01(\bR\bisActive\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x12 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x13 \x01(\tR\tupdatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x8c\a\n" +
 	"\x10OvertimeResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x1f\n" +
@@ -1718,7 +2457,43 @@ const file_api_hrm_v1_overtime_proto_rawDesc = "" +
 	"\x16UseCompOffDaysResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12%\n" +
-	"\x0eremaining_days\x18\x03 \x01(\x01R\rremainingDays2\x91\x0e\n" +
+	"\x0eremaining_days\x18\x03 \x01(\x01R\rremainingDays\"\xf9\x04\n" +
+	"\x15OvertimePolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x122\n" +
+	"\x05rules\x18\x05 \x03(\v2\x1c.api.hrm.v1.OvertimeTypeRuleR\x05rules\x12\"\n" +
+	"\rhours_per_day\x18\x06 \x01(\x01R\vhoursPerDay\x120\n" +
+	"\x14min_duration_minutes\x18\a \x01(\x05R\x12minDurationMinutes\x12!\n" +
+	"\funit_minutes\x18\b \x01(\x05R\vunitMinutes\x12\x1a\n" +
+	"\brounding\x18\t \x01(\tR\brounding\x12*\n" +
+	"\x11monthly_cap_hours\x18\n" +
+	" \x01(\x01R\x0fmonthlyCapHours\x12(\n" +
+	"\x10yearly_cap_hours\x18\v \x01(\x01R\x0eyearlyCapHours\x12$\n" +
+	"\x0eblock_over_cap\x18\f \x01(\bR\fblockOverCap\x12\x1f\n" +
+	"\vauto_detect\x18\r \x01(\bR\n" +
+	"autoDetect\x127\n" +
+	"\x15auto_require_approval\x18\x0e \x01(\bH\x00R\x13autoRequireApproval\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x0f \x01(\bR\tisDefault\x12 \n" +
+	"\tis_active\x18\x10 \x01(\bH\x01R\bisActive\x88\x01\x01B\x18\n" +
+	"\x16_auto_require_approvalB\f\n" +
+	"\n" +
+	"_is_active\"*\n" +
+	"\x18GetOvertimePolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"-\n" +
+	"\x1bDeleteOvertimePolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1d\n" +
+	"\x1bListOvertimePoliciesRequest\"X\n" +
+	"\x1cListOvertimePoliciesResponse\x128\n" +
+	"\x05items\x18\x01 \x03(\v2\".api.hrm.v1.OvertimePolicyResponseR\x05items\"J\n" +
+	"\x1bAssignOvertimePolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tpolicy_id\x18\x02 \x01(\tR\bpolicyId\"?\n" +
+	"\x1cResolveOvertimePolicyRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\tR\n" +
+	"employeeId2\x91\x0e\n" +
 	"\x0fOvertimeService\x12s\n" +
 	"\x0eCreateOvertime\x12!.api.hrm.v1.CreateOvertimeRequest\x1a\x1c.api.hrm.v1.OvertimeResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/hrm/overtimes\x12x\n" +
 	"\x0eUpdateOvertime\x12!.api.hrm.v1.UpdateOvertimeRequest\x1a\x1c.api.hrm.v1.OvertimeResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\x1a\x1a/api/v1/hrm/overtimes/{id}\x12{\n" +
@@ -1732,7 +2507,15 @@ const file_api_hrm_v1_overtime_proto_rawDesc = "" +
 	"\x0eRejectOvertime\x12!.api.hrm.v1.RejectOvertimeRequest\x1a\".api.hrm.v1.RejectOvertimeResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/hrm/overtimes/{overtime_id}/reject\x12\x99\x01\n" +
 	"\x10SumOvertimeHours\x12#.api.hrm.v1.SumOvertimeHoursRequest\x1a$.api.hrm.v1.SumOvertimeHoursResponse\":\x82\xd3\xe4\x93\x024\x122/api/v1/hrm/employees/{employee_id}/overtime-hours\x12\x92\x01\n" +
 	"\x0eGetCompOffDays\x12!.api.hrm.v1.GetCompOffDaysRequest\x1a\".api.hrm.v1.GetCompOffDaysResponse\"9\x82\xd3\xe4\x93\x023\x121/api/v1/hrm/employees/{employee_id}/comp-off-days\x12\x99\x01\n" +
-	"\x0eUseCompOffDays\x12!.api.hrm.v1.UseCompOffDaysRequest\x1a\".api.hrm.v1.UseCompOffDaysResponse\"@\x82\xd3\xe4\x93\x02::\x01*\"5/api/v1/hrm/employees/{employee_id}/comp-off-days/useB\x9f\x01\n" +
+	"\x0eUseCompOffDays\x12!.api.hrm.v1.UseCompOffDaysRequest\x1a\".api.hrm.v1.UseCompOffDaysResponse\"@\x82\xd3\xe4\x93\x02::\x01*\"5/api/v1/hrm/employees/{employee_id}/comp-off-days/use2\x9e\b\n" +
+	"\x15OvertimePolicyService\x12\x87\x01\n" +
+	"\x14CreateOvertimePolicy\x12!.api.hrm.v1.OvertimePolicyRequest\x1a\".api.hrm.v1.OvertimePolicyResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/hrm/overtime-policies\x12\x90\x01\n" +
+	"\x14ListOvertimePolicies\x12'.api.hrm.v1.ListOvertimePoliciesRequest\x1a(.api.hrm.v1.ListOvertimePoliciesResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/hrm/overtime-policies\x12\x89\x01\n" +
+	"\x11GetOvertimePolicy\x12$.api.hrm.v1.GetOvertimePolicyRequest\x1a\".api.hrm.v1.OvertimePolicyResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/hrm/overtime-policies/{id}\x12\x8c\x01\n" +
+	"\x14UpdateOvertimePolicy\x12!.api.hrm.v1.OvertimePolicyRequest\x1a\".api.hrm.v1.OvertimePolicyResponse\"-\x82\xd3\xe4\x93\x02':\x01*\x1a\"/api/v1/hrm/overtime-policies/{id}\x12\x83\x01\n" +
+	"\x14DeleteOvertimePolicy\x12'.api.hrm.v1.DeleteOvertimePolicyRequest\x1a\x16.google.protobuf.Empty\"*\x82\xd3\xe4\x93\x02$*\"/api/v1/hrm/overtime-policies/{id}\x12\xa1\x01\n" +
+	"\x14AssignOvertimePolicy\x12'.api.hrm.v1.AssignOvertimePolicyRequest\x1a\".api.hrm.v1.AttendanceRuleResponse\"<\x82\xd3\xe4\x93\x026:\x01*\x1a1/api/v1/hrm/attendance-rules/{id}/overtime-policy\x12\xa2\x01\n" +
+	"\x15ResolveOvertimePolicy\x12(.api.hrm.v1.ResolveOvertimePolicyRequest\x1a\".api.hrm.v1.OvertimePolicyResponse\";\x82\xd3\xe4\x93\x025\x123/api/v1/hrm/employees/{employee_id}/overtime-policyB\x9f\x01\n" +
 	"\x0ecom.api.hrm.v1B\rOvertimeProtoP\x01Z4github.com/lk2023060901/go-next-erp/api/hrm/v1;hrmv1\xa2\x02\x03AHX\xaa\x02\n" +
 	"Api.Hrm.V1\xca\x02\n" +
 	"Api\\Hrm\\V1\xe2\x02\x16Api\\Hrm\\V1\\GPBMetadata\xea\x02\fApi::Hrm::V1b\x06proto3"
@@ -1749,79 +2532,109 @@ func file_api_hrm_v1_overtime_proto_rawDescGZIP() []byte {
 	return file_api_hrm_v1_overtime_proto_rawDescData
 }
 
-var file_api_hrm_v1_overtime_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_api_hrm_v1_overtime_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_api_hrm_v1_overtime_proto_goTypes = []any{
-	(*OvertimeResponse)(nil),             // 0: api.hrm.v1.OvertimeResponse
-	(*CreateOvertimeRequest)(nil),        // 1: api.hrm.v1.CreateOvertimeRequest
-	(*UpdateOvertimeRequest)(nil),        // 2: api.hrm.v1.UpdateOvertimeRequest
-	(*DeleteOvertimeRequest)(nil),        // 3: api.hrm.v1.DeleteOvertimeRequest
-	(*DeleteOvertimeResponse)(nil),       // 4: api.hrm.v1.DeleteOvertimeResponse
-	(*GetOvertimeRequest)(nil),           // 5: api.hrm.v1.GetOvertimeRequest
-	(*ListOvertimesRequest)(nil),         // 6: api.hrm.v1.ListOvertimesRequest
-	(*ListOvertimesResponse)(nil),        // 7: api.hrm.v1.ListOvertimesResponse
-	(*ListEmployeeOvertimesRequest)(nil), // 8: api.hrm.v1.ListEmployeeOvertimesRequest
-	(*ListPendingOvertimesRequest)(nil),  // 9: api.hrm.v1.ListPendingOvertimesRequest
-	(*SubmitOvertimeRequest)(nil),        // 10: api.hrm.v1.SubmitOvertimeRequest
-	(*SubmitOvertimeResponse)(nil),       // 11: api.hrm.v1.SubmitOvertimeResponse
-	(*ApproveOvertimeRequest)(nil),       // 12: api.hrm.v1.ApproveOvertimeRequest
-	(*ApproveOvertimeResponse)(nil),      // 13: api.hrm.v1.ApproveOvertimeResponse
-	(*RejectOvertimeRequest)(nil),        // 14: api.hrm.v1.RejectOvertimeRequest
-	(*RejectOvertimeResponse)(nil),       // 15: api.hrm.v1.RejectOvertimeResponse
-	(*SumOvertimeHoursRequest)(nil),      // 16: api.hrm.v1.SumOvertimeHoursRequest
-	(*SumOvertimeHoursResponse)(nil),     // 17: api.hrm.v1.SumOvertimeHoursResponse
-	(*GetCompOffDaysRequest)(nil),        // 18: api.hrm.v1.GetCompOffDaysRequest
-	(*GetCompOffDaysResponse)(nil),       // 19: api.hrm.v1.GetCompOffDaysResponse
-	(*UseCompOffDaysRequest)(nil),        // 20: api.hrm.v1.UseCompOffDaysRequest
-	(*UseCompOffDaysResponse)(nil),       // 21: api.hrm.v1.UseCompOffDaysResponse
-	(*timestamppb.Timestamp)(nil),        // 22: google.protobuf.Timestamp
+	(*OvertimeTypeRule)(nil),             // 0: api.hrm.v1.OvertimeTypeRule
+	(*OvertimePolicyResponse)(nil),       // 1: api.hrm.v1.OvertimePolicyResponse
+	(*OvertimeResponse)(nil),             // 2: api.hrm.v1.OvertimeResponse
+	(*CreateOvertimeRequest)(nil),        // 3: api.hrm.v1.CreateOvertimeRequest
+	(*UpdateOvertimeRequest)(nil),        // 4: api.hrm.v1.UpdateOvertimeRequest
+	(*DeleteOvertimeRequest)(nil),        // 5: api.hrm.v1.DeleteOvertimeRequest
+	(*DeleteOvertimeResponse)(nil),       // 6: api.hrm.v1.DeleteOvertimeResponse
+	(*GetOvertimeRequest)(nil),           // 7: api.hrm.v1.GetOvertimeRequest
+	(*ListOvertimesRequest)(nil),         // 8: api.hrm.v1.ListOvertimesRequest
+	(*ListOvertimesResponse)(nil),        // 9: api.hrm.v1.ListOvertimesResponse
+	(*ListEmployeeOvertimesRequest)(nil), // 10: api.hrm.v1.ListEmployeeOvertimesRequest
+	(*ListPendingOvertimesRequest)(nil),  // 11: api.hrm.v1.ListPendingOvertimesRequest
+	(*SubmitOvertimeRequest)(nil),        // 12: api.hrm.v1.SubmitOvertimeRequest
+	(*SubmitOvertimeResponse)(nil),       // 13: api.hrm.v1.SubmitOvertimeResponse
+	(*ApproveOvertimeRequest)(nil),       // 14: api.hrm.v1.ApproveOvertimeRequest
+	(*ApproveOvertimeResponse)(nil),      // 15: api.hrm.v1.ApproveOvertimeResponse
+	(*RejectOvertimeRequest)(nil),        // 16: api.hrm.v1.RejectOvertimeRequest
+	(*RejectOvertimeResponse)(nil),       // 17: api.hrm.v1.RejectOvertimeResponse
+	(*SumOvertimeHoursRequest)(nil),      // 18: api.hrm.v1.SumOvertimeHoursRequest
+	(*SumOvertimeHoursResponse)(nil),     // 19: api.hrm.v1.SumOvertimeHoursResponse
+	(*GetCompOffDaysRequest)(nil),        // 20: api.hrm.v1.GetCompOffDaysRequest
+	(*GetCompOffDaysResponse)(nil),       // 21: api.hrm.v1.GetCompOffDaysResponse
+	(*UseCompOffDaysRequest)(nil),        // 22: api.hrm.v1.UseCompOffDaysRequest
+	(*UseCompOffDaysResponse)(nil),       // 23: api.hrm.v1.UseCompOffDaysResponse
+	(*OvertimePolicyRequest)(nil),        // 24: api.hrm.v1.OvertimePolicyRequest
+	(*GetOvertimePolicyRequest)(nil),     // 25: api.hrm.v1.GetOvertimePolicyRequest
+	(*DeleteOvertimePolicyRequest)(nil),  // 26: api.hrm.v1.DeleteOvertimePolicyRequest
+	(*ListOvertimePoliciesRequest)(nil),  // 27: api.hrm.v1.ListOvertimePoliciesRequest
+	(*ListOvertimePoliciesResponse)(nil), // 28: api.hrm.v1.ListOvertimePoliciesResponse
+	(*AssignOvertimePolicyRequest)(nil),  // 29: api.hrm.v1.AssignOvertimePolicyRequest
+	(*ResolveOvertimePolicyRequest)(nil), // 30: api.hrm.v1.ResolveOvertimePolicyRequest
+	(*timestamppb.Timestamp)(nil),        // 31: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                // 32: google.protobuf.Empty
+	(*AttendanceRuleResponse)(nil),       // 33: api.hrm.v1.AttendanceRuleResponse
 }
 var file_api_hrm_v1_overtime_proto_depIdxs = []int32{
-	22, // 0: api.hrm.v1.OvertimeResponse.start_time:type_name -> google.protobuf.Timestamp
-	22, // 1: api.hrm.v1.OvertimeResponse.end_time:type_name -> google.protobuf.Timestamp
-	22, // 2: api.hrm.v1.OvertimeResponse.approved_at:type_name -> google.protobuf.Timestamp
-	22, // 3: api.hrm.v1.OvertimeResponse.comp_off_expire_at:type_name -> google.protobuf.Timestamp
-	22, // 4: api.hrm.v1.OvertimeResponse.created_at:type_name -> google.protobuf.Timestamp
-	22, // 5: api.hrm.v1.OvertimeResponse.updated_at:type_name -> google.protobuf.Timestamp
-	22, // 6: api.hrm.v1.CreateOvertimeRequest.start_time:type_name -> google.protobuf.Timestamp
-	22, // 7: api.hrm.v1.CreateOvertimeRequest.end_time:type_name -> google.protobuf.Timestamp
-	22, // 8: api.hrm.v1.UpdateOvertimeRequest.start_time:type_name -> google.protobuf.Timestamp
-	22, // 9: api.hrm.v1.UpdateOvertimeRequest.end_time:type_name -> google.protobuf.Timestamp
-	22, // 10: api.hrm.v1.ListOvertimesRequest.start_date:type_name -> google.protobuf.Timestamp
-	22, // 11: api.hrm.v1.ListOvertimesRequest.end_date:type_name -> google.protobuf.Timestamp
-	0,  // 12: api.hrm.v1.ListOvertimesResponse.items:type_name -> api.hrm.v1.OvertimeResponse
-	22, // 13: api.hrm.v1.SumOvertimeHoursRequest.start_date:type_name -> google.protobuf.Timestamp
-	22, // 14: api.hrm.v1.SumOvertimeHoursRequest.end_date:type_name -> google.protobuf.Timestamp
-	1,  // 15: api.hrm.v1.OvertimeService.CreateOvertime:input_type -> api.hrm.v1.CreateOvertimeRequest
-	2,  // 16: api.hrm.v1.OvertimeService.UpdateOvertime:input_type -> api.hrm.v1.UpdateOvertimeRequest
-	3,  // 17: api.hrm.v1.OvertimeService.DeleteOvertime:input_type -> api.hrm.v1.DeleteOvertimeRequest
-	5,  // 18: api.hrm.v1.OvertimeService.GetOvertime:input_type -> api.hrm.v1.GetOvertimeRequest
-	6,  // 19: api.hrm.v1.OvertimeService.ListOvertimes:input_type -> api.hrm.v1.ListOvertimesRequest
-	8,  // 20: api.hrm.v1.OvertimeService.ListEmployeeOvertimes:input_type -> api.hrm.v1.ListEmployeeOvertimesRequest
-	9,  // 21: api.hrm.v1.OvertimeService.ListPendingOvertimes:input_type -> api.hrm.v1.ListPendingOvertimesRequest
-	10, // 22: api.hrm.v1.OvertimeService.SubmitOvertime:input_type -> api.hrm.v1.SubmitOvertimeRequest
-	12, // 23: api.hrm.v1.OvertimeService.ApproveOvertime:input_type -> api.hrm.v1.ApproveOvertimeRequest
-	14, // 24: api.hrm.v1.OvertimeService.RejectOvertime:input_type -> api.hrm.v1.RejectOvertimeRequest
-	16, // 25: api.hrm.v1.OvertimeService.SumOvertimeHours:input_type -> api.hrm.v1.SumOvertimeHoursRequest
-	18, // 26: api.hrm.v1.OvertimeService.GetCompOffDays:input_type -> api.hrm.v1.GetCompOffDaysRequest
-	20, // 27: api.hrm.v1.OvertimeService.UseCompOffDays:input_type -> api.hrm.v1.UseCompOffDaysRequest
-	0,  // 28: api.hrm.v1.OvertimeService.CreateOvertime:output_type -> api.hrm.v1.OvertimeResponse
-	0,  // 29: api.hrm.v1.OvertimeService.UpdateOvertime:output_type -> api.hrm.v1.OvertimeResponse
-	4,  // 30: api.hrm.v1.OvertimeService.DeleteOvertime:output_type -> api.hrm.v1.DeleteOvertimeResponse
-	0,  // 31: api.hrm.v1.OvertimeService.GetOvertime:output_type -> api.hrm.v1.OvertimeResponse
-	7,  // 32: api.hrm.v1.OvertimeService.ListOvertimes:output_type -> api.hrm.v1.ListOvertimesResponse
-	7,  // 33: api.hrm.v1.OvertimeService.ListEmployeeOvertimes:output_type -> api.hrm.v1.ListOvertimesResponse
-	7,  // 34: api.hrm.v1.OvertimeService.ListPendingOvertimes:output_type -> api.hrm.v1.ListOvertimesResponse
-	11, // 35: api.hrm.v1.OvertimeService.SubmitOvertime:output_type -> api.hrm.v1.SubmitOvertimeResponse
-	13, // 36: api.hrm.v1.OvertimeService.ApproveOvertime:output_type -> api.hrm.v1.ApproveOvertimeResponse
-	15, // 37: api.hrm.v1.OvertimeService.RejectOvertime:output_type -> api.hrm.v1.RejectOvertimeResponse
-	17, // 38: api.hrm.v1.OvertimeService.SumOvertimeHours:output_type -> api.hrm.v1.SumOvertimeHoursResponse
-	19, // 39: api.hrm.v1.OvertimeService.GetCompOffDays:output_type -> api.hrm.v1.GetCompOffDaysResponse
-	21, // 40: api.hrm.v1.OvertimeService.UseCompOffDays:output_type -> api.hrm.v1.UseCompOffDaysResponse
-	28, // [28:41] is the sub-list for method output_type
-	15, // [15:28] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	0,  // 0: api.hrm.v1.OvertimePolicyResponse.rules:type_name -> api.hrm.v1.OvertimeTypeRule
+	31, // 1: api.hrm.v1.OvertimePolicyResponse.created_at:type_name -> google.protobuf.Timestamp
+	31, // 2: api.hrm.v1.OvertimePolicyResponse.updated_at:type_name -> google.protobuf.Timestamp
+	31, // 3: api.hrm.v1.OvertimeResponse.start_time:type_name -> google.protobuf.Timestamp
+	31, // 4: api.hrm.v1.OvertimeResponse.end_time:type_name -> google.protobuf.Timestamp
+	31, // 5: api.hrm.v1.OvertimeResponse.approved_at:type_name -> google.protobuf.Timestamp
+	31, // 6: api.hrm.v1.OvertimeResponse.comp_off_expire_at:type_name -> google.protobuf.Timestamp
+	31, // 7: api.hrm.v1.OvertimeResponse.created_at:type_name -> google.protobuf.Timestamp
+	31, // 8: api.hrm.v1.OvertimeResponse.updated_at:type_name -> google.protobuf.Timestamp
+	31, // 9: api.hrm.v1.CreateOvertimeRequest.start_time:type_name -> google.protobuf.Timestamp
+	31, // 10: api.hrm.v1.CreateOvertimeRequest.end_time:type_name -> google.protobuf.Timestamp
+	31, // 11: api.hrm.v1.UpdateOvertimeRequest.start_time:type_name -> google.protobuf.Timestamp
+	31, // 12: api.hrm.v1.UpdateOvertimeRequest.end_time:type_name -> google.protobuf.Timestamp
+	31, // 13: api.hrm.v1.ListOvertimesRequest.start_date:type_name -> google.protobuf.Timestamp
+	31, // 14: api.hrm.v1.ListOvertimesRequest.end_date:type_name -> google.protobuf.Timestamp
+	2,  // 15: api.hrm.v1.ListOvertimesResponse.items:type_name -> api.hrm.v1.OvertimeResponse
+	31, // 16: api.hrm.v1.SumOvertimeHoursRequest.start_date:type_name -> google.protobuf.Timestamp
+	31, // 17: api.hrm.v1.SumOvertimeHoursRequest.end_date:type_name -> google.protobuf.Timestamp
+	0,  // 18: api.hrm.v1.OvertimePolicyRequest.rules:type_name -> api.hrm.v1.OvertimeTypeRule
+	1,  // 19: api.hrm.v1.ListOvertimePoliciesResponse.items:type_name -> api.hrm.v1.OvertimePolicyResponse
+	3,  // 20: api.hrm.v1.OvertimeService.CreateOvertime:input_type -> api.hrm.v1.CreateOvertimeRequest
+	4,  // 21: api.hrm.v1.OvertimeService.UpdateOvertime:input_type -> api.hrm.v1.UpdateOvertimeRequest
+	5,  // 22: api.hrm.v1.OvertimeService.DeleteOvertime:input_type -> api.hrm.v1.DeleteOvertimeRequest
+	7,  // 23: api.hrm.v1.OvertimeService.GetOvertime:input_type -> api.hrm.v1.GetOvertimeRequest
+	8,  // 24: api.hrm.v1.OvertimeService.ListOvertimes:input_type -> api.hrm.v1.ListOvertimesRequest
+	10, // 25: api.hrm.v1.OvertimeService.ListEmployeeOvertimes:input_type -> api.hrm.v1.ListEmployeeOvertimesRequest
+	11, // 26: api.hrm.v1.OvertimeService.ListPendingOvertimes:input_type -> api.hrm.v1.ListPendingOvertimesRequest
+	12, // 27: api.hrm.v1.OvertimeService.SubmitOvertime:input_type -> api.hrm.v1.SubmitOvertimeRequest
+	14, // 28: api.hrm.v1.OvertimeService.ApproveOvertime:input_type -> api.hrm.v1.ApproveOvertimeRequest
+	16, // 29: api.hrm.v1.OvertimeService.RejectOvertime:input_type -> api.hrm.v1.RejectOvertimeRequest
+	18, // 30: api.hrm.v1.OvertimeService.SumOvertimeHours:input_type -> api.hrm.v1.SumOvertimeHoursRequest
+	20, // 31: api.hrm.v1.OvertimeService.GetCompOffDays:input_type -> api.hrm.v1.GetCompOffDaysRequest
+	22, // 32: api.hrm.v1.OvertimeService.UseCompOffDays:input_type -> api.hrm.v1.UseCompOffDaysRequest
+	24, // 33: api.hrm.v1.OvertimePolicyService.CreateOvertimePolicy:input_type -> api.hrm.v1.OvertimePolicyRequest
+	27, // 34: api.hrm.v1.OvertimePolicyService.ListOvertimePolicies:input_type -> api.hrm.v1.ListOvertimePoliciesRequest
+	25, // 35: api.hrm.v1.OvertimePolicyService.GetOvertimePolicy:input_type -> api.hrm.v1.GetOvertimePolicyRequest
+	24, // 36: api.hrm.v1.OvertimePolicyService.UpdateOvertimePolicy:input_type -> api.hrm.v1.OvertimePolicyRequest
+	26, // 37: api.hrm.v1.OvertimePolicyService.DeleteOvertimePolicy:input_type -> api.hrm.v1.DeleteOvertimePolicyRequest
+	29, // 38: api.hrm.v1.OvertimePolicyService.AssignOvertimePolicy:input_type -> api.hrm.v1.AssignOvertimePolicyRequest
+	30, // 39: api.hrm.v1.OvertimePolicyService.ResolveOvertimePolicy:input_type -> api.hrm.v1.ResolveOvertimePolicyRequest
+	2,  // 40: api.hrm.v1.OvertimeService.CreateOvertime:output_type -> api.hrm.v1.OvertimeResponse
+	2,  // 41: api.hrm.v1.OvertimeService.UpdateOvertime:output_type -> api.hrm.v1.OvertimeResponse
+	6,  // 42: api.hrm.v1.OvertimeService.DeleteOvertime:output_type -> api.hrm.v1.DeleteOvertimeResponse
+	2,  // 43: api.hrm.v1.OvertimeService.GetOvertime:output_type -> api.hrm.v1.OvertimeResponse
+	9,  // 44: api.hrm.v1.OvertimeService.ListOvertimes:output_type -> api.hrm.v1.ListOvertimesResponse
+	9,  // 45: api.hrm.v1.OvertimeService.ListEmployeeOvertimes:output_type -> api.hrm.v1.ListOvertimesResponse
+	9,  // 46: api.hrm.v1.OvertimeService.ListPendingOvertimes:output_type -> api.hrm.v1.ListOvertimesResponse
+	13, // 47: api.hrm.v1.OvertimeService.SubmitOvertime:output_type -> api.hrm.v1.SubmitOvertimeResponse
+	15, // 48: api.hrm.v1.OvertimeService.ApproveOvertime:output_type -> api.hrm.v1.ApproveOvertimeResponse
+	17, // 49: api.hrm.v1.OvertimeService.RejectOvertime:output_type -> api.hrm.v1.RejectOvertimeResponse
+	19, // 50: api.hrm.v1.OvertimeService.SumOvertimeHours:output_type -> api.hrm.v1.SumOvertimeHoursResponse
+	21, // 51: api.hrm.v1.OvertimeService.GetCompOffDays:output_type -> api.hrm.v1.GetCompOffDaysResponse
+	23, // 52: api.hrm.v1.OvertimeService.UseCompOffDays:output_type -> api.hrm.v1.UseCompOffDaysResponse
+	1,  // 53: api.hrm.v1.OvertimePolicyService.CreateOvertimePolicy:output_type -> api.hrm.v1.OvertimePolicyResponse
+	28, // 54: api.hrm.v1.OvertimePolicyService.ListOvertimePolicies:output_type -> api.hrm.v1.ListOvertimePoliciesResponse
+	1,  // 55: api.hrm.v1.OvertimePolicyService.GetOvertimePolicy:output_type -> api.hrm.v1.OvertimePolicyResponse
+	1,  // 56: api.hrm.v1.OvertimePolicyService.UpdateOvertimePolicy:output_type -> api.hrm.v1.OvertimePolicyResponse
+	32, // 57: api.hrm.v1.OvertimePolicyService.DeleteOvertimePolicy:output_type -> google.protobuf.Empty
+	33, // 58: api.hrm.v1.OvertimePolicyService.AssignOvertimePolicy:output_type -> api.hrm.v1.AttendanceRuleResponse
+	1,  // 59: api.hrm.v1.OvertimePolicyService.ResolveOvertimePolicy:output_type -> api.hrm.v1.OvertimePolicyResponse
+	40, // [40:60] is the sub-list for method output_type
+	20, // [20:40] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_api_hrm_v1_overtime_proto_init() }
@@ -1829,15 +2642,17 @@ func file_api_hrm_v1_overtime_proto_init() {
 	if File_api_hrm_v1_overtime_proto != nil {
 		return
 	}
+	file_api_hrm_v1_attendance_proto_init()
+	file_api_hrm_v1_overtime_proto_msgTypes[24].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_hrm_v1_overtime_proto_rawDesc), len(file_api_hrm_v1_overtime_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   31,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_api_hrm_v1_overtime_proto_goTypes,
 		DependencyIndexes: file_api_hrm_v1_overtime_proto_depIdxs,
@@ -35,181 +35,211 @@ var (
 	_ = sort.Sort
 )
 
-// Validate checks the field values on OvertimeResponse with the rules defined
+// Validate checks the field values on OvertimeTypeRule with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *OvertimeResponse) Validate() error {
+func (m *OvertimeTypeRule) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OvertimeResponse with the rules
+// ValidateAll checks the field values on OvertimeTypeRule with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// OvertimeResponseMultiError, or nil if none found.
-func (m *OvertimeResponse) ValidateAll() error {
+// OvertimeTypeRuleMultiError, or nil if none found.
+func (m *OvertimeTypeRule) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OvertimeResponse) validate(all bool) error {
+func (m *OvertimeTypeRule) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for TenantId
+	// no validation rules for Type
 
-	// no validation rules for EmployeeId
+	// no validation rules for PayRate
 
-	// no validation rules for EmployeeName
+	// no validation rules for CompOffRate
 
-	// no validation rules for DepartmentId
+	// no validation rules for Compensation
 
-	if all {
-		switch v := interface{}(m.GetStartTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, OvertimeResponseValidationError{
-					field:  "StartTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, OvertimeResponseValidationError{
-					field:  "StartTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return OvertimeResponseValidationError{
-				field:  "StartTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if len(errors) > 0 {
+		return OvertimeTypeRuleMultiError(errors)
 	}
 
-	if all {
-		switch v := interface{}(m.GetEndTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, OvertimeResponseValidationError{
-					field:  "EndTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, OvertimeResponseValidationError{
-					field:  "EndTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return OvertimeResponseValidationError{
-				field:  "EndTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	return nil
+}
+
+// OvertimeTypeRuleMultiError is an error wrapping multiple validation errors
+// returned by OvertimeTypeRule.ValidateAll() if the designated constraints
+// aren't met.
+type OvertimeTypeRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OvertimeTypeRuleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	// no validation rules for Duration
+// AllErrors returns a list of validation violation errors.
+func (m OvertimeTypeRuleMultiError) AllErrors() []error { return m }
 
-	// no validation rules for OvertimeType
+// OvertimeTypeRuleValidationError is the validation error returned by
+// OvertimeTypeRule.Validate if the designated constraints aren't met.
+type OvertimeTypeRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-	// no validation rules for PayType
+// Field function returns field value.
+func (e OvertimeTypeRuleValidationError) Field() string { return e.field }
 
-	// no validation rules for PayRate
+// Reason function returns reason value.
+func (e OvertimeTypeRuleValidationError) Reason() string { return e.reason }
 
-	// no validation rules for Reason
+// Cause function returns cause value.
+func (e OvertimeTypeRuleValidationError) Cause() error { return e.cause }
 
-	// no validation rules for ApprovalStatus
+// Key function returns key value.
+func (e OvertimeTypeRuleValidationError) Key() bool { return e.key }
 
-	// no validation rules for ApprovedBy
+// ErrorName returns error name.
+func (e OvertimeTypeRuleValidationError) ErrorName() string { return "OvertimeTypeRuleValidationError" }
 
-	if all {
-		switch v := interface{}(m.GetApprovedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, OvertimeResponseValidationError{
-					field:  "ApprovedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, OvertimeResponseValidationError{
-					field:  "ApprovedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetApprovedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return OvertimeResponseValidationError{
-				field:  "ApprovedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+// Error satisfies the builtin error interface
+func (e OvertimeTypeRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	// no validation rules for RejectReason
+	key := ""
+	if e.key {
+		key = "key for "
+	}
 
-	// no validation rules for CompOffDays
+	return fmt.Sprintf(
+		"invalid %sOvertimeTypeRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
 
-	// no validation rules for CompOffUsed
+var _ error = OvertimeTypeRuleValidationError{}
 
-	if all {
-		switch v := interface{}(m.GetCompOffExpireAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, OvertimeResponseValidationError{
-					field:  "CompOffExpireAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OvertimeTypeRuleValidationError{}
+
+// Validate checks the field values on OvertimePolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OvertimePolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OvertimePolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OvertimePolicyResponseMultiError, or nil if none found.
+func (m *OvertimePolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OvertimePolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TenantId
+
+	// no validation rules for Code
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OvertimePolicyResponseValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OvertimePolicyResponseValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, OvertimeResponseValidationError{
-					field:  "CompOffExpireAt",
+				return OvertimePolicyResponseValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCompOffExpireAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return OvertimeResponseValidationError{
-				field:  "CompOffExpireAt",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
-	// no validation rules for Remark
+	// no validation rules for HoursPerDay
+
+	// no validation rules for MinDurationMinutes
+
+	// no validation rules for UnitMinutes
+
+	// no validation rules for Rounding
+
+	// no validation rules for MonthlyCapHours
+
+	// no validation rules for YearlyCapHours
+
+	// no validation rules for BlockOverCap
+
+	// no validation rules for AutoDetect
+
+	// no validation rules for AutoRequireApproval
+
+	// no validation rules for IsDefault
+
+	// no validation rules for IsActive
+
+	// no validation rules for CreatedBy
+
+	// no validation rules for UpdatedBy
 
 	if all {
 		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, OvertimeResponseValidationError{
+				errors = append(errors, OvertimePolicyResponseValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -217,7 +247,7 @@ func (m *OvertimeResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, OvertimeResponseValidationError{
+				errors = append(errors, OvertimePolicyResponseValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -226,7 +256,7 @@ func (m *OvertimeResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return OvertimeResponseValidationError{
+			return OvertimePolicyResponseValidationError{
 				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -238,7 +268,7 @@ func (m *OvertimeResponse) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, OvertimeResponseValidationError{
+				errors = append(errors, OvertimePolicyResponseValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -246,7 +276,7 @@ func (m *OvertimeResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, OvertimeResponseValidationError{
+				errors = append(errors, OvertimePolicyResponseValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -255,7 +285,7 @@ func (m *OvertimeResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return OvertimeResponseValidationError{
+			return OvertimePolicyResponseValidationError{
 				field:  "UpdatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -264,19 +294,19 @@ func (m *OvertimeResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return OvertimeResponseMultiError(errors)
+		return OvertimePolicyResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// OvertimeResponseMultiError is an error wrapping multiple validation errors
-// returned by OvertimeResponse.ValidateAll() if the designated constraints
-// aren't met.
-type OvertimeResponseMultiError []error
+// OvertimePolicyResponseMultiError is an error wrapping multiple validation
+// errors returned by OvertimePolicyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type OvertimePolicyResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OvertimeResponseMultiError) Error() string {
+func (m OvertimePolicyResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -285,11 +315,11 @@ func (m OvertimeResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OvertimeResponseMultiError) AllErrors() []error { return m }
+func (m OvertimePolicyResponseMultiError) AllErrors() []error { return m }
 
-// OvertimeResponseValidationError is the validation error returned by
-// OvertimeResponse.Validate if the designated constraints aren't met.
-type OvertimeResponseValidationError struct {
+// OvertimePolicyResponseValidationError is the validation error returned by
+// OvertimePolicyResponse.Validate if the designated constraints aren't met.
+type OvertimePolicyResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -297,22 +327,24 @@ type OvertimeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e OvertimeResponseValidationError) Field() string { return e.field }
+func (e OvertimePolicyResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OvertimeResponseValidationError) Reason() string { return e.reason }
+func (e OvertimePolicyResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OvertimeResponseValidationError) Cause() error { return e.cause }
+func (e OvertimePolicyResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OvertimeResponseValidationError) Key() bool { return e.key }
+func (e OvertimePolicyResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OvertimeResponseValidationError) ErrorName() string { return "OvertimeResponseValidationError" }
+func (e OvertimePolicyResponseValidationError) ErrorName() string {
+	return "OvertimePolicyResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e OvertimeResponseValidationError) Error() string {
+func (e OvertimePolicyResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -324,14 +356,14 @@ func (e OvertimeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOvertimeResponse.%s: %s%s",
+		"invalid %sOvertimePolicyResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OvertimeResponseValidationError{}
+var _ error = OvertimePolicyResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -339,30 +371,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OvertimeResponseValidationError{}
+} = OvertimePolicyResponseValidationError{}
 
-// Validate checks the field values on CreateOvertimeRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateOvertimeRequest) Validate() error {
-	return m.validate(false)
+// Validate checks the field values on OvertimeResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OvertimeResponse) Validate() error {
+	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateOvertimeRequest with the rules
+// ValidateAll checks the field values on OvertimeResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateOvertimeRequestMultiError, or nil if none found.
-func (m *CreateOvertimeRequest) ValidateAll() error {
+// OvertimeResponseMultiError, or nil if none found.
+func (m *OvertimeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateOvertimeRequest) validate(all bool) error {
+func (m *OvertimeResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
 	// no validation rules for TenantId
 
 	// no validation rules for EmployeeId
@@ -375,7 +409,7 @@ func (m *CreateOvertimeRequest) validate(all bool) error {
 		switch v := interface{}(m.GetStartTime()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateOvertimeRequestValidationError{
+				errors = append(errors, OvertimeResponseValidationError{
 					field:  "StartTime",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -383,7 +417,7 @@ func (m *CreateOvertimeRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateOvertimeRequestValidationError{
+				errors = append(errors, OvertimeResponseValidationError{
 					field:  "StartTime",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -392,7 +426,7 @@ func (m *CreateOvertimeRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateOvertimeRequestValidationError{
+			return OvertimeResponseValidationError{
 				field:  "StartTime",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -404,7 +438,7 @@ func (m *CreateOvertimeRequest) validate(all bool) error {
 		switch v := interface{}(m.GetEndTime()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateOvertimeRequestValidationError{
+				errors = append(errors, OvertimeResponseValidationError{
 					field:  "EndTime",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -412,7 +446,7 @@ func (m *CreateOvertimeRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateOvertimeRequestValidationError{
+				errors = append(errors, OvertimeResponseValidationError{
 					field:  "EndTime",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -421,7 +455,7 @@ func (m *CreateOvertimeRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateOvertimeRequestValidationError{
+			return OvertimeResponseValidationError{
 				field:  "EndTime",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -435,24 +469,152 @@ func (m *CreateOvertimeRequest) validate(all bool) error {
 
 	// no validation rules for PayType
 
+	// no validation rules for PayRate
+
 	// no validation rules for Reason
 
+	// no validation rules for ApprovalStatus
+
+	// no validation rules for ApprovedBy
+
+	if all {
+		switch v := interface{}(m.GetApprovedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OvertimeResponseValidationError{
+					field:  "ApprovedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OvertimeResponseValidationError{
+					field:  "ApprovedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApprovedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OvertimeResponseValidationError{
+				field:  "ApprovedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RejectReason
+
+	// no validation rules for CompOffDays
+
+	// no validation rules for CompOffUsed
+
+	if all {
+		switch v := interface{}(m.GetCompOffExpireAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OvertimeResponseValidationError{
+					field:  "CompOffExpireAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OvertimeResponseValidationError{
+					field:  "CompOffExpireAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCompOffExpireAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OvertimeResponseValidationError{
+				field:  "CompOffExpireAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	// no validation rules for Remark
 
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OvertimeResponseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OvertimeResponseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OvertimeResponseValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OvertimeResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OvertimeResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OvertimeResponseValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return CreateOvertimeRequestMultiError(errors)
+		return OvertimeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateOvertimeRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateOvertimeRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateOvertimeRequestMultiError []error
+// OvertimeResponseMultiError is an error wrapping multiple validation errors
+// returned by OvertimeResponse.ValidateAll() if the designated constraints
+// aren't met.
+type OvertimeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateOvertimeRequestMultiError) Error() string {
+func (m OvertimeResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -461,11 +623,11 @@ func (m CreateOvertimeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateOvertimeRequestMultiError) AllErrors() []error { return m }
+func (m OvertimeResponseMultiError) AllErrors() []error { return m }
 
-// CreateOvertimeRequestValidationError is the validation error returned by
-// CreateOvertimeRequest.Validate if the designated constraints aren't met.
-type CreateOvertimeRequestValidationError struct {
+// OvertimeResponseValidationError is the validation error returned by
+// OvertimeResponse.Validate if the designated constraints aren't met.
+type OvertimeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -473,24 +635,22 @@ type CreateOvertimeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateOvertimeRequestValidationError) Field() string { return e.field }
+func (e OvertimeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateOvertimeRequestValidationError) Reason() string { return e.reason }
+func (e OvertimeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateOvertimeRequestValidationError) Cause() error { return e.cause }
+func (e OvertimeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateOvertimeRequestValidationError) Key() bool { return e.key }
+func (e OvertimeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateOvertimeRequestValidationError) ErrorName() string {
-	return "CreateOvertimeRequestValidationError"
-}
+func (e OvertimeResponseValidationError) ErrorName() string { return "OvertimeResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateOvertimeRequestValidationError) Error() string {
+func (e OvertimeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -502,14 +662,14 @@ func (e CreateOvertimeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateOvertimeRequest.%s: %s%s",
+		"invalid %sOvertimeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateOvertimeRequestValidationError{}
+var _ error = OvertimeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -517,37 +677,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateOvertimeRequestValidationError{}
+} = OvertimeResponseValidationError{}
 
-// Validate checks the field values on UpdateOvertimeRequest with the rules
+// Validate checks the field values on CreateOvertimeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateOvertimeRequest) Validate() error {
+func (m *CreateOvertimeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateOvertimeRequest with the rules
+// ValidateAll checks the field values on CreateOvertimeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateOvertimeRequestMultiError, or nil if none found.
-func (m *UpdateOvertimeRequest) ValidateAll() error {
+// CreateOvertimeRequestMultiError, or nil if none found.
+func (m *CreateOvertimeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateOvertimeRequest) validate(all bool) error {
+func (m *CreateOvertimeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for TenantId
+
+	// no validation rules for EmployeeId
+
+	// no validation rules for EmployeeName
+
+	// no validation rules for DepartmentId
 
 	if all {
 		switch v := interface{}(m.GetStartTime()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateOvertimeRequestValidationError{
+				errors = append(errors, CreateOvertimeRequestValidationError{
 					field:  "StartTime",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -555,7 +721,7 @@ func (m *UpdateOvertimeRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateOvertimeRequestValidationError{
+				errors = append(errors, CreateOvertimeRequestValidationError{
 					field:  "StartTime",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -564,7 +730,7 @@ func (m *UpdateOvertimeRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateOvertimeRequestValidationError{
+			return CreateOvertimeRequestValidationError{
 				field:  "StartTime",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -576,7 +742,7 @@ func (m *UpdateOvertimeRequest) validate(all bool) error {
 		switch v := interface{}(m.GetEndTime()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateOvertimeRequestValidationError{
+				errors = append(errors, CreateOvertimeRequestValidationError{
 					field:  "EndTime",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -584,7 +750,7 @@ func (m *UpdateOvertimeRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateOvertimeRequestValidationError{
+				errors = append(errors, CreateOvertimeRequestValidationError{
 					field:  "EndTime",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -593,7 +759,7 @@ func (m *UpdateOvertimeRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateOvertimeRequestValidationError{
+			return CreateOvertimeRequestValidationError{
 				field:  "EndTime",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -612,19 +778,19 @@ func (m *UpdateOvertimeRequest) validate(all bool) error {
 	// no validation rules for Remark
 
 	if len(errors) > 0 {
-		return UpdateOvertimeRequestMultiError(errors)
+		return CreateOvertimeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateOvertimeRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateOvertimeRequest.ValidateAll() if the designated
+// CreateOvertimeRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateOvertimeRequest.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateOvertimeRequestMultiError []error
+type CreateOvertimeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateOvertimeRequestMultiError) Error() string {
+func (m CreateOvertimeRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -633,11 +799,11 @@ func (m UpdateOvertimeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateOvertimeRequestMultiError) AllErrors() []error { return m }
+func (m CreateOvertimeRequestMultiError) AllErrors() []error { return m }
 
-// UpdateOvertimeRequestValidationError is the validation error returned by
-// UpdateOvertimeRequest.Validate if the designated constraints aren't met.
-type UpdateOvertimeRequestValidationError struct {
+// CreateOvertimeRequestValidationError is the validation error returned by
+// CreateOvertimeRequest.Validate if the designated constraints aren't met.
+type CreateOvertimeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -645,24 +811,1042 @@ type UpdateOvertimeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateOvertimeRequestValidationError) Field() string { return e.field }
+func (e CreateOvertimeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateOvertimeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateOvertimeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateOvertimeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateOvertimeRequestValidationError) ErrorName() string {
+	return "CreateOvertimeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateOvertimeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateOvertimeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateOvertimeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateOvertimeRequestValidationError{}
+
+// Validate checks the field values on UpdateOvertimeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateOvertimeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateOvertimeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateOvertimeRequestMultiError, or nil if none found.
+func (m *UpdateOvertimeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateOvertimeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateOvertimeRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateOvertimeRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateOvertimeRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateOvertimeRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateOvertimeRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateOvertimeRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Duration
+
+	// no validation rules for OvertimeType
+
+	// no validation rules for PayType
+
+	// no validation rules for Reason
+
+	// no validation rules for Remark
+
+	if len(errors) > 0 {
+		return UpdateOvertimeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateOvertimeRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateOvertimeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateOvertimeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateOvertimeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateOvertimeRequestMultiError) AllErrors() []error { return m }
+
+// UpdateOvertimeRequestValidationError is the validation error returned by
+// UpdateOvertimeRequest.Validate if the designated constraints aren't met.
+type UpdateOvertimeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateOvertimeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateOvertimeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateOvertimeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateOvertimeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateOvertimeRequestValidationError) ErrorName() string {
+	return "UpdateOvertimeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateOvertimeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateOvertimeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateOvertimeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateOvertimeRequestValidationError{}
+
+// Validate checks the field values on DeleteOvertimeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteOvertimeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteOvertimeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteOvertimeRequestMultiError, or nil if none found.
+func (m *DeleteOvertimeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteOvertimeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteOvertimeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteOvertimeRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteOvertimeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteOvertimeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteOvertimeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteOvertimeRequestMultiError) AllErrors() []error { return m }
+
+// DeleteOvertimeRequestValidationError is the validation error returned by
+// DeleteOvertimeRequest.Validate if the designated constraints aren't met.
+type DeleteOvertimeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteOvertimeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteOvertimeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteOvertimeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteOvertimeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteOvertimeRequestValidationError) ErrorName() string {
+	return "DeleteOvertimeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteOvertimeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteOvertimeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteOvertimeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteOvertimeRequestValidationError{}
+
+// Validate checks the field values on DeleteOvertimeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteOvertimeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteOvertimeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteOvertimeResponseMultiError, or nil if none found.
+func (m *DeleteOvertimeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteOvertimeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return DeleteOvertimeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteOvertimeResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteOvertimeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteOvertimeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteOvertimeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteOvertimeResponseMultiError) AllErrors() []error { return m }
+
+// DeleteOvertimeResponseValidationError is the validation error returned by
+// DeleteOvertimeResponse.Validate if the designated constraints aren't met.
+type DeleteOvertimeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteOvertimeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteOvertimeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteOvertimeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteOvertimeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteOvertimeResponseValidationError) ErrorName() string {
+	return "DeleteOvertimeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteOvertimeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteOvertimeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteOvertimeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteOvertimeResponseValidationError{}
+
+// Validate checks the field values on GetOvertimeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOvertimeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOvertimeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOvertimeRequestMultiError, or nil if none found.
+func (m *GetOvertimeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOvertimeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetOvertimeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOvertimeRequestMultiError is an error wrapping multiple validation errors
+// returned by GetOvertimeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetOvertimeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOvertimeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOvertimeRequestMultiError) AllErrors() []error { return m }
+
+// GetOvertimeRequestValidationError is the validation error returned by
+// GetOvertimeRequest.Validate if the designated constraints aren't met.
+type GetOvertimeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOvertimeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOvertimeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOvertimeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOvertimeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOvertimeRequestValidationError) ErrorName() string {
+	return "GetOvertimeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOvertimeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOvertimeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOvertimeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOvertimeRequestValidationError{}
+
+// Validate checks the field values on ListOvertimesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListOvertimesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOvertimesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOvertimesRequestMultiError, or nil if none found.
+func (m *ListOvertimesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOvertimesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for EmployeeId
+
+	// no validation rules for DepartmentId
+
+	// no validation rules for OvertimeType
+
+	// no validation rules for ApprovalStatus
+
+	if all {
+		switch v := interface{}(m.GetStartDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListOvertimesRequestValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListOvertimesRequestValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListOvertimesRequestValidationError{
+				field:  "StartDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListOvertimesRequestValidationError{
+					field:  "EndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListOvertimesRequestValidationError{
+					field:  "EndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListOvertimesRequestValidationError{
+				field:  "EndDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Keyword
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListOvertimesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOvertimesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListOvertimesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListOvertimesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOvertimesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOvertimesRequestMultiError) AllErrors() []error { return m }
+
+// ListOvertimesRequestValidationError is the validation error returned by
+// ListOvertimesRequest.Validate if the designated constraints aren't met.
+type ListOvertimesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOvertimesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOvertimesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOvertimesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOvertimesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOvertimesRequestValidationError) ErrorName() string {
+	return "ListOvertimesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOvertimesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOvertimesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOvertimesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOvertimesRequestValidationError{}
+
+// Validate checks the field values on ListOvertimesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListOvertimesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOvertimesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOvertimesResponseMultiError, or nil if none found.
+func (m *ListOvertimesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOvertimesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOvertimesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOvertimesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOvertimesResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListOvertimesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOvertimesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListOvertimesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListOvertimesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOvertimesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOvertimesResponseMultiError) AllErrors() []error { return m }
+
+// ListOvertimesResponseValidationError is the validation error returned by
+// ListOvertimesResponse.Validate if the designated constraints aren't met.
+type ListOvertimesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOvertimesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOvertimesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOvertimesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOvertimesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOvertimesResponseValidationError) ErrorName() string {
+	return "ListOvertimesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOvertimesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOvertimesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOvertimesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOvertimesResponseValidationError{}
+
+// Validate checks the field values on ListEmployeeOvertimesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListEmployeeOvertimesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEmployeeOvertimesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEmployeeOvertimesRequestMultiError, or nil if none found.
+func (m *ListEmployeeOvertimesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEmployeeOvertimesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for EmployeeId
+
+	// no validation rules for Year
+
+	if len(errors) > 0 {
+		return ListEmployeeOvertimesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEmployeeOvertimesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListEmployeeOvertimesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListEmployeeOvertimesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEmployeeOvertimesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEmployeeOvertimesRequestMultiError) AllErrors() []error { return m }
+
+// ListEmployeeOvertimesRequestValidationError is the validation error returned
+// by ListEmployeeOvertimesRequest.Validate if the designated constraints
+// aren't met.
+type ListEmployeeOvertimesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEmployeeOvertimesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEmployeeOvertimesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEmployeeOvertimesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEmployeeOvertimesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEmployeeOvertimesRequestValidationError) ErrorName() string {
+	return "ListEmployeeOvertimesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEmployeeOvertimesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEmployeeOvertimesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEmployeeOvertimesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEmployeeOvertimesRequestValidationError{}
+
+// Validate checks the field values on ListPendingOvertimesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPendingOvertimesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPendingOvertimesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPendingOvertimesRequestMultiError, or nil if none found.
+func (m *ListPendingOvertimesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPendingOvertimesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	if len(errors) > 0 {
+		return ListPendingOvertimesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPendingOvertimesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListPendingOvertimesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListPendingOvertimesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPendingOvertimesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPendingOvertimesRequestMultiError) AllErrors() []error { return m }
+
+// ListPendingOvertimesRequestValidationError is the validation error returned
+// by ListPendingOvertimesRequest.Validate if the designated constraints
+// aren't met.
+type ListPendingOvertimesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPendingOvertimesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateOvertimeRequestValidationError) Reason() string { return e.reason }
+func (e ListPendingOvertimesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateOvertimeRequestValidationError) Cause() error { return e.cause }
+func (e ListPendingOvertimesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateOvertimeRequestValidationError) Key() bool { return e.key }
+func (e ListPendingOvertimesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateOvertimeRequestValidationError) ErrorName() string {
-	return "UpdateOvertimeRequestValidationError"
+func (e ListPendingOvertimesRequestValidationError) ErrorName() string {
+	return "ListPendingOvertimesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateOvertimeRequestValidationError) Error() string {
+func (e ListPendingOvertimesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -674,14 +1858,14 @@ func (e UpdateOvertimeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateOvertimeRequest.%s: %s%s",
+		"invalid %sListPendingOvertimesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateOvertimeRequestValidationError{}
+var _ error = ListPendingOvertimesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -689,46 +1873,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateOvertimeRequestValidationError{}
+} = ListPendingOvertimesRequestValidationError{}
 
-// Validate checks the field values on DeleteOvertimeRequest with the rules
+// Validate checks the field values on SubmitOvertimeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteOvertimeRequest) Validate() error {
+func (m *SubmitOvertimeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteOvertimeRequest with the rules
+// ValidateAll checks the field values on SubmitOvertimeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteOvertimeRequestMultiError, or nil if none found.
-func (m *DeleteOvertimeRequest) ValidateAll() error {
+// SubmitOvertimeRequestMultiError, or nil if none found.
+func (m *SubmitOvertimeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteOvertimeRequest) validate(all bool) error {
+func (m *SubmitOvertimeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for OvertimeId
+
+	// no validation rules for SubmitterId
 
 	if len(errors) > 0 {
-		return DeleteOvertimeRequestMultiError(errors)
+		return SubmitOvertimeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteOvertimeRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteOvertimeRequest.ValidateAll() if the designated
+// SubmitOvertimeRequestMultiError is an error wrapping multiple validation
+// errors returned by SubmitOvertimeRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteOvertimeRequestMultiError []error
+type SubmitOvertimeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteOvertimeRequestMultiError) Error() string {
+func (m SubmitOvertimeRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -737,11 +1923,11 @@ func (m DeleteOvertimeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteOvertimeRequestMultiError) AllErrors() []error { return m }
+func (m SubmitOvertimeRequestMultiError) AllErrors() []error { return m }
 
-// DeleteOvertimeRequestValidationError is the validation error returned by
-// DeleteOvertimeRequest.Validate if the designated constraints aren't met.
-type DeleteOvertimeRequestValidationError struct {
+// SubmitOvertimeRequestValidationError is the validation error returned by
+// SubmitOvertimeRequest.Validate if the designated constraints aren't met.
+type SubmitOvertimeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -749,24 +1935,24 @@ type DeleteOvertimeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteOvertimeRequestValidationError) Field() string { return e.field }
+func (e SubmitOvertimeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteOvertimeRequestValidationError) Reason() string { return e.reason }
+func (e SubmitOvertimeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteOvertimeRequestValidationError) Cause() error { return e.cause }
+func (e SubmitOvertimeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteOvertimeRequestValidationError) Key() bool { return e.key }
+func (e SubmitOvertimeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteOvertimeRequestValidationError) ErrorName() string {
-	return "DeleteOvertimeRequestValidationError"
+func (e SubmitOvertimeRequestValidationError) ErrorName() string {
+	return "SubmitOvertimeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteOvertimeRequestValidationError) Error() string {
+func (e SubmitOvertimeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -778,14 +1964,14 @@ func (e DeleteOvertimeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteOvertimeRequest.%s: %s%s",
+		"invalid %sSubmitOvertimeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteOvertimeRequestValidationError{}
+var _ error = SubmitOvertimeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -793,24 +1979,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteOvertimeRequestValidationError{}
+} = SubmitOvertimeRequestValidationError{}
 
-// Validate checks the field values on DeleteOvertimeResponse with the rules
+// Validate checks the field values on SubmitOvertimeResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteOvertimeResponse) Validate() error {
+func (m *SubmitOvertimeResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteOvertimeResponse with the rules
+// ValidateAll checks the field values on SubmitOvertimeResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteOvertimeResponseMultiError, or nil if none found.
-func (m *DeleteOvertimeResponse) ValidateAll() error {
+// SubmitOvertimeResponseMultiError, or nil if none found.
+func (m *SubmitOvertimeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteOvertimeResponse) validate(all bool) error {
+func (m *SubmitOvertimeResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -822,19 +2008,19 @@ func (m *DeleteOvertimeResponse) validate(all bool) error {
 	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return DeleteOvertimeResponseMultiError(errors)
+		return SubmitOvertimeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteOvertimeResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteOvertimeResponse.ValidateAll() if the designated
+// SubmitOvertimeResponseMultiError is an error wrapping multiple validation
+// errors returned by SubmitOvertimeResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteOvertimeResponseMultiError []error
+type SubmitOvertimeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteOvertimeResponseMultiError) Error() string {
+func (m SubmitOvertimeResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -843,11 +2029,11 @@ func (m DeleteOvertimeResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteOvertimeResponseMultiError) AllErrors() []error { return m }
+func (m SubmitOvertimeResponseMultiError) AllErrors() []error { return m }
 
-// DeleteOvertimeResponseValidationError is the validation error returned by
-// DeleteOvertimeResponse.Validate if the designated constraints aren't met.
-type DeleteOvertimeResponseValidationError struct {
+// SubmitOvertimeResponseValidationError is the validation error returned by
+// SubmitOvertimeResponse.Validate if the designated constraints aren't met.
+type SubmitOvertimeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -855,24 +2041,24 @@ type DeleteOvertimeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteOvertimeResponseValidationError) Field() string { return e.field }
+func (e SubmitOvertimeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteOvertimeResponseValidationError) Reason() string { return e.reason }
+func (e SubmitOvertimeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteOvertimeResponseValidationError) Cause() error { return e.cause }
+func (e SubmitOvertimeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteOvertimeResponseValidationError) Key() bool { return e.key }
+func (e SubmitOvertimeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteOvertimeResponseValidationError) ErrorName() string {
-	return "DeleteOvertimeResponseValidationError"
+func (e SubmitOvertimeResponseValidationError) ErrorName() string {
+	return "SubmitOvertimeResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteOvertimeResponseValidationError) Error() string {
+func (e SubmitOvertimeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -884,14 +2070,14 @@ func (e DeleteOvertimeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteOvertimeResponse.%s: %s%s",
+		"invalid %sSubmitOvertimeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteOvertimeResponseValidationError{}
+var _ error = SubmitOvertimeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -899,46 +2085,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteOvertimeResponseValidationError{}
+} = SubmitOvertimeResponseValidationError{}
 
-// Validate checks the field values on GetOvertimeRequest with the rules
+// Validate checks the field values on ApproveOvertimeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetOvertimeRequest) Validate() error {
+func (m *ApproveOvertimeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetOvertimeRequest with the rules
+// ValidateAll checks the field values on ApproveOvertimeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetOvertimeRequestMultiError, or nil if none found.
-func (m *GetOvertimeRequest) ValidateAll() error {
+// ApproveOvertimeRequestMultiError, or nil if none found.
+func (m *ApproveOvertimeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetOvertimeRequest) validate(all bool) error {
+func (m *ApproveOvertimeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for OvertimeId
+
+	// no validation rules for ApproverId
 
 	if len(errors) > 0 {
-		return GetOvertimeRequestMultiError(errors)
+		return ApproveOvertimeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetOvertimeRequestMultiError is an error wrapping multiple validation errors
-// returned by GetOvertimeRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetOvertimeRequestMultiError []error
+// ApproveOvertimeRequestMultiError is an error wrapping multiple validation
+// errors returned by ApproveOvertimeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ApproveOvertimeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetOvertimeRequestMultiError) Error() string {
+func (m ApproveOvertimeRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -947,11 +2135,11 @@ func (m GetOvertimeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetOvertimeRequestMultiError) AllErrors() []error { return m }
+func (m ApproveOvertimeRequestMultiError) AllErrors() []error { return m }
 
-// GetOvertimeRequestValidationError is the validation error returned by
-// GetOvertimeRequest.Validate if the designated constraints aren't met.
-type GetOvertimeRequestValidationError struct {
+// ApproveOvertimeRequestValidationError is the validation error returned by
+// ApproveOvertimeRequest.Validate if the designated constraints aren't met.
+type ApproveOvertimeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -959,162 +2147,92 @@ type GetOvertimeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetOvertimeRequestValidationError) Field() string { return e.field }
+func (e ApproveOvertimeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetOvertimeRequestValidationError) Reason() string { return e.reason }
+func (e ApproveOvertimeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetOvertimeRequestValidationError) Cause() error { return e.cause }
+func (e ApproveOvertimeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetOvertimeRequestValidationError) Key() bool { return e.key }
+func (e ApproveOvertimeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetOvertimeRequestValidationError) ErrorName() string {
-	return "GetOvertimeRequestValidationError"
+func (e ApproveOvertimeRequestValidationError) ErrorName() string {
+	return "ApproveOvertimeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetOvertimeRequestValidationError) Error() string {
+func (e ApproveOvertimeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
-
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sGetOvertimeRequest.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = GetOvertimeRequestValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = GetOvertimeRequestValidationError{}
-
-// Validate checks the field values on ListOvertimesRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListOvertimesRequest) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on ListOvertimesRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListOvertimesRequestMultiError, or nil if none found.
-func (m *ListOvertimesRequest) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *ListOvertimesRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	// no validation rules for TenantId
-
-	// no validation rules for EmployeeId
-
-	// no validation rules for DepartmentId
-
-	// no validation rules for OvertimeType
-
-	// no validation rules for ApprovalStatus
-
-	if all {
-		switch v := interface{}(m.GetStartDate()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListOvertimesRequestValidationError{
-					field:  "StartDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ListOvertimesRequestValidationError{
-					field:  "StartDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetStartDate()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListOvertimesRequestValidationError{
-				field:  "StartDate",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+
+	key := ""
+	if e.key {
+		key = "key for "
 	}
 
-	if all {
-		switch v := interface{}(m.GetEndDate()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListOvertimesRequestValidationError{
-					field:  "EndDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ListOvertimesRequestValidationError{
-					field:  "EndDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetEndDate()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListOvertimesRequestValidationError{
-				field:  "EndDate",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	return fmt.Sprintf(
+		"invalid %sApproveOvertimeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveOvertimeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveOvertimeRequestValidationError{}
+
+// Validate checks the field values on ApproveOvertimeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveOvertimeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveOvertimeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveOvertimeResponseMultiError, or nil if none found.
+func (m *ApproveOvertimeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveOvertimeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	// no validation rules for Keyword
+	var errors []error
 
-	// no validation rules for Page
+	// no validation rules for Success
 
-	// no validation rules for PageSize
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return ListOvertimesRequestMultiError(errors)
+		return ApproveOvertimeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListOvertimesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListOvertimesRequest.ValidateAll() if the designated
+// ApproveOvertimeResponseMultiError is an error wrapping multiple validation
+// errors returned by ApproveOvertimeResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListOvertimesRequestMultiError []error
+type ApproveOvertimeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListOvertimesRequestMultiError) Error() string {
+func (m ApproveOvertimeResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1123,11 +2241,11 @@ func (m ListOvertimesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListOvertimesRequestMultiError) AllErrors() []error { return m }
+func (m ApproveOvertimeResponseMultiError) AllErrors() []error { return m }
 
-// ListOvertimesRequestValidationError is the validation error returned by
-// ListOvertimesRequest.Validate if the designated constraints aren't met.
-type ListOvertimesRequestValidationError struct {
+// ApproveOvertimeResponseValidationError is the validation error returned by
+// ApproveOvertimeResponse.Validate if the designated constraints aren't met.
+type ApproveOvertimeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1135,24 +2253,24 @@ type ListOvertimesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListOvertimesRequestValidationError) Field() string { return e.field }
+func (e ApproveOvertimeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListOvertimesRequestValidationError) Reason() string { return e.reason }
+func (e ApproveOvertimeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListOvertimesRequestValidationError) Cause() error { return e.cause }
+func (e ApproveOvertimeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListOvertimesRequestValidationError) Key() bool { return e.key }
+func (e ApproveOvertimeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListOvertimesRequestValidationError) ErrorName() string {
-	return "ListOvertimesRequestValidationError"
+func (e ApproveOvertimeResponseValidationError) ErrorName() string {
+	return "ApproveOvertimeResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListOvertimesRequestValidationError) Error() string {
+func (e ApproveOvertimeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1164,14 +2282,14 @@ func (e ListOvertimesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListOvertimesRequest.%s: %s%s",
+		"invalid %sApproveOvertimeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListOvertimesRequestValidationError{}
+var _ error = ApproveOvertimeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1179,84 +2297,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListOvertimesRequestValidationError{}
+} = ApproveOvertimeResponseValidationError{}
 
-// Validate checks the field values on ListOvertimesResponse with the rules
+// Validate checks the field values on RejectOvertimeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListOvertimesResponse) Validate() error {
+func (m *RejectOvertimeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListOvertimesResponse with the rules
+// ValidateAll checks the field values on RejectOvertimeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListOvertimesResponseMultiError, or nil if none found.
-func (m *ListOvertimesResponse) ValidateAll() error {
+// RejectOvertimeRequestMultiError, or nil if none found.
+func (m *RejectOvertimeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListOvertimesResponse) validate(all bool) error {
+func (m *RejectOvertimeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetItems() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListOvertimesResponseValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListOvertimesResponseValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListOvertimesResponseValidationError{
-					field:  fmt.Sprintf("Items[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for Total
+	// no validation rules for OvertimeId
 
-	// no validation rules for Page
+	// no validation rules for ApproverId
 
-	// no validation rules for PageSize
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return ListOvertimesResponseMultiError(errors)
+		return RejectOvertimeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListOvertimesResponseMultiError is an error wrapping multiple validation
-// errors returned by ListOvertimesResponse.ValidateAll() if the designated
+// RejectOvertimeRequestMultiError is an error wrapping multiple validation
+// errors returned by RejectOvertimeRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListOvertimesResponseMultiError []error
+type RejectOvertimeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListOvertimesResponseMultiError) Error() string {
+func (m RejectOvertimeRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1265,11 +2349,11 @@ func (m ListOvertimesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListOvertimesResponseMultiError) AllErrors() []error { return m }
+func (m RejectOvertimeRequestMultiError) AllErrors() []error { return m }
 
-// ListOvertimesResponseValidationError is the validation error returned by
-// ListOvertimesResponse.Validate if the designated constraints aren't met.
-type ListOvertimesResponseValidationError struct {
+// RejectOvertimeRequestValidationError is the validation error returned by
+// RejectOvertimeRequest.Validate if the designated constraints aren't met.
+type RejectOvertimeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1277,24 +2361,24 @@ type ListOvertimesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListOvertimesResponseValidationError) Field() string { return e.field }
+func (e RejectOvertimeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListOvertimesResponseValidationError) Reason() string { return e.reason }
+func (e RejectOvertimeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListOvertimesResponseValidationError) Cause() error { return e.cause }
+func (e RejectOvertimeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListOvertimesResponseValidationError) Key() bool { return e.key }
+func (e RejectOvertimeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListOvertimesResponseValidationError) ErrorName() string {
-	return "ListOvertimesResponseValidationError"
+func (e RejectOvertimeRequestValidationError) ErrorName() string {
+	return "RejectOvertimeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListOvertimesResponseValidationError) Error() string {
+func (e RejectOvertimeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1306,14 +2390,14 @@ func (e ListOvertimesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListOvertimesResponse.%s: %s%s",
+		"invalid %sRejectOvertimeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListOvertimesResponseValidationError{}
+var _ error = RejectOvertimeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1321,50 +2405,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListOvertimesResponseValidationError{}
+} = RejectOvertimeRequestValidationError{}
 
-// Validate checks the field values on ListEmployeeOvertimesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RejectOvertimeResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListEmployeeOvertimesRequest) Validate() error {
+func (m *RejectOvertimeResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListEmployeeOvertimesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RejectOvertimeResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListEmployeeOvertimesRequestMultiError, or nil if none found.
-func (m *ListEmployeeOvertimesRequest) ValidateAll() error {
+// RejectOvertimeResponseMultiError, or nil if none found.
+func (m *RejectOvertimeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListEmployeeOvertimesRequest) validate(all bool) error {
+func (m *RejectOvertimeResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TenantId
-
-	// no validation rules for EmployeeId
+	// no validation rules for Success
 
-	// no validation rules for Year
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return ListEmployeeOvertimesRequestMultiError(errors)
+		return RejectOvertimeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListEmployeeOvertimesRequestMultiError is an error wrapping multiple
-// validation errors returned by ListEmployeeOvertimesRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ListEmployeeOvertimesRequestMultiError []error
+// RejectOvertimeResponseMultiError is an error wrapping multiple validation
+// errors returned by RejectOvertimeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RejectOvertimeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListEmployeeOvertimesRequestMultiError) Error() string {
+func (m RejectOvertimeResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1373,12 +2455,11 @@ func (m ListEmployeeOvertimesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListEmployeeOvertimesRequestMultiError) AllErrors() []error { return m }
+func (m RejectOvertimeResponseMultiError) AllErrors() []error { return m }
 
-// ListEmployeeOvertimesRequestValidationError is the validation error returned
-// by ListEmployeeOvertimesRequest.Validate if the designated constraints
-// aren't met.
-type ListEmployeeOvertimesRequestValidationError struct {
+// RejectOvertimeResponseValidationError is the validation error returned by
+// RejectOvertimeResponse.Validate if the designated constraints aren't met.
+type RejectOvertimeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1386,24 +2467,24 @@ type ListEmployeeOvertimesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListEmployeeOvertimesRequestValidationError) Field() string { return e.field }
+func (e RejectOvertimeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListEmployeeOvertimesRequestValidationError) Reason() string { return e.reason }
+func (e RejectOvertimeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListEmployeeOvertimesRequestValidationError) Cause() error { return e.cause }
+func (e RejectOvertimeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListEmployeeOvertimesRequestValidationError) Key() bool { return e.key }
+func (e RejectOvertimeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListEmployeeOvertimesRequestValidationError) ErrorName() string {
-	return "ListEmployeeOvertimesRequestValidationError"
+func (e RejectOvertimeResponseValidationError) ErrorName() string {
+	return "RejectOvertimeResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListEmployeeOvertimesRequestValidationError) Error() string {
+func (e RejectOvertimeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1415,14 +2496,14 @@ func (e ListEmployeeOvertimesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListEmployeeOvertimesRequest.%s: %s%s",
+		"invalid %sRejectOvertimeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListEmployeeOvertimesRequestValidationError{}
+var _ error = RejectOvertimeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1430,24 +2511,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListEmployeeOvertimesRequestValidationError{}
+} = RejectOvertimeResponseValidationError{}
 
-// Validate checks the field values on ListPendingOvertimesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SumOvertimeHoursRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListPendingOvertimesRequest) Validate() error {
+func (m *SumOvertimeHoursRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListPendingOvertimesRequest with the
+// ValidateAll checks the field values on SumOvertimeHoursRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListPendingOvertimesRequestMultiError, or nil if none found.
-func (m *ListPendingOvertimesRequest) ValidateAll() error {
+// SumOvertimeHoursRequestMultiError, or nil if none found.
+func (m *SumOvertimeHoursRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListPendingOvertimesRequest) validate(all bool) error {
+func (m *SumOvertimeHoursRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1456,20 +2537,80 @@ func (m *ListPendingOvertimesRequest) validate(all bool) error {
 
 	// no validation rules for TenantId
 
+	// no validation rules for EmployeeId
+
+	if all {
+		switch v := interface{}(m.GetStartDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SumOvertimeHoursRequestValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SumOvertimeHoursRequestValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SumOvertimeHoursRequestValidationError{
+				field:  "StartDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SumOvertimeHoursRequestValidationError{
+					field:  "EndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SumOvertimeHoursRequestValidationError{
+					field:  "EndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SumOvertimeHoursRequestValidationError{
+				field:  "EndDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return ListPendingOvertimesRequestMultiError(errors)
+		return SumOvertimeHoursRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListPendingOvertimesRequestMultiError is an error wrapping multiple
-// validation errors returned by ListPendingOvertimesRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ListPendingOvertimesRequestMultiError []error
+// SumOvertimeHoursRequestMultiError is an error wrapping multiple validation
+// errors returned by SumOvertimeHoursRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SumOvertimeHoursRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListPendingOvertimesRequestMultiError) Error() string {
+func (m SumOvertimeHoursRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1478,12 +2619,11 @@ func (m ListPendingOvertimesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListPendingOvertimesRequestMultiError) AllErrors() []error { return m }
+func (m SumOvertimeHoursRequestMultiError) AllErrors() []error { return m }
 
-// ListPendingOvertimesRequestValidationError is the validation error returned
-// by ListPendingOvertimesRequest.Validate if the designated constraints
-// aren't met.
-type ListPendingOvertimesRequestValidationError struct {
+// SumOvertimeHoursRequestValidationError is the validation error returned by
+// SumOvertimeHoursRequest.Validate if the designated constraints aren't met.
+type SumOvertimeHoursRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1491,24 +2631,24 @@ type ListPendingOvertimesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListPendingOvertimesRequestValidationError) Field() string { return e.field }
+func (e SumOvertimeHoursRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListPendingOvertimesRequestValidationError) Reason() string { return e.reason }
+func (e SumOvertimeHoursRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListPendingOvertimesRequestValidationError) Cause() error { return e.cause }
+func (e SumOvertimeHoursRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListPendingOvertimesRequestValidationError) Key() bool { return e.key }
+func (e SumOvertimeHoursRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListPendingOvertimesRequestValidationError) ErrorName() string {
-	return "ListPendingOvertimesRequestValidationError"
+func (e SumOvertimeHoursRequestValidationError) ErrorName() string {
+	return "SumOvertimeHoursRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListPendingOvertimesRequestValidationError) Error() string {
+func (e SumOvertimeHoursRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1520,14 +2660,14 @@ func (e ListPendingOvertimesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListPendingOvertimesRequest.%s: %s%s",
+		"invalid %sSumOvertimeHoursRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListPendingOvertimesRequestValidationError{}
+var _ error = SumOvertimeHoursRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1535,48 +2675,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListPendingOvertimesRequestValidationError{}
+} = SumOvertimeHoursRequestValidationError{}
 
-// Validate checks the field values on SubmitOvertimeRequest with the rules
+// Validate checks the field values on SumOvertimeHoursResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SubmitOvertimeRequest) Validate() error {
+func (m *SumOvertimeHoursResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SubmitOvertimeRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SumOvertimeHoursResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SubmitOvertimeRequestMultiError, or nil if none found.
-func (m *SubmitOvertimeRequest) ValidateAll() error {
+// SumOvertimeHoursResponseMultiError, or nil if none found.
+func (m *SumOvertimeHoursResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SubmitOvertimeRequest) validate(all bool) error {
+func (m *SumOvertimeHoursResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OvertimeId
-
-	// no validation rules for SubmitterId
+	// no validation rules for TotalHours
 
 	if len(errors) > 0 {
-		return SubmitOvertimeRequestMultiError(errors)
+		return SumOvertimeHoursResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SubmitOvertimeRequestMultiError is an error wrapping multiple validation
-// errors returned by SubmitOvertimeRequest.ValidateAll() if the designated
+// SumOvertimeHoursResponseMultiError is an error wrapping multiple validation
+// errors returned by SumOvertimeHoursResponse.ValidateAll() if the designated
 // constraints aren't met.
-type SubmitOvertimeRequestMultiError []error
+type SumOvertimeHoursResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SubmitOvertimeRequestMultiError) Error() string {
+func (m SumOvertimeHoursResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1585,11 +2723,11 @@ func (m SubmitOvertimeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SubmitOvertimeRequestMultiError) AllErrors() []error { return m }
+func (m SumOvertimeHoursResponseMultiError) AllErrors() []error { return m }
 
-// SubmitOvertimeRequestValidationError is the validation error returned by
-// SubmitOvertimeRequest.Validate if the designated constraints aren't met.
-type SubmitOvertimeRequestValidationError struct {
+// SumOvertimeHoursResponseValidationError is the validation error returned by
+// SumOvertimeHoursResponse.Validate if the designated constraints aren't met.
+type SumOvertimeHoursResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1597,24 +2735,24 @@ type SubmitOvertimeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SubmitOvertimeRequestValidationError) Field() string { return e.field }
+func (e SumOvertimeHoursResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SubmitOvertimeRequestValidationError) Reason() string { return e.reason }
+func (e SumOvertimeHoursResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SubmitOvertimeRequestValidationError) Cause() error { return e.cause }
+func (e SumOvertimeHoursResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SubmitOvertimeRequestValidationError) Key() bool { return e.key }
+func (e SumOvertimeHoursResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SubmitOvertimeRequestValidationError) ErrorName() string {
-	return "SubmitOvertimeRequestValidationError"
+func (e SumOvertimeHoursResponseValidationError) ErrorName() string {
+	return "SumOvertimeHoursResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SubmitOvertimeRequestValidationError) Error() string {
+func (e SumOvertimeHoursResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1626,14 +2764,14 @@ func (e SubmitOvertimeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSubmitOvertimeRequest.%s: %s%s",
+		"invalid %sSumOvertimeHoursResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SubmitOvertimeRequestValidationError{}
+var _ error = SumOvertimeHoursResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1641,48 +2779,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SubmitOvertimeRequestValidationError{}
+} = SumOvertimeHoursResponseValidationError{}
 
-// Validate checks the field values on SubmitOvertimeResponse with the rules
+// Validate checks the field values on GetCompOffDaysRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SubmitOvertimeResponse) Validate() error {
+func (m *GetCompOffDaysRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SubmitOvertimeResponse with the rules
+// ValidateAll checks the field values on GetCompOffDaysRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SubmitOvertimeResponseMultiError, or nil if none found.
-func (m *SubmitOvertimeResponse) ValidateAll() error {
+// GetCompOffDaysRequestMultiError, or nil if none found.
+func (m *GetCompOffDaysRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SubmitOvertimeResponse) validate(all bool) error {
+func (m *GetCompOffDaysRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	// no validation rules for TenantId
 
-	// no validation rules for Message
+	// no validation rules for EmployeeId
 
 	if len(errors) > 0 {
-		return SubmitOvertimeResponseMultiError(errors)
+		return GetCompOffDaysRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SubmitOvertimeResponseMultiError is an error wrapping multiple validation
-// errors returned by SubmitOvertimeResponse.ValidateAll() if the designated
+// GetCompOffDaysRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCompOffDaysRequest.ValidateAll() if the designated
 // constraints aren't met.
-type SubmitOvertimeResponseMultiError []error
+type GetCompOffDaysRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SubmitOvertimeResponseMultiError) Error() string {
+func (m GetCompOffDaysRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1691,11 +2829,11 @@ func (m SubmitOvertimeResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SubmitOvertimeResponseMultiError) AllErrors() []error { return m }
+func (m GetCompOffDaysRequestMultiError) AllErrors() []error { return m }
 
-// SubmitOvertimeResponseValidationError is the validation error returned by
-// SubmitOvertimeResponse.Validate if the designated constraints aren't met.
-type SubmitOvertimeResponseValidationError struct {
+// GetCompOffDaysRequestValidationError is the validation error returned by
+// GetCompOffDaysRequest.Validate if the designated constraints aren't met.
+type GetCompOffDaysRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1703,24 +2841,24 @@ type SubmitOvertimeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SubmitOvertimeResponseValidationError) Field() string { return e.field }
+func (e GetCompOffDaysRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SubmitOvertimeResponseValidationError) Reason() string { return e.reason }
+func (e GetCompOffDaysRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SubmitOvertimeResponseValidationError) Cause() error { return e.cause }
+func (e GetCompOffDaysRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SubmitOvertimeResponseValidationError) Key() bool { return e.key }
+func (e GetCompOffDaysRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SubmitOvertimeResponseValidationError) ErrorName() string {
-	return "SubmitOvertimeResponseValidationError"
+func (e GetCompOffDaysRequestValidationError) ErrorName() string {
+	return "GetCompOffDaysRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SubmitOvertimeResponseValidationError) Error() string {
+func (e GetCompOffDaysRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1732,14 +2870,14 @@ func (e SubmitOvertimeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSubmitOvertimeResponse.%s: %s%s",
+		"invalid %sGetCompOffDaysRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SubmitOvertimeResponseValidationError{}
+var _ error = GetCompOffDaysRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1747,48 +2885,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SubmitOvertimeResponseValidationError{}
+} = GetCompOffDaysRequestValidationError{}
 
-// Validate checks the field values on ApproveOvertimeRequest with the rules
+// Validate checks the field values on GetCompOffDaysResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ApproveOvertimeRequest) Validate() error {
+func (m *GetCompOffDaysResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ApproveOvertimeRequest with the rules
+// ValidateAll checks the field values on GetCompOffDaysResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ApproveOvertimeRequestMultiError, or nil if none found.
-func (m *ApproveOvertimeRequest) ValidateAll() error {
+// GetCompOffDaysResponseMultiError, or nil if none found.
+func (m *GetCompOffDaysResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ApproveOvertimeRequest) validate(all bool) error {
+func (m *GetCompOffDaysResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OvertimeId
-
-	// no validation rules for ApproverId
+	// no validation rules for AvailableDays
 
 	if len(errors) > 0 {
-		return ApproveOvertimeRequestMultiError(errors)
+		return GetCompOffDaysResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ApproveOvertimeRequestMultiError is an error wrapping multiple validation
-// errors returned by ApproveOvertimeRequest.ValidateAll() if the designated
+// GetCompOffDaysResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCompOffDaysResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ApproveOvertimeRequestMultiError []error
+type GetCompOffDaysResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ApproveOvertimeRequestMultiError) Error() string {
+func (m GetCompOffDaysResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1797,11 +2933,11 @@ func (m ApproveOvertimeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ApproveOvertimeRequestMultiError) AllErrors() []error { return m }
+func (m GetCompOffDaysResponseMultiError) AllErrors() []error { return m }
 
-// ApproveOvertimeRequestValidationError is the validation error returned by
-// ApproveOvertimeRequest.Validate if the designated constraints aren't met.
-type ApproveOvertimeRequestValidationError struct {
+// GetCompOffDaysResponseValidationError is the validation error returned by
+// GetCompOffDaysResponse.Validate if the designated constraints aren't met.
+type GetCompOffDaysResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1809,24 +2945,24 @@ type ApproveOvertimeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ApproveOvertimeRequestValidationError) Field() string { return e.field }
+func (e GetCompOffDaysResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ApproveOvertimeRequestValidationError) Reason() string { return e.reason }
+func (e GetCompOffDaysResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ApproveOvertimeRequestValidationError) Cause() error { return e.cause }
+func (e GetCompOffDaysResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ApproveOvertimeRequestValidationError) Key() bool { return e.key }
+func (e GetCompOffDaysResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ApproveOvertimeRequestValidationError) ErrorName() string {
-	return "ApproveOvertimeRequestValidationError"
+func (e GetCompOffDaysResponseValidationError) ErrorName() string {
+	return "GetCompOffDaysResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ApproveOvertimeRequestValidationError) Error() string {
+func (e GetCompOffDaysResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1838,14 +2974,14 @@ func (e ApproveOvertimeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sApproveOvertimeRequest.%s: %s%s",
+		"invalid %sGetCompOffDaysResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ApproveOvertimeRequestValidationError{}
+var _ error = GetCompOffDaysResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1853,48 +2989,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ApproveOvertimeRequestValidationError{}
+} = GetCompOffDaysResponseValidationError{}
 
-// Validate checks the field values on ApproveOvertimeResponse with the rules
+// Validate checks the field values on UseCompOffDaysRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ApproveOvertimeResponse) Validate() error {
+func (m *UseCompOffDaysRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ApproveOvertimeResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UseCompOffDaysRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ApproveOvertimeResponseMultiError, or nil if none found.
-func (m *ApproveOvertimeResponse) ValidateAll() error {
+// UseCompOffDaysRequestMultiError, or nil if none found.
+func (m *UseCompOffDaysRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ApproveOvertimeResponse) validate(all bool) error {
+func (m *UseCompOffDaysRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	// no validation rules for TenantId
 
-	// no validation rules for Message
+	// no validation rules for EmployeeId
+
+	// no validation rules for Days
 
 	if len(errors) > 0 {
-		return ApproveOvertimeResponseMultiError(errors)
+		return UseCompOffDaysRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ApproveOvertimeResponseMultiError is an error wrapping multiple validation
-// errors returned by ApproveOvertimeResponse.ValidateAll() if the designated
+// UseCompOffDaysRequestMultiError is an error wrapping multiple validation
+// errors returned by UseCompOffDaysRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ApproveOvertimeResponseMultiError []error
+type UseCompOffDaysRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ApproveOvertimeResponseMultiError) Error() string {
+func (m UseCompOffDaysRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1903,11 +3041,11 @@ func (m ApproveOvertimeResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ApproveOvertimeResponseMultiError) AllErrors() []error { return m }
+func (m UseCompOffDaysRequestMultiError) AllErrors() []error { return m }
 
-// ApproveOvertimeResponseValidationError is the validation error returned by
-// ApproveOvertimeResponse.Validate if the designated constraints aren't met.
-type ApproveOvertimeResponseValidationError struct {
+// UseCompOffDaysRequestValidationError is the validation error returned by
+// UseCompOffDaysRequest.Validate if the designated constraints aren't met.
+type UseCompOffDaysRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1915,24 +3053,24 @@ type ApproveOvertimeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ApproveOvertimeResponseValidationError) Field() string { return e.field }
+func (e UseCompOffDaysRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ApproveOvertimeResponseValidationError) Reason() string { return e.reason }
+func (e UseCompOffDaysRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ApproveOvertimeResponseValidationError) Cause() error { return e.cause }
+func (e UseCompOffDaysRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ApproveOvertimeResponseValidationError) Key() bool { return e.key }
+func (e UseCompOffDaysRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ApproveOvertimeResponseValidationError) ErrorName() string {
-	return "ApproveOvertimeResponseValidationError"
+func (e UseCompOffDaysRequestValidationError) ErrorName() string {
+	return "UseCompOffDaysRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ApproveOvertimeResponseValidationError) Error() string {
+func (e UseCompOffDaysRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1944,14 +3082,14 @@ func (e ApproveOvertimeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sApproveOvertimeResponse.%s: %s%s",
+		"invalid %sUseCompOffDaysRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ApproveOvertimeResponseValidationError{}
+var _ error = UseCompOffDaysRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1959,50 +3097,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ApproveOvertimeResponseValidationError{}
+} = UseCompOffDaysRequestValidationError{}
 
-// Validate checks the field values on RejectOvertimeRequest with the rules
+// Validate checks the field values on UseCompOffDaysResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RejectOvertimeRequest) Validate() error {
+func (m *UseCompOffDaysResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RejectOvertimeRequest with the rules
+// ValidateAll checks the field values on UseCompOffDaysResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RejectOvertimeRequestMultiError, or nil if none found.
-func (m *RejectOvertimeRequest) ValidateAll() error {
+// UseCompOffDaysResponseMultiError, or nil if none found.
+func (m *UseCompOffDaysResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RejectOvertimeRequest) validate(all bool) error {
+func (m *UseCompOffDaysResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OvertimeId
+	// no validation rules for Success
 
-	// no validation rules for ApproverId
+	// no validation rules for Message
 
-	// no validation rules for Reason
+	// no validation rules for RemainingDays
 
 	if len(errors) > 0 {
-		return RejectOvertimeRequestMultiError(errors)
+		return UseCompOffDaysResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RejectOvertimeRequestMultiError is an error wrapping multiple validation
-// errors returned by RejectOvertimeRequest.ValidateAll() if the designated
+// UseCompOffDaysResponseMultiError is an error wrapping multiple validation
+// errors returned by UseCompOffDaysResponse.ValidateAll() if the designated
 // constraints aren't met.
-type RejectOvertimeRequestMultiError []error
+type UseCompOffDaysResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RejectOvertimeRequestMultiError) Error() string {
+func (m UseCompOffDaysResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2011,11 +3149,11 @@ func (m RejectOvertimeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RejectOvertimeRequestMultiError) AllErrors() []error { return m }
+func (m UseCompOffDaysResponseMultiError) AllErrors() []error { return m }
 
-// RejectOvertimeRequestValidationError is the validation error returned by
-// RejectOvertimeRequest.Validate if the designated constraints aren't met.
-type RejectOvertimeRequestValidationError struct {
+// UseCompOffDaysResponseValidationError is the validation error returned by
+// UseCompOffDaysResponse.Validate if the designated constraints aren't met.
+type UseCompOffDaysResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2023,24 +3161,24 @@ type RejectOvertimeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RejectOvertimeRequestValidationError) Field() string { return e.field }
+func (e UseCompOffDaysResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RejectOvertimeRequestValidationError) Reason() string { return e.reason }
+func (e UseCompOffDaysResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RejectOvertimeRequestValidationError) Cause() error { return e.cause }
+func (e UseCompOffDaysResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RejectOvertimeRequestValidationError) Key() bool { return e.key }
+func (e UseCompOffDaysResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RejectOvertimeRequestValidationError) ErrorName() string {
-	return "RejectOvertimeRequestValidationError"
+func (e UseCompOffDaysResponseValidationError) ErrorName() string {
+	return "UseCompOffDaysResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RejectOvertimeRequestValidationError) Error() string {
+func (e UseCompOffDaysResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2052,14 +3190,14 @@ func (e RejectOvertimeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRejectOvertimeRequest.%s: %s%s",
+		"invalid %sUseCompOffDaysResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RejectOvertimeRequestValidationError{}
+var _ error = UseCompOffDaysResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2067,48 +3205,112 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RejectOvertimeRequestValidationError{}
+} = UseCompOffDaysResponseValidationError{}
 
-// Validate checks the field values on RejectOvertimeResponse with the rules
+// Validate checks the field values on OvertimePolicyRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RejectOvertimeResponse) Validate() error {
+func (m *OvertimePolicyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RejectOvertimeResponse with the rules
+// ValidateAll checks the field values on OvertimePolicyRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RejectOvertimeResponseMultiError, or nil if none found.
-func (m *RejectOvertimeResponse) ValidateAll() error {
+// OvertimePolicyRequestMultiError, or nil if none found.
+func (m *OvertimePolicyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RejectOvertimeResponse) validate(all bool) error {
+func (m *OvertimePolicyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	// no validation rules for Id
 
-	// no validation rules for Message
+	// no validation rules for Code
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OvertimePolicyRequestValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OvertimePolicyRequestValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OvertimePolicyRequestValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for HoursPerDay
+
+	// no validation rules for MinDurationMinutes
+
+	// no validation rules for UnitMinutes
+
+	// no validation rules for Rounding
+
+	// no validation rules for MonthlyCapHours
+
+	// no validation rules for YearlyCapHours
+
+	// no validation rules for BlockOverCap
+
+	// no validation rules for AutoDetect
+
+	// no validation rules for IsDefault
+
+	if m.AutoRequireApproval != nil {
+		// no validation rules for AutoRequireApproval
+	}
+
+	if m.IsActive != nil {
+		// no validation rules for IsActive
+	}
 
 	if len(errors) > 0 {
-		return RejectOvertimeResponseMultiError(errors)
+		return OvertimePolicyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RejectOvertimeResponseMultiError is an error wrapping multiple validation
-// errors returned by RejectOvertimeResponse.ValidateAll() if the designated
+// OvertimePolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by OvertimePolicyRequest.ValidateAll() if the designated
 // constraints aren't met.
-type RejectOvertimeResponseMultiError []error
+type OvertimePolicyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RejectOvertimeResponseMultiError) Error() string {
+func (m OvertimePolicyRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2117,11 +3319,11 @@ func (m RejectOvertimeResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RejectOvertimeResponseMultiError) AllErrors() []error { return m }
+func (m OvertimePolicyRequestMultiError) AllErrors() []error { return m }
 
-// RejectOvertimeResponseValidationError is the validation error returned by
-// RejectOvertimeResponse.Validate if the designated constraints aren't met.
-type RejectOvertimeResponseValidationError struct {
+// OvertimePolicyRequestValidationError is the validation error returned by
+// OvertimePolicyRequest.Validate if the designated constraints aren't met.
+type OvertimePolicyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2129,24 +3331,24 @@ type RejectOvertimeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RejectOvertimeResponseValidationError) Field() string { return e.field }
+func (e OvertimePolicyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RejectOvertimeResponseValidationError) Reason() string { return e.reason }
+func (e OvertimePolicyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RejectOvertimeResponseValidationError) Cause() error { return e.cause }
+func (e OvertimePolicyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RejectOvertimeResponseValidationError) Key() bool { return e.key }
+func (e OvertimePolicyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RejectOvertimeResponseValidationError) ErrorName() string {
-	return "RejectOvertimeResponseValidationError"
+func (e OvertimePolicyRequestValidationError) ErrorName() string {
+	return "OvertimePolicyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RejectOvertimeResponseValidationError) Error() string {
+func (e OvertimePolicyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2158,14 +3360,14 @@ func (e RejectOvertimeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRejectOvertimeResponse.%s: %s%s",
+		"invalid %sOvertimePolicyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RejectOvertimeResponseValidationError{}
+var _ error = OvertimePolicyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2173,106 +3375,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RejectOvertimeResponseValidationError{}
+} = OvertimePolicyRequestValidationError{}
 
-// Validate checks the field values on SumOvertimeHoursRequest with the rules
+// Validate checks the field values on GetOvertimePolicyRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SumOvertimeHoursRequest) Validate() error {
+func (m *GetOvertimePolicyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SumOvertimeHoursRequest with the
+// ValidateAll checks the field values on GetOvertimePolicyRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SumOvertimeHoursRequestMultiError, or nil if none found.
-func (m *SumOvertimeHoursRequest) ValidateAll() error {
+// GetOvertimePolicyRequestMultiError, or nil if none found.
+func (m *GetOvertimePolicyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SumOvertimeHoursRequest) validate(all bool) error {
+func (m *GetOvertimePolicyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	// no validation rules for TenantId
-
-	// no validation rules for EmployeeId
-
-	if all {
-		switch v := interface{}(m.GetStartDate()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SumOvertimeHoursRequestValidationError{
-					field:  "StartDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SumOvertimeHoursRequestValidationError{
-					field:  "StartDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetStartDate()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SumOvertimeHoursRequestValidationError{
-				field:  "StartDate",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetEndDate()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SumOvertimeHoursRequestValidationError{
-					field:  "EndDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SumOvertimeHoursRequestValidationError{
-					field:  "EndDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetEndDate()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SumOvertimeHoursRequestValidationError{
-				field:  "EndDate",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	var errors []error
+
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return SumOvertimeHoursRequestMultiError(errors)
+		return GetOvertimePolicyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SumOvertimeHoursRequestMultiError is an error wrapping multiple validation
-// errors returned by SumOvertimeHoursRequest.ValidateAll() if the designated
+// GetOvertimePolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by GetOvertimePolicyRequest.ValidateAll() if the designated
 // constraints aren't met.
-type SumOvertimeHoursRequestMultiError []error
+type GetOvertimePolicyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SumOvertimeHoursRequestMultiError) Error() string {
+func (m GetOvertimePolicyRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2281,11 +3423,11 @@ func (m SumOvertimeHoursRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SumOvertimeHoursRequestMultiError) AllErrors() []error { return m }
+func (m GetOvertimePolicyRequestMultiError) AllErrors() []error { return m }
 
-// SumOvertimeHoursRequestValidationError is the validation error returned by
-// SumOvertimeHoursRequest.Validate if the designated constraints aren't met.
-type SumOvertimeHoursRequestValidationError struct {
+// GetOvertimePolicyRequestValidationError is the validation error returned by
+// GetOvertimePolicyRequest.Validate if the designated constraints aren't met.
+type GetOvertimePolicyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2293,24 +3435,24 @@ type SumOvertimeHoursRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SumOvertimeHoursRequestValidationError) Field() string { return e.field }
+func (e GetOvertimePolicyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SumOvertimeHoursRequestValidationError) Reason() string { return e.reason }
+func (e GetOvertimePolicyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SumOvertimeHoursRequestValidationError) Cause() error { return e.cause }
+func (e GetOvertimePolicyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SumOvertimeHoursRequestValidationError) Key() bool { return e.key }
+func (e GetOvertimePolicyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SumOvertimeHoursRequestValidationError) ErrorName() string {
-	return "SumOvertimeHoursRequestValidationError"
+func (e GetOvertimePolicyRequestValidationError) ErrorName() string {
+	return "GetOvertimePolicyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SumOvertimeHoursRequestValidationError) Error() string {
+func (e GetOvertimePolicyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2322,14 +3464,14 @@ func (e SumOvertimeHoursRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSumOvertimeHoursRequest.%s: %s%s",
+		"invalid %sGetOvertimePolicyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SumOvertimeHoursRequestValidationError{}
+var _ error = GetOvertimePolicyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2337,46 +3479,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SumOvertimeHoursRequestValidationError{}
+} = GetOvertimePolicyRequestValidationError{}
 
-// Validate checks the field values on SumOvertimeHoursResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteOvertimePolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SumOvertimeHoursResponse) Validate() error {
+func (m *DeleteOvertimePolicyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SumOvertimeHoursResponse with the
+// ValidateAll checks the field values on DeleteOvertimePolicyRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SumOvertimeHoursResponseMultiError, or nil if none found.
-func (m *SumOvertimeHoursResponse) ValidateAll() error {
+// DeleteOvertimePolicyRequestMultiError, or nil if none found.
+func (m *DeleteOvertimePolicyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SumOvertimeHoursResponse) validate(all bool) error {
+func (m *DeleteOvertimePolicyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TotalHours
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return SumOvertimeHoursResponseMultiError(errors)
+		return DeleteOvertimePolicyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SumOvertimeHoursResponseMultiError is an error wrapping multiple validation
-// errors returned by SumOvertimeHoursResponse.ValidateAll() if the designated
-// constraints aren't met.
-type SumOvertimeHoursResponseMultiError []error
+// DeleteOvertimePolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteOvertimePolicyRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteOvertimePolicyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SumOvertimeHoursResponseMultiError) Error() string {
+func (m DeleteOvertimePolicyRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2385,11 +3527,12 @@ func (m SumOvertimeHoursResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SumOvertimeHoursResponseMultiError) AllErrors() []error { return m }
+func (m DeleteOvertimePolicyRequestMultiError) AllErrors() []error { return m }
 
-// SumOvertimeHoursResponseValidationError is the validation error returned by
-// SumOvertimeHoursResponse.Validate if the designated constraints aren't met.
-type SumOvertimeHoursResponseValidationError struct {
+// DeleteOvertimePolicyRequestValidationError is the validation error returned
+// by DeleteOvertimePolicyRequest.Validate if the designated constraints
+// aren't met.
+type DeleteOvertimePolicyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2397,24 +3540,24 @@ type SumOvertimeHoursResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SumOvertimeHoursResponseValidationError) Field() string { return e.field }
+func (e DeleteOvertimePolicyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SumOvertimeHoursResponseValidationError) Reason() string { return e.reason }
+func (e DeleteOvertimePolicyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SumOvertimeHoursResponseValidationError) Cause() error { return e.cause }
+func (e DeleteOvertimePolicyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SumOvertimeHoursResponseValidationError) Key() bool { return e.key }
+func (e DeleteOvertimePolicyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SumOvertimeHoursResponseValidationError) ErrorName() string {
-	return "SumOvertimeHoursResponseValidationError"
+func (e DeleteOvertimePolicyRequestValidationError) ErrorName() string {
+	return "DeleteOvertimePolicyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SumOvertimeHoursResponseValidationError) Error() string {
+func (e DeleteOvertimePolicyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2426,14 +3569,14 @@ func (e SumOvertimeHoursResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSumOvertimeHoursResponse.%s: %s%s",
+		"invalid %sDeleteOvertimePolicyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SumOvertimeHoursResponseValidationError{}
+var _ error = DeleteOvertimePolicyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2441,48 +3584,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SumOvertimeHoursResponseValidationError{}
+} = DeleteOvertimePolicyRequestValidationError{}
 
-// Validate checks the field values on GetCompOffDaysRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListOvertimePoliciesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCompOffDaysRequest) Validate() error {
+func (m *ListOvertimePoliciesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCompOffDaysRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListOvertimePoliciesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCompOffDaysRequestMultiError, or nil if none found.
-func (m *GetCompOffDaysRequest) ValidateAll() error {
+// ListOvertimePoliciesRequestMultiError, or nil if none found.
+func (m *ListOvertimePoliciesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCompOffDaysRequest) validate(all bool) error {
+func (m *ListOvertimePoliciesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TenantId
-
-	// no validation rules for EmployeeId
-
 	if len(errors) > 0 {
-		return GetCompOffDaysRequestMultiError(errors)
+		return ListOvertimePoliciesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCompOffDaysRequestMultiError is an error wrapping multiple validation
-// errors returned by GetCompOffDaysRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetCompOffDaysRequestMultiError []error
+// ListOvertimePoliciesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListOvertimePoliciesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListOvertimePoliciesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCompOffDaysRequestMultiError) Error() string {
+func (m ListOvertimePoliciesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2491,11 +3630,12 @@ func (m GetCompOffDaysRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCompOffDaysRequestMultiError) AllErrors() []error { return m }
+func (m ListOvertimePoliciesRequestMultiError) AllErrors() []error { return m }
 
-// GetCompOffDaysRequestValidationError is the validation error returned by
-// GetCompOffDaysRequest.Validate if the designated constraints aren't met.
-type GetCompOffDaysRequestValidationError struct {
+// ListOvertimePoliciesRequestValidationError is the validation error returned
+// by ListOvertimePoliciesRequest.Validate if the designated constraints
+// aren't met.
+type ListOvertimePoliciesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2503,24 +3643,24 @@ type GetCompOffDaysRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCompOffDaysRequestValidationError) Field() string { return e.field }
+func (e ListOvertimePoliciesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCompOffDaysRequestValidationError) Reason() string { return e.reason }
+func (e ListOvertimePoliciesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCompOffDaysRequestValidationError) Cause() error { return e.cause }
+func (e ListOvertimePoliciesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCompOffDaysRequestValidationError) Key() bool { return e.key }
+func (e ListOvertimePoliciesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCompOffDaysRequestValidationError) ErrorName() string {
-	return "GetCompOffDaysRequestValidationError"
+func (e ListOvertimePoliciesRequestValidationError) ErrorName() string {
+	return "ListOvertimePoliciesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCompOffDaysRequestValidationError) Error() string {
+func (e ListOvertimePoliciesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2532,14 +3672,14 @@ func (e GetCompOffDaysRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCompOffDaysRequest.%s: %s%s",
+		"invalid %sListOvertimePoliciesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCompOffDaysRequestValidationError{}
+var _ error = ListOvertimePoliciesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2547,46 +3687,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCompOffDaysRequestValidationError{}
+} = ListOvertimePoliciesRequestValidationError{}
 
-// Validate checks the field values on GetCompOffDaysResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListOvertimePoliciesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCompOffDaysResponse) Validate() error {
+func (m *ListOvertimePoliciesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCompOffDaysResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListOvertimePoliciesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCompOffDaysResponseMultiError, or nil if none found.
-func (m *GetCompOffDaysResponse) ValidateAll() error {
+// ListOvertimePoliciesResponseMultiError, or nil if none found.
+func (m *ListOvertimePoliciesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCompOffDaysResponse) validate(all bool) error {
+func (m *ListOvertimePoliciesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AvailableDays
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOvertimePoliciesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOvertimePoliciesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOvertimePoliciesResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return GetCompOffDaysResponseMultiError(errors)
+		return ListOvertimePoliciesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCompOffDaysResponseMultiError is an error wrapping multiple validation
-// errors returned by GetCompOffDaysResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetCompOffDaysResponseMultiError []error
+// ListOvertimePoliciesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListOvertimePoliciesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListOvertimePoliciesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCompOffDaysResponseMultiError) Error() string {
+func (m ListOvertimePoliciesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2595,11 +3767,12 @@ func (m GetCompOffDaysResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCompOffDaysResponseMultiError) AllErrors() []error { return m }
+func (m ListOvertimePoliciesResponseMultiError) AllErrors() []error { return m }
 
-// GetCompOffDaysResponseValidationError is the validation error returned by
-// GetCompOffDaysResponse.Validate if the designated constraints aren't met.
-type GetCompOffDaysResponseValidationError struct {
+// ListOvertimePoliciesResponseValidationError is the validation error returned
+// by ListOvertimePoliciesResponse.Validate if the designated constraints
+// aren't met.
+type ListOvertimePoliciesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2607,24 +3780,24 @@ type GetCompOffDaysResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCompOffDaysResponseValidationError) Field() string { return e.field }
+func (e ListOvertimePoliciesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCompOffDaysResponseValidationError) Reason() string { return e.reason }
+func (e ListOvertimePoliciesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCompOffDaysResponseValidationError) Cause() error { return e.cause }
+func (e ListOvertimePoliciesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCompOffDaysResponseValidationError) Key() bool { return e.key }
+func (e ListOvertimePoliciesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCompOffDaysResponseValidationError) ErrorName() string {
-	return "GetCompOffDaysResponseValidationError"
+func (e ListOvertimePoliciesResponseValidationError) ErrorName() string {
+	return "ListOvertimePoliciesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCompOffDaysResponseValidationError) Error() string {
+func (e ListOvertimePoliciesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2636,14 +3809,14 @@ func (e GetCompOffDaysResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCompOffDaysResponse.%s: %s%s",
+		"invalid %sListOvertimePoliciesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCompOffDaysResponseValidationError{}
+var _ error = ListOvertimePoliciesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2651,50 +3824,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCompOffDaysResponseValidationError{}
+} = ListOvertimePoliciesResponseValidationError{}
 
-// Validate checks the field values on UseCompOffDaysRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AssignOvertimePolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UseCompOffDaysRequest) Validate() error {
+func (m *AssignOvertimePolicyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UseCompOffDaysRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AssignOvertimePolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UseCompOffDaysRequestMultiError, or nil if none found.
-func (m *UseCompOffDaysRequest) ValidateAll() error {
+// AssignOvertimePolicyRequestMultiError, or nil if none found.
+func (m *AssignOvertimePolicyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UseCompOffDaysRequest) validate(all bool) error {
+func (m *AssignOvertimePolicyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TenantId
-
-	// no validation rules for EmployeeId
+	// no validation rules for Id
 
-	// no validation rules for Days
+	// no validation rules for PolicyId
 
 	if len(errors) > 0 {
-		return UseCompOffDaysRequestMultiError(errors)
+		return AssignOvertimePolicyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UseCompOffDaysRequestMultiError is an error wrapping multiple validation
-// errors returned by UseCompOffDaysRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UseCompOffDaysRequestMultiError []error
+// AssignOvertimePolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by AssignOvertimePolicyRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AssignOvertimePolicyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UseCompOffDaysRequestMultiError) Error() string {
+func (m AssignOvertimePolicyRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2703,11 +3874,12 @@ func (m UseCompOffDaysRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UseCompOffDaysRequestMultiError) AllErrors() []error { return m }
+func (m AssignOvertimePolicyRequestMultiError) AllErrors() []error { return m }
 
-// UseCompOffDaysRequestValidationError is the validation error returned by
-// UseCompOffDaysRequest.Validate if the designated constraints aren't met.
-type UseCompOffDaysRequestValidationError struct {
+// AssignOvertimePolicyRequestValidationError is the validation error returned
+// by AssignOvertimePolicyRequest.Validate if the designated constraints
+// aren't met.
+type AssignOvertimePolicyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2715,24 +3887,24 @@ type UseCompOffDaysRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UseCompOffDaysRequestValidationError) Field() string { return e.field }
+func (e AssignOvertimePolicyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UseCompOffDaysRequestValidationError) Reason() string { return e.reason }
+func (e AssignOvertimePolicyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UseCompOffDaysRequestValidationError) Cause() error { return e.cause }
+func (e AssignOvertimePolicyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UseCompOffDaysRequestValidationError) Key() bool { return e.key }
+func (e AssignOvertimePolicyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UseCompOffDaysRequestValidationError) ErrorName() string {
-	return "UseCompOffDaysRequestValidationError"
+func (e AssignOvertimePolicyRequestValidationError) ErrorName() string {
+	return "AssignOvertimePolicyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UseCompOffDaysRequestValidationError) Error() string {
+func (e AssignOvertimePolicyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2744,14 +3916,14 @@ func (e UseCompOffDaysRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUseCompOffDaysRequest.%s: %s%s",
+		"invalid %sAssignOvertimePolicyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UseCompOffDaysRequestValidationError{}
+var _ error = AssignOvertimePolicyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2759,50 +3931,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UseCompOffDaysRequestValidationError{}
+} = AssignOvertimePolicyRequestValidationError{}
 
-// Validate checks the field values on UseCompOffDaysResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ResolveOvertimePolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UseCompOffDaysResponse) Validate() error {
+func (m *ResolveOvertimePolicyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UseCompOffDaysResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ResolveOvertimePolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UseCompOffDaysResponseMultiError, or nil if none found.
-func (m *UseCompOffDaysResponse) ValidateAll() error {
+// ResolveOvertimePolicyRequestMultiError, or nil if none found.
+func (m *ResolveOvertimePolicyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UseCompOffDaysResponse) validate(all bool) error {
+func (m *ResolveOvertimePolicyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
-
-	// no validation rules for Message
-
-	// no validation rules for RemainingDays
+	// no validation rules for EmployeeId
 
 	if len(errors) > 0 {
-		return UseCompOffDaysResponseMultiError(errors)
+		return ResolveOvertimePolicyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UseCompOffDaysResponseMultiError is an error wrapping multiple validation
-// errors returned by UseCompOffDaysResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UseCompOffDaysResponseMultiError []error
+// ResolveOvertimePolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by ResolveOvertimePolicyRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ResolveOvertimePolicyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UseCompOffDaysResponseMultiError) Error() string {
+func (m ResolveOvertimePolicyRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2811,11 +3979,12 @@ func (m UseCompOffDaysResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UseCompOffDaysResponseMultiError) AllErrors() []error { return m }
+func (m ResolveOvertimePolicyRequestMultiError) AllErrors() []error { return m }
 
-// UseCompOffDaysResponseValidationError is the validation error returned by
-// UseCompOffDaysResponse.Validate if the designated constraints aren't met.
-type UseCompOffDaysResponseValidationError struct {
+// ResolveOvertimePolicyRequestValidationError is the validation error returned
+// by ResolveOvertimePolicyRequest.Validate if the designated constraints
+// aren't met.
+type ResolveOvertimePolicyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2823,24 +3992,24 @@ type UseCompOffDaysResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UseCompOffDaysResponseValidationError) Field() string { return e.field }
+func (e ResolveOvertimePolicyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UseCompOffDaysResponseValidationError) Reason() string { return e.reason }
+func (e ResolveOvertimePolicyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UseCompOffDaysResponseValidationError) Cause() error { return e.cause }
+func (e ResolveOvertimePolicyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UseCompOffDaysResponseValidationError) Key() bool { return e.key }
+func (e ResolveOvertimePolicyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UseCompOffDaysResponseValidationError) ErrorName() string {
-	return "UseCompOffDaysResponseValidationError"
+func (e ResolveOvertimePolicyRequestValidationError) ErrorName() string {
+	return "ResolveOvertimePolicyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UseCompOffDaysResponseValidationError) Error() string {
+func (e ResolveOvertimePolicyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2852,14 +4021,14 @@ func (e UseCompOffDaysResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUseCompOffDaysResponse.%s: %s%s",
+		"invalid %sResolveOvertimePolicyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UseCompOffDaysResponseValidationError{}
+var _ error = ResolveOvertimePolicyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2867,4 +4036,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UseCompOffDaysResponseValidationError{}
+} = ResolveOvertimePolicyRequestValidationError{}
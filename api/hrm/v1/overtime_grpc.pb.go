@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -559,3 +560,317 @@ var OvertimeService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/hrm/v1/overtime.proto",
 }
+
+// OvertimePolicyServiceClient is the client API for OvertimePolicyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OvertimePolicyServiceClient interface {
+	// 创建加班政策
+	CreateOvertimePolicy(ctx context.Context, in *OvertimePolicyRequest, opts ...grpc.CallOption) (*OvertimePolicyResponse, error)
+	// 租户加班政策列表
+	ListOvertimePolicies(ctx context.Context, in *ListOvertimePoliciesRequest, opts ...grpc.CallOption) (*ListOvertimePoliciesResponse, error)
+	// 获取加班政策
+	GetOvertimePolicy(ctx context.Context, in *GetOvertimePolicyRequest, opts ...grpc.CallOption) (*OvertimePolicyResponse, error)
+	// 更新加班政策（编码不可修改，已生成的加班记录不重算）
+	UpdateOvertimePolicy(ctx context.Context, in *OvertimePolicyRequest, opts ...grpc.CallOption) (*OvertimePolicyResponse, error)
+	// 删除加班政策（关联的考勤规则改用租户默认政策）
+	DeleteOvertimePolicy(ctx context.Context, in *DeleteOvertimePolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 考勤规则关联加班政策，规则适用范围内按该政策计算加班
+	AssignOvertimePolicy(ctx context.Context, in *AssignOvertimePolicyRequest, opts ...grpc.CallOption) (*AttendanceRuleResponse, error)
+	// 查询员工当前适用的加班政策
+	ResolveOvertimePolicy(ctx context.Context, in *ResolveOvertimePolicyRequest, opts ...grpc.CallOption) (*OvertimePolicyResponse, error)
+}
+
+type overtimePolicyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOvertimePolicyServiceClient(cc grpc.ClientConnInterface) OvertimePolicyServiceClient {
+	return &overtimePolicyServiceClient{cc}
+}
+
+func (c *overtimePolicyServiceClient) CreateOvertimePolicy(ctx context.Context, in *OvertimePolicyRequest, opts ...grpc.CallOption) (*OvertimePolicyResponse, error) {
+	out := new(OvertimePolicyResponse)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.OvertimePolicyService/CreateOvertimePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *overtimePolicyServiceClient) ListOvertimePolicies(ctx context.Context, in *ListOvertimePoliciesRequest, opts ...grpc.CallOption) (*ListOvertimePoliciesResponse, error) {
+	out := new(ListOvertimePoliciesResponse)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.OvertimePolicyService/ListOvertimePolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *overtimePolicyServiceClient) GetOvertimePolicy(ctx context.Context, in *GetOvertimePolicyRequest, opts ...grpc.CallOption) (*OvertimePolicyResponse, error) {
+	out := new(OvertimePolicyResponse)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.OvertimePolicyService/GetOvertimePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *overtimePolicyServiceClient) UpdateOvertimePolicy(ctx context.Context, in *OvertimePolicyRequest, opts ...grpc.CallOption) (*OvertimePolicyResponse, error) {
+	out := new(OvertimePolicyResponse)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.OvertimePolicyService/UpdateOvertimePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *overtimePolicyServiceClient) DeleteOvertimePolicy(ctx context.Context, in *DeleteOvertimePolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.OvertimePolicyService/DeleteOvertimePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *overtimePolicyServiceClient) AssignOvertimePolicy(ctx context.Context, in *AssignOvertimePolicyRequest, opts ...grpc.CallOption) (*AttendanceRuleResponse, error) {
+	out := new(AttendanceRuleResponse)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.OvertimePolicyService/AssignOvertimePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *overtimePolicyServiceClient) ResolveOvertimePolicy(ctx context.Context, in *ResolveOvertimePolicyRequest, opts ...grpc.CallOption) (*OvertimePolicyResponse, error) {
+	out := new(OvertimePolicyResponse)
+	err := c.cc.Invoke(ctx, "/api.hrm.v1.OvertimePolicyService/ResolveOvertimePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OvertimePolicyServiceServer is the server API for OvertimePolicyService service.
+// All implementations should embed UnimplementedOvertimePolicyServiceServer
+// for forward compatibility
+type OvertimePolicyServiceServer interface {
+	// 创建加班政策
+	CreateOvertimePolicy(context.Context, *OvertimePolicyRequest) (*OvertimePolicyResponse, error)
+	// 租户加班政策列表
+	ListOvertimePolicies(context.Context, *ListOvertimePoliciesRequest) (*ListOvertimePoliciesResponse, error)
+	// 获取加班政策
+	GetOvertimePolicy(context.Context, *GetOvertimePolicyRequest) (*OvertimePolicyResponse, error)
+	// 更新加班政策（编码不可修改，已生成的加班记录不重算）
+	UpdateOvertimePolicy(context.Context, *OvertimePolicyRequest) (*OvertimePolicyResponse, error)
+	// 删除加班政策（关联的考勤规则改用租户默认政策）
+	DeleteOvertimePolicy(context.Context, *DeleteOvertimePolicyRequest) (*emptypb.Empty, error)
+	// 考勤规则关联加班政策，规则适用范围内按该政策计算加班
+	AssignOvertimePolicy(context.Context, *AssignOvertimePolicyRequest) (*AttendanceRuleResponse, error)
+	// 查询员工当前适用的加班政策
+	ResolveOvertimePolicy(context.Context, *ResolveOvertimePolicyRequest) (*OvertimePolicyResponse, error)
+}
+
+// UnimplementedOvertimePolicyServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedOvertimePolicyServiceServer struct {
+}
+
+func (UnimplementedOvertimePolicyServiceServer) CreateOvertimePolicy(context.Context, *OvertimePolicyRequest) (*OvertimePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOvertimePolicy not implemented")
+}
+func (UnimplementedOvertimePolicyServiceServer) ListOvertimePolicies(context.Context, *ListOvertimePoliciesRequest) (*ListOvertimePoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOvertimePolicies not implemented")
+}
+func (UnimplementedOvertimePolicyServiceServer) GetOvertimePolicy(context.Context, *GetOvertimePolicyRequest) (*OvertimePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOvertimePolicy not implemented")
+}
+func (UnimplementedOvertimePolicyServiceServer) UpdateOvertimePolicy(context.Context, *OvertimePolicyRequest) (*OvertimePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOvertimePolicy not implemented")
+}
+func (UnimplementedOvertimePolicyServiceServer) DeleteOvertimePolicy(context.Context, *DeleteOvertimePolicyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOvertimePolicy not implemented")
+}
+func (UnimplementedOvertimePolicyServiceServer) AssignOvertimePolicy(context.Context, *AssignOvertimePolicyRequest) (*AttendanceRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignOvertimePolicy not implemented")
+}
+func (UnimplementedOvertimePolicyServiceServer) ResolveOvertimePolicy(context.Context, *ResolveOvertimePolicyRequest) (*OvertimePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveOvertimePolicy not implemented")
+}
+
+// UnsafeOvertimePolicyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OvertimePolicyServiceServer will
+// result in compilation errors.
+type UnsafeOvertimePolicyServiceServer interface {
+	mustEmbedUnimplementedOvertimePolicyServiceServer()
+}
+
+func RegisterOvertimePolicyServiceServer(s grpc.ServiceRegistrar, srv OvertimePolicyServiceServer) {
+	s.RegisterService(&OvertimePolicyService_ServiceDesc, srv)
+}
+
+func _OvertimePolicyService_CreateOvertimePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OvertimePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OvertimePolicyServiceServer).CreateOvertimePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.OvertimePolicyService/CreateOvertimePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OvertimePolicyServiceServer).CreateOvertimePolicy(ctx, req.(*OvertimePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OvertimePolicyService_ListOvertimePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOvertimePoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OvertimePolicyServiceServer).ListOvertimePolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.OvertimePolicyService/ListOvertimePolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OvertimePolicyServiceServer).ListOvertimePolicies(ctx, req.(*ListOvertimePoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OvertimePolicyService_GetOvertimePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOvertimePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OvertimePolicyServiceServer).GetOvertimePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.OvertimePolicyService/GetOvertimePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OvertimePolicyServiceServer).GetOvertimePolicy(ctx, req.(*GetOvertimePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OvertimePolicyService_UpdateOvertimePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OvertimePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OvertimePolicyServiceServer).UpdateOvertimePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.OvertimePolicyService/UpdateOvertimePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OvertimePolicyServiceServer).UpdateOvertimePolicy(ctx, req.(*OvertimePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OvertimePolicyService_DeleteOvertimePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOvertimePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OvertimePolicyServiceServer).DeleteOvertimePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.OvertimePolicyService/DeleteOvertimePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OvertimePolicyServiceServer).DeleteOvertimePolicy(ctx, req.(*DeleteOvertimePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OvertimePolicyService_AssignOvertimePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignOvertimePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OvertimePolicyServiceServer).AssignOvertimePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.OvertimePolicyService/AssignOvertimePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OvertimePolicyServiceServer).AssignOvertimePolicy(ctx, req.(*AssignOvertimePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OvertimePolicyService_ResolveOvertimePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveOvertimePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OvertimePolicyServiceServer).ResolveOvertimePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hrm.v1.OvertimePolicyService/ResolveOvertimePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OvertimePolicyServiceServer).ResolveOvertimePolicy(ctx, req.(*ResolveOvertimePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OvertimePolicyService_ServiceDesc is the grpc.ServiceDesc for OvertimePolicyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OvertimePolicyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.hrm.v1.OvertimePolicyService",
+	HandlerType: (*OvertimePolicyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateOvertimePolicy",
+			Handler:    _OvertimePolicyService_CreateOvertimePolicy_Handler,
+		},
+		{
+			MethodName: "ListOvertimePolicies",
+			Handler:    _OvertimePolicyService_ListOvertimePolicies_Handler,
+		},
+		{
+			MethodName: "GetOvertimePolicy",
+			Handler:    _OvertimePolicyService_GetOvertimePolicy_Handler,
+		},
+		{
+			MethodName: "UpdateOvertimePolicy",
+			Handler:    _OvertimePolicyService_UpdateOvertimePolicy_Handler,
+		},
+		{
+			MethodName: "DeleteOvertimePolicy",
+			Handler:    _OvertimePolicyService_DeleteOvertimePolicy_Handler,
+		},
+		{
+			MethodName: "AssignOvertimePolicy",
+			Handler:    _OvertimePolicyService_AssignOvertimePolicy_Handler,
+		},
+		{
+			MethodName: "ResolveOvertimePolicy",
+			Handler:    _OvertimePolicyService_ResolveOvertimePolicy_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/hrm/v1/overtime.proto",
+}
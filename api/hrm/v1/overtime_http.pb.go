@@ -10,6 +10,7 @@ import (
 	context "context"
 	http "github.com/go-kratos/kratos/v2/transport/http"
 	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -592,3 +593,319 @@ func (c *OvertimeServiceHTTPClientImpl) UseCompOffDays(ctx context.Context, in *
 	}
 	return &out, nil
 }
+
+const OperationOvertimePolicyServiceAssignOvertimePolicy = "/api.hrm.v1.OvertimePolicyService/AssignOvertimePolicy"
+const OperationOvertimePolicyServiceCreateOvertimePolicy = "/api.hrm.v1.OvertimePolicyService/CreateOvertimePolicy"
+const OperationOvertimePolicyServiceDeleteOvertimePolicy = "/api.hrm.v1.OvertimePolicyService/DeleteOvertimePolicy"
+const OperationOvertimePolicyServiceGetOvertimePolicy = "/api.hrm.v1.OvertimePolicyService/GetOvertimePolicy"
+const OperationOvertimePolicyServiceListOvertimePolicies = "/api.hrm.v1.OvertimePolicyService/ListOvertimePolicies"
+const OperationOvertimePolicyServiceResolveOvertimePolicy = "/api.hrm.v1.OvertimePolicyService/ResolveOvertimePolicy"
+const OperationOvertimePolicyServiceUpdateOvertimePolicy = "/api.hrm.v1.OvertimePolicyService/UpdateOvertimePolicy"
+
+type OvertimePolicyServiceHTTPServer interface {
+	// AssignOvertimePolicy 考勤规则关联加班政策，规则适用范围内按该政策计算加班
+	AssignOvertimePolicy(context.Context, *AssignOvertimePolicyRequest) (*AttendanceRuleResponse, error)
+	// CreateOvertimePolicy 创建加班政策
+	CreateOvertimePolicy(context.Context, *OvertimePolicyRequest) (*OvertimePolicyResponse, error)
+	// DeleteOvertimePolicy 删除加班政策（关联的考勤规则改用租户默认政策）
+	DeleteOvertimePolicy(context.Context, *DeleteOvertimePolicyRequest) (*emptypb.Empty, error)
+	// GetOvertimePolicy 获取加班政策
+	GetOvertimePolicy(context.Context, *GetOvertimePolicyRequest) (*OvertimePolicyResponse, error)
+	// ListOvertimePolicies 租户加班政策列表
+	ListOvertimePolicies(context.Context, *ListOvertimePoliciesRequest) (*ListOvertimePoliciesResponse, error)
+	// ResolveOvertimePolicy 查询员工当前适用的加班政策
+	ResolveOvertimePolicy(context.Context, *ResolveOvertimePolicyRequest) (*OvertimePolicyResponse, error)
+	// UpdateOvertimePolicy 更新加班政策（编码不可修改，已生成的加班记录不重算）
+	UpdateOvertimePolicy(context.Context, *OvertimePolicyRequest) (*OvertimePolicyResponse, error)
+}
+
+func RegisterOvertimePolicyServiceHTTPServer(s *http.Server, srv OvertimePolicyServiceHTTPServer) {
+	r := s.Route("/")
+	r.POST("/api/v1/hrm/overtime-policies", _OvertimePolicyService_CreateOvertimePolicy0_HTTP_Handler(srv))
+	r.GET("/api/v1/hrm/overtime-policies", _OvertimePolicyService_ListOvertimePolicies0_HTTP_Handler(srv))
+	r.GET("/api/v1/hrm/overtime-policies/{id}", _OvertimePolicyService_GetOvertimePolicy0_HTTP_Handler(srv))
+	r.PUT("/api/v1/hrm/overtime-policies/{id}", _OvertimePolicyService_UpdateOvertimePolicy0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/hrm/overtime-policies/{id}", _OvertimePolicyService_DeleteOvertimePolicy0_HTTP_Handler(srv))
+	r.PUT("/api/v1/hrm/attendance-rules/{id}/overtime-policy", _OvertimePolicyService_AssignOvertimePolicy0_HTTP_Handler(srv))
+	r.GET("/api/v1/hrm/employees/{employee_id}/overtime-policy", _OvertimePolicyService_ResolveOvertimePolicy0_HTTP_Handler(srv))
+}
+
+func _OvertimePolicyService_CreateOvertimePolicy0_HTTP_Handler(srv OvertimePolicyServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in OvertimePolicyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOvertimePolicyServiceCreateOvertimePolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateOvertimePolicy(ctx, req.(*OvertimePolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*OvertimePolicyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _OvertimePolicyService_ListOvertimePolicies0_HTTP_Handler(srv OvertimePolicyServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListOvertimePoliciesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOvertimePolicyServiceListOvertimePolicies)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListOvertimePolicies(ctx, req.(*ListOvertimePoliciesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListOvertimePoliciesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _OvertimePolicyService_GetOvertimePolicy0_HTTP_Handler(srv OvertimePolicyServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetOvertimePolicyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOvertimePolicyServiceGetOvertimePolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetOvertimePolicy(ctx, req.(*GetOvertimePolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*OvertimePolicyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _OvertimePolicyService_UpdateOvertimePolicy0_HTTP_Handler(srv OvertimePolicyServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in OvertimePolicyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOvertimePolicyServiceUpdateOvertimePolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateOvertimePolicy(ctx, req.(*OvertimePolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*OvertimePolicyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _OvertimePolicyService_DeleteOvertimePolicy0_HTTP_Handler(srv OvertimePolicyServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteOvertimePolicyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOvertimePolicyServiceDeleteOvertimePolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteOvertimePolicy(ctx, req.(*DeleteOvertimePolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _OvertimePolicyService_AssignOvertimePolicy0_HTTP_Handler(srv OvertimePolicyServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AssignOvertimePolicyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOvertimePolicyServiceAssignOvertimePolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AssignOvertimePolicy(ctx, req.(*AssignOvertimePolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AttendanceRuleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _OvertimePolicyService_ResolveOvertimePolicy0_HTTP_Handler(srv OvertimePolicyServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResolveOvertimePolicyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOvertimePolicyServiceResolveOvertimePolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResolveOvertimePolicy(ctx, req.(*ResolveOvertimePolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*OvertimePolicyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type OvertimePolicyServiceHTTPClient interface {
+	// AssignOvertimePolicy 考勤规则关联加班政策，规则适用范围内按该政策计算加班
+	AssignOvertimePolicy(ctx context.Context, req *AssignOvertimePolicyRequest, opts ...http.CallOption) (rsp *AttendanceRuleResponse, err error)
+	// CreateOvertimePolicy 创建加班政策
+	CreateOvertimePolicy(ctx context.Context, req *OvertimePolicyRequest, opts ...http.CallOption) (rsp *OvertimePolicyResponse, err error)
+	// DeleteOvertimePolicy 删除加班政策（关联的考勤规则改用租户默认政策）
+	DeleteOvertimePolicy(ctx context.Context, req *DeleteOvertimePolicyRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// GetOvertimePolicy 获取加班政策
+	GetOvertimePolicy(ctx context.Context, req *GetOvertimePolicyRequest, opts ...http.CallOption) (rsp *OvertimePolicyResponse, err error)
+	// ListOvertimePolicies 租户加班政策列表
+	ListOvertimePolicies(ctx context.Context, req *ListOvertimePoliciesRequest, opts ...http.CallOption) (rsp *ListOvertimePoliciesResponse, err error)
+	// ResolveOvertimePolicy 查询员工当前适用的加班政策
+	ResolveOvertimePolicy(ctx context.Context, req *ResolveOvertimePolicyRequest, opts ...http.CallOption) (rsp *OvertimePolicyResponse, err error)
+	// UpdateOvertimePolicy 更新加班政策（编码不可修改，已生成的加班记录不重算）
+	UpdateOvertimePolicy(ctx context.Context, req *OvertimePolicyRequest, opts ...http.CallOption) (rsp *OvertimePolicyResponse, err error)
+}
+
+type OvertimePolicyServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewOvertimePolicyServiceHTTPClient(client *http.Client) OvertimePolicyServiceHTTPClient {
+	return &OvertimePolicyServiceHTTPClientImpl{client}
+}
+
+// AssignOvertimePolicy 考勤规则关联加班政策，规则适用范围内按该政策计算加班
+func (c *OvertimePolicyServiceHTTPClientImpl) AssignOvertimePolicy(ctx context.Context, in *AssignOvertimePolicyRequest, opts ...http.CallOption) (*AttendanceRuleResponse, error) {
+	var out AttendanceRuleResponse
+	pattern := "/api/v1/hrm/attendance-rules/{id}/overtime-policy"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationOvertimePolicyServiceAssignOvertimePolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateOvertimePolicy 创建加班政策
+func (c *OvertimePolicyServiceHTTPClientImpl) CreateOvertimePolicy(ctx context.Context, in *OvertimePolicyRequest, opts ...http.CallOption) (*OvertimePolicyResponse, error) {
+	var out OvertimePolicyResponse
+	pattern := "/api/v1/hrm/overtime-policies"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationOvertimePolicyServiceCreateOvertimePolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteOvertimePolicy 删除加班政策（关联的考勤规则改用租户默认政策）
+func (c *OvertimePolicyServiceHTTPClientImpl) DeleteOvertimePolicy(ctx context.Context, in *DeleteOvertimePolicyRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/v1/hrm/overtime-policies/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationOvertimePolicyServiceDeleteOvertimePolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetOvertimePolicy 获取加班政策
+func (c *OvertimePolicyServiceHTTPClientImpl) GetOvertimePolicy(ctx context.Context, in *GetOvertimePolicyRequest, opts ...http.CallOption) (*OvertimePolicyResponse, error) {
+	var out OvertimePolicyResponse
+	pattern := "/api/v1/hrm/overtime-policies/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationOvertimePolicyServiceGetOvertimePolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListOvertimePolicies 租户加班政策列表
+func (c *OvertimePolicyServiceHTTPClientImpl) ListOvertimePolicies(ctx context.Context, in *ListOvertimePoliciesRequest, opts ...http.CallOption) (*ListOvertimePoliciesResponse, error) {
+	var out ListOvertimePoliciesResponse
+	pattern := "/api/v1/hrm/overtime-policies"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationOvertimePolicyServiceListOvertimePolicies))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ResolveOvertimePolicy 查询员工当前适用的加班政策
+func (c *OvertimePolicyServiceHTTPClientImpl) ResolveOvertimePolicy(ctx context.Context, in *ResolveOvertimePolicyRequest, opts ...http.CallOption) (*OvertimePolicyResponse, error) {
+	var out OvertimePolicyResponse
+	pattern := "/api/v1/hrm/employees/{employee_id}/overtime-policy"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationOvertimePolicyServiceResolveOvertimePolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateOvertimePolicy 更新加班政策（编码不可修改，已生成的加班记录不重算）
+func (c *OvertimePolicyServiceHTTPClientImpl) UpdateOvertimePolicy(ctx context.Context, in *OvertimePolicyRequest, opts ...http.CallOption) (*OvertimePolicyResponse, error) {
+	var out OvertimePolicyResponse
+	pattern := "/api/v1/hrm/overtime-policies/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationOvertimePolicyServiceUpdateOvertimePolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -1552,6 +1552,205 @@ func (x *SupplementEvidence) GetDescription() string {
 	return ""
 }
 
+// GetPunchCardSupplementPolicyRequest 查询补卡政策请求
+type GetPunchCardSupplementPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPunchCardSupplementPolicyRequest) Reset() {
+	*x = GetPunchCardSupplementPolicyRequest{}
+	mi := &file_api_hrm_v1_punch_card_supplement_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPunchCardSupplementPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPunchCardSupplementPolicyRequest) ProtoMessage() {}
+
+func (x *GetPunchCardSupplementPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_punch_card_supplement_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPunchCardSupplementPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetPunchCardSupplementPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_punch_card_supplement_proto_rawDescGZIP(), []int{21}
+}
+
+// SavePunchCardSupplementPolicyRequest 保存补卡政策请求（0 表示不限）
+type SavePunchCardSupplementPolicyRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	MaxPerMonth         int32                  `protobuf:"varint,1,opt,name=max_per_month,json=maxPerMonth,proto3" json:"max_per_month,omitempty"`
+	FilingDeadlineDays  int32                  `protobuf:"varint,2,opt,name=filing_deadline_days,json=filingDeadlineDays,proto3" json:"filing_deadline_days,omitempty"`
+	AllowedMissingTypes []string               `protobuf:"bytes,3,rep,name=allowed_missing_types,json=allowedMissingTypes,proto3" json:"allowed_missing_types,omitempty"` // 为空表示不限
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SavePunchCardSupplementPolicyRequest) Reset() {
+	*x = SavePunchCardSupplementPolicyRequest{}
+	mi := &file_api_hrm_v1_punch_card_supplement_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavePunchCardSupplementPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavePunchCardSupplementPolicyRequest) ProtoMessage() {}
+
+func (x *SavePunchCardSupplementPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_punch_card_supplement_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavePunchCardSupplementPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SavePunchCardSupplementPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_punch_card_supplement_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SavePunchCardSupplementPolicyRequest) GetMaxPerMonth() int32 {
+	if x != nil {
+		return x.MaxPerMonth
+	}
+	return 0
+}
+
+func (x *SavePunchCardSupplementPolicyRequest) GetFilingDeadlineDays() int32 {
+	if x != nil {
+		return x.FilingDeadlineDays
+	}
+	return 0
+}
+
+func (x *SavePunchCardSupplementPolicyRequest) GetAllowedMissingTypes() []string {
+	if x != nil {
+		return x.AllowedMissingTypes
+	}
+	return nil
+}
+
+// PunchCardSupplementPolicyResponse 补卡政策
+type PunchCardSupplementPolicyResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId            string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	MaxPerMonth         int32                  `protobuf:"varint,3,opt,name=max_per_month,json=maxPerMonth,proto3" json:"max_per_month,omitempty"`                      // 每月补卡次数上限，0 表示不限
+	FilingDeadlineDays  int32                  `protobuf:"varint,4,opt,name=filing_deadline_days,json=filingDeadlineDays,proto3" json:"filing_deadline_days,omitempty"` // 须在缺卡日期后 N 天内提交，0 表示不限
+	AllowedMissingTypes []string               `protobuf:"bytes,5,rep,name=allowed_missing_types,json=allowedMissingTypes,proto3" json:"allowed_missing_types,omitempty"`
+	UpdatedBy           string                 `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *PunchCardSupplementPolicyResponse) Reset() {
+	*x = PunchCardSupplementPolicyResponse{}
+	mi := &file_api_hrm_v1_punch_card_supplement_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PunchCardSupplementPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PunchCardSupplementPolicyResponse) ProtoMessage() {}
+
+func (x *PunchCardSupplementPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hrm_v1_punch_card_supplement_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PunchCardSupplementPolicyResponse.ProtoReflect.Descriptor instead.
+func (*PunchCardSupplementPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_api_hrm_v1_punch_card_supplement_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PunchCardSupplementPolicyResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PunchCardSupplementPolicyResponse) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *PunchCardSupplementPolicyResponse) GetMaxPerMonth() int32 {
+	if x != nil {
+		return x.MaxPerMonth
+	}
+	return 0
+}
+
+func (x *PunchCardSupplementPolicyResponse) GetFilingDeadlineDays() int32 {
+	if x != nil {
+		return x.FilingDeadlineDays
+	}
+	return 0
+}
+
+func (x *PunchCardSupplementPolicyResponse) GetAllowedMissingTypes() []string {
+	if x != nil {
+		return x.AllowedMissingTypes
+	}
+	return nil
+}
+
+func (x *PunchCardSupplementPolicyResponse) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *PunchCardSupplementPolicyResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PunchCardSupplementPolicyResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
 var File_api_hrm_v1_punch_card_supplement_proto protoreflect.FileDescriptor
 
 const file_api_hrm_v1_punch_card_supplement_proto_rawDesc = "" +
@@ -1686,7 +1885,24 @@ const file_api_hrm_v1_punch_card_supplement_proto_rawDesc = "" +
 	"\x12SupplementEvidence\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription2\xcc\x10\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"%\n" +
+	"#GetPunchCardSupplementPolicyRequest\"\xb0\x01\n" +
+	"$SavePunchCardSupplementPolicyRequest\x12\"\n" +
+	"\rmax_per_month\x18\x01 \x01(\x05R\vmaxPerMonth\x120\n" +
+	"\x14filing_deadline_days\x18\x02 \x01(\x05R\x12filingDeadlineDays\x122\n" +
+	"\x15allowed_missing_types\x18\x03 \x03(\tR\x13allowedMissingTypes\"\xef\x02\n" +
+	"!PunchCardSupplementPolicyResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\"\n" +
+	"\rmax_per_month\x18\x03 \x01(\x05R\vmaxPerMonth\x120\n" +
+	"\x14filing_deadline_days\x18\x04 \x01(\x05R\x12filingDeadlineDays\x122\n" +
+	"\x15allowed_missing_types\x18\x05 \x03(\tR\x13allowedMissingTypes\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x06 \x01(\tR\tupdatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt2\xa7\x13\n" +
 	"\x1aPunchCardSupplementService\x12\x99\x01\n" +
 	"\x19CreatePunchCardSupplement\x12(.hrm.v1.CreatePunchCardSupplementRequest\x1a#.hrm.v1.PunchCardSupplementResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/hrm/punch-card-supplements\x12\x9e\x01\n" +
 	"\x19UpdatePunchCardSupplement\x12(.hrm.v1.UpdatePunchCardSupplementRequest\x1a#.hrm.v1.PunchCardSupplementResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\x1a'/api/v1/hrm/punch-card-supplements/{id}\x12\xa1\x01\n" +
@@ -1699,7 +1915,9 @@ const file_api_hrm_v1_punch_card_supplement_proto_rawDesc = "" +
 	"\x1aApprovePunchCardSupplement\x12).hrm.v1.ApprovePunchCardSupplementRequest\x1a*.hrm.v1.ApprovePunchCardSupplementResponse\"E\x82\xd3\xe4\x93\x02?:\x01*\":/api/v1/hrm/punch-card-supplements/{supplement_id}/approve\x12\xb6\x01\n" +
 	"\x19RejectPunchCardSupplement\x12(.hrm.v1.RejectPunchCardSupplementRequest\x1a).hrm.v1.RejectPunchCardSupplementResponse\"D\x82\xd3\xe4\x93\x02>:\x01*\"9/api/v1/hrm/punch-card-supplements/{supplement_id}/reject\x12\xba\x01\n" +
 	"\x1aProcessPunchCardSupplement\x12).hrm.v1.ProcessPunchCardSupplementRequest\x1a*.hrm.v1.ProcessPunchCardSupplementResponse\"E\x82\xd3\xe4\x93\x02?:\x01*\":/api/v1/hrm/punch-card-supplements/{supplement_id}/process\x12\xb6\x01\n" +
-	"\x19CancelPunchCardSupplement\x12(.hrm.v1.CancelPunchCardSupplementRequest\x1a).hrm.v1.CancelPunchCardSupplementResponse\"D\x82\xd3\xe4\x93\x02>:\x01*\"9/api/v1/hrm/punch-card-supplements/{supplement_id}/cancelB\x95\x01\n" +
+	"\x19CancelPunchCardSupplement\x12(.hrm.v1.CancelPunchCardSupplementRequest\x1a).hrm.v1.CancelPunchCardSupplementResponse\"D\x82\xd3\xe4\x93\x02>:\x01*\"9/api/v1/hrm/punch-card-supplements/{supplement_id}/cancel\x12\xa8\x01\n" +
+	"\x1cGetPunchCardSupplementPolicy\x12+.hrm.v1.GetPunchCardSupplementPolicyRequest\x1a).hrm.v1.PunchCardSupplementPolicyResponse\"0\x82\xd3\xe4\x93\x02*\x12(/api/v1/hrm/punch-card-supplement-policy\x12\xad\x01\n" +
+	"\x1dSavePunchCardSupplementPolicy\x12,.hrm.v1.SavePunchCardSupplementPolicyRequest\x1a).hrm.v1.PunchCardSupplementPolicyResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\x1a(/api/v1/hrm/punch-card-supplement-policyB\x95\x01\n" +
 	"\n" +
 	"com.hrm.v1B\x18PunchCardSupplementProtoP\x01Z4github.com/lk2023060901/go-next-erp/api/hrm/v1;hrmv1\xa2\x02\x03HXX\xaa\x02\x06Hrm.V1\xca\x02\x06Hrm\\V1\xe2\x02\x12Hrm\\V1\\GPBMetadata\xea\x02\aHrm::V1b\x06proto3"
 
@@ -1715,7 +1933,7 @@ func file_api_hrm_v1_punch_card_supplement_proto_rawDescGZIP() []byte {
 	return file_api_hrm_v1_punch_card_supplement_proto_rawDescData
 }
 
-var file_api_hrm_v1_punch_card_supplement_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_api_hrm_v1_punch_card_supplement_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_api_hrm_v1_punch_card_supplement_proto_goTypes = []any{
 	(*CreatePunchCardSupplementRequest)(nil),        // 0: hrm.v1.CreatePunchCardSupplementRequest
 	(*UpdatePunchCardSupplementRequest)(nil),        // 1: hrm.v1.UpdatePunchCardSupplementRequest
@@ -1738,54 +1956,63 @@ var file_api_hrm_v1_punch_card_supplement_proto_goTypes = []any{
 	(*CancelPunchCardSupplementResponse)(nil),       // 18: hrm.v1.CancelPunchCardSupplementResponse
 	(*PunchCardSupplementResponse)(nil),             // 19: hrm.v1.PunchCardSupplementResponse
 	(*SupplementEvidence)(nil),                      // 20: hrm.v1.SupplementEvidence
-	(*timestamppb.Timestamp)(nil),                   // 21: google.protobuf.Timestamp
+	(*GetPunchCardSupplementPolicyRequest)(nil),     // 21: hrm.v1.GetPunchCardSupplementPolicyRequest
+	(*SavePunchCardSupplementPolicyRequest)(nil),    // 22: hrm.v1.SavePunchCardSupplementPolicyRequest
+	(*PunchCardSupplementPolicyResponse)(nil),       // 23: hrm.v1.PunchCardSupplementPolicyResponse
+	(*timestamppb.Timestamp)(nil),                   // 24: google.protobuf.Timestamp
 }
 var file_api_hrm_v1_punch_card_supplement_proto_depIdxs = []int32{
-	21, // 0: hrm.v1.CreatePunchCardSupplementRequest.supplement_date:type_name -> google.protobuf.Timestamp
-	21, // 1: hrm.v1.CreatePunchCardSupplementRequest.supplement_time:type_name -> google.protobuf.Timestamp
+	24, // 0: hrm.v1.CreatePunchCardSupplementRequest.supplement_date:type_name -> google.protobuf.Timestamp
+	24, // 1: hrm.v1.CreatePunchCardSupplementRequest.supplement_time:type_name -> google.protobuf.Timestamp
 	20, // 2: hrm.v1.CreatePunchCardSupplementRequest.evidence:type_name -> hrm.v1.SupplementEvidence
-	21, // 3: hrm.v1.UpdatePunchCardSupplementRequest.supplement_date:type_name -> google.protobuf.Timestamp
-	21, // 4: hrm.v1.UpdatePunchCardSupplementRequest.supplement_time:type_name -> google.protobuf.Timestamp
+	24, // 3: hrm.v1.UpdatePunchCardSupplementRequest.supplement_date:type_name -> google.protobuf.Timestamp
+	24, // 4: hrm.v1.UpdatePunchCardSupplementRequest.supplement_time:type_name -> google.protobuf.Timestamp
 	20, // 5: hrm.v1.UpdatePunchCardSupplementRequest.evidence:type_name -> hrm.v1.SupplementEvidence
-	21, // 6: hrm.v1.ListPunchCardSupplementsRequest.start_date:type_name -> google.protobuf.Timestamp
-	21, // 7: hrm.v1.ListPunchCardSupplementsRequest.end_date:type_name -> google.protobuf.Timestamp
+	24, // 6: hrm.v1.ListPunchCardSupplementsRequest.start_date:type_name -> google.protobuf.Timestamp
+	24, // 7: hrm.v1.ListPunchCardSupplementsRequest.end_date:type_name -> google.protobuf.Timestamp
 	19, // 8: hrm.v1.ListPunchCardSupplementsResponse.items:type_name -> hrm.v1.PunchCardSupplementResponse
-	21, // 9: hrm.v1.PunchCardSupplementResponse.supplement_date:type_name -> google.protobuf.Timestamp
-	21, // 10: hrm.v1.PunchCardSupplementResponse.supplement_time:type_name -> google.protobuf.Timestamp
+	24, // 9: hrm.v1.PunchCardSupplementResponse.supplement_date:type_name -> google.protobuf.Timestamp
+	24, // 10: hrm.v1.PunchCardSupplementResponse.supplement_time:type_name -> google.protobuf.Timestamp
 	20, // 11: hrm.v1.PunchCardSupplementResponse.evidence:type_name -> hrm.v1.SupplementEvidence
-	21, // 12: hrm.v1.PunchCardSupplementResponse.approved_at:type_name -> google.protobuf.Timestamp
-	21, // 13: hrm.v1.PunchCardSupplementResponse.processed_at:type_name -> google.protobuf.Timestamp
-	21, // 14: hrm.v1.PunchCardSupplementResponse.created_at:type_name -> google.protobuf.Timestamp
-	21, // 15: hrm.v1.PunchCardSupplementResponse.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 16: hrm.v1.PunchCardSupplementService.CreatePunchCardSupplement:input_type -> hrm.v1.CreatePunchCardSupplementRequest
-	1,  // 17: hrm.v1.PunchCardSupplementService.UpdatePunchCardSupplement:input_type -> hrm.v1.UpdatePunchCardSupplementRequest
-	2,  // 18: hrm.v1.PunchCardSupplementService.DeletePunchCardSupplement:input_type -> hrm.v1.DeletePunchCardSupplementRequest
-	4,  // 19: hrm.v1.PunchCardSupplementService.GetPunchCardSupplement:input_type -> hrm.v1.GetPunchCardSupplementRequest
-	5,  // 20: hrm.v1.PunchCardSupplementService.ListPunchCardSupplements:input_type -> hrm.v1.ListPunchCardSupplementsRequest
-	7,  // 21: hrm.v1.PunchCardSupplementService.ListEmployeePunchCardSupplements:input_type -> hrm.v1.ListEmployeePunchCardSupplementsRequest
-	8,  // 22: hrm.v1.PunchCardSupplementService.ListPendingPunchCardSupplements:input_type -> hrm.v1.ListPendingPunchCardSupplementsRequest
-	9,  // 23: hrm.v1.PunchCardSupplementService.SubmitPunchCardSupplement:input_type -> hrm.v1.SubmitPunchCardSupplementRequest
-	11, // 24: hrm.v1.PunchCardSupplementService.ApprovePunchCardSupplement:input_type -> hrm.v1.ApprovePunchCardSupplementRequest
-	13, // 25: hrm.v1.PunchCardSupplementService.RejectPunchCardSupplement:input_type -> hrm.v1.RejectPunchCardSupplementRequest
-	15, // 26: hrm.v1.PunchCardSupplementService.ProcessPunchCardSupplement:input_type -> hrm.v1.ProcessPunchCardSupplementRequest
-	17, // 27: hrm.v1.PunchCardSupplementService.CancelPunchCardSupplement:input_type -> hrm.v1.CancelPunchCardSupplementRequest
-	19, // 28: hrm.v1.PunchCardSupplementService.CreatePunchCardSupplement:output_type -> hrm.v1.PunchCardSupplementResponse
-	19, // 29: hrm.v1.PunchCardSupplementService.UpdatePunchCardSupplement:output_type -> hrm.v1.PunchCardSupplementResponse
-	3,  // 30: hrm.v1.PunchCardSupplementService.DeletePunchCardSupplement:output_type -> hrm.v1.DeletePunchCardSupplementResponse
-	19, // 31: hrm.v1.PunchCardSupplementService.GetPunchCardSupplement:output_type -> hrm.v1.PunchCardSupplementResponse
-	6,  // 32: hrm.v1.PunchCardSupplementService.ListPunchCardSupplements:output_type -> hrm.v1.ListPunchCardSupplementsResponse
-	6,  // 33: hrm.v1.PunchCardSupplementService.ListEmployeePunchCardSupplements:output_type -> hrm.v1.ListPunchCardSupplementsResponse
-	6,  // 34: hrm.v1.PunchCardSupplementService.ListPendingPunchCardSupplements:output_type -> hrm.v1.ListPunchCardSupplementsResponse
-	10, // 35: hrm.v1.PunchCardSupplementService.SubmitPunchCardSupplement:output_type -> hrm.v1.SubmitPunchCardSupplementResponse
-	12, // 36: hrm.v1.PunchCardSupplementService.ApprovePunchCardSupplement:output_type -> hrm.v1.ApprovePunchCardSupplementResponse
-	14, // 37: hrm.v1.PunchCardSupplementService.RejectPunchCardSupplement:output_type -> hrm.v1.RejectPunchCardSupplementResponse
-	16, // 38: hrm.v1.PunchCardSupplementService.ProcessPunchCardSupplement:output_type -> hrm.v1.ProcessPunchCardSupplementResponse
-	18, // 39: hrm.v1.PunchCardSupplementService.CancelPunchCardSupplement:output_type -> hrm.v1.CancelPunchCardSupplementResponse
-	28, // [28:40] is the sub-list for method output_type
-	16, // [16:28] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	24, // 12: hrm.v1.PunchCardSupplementResponse.approved_at:type_name -> google.protobuf.Timestamp
+	24, // 13: hrm.v1.PunchCardSupplementResponse.processed_at:type_name -> google.protobuf.Timestamp
+	24, // 14: hrm.v1.PunchCardSupplementResponse.created_at:type_name -> google.protobuf.Timestamp
+	24, // 15: hrm.v1.PunchCardSupplementResponse.updated_at:type_name -> google.protobuf.Timestamp
+	24, // 16: hrm.v1.PunchCardSupplementPolicyResponse.created_at:type_name -> google.protobuf.Timestamp
+	24, // 17: hrm.v1.PunchCardSupplementPolicyResponse.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 18: hrm.v1.PunchCardSupplementService.CreatePunchCardSupplement:input_type -> hrm.v1.CreatePunchCardSupplementRequest
+	1,  // 19: hrm.v1.PunchCardSupplementService.UpdatePunchCardSupplement:input_type -> hrm.v1.UpdatePunchCardSupplementRequest
+	2,  // 20: hrm.v1.PunchCardSupplementService.DeletePunchCardSupplement:input_type -> hrm.v1.DeletePunchCardSupplementRequest
+	4,  // 21: hrm.v1.PunchCardSupplementService.GetPunchCardSupplement:input_type -> hrm.v1.GetPunchCardSupplementRequest
+	5,  // 22: hrm.v1.PunchCardSupplementService.ListPunchCardSupplements:input_type -> hrm.v1.ListPunchCardSupplementsRequest
+	7,  // 23: hrm.v1.PunchCardSupplementService.ListEmployeePunchCardSupplements:input_type -> hrm.v1.ListEmployeePunchCardSupplementsRequest
+	8,  // 24: hrm.v1.PunchCardSupplementService.ListPendingPunchCardSupplements:input_type -> hrm.v1.ListPendingPunchCardSupplementsRequest
+	9,  // 25: hrm.v1.PunchCardSupplementService.SubmitPunchCardSupplement:input_type -> hrm.v1.SubmitPunchCardSupplementRequest
+	11, // 26: hrm.v1.PunchCardSupplementService.ApprovePunchCardSupplement:input_type -> hrm.v1.ApprovePunchCardSupplementRequest
+	13, // 27: hrm.v1.PunchCardSupplementService.RejectPunchCardSupplement:input_type -> hrm.v1.RejectPunchCardSupplementRequest
+	15, // 28: hrm.v1.PunchCardSupplementService.ProcessPunchCardSupplement:input_type -> hrm.v1.ProcessPunchCardSupplementRequest
+	17, // 29: hrm.v1.PunchCardSupplementService.CancelPunchCardSupplement:input_type -> hrm.v1.CancelPunchCardSupplementRequest
+	21, // 30: hrm.v1.PunchCardSupplementService.GetPunchCardSupplementPolicy:input_type -> hrm.v1.GetPunchCardSupplementPolicyRequest
+	22, // 31: hrm.v1.PunchCardSupplementService.SavePunchCardSupplementPolicy:input_type -> hrm.v1.SavePunchCardSupplementPolicyRequest
+	19, // 32: hrm.v1.PunchCardSupplementService.CreatePunchCardSupplement:output_type -> hrm.v1.PunchCardSupplementResponse
+	19, // 33: hrm.v1.PunchCardSupplementService.UpdatePunchCardSupplement:output_type -> hrm.v1.PunchCardSupplementResponse
+	3,  // 34: hrm.v1.PunchCardSupplementService.DeletePunchCardSupplement:output_type -> hrm.v1.DeletePunchCardSupplementResponse
+	19, // 35: hrm.v1.PunchCardSupplementService.GetPunchCardSupplement:output_type -> hrm.v1.PunchCardSupplementResponse
+	6,  // 36: hrm.v1.PunchCardSupplementService.ListPunchCardSupplements:output_type -> hrm.v1.ListPunchCardSupplementsResponse
+	6,  // 37: hrm.v1.PunchCardSupplementService.ListEmployeePunchCardSupplements:output_type -> hrm.v1.ListPunchCardSupplementsResponse
+	6,  // 38: hrm.v1.PunchCardSupplementService.ListPendingPunchCardSupplements:output_type -> hrm.v1.ListPunchCardSupplementsResponse
+	10, // 39: hrm.v1.PunchCardSupplementService.SubmitPunchCardSupplement:output_type -> hrm.v1.SubmitPunchCardSupplementResponse
+	12, // 40: hrm.v1.PunchCardSupplementService.ApprovePunchCardSupplement:output_type -> hrm.v1.ApprovePunchCardSupplementResponse
+	14, // 41: hrm.v1.PunchCardSupplementService.RejectPunchCardSupplement:output_type -> hrm.v1.RejectPunchCardSupplementResponse
+	16, // 42: hrm.v1.PunchCardSupplementService.ProcessPunchCardSupplement:output_type -> hrm.v1.ProcessPunchCardSupplementResponse
+	18, // 43: hrm.v1.PunchCardSupplementService.CancelPunchCardSupplement:output_type -> hrm.v1.CancelPunchCardSupplementResponse
+	23, // 44: hrm.v1.PunchCardSupplementService.GetPunchCardSupplementPolicy:output_type -> hrm.v1.PunchCardSupplementPolicyResponse
+	23, // 45: hrm.v1.PunchCardSupplementService.SavePunchCardSupplementPolicy:output_type -> hrm.v1.PunchCardSupplementPolicyResponse
+	32, // [32:46] is the sub-list for method output_type
+	18, // [18:32] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_api_hrm_v1_punch_card_supplement_proto_init() }
@@ -1799,7 +2026,7 @@ func file_api_hrm_v1_punch_card_supplement_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_hrm_v1_punch_card_supplement_proto_rawDesc), len(file_api_hrm_v1_punch_card_supplement_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
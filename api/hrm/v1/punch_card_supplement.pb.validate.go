@@ -2872,3 +2872,390 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = SupplementEvidenceValidationError{}
+
+// Validate checks the field values on GetPunchCardSupplementPolicyRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetPunchCardSupplementPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPunchCardSupplementPolicyRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetPunchCardSupplementPolicyRequestMultiError, or nil if none found.
+func (m *GetPunchCardSupplementPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPunchCardSupplementPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetPunchCardSupplementPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPunchCardSupplementPolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetPunchCardSupplementPolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPunchCardSupplementPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPunchCardSupplementPolicyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPunchCardSupplementPolicyRequestMultiError) AllErrors() []error { return m }
+
+// GetPunchCardSupplementPolicyRequestValidationError is the validation error
+// returned by GetPunchCardSupplementPolicyRequest.Validate if the designated
+// constraints aren't met.
+type GetPunchCardSupplementPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPunchCardSupplementPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPunchCardSupplementPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPunchCardSupplementPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPunchCardSupplementPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPunchCardSupplementPolicyRequestValidationError) ErrorName() string {
+	return "GetPunchCardSupplementPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPunchCardSupplementPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPunchCardSupplementPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPunchCardSupplementPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPunchCardSupplementPolicyRequestValidationError{}
+
+// Validate checks the field values on SavePunchCardSupplementPolicyRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SavePunchCardSupplementPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SavePunchCardSupplementPolicyRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SavePunchCardSupplementPolicyRequestMultiError, or nil if none found.
+func (m *SavePunchCardSupplementPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SavePunchCardSupplementPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MaxPerMonth
+
+	// no validation rules for FilingDeadlineDays
+
+	if len(errors) > 0 {
+		return SavePunchCardSupplementPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SavePunchCardSupplementPolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// SavePunchCardSupplementPolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SavePunchCardSupplementPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SavePunchCardSupplementPolicyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SavePunchCardSupplementPolicyRequestMultiError) AllErrors() []error { return m }
+
+// SavePunchCardSupplementPolicyRequestValidationError is the validation error
+// returned by SavePunchCardSupplementPolicyRequest.Validate if the designated
+// constraints aren't met.
+type SavePunchCardSupplementPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SavePunchCardSupplementPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SavePunchCardSupplementPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SavePunchCardSupplementPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SavePunchCardSupplementPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SavePunchCardSupplementPolicyRequestValidationError) ErrorName() string {
+	return "SavePunchCardSupplementPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SavePunchCardSupplementPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSavePunchCardSupplementPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SavePunchCardSupplementPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SavePunchCardSupplementPolicyRequestValidationError{}
+
+// Validate checks the field values on PunchCardSupplementPolicyResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PunchCardSupplementPolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PunchCardSupplementPolicyResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PunchCardSupplementPolicyResponseMultiError, or nil if none found.
+func (m *PunchCardSupplementPolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PunchCardSupplementPolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TenantId
+
+	// no validation rules for MaxPerMonth
+
+	// no validation rules for FilingDeadlineDays
+
+	// no validation rules for UpdatedBy
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PunchCardSupplementPolicyResponseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PunchCardSupplementPolicyResponseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PunchCardSupplementPolicyResponseValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PunchCardSupplementPolicyResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PunchCardSupplementPolicyResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PunchCardSupplementPolicyResponseValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PunchCardSupplementPolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PunchCardSupplementPolicyResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// PunchCardSupplementPolicyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PunchCardSupplementPolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PunchCardSupplementPolicyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PunchCardSupplementPolicyResponseMultiError) AllErrors() []error { return m }
+
+// PunchCardSupplementPolicyResponseValidationError is the validation error
+// returned by PunchCardSupplementPolicyResponse.Validate if the designated
+// constraints aren't met.
+type PunchCardSupplementPolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PunchCardSupplementPolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PunchCardSupplementPolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PunchCardSupplementPolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PunchCardSupplementPolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PunchCardSupplementPolicyResponseValidationError) ErrorName() string {
+	return "PunchCardSupplementPolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PunchCardSupplementPolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPunchCardSupplementPolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PunchCardSupplementPolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PunchCardSupplementPolicyResponseValidationError{}
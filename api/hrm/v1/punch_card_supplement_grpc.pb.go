@@ -46,6 +46,10 @@ type PunchCardSupplementServiceClient interface {
 	ProcessPunchCardSupplement(ctx context.Context, in *ProcessPunchCardSupplementRequest, opts ...grpc.CallOption) (*ProcessPunchCardSupplementResponse, error)
 	// CancelPunchCardSupplement 取消补卡申请
 	CancelPunchCardSupplement(ctx context.Context, in *CancelPunchCardSupplementRequest, opts ...grpc.CallOption) (*CancelPunchCardSupplementResponse, error)
+	// 查询租户补卡政策（未配置时返回默认政策）
+	GetPunchCardSupplementPolicy(ctx context.Context, in *GetPunchCardSupplementPolicyRequest, opts ...grpc.CallOption) (*PunchCardSupplementPolicyResponse, error)
+	// 保存租户补卡政策（只影响之后提交或修改的补卡申请）
+	SavePunchCardSupplementPolicy(ctx context.Context, in *SavePunchCardSupplementPolicyRequest, opts ...grpc.CallOption) (*PunchCardSupplementPolicyResponse, error)
 }
 
 type punchCardSupplementServiceClient struct {
@@ -164,6 +168,24 @@ func (c *punchCardSupplementServiceClient) CancelPunchCardSupplement(ctx context
 	return out, nil
 }
 
+func (c *punchCardSupplementServiceClient) GetPunchCardSupplementPolicy(ctx context.Context, in *GetPunchCardSupplementPolicyRequest, opts ...grpc.CallOption) (*PunchCardSupplementPolicyResponse, error) {
+	out := new(PunchCardSupplementPolicyResponse)
+	err := c.cc.Invoke(ctx, "/hrm.v1.PunchCardSupplementService/GetPunchCardSupplementPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *punchCardSupplementServiceClient) SavePunchCardSupplementPolicy(ctx context.Context, in *SavePunchCardSupplementPolicyRequest, opts ...grpc.CallOption) (*PunchCardSupplementPolicyResponse, error) {
+	out := new(PunchCardSupplementPolicyResponse)
+	err := c.cc.Invoke(ctx, "/hrm.v1.PunchCardSupplementService/SavePunchCardSupplementPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PunchCardSupplementServiceServer is the server API for PunchCardSupplementService service.
 // All implementations should embed UnimplementedPunchCardSupplementServiceServer
 // for forward compatibility
@@ -192,6 +214,10 @@ type PunchCardSupplementServiceServer interface {
 	ProcessPunchCardSupplement(context.Context, *ProcessPunchCardSupplementRequest) (*ProcessPunchCardSupplementResponse, error)
 	// CancelPunchCardSupplement 取消补卡申请
 	CancelPunchCardSupplement(context.Context, *CancelPunchCardSupplementRequest) (*CancelPunchCardSupplementResponse, error)
+	// 查询租户补卡政策（未配置时返回默认政策）
+	GetPunchCardSupplementPolicy(context.Context, *GetPunchCardSupplementPolicyRequest) (*PunchCardSupplementPolicyResponse, error)
+	// 保存租户补卡政策（只影响之后提交或修改的补卡申请）
+	SavePunchCardSupplementPolicy(context.Context, *SavePunchCardSupplementPolicyRequest) (*PunchCardSupplementPolicyResponse, error)
 }
 
 // UnimplementedPunchCardSupplementServiceServer should be embedded to have forward compatible implementations.
@@ -234,6 +260,12 @@ func (UnimplementedPunchCardSupplementServiceServer) ProcessPunchCardSupplement(
 func (UnimplementedPunchCardSupplementServiceServer) CancelPunchCardSupplement(context.Context, *CancelPunchCardSupplementRequest) (*CancelPunchCardSupplementResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelPunchCardSupplement not implemented")
 }
+func (UnimplementedPunchCardSupplementServiceServer) GetPunchCardSupplementPolicy(context.Context, *GetPunchCardSupplementPolicyRequest) (*PunchCardSupplementPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPunchCardSupplementPolicy not implemented")
+}
+func (UnimplementedPunchCardSupplementServiceServer) SavePunchCardSupplementPolicy(context.Context, *SavePunchCardSupplementPolicyRequest) (*PunchCardSupplementPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SavePunchCardSupplementPolicy not implemented")
+}
 
 // UnsafePunchCardSupplementServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to PunchCardSupplementServiceServer will
@@ -462,6 +494,42 @@ func _PunchCardSupplementService_CancelPunchCardSupplement_Handler(srv interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PunchCardSupplementService_GetPunchCardSupplementPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPunchCardSupplementPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PunchCardSupplementServiceServer).GetPunchCardSupplementPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hrm.v1.PunchCardSupplementService/GetPunchCardSupplementPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PunchCardSupplementServiceServer).GetPunchCardSupplementPolicy(ctx, req.(*GetPunchCardSupplementPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PunchCardSupplementService_SavePunchCardSupplementPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavePunchCardSupplementPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PunchCardSupplementServiceServer).SavePunchCardSupplementPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hrm.v1.PunchCardSupplementService/SavePunchCardSupplementPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PunchCardSupplementServiceServer).SavePunchCardSupplementPolicy(ctx, req.(*SavePunchCardSupplementPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PunchCardSupplementService_ServiceDesc is the grpc.ServiceDesc for PunchCardSupplementService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -517,6 +585,14 @@ var PunchCardSupplementService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelPunchCardSupplement",
 			Handler:    _PunchCardSupplementService_CancelPunchCardSupplement_Handler,
 		},
+		{
+			MethodName: "GetPunchCardSupplementPolicy",
+			Handler:    _PunchCardSupplementService_GetPunchCardSupplementPolicy_Handler,
+		},
+		{
+			MethodName: "SavePunchCardSupplementPolicy",
+			Handler:    _PunchCardSupplementService_SavePunchCardSupplementPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/hrm/v1/punch_card_supplement.proto",
@@ -24,11 +24,13 @@ const OperationPunchCardSupplementServiceCancelPunchCardSupplement = "/hrm.v1.Pu
 const OperationPunchCardSupplementServiceCreatePunchCardSupplement = "/hrm.v1.PunchCardSupplementService/CreatePunchCardSupplement"
 const OperationPunchCardSupplementServiceDeletePunchCardSupplement = "/hrm.v1.PunchCardSupplementService/DeletePunchCardSupplement"
 const OperationPunchCardSupplementServiceGetPunchCardSupplement = "/hrm.v1.PunchCardSupplementService/GetPunchCardSupplement"
+const OperationPunchCardSupplementServiceGetPunchCardSupplementPolicy = "/hrm.v1.PunchCardSupplementService/GetPunchCardSupplementPolicy"
 const OperationPunchCardSupplementServiceListEmployeePunchCardSupplements = "/hrm.v1.PunchCardSupplementService/ListEmployeePunchCardSupplements"
 const OperationPunchCardSupplementServiceListPendingPunchCardSupplements = "/hrm.v1.PunchCardSupplementService/ListPendingPunchCardSupplements"
 const OperationPunchCardSupplementServiceListPunchCardSupplements = "/hrm.v1.PunchCardSupplementService/ListPunchCardSupplements"
 const OperationPunchCardSupplementServiceProcessPunchCardSupplement = "/hrm.v1.PunchCardSupplementService/ProcessPunchCardSupplement"
 const OperationPunchCardSupplementServiceRejectPunchCardSupplement = "/hrm.v1.PunchCardSupplementService/RejectPunchCardSupplement"
+const OperationPunchCardSupplementServiceSavePunchCardSupplementPolicy = "/hrm.v1.PunchCardSupplementService/SavePunchCardSupplementPolicy"
 const OperationPunchCardSupplementServiceSubmitPunchCardSupplement = "/hrm.v1.PunchCardSupplementService/SubmitPunchCardSupplement"
 const OperationPunchCardSupplementServiceUpdatePunchCardSupplement = "/hrm.v1.PunchCardSupplementService/UpdatePunchCardSupplement"
 
@@ -43,6 +45,8 @@ type PunchCardSupplementServiceHTTPServer interface {
 	DeletePunchCardSupplement(context.Context, *DeletePunchCardSupplementRequest) (*DeletePunchCardSupplementResponse, error)
 	// GetPunchCardSupplement GetPunchCardSupplement 获取补卡申请详情
 	GetPunchCardSupplement(context.Context, *GetPunchCardSupplementRequest) (*PunchCardSupplementResponse, error)
+	// GetPunchCardSupplementPolicy 查询租户补卡政策（未配置时返回默认政策）
+	GetPunchCardSupplementPolicy(context.Context, *GetPunchCardSupplementPolicyRequest) (*PunchCardSupplementPolicyResponse, error)
 	// ListEmployeePunchCardSupplements ListEmployeePunchCardSupplements 查询员工补卡申请记录
 	ListEmployeePunchCardSupplements(context.Context, *ListEmployeePunchCardSupplementsRequest) (*ListPunchCardSupplementsResponse, error)
 	// ListPendingPunchCardSupplements ListPendingPunchCardSupplements 查询待审批的补卡申请
@@ -53,6 +57,8 @@ type PunchCardSupplementServiceHTTPServer interface {
 	ProcessPunchCardSupplement(context.Context, *ProcessPunchCardSupplementRequest) (*ProcessPunchCardSupplementResponse, error)
 	// RejectPunchCardSupplement RejectPunchCardSupplement 拒绝补卡申请
 	RejectPunchCardSupplement(context.Context, *RejectPunchCardSupplementRequest) (*RejectPunchCardSupplementResponse, error)
+	// SavePunchCardSupplementPolicy 保存租户补卡政策（只影响之后提交或修改的补卡申请）
+	SavePunchCardSupplementPolicy(context.Context, *SavePunchCardSupplementPolicyRequest) (*PunchCardSupplementPolicyResponse, error)
 	// SubmitPunchCardSupplement SubmitPunchCardSupplement 提交补卡申请审批
 	SubmitPunchCardSupplement(context.Context, *SubmitPunchCardSupplementRequest) (*SubmitPunchCardSupplementResponse, error)
 	// UpdatePunchCardSupplement UpdatePunchCardSupplement 更新补卡申请
@@ -73,6 +79,8 @@ func RegisterPunchCardSupplementServiceHTTPServer(s *http.Server, srv PunchCardS
 	r.POST("/api/v1/hrm/punch-card-supplements/{supplement_id}/reject", _PunchCardSupplementService_RejectPunchCardSupplement0_HTTP_Handler(srv))
 	r.POST("/api/v1/hrm/punch-card-supplements/{supplement_id}/process", _PunchCardSupplementService_ProcessPunchCardSupplement0_HTTP_Handler(srv))
 	r.POST("/api/v1/hrm/punch-card-supplements/{supplement_id}/cancel", _PunchCardSupplementService_CancelPunchCardSupplement0_HTTP_Handler(srv))
+	r.GET("/api/v1/hrm/punch-card-supplement-policy", _PunchCardSupplementService_GetPunchCardSupplementPolicy0_HTTP_Handler(srv))
+	r.PUT("/api/v1/hrm/punch-card-supplement-policy", _PunchCardSupplementService_SavePunchCardSupplementPolicy0_HTTP_Handler(srv))
 }
 
 func _PunchCardSupplementService_CreatePunchCardSupplement0_HTTP_Handler(srv PunchCardSupplementServiceHTTPServer) func(ctx http.Context) error {
@@ -351,6 +359,47 @@ func _PunchCardSupplementService_CancelPunchCardSupplement0_HTTP_Handler(srv Pun
 	}
 }
 
+func _PunchCardSupplementService_GetPunchCardSupplementPolicy0_HTTP_Handler(srv PunchCardSupplementServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetPunchCardSupplementPolicyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPunchCardSupplementServiceGetPunchCardSupplementPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetPunchCardSupplementPolicy(ctx, req.(*GetPunchCardSupplementPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PunchCardSupplementPolicyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PunchCardSupplementService_SavePunchCardSupplementPolicy0_HTTP_Handler(srv PunchCardSupplementServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SavePunchCardSupplementPolicyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPunchCardSupplementServiceSavePunchCardSupplementPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SavePunchCardSupplementPolicy(ctx, req.(*SavePunchCardSupplementPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PunchCardSupplementPolicyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type PunchCardSupplementServiceHTTPClient interface {
 	// ApprovePunchCardSupplement ApprovePunchCardSupplement 批准补卡申请
 	ApprovePunchCardSupplement(ctx context.Context, req *ApprovePunchCardSupplementRequest, opts ...http.CallOption) (rsp *ApprovePunchCardSupplementResponse, err error)
@@ -362,6 +411,8 @@ type PunchCardSupplementServiceHTTPClient interface {
 	DeletePunchCardSupplement(ctx context.Context, req *DeletePunchCardSupplementRequest, opts ...http.CallOption) (rsp *DeletePunchCardSupplementResponse, err error)
 	// GetPunchCardSupplement GetPunchCardSupplement 获取补卡申请详情
 	GetPunchCardSupplement(ctx context.Context, req *GetPunchCardSupplementRequest, opts ...http.CallOption) (rsp *PunchCardSupplementResponse, err error)
+	// GetPunchCardSupplementPolicy 查询租户补卡政策（未配置时返回默认政策）
+	GetPunchCardSupplementPolicy(ctx context.Context, req *GetPunchCardSupplementPolicyRequest, opts ...http.CallOption) (rsp *PunchCardSupplementPolicyResponse, err error)
 	// ListEmployeePunchCardSupplements ListEmployeePunchCardSupplements 查询员工补卡申请记录
 	ListEmployeePunchCardSupplements(ctx context.Context, req *ListEmployeePunchCardSupplementsRequest, opts ...http.CallOption) (rsp *ListPunchCardSupplementsResponse, err error)
 	// ListPendingPunchCardSupplements ListPendingPunchCardSupplements 查询待审批的补卡申请
@@ -372,6 +423,8 @@ type PunchCardSupplementServiceHTTPClient interface {
 	ProcessPunchCardSupplement(ctx context.Context, req *ProcessPunchCardSupplementRequest, opts ...http.CallOption) (rsp *ProcessPunchCardSupplementResponse, err error)
 	// RejectPunchCardSupplement RejectPunchCardSupplement 拒绝补卡申请
 	RejectPunchCardSupplement(ctx context.Context, req *RejectPunchCardSupplementRequest, opts ...http.CallOption) (rsp *RejectPunchCardSupplementResponse, err error)
+	// SavePunchCardSupplementPolicy 保存租户补卡政策（只影响之后提交或修改的补卡申请）
+	SavePunchCardSupplementPolicy(ctx context.Context, req *SavePunchCardSupplementPolicyRequest, opts ...http.CallOption) (rsp *PunchCardSupplementPolicyResponse, err error)
 	// SubmitPunchCardSupplement SubmitPunchCardSupplement 提交补卡申请审批
 	SubmitPunchCardSupplement(ctx context.Context, req *SubmitPunchCardSupplementRequest, opts ...http.CallOption) (rsp *SubmitPunchCardSupplementResponse, err error)
 	// UpdatePunchCardSupplement UpdatePunchCardSupplement 更新补卡申请
@@ -456,6 +509,20 @@ func (c *PunchCardSupplementServiceHTTPClientImpl) GetPunchCardSupplement(ctx co
 	return &out, nil
 }
 
+// GetPunchCardSupplementPolicy 查询租户补卡政策（未配置时返回默认政策）
+func (c *PunchCardSupplementServiceHTTPClientImpl) GetPunchCardSupplementPolicy(ctx context.Context, in *GetPunchCardSupplementPolicyRequest, opts ...http.CallOption) (*PunchCardSupplementPolicyResponse, error) {
+	var out PunchCardSupplementPolicyResponse
+	pattern := "/api/v1/hrm/punch-card-supplement-policy"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPunchCardSupplementServiceGetPunchCardSupplementPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListEmployeePunchCardSupplements ListEmployeePunchCardSupplements 查询员工补卡申请记录
 func (c *PunchCardSupplementServiceHTTPClientImpl) ListEmployeePunchCardSupplements(ctx context.Context, in *ListEmployeePunchCardSupplementsRequest, opts ...http.CallOption) (*ListPunchCardSupplementsResponse, error) {
 	var out ListPunchCardSupplementsResponse
@@ -526,6 +593,20 @@ func (c *PunchCardSupplementServiceHTTPClientImpl) RejectPunchCardSupplement(ctx
 	return &out, nil
 }
 
+// SavePunchCardSupplementPolicy 保存租户补卡政策（只影响之后提交或修改的补卡申请）
+func (c *PunchCardSupplementServiceHTTPClientImpl) SavePunchCardSupplementPolicy(ctx context.Context, in *SavePunchCardSupplementPolicyRequest, opts ...http.CallOption) (*PunchCardSupplementPolicyResponse, error) {
+	var out PunchCardSupplementPolicyResponse
+	pattern := "/api/v1/hrm/punch-card-supplement-policy"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPunchCardSupplementServiceSavePunchCardSupplementPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // SubmitPunchCardSupplement SubmitPunchCardSupplement 提交补卡申请审批
 func (c *PunchCardSupplementServiceHTTPClientImpl) SubmitPunchCardSupplement(ctx context.Context, in *SubmitPunchCardSupplementRequest, opts ...http.CallOption) (*SubmitPunchCardSupplementResponse, error) {
 	var out SubmitPunchCardSupplementResponse
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/conf"
+	"github.com/lk2023060901/go-next-erp/internal/hrm"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository/postgres"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/service"
+	"github.com/lk2023060901/go-next-erp/pkg"
+)
+
+// rotatekeys 轮换 HRM 敏感字段密钥并批量重新加密存量数据
+//
+// 数据密钥轮换：  rotatekeys -conf configs/config.yaml
+// 主密钥轮换：    先在配置中新增主密钥并设为 active_master_key，再执行 rotatekeys -rewrap
+// 明文数据迁移：  rotatekeys -reencrypt-only（不生成新数据密钥，仅加密明文、迁移旧版本密文）
+func main() {
+	var (
+		flagconf      string
+		tenant        string
+		batchSize     int
+		rewrap        bool
+		reencryptOnly bool
+	)
+	flag.StringVar(&flagconf, "conf", "../../configs/config.yaml", "config path, eg: -conf config.yaml")
+	flag.StringVar(&tenant, "tenant", "", "only rotate the given tenant ID (default: all tenants)")
+	flag.IntVar(&batchSize, "batch", 200, "rows re-encrypted per transaction")
+	flag.BoolVar(&rewrap, "rewrap", false, "rewrap all data keys with the active master key")
+	flag.BoolVar(&reencryptOnly, "reencrypt-only", false, "re-encrypt rows without generating a new data key")
+	flag.Parse()
+
+	cfg, err := conf.Load(flagconf)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	ctx := context.Background()
+	db, cleanup, err := pkg.ProvideDatabase(ctx, cfg)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer cleanup()
+
+	cipher, err := hrm.ProvideFieldCipher(cfg, postgres.NewDataKeyStore(db))
+	if err != nil {
+		log.Fatalf("Failed to init field cipher: %v", err)
+	}
+	rotation := service.NewKeyRotationService(cipher, postgres.NewSensitiveDataRepository(db, cipher))
+
+	opts := &service.KeyRotationOptions{
+		BatchSize:  batchSize,
+		NewDataKey: !reencryptOnly,
+		Rewrap:     rewrap,
+	}
+
+	var results []*service.KeyRotationResult
+	if tenant != "" {
+		tenantID, err := uuid.Parse(tenant)
+		if err != nil {
+			log.Fatalf("Invalid tenant ID: %v", err)
+		}
+		result, err := rotation.RotateTenant(ctx, tenantID, opts)
+		if err != nil {
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	} else {
+		results, err = rotation.RotateAll(ctx, opts)
+		if err != nil {
+			log.Fatalf("Key rotation failed: %v", err)
+		}
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	_ = encoder.Encode(results)
+
+	for _, result := range results {
+		if result.Error != "" {
+			os.Exit(1)
+		}
+	}
+}
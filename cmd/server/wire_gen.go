@@ -23,6 +23,7 @@ import (
 	repository6 "github.com/lk2023060901/go-next-erp/internal/file/repository"
 	service4 "github.com/lk2023060901/go-next-erp/internal/file/service"
 	repository2 "github.com/lk2023060901/go-next-erp/internal/form/repository"
+	"github.com/lk2023060901/go-next-erp/internal/hrm"
 	"github.com/lk2023060901/go-next-erp/internal/hrm/handler"
 	"github.com/lk2023060901/go-next-erp/internal/hrm/repository/postgres"
 	service5 "github.com/lk2023060901/go-next-erp/internal/hrm/service"
@@ -81,11 +82,22 @@ func wireApp(contextContext context.Context, config *conf.Config, logger log.Log
 	processDefinitionRepository := repository5.NewProcessDefinitionRepository(db)
 	processInstanceRepository := repository5.NewProcessInstanceRepository(db)
 	approvalTaskRepository := repository5.NewApprovalTaskRepository(db)
-	processHistoryRepository := repository5.NewProcessHistoryRepository(db)
+	historyChainKey, err := approval.ProvideHistoryChainKey(config)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	processHistoryRepository := repository5.NewProcessHistoryRepository(db, historyChainKey)
 	engine := approval.ProvideWorkflowEngine()
 	assigneeResolver := service3.NewAssigneeResolver(userRepository, employeeService, organizationService)
-	approvalService := service3.NewApprovalService(processDefinitionRepository, processInstanceRepository, approvalTaskRepository, processHistoryRepository, formDefinitionRepository, formDataRepository, engine, assigneeResolver, authorizationService, notificationService)
-	approvalAdapter := adapter.NewApprovalAdapter(approvalService)
+	processSearchRepository := repository5.NewProcessSearchRepository(db)
+	processSearchService := service3.NewProcessSearchService(processSearchRepository, processDefinitionRepository, processInstanceRepository, approvalTaskRepository, authorizationService)
+	actionLinkConfig := approval.ProvideActionLinkConfig(config)
+	actionTokenRepository := repository5.NewActionTokenRepository(db)
+	actionLinkSigner := service3.NewActionLinkSigner(actionLinkConfig, actionTokenRepository)
+	approvalService := service3.NewApprovalService(processDefinitionRepository, processInstanceRepository, approvalTaskRepository, processHistoryRepository, formDefinitionRepository, formDataRepository, engine, assigneeResolver, authorizationService, notificationService, processSearchService, actionLinkSigner)
+	actionLinkService := service3.NewActionLinkService(actionLinkSigner, actionTokenRepository, approvalTaskRepository, approvalService, userRepository, auditLogRepository)
 	fileRepository := repository6.NewFileRepository(db, redis)
 	quotaRepository := repository6.NewQuotaRepository(db)
 	storage, cleanup3, err := pkg.ProvideStorage(contextContext, config)
@@ -103,8 +115,24 @@ func wireApp(contextContext context.Context, config *conf.Config, logger log.Log
 	}
 	uploadServiceConfig := file.ProvideUploadServiceConfig()
 	uploadService := service4.NewUploadService(fileRepository, quotaRepository, storage, loggerLogger, uploadServiceConfig)
+	fileRelationRepository := repository6.NewFileRelationRepository(db)
+	fileRelationService := service4.NewFileRelationService(fileRepository, fileRelationRepository, loggerLogger)
 	downloadStatsRepository := repository6.NewDownloadStatsRepository(db, redis)
 	downloadService := service4.NewDownloadService(fileRepository, downloadStatsRepository, storage, loggerLogger)
+	processAuditService := service3.NewProcessAuditService(processInstanceRepository, approvalTaskRepository, processHistoryRepository, formDataRepository, uploadService, fileRelationService, downloadService, authorizationService, historyChainKey)
+	processStatsRepository := repository5.NewProcessStatsRepository(db)
+	statsConfig, err := approval.ProvideStatsConfig(config)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	processStatsService := service3.NewProcessStatsService(processStatsRepository, authorizationService, statsConfig)
+	adminJobRepository := repository5.NewAdminJobRepository(db)
+	processAdminJobService := service3.NewProcessAdminJobService(approvalService, adminJobRepository, approvalTaskRepository, userRepository, authorizationService)
+	approvalAdapter := adapter.NewApprovalAdapter(approvalService, processSearchService, actionLinkService, processAuditService, processStatsService, processAdminJobService)
 	quotaServiceConfig := file.ProvideQuotaServiceConfig()
 	quotaService := service4.NewQuotaService(fileRepository, quotaRepository, loggerLogger, quotaServiceConfig)
 	multipartUploadRepository := repository6.NewMultipartUploadRepository(db)
@@ -115,39 +143,114 @@ func wireApp(contextContext context.Context, config *conf.Config, logger log.Log
 	shiftRepository := postgres.NewShiftRepository(db)
 	scheduleRepository := postgres.NewScheduleRepository(db)
 	attendanceRuleRepository := postgres.NewAttendanceRuleRepository(db)
-	hrmEmployeeRepository := postgres.NewHRMEmployeeRepository(db)
-	attendanceService := service5.NewAttendanceService(attendanceRecordRepository, shiftRepository, scheduleRepository, attendanceRuleRepository, hrmEmployeeRepository)
-	attendanceHandler := handler.NewAttendanceHandler(attendanceService)
+	keyStore := postgres.NewDataKeyStore(db)
+	cipher, err := hrm.ProvideFieldCipher(config, keyStore)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	hrmEmployeeRepository := postgres.NewHRMEmployeeRepository(db, cipher)
+	holidayCalendarRepository := postgres.NewHolidayCalendarRepository(db)
+	dayTypeResolver := service5.NewDayTypeResolver(holidayCalendarRepository, attendanceRuleRepository, scheduleRepository, hrmEmployeeRepository)
+	attendanceSummaryRepository := postgres.NewAttendanceSummaryRepository(db)
+	attendancePeriodGuard := service5.NewAttendancePeriodGuard(attendanceSummaryRepository)
+	overtimeRepository := postgres.NewOvertimeRepository(db)
+	overtimePolicyRepository := postgres.NewOvertimePolicyRepository(db)
+	overtimePolicyService := service5.NewOvertimePolicyService(overtimePolicyRepository, attendanceRuleRepository, hrmEmployeeRepository, overtimeRepository)
+	leaveTypeRepository := postgres.NewLeaveTypeRepository(db)
+	leaveQuotaRepository := postgres.NewLeaveQuotaRepository(db)
+	leaveQuotaLedgerRepository := postgres.NewLeaveQuotaLedgerRepository(db)
+	leaveAccrualService := service5.NewLeaveAccrualService(leaveTypeRepository, leaveQuotaRepository, leaveQuotaLedgerRepository, hrmEmployeeRepository)
+	timeAllocationRepository := postgres.NewTimeAllocationRepository(db)
+	timeAllocationService := service5.NewTimeAllocationService(timeAllocationRepository, scheduleRepository, shiftRepository, hrmEmployeeRepository)
+	overtimeService := service5.NewOvertimeService(db, overtimeRepository, shiftRepository, overtimePolicyService, dayTypeResolver, attendancePeriodGuard, leaveAccrualService, engine, timeAllocationService)
+	clockLocationRepository := postgres.NewClockLocationRepository(db)
+	clockAttemptRepository := postgres.NewClockAttemptRepository(db)
+	clockQRConfig := hrm.ProvideClockQRConfig(config)
+	clockQRSigner := service5.NewClockQRSigner(clockQRConfig)
+	attendanceService := service5.NewAttendanceService(attendanceRecordRepository, shiftRepository, scheduleRepository, attendanceRuleRepository, hrmEmployeeRepository, dayTypeResolver, attendancePeriodGuard, overtimeService, clockLocationRepository, clockAttemptRepository, clockQRSigner)
+	attendanceHandler := handler.NewAttendanceHandler(attendanceService, hrmEmployeeRepository)
 	shiftService := service5.NewShiftService(shiftRepository)
 	shiftHandler := handler.NewShiftHandler(shiftService)
 	scheduleService := service5.NewScheduleService(scheduleRepository, shiftRepository, hrmEmployeeRepository, db)
 	scheduleHandler := handler.NewScheduleHandler(scheduleService)
 	attendanceRuleService := service5.NewAttendanceRuleService(attendanceRuleRepository)
 	attendanceRuleHandler := handler.NewAttendanceRuleHandler(attendanceRuleService)
-	overtimeRepository := postgres.NewOvertimeRepository(db)
-	overtimeService := service5.NewOvertimeService(db, overtimeRepository, engine)
 	overtimeHandler := handler.NewOvertimeHandler(overtimeService)
-	leaveTypeRepository := postgres.NewLeaveTypeRepository(db)
-	leaveQuotaRepository := postgres.NewLeaveQuotaRepository(db)
 	leaveRequestRepository := postgres.NewLeaveRequestRepository(db)
 	leaveApprovalRepository := postgres.NewLeaveApprovalRepository(db)
-	leaveService := service5.NewLeaveService(db, leaveTypeRepository, leaveQuotaRepository, leaveRequestRepository, leaveApprovalRepository, engine)
+	leaveDurationCalculator := service5.NewLeaveDurationCalculator(scheduleRepository, shiftRepository, hrmEmployeeRepository, dayTypeResolver)
+	leaveService := service5.NewLeaveService(db, leaveTypeRepository, leaveQuotaRepository, leaveRequestRepository, leaveApprovalRepository, leaveDurationCalculator, leaveAccrualService, attendancePeriodGuard, engine, timeAllocationService)
 	leaveHandler := handler.NewLeaveHandler(leaveService)
 	businessTripRepository := postgres.NewBusinessTripRepository(db)
-	businessTripService := service5.NewBusinessTripService(db, businessTripRepository, engine)
+	tripExpenseRepository := postgres.NewTripExpenseRepository(db)
+	tripExpensePolicyRepository := postgres.NewTripExpensePolicyRepository(db)
+	tripExpenseService := service5.NewTripExpenseService(businessTripRepository, tripExpenseRepository, tripExpensePolicyRepository, hrmEmployeeRepository, fileRelationService, approvalService)
+	businessTripService := service5.NewBusinessTripService(db, businessTripRepository, attendancePeriodGuard, engine, tripExpenseService, timeAllocationService)
 	businessTripHandler := handler.NewBusinessTripHandler(businessTripService)
 	leaveOfficeRepository := postgres.NewLeaveOfficeRepository(db)
-	leaveOfficeService := service5.NewLeaveOfficeService(db, leaveOfficeRepository, engine)
+	leaveOfficeService := service5.NewLeaveOfficeService(db, leaveOfficeRepository, attendancePeriodGuard, engine, timeAllocationService)
 	leaveOfficeHandler := handler.NewLeaveOfficeHandler(leaveOfficeService)
 	punchCardSupplementRepository := postgres.NewPunchCardSupplementRepo(db)
-	punchCardSupplementService := service5.NewPunchCardSupplementService(punchCardSupplementRepository)
+	punchCardSupplementPolicyRepository := postgres.NewPunchCardSupplementPolicyRepository(db)
+	punchCardSupplementService := service5.NewPunchCardSupplementService(punchCardSupplementRepository, punchCardSupplementPolicyRepository, attendanceRecordRepository, attendanceService, attendancePeriodGuard)
 	punchCardSupplementHandler := handler.NewPunchCardSupplementHandler(punchCardSupplementService)
-	hrmAdapter := adapter.NewHRMAdapter(attendanceHandler, shiftHandler, scheduleHandler, attendanceRuleHandler, overtimeHandler, leaveHandler, businessTripHandler, leaveOfficeHandler, punchCardSupplementHandler)
+	holidayCalendarService := service5.NewHolidayCalendarService(holidayCalendarRepository)
+	attendanceSummaryService := service5.NewAttendanceSummaryService(attendanceSummaryRepository, attendanceRecordRepository, leaveRequestRepository, overtimeRepository, businessTripRepository, tripExpenseRepository, leaveOfficeRepository, punchCardSupplementRepository, shiftRepository, hrmEmployeeRepository, dayTypeResolver)
+	rotationTemplateRepository := postgres.NewRotationTemplateRepository(db)
+	scheduleRotationService := service5.NewScheduleRotationService(rotationTemplateRepository, scheduleRepository, shiftRepository, hrmEmployeeRepository, dayTypeResolver, attendancePeriodGuard, notificationService)
+	shiftSwapRepository := postgres.NewShiftSwapRepository(db)
+	shiftSwapService, err := service5.NewShiftSwapService(shiftSwapRepository, scheduleRepository, shiftRepository, hrmEmployeeRepository, leaveRequestRepository, businessTripRepository, attendancePeriodGuard, engine, notificationService)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	attendanceAnomalyRepository := postgres.NewAttendanceAnomalyRepository(db)
+	attendanceDeviceRepository := postgres.NewAttendanceDeviceRepository(db, cipher)
+	attendanceAnomalyService := service5.NewAttendanceAnomalyService(attendanceAnomalyRepository, attendanceRecordRepository, attendanceDeviceRepository, hrmEmployeeRepository)
+	deviceCommandRepository := postgres.NewDeviceCommandRepository(db)
+	attendanceDeviceService := service5.NewAttendanceDeviceService(attendanceDeviceRepository, deviceCommandRepository, hrmEmployeeRepository)
+	deviceFleetService := service5.NewDeviceFleetService(attendanceDeviceRepository, deviceCommandRepository, hrmEmployeeRepository, notificationService)
+	biometricRepository := postgres.NewBiometricRepository(db, cipher)
+	biometricService := service5.NewBiometricService(biometricRepository, attendanceDeviceRepository, deviceCommandRepository, hrmEmployeeRepository)
+	thirdPartyIntegrationRepository := postgres.NewThirdPartyIntegrationRepository(db)
+	syncLogRepository := postgres.NewSyncLogRepository(db)
+	employeeSyncMappingRepository := postgres.NewEmployeeSyncMappingRepository(db)
+	platformAdapterFactory := service5.NewPlatformAdapterFactory(redis)
+	platformSyncService := service5.NewPlatformSyncService(thirdPartyIntegrationRepository, syncLogRepository, employeeSyncMappingRepository, attendanceRecordRepository, hrmEmployeeRepository, attendanceService, platformAdapterFactory)
+	employeeLifecycleRepository := postgres.NewEmployeeLifecycleRepository(db)
+	hrmEmployeeService := service5.NewHRMEmployeeService(hrmEmployeeRepository, employeeSyncMappingRepository, employeeService)
+	employeePositionRepository := repository3.NewEmployeePositionRepository(db)
+	employeeLifecycleService := service5.NewEmployeeLifecycleService(employeeLifecycleRepository, hrmEmployeeRepository, hrmEmployeeService, employeeService, employeeRepository, employeePositionRepository, sessionRepository, approvalService, notificationService, biometricService)
+	reportExportRepository := postgres.NewReportExportRepository(db)
+	reportExportService := service5.NewReportExportService(reportExportRepository, attendanceSummaryRepository, leaveQuotaRepository, overtimeRepository, attendanceSummaryService, organizationRepository, employeeRepository, uploadService, downloadService)
+	payrollRunRepository := postgres.NewPayrollRunRepository(db)
+	payrollService := service5.NewPayrollService(payrollRunRepository, attendanceSummaryRepository, leaveTypeRepository, organizationRepository, employeeRepository, uploadService, downloadService)
+	clockLocationService := service5.NewClockLocationService(clockLocationRepository, clockAttemptRepository, attendanceRuleRepository, clockQRSigner, attendanceService)
+	hrmAdapter := adapter.NewHRMAdapter(attendanceHandler, shiftHandler, scheduleHandler, attendanceRuleHandler, overtimeHandler, leaveHandler, businessTripHandler, leaveOfficeHandler, punchCardSupplementHandler, holidayCalendarService, dayTypeResolver, attendanceSummaryService, leaveService, leaveAccrualService, scheduleRotationService, shiftSwapService, overtimePolicyService, attendanceAnomalyService, attendanceDeviceService, deviceFleetService, biometricService, platformSyncService, tripExpenseService, employeeLifecycleService, reportExportService, payrollService, clockLocationService, attendanceService, punchCardSupplementService, timeAllocationService, hrmEmployeeRepository, authorizationService)
+	devicePushService := service5.NewDevicePushService(attendanceDeviceRepository, deviceCommandRepository, hrmEmployeeRepository, attendanceRecordRepository, attendanceService, biometricService)
+	zkTecoHTTPAdapter := adapter.NewZKTecoHTTPAdapter(devicePushService)
+	platformCallbackHTTPAdapter := adapter.NewPlatformCallbackHTTPAdapter(platformSyncService)
 	hub := websocket.NewHub()
 	websocketHandler := websocket.NewHandler(hub, manager, notificationService)
-	httpServer := server.NewHTTPServer(config, manager, authAdapter, userAdapter, roleAdapter, formAdapter, organizationAdapter, notificationAdapter, approvalAdapter, fileAdapter, hrmAdapter, notificationService, hub, websocketHandler, logger)
+	httpServer := server.NewHTTPServer(config, manager, authAdapter, userAdapter, roleAdapter, formAdapter, organizationAdapter, notificationAdapter, approvalAdapter, fileAdapter, hrmAdapter, zkTecoHTTPAdapter, platformCallbackHTTPAdapter, notificationService, hub, websocketHandler, logger)
 	grpcServer := server.NewGRPCServer(config, manager, authAdapter, userAdapter, roleAdapter, formAdapter, organizationAdapter, notificationAdapter, approvalAdapter, fileAdapter, hrmAdapter, logger)
-	app := newApp(logger, httpServer, grpcServer)
+	scheduler := pkg.ProvideScheduler(loggerLogger)
+	jobServer, err := server.NewJobServer(scheduler, processStatsService, processAdminJobService, attendanceSummaryService, leaveAccrualService, attendanceAnomalyService, platformSyncService, employeeLifecycleService, reportExportService, deviceFleetService, logger)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	app := newApp(logger, httpServer, grpcServer, jobServer)
 	return app, func() {
 		cleanup4()
 		cleanup3()
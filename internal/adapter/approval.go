@@ -2,8 +2,10 @@ package adapter
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/emptypb"
 
@@ -13,18 +15,47 @@ import (
 	"github.com/lk2023060901/go-next-erp/internal/approval/service"
 )
 
-// ApprovalAdapter 审批模块适配器（实现三个服务接口）
+// ApprovalPublicOperations 无需 JWT 认证的审批接口（快捷链接凭签名令牌鉴权）
+var ApprovalPublicOperations = []string{
+	approvalv1.OperationActionLinkServicePreviewActionLink,
+	approvalv1.OperationActionLinkServiceExecuteActionLink,
+	approvalv1.OperationActionLinkServiceInboundReply,
+}
+
+// ApprovalAdapter 审批模块适配器
 type ApprovalAdapter struct {
 	approvalv1.UnimplementedProcessDefinitionServiceServer
 	approvalv1.UnimplementedProcessInstanceServiceServer
 	approvalv1.UnimplementedApprovalTaskServiceServer
-	approvalService service.ApprovalService
+	approvalv1.UnimplementedProcessSearchServiceServer
+	approvalv1.UnimplementedProcessAuditServiceServer
+	approvalv1.UnimplementedProcessStatsServiceServer
+	approvalv1.UnimplementedProcessAdminJobServiceServer
+	approvalv1.UnimplementedActionLinkServiceServer
+	approvalService   service.ApprovalService
+	searchService     service.ProcessSearchService
+	actionLinkService service.ActionLinkService
+	auditService      service.ProcessAuditService
+	statsService      service.ProcessStatsService
+	adminJobService   service.ProcessAdminJobService
 }
 
 // NewApprovalAdapter 创建审批适配器
-func NewApprovalAdapter(approvalService service.ApprovalService) *ApprovalAdapter {
+func NewApprovalAdapter(
+	approvalService service.ApprovalService,
+	searchService service.ProcessSearchService,
+	actionLinkService service.ActionLinkService,
+	auditService service.ProcessAuditService,
+	statsService service.ProcessStatsService,
+	adminJobService service.ProcessAdminJobService,
+) *ApprovalAdapter {
 	return &ApprovalAdapter{
-		approvalService: approvalService,
+		approvalService:   approvalService,
+		searchService:     searchService,
+		actionLinkService: actionLinkService,
+		auditService:      auditService,
+		statsService:      statsService,
+		adminJobService:   adminJobService,
 	}
 }
 
@@ -468,6 +499,597 @@ func (a *ApprovalAdapter) DelegateTask(ctx context.Context, req *approvalv1.Dele
 	return &emptypb.Empty{}, nil
 }
 
+// ========== ProcessSearchService 实现 ==========
+
+// SearchInstances 检索流程实例（全文 + 表单字段过滤 + 游标分页）
+func (a *ApprovalAdapter) SearchInstances(ctx context.Context, req *approvalv1.SearchInstancesRequest) (*approvalv1.SearchInstancesResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	searchReq := &dto.SearchInstancesRequest{
+		TenantID:   tenantID,
+		OperatorID: userID,
+		Keyword:    req.Keyword,
+		SortBy:     model.SearchSortField(req.SortBy),
+		SortDesc:   req.SortDesc,
+		Cursor:     req.Cursor,
+		PageSize:   int(req.PageSize),
+	}
+	if req.ProcessDefId != "" {
+		id, err := parseUUID("process_def_id", req.ProcessDefId)
+		if err != nil {
+			return nil, err
+		}
+		searchReq.ProcessDefID = &id
+	}
+	if req.ApplicantId != "" {
+		id, err := parseUUID("applicant_id", req.ApplicantId)
+		if err != nil {
+			return nil, err
+		}
+		searchReq.ApplicantID = &id
+	}
+	if req.Status != "" {
+		status := model.ProcessStatus(req.Status)
+		searchReq.Status = &status
+	}
+	if searchReq.StartDate, err = parseOptionalTime("start_date", req.StartDate); err != nil {
+		return nil, err
+	}
+	if searchReq.EndDate, err = parseOptionalTime("end_date", req.EndDate); err != nil {
+		return nil, err
+	}
+	for _, f := range req.FieldFilters {
+		searchReq.FieldFilters = append(searchReq.FieldFilters, model.FieldFilter{
+			Key:     f.Key,
+			Op:      model.FieldFilterOp(f.Op),
+			Value:   f.Value,
+			ValueTo: f.ValueTo,
+		})
+	}
+
+	page, err := a.searchService.SearchInstances(ctx, searchReq)
+	if err != nil {
+		return nil, approvalError(err)
+	}
+
+	items := make([]*approvalv1.ProcessInstanceResponse, len(page.Items))
+	for i, inst := range page.Items {
+		items[i] = toProcessInstanceResponse(inst)
+	}
+	return &approvalv1.SearchInstancesResponse{
+		Items:      items,
+		PageSize:   int32(page.PageSize),
+		HasNext:    page.HasNext,
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
+// GetSearchFields 获取流程可检索字段
+func (a *ApprovalAdapter) GetSearchFields(ctx context.Context, req *approvalv1.GetSearchFieldsRequest) (*approvalv1.SearchFieldsResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := a.searchService.GetSearchFields(ctx, tenantID, id)
+	if err != nil {
+		return nil, approvalError(err)
+	}
+	return toSearchFieldsResponse(fields), nil
+}
+
+// ConfigureSearchFields 配置流程可检索字段
+func (a *ApprovalAdapter) ConfigureSearchFields(ctx context.Context, req *approvalv1.ConfigureSearchFieldsRequest) (*approvalv1.SearchFieldsResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	configs := make([]dto.SearchFieldConfig, len(req.Fields))
+	for i, f := range req.Fields {
+		configs[i] = dto.SearchFieldConfig{
+			FieldKey:   f.FieldKey,
+			FieldLabel: f.FieldLabel,
+			FieldType:  model.SearchFieldType(f.FieldType),
+			Sort:       int(f.Sort),
+		}
+	}
+
+	fields, err := a.searchService.ConfigureSearchFields(ctx, &dto.ConfigureSearchFieldsRequest{
+		TenantID:     tenantID,
+		OperatorID:   userID,
+		ProcessDefID: id,
+		Fields:       configs,
+	})
+	if err != nil {
+		return nil, approvalError(err)
+	}
+	return toSearchFieldsResponse(fields), nil
+}
+
+// ReindexProcessDefinition 重建流程定义下所有实例的检索索引
+func (a *ApprovalAdapter) ReindexProcessDefinition(ctx context.Context, req *approvalv1.ReindexProcessDefinitionRequest) (*approvalv1.ReindexProcessDefinitionResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := a.searchService.ReindexProcessDefinition(ctx, tenantID, userID, id)
+	if err != nil {
+		return nil, approvalError(err)
+	}
+	return &approvalv1.ReindexProcessDefinitionResponse{Indexed: int32(count)}, nil
+}
+
+// ========== ProcessAuditService 实现 ==========
+
+// VerifyHistoryChain 校验流程实例历史记录哈希链
+func (a *ApprovalAdapter) VerifyHistoryChain(ctx context.Context, req *approvalv1.VerifyHistoryChainRequest) (*approvalv1.HistoryChainReportResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := a.auditService.VerifyHistoryChain(ctx, tenantID, id, userID)
+	if err != nil {
+		return nil, approvalError(err)
+	}
+
+	issues := make([]*approvalv1.HistoryChainIssue, len(report.Issues))
+	for i, issue := range report.Issues {
+		issues[i] = &approvalv1.HistoryChainIssue{
+			Type:     string(issue.Type),
+			Sequence: issue.Sequence,
+			Detail:   issue.Detail,
+		}
+		if issue.HistoryID != nil {
+			issues[i].HistoryId = issue.HistoryID.String()
+		}
+	}
+	return &approvalv1.HistoryChainReportResponse{
+		ProcessInstanceId: report.ProcessInstanceID.String(),
+		Valid:             report.Valid,
+		Total:             int32(report.Total),
+		HeadSequence:      report.HeadSequence,
+		HeadHash:          report.HeadHash,
+		Issues:            issues,
+		VerifiedAt:        report.VerifiedAt.Format(time.RFC3339),
+	}, nil
+}
+
+// ExportDossier 导出流程实例审批档案（PDF/JSON）
+func (a *ApprovalAdapter) ExportDossier(ctx context.Context, req *approvalv1.ExportDossierRequest) (*approvalv1.DossierExportResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := a.auditService.ExportDossier(ctx, &dto.ExportDossierRequest{
+		TenantID:          tenantID,
+		OperatorID:        userID,
+		ProcessInstanceID: id,
+		Format:            dto.DossierFormat(req.Format),
+	})
+	if err != nil {
+		return nil, approvalError(err)
+	}
+	return &approvalv1.DossierExportResponse{
+		FileId:      result.FileID.String(),
+		Filename:    result.Filename,
+		Format:      string(result.Format),
+		Size:        result.Size,
+		ChainValid:  result.ChainValid,
+		DownloadUrl: result.DownloadURL,
+	}, nil
+}
+
+// ========== ProcessStatsService 实现 ==========
+
+// GetNodeStats 节点周度处理时长统计
+func (a *ApprovalAdapter) GetNodeStats(ctx context.Context, req *approvalv1.ApprovalStatsRequest) (*approvalv1.NodeStatsResponse, error) {
+	statsReq, err := toStatsRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := a.statsService.GetNodeStats(ctx, statsReq)
+	if err != nil {
+		return nil, approvalError(err)
+	}
+
+	items := make([]*approvalv1.NodeWeeklyStats, len(stats))
+	for i, s := range stats {
+		items[i] = &approvalv1.NodeWeeklyStats{
+			ProcessDefId:  s.ProcessDefID.String(),
+			NodeId:        s.NodeID,
+			NodeName:      s.NodeName,
+			WeekStart:     s.WeekStart.Format(time.RFC3339),
+			TaskCount:     int32(s.TaskCount),
+			ApprovedCount: int32(s.ApprovedCount),
+			RejectedCount: int32(s.RejectedCount),
+			AvgSeconds:    s.AvgSeconds,
+			MedianSeconds: s.MedianSeconds,
+			P90Seconds:    s.P90Seconds,
+			RefreshedAt:   s.RefreshedAt.Format(time.RFC3339),
+		}
+	}
+	return &approvalv1.NodeStatsResponse{Items: items}, nil
+}
+
+// GetQueueTrend 节点待办队列长度变化
+func (a *ApprovalAdapter) GetQueueTrend(ctx context.Context, req *approvalv1.ApprovalStatsRequest) (*approvalv1.QueueTrendResponse, error) {
+	statsReq, err := toStatsRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots, err := a.statsService.GetQueueTrend(ctx, statsReq)
+	if err != nil {
+		return nil, approvalError(err)
+	}
+
+	items := make([]*approvalv1.QueueSnapshot, len(snapshots))
+	for i, s := range snapshots {
+		items[i] = &approvalv1.QueueSnapshot{
+			ProcessDefId:         s.ProcessDefID.String(),
+			NodeId:               s.NodeID,
+			NodeName:             s.NodeName,
+			SnapshotAt:           s.SnapshotAt.Format(time.RFC3339),
+			PendingCount:         int32(s.PendingCount),
+			OldestPendingSeconds: s.OldestPendingSeconds,
+		}
+	}
+	return &approvalv1.QueueTrendResponse{Items: items}, nil
+}
+
+// GetSlowApprovers 处理最慢的审批人排行
+func (a *ApprovalAdapter) GetSlowApprovers(ctx context.Context, req *approvalv1.ApprovalStatsRequest) (*approvalv1.SlowApproversResponse, error) {
+	statsReq, err := toStatsRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := a.statsService.GetSlowApprovers(ctx, statsReq)
+	if err != nil {
+		return nil, approvalError(err)
+	}
+
+	items := make([]*approvalv1.ApproverWeeklyStats, len(stats))
+	for i, s := range stats {
+		items[i] = &approvalv1.ApproverWeeklyStats{
+			AssigneeId:   s.AssigneeID.String(),
+			AssigneeName: s.AssigneeName,
+			WeekStart:    s.WeekStart.Format(time.RFC3339),
+			TaskCount:    int32(s.TaskCount),
+			AvgSeconds:   s.AvgSeconds,
+			P90Seconds:   s.P90Seconds,
+			RefreshedAt:  s.RefreshedAt.Format(time.RFC3339),
+		}
+	}
+	return &approvalv1.SlowApproversResponse{Items: items}, nil
+}
+
+// GetRejectionReasons 节点拒绝原因排行
+func (a *ApprovalAdapter) GetRejectionReasons(ctx context.Context, req *approvalv1.ApprovalStatsRequest) (*approvalv1.RejectionReasonsResponse, error) {
+	statsReq, err := toStatsRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := a.statsService.GetRejectionReasons(ctx, statsReq)
+	if err != nil {
+		return nil, approvalError(err)
+	}
+
+	items := make([]*approvalv1.RejectionReasonStats, len(stats))
+	for i, s := range stats {
+		items[i] = &approvalv1.RejectionReasonStats{
+			ProcessDefId: s.ProcessDefID.String(),
+			NodeId:       s.NodeID,
+			NodeName:     s.NodeName,
+			WeekStart:    s.WeekStart.Format(time.RFC3339),
+			Reason:       s.Reason,
+			Count:        int32(s.Count),
+		}
+	}
+	return &approvalv1.RejectionReasonsResponse{Items: items}, nil
+}
+
+// GetProcessTrends 流程定义周度趋势与环比
+func (a *ApprovalAdapter) GetProcessTrends(ctx context.Context, req *approvalv1.ApprovalStatsRequest) (*approvalv1.ProcessTrendsResponse, error) {
+	statsReq, err := toStatsRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	trends, err := a.statsService.GetProcessTrends(ctx, statsReq)
+	if err != nil {
+		return nil, approvalError(err)
+	}
+
+	items := make([]*approvalv1.ProcessTrend, len(trends))
+	for i, t := range trends {
+		weeks := make([]*approvalv1.ProcessWeeklyStats, len(t.Weeks))
+		for j, w := range t.Weeks {
+			weeks[j] = toProcessWeeklyStats(w)
+		}
+		items[i] = &approvalv1.ProcessTrend{
+			ProcessDefId:    t.ProcessDefID.String(),
+			ProcessDefName:  t.ProcessDefName,
+			Weeks:           weeks,
+			Current:         toProcessWeeklyStats(t.Current),
+			Previous:        toProcessWeeklyStats(t.Previous),
+			StartedChange:   t.StartedChange,
+			CompletedChange: t.CompletedChange,
+			CycleTimeChange: t.CycleTimeChange,
+		}
+	}
+	return &approvalv1.ProcessTrendsResponse{Items: items}, nil
+}
+
+// RefreshStats 立即重算本租户最近 N 周统计
+func (a *ApprovalAdapter) RefreshStats(ctx context.Context, req *approvalv1.RefreshStatsRequest) (*approvalv1.RefreshStatsResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := a.statsService.Rebuild(ctx, &dto.StatsRebuildRequest{
+		TenantID:   tenantID,
+		OperatorID: userID,
+		Weeks:      int(req.Weeks),
+	})
+	if err != nil {
+		return nil, approvalError(err)
+	}
+	return &approvalv1.RefreshStatsResponse{
+		Since:          result.Since.Format(time.RFC3339),
+		QueueSnapshots: result.QueueSnapshots,
+		RefreshedAt:    result.RefreshedAt.Format(time.RFC3339),
+	}, nil
+}
+
+// ========== ProcessAdminJobService 实现 ==========
+
+// SubmitReassignTasks 提交离职人员待办转交作业
+func (a *ApprovalAdapter) SubmitReassignTasks(ctx context.Context, req *approvalv1.SubmitReassignTasksRequest) (*approvalv1.AdminJobResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fromUserID, err := parseUUID("from_user_id", req.FromUserId)
+	if err != nil {
+		return nil, err
+	}
+	toUserID, err := parseUUID("to_user_id", req.ToUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := a.adminJobService.SubmitReassignTasks(ctx, &dto.SubmitReassignJobRequest{
+		TenantID:   tenantID,
+		OperatorID: userID,
+		FromUserID: fromUserID,
+		ToUserID:   toUserID,
+		ToUserName: req.ToUserName,
+		Reason:     req.Reason,
+	})
+	if err != nil {
+		return nil, approvalError(err)
+	}
+	return toAdminJobResponse(job), nil
+}
+
+// SubmitCancelInstances 提交批量取消流程作业
+func (a *ApprovalAdapter) SubmitCancelInstances(ctx context.Context, req *approvalv1.SubmitInstanceJobRequest) (*approvalv1.AdminJobResponse, error) {
+	jobReq, err := toInstanceJobRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := a.adminJobService.SubmitCancelInstances(ctx, jobReq)
+	if err != nil {
+		return nil, approvalError(err)
+	}
+	return toAdminJobResponse(job), nil
+}
+
+// SubmitForceComplete 提交批量强制完结作业
+func (a *ApprovalAdapter) SubmitForceComplete(ctx context.Context, req *approvalv1.SubmitInstanceJobRequest) (*approvalv1.AdminJobResponse, error) {
+	jobReq, err := toInstanceJobRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := a.adminJobService.SubmitForceComplete(ctx, jobReq)
+	if err != nil {
+		return nil, approvalError(err)
+	}
+	return toAdminJobResponse(job), nil
+}
+
+// SubmitRetriggerTasks 提交重新生成审批任务作业
+func (a *ApprovalAdapter) SubmitRetriggerTasks(ctx context.Context, req *approvalv1.SubmitInstanceJobRequest) (*approvalv1.AdminJobResponse, error) {
+	jobReq, err := toInstanceJobRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := a.adminJobService.SubmitRetriggerTasks(ctx, jobReq)
+	if err != nil {
+		return nil, approvalError(err)
+	}
+	return toAdminJobResponse(job), nil
+}
+
+// ListAdminJobs 批量作业列表
+func (a *ApprovalAdapter) ListAdminJobs(ctx context.Context, req *approvalv1.ListAdminJobsRequest) (*approvalv1.ListAdminJobsResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := a.adminJobService.ListJobs(ctx, tenantID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*approvalv1.AdminJobResponse, len(jobs))
+	for i, job := range jobs {
+		items[i] = toAdminJobResponse(job)
+	}
+	return &approvalv1.ListAdminJobsResponse{Items: items}, nil
+}
+
+// GetAdminJob 查询批量作业进度
+func (a *ApprovalAdapter) GetAdminJob(ctx context.Context, req *approvalv1.GetAdminJobRequest) (*approvalv1.AdminJobResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := a.adminJobService.GetJob(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	return toAdminJobResponse(job), nil
+}
+
+// ListAdminJobItems 批量作业逐条处理结果
+func (a *ApprovalAdapter) ListAdminJobItems(ctx context.Context, req *approvalv1.ListAdminJobItemsRequest) (*approvalv1.ListAdminJobItemsResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	jobItems, err := a.adminJobService.ListJobItems(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*approvalv1.AdminJobItem, len(jobItems))
+	for i, item := range jobItems {
+		items[i] = &approvalv1.AdminJobItem{
+			Id:          item.ID.String(),
+			JobId:       item.JobID.String(),
+			TargetId:    item.TargetID.String(),
+			Status:      string(item.Status),
+			Message:     item.Message,
+			ProcessedAt: item.ProcessedAt.Format(time.RFC3339),
+		}
+	}
+	return &approvalv1.ListAdminJobItemsResponse{Items: items}, nil
+}
+
+// ========== ActionLinkService 实现 ==========
+
+// PreviewActionLink 预览快捷链接（只校验不执行）
+func (a *ApprovalAdapter) PreviewActionLink(ctx context.Context, req *approvalv1.PreviewActionLinkRequest) (*approvalv1.ActionLinkPreviewResponse, error) {
+	preview, err := a.actionLinkService.PreviewLink(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	return &approvalv1.ActionLinkPreviewResponse{
+		TaskId:            preview.TaskID.String(),
+		ProcessInstanceId: preview.ProcessInstanceID.String(),
+		NodeName:          preview.NodeName,
+		Action:            string(preview.Action),
+		TaskStatus:        string(preview.TaskStatus),
+		ExpiresAt:         preview.ExpiresAt.Format(time.RFC3339),
+	}, nil
+}
+
+// ExecuteActionLink 执行快捷链接审批
+func (a *ApprovalAdapter) ExecuteActionLink(ctx context.Context, req *approvalv1.ExecuteActionLinkRequest) (*approvalv1.ActionLinkResultResponse, error) {
+	execReq := &dto.ExecuteActionLinkRequest{
+		Token:   req.Token,
+		Comment: req.Comment,
+	}
+	execReq.ClientIP, execReq.UserAgent = clientInfo(ctx)
+
+	result, err := a.actionLinkService.ExecuteLink(ctx, execReq)
+	if err != nil {
+		return nil, err
+	}
+	return toActionLinkResultResponse(result), nil
+}
+
+// InboundReply 入站邮件回复 Webhook
+func (a *ApprovalAdapter) InboundReply(ctx context.Context, req *approvalv1.InboundReplyRequest) (*approvalv1.ActionLinkResultResponse, error) {
+	result, err := a.actionLinkService.HandleInboundReply(ctx, &dto.InboundReplyRequest{
+		From:      req.From,
+		To:        req.To,
+		Subject:   req.Subject,
+		Text:      req.Text,
+		Timestamp: req.Timestamp,
+		Nonce:     req.Nonce,
+		Signature: req.Signature,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toActionLinkResultResponse(result), nil
+}
+
 // ========== 辅助转换函数 ==========
 
 func toProcessDefinitionResponse(dto *dto.ProcessDefResponse) *approvalv1.ProcessDefinitionResponse {
@@ -551,3 +1173,168 @@ func convertByStatus(byStatus map[string]int) map[string]int32 {
 	}
 	return result
 }
+
+// approvalError 将审批服务的权限、不存在、参数错误转换为 HTTP 错误
+func approvalError(err error) error {
+	if errors.Is(err, service.ErrPermissionDenied) {
+		return errors.Forbidden("PERMISSION_DENIED", err.Error())
+	}
+	if errors.Is(err, service.ErrProcessNotFound) || errors.Is(err, service.ErrProcessInstanceNotFound) {
+		return errors.NotFound("NOT_FOUND", err.Error())
+	}
+	if errors.Is(err, service.ErrAdminJobSuccessor) {
+		return errors.BadRequest("INVALID_SUCCESSOR", err.Error())
+	}
+	return err
+}
+
+// parseOptionalTime 解析可选的 RFC3339 时间参数
+func parseOptionalTime(field, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_ARGUMENT", "invalid "+field)
+	}
+	return &t, nil
+}
+
+// toStatsRequest 解析统计看板查询参数
+func toStatsRequest(ctx context.Context, req *approvalv1.ApprovalStatsRequest) (*dto.StatsRequest, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statsReq := &dto.StatsRequest{
+		TenantID:   tenantID,
+		OperatorID: userID,
+		Weeks:      int(req.Weeks),
+		Limit:      int(req.Limit),
+		MinTasks:   int(req.MinTasks),
+	}
+	if req.ProcessDefId != "" {
+		id, err := parseUUID("process_def_id", req.ProcessDefId)
+		if err != nil {
+			return nil, err
+		}
+		statsReq.ProcessDefID = &id
+	}
+	if req.NodeId != "" {
+		statsReq.NodeID = &req.NodeId
+	}
+
+	return statsReq, nil
+}
+
+// toInstanceJobRequest 解析流程实例批量干预作业请求，并从上下文填充租户与操作人
+func toInstanceJobRequest(ctx context.Context, req *approvalv1.SubmitInstanceJobRequest) (*dto.SubmitInstanceJobRequest, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	instanceIDs := make([]uuid.UUID, len(req.InstanceIds))
+	for i, v := range req.InstanceIds {
+		if instanceIDs[i], err = parseUUID("instance_ids", v); err != nil {
+			return nil, err
+		}
+	}
+
+	return &dto.SubmitInstanceJobRequest{
+		TenantID:    tenantID,
+		OperatorID:  userID,
+		InstanceIDs: instanceIDs,
+		Status:      model.ProcessStatus(req.Status),
+		Reason:      req.Reason,
+	}, nil
+}
+
+func toSearchFieldsResponse(fields []*model.ProcessSearchField) *approvalv1.SearchFieldsResponse {
+	items := make([]*approvalv1.SearchFieldResponse, len(fields))
+	for i, f := range fields {
+		items[i] = &approvalv1.SearchFieldResponse{
+			Id:           f.ID.String(),
+			ProcessDefId: f.ProcessDefID.String(),
+			FieldKey:     f.FieldKey,
+			FieldLabel:   f.FieldLabel,
+			FieldType:    string(f.FieldType),
+			Sort:         int32(f.Sort),
+			CreatedAt:    f.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:    f.UpdatedAt.Format(time.RFC3339),
+		}
+	}
+	return &approvalv1.SearchFieldsResponse{Items: items}
+}
+
+func toProcessWeeklyStats(s *model.ProcessWeeklyStats) *approvalv1.ProcessWeeklyStats {
+	if s == nil {
+		return nil
+	}
+	return &approvalv1.ProcessWeeklyStats{
+		ProcessDefId:    s.ProcessDefID.String(),
+		ProcessDefName:  s.ProcessDefName,
+		WeekStart:       s.WeekStart.Format(time.RFC3339),
+		StartedCount:    int32(s.StartedCount),
+		CompletedCount:  int32(s.CompletedCount),
+		ApprovedCount:   int32(s.ApprovedCount),
+		RejectedCount:   int32(s.RejectedCount),
+		AvgCycleSeconds: s.AvgCycleSeconds,
+		P90CycleSeconds: s.P90CycleSeconds,
+		RefreshedAt:     s.RefreshedAt.Format(time.RFC3339),
+	}
+}
+
+func toAdminJobResponse(job *dto.AdminJobResponse) *approvalv1.AdminJobResponse {
+	params := make(map[string]string, len(job.Params))
+	for k, v := range job.Params {
+		params[k] = fmt.Sprint(v)
+	}
+
+	resp := &approvalv1.AdminJobResponse{
+		Id:        job.ID.String(),
+		TenantId:  job.TenantID.String(),
+		Type:      string(job.Type),
+		Status:    string(job.Status),
+		Params:    params,
+		Reason:    job.Reason,
+		Total:     int32(job.Total),
+		Processed: int32(job.Processed),
+		Succeeded: int32(job.Succeeded),
+		Failed:    int32(job.Failed),
+		Skipped:   int32(job.Skipped),
+		CreatedBy: job.CreatedBy.String(),
+		CreatedAt: job.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: job.UpdatedAt.Format(time.RFC3339),
+		Progress:  job.Progress,
+	}
+
+	if job.Error != nil {
+		resp.Error = *job.Error
+	}
+	if job.StartedAt != nil {
+		resp.StartedAt = job.StartedAt.Format(time.RFC3339)
+	}
+	if job.FinishedAt != nil {
+		resp.FinishedAt = job.FinishedAt.Format(time.RFC3339)
+	}
+
+	return resp
+}
+
+func toActionLinkResultResponse(result *dto.ActionLinkResult) *approvalv1.ActionLinkResultResponse {
+	return &approvalv1.ActionLinkResultResponse{
+		TaskId:    result.TaskID.String(),
+		Action:    string(result.Action),
+		Processed: result.Processed,
+	}
+}
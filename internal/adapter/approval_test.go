@@ -5,12 +5,16 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/google/uuid"
 	approvalv1 "github.com/lk2023060901/go-next-erp/api/approval/v1"
 	"github.com/lk2023060901/go-next-erp/internal/approval/dto"
 	"github.com/lk2023060901/go-next-erp/internal/approval/model"
+	"github.com/lk2023060901/go-next-erp/internal/approval/service"
+	"github.com/lk2023060901/go-next-erp/pkg/pagination"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -105,6 +109,10 @@ func (m *MockApprovalService) CancelProcess(ctx context.Context, instanceID, ope
 	return args.Error(0)
 }
 
+func (m *MockApprovalService) OnProcessClosed(hook service.ProcessClosedHook) {
+	m.Called(hook)
+}
+
 func (m *MockApprovalService) ListProcessInstances(ctx context.Context, tenantID uuid.UUID, processDefID *uuid.UUID, status *model.ProcessStatus, applicantID *uuid.UUID, startDate, endDate *time.Time, limit, offset int) ([]*dto.ProcessInstanceResponse, int, error) {
 	args := m.Called(ctx, tenantID, processDefID, status, applicantID, startDate, endDate, limit, offset)
 	if args.Get(0) == nil {
@@ -254,11 +262,31 @@ func (m *MockApprovalService) GetUserWorkload(ctx context.Context, userID uuid.U
 	return args.Get(0).(*dto.UserWorkload), args.Error(1)
 }
 
+func (m *MockApprovalService) AdminReassignTask(ctx context.Context, req *dto.AdminReassignTaskRequest) error {
+	args := m.Called(ctx, req)
+	return args.Error(0)
+}
+
+func (m *MockApprovalService) AdminCancelInstance(ctx context.Context, req *dto.AdminInstanceRequest) error {
+	args := m.Called(ctx, req)
+	return args.Error(0)
+}
+
+func (m *MockApprovalService) AdminForceCompleteInstance(ctx context.Context, req *dto.AdminInstanceRequest) error {
+	args := m.Called(ctx, req)
+	return args.Error(0)
+}
+
+func (m *MockApprovalService) AdminRetriggerTasks(ctx context.Context, req *dto.AdminInstanceRequest) (int, error) {
+	args := m.Called(ctx, req)
+	return args.Int(0), args.Error(1)
+}
+
 // TestApprovalAdapter_CreateProcessDefinition tests creating process definitions
 func TestApprovalAdapter_CreateProcessDefinition(t *testing.T) {
 	t.Run("CreateProcessDefinition successfully", func(t *testing.T) {
 		mockService := new(MockApprovalService)
-		adapter := NewApprovalAdapter(mockService)
+		adapter := NewApprovalAdapter(mockService, nil, nil, nil, nil, nil)
 
 		processDefID := uuid.New()
 		tenantID := uuid.New()
@@ -304,7 +332,7 @@ func TestApprovalAdapter_CreateProcessDefinition(t *testing.T) {
 func TestApprovalAdapter_GetProcessDefinition(t *testing.T) {
 	t.Run("GetProcessDefinition successfully", func(t *testing.T) {
 		mockService := new(MockApprovalService)
-		adapter := NewApprovalAdapter(mockService)
+		adapter := NewApprovalAdapter(mockService, nil, nil, nil, nil, nil)
 
 		processDefID := uuid.New()
 
@@ -338,7 +366,7 @@ func TestApprovalAdapter_GetProcessDefinition(t *testing.T) {
 func TestApprovalAdapter_UpdateProcessDefinition(t *testing.T) {
 	t.Run("UpdateProcessDefinition successfully", func(t *testing.T) {
 		mockService := new(MockApprovalService)
-		adapter := NewApprovalAdapter(mockService)
+		adapter := NewApprovalAdapter(mockService, nil, nil, nil, nil, nil)
 
 		processDefID := uuid.New()
 		formID := uuid.New()
@@ -378,7 +406,7 @@ func TestApprovalAdapter_UpdateProcessDefinition(t *testing.T) {
 func TestApprovalAdapter_DeleteProcessDefinition(t *testing.T) {
 	t.Run("DeleteProcessDefinition successfully", func(t *testing.T) {
 		mockService := new(MockApprovalService)
-		adapter := NewApprovalAdapter(mockService)
+		adapter := NewApprovalAdapter(mockService, nil, nil, nil, nil, nil)
 
 		processDefID := uuid.New()
 
@@ -401,7 +429,7 @@ func TestApprovalAdapter_DeleteProcessDefinition(t *testing.T) {
 func TestApprovalAdapter_StartProcess(t *testing.T) {
 	t.Run("StartProcess successfully", func(t *testing.T) {
 		mockService := new(MockApprovalService)
-		adapter := NewApprovalAdapter(mockService)
+		adapter := NewApprovalAdapter(mockService, nil, nil, nil, nil, nil)
 
 		instanceID := uuid.New()
 		processDefID := uuid.New()
@@ -441,7 +469,7 @@ func TestApprovalAdapter_StartProcess(t *testing.T) {
 func TestApprovalAdapter_ProcessTask(t *testing.T) {
 	t.Run("ProcessTask approve successfully", func(t *testing.T) {
 		mockService := new(MockApprovalService)
-		adapter := NewApprovalAdapter(mockService)
+		adapter := NewApprovalAdapter(mockService, nil, nil, nil, nil, nil)
 
 		taskID := uuid.New()
 
@@ -463,7 +491,7 @@ func TestApprovalAdapter_ProcessTask(t *testing.T) {
 
 	t.Run("ProcessTask reject successfully", func(t *testing.T) {
 		mockService := new(MockApprovalService)
-		adapter := NewApprovalAdapter(mockService)
+		adapter := NewApprovalAdapter(mockService, nil, nil, nil, nil, nil)
 
 		taskID := uuid.New()
 
@@ -488,7 +516,7 @@ func TestApprovalAdapter_ProcessTask(t *testing.T) {
 func TestApprovalAdapter_ListMyTasks(t *testing.T) {
 	t.Run("ListMyTasks successfully", func(t *testing.T) {
 		mockService := new(MockApprovalService)
-		adapter := NewApprovalAdapter(mockService)
+		adapter := NewApprovalAdapter(mockService, nil, nil, nil, nil, nil)
 
 		expectedTasks := []*dto.ApprovalTaskResponse{
 			{
@@ -523,7 +551,7 @@ func TestApprovalAdapter_ListMyTasks(t *testing.T) {
 func TestApprovalAdapter_CountPendingTasks(t *testing.T) {
 	t.Run("CountPendingTasks successfully", func(t *testing.T) {
 		mockService := new(MockApprovalService)
-		adapter := NewApprovalAdapter(mockService)
+		adapter := NewApprovalAdapter(mockService, nil, nil, nil, nil, nil)
 
 		mockService.On("CountPendingTasks", mock.Anything, mock.AnythingOfType("uuid.UUID")).
 			Return(5, nil).Once()
@@ -543,7 +571,7 @@ func TestApprovalAdapter_CountPendingTasks(t *testing.T) {
 func TestApprovalAdapter_BatchProcessTasks(t *testing.T) {
 	t.Run("BatchProcessTasks successfully", func(t *testing.T) {
 		mockService := new(MockApprovalService)
-		adapter := NewApprovalAdapter(mockService)
+		adapter := NewApprovalAdapter(mockService, nil, nil, nil, nil, nil)
 
 		taskID1 := uuid.New()
 		taskID2 := uuid.New()
@@ -574,7 +602,7 @@ func TestApprovalAdapter_BatchProcessTasks(t *testing.T) {
 func TestApprovalAdapter_TransferTask(t *testing.T) {
 	t.Run("TransferTask successfully", func(t *testing.T) {
 		mockService := new(MockApprovalService)
-		adapter := NewApprovalAdapter(mockService)
+		adapter := NewApprovalAdapter(mockService, nil, nil, nil, nil, nil)
 
 		taskID := uuid.New()
 		toUserID := uuid.New()
@@ -599,7 +627,7 @@ func TestApprovalAdapter_TransferTask(t *testing.T) {
 func TestApprovalAdapter_DelegateTask(t *testing.T) {
 	t.Run("DelegateTask successfully", func(t *testing.T) {
 		mockService := new(MockApprovalService)
-		adapter := NewApprovalAdapter(mockService)
+		adapter := NewApprovalAdapter(mockService, nil, nil, nil, nil, nil)
 
 		taskID := uuid.New()
 		toUserID := uuid.New()
@@ -620,3 +648,123 @@ func TestApprovalAdapter_DelegateTask(t *testing.T) {
 		mockService.AssertExpectations(t)
 	})
 }
+
+type stubProcessSearchService struct {
+	service.ProcessSearchService
+	requests []*dto.SearchInstancesRequest
+}
+
+func (s *stubProcessSearchService) SearchInstances(ctx context.Context, req *dto.SearchInstancesRequest) (*pagination.PageResponse[*dto.ProcessInstanceResponse], error) {
+	s.requests = append(s.requests, req)
+	return &pagination.PageResponse[*dto.ProcessInstanceResponse]{
+		Items:      []*dto.ProcessInstanceResponse{{ID: uuid.New(), Title: "请假申请"}},
+		PageSize:   req.PageSize,
+		HasNext:    true,
+		NextCursor: "next",
+	}, nil
+}
+
+type stubProcessAdminJobService struct {
+	service.ProcessAdminJobService
+	requests []*dto.SubmitInstanceJobRequest
+}
+
+func (s *stubProcessAdminJobService) SubmitForceComplete(ctx context.Context, req *dto.SubmitInstanceJobRequest) (*dto.AdminJobResponse, error) {
+	s.requests = append(s.requests, req)
+	return &dto.AdminJobResponse{
+		AdminJob: &model.AdminJob{
+			ID:     uuid.New(),
+			Type:   model.AdminJobForceComplete,
+			Status: model.AdminJobStatusPending,
+			Params: map[string]interface{}{"status": string(req.Status)},
+			Total:  len(req.InstanceIDs),
+		},
+	}, nil
+}
+
+func loggedInContext(tenantID, userID uuid.UUID) context.Context {
+	ctx := context.WithValue(context.Background(), "tenant_id", tenantID)
+	return context.WithValue(ctx, "user_id", userID)
+}
+
+// TestApprovalAdapter_SearchInstances 检索条件从 proto 请求转换
+func TestApprovalAdapter_SearchInstances(t *testing.T) {
+	tenantID, userID, processDefID := uuid.New(), uuid.New(), uuid.New()
+
+	t.Run("转换过滤条件并返回游标", func(t *testing.T) {
+		search := &stubProcessSearchService{}
+		adapter := NewApprovalAdapter(nil, search, nil, nil, nil, nil)
+
+		resp, err := adapter.SearchInstances(loggedInContext(tenantID, userID), &approvalv1.SearchInstancesRequest{
+			Keyword:      "请假",
+			ProcessDefId: processDefID.String(),
+			Status:       string(model.ProcessStatusPending),
+			FieldFilters: []*approvalv1.FieldFilter{{Key: "days", Op: "between", Value: "1", ValueTo: "3"}},
+			StartDate:    "2025-01-01T00:00:00Z",
+			PageSize:     20,
+		})
+		require.NoError(t, err)
+		assert.Len(t, resp.Items, 1)
+		assert.True(t, resp.HasNext)
+		assert.Equal(t, "next", resp.NextCursor)
+
+		require.Len(t, search.requests, 1)
+		req := search.requests[0]
+		assert.Equal(t, tenantID, req.TenantID)
+		assert.Equal(t, userID, req.OperatorID)
+		assert.Equal(t, processDefID, *req.ProcessDefID)
+		assert.Equal(t, model.ProcessStatusPending, *req.Status)
+		assert.Equal(t, []model.FieldFilter{{Key: "days", Op: model.FieldFilterBetween, Value: "1", ValueTo: "3"}}, req.FieldFilters)
+		assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), *req.StartDate)
+		assert.Nil(t, req.EndDate)
+	})
+
+	t.Run("非法参数", func(t *testing.T) {
+		search := &stubProcessSearchService{}
+		adapter := NewApprovalAdapter(nil, search, nil, nil, nil, nil)
+
+		_, err := adapter.SearchInstances(loggedInContext(tenantID, userID), &approvalv1.SearchInstancesRequest{StartDate: "2025-01-01"})
+		assert.True(t, errors.IsBadRequest(err))
+
+		_, err = adapter.SearchInstances(context.Background(), &approvalv1.SearchInstancesRequest{})
+		assert.ErrorIs(t, err, ErrMissingTenant)
+		assert.Empty(t, search.requests)
+	})
+}
+
+// TestApprovalAdapter_SubmitForceComplete 批量作业请求从 proto 请求转换
+func TestApprovalAdapter_SubmitForceComplete(t *testing.T) {
+	tenantID, userID, instanceID := uuid.New(), uuid.New(), uuid.New()
+
+	t.Run("提交作业", func(t *testing.T) {
+		jobs := &stubProcessAdminJobService{}
+		adapter := NewApprovalAdapter(nil, nil, nil, nil, nil, jobs)
+
+		resp, err := adapter.SubmitForceComplete(loggedInContext(tenantID, userID), &approvalv1.SubmitInstanceJobRequest{
+			InstanceIds: []string{instanceID.String()},
+			Status:      string(model.ProcessStatusApproved),
+			Reason:      "流程卡死",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, string(model.AdminJobForceComplete), resp.Type)
+		assert.Equal(t, int32(1), resp.Total)
+		assert.Equal(t, map[string]string{"status": string(model.ProcessStatusApproved)}, resp.Params)
+
+		require.Len(t, jobs.requests, 1)
+		assert.Equal(t, tenantID, jobs.requests[0].TenantID)
+		assert.Equal(t, userID, jobs.requests[0].OperatorID)
+		assert.Equal(t, []uuid.UUID{instanceID}, jobs.requests[0].InstanceIDs)
+	})
+
+	t.Run("非法实例ID", func(t *testing.T) {
+		jobs := &stubProcessAdminJobService{}
+		adapter := NewApprovalAdapter(nil, nil, nil, nil, nil, jobs)
+
+		_, err := adapter.SubmitForceComplete(loggedInContext(tenantID, userID), &approvalv1.SubmitInstanceJobRequest{
+			InstanceIds: []string{"not-a-uuid"},
+			Reason:      "流程卡死",
+		})
+		assert.True(t, errors.IsBadRequest(err))
+		assert.Empty(t, jobs.requests)
+	})
+}
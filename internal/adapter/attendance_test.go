@@ -0,0 +1,102 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	hrmv1 "github.com/lk2023060901/go-next-erp/api/hrm/v1"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/handler"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	hrmService "github.com/lk2023060901/go-next-erp/internal/hrm/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubClockInService struct {
+	hrmService.AttendanceService
+	requests []*hrmService.ClockInRequest
+}
+
+func (s *stubClockInService) ClockIn(ctx context.Context, req *hrmService.ClockInRequest) (*model.AttendanceRecord, error) {
+	s.requests = append(s.requests, req)
+	return &model.AttendanceRecord{ID: uuid.New(), TenantID: req.TenantID, EmployeeID: req.EmployeeID, ClockTime: req.ClockTime, ClockType: req.ClockType}, nil
+}
+
+type stubLinkedEmployeeRepo struct {
+	repository.HRMEmployeeRepository
+	links map[uuid.UUID]uuid.UUID
+}
+
+func (r *stubLinkedEmployeeRepo) FindEmployeeIDByUser(ctx context.Context, tenantID, userID uuid.UUID) (uuid.UUID, error) {
+	employeeID, ok := r.links[userID]
+	if !ok {
+		return uuid.Nil, errors.New("employee not found")
+	}
+	return employeeID, nil
+}
+
+// TestAttendanceAdapter_ClockIn 旧打卡接口只能为本人打卡
+func TestAttendanceAdapter_ClockIn(t *testing.T) {
+	tenantID, userID, employeeID := uuid.New(), uuid.New(), uuid.New()
+	newAdapter := func() (*HRMAdapter, *stubClockInService) {
+		svc := &stubClockInService{}
+		repo := &stubLinkedEmployeeRepo{links: map[uuid.UUID]uuid.UUID{userID: employeeID}}
+		return NewHRMAdapter(handler.NewAttendanceHandler(svc, repo), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil), svc
+	}
+	loggedIn := func(userID uuid.UUID) context.Context {
+		ctx := context.WithValue(context.Background(), "tenant_id", tenantID)
+		return context.WithValue(ctx, "user_id", userID)
+	}
+
+	t.Run("打卡员工取自登录用户", func(t *testing.T) {
+		adapter, svc := newAdapter()
+
+		resp, err := adapter.ClockIn(loggedIn(userID), &hrmv1.ClockInRequest{
+			ClockType: string(model.ClockTypeCheckIn),
+			WifiSsid:  "office",
+			Location:  &hrmv1.LocationInfo{Latitude: 31.2, Longitude: 121.5},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, employeeID.String(), resp.EmployeeId)
+		require.Len(t, svc.requests, 1)
+		assert.Equal(t, tenantID, svc.requests[0].TenantID)
+		assert.Equal(t, employeeID, svc.requests[0].EmployeeID)
+		assert.Equal(t, model.SourceTypeSystem, svc.requests[0].SourceType)
+	})
+
+	t.Run("不能替他人打卡", func(t *testing.T) {
+		adapter, svc := newAdapter()
+
+		_, err := adapter.ClockIn(loggedIn(userID), &hrmv1.ClockInRequest{
+			TenantId:   tenantID.String(),
+			EmployeeId: uuid.NewString(),
+			ClockType:  string(model.ClockTypeCheckIn),
+		})
+		assert.ErrorIs(t, err, handler.ErrClockInForOthers)
+
+		_, err = adapter.ClockIn(loggedIn(userID), &hrmv1.ClockInRequest{
+			TenantId:   uuid.NewString(),
+			EmployeeId: employeeID.String(),
+			ClockType:  string(model.ClockTypeCheckIn),
+		})
+		assert.ErrorIs(t, err, handler.ErrClockInForOthers)
+		assert.Empty(t, svc.requests)
+	})
+
+	t.Run("未关联员工或未登录", func(t *testing.T) {
+		adapter, svc := newAdapter()
+
+		_, err := adapter.ClockIn(loggedIn(uuid.New()), &hrmv1.ClockInRequest{ClockType: string(model.ClockTypeCheckIn)})
+		assert.ErrorIs(t, err, handler.ErrClockInNoEmployee)
+
+		_, err = adapter.ClockIn(context.Background(), &hrmv1.ClockInRequest{
+			EmployeeId: employeeID.String(),
+			ClockType:  string(model.ClockTypeCheckIn),
+		})
+		assert.ErrorIs(t, err, handler.ErrMissingTenant)
+		assert.Empty(t, svc.requests)
+	})
+}
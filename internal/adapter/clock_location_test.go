@@ -0,0 +1,106 @@
+package adapter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	hrmv1 "github.com/lk2023060901/go-next-erp/api/hrm/v1"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	hrmService "github.com/lk2023060901/go-next-erp/internal/hrm/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubClockLocationService struct {
+	hrmService.ClockLocationService
+	created []*model.ClockLocation
+	qr      *hrmService.ClockKioskQR
+}
+
+func (s *stubClockLocationService) CreateLocation(ctx context.Context, location *model.ClockLocation) error {
+	location.ID = uuid.New()
+	s.created = append(s.created, location)
+	return nil
+}
+
+func (s *stubClockLocationService) KioskQR(ctx context.Context, kioskKey string) (*hrmService.ClockKioskQR, error) {
+	if s.qr == nil || kioskKey != "kiosk-key" {
+		return nil, hrmService.ErrClockKioskKeyInvalid
+	}
+	return s.qr, nil
+}
+
+func newClockLocationAdapter(svc hrmService.ClockLocationService) *HRMAdapter {
+	return NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, svc, nil, nil, nil, nil, nil)
+}
+
+// TestClockLocationAdapter_CreateClockLocation 围栏顶点和打卡时段按请求写入打卡地点
+func TestClockLocationAdapter_CreateClockLocation(t *testing.T) {
+	tenantID, userID := uuid.New(), uuid.New()
+	ctx := context.WithValue(context.WithValue(context.Background(), "tenant_id", tenantID), "user_id", userID)
+	svc := &stubClockLocationService{}
+
+	resp, err := newClockLocationAdapter(svc).CreateClockLocation(ctx, &hrmv1.ClockLocationRequest{
+		Code: "HQ",
+		Name: "总部",
+		Polygon: []*hrmv1.GeoPoint{
+			{Latitude: 31.20, Longitude: 121.50},
+			{Latitude: 31.21, Longitude: 121.50},
+			{Latitude: 31.21, Longitude: 121.51},
+		},
+		TimeWindows: []*hrmv1.ClockTimeWindow{{Weekdays: []int32{1, 2, 3, 4, 5}, Start: "08:00", End: "10:00"}},
+		QrRequired:  true,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, svc.created, 1)
+	location := svc.created[0]
+	assert.Equal(t, tenantID, location.TenantID)
+	assert.Equal(t, userID, location.CreatedBy)
+	assert.True(t, location.IsActive)
+	assert.Len(t, location.Polygon, 3)
+	assert.Equal(t, []model.ClockTimeWindow{{Weekdays: []int{1, 2, 3, 4, 5}, Start: "08:00", End: "10:00"}}, location.TimeWindows)
+
+	assert.Equal(t, location.ID.String(), resp.Id)
+	assert.Equal(t, "HQ", resp.Code)
+	assert.Len(t, resp.Polygon, 3)
+	assert.Equal(t, []int32{1, 2, 3, 4, 5}, resp.TimeWindows[0].Weekdays)
+	assert.True(t, resp.QrRequired)
+}
+
+// TestClockLocationAdapter_GetClockKioskQR 考勤屏凭密钥拉取二维码，密钥错误时返回 401
+func TestClockLocationAdapter_GetClockKioskQR(t *testing.T) {
+	expiresAt := time.Now().Add(30 * time.Second)
+	svc := &stubClockLocationService{qr: &hrmService.ClockKioskQR{
+		LocationID:   uuid.New(),
+		LocationName: "总部",
+		Token:        "token",
+		Content:      "erp://clock?t=token",
+		ExpiresAt:    expiresAt,
+		RefreshIn:    30,
+	}}
+	adapter := newClockLocationAdapter(svc)
+
+	t.Run("返回当前二维码", func(t *testing.T) {
+		resp, err := adapter.GetClockKioskQR(context.Background(), &hrmv1.GetClockKioskQRRequest{Key: "kiosk-key"})
+		require.NoError(t, err)
+		assert.Equal(t, svc.qr.LocationID.String(), resp.LocationId)
+		assert.Equal(t, "token", resp.Token)
+		assert.Equal(t, int32(30), resp.RefreshIn)
+		assert.True(t, resp.ExpiresAt.AsTime().Equal(expiresAt))
+	})
+
+	t.Run("密钥错误", func(t *testing.T) {
+		_, err := adapter.GetClockKioskQR(context.Background(), &hrmv1.GetClockKioskQRRequest{Key: "wrong"})
+		require.Error(t, err)
+		assert.True(t, errors.IsUnauthorized(err))
+	})
+}
+
+// TestHRMPublicOperations 免登录接口只有考勤屏拉取二维码
+func TestHRMPublicOperations(t *testing.T) {
+	assert.Equal(t, []string{"/api.hrm.v1.ClockLocationService/GetClockKioskQR"}, HRMPublicOperations)
+}
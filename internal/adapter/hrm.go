@@ -4,7 +4,10 @@ import (
 	"context"
 
 	hrmv1 "github.com/lk2023060901/go-next-erp/api/hrm/v1"
+	"github.com/lk2023060901/go-next-erp/internal/auth/authorization"
 	"github.com/lk2023060901/go-next-erp/internal/hrm/handler"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	hrmService "github.com/lk2023060901/go-next-erp/internal/hrm/service"
 )
 
 // HRMAdapter HRM 适配器
@@ -20,6 +23,22 @@ type HRMAdapter struct {
 	hrmv1.UnimplementedBusinessTripServiceServer
 	hrmv1.UnimplementedLeaveOfficeServiceServer
 	hrmv1.UnimplementedPunchCardSupplementServiceServer
+	hrmv1.UnimplementedHolidayCalendarServiceServer
+	hrmv1.UnimplementedAttendanceSummaryServiceServer
+	hrmv1.UnimplementedScheduleRotationServiceServer
+	hrmv1.UnimplementedShiftSwapServiceServer
+	hrmv1.UnimplementedOvertimePolicyServiceServer
+	hrmv1.UnimplementedAttendanceAnomalyServiceServer
+	hrmv1.UnimplementedAttendanceDeviceServiceServer
+	hrmv1.UnimplementedBiometricServiceServer
+	hrmv1.UnimplementedHRMEmployeeServiceServer
+	hrmv1.UnimplementedEmployeeLifecycleServiceServer
+	hrmv1.UnimplementedTripExpenseServiceServer
+	hrmv1.UnimplementedPlatformSyncServiceServer
+	hrmv1.UnimplementedReportExportServiceServer
+	hrmv1.UnimplementedPayrollServiceServer
+	hrmv1.UnimplementedClockLocationServiceServer
+	hrmv1.UnimplementedTimeAllocationServiceServer
 
 	attendanceHandler          *handler.AttendanceHandler
 	shiftHandler               *handler.ShiftHandler
@@ -30,6 +49,30 @@ type HRMAdapter struct {
 	businessTripHandler        *handler.BusinessTripHandler
 	leaveOfficeHandler         *handler.LeaveOfficeHandler
 	punchCardSupplementHandler *handler.PunchCardSupplementHandler
+
+	calendarService hrmService.HolidayCalendarService
+	dayResolver     hrmService.DayTypeResolver
+	summaryService  hrmService.AttendanceSummaryService
+	leaveService    hrmService.LeaveService
+	accrualService  hrmService.LeaveAccrualService
+	rotationService hrmService.ScheduleRotationService
+	swapService     hrmService.ShiftSwapService
+	policyService   hrmService.OvertimePolicyService
+	anomalyService  hrmService.AttendanceAnomalyService
+	deviceService   hrmService.AttendanceDeviceService
+	deviceFleet     hrmService.DeviceFleetService
+	biometrics      hrmService.BiometricService
+	platformSync    hrmService.PlatformSyncService
+	tripExpenses    hrmService.TripExpenseService
+	lifecycle       hrmService.EmployeeLifecycleService
+	reportExports   hrmService.ReportExportService
+	payroll         hrmService.PayrollService
+	clockLocations  hrmService.ClockLocationService
+	attendance      hrmService.AttendanceService
+	supplements     hrmService.PunchCardSupplementService
+	allocations     hrmService.TimeAllocationService
+	hrmEmpRepo      repository.HRMEmployeeRepository
+	authzService    *authorization.Service
 }
 
 // NewHRMAdapter 创建 HRM 适配器
@@ -43,6 +86,29 @@ func NewHRMAdapter(
 	businessTripHandler *handler.BusinessTripHandler,
 	leaveOfficeHandler *handler.LeaveOfficeHandler,
 	punchCardSupplementHandler *handler.PunchCardSupplementHandler,
+	calendarService hrmService.HolidayCalendarService,
+	dayResolver hrmService.DayTypeResolver,
+	summaryService hrmService.AttendanceSummaryService,
+	leaveService hrmService.LeaveService,
+	accrualService hrmService.LeaveAccrualService,
+	rotationService hrmService.ScheduleRotationService,
+	swapService hrmService.ShiftSwapService,
+	policyService hrmService.OvertimePolicyService,
+	anomalyService hrmService.AttendanceAnomalyService,
+	deviceService hrmService.AttendanceDeviceService,
+	deviceFleet hrmService.DeviceFleetService,
+	biometrics hrmService.BiometricService,
+	platformSync hrmService.PlatformSyncService,
+	tripExpenses hrmService.TripExpenseService,
+	lifecycle hrmService.EmployeeLifecycleService,
+	reportExports hrmService.ReportExportService,
+	payroll hrmService.PayrollService,
+	clockLocations hrmService.ClockLocationService,
+	attendance hrmService.AttendanceService,
+	supplements hrmService.PunchCardSupplementService,
+	allocations hrmService.TimeAllocationService,
+	hrmEmpRepo repository.HRMEmployeeRepository,
+	authzService *authorization.Service,
 ) *HRMAdapter {
 	return &HRMAdapter{
 		attendanceHandler:          attendanceHandler,
@@ -54,6 +120,29 @@ func NewHRMAdapter(
 		businessTripHandler:        businessTripHandler,
 		leaveOfficeHandler:         leaveOfficeHandler,
 		punchCardSupplementHandler: punchCardSupplementHandler,
+		calendarService:            calendarService,
+		dayResolver:                dayResolver,
+		summaryService:             summaryService,
+		leaveService:               leaveService,
+		accrualService:             accrualService,
+		rotationService:            rotationService,
+		swapService:                swapService,
+		policyService:              policyService,
+		anomalyService:             anomalyService,
+		deviceService:              deviceService,
+		deviceFleet:                deviceFleet,
+		biometrics:                 biometrics,
+		platformSync:               platformSync,
+		tripExpenses:               tripExpenses,
+		lifecycle:                  lifecycle,
+		reportExports:              reportExports,
+		payroll:                    payroll,
+		clockLocations:             clockLocations,
+		attendance:                 attendance,
+		supplements:                supplements,
+		allocations:                allocations,
+		hrmEmpRepo:                 hrmEmpRepo,
+		authzService:               authzService,
 	}
 }
 
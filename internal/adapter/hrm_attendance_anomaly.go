@@ -0,0 +1,207 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	hrmv1 "github.com/lk2023060901/go-next-erp/api/hrm/v1"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	hrmService "github.com/lk2023060901/go-next-erp/internal/hrm/service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ========== AttendanceAnomalyService 实现 ==========
+
+// AnalyzeAttendanceAnomalies 手动分析时间段内的打卡记录（已识别的异常不重复生成）
+func (a *HRMAdapter) AnalyzeAttendanceAnomalies(ctx context.Context, req *hrmv1.AnalyzeAttendanceAnomaliesRequest) (*hrmv1.AnomalyAnalysisResponse, error) {
+	start, err := parseDate("start_date", req.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	end, err := parseDate("end_date", req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := a.anomalyService.Analyze(ctx, tenantID, start, end.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, attendanceAnomalyError(err)
+	}
+
+	resp := &hrmv1.AnomalyAnalysisResponse{
+		Records:  int32(result.Records),
+		Detected: make(map[string]int32, len(result.Detected)),
+		Created:  int32(result.Created),
+	}
+	for anomalyType, count := range result.Detected {
+		resp.Detected[string(anomalyType)] = int32(count)
+	}
+	return resp, nil
+}
+
+// ListAttendanceAnomalies 考勤异常列表（HR 待处理清单）
+func (a *HRMAdapter) ListAttendanceAnomalies(ctx context.Context, req *hrmv1.ListAttendanceAnomaliesRequest) (*hrmv1.ListAttendanceAnomaliesResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &repository.AttendanceAnomalyFilter{DeviceSN: req.DeviceSn}
+	if req.Type != "" {
+		anomalyType := model.AttendanceAnomalyType(req.Type)
+		filter.Type = &anomalyType
+	}
+	if req.Status != "" {
+		status := model.AttendanceAnomalyStatus(req.Status)
+		filter.Status = &status
+	}
+	if req.EmployeeId != "" {
+		employeeID, err := parseUUID("employee_id", req.EmployeeId)
+		if err != nil {
+			return nil, err
+		}
+		filter.EmployeeID = &employeeID
+	}
+	if req.StartDate != "" {
+		start, err := parseDate("start_date", req.StartDate)
+		if err != nil {
+			return nil, err
+		}
+		filter.StartDate = &start
+	}
+	if req.EndDate != "" {
+		end, err := parseDate("end_date", req.EndDate)
+		if err != nil {
+			return nil, err
+		}
+		end = end.AddDate(0, 0, 1)
+		filter.EndDate = &end
+	}
+
+	page, pageSize := int(req.Page), int(req.PageSize)
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 200 {
+		pageSize = 50
+	}
+
+	anomalies, total, err := a.anomalyService.List(ctx, tenantID, filter, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrmv1.AttendanceAnomalyResponse, 0, len(anomalies))
+	for _, anomaly := range anomalies {
+		items = append(items, toAttendanceAnomalyResponse(anomaly))
+	}
+	return &hrmv1.ListAttendanceAnomaliesResponse{Items: items, Total: int32(total)}, nil
+}
+
+// GetAttendanceAnomaly 获取考勤异常详情
+func (a *HRMAdapter) GetAttendanceAnomaly(ctx context.Context, req *hrmv1.GetAttendanceAnomalyRequest) (*hrmv1.AttendanceAnomalyResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	anomaly, err := a.anomalyService.Get(ctx, tenantID, id)
+	if err != nil {
+		return nil, attendanceAnomalyError(err)
+	}
+	return toAttendanceAnomalyResponse(anomaly), nil
+}
+
+// AcknowledgeAttendanceAnomaly HR 确认异常属实
+func (a *HRMAdapter) AcknowledgeAttendanceAnomaly(ctx context.Context, req *hrmv1.HandleAttendanceAnomalyRequest) (*hrmv1.AttendanceAnomalyResponse, error) {
+	return a.handleAttendanceAnomaly(ctx, req, a.anomalyService.Acknowledge)
+}
+
+// DismissAttendanceAnomaly HR 判定为误报并忽略
+func (a *HRMAdapter) DismissAttendanceAnomaly(ctx context.Context, req *hrmv1.HandleAttendanceAnomalyRequest) (*hrmv1.AttendanceAnomalyResponse, error) {
+	return a.handleAttendanceAnomaly(ctx, req, a.anomalyService.Dismiss)
+}
+
+func (a *HRMAdapter) handleAttendanceAnomaly(
+	ctx context.Context,
+	req *hrmv1.HandleAttendanceAnomalyRequest,
+	handle func(ctx context.Context, tenantID, id, operatorID uuid.UUID, comment string) (*model.AttendanceAnomaly, error),
+) (*hrmv1.AttendanceAnomalyResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	anomaly, err := handle(ctx, tenantID, id, userID, req.Comment)
+	if err != nil {
+		return nil, attendanceAnomalyError(err)
+	}
+	return toAttendanceAnomalyResponse(anomaly), nil
+}
+
+// attendanceAnomalyError 考勤异常业务错误转换为 HTTP 错误
+func attendanceAnomalyError(err error) error {
+	switch {
+	case errors.Is(err, hrmService.ErrAttendanceAnomalyNotFound):
+		return errors.NotFound("NOT_FOUND", err.Error())
+	case errors.Is(err, hrmService.ErrAnomalyAlreadyHandled):
+		return errors.Conflict("FAILED_PRECONDITION", err.Error())
+	case errors.Is(err, hrmService.ErrInvalidAnomalyRange):
+		return errors.BadRequest("INVALID_ARGUMENT", err.Error())
+	}
+	return err
+}
+
+// ========== 辅助转换函数 ==========
+
+func toAttendanceAnomalyResponse(anomaly *model.AttendanceAnomaly) *hrmv1.AttendanceAnomalyResponse {
+	resp := &hrmv1.AttendanceAnomalyResponse{
+		Id:            anomaly.ID.String(),
+		TenantId:      anomaly.TenantID.String(),
+		Type:          string(anomaly.Type),
+		Severity:      string(anomaly.Severity),
+		Status:        string(anomaly.Status),
+		EmployeeId:    optionalUUIDString(anomaly.EmployeeID),
+		EmployeeName:  anomaly.EmployeeName,
+		DeviceSn:      anomaly.DeviceSN,
+		RecordIds:     make([]string, 0, len(anomaly.RecordIDs)),
+		OccurredAt:    timestamppb.New(anomaly.OccurredAt),
+		Description:   anomaly.Description,
+		HandledBy:     optionalUUIDString(anomaly.HandledBy),
+		HandleComment: anomaly.HandleComment,
+		CreatedAt:     timestamppb.New(anomaly.CreatedAt),
+		UpdatedAt:     timestamppb.New(anomaly.UpdatedAt),
+	}
+	for _, id := range anomaly.RecordIDs {
+		resp.RecordIds = append(resp.RecordIds, id.String())
+	}
+	if len(anomaly.Details) > 0 {
+		resp.Details = make(map[string]string, len(anomaly.Details))
+		for key, value := range anomaly.Details {
+			resp.Details[key] = fmt.Sprint(value)
+		}
+	}
+	if anomaly.HandledAt != nil {
+		resp.HandledAt = timestamppb.New(*anomaly.HandledAt)
+	}
+	return resp
+}
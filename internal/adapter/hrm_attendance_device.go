@@ -0,0 +1,682 @@
+package adapter
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	hrmv1 "github.com/lk2023060901/go-next-erp/api/hrm/v1"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	hrmService "github.com/lk2023060901/go-next-erp/internal/hrm/service"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ========== AttendanceDeviceService 实现 ==========
+
+// CreateAttendanceDevice 登记考勤设备（推送协议的设备按序列号识别）
+func (a *HRMAdapter) CreateAttendanceDevice(ctx context.Context, req *hrmv1.AttendanceDeviceRequest) (*hrmv1.AttendanceDeviceResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	device := &model.AttendanceDevice{
+		TenantID:    tenantID,
+		DeviceSN:    req.DeviceSn,
+		SyncEnabled: true,
+		IsActive:    true,
+		CreatedBy:   userID,
+	}
+	if err := applyAttendanceDeviceRequest(device, req, userID); err != nil {
+		return nil, err
+	}
+
+	if err := a.deviceService.Create(ctx, device); err != nil {
+		return nil, attendanceDeviceError(err)
+	}
+	return toAttendanceDeviceResponse(a.maskDevice(ctx, device)), nil
+}
+
+// UpdateAttendanceDevice 更新考勤设备
+func (a *HRMAdapter) UpdateAttendanceDevice(ctx context.Context, req *hrmv1.AttendanceDeviceRequest) (*hrmv1.AttendanceDeviceResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	device, err := a.deviceService.Get(ctx, tenantID, id)
+	if err != nil {
+		return nil, attendanceDeviceError(err)
+	}
+	if err := applyAttendanceDeviceRequest(device, req, userID); err != nil {
+		return nil, err
+	}
+
+	if err := a.deviceService.Update(ctx, device); err != nil {
+		return nil, attendanceDeviceError(err)
+	}
+	return toAttendanceDeviceResponse(a.maskDevice(ctx, device)), nil
+}
+
+// DeleteAttendanceDevice 删除考勤设备（设备后续上传将被拒绝）
+func (a *HRMAdapter) DeleteAttendanceDevice(ctx context.Context, req *hrmv1.DeleteAttendanceDeviceRequest) (*emptypb.Empty, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.deviceService.Delete(ctx, tenantID, id); err != nil {
+		return nil, attendanceDeviceError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetAttendanceDevice 获取考勤设备
+func (a *HRMAdapter) GetAttendanceDevice(ctx context.Context, req *hrmv1.GetAttendanceDeviceRequest) (*hrmv1.AttendanceDeviceResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	device, err := a.deviceService.Get(ctx, tenantID, id)
+	if err != nil {
+		return nil, attendanceDeviceError(err)
+	}
+	return toAttendanceDeviceResponse(a.maskDevice(ctx, device)), nil
+}
+
+// ListAttendanceDevices 考勤设备列表
+func (a *HRMAdapter) ListAttendanceDevices(ctx context.Context, req *hrmv1.ListAttendanceDevicesRequest) (*hrmv1.ListAttendanceDevicesResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &repository.DeviceFilter{Keyword: req.Keyword}
+	if req.DeviceType != "" {
+		deviceType := model.DeviceType(req.DeviceType)
+		filter.DeviceType = &deviceType
+	}
+	if req.Status != "" {
+		status := model.DeviceStatus(req.Status)
+		filter.Status = &status
+	}
+
+	page, pageSize := int(req.Page), int(req.PageSize)
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 200 {
+		pageSize = 50
+	}
+
+	devices, total, err := a.deviceService.List(ctx, tenantID, filter, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	sensitive := a.canViewSensitive(ctx)
+	items := make([]*hrmv1.AttendanceDeviceResponse, 0, len(devices))
+	for _, device := range devices {
+		if !sensitive {
+			device = maskDeviceSecrets(device)
+		}
+		items = append(items, toAttendanceDeviceResponse(device))
+	}
+	return &hrmv1.ListAttendanceDevicesResponse{Items: items, Total: int32(total)}, nil
+}
+
+// PushDeviceEmployees 下发员工到设备（PIN 为工号）
+func (a *HRMAdapter) PushDeviceEmployees(ctx context.Context, req *hrmv1.DeviceEmployeesRequest) (*hrmv1.DeviceCommandQueuedResponse, error) {
+	return a.queueDeviceEmployees(ctx, req, a.deviceService.PushEmployees)
+}
+
+// RemoveDeviceEmployees 从设备删除员工
+func (a *HRMAdapter) RemoveDeviceEmployees(ctx context.Context, req *hrmv1.DeviceEmployeesRequest) (*hrmv1.DeviceCommandQueuedResponse, error) {
+	return a.queueDeviceEmployees(ctx, req, a.deviceService.RemoveEmployees)
+}
+
+func (a *HRMAdapter) queueDeviceEmployees(
+	ctx context.Context,
+	req *hrmv1.DeviceEmployeesRequest,
+	queue func(ctx context.Context, tenantID, deviceID uuid.UUID, employeeIDs []uuid.UUID, operatorID uuid.UUID) (int, error),
+) (*hrmv1.DeviceCommandQueuedResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	employeeIDs := make([]uuid.UUID, 0, len(req.EmployeeIds))
+	for _, value := range req.EmployeeIds {
+		employeeID, err := parseUUID("employee_ids", value)
+		if err != nil {
+			return nil, err
+		}
+		employeeIDs = append(employeeIDs, employeeID)
+	}
+	if len(employeeIDs) == 0 {
+		return nil, errors.BadRequest("INVALID_ARGUMENT", "employee_ids is required")
+	}
+
+	queued, err := queue(ctx, tenantID, id, employeeIDs, userID)
+	if err != nil {
+		return nil, attendanceDeviceError(err)
+	}
+	return &hrmv1.DeviceCommandQueuedResponse{Queued: int32(queued)}, nil
+}
+
+// ClearDeviceRecords 清空设备上的考勤记录
+func (a *HRMAdapter) ClearDeviceRecords(ctx context.Context, req *hrmv1.ClearDeviceRecordsRequest) (*emptypb.Empty, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.authorize(ctx, deviceResource, deviceCommandAction); err != nil {
+		return nil, err
+	}
+	if !req.Confirm {
+		return nil, attendanceDeviceError(hrmService.ErrDeviceCommandNotConfirmed)
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.deviceService.ClearRecords(ctx, tenantID, id, userID); err != nil {
+		return nil, attendanceDeviceError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListDeviceCommands 设备命令及执行结果
+func (a *HRMAdapter) ListDeviceCommands(ctx context.Context, req *hrmv1.ListDeviceCommandsRequest) (*hrmv1.ListDeviceCommandsResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, pageSize := int(req.Page), int(req.PageSize)
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 200 {
+		pageSize = 50
+	}
+
+	commands, total, err := a.deviceService.ListCommands(ctx, tenantID, id, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, attendanceDeviceError(err)
+	}
+
+	items := make([]*hrmv1.DeviceCommandInfo, 0, len(commands))
+	for _, command := range commands {
+		items = append(items, toDeviceCommandInfo(command))
+	}
+	return &hrmv1.ListDeviceCommandsResponse{Items: items, Total: int32(total)}, nil
+}
+
+// SendDeviceCommand 下发设备远程命令（同步员工、清空记录、重启、校时）
+func (a *HRMAdapter) SendDeviceCommand(ctx context.Context, req *hrmv1.SendDeviceCommandRequest) (*hrmv1.DeviceCommandQueuedResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.authorize(ctx, deviceResource, deviceCommandAction); err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	command := &hrmService.DeviceCommandRequest{
+		TenantID:   tenantID,
+		DeviceID:   id,
+		Command:    hrmService.DeviceRemoteCommand(req.Command),
+		Confirmed:  req.Confirm,
+		OperatorID: userID,
+	}
+	if req.Time != "" {
+		t, err := time.Parse(time.RFC3339, req.Time)
+		if err != nil {
+			return nil, errors.BadRequest("INVALID_ARGUMENT", "invalid time")
+		}
+		command.Time = &t
+	}
+
+	queued, err := a.deviceFleet.SendCommand(ctx, command)
+	if err != nil {
+		return nil, attendanceDeviceError(err)
+	}
+	return &hrmv1.DeviceCommandQueuedResponse{Queued: int32(queued)}, nil
+}
+
+// ListDeviceHealth 设备运行状况（在线状态、固件、同步延迟、命令积压）
+func (a *HRMAdapter) ListDeviceHealth(ctx context.Context, _ *hrmv1.ListDeviceHealthRequest) (*hrmv1.ListDeviceHealthResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	health, err := a.deviceFleet.ListHealth(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrmv1.DeviceHealthInfo, 0, len(health))
+	for _, h := range health {
+		items = append(items, toDeviceHealthInfo(h))
+	}
+	return &hrmv1.ListDeviceHealthResponse{Items: items}, nil
+}
+
+// ========== BiometricService 实现 ==========
+
+// EnrollBiometric 在设备上为员工启动指纹或人脸采集，返回采集会话；设备在有效期内上传的结果自动下发到其他设备
+func (a *HRMAdapter) EnrollBiometric(ctx context.Context, req *hrmv1.EnrollBiometricRequest) (*hrmv1.BiometricEnrollmentResponse, error) {
+	employeeID, err := parseUUID("employee_id", req.EmployeeId)
+	if err != nil {
+		return nil, err
+	}
+	deviceID, err := parseUUID("device_id", req.DeviceId)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollment, err := a.biometrics.StartEnrollment(ctx, &hrmService.BiometricEnrollRequest{
+		TenantID:   tenantID,
+		EmployeeID: employeeID,
+		DeviceID:   deviceID,
+		Type:       model.BiometricType(req.Type),
+		Index:      int(req.Index),
+		OperatorID: userID,
+	})
+	if err != nil {
+		return nil, biometricError(err)
+	}
+	return toBiometricEnrollmentResponse(enrollment), nil
+}
+
+// ListBiometricTemplates 员工当前的生物特征模板（不含模板数据）
+func (a *HRMAdapter) ListBiometricTemplates(ctx context.Context, req *hrmv1.EmployeeBiometricsRequest) (*hrmv1.ListBiometricTemplatesResponse, error) {
+	employeeID, err := parseUUID("employee_id", req.EmployeeId)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := a.biometrics.ListTemplates(ctx, tenantID, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrmv1.BiometricTemplateInfo, 0, len(templates))
+	for _, template := range templates {
+		items = append(items, toBiometricTemplateInfo(template))
+	}
+	return &hrmv1.ListBiometricTemplatesResponse{Items: items}, nil
+}
+
+// DistributeBiometrics 重新下发员工及其模板到所在部门的全部设备
+func (a *HRMAdapter) DistributeBiometrics(ctx context.Context, req *hrmv1.EmployeeBiometricsRequest) (*hrmv1.DistributeBiometricsResponse, error) {
+	employeeID, err := parseUUID("employee_id", req.EmployeeId)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	devices, err := a.biometrics.Distribute(ctx, tenantID, employeeID, &userID)
+	if err != nil {
+		return nil, biometricError(err)
+	}
+	return &hrmv1.DistributeBiometricsResponse{Devices: int32(devices)}, nil
+}
+
+// ListBiometricDistributions 生物特征在各设备上的下发状态
+func (a *HRMAdapter) ListBiometricDistributions(ctx context.Context, req *hrmv1.ListBiometricDistributionsRequest) (*hrmv1.ListBiometricDistributionsResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &repository.BiometricDistributionFilter{}
+	if req.EmployeeId != "" {
+		employeeID, err := parseUUID("employee_id", req.EmployeeId)
+		if err != nil {
+			return nil, err
+		}
+		filter.EmployeeID = &employeeID
+	}
+	if req.DeviceId != "" {
+		deviceID, err := parseUUID("device_id", req.DeviceId)
+		if err != nil {
+			return nil, err
+		}
+		filter.DeviceID = &deviceID
+	}
+
+	distributions, err := a.biometrics.ListDistributions(ctx, tenantID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrmv1.BiometricDistributionInfo, 0, len(distributions))
+	for _, distribution := range distributions {
+		items = append(items, toBiometricDistributionInfo(distribution))
+	}
+	return &hrmv1.ListBiometricDistributionsResponse{Items: items}, nil
+}
+
+func applyAttendanceDeviceRequest(device *model.AttendanceDevice, req *hrmv1.AttendanceDeviceRequest, userID uuid.UUID) error {
+	device.DeviceType = model.DeviceType(req.DeviceType)
+	device.DeviceName = req.DeviceName
+	device.DeviceModel = req.DeviceModel
+	device.IPAddress = req.IpAddress
+	device.Port = int(req.Port)
+	device.Location = nil
+	if req.Location != nil {
+		device.Location = &model.LocationInfo{
+			Latitude:  req.Location.Latitude,
+			Longitude: req.Location.Longitude,
+			Accuracy:  req.Location.Accuracy,
+		}
+	}
+	device.InstallAddress = req.InstallAddress
+	device.SyncMode = req.SyncMode
+	device.SyncInterval = int(req.SyncInterval)
+	device.SupportFace = req.SupportFace
+	device.SupportFingerprint = req.SupportFingerprint
+	device.SupportCard = req.SupportCard
+	device.Remark = req.Remark
+	device.PushAllowedIPs = req.PushAllowedIps
+	if req.CommKey != nil && *req.CommKey != maskedSecret {
+		device.SecretKey = *req.CommKey
+	}
+	if req.IsActive != nil {
+		device.IsActive = *req.IsActive
+	}
+
+	device.DepartmentID = nil
+	if req.DepartmentId != "" {
+		departmentID, err := parseUUID("department_id", req.DepartmentId)
+		if err != nil {
+			return err
+		}
+		device.DepartmentID = &departmentID
+	}
+	device.UpdatedBy = userID
+	return nil
+}
+
+// maskDevice 按权限脱敏设备凭据
+func (a *HRMAdapter) maskDevice(ctx context.Context, device *model.AttendanceDevice) *model.AttendanceDevice {
+	if a.canViewSensitive(ctx) {
+		return device
+	}
+	return maskDeviceSecrets(device)
+}
+
+// maskDeviceSecrets 返回凭据脱敏后的副本，不修改原对象
+func maskDeviceSecrets(device *model.AttendanceDevice) *model.AttendanceDevice {
+	masked := *device
+	if masked.Password != "" {
+		masked.Password = maskedSecret
+	}
+	if masked.SecretKey != "" {
+		masked.SecretKey = maskedSecret
+	}
+	return &masked
+}
+
+// attendanceDeviceError 考勤设备业务错误转换为 HTTP 错误
+func attendanceDeviceError(err error) error {
+	switch {
+	case errors.Is(err, hrmService.ErrAttendanceDeviceNotFound):
+		return errors.NotFound("NOT_FOUND", err.Error())
+	case errors.Is(err, hrmService.ErrDeviceSNExists):
+		return errors.Conflict("ALREADY_EXISTS", err.Error())
+	case errors.Is(err, hrmService.ErrDeviceTypeNotSupported):
+		return errors.Conflict("FAILED_PRECONDITION", err.Error())
+	case errors.Is(err, hrmService.ErrDeviceDisabled):
+		return errors.Conflict("FAILED_PRECONDITION", err.Error())
+	case errors.Is(err, hrmService.ErrInvalidAttendanceDevice),
+		errors.Is(err, hrmService.ErrDevicePushCredentials),
+		errors.Is(err, hrmService.ErrDeviceUsersNotFound),
+		errors.Is(err, hrmService.ErrInvalidDeviceCommand),
+		errors.Is(err, hrmService.ErrDeviceCommandNotConfirmed):
+		return errors.BadRequest("INVALID_ARGUMENT", err.Error())
+	}
+	return err
+}
+
+// biometricError 生物特征业务错误转换为 HTTP 错误
+func biometricError(err error) error {
+	switch {
+	case errors.Is(err, hrmService.ErrBiometricTemplatesNotFound):
+		return errors.NotFound("NOT_FOUND", err.Error())
+	case errors.Is(err, hrmService.ErrInvalidBiometric):
+		return errors.BadRequest("INVALID_ARGUMENT", err.Error())
+	}
+	return attendanceDeviceError(err)
+}
+
+// ========== 辅助转换函数 ==========
+
+// optionalTimestamp 可空时间转换为 Timestamp，为空时返回 nil
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func toAttendanceDeviceResponse(device *model.AttendanceDevice) *hrmv1.AttendanceDeviceResponse {
+	resp := &hrmv1.AttendanceDeviceResponse{
+		Id:                 device.ID.String(),
+		TenantId:           device.TenantID.String(),
+		DeviceType:         string(device.DeviceType),
+		DeviceSn:           device.DeviceSN,
+		DeviceName:         device.DeviceName,
+		DeviceModel:        device.DeviceModel,
+		IpAddress:          device.IPAddress,
+		Port:               int32(device.Port),
+		MacAddress:         device.MACAddress,
+		InstallAddress:     device.InstallAddress,
+		DepartmentId:       optionalUUIDString(device.DepartmentID),
+		AuthType:           device.AuthType,
+		Username:           device.Username,
+		Password:           device.Password,
+		ApiKey:             device.APIKey,
+		SecretKey:          device.SecretKey,
+		SyncEnabled:        device.SyncEnabled,
+		SyncInterval:       int32(device.SyncInterval),
+		SyncMode:           device.SyncMode,
+		LastSyncAt:         optionalTimestamp(device.LastSyncAt),
+		SupportFace:        device.SupportFace,
+		SupportFingerprint: device.SupportFingerprint,
+		SupportCard:        device.SupportCard,
+		SupportTemperature: device.SupportTemperature,
+		Status:             string(device.Status),
+		IsActive:           device.IsActive,
+		LastHeartbeat:      optionalTimestamp(device.LastHeartbeat),
+		ErrorMessage:       device.ErrorMessage,
+		FirmwareVersion:    device.FirmwareVersion,
+		FingerprintVersion: device.FingerprintVersion,
+		FaceVersion:        device.FaceVersion,
+		PushAllowedIps:     device.PushAllowedIPs,
+		TotalRecords:       int32(device.TotalRecords),
+		TodayRecords:       int32(device.TodayRecords),
+		Remark:             device.Remark,
+		CreatedBy:          device.CreatedBy.String(),
+		UpdatedBy:          device.UpdatedBy.String(),
+		CreatedAt:          timestamppb.New(device.CreatedAt),
+		UpdatedAt:          timestamppb.New(device.UpdatedAt),
+	}
+	if device.Location != nil {
+		resp.Location = &hrmv1.LocationInfo{
+			Latitude:  device.Location.Latitude,
+			Longitude: device.Location.Longitude,
+			Accuracy:  device.Location.Accuracy,
+		}
+	}
+	return resp
+}
+
+func toDeviceCommandInfo(command *model.DeviceCommand) *hrmv1.DeviceCommandInfo {
+	info := &hrmv1.DeviceCommandInfo{
+		Id:          command.ID.String(),
+		Seq:         command.Seq,
+		TenantId:    command.TenantID.String(),
+		DeviceId:    command.DeviceID.String(),
+		DeviceSn:    command.DeviceSN,
+		EmployeeId:  optionalUUIDString(command.EmployeeID),
+		CommandType: string(command.CommandType),
+		Content:     command.Content,
+		Status:      string(command.Status),
+		SentAt:      optionalTimestamp(command.SentAt),
+		CompletedAt: optionalTimestamp(command.CompletedAt),
+		CreatedBy:   optionalUUIDString(command.CreatedBy),
+		CreatedAt:   timestamppb.New(command.CreatedAt),
+		UpdatedAt:   timestamppb.New(command.UpdatedAt),
+	}
+	if command.ReturnCode != nil {
+		code := int32(*command.ReturnCode)
+		info.ReturnCode = &code
+	}
+	return info
+}
+
+func toDeviceHealthInfo(health *model.DeviceHealth) *hrmv1.DeviceHealthInfo {
+	return &hrmv1.DeviceHealthInfo{
+		DeviceId:        health.DeviceID.String(),
+		DeviceSn:        health.DeviceSN,
+		DeviceName:      health.DeviceName,
+		DeviceModel:     health.DeviceModel,
+		FirmwareVersion: health.FirmwareVersion,
+		Status:          string(health.Status),
+		Online:          health.Online,
+		IsActive:        health.IsActive,
+		ErrorMessage:    health.ErrorMessage,
+		LastHeartbeat:   optionalTimestamp(health.LastHeartbeat),
+		LastSyncAt:      optionalTimestamp(health.LastSyncAt),
+		SyncLagSeconds:  health.SyncLag,
+		UserCount:       int32(health.UserCount),
+		RecordCount:     int32(health.RecordCount),
+		PendingCommands: int32(health.PendingCommands),
+		OldestPendingAt: optionalTimestamp(health.OldestPending),
+		FailedCommands:  int32(health.FailedCommands),
+	}
+}
+
+func toBiometricEnrollmentResponse(enrollment *model.BiometricEnrollment) *hrmv1.BiometricEnrollmentResponse {
+	return &hrmv1.BiometricEnrollmentResponse{
+		Id:         enrollment.ID.String(),
+		TenantId:   enrollment.TenantID.String(),
+		EmployeeId: enrollment.EmployeeID.String(),
+		DeviceId:   enrollment.DeviceID.String(),
+		Type:       string(enrollment.Type),
+		Index:      int32(enrollment.Index),
+		Status:     string(enrollment.Status),
+		ExpiresAt:  timestamppb.New(enrollment.ExpiresAt),
+		CapturedAt: optionalTimestamp(enrollment.CapturedAt),
+		CreatedBy:  enrollment.CreatedBy.String(),
+		CreatedAt:  timestamppb.New(enrollment.CreatedAt),
+	}
+}
+
+func toBiometricTemplateInfo(template *model.BiometricTemplate) *hrmv1.BiometricTemplateInfo {
+	return &hrmv1.BiometricTemplateInfo{
+		Id:               template.ID.String(),
+		TenantId:         template.TenantID.String(),
+		EmployeeId:       template.EmployeeID.String(),
+		Type:             string(template.Type),
+		Index:            int32(template.Index),
+		DeviceType:       string(template.DeviceType),
+		AlgorithmVersion: template.AlgorithmVersion,
+		Revision:         int32(template.Revision),
+		SourceDeviceId:   optionalUUIDString(template.SourceDeviceID),
+		CreatedAt:        timestamppb.New(template.CreatedAt),
+	}
+}
+
+func toBiometricDistributionInfo(distribution *model.BiometricDistribution) *hrmv1.BiometricDistributionInfo {
+	return &hrmv1.BiometricDistributionInfo{
+		Id:              distribution.ID.String(),
+		TenantId:        distribution.TenantID.String(),
+		EmployeeId:      distribution.EmployeeID.String(),
+		EmployeeNo:      distribution.EmployeeNo,
+		EmployeeName:    distribution.EmployeeName,
+		DeviceId:        distribution.DeviceID.String(),
+		DeviceSn:        distribution.DeviceSN,
+		DeviceName:      distribution.DeviceName,
+		Action:          string(distribution.Action),
+		Templates:       int32(distribution.Templates),
+		QueuedAt:        timestamppb.New(distribution.QueuedAt),
+		PendingCommands: int32(distribution.PendingCommands),
+		FailedCommands:  int32(distribution.FailedCommands),
+		Status:          string(distribution.Status),
+		CreatedAt:       timestamppb.New(distribution.CreatedAt),
+		UpdatedAt:       timestamppb.New(distribution.UpdatedAt),
+	}
+}
@@ -0,0 +1,239 @@
+package adapter
+
+import (
+	"context"
+
+	hrmv1 "github.com/lk2023060901/go-next-erp/api/hrm/v1"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ========== AttendanceSummaryService 实现 ==========
+
+// ListAttendanceSummaries 考勤汇总列表
+func (a *HRMAdapter) ListAttendanceSummaries(ctx context.Context, req *hrmv1.ListAttendanceSummariesRequest) (*hrmv1.ListAttendanceSummariesResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &repository.AttendanceSummaryFilter{Keyword: req.Keyword}
+	if req.Year > 0 {
+		year := int(req.Year)
+		filter.Year = &year
+	}
+	if req.Month > 0 {
+		month := int(req.Month)
+		filter.Month = &month
+	}
+	if req.Status != "" {
+		filter.Status = &req.Status
+	}
+	if req.EmployeeId != "" {
+		employeeID, err := parseUUID("employee_id", req.EmployeeId)
+		if err != nil {
+			return nil, err
+		}
+		filter.EmployeeID = &employeeID
+	}
+	if req.DepartmentId != "" {
+		departmentID, err := parseUUID("department_id", req.DepartmentId)
+		if err != nil {
+			return nil, err
+		}
+		filter.DepartmentID = &departmentID
+	}
+
+	page, pageSize := int(req.Page), int(req.PageSize)
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 200 {
+		pageSize = 50
+	}
+
+	summaries, total, err := a.summaryService.List(ctx, tenantID, filter, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrmv1.AttendanceSummaryResponse, 0, len(summaries))
+	for _, summary := range summaries {
+		items = append(items, toAttendanceSummaryResponse(summary))
+	}
+	return &hrmv1.ListAttendanceSummariesResponse{
+		Items:    items,
+		Total:    int64(total),
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	}, nil
+}
+
+// GetAttendanceSummary 获取员工月度考勤汇总
+func (a *HRMAdapter) GetAttendanceSummary(ctx context.Context, req *hrmv1.EmployeeMonthRequest) (*hrmv1.AttendanceSummaryResponse, error) {
+	employeeID, err := parseUUID("employee_id", req.EmployeeId)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := a.summaryService.Get(ctx, tenantID, employeeID, int(req.Year), int(req.Month))
+	if err != nil {
+		return nil, err
+	}
+	return toAttendanceSummaryResponse(summary), nil
+}
+
+// ComputeEmployeeSummary 立即重算员工月度考勤汇总
+func (a *HRMAdapter) ComputeEmployeeSummary(ctx context.Context, req *hrmv1.EmployeeMonthRequest) (*hrmv1.AttendanceSummaryResponse, error) {
+	employeeID, err := parseUUID("employee_id", req.EmployeeId)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := a.summaryService.Compute(ctx, tenantID, employeeID, int(req.Year), int(req.Month))
+	if err != nil {
+		return nil, err
+	}
+	return toAttendanceSummaryResponse(summary), nil
+}
+
+// ComputeAttendanceMonth 立即重算租户某月的草稿汇总
+func (a *HRMAdapter) ComputeAttendanceMonth(ctx context.Context, req *hrmv1.AttendanceMonthRequest) (*hrmv1.AttendanceMonthResultResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	computed, err := a.summaryService.ComputeMonth(ctx, tenantID, int(req.Year), int(req.Month))
+	if err != nil {
+		return nil, err
+	}
+	return &hrmv1.AttendanceMonthResultResponse{Affected: int64(computed)}, nil
+}
+
+// ConfirmAttendanceSummary HR 确认单个员工的月度汇总
+func (a *HRMAdapter) ConfirmAttendanceSummary(ctx context.Context, req *hrmv1.ConfirmAttendanceSummaryRequest) (*emptypb.Empty, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.summaryService.Confirm(ctx, tenantID, id, userID); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ConfirmAttendanceMonth HR 确认某月全部草稿汇总
+func (a *HRMAdapter) ConfirmAttendanceMonth(ctx context.Context, req *hrmv1.AttendanceMonthRequest) (*hrmv1.AttendanceMonthResultResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := a.summaryService.ConfirmMonth(ctx, tenantID, int(req.Year), int(req.Month), userID)
+	if err != nil {
+		return nil, err
+	}
+	return &hrmv1.AttendanceMonthResultResponse{Affected: affected}, nil
+}
+
+// LockAttendanceMonth 锁定某月考勤（锁定后拒绝修改该月的考勤、请假、加班等数据）
+func (a *HRMAdapter) LockAttendanceMonth(ctx context.Context, req *hrmv1.AttendanceMonthRequest) (*emptypb.Empty, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.authorize(ctx, attendancePeriodResource, attendancePeriodLockAction); err != nil {
+		return nil, err
+	}
+
+	if err := a.summaryService.LockMonth(ctx, tenantID, int(req.Year), int(req.Month)); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// UnlockAttendanceMonth 解锁某月考勤（汇总退回已确认状态）
+func (a *HRMAdapter) UnlockAttendanceMonth(ctx context.Context, req *hrmv1.AttendanceMonthRequest) (*emptypb.Empty, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.authorize(ctx, attendancePeriodResource, attendancePeriodUnlockAction); err != nil {
+		return nil, err
+	}
+
+	if err := a.summaryService.UnlockMonth(ctx, tenantID, int(req.Year), int(req.Month)); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ========== 辅助转换函数 ==========
+
+func toAttendanceSummaryResponse(summary *model.AttendanceSummary) *hrmv1.AttendanceSummaryResponse {
+	resp := &hrmv1.AttendanceSummaryResponse{
+		Id:                summary.ID.String(),
+		TenantId:          summary.TenantID.String(),
+		EmployeeId:        summary.EmployeeID.String(),
+		EmployeeName:      summary.EmployeeName,
+		DepartmentId:      summary.DepartmentID.String(),
+		Year:              int32(summary.Year),
+		Month:             int32(summary.Month),
+		WorkDays:          int32(summary.WorkDays),
+		ActualDays:        int32(summary.ActualDays),
+		LateCount:         int32(summary.LateCount),
+		LateDuration:      int32(summary.LateDuration),
+		EarlyCount:        int32(summary.EarlyCount),
+		EarlyDuration:     int32(summary.EarlyDuration),
+		AbsentCount:       int32(summary.AbsentCount),
+		AbsentDays:        summary.AbsentDays,
+		MissingCount:      int32(summary.MissingCount),
+		LeaveCount:        int32(summary.LeaveCount),
+		LeaveDays:         summary.LeaveDays,
+		OvertimeCount:     int32(summary.OvertimeCount),
+		OvertimeHours:     summary.OvertimeHours,
+		WeekendOtHours:    summary.WeekendOTHours,
+		HolidayOtHours:    summary.HolidayOTHours,
+		CompOffDays:       summary.CompOffDays,
+		TripCount:         int32(summary.TripCount),
+		TripDays:          summary.TripDays,
+		LeaveOfficeCount:  int32(summary.LeaveOfficeCount),
+		LeaveOfficeHours:  summary.LeaveOfficeHours,
+		WorkHours:         summary.WorkHours,
+		StandardWorkHours: summary.StandardWorkHours,
+		Status:            summary.Status,
+		CreatedAt:         timestamppb.New(summary.CreatedAt),
+		UpdatedAt:         timestamppb.New(summary.UpdatedAt),
+	}
+	if summary.ConfirmedAt != nil {
+		resp.ConfirmedAt = timestamppb.New(*summary.ConfirmedAt)
+	}
+	if summary.ConfirmedBy != nil {
+		resp.ConfirmedBy = summary.ConfirmedBy.String()
+	}
+	return resp
+}
@@ -0,0 +1,495 @@
+package adapter
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	hrmv1 "github.com/lk2023060901/go-next-erp/api/hrm/v1"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	hrmService "github.com/lk2023060901/go-next-erp/internal/hrm/service"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ========== ClockLocationService 实现 ==========
+
+// CreateClockLocation 创建打卡地点
+func (a *HRMAdapter) CreateClockLocation(ctx context.Context, req *hrmv1.ClockLocationRequest) (*hrmv1.ClockLocationResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	location := &model.ClockLocation{
+		TenantID:  tenantID,
+		Code:      req.Code,
+		IsActive:  true,
+		CreatedBy: userID,
+	}
+	applyClockLocationRequest(location, req, userID)
+
+	if err := a.clockLocations.CreateLocation(ctx, location); err != nil {
+		return nil, clockLocationError(err)
+	}
+	return toClockLocationResponse(location), nil
+}
+
+// UpdateClockLocation 更新打卡地点（围栏、时段、是否须扫码）
+func (a *HRMAdapter) UpdateClockLocation(ctx context.Context, req *hrmv1.ClockLocationRequest) (*hrmv1.ClockLocationResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := a.clockLocations.GetLocation(ctx, tenantID, id)
+	if err != nil {
+		return nil, clockLocationError(err)
+	}
+	applyClockLocationRequest(location, req, userID)
+
+	if err := a.clockLocations.UpdateLocation(ctx, location); err != nil {
+		return nil, clockLocationError(err)
+	}
+	return toClockLocationResponse(location), nil
+}
+
+// DeleteClockLocation 删除打卡地点（考勤规则中的关联随之失效）
+func (a *HRMAdapter) DeleteClockLocation(ctx context.Context, req *hrmv1.DeleteClockLocationRequest) (*emptypb.Empty, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.clockLocations.DeleteLocation(ctx, tenantID, id); err != nil {
+		return nil, clockLocationError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetClockLocation 获取打卡地点
+func (a *HRMAdapter) GetClockLocation(ctx context.Context, req *hrmv1.GetClockLocationRequest) (*hrmv1.ClockLocationResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := a.clockLocations.GetLocation(ctx, tenantID, id)
+	if err != nil {
+		return nil, clockLocationError(err)
+	}
+	return toClockLocationResponse(location), nil
+}
+
+// ListClockLocations 租户打卡地点列表
+func (a *HRMAdapter) ListClockLocations(ctx context.Context, _ *hrmv1.ListClockLocationsRequest) (*hrmv1.ListClockLocationsResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	locations, err := a.clockLocations.ListLocations(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrmv1.ClockLocationResponse, 0, len(locations))
+	for _, location := range locations {
+		items = append(items, toClockLocationResponse(location))
+	}
+	return &hrmv1.ListClockLocationsResponse{Items: items}, nil
+}
+
+// RotateClockKioskKey 生成考勤屏密钥（旧密钥立即失效），明文只返回这一次
+func (a *HRMAdapter) RotateClockKioskKey(ctx context.Context, req *hrmv1.RotateClockKioskKeyRequest) (*hrmv1.ClockKioskKeyResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := a.clockLocations.RotateKioskKey(ctx, tenantID, id, userID)
+	if err != nil {
+		return nil, clockLocationError(err)
+	}
+	return &hrmv1.ClockKioskKeyResponse{KioskKey: key}, nil
+}
+
+// AssignClockLocations 考勤规则关联打卡地点，规则适用范围内的员工自助打卡须符合地点的围栏、时段和扫码要求
+func (a *HRMAdapter) AssignClockLocations(ctx context.Context, req *hrmv1.AssignClockLocationsRequest) (*hrmv1.AttendanceRuleResponse, error) {
+	ruleID, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	locationIDs := make([]uuid.UUID, 0, len(req.LocationIds))
+	for _, value := range req.LocationIds {
+		id, err := parseUUID("location_ids", value)
+		if err != nil {
+			return nil, err
+		}
+		locationIDs = append(locationIDs, id)
+	}
+
+	rule, err := a.clockLocations.AssignToRule(ctx, tenantID, ruleID, locationIDs, userID)
+	if err != nil {
+		return nil, clockLocationError(err)
+	}
+	return toAttendanceRuleResponse(rule), nil
+}
+
+// GetClockKioskQR 考勤屏凭密钥拉取当前二维码（免登录，按 refresh_in 定时刷新）
+func (a *HRMAdapter) GetClockKioskQR(ctx context.Context, req *hrmv1.GetClockKioskQRRequest) (*hrmv1.ClockKioskQRResponse, error) {
+	qr, err := a.clockLocations.KioskQR(ctx, req.Key)
+	if err != nil {
+		return nil, clockLocationError(err)
+	}
+	return &hrmv1.ClockKioskQRResponse{
+		LocationId:   qr.LocationID.String(),
+		LocationName: qr.LocationName,
+		Token:        qr.Token,
+		Content:      qr.Content,
+		ExpiresAt:    timestamppb.New(qr.ExpiresAt),
+		RefreshIn:    int32(qr.RefreshIn),
+	}, nil
+}
+
+// ScanClockIn 员工扫描考勤屏二维码为本人打卡，二维码须在有效期内、本周期未使用过，且与定位、打卡时段相符
+func (a *HRMAdapter) ScanClockIn(ctx context.Context, req *hrmv1.ScanClockInRequest) (*hrmv1.ClockInResponse, error) {
+	tenantID, employeeID, err := a.employeeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Token == "" {
+		return nil, errors.BadRequest("INVALID_ARGUMENT", "token is required")
+	}
+
+	clockType := model.ClockTypeCheckIn
+	if req.ClockType != "" {
+		clockType = model.AttendanceClockType(req.ClockType)
+	}
+
+	clockReq := &hrmService.ClockInRequest{
+		TenantID:      tenantID,
+		EmployeeID:    employeeID,
+		ClockTime:     time.Now(),
+		ClockType:     clockType,
+		CheckInMethod: model.MethodQRCode,
+		SourceType:    model.SourceTypeSystem,
+		Address:       req.Address,
+		WiFiSSID:      req.WifiSsid,
+		WiFiMAC:       req.WifiMac,
+		Remark:        req.Remark,
+		QRToken:       req.Token,
+	}
+	if req.Latitude != nil && req.Longitude != nil {
+		clockReq.Location = &model.LocationInfo{
+			Latitude:  *req.Latitude,
+			Longitude: *req.Longitude,
+			Accuracy:  req.Accuracy,
+		}
+	}
+
+	record, err := a.attendance.ClockIn(ctx, clockReq)
+	if err != nil {
+		return nil, clockLocationError(err)
+	}
+	return &hrmv1.ClockInResponse{
+		Id:              record.ID.String(),
+		EmployeeId:      record.EmployeeID.String(),
+		EmployeeName:    record.EmployeeName,
+		ClockTime:       record.ClockTime.Format(time.RFC3339),
+		ClockType:       string(record.ClockType),
+		Status:          string(record.Status),
+		ShiftName:       record.ShiftName,
+		IsException:     record.IsException,
+		ExceptionReason: record.ExceptionReason,
+		Message:         "打卡成功",
+	}, nil
+}
+
+// ListClockAttempts 被拒打卡列表（HR 复核清单）
+func (a *HRMAdapter) ListClockAttempts(ctx context.Context, req *hrmv1.ListClockAttemptsRequest) (*hrmv1.ListClockAttemptsResponse, error) {
+	if err := a.authorize(ctx, clockAttemptResource, clockAttemptAction); err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &repository.ClockAttemptFilter{}
+	if filter.EmployeeID, err = parseOptionalUUID("employee_id", req.EmployeeId); err != nil {
+		return nil, err
+	}
+	if filter.LocationID, err = parseOptionalUUID("location_id", req.LocationId); err != nil {
+		return nil, err
+	}
+	if req.Reason != "" {
+		reason := model.ClockRejectReason(req.Reason)
+		filter.Reason = &reason
+	}
+	if req.Status != "" {
+		status := model.ClockAttemptStatus(req.Status)
+		filter.Status = &status
+	}
+	if req.StartDate != "" {
+		start, err := parseDate("start_date", req.StartDate)
+		if err != nil {
+			return nil, err
+		}
+		filter.StartDate = &start
+	}
+	if req.EndDate != "" {
+		end, err := parseDate("end_date", req.EndDate)
+		if err != nil {
+			return nil, err
+		}
+		end = end.AddDate(0, 0, 1)
+		filter.EndDate = &end
+	}
+
+	page, pageSize := int(req.Page), int(req.PageSize)
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 200 {
+		pageSize = 50
+	}
+
+	attempts, total, err := a.clockLocations.ListAttempts(ctx, tenantID, filter, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrmv1.ClockAttemptResponse, 0, len(attempts))
+	for _, attempt := range attempts {
+		items = append(items, toClockAttemptResponse(attempt))
+	}
+	return &hrmv1.ListClockAttemptsResponse{Items: items, Total: int32(total)}, nil
+}
+
+// GetClockAttempt 获取被拒打卡详情
+func (a *HRMAdapter) GetClockAttempt(ctx context.Context, req *hrmv1.GetClockAttemptRequest) (*hrmv1.ClockAttemptResponse, error) {
+	if err := a.authorize(ctx, clockAttemptResource, clockAttemptAction); err != nil {
+		return nil, err
+	}
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	attempt, err := a.clockLocations.GetAttempt(ctx, tenantID, id)
+	if err != nil {
+		return nil, clockLocationError(err)
+	}
+	return toClockAttemptResponse(attempt), nil
+}
+
+// AcceptClockAttempt HR 认定被拒打卡有效，按原打卡时间补记考勤
+func (a *HRMAdapter) AcceptClockAttempt(ctx context.Context, req *hrmv1.ReviewClockAttemptRequest) (*hrmv1.ClockAttemptResponse, error) {
+	return a.reviewClockAttempt(ctx, req, a.clockLocations.AcceptAttempt)
+}
+
+// DismissClockAttempt HR 认定被拒打卡无效
+func (a *HRMAdapter) DismissClockAttempt(ctx context.Context, req *hrmv1.ReviewClockAttemptRequest) (*hrmv1.ClockAttemptResponse, error) {
+	return a.reviewClockAttempt(ctx, req, a.clockLocations.DismissAttempt)
+}
+
+func (a *HRMAdapter) reviewClockAttempt(
+	ctx context.Context,
+	req *hrmv1.ReviewClockAttemptRequest,
+	review func(ctx context.Context, tenantID, id, operatorID uuid.UUID, comment string) (*model.ClockAttempt, error),
+) (*hrmv1.ClockAttemptResponse, error) {
+	if err := a.authorize(ctx, clockAttemptResource, clockAttemptAction); err != nil {
+		return nil, err
+	}
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	attempt, err := review(ctx, tenantID, id, userID, req.Comment)
+	if err != nil {
+		return nil, clockLocationError(err)
+	}
+	return toClockAttemptResponse(attempt), nil
+}
+
+// applyClockLocationRequest 将请求字段写入打卡地点
+func applyClockLocationRequest(location *model.ClockLocation, req *hrmv1.ClockLocationRequest, userID uuid.UUID) {
+	location.Name = req.Name
+	location.Address = req.Address
+	location.Polygon = nil
+	for _, point := range req.Polygon {
+		location.Polygon = append(location.Polygon, model.GeoPoint{Latitude: point.Latitude, Longitude: point.Longitude})
+	}
+	location.Latitude = req.Latitude
+	location.Longitude = req.Longitude
+	location.Radius = int(req.Radius)
+	location.TimeWindows = nil
+	for _, window := range req.TimeWindows {
+		weekdays := make([]int, 0, len(window.Weekdays))
+		for _, day := range window.Weekdays {
+			weekdays = append(weekdays, int(day))
+		}
+		location.TimeWindows = append(location.TimeWindows, model.ClockTimeWindow{
+			Weekdays: weekdays,
+			Start:    window.Start,
+			End:      window.End,
+		})
+	}
+	location.QRRequired = req.QrRequired
+	if req.IsActive != nil {
+		location.IsActive = *req.IsActive
+	}
+	location.UpdatedBy = userID
+}
+
+// clockLocationError 打卡地点、扫码打卡业务错误转换为 HTTP 错误；
+// 打卡被拒时 metadata.reason 为拒绝原因
+func clockLocationError(err error) error {
+	var rejection *hrmService.ClockRejectedError
+	switch {
+	case errors.As(err, &rejection):
+		return errors.Forbidden("CLOCK_IN_REJECTED", rejection.Detail).
+			WithMetadata(map[string]string{"reason": string(rejection.Reason)})
+	case errors.Is(err, hrmService.ErrClockLocationNotFound),
+		errors.Is(err, hrmService.ErrClockAttemptNotFound):
+		return errors.NotFound("NOT_FOUND", err.Error())
+	case errors.Is(err, hrmService.ErrClockKioskKeyInvalid):
+		return errors.Unauthorized("UNAUTHORIZED", err.Error())
+	case errors.Is(err, hrmService.ErrClockLocationCodeExists):
+		return errors.Conflict("ALREADY_EXISTS", err.Error())
+	case errors.Is(err, hrmService.ErrClockAttemptReviewed):
+		return errors.Conflict("FAILED_PRECONDITION", err.Error())
+	case errors.Is(err, hrmService.ErrClockQRDisabled):
+		return errors.ServiceUnavailable("UNAVAILABLE", err.Error())
+	case errors.Is(err, hrmService.ErrInvalidClockLocation):
+		return errors.BadRequest("INVALID_ARGUMENT", err.Error())
+	}
+	return err
+}
+
+// ========== 辅助转换函数 ==========
+
+func toClockLocationResponse(location *model.ClockLocation) *hrmv1.ClockLocationResponse {
+	resp := &hrmv1.ClockLocationResponse{
+		Id:                location.ID.String(),
+		TenantId:          location.TenantID.String(),
+		Code:              location.Code,
+		Name:              location.Name,
+		Address:           location.Address,
+		Polygon:           make([]*hrmv1.GeoPoint, 0, len(location.Polygon)),
+		Latitude:          location.Latitude,
+		Longitude:         location.Longitude,
+		Radius:            int32(location.Radius),
+		TimeWindows:       make([]*hrmv1.ClockTimeWindow, 0, len(location.TimeWindows)),
+		QrRequired:        location.QRRequired,
+		KioskKeyRotatedAt: optionalTimestamp(location.KioskKeyRotatedAt),
+		IsActive:          location.IsActive,
+		CreatedBy:         location.CreatedBy.String(),
+		UpdatedBy:         location.UpdatedBy.String(),
+		CreatedAt:         timestamppb.New(location.CreatedAt),
+		UpdatedAt:         timestamppb.New(location.UpdatedAt),
+	}
+	for _, point := range location.Polygon {
+		resp.Polygon = append(resp.Polygon, &hrmv1.GeoPoint{Latitude: point.Latitude, Longitude: point.Longitude})
+	}
+	for _, window := range location.TimeWindows {
+		weekdays := make([]int32, 0, len(window.Weekdays))
+		for _, day := range window.Weekdays {
+			weekdays = append(weekdays, int32(day))
+		}
+		resp.TimeWindows = append(resp.TimeWindows, &hrmv1.ClockTimeWindow{
+			Weekdays: weekdays,
+			Start:    window.Start,
+			End:      window.End,
+		})
+	}
+	return resp
+}
+
+func toClockAttemptResponse(attempt *model.ClockAttempt) *hrmv1.ClockAttemptResponse {
+	resp := &hrmv1.ClockAttemptResponse{
+		Id:            attempt.ID.String(),
+		TenantId:      attempt.TenantID.String(),
+		EmployeeId:    attempt.EmployeeID.String(),
+		EmployeeName:  attempt.EmployeeName,
+		ClockTime:     timestamppb.New(attempt.ClockTime),
+		ClockType:     string(attempt.ClockType),
+		CheckInMethod: string(attempt.CheckInMethod),
+		SourceType:    string(attempt.SourceType),
+		LocationId:    optionalUUIDString(attempt.LocationID),
+		Address:       attempt.Address,
+		WifiSsid:      attempt.WiFiSSID,
+		WifiMac:       attempt.WiFiMAC,
+		FaceScore:     attempt.FaceScore,
+		Reason:        string(attempt.Reason),
+		Detail:        attempt.Detail,
+		Status:        string(attempt.Status),
+		ReviewedBy:    optionalUUIDString(attempt.ReviewedBy),
+		ReviewedAt:    optionalTimestamp(attempt.ReviewedAt),
+		ReviewNote:    attempt.ReviewNote,
+		RecordId:      optionalUUIDString(attempt.RecordID),
+		CreatedAt:     timestamppb.New(attempt.CreatedAt),
+		UpdatedAt:     timestamppb.New(attempt.UpdatedAt),
+	}
+	if attempt.Location != nil {
+		resp.Location = &hrmv1.LocationInfo{
+			Latitude:  attempt.Location.Latitude,
+			Longitude: attempt.Location.Longitude,
+			Accuracy:  attempt.Location.Accuracy,
+		}
+	}
+	return resp
+}
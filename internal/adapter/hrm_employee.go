@@ -0,0 +1,374 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	hrmv1 "github.com/lk2023060901/go-next-erp/api/hrm/v1"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	hrmService "github.com/lk2023060901/go-next-erp/internal/hrm/service"
+	"github.com/lk2023060901/go-next-erp/pkg/fieldcrypt"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ========== HRMEmployeeService 实现 ==========
+
+// GetEmployeeProfile 获取员工HRM档案（身份证号、生物特征按权限脱敏）
+func (a *HRMAdapter) GetEmployeeProfile(ctx context.Context, req *hrmv1.GetEmployeeProfileRequest) (*hrmv1.EmployeeProfileResponse, error) {
+	employeeID, err := parseUUID("employee_id", req.EmployeeId)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// FindByEmployeeID 只返回考勤相关字段，完整档案按主键读取
+	found, err := a.hrmEmpRepo.FindByEmployeeID(ctx, tenantID, employeeID)
+	if err != nil {
+		return nil, errors.NotFound("NOT_FOUND", "hrm employee not found")
+	}
+	emp, err := a.hrmEmpRepo.FindByID(ctx, found.ID)
+	if err != nil {
+		return nil, errors.NotFound("NOT_FOUND", "hrm employee not found")
+	}
+
+	if !a.canViewSensitive(ctx) {
+		emp.IDCardNo = fieldcrypt.Mask(emp.IDCardNo, 3, 4)
+		if emp.FaceData != "" {
+			emp.FaceData = maskedSecret
+		}
+		if emp.Fingerprint != "" {
+			emp.Fingerprint = maskedSecret
+		}
+	}
+	return toEmployeeProfileResponse(emp), nil
+}
+
+// ========== EmployeeLifecycleService 实现 ==========
+
+// SubmitLifecycleRequest 提交入职/转正/调岗/离职申请，审批通过后在生效日自动执行
+func (a *HRMAdapter) SubmitLifecycleRequest(ctx context.Context, req *hrmv1.SubmitLifecycleRequestRequest) (*hrmv1.LifecycleRequestResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.authorize(ctx, lifecycleResource, lifecycleSubmitAction); err != nil {
+		return nil, err
+	}
+	effectiveDate, err := parseDate("effective_date", req.EffectiveDate)
+	if err != nil {
+		return nil, err
+	}
+
+	submit := &hrmService.SubmitLifecycleRequest{
+		TenantID:      tenantID,
+		Action:        model.LifecycleAction(req.Action),
+		EffectiveDate: effectiveDate,
+		Reason:        req.Reason,
+		RequestedBy:   userID,
+	}
+	if submit.EmployeeID, err = parseOptionalUUID("employee_id", req.EmployeeId); err != nil {
+		return nil, err
+	}
+	if submit.Hire, err = toLifecycleHireInfo(req.Hire); err != nil {
+		return nil, err
+	}
+	if submit.Transfer, err = toLifecycleTransferInfo(req.Transfer); err != nil {
+		return nil, err
+	}
+	if req.Separation != nil {
+		submit.Separation = &model.LifecycleSeparationInfo{}
+		if submit.Separation.HandoverEmployeeID, err = parseOptionalUUID("handover_employee_id", req.Separation.HandoverEmployeeId); err != nil {
+			return nil, err
+		}
+	}
+
+	request, err := a.lifecycle.Submit(ctx, submit)
+	if err != nil {
+		return nil, employeeLifecycleError(err)
+	}
+	return toLifecycleRequestResponse(request), nil
+}
+
+// ListLifecycleRequests 生命周期申请列表
+func (a *HRMAdapter) ListLifecycleRequests(ctx context.Context, req *hrmv1.ListLifecycleRequestsRequest) (*hrmv1.ListLifecycleRequestsResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &repository.EmployeeLifecycleFilter{}
+	if filter.EmployeeID, err = parseOptionalUUID("employee_id", req.EmployeeId); err != nil {
+		return nil, err
+	}
+	if req.Action != "" {
+		action := model.LifecycleAction(req.Action)
+		filter.Action = &action
+	}
+	if req.Status != "" {
+		status := model.LifecycleRequestStatus(req.Status)
+		filter.Status = &status
+	}
+
+	page, pageSize := int(req.Page), int(req.PageSize)
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 200 {
+		pageSize = 50
+	}
+
+	requests, total, err := a.lifecycle.List(ctx, tenantID, filter, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrmv1.LifecycleRequestResponse, 0, len(requests))
+	for _, request := range requests {
+		items = append(items, toLifecycleRequestResponse(request))
+	}
+	return &hrmv1.ListLifecycleRequestsResponse{Items: items, Total: int32(total)}, nil
+}
+
+// GetLifecycleRequest 获取生命周期申请（同步审批状态）
+func (a *HRMAdapter) GetLifecycleRequest(ctx context.Context, req *hrmv1.GetLifecycleRequestRequest) (*hrmv1.LifecycleRequestResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := a.lifecycle.Get(ctx, tenantID, id)
+	if err != nil {
+		return nil, employeeLifecycleError(err)
+	}
+	return toLifecycleRequestResponse(request), nil
+}
+
+// CancelLifecycleRequest 撤回尚未执行的生命周期申请
+func (a *HRMAdapter) CancelLifecycleRequest(ctx context.Context, req *hrmv1.CancelLifecycleRequestRequest) (*hrmv1.LifecycleRequestResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := a.lifecycle.Cancel(ctx, tenantID, id, userID)
+	if err != nil {
+		return nil, employeeLifecycleError(err)
+	}
+	return toLifecycleRequestResponse(request), nil
+}
+
+// ListLifecycleEvents 员工的生命周期事件（入职、转正、调岗、离职及试用期提醒）
+func (a *HRMAdapter) ListLifecycleEvents(ctx context.Context, req *hrmv1.ListLifecycleEventsRequest) (*hrmv1.ListLifecycleEventsResponse, error) {
+	employeeID, err := parseUUID("employee_id", req.EmployeeId)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := a.lifecycle.ListEvents(ctx, tenantID, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrmv1.LifecycleEventInfo, 0, len(events))
+	for _, event := range events {
+		items = append(items, toLifecycleEventInfo(event))
+	}
+	return &hrmv1.ListLifecycleEventsResponse{Items: items}, nil
+}
+
+func toLifecycleHireInfo(hire *hrmv1.LifecycleHireInfo) (*model.LifecycleHireInfo, error) {
+	if hire == nil {
+		return nil, nil
+	}
+	userID, err := parseUUID("hire.user_id", hire.UserId)
+	if err != nil {
+		return nil, err
+	}
+	orgID, err := parseUUID("hire.org_id", hire.OrgId)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &model.LifecycleHireInfo{
+		UserID:          userID,
+		EmployeeNo:      hire.EmployeeNo,
+		Name:            hire.Name,
+		Gender:          hire.Gender,
+		Mobile:          hire.Mobile,
+		Email:           hire.Email,
+		OrgID:           orgID,
+		ProbationMonths: int(hire.ProbationMonths),
+	}
+	if info.PositionID, err = parseOptionalUUID("hire.position_id", hire.PositionId); err != nil {
+		return nil, err
+	}
+	if info.DirectLeaderID, err = parseOptionalUUID("hire.direct_leader_id", hire.DirectLeaderId); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+func toLifecycleTransferInfo(transfer *hrmv1.LifecycleTransferInfo) (*model.LifecycleTransferInfo, error) {
+	if transfer == nil {
+		return nil, nil
+	}
+
+	// 调出组织/职位由服务端按员工当前任职回填，请求中的 from_* 忽略
+	info := &model.LifecycleTransferInfo{}
+	var err error
+	if info.ToOrgID, err = parseOptionalUUID("transfer.to_org_id", transfer.ToOrgId); err != nil {
+		return nil, err
+	}
+	if info.ToPositionID, err = parseOptionalUUID("transfer.to_position_id", transfer.ToPositionId); err != nil {
+		return nil, err
+	}
+	if info.ToLeaderID, err = parseOptionalUUID("transfer.to_leader_id", transfer.ToLeaderId); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// employeeLifecycleError 员工生命周期业务错误转换为 HTTP 错误
+func employeeLifecycleError(err error) error {
+	switch {
+	case errors.Is(err, hrmService.ErrLifecycleRequestNotFound),
+		errors.Is(err, hrmService.ErrLifecycleEmployeeNotFound):
+		return errors.NotFound("NOT_FOUND", err.Error())
+	case errors.Is(err, hrmService.ErrLifecycleRequestConflict):
+		return errors.Conflict("ALREADY_EXISTS", err.Error())
+	case errors.Is(err, hrmService.ErrLifecycleRequestNotOpen),
+		errors.Is(err, hrmService.ErrLifecycleEmployeeState),
+		errors.Is(err, hrmService.ErrLifecycleProcessNotDefined):
+		return errors.Conflict("FAILED_PRECONDITION", err.Error())
+	case errors.Is(err, hrmService.ErrLifecycleRequestInvalid),
+		errors.Is(err, hrmService.ErrLifecycleHandoverNotAllowed):
+		return errors.BadRequest("INVALID_ARGUMENT", err.Error())
+	}
+	return err
+}
+
+// ========== 辅助转换函数 ==========
+
+func toEmployeeProfileResponse(emp *model.HRMEmployee) *hrmv1.EmployeeProfileResponse {
+	return &hrmv1.EmployeeProfileResponse{
+		Id:                emp.ID.String(),
+		TenantId:          emp.TenantID.String(),
+		EmployeeId:        emp.EmployeeID.String(),
+		IdCardNo:          emp.IDCardNo,
+		CardNo:            emp.CardNo,
+		FaceData:          emp.FaceData,
+		Fingerprint:       emp.Fingerprint,
+		DingtalkUserId:    emp.DingTalkUserID,
+		WecomUserId:       emp.WeComUserID,
+		FeishuUserId:      emp.FeishuUserID,
+		FeishuOpenId:      emp.FeishuOpenID,
+		WorkLocation:      emp.WorkLocation,
+		WorkScheduleType:  emp.WorkScheduleType,
+		AttendanceRuleId:  optionalUUIDString(emp.AttendanceRuleID),
+		DefaultShiftId:    optionalUUIDString(emp.DefaultShiftID),
+		AllowFieldWork:    emp.AllowFieldWork,
+		RequireFace:       emp.RequireFace,
+		RequireLocation:   emp.RequireLocation,
+		RequireWifi:       emp.RequireWiFi,
+		EmergencyContact:  emp.EmergencyContact,
+		EmergencyPhone:    emp.EmergencyPhone,
+		EmergencyRelation: emp.EmergencyRelation,
+		IsActive:          emp.IsActive,
+		Remark:            emp.Remark,
+		CreatedAt:         timestamppb.New(emp.CreatedAt),
+		UpdatedAt:         timestamppb.New(emp.UpdatedAt),
+	}
+}
+
+func toLifecycleRequestResponse(request *model.EmployeeLifecycleRequest) *hrmv1.LifecycleRequestResponse {
+	resp := &hrmv1.LifecycleRequestResponse{
+		Id:                 request.ID.String(),
+		TenantId:           request.TenantID.String(),
+		EmployeeId:         optionalUUIDString(request.EmployeeID),
+		EmployeeName:       request.EmployeeName,
+		Action:             string(request.Action),
+		Status:             string(request.Status),
+		EffectiveDate:      request.EffectiveDate.Format("2006-01-02"),
+		Reason:             request.Reason,
+		ApprovalInstanceId: optionalUUIDString(request.ApprovalInstanceID),
+		RequestedBy:        request.RequestedBy.String(),
+		DecidedAt:          optionalTimestamp(request.DecidedAt),
+		CompletedAt:        optionalTimestamp(request.CompletedAt),
+		FailureReason:      request.FailureReason,
+		CreatedAt:          timestamppb.New(request.CreatedAt),
+		UpdatedAt:          timestamppb.New(request.UpdatedAt),
+	}
+	if hire := request.Hire; hire != nil {
+		resp.Hire = &hrmv1.LifecycleHireInfo{
+			UserId:          hire.UserID.String(),
+			EmployeeNo:      hire.EmployeeNo,
+			Name:            hire.Name,
+			Gender:          hire.Gender,
+			Mobile:          hire.Mobile,
+			Email:           hire.Email,
+			OrgId:           hire.OrgID.String(),
+			PositionId:      optionalUUIDString(hire.PositionID),
+			DirectLeaderId:  optionalUUIDString(hire.DirectLeaderID),
+			ProbationMonths: int32(hire.ProbationMonths),
+		}
+	}
+	if transfer := request.Transfer; transfer != nil {
+		resp.Transfer = &hrmv1.LifecycleTransferInfo{
+			FromOrgId:      transfer.FromOrgID.String(),
+			FromPositionId: optionalUUIDString(transfer.FromPositionID),
+			ToOrgId:        optionalUUIDString(transfer.ToOrgID),
+			ToPositionId:   optionalUUIDString(transfer.ToPositionID),
+			ToLeaderId:     optionalUUIDString(transfer.ToLeaderID),
+		}
+	}
+	if request.Separation != nil {
+		resp.Separation = &hrmv1.LifecycleSeparationInfo{
+			HandoverEmployeeId: optionalUUIDString(request.Separation.HandoverEmployeeID),
+		}
+	}
+	return resp
+}
+
+func toLifecycleEventInfo(event *model.EmployeeLifecycleEvent) *hrmv1.LifecycleEventInfo {
+	info := &hrmv1.LifecycleEventInfo{
+		Id:         event.ID.String(),
+		TenantId:   event.TenantID.String(),
+		EmployeeId: optionalUUIDString(event.EmployeeID),
+		RequestId:  optionalUUIDString(event.RequestID),
+		Type:       string(event.Type),
+		Action:     string(event.Action),
+		OccurredAt: timestamppb.New(event.OccurredAt),
+	}
+	if len(event.Payload) > 0 {
+		info.Payload = make(map[string]string, len(event.Payload))
+		for key, value := range event.Payload {
+			info.Payload[key] = fmt.Sprint(value)
+		}
+	}
+	return info
+}
@@ -0,0 +1,127 @@
+package adapter
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	hrmv1 "github.com/lk2023060901/go-next-erp/api/hrm/v1"
+)
+
+// HRMPublicOperations 无需 JWT 认证的 HRM 接口（考勤屏凭密钥拉取二维码）
+var HRMPublicOperations = []string{
+	hrmv1.OperationClockLocationServiceGetClockKioskQR,
+}
+
+const (
+	// sensitiveDataResource 查看身份证号、生物特征、设备凭据明文所需的权限资源
+	sensitiveDataResource = "hrm_sensitive_data"
+	sensitiveDataAction   = "view"
+
+	// maskedSecret 凭据、生物特征等不保留任何字符的脱敏占位
+	maskedSecret = "******"
+
+	// clockAttemptResource 查看和复核被拒打卡所需的权限资源
+	clockAttemptResource = "hrm_clock_attempt"
+	clockAttemptAction   = "review"
+
+	// payrollResource 导出和查看薪资输入所需的权限资源
+	payrollResource     = "hrm_payroll"
+	payrollExportAction = "export"
+	payrollViewAction   = "view"
+
+	// deviceResource 向考勤设备下发远程命令（清空记录、重启等）所需的权限资源
+	deviceResource      = "hrm_attendance_device"
+	deviceCommandAction = "command"
+
+	// attendancePeriodResource 锁定和解锁考勤月份所需的权限资源
+	attendancePeriodResource     = "hrm_attendance_period"
+	attendancePeriodLockAction   = "lock"
+	attendancePeriodUnlockAction = "unlock"
+
+	// leaveQuotaResource 人工调整额度和执行年末结转所需的权限资源
+	leaveQuotaResource     = "hrm_leave_quota"
+	leaveQuotaAdjustAction = "adjust"
+
+	// lifecycleResource 提交入职、转正、调岗、离职申请所需的权限资源
+	lifecycleResource     = "hrm_employee_lifecycle"
+	lifecycleSubmitAction = "submit"
+)
+
+// ErrNoLinkedEmployee 当前用户未关联员工，不能使用员工自助接口
+var ErrNoLinkedEmployee = errors.Forbidden("NO_LINKED_EMPLOYEE", "当前用户未关联员工")
+
+// authorize 校验当前用户在租户内拥有指定权限（未配置鉴权时一律拒绝）
+func (a *HRMAdapter) authorize(ctx context.Context, resource, action string) error {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if a.authzService == nil {
+		return errors.Forbidden("PERMISSION_DENIED", "permission denied")
+	}
+
+	allowed, err := a.authzService.CheckPermission(ctx, userID, tenantID, resource, action, nil)
+	if err != nil || !allowed {
+		return errors.Forbidden("PERMISSION_DENIED", "permission denied")
+	}
+	return nil
+}
+
+// employeeFromContext 当前登录用户对应的员工，员工自助接口只能以本人身份操作
+func (a *HRMAdapter) employeeFromContext(ctx context.Context) (uuid.UUID, uuid.UUID, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	employeeID, err := a.hrmEmpRepo.FindEmployeeIDByUser(ctx, tenantID, userID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, ErrNoLinkedEmployee
+	}
+	return tenantID, employeeID, nil
+}
+
+// canViewSensitive 当前用户是否有权查看敏感字段明文（未配置鉴权或鉴权失败时一律脱敏）
+func (a *HRMAdapter) canViewSensitive(ctx context.Context) bool {
+	if a.authzService == nil {
+		return false
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return false
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return false
+	}
+
+	allowed, err := a.authzService.CheckPermission(ctx, userID, tenantID, sensitiveDataResource, sensitiveDataAction, nil)
+	return err == nil && allowed
+}
+
+// validateYearMonth 校验路径中的年月
+func validateYearMonth(year, month int) error {
+	if year < 2000 || month < 1 || month > 12 {
+		return errors.BadRequest("INVALID_ARGUMENT", "invalid year or month")
+	}
+	return nil
+}
+
+// parseDate 解析请求中的日期参数（YYYY-MM-DD，按服务器本地时区）
+func parseDate(field, value string) (time.Time, error) {
+	date, err := time.ParseInLocation("2006-01-02", value, time.Local)
+	if err != nil {
+		return time.Time{}, errors.BadRequest("INVALID_ARGUMENT", "invalid "+field)
+	}
+	return date, nil
+}
@@ -0,0 +1,380 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	hrmv1 "github.com/lk2023060901/go-next-erp/api/hrm/v1"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	hrmService "github.com/lk2023060901/go-next-erp/internal/hrm/service"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ========== HolidayCalendarService 实现 ==========
+
+// CreateHolidayCalendar 创建假期日历
+func (a *HRMAdapter) CreateHolidayCalendar(ctx context.Context, req *hrmv1.HolidayCalendarRequest) (*hrmv1.HolidayCalendarResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Code == "" || req.Name == "" {
+		return nil, errors.BadRequest("INVALID_ARGUMENT", "code and name are required")
+	}
+
+	calendar := &model.HolidayCalendar{
+		TenantID:    tenantID,
+		Code:        req.Code,
+		Name:        req.Name,
+		Description: req.Description,
+		Region:      req.Region,
+		IsDefault:   req.IsDefault,
+		IsActive:    req.IsActive == nil || *req.IsActive,
+		CreatedBy:   userID,
+		UpdatedBy:   userID,
+	}
+	if err := a.calendarService.CreateCalendar(ctx, calendar); err != nil {
+		return nil, err
+	}
+
+	return toHolidayCalendarResponse(calendar), nil
+}
+
+// UpdateHolidayCalendar 更新假期日历（编码不可修改）
+func (a *HRMAdapter) UpdateHolidayCalendar(ctx context.Context, req *hrmv1.HolidayCalendarRequest) (*hrmv1.HolidayCalendarResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar, err := a.calendarService.GetCalendar(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if req.Name != "" {
+		calendar.Name = req.Name
+	}
+	calendar.Description = req.Description
+	calendar.Region = req.Region
+	calendar.IsDefault = req.IsDefault
+	if req.IsActive != nil {
+		calendar.IsActive = *req.IsActive
+	}
+	calendar.UpdatedBy = userID
+
+	if err := a.calendarService.UpdateCalendar(ctx, calendar); err != nil {
+		return nil, err
+	}
+
+	return toHolidayCalendarResponse(calendar), nil
+}
+
+// DeleteHolidayCalendar 删除假期日历
+func (a *HRMAdapter) DeleteHolidayCalendar(ctx context.Context, req *hrmv1.DeleteHolidayCalendarRequest) (*emptypb.Empty, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.calendarService.DeleteCalendar(ctx, tenantID, id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetHolidayCalendar 获取假期日历
+func (a *HRMAdapter) GetHolidayCalendar(ctx context.Context, req *hrmv1.GetHolidayCalendarRequest) (*hrmv1.HolidayCalendarResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar, err := a.calendarService.GetCalendar(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	return toHolidayCalendarResponse(calendar), nil
+}
+
+// ListHolidayCalendars 租户假期日历列表
+func (a *HRMAdapter) ListHolidayCalendars(ctx context.Context, _ *hrmv1.ListHolidayCalendarsRequest) (*hrmv1.ListHolidayCalendarsResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	calendars, err := a.calendarService.ListCalendars(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrmv1.HolidayCalendarResponse, 0, len(calendars))
+	for _, calendar := range calendars {
+		items = append(items, toHolidayCalendarResponse(calendar))
+	}
+	return &hrmv1.ListHolidayCalendarsResponse{Items: items}, nil
+}
+
+// SetCalendarDays 批量设置放假/调休上班日
+func (a *HRMAdapter) SetCalendarDays(ctx context.Context, req *hrmv1.SetCalendarDaysRequest) (*emptypb.Empty, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	days := make([]*model.HolidayCalendarDay, 0, len(req.Days))
+	for _, item := range req.Days {
+		date, err := parseDate("date", item.Date)
+		if err != nil {
+			return nil, err
+		}
+		days = append(days, &model.HolidayCalendarDay{
+			Date:        date,
+			Kind:        model.CalendarDayKind(item.Kind),
+			Name:        item.Name,
+			IsStatutory: item.IsStatutory,
+			Remark:      item.Remark,
+		})
+	}
+
+	if err := a.calendarService.SetDays(ctx, tenantID, id, days); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteCalendarDay 删除某个特殊日
+func (a *HRMAdapter) DeleteCalendarDay(ctx context.Context, req *hrmv1.DeleteCalendarDayRequest) (*emptypb.Empty, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	date, err := parseDate("date", req.Date)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.calendarService.DeleteDay(ctx, tenantID, id, date); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListCalendarDays 查询日历某年的特殊日
+func (a *HRMAdapter) ListCalendarDays(ctx context.Context, req *hrmv1.CalendarYearRequest) (*hrmv1.ListCalendarDaysResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	year := int(req.Year)
+	if year == 0 {
+		year = time.Now().Year()
+	}
+
+	days, err := a.calendarService.ListDays(ctx, tenantID, id, year)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrmv1.CalendarDay, 0, len(days))
+	for _, day := range days {
+		items = append(items, &hrmv1.CalendarDay{
+			Id:          day.ID.String(),
+			CalendarId:  day.CalendarID.String(),
+			Date:        day.Date.Format("2006-01-02"),
+			Kind:        string(day.Kind),
+			Name:        day.Name,
+			IsStatutory: day.IsStatutory,
+			Remark:      day.Remark,
+		})
+	}
+	return &hrmv1.ListCalendarDaysResponse{Items: items}, nil
+}
+
+// ImportCalendarICS 导入 iCalendar 文件
+func (a *HRMAdapter) ImportCalendarICS(ctx context.Context, req *hrmv1.ImportCalendarICSRequest) (*hrmv1.CalendarImportResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := a.calendarService.ImportICS(ctx, tenantID, id, strings.NewReader(req.Content), &hrmService.ICSImportOptions{
+		Year:    int(req.Year),
+		Replace: req.Replace,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toCalendarImportResponse(result), nil
+}
+
+// ExportCalendarICS 导出日历某年的特殊日为 iCalendar 文件
+func (a *HRMAdapter) ExportCalendarICS(ctx context.Context, req *hrmv1.CalendarYearRequest) (*hrmv1.ExportCalendarICSResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	year := int(req.Year)
+	if year == 0 {
+		year = time.Now().Year()
+	}
+
+	calendar, err := a.calendarService.GetCalendar(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	content, err := a.calendarService.ExportICS(ctx, tenantID, id, year)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hrmv1.ExportCalendarICSResponse{
+		FileName: fmt.Sprintf("%s-%d.ics", calendar.Code, year),
+		Content:  string(content),
+	}, nil
+}
+
+// ListHolidayPresets 内置法定节假日预设列表
+func (a *HRMAdapter) ListHolidayPresets(ctx context.Context, _ *hrmv1.ListHolidayPresetsRequest) (*hrmv1.ListHolidayPresetsResponse, error) {
+	return &hrmv1.ListHolidayPresetsResponse{Items: a.calendarService.ListPresets()}, nil
+}
+
+// ImportHolidayPreset 导入内置法定节假日预设
+func (a *HRMAdapter) ImportHolidayPreset(ctx context.Context, req *hrmv1.ImportHolidayPresetRequest) (*hrmv1.CalendarImportResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := a.calendarService.ImportPreset(ctx, tenantID, id, req.Code, req.Replace)
+	if err != nil {
+		return nil, err
+	}
+	return toCalendarImportResponse(result), nil
+}
+
+// ResolveDayTypes 查询员工区间内每天的日期类型（工作日/休息日/节假日）
+func (a *HRMAdapter) ResolveDayTypes(ctx context.Context, req *hrmv1.ResolveDayTypesRequest) (*hrmv1.ResolveDayTypesResponse, error) {
+	employeeID, err := parseUUID("employee_id", req.EmployeeId)
+	if err != nil {
+		return nil, err
+	}
+	start, err := parseDate("start", req.Start)
+	if err != nil {
+		return nil, err
+	}
+	end, err := parseDate("end", req.End)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	days, err := a.dayResolver.ResolveRange(ctx, tenantID, employeeID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrmv1.DayInfo, 0, len(days))
+	for _, day := range days {
+		items = append(items, toDayInfo(day))
+	}
+	return &hrmv1.ResolveDayTypesResponse{Items: items}, nil
+}
+
+// ========== 辅助转换函数 ==========
+
+func toHolidayCalendarResponse(calendar *model.HolidayCalendar) *hrmv1.HolidayCalendarResponse {
+	return &hrmv1.HolidayCalendarResponse{
+		Id:          calendar.ID.String(),
+		TenantId:    calendar.TenantID.String(),
+		Code:        calendar.Code,
+		Name:        calendar.Name,
+		Description: calendar.Description,
+		Region:      calendar.Region,
+		IsDefault:   calendar.IsDefault,
+		IsActive:    calendar.IsActive,
+		CreatedBy:   calendar.CreatedBy.String(),
+		UpdatedBy:   calendar.UpdatedBy.String(),
+		CreatedAt:   timestamppb.New(calendar.CreatedAt),
+		UpdatedAt:   timestamppb.New(calendar.UpdatedAt),
+	}
+}
+
+func toCalendarImportResponse(result *hrmService.ICSImportResult) *hrmv1.CalendarImportResponse {
+	years := make([]int32, 0, len(result.Years))
+	for _, year := range result.Years {
+		years = append(years, int32(year))
+	}
+	return &hrmv1.CalendarImportResponse{
+		Holidays:       int32(result.Holidays),
+		MakeupWorkdays: int32(result.MakeupWorkdays),
+		Replaced:       result.Replaced,
+		Years:          years,
+	}
+}
+
+func toDayInfo(day *model.DayInfo) *hrmv1.DayInfo {
+	info := &hrmv1.DayInfo{
+		Date:        day.Date.Format("2006-01-02"),
+		Type:        string(day.Type),
+		Name:        day.Name,
+		IsStatutory: day.IsStatutory,
+		IsMakeup:    day.IsMakeup,
+		Source:      string(day.Source),
+	}
+	if day.CalendarID != nil {
+		info.CalendarId = day.CalendarID.String()
+	}
+	return info
+}
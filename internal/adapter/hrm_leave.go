@@ -0,0 +1,227 @@
+package adapter
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	hrmv1 "github.com/lk2023060901/go-next-erp/api/hrm/v1"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	hrmService "github.com/lk2023060901/go-next-erp/internal/hrm/service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ========== 请假时长实现 ==========
+
+// CalculateLeaveDuration 按排班和请假类型规则预览请假时长（含按天、按月拆分）
+func (a *HRMAdapter) CalculateLeaveDuration(ctx context.Context, req *hrmv1.CalculateLeaveDurationRequest) (*hrmv1.LeaveDurationResponse, error) {
+	employeeID, err := parseUUID("employee_id", req.EmployeeId)
+	if err != nil {
+		return nil, err
+	}
+	leaveTypeID, err := parseUUID("leave_type_id", req.LeaveTypeId)
+	if err != nil {
+		return nil, err
+	}
+	if req.StartTime == nil || req.EndTime == nil {
+		return nil, errors.BadRequest("INVALID_ARGUMENT", "start_time and end_time are required")
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := a.leaveService.CalculateLeaveDuration(ctx, tenantID, employeeID, leaveTypeID, req.StartTime.AsTime(), req.EndTime.AsTime())
+	if err != nil {
+		return nil, err
+	}
+	return toLeaveDurationResponse(result), nil
+}
+
+// GetLeaveDurationBreakdown 获取请假申请保存的按天拆分
+func (a *HRMAdapter) GetLeaveDurationBreakdown(ctx context.Context, req *hrmv1.GetLeaveDurationBreakdownRequest) (*hrmv1.LeaveDurationResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := a.leaveService.GetLeaveRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if request.TenantID != tenantID {
+		return nil, errors.NotFound("NOT_FOUND", "leave request not found")
+	}
+
+	result := &model.LeaveDurationResult{
+		Unit:     request.Unit,
+		Duration: request.Duration,
+		Days:     request.DayBreakdown,
+	}
+	for _, day := range request.DayBreakdown {
+		result.Hours += day.Hours
+	}
+	result.Months = hrmService.SplitLeaveByMonth(request.DayBreakdown)
+	return toLeaveDurationResponse(result), nil
+}
+
+// ========== 额度发放实现 ==========
+
+// ListLeaveQuotaLedger 查询员工某年度的额度流水
+func (a *HRMAdapter) ListLeaveQuotaLedger(ctx context.Context, req *hrmv1.EmployeeYearRequest) (*hrmv1.ListLeaveQuotaLedgerResponse, error) {
+	employeeID, err := parseUUID("employee_id", req.EmployeeId)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := a.accrualService.ListLedger(ctx, tenantID, employeeID, int(req.Year))
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrmv1.LeaveQuotaLedgerEntry, 0, len(entries))
+	for _, entry := range entries {
+		items = append(items, toLeaveQuotaLedgerEntry(entry))
+	}
+	return &hrmv1.ListLeaveQuotaLedgerResponse{Items: items}, nil
+}
+
+// AccrueEmployeeQuota 按发放规则立即补齐员工某年度的额度
+func (a *HRMAdapter) AccrueEmployeeQuota(ctx context.Context, req *hrmv1.EmployeeYearRequest) (*hrmv1.LeaveAccrualResultResponse, error) {
+	employeeID, err := parseUUID("employee_id", req.EmployeeId)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, err := a.accrualService.AccrueEmployee(ctx, tenantID, employeeID, int(req.Year))
+	if err != nil {
+		return nil, err
+	}
+	return &hrmv1.LeaveAccrualResultResponse{Changed: int32(changed)}, nil
+}
+
+// AdjustLeaveQuota 人工调整额度（必须填写原因，记入额度流水）
+func (a *HRMAdapter) AdjustLeaveQuota(ctx context.Context, req *hrmv1.AdjustLeaveQuotaRequest) (*hrmv1.LeaveQuotaLedgerEntry, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.authorize(ctx, leaveQuotaResource, leaveQuotaAdjustAction); err != nil {
+		return nil, err
+	}
+
+	entry, err := a.accrualService.Adjust(ctx, tenantID, id, req.Amount, strings.TrimSpace(req.Reason), userID)
+	switch {
+	case errors.Is(err, hrmService.ErrAdjustReasonRequired), errors.Is(err, hrmService.ErrAdjustAmountZero):
+		return nil, errors.BadRequest("INVALID_ARGUMENT", err.Error())
+	case errors.Is(err, hrmService.ErrLeaveQuotaNotFound):
+		return nil, errors.NotFound("NOT_FOUND", err.Error())
+	case err != nil:
+		return nil, err
+	}
+	return toLeaveQuotaLedgerEntry(entry), nil
+}
+
+// CarryOverLeaveQuotas 立即执行某年度的年末结转（可重复执行）
+func (a *HRMAdapter) CarryOverLeaveQuotas(ctx context.Context, req *hrmv1.CarryOverLeaveQuotasRequest) (*hrmv1.LeaveAccrualResultResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.authorize(ctx, leaveQuotaResource, leaveQuotaAdjustAction); err != nil {
+		return nil, err
+	}
+
+	changed, err := a.accrualService.CarryOver(ctx, tenantID, int(req.Year))
+	if err != nil {
+		return nil, err
+	}
+	return &hrmv1.LeaveAccrualResultResponse{Changed: int32(changed)}, nil
+}
+
+// ========== 辅助转换函数 ==========
+
+func toLeaveDurationResponse(result *model.LeaveDurationResult) *hrmv1.LeaveDurationResponse {
+	resp := &hrmv1.LeaveDurationResponse{
+		Unit:     string(result.Unit),
+		Duration: result.Duration,
+		Hours:    result.Hours,
+		Days:     make([]*hrmv1.LeaveDayDuration, 0, len(result.Days)),
+		Months:   make([]*hrmv1.LeaveMonthDuration, 0, len(result.Months)),
+	}
+	for _, day := range result.Days {
+		item := &hrmv1.LeaveDayDuration{
+			Date:      day.Date.Format("2006-01-02"),
+			DayType:   string(day.DayType),
+			ShiftName: day.ShiftName,
+			Hours:     day.Hours,
+			Duration:  day.Duration,
+		}
+		if day.ShiftID != nil {
+			item.ShiftId = day.ShiftID.String()
+		}
+		if day.StartTime != nil {
+			item.StartTime = timestamppb.New(*day.StartTime)
+		}
+		if day.EndTime != nil {
+			item.EndTime = timestamppb.New(*day.EndTime)
+		}
+		resp.Days = append(resp.Days, item)
+	}
+	for _, month := range result.Months {
+		resp.Months = append(resp.Months, &hrmv1.LeaveMonthDuration{
+			Year:     int32(month.Year),
+			Month:    int32(month.Month),
+			Hours:    month.Hours,
+			Duration: month.Duration,
+		})
+	}
+	return resp
+}
+
+func toLeaveQuotaLedgerEntry(entry *model.LeaveQuotaLedgerEntry) *hrmv1.LeaveQuotaLedgerEntry {
+	resp := &hrmv1.LeaveQuotaLedgerEntry{
+		Id:           entry.ID.String(),
+		TenantId:     entry.TenantID.String(),
+		EmployeeId:   entry.EmployeeID.String(),
+		LeaveTypeId:  entry.LeaveTypeID.String(),
+		QuotaId:      entry.QuotaID.String(),
+		Year:         int32(entry.Year),
+		EntryType:    string(entry.EntryType),
+		Amount:       entry.Amount,
+		BalanceAfter: entry.BalanceAfter,
+		Reason:       entry.Reason,
+		SourceType:   entry.SourceType,
+		CreatedAt:    timestamppb.New(entry.CreatedAt),
+	}
+	if entry.ExpiresAt != nil {
+		resp.ExpiresAt = timestamppb.New(*entry.ExpiresAt)
+	}
+	if entry.SourceID != nil {
+		resp.SourceId = entry.SourceID.String()
+	}
+	if entry.OperatorID != nil {
+		resp.OperatorId = entry.OperatorID.String()
+	}
+	return resp
+}
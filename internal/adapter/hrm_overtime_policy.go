@@ -0,0 +1,295 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	hrmv1 "github.com/lk2023060901/go-next-erp/api/hrm/v1"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	hrmService "github.com/lk2023060901/go-next-erp/internal/hrm/service"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ========== OvertimePolicyService 实现 ==========
+
+// CreateOvertimePolicy 创建加班政策
+func (a *HRMAdapter) CreateOvertimePolicy(ctx context.Context, req *hrmv1.OvertimePolicyRequest) (*hrmv1.OvertimePolicyResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &model.OvertimePolicy{
+		TenantID:  tenantID,
+		Code:      req.Code,
+		IsActive:  true,
+		CreatedBy: userID,
+	}
+	applyOvertimePolicyRequest(policy, req, userID)
+
+	if err := a.policyService.CreatePolicy(ctx, policy); err != nil {
+		return nil, overtimePolicyError(err)
+	}
+	return toOvertimePolicyResponse(policy), nil
+}
+
+// UpdateOvertimePolicy 更新加班政策（编码不可修改，已生成的加班记录不重算）
+func (a *HRMAdapter) UpdateOvertimePolicy(ctx context.Context, req *hrmv1.OvertimePolicyRequest) (*hrmv1.OvertimePolicyResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := a.policyService.GetPolicy(ctx, tenantID, id)
+	if err != nil {
+		return nil, overtimePolicyError(err)
+	}
+	applyOvertimePolicyRequest(policy, req, userID)
+
+	if err := a.policyService.UpdatePolicy(ctx, policy); err != nil {
+		return nil, overtimePolicyError(err)
+	}
+	return toOvertimePolicyResponse(policy), nil
+}
+
+// DeleteOvertimePolicy 删除加班政策（关联的考勤规则改用租户默认政策）
+func (a *HRMAdapter) DeleteOvertimePolicy(ctx context.Context, req *hrmv1.DeleteOvertimePolicyRequest) (*emptypb.Empty, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.policyService.DeletePolicy(ctx, tenantID, id); err != nil {
+		return nil, overtimePolicyError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetOvertimePolicy 获取加班政策
+func (a *HRMAdapter) GetOvertimePolicy(ctx context.Context, req *hrmv1.GetOvertimePolicyRequest) (*hrmv1.OvertimePolicyResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := a.policyService.GetPolicy(ctx, tenantID, id)
+	if err != nil {
+		return nil, overtimePolicyError(err)
+	}
+	return toOvertimePolicyResponse(policy), nil
+}
+
+// ListOvertimePolicies 租户加班政策列表
+func (a *HRMAdapter) ListOvertimePolicies(ctx context.Context, _ *hrmv1.ListOvertimePoliciesRequest) (*hrmv1.ListOvertimePoliciesResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := a.policyService.ListPolicies(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrmv1.OvertimePolicyResponse, 0, len(policies))
+	for _, policy := range policies {
+		items = append(items, toOvertimePolicyResponse(policy))
+	}
+	return &hrmv1.ListOvertimePoliciesResponse{Items: items}, nil
+}
+
+// AssignOvertimePolicy 考勤规则关联加班政策，规则适用范围（全员/部门/员工）内按该政策计算加班
+func (a *HRMAdapter) AssignOvertimePolicy(ctx context.Context, req *hrmv1.AssignOvertimePolicyRequest) (*hrmv1.AttendanceRuleResponse, error) {
+	ruleID, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var policyID *uuid.UUID
+	if req.PolicyId != "" {
+		id, err := parseUUID("policy_id", req.PolicyId)
+		if err != nil {
+			return nil, err
+		}
+		policyID = &id
+	}
+
+	rule, err := a.policyService.AssignToRule(ctx, tenantID, ruleID, policyID, userID)
+	if err != nil {
+		return nil, overtimePolicyError(err)
+	}
+	return toAttendanceRuleResponse(rule), nil
+}
+
+// ResolveOvertimePolicy 查询员工当前适用的加班政策
+func (a *HRMAdapter) ResolveOvertimePolicy(ctx context.Context, req *hrmv1.ResolveOvertimePolicyRequest) (*hrmv1.OvertimePolicyResponse, error) {
+	employeeID, err := parseUUID("employee_id", req.EmployeeId)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toOvertimePolicyResponse(a.policyService.Resolve(ctx, tenantID, employeeID)), nil
+}
+
+func applyOvertimePolicyRequest(policy *model.OvertimePolicy, req *hrmv1.OvertimePolicyRequest, userID uuid.UUID) {
+	if req.Name != "" {
+		policy.Name = req.Name
+	}
+	policy.Description = req.Description
+	policy.Rules = make([]model.OvertimeTypeRule, 0, len(req.Rules))
+	for _, rule := range req.Rules {
+		policy.Rules = append(policy.Rules, model.OvertimeTypeRule{
+			Type:         model.OvertimeType(rule.Type),
+			PayRate:      rule.PayRate,
+			CompOffRate:  rule.CompOffRate,
+			Compensation: model.OvertimeCompensation(rule.Compensation),
+		})
+	}
+	policy.HoursPerDay = req.HoursPerDay
+	policy.MinDurationMinutes = int(req.MinDurationMinutes)
+	policy.UnitMinutes = int(req.UnitMinutes)
+	policy.Rounding = model.OvertimeRounding(req.Rounding)
+	policy.MonthlyCapHours = req.MonthlyCapHours
+	policy.YearlyCapHours = req.YearlyCapHours
+	policy.BlockOverCap = req.BlockOverCap
+	policy.AutoDetect = req.AutoDetect
+	// 自动识别的加班默认需要审批
+	policy.AutoRequireApproval = req.AutoRequireApproval == nil || *req.AutoRequireApproval
+	policy.IsDefault = req.IsDefault
+	if req.IsActive != nil {
+		policy.IsActive = *req.IsActive
+	}
+	policy.UpdatedBy = userID
+}
+
+// overtimePolicyError 加班政策业务错误转换为 HTTP 错误
+func overtimePolicyError(err error) error {
+	switch {
+	case errors.Is(err, hrmService.ErrOvertimePolicyNotFound):
+		return errors.NotFound("NOT_FOUND", err.Error())
+	case errors.Is(err, hrmService.ErrOvertimePolicyCodeExists):
+		return errors.Conflict("ALREADY_EXISTS", err.Error())
+	case errors.Is(err, hrmService.ErrInvalidOvertimePolicy):
+		return errors.BadRequest("INVALID_ARGUMENT", err.Error())
+	}
+	return err
+}
+
+// ========== 辅助转换函数 ==========
+
+func toOvertimePolicyResponse(policy *model.OvertimePolicy) *hrmv1.OvertimePolicyResponse {
+	resp := &hrmv1.OvertimePolicyResponse{
+		Id:                  policy.ID.String(),
+		TenantId:            policy.TenantID.String(),
+		Code:                policy.Code,
+		Name:                policy.Name,
+		Description:         policy.Description,
+		Rules:               make([]*hrmv1.OvertimeTypeRule, 0, len(policy.Rules)),
+		HoursPerDay:         policy.HoursPerDay,
+		MinDurationMinutes:  int32(policy.MinDurationMinutes),
+		UnitMinutes:         int32(policy.UnitMinutes),
+		Rounding:            string(policy.Rounding),
+		MonthlyCapHours:     policy.MonthlyCapHours,
+		YearlyCapHours:      policy.YearlyCapHours,
+		BlockOverCap:        policy.BlockOverCap,
+		AutoDetect:          policy.AutoDetect,
+		AutoRequireApproval: policy.AutoRequireApproval,
+		IsDefault:           policy.IsDefault,
+		IsActive:            policy.IsActive,
+		CreatedBy:           policy.CreatedBy.String(),
+		UpdatedBy:           policy.UpdatedBy.String(),
+		CreatedAt:           timestamppb.New(policy.CreatedAt),
+		UpdatedAt:           timestamppb.New(policy.UpdatedAt),
+	}
+	for _, rule := range policy.Rules {
+		resp.Rules = append(resp.Rules, &hrmv1.OvertimeTypeRule{
+			Type:         string(rule.Type),
+			PayRate:      rule.PayRate,
+			CompOffRate:  rule.CompOffRate,
+			Compensation: string(rule.Compensation),
+		})
+	}
+	return resp
+}
+
+// toAttendanceRuleResponse 关联加班政策、打卡地点后返回的考勤规则
+func toAttendanceRuleResponse(rule *model.AttendanceRule) *hrmv1.AttendanceRuleResponse {
+	resp := &hrmv1.AttendanceRuleResponse{
+		Id:                rule.ID.String(),
+		TenantId:          rule.TenantID.String(),
+		Code:              rule.Code,
+		Name:              rule.Name,
+		Description:       rule.Description,
+		ApplyType:         string(rule.ApplyType),
+		WorkdayType:       string(rule.WorkdayType),
+		DefaultShiftId:    optionalUUIDString(rule.DefaultShiftID),
+		LocationRequired:  rule.LocationRequired,
+		WifiRequired:      rule.WiFiRequired,
+		AllowedWifi:       rule.AllowedWiFi,
+		FaceRequired:      rule.FaceRequired,
+		FaceThreshold:     rule.FaceThreshold,
+		AllowFieldWork:    rule.AllowFieldWork,
+		IsActive:          rule.IsActive,
+		Priority:          int32(rule.Priority),
+		CreatedAt:         rule.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:         rule.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		HolidayCalendarId: optionalUUIDString(rule.HolidayCalendarID),
+		OvertimePolicyId:  optionalUUIDString(rule.OvertimePolicyID),
+	}
+	for _, id := range rule.DepartmentIDs {
+		resp.DepartmentIds = append(resp.DepartmentIds, id.String())
+	}
+	for _, id := range rule.EmployeeIDs {
+		resp.EmployeeIds = append(resp.EmployeeIds, id.String())
+	}
+	for _, day := range rule.WeekendDays {
+		resp.WeekendDays = append(resp.WeekendDays, int32(day))
+	}
+	for _, loc := range rule.AllowedLocations {
+		resp.AllowedLocations = append(resp.AllowedLocations, &hrmv1.AllowedLocationInfo{
+			Name:      loc.Name,
+			Latitude:  loc.Latitude,
+			Longitude: loc.Longitude,
+			Radius:    int32(loc.Radius),
+			Address:   loc.Address,
+		})
+	}
+	for _, id := range rule.ClockLocationIDs {
+		resp.ClockLocationIds = append(resp.ClockLocationIds, id.String())
+	}
+	return resp
+}
@@ -0,0 +1,245 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	hrmv1 "github.com/lk2023060901/go-next-erp/api/hrm/v1"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	hrmService "github.com/lk2023060901/go-next-erp/internal/hrm/service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ========== PayrollService 实现 ==========
+
+// ExportPayroll 按已锁定的月度考勤导出薪资输入；同一期重复导出时返回与上一次导出的差异
+func (a *HRMAdapter) ExportPayroll(ctx context.Context, req *hrmv1.ExportPayrollRequest) (*hrmv1.PayrollRunResponse, error) {
+	if err := a.authorize(ctx, payrollResource, payrollExportAction); err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	run, err := a.payroll.Export(ctx, &hrmService.PayrollExportRequest{
+		TenantID:    tenantID,
+		RequestedBy: userID,
+		Year:        int(req.Year),
+		Month:       int(req.Month),
+		Exporter:    req.Exporter,
+	})
+	if err != nil {
+		return nil, payrollError(err)
+	}
+	return toPayrollRunResponse(run), nil
+}
+
+// PreviewPayroll 预览某期薪资输入及与最近一次导出的差异
+func (a *HRMAdapter) PreviewPayroll(ctx context.Context, req *hrmv1.AttendanceMonthRequest) (*hrmv1.PayrollFeedResponse, error) {
+	if err := a.authorize(ctx, payrollResource, payrollViewAction); err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	feed, err := a.payroll.Preview(ctx, tenantID, int(req.Year), int(req.Month))
+	if err != nil {
+		return nil, payrollError(err)
+	}
+	return toPayrollFeedResponse(feed), nil
+}
+
+// ListPayrollRuns 薪资导出批次列表
+func (a *HRMAdapter) ListPayrollRuns(ctx context.Context, req *hrmv1.ListPayrollRunsRequest) (*hrmv1.ListPayrollRunsResponse, error) {
+	if err := a.authorize(ctx, payrollResource, payrollViewAction); err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, pageSize := int(req.Page), int(req.PageSize)
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 200 {
+		pageSize = 50
+	}
+
+	runs, total, err := a.payroll.List(ctx, tenantID, int(req.Year), int(req.Month), (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrmv1.PayrollRunResponse, 0, len(runs))
+	for _, run := range runs {
+		items = append(items, toPayrollRunResponse(run))
+	}
+	return &hrmv1.ListPayrollRunsResponse{Items: items, Total: int32(total)}, nil
+}
+
+// GetPayrollRun 查询薪资导出批次（含差异和下载地址）
+func (a *HRMAdapter) GetPayrollRun(ctx context.Context, req *hrmv1.GetPayrollRunRequest) (*hrmv1.PayrollRunResponse, error) {
+	if err := a.authorize(ctx, payrollResource, payrollViewAction); err != nil {
+		return nil, err
+	}
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	run, err := a.payroll.Get(ctx, tenantID, id, userID)
+	if err != nil {
+		return nil, payrollError(err)
+	}
+	return toPayrollRunResponse(run), nil
+}
+
+// ListPayrollExporters 已注册的薪资导出格式
+func (a *HRMAdapter) ListPayrollExporters(ctx context.Context, _ *hrmv1.ListPayrollExportersRequest) (*hrmv1.ListPayrollExportersResponse, error) {
+	return &hrmv1.ListPayrollExportersResponse{Items: a.payroll.Exporters()}, nil
+}
+
+// payrollError 薪资对接业务错误转换为 HTTP 错误
+func payrollError(err error) error {
+	switch {
+	case errors.Is(err, hrmService.ErrPayrollRunNotFound):
+		return errors.NotFound("NOT_FOUND", err.Error())
+	case errors.Is(err, hrmService.ErrPayrollPeriodNotLocked),
+		errors.Is(err, hrmService.ErrPayrollSnapshotMissing):
+		return errors.Conflict("FAILED_PRECONDITION", err.Error())
+	case errors.Is(err, hrmService.ErrPayrollPeriodInvalid),
+		errors.Is(err, hrmService.ErrPayrollExporterUnknown):
+		return errors.BadRequest("INVALID_ARGUMENT", err.Error())
+	}
+	return err
+}
+
+// ========== 辅助转换函数 ==========
+
+func toPayrollRunResponse(run *model.PayrollRun) *hrmv1.PayrollRunResponse {
+	return &hrmv1.PayrollRunResponse{
+		Id:            run.ID.String(),
+		TenantId:      run.TenantID.String(),
+		Year:          int32(run.Year),
+		Month:         int32(run.Month),
+		RunNo:         int32(run.RunNo),
+		SchemaVersion: run.SchemaVersion,
+		Exporter:      run.Exporter,
+		EmployeeCount: int32(run.EmployeeCount),
+		Checksum:      run.Checksum,
+		FileId:        optionalUUIDString(run.FileID),
+		Filename:      run.Filename,
+		PreviousRunId: optionalUUIDString(run.PreviousRunID),
+		Diff:          toPayrollDiff(run.Diff),
+		CreatedBy:     run.CreatedBy.String(),
+		CreatedAt:     timestamppb.New(run.CreatedAt),
+		DownloadUrl:   run.DownloadURL,
+	}
+}
+
+func toPayrollFeedResponse(feed *model.PayrollFeed) *hrmv1.PayrollFeedResponse {
+	resp := &hrmv1.PayrollFeedResponse{
+		SchemaVersion: feed.SchemaVersion,
+		TenantId:      feed.TenantID.String(),
+		Year:          int32(feed.Year),
+		Month:         int32(feed.Month),
+		RunId:         feed.RunID.String(),
+		RunNo:         int32(feed.RunNo),
+		GeneratedAt:   timestamppb.New(feed.GeneratedAt),
+		Employees:     make([]*hrmv1.PayrollEmployee, 0, len(feed.Employees)),
+		Changes:       toPayrollDiff(feed.Changes),
+	}
+	for _, emp := range feed.Employees {
+		item := &hrmv1.PayrollEmployee{
+			EmployeeId:     emp.EmployeeID.String(),
+			EmployeeNo:     emp.EmployeeNo,
+			EmployeeName:   emp.EmployeeName,
+			DepartmentId:   emp.DepartmentID.String(),
+			DepartmentName: emp.DepartmentName,
+			Items:          make([]*hrmv1.PayrollItem, 0, len(emp.Items)),
+		}
+		for _, payrollItem := range emp.Items {
+			item.Items = append(item.Items, toPayrollItem(payrollItem))
+		}
+		resp.Employees = append(resp.Employees, item)
+	}
+	return resp
+}
+
+func toPayrollDiff(diff *model.PayrollDiff) *hrmv1.PayrollDiff {
+	if diff == nil {
+		return nil
+	}
+
+	resp := &hrmv1.PayrollDiff{
+		PreviousRunId: diff.PreviousRunID.String(),
+		PreviousRunNo: int32(diff.PreviousRunNo),
+		Added:         toPayrollEmployeeRefs(diff.Added),
+		Removed:       toPayrollEmployeeRefs(diff.Removed),
+		Changed:       make([]*hrmv1.PayrollEmployeeChange, 0, len(diff.Changed)),
+	}
+	for _, change := range diff.Changed {
+		item := &hrmv1.PayrollEmployeeChange{
+			EmployeeId:   change.EmployeeID.String(),
+			EmployeeNo:   change.EmployeeNo,
+			EmployeeName: change.EmployeeName,
+			Items:        make([]*hrmv1.PayrollItemChange, 0, len(change.Items)),
+		}
+		for _, itemChange := range change.Items {
+			item.Items = append(item.Items, &hrmv1.PayrollItemChange{
+				Key:      itemChange.Key,
+				Item:     toPayrollItem(itemChange.Item),
+				Previous: itemChange.Previous,
+				Current:  itemChange.Current,
+			})
+		}
+		resp.Changed = append(resp.Changed, item)
+	}
+	return resp
+}
+
+func toPayrollEmployeeRefs(refs []*model.PayrollEmployeeRef) []*hrmv1.PayrollEmployeeRef {
+	items := make([]*hrmv1.PayrollEmployeeRef, 0, len(refs))
+	for _, ref := range refs {
+		items = append(items, &hrmv1.PayrollEmployeeRef{
+			EmployeeId:   ref.EmployeeID.String(),
+			EmployeeNo:   ref.EmployeeNo,
+			EmployeeName: ref.EmployeeName,
+		})
+	}
+	return items
+}
+
+func toPayrollItem(item *model.PayrollItem) *hrmv1.PayrollItem {
+	if item == nil {
+		return nil
+	}
+	return &hrmv1.PayrollItem{
+		Category:   string(item.Category),
+		Code:       item.Code,
+		Name:       item.Name,
+		Paid:       item.Paid,
+		PayType:    item.PayType,
+		Multiplier: item.Multiplier,
+		Quantity:   item.Quantity,
+		Unit:       string(item.Unit),
+		Currency:   item.Currency,
+	}
+}
@@ -0,0 +1,106 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	hrmv1 "github.com/lk2023060901/go-next-erp/api/hrm/v1"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	hrmService "github.com/lk2023060901/go-next-erp/internal/hrm/service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ========== PlatformSyncService 实现 ==========
+
+// SyncPlatformAttendance 立即增量同步第三方平台考勤（平台调用失败时返回状态为 failed 的同步日志）
+func (a *HRMAdapter) SyncPlatformAttendance(ctx context.Context, req *hrmv1.SyncPlatformAttendanceRequest) (*hrmv1.SyncLogResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	log, err := a.platformSync.SyncAttendance(ctx, tenantID, id)
+	if err != nil {
+		return nil, platformSyncError(err)
+	}
+	return toSyncLogResponse(log), nil
+}
+
+// ListPlatformSyncLogs 第三方平台同步日志
+func (a *HRMAdapter) ListPlatformSyncLogs(ctx context.Context, req *hrmv1.ListPlatformSyncLogsRequest) (*hrmv1.ListPlatformSyncLogsResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, pageSize := int(req.Page), int(req.PageSize)
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 200 {
+		pageSize = 50
+	}
+
+	logs, total, err := a.platformSync.ListSyncLogs(ctx, tenantID, id, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, platformSyncError(err)
+	}
+
+	items := make([]*hrmv1.SyncLogResponse, 0, len(logs))
+	for _, log := range logs {
+		items = append(items, toSyncLogResponse(log))
+	}
+	return &hrmv1.ListPlatformSyncLogsResponse{Items: items, Total: int32(total)}, nil
+}
+
+// platformSyncError 第三方平台同步业务错误转换为 HTTP 错误
+func platformSyncError(err error) error {
+	switch {
+	case errors.Is(err, hrmService.ErrIntegrationNotFound):
+		return errors.NotFound("NOT_FOUND", err.Error())
+	case errors.Is(err, hrmService.ErrSyncInProgress):
+		return errors.Conflict("ABORTED", err.Error())
+	case errors.Is(err, hrmService.ErrIntegrationInactive),
+		errors.Is(err, hrmService.ErrPlatformNotSupported):
+		return errors.Conflict("FAILED_PRECONDITION", err.Error())
+	}
+	return err
+}
+
+// ========== 辅助转换函数 ==========
+
+func toSyncLogResponse(log *model.SyncLog) *hrmv1.SyncLogResponse {
+	resp := &hrmv1.SyncLogResponse{
+		Id:            log.ID.String(),
+		TenantId:      log.TenantID.String(),
+		SourceType:    log.SourceType,
+		SourceId:      log.SourceID.String(),
+		SyncType:      log.SyncType,
+		SyncDirection: log.SyncDirection,
+		StartTime:     timestamppb.New(log.StartTime),
+		EndTime:       timestamppb.New(log.EndTime),
+		Duration:      int32(log.Duration),
+		Status:        log.Status,
+		TotalCount:    int32(log.TotalCount),
+		SuccessCount:  int32(log.SuccessCount),
+		FailedCount:   int32(log.FailedCount),
+		ErrorMessage:  log.ErrorMessage,
+		CreatedAt:     timestamppb.New(log.CreatedAt),
+	}
+	if len(log.Details) > 0 {
+		resp.Details = make(map[string]string, len(log.Details))
+		for key, value := range log.Details {
+			resp.Details[key] = fmt.Sprint(value)
+		}
+	}
+	return resp
+}
@@ -0,0 +1,149 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	hrmv1 "github.com/lk2023060901/go-next-erp/api/hrm/v1"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	hrmService "github.com/lk2023060901/go-next-erp/internal/hrm/service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ========== ReportExportService 实现 ==========
+
+// CreateReportExport 导出月度考勤表、假期余额表或加班台账；
+// 小报表直接返回下载地址，大报表返回排队中的任务，完成后通过查询接口获取下载地址
+func (a *HRMAdapter) CreateReportExport(ctx context.Context, req *hrmv1.CreateReportExportRequest) (*hrmv1.ReportExportResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	create := &hrmService.ReportExportRequest{
+		TenantID:    tenantID,
+		RequestedBy: userID,
+		ReportType:  model.ReportType(req.ReportType),
+		Format:      model.ExportFormat(req.Format),
+		Locale:      req.Locale,
+		Columns:     req.Columns,
+		Year:        int(req.Year),
+		Month:       int(req.Month),
+	}
+	if create.DepartmentID, err = parseOptionalUUID("department_id", req.DepartmentId); err != nil {
+		return nil, err
+	}
+
+	export, err := a.reportExports.Create(ctx, create)
+	if err != nil {
+		return nil, reportExportError(err)
+	}
+	return toReportExportResponse(export), nil
+}
+
+// ListReportExports 导出任务列表
+func (a *HRMAdapter) ListReportExports(ctx context.Context, req *hrmv1.ListReportExportsRequest) (*hrmv1.ListReportExportsResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, pageSize := int(req.Page), int(req.PageSize)
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 200 {
+		pageSize = 50
+	}
+
+	exports, total, err := a.reportExports.List(ctx, tenantID, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrmv1.ReportExportResponse, 0, len(exports))
+	for _, export := range exports {
+		items = append(items, toReportExportResponse(export))
+	}
+	return &hrmv1.ListReportExportsResponse{Items: items, Total: int32(total)}, nil
+}
+
+// GetReportExport 查询导出任务，已完成时返回下载地址
+func (a *HRMAdapter) GetReportExport(ctx context.Context, req *hrmv1.GetReportExportRequest) (*hrmv1.ReportExportResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	export, err := a.reportExports.Get(ctx, tenantID, id, userID)
+	if err != nil {
+		return nil, reportExportError(err)
+	}
+	return toReportExportResponse(export), nil
+}
+
+// ListReportColumns 报表可选列及对应语言的表头
+func (a *HRMAdapter) ListReportColumns(ctx context.Context, req *hrmv1.ListReportColumnsRequest) (*hrmv1.ListReportColumnsResponse, error) {
+	columns, err := a.reportExports.Columns(model.ReportType(req.ReportType), req.Locale)
+	if err != nil {
+		return nil, reportExportError(err)
+	}
+
+	items := make([]*hrmv1.ReportColumn, 0, len(columns))
+	for _, column := range columns {
+		items = append(items, &hrmv1.ReportColumn{Key: column.Key, Title: column.Title})
+	}
+	return &hrmv1.ListReportColumnsResponse{Items: items}, nil
+}
+
+// reportExportError 报表导出业务错误转换为 HTTP 错误
+func reportExportError(err error) error {
+	switch {
+	case errors.Is(err, hrmService.ErrReportExportNotFound),
+		errors.Is(err, hrmService.ErrReportDepartmentNotFound):
+		return errors.NotFound("NOT_FOUND", err.Error())
+	case errors.Is(err, hrmService.ErrReportExportInvalid),
+		errors.Is(err, hrmService.ErrReportColumnUnknown):
+		return errors.BadRequest("INVALID_ARGUMENT", err.Error())
+	}
+	return err
+}
+
+// ========== 辅助转换函数 ==========
+
+func toReportExportResponse(export *model.ReportExport) *hrmv1.ReportExportResponse {
+	return &hrmv1.ReportExportResponse{
+		Id:           export.ID.String(),
+		TenantId:     export.TenantID.String(),
+		ReportType:   string(export.ReportType),
+		Format:       string(export.Format),
+		Locale:       export.Locale,
+		Columns:      export.Columns,
+		DepartmentId: optionalUUIDString(export.DepartmentID),
+		Year:         int32(export.Year),
+		Month:        int32(export.Month),
+		Status:       string(export.Status),
+		RowCount:     int32(export.RowCount),
+		FileId:       optionalUUIDString(export.FileID),
+		Filename:     export.Filename,
+		Error:        export.Error,
+		RequestedBy:  export.RequestedBy.String(),
+		StartedAt:    optionalTimestamp(export.StartedAt),
+		FinishedAt:   optionalTimestamp(export.FinishedAt),
+		DownloadUrl:  export.DownloadURL,
+		CreatedAt:    timestamppb.New(export.CreatedAt),
+		UpdatedAt:    timestamppb.New(export.UpdatedAt),
+	}
+}
@@ -0,0 +1,438 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	hrmv1 "github.com/lk2023060901/go-next-erp/api/hrm/v1"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	hrmService "github.com/lk2023060901/go-next-erp/internal/hrm/service"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ========== ScheduleRotationService 实现 ==========
+
+// CreateRotationTemplate 创建轮班模板
+func (a *HRMAdapter) CreateRotationTemplate(ctx context.Context, req *hrmv1.RotationTemplateRequest) (*hrmv1.RotationTemplateResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Code == "" || req.Name == "" {
+		return nil, errors.BadRequest("INVALID_ARGUMENT", "code and name are required")
+	}
+	slots, err := toRotationSlots(req.Slots)
+	if err != nil {
+		return nil, err
+	}
+	constraints, err := toScheduleConstraints(req.Constraints)
+	if err != nil {
+		return nil, err
+	}
+
+	template := &model.RotationTemplate{
+		TenantID:     tenantID,
+		Code:         req.Code,
+		Name:         req.Name,
+		Description:  req.Description,
+		Slots:        slots,
+		SkipHolidays: req.SkipHolidays,
+		IsActive:     req.IsActive == nil || *req.IsActive,
+		CreatedBy:    userID,
+		UpdatedBy:    userID,
+	}
+	if constraints != nil {
+		template.Constraints = *constraints
+	}
+	if err := a.rotationService.CreateTemplate(ctx, template); err != nil {
+		return nil, rotationError(err)
+	}
+
+	return toRotationTemplateResponse(template), nil
+}
+
+// UpdateRotationTemplate 更新轮班模板（编码不可修改）
+func (a *HRMAdapter) UpdateRotationTemplate(ctx context.Context, req *hrmv1.RotationTemplateRequest) (*hrmv1.RotationTemplateResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slots, err := toRotationSlots(req.Slots)
+	if err != nil {
+		return nil, err
+	}
+	constraints, err := toScheduleConstraints(req.Constraints)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := a.rotationService.GetTemplate(ctx, tenantID, id)
+	if err != nil {
+		return nil, rotationError(err)
+	}
+	if req.Name != "" {
+		template.Name = req.Name
+	}
+	template.Description = req.Description
+	if len(slots) > 0 {
+		template.Slots = slots
+	}
+	template.SkipHolidays = req.SkipHolidays
+	template.Constraints = model.ScheduleConstraints{}
+	if constraints != nil {
+		template.Constraints = *constraints
+	}
+	if req.IsActive != nil {
+		template.IsActive = *req.IsActive
+	}
+	template.UpdatedBy = userID
+
+	if err := a.rotationService.UpdateTemplate(ctx, template); err != nil {
+		return nil, rotationError(err)
+	}
+
+	return toRotationTemplateResponse(template), nil
+}
+
+// DeleteRotationTemplate 删除轮班模板（已生成的排班不受影响）
+func (a *HRMAdapter) DeleteRotationTemplate(ctx context.Context, req *hrmv1.DeleteRotationTemplateRequest) (*emptypb.Empty, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.rotationService.DeleteTemplate(ctx, tenantID, id); err != nil {
+		return nil, rotationError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetRotationTemplate 获取轮班模板
+func (a *HRMAdapter) GetRotationTemplate(ctx context.Context, req *hrmv1.GetRotationTemplateRequest) (*hrmv1.RotationTemplateResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := a.rotationService.GetTemplate(ctx, tenantID, id)
+	if err != nil {
+		return nil, rotationError(err)
+	}
+	return toRotationTemplateResponse(template), nil
+}
+
+// ListRotationTemplates 查询租户轮班模板
+func (a *HRMAdapter) ListRotationTemplates(ctx context.Context, _ *hrmv1.ListRotationTemplatesRequest) (*hrmv1.ListRotationTemplatesResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := a.rotationService.ListTemplates(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrmv1.RotationTemplateResponse, 0, len(templates))
+	for _, template := range templates {
+		items = append(items, toRotationTemplateResponse(template))
+	}
+	return &hrmv1.ListRotationTemplatesResponse{Items: items}, nil
+}
+
+// GenerateSchedules 按轮班模板生成部门月度草稿排班（dry_run 只返回预览和违规清单）
+func (a *HRMAdapter) GenerateSchedules(ctx context.Context, req *hrmv1.GenerateSchedulesRequest) (*hrmv1.ScheduleGenerationResponse, error) {
+	departmentID, err := parseUUID("department_id", req.DepartmentId)
+	if err != nil {
+		return nil, err
+	}
+	templateID, err := parseUUID("template_id", req.TemplateId)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateYearMonth(int(req.Year), int(req.Month)); err != nil {
+		return nil, err
+	}
+	constraints, err := toScheduleConstraints(req.Constraints)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genReq := &hrmService.GenerateSchedulesRequest{
+		TenantID:     tenantID,
+		DepartmentID: departmentID,
+		TemplateID:   templateID,
+		Year:         int(req.Year),
+		Month:        int(req.Month),
+		StaggerDays:  int(req.StaggerDays),
+		Constraints:  constraints,
+		DryRun:       req.DryRun,
+		OperatorID:   userID,
+	}
+	for _, raw := range req.EmployeeIds {
+		employeeID, err := parseUUID("employee_ids", raw)
+		if err != nil {
+			return nil, err
+		}
+		genReq.EmployeeIDs = append(genReq.EmployeeIDs, employeeID)
+	}
+	if req.AnchorDate != "" {
+		anchor, err := parseDate("anchor_date", req.AnchorDate)
+		if err != nil {
+			return nil, err
+		}
+		genReq.AnchorDate = &anchor
+	}
+
+	result, err := a.rotationService.Generate(ctx, genReq)
+	if err != nil {
+		return nil, rotationError(err)
+	}
+
+	resp := &hrmv1.ScheduleGenerationResponse{
+		Schedules:  make([]*hrmv1.ScheduleResponse, 0, len(result.Schedules)),
+		Created:    int32(result.Created),
+		Replaced:   result.Replaced,
+		Skipped:    int32(result.Skipped),
+		Violations: toScheduleViolations(result.Violations),
+	}
+	for _, schedule := range result.Schedules {
+		resp.Schedules = append(resp.Schedules, toScheduleResponse(schedule))
+	}
+	return resp, nil
+}
+
+// ValidateSchedules 按约束校验部门月度排班
+func (a *HRMAdapter) ValidateSchedules(ctx context.Context, req *hrmv1.ScheduleMonthRequest) (*hrmv1.ValidateSchedulesResponse, error) {
+	validateReq, err := a.scheduleMonthRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	violations, err := a.rotationService.Validate(ctx, validateReq)
+	if err != nil {
+		return nil, rotationError(err)
+	}
+	return &hrmv1.ValidateSchedulesResponse{Items: toScheduleViolations(violations)}, nil
+}
+
+// PublishSchedules 发布部门月度草稿排班并通知员工（存在违规时需 force）
+func (a *HRMAdapter) PublishSchedules(ctx context.Context, req *hrmv1.ScheduleMonthRequest) (*hrmv1.SchedulePublishResponse, error) {
+	validateReq, err := a.scheduleMonthRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := a.rotationService.Publish(ctx, &hrmService.PublishSchedulesRequest{
+		ValidateSchedulesRequest: *validateReq,
+		Force:                    req.Force,
+		OperatorID:               userID,
+	})
+	if err != nil {
+		return nil, rotationError(err)
+	}
+	return &hrmv1.SchedulePublishResponse{
+		Published:  result.Published,
+		Notified:   int32(result.Notified),
+		Blocked:    result.Blocked,
+		Violations: toScheduleViolations(result.Violations),
+	}, nil
+}
+
+func (a *HRMAdapter) scheduleMonthRequest(ctx context.Context, req *hrmv1.ScheduleMonthRequest) (*hrmService.ValidateSchedulesRequest, error) {
+	departmentID, err := parseUUID("department_id", req.DepartmentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateYearMonth(int(req.Year), int(req.Month)); err != nil {
+		return nil, err
+	}
+	constraints, err := toScheduleConstraints(req.Constraints)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	validateReq := &hrmService.ValidateSchedulesRequest{
+		TenantID:     tenantID,
+		DepartmentID: departmentID,
+		Year:         int(req.Year),
+		Month:        int(req.Month),
+		Constraints:  constraints,
+	}
+	if req.TemplateId != "" {
+		templateID, err := parseUUID("template_id", req.TemplateId)
+		if err != nil {
+			return nil, err
+		}
+		validateReq.TemplateID = &templateID
+	}
+	return validateReq, nil
+}
+
+func rotationError(err error) error {
+	switch {
+	case errors.Is(err, hrmService.ErrRotationTemplateNotFound):
+		return errors.NotFound("NOT_FOUND", err.Error())
+	case errors.Is(err, hrmService.ErrRotationTemplateCodeExists):
+		return errors.Conflict("ALREADY_EXISTS", err.Error())
+	case errors.Is(err, hrmService.ErrAttendancePeriodLocked):
+		return errors.Forbidden("PERIOD_LOCKED", err.Error())
+	case errors.Is(err, hrmService.ErrRotationTemplateEmpty),
+		errors.Is(err, hrmService.ErrRotationShiftNotFound),
+		errors.Is(err, hrmService.ErrRotationTemplateInactive),
+		errors.Is(err, hrmService.ErrScheduleNoEmployees),
+		errors.Is(err, hrmService.ErrEmployeeNotInDepartment),
+		errors.Is(err, hrmService.ErrNoDraftSchedules):
+		return errors.BadRequest("INVALID_ARGUMENT", err.Error())
+	}
+	return err
+}
+
+// ========== 辅助转换函数 ==========
+
+func toRotationSlots(slots []*hrmv1.RotationSlot) ([]model.RotationSlot, error) {
+	result := make([]model.RotationSlot, 0, len(slots))
+	for _, slot := range slots {
+		if slot.ShiftId == "" {
+			result = append(result, model.RotationSlot{})
+			continue
+		}
+		shiftID, err := parseUUID("slots.shift_id", slot.ShiftId)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, model.RotationSlot{ShiftID: &shiftID})
+	}
+	return result, nil
+}
+
+// toScheduleConstraints 未传约束时返回 nil
+func toScheduleConstraints(constraints *hrmv1.ScheduleConstraints) (*model.ScheduleConstraints, error) {
+	if constraints == nil {
+		return nil, nil
+	}
+	result := &model.ScheduleConstraints{
+		MinRestHours:       constraints.MinRestHours,
+		MaxConsecutiveDays: int(constraints.MaxConsecutiveDays),
+	}
+	for _, headcount := range constraints.MinHeadcount {
+		shiftID, err := parseUUID("min_headcount.shift_id", headcount.ShiftId)
+		if err != nil {
+			return nil, err
+		}
+		result.MinHeadcount = append(result.MinHeadcount, model.ShiftHeadcount{ShiftID: shiftID, Min: int(headcount.Min)})
+	}
+	return result, nil
+}
+
+func toRotationTemplateResponse(template *model.RotationTemplate) *hrmv1.RotationTemplateResponse {
+	resp := &hrmv1.RotationTemplateResponse{
+		Id:           template.ID.String(),
+		TenantId:     template.TenantID.String(),
+		Code:         template.Code,
+		Name:         template.Name,
+		Description:  template.Description,
+		Slots:        make([]*hrmv1.RotationSlot, 0, len(template.Slots)),
+		SkipHolidays: template.SkipHolidays,
+		Constraints: &hrmv1.ScheduleConstraints{
+			MinRestHours:       template.Constraints.MinRestHours,
+			MaxConsecutiveDays: int32(template.Constraints.MaxConsecutiveDays),
+		},
+		IsActive:  template.IsActive,
+		CreatedBy: template.CreatedBy.String(),
+		UpdatedBy: template.UpdatedBy.String(),
+		CreatedAt: timestamppb.New(template.CreatedAt),
+		UpdatedAt: timestamppb.New(template.UpdatedAt),
+	}
+	for _, slot := range template.Slots {
+		resp.Slots = append(resp.Slots, &hrmv1.RotationSlot{ShiftId: optionalUUIDString(slot.ShiftID)})
+	}
+	for _, headcount := range template.Constraints.MinHeadcount {
+		resp.Constraints.MinHeadcount = append(resp.Constraints.MinHeadcount, &hrmv1.ShiftHeadcount{
+			ShiftId: headcount.ShiftID.String(),
+			Min:     int32(headcount.Min),
+		})
+	}
+	return resp
+}
+
+func toScheduleViolations(violations []*model.ScheduleViolation) []*hrmv1.ScheduleViolation {
+	items := make([]*hrmv1.ScheduleViolation, 0, len(violations))
+	for _, violation := range violations {
+		items = append(items, &hrmv1.ScheduleViolation{
+			Type:         string(violation.Type),
+			Date:         violation.Date.Format("2006-01-02"),
+			EmployeeId:   optionalUUIDString(violation.EmployeeID),
+			EmployeeName: violation.EmployeeName,
+			ShiftId:      optionalUUIDString(violation.ShiftID),
+			ShiftName:    violation.ShiftName,
+			Actual:       violation.Actual,
+			Limit:        violation.Limit,
+			Message:      violation.Message,
+		})
+	}
+	return items
+}
+
+func toScheduleResponse(schedule *model.Schedule) *hrmv1.ScheduleResponse {
+	return &hrmv1.ScheduleResponse{
+		Id:           schedule.ID.String(),
+		TenantId:     schedule.TenantID.String(),
+		EmployeeId:   schedule.EmployeeID.String(),
+		EmployeeName: schedule.EmployeeName,
+		DepartmentId: schedule.DepartmentID.String(),
+		ShiftId:      schedule.ShiftID.String(),
+		ShiftName:    schedule.ShiftName,
+		ScheduleDate: schedule.ScheduleDate.Format("2006-01-02"),
+		WorkdayType:  schedule.WorkdayType,
+		Status:       schedule.Status,
+		Remark:       schedule.Remark,
+		CreatedAt:    schedule.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// optionalUUIDString 可空 ID 转字符串，nil 返回空串
+func optionalUUIDString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
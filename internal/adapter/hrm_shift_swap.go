@@ -0,0 +1,253 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	hrmv1 "github.com/lk2023060901/go-next-erp/api/hrm/v1"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	hrmService "github.com/lk2023060901/go-next-erp/internal/hrm/service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ========== ShiftSwapService 实现 ==========
+
+// ProposeShiftSwap 发起换班/代班申请，通知对方确认
+func (a *HRMAdapter) ProposeShiftSwap(ctx context.Context, req *hrmv1.ProposeShiftSwapRequest) (*hrmv1.ShiftSwapResponse, error) {
+	scheduleID, err := parseUUID("schedule_id", req.ScheduleId)
+	if err != nil {
+		return nil, err
+	}
+	targetEmployeeID, err := parseUUID("target_employee_id", req.TargetEmployeeId)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, employeeID, err := a.employeeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	proposeReq := &hrmService.ProposeShiftSwapRequest{
+		TenantID:            tenantID,
+		RequesterID:         employeeID,
+		RequesterScheduleID: scheduleID,
+		TargetEmployeeID:    targetEmployeeID,
+		Reason:              req.Reason,
+	}
+	if req.TargetScheduleId != "" {
+		targetScheduleID, err := parseUUID("target_schedule_id", req.TargetScheduleId)
+		if err != nil {
+			return nil, err
+		}
+		proposeReq.TargetScheduleID = &targetScheduleID
+	}
+
+	request, err := a.swapService.Propose(ctx, proposeReq)
+	if err != nil {
+		return nil, shiftSwapError(err)
+	}
+	return toShiftSwapResponse(request), nil
+}
+
+// ListShiftSwaps 查询换班申请
+func (a *HRMAdapter) ListShiftSwaps(ctx context.Context, req *hrmv1.ListShiftSwapsRequest) (*hrmv1.ListShiftSwapsResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &repository.ShiftSwapFilter{}
+	if req.EmployeeId != "" {
+		employeeID, err := parseUUID("employee_id", req.EmployeeId)
+		if err != nil {
+			return nil, err
+		}
+		filter.EmployeeID = &employeeID
+	}
+	if req.Status != "" {
+		status := model.ShiftSwapStatus(req.Status)
+		filter.Status = &status
+	}
+
+	requests, err := a.swapService.List(ctx, tenantID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrmv1.ShiftSwapResponse, 0, len(requests))
+	for _, request := range requests {
+		items = append(items, toShiftSwapResponse(request))
+	}
+	return &hrmv1.ListShiftSwapsResponse{Items: items}, nil
+}
+
+// GetShiftSwap 获取换班申请
+func (a *HRMAdapter) GetShiftSwap(ctx context.Context, req *hrmv1.GetShiftSwapRequest) (*hrmv1.ShiftSwapResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := a.swapService.Get(ctx, tenantID, id)
+	if err != nil {
+		return nil, shiftSwapError(err)
+	}
+	return toShiftSwapResponse(request), nil
+}
+
+// RespondShiftSwap 对方同意或拒绝换班
+func (a *HRMAdapter) RespondShiftSwap(ctx context.Context, req *hrmv1.ShiftSwapActionRequest) (*hrmv1.ShiftSwapResponse, error) {
+	tenantID, id, employeeID, err := a.shiftSwapParticipant(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := a.swapService.Respond(ctx, tenantID, id, employeeID, req.Accept, req.Comment)
+	if err != nil {
+		return nil, shiftSwapError(err)
+	}
+	return toShiftSwapResponse(request), nil
+}
+
+// CancelShiftSwap 申请人撤销换班
+func (a *HRMAdapter) CancelShiftSwap(ctx context.Context, req *hrmv1.ShiftSwapActionRequest) (*hrmv1.ShiftSwapResponse, error) {
+	tenantID, id, employeeID, err := a.shiftSwapParticipant(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := a.swapService.Cancel(ctx, tenantID, id, employeeID)
+	if err != nil {
+		return nil, shiftSwapError(err)
+	}
+	return toShiftSwapResponse(request), nil
+}
+
+// ApproveShiftSwap 主管批准换班，交换双方排班
+func (a *HRMAdapter) ApproveShiftSwap(ctx context.Context, req *hrmv1.ShiftSwapActionRequest) (*hrmv1.ShiftSwapResponse, error) {
+	tenantID, id, approverID, err := a.shiftSwapApprover(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := a.swapService.Approve(ctx, tenantID, id, approverID, req.Comment)
+	if err != nil {
+		return nil, shiftSwapError(err)
+	}
+	return toShiftSwapResponse(request), nil
+}
+
+// RejectShiftSwap 主管驳回换班
+func (a *HRMAdapter) RejectShiftSwap(ctx context.Context, req *hrmv1.ShiftSwapActionRequest) (*hrmv1.ShiftSwapResponse, error) {
+	tenantID, id, approverID, err := a.shiftSwapApprover(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := a.swapService.Reject(ctx, tenantID, id, approverID, req.Comment)
+	if err != nil {
+		return nil, shiftSwapError(err)
+	}
+	return toShiftSwapResponse(request), nil
+}
+
+// shiftSwapParticipant 确认、撤销只能以当前登录员工的身份操作
+func (a *HRMAdapter) shiftSwapParticipant(ctx context.Context, req *hrmv1.ShiftSwapActionRequest) (uuid.UUID, uuid.UUID, uuid.UUID, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+	tenantID, employeeID, err := a.employeeFromContext(ctx)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+	return tenantID, id, employeeID, nil
+}
+
+func (a *HRMAdapter) shiftSwapApprover(ctx context.Context, req *hrmv1.ShiftSwapActionRequest) (uuid.UUID, uuid.UUID, uuid.UUID, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+	return tenantID, id, userID, nil
+}
+
+// shiftSwapError 换班业务错误转换为 HTTP 错误
+func shiftSwapError(err error) error {
+	switch {
+	case errors.Is(err, hrmService.ErrShiftSwapNotFound),
+		errors.Is(err, hrmService.ErrShiftSwapScheduleMissing):
+		return errors.NotFound("NOT_FOUND", err.Error())
+	case errors.Is(err, hrmService.ErrShiftSwapNotParticipant):
+		return errors.Forbidden("NOT_PARTICIPANT", err.Error())
+	case errors.Is(err, hrmService.ErrShiftSwapNotApprover):
+		return errors.Forbidden("NOT_APPROVER", err.Error())
+	case errors.Is(err, hrmService.ErrAttendancePeriodLocked):
+		return errors.Forbidden("PERIOD_LOCKED", err.Error())
+	case errors.Is(err, hrmService.ErrShiftSwapConflict):
+		return errors.Conflict("SHIFT_SWAP_CONFLICT", err.Error())
+	case errors.Is(err, hrmService.ErrShiftSwapDuplicate),
+		errors.Is(err, hrmService.ErrShiftSwapInvalidStatus),
+		errors.Is(err, hrmService.ErrShiftSwapScheduleChanged):
+		return errors.Conflict("FAILED_PRECONDITION", err.Error())
+	case errors.Is(err, hrmService.ErrShiftSwapSameEmployee),
+		errors.Is(err, hrmService.ErrShiftSwapScheduleOwner),
+		errors.Is(err, hrmService.ErrShiftSwapPastSchedule),
+		errors.Is(err, hrmService.ErrEmployeeNotInDepartment):
+		return errors.BadRequest("INVALID_ARGUMENT", err.Error())
+	}
+	return err
+}
+
+// ========== 辅助转换函数 ==========
+
+func toShiftSwapResponse(request *model.ShiftSwapRequest) *hrmv1.ShiftSwapResponse {
+	resp := &hrmv1.ShiftSwapResponse{
+		Id:                  request.ID.String(),
+		TenantId:            request.TenantID.String(),
+		Type:                string(request.Type),
+		RequesterId:         request.RequesterID.String(),
+		RequesterName:       request.RequesterName,
+		RequesterScheduleId: request.RequesterScheduleID.String(),
+		RequesterDate:       request.RequesterDate.Format("2006-01-02"),
+		RequesterShiftId:    request.RequesterShiftID.String(),
+		RequesterShiftName:  request.RequesterShiftName,
+		TargetEmployeeId:    request.TargetEmployeeID.String(),
+		TargetEmployeeName:  request.TargetEmployeeName,
+		TargetScheduleId:    optionalUUIDString(request.TargetScheduleID),
+		TargetShiftId:       optionalUUIDString(request.TargetShiftID),
+		TargetShiftName:     request.TargetShiftName,
+		Reason:              request.Reason,
+		Status:              string(request.Status),
+		PeerComment:         request.PeerComment,
+		WorkflowExecutionId: request.WorkflowExecutionID,
+		ApproverId:          optionalUUIDString(request.ApproverID),
+		ApprovalComment:     request.ApprovalComment,
+		CreatedAt:           timestamppb.New(request.CreatedAt),
+		UpdatedAt:           timestamppb.New(request.UpdatedAt),
+	}
+	if request.TargetDate != nil {
+		resp.TargetDate = request.TargetDate.Format("2006-01-02")
+	}
+	if request.PeerRespondedAt != nil {
+		resp.PeerRespondedAt = timestamppb.New(*request.PeerRespondedAt)
+	}
+	if request.ApprovedAt != nil {
+		resp.ApprovedAt = timestamppb.New(*request.ApprovedAt)
+	}
+	return resp
+}
@@ -0,0 +1,78 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	hrmv1 "github.com/lk2023060901/go-next-erp/api/hrm/v1"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	hrmService "github.com/lk2023060901/go-next-erp/internal/hrm/service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ========== PunchCardSupplementService 补卡政策实现 ==========
+
+// GetPunchCardSupplementPolicy 查询租户补卡政策（未配置时返回默认政策）
+func (a *HRMAdapter) GetPunchCardSupplementPolicy(ctx context.Context, _ *hrmv1.GetPunchCardSupplementPolicyRequest) (*hrmv1.PunchCardSupplementPolicyResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := a.supplements.GetPolicy(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return toSupplementPolicyResponse(policy), nil
+}
+
+// SavePunchCardSupplementPolicy 保存租户补卡政策（只影响之后提交或修改的补卡申请）
+func (a *HRMAdapter) SavePunchCardSupplementPolicy(ctx context.Context, req *hrmv1.SavePunchCardSupplementPolicyRequest) (*hrmv1.PunchCardSupplementPolicyResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := a.supplements.GetPolicy(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	policy.MaxPerMonth = int(req.MaxPerMonth)
+	policy.FilingDeadlineDays = int(req.FilingDeadlineDays)
+	policy.AllowedMissingTypes = nil
+	for _, missingType := range req.AllowedMissingTypes {
+		policy.AllowedMissingTypes = append(policy.AllowedMissingTypes, model.PunchCardMissingType(missingType))
+	}
+	policy.UpdatedBy = userID
+
+	if err := a.supplements.SavePolicy(ctx, policy); err != nil {
+		if errors.Is(err, hrmService.ErrSupplementPolicyInvalid) {
+			return nil, errors.BadRequest("INVALID_ARGUMENT", err.Error())
+		}
+		return nil, err
+	}
+	return toSupplementPolicyResponse(policy), nil
+}
+
+// ========== 辅助转换函数 ==========
+
+func toSupplementPolicyResponse(policy *model.PunchCardSupplementPolicy) *hrmv1.PunchCardSupplementPolicyResponse {
+	resp := &hrmv1.PunchCardSupplementPolicyResponse{
+		Id:                  policy.ID.String(),
+		TenantId:            policy.TenantID.String(),
+		MaxPerMonth:         int32(policy.MaxPerMonth),
+		FilingDeadlineDays:  int32(policy.FilingDeadlineDays),
+		AllowedMissingTypes: make([]string, 0, len(policy.AllowedMissingTypes)),
+		UpdatedBy:           policy.UpdatedBy.String(),
+		CreatedAt:           timestamppb.New(policy.CreatedAt),
+		UpdatedAt:           timestamppb.New(policy.UpdatedAt),
+	}
+	for _, missingType := range policy.AllowedMissingTypes {
+		resp.AllowedMissingTypes = append(resp.AllowedMissingTypes, string(missingType))
+	}
+	return resp
+}
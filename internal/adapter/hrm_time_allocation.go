@@ -0,0 +1,105 @@
+package adapter
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	hrmv1 "github.com/lk2023060901/go-next-erp/api/hrm/v1"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	hrmService "github.com/lk2023060901/go-next-erp/internal/hrm/service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ========== TimeAllocationService 实现 ==========
+
+// GetEmployeeCalendar 员工合并日历
+func (a *HRMAdapter) GetEmployeeCalendar(ctx context.Context, req *hrmv1.GetEmployeeCalendarRequest) (*hrmv1.EmployeeCalendarResponse, error) {
+	employeeID, err := parseUUID("employee_id", req.EmployeeId)
+	if err != nil {
+		return nil, err
+	}
+	start, end, err := parseCalendarRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar, err := a.allocations.EmployeeCalendar(ctx, tenantID, employeeID, start, end)
+	if err != nil {
+		return nil, timeAllocationError(err)
+	}
+	return toEmployeeCalendarResponse(calendar), nil
+}
+
+// GetTeamCalendar 部门团队日历
+func (a *HRMAdapter) GetTeamCalendar(ctx context.Context, req *hrmv1.GetTeamCalendarRequest) (*hrmv1.TeamCalendarResponse, error) {
+	departmentID, err := parseUUID("department_id", req.DepartmentId)
+	if err != nil {
+		return nil, err
+	}
+	start, end, err := parseCalendarRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	calendars, err := a.allocations.TeamCalendar(ctx, tenantID, departmentID, start, end)
+	if err != nil {
+		return nil, timeAllocationError(err)
+	}
+
+	items := make([]*hrmv1.EmployeeCalendarResponse, 0, len(calendars))
+	for _, calendar := range calendars {
+		items = append(items, toEmployeeCalendarResponse(calendar))
+	}
+	return &hrmv1.TeamCalendarResponse{Items: items}, nil
+}
+
+func parseCalendarRange(startDate, endDate string) (time.Time, time.Time, error) {
+	start, err := parseDate("start_date", startDate)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	end, err := parseDate("end_date", endDate)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return start, end, nil
+}
+
+// timeAllocationError 时间占用业务错误转换为 HTTP 错误
+func timeAllocationError(err error) error {
+	switch {
+	case errors.Is(err, hrmService.ErrInvalidCalendarRange):
+		return errors.BadRequest("INVALID_ARGUMENT", err.Error())
+	}
+	return err
+}
+
+// ========== 辅助转换函数 ==========
+
+func toEmployeeCalendarResponse(calendar *model.EmployeeCalendar) *hrmv1.EmployeeCalendarResponse {
+	resp := &hrmv1.EmployeeCalendarResponse{
+		EmployeeId:   calendar.EmployeeID.String(),
+		EmployeeName: calendar.EmployeeName,
+		Entries:      make([]*hrmv1.CalendarEntry, 0, len(calendar.Entries)),
+	}
+	for _, entry := range calendar.Entries {
+		resp.Entries = append(resp.Entries, &hrmv1.CalendarEntry{
+			Kind:      string(entry.Kind),
+			SourceId:  entry.SourceID.String(),
+			Title:     entry.Title,
+			StartTime: timestamppb.New(entry.StartTime),
+			EndTime:   timestamppb.New(entry.EndTime),
+			Status:    entry.Status,
+		})
+	}
+	return resp
+}
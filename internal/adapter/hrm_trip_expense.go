@@ -0,0 +1,481 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	hrmv1 "github.com/lk2023060901/go-next-erp/api/hrm/v1"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	hrmService "github.com/lk2023060901/go-next-erp/internal/hrm/service"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ========== TripExpenseService 实现 ==========
+
+// AddTripExpense 新增出差报销明细（出差需已批准，报销单审批中或已通过时不可修改）
+func (a *HRMAdapter) AddTripExpense(ctx context.Context, req *hrmv1.TripExpenseRequest) (*hrmv1.TripExpenseResponse, error) {
+	tripID, err := parseUUID("trip_id", req.TripId)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	expense := &model.TripExpense{
+		TenantID:  tenantID,
+		TripID:    tripID,
+		CreatedBy: userID,
+	}
+	if err := applyTripExpenseRequest(expense, req); err != nil {
+		return nil, err
+	}
+	for _, raw := range req.ReceiptFileIds {
+		fileID, err := parseUUID("receipt_file_ids", raw)
+		if err != nil {
+			return nil, err
+		}
+		expense.ReceiptFileIDs = append(expense.ReceiptFileIDs, fileID)
+	}
+
+	if err := a.tripExpenses.AddExpense(ctx, expense); err != nil {
+		return nil, tripExpenseError(err)
+	}
+	return toTripExpenseResponse(expense), nil
+}
+
+// UpdateTripExpense 修改出差报销明细（票据通过单独接口维护）
+func (a *HRMAdapter) UpdateTripExpense(ctx context.Context, req *hrmv1.TripExpenseRequest) (*hrmv1.TripExpenseResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	expense := &model.TripExpense{ID: id, TenantID: tenantID}
+	if err := applyTripExpenseRequest(expense, req); err != nil {
+		return nil, err
+	}
+
+	if err := a.tripExpenses.UpdateExpense(ctx, expense); err != nil {
+		return nil, tripExpenseError(err)
+	}
+	return toTripExpenseResponse(expense), nil
+}
+
+// DeleteTripExpense 删除出差报销明细，同时取消票据关联
+func (a *HRMAdapter) DeleteTripExpense(ctx context.Context, req *hrmv1.DeleteTripExpenseRequest) (*emptypb.Empty, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.tripExpenses.DeleteExpense(ctx, tenantID, id); err != nil {
+		return nil, tripExpenseError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// AttachTripExpenseReceipt 为报销明细关联票据文件
+func (a *HRMAdapter) AttachTripExpenseReceipt(ctx context.Context, req *hrmv1.TripExpenseReceiptRequest) (*emptypb.Empty, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	fileID, err := parseUUID("file_id", req.FileId)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.tripExpenses.AttachReceipt(ctx, tenantID, id, fileID, userID); err != nil {
+		return nil, tripExpenseError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// DetachTripExpenseReceipt 取消报销明细的票据关联（文件本身不删除）
+func (a *HRMAdapter) DetachTripExpenseReceipt(ctx context.Context, req *hrmv1.TripExpenseReceiptRequest) (*emptypb.Empty, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	fileID, err := parseUUID("file_id", req.FileId)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.tripExpenses.DetachReceipt(ctx, tenantID, id, fileID); err != nil {
+		return nil, tripExpenseError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetTripExpenseClaim 获取出差报销单（含明细、超标标记和票据）
+func (a *HRMAdapter) GetTripExpenseClaim(ctx context.Context, req *hrmv1.TripIDRequest) (*hrmv1.TripExpenseClaimResponse, error) {
+	tripID, err := parseUUID("trip_id", req.TripId)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claim, err := a.tripExpenses.GetClaim(ctx, tenantID, tripID)
+	if err != nil {
+		return nil, tripExpenseError(err)
+	}
+	return toTripExpenseClaimResponse(claim), nil
+}
+
+// SubmitTripExpenseClaim 提交出差报销单审批
+func (a *HRMAdapter) SubmitTripExpenseClaim(ctx context.Context, req *hrmv1.TripIDRequest) (*hrmv1.TripExpenseClaimResponse, error) {
+	tripID, err := parseUUID("trip_id", req.TripId)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claim, err := a.tripExpenses.SubmitClaim(ctx, tenantID, tripID, userID)
+	if err != nil {
+		return nil, tripExpenseError(err)
+	}
+	return toTripExpenseClaimResponse(claim), nil
+}
+
+// ResolveTripExpensePolicy 查询出差适用的差旅标准
+func (a *HRMAdapter) ResolveTripExpensePolicy(ctx context.Context, req *hrmv1.TripIDRequest) (*hrmv1.ResolveTripExpensePolicyResponse, error) {
+	tripID, err := parseUUID("trip_id", req.TripId)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, tier, err := a.tripExpenses.ResolvePolicy(ctx, tenantID, tripID)
+	if err != nil {
+		return nil, tripExpenseError(err)
+	}
+
+	resp := &hrmv1.ResolveTripExpensePolicyResponse{DestinationTier: tier}
+	if policy != nil {
+		resp.Policy = toTripExpensePolicyResponse(policy)
+	}
+	return resp, nil
+}
+
+// CreateTripExpensePolicy 创建差旅标准
+func (a *HRMAdapter) CreateTripExpensePolicy(ctx context.Context, req *hrmv1.TripExpensePolicyRequest) (*hrmv1.TripExpensePolicyResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &model.TripExpensePolicy{
+		TenantID:  tenantID,
+		IsActive:  true,
+		CreatedBy: userID,
+	}
+	applyTripExpensePolicyRequest(policy, req, userID)
+
+	if err := a.tripExpenses.CreatePolicy(ctx, policy); err != nil {
+		return nil, tripExpenseError(err)
+	}
+	return toTripExpensePolicyResponse(policy), nil
+}
+
+// UpdateTripExpensePolicy 更新差旅标准（只影响之后变动的报销明细，已提交的报销单不重算）
+func (a *HRMAdapter) UpdateTripExpensePolicy(ctx context.Context, req *hrmv1.TripExpensePolicyRequest) (*hrmv1.TripExpensePolicyResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := a.tripExpenses.GetPolicy(ctx, tenantID, id)
+	if err != nil {
+		return nil, tripExpenseError(err)
+	}
+	applyTripExpensePolicyRequest(policy, req, userID)
+
+	if err := a.tripExpenses.UpdatePolicy(ctx, policy); err != nil {
+		return nil, tripExpenseError(err)
+	}
+	return toTripExpensePolicyResponse(policy), nil
+}
+
+// DeleteTripExpensePolicy 删除差旅标准
+func (a *HRMAdapter) DeleteTripExpensePolicy(ctx context.Context, req *hrmv1.DeleteTripExpensePolicyRequest) (*emptypb.Empty, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.tripExpenses.DeletePolicy(ctx, tenantID, id); err != nil {
+		return nil, tripExpenseError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetTripExpensePolicy 获取差旅标准
+func (a *HRMAdapter) GetTripExpensePolicy(ctx context.Context, req *hrmv1.GetTripExpensePolicyRequest) (*hrmv1.TripExpensePolicyResponse, error) {
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := a.tripExpenses.GetPolicy(ctx, tenantID, id)
+	if err != nil {
+		return nil, tripExpenseError(err)
+	}
+	return toTripExpensePolicyResponse(policy), nil
+}
+
+// ListTripExpensePolicies 租户差旅标准列表
+func (a *HRMAdapter) ListTripExpensePolicies(ctx context.Context, _ *hrmv1.ListTripExpensePoliciesRequest) (*hrmv1.ListTripExpensePoliciesResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := a.tripExpenses.ListPolicies(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrmv1.TripExpensePolicyResponse, 0, len(policies))
+	for _, policy := range policies {
+		items = append(items, toTripExpensePolicyResponse(policy))
+	}
+	return &hrmv1.ListTripExpensePoliciesResponse{Items: items}, nil
+}
+
+// ListTripDestinationTiers 目的地级别列表
+func (a *HRMAdapter) ListTripDestinationTiers(ctx context.Context, _ *hrmv1.ListTripDestinationTiersRequest) (*hrmv1.TripDestinationTiersResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tiers, err := a.tripExpenses.ListDestinationTiers(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return toTripDestinationTiersResponse(tiers), nil
+}
+
+// SetTripDestinationTiers 整体替换目的地级别（未匹配任何关键字的目的地按 default 级别）
+func (a *HRMAdapter) SetTripDestinationTiers(ctx context.Context, req *hrmv1.SetTripDestinationTiersRequest) (*hrmv1.TripDestinationTiersResponse, error) {
+	tenantID, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	input := make([]*model.TripDestinationTier, 0, len(req.Tiers))
+	for _, tier := range req.Tiers {
+		input = append(input, &model.TripDestinationTier{Destination: tier.Destination, Tier: tier.Tier})
+	}
+
+	tiers, err := a.tripExpenses.SetDestinationTiers(ctx, tenantID, input)
+	if err != nil {
+		return nil, tripExpenseError(err)
+	}
+	return toTripDestinationTiersResponse(tiers), nil
+}
+
+// applyTripExpenseRequest 将请求字段写入报销明细
+func applyTripExpenseRequest(expense *model.TripExpense, req *hrmv1.TripExpenseRequest) error {
+	date, err := parseDate("expense_date", req.ExpenseDate)
+	if err != nil {
+		return err
+	}
+
+	expense.Category = model.TripExpenseCategory(req.Category)
+	expense.Amount = req.Amount
+	expense.Currency = req.Currency
+	expense.ExpenseDate = date
+	expense.Nights = int(req.Nights)
+	expense.Description = req.Description
+	expense.OverPolicyNote = req.OverPolicyNote
+	return nil
+}
+
+// applyTripExpensePolicyRequest 将请求字段写入差旅标准
+func applyTripExpensePolicyRequest(policy *model.TripExpensePolicy, req *hrmv1.TripExpensePolicyRequest, userID uuid.UUID) {
+	policy.Name = req.Name
+	policy.DestinationTier = req.DestinationTier
+	policy.MinPositionLevel = int(req.MinPositionLevel)
+	policy.MaxPositionLevel = int(req.MaxPositionLevel)
+	policy.PerDiemCap = req.PerDiemCap
+	policy.HotelCapPerNight = req.HotelCapPerNight
+	policy.Currency = req.Currency
+	if req.IsActive != nil {
+		policy.IsActive = *req.IsActive
+	}
+	policy.UpdatedBy = userID
+}
+
+// tripExpenseError 出差报销业务错误转换为 HTTP 错误
+func tripExpenseError(err error) error {
+	switch {
+	case errors.Is(err, hrmService.ErrTripNotFound),
+		errors.Is(err, hrmService.ErrTripExpenseNotFound),
+		errors.Is(err, hrmService.ErrTripExpensePolicyNotFound):
+		return errors.NotFound("NOT_FOUND", err.Error())
+	case errors.Is(err, hrmService.ErrTripNotApproved),
+		errors.Is(err, hrmService.ErrTripExpenseClaimLocked),
+		errors.Is(err, hrmService.ErrTripExpenseClaimNotApproved),
+		errors.Is(err, hrmService.ErrTripExpenseProcessNotDefined):
+		return errors.Conflict("FAILED_PRECONDITION", err.Error())
+	case errors.Is(err, hrmService.ErrTripExpenseInvalid),
+		errors.Is(err, hrmService.ErrTripExpenseDateOutOfRange),
+		errors.Is(err, hrmService.ErrTripExpenseCurrencyMismatch),
+		errors.Is(err, hrmService.ErrTripExpenseClaimEmpty),
+		errors.Is(err, hrmService.ErrTripExpenseReceiptRequired),
+		errors.Is(err, hrmService.ErrTripExpenseNoteRequired),
+		errors.Is(err, hrmService.ErrTripExpensePolicyInvalid),
+		errors.Is(err, hrmService.ErrTripDestinationTierDuplicated):
+		return errors.BadRequest("INVALID_ARGUMENT", err.Error())
+	}
+	return err
+}
+
+// ========== 辅助转换函数 ==========
+
+func toTripExpenseResponse(expense *model.TripExpense) *hrmv1.TripExpenseResponse {
+	resp := &hrmv1.TripExpenseResponse{
+		Id:               expense.ID.String(),
+		TenantId:         expense.TenantID.String(),
+		TripId:           expense.TripID.String(),
+		Category:         string(expense.Category),
+		Amount:           expense.Amount,
+		Currency:         expense.Currency,
+		ExpenseDate:      expense.ExpenseDate.Format("2006-01-02"),
+		Nights:           int32(expense.Nights),
+		Description:      expense.Description,
+		ReceiptFileIds:   make([]string, 0, len(expense.ReceiptFileIDs)),
+		PolicyCap:        expense.PolicyCap,
+		OverPolicy:       expense.OverPolicy,
+		OverPolicyAmount: expense.OverPolicyAmount,
+		OverPolicyNote:   expense.OverPolicyNote,
+		CreatedBy:        expense.CreatedBy.String(),
+		CreatedAt:        timestamppb.New(expense.CreatedAt),
+		UpdatedAt:        timestamppb.New(expense.UpdatedAt),
+	}
+	for _, fileID := range expense.ReceiptFileIDs {
+		resp.ReceiptFileIds = append(resp.ReceiptFileIds, fileID.String())
+	}
+	return resp
+}
+
+func toTripExpenseClaimResponse(claim *model.TripExpenseClaim) *hrmv1.TripExpenseClaimResponse {
+	resp := &hrmv1.TripExpenseClaimResponse{
+		TripId:             claim.TripID.String(),
+		TenantId:           claim.TenantID.String(),
+		EmployeeId:         claim.EmployeeID.String(),
+		Status:             string(claim.Status),
+		Currency:           claim.Currency,
+		TotalAmount:        claim.TotalAmount,
+		OverPolicyAmount:   claim.OverPolicyAmount,
+		OverPolicyCount:    int32(claim.OverPolicyCount),
+		PolicyId:           optionalUUIDString(claim.PolicyID),
+		DestinationTier:    claim.DestinationTier,
+		ApprovalInstanceId: optionalUUIDString(claim.ApprovalInstanceID),
+		SubmittedBy:        optionalUUIDString(claim.SubmittedBy),
+		SubmittedAt:        optionalTimestamp(claim.SubmittedAt),
+		DecidedAt:          optionalTimestamp(claim.DecidedAt),
+		Items:              make([]*hrmv1.TripExpenseResponse, 0, len(claim.Items)),
+		CreatedAt:          timestamppb.New(claim.CreatedAt),
+		UpdatedAt:          timestamppb.New(claim.UpdatedAt),
+	}
+	for _, expense := range claim.Items {
+		resp.Items = append(resp.Items, toTripExpenseResponse(expense))
+	}
+	return resp
+}
+
+func toTripExpensePolicyResponse(policy *model.TripExpensePolicy) *hrmv1.TripExpensePolicyResponse {
+	return &hrmv1.TripExpensePolicyResponse{
+		Id:               policy.ID.String(),
+		TenantId:         policy.TenantID.String(),
+		Name:             policy.Name,
+		DestinationTier:  policy.DestinationTier,
+		MinPositionLevel: int32(policy.MinPositionLevel),
+		MaxPositionLevel: int32(policy.MaxPositionLevel),
+		PerDiemCap:       policy.PerDiemCap,
+		HotelCapPerNight: policy.HotelCapPerNight,
+		Currency:         policy.Currency,
+		IsActive:         policy.IsActive,
+		CreatedBy:        policy.CreatedBy.String(),
+		UpdatedBy:        policy.UpdatedBy.String(),
+		CreatedAt:        timestamppb.New(policy.CreatedAt),
+		UpdatedAt:        timestamppb.New(policy.UpdatedAt),
+	}
+}
+
+func toTripDestinationTiersResponse(tiers []*model.TripDestinationTier) *hrmv1.TripDestinationTiersResponse {
+	items := make([]*hrmv1.TripDestinationTier, 0, len(tiers))
+	for _, tier := range tiers {
+		items = append(items, &hrmv1.TripDestinationTier{
+			Id:          tier.ID.String(),
+			Destination: tier.Destination,
+			Tier:        tier.Tier,
+			CreatedAt:   timestamppb.New(tier.CreatedAt),
+		})
+	}
+	return &hrmv1.TripDestinationTiersResponse{Items: items}
+}
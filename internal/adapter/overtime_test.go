@@ -97,12 +97,20 @@ func (m *MockOvertimeService) UseCompOffDays(ctx context.Context, tenantID, empl
 	return args.Error(0)
 }
 
+func (m *MockOvertimeService) DetectFromClockOut(ctx context.Context, record *model.AttendanceRecord) (*model.Overtime, error) {
+	args := m.Called(ctx, record)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Overtime), args.Error(1)
+}
+
 // TestOvertimeAdapter_CreateOvertime 测试创建加班申请
 func TestOvertimeAdapter_CreateOvertime(t *testing.T) {
 	t.Run("创建成功", func(t *testing.T) {
 		mockService := new(MockOvertimeService)
 		overtimeHandler := handler.NewOvertimeHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		tenantID := uuid.New()
 		employeeID := uuid.New()
@@ -135,7 +143,7 @@ func TestOvertimeAdapter_ApproveOvertime(t *testing.T) {
 	t.Run("批准成功", func(t *testing.T) {
 		mockService := new(MockOvertimeService)
 		overtimeHandler := handler.NewOvertimeHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		overtimeID := uuid.New()
 		approverID := uuid.New()
@@ -160,7 +168,7 @@ func TestOvertimeAdapter_UseCompOffDays(t *testing.T) {
 	t.Run("使用调休成功", func(t *testing.T) {
 		mockService := new(MockOvertimeService)
 		overtimeHandler := handler.NewOvertimeHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		tenantID := uuid.New()
 		employeeID := uuid.New()
@@ -188,7 +196,7 @@ func TestOvertimeAdapter_ListOvertimes(t *testing.T) {
 	t.Run("列表查询成功", func(t *testing.T) {
 		mockService := new(MockOvertimeService)
 		overtimeHandler := handler.NewOvertimeHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		tenantID := uuid.New()
 		overtimes := []*model.Overtime{
@@ -226,7 +234,7 @@ func TestOvertimeAdapter_GetOvertime(t *testing.T) {
 	t.Run("获取详情成功", func(t *testing.T) {
 		mockService := new(MockOvertimeService)
 		overtimeHandler := handler.NewOvertimeHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		overtimeID := uuid.New()
 		overtime := &model.Overtime{
@@ -261,7 +269,7 @@ func TestOvertimeAdapter_RejectOvertime(t *testing.T) {
 	t.Run("拒绝成功", func(t *testing.T) {
 		mockService := new(MockOvertimeService)
 		overtimeHandler := handler.NewOvertimeHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		overtimeID := uuid.New()
 		approverID := uuid.New()
@@ -287,7 +295,7 @@ func TestOvertimeAdapter_Security_InvalidUUID(t *testing.T) {
 	t.Run("CreateOvertime_无效TenantID", func(t *testing.T) {
 		mockService := new(MockOvertimeService)
 		overtimeHandler := handler.NewOvertimeHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		req := &hrmv1.CreateOvertimeRequest{
 			TenantId:   "invalid-uuid",
@@ -304,7 +312,7 @@ func TestOvertimeAdapter_Security_InvalidUUID(t *testing.T) {
 	t.Run("GetOvertime_无效ID", func(t *testing.T) {
 		mockService := new(MockOvertimeService)
 		overtimeHandler := handler.NewOvertimeHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		req := &hrmv1.GetOvertimeRequest{
 			Id: "not-a-uuid",
@@ -322,7 +330,7 @@ func TestOvertimeAdapter_Security_BoundaryValues(t *testing.T) {
 	t.Run("UseCompOffDays_负数天数", func(t *testing.T) {
 		mockService := new(MockOvertimeService)
 		overtimeHandler := handler.NewOvertimeHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		tenantID := uuid.New()
 		employeeID := uuid.New()
@@ -348,7 +356,7 @@ func TestOvertimeAdapter_UpdateOvertime(t *testing.T) {
 	t.Run("更新成功", func(t *testing.T) {
 		mockService := new(MockOvertimeService)
 		overtimeHandler := handler.NewOvertimeHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		overtimeID := uuid.New()
 		existingOvertime := &model.Overtime{
@@ -382,7 +390,7 @@ func TestOvertimeAdapter_UpdateOvertime(t *testing.T) {
 	t.Run("更新失败_无效ID", func(t *testing.T) {
 		mockService := new(MockOvertimeService)
 		overtimeHandler := handler.NewOvertimeHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		req := &hrmv1.UpdateOvertimeRequest{
 			Id:       "invalid-uuid",
@@ -401,7 +409,7 @@ func TestOvertimeAdapter_DeleteOvertime(t *testing.T) {
 	t.Run("删除成功", func(t *testing.T) {
 		mockService := new(MockOvertimeService)
 		overtimeHandler := handler.NewOvertimeHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		overtimeID := uuid.New()
 
@@ -422,7 +430,7 @@ func TestOvertimeAdapter_DeleteOvertime(t *testing.T) {
 	t.Run("删除失败_无效ID", func(t *testing.T) {
 		mockService := new(MockOvertimeService)
 		overtimeHandler := handler.NewOvertimeHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		req := &hrmv1.DeleteOvertimeRequest{
 			Id: "invalid-uuid",
@@ -440,7 +448,7 @@ func TestOvertimeAdapter_ListEmployeeOvertimes(t *testing.T) {
 	t.Run("查询成功", func(t *testing.T) {
 		mockService := new(MockOvertimeService)
 		overtimeHandler := handler.NewOvertimeHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		tenantID := uuid.New()
 		employeeID := uuid.New()
@@ -471,7 +479,7 @@ func TestOvertimeAdapter_ListPendingOvertimes(t *testing.T) {
 	t.Run("查询成功", func(t *testing.T) {
 		mockService := new(MockOvertimeService)
 		overtimeHandler := handler.NewOvertimeHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		tenantID := uuid.New()
 		pendingOvertimes := []*model.Overtime{
@@ -498,7 +506,7 @@ func TestOvertimeAdapter_SubmitOvertime(t *testing.T) {
 	t.Run("提交成功", func(t *testing.T) {
 		mockService := new(MockOvertimeService)
 		overtimeHandler := handler.NewOvertimeHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		overtimeID := uuid.New()
 		submitterID := uuid.New()
@@ -520,7 +528,7 @@ func TestOvertimeAdapter_SubmitOvertime(t *testing.T) {
 	t.Run("提交失败_无效OvertimeID", func(t *testing.T) {
 		mockService := new(MockOvertimeService)
 		overtimeHandler := handler.NewOvertimeHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		req := &hrmv1.SubmitOvertimeRequest{
 			OvertimeId:  "invalid",
@@ -540,7 +548,7 @@ func TestOvertimeAdapter_SumOvertimeHours(t *testing.T) {
 	t.Run("统计成功", func(t *testing.T) {
 		mockService := new(MockOvertimeService)
 		overtimeHandler := handler.NewOvertimeHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		tenantID := uuid.New()
 		employeeID := uuid.New()
@@ -569,7 +577,7 @@ func TestOvertimeAdapter_GetCompOffDays(t *testing.T) {
 	t.Run("统计成功", func(t *testing.T) {
 		mockService := new(MockOvertimeService)
 		overtimeHandler := handler.NewOvertimeHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, overtimeHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		tenantID := uuid.New()
 		employeeID := uuid.New()
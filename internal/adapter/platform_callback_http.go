@@ -0,0 +1,89 @@
+package adapter
+
+import (
+	"errors"
+	"io"
+	stdhttp "net/http"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/integration"
+	hrmService "github.com/lk2023060901/go-next-erp/internal/hrm/service"
+)
+
+// maxCallbackBytes 平台事件回调请求体上限
+const maxCallbackBytes = 1 << 20
+
+// PlatformCallbackHTTPAdapter 钉钉、企业微信、飞书事件回调接入点。
+// 平台按签名鉴权、不携带 JWT，各平台应答格式不同，因此直接注册为原生 HTTP 处理器。
+// 回调地址形如 /callbacks/hrm/platform?integration_id=<集成ID>
+type PlatformCallbackHTTPAdapter struct {
+	platformSync hrmService.PlatformSyncService
+}
+
+// NewPlatformCallbackHTTPAdapter 创建平台事件回调适配器
+func NewPlatformCallbackHTTPAdapter(platformSync hrmService.PlatformSyncService) *PlatformCallbackHTTPAdapter {
+	return &PlatformCallbackHTTPAdapter{platformSync: platformSync}
+}
+
+// RegisterRoutes 注册回调路由
+func (a *PlatformCallbackHTTPAdapter) RegisterRoutes(srv *http.Server) {
+	srv.HandleFunc("/callbacks/hrm/platform", a.Callback)
+}
+
+// Callback GET 为企业微信回调地址验证，POST 为事件推送
+func (a *PlatformCallbackHTTPAdapter) Callback(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	if r.Method != stdhttp.MethodGet && r.Method != stdhttp.MethodPost {
+		stdhttp.Error(w, "method not allowed", stdhttp.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	integrationID, err := uuid.Parse(query.Get("integration_id"))
+	if err != nil {
+		stdhttp.Error(w, "invalid integration_id", stdhttp.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxCallbackBytes))
+	if err != nil {
+		stdhttp.Error(w, err.Error(), stdhttp.StatusBadRequest)
+		return
+	}
+
+	result, err := a.platformSync.HandleCallback(r.Context(), integrationID, &integration.CallbackRequest{
+		Method: r.Method,
+		Query:  query,
+		Header: r.Header,
+		Body:   body,
+	})
+	if err != nil {
+		writeCallbackError(w, err)
+		return
+	}
+
+	contentType := result.ContentType
+	if contentType == "" {
+		contentType = "text/plain; charset=utf-8"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(stdhttp.StatusOK)
+	_, _ = w.Write(result.Reply)
+}
+
+// writeCallbackError 签名或报文错误返回 4xx，平台不会重试；其余错误返回 5xx 由平台重推
+func writeCallbackError(w stdhttp.ResponseWriter, err error) {
+	status := stdhttp.StatusInternalServerError
+	switch {
+	case errors.Is(err, hrmService.ErrIntegrationNotFound):
+		status = stdhttp.StatusNotFound
+	case errors.Is(err, hrmService.ErrIntegrationInactive),
+		errors.Is(err, hrmService.ErrPlatformNotSupported):
+		status = stdhttp.StatusForbidden
+	case errors.Is(err, integration.ErrInvalidSignature):
+		status = stdhttp.StatusUnauthorized
+	case errors.Is(err, integration.ErrInvalidCallbackMessage):
+		status = stdhttp.StatusBadRequest
+	}
+	stdhttp.Error(w, err.Error(), status)
+}
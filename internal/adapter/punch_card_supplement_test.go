@@ -107,12 +107,25 @@ func (m *MockPunchCardSupplementService) CountByEmployee(ctx context.Context, te
 	return args.Int(0), args.Error(1)
 }
 
+func (m *MockPunchCardSupplementService) GetPolicy(ctx context.Context, tenantID uuid.UUID) (*model.PunchCardSupplementPolicy, error) {
+	args := m.Called(ctx, tenantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.PunchCardSupplementPolicy), args.Error(1)
+}
+
+func (m *MockPunchCardSupplementService) SavePolicy(ctx context.Context, policy *model.PunchCardSupplementPolicy) error {
+	args := m.Called(ctx, policy)
+	return args.Error(0)
+}
+
 // TestPunchCardSupplementAdapter_CreatePunchCardSupplement 测试创建补卡申请
 func TestPunchCardSupplementAdapter_CreatePunchCardSupplement(t *testing.T) {
 	t.Run("创建成功", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		tenantID := uuid.New()
 		employeeID := uuid.New()
@@ -156,7 +169,7 @@ func TestPunchCardSupplementAdapter_CreatePunchCardSupplement(t *testing.T) {
 	t.Run("创建失败_无效TenantID", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		req := &hrmv1.CreatePunchCardSupplementRequest{
 			TenantId:   "invalid-uuid",
@@ -176,7 +189,7 @@ func TestPunchCardSupplementAdapter_UpdatePunchCardSupplement(t *testing.T) {
 	t.Run("更新成功", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		supplementID := uuid.New()
 		existingSupplement := &model.PunchCardSupplement{
@@ -209,7 +222,7 @@ func TestPunchCardSupplementAdapter_UpdatePunchCardSupplement(t *testing.T) {
 	t.Run("更新失败_无效ID", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		req := &hrmv1.UpdatePunchCardSupplementRequest{
 			Id: "invalid-uuid",
@@ -227,7 +240,7 @@ func TestPunchCardSupplementAdapter_DeletePunchCardSupplement(t *testing.T) {
 	t.Run("删除成功", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		supplementID := uuid.New()
 
@@ -247,7 +260,7 @@ func TestPunchCardSupplementAdapter_DeletePunchCardSupplement(t *testing.T) {
 	t.Run("删除失败_服务错误", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		supplementID := uuid.New()
 
@@ -267,7 +280,7 @@ func TestPunchCardSupplementAdapter_DeletePunchCardSupplement(t *testing.T) {
 	t.Run("删除失败_无效ID", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		req := &hrmv1.DeletePunchCardSupplementRequest{
 			Id: "invalid-uuid",
@@ -286,7 +299,7 @@ func TestPunchCardSupplementAdapter_GetPunchCardSupplement(t *testing.T) {
 	t.Run("获取成功", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		supplementID := uuid.New()
 		supplement := &model.PunchCardSupplement{
@@ -317,7 +330,7 @@ func TestPunchCardSupplementAdapter_GetPunchCardSupplement(t *testing.T) {
 	t.Run("获取失败_无效ID", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		req := &hrmv1.GetPunchCardSupplementRequest{
 			Id: "invalid-uuid",
@@ -336,7 +349,7 @@ func TestPunchCardSupplementAdapter_ListPunchCardSupplements(t *testing.T) {
 	t.Run("列表查询成功", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		tenantID := uuid.New()
 		supplements := []*model.PunchCardSupplement{
@@ -371,7 +384,7 @@ func TestPunchCardSupplementAdapter_ListPunchCardSupplements(t *testing.T) {
 	t.Run("无效TenantID", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		req := &hrmv1.ListPunchCardSupplementsRequest{
 			TenantId: "invalid-uuid",
@@ -387,7 +400,7 @@ func TestPunchCardSupplementAdapter_ListPunchCardSupplements(t *testing.T) {
 	t.Run("无效EmployeeID", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		req := &hrmv1.ListPunchCardSupplementsRequest{
 			TenantId:   uuid.New().String(),
@@ -404,7 +417,7 @@ func TestPunchCardSupplementAdapter_ListPunchCardSupplements(t *testing.T) {
 	t.Run("无效DepartmentID", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		req := &hrmv1.ListPunchCardSupplementsRequest{
 			TenantId:     uuid.New().String(),
@@ -421,7 +434,7 @@ func TestPunchCardSupplementAdapter_ListPunchCardSupplements(t *testing.T) {
 	t.Run("无效StartDate", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		req := &hrmv1.ListPunchCardSupplementsRequest{
 			TenantId:  uuid.New().String(),
@@ -445,7 +458,7 @@ func TestPunchCardSupplementAdapter_ApprovePunchCardSupplement(t *testing.T) {
 	t.Run("批准成功", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		supplementID := uuid.New()
 		approverID := uuid.New()
@@ -471,7 +484,7 @@ func TestPunchCardSupplementAdapter_RejectPunchCardSupplement(t *testing.T) {
 	t.Run("拒绝成功", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		supplementID := uuid.New()
 		approverID := uuid.New()
@@ -497,7 +510,7 @@ func TestPunchCardSupplementAdapter_ListPendingPunchCardSupplements(t *testing.T
 	t.Run("查询成功", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		tenantID := uuid.New()
 		pendingSupplements := []*model.PunchCardSupplement{
@@ -526,7 +539,7 @@ func TestPunchCardSupplementAdapter_ListEmployeePunchCardSupplements(t *testing.
 	t.Run("查询成功", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		tenantID := uuid.New()
 		employeeID := uuid.New()
@@ -562,7 +575,7 @@ func TestPunchCardSupplementAdapter_ListEmployeePunchCardSupplements(t *testing.
 	t.Run("无效TenantID", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		req := &hrmv1.ListEmployeePunchCardSupplementsRequest{
 			TenantId:   "invalid-uuid",
@@ -579,7 +592,7 @@ func TestPunchCardSupplementAdapter_ListEmployeePunchCardSupplements(t *testing.
 	t.Run("无效EmployeeID", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		req := &hrmv1.ListEmployeePunchCardSupplementsRequest{
 			TenantId:   uuid.New().String(),
@@ -599,7 +612,7 @@ func TestPunchCardSupplementAdapter_SubmitPunchCardSupplement(t *testing.T) {
 	t.Run("提交成功", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		supplementID := uuid.New()
 		submitterID := uuid.New()
@@ -621,7 +634,7 @@ func TestPunchCardSupplementAdapter_SubmitPunchCardSupplement(t *testing.T) {
 	t.Run("无效SupplementID", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		req := &hrmv1.SubmitPunchCardSupplementRequest{
 			SupplementId: "invalid-uuid",
@@ -638,7 +651,7 @@ func TestPunchCardSupplementAdapter_SubmitPunchCardSupplement(t *testing.T) {
 	t.Run("无效SubmitterID", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		req := &hrmv1.SubmitPunchCardSupplementRequest{
 			SupplementId: uuid.New().String(),
@@ -658,7 +671,7 @@ func TestPunchCardSupplementAdapter_ProcessPunchCardSupplement(t *testing.T) {
 	t.Run("处理成功", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		supplementID := uuid.New()
 		processorID := uuid.New()
@@ -680,7 +693,7 @@ func TestPunchCardSupplementAdapter_ProcessPunchCardSupplement(t *testing.T) {
 	t.Run("无效SupplementID", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		req := &hrmv1.ProcessPunchCardSupplementRequest{
 			SupplementId: "invalid-uuid",
@@ -697,7 +710,7 @@ func TestPunchCardSupplementAdapter_ProcessPunchCardSupplement(t *testing.T) {
 	t.Run("无效ProcessorID", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		req := &hrmv1.ProcessPunchCardSupplementRequest{
 			SupplementId: uuid.New().String(),
@@ -717,7 +730,7 @@ func TestPunchCardSupplementAdapter_CancelPunchCardSupplement(t *testing.T) {
 	t.Run("取消成功", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		supplementID := uuid.New()
 
@@ -737,7 +750,7 @@ func TestPunchCardSupplementAdapter_CancelPunchCardSupplement(t *testing.T) {
 	t.Run("无效SupplementID", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		req := &hrmv1.CancelPunchCardSupplementRequest{
 			SupplementId: "invalid-uuid",
@@ -756,7 +769,7 @@ func TestPunchCardSupplementAdapter_Security_InvalidUUID(t *testing.T) {
 	t.Run("CreatePunchCardSupplement_无效DepartmentID", func(t *testing.T) {
 		mockService := new(MockPunchCardSupplementService)
 		supplementHandler := handler.NewPunchCardSupplementHandler(mockService)
-		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler)
+		adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, supplementHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		req := &hrmv1.CreatePunchCardSupplementRequest{
 			TenantId:     uuid.New().String(),
@@ -0,0 +1,80 @@
+package adapter
+
+import (
+	"context"
+	"net"
+	stdhttp "net/http"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/pkg/middleware"
+)
+
+var (
+	// ErrMissingTenant 上下文中缺少租户信息
+	ErrMissingTenant = errors.Unauthorized("MISSING_TENANT", "缺少租户信息")
+	// ErrMissingUser 上下文中缺少用户信息
+	ErrMissingUser = errors.Unauthorized("MISSING_USER", "缺少用户信息")
+)
+
+// parseUUID 解析请求中的 UUID 参数
+func parseUUID(field, value string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, errors.BadRequest("INVALID_ARGUMENT", "invalid "+field)
+	}
+	return id, nil
+}
+
+// parseOptionalUUID 解析可选的 UUID 参数，为空时返回 nil
+func parseOptionalUUID(field, value string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+	id, err := parseUUID(field, value)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// tenantFromContext 从认证上下文获取租户ID
+func tenantFromContext(ctx context.Context) (uuid.UUID, error) {
+	tenantID, ok := middleware.GetTenantID(ctx)
+	if !ok {
+		return uuid.Nil, ErrMissingTenant
+	}
+	return tenantID, nil
+}
+
+// userFromContext 从认证上下文获取当前用户ID
+func userFromContext(ctx context.Context) (uuid.UUID, error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		return uuid.Nil, ErrMissingUser
+	}
+	return userID, nil
+}
+
+// clientInfo 获取客户端 IP 和 User-Agent
+func clientInfo(ctx context.Context) (string, string) {
+	req, ok := http.RequestFromServerContext(ctx)
+	if !ok {
+		return "", ""
+	}
+
+	return remoteIP(req), req.UserAgent()
+}
+
+// remoteIP 获取客户端 IP（优先取代理转发的原始地址）
+func remoteIP(req *stdhttp.Request) string {
+	ip := req.RemoteAddr
+	if forwarded := req.Header.Get("X-Forwarded-For"); forwarded != "" {
+		ip = strings.TrimSpace(strings.Split(forwarded, ",")[0])
+	} else if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+	return ip
+}
@@ -0,0 +1,79 @@
+package adapter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	hrmv1 "github.com/lk2023060901/go-next-erp/api/hrm/v1"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	hrmService "github.com/lk2023060901/go-next-erp/internal/hrm/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubTimeAllocationService struct {
+	hrmService.TimeAllocationService
+	calendar *model.EmployeeCalendar
+}
+
+func (s *stubTimeAllocationService) EmployeeCalendar(ctx context.Context, tenantID, employeeID uuid.UUID, startDate, endDate time.Time) (*model.EmployeeCalendar, error) {
+	if endDate.Before(startDate) {
+		return nil, hrmService.ErrInvalidCalendarRange
+	}
+	return s.calendar, nil
+}
+
+// TestTimeAllocationAdapter_GetEmployeeCalendar 员工日历按条目返回，日期参数错误时返回 400
+func TestTimeAllocationAdapter_GetEmployeeCalendar(t *testing.T) {
+	tenantID, employeeID, leaveID := uuid.New(), uuid.New(), uuid.New()
+	start := time.Date(2026, 10, 12, 9, 0, 0, 0, time.Local)
+	svc := &stubTimeAllocationService{calendar: &model.EmployeeCalendar{
+		EmployeeID:   employeeID,
+		EmployeeName: "张三",
+		Entries: []*model.CalendarEntry{{
+			Kind:      model.TimeAllocationLeave,
+			SourceID:  leaveID,
+			Title:     "年假",
+			StartTime: start,
+			EndTime:   start.Add(8 * time.Hour),
+			Status:    "approved",
+		}},
+	}}
+	adapter := NewHRMAdapter(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, svc, nil, nil)
+	ctx := context.WithValue(context.Background(), "tenant_id", tenantID)
+
+	t.Run("返回合并日历", func(t *testing.T) {
+		resp, err := adapter.GetEmployeeCalendar(ctx, &hrmv1.GetEmployeeCalendarRequest{
+			EmployeeId: employeeID.String(),
+			StartDate:  "2026-10-12",
+			EndDate:    "2026-10-18",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, employeeID.String(), resp.EmployeeId)
+		require.Len(t, resp.Entries, 1)
+		assert.Equal(t, "leave", resp.Entries[0].Kind)
+		assert.Equal(t, leaveID.String(), resp.Entries[0].SourceId)
+		assert.True(t, resp.Entries[0].StartTime.AsTime().Equal(start))
+	})
+
+	t.Run("日期格式错误", func(t *testing.T) {
+		_, err := adapter.GetEmployeeCalendar(ctx, &hrmv1.GetEmployeeCalendarRequest{
+			EmployeeId: employeeID.String(),
+			StartDate:  "2026/10/12",
+			EndDate:    "2026-10-18",
+		})
+		assert.True(t, errors.IsBadRequest(err))
+	})
+
+	t.Run("结束日期早于开始日期", func(t *testing.T) {
+		_, err := adapter.GetEmployeeCalendar(ctx, &hrmv1.GetEmployeeCalendarRequest{
+			EmployeeId: employeeID.String(),
+			StartDate:  "2026-10-18",
+			EndDate:    "2026-10-12",
+		})
+		assert.True(t, errors.IsBadRequest(err))
+	})
+}
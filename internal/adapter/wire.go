@@ -11,6 +11,8 @@ var ProviderSet = wire.NewSet(
 	NewOrganizationAdapter,
 	NewNotificationAdapter,
 	NewApprovalAdapter,
+	NewZKTecoHTTPAdapter,
+	NewPlatformCallbackHTTPAdapter,
 	NewFileAdapter,
 	NewHRMAdapter,
 )
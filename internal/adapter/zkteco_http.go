@@ -0,0 +1,134 @@
+package adapter
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	stdhttp "net/http"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/integration/zkteco"
+	hrmService "github.com/lk2023060901/go-next-erp/internal/hrm/service"
+)
+
+// maxDeviceUploadBytes 单次上传请求体上限
+const maxDeviceUploadBytes = 4 << 20
+
+// ZKTecoHTTPAdapter ZKTeco 推送协议（ADMS / iClock）接入点。
+// 设备不携带 JWT，按序列号识别、按通讯密钥（服务器地址中的 key 参数）或登记的来源地址认证，
+// 应答为纯文本，因此直接注册为原生 HTTP 处理器
+type ZKTecoHTTPAdapter struct {
+	pushService hrmService.DevicePushService
+}
+
+// NewZKTecoHTTPAdapter 创建 ZKTeco 推送协议适配器
+func NewZKTecoHTTPAdapter(pushService hrmService.DevicePushService) *ZKTecoHTTPAdapter {
+	return &ZKTecoHTTPAdapter{pushService: pushService}
+}
+
+// RegisterRoutes 注册设备接入路由
+func (a *ZKTecoHTTPAdapter) RegisterRoutes(srv *http.Server) {
+	srv.HandleFunc("/iclock/cdata", a.CData)
+	srv.HandleFunc("/iclock/getrequest", a.GetRequest)
+	srv.HandleFunc("/iclock/devicecmd", a.DeviceCmd)
+}
+
+// CData GET 为握手，POST 为上传数据表（ATTLOG 等）
+func (a *ZKTecoHTTPAdapter) CData(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	query := r.URL.Query()
+	creds := deviceCredentials(r)
+
+	switch r.Method {
+	case stdhttp.MethodGet:
+		reply, err := a.pushService.Handshake(r.Context(), creds)
+		if err != nil {
+			writeDeviceError(w, err)
+			return
+		}
+		writeDeviceReply(w, reply)
+
+	case stdhttp.MethodPost:
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxDeviceUploadBytes))
+		if err != nil {
+			stdhttp.Error(w, err.Error(), stdhttp.StatusBadRequest)
+			return
+		}
+		result, err := a.pushService.Upload(r.Context(), creds, query.Get("table"), query.Get("Stamp"), string(body))
+		if err != nil {
+			writeDeviceError(w, err)
+			return
+		}
+		writeDeviceReply(w, fmt.Sprintf("OK: %d", result.Lines))
+
+	default:
+		stdhttp.Error(w, "method not allowed", stdhttp.StatusMethodNotAllowed)
+	}
+}
+
+// GetRequest 设备心跳，返回待执行命令
+func (a *ZKTecoHTTPAdapter) GetRequest(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	reply, err := a.pushService.Poll(r.Context(), deviceCredentials(r), r.URL.Query().Get("INFO"))
+	if err != nil {
+		writeDeviceError(w, err)
+		return
+	}
+	writeDeviceReply(w, reply)
+}
+
+// DeviceCmd 命令执行回执
+func (a *ZKTecoHTTPAdapter) DeviceCmd(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	if r.Method != stdhttp.MethodPost {
+		stdhttp.Error(w, "method not allowed", stdhttp.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxDeviceUploadBytes))
+	if err != nil {
+		stdhttp.Error(w, err.Error(), stdhttp.StatusBadRequest)
+		return
+	}
+	if _, err := a.pushService.ReportCommands(r.Context(), deviceCredentials(r), string(body)); err != nil {
+		writeDeviceError(w, err)
+		return
+	}
+	writeDeviceReply(w, "OK")
+}
+
+// deviceCredentials 提取设备身份：来源地址取 TCP 连接地址，X-Forwarded-For 可被伪造，不参与认证
+func deviceCredentials(r *stdhttp.Request) *hrmService.DeviceCredentials {
+	query := r.URL.Query()
+	ip := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+	return &hrmService.DeviceCredentials{
+		SN:       query.Get("SN"),
+		CommKey:  query.Get("key"),
+		RemoteIP: ip,
+	}
+}
+
+func writeDeviceReply(w stdhttp.ResponseWriter, reply string) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(stdhttp.StatusOK)
+	_, _ = io.WriteString(w, reply)
+}
+
+// writeDeviceError 未登记或已停用的设备返回 4xx，设备按 ErrorDelay 间隔重试
+func writeDeviceError(w stdhttp.ResponseWriter, err error) {
+	status := stdhttp.StatusInternalServerError
+	switch {
+	case errors.Is(err, hrmService.ErrDeviceNotRegistered):
+		status = stdhttp.StatusNotFound
+	case errors.Is(err, hrmService.ErrDeviceAuthFailed):
+		status = stdhttp.StatusUnauthorized
+	case errors.Is(err, hrmService.ErrDeviceDisabled):
+		status = stdhttp.StatusForbidden
+	case errors.Is(err, hrmService.ErrDeviceSNExists):
+		status = stdhttp.StatusConflict
+	case errors.Is(err, zkteco.ErrInvalidReply):
+		status = stdhttp.StatusBadRequest
+	}
+	stdhttp.Error(w, err.Error(), status)
+}
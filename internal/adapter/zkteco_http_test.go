@@ -0,0 +1,431 @@
+package adapter
+
+import (
+	"context"
+	"io"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	hrmService "github.com/lk2023060901/go-next-erp/internal/hrm/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 以下请求体抓取自 ZKTeco 推送固件（ADMS 2.4.1），用于模拟设备回放
+
+const capturedAttLog = "1001\t2025-03-03 08:55:12\t0\t1\t0\t0\t0\n" +
+	"1002\t2025-03-03 08:57:40\t0\t15\t0\t0\t0\n" +
+	"9999\t2025-03-03 08:58:03\t0\t1\t0\t0\t0\n" +
+	"1001\t2025-03-03 18:05:31\t1\t1\t0\t0\t0\n"
+
+const capturedOperLog = "OPLOG 4\t0\t2025-03-03 08:50:00\t0\t0\t0\t0\n"
+
+const capturedHeartbeatInfo = "Ver 6.60 Apr 28 2017,2,2,4,192.168.1.201,10,7,12,1,111"
+
+type fakePushDeviceRepo struct {
+	repository.AttendanceDeviceRepository
+	devices    []*model.AttendanceDevice
+	heartbeats int
+}
+
+func (r *fakePushDeviceRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.AttendanceDevice, error) {
+	for _, device := range r.devices {
+		if device.ID == id {
+			return device, nil
+		}
+	}
+	return nil, assert.AnError
+}
+
+func (r *fakePushDeviceRepo) ListBySN(ctx context.Context, deviceSN string) ([]*model.AttendanceDevice, error) {
+	var result []*model.AttendanceDevice
+	for _, device := range r.devices {
+		if device.DeviceSN == deviceSN {
+			result = append(result, device)
+		}
+	}
+	return result, nil
+}
+
+func (r *fakePushDeviceRepo) UpdateHeartbeat(ctx context.Context, id uuid.UUID) error {
+	device, _ := r.FindByID(ctx, id)
+	now := time.Now()
+	device.LastHeartbeat = &now
+	device.Status = model.DeviceStatusOnline
+	r.heartbeats++
+	return nil
+}
+
+func (r *fakePushDeviceRepo) UpdateConnection(ctx context.Context, id uuid.UUID, ipAddress, firmwareVersion string) error {
+	device, _ := r.FindByID(ctx, id)
+	if ipAddress != "" {
+		device.IPAddress = ipAddress
+	}
+	if firmwareVersion != "" {
+		device.FirmwareVersion = firmwareVersion
+	}
+	return nil
+}
+
+func (r *fakePushDeviceRepo) UpdateBiometricVersions(ctx context.Context, id uuid.UUID, fingerprintVersion, faceVersion string) error {
+	device, _ := r.FindByID(ctx, id)
+	if fingerprintVersion != "" {
+		device.FingerprintVersion = fingerprintVersion
+	}
+	if faceVersion != "" {
+		device.FaceVersion = faceVersion
+	}
+	return nil
+}
+
+func (r *fakePushDeviceRepo) UpdatePushStamp(ctx context.Context, id uuid.UUID, stamp string, records int) error {
+	device, _ := r.FindByID(ctx, id)
+	if stamp != "" {
+		device.PushStamp = stamp
+	}
+	device.TotalRecords += records
+	return nil
+}
+
+type fakeDeviceCommandRepo struct {
+	repository.DeviceCommandRepository
+	commands []*model.DeviceCommand
+}
+
+func (r *fakeDeviceCommandRepo) Create(ctx context.Context, command *model.DeviceCommand) error {
+	command.Seq = int64(len(r.commands) + 1)
+	r.commands = append(r.commands, command)
+	return nil
+}
+
+func (r *fakeDeviceCommandRepo) ListPending(ctx context.Context, deviceID uuid.UUID, limit int) ([]*model.DeviceCommand, error) {
+	var result []*model.DeviceCommand
+	for _, command := range r.commands {
+		if command.DeviceID == deviceID && command.Status == model.DeviceCommandPending && len(result) < limit {
+			result = append(result, command)
+		}
+	}
+	return result, nil
+}
+
+func (r *fakeDeviceCommandRepo) MarkSent(ctx context.Context, ids []uuid.UUID) error {
+	for _, command := range r.commands {
+		for _, id := range ids {
+			if command.ID == id {
+				command.Status = model.DeviceCommandSent
+			}
+		}
+	}
+	return nil
+}
+
+func (r *fakeDeviceCommandRepo) Complete(ctx context.Context, deviceID uuid.UUID, seq int64, returnCode int) (bool, error) {
+	for _, command := range r.commands {
+		if command.DeviceID == deviceID && command.Seq == seq {
+			code := returnCode
+			command.ReturnCode = &code
+			command.Status = model.DeviceCommandSucceeded
+			if returnCode < 0 {
+				command.Status = model.DeviceCommandFailed
+			}
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type fakeDeviceUserRepo struct {
+	repository.HRMEmployeeRepository
+	users []*model.DeviceUser
+}
+
+func (r *fakeDeviceUserRepo) ListDeviceUsers(ctx context.Context, tenantID uuid.UUID, employeeIDs []uuid.UUID) ([]*model.DeviceUser, error) {
+	var result []*model.DeviceUser
+	for _, user := range r.users {
+		for _, id := range employeeIDs {
+			if user.EmployeeID == id {
+				result = append(result, user)
+			}
+		}
+	}
+	return result, nil
+}
+
+func (r *fakeDeviceUserRepo) FindDeviceUsersByPIN(ctx context.Context, tenantID uuid.UUID, pins []string) (map[string]*model.DeviceUser, error) {
+	result := make(map[string]*model.DeviceUser)
+	for _, user := range r.users {
+		for _, pin := range pins {
+			if user.PIN == pin {
+				result[pin] = user
+			}
+		}
+	}
+	return result, nil
+}
+
+// fakeDeviceAttendance 考勤记录仓储，lockedBefore 之前的记录视为期间已锁定；
+// staleReads 模拟并发上传：查询不到其他请求刚写入的记录
+type fakeDeviceAttendance struct {
+	repository.AttendanceRecordRepository
+	records      []*model.AttendanceRecord
+	lockedBefore time.Time
+	staleReads   bool
+}
+
+func (f *fakeDeviceAttendance) FindBySource(ctx context.Context, tenantID uuid.UUID, sourceType model.SourceType, sourceID string, startTime, endTime time.Time) ([]*model.AttendanceRecord, error) {
+	if f.staleReads {
+		return nil, nil
+	}
+	var result []*model.AttendanceRecord
+	for _, record := range f.records {
+		if record.SourceType == sourceType && record.SourceID == sourceID &&
+			!record.ClockTime.Before(startTime) && !record.ClockTime.After(endTime) {
+			result = append(result, record)
+		}
+	}
+	return result, nil
+}
+
+// fakeDeviceImporter 考勤服务，导入的记录写入 fakeDeviceAttendance
+type fakeDeviceImporter struct {
+	hrmService.AttendanceService
+	store *fakeDeviceAttendance
+}
+
+// ImportDeviceRecord 按 (设备, 员工, 打卡时间) 去重，与数据库唯一索引一致
+func (f *fakeDeviceImporter) ImportDeviceRecord(ctx context.Context, record *model.AttendanceRecord) (bool, error) {
+	if record.ClockTime.Before(f.store.lockedBefore) {
+		return false, hrmService.ErrAttendancePeriodLocked
+	}
+	for _, existing := range f.store.records {
+		if existing.SourceID == record.SourceID && existing.EmployeeID == record.EmployeeID && existing.ClockTime.Equal(record.ClockTime) {
+			return false, nil
+		}
+	}
+	f.store.records = append(f.store.records, record)
+	return true, nil
+}
+
+type fakeZKTecoDevice struct {
+	t      *testing.T
+	server *httptest.Server
+	sn     string
+	key    string
+}
+
+func (d *fakeZKTecoDevice) do(method, path, query, body string) (int, string) {
+	req, err := stdhttp.NewRequest(method, d.server.URL+path+"?SN="+d.sn+"&key="+d.key+query, strings.NewReader(body))
+	require.NoError(d.t, err)
+	req.Header.Set("Content-Type", "text/plain")
+
+	resp, err := d.server.Client().Do(req)
+	require.NoError(d.t, err)
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(d.t, err)
+	return resp.StatusCode, string(data)
+}
+
+func TestZKTecoHTTPAdapter_PushProtocol(t *testing.T) {
+	tenantID := uuid.New()
+	operatorID := uuid.New()
+	device := &model.AttendanceDevice{
+		ID:         uuid.New(),
+		TenantID:   tenantID,
+		DeviceType: model.DeviceTypeZKTeco,
+		DeviceSN:   "CKJE201560001",
+		DeviceName: "前台考勤机",
+		SyncMode:   "push",
+		SecretKey:  "zk-comm-key",
+		IsActive:   true,
+	}
+	zhang := &model.DeviceUser{EmployeeID: uuid.New(), PIN: "1001", Name: "张三", DepartmentID: uuid.New(), CardNo: "8899"}
+	li := &model.DeviceUser{EmployeeID: uuid.New(), PIN: "1002", Name: "李四", DepartmentID: uuid.New()}
+
+	newDevice := func(t *testing.T, attendance *fakeDeviceAttendance) (*fakeZKTecoDevice, *fakePushDeviceRepo, *fakeDeviceCommandRepo, hrmService.AttendanceDeviceService) {
+		registered := *device
+		deviceRepo := &fakePushDeviceRepo{devices: []*model.AttendanceDevice{&registered}}
+		commandRepo := &fakeDeviceCommandRepo{}
+		userRepo := &fakeDeviceUserRepo{users: []*model.DeviceUser{zhang, li}}
+
+		pushService := hrmService.NewDevicePushService(deviceRepo, commandRepo, userRepo, attendance, &fakeDeviceImporter{store: attendance}, nil)
+		deviceService := hrmService.NewAttendanceDeviceService(deviceRepo, commandRepo, userRepo)
+
+		mux := stdhttp.NewServeMux()
+		adapter := NewZKTecoHTTPAdapter(pushService)
+		mux.HandleFunc("/iclock/cdata", adapter.CData)
+		mux.HandleFunc("/iclock/getrequest", adapter.GetRequest)
+		mux.HandleFunc("/iclock/devicecmd", adapter.DeviceCmd)
+		server := httptest.NewServer(mux)
+		t.Cleanup(server.Close)
+
+		return &fakeZKTecoDevice{t: t, server: server, sn: device.DeviceSN, key: device.SecretKey}, deviceRepo, commandRepo, deviceService
+	}
+
+	t.Run("handshake and attlog upload with dedup", func(t *testing.T) {
+		attendance := &fakeDeviceAttendance{}
+		zk, deviceRepo, _, _ := newDevice(t, attendance)
+
+		status, body := zk.do("GET", "/iclock/cdata", "&options=all&pushver=2.4.1&language=83", "")
+		assert.Equal(t, stdhttp.StatusOK, status)
+		assert.True(t, strings.HasPrefix(body, "GET OPTION FROM: CKJE201560001\n"))
+		assert.Contains(t, body, "ATTLOGStamp=0\n")
+
+		status, body = zk.do("POST", "/iclock/cdata", "&table=ATTLOG&Stamp=9999", capturedAttLog)
+		assert.Equal(t, stdhttp.StatusOK, status)
+		assert.Equal(t, "OK: 4", body)
+		require.Len(t, attendance.records, 3)
+
+		first := attendance.records[0]
+		assert.Equal(t, zhang.EmployeeID, first.EmployeeID)
+		assert.Equal(t, "张三", first.EmployeeName)
+		assert.Equal(t, model.ClockTypeCheckIn, first.ClockType)
+		assert.Equal(t, model.MethodFingerprint, first.CheckInMethod)
+		assert.Equal(t, model.SourceTypeDevice, first.SourceType)
+		assert.Equal(t, "CKJE201560001", first.SourceID)
+		assert.Equal(t, model.MethodFace, attendance.records[1].CheckInMethod)
+		assert.Equal(t, model.ClockTypeCheckOut, attendance.records[2].ClockType)
+
+		// 设备未收到应答时会重传同一批记录
+		status, body = zk.do("POST", "/iclock/cdata", "&table=ATTLOG&Stamp=9999", capturedAttLog)
+		assert.Equal(t, stdhttp.StatusOK, status)
+		assert.Equal(t, "OK: 4", body)
+		assert.Len(t, attendance.records, 3)
+
+		registered := deviceRepo.devices[0]
+		assert.Equal(t, "9999", registered.PushStamp)
+		assert.Equal(t, 3, registered.TotalRecords)
+
+		// 重新握手时带回已接收的上传戳，设备只续传新记录
+		_, body = zk.do("GET", "/iclock/cdata", "&options=all", "")
+		assert.Contains(t, body, "ATTLOGStamp=9999\n")
+
+		// 非考勤表直接确认
+		status, body = zk.do("POST", "/iclock/cdata", "&table=OPERLOG&Stamp=1", capturedOperLog)
+		assert.Equal(t, stdhttp.StatusOK, status)
+		assert.Equal(t, "OK: 1", body)
+		assert.Len(t, attendance.records, 3)
+	})
+
+	t.Run("concurrent retransmit is deduplicated on insert", func(t *testing.T) {
+		attendance := &fakeDeviceAttendance{}
+		zk, _, _, _ := newDevice(t, attendance)
+
+		status, _ := zk.do("POST", "/iclock/cdata", "&table=ATTLOG&Stamp=1", capturedAttLog)
+		assert.Equal(t, stdhttp.StatusOK, status)
+		require.Len(t, attendance.records, 3)
+
+		// 第二个请求查重时还看不到第一个请求写入的记录
+		attendance.staleReads = true
+		status, _ = zk.do("POST", "/iclock/cdata", "&table=ATTLOG&Stamp=1", capturedAttLog)
+		assert.Equal(t, stdhttp.StatusOK, status)
+		assert.Len(t, attendance.records, 3)
+	})
+
+	t.Run("records in locked period are skipped", func(t *testing.T) {
+		attendance := &fakeDeviceAttendance{lockedBefore: time.Date(2025, 3, 3, 12, 0, 0, 0, time.Local)}
+		zk, _, _, _ := newDevice(t, attendance)
+
+		status, body := zk.do("POST", "/iclock/cdata", "&table=ATTLOG&Stamp=1", capturedAttLog)
+		assert.Equal(t, stdhttp.StatusOK, status)
+		assert.Equal(t, "OK: 4", body)
+		require.Len(t, attendance.records, 1)
+		assert.Equal(t, model.ClockTypeCheckOut, attendance.records[0].ClockType)
+	})
+
+	t.Run("queued commands are delivered on heartbeat", func(t *testing.T) {
+		zk, deviceRepo, commandRepo, deviceService := newDevice(t, &fakeDeviceAttendance{})
+		ctx := context.Background()
+
+		queued, err := deviceService.PushEmployees(ctx, tenantID, device.ID, []uuid.UUID{zhang.EmployeeID}, operatorID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, queued)
+		_, err = deviceService.RemoveEmployees(ctx, tenantID, device.ID, []uuid.UUID{li.EmployeeID}, operatorID)
+		require.NoError(t, err)
+		require.NoError(t, deviceService.ClearRecords(ctx, tenantID, device.ID, operatorID))
+
+		status, body := zk.do("GET", "/iclock/getrequest", "&INFO="+strings.ReplaceAll(capturedHeartbeatInfo, " ", "%20"), "")
+		assert.Equal(t, stdhttp.StatusOK, status)
+		assert.Equal(t,
+			"C:1:DATA UPDATE USERINFO PIN=1001\tName=张三\tPri=0\tPasswd=\tCard=8899\tGrp=1\tTZ=0000000100000000\tVerify=0\n"+
+				"C:2:DATA DELETE USERINFO PIN=1002\n"+
+				"C:3:CLEAR LOG\n",
+			body,
+		)
+		for _, command := range commandRepo.commands {
+			assert.Equal(t, model.DeviceCommandSent, command.Status)
+		}
+
+		registered := deviceRepo.devices[0]
+		assert.Equal(t, model.DeviceStatusOnline, registered.Status)
+		assert.Equal(t, "192.168.1.201", registered.IPAddress)
+		assert.Equal(t, "Ver 6.60 Apr 28 2017", registered.FirmwareVersion)
+		assert.Equal(t, "10", registered.FingerprintVersion)
+		assert.Equal(t, "7", registered.FaceVersion)
+
+		// 已下发的命令不再重复下发
+		_, body = zk.do("GET", "/iclock/getrequest", "", "")
+		assert.Equal(t, "OK", body)
+
+		status, body = zk.do("POST", "/iclock/devicecmd", "", "ID=1&Return=0&CMD=DATA\nID=2&Return=0&CMD=DATA\nID=3&Return=-1002&CMD=CLEAR_LOG\n")
+		assert.Equal(t, stdhttp.StatusOK, status)
+		assert.Equal(t, "OK", body)
+		assert.Equal(t, model.DeviceCommandSucceeded, commandRepo.commands[0].Status)
+		assert.Equal(t, model.DeviceCommandSucceeded, commandRepo.commands[1].Status)
+		assert.Equal(t, model.DeviceCommandFailed, commandRepo.commands[2].Status)
+		assert.Equal(t, -1002, *commandRepo.commands[2].ReturnCode)
+
+		status, _ = zk.do("POST", "/iclock/devicecmd", "", "ID=x&Return=0")
+		assert.Equal(t, stdhttp.StatusBadRequest, status)
+	})
+
+	t.Run("unregistered or disabled devices are rejected", func(t *testing.T) {
+		zk, deviceRepo, _, _ := newDevice(t, &fakeDeviceAttendance{})
+
+		unknown := &fakeZKTecoDevice{t: t, server: zk.server, sn: "UNKNOWN0001"}
+		status, _ := unknown.do("GET", "/iclock/cdata", "&options=all", "")
+		assert.Equal(t, stdhttp.StatusNotFound, status)
+
+		deviceRepo.devices[0].IsActive = false
+		status, _ = zk.do("GET", "/iclock/getrequest", "", "")
+		assert.Equal(t, stdhttp.StatusForbidden, status)
+		assert.Zero(t, deviceRepo.heartbeats)
+	})
+
+	t.Run("serial number alone does not authenticate", func(t *testing.T) {
+		attendance := &fakeDeviceAttendance{}
+		zk, deviceRepo, _, _ := newDevice(t, attendance)
+
+		forged := &fakeZKTecoDevice{t: t, server: zk.server, sn: device.DeviceSN, key: "guessed"}
+		status, _ := forged.do("POST", "/iclock/cdata", "&table=ATTLOG&Stamp=1", capturedAttLog)
+		assert.Equal(t, stdhttp.StatusUnauthorized, status)
+		forged.key = ""
+		status, _ = forged.do("GET", "/iclock/getrequest", "", "")
+		assert.Equal(t, stdhttp.StatusUnauthorized, status)
+		assert.Empty(t, attendance.records)
+		assert.Zero(t, deviceRepo.heartbeats)
+
+		// 只登记来源地址的设备按连接地址认证
+		registered := deviceRepo.devices[0]
+		registered.SecretKey = ""
+		registered.PushAllowedIPs = []string{"10.0.0.0/8"}
+		status, _ = forged.do("GET", "/iclock/cdata", "&options=all", "")
+		assert.Equal(t, stdhttp.StatusUnauthorized, status)
+
+		registered.PushAllowedIPs = []string{"127.0.0.0/8"}
+		status, _ = forged.do("GET", "/iclock/cdata", "&options=all", "")
+		assert.Equal(t, stdhttp.StatusOK, status)
+
+		// 没有任何接入凭据的设备一律拒绝
+		registered.PushAllowedIPs = nil
+		status, _ = forged.do("GET", "/iclock/cdata", "&options=all", "")
+		assert.Equal(t, stdhttp.StatusUnauthorized, status)
+	})
+}
@@ -391,3 +391,205 @@ type UserWorkload struct {
 	ThisWeekTasks    int       `json:"this_week_tasks"`
 	ThisMonthTasks   int       `json:"this_month_tasks"`
 }
+
+// SearchFieldConfig 可检索字段配置
+type SearchFieldConfig struct {
+	FieldKey   string                `json:"field_key" binding:"required,max=100"`
+	FieldLabel string                `json:"field_label"`
+	FieldType  model.SearchFieldType `json:"field_type"`
+	Sort       int                   `json:"sort"`
+}
+
+// ConfigureSearchFieldsRequest 配置可检索字段请求
+type ConfigureSearchFieldsRequest struct {
+	TenantID     uuid.UUID           `json:"-"`
+	OperatorID   uuid.UUID           `json:"-"`
+	ProcessDefID uuid.UUID           `json:"-"`
+	Fields       []SearchFieldConfig `json:"fields"`
+}
+
+// SearchInstancesRequest 流程实例检索请求
+type SearchInstancesRequest struct {
+	TenantID     uuid.UUID             `json:"-"`
+	OperatorID   uuid.UUID             `json:"-"`
+	Keyword      string                `json:"keyword"`
+	ProcessDefID *uuid.UUID            `json:"process_def_id,omitempty"`
+	Status       *model.ProcessStatus  `json:"status,omitempty"`
+	ApplicantID  *uuid.UUID            `json:"applicant_id,omitempty"`
+	FieldFilters []model.FieldFilter   `json:"field_filters,omitempty"`
+	StartDate    *time.Time            `json:"start_date,omitempty"`
+	EndDate      *time.Time            `json:"end_date,omitempty"`
+	SortBy       model.SearchSortField `json:"sort_by"`
+	SortDesc     bool                  `json:"sort_desc"`
+	Cursor       string                `json:"cursor"`
+	PageSize     int                   `json:"page_size"`
+}
+
+// ExecuteActionLinkRequest 执行审批快捷链接请求
+type ExecuteActionLinkRequest struct {
+	Token     string  `json:"token"`
+	Comment   *string `json:"comment"`
+	ClientIP  string  `json:"-"`
+	UserAgent string  `json:"-"`
+}
+
+// InboundReplyRequest 入站邮件回复（邮件服务商 Webhook 推送）
+type InboundReplyRequest struct {
+	From      string `json:"from"`
+	To        string `json:"to"`
+	Subject   string `json:"subject"`
+	Text      string `json:"text"`
+	Timestamp string `json:"timestamp"`
+	Nonce     string `json:"nonce"`
+	Signature string `json:"signature"`
+}
+
+// ActionLinkPreview 审批快捷链接预览
+type ActionLinkPreview struct {
+	TaskID            uuid.UUID            `json:"task_id"`
+	ProcessInstanceID uuid.UUID            `json:"process_instance_id"`
+	NodeName          string               `json:"node_name"`
+	Action            model.ApprovalAction `json:"action"`
+	TaskStatus        model.TaskStatus     `json:"task_status"`
+	ExpiresAt         time.Time            `json:"expires_at"`
+}
+
+// ActionLinkResult 审批快捷链接执行结果
+type ActionLinkResult struct {
+	TaskID    uuid.UUID            `json:"task_id"`
+	Action    model.ApprovalAction `json:"action"`
+	Processed bool                 `json:"processed"`
+}
+
+// DossierFormat 审批档案导出格式
+type DossierFormat string
+
+const (
+	DossierFormatJSON DossierFormat = "json"
+	DossierFormatPDF  DossierFormat = "pdf"
+)
+
+// ExportDossierRequest 导出审批档案请求
+type ExportDossierRequest struct {
+	TenantID          uuid.UUID     `json:"-"`
+	OperatorID        uuid.UUID     `json:"-"`
+	ProcessInstanceID uuid.UUID     `json:"-"`
+	Format            DossierFormat `json:"format"`
+}
+
+// DossierAttachment 档案附件清单项
+type DossierAttachment struct {
+	FileID   *uuid.UUID `json:"file_id,omitempty"`
+	Filename string     `json:"filename"`
+	Size     int64      `json:"size"`
+	Source   string     `json:"source"`            // form_data / task
+	TaskID   *uuid.UUID `json:"task_id,omitempty"` // 来源任务
+	URL      string     `json:"url,omitempty"`     // 任务附件 URL
+}
+
+// ProcessDossier 审批实例完整档案
+type ProcessDossier struct {
+	Instance    *model.ProcessInstance    `json:"instance"`
+	FormData    map[string]interface{}    `json:"form_data"`
+	Histories   []*model.ProcessHistory   `json:"histories"`
+	Tasks       []*model.ApprovalTask     `json:"tasks"`
+	Attachments []*DossierAttachment      `json:"attachments"`
+	Chain       *model.HistoryChainReport `json:"chain"`
+	ExportedBy  uuid.UUID                 `json:"exported_by"`
+	ExportedAt  time.Time                 `json:"exported_at"`
+}
+
+// DossierExportResult 审批档案导出结果
+type DossierExportResult struct {
+	FileID      uuid.UUID     `json:"file_id"`
+	Filename    string        `json:"filename"`
+	Format      DossierFormat `json:"format"`
+	Size        int64         `json:"size"`
+	ChainValid  bool          `json:"chain_valid"`
+	DownloadURL string        `json:"download_url,omitempty"`
+}
+
+// StatsRequest 审批统计看板查询请求（按最近 N 周）
+type StatsRequest struct {
+	TenantID     uuid.UUID  `json:"-"`
+	OperatorID   uuid.UUID  `json:"-"`
+	ProcessDefID *uuid.UUID `json:"process_def_id,omitempty"`
+	NodeID       *string    `json:"node_id,omitempty"`
+	Weeks        int        `json:"weeks"`     // 统计周数（含本周），默认 8
+	Limit        int        `json:"limit"`     // 排行条数
+	MinTasks     int        `json:"min_tasks"` // 审批人排行的最少任务数
+}
+
+// ProcessTrend 流程定义周度趋势及环比
+type ProcessTrend struct {
+	ProcessDefID   uuid.UUID                   `json:"process_def_id"`
+	ProcessDefName string                      `json:"process_def_name"`
+	Weeks          []*model.ProcessWeeklyStats `json:"weeks"`
+	Current        *model.ProcessWeeklyStats   `json:"current"`  // 本周至今
+	Previous       *model.ProcessWeeklyStats   `json:"previous"` // 上周同期（与本周已过去的时长相同）
+
+	// 环比变化率（上周同期为 0 时为空）
+	StartedChange   *float64 `json:"started_change"`
+	CompletedChange *float64 `json:"completed_change"`
+	CycleTimeChange *float64 `json:"cycle_time_change"`
+}
+
+// StatsRebuildRequest 手动重算本租户统计请求
+type StatsRebuildRequest struct {
+	TenantID   uuid.UUID `json:"-"`
+	OperatorID uuid.UUID `json:"-"`
+	Weeks      int       `json:"weeks"` // 重算最近几周（含本周）
+}
+
+// StatsRefreshResult 统计刷新结果
+type StatsRefreshResult struct {
+	Since          time.Time `json:"since"`
+	QueueSnapshots int64     `json:"queue_snapshots"`
+	RefreshedAt    time.Time `json:"refreshed_at"`
+}
+
+// AdminReassignTaskRequest 管理员转交单个待办任务
+type AdminReassignTaskRequest struct {
+	TenantID   uuid.UUID
+	JobID      *uuid.UUID // 所属批量作业（写入历史意见便于追溯）
+	TaskID     uuid.UUID
+	ToUserID   uuid.UUID
+	ToUserName string
+	OperatorID uuid.UUID
+	Reason     *string
+}
+
+// AdminInstanceRequest 管理员干预单个流程实例（取消/强制完结/重新生成任务）
+type AdminInstanceRequest struct {
+	TenantID   uuid.UUID
+	JobID      *uuid.UUID
+	InstanceID uuid.UUID
+	Status     model.ProcessStatus // 强制完结的目标状态：approved 或 rejected
+	OperatorID uuid.UUID
+	Reason     *string
+}
+
+// SubmitReassignJobRequest 提交离职人员待办转交作业
+type SubmitReassignJobRequest struct {
+	TenantID   uuid.UUID `json:"-"`
+	OperatorID uuid.UUID `json:"-"`
+	FromUserID uuid.UUID `json:"from_user_id" validate:"required"`
+	ToUserID   uuid.UUID `json:"to_user_id" validate:"required"`
+	ToUserName string    `json:"to_user_name"`
+	Reason     string    `json:"reason" validate:"required"`
+}
+
+// SubmitInstanceJobRequest 提交流程实例批量干预作业
+type SubmitInstanceJobRequest struct {
+	TenantID    uuid.UUID           `json:"-"`
+	OperatorID  uuid.UUID           `json:"-"`
+	InstanceIDs []uuid.UUID         `json:"instance_ids" validate:"required,min=1"`
+	Status      model.ProcessStatus `json:"status,omitempty"` // 仅强制完结使用
+	Reason      string              `json:"reason" validate:"required"`
+}
+
+// AdminJobResponse 批量作业响应
+type AdminJobResponse struct {
+	*model.AdminJob
+	Progress float64 `json:"progress"`
+}
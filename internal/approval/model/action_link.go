@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ActionLinkReply 邮件回复类令牌（审批操作由回复内容决定）
+const ActionLinkReply ApprovalAction = "reply"
+
+// ActionLinkToken 审批快捷链接令牌（一次性、限时）
+type ActionLinkToken struct {
+	ID         uuid.UUID      `json:"id"` // 令牌ID（签名载荷中的 jti）
+	TenantID   uuid.UUID      `json:"tenant_id"`
+	TaskID     uuid.UUID      `json:"task_id"`     // 绑定的审批任务
+	AssigneeID uuid.UUID      `json:"assignee_id"` // 绑定的审批人
+	Action     ApprovalAction `json:"action"`      // approve/reject/reply
+	ExpiresAt  time.Time      `json:"expires_at"`
+	UsedAt     *time.Time     `json:"used_at"` // 使用时间（为空表示未使用）
+	UsedIP     *string        `json:"used_ip"`
+	CreatedAt  time.Time      `json:"created_at"`
+}
+
+// IsUsed 是否已使用
+func (t *ActionLinkToken) IsUsed() bool {
+	return t.UsedAt != nil
+}
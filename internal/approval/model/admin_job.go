@@ -0,0 +1,96 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 管理员干预操作（记录在流程历史中，与审批人操作区分）
+const (
+	ApprovalActionAdminReassign      ApprovalAction = "admin_reassign"       // 管理员转交任务
+	ApprovalActionAdminCancel        ApprovalAction = "admin_cancel"         // 管理员取消流程
+	ApprovalActionAdminForceComplete ApprovalAction = "admin_force_complete" // 管理员强制完结
+	ApprovalActionAdminRetrigger     ApprovalAction = "admin_retrigger"      // 管理员重新生成任务
+)
+
+// IsAdminAction 是否为管理员干预操作
+func (a ApprovalAction) IsAdminAction() bool {
+	switch a {
+	case ApprovalActionAdminReassign, ApprovalActionAdminCancel, ApprovalActionAdminForceComplete, ApprovalActionAdminRetrigger:
+		return true
+	}
+	return false
+}
+
+// AdminJobType 管理批量作业类型
+type AdminJobType string
+
+const (
+	AdminJobReassignTasks   AdminJobType = "reassign_tasks"   // 离职人员待办转交
+	AdminJobCancelInstances AdminJobType = "cancel_instances" // 批量取消流程
+	AdminJobForceComplete   AdminJobType = "force_complete"   // 批量强制完结
+	AdminJobRetriggerTasks  AdminJobType = "retrigger_tasks"  // 重新生成审批任务
+)
+
+// AdminJobStatus 管理批量作业状态
+type AdminJobStatus string
+
+const (
+	AdminJobStatusPending   AdminJobStatus = "pending"
+	AdminJobStatusRunning   AdminJobStatus = "running"
+	AdminJobStatusCompleted AdminJobStatus = "completed" // 全部处理完成（可能含失败条目）
+	AdminJobStatusFailed    AdminJobStatus = "failed"    // 作业异常中止
+)
+
+// AdminJobItemStatus 作业条目处理结果
+type AdminJobItemStatus string
+
+const (
+	AdminJobItemSucceeded AdminJobItemStatus = "succeeded"
+	AdminJobItemFailed    AdminJobItemStatus = "failed"
+	AdminJobItemSkipped   AdminJobItemStatus = "skipped"
+)
+
+// AdminJob 审批管理批量作业
+type AdminJob struct {
+	ID         uuid.UUID              `json:"id"`
+	TenantID   uuid.UUID              `json:"tenant_id"`
+	Type       AdminJobType           `json:"type"`
+	Status     AdminJobStatus         `json:"status"`
+	Params     map[string]interface{} `json:"params"` // 作业参数（转交对象、原因等）
+	Targets    []uuid.UUID            `json:"-"`      // 待处理的任务或流程实例ID（服务重启后据此续跑）
+	Reason     string                 `json:"reason"`
+	Total      int                    `json:"total"`
+	Processed  int                    `json:"processed"`
+	Succeeded  int                    `json:"succeeded"`
+	Failed     int                    `json:"failed"`
+	Skipped    int                    `json:"skipped"`
+	Error      *string                `json:"error"`
+	CreatedBy  uuid.UUID              `json:"created_by"`
+	CreatedAt  time.Time              `json:"created_at"`
+	StartedAt  *time.Time             `json:"started_at"`
+	FinishedAt *time.Time             `json:"finished_at"`
+	UpdatedAt  time.Time              `json:"updated_at"`
+}
+
+// Progress 作业进度（0-1）
+func (j *AdminJob) Progress() float64 {
+	if j.Total == 0 {
+		if j.Status == AdminJobStatusCompleted {
+			return 1
+		}
+		return 0
+	}
+	return float64(j.Processed) / float64(j.Total)
+}
+
+// AdminJobItem 作业条目（任务或流程实例）处理结果
+type AdminJobItem struct {
+	ID          uuid.UUID          `json:"id"`
+	JobID       uuid.UUID          `json:"job_id"`
+	TargetID    uuid.UUID          `json:"target_id"` // 任务ID或流程实例ID
+	Status      AdminJobItemStatus `json:"status"`
+	Message     string             `json:"message"`
+	ProcessedAt time.Time          `json:"processed_at"`
+}
@@ -14,6 +14,7 @@ const (
 	ApprovalActionReject   ApprovalAction = "reject"   // 拒绝
 	ApprovalActionTransfer ApprovalAction = "transfer" // 转审
 	ApprovalActionWithdraw ApprovalAction = "withdraw" // 撤回
+	ApprovalActionCancel   ApprovalAction = "cancel"   // 取消
 )
 
 // ProcessStatus 流程状态
@@ -115,4 +116,9 @@ type ProcessHistory struct {
 	FromStatus        *ProcessStatus `json:"from_status"`
 	ToStatus          ProcessStatus  `json:"to_status"`
 	CreatedAt         time.Time      `json:"created_at"`
+
+	// 防篡改哈希链（同一流程实例内按序号串联）
+	Sequence int64  `json:"sequence"`  // 实例内序号（从1开始）
+	PrevHash string `json:"prev_hash"` // 上一条记录的哈希
+	Hash     string `json:"hash"`      // 本条记录的哈希
 }
@@ -0,0 +1,138 @@
+package model
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ChainTimestamp 哈希链使用的时间精度（与 PostgreSQL TIMESTAMPTZ 微秒精度一致）
+func ChainTimestamp(t time.Time) time.Time {
+	return t.UTC().Truncate(time.Microsecond)
+}
+
+// HistoryChainKey 历史记录哈希链的 HMAC 密钥（配置项 approval.history_chain.secret）。
+// 只能写数据库的人没有密钥，无法在篡改或删除记录后重算整条链
+type HistoryChainKey []byte
+
+// ComputeHash 计算历史记录哈希：HMAC-SHA256(密钥, 上一条哈希 + 本条全部业务字段)
+func (h *ProcessHistory) ComputeHash(key HistoryChainKey) string {
+	taskID := ""
+	if h.TaskID != nil {
+		taskID = h.TaskID.String()
+	}
+	comment := ""
+	if h.Comment != nil {
+		comment = *h.Comment
+	}
+	fromStatus := ""
+	if h.FromStatus != nil {
+		fromStatus = string(*h.FromStatus)
+	}
+
+	// 使用 JSON 数组编码，避免字段内容中的分隔符造成歧义
+	payload, _ := json.Marshal([]string{
+		h.PrevHash,
+		strconv.FormatInt(h.Sequence, 10),
+		h.ID.String(),
+		h.TenantID.String(),
+		h.ProcessInstanceID.String(),
+		taskID,
+		h.NodeID,
+		h.NodeName,
+		h.OperatorID.String(),
+		h.OperatorName,
+		string(h.Action),
+		comment,
+		fromStatus,
+		string(h.ToStatus),
+		ChainTimestamp(h.CreatedAt).Format(time.RFC3339Nano),
+	})
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// HistoryChainIssueType 哈希链问题类型
+type HistoryChainIssueType string
+
+const (
+	HistoryChainGap          HistoryChainIssueType = "gap"                // 序号不连续（记录被删除）
+	HistoryChainPrevMismatch HistoryChainIssueType = "prev_hash_mismatch" // 前向哈希与上一条不一致
+	HistoryChainHashMismatch HistoryChainIssueType = "hash_mismatch"      // 记录内容被修改
+	HistoryChainUnchained    HistoryChainIssueType = "unchained"          // 未入链的历史记录（上线前的存量数据）
+	HistoryChainHeadMismatch HistoryChainIssueType = "head_mismatch"      // 链头与实例记录不一致（尾部记录被删除）
+)
+
+// HistoryChainIssue 哈希链校验问题
+type HistoryChainIssue struct {
+	Type      HistoryChainIssueType `json:"type"`
+	Sequence  int64                 `json:"sequence"`
+	HistoryID *uuid.UUID            `json:"history_id,omitempty"`
+	Detail    string                `json:"detail"`
+}
+
+// HistoryChainReport 哈希链校验报告
+type HistoryChainReport struct {
+	ProcessInstanceID uuid.UUID            `json:"process_instance_id"`
+	Valid             bool                 `json:"valid"`
+	Total             int                  `json:"total"`
+	HeadSequence      int64                `json:"head_sequence"`
+	HeadHash          string               `json:"head_hash"`
+	Issues            []*HistoryChainIssue `json:"issues"`
+	VerifiedAt        time.Time            `json:"verified_at"`
+}
+
+// VerifyHistoryChain 用密钥校验按序号排序的历史记录链，headSeq/headHash 为实例上记录的链头
+func VerifyHistoryChain(key HistoryChainKey, instanceID uuid.UUID, histories []*ProcessHistory, headSeq int64, headHash string) *HistoryChainReport {
+	report := &HistoryChainReport{
+		ProcessInstanceID: instanceID,
+		Total:             len(histories),
+		HeadSequence:      headSeq,
+		HeadHash:          headHash,
+		Issues:            []*HistoryChainIssue{},
+		VerifiedAt:        time.Now(),
+	}
+
+	addIssue := func(t HistoryChainIssueType, seq int64, id *uuid.UUID, detail string) {
+		report.Issues = append(report.Issues, &HistoryChainIssue{Type: t, Sequence: seq, HistoryID: id, Detail: detail})
+	}
+
+	var lastSeq int64
+	lastHash := ""
+	for _, h := range histories {
+		id := h.ID
+		if h.Sequence == 0 {
+			addIssue(HistoryChainUnchained, 0, &id, "history record is not part of the chain")
+			continue
+		}
+
+		if h.Sequence != lastSeq+1 {
+			addIssue(HistoryChainGap, h.Sequence, &id, fmt.Sprintf("expected sequence %d, got %d", lastSeq+1, h.Sequence))
+		} else if h.PrevHash != lastHash {
+			addIssue(HistoryChainPrevMismatch, h.Sequence, &id, "prev_hash does not match previous record")
+		}
+
+		if !hmac.Equal([]byte(h.ComputeHash(key)), []byte(h.Hash)) {
+			addIssue(HistoryChainHashMismatch, h.Sequence, &id, "record content does not match its hash")
+		}
+
+		lastSeq = h.Sequence
+		lastHash = h.Hash
+	}
+
+	if lastSeq != headSeq || lastHash != headHash {
+		addIssue(HistoryChainHeadMismatch, headSeq, nil,
+			fmt.Sprintf("chain ends at sequence %d, instance head is %d", lastSeq, headSeq))
+	}
+
+	report.Valid = len(report.Issues) == 0
+	return report
+}
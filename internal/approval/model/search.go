@@ -0,0 +1,93 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SearchFieldType 检索字段类型
+type SearchFieldType string
+
+const (
+	SearchFieldTypeText    SearchFieldType = "text"    // 文本（参与全文检索）
+	SearchFieldTypeKeyword SearchFieldType = "keyword" // 关键字（精确匹配）
+	SearchFieldTypeNumber  SearchFieldType = "number"  // 数值（支持范围）
+	SearchFieldTypeDate    SearchFieldType = "date"    // 日期（支持范围）
+)
+
+// SearchSortField 检索排序字段
+type SearchSortField string
+
+const (
+	SearchSortByStartedAt SearchSortField = "started_at" // 按发起时间
+	SearchSortByUpdatedAt SearchSortField = "updated_at" // 按更新时间
+)
+
+// FieldFilterOp 字段过滤操作符
+type FieldFilterOp string
+
+const (
+	FieldFilterEq      FieldFilterOp = "eq"      // 等于
+	FieldFilterLike    FieldFilterOp = "like"    // 模糊匹配
+	FieldFilterGte     FieldFilterOp = "gte"     // 大于等于
+	FieldFilterLte     FieldFilterOp = "lte"     // 小于等于
+	FieldFilterBetween FieldFilterOp = "between" // 区间
+)
+
+// ProcessSearchField 流程可检索字段配置
+type ProcessSearchField struct {
+	ID           uuid.UUID       `json:"id"`
+	TenantID     uuid.UUID       `json:"tenant_id"`
+	ProcessDefID uuid.UUID       `json:"process_def_id"` // 流程定义ID
+	FieldKey     string          `json:"field_key"`      // 表单字段Key
+	FieldLabel   string          `json:"field_label"`    // 字段显示名
+	FieldType    SearchFieldType `json:"field_type"`     // 检索类型
+	Sort         int             `json:"sort"`
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+}
+
+// InstanceSearchIndex 流程实例检索索引
+type InstanceSearchIndex struct {
+	ProcessInstanceID uuid.UUID              `json:"process_instance_id"`
+	TenantID          uuid.UUID              `json:"tenant_id"`
+	ProcessDefID      uuid.UUID              `json:"process_def_id"`
+	ApplicantID       uuid.UUID              `json:"applicant_id"`
+	ApplicantName     string                 `json:"applicant_name"`
+	Title             string                 `json:"title"`
+	Status            ProcessStatus          `json:"status"`
+	FieldValues       map[string]interface{} `json:"field_values"` // 已配置字段的取值
+	Comments          string                 `json:"comments"`     // 审批意见汇总
+	Content           string                 `json:"content"`      // 全文检索文本
+	StartedAt         time.Time              `json:"started_at"`
+	CompletedAt       *time.Time             `json:"completed_at"`
+	UpdatedAt         time.Time              `json:"updated_at"`
+}
+
+// FieldFilter 表单字段过滤条件
+type FieldFilter struct {
+	Key     string        `json:"key"`
+	Op      FieldFilterOp `json:"op"`
+	Value   string        `json:"value"`
+	ValueTo string        `json:"value_to,omitempty"` // between 的上界
+}
+
+// InstanceSearchQuery 流程实例检索条件
+type InstanceSearchQuery struct {
+	TenantID     uuid.UUID
+	ProcessDefID *uuid.UUID
+	Status       *ProcessStatus
+	ApplicantID  *uuid.UUID
+	Keyword      string
+	FieldFilters []FieldFilter
+	StartDate    *time.Time
+	EndDate      *time.Time
+	SortBy       SearchSortField
+	SortDesc     bool
+
+	// 游标（上一页最后一条的排序值和ID）
+	AfterValue *time.Time
+	AfterID    *uuid.UUID
+	Limit      int
+}
@@ -0,0 +1,91 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NodeWeeklyStats 节点周度处理时长统计
+type NodeWeeklyStats struct {
+	TenantID      uuid.UUID `json:"tenant_id"`
+	ProcessDefID  uuid.UUID `json:"process_def_id"`
+	NodeID        string    `json:"node_id"`
+	NodeName      string    `json:"node_name"`
+	WeekStart     time.Time `json:"week_start"`     // 周一 00:00
+	TaskCount     int       `json:"task_count"`     // 已处理任务数
+	ApprovedCount int       `json:"approved_count"` // 同意数
+	RejectedCount int       `json:"rejected_count"` // 拒绝数
+	AvgSeconds    float64   `json:"avg_seconds"`    // 平均处理时长
+	MedianSeconds float64   `json:"median_seconds"` // 中位数处理时长
+	P90Seconds    float64   `json:"p90_seconds"`    // P90 处理时长
+	RefreshedAt   time.Time `json:"refreshed_at"`
+}
+
+// ApproverWeeklyStats 审批人周度处理时长统计
+type ApproverWeeklyStats struct {
+	TenantID     uuid.UUID `json:"tenant_id"`
+	AssigneeID   uuid.UUID `json:"assignee_id"`
+	AssigneeName string    `json:"assignee_name"`
+	WeekStart    time.Time `json:"week_start"`
+	TaskCount    int       `json:"task_count"`
+	AvgSeconds   float64   `json:"avg_seconds"`
+	P90Seconds   float64   `json:"p90_seconds"`
+	RefreshedAt  time.Time `json:"refreshed_at"`
+}
+
+// RejectionReasonStats 节点拒绝原因统计
+type RejectionReasonStats struct {
+	TenantID     uuid.UUID `json:"tenant_id"`
+	ProcessDefID uuid.UUID `json:"process_def_id"`
+	NodeID       string    `json:"node_id"`
+	NodeName     string    `json:"node_name"`
+	WeekStart    time.Time `json:"week_start"`
+	Reason       string    `json:"reason"` // 拒绝意见（归一化后），未填写为空串
+	Count        int       `json:"count"`
+}
+
+// ProcessWeeklyStats 流程定义周度统计
+type ProcessWeeklyStats struct {
+	TenantID        uuid.UUID `json:"tenant_id"`
+	ProcessDefID    uuid.UUID `json:"process_def_id"`
+	ProcessDefName  string    `json:"process_def_name"`
+	WeekStart       time.Time `json:"week_start"`
+	StartedCount    int       `json:"started_count"`   // 本周发起数
+	CompletedCount  int       `json:"completed_count"` // 本周完成数
+	ApprovedCount   int       `json:"approved_count"`
+	RejectedCount   int       `json:"rejected_count"`
+	AvgCycleSeconds float64   `json:"avg_cycle_seconds"` // 本周完成实例的平均耗时
+	P90CycleSeconds float64   `json:"p90_cycle_seconds"`
+	RefreshedAt     time.Time `json:"refreshed_at"`
+}
+
+// QueueSnapshot 节点待办队列快照
+type QueueSnapshot struct {
+	TenantID             uuid.UUID `json:"tenant_id"`
+	ProcessDefID         uuid.UUID `json:"process_def_id"`
+	NodeID               string    `json:"node_id"`
+	NodeName             string    `json:"node_name"`
+	SnapshotAt           time.Time `json:"snapshot_at"`
+	PendingCount         int       `json:"pending_count"`          // 待处理任务数
+	OldestPendingSeconds float64   `json:"oldest_pending_seconds"` // 最久待办已等待时长
+}
+
+// StatsQuery 统计查询条件
+type StatsQuery struct {
+	TenantID     uuid.UUID
+	ProcessDefID *uuid.UUID
+	NodeID       *string
+	From         time.Time // 周度统计按 week_start 过滤，快照按 snapshot_at 过滤
+	To           time.Time
+	MinTasks     int // 审批人排行的最少任务数（过滤样本过少的噪声）
+	Limit        int
+}
+
+// WeekStart 返回 t 所在周的周一零点（按 t 的时区）
+func WeekStart(t time.Time) time.Time {
+	y, m, d := t.Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lk2023060901/go-next-erp/internal/approval/model"
+	"github.com/lk2023060901/go-next-erp/pkg/database"
+)
+
+// ActionTokenRepository 审批快捷链接令牌仓储接口
+type ActionTokenRepository interface {
+	Create(ctx context.Context, token *model.ActionLinkToken) error
+	FindByID(ctx context.Context, id uuid.UUID) (*model.ActionLinkToken, error)
+	// Consume 锁定令牌所属任务下未使用的令牌后执行 fn，fn 成功时在同一事务中标记该令牌已使用并作废其他令牌；
+	// fn 失败时回滚，令牌仍可使用。令牌已被使用时不执行 fn 并返回 false
+	Consume(ctx context.Context, id uuid.UUID, usedAt time.Time, usedIP string, fn func(ctx context.Context) error) (bool, error)
+	// UseInboundNonce 记录入站 Webhook 随机数并清理 expireBefore 之前的记录，随机数已出现过时返回 false
+	UseInboundNonce(ctx context.Context, nonce string, receivedAt, expireBefore time.Time) (bool, error)
+}
+
+type actionTokenRepo struct {
+	db *database.DB
+}
+
+// NewActionTokenRepository 创建审批快捷链接令牌仓储
+func NewActionTokenRepository(db *database.DB) ActionTokenRepository {
+	return &actionTokenRepo{db: db}
+}
+
+func (r *actionTokenRepo) Create(ctx context.Context, token *model.ActionLinkToken) error {
+	sql := `
+		INSERT INTO approval_action_tokens (
+			id, tenant_id, task_id, assignee_id, action, expires_at, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := r.db.Exec(ctx, sql,
+		token.ID,
+		token.TenantID,
+		token.TaskID,
+		token.AssigneeID,
+		token.Action,
+		token.ExpiresAt,
+		token.CreatedAt,
+	)
+
+	return err
+}
+
+func (r *actionTokenRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.ActionLinkToken, error) {
+	sql := `
+		SELECT id, tenant_id, task_id, assignee_id, action, expires_at, used_at, used_ip, created_at
+		FROM approval_action_tokens
+		WHERE id = $1
+	`
+
+	var token model.ActionLinkToken
+	err := r.db.QueryRow(ctx, sql, id).Scan(
+		&token.ID,
+		&token.TenantID,
+		&token.TaskID,
+		&token.AssigneeID,
+		&token.Action,
+		&token.ExpiresAt,
+		&token.UsedAt,
+		&token.UsedIP,
+		&token.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func (r *actionTokenRepo) Consume(ctx context.Context, id uuid.UUID, usedAt time.Time, usedIP string, fn func(ctx context.Context) error) (bool, error) {
+	consumed := false
+	err := r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		// 同一任务的同意、拒绝链接并发使用时串行执行
+		rows, err := tx.Query(ctx, `
+			SELECT id FROM approval_action_tokens
+			WHERE task_id = (SELECT task_id FROM approval_action_tokens WHERE id = $1) AND used_at IS NULL
+			ORDER BY id
+			FOR UPDATE
+		`, id)
+		if err != nil {
+			return err
+		}
+		found := false
+		for rows.Next() {
+			var tokenID uuid.UUID
+			if err := rows.Scan(&tokenID); err != nil {
+				rows.Close()
+				return err
+			}
+			found = found || tokenID == id
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		if !found {
+			return nil
+		}
+
+		if err := fn(ctx); err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, `
+			UPDATE approval_action_tokens SET used_at = $2, used_ip = $3 WHERE id = $1
+		`, id, usedAt, usedIP); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, `
+			UPDATE approval_action_tokens
+			SET used_at = $2, used_ip = 'revoked'
+			WHERE task_id = (SELECT task_id FROM approval_action_tokens WHERE id = $1) AND id <> $1 AND used_at IS NULL
+		`, id, usedAt); err != nil {
+			return err
+		}
+
+		consumed = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return consumed, nil
+}
+
+func (r *actionTokenRepo) UseInboundNonce(ctx context.Context, nonce string, receivedAt, expireBefore time.Time) (bool, error) {
+	if _, err := r.db.Exec(ctx, `DELETE FROM approval_inbound_nonces WHERE received_at < $1`, expireBefore); err != nil {
+		return false, err
+	}
+
+	var inserted string
+	err := r.db.QueryRow(ctx, `
+		INSERT INTO approval_inbound_nonces (nonce, received_at) VALUES ($1, $2)
+		ON CONFLICT (nonce) DO NOTHING
+		RETURNING nonce
+	`, nonce, receivedAt).Scan(&inserted)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
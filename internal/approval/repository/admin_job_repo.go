@@ -0,0 +1,231 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lk2023060901/go-next-erp/internal/approval/model"
+	"github.com/lk2023060901/go-next-erp/pkg/database"
+)
+
+// AdminJobRepository 审批管理批量作业仓储接口
+type AdminJobRepository interface {
+	Create(ctx context.Context, job *model.AdminJob) error
+	UpdateProgress(ctx context.Context, job *model.AdminJob) error
+	FindByID(ctx context.Context, id uuid.UUID) (*model.AdminJob, error)
+	ListByTenant(ctx context.Context, tenantID uuid.UUID, limit, offset int) ([]*model.AdminJob, error)
+
+	// ListStale 查询 before 之前未再更新的未结束作业（服务重启后中断的作业）
+	ListStale(ctx context.Context, before time.Time, limit int) ([]*model.AdminJob, error)
+
+	// AddItem 记录条目结果并原子更新作业计数
+	AddItem(ctx context.Context, item *model.AdminJobItem) error
+	ListItems(ctx context.Context, jobID uuid.UUID) ([]*model.AdminJobItem, error)
+}
+
+type adminJobRepo struct {
+	db *database.DB
+}
+
+// NewAdminJobRepository 创建审批管理批量作业仓储
+func NewAdminJobRepository(db *database.DB) AdminJobRepository {
+	return &adminJobRepo{db: db}
+}
+
+const adminJobColumns = `
+	id, tenant_id, type, status, params, targets, reason, total, processed, succeeded, failed, skipped,
+	error, created_by, created_at, started_at, finished_at, updated_at
+`
+
+func (r *adminJobRepo) Create(ctx context.Context, job *model.AdminJob) error {
+	paramsJSON, err := json.Marshal(job.Params)
+	if err != nil {
+		return err
+	}
+
+	sql := `
+		INSERT INTO approval_admin_jobs (` + adminJobColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+	`
+
+	_, err = r.db.Exec(ctx, sql,
+		job.ID,
+		job.TenantID,
+		job.Type,
+		job.Status,
+		paramsJSON,
+		job.Targets,
+		job.Reason,
+		job.Total,
+		job.Processed,
+		job.Succeeded,
+		job.Failed,
+		job.Skipped,
+		job.Error,
+		job.CreatedBy,
+		job.CreatedAt,
+		job.StartedAt,
+		job.FinishedAt,
+		job.CreatedAt,
+	)
+
+	return err
+}
+
+// UpdateProgress 更新作业状态（计数由 AddItem 维护，此处不覆盖）
+func (r *adminJobRepo) UpdateProgress(ctx context.Context, job *model.AdminJob) error {
+	sql := `
+		UPDATE approval_admin_jobs
+		SET status = $2, total = $3, error = $4, started_at = $5, finished_at = $6, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+	`
+
+	_, err := r.db.Exec(ctx, sql,
+		job.ID,
+		job.Status,
+		job.Total,
+		job.Error,
+		job.StartedAt,
+		job.FinishedAt,
+	)
+
+	return err
+}
+
+func (r *adminJobRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.AdminJob, error) {
+	sql := `SELECT ` + adminJobColumns + ` FROM approval_admin_jobs WHERE id = $1`
+
+	return scanAdminJob(r.db.QueryRow(ctx, sql, id))
+}
+
+func (r *adminJobRepo) ListByTenant(ctx context.Context, tenantID uuid.UUID, limit, offset int) ([]*model.AdminJob, error) {
+	sql := `
+		SELECT ` + adminJobColumns + `
+		FROM approval_admin_jobs
+		WHERE tenant_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	return r.queryJobs(ctx, sql, tenantID, limit, offset)
+}
+
+func (r *adminJobRepo) ListStale(ctx context.Context, before time.Time, limit int) ([]*model.AdminJob, error) {
+	sql := `
+		SELECT ` + adminJobColumns + `
+		FROM approval_admin_jobs
+		WHERE status IN ('pending', 'running') AND updated_at < $1
+		ORDER BY created_at
+		LIMIT $2
+	`
+
+	return r.queryJobs(ctx, sql, before, limit)
+}
+
+func (r *adminJobRepo) queryJobs(ctx context.Context, sql string, args ...interface{}) ([]*model.AdminJob, error) {
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var jobs []*model.AdminJob
+	for rows.Next() {
+		job, err := scanAdminJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+
+	return jobs, rows.Err()
+}
+
+func (r *adminJobRepo) AddItem(ctx context.Context, item *model.AdminJobItem) error {
+	return r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO approval_admin_job_items (id, job_id, target_id, status, message, processed_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`, item.ID, item.JobID, item.TargetID, item.Status, item.Message, item.ProcessedAt)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, `
+			UPDATE approval_admin_jobs
+			SET processed = processed + 1,
+			    succeeded = succeeded + CASE WHEN $2 = 'succeeded' THEN 1 ELSE 0 END,
+			    failed = failed + CASE WHEN $2 = 'failed' THEN 1 ELSE 0 END,
+			    skipped = skipped + CASE WHEN $2 = 'skipped' THEN 1 ELSE 0 END,
+			    updated_at = CURRENT_TIMESTAMP
+			WHERE id = $1
+		`, item.JobID, string(item.Status))
+		return err
+	})
+}
+
+func (r *adminJobRepo) ListItems(ctx context.Context, jobID uuid.UUID) ([]*model.AdminJobItem, error) {
+	sql := `
+		SELECT id, job_id, target_id, status, message, processed_at
+		FROM approval_admin_job_items
+		WHERE job_id = $1
+		ORDER BY processed_at ASC
+	`
+
+	rows, err := r.db.Query(ctx, sql, jobID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []*model.AdminJobItem
+	for rows.Next() {
+		var item model.AdminJobItem
+		if err := rows.Scan(&item.ID, &item.JobID, &item.TargetID, &item.Status, &item.Message, &item.ProcessedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, &item)
+	}
+
+	return items, rows.Err()
+}
+
+func scanAdminJob(row pgx.Row) (*model.AdminJob, error) {
+	var job model.AdminJob
+	var paramsJSON []byte
+
+	err := row.Scan(
+		&job.ID,
+		&job.TenantID,
+		&job.Type,
+		&job.Status,
+		&paramsJSON,
+		&job.Targets,
+		&job.Reason,
+		&job.Total,
+		&job.Processed,
+		&job.Succeeded,
+		&job.Failed,
+		&job.Skipped,
+		&job.Error,
+		&job.CreatedBy,
+		&job.CreatedAt,
+		&job.StartedAt,
+		&job.FinishedAt,
+		&job.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(paramsJSON) > 0 {
+		if err := json.Unmarshal(paramsJSON, &job.Params); err != nil {
+			return nil, err
+		}
+	}
+
+	return &job, nil
+}
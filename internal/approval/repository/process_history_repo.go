@@ -2,105 +2,139 @@ package repository
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/lk2023060901/go-next-erp/internal/approval/model"
 	"github.com/lk2023060901/go-next-erp/pkg/database"
 )
 
 // ProcessHistoryRepository 流程历史仓储接口
 type ProcessHistoryRepository interface {
+	// Create 追加历史记录，并与同实例上一条记录哈希串联
 	Create(ctx context.Context, history *model.ProcessHistory) error
 	ListByInstance(ctx context.Context, instanceID uuid.UUID) ([]*model.ProcessHistory, error)
 	ListByTaskID(ctx context.Context, taskID uuid.UUID) ([]*model.ProcessHistory, error)
+	// GetChainHead 获取实例记录的链头（最新序号和哈希），用于发现尾部记录被删除
+	GetChainHead(ctx context.Context, instanceID uuid.UUID) (int64, string, error)
 }
 
 type processHistoryRepo struct {
-	db *database.DB
+	db  *database.DB
+	key model.HistoryChainKey
 }
 
-// NewProcessHistoryRepository 创建流程历史仓储
-func NewProcessHistoryRepository(db *database.DB) ProcessHistoryRepository {
-	return &processHistoryRepo{db: db}
+// NewProcessHistoryRepository 创建流程历史仓储，key 为哈希链的 HMAC 密钥
+func NewProcessHistoryRepository(db *database.DB, key model.HistoryChainKey) ProcessHistoryRepository {
+	return &processHistoryRepo{db: db, key: key}
 }
 
+const processHistoryColumns = `
+	id, tenant_id, process_instance_id, task_id, node_id, node_name,
+	operator_id, operator_name, action, comment, from_status, to_status,
+	created_at, seq, prev_hash, hash
+`
+
 func (r *processHistoryRepo) Create(ctx context.Context, history *model.ProcessHistory) error {
-	sql := `
-		INSERT INTO approval_process_histories (
-			id, tenant_id, process_instance_id, task_id, node_id, node_name,
-			operator_id, operator_name, action, comment, from_status, to_status, created_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
-	`
+	return r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		// 锁定流程实例行，串行化同一实例的历史追加
+		var headSeq int64
+		var headHash *string
+		err := tx.QueryRow(ctx, `
+			SELECT history_seq, history_head_hash
+			FROM approval_process_instances
+			WHERE id = $1
+			FOR UPDATE
+		`, history.ProcessInstanceID).Scan(&headSeq, &headHash)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+
+		history.Sequence = headSeq + 1
+		history.PrevHash = ""
+		if headHash != nil {
+			history.PrevHash = *headHash
+		}
+		history.CreatedAt = model.ChainTimestamp(history.CreatedAt)
+		history.Hash = history.ComputeHash(r.key)
+
+		sql := `
+			INSERT INTO approval_process_histories (` + processHistoryColumns + `)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+		`
+		if _, err := tx.Exec(ctx, sql,
+			history.ID,
+			history.TenantID,
+			history.ProcessInstanceID,
+			history.TaskID,
+			history.NodeID,
+			history.NodeName,
+			history.OperatorID,
+			history.OperatorName,
+			history.Action,
+			history.Comment,
+			history.FromStatus,
+			history.ToStatus,
+			history.CreatedAt,
+			history.Sequence,
+			history.PrevHash,
+			history.Hash,
+		); err != nil {
+			return err
+		}
 
-	_, err := r.db.Exec(ctx, sql,
-		history.ID,
-		history.TenantID,
-		history.ProcessInstanceID,
-		history.TaskID,
-		history.NodeID,
-		history.NodeName,
-		history.OperatorID,
-		history.OperatorName,
-		history.Action,
-		history.Comment,
-		history.FromStatus,
-		history.ToStatus,
-		history.CreatedAt,
-	)
-
-	return err
+		_, err = tx.Exec(ctx, `
+			UPDATE approval_process_instances
+			SET history_seq = $2, history_head_hash = $3
+			WHERE id = $1
+		`, history.ProcessInstanceID, history.Sequence, history.Hash)
+		return err
+	})
 }
 
 func (r *processHistoryRepo) ListByInstance(ctx context.Context, instanceID uuid.UUID) ([]*model.ProcessHistory, error) {
 	sql := `
-		SELECT id, tenant_id, process_instance_id, task_id, node_id,
-		       action, operator_id, comment, created_at
+		SELECT ` + processHistoryColumns + `
 		FROM approval_process_histories
 		WHERE process_instance_id = $1
-		ORDER BY created_at ASC
+		ORDER BY seq ASC, created_at ASC
 	`
 
-	rows, err := r.db.Query(ctx, sql, instanceID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var histories []*model.ProcessHistory
-	for rows.Next() {
-		var history model.ProcessHistory
-		err := rows.Scan(
-			&history.ID,
-			&history.TenantID,
-			&history.ProcessInstanceID,
-			&history.TaskID,
-			&history.NodeID,
-			&history.Action,
-			&history.OperatorID,
-			&history.Comment,
-			&history.CreatedAt,
-		)
-
-		if err != nil {
-			return nil, err
-		}
-
-		histories = append(histories, &history)
-	}
-
-	return histories, rows.Err()
+	return r.list(ctx, sql, instanceID)
 }
 
 func (r *processHistoryRepo) ListByTaskID(ctx context.Context, taskID uuid.UUID) ([]*model.ProcessHistory, error) {
 	sql := `
-		SELECT id, tenant_id, process_instance_id, task_id, node_id,
-		       action, operator_id, comment, created_at
+		SELECT ` + processHistoryColumns + `
 		FROM approval_process_histories
 		WHERE task_id = $1
 		ORDER BY created_at ASC
 	`
 
-	rows, err := r.db.Query(ctx, sql, taskID)
+	return r.list(ctx, sql, taskID)
+}
+
+func (r *processHistoryRepo) GetChainHead(ctx context.Context, instanceID uuid.UUID) (int64, string, error) {
+	var seq int64
+	var hash *string
+	err := r.db.QueryRow(ctx, `
+		SELECT history_seq, history_head_hash
+		FROM approval_process_instances
+		WHERE id = $1
+	`, instanceID).Scan(&seq, &hash)
+	if err != nil {
+		return 0, "", err
+	}
+
+	if hash == nil {
+		return seq, "", nil
+	}
+	return seq, *hash, nil
+}
+
+func (r *processHistoryRepo) list(ctx context.Context, sql string, args ...interface{}) ([]*model.ProcessHistory, error) {
+	rows, err := r.db.Query(ctx, sql, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -115,10 +149,17 @@ func (r *processHistoryRepo) ListByTaskID(ctx context.Context, taskID uuid.UUID)
 			&history.ProcessInstanceID,
 			&history.TaskID,
 			&history.NodeID,
-			&history.Action,
+			&history.NodeName,
 			&history.OperatorID,
+			&history.OperatorName,
+			&history.Action,
 			&history.Comment,
+			&history.FromStatus,
+			&history.ToStatus,
 			&history.CreatedAt,
+			&history.Sequence,
+			&history.PrevHash,
+			&history.Hash,
 		)
 
 		if err != nil {
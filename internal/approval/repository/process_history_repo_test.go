@@ -68,7 +68,7 @@ func TestProcessHistoryRepository_Create(t *testing.T) {
 	}
 	defer db.Close()
 
-	repo := NewProcessHistoryRepository(db)
+	repo := NewProcessHistoryRepository(db, model.HistoryChainKey("test-chain-key"))
 	ctx := context.Background()
 	tenantID := uuid.New()
 	defer cleanupProcessHistories(t, db, tenantID)
@@ -189,7 +189,7 @@ func TestProcessHistoryRepository_ListByInstance(t *testing.T) {
 	}
 	defer db.Close()
 
-	repo := NewProcessHistoryRepository(db)
+	repo := NewProcessHistoryRepository(db, model.HistoryChainKey("test-chain-key"))
 	ctx := context.Background()
 	tenantID := uuid.New()
 	defer cleanupProcessHistories(t, db, tenantID)
@@ -394,7 +394,7 @@ func TestProcessHistoryRepository_ListByTaskID(t *testing.T) {
 	}
 	defer db.Close()
 
-	repo := NewProcessHistoryRepository(db)
+	repo := NewProcessHistoryRepository(db, model.HistoryChainKey("test-chain-key"))
 	ctx := context.Background()
 	tenantID := uuid.New()
 	defer cleanupProcessHistories(t, db, tenantID)
@@ -501,7 +501,7 @@ func TestProcessHistoryRepository_ApprovalTrail(t *testing.T) {
 	}
 	defer db.Close()
 
-	repo := NewProcessHistoryRepository(db)
+	repo := NewProcessHistoryRepository(db, model.HistoryChainKey("test-chain-key"))
 	ctx := context.Background()
 	tenantID := uuid.New()
 	defer cleanupProcessHistories(t, db, tenantID)
@@ -569,7 +569,7 @@ func TestProcessHistoryRepository_TenantIsolation(t *testing.T) {
 	}
 	defer db.Close()
 
-	repo := NewProcessHistoryRepository(db)
+	repo := NewProcessHistoryRepository(db, model.HistoryChainKey("test-chain-key"))
 	ctx := context.Background()
 	tenantID1 := uuid.New()
 	tenantID2 := uuid.New()
@@ -656,7 +656,7 @@ func BenchmarkProcessHistoryRepository_Create(b *testing.B) {
 	}
 	defer db.Close()
 
-	repo := NewProcessHistoryRepository(db)
+	repo := NewProcessHistoryRepository(db, model.HistoryChainKey("test-chain-key"))
 	ctx := context.Background()
 	tenantID := uuid.New()
 
@@ -676,7 +676,7 @@ func BenchmarkProcessHistoryRepository_ListByInstance(b *testing.B) {
 	}
 	defer db.Close()
 
-	repo := NewProcessHistoryRepository(db)
+	repo := NewProcessHistoryRepository(db, model.HistoryChainKey("test-chain-key"))
 	ctx := context.Background()
 	tenantID := uuid.New()
 
@@ -703,7 +703,7 @@ func BenchmarkProcessHistoryRepository_ListByTaskID(b *testing.B) {
 	}
 	defer db.Close()
 
-	repo := NewProcessHistoryRepository(db)
+	repo := NewProcessHistoryRepository(db, model.HistoryChainKey("test-chain-key"))
 	ctx := context.Background()
 	tenantID := uuid.New()
 	taskID := uuid.New()
@@ -0,0 +1,291 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lk2023060901/go-next-erp/internal/approval/model"
+	"github.com/lk2023060901/go-next-erp/pkg/database"
+)
+
+// ProcessSearchRepository 流程实例检索仓储接口
+type ProcessSearchRepository interface {
+	// 可检索字段配置
+	ReplaceFields(ctx context.Context, processDefID uuid.UUID, fields []*model.ProcessSearchField) error
+	ListFields(ctx context.Context, processDefID uuid.UUID) ([]*model.ProcessSearchField, error)
+
+	// 检索索引
+	UpsertIndex(ctx context.Context, index *model.InstanceSearchIndex) error
+	DeleteIndex(ctx context.Context, instanceID uuid.UUID) error
+	Search(ctx context.Context, query *model.InstanceSearchQuery) ([]*model.InstanceSearchIndex, bool, error)
+}
+
+type processSearchRepo struct {
+	db *database.DB
+}
+
+// NewProcessSearchRepository 创建流程实例检索仓储
+func NewProcessSearchRepository(db *database.DB) ProcessSearchRepository {
+	return &processSearchRepo{db: db}
+}
+
+func (r *processSearchRepo) ReplaceFields(ctx context.Context, processDefID uuid.UUID, fields []*model.ProcessSearchField) error {
+	return r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `DELETE FROM approval_search_fields WHERE process_def_id = $1`, processDefID); err != nil {
+			return err
+		}
+
+		sql := `
+			INSERT INTO approval_search_fields (
+				id, tenant_id, process_def_id, field_key, field_label, field_type, sort, created_at, updated_at
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		`
+		for _, f := range fields {
+			if _, err := tx.Exec(ctx, sql,
+				f.ID,
+				f.TenantID,
+				processDefID,
+				f.FieldKey,
+				f.FieldLabel,
+				f.FieldType,
+				f.Sort,
+				f.CreatedAt,
+				f.UpdatedAt,
+			); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *processSearchRepo) ListFields(ctx context.Context, processDefID uuid.UUID) ([]*model.ProcessSearchField, error) {
+	sql := `
+		SELECT id, tenant_id, process_def_id, field_key, field_label, field_type, sort, created_at, updated_at
+		FROM approval_search_fields
+		WHERE process_def_id = $1
+		ORDER BY sort ASC, field_key ASC
+	`
+
+	rows, err := r.db.Query(ctx, sql, processDefID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var fields []*model.ProcessSearchField
+	for rows.Next() {
+		var f model.ProcessSearchField
+		if err := rows.Scan(
+			&f.ID,
+			&f.TenantID,
+			&f.ProcessDefID,
+			&f.FieldKey,
+			&f.FieldLabel,
+			&f.FieldType,
+			&f.Sort,
+			&f.CreatedAt,
+			&f.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		fields = append(fields, &f)
+	}
+
+	return fields, rows.Err()
+}
+
+func (r *processSearchRepo) UpsertIndex(ctx context.Context, index *model.InstanceSearchIndex) error {
+	valuesJSON, err := json.Marshal(index.FieldValues)
+	if err != nil {
+		return err
+	}
+
+	sql := `
+		INSERT INTO approval_instance_search_index (
+			process_instance_id, tenant_id, process_def_id, applicant_id, applicant_name,
+			title, status, field_values, comments, content, started_at, completed_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT (process_instance_id) DO UPDATE SET
+			applicant_name = EXCLUDED.applicant_name,
+			title = EXCLUDED.title,
+			status = EXCLUDED.status,
+			field_values = EXCLUDED.field_values,
+			comments = EXCLUDED.comments,
+			content = EXCLUDED.content,
+			completed_at = EXCLUDED.completed_at,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	_, err = r.db.Exec(ctx, sql,
+		index.ProcessInstanceID,
+		index.TenantID,
+		index.ProcessDefID,
+		index.ApplicantID,
+		index.ApplicantName,
+		index.Title,
+		index.Status,
+		valuesJSON,
+		index.Comments,
+		index.Content,
+		index.StartedAt,
+		index.CompletedAt,
+		index.UpdatedAt,
+	)
+
+	return err
+}
+
+func (r *processSearchRepo) DeleteIndex(ctx context.Context, instanceID uuid.UUID) error {
+	_, err := r.db.Exec(ctx, `DELETE FROM approval_instance_search_index WHERE process_instance_id = $1`, instanceID)
+	return err
+}
+
+// Search 检索流程实例（游标分页，多取一条用于判断是否有下一页）
+func (r *processSearchRepo) Search(ctx context.Context, query *model.InstanceSearchQuery) ([]*model.InstanceSearchIndex, bool, error) {
+	where, args := buildSearchWhere(query)
+
+	sortColumn := "started_at"
+	if query.SortBy == model.SearchSortByUpdatedAt {
+		sortColumn = "updated_at"
+	}
+	direction, operator := "ASC", ">"
+	if query.SortDesc {
+		direction, operator = "DESC", "<"
+	}
+
+	// 游标条件：(排序值, ID) 组合键保证翻页稳定
+	if query.AfterValue != nil && query.AfterID != nil {
+		args = append(args, *query.AfterValue, *query.AfterID)
+		where = append(where, fmt.Sprintf("(%s, process_instance_id) %s ($%d, $%d)", sortColumn, operator, len(args)-1, len(args)))
+	}
+
+	args = append(args, query.Limit+1)
+	sql := fmt.Sprintf(`
+		SELECT process_instance_id, tenant_id, process_def_id, applicant_id, applicant_name,
+		       title, status, field_values, comments, content, started_at, completed_at, updated_at
+		FROM approval_instance_search_index
+		WHERE %s
+		ORDER BY %s %s, process_instance_id %s
+		LIMIT $%d
+	`, strings.Join(where, " AND "), sortColumn, direction, direction, len(args))
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, false, err
+	}
+	defer rows.Close()
+
+	var results []*model.InstanceSearchIndex
+	for rows.Next() {
+		var idx model.InstanceSearchIndex
+		var valuesJSON []byte
+		if err := rows.Scan(
+			&idx.ProcessInstanceID,
+			&idx.TenantID,
+			&idx.ProcessDefID,
+			&idx.ApplicantID,
+			&idx.ApplicantName,
+			&idx.Title,
+			&idx.Status,
+			&valuesJSON,
+			&idx.Comments,
+			&idx.Content,
+			&idx.StartedAt,
+			&idx.CompletedAt,
+			&idx.UpdatedAt,
+		); err != nil {
+			return nil, false, err
+		}
+		if len(valuesJSON) > 0 {
+			if err := json.Unmarshal(valuesJSON, &idx.FieldValues); err != nil {
+				return nil, false, err
+			}
+		}
+		results = append(results, &idx)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, err
+	}
+
+	hasMore := len(results) > query.Limit
+	if hasMore {
+		results = results[:query.Limit]
+	}
+
+	return results, hasMore, nil
+}
+
+// buildSearchWhere 构建检索条件（所有取值均参数化）
+func buildSearchWhere(query *model.InstanceSearchQuery) ([]string, []interface{}) {
+	where := []string{"tenant_id = $1"}
+	args := []interface{}{query.TenantID}
+
+	next := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if query.ProcessDefID != nil {
+		where = append(where, "process_def_id = "+next(*query.ProcessDefID))
+	}
+	if query.Status != nil {
+		where = append(where, "status = "+next(*query.Status))
+	}
+	if query.ApplicantID != nil {
+		where = append(where, "applicant_id = "+next(*query.ApplicantID))
+	}
+	if query.StartDate != nil {
+		where = append(where, "started_at >= "+next(*query.StartDate))
+	}
+	if query.EndDate != nil {
+		where = append(where, "started_at <= "+next(*query.EndDate))
+	}
+
+	if kw := strings.TrimSpace(query.Keyword); kw != "" {
+		where = append(where, fmt.Sprintf("(content_tsv @@ plainto_tsquery('simple', %s) OR content ILIKE '%%' || %s || '%%' ESCAPE '\\')", next(kw), next(escapeLike(kw))))
+	}
+
+	for _, f := range query.FieldFilters {
+		key := next(f.Key)
+		field := fmt.Sprintf("(field_values->>%s)", key)
+		numeric := fmt.Sprintf("(CASE WHEN %s ~ '^-?[0-9]+(\\.[0-9]+)?$' THEN %s::numeric END)", field, field)
+
+		switch f.Op {
+		case model.FieldFilterLike:
+			where = append(where, fmt.Sprintf("%s ILIKE '%%' || %s || '%%' ESCAPE '\\'", field, next(escapeLike(f.Value))))
+		case model.FieldFilterGte:
+			where = append(where, compareExpr(field, numeric, ">=", f.Value, next))
+		case model.FieldFilterLte:
+			where = append(where, compareExpr(field, numeric, "<=", f.Value, next))
+		case model.FieldFilterBetween:
+			where = append(where, compareExpr(field, numeric, ">=", f.Value, next))
+			where = append(where, compareExpr(field, numeric, "<=", f.ValueTo, next))
+		default:
+			where = append(where, fmt.Sprintf("%s = %s", field, next(f.Value)))
+		}
+	}
+
+	return where, args
+}
+
+// likeEscaper 转义 LIKE 通配符，使关键字按字面匹配
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+// compareExpr 数值按 numeric 比较，其余（如 YYYY-MM-DD 日期）按字符串比较
+func compareExpr(field, numeric, op, value string, next func(interface{}) string) string {
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return fmt.Sprintf("%s %s %s::numeric", numeric, op, next(value))
+	}
+	return fmt.Sprintf("%s %s %s", field, op, next(value))
+}
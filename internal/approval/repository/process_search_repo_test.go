@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/approval/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildSearchWhere(t *testing.T) {
+	tenantID := uuid.New()
+	status := model.ProcessStatusApproved
+
+	t.Run("tenant only", func(t *testing.T) {
+		where, args := buildSearchWhere(&model.InstanceSearchQuery{TenantID: tenantID})
+		assert.Equal(t, []string{"tenant_id = $1"}, where)
+		assert.Equal(t, []interface{}{tenantID}, args)
+	})
+
+	t.Run("keyword and filters are parameterized", func(t *testing.T) {
+		where, args := buildSearchWhere(&model.InstanceSearchQuery{
+			TenantID: tenantID,
+			Status:   &status,
+			Keyword:  "'; DROP TABLE x; --",
+			FieldFilters: []model.FieldFilter{
+				{Key: "supplier", Op: model.FieldFilterLike, Value: "华"},
+				{Key: "amount", Op: model.FieldFilterBetween, Value: "100", ValueTo: "500.5"},
+				{Key: "due_date", Op: model.FieldFilterGte, Value: "2024-01-01"},
+				{Key: "type", Value: "A"},
+			},
+		})
+
+		clause := strings.Join(where, " AND ")
+		assert.NotContains(t, clause, "DROP")
+		assert.NotContains(t, clause, "supplier")
+		assert.Contains(t, clause, "plainto_tsquery('simple', $3)")
+		assert.Contains(t, clause, "::numeric")
+		assert.Contains(t, args, "'; DROP TABLE x; --")
+		assert.Contains(t, args, "500.5")
+		assert.Contains(t, args, "2024-01-01")
+		assert.Equal(t, tenantID, args[0])
+		assert.Equal(t, status, args[1])
+	})
+
+	t.Run("like wildcards match literally", func(t *testing.T) {
+		where, args := buildSearchWhere(&model.InstanceSearchQuery{
+			TenantID:     tenantID,
+			Keyword:      "100%_off",
+			FieldFilters: []model.FieldFilter{{Key: "code", Op: model.FieldFilterLike, Value: `a_b\c`}},
+		})
+
+		clause := strings.Join(where, " AND ")
+		assert.Contains(t, clause, "plainto_tsquery('simple', $2)")
+		assert.Contains(t, clause, "content ILIKE '%' || $3 || '%' ESCAPE '\\'")
+		// 全文检索仍使用原始关键字，ILIKE 使用转义后的关键字
+		assert.Equal(t, "100%_off", args[1])
+		assert.Equal(t, `100\%\_off`, args[2])
+		assert.Contains(t, args, `a\_b\\c`)
+	})
+}
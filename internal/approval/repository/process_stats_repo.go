@@ -0,0 +1,417 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lk2023060901/go-next-erp/internal/approval/model"
+	"github.com/lk2023060901/go-next-erp/pkg/database"
+)
+
+// ProcessStatsRepository 审批统计仓储接口
+type ProcessStatsRepository interface {
+	// 刷新（重算 since 所在周及之后的周度统计；tenantID 为空时重算所有租户）
+	RefreshWeekly(ctx context.Context, tenantID *uuid.UUID, since time.Time, loc *time.Location, now time.Time) error
+	SnapshotQueues(ctx context.Context, at time.Time) (int64, error)
+	PruneQueueSnapshots(ctx context.Context, before time.Time) (int64, error)
+
+	// 查询
+	ListNodeStats(ctx context.Context, query *model.StatsQuery) ([]*model.NodeWeeklyStats, error)
+	ListSlowApprovers(ctx context.Context, query *model.StatsQuery) ([]*model.ApproverWeeklyStats, error)
+	ListRejectionReasons(ctx context.Context, query *model.StatsQuery) ([]*model.RejectionReasonStats, error)
+	ListProcessWeekly(ctx context.Context, query *model.StatsQuery) ([]*model.ProcessWeeklyStats, error)
+	SumProcessSpan(ctx context.Context, query *model.StatsQuery) ([]*model.ProcessWeeklyStats, error)
+	ListQueueSnapshots(ctx context.Context, query *model.StatsQuery) ([]*model.QueueSnapshot, error)
+}
+
+type processStatsRepo struct {
+	db *database.DB
+}
+
+// NewProcessStatsRepository 创建审批统计仓储
+func NewProcessStatsRepository(db *database.DB) ProcessStatsRepository {
+	return &processStatsRepo{db: db}
+}
+
+// 周度清理 SQL：$1 起始日期（周一），$2 租户（为空时所有租户）
+var weeklyDeleteSQL = []string{
+	`DELETE FROM approval_stats_node_weekly WHERE week_start >= $1::date AND ($2::uuid IS NULL OR tenant_id = $2)`,
+	`DELETE FROM approval_stats_approver_weekly WHERE week_start >= $1::date AND ($2::uuid IS NULL OR tenant_id = $2)`,
+	`DELETE FROM approval_stats_rejection_reasons WHERE week_start >= $1::date AND ($2::uuid IS NULL OR tenant_id = $2)`,
+	`DELETE FROM approval_stats_process_weekly WHERE week_start >= $1::date AND ($2::uuid IS NULL OR tenant_id = $2)`,
+}
+
+// 周度聚合 SQL：$1 起始日期（周一），$2 时区，$3 刷新时间，$4 租户（为空时所有租户）
+var weeklyRefreshSQL = []string{
+
+	`INSERT INTO approval_stats_node_weekly (
+		tenant_id, process_def_id, node_id, node_name, week_start,
+		task_count, approved_count, rejected_count, avg_seconds, median_seconds, p90_seconds, refreshed_at
+	)
+	SELECT t.tenant_id, i.process_def_id, t.node_id, MAX(t.node_name),
+	       date_trunc('week', t.approved_at AT TIME ZONE $2)::date AS week_start,
+	       COUNT(*),
+	       COUNT(*) FILTER (WHERE t.status = 'approved'),
+	       COUNT(*) FILTER (WHERE t.status = 'rejected'),
+	       AVG(d.seconds),
+	       percentile_cont(0.5) WITHIN GROUP (ORDER BY d.seconds),
+	       percentile_cont(0.9) WITHIN GROUP (ORDER BY d.seconds),
+	       $3
+	FROM approval_tasks t
+	JOIN approval_process_instances i ON i.id = t.process_instance_id
+	CROSS JOIN LATERAL (SELECT EXTRACT(EPOCH FROM (t.approved_at - t.created_at))::float8 AS seconds) d
+	WHERE t.approved_at IS NOT NULL AND (t.approved_at AT TIME ZONE $2)::date >= $1::date
+	  AND ($4::uuid IS NULL OR t.tenant_id = $4)
+	GROUP BY t.tenant_id, i.process_def_id, t.node_id, week_start`,
+
+	`INSERT INTO approval_stats_approver_weekly (
+		tenant_id, assignee_id, assignee_name, week_start, task_count, avg_seconds, p90_seconds, refreshed_at
+	)
+	SELECT t.tenant_id, t.assignee_id, MAX(t.assignee_name),
+	       date_trunc('week', t.approved_at AT TIME ZONE $2)::date AS week_start,
+	       COUNT(*),
+	       AVG(d.seconds),
+	       percentile_cont(0.9) WITHIN GROUP (ORDER BY d.seconds),
+	       $3
+	FROM approval_tasks t
+	CROSS JOIN LATERAL (SELECT EXTRACT(EPOCH FROM (t.approved_at - t.created_at))::float8 AS seconds) d
+	WHERE t.approved_at IS NOT NULL AND (t.approved_at AT TIME ZONE $2)::date >= $1::date
+	  AND ($4::uuid IS NULL OR t.tenant_id = $4)
+	GROUP BY t.tenant_id, t.assignee_id, week_start`,
+
+	`INSERT INTO approval_stats_rejection_reasons (
+		tenant_id, process_def_id, node_id, node_name, week_start, reason, count
+	)
+	SELECT t.tenant_id, i.process_def_id, t.node_id, MAX(t.node_name),
+	       date_trunc('week', t.approved_at AT TIME ZONE $2)::date AS week_start,
+	       LEFT(regexp_replace(btrim(COALESCE(t.comment, '')), '\s+', ' ', 'g'), 200) AS reason,
+	       COUNT(*)
+	FROM approval_tasks t
+	JOIN approval_process_instances i ON i.id = t.process_instance_id
+	WHERE t.status = 'rejected' AND t.approved_at IS NOT NULL AND (t.approved_at AT TIME ZONE $2)::date >= $1::date
+	  AND ($4::uuid IS NULL OR t.tenant_id = $4)
+	GROUP BY t.tenant_id, i.process_def_id, t.node_id, week_start, reason`,
+
+	`WITH started AS (
+		SELECT tenant_id, process_def_id, MAX(process_def_name) AS name,
+		       date_trunc('week', started_at AT TIME ZONE $2)::date AS week_start,
+		       COUNT(*) AS cnt
+		FROM approval_process_instances
+		WHERE (started_at AT TIME ZONE $2)::date >= $1::date
+		  AND ($4::uuid IS NULL OR tenant_id = $4)
+		GROUP BY tenant_id, process_def_id, week_start
+	), completed AS (
+		SELECT tenant_id, process_def_id, MAX(process_def_name) AS name,
+		       date_trunc('week', completed_at AT TIME ZONE $2)::date AS week_start,
+		       COUNT(*) AS cnt,
+		       COUNT(*) FILTER (WHERE status = 'approved') AS approved,
+		       COUNT(*) FILTER (WHERE status = 'rejected') AS rejected,
+		       AVG(EXTRACT(EPOCH FROM (completed_at - started_at))::float8) AS avg_s,
+		       percentile_cont(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM (completed_at - started_at))::float8) AS p90_s
+		FROM approval_process_instances
+		WHERE completed_at IS NOT NULL AND (completed_at AT TIME ZONE $2)::date >= $1::date
+		  AND ($4::uuid IS NULL OR tenant_id = $4)
+		GROUP BY tenant_id, process_def_id, week_start
+	)
+	INSERT INTO approval_stats_process_weekly (
+		tenant_id, process_def_id, process_def_name, week_start, started_count, completed_count,
+		approved_count, rejected_count, avg_cycle_seconds, p90_cycle_seconds, refreshed_at
+	)
+	SELECT COALESCE(s.tenant_id, c.tenant_id), COALESCE(s.process_def_id, c.process_def_id),
+	       COALESCE(s.name, c.name), COALESCE(s.week_start, c.week_start),
+	       COALESCE(s.cnt, 0), COALESCE(c.cnt, 0), COALESCE(c.approved, 0), COALESCE(c.rejected, 0),
+	       COALESCE(c.avg_s, 0), COALESCE(c.p90_s, 0), $3
+	FROM started s
+	FULL OUTER JOIN completed c
+	  ON s.tenant_id = c.tenant_id AND s.process_def_id = c.process_def_id AND s.week_start = c.week_start`,
+}
+
+func (r *processStatsRepo) RefreshWeekly(ctx context.Context, tenantID *uuid.UUID, since time.Time, loc *time.Location, now time.Time) error {
+	sinceDate := since.In(loc).Format("2006-01-02")
+
+	return r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		for i, sql := range weeklyDeleteSQL {
+			if _, err := tx.Exec(ctx, sql, sinceDate, tenantID); err != nil {
+				return fmt.Errorf("refresh delete step %d: %w", i, err)
+			}
+		}
+		for i, sql := range weeklyRefreshSQL {
+			if _, err := tx.Exec(ctx, sql, sinceDate, loc.String(), now, tenantID); err != nil {
+				return fmt.Errorf("refresh step %d: %w", i, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *processStatsRepo) SnapshotQueues(ctx context.Context, at time.Time) (int64, error) {
+	sql := `
+		INSERT INTO approval_stats_queue_snapshots (
+			tenant_id, process_def_id, node_id, node_name, snapshot_at, pending_count, oldest_pending_seconds
+		)
+		SELECT t.tenant_id, i.process_def_id, t.node_id, MAX(t.node_name), $1,
+		       COUNT(*), EXTRACT(EPOCH FROM ($1 - MIN(t.created_at)))::float8
+		FROM approval_tasks t
+		JOIN approval_process_instances i ON i.id = t.process_instance_id
+		WHERE t.status = 'pending'
+		GROUP BY t.tenant_id, i.process_def_id, t.node_id
+		ON CONFLICT DO NOTHING
+	`
+
+	tag, err := r.db.Exec(ctx, sql, at)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+func (r *processStatsRepo) PruneQueueSnapshots(ctx context.Context, before time.Time) (int64, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM approval_stats_queue_snapshots WHERE snapshot_at < $1`, before)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+func (r *processStatsRepo) ListNodeStats(ctx context.Context, query *model.StatsQuery) ([]*model.NodeWeeklyStats, error) {
+	where, args := statsWhere(query, "week_start", true, true)
+	sql := fmt.Sprintf(`
+		SELECT tenant_id, process_def_id, node_id, node_name, week_start,
+		       task_count, approved_count, rejected_count, avg_seconds, median_seconds, p90_seconds, refreshed_at
+		FROM approval_stats_node_weekly
+		WHERE %s
+		ORDER BY process_def_id, node_id, week_start
+	`, where)
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stats []*model.NodeWeeklyStats
+	for rows.Next() {
+		var s model.NodeWeeklyStats
+		if err := rows.Scan(
+			&s.TenantID, &s.ProcessDefID, &s.NodeID, &s.NodeName, &s.WeekStart,
+			&s.TaskCount, &s.ApprovedCount, &s.RejectedCount, &s.AvgSeconds, &s.MedianSeconds, &s.P90Seconds, &s.RefreshedAt,
+		); err != nil {
+			return nil, err
+		}
+		stats = append(stats, &s)
+	}
+
+	return stats, rows.Err()
+}
+
+// ListSlowApprovers 按区间内平均处理时长倒序的审批人排行（P90 取区间内最差一周）
+func (r *processStatsRepo) ListSlowApprovers(ctx context.Context, query *model.StatsQuery) ([]*model.ApproverWeeklyStats, error) {
+	where, args := statsWhere(query, "week_start", false, false)
+	args = append(args, query.MinTasks, query.Limit)
+	sql := fmt.Sprintf(`
+		SELECT tenant_id, assignee_id, MAX(assignee_name), MIN(week_start),
+		       SUM(task_count)::int,
+		       SUM(avg_seconds * task_count) / NULLIF(SUM(task_count), 0),
+		       MAX(p90_seconds),
+		       MAX(refreshed_at)
+		FROM approval_stats_approver_weekly
+		WHERE %s
+		GROUP BY tenant_id, assignee_id
+		HAVING SUM(task_count) >= $%d
+		ORDER BY 6 DESC NULLS LAST, assignee_id
+		LIMIT $%d
+	`, where, len(args)-1, len(args))
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stats []*model.ApproverWeeklyStats
+	for rows.Next() {
+		var s model.ApproverWeeklyStats
+		if err := rows.Scan(
+			&s.TenantID, &s.AssigneeID, &s.AssigneeName, &s.WeekStart,
+			&s.TaskCount, &s.AvgSeconds, &s.P90Seconds, &s.RefreshedAt,
+		); err != nil {
+			return nil, err
+		}
+		stats = append(stats, &s)
+	}
+
+	return stats, rows.Err()
+}
+
+// ListRejectionReasons 区间内各节点拒绝原因汇总（按次数倒序）
+func (r *processStatsRepo) ListRejectionReasons(ctx context.Context, query *model.StatsQuery) ([]*model.RejectionReasonStats, error) {
+	where, args := statsWhere(query, "week_start", true, true)
+	args = append(args, query.Limit)
+	sql := fmt.Sprintf(`
+		SELECT tenant_id, process_def_id, node_id, MAX(node_name), MIN(week_start), reason, SUM(count)::int AS total
+		FROM approval_stats_rejection_reasons
+		WHERE %s
+		GROUP BY tenant_id, process_def_id, node_id, reason
+		ORDER BY total DESC, node_id, reason
+		LIMIT $%d
+	`, where, len(args))
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stats []*model.RejectionReasonStats
+	for rows.Next() {
+		var s model.RejectionReasonStats
+		if err := rows.Scan(&s.TenantID, &s.ProcessDefID, &s.NodeID, &s.NodeName, &s.WeekStart, &s.Reason, &s.Count); err != nil {
+			return nil, err
+		}
+		stats = append(stats, &s)
+	}
+
+	return stats, rows.Err()
+}
+
+func (r *processStatsRepo) ListProcessWeekly(ctx context.Context, query *model.StatsQuery) ([]*model.ProcessWeeklyStats, error) {
+	where, args := statsWhere(query, "week_start", true, false)
+	sql := fmt.Sprintf(`
+		SELECT tenant_id, process_def_id, process_def_name, week_start, started_count, completed_count,
+		       approved_count, rejected_count, avg_cycle_seconds, p90_cycle_seconds, refreshed_at
+		FROM approval_stats_process_weekly
+		WHERE %s
+		ORDER BY process_def_id, week_start
+	`, where)
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stats []*model.ProcessWeeklyStats
+	for rows.Next() {
+		var s model.ProcessWeeklyStats
+		if err := rows.Scan(
+			&s.TenantID, &s.ProcessDefID, &s.ProcessDefName, &s.WeekStart, &s.StartedCount, &s.CompletedCount,
+			&s.ApprovedCount, &s.RejectedCount, &s.AvgCycleSeconds, &s.P90CycleSeconds, &s.RefreshedAt,
+		); err != nil {
+			return nil, err
+		}
+		stats = append(stats, &s)
+	}
+
+	return stats, rows.Err()
+}
+
+// SumProcessSpan 直接从流程实例汇总 [From, To) 区间内各流程定义的发起/完成情况（用于同期环比）
+func (r *processStatsRepo) SumProcessSpan(ctx context.Context, query *model.StatsQuery) ([]*model.ProcessWeeklyStats, error) {
+	where := "tenant_id = $1"
+	args := []interface{}{query.TenantID, query.From, query.To}
+	if query.ProcessDefID != nil {
+		args = append(args, *query.ProcessDefID)
+		where += fmt.Sprintf(" AND process_def_id = $%d", len(args))
+	}
+
+	sql := fmt.Sprintf(`
+		WITH started AS (
+			SELECT process_def_id, MAX(process_def_name) AS name, COUNT(*) AS cnt
+			FROM approval_process_instances
+			WHERE %[1]s AND started_at >= $2 AND started_at < $3
+			GROUP BY process_def_id
+		), completed AS (
+			SELECT process_def_id, MAX(process_def_name) AS name,
+			       COUNT(*) AS cnt,
+			       COUNT(*) FILTER (WHERE status = 'approved') AS approved,
+			       COUNT(*) FILTER (WHERE status = 'rejected') AS rejected,
+			       AVG(EXTRACT(EPOCH FROM (completed_at - started_at))::float8) AS avg_s,
+			       percentile_cont(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM (completed_at - started_at))::float8) AS p90_s
+			FROM approval_process_instances
+			WHERE %[1]s AND completed_at >= $2 AND completed_at < $3
+			GROUP BY process_def_id
+		)
+		SELECT COALESCE(s.process_def_id, c.process_def_id), COALESCE(s.name, c.name),
+		       COALESCE(s.cnt, 0)::int, COALESCE(c.cnt, 0)::int, COALESCE(c.approved, 0)::int, COALESCE(c.rejected, 0)::int,
+		       COALESCE(c.avg_s, 0), COALESCE(c.p90_s, 0)
+		FROM started s
+		FULL OUTER JOIN completed c ON s.process_def_id = c.process_def_id
+		ORDER BY 1
+	`, where)
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stats []*model.ProcessWeeklyStats
+	for rows.Next() {
+		s := model.ProcessWeeklyStats{TenantID: query.TenantID, WeekStart: query.From, RefreshedAt: query.To}
+		if err := rows.Scan(
+			&s.ProcessDefID, &s.ProcessDefName, &s.StartedCount, &s.CompletedCount,
+			&s.ApprovedCount, &s.RejectedCount, &s.AvgCycleSeconds, &s.P90CycleSeconds,
+		); err != nil {
+			return nil, err
+		}
+		stats = append(stats, &s)
+	}
+
+	return stats, rows.Err()
+}
+
+func (r *processStatsRepo) ListQueueSnapshots(ctx context.Context, query *model.StatsQuery) ([]*model.QueueSnapshot, error) {
+	where, args := statsWhere(query, "snapshot_at", true, true)
+	sql := fmt.Sprintf(`
+		SELECT tenant_id, process_def_id, node_id, node_name, snapshot_at, pending_count, oldest_pending_seconds
+		FROM approval_stats_queue_snapshots
+		WHERE %s
+		ORDER BY snapshot_at, process_def_id, node_id
+	`, where)
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var snapshots []*model.QueueSnapshot
+	for rows.Next() {
+		var s model.QueueSnapshot
+		if err := rows.Scan(&s.TenantID, &s.ProcessDefID, &s.NodeID, &s.NodeName, &s.SnapshotAt, &s.PendingCount, &s.OldestPendingSeconds); err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, &s)
+	}
+
+	return snapshots, rows.Err()
+}
+
+// statsWhere 构建统计查询条件（租户 + 时间区间，可选流程定义/节点）
+// 周度表的 week_start 为 DATE，区间按日期比较
+func statsWhere(query *model.StatsQuery, timeColumn string, withProcess, withNode bool) (string, []interface{}) {
+	var from, to interface{} = query.From, query.To
+	cast := ""
+	if timeColumn == "week_start" {
+		from, to = query.From.Format("2006-01-02"), query.To.Format("2006-01-02")
+		cast = "::date"
+	}
+
+	where := []string{"tenant_id = $1", timeColumn + " >= $2" + cast, timeColumn + " <= $3" + cast}
+	args := []interface{}{query.TenantID, from, to}
+
+	if withProcess && query.ProcessDefID != nil {
+		args = append(args, *query.ProcessDefID)
+		where = append(where, fmt.Sprintf("process_def_id = $%d", len(args)))
+	}
+	if withNode && query.NodeID != nil {
+		args = append(args, *query.NodeID)
+		where = append(where, fmt.Sprintf("node_id = $%d", len(args)))
+	}
+
+	return strings.Join(where, " AND "), args
+}
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/approval/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatsWhere(t *testing.T) {
+	tenantID := uuid.New()
+	processDefID := uuid.New()
+	nodeID := "node_1"
+	loc := time.FixedZone("CST", 8*3600)
+	query := &model.StatsQuery{
+		TenantID:     tenantID,
+		ProcessDefID: &processDefID,
+		NodeID:       &nodeID,
+		From:         time.Date(2024, 2, 12, 0, 0, 0, 0, loc),
+		To:           time.Date(2024, 3, 4, 0, 0, 0, 0, loc),
+	}
+
+	t.Run("weekly tables compare dates", func(t *testing.T) {
+		where, args := statsWhere(query, "week_start", true, true)
+		assert.Equal(t, "tenant_id = $1 AND week_start >= $2::date AND week_start <= $3::date AND process_def_id = $4 AND node_id = $5", where)
+		assert.Equal(t, []interface{}{tenantID, "2024-02-12", "2024-03-04", processDefID, nodeID}, args)
+	})
+
+	t.Run("approver table ignores process and node", func(t *testing.T) {
+		where, args := statsWhere(query, "week_start", false, false)
+		assert.Equal(t, "tenant_id = $1 AND week_start >= $2::date AND week_start <= $3::date", where)
+		assert.Len(t, args, 3)
+	})
+
+	t.Run("snapshots compare timestamps", func(t *testing.T) {
+		where, args := statsWhere(query, "snapshot_at", true, false)
+		assert.Equal(t, "tenant_id = $1 AND snapshot_at >= $2 AND snapshot_at <= $3 AND process_def_id = $4", where)
+		assert.Equal(t, query.From, args[1])
+	})
+}
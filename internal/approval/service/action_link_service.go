@@ -0,0 +1,537 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/approval/dto"
+	"github.com/lk2023060901/go-next-erp/internal/approval/model"
+	"github.com/lk2023060901/go-next-erp/internal/approval/repository"
+	authModel "github.com/lk2023060901/go-next-erp/internal/auth/model"
+	authRepo "github.com/lk2023060901/go-next-erp/internal/auth/repository"
+)
+
+var (
+	ErrInvalidActionLink   = errors.New("invalid approval action link")
+	ErrActionLinkExpired   = errors.New("approval action link expired")
+	ErrActionLinkUsed      = errors.New("approval action link already used")
+	ErrInvalidInboundReply = errors.New("invalid inbound reply")
+	ErrUnknownReplyAction  = errors.New("cannot determine approve/reject from reply")
+)
+
+// 审计动作
+const (
+	AuditActionLinkExecuted = "approval.action_link.executed"
+	AuditActionLinkReused   = "approval.action_link.reused"
+	AuditActionLinkInvalid  = "approval.action_link.invalid"
+	AuditActionLinkFailed   = "approval.action_link.failed"
+)
+
+// 入站邮件时间戳允许的最大偏差
+const inboundReplyMaxSkew = 15 * time.Minute
+
+// ActionLinkConfig 审批快捷链接配置
+type ActionLinkConfig struct {
+	Secret        []byte
+	BaseURL       string
+	TTL           time.Duration
+	ReplyDomain   string
+	InboundSecret []byte
+}
+
+// ActionLinkClaims 链接令牌签名载荷
+type ActionLinkClaims struct {
+	TokenID    uuid.UUID            `json:"jti"`
+	TenantID   uuid.UUID            `json:"tid"`
+	TaskID     uuid.UUID            `json:"tsk"`
+	AssigneeID uuid.UUID            `json:"usr"`
+	Action     model.ApprovalAction `json:"act"`
+	ExpiresAt  int64                `json:"exp"`
+}
+
+// ActionLinkSigner 审批快捷链接签发器（HMAC 绑定任务、审批人和操作）
+type ActionLinkSigner struct {
+	config    *ActionLinkConfig
+	tokenRepo repository.ActionTokenRepository
+}
+
+// NewActionLinkSigner 创建审批快捷链接签发器
+func NewActionLinkSigner(config *ActionLinkConfig, tokenRepo repository.ActionTokenRepository) *ActionLinkSigner {
+	return &ActionLinkSigner{
+		config:    config,
+		tokenRepo: tokenRepo,
+	}
+}
+
+// Enabled 是否已配置签名密钥
+func (s *ActionLinkSigner) Enabled() bool {
+	return s != nil && s.config != nil && len(s.config.Secret) > 0
+}
+
+// Issue 为任务签发一次性令牌并持久化
+func (s *ActionLinkSigner) Issue(ctx context.Context, task *model.ApprovalTask, action model.ApprovalAction) (string, error) {
+	now := time.Now()
+	claims := &ActionLinkClaims{
+		TokenID:    uuid.New(),
+		TenantID:   task.TenantID,
+		TaskID:     task.ID,
+		AssigneeID: task.AssigneeID,
+		Action:     action,
+		ExpiresAt:  now.Add(s.config.TTL).Unix(),
+	}
+
+	if err := s.tokenRepo.Create(ctx, &model.ActionLinkToken{
+		ID:         claims.TokenID,
+		TenantID:   claims.TenantID,
+		TaskID:     claims.TaskID,
+		AssigneeID: claims.AssigneeID,
+		Action:     action,
+		ExpiresAt:  time.Unix(claims.ExpiresAt, 0),
+		CreatedAt:  now,
+	}); err != nil {
+		return "", fmt.Errorf("failed to save action token: %w", err)
+	}
+
+	return s.Sign(claims)
+}
+
+// Sign 生成令牌字符串：base64url(payload).base64url(hmac)
+func (s *ActionLinkSigner) Sign(claims *ActionLinkClaims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(s.mac(encoded)), nil
+}
+
+// Parse 校验签名和有效期并返回载荷
+func (s *ActionLinkSigner) Parse(token string) (*ActionLinkClaims, error) {
+	encoded, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, ErrInvalidActionLink
+	}
+
+	expected, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(expected, s.mac(encoded)) {
+		return nil, ErrInvalidActionLink
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidActionLink
+	}
+
+	var claims ActionLinkClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, ErrInvalidActionLink
+	}
+
+	if time.Now().Unix() > claims.ExpiresAt {
+		return &claims, ErrActionLinkExpired
+	}
+
+	return &claims, nil
+}
+
+// URL 生成确认页链接
+func (s *ActionLinkSigner) URL(token string) string {
+	sep := "?"
+	if strings.Contains(s.config.BaseURL, "?") {
+		sep = "&"
+	}
+	return s.config.BaseURL + sep + "token=" + url.QueryEscape(token)
+}
+
+// ReplyAddress 生成邮件回复地址（approval+<token>@domain）
+func (s *ActionLinkSigner) ReplyAddress(token string) string {
+	if s.config.ReplyDomain == "" {
+		return ""
+	}
+	return fmt.Sprintf("approval+%s@%s", token, s.config.ReplyDomain)
+}
+
+// VerifyInbound 校验入站邮件 Webhook 签名：hex(hmac(InboundSignaturePayload))，签名覆盖回复内容，
+// 时间戳超出允许偏差的请求拒绝；随机数是否重复由调用方校验
+func (s *ActionLinkSigner) VerifyInbound(req *dto.InboundReplyRequest, now time.Time) bool {
+	if len(s.config.InboundSecret) == 0 || req.Nonce == "" {
+		return false
+	}
+
+	ts, err := strconv.ParseInt(req.Timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	skew := now.Sub(time.Unix(ts, 0))
+	if skew > inboundReplyMaxSkew || skew < -inboundReplyMaxSkew {
+		return false
+	}
+
+	expected, err := hex.DecodeString(req.Signature)
+	if err != nil {
+		return false
+	}
+
+	h := hmac.New(sha256.New, s.config.InboundSecret)
+	h.Write([]byte(InboundSignaturePayload(req)))
+	return hmac.Equal(expected, h.Sum(nil))
+}
+
+// InboundSignaturePayload 入站邮件签名原文：时间戳、随机数、发件人、收件人、主题、正文按换行拼接
+func InboundSignaturePayload(req *dto.InboundReplyRequest) string {
+	return strings.Join([]string{req.Timestamp, req.Nonce, req.From, req.To, req.Subject, req.Text}, "\n")
+}
+
+func (s *ActionLinkSigner) mac(data string) []byte {
+	h := hmac.New(sha256.New, s.config.Secret)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// ActionLinkService 审批快捷链接服务接口（免登录、凭签名令牌审批）
+type ActionLinkService interface {
+	// PreviewLink 校验令牌并返回待确认的任务信息（不消耗令牌）
+	PreviewLink(ctx context.Context, token string) (*dto.ActionLinkPreview, error)
+	// ExecuteLink 消耗令牌并执行审批
+	ExecuteLink(ctx context.Context, req *dto.ExecuteActionLinkRequest) (*dto.ActionLinkResult, error)
+	// HandleInboundReply 处理入站邮件回复
+	HandleInboundReply(ctx context.Context, req *dto.InboundReplyRequest) (*dto.ActionLinkResult, error)
+}
+
+type actionLinkService struct {
+	signer          *ActionLinkSigner
+	tokenRepo       repository.ActionTokenRepository
+	taskRepo        repository.ApprovalTaskRepository
+	approvalService ApprovalService
+	userRepo        authRepo.UserRepository
+	auditRepo       authRepo.AuditLogRepository
+}
+
+// NewActionLinkService 创建审批快捷链接服务
+func NewActionLinkService(
+	signer *ActionLinkSigner,
+	tokenRepo repository.ActionTokenRepository,
+	taskRepo repository.ApprovalTaskRepository,
+	approvalService ApprovalService,
+	userRepo authRepo.UserRepository,
+	auditRepo authRepo.AuditLogRepository,
+) ActionLinkService {
+	return &actionLinkService{
+		signer:          signer,
+		tokenRepo:       tokenRepo,
+		taskRepo:        taskRepo,
+		approvalService: approvalService,
+		userRepo:        userRepo,
+		auditRepo:       auditRepo,
+	}
+}
+
+// PreviewLink 预览链接（邮件客户端可能预取 GET 链接，因此 GET 只做校验不执行）
+func (s *actionLinkService) PreviewLink(ctx context.Context, token string) (*dto.ActionLinkPreview, error) {
+	claims, record, err := s.validate(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := s.taskRepo.FindByID(ctx, claims.TaskID)
+	if err != nil {
+		return nil, ErrTaskNotFound
+	}
+
+	return &dto.ActionLinkPreview{
+		TaskID:            task.ID,
+		ProcessInstanceID: task.ProcessInstanceID,
+		NodeName:          task.NodeName,
+		Action:            record.Action,
+		TaskStatus:        task.Status,
+		ExpiresAt:         record.ExpiresAt,
+	}, nil
+}
+
+// ExecuteLink 执行链接中的审批操作
+func (s *actionLinkService) ExecuteLink(ctx context.Context, req *dto.ExecuteActionLinkRequest) (*dto.ActionLinkResult, error) {
+	claims, _, err := s.validate(ctx, req.Token)
+	if err != nil {
+		s.audit(ctx, claims, auditActionFor(err), req.ClientIP, req.UserAgent, err)
+		return nil, err
+	}
+
+	if claims.Action != model.ApprovalActionApprove && claims.Action != model.ApprovalActionReject {
+		s.audit(ctx, claims, AuditActionLinkInvalid, req.ClientIP, req.UserAgent, ErrInvalidActionLink)
+		return nil, ErrInvalidActionLink
+	}
+
+	return s.consume(ctx, claims, claims.Action, req.Comment, req.ClientIP, req.UserAgent)
+}
+
+// HandleInboundReply 解析邮件回复中的同意/拒绝并执行
+func (s *actionLinkService) HandleInboundReply(ctx context.Context, req *dto.InboundReplyRequest) (*dto.ActionLinkResult, error) {
+	now := time.Now()
+	if !s.signer.VerifyInbound(req, now) {
+		return nil, ErrInvalidInboundReply
+	}
+	// 签名有效期内同一随机数只接受一次，防止截获的请求被重放
+	fresh, err := s.tokenRepo.UseInboundNonce(ctx, req.Nonce, now, now.Add(-2*inboundReplyMaxSkew))
+	if err != nil {
+		return nil, fmt.Errorf("failed to record inbound nonce: %w", err)
+	}
+	if !fresh {
+		return nil, ErrInvalidInboundReply
+	}
+
+	token := ExtractReplyToken(req.To)
+	if token == "" {
+		token = ExtractReplyToken(req.Text)
+	}
+	if token == "" {
+		return nil, ErrInvalidInboundReply
+	}
+
+	claims, _, err := s.validate(ctx, token)
+	if err != nil {
+		s.audit(ctx, claims, auditActionFor(err), "inbound-email", req.From, err)
+		return nil, err
+	}
+	if claims.Action != model.ActionLinkReply {
+		s.audit(ctx, claims, AuditActionLinkInvalid, "inbound-email", req.From, ErrInvalidActionLink)
+		return nil, ErrInvalidActionLink
+	}
+
+	// 发件人必须是任务审批人本人
+	if s.userRepo != nil {
+		user, err := s.userRepo.FindByID(ctx, claims.AssigneeID)
+		if err != nil || !strings.EqualFold(user.Email, ExtractEmailAddress(req.From)) {
+			s.audit(ctx, claims, AuditActionLinkInvalid, "inbound-email", req.From, ErrUnauthorized)
+			return nil, ErrUnauthorized
+		}
+	}
+
+	action, comment := ParseReplyAction(req.Text)
+	if action == "" {
+		return nil, ErrUnknownReplyAction
+	}
+
+	return s.consume(ctx, claims, action, comment, "inbound-email", req.From)
+}
+
+// validate 校验签名、有效期及令牌记录，返回的 claims 在签名有效时非空（用于审计）
+func (s *actionLinkService) validate(ctx context.Context, token string) (*ActionLinkClaims, *model.ActionLinkToken, error) {
+	claims, err := s.signer.Parse(token)
+	if err != nil {
+		return claims, nil, err
+	}
+
+	record, err := s.tokenRepo.FindByID(ctx, claims.TokenID)
+	if err != nil {
+		return claims, nil, ErrInvalidActionLink
+	}
+
+	// 令牌记录必须与签名载荷一致
+	if record.TaskID != claims.TaskID || record.AssigneeID != claims.AssigneeID || record.Action != claims.Action {
+		return claims, nil, ErrInvalidActionLink
+	}
+	if record.IsUsed() {
+		return claims, record, ErrActionLinkUsed
+	}
+
+	return claims, record, nil
+}
+
+// consume 以审批人身份处理任务，处理成功后才消耗令牌并作废同一任务的其他链接（同一事务），
+// 处理失败时令牌仍可使用
+func (s *actionLinkService) consume(
+	ctx context.Context,
+	claims *ActionLinkClaims,
+	action model.ApprovalAction,
+	comment *string,
+	clientIP, userAgent string,
+) (*dto.ActionLinkResult, error) {
+	var processErr error
+	consumed, err := s.tokenRepo.Consume(ctx, claims.TokenID, time.Now(), clientIP, func(ctx context.Context) error {
+		processErr = s.approvalService.ProcessTask(ctx, &dto.ProcessTaskRequest{
+			TaskID:     claims.TaskID,
+			OperatorID: claims.AssigneeID,
+			Action:     action,
+			Comment:    comment,
+		})
+		return processErr
+	})
+	if processErr != nil {
+		s.audit(ctx, claims, AuditActionLinkFailed, clientIP, userAgent, processErr)
+		return nil, processErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to consume action token: %w", err)
+	}
+	if !consumed {
+		s.audit(ctx, claims, AuditActionLinkReused, clientIP, userAgent, ErrActionLinkUsed)
+		return nil, ErrActionLinkUsed
+	}
+
+	s.audit(ctx, claims, AuditActionLinkExecuted, clientIP, userAgent, nil)
+
+	return &dto.ActionLinkResult{
+		TaskID:    claims.TaskID,
+		Action:    action,
+		Processed: true,
+	}, nil
+}
+
+func (s *actionLinkService) audit(ctx context.Context, claims *ActionLinkClaims, action, clientIP, userAgent string, cause error) {
+	if s.auditRepo == nil {
+		return
+	}
+
+	log := &authModel.AuditLog{
+		EventID:   uuid.New().String(),
+		Action:    action,
+		Resource:  "approval_task",
+		IPAddress: clientIP,
+		UserAgent: userAgent,
+		Result:    authModel.AuditResultSuccess,
+		CreatedAt: time.Now(),
+	}
+	if claims != nil {
+		log.TenantID = claims.TenantID
+		log.UserID = claims.AssigneeID
+		log.ResourceID = claims.TaskID.String()
+		log.Metadata = map[string]interface{}{
+			"token_id": claims.TokenID.String(),
+			"action":   string(claims.Action),
+		}
+	}
+	if cause != nil {
+		log.Result = authModel.AuditResultDenied
+		log.ErrorMsg = cause.Error()
+	}
+
+	_ = s.auditRepo.Create(ctx, log)
+}
+
+func auditActionFor(err error) string {
+	if errors.Is(err, ErrActionLinkUsed) {
+		return AuditActionLinkReused
+	}
+	return AuditActionLinkInvalid
+}
+
+var (
+	replyTokenPattern = regexp.MustCompile(`approval\+([A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+)@`)
+	emailPattern      = regexp.MustCompile(`[^\s<>"]+@[^\s<>"]+`)
+)
+
+// ExtractReplyToken 从回复地址（approval+<token>@domain）中提取令牌
+func ExtractReplyToken(s string) string {
+	m := replyTokenPattern.FindStringSubmatch(s)
+	if len(m) < 2 {
+		return ""
+	}
+	return m[1]
+}
+
+// ExtractEmailAddress 从 "Name <a@b.com>" 格式中提取邮箱
+func ExtractEmailAddress(s string) string {
+	return emailPattern.FindString(s)
+}
+
+var (
+	replyApproveWords = []string{"approve", "approved", "agree", "yes", "ok", "同意", "通过", "批准"}
+	replyRejectWords  = []string{"reject", "rejected", "deny", "denied", "no", "拒绝", "驳回", "不同意"}
+
+	// replyStandaloneWords 日常用语里常带后续内容（"No problem"、"OK but..."），只有单独成句时才视为关键字
+	replyStandaloneWords = map[string]bool{"yes": true, "ok": true, "no": true}
+)
+
+// replyClauseSeparators 短句分隔标点
+const replyClauseSeparators = ",，.。;；:：!！?？"
+
+// ParseReplyAction 解析回复正文：只看第一行有效内容（跳过引用行），该行须以同意/拒绝关键字开头，
+// 关键字之后的内容作为审批意见；后续短句或后续行中的关键字不算数，无法识别时返回空操作
+func ParseReplyAction(text string) (model.ApprovalAction, *string) {
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		// 遇到引用原文即停止
+		if strings.HasPrefix(line, ">") || strings.HasPrefix(line, "On ") || strings.HasPrefix(line, "----") {
+			break
+		}
+
+		action, rest, ok := matchReplyClause(line)
+		if !ok {
+			return "", nil
+		}
+		if rest == "" {
+			return action, nil
+		}
+		return action, &rest
+	}
+
+	return "", nil
+}
+
+// matchReplyClause 短句是否以关键字开头，返回操作和关键字之后的内容
+func matchReplyClause(clause string) (model.ApprovalAction, string, bool) {
+	lower := strings.ToLower(clause)
+	// 拒绝词优先匹配（"不同意" 包含 "同意"）
+	for _, words := range []struct {
+		action model.ApprovalAction
+		words  []string
+	}{
+		{model.ApprovalActionReject, replyRejectWords},
+		{model.ApprovalActionApprove, replyApproveWords},
+	} {
+		for _, w := range words.words {
+			rest, ok := matchReplyKeyword(lower, clause, w)
+			if !ok {
+				continue
+			}
+			if replyStandaloneWords[w] && !endsClause(clause[len(w):]) {
+				continue
+			}
+			return words.action, rest, true
+		}
+	}
+	return "", "", false
+}
+
+// endsClause 关键字之后是否直接结束短句
+func endsClause(rest string) bool {
+	rest = strings.TrimSpace(rest)
+	if rest == "" {
+		return true
+	}
+	r, _ := utf8.DecodeRuneInString(rest)
+	return strings.ContainsRune(replyClauseSeparators, r)
+}
+
+// matchReplyKeyword 关键字需位于行首且后跟分隔符或行尾
+func matchReplyKeyword(lower, original, word string) (string, bool) {
+	if !strings.HasPrefix(lower, word) {
+		return "", false
+	}
+	rest := original[len(word):]
+	if rest != "" {
+		r := []rune(rest)[0]
+		isASCIIWord := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+		if isASCIIWord {
+			return "", false
+		}
+	}
+	return strings.TrimSpace(strings.TrimLeft(rest, " ,，.。:：!！-")), true
+}
@@ -0,0 +1,330 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/approval/dto"
+	"github.com/lk2023060901/go-next-erp/internal/approval/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryActionTokenRepo 内存令牌仓储
+type memoryActionTokenRepo struct {
+	mu     sync.Mutex
+	tokens map[uuid.UUID]*model.ActionLinkToken
+	nonces map[string]time.Time
+}
+
+func newMemoryActionTokenRepo() *memoryActionTokenRepo {
+	return &memoryActionTokenRepo{tokens: make(map[uuid.UUID]*model.ActionLinkToken)}
+}
+
+func (r *memoryActionTokenRepo) Create(ctx context.Context, token *model.ActionLinkToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *token
+	r.tokens[token.ID] = &copied
+	return nil
+}
+
+func (r *memoryActionTokenRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.ActionLinkToken, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t, ok := r.tokens[id]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	copied := *t
+	return &copied, nil
+}
+
+func (r *memoryActionTokenRepo) Consume(ctx context.Context, id uuid.UUID, usedAt time.Time, usedIP string, fn func(ctx context.Context) error) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t, ok := r.tokens[id]
+	if !ok || t.UsedAt != nil {
+		return false, nil
+	}
+	if err := fn(ctx); err != nil {
+		return false, err
+	}
+	t.UsedAt = &usedAt
+	t.UsedIP = &usedIP
+	for _, other := range r.tokens {
+		if other.TaskID == t.TaskID && other.UsedAt == nil {
+			other.UsedAt = &usedAt
+		}
+	}
+	return true, nil
+}
+
+func (r *memoryActionTokenRepo) UseInboundNonce(ctx context.Context, nonce string, receivedAt, expireBefore time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.nonces == nil {
+		r.nonces = make(map[string]time.Time)
+	}
+	for n, at := range r.nonces {
+		if at.Before(expireBefore) {
+			delete(r.nonces, n)
+		}
+	}
+	if _, seen := r.nonces[nonce]; seen {
+		return false, nil
+	}
+	r.nonces[nonce] = receivedAt
+	return true, nil
+}
+
+// stubApprovalService 仅记录 ProcessTask 调用
+type stubApprovalService struct {
+	ApprovalService
+	processed []*dto.ProcessTaskRequest
+	fail      error
+}
+
+func (s *stubApprovalService) ProcessTask(ctx context.Context, req *dto.ProcessTaskRequest) error {
+	if s.fail != nil {
+		return s.fail
+	}
+	s.processed = append(s.processed, req)
+	return nil
+}
+
+// signInbound 按入站 Webhook 约定签名
+func signInbound(req *dto.InboundReplyRequest) {
+	h := hmac.New(sha256.New, []byte("inbound-secret"))
+	h.Write([]byte(InboundSignaturePayload(req)))
+	req.Signature = hex.EncodeToString(h.Sum(nil))
+}
+
+func newTestSigner(repo *memoryActionTokenRepo, ttl time.Duration) *ActionLinkSigner {
+	return NewActionLinkSigner(&ActionLinkConfig{
+		Secret:        []byte("link-secret"),
+		BaseURL:       "https://erp.example.com/approval/action",
+		TTL:           ttl,
+		ReplyDomain:   "reply.example.com",
+		InboundSecret: []byte("inbound-secret"),
+	}, repo)
+}
+
+func TestActionLinkSigner(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemoryActionTokenRepo()
+	signer := newTestSigner(repo, time.Hour)
+	task := &model.ApprovalTask{ID: uuid.New(), TenantID: uuid.New(), AssigneeID: uuid.New()}
+
+	t.Run("issue and parse", func(t *testing.T) {
+		token, err := signer.Issue(ctx, task, model.ApprovalActionApprove)
+		require.NoError(t, err)
+
+		claims, err := signer.Parse(token)
+		require.NoError(t, err)
+		assert.Equal(t, task.ID, claims.TaskID)
+		assert.Equal(t, task.AssigneeID, claims.AssigneeID)
+		assert.Equal(t, model.ApprovalActionApprove, claims.Action)
+		assert.Contains(t, signer.URL(token), "https://erp.example.com/approval/action?token=")
+	})
+
+	t.Run("tampered action is rejected", func(t *testing.T) {
+		token, err := signer.Issue(ctx, task, model.ApprovalActionReject)
+		require.NoError(t, err)
+		claims, err := signer.Parse(token)
+		require.NoError(t, err)
+
+		// 用其他密钥重新签名，伪造为同意
+		claims.Action = model.ApprovalActionApprove
+		forger := newTestSigner(repo, time.Hour)
+		forger.config.Secret = []byte("other")
+		forged, err := forger.Sign(claims)
+		require.NoError(t, err)
+
+		_, err = signer.Parse(forged)
+		assert.ErrorIs(t, err, ErrInvalidActionLink)
+
+		_, err = signer.Parse("garbage")
+		assert.ErrorIs(t, err, ErrInvalidActionLink)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		expired := newTestSigner(repo, -time.Minute)
+		token, err := expired.Issue(ctx, task, model.ApprovalActionApprove)
+		require.NoError(t, err)
+
+		_, err = signer.Parse(token)
+		assert.ErrorIs(t, err, ErrActionLinkExpired)
+	})
+
+	t.Run("inbound signature", func(t *testing.T) {
+		now := time.Now()
+		req := &dto.InboundReplyRequest{
+			From: "boss@example.com", To: "approval+x.y@reply.example.com", Text: "拒绝",
+			Timestamp: strconv.FormatInt(now.Unix(), 10), Nonce: "nonce-1",
+		}
+		signInbound(req)
+		assert.True(t, signer.VerifyInbound(req, now))
+		assert.False(t, signer.VerifyInbound(req, now.Add(time.Hour)))
+
+		// 签名覆盖正文，篡改回复内容或随机数后失效
+		tampered := *req
+		tampered.Text = "同意"
+		assert.False(t, signer.VerifyInbound(&tampered, now))
+		tampered = *req
+		tampered.Nonce = "nonce-2"
+		assert.False(t, signer.VerifyInbound(&tampered, now))
+	})
+}
+
+func TestActionLinkService_ExecuteLink(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemoryActionTokenRepo()
+	signer := newTestSigner(repo, time.Hour)
+	approval := &stubApprovalService{}
+	svc := NewActionLinkService(signer, repo, nil, approval, nil, nil)
+
+	task := &model.ApprovalTask{ID: uuid.New(), TenantID: uuid.New(), AssigneeID: uuid.New()}
+	approveToken, err := signer.Issue(ctx, task, model.ApprovalActionApprove)
+	require.NoError(t, err)
+	rejectToken, err := signer.Issue(ctx, task, model.ApprovalActionReject)
+	require.NoError(t, err)
+
+	result, err := svc.ExecuteLink(ctx, &dto.ExecuteActionLinkRequest{Token: approveToken})
+	require.NoError(t, err)
+	assert.True(t, result.Processed)
+	require.Len(t, approval.processed, 1)
+	assert.Equal(t, task.AssigneeID, approval.processed[0].OperatorID)
+	assert.Equal(t, model.ApprovalActionApprove, approval.processed[0].Action)
+
+	t.Run("reuse is rejected", func(t *testing.T) {
+		_, err := svc.ExecuteLink(ctx, &dto.ExecuteActionLinkRequest{Token: approveToken})
+		assert.ErrorIs(t, err, ErrActionLinkUsed)
+	})
+
+	t.Run("sibling link revoked", func(t *testing.T) {
+		_, err := svc.ExecuteLink(ctx, &dto.ExecuteActionLinkRequest{Token: rejectToken})
+		assert.ErrorIs(t, err, ErrActionLinkUsed)
+		assert.Len(t, approval.processed, 1)
+	})
+
+	t.Run("failed processing keeps links usable", func(t *testing.T) {
+		other := &model.ApprovalTask{ID: uuid.New(), TenantID: uuid.New(), AssigneeID: uuid.New()}
+		approve, err := signer.Issue(ctx, other, model.ApprovalActionApprove)
+		require.NoError(t, err)
+		reject, err := signer.Issue(ctx, other, model.ApprovalActionReject)
+		require.NoError(t, err)
+
+		approval.fail = errors.New("database unavailable")
+		_, err = svc.ExecuteLink(ctx, &dto.ExecuteActionLinkRequest{Token: approve})
+		assert.EqualError(t, err, "database unavailable")
+
+		approval.fail = nil
+		result, err := svc.ExecuteLink(ctx, &dto.ExecuteActionLinkRequest{Token: reject})
+		require.NoError(t, err)
+		assert.Equal(t, model.ApprovalActionReject, result.Action)
+		_, err = svc.ExecuteLink(ctx, &dto.ExecuteActionLinkRequest{Token: approve})
+		assert.ErrorIs(t, err, ErrActionLinkUsed)
+	})
+
+	t.Run("reply token cannot be used as link", func(t *testing.T) {
+		other := &model.ApprovalTask{ID: uuid.New(), TenantID: uuid.New(), AssigneeID: uuid.New()}
+		replyToken, err := signer.Issue(ctx, other, model.ActionLinkReply)
+		require.NoError(t, err)
+
+		_, err = svc.ExecuteLink(ctx, &dto.ExecuteActionLinkRequest{Token: replyToken})
+		assert.ErrorIs(t, err, ErrInvalidActionLink)
+	})
+}
+
+func TestActionLinkService_HandleInboundReply(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemoryActionTokenRepo()
+	signer := newTestSigner(repo, time.Hour)
+	approval := &stubApprovalService{}
+	svc := NewActionLinkService(signer, repo, nil, approval, nil, nil)
+
+	task := &model.ApprovalTask{ID: uuid.New(), TenantID: uuid.New(), AssigneeID: uuid.New()}
+	replyToken, err := signer.Issue(ctx, task, model.ActionLinkReply)
+	require.NoError(t, err)
+
+	// 关键字不在首个短句时不做决定，返回无法识别的错误
+	ambiguous := &dto.InboundReplyRequest{
+		From: "boss@example.com", To: signer.ReplyAddress(replyToken), Text: "No problem, approve",
+		Timestamp: strconv.FormatInt(time.Now().Unix(), 10), Nonce: "nonce-0",
+	}
+	signInbound(ambiguous)
+	_, err = svc.HandleInboundReply(ctx, ambiguous)
+	assert.ErrorIs(t, err, ErrUnknownReplyAction)
+	assert.Empty(t, approval.processed)
+
+	req := &dto.InboundReplyRequest{
+		From: "boss@example.com", To: signer.ReplyAddress(replyToken), Text: "Approve, no problem",
+		Timestamp: strconv.FormatInt(time.Now().Unix(), 10), Nonce: "nonce-1",
+	}
+	signInbound(req)
+
+	result, err := svc.HandleInboundReply(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, model.ApprovalActionApprove, result.Action)
+	require.Len(t, approval.processed, 1)
+
+	t.Run("replayed request is rejected", func(t *testing.T) {
+		_, err := svc.HandleInboundReply(ctx, req)
+		assert.ErrorIs(t, err, ErrInvalidInboundReply)
+		assert.Len(t, approval.processed, 1)
+	})
+}
+
+func TestParseReplyAction(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		action  model.ApprovalAction
+		comment string
+	}{
+		{"english approve", "Approve\n\n> original", model.ApprovalActionApprove, ""},
+		{"approve with comment", "approve, looks good", model.ApprovalActionApprove, "looks good"},
+		{"chinese reject with reason", "拒绝：预算超标\n\n-----原始邮件-----", model.ApprovalActionReject, "预算超标"},
+		{"not agree is reject", "不同意", model.ApprovalActionReject, ""},
+		{"agree", "同意", model.ApprovalActionApprove, ""},
+		{"prefix word", "approvement pending", "", ""},
+		{"unknown", "please call me", "", ""},
+		{"quoted only", "> approve", "", ""},
+		{"no problem is not a reject", "No problem, approve", "", ""},
+		{"ok followed by words", "OK let me check first", "", ""},
+		{"standalone no", "No.", model.ApprovalActionReject, ""},
+		{"standalone ok", "ok", model.ApprovalActionApprove, ""},
+		{"keyword in later clause is ignored", "没问题，同意", "", ""},
+		{"keyword in later clause of english reply", "I'll think about it, reject for now", "", ""},
+		{"keyword on later line is ignored", "Let me check\napprove", "", ""},
+		{"first clause decides", "approve, do not reject the hotel line", model.ApprovalActionApprove, "do not reject the hotel line"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action, comment := ParseReplyAction(tt.text)
+			assert.Equal(t, tt.action, action)
+			if tt.comment == "" {
+				assert.Nil(t, comment)
+			} else {
+				require.NotNil(t, comment)
+				assert.Equal(t, tt.comment, *comment)
+			}
+		})
+	}
+}
+
+func TestExtractReplyToken(t *testing.T) {
+	assert.Equal(t, "abc_-1.def-_2", ExtractReplyToken("Approvals <approval+abc_-1.def-_2@reply.example.com>"))
+	assert.Equal(t, "", ExtractReplyToken("someone@example.com"))
+	assert.Equal(t, "boss@example.com", ExtractEmailAddress("Boss <boss@example.com>"))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,6 +15,7 @@ import (
 	formModel "github.com/lk2023060901/go-next-erp/internal/form/model"
 	formRepo "github.com/lk2023060901/go-next-erp/internal/form/repository"
 	notificationDto "github.com/lk2023060901/go-next-erp/internal/notification/dto"
+	notificationModel "github.com/lk2023060901/go-next-erp/internal/notification/model"
 	notificationService "github.com/lk2023060901/go-next-erp/internal/notification/service"
 	"github.com/lk2023060901/go-next-erp/pkg/workflow"
 	workflowModel "github.com/lk2023060901/go-next-erp/pkg/workflow"
@@ -30,6 +32,9 @@ var (
 	ErrProcessHasInstances     = errors.New("process definition has active instances")
 )
 
+// ProcessClosedHook 流程实例进入终态（通过、驳回、撤回、取消）后的回调，业务模块据此同步审批结果
+type ProcessClosedHook func(ctx context.Context, instance *model.ProcessInstance)
+
 // ApprovalService 审批服务接口
 type ApprovalService interface {
 	// 流程定义管理
@@ -47,6 +52,7 @@ type ApprovalService interface {
 	ListMyApplications(ctx context.Context, applicantID uuid.UUID, limit, offset int) ([]*dto.ProcessInstanceResponse, error)
 	ListProcessInstances(ctx context.Context, tenantID uuid.UUID, processDefID *uuid.UUID, status *model.ProcessStatus, applicantID *uuid.UUID, startDate, endDate *time.Time, limit, offset int) ([]*dto.ProcessInstanceResponse, int, error)
 	CancelProcess(ctx context.Context, instanceID uuid.UUID, operatorID uuid.UUID, reason *string) error
+	OnProcessClosed(hook ProcessClosedHook)
 	GetInstanceStatsSummary(ctx context.Context, tenantID uuid.UUID) (*dto.InstanceStatsSummary, error)
 	GetInstanceStatsByStatus(ctx context.Context, tenantID uuid.UUID, processDefID *uuid.UUID, startDate, endDate *time.Time) (map[string]int, error)
 
@@ -77,6 +83,12 @@ type ApprovalService interface {
 	GetDashboard(ctx context.Context, tenantID, userID uuid.UUID) (*dto.DashboardResponse, error)
 	GetProcessMetrics(ctx context.Context, processDefID uuid.UUID, startDate, endDate *time.Time) (*dto.ProcessMetrics, error)
 	GetUserWorkload(ctx context.Context, userID uuid.UUID, startDate, endDate *time.Time) (*dto.UserWorkload, error)
+
+	// 管理员干预（记录为管理员操作类型的流程历史）
+	AdminReassignTask(ctx context.Context, req *dto.AdminReassignTaskRequest) error
+	AdminCancelInstance(ctx context.Context, req *dto.AdminInstanceRequest) error
+	AdminForceCompleteInstance(ctx context.Context, req *dto.AdminInstanceRequest) error
+	AdminRetriggerTasks(ctx context.Context, req *dto.AdminInstanceRequest) (int, error)
 }
 
 type approvalService struct {
@@ -90,6 +102,11 @@ type approvalService struct {
 	assigneeResolver    *AssigneeResolver
 	authzService        *authorization.Service
 	notificationService notificationService.NotificationService
+	searchService       ProcessSearchService
+	actionLinkSigner    *ActionLinkSigner
+
+	hooksMu     sync.RWMutex
+	closedHooks []ProcessClosedHook
 }
 
 // NewApprovalService 创建审批服务
@@ -104,6 +121,8 @@ func NewApprovalService(
 	assigneeResolver *AssigneeResolver,
 	authzService *authorization.Service,
 	notificationService notificationService.NotificationService,
+	searchService ProcessSearchService,
+	actionLinkSigner *ActionLinkSigner,
 ) ApprovalService {
 	return &approvalService{
 		processDefRepo:      processDefRepo,
@@ -116,6 +135,8 @@ func NewApprovalService(
 		assigneeResolver:    assigneeResolver,
 		authzService:        authzService,
 		notificationService: notificationService,
+		searchService:       searchService,
+		actionLinkSigner:    actionLinkSigner,
 	}
 }
 
@@ -387,6 +408,8 @@ func (s *approvalService) StartProcess(ctx context.Context, req *dto.StartProces
 		}
 	}
 
+	s.refreshSearchIndex(ctx, instance.ID)
+
 	return &dto.ProcessInstanceResponse{
 		ID:             instance.ID,
 		ProcessDefID:   instance.ProcessDefID,
@@ -592,6 +615,9 @@ func (s *approvalService) ProcessTask(ctx context.Context, req *dto.ProcessTaskR
 		if err := s.processInstRepo.Update(ctx, instance); err != nil {
 			return fmt.Errorf("failed to update process instance: %w", err)
 		}
+		if err := s.cancelExecution(instance); err != nil {
+			return err
+		}
 
 		// 通知申请人流程被拒绝
 		if s.notificationService != nil {
@@ -680,6 +706,11 @@ func (s *approvalService) ProcessTask(ctx context.Context, req *dto.ProcessTaskR
 		}
 	}
 
+	s.refreshSearchIndex(ctx, instance.ID)
+	if instance.Status != model.ProcessStatusPending {
+		s.fireClosedHooks(ctx, instance)
+	}
+
 	return nil
 }
 
@@ -700,31 +731,9 @@ func (s *approvalService) WithdrawProcess(ctx context.Context, instanceID uuid.U
 		return fmt.Errorf("can only withdraw pending process")
 	}
 
-	// 更新流程状态
-	now := time.Now()
-	instance.Status = model.ProcessStatusWithdrawn
-	instance.CompletedAt = &now
-	instance.UpdatedAt = now
-
-	if err := s.processInstRepo.Update(ctx, instance); err != nil {
+	if err := s.closeInstance(ctx, instance, model.ProcessStatusWithdrawn, operatorID, model.ApprovalActionWithdraw, nil); err != nil {
 		return fmt.Errorf("failed to withdraw process: %w", err)
 	}
-
-	// 记录历史
-	history := &model.ProcessHistory{
-		ID:                uuid.New(),
-		TenantID:          instance.TenantID,
-		ProcessInstanceID: instanceID,
-		NodeID:            *instance.CurrentNodeID,
-		OperatorID:        operatorID,
-		Action:            model.ApprovalActionWithdraw,
-		CreatedAt:         now,
-	}
-
-	if err := s.historyRepo.Create(ctx, history); err != nil {
-		return fmt.Errorf("failed to create history: %w", err)
-	}
-
 	return nil
 }
 
@@ -1154,17 +1163,171 @@ func (s *approvalService) sendTaskNotification(
 		)
 	}
 
+	// 新任务附带一键审批链接，审批人可在邮件/IM 中直接处理
+	var links map[string]interface{}
+	if action == "created" && s.actionLinkSigner.Enabled() {
+		var err error
+		if links, err = s.issueActionLinks(ctx, task); err == nil {
+			content += fmt.Sprintf(
+				"\n\n一键同意：%s\n一键拒绝：%s",
+				links["approve_url"],
+				links["reject_url"],
+			)
+			if replyTo, ok := links[notificationModel.DataKeyReplyTo].(string); ok && replyTo != "" {
+				content += fmt.Sprintf("\n也可直接回复本邮件“同意”或“拒绝”（可附审批意见），回复地址：%s", replyTo)
+			}
+		}
+	}
+
 	notifReq := &notificationDto.SendNotificationRequest{
 		Type:        "approval",
 		Channel:     "in_app",
 		RecipientID: task.AssigneeID.String(),
 		Title:       title,
 		Content:     content,
+		Data:        links,
 		RelatedType: stringPtr("approval_task"),
 		RelatedID:   stringPtr(task.ID.String()),
 	}
 
 	_, _ = s.notificationService.SendNotification(ctx, task.TenantID, notifReq)
+
+	// 邮件副本发往审批人邮箱，回复地址随附加数据写入 Reply-To 头
+	if links != nil {
+		if email := s.assigneeResolver.UserEmail(ctx, task.AssigneeID); email != "" {
+			emailReq := *notifReq
+			emailReq.Channel = "email"
+			emailReq.RecipientEmail = &email
+			_, _ = s.notificationService.SendNotification(ctx, task.TenantID, &emailReq)
+		}
+	}
+}
+
+// issueActionLinks 签发同意/拒绝链接和邮件回复地址
+func (s *approvalService) issueActionLinks(ctx context.Context, task *model.ApprovalTask) (map[string]interface{}, error) {
+	approveToken, err := s.actionLinkSigner.Issue(ctx, task, model.ApprovalActionApprove)
+	if err != nil {
+		return nil, err
+	}
+	rejectToken, err := s.actionLinkSigner.Issue(ctx, task, model.ApprovalActionReject)
+	if err != nil {
+		return nil, err
+	}
+
+	links := map[string]interface{}{
+		"approve_url": s.actionLinkSigner.URL(approveToken),
+		"reject_url":  s.actionLinkSigner.URL(rejectToken),
+	}
+
+	replyToken, err := s.actionLinkSigner.Issue(ctx, task, model.ActionLinkReply)
+	if err == nil {
+		if replyTo := s.actionLinkSigner.ReplyAddress(replyToken); replyTo != "" {
+			links[notificationModel.DataKeyReplyTo] = replyTo
+		}
+	}
+
+	return links, nil
+}
+
+// OnProcessClosed 注册流程结束回调
+func (s *approvalService) OnProcessClosed(hook ProcessClosedHook) {
+	s.hooksMu.Lock()
+	defer s.hooksMu.Unlock()
+	s.closedHooks = append(s.closedHooks, hook)
+}
+
+// fireClosedHooks 通知业务模块流程已结束
+func (s *approvalService) fireClosedHooks(ctx context.Context, instance *model.ProcessInstance) {
+	s.hooksMu.RLock()
+	hooks := append([]ProcessClosedHook(nil), s.closedHooks...)
+	s.hooksMu.RUnlock()
+
+	for _, hook := range hooks {
+		hook(ctx, instance)
+	}
+}
+
+// closeInstance 结束待审批的流程实例：跳过剩余待办、终止工作流执行、记录历史、刷新索引并触发结束回调
+func (s *approvalService) closeInstance(
+	ctx context.Context,
+	instance *model.ProcessInstance,
+	toStatus model.ProcessStatus,
+	operatorID uuid.UUID,
+	action model.ApprovalAction,
+	comment *string,
+) error {
+	now := time.Now()
+	tasks, err := s.taskRepo.ListByInstance(ctx, instance.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list tasks: %w", err)
+	}
+	for _, task := range tasks {
+		if task.Status != model.TaskStatusPending {
+			continue
+		}
+		if err := s.taskRepo.UpdateStatus(ctx, task.ID, model.TaskStatusSkipped, &action, comment, &now); err != nil {
+			return fmt.Errorf("failed to skip task %s: %w", task.ID, err)
+		}
+	}
+
+	fromStatus := instance.Status
+	instance.Status = toStatus
+	instance.CompletedAt = &now
+	instance.UpdatedAt = now
+	if err := s.processInstRepo.Update(ctx, instance); err != nil {
+		return fmt.Errorf("failed to update process instance: %w", err)
+	}
+	if err := s.cancelExecution(instance); err != nil {
+		return err
+	}
+
+	nodeID, nodeName := "", ""
+	if instance.CurrentNodeID != nil {
+		nodeID = *instance.CurrentNodeID
+	}
+	if instance.CurrentNodeName != nil {
+		nodeName = *instance.CurrentNodeName
+	}
+	history := &model.ProcessHistory{
+		ID:                uuid.New(),
+		TenantID:          instance.TenantID,
+		ProcessInstanceID: instance.ID,
+		NodeID:            nodeID,
+		NodeName:          nodeName,
+		OperatorID:        operatorID,
+		Action:            action,
+		Comment:           comment,
+		FromStatus:        &fromStatus,
+		ToStatus:          toStatus,
+		CreatedAt:         now,
+	}
+	if err := s.historyRepo.Create(ctx, history); err != nil {
+		return fmt.Errorf("failed to create history: %w", err)
+	}
+
+	s.refreshSearchIndex(ctx, instance.ID)
+	s.fireClosedHooks(ctx, instance)
+	return nil
+}
+
+// cancelExecution 终止流程实例对应的工作流执行（执行已不存在或已结束时忽略）
+func (s *approvalService) cancelExecution(instance *model.ProcessInstance) error {
+	if s.workflowEngine == nil {
+		return nil
+	}
+	err := s.workflowEngine.CancelExecution(instance.WorkflowInstanceID.String())
+	if err != nil && !errors.Is(err, workflow.ErrExecutionNotFound) && !errors.Is(err, workflow.ErrExecutionAlreadyDone) {
+		return fmt.Errorf("failed to cancel workflow execution: %w", err)
+	}
+	return nil
+}
+
+// refreshSearchIndex 刷新流程实例检索索引（失败不影响审批主流程）
+func (s *approvalService) refreshSearchIndex(ctx context.Context, instanceID uuid.UUID) {
+	if s.searchService == nil {
+		return
+	}
+	_ = s.searchService.IndexInstance(ctx, instanceID)
 }
 
 func stringPtr(s string) *string {
@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/approval/dto"
+	"github.com/lk2023060901/go-next-erp/internal/approval/model"
+	workflowModel "github.com/lk2023060901/go-next-erp/pkg/workflow"
+)
+
+var (
+	ErrInstanceNotPending   = errors.New("process instance is not pending")
+	ErrInstanceHasTasks     = errors.New("process instance already has pending tasks")
+	ErrCurrentNodeUnknown   = errors.New("cannot determine current node of process instance")
+	ErrInvalidForceComplete = errors.New("force complete status must be approved or rejected")
+)
+
+// AdminReassignTask 管理员将待办任务转交给他人（如离职交接）
+func (s *approvalService) AdminReassignTask(ctx context.Context, req *dto.AdminReassignTaskRequest) error {
+	task, err := s.taskRepo.FindByID(ctx, req.TaskID)
+	if err != nil || task.TenantID != req.TenantID {
+		return ErrTaskNotFound
+	}
+	if task.Status != model.TaskStatusPending {
+		return ErrTaskAlreadyProcessed
+	}
+
+	instance, err := s.processInstRepo.FindByID(ctx, task.ProcessInstanceID)
+	if err != nil {
+		return ErrProcessInstanceNotFound
+	}
+
+	fromName := task.AssigneeName
+	now := time.Now()
+	task.AssigneeID = req.ToUserID
+	task.AssigneeName = req.ToUserName
+	task.TransferToID = &req.ToUserID
+	task.TransferToName = &req.ToUserName
+	task.UpdatedAt = now
+	if err := s.taskRepo.Update(ctx, task); err != nil {
+		return fmt.Errorf("failed to update task: %w", err)
+	}
+
+	comment := adminComment(req.JobID, fmt.Sprintf("%s -> %s", fromName, req.ToUserName), req.Reason)
+	if err := s.recordAdminHistory(ctx, instance, &task.ID, task.NodeID, task.NodeName, req.OperatorID, model.ApprovalActionAdminReassign, comment, instance.Status); err != nil {
+		return err
+	}
+
+	if s.notificationService != nil {
+		s.sendTaskNotification(ctx, task, instance, "created")
+	}
+	s.refreshSearchIndex(ctx, instance.ID)
+
+	return nil
+}
+
+// AdminCancelInstance 管理员取消卡住的流程实例
+func (s *approvalService) AdminCancelInstance(ctx context.Context, req *dto.AdminInstanceRequest) error {
+	return s.adminCloseInstance(ctx, req, model.ProcessStatusCancelled, model.ApprovalActionAdminCancel)
+}
+
+// AdminForceCompleteInstance 管理员强制完结流程实例（通过或拒绝）
+func (s *approvalService) AdminForceCompleteInstance(ctx context.Context, req *dto.AdminInstanceRequest) error {
+	if req.Status != model.ProcessStatusApproved && req.Status != model.ProcessStatusRejected {
+		return ErrInvalidForceComplete
+	}
+	return s.adminCloseInstance(ctx, req, req.Status, model.ApprovalActionAdminForceComplete)
+}
+
+// AdminRetriggerTasks 为没有待办任务的进行中实例重新生成当前节点的审批任务
+func (s *approvalService) AdminRetriggerTasks(ctx context.Context, req *dto.AdminInstanceRequest) (int, error) {
+	instance, err := s.processInstRepo.FindByID(ctx, req.InstanceID)
+	if err != nil || instance.TenantID != req.TenantID {
+		return 0, ErrProcessInstanceNotFound
+	}
+	if instance.Status != model.ProcessStatusPending {
+		return 0, ErrInstanceNotPending
+	}
+
+	tasks, err := s.taskRepo.ListByInstance(ctx, instance.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list tasks: %w", err)
+	}
+	for _, task := range tasks {
+		if task.Status == model.TaskStatusPending {
+			return 0, ErrInstanceHasTasks
+		}
+	}
+
+	node, err := s.currentNode(ctx, instance)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := s.createTasksForNode(ctx, node, instance, instance.Variables); err != nil {
+		return 0, err
+	}
+
+	created, err := s.taskRepo.ListByInstance(ctx, instance.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list tasks: %w", err)
+	}
+	count := 0
+	for _, task := range created {
+		if task.Status == model.TaskStatusPending && task.NodeID == node.ID {
+			count++
+		}
+	}
+
+	instance.CurrentNodeID = &node.ID
+	instance.CurrentNodeName = &node.Name
+	instance.UpdatedAt = time.Now()
+	if err := s.processInstRepo.Update(ctx, instance); err != nil {
+		return 0, fmt.Errorf("failed to update process instance: %w", err)
+	}
+
+	comment := adminComment(req.JobID, fmt.Sprintf("重新生成 %d 个任务", count), req.Reason)
+	if err := s.recordAdminHistory(ctx, instance, nil, node.ID, node.Name, req.OperatorID, model.ApprovalActionAdminRetrigger, comment, instance.Status); err != nil {
+		return 0, err
+	}
+	s.refreshSearchIndex(ctx, instance.ID)
+
+	return count, nil
+}
+
+// adminCloseInstance 管理员结束流程实例（与申请人取消共用收尾流程）
+func (s *approvalService) adminCloseInstance(ctx context.Context, req *dto.AdminInstanceRequest, toStatus model.ProcessStatus, action model.ApprovalAction) error {
+	instance, err := s.processInstRepo.FindByID(ctx, req.InstanceID)
+	if err != nil || instance.TenantID != req.TenantID {
+		return ErrProcessInstanceNotFound
+	}
+	if instance.Status != model.ProcessStatusPending {
+		return ErrInstanceNotPending
+	}
+
+	comment := adminComment(req.JobID, string(instance.Status)+" -> "+string(toStatus), req.Reason)
+	return s.closeInstance(ctx, instance, toStatus, req.OperatorID, action, comment)
+}
+
+// currentNode 获取实例当前所在的工作流节点（优先实例记录，其次工作流执行上下文）
+func (s *approvalService) currentNode(ctx context.Context, instance *model.ProcessInstance) (*workflowModel.NodeDefinition, error) {
+	nodeID := ""
+	if instance.CurrentNodeID != nil {
+		nodeID = *instance.CurrentNodeID
+	} else if execCtx, err := s.workflowEngine.GetExecution(instance.WorkflowInstanceID.String()); err == nil {
+		nodeID = execCtx.CurrentNodeID
+	}
+	if nodeID == "" {
+		return nil, ErrCurrentNodeUnknown
+	}
+
+	processDef, err := s.processDefRepo.FindByID(ctx, instance.ProcessDefID)
+	if err != nil {
+		return nil, ErrProcessNotFound
+	}
+	workflow, err := s.workflowEngine.GetWorkflow(processDef.WorkflowID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflow: %w", err)
+	}
+
+	for _, node := range workflow.Nodes {
+		if node.ID == nodeID {
+			return node, nil
+		}
+	}
+	return nil, ErrCurrentNodeUnknown
+}
+
+func (s *approvalService) recordAdminHistory(
+	ctx context.Context,
+	instance *model.ProcessInstance,
+	taskID *uuid.UUID,
+	nodeID, nodeName string,
+	operatorID uuid.UUID,
+	action model.ApprovalAction,
+	comment *string,
+	status model.ProcessStatus,
+) error {
+	history := &model.ProcessHistory{
+		ID:                uuid.New(),
+		TenantID:          instance.TenantID,
+		ProcessInstanceID: instance.ID,
+		TaskID:            taskID,
+		NodeID:            nodeID,
+		NodeName:          nodeName,
+		OperatorID:        operatorID,
+		Action:            action,
+		Comment:           comment,
+		FromStatus:        &status,
+		ToStatus:          status,
+		CreatedAt:         time.Now(),
+	}
+	if err := s.historyRepo.Create(ctx, history); err != nil {
+		return fmt.Errorf("failed to create history: %w", err)
+	}
+	return nil
+}
+
+// adminComment 组装管理员操作的历史意见：[作业ID] 变更摘要；原因
+func adminComment(jobID *uuid.UUID, summary string, reason *string) *string {
+	comment := summary
+	if jobID != nil {
+		comment = fmt.Sprintf("[%s] %s", jobID.String()[:8], comment)
+	}
+	if reason != nil && *reason != "" {
+		comment += "；" + *reason
+	}
+	return &comment
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/approval/dto"
+	"github.com/lk2023060901/go-next-erp/internal/approval/model"
+	"github.com/lk2023060901/go-next-erp/internal/approval/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/workflow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryInstanceRepo struct {
+	repository.ProcessInstanceRepository
+	instances map[uuid.UUID]*model.ProcessInstance
+}
+
+func (r *memoryInstanceRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.ProcessInstance, error) {
+	instance, ok := r.instances[id]
+	if !ok {
+		return nil, ErrProcessInstanceNotFound
+	}
+	copied := *instance
+	return &copied, nil
+}
+
+func (r *memoryInstanceRepo) Update(ctx context.Context, instance *model.ProcessInstance) error {
+	copied := *instance
+	r.instances[instance.ID] = &copied
+	return nil
+}
+
+type memoryInstanceTaskRepo struct {
+	repository.ApprovalTaskRepository
+	tasks []*model.ApprovalTask
+}
+
+func (r *memoryInstanceTaskRepo) ListByInstance(ctx context.Context, instanceID uuid.UUID) ([]*model.ApprovalTask, error) {
+	var tasks []*model.ApprovalTask
+	for _, task := range r.tasks {
+		if task.ProcessInstanceID == instanceID {
+			tasks = append(tasks, task)
+		}
+	}
+	return tasks, nil
+}
+
+func (r *memoryInstanceTaskRepo) UpdateStatus(ctx context.Context, id uuid.UUID, status model.TaskStatus, action *model.ApprovalAction, comment *string, approvedAt *time.Time) error {
+	for _, task := range r.tasks {
+		if task.ID == id {
+			task.Status = status
+		}
+	}
+	return nil
+}
+
+type memoryHistoryRepo struct {
+	repository.ProcessHistoryRepository
+	histories []*model.ProcessHistory
+}
+
+func (r *memoryHistoryRepo) Create(ctx context.Context, history *model.ProcessHistory) error {
+	r.histories = append(r.histories, history)
+	return nil
+}
+
+func TestApprovalService_CloseInstance(t *testing.T) {
+	ctx := context.Background()
+	tenantID, applicantID, adminID := uuid.New(), uuid.New(), uuid.New()
+
+	newFixture := func(t *testing.T) (*approvalService, *model.ProcessInstance, *memoryInstanceTaskRepo, *memoryHistoryRepo, *[]*model.ProcessInstance) {
+		nodeID := "dept"
+		instance := &model.ProcessInstance{
+			ID: uuid.New(), TenantID: tenantID, ApplicantID: applicantID, WorkflowInstanceID: uuid.New(),
+			Status: model.ProcessStatusPending, CurrentNodeID: &nodeID,
+		}
+		tasks := &memoryInstanceTaskRepo{tasks: []*model.ApprovalTask{
+			{ID: uuid.New(), ProcessInstanceID: instance.ID, Status: model.TaskStatusPending},
+			{ID: uuid.New(), ProcessInstanceID: instance.ID, Status: model.TaskStatusApproved},
+		}}
+		histories := &memoryHistoryRepo{}
+		engine, err := workflow.New()
+		require.NoError(t, err)
+
+		svc := &approvalService{
+			processInstRepo: &memoryInstanceRepo{instances: map[uuid.UUID]*model.ProcessInstance{instance.ID: instance}},
+			taskRepo:        tasks,
+			historyRepo:     histories,
+			workflowEngine:  engine,
+		}
+		closed := &[]*model.ProcessInstance{}
+		svc.OnProcessClosed(func(ctx context.Context, instance *model.ProcessInstance) {
+			*closed = append(*closed, instance)
+		})
+		return svc, instance, tasks, histories, closed
+	}
+
+	t.Run("admin cancel settles like applicant cancel", func(t *testing.T) {
+		svc, instance, tasks, histories, closed := newFixture(t)
+		reason := "流程卡死"
+
+		require.NoError(t, svc.AdminCancelInstance(ctx, &dto.AdminInstanceRequest{
+			TenantID: tenantID, InstanceID: instance.ID, OperatorID: adminID, Reason: &reason,
+		}))
+
+		assert.Equal(t, model.TaskStatusSkipped, tasks.tasks[0].Status)
+		assert.Equal(t, model.TaskStatusApproved, tasks.tasks[1].Status)
+		require.Len(t, histories.histories, 1)
+		assert.Equal(t, model.ApprovalActionAdminCancel, histories.histories[0].Action)
+		assert.Equal(t, model.ProcessStatusCancelled, histories.histories[0].ToStatus)
+		assert.Equal(t, "dept", histories.histories[0].NodeID)
+		require.Len(t, *closed, 1)
+		assert.Equal(t, model.ProcessStatusCancelled, (*closed)[0].Status)
+		assert.NotNil(t, (*closed)[0].CompletedAt)
+
+		err := svc.AdminCancelInstance(ctx, &dto.AdminInstanceRequest{TenantID: tenantID, InstanceID: instance.ID, OperatorID: adminID})
+		assert.ErrorIs(t, err, ErrInstanceNotPending)
+		assert.Len(t, *closed, 1)
+	})
+
+	t.Run("force complete notifies hooks with final status", func(t *testing.T) {
+		svc, instance, _, histories, closed := newFixture(t)
+
+		require.NoError(t, svc.AdminForceCompleteInstance(ctx, &dto.AdminInstanceRequest{
+			TenantID: tenantID, InstanceID: instance.ID, OperatorID: adminID, Status: model.ProcessStatusApproved,
+		}))
+		require.Len(t, *closed, 1)
+		assert.Equal(t, model.ProcessStatusApproved, (*closed)[0].Status)
+		assert.Equal(t, model.ApprovalActionAdminForceComplete, histories.histories[0].Action)
+	})
+
+	t.Run("applicant cancel records history", func(t *testing.T) {
+		svc, instance, tasks, histories, closed := newFixture(t)
+
+		assert.ErrorIs(t, svc.CancelProcess(ctx, instance.ID, adminID, nil), ErrUnauthorized)
+		require.NoError(t, svc.CancelProcess(ctx, instance.ID, applicantID, nil))
+		assert.Equal(t, model.TaskStatusSkipped, tasks.tasks[0].Status)
+		require.Len(t, histories.histories, 1)
+		assert.Equal(t, model.ApprovalActionCancel, histories.histories[0].Action)
+		assert.Len(t, *closed, 1)
+	})
+
+	t.Run("other tenant cannot close instance", func(t *testing.T) {
+		svc, instance, _, _, closed := newFixture(t)
+
+		err := svc.AdminCancelInstance(ctx, &dto.AdminInstanceRequest{TenantID: uuid.New(), InstanceID: instance.ID, OperatorID: adminID})
+		assert.ErrorIs(t, err, ErrProcessInstanceNotFound)
+		assert.Empty(t, *closed)
+	})
+}
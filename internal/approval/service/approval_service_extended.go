@@ -160,10 +160,7 @@ func (s *approvalService) CancelProcess(ctx context.Context, instanceID uuid.UUI
 		return fmt.Errorf("cannot cancel process in %s status", instance.Status)
 	}
 
-	instance.Status = model.ProcessStatusCancelled
-	now := time.Now()
-	instance.CompletedAt = &now
-	return s.processInstRepo.Update(ctx, instance)
+	return s.closeInstance(ctx, instance, model.ProcessStatusCancelled, operatorID, model.ApprovalActionCancel, reason)
 }
 
 // GetInstanceStatsSummary 获取实例统计汇总
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/approval/model"
+	authModel "github.com/lk2023060901/go-next-erp/internal/auth/model"
+	authRepo "github.com/lk2023060901/go-next-erp/internal/auth/repository"
+	notificationModel "github.com/lk2023060901/go-next-erp/internal/notification/model"
+	notificationRepo "github.com/lk2023060901/go-next-erp/internal/notification/repository"
+	notificationService "github.com/lk2023060901/go-next-erp/internal/notification/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubUserRepo 按 ID 返回用户
+type stubUserRepo struct {
+	authRepo.UserRepository
+	users map[uuid.UUID]*authModel.User
+}
+
+func (r *stubUserRepo) FindByID(ctx context.Context, id uuid.UUID) (*authModel.User, error) {
+	user, ok := r.users[id]
+	if !ok {
+		return nil, errors.New("user not found")
+	}
+	return user, nil
+}
+
+// memoryNotificationRepo 内存通知仓储
+type memoryNotificationRepo struct {
+	notificationRepo.NotificationRepository
+	mu            sync.Mutex
+	notifications []*notificationModel.Notification
+}
+
+func (r *memoryNotificationRepo) Create(ctx context.Context, notification *notificationModel.Notification) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *notification
+	r.notifications = append(r.notifications, &copied)
+	return nil
+}
+
+func (r *memoryNotificationRepo) list() []*notificationModel.Notification {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*notificationModel.Notification(nil), r.notifications...)
+}
+
+func (r *memoryNotificationRepo) Update(ctx context.Context, notification *notificationModel.Notification) error {
+	return nil
+}
+
+// smtpMessage 测试 SMTP 服务收到的邮件
+type smtpMessage struct {
+	rcpt string
+	data string
+}
+
+// startSMTPServer 启动只接收一封邮件的本地 SMTP 服务
+func startSMTPServer(t *testing.T) (string, int, <-chan smtpMessage) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	received := make(chan smtpMessage, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		text := textproto.NewConn(conn)
+		reply := func(line string) { _ = text.PrintfLine("%s", line) }
+		reply("220 localhost ESMTP")
+
+		var msg smtpMessage
+		for {
+			line, err := text.ReadLine()
+			if err != nil {
+				return
+			}
+			verb := strings.ToUpper(strings.Fields(line + " ")[0])
+			switch verb {
+			case "EHLO", "HELO":
+				reply("250-localhost")
+				reply("250 AUTH PLAIN")
+			case "AUTH":
+				reply("235 authenticated")
+			case "MAIL":
+				reply("250 ok")
+			case "RCPT":
+				msg.rcpt = strings.Trim(strings.TrimPrefix(line, "RCPT TO:"), "<>")
+				reply("250 ok")
+			case "DATA":
+				reply("354 end with .")
+				data, err := text.ReadDotBytes()
+				if err != nil {
+					return
+				}
+				msg.data = string(data)
+				reply("250 queued")
+				received <- msg
+			case "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("250 ok")
+			}
+		}
+	}()
+
+	host, port, err := net.SplitHostPort(listener.Addr().String())
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+	return host, portNum, received
+}
+
+func TestApprovalService_TaskNotificationEmail(t *testing.T) {
+	ctx := context.Background()
+	host, port, received := startSMTPServer(t)
+
+	assigneeID := uuid.New()
+	users := &stubUserRepo{users: map[uuid.UUID]*authModel.User{
+		assigneeID: {ID: assigneeID, Email: "approver@example.com"},
+	}}
+	notifications := &memoryNotificationRepo{}
+	svc := &approvalService{
+		assigneeResolver: NewAssigneeResolver(users, nil, nil),
+		notificationService: notificationService.NewNotificationService(notifications, &notificationService.EmailConfig{
+			Host: host, Port: port, From: "erp@example.com",
+		}),
+		actionLinkSigner: newTestSigner(newMemoryActionTokenRepo(), time.Hour),
+	}
+
+	task := &model.ApprovalTask{
+		ID: uuid.New(), TenantID: uuid.New(), AssigneeID: assigneeID,
+		NodeName: "部门审批", CreatedAt: time.Now(),
+	}
+	instance := &model.ProcessInstance{ProcessDefName: "请假", ApplicantName: "张三"}
+	svc.sendTaskNotification(ctx, task, instance, "created")
+
+	var msg smtpMessage
+	select {
+	case msg = <-received:
+	case <-time.After(5 * time.Second):
+		t.Fatal("approval email was not delivered")
+	}
+
+	assert.Equal(t, "approver@example.com", msg.rcpt)
+	headers, body, _ := strings.Cut(msg.data, "\n\n")
+	assert.Contains(t, headers, "To: approver@example.com")
+	assert.Regexp(t, `Reply-To: approval\+\S+@reply\.example\.com`, headers)
+	replyTo := headers[strings.Index(headers, "Reply-To: ")+len("Reply-To: "):]
+	replyTo = strings.TrimSpace(strings.SplitN(replyTo, "\n", 2)[0])
+	assert.Contains(t, body, "回复地址："+replyTo)
+	assert.Contains(t, body, "一键同意：https://erp.example.com/approval/action?token=")
+
+	sent := notifications.list()
+	require.Len(t, sent, 2)
+	assert.Equal(t, notificationModel.NotificationChannelInApp, sent[0].Channel)
+	assert.Nil(t, sent[0].RecipientEmail)
+	assert.Equal(t, notificationModel.NotificationChannelEmail, sent[1].Channel)
+	require.NotNil(t, sent[1].RecipientEmail)
+	assert.Equal(t, "approver@example.com", *sent[1].RecipientEmail)
+
+	t.Run("assignee without email gets in-app notification only", func(t *testing.T) {
+		users.users[assigneeID].Email = ""
+		svc.sendTaskNotification(ctx, task, instance, "created")
+
+		sent := notifications.list()
+		require.Len(t, sent, 3)
+		assert.Equal(t, notificationModel.NotificationChannelInApp, sent[2].Channel)
+	})
+}
@@ -114,6 +114,18 @@ func (r *AssigneeResolver) parseAssigneeConfig(nodeConfig map[string]interface{}
 	}, nil
 }
 
+// UserEmail 查询用户邮箱（邮件通知用），用户不存在或未设置邮箱时返回空
+func (r *AssigneeResolver) UserEmail(ctx context.Context, userID uuid.UUID) string {
+	if r == nil || r.userRepo == nil {
+		return ""
+	}
+	user, err := r.userRepo.FindByID(ctx, userID)
+	if err != nil || user == nil {
+		return ""
+	}
+	return user.Email
+}
+
 // resolveUserAssignee 解析指定用户
 func (r *AssigneeResolver) resolveUserAssignee(userIDStr string) ([]uuid.UUID, error) {
 	userID, err := uuid.Parse(userIDStr)
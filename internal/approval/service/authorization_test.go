@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/auth/authorization"
+	authModel "github.com/lk2023060901/go-next-erp/internal/auth/model"
+	authRepo "github.com/lk2023060901/go-next-erp/internal/auth/repository"
+)
+
+// testAuthzRole 测试中授予权限的角色
+var testAuthzRole = &authModel.Role{ID: uuid.New(), Name: "approval-admin"}
+
+type stubAuthzRoleRepo struct {
+	authRepo.RoleRepository
+	users map[uuid.UUID]bool
+}
+
+func (r *stubAuthzRoleRepo) GetUserRoles(ctx context.Context, userID uuid.UUID) ([]*authModel.Role, error) {
+	if r.users[userID] {
+		return []*authModel.Role{testAuthzRole}, nil
+	}
+	return nil, nil
+}
+
+func (r *stubAuthzRoleRepo) GetRoleHierarchy(ctx context.Context, roleID uuid.UUID) ([]*authModel.Role, error) {
+	return nil, nil
+}
+
+type stubAuthzPermissionRepo struct {
+	authRepo.PermissionRepository
+	permissions []*authModel.Permission
+}
+
+func (r *stubAuthzPermissionRepo) GetRolePermissions(ctx context.Context, roleID uuid.UUID) ([]*authModel.Permission, error) {
+	return r.permissions, nil
+}
+
+type stubAuthzUserRepo struct {
+	authRepo.UserRepository
+}
+
+func (r *stubAuthzUserRepo) FindByID(ctx context.Context, id uuid.UUID) (*authModel.User, error) {
+	return nil, errors.New("not found")
+}
+
+// newTestAuthz 创建只授予 users 指定权限（resource:action）的授权服务，其余用户一律拒绝
+func newTestAuthz(users []uuid.UUID, resource, action string) *authorization.Service {
+	granted := make(map[uuid.UUID]bool, len(users))
+	for _, id := range users {
+		granted[id] = true
+	}
+	return authorization.NewService(
+		&stubAuthzRoleRepo{users: granted},
+		&stubAuthzPermissionRepo{permissions: []*authModel.Permission{{ID: uuid.New(), Resource: resource, Action: action}}},
+		nil,
+		&stubAuthzUserRepo{},
+		nil,
+		nil,
+		nil,
+	)
+}
@@ -0,0 +1,444 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/approval/dto"
+	"github.com/lk2023060901/go-next-erp/internal/approval/model"
+	"github.com/lk2023060901/go-next-erp/internal/approval/repository"
+	"github.com/lk2023060901/go-next-erp/internal/auth/authorization"
+	authRepo "github.com/lk2023060901/go-next-erp/internal/auth/repository"
+)
+
+const (
+	// maxAdminJobTargets 单个作业最多处理的条目数
+	maxAdminJobTargets = 5000
+	// maxRunningAdminJobs 同时运行的作业数
+	maxRunningAdminJobs = 4
+
+	// adminJobCron 恢复服务重启后中断的作业
+	adminJobCron = "*/5 * * * *"
+	// staleAdminJobAfter 未结束作业超过该时长未更新视为已中断
+	staleAdminJobAfter = 30 * time.Minute
+
+	// adminJobResource 提交管理作业所需的权限资源
+	adminJobResource = "approval_admin"
+	adminJobAction   = "manage"
+)
+
+var (
+	ErrAdminJobNotFound   = errors.New("admin job not found")
+	ErrAdminJobNoTargets  = errors.New("admin job has no targets")
+	ErrAdminJobTooLarge   = errors.New("admin job has too many targets")
+	ErrAdminJobSameTarget = errors.New("successor must differ from original assignee")
+	ErrAdminJobSuccessor  = errors.New("successor must be an active user of the tenant")
+)
+
+// ProcessAdminJobService 审批管理批量作业服务接口（后台执行，可查询进度与逐条结果）
+type ProcessAdminJobService interface {
+	// 提交作业
+	SubmitReassignTasks(ctx context.Context, req *dto.SubmitReassignJobRequest) (*dto.AdminJobResponse, error)
+	SubmitCancelInstances(ctx context.Context, req *dto.SubmitInstanceJobRequest) (*dto.AdminJobResponse, error)
+	SubmitForceComplete(ctx context.Context, req *dto.SubmitInstanceJobRequest) (*dto.AdminJobResponse, error)
+	SubmitRetriggerTasks(ctx context.Context, req *dto.SubmitInstanceJobRequest) (*dto.AdminJobResponse, error)
+
+	// 查询
+	GetJob(ctx context.Context, tenantID, jobID uuid.UUID) (*dto.AdminJobResponse, error)
+	ListJobs(ctx context.Context, tenantID uuid.UUID, limit, offset int) ([]*dto.AdminJobResponse, error)
+	ListJobItems(ctx context.Context, tenantID, jobID uuid.UUID) ([]*model.AdminJobItem, error)
+
+	// RunDue 定时任务：续跑中断的作业（跳过已处理的条目），返回续跑数量
+	RunDue(ctx context.Context) (int, error)
+
+	// CronSpec 定时任务的 Cron 表达式
+	CronSpec() string
+}
+
+// adminJobHandler 处理作业中的一个条目，返回结果说明
+type adminJobHandler func(ctx context.Context, jobID, targetID uuid.UUID) (string, error)
+
+type processAdminJobService struct {
+	approvalService ApprovalService
+	jobRepo         repository.AdminJobRepository
+	taskRepo        repository.ApprovalTaskRepository
+	userRepo        authRepo.UserRepository
+	authzService    *authorization.Service
+	slots           chan struct{}
+	async           func(func())
+	active          sync.Map // 本实例排队或执行中的作业，续跑时跳过
+}
+
+// NewProcessAdminJobService 创建审批管理批量作业服务
+func NewProcessAdminJobService(
+	approvalService ApprovalService,
+	jobRepo repository.AdminJobRepository,
+	taskRepo repository.ApprovalTaskRepository,
+	userRepo authRepo.UserRepository,
+	authzService *authorization.Service,
+) ProcessAdminJobService {
+	return &processAdminJobService{
+		approvalService: approvalService,
+		jobRepo:         jobRepo,
+		taskRepo:        taskRepo,
+		userRepo:        userRepo,
+		authzService:    authzService,
+		slots:           make(chan struct{}, maxRunningAdminJobs),
+		async:           func(fn func()) { go fn() },
+	}
+}
+
+// SubmitReassignTasks 将离职人员的全部待办转交给接任者
+func (s *processAdminJobService) SubmitReassignTasks(ctx context.Context, req *dto.SubmitReassignJobRequest) (*dto.AdminJobResponse, error) {
+	if req.FromUserID == req.ToUserID {
+		return nil, ErrAdminJobSameTarget
+	}
+	if err := s.authorize(ctx, req.TenantID, req.OperatorID); err != nil {
+		return nil, err
+	}
+	successor, err := s.userRepo.FindByID(ctx, req.ToUserID)
+	if err != nil || successor.TenantID != req.TenantID || !successor.IsActive() {
+		return nil, ErrAdminJobSuccessor
+	}
+
+	tasks, err := s.taskRepo.ListPendingByAssignee(ctx, req.FromUserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending tasks: %w", err)
+	}
+	var taskIDs []uuid.UUID
+	for _, task := range tasks {
+		if task.TenantID == req.TenantID {
+			taskIDs = append(taskIDs, task.ID)
+		}
+	}
+
+	params := map[string]interface{}{
+		"from_user_id": req.FromUserID.String(),
+		"to_user_id":   req.ToUserID.String(),
+		"to_user_name": req.ToUserName,
+	}
+
+	return s.submit(ctx, req.TenantID, req.OperatorID, model.AdminJobReassignTasks, params, req.Reason, taskIDs)
+}
+
+// SubmitCancelInstances 批量取消流程实例
+func (s *processAdminJobService) SubmitCancelInstances(ctx context.Context, req *dto.SubmitInstanceJobRequest) (*dto.AdminJobResponse, error) {
+	return s.submitInstances(ctx, model.AdminJobCancelInstances, req, nil)
+}
+
+// SubmitForceComplete 批量强制完结流程实例
+func (s *processAdminJobService) SubmitForceComplete(ctx context.Context, req *dto.SubmitInstanceJobRequest) (*dto.AdminJobResponse, error) {
+	if req.Status != model.ProcessStatusApproved && req.Status != model.ProcessStatusRejected {
+		return nil, ErrInvalidForceComplete
+	}
+	params := map[string]interface{}{"status": string(req.Status)}
+	return s.submitInstances(ctx, model.AdminJobForceComplete, req, params)
+}
+
+// SubmitRetriggerTasks 为任务生成失败的实例重新生成审批任务
+func (s *processAdminJobService) SubmitRetriggerTasks(ctx context.Context, req *dto.SubmitInstanceJobRequest) (*dto.AdminJobResponse, error) {
+	return s.submitInstances(ctx, model.AdminJobRetriggerTasks, req, nil)
+}
+
+func (s *processAdminJobService) GetJob(ctx context.Context, tenantID, jobID uuid.UUID) (*dto.AdminJobResponse, error) {
+	job, err := s.findJob(ctx, tenantID, jobID)
+	if err != nil {
+		return nil, err
+	}
+	return toAdminJobResponse(job), nil
+}
+
+func (s *processAdminJobService) ListJobs(ctx context.Context, tenantID uuid.UUID, limit, offset int) ([]*dto.AdminJobResponse, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	jobs, err := s.jobRepo.ListByTenant(ctx, tenantID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*dto.AdminJobResponse, 0, len(jobs))
+	for _, job := range jobs {
+		result = append(result, toAdminJobResponse(job))
+	}
+	return result, nil
+}
+
+func (s *processAdminJobService) ListJobItems(ctx context.Context, tenantID, jobID uuid.UUID) ([]*model.AdminJobItem, error) {
+	if _, err := s.findJob(ctx, tenantID, jobID); err != nil {
+		return nil, err
+	}
+	return s.jobRepo.ListItems(ctx, jobID)
+}
+
+func (s *processAdminJobService) RunDue(ctx context.Context) (int, error) {
+	jobs, err := s.jobRepo.ListStale(ctx, time.Now().Add(-staleAdminJobAfter), maxRunningAdminJobs*10)
+	if err != nil {
+		return 0, err
+	}
+
+	resumed := 0
+	for _, job := range jobs {
+		items, err := s.jobRepo.ListItems(ctx, job.ID)
+		if err != nil {
+			return resumed, err
+		}
+		done := make(map[uuid.UUID]bool, len(items))
+		for _, item := range items {
+			done[item.TargetID] = true
+		}
+		var remaining []uuid.UUID
+		for _, targetID := range job.Targets {
+			if !done[targetID] {
+				remaining = append(remaining, targetID)
+			}
+		}
+
+		if s.start(job, remaining) {
+			resumed++
+		}
+	}
+	return resumed, nil
+}
+
+func (s *processAdminJobService) CronSpec() string {
+	return adminJobCron
+}
+
+// authorize 校验操作人是否有审批管理权限
+func (s *processAdminJobService) authorize(ctx context.Context, tenantID, operatorID uuid.UUID) error {
+	if s.authzService == nil {
+		return ErrPermissionDenied
+	}
+	allowed, err := s.authzService.CheckPermission(ctx, operatorID, tenantID, adminJobResource, adminJobAction, nil)
+	if err != nil || !allowed {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+func (s *processAdminJobService) findJob(ctx context.Context, tenantID, jobID uuid.UUID) (*model.AdminJob, error) {
+	job, err := s.jobRepo.FindByID(ctx, jobID)
+	if err != nil || job.TenantID != tenantID {
+		return nil, ErrAdminJobNotFound
+	}
+	return job, nil
+}
+
+// submitInstances 以流程实例为条目提交作业（去重后逐个处理）
+func (s *processAdminJobService) submitInstances(
+	ctx context.Context,
+	jobType model.AdminJobType,
+	req *dto.SubmitInstanceJobRequest,
+	params map[string]interface{},
+) (*dto.AdminJobResponse, error) {
+	seen := make(map[uuid.UUID]bool, len(req.InstanceIDs))
+	var ids []uuid.UUID
+	for _, id := range req.InstanceIDs {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, ErrAdminJobNoTargets
+	}
+	if err := s.authorize(ctx, req.TenantID, req.OperatorID); err != nil {
+		return nil, err
+	}
+
+	return s.submit(ctx, req.TenantID, req.OperatorID, jobType, params, req.Reason, ids)
+}
+
+// submit 创建作业记录（含全部条目，供续跑）并在后台逐条执行
+func (s *processAdminJobService) submit(
+	ctx context.Context,
+	tenantID, operatorID uuid.UUID,
+	jobType model.AdminJobType,
+	params map[string]interface{},
+	reason string,
+	targets []uuid.UUID,
+) (*dto.AdminJobResponse, error) {
+	if len(targets) > maxAdminJobTargets {
+		return nil, ErrAdminJobTooLarge
+	}
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+
+	now := time.Now()
+	job := &model.AdminJob{
+		ID:        uuid.New(),
+		TenantID:  tenantID,
+		Type:      jobType,
+		Status:    model.AdminJobStatusPending,
+		Params:    params,
+		Targets:   targets,
+		Reason:    reason,
+		Total:     len(targets),
+		CreatedBy: operatorID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.jobRepo.Create(ctx, job); err != nil {
+		return nil, fmt.Errorf("failed to create admin job: %w", err)
+	}
+
+	snapshot := *job
+	s.start(job, targets)
+
+	return toAdminJobResponse(&snapshot), nil
+}
+
+// start 在后台执行作业，作业已在本实例排队或执行时返回 false
+func (s *processAdminJobService) start(job *model.AdminJob, targets []uuid.UUID) bool {
+	if _, loaded := s.active.LoadOrStore(job.ID, true); loaded {
+		return false
+	}
+	s.async(func() {
+		defer s.active.Delete(job.ID)
+		s.run(context.Background(), job, targets)
+	})
+	return true
+}
+
+// run 执行作业：逐条处理并记录结果，单条失败不影响其他条目
+func (s *processAdminJobService) run(ctx context.Context, job *model.AdminJob, targets []uuid.UUID) {
+	s.slots <- struct{}{}
+	defer func() { <-s.slots }()
+
+	// 条目处理中的 panic 不能让作业停留在执行中
+	defer func() {
+		if r := recover(); r != nil {
+			s.finish(ctx, job, fmt.Errorf("admin job panicked: %v", r))
+		}
+	}()
+
+	handle, err := s.handler(job)
+	if err != nil {
+		s.finish(ctx, job, err)
+		return
+	}
+
+	if job.StartedAt == nil {
+		startedAt := time.Now()
+		job.StartedAt = &startedAt
+	}
+	job.Status = model.AdminJobStatusRunning
+	if err := s.jobRepo.UpdateProgress(ctx, job); err != nil {
+		s.finish(ctx, job, err)
+		return
+	}
+
+	for _, targetID := range targets {
+		message, err := handle(ctx, job.ID, targetID)
+		item := &model.AdminJobItem{
+			ID:          uuid.New(),
+			JobID:       job.ID,
+			TargetID:    targetID,
+			Status:      adminItemStatus(err),
+			Message:     message,
+			ProcessedAt: time.Now(),
+		}
+		if err != nil {
+			item.Message = err.Error()
+		}
+		if err := s.jobRepo.AddItem(ctx, item); err != nil {
+			s.finish(ctx, job, fmt.Errorf("failed to record item %s: %w", targetID, err))
+			return
+		}
+	}
+
+	s.finish(ctx, job, nil)
+}
+
+// handler 按作业类型和参数构造条目处理函数（续跑时从作业记录还原）
+func (s *processAdminJobService) handler(job *model.AdminJob) (adminJobHandler, error) {
+	reason := job.Reason
+	instanceRequest := func(jobID, instanceID uuid.UUID) *dto.AdminInstanceRequest {
+		status, _ := job.Params["status"].(string)
+		return &dto.AdminInstanceRequest{
+			TenantID:   job.TenantID,
+			JobID:      &jobID,
+			InstanceID: instanceID,
+			Status:     model.ProcessStatus(status),
+			OperatorID: job.CreatedBy,
+			Reason:     &reason,
+		}
+	}
+
+	switch job.Type {
+	case model.AdminJobReassignTasks:
+		toValue, _ := job.Params["to_user_id"].(string)
+		toUserID, err := uuid.Parse(toValue)
+		if err != nil {
+			return nil, fmt.Errorf("invalid successor in job params: %w", err)
+		}
+		toUserName, _ := job.Params["to_user_name"].(string)
+		return func(ctx context.Context, jobID, taskID uuid.UUID) (string, error) {
+			return "", s.approvalService.AdminReassignTask(ctx, &dto.AdminReassignTaskRequest{
+				TenantID:   job.TenantID,
+				JobID:      &jobID,
+				TaskID:     taskID,
+				ToUserID:   toUserID,
+				ToUserName: toUserName,
+				OperatorID: job.CreatedBy,
+				Reason:     &reason,
+			})
+		}, nil
+	case model.AdminJobCancelInstances:
+		return func(ctx context.Context, jobID, instanceID uuid.UUID) (string, error) {
+			return "", s.approvalService.AdminCancelInstance(ctx, instanceRequest(jobID, instanceID))
+		}, nil
+	case model.AdminJobForceComplete:
+		return func(ctx context.Context, jobID, instanceID uuid.UUID) (string, error) {
+			return "", s.approvalService.AdminForceCompleteInstance(ctx, instanceRequest(jobID, instanceID))
+		}, nil
+	case model.AdminJobRetriggerTasks:
+		return func(ctx context.Context, jobID, instanceID uuid.UUID) (string, error) {
+			count, err := s.approvalService.AdminRetriggerTasks(ctx, instanceRequest(jobID, instanceID))
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("created %d tasks", count), nil
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown admin job type: %s", job.Type)
+}
+
+func (s *processAdminJobService) finish(ctx context.Context, job *model.AdminJob, runErr error) {
+	finishedAt := time.Now()
+	job.FinishedAt = &finishedAt
+	job.Status = model.AdminJobStatusCompleted
+	if runErr != nil {
+		msg := runErr.Error()
+		job.Status = model.AdminJobStatusFailed
+		job.Error = &msg
+	}
+	_ = s.jobRepo.UpdateProgress(ctx, job)
+}
+
+// adminItemStatus 条目结果：状态已变化（已处理/已结束/已有任务）视为跳过
+func adminItemStatus(err error) model.AdminJobItemStatus {
+	switch {
+	case err == nil:
+		return model.AdminJobItemSucceeded
+	case errors.Is(err, ErrTaskAlreadyProcessed),
+		errors.Is(err, ErrInstanceNotPending),
+		errors.Is(err, ErrInstanceHasTasks):
+		return model.AdminJobItemSkipped
+	default:
+		return model.AdminJobItemFailed
+	}
+}
+
+func toAdminJobResponse(job *model.AdminJob) *dto.AdminJobResponse {
+	return &dto.AdminJobResponse{
+		AdminJob: job,
+		Progress: job.Progress(),
+	}
+}
@@ -0,0 +1,361 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/approval/dto"
+	"github.com/lk2023060901/go-next-erp/internal/approval/model"
+	"github.com/lk2023060901/go-next-erp/internal/approval/repository"
+	authModel "github.com/lk2023060901/go-next-erp/internal/auth/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryAdminJobRepo struct {
+	repository.AdminJobRepository
+	mu    sync.Mutex
+	jobs  map[uuid.UUID]*model.AdminJob
+	items []*model.AdminJobItem
+}
+
+func newMemoryAdminJobRepo() *memoryAdminJobRepo {
+	return &memoryAdminJobRepo{jobs: make(map[uuid.UUID]*model.AdminJob)}
+}
+
+func (r *memoryAdminJobRepo) Create(ctx context.Context, job *model.AdminJob) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := *job
+	r.jobs[job.ID] = &stored
+	return nil
+}
+
+func (r *memoryAdminJobRepo) UpdateProgress(ctx context.Context, job *model.AdminJob) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := r.jobs[job.ID]
+	stored.Status, stored.Total, stored.Error = job.Status, job.Total, job.Error
+	stored.StartedAt, stored.FinishedAt = job.StartedAt, job.FinishedAt
+	return nil
+}
+
+func (r *memoryAdminJobRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.AdminJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	job, ok := r.jobs[id]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	copied := *job
+	return &copied, nil
+}
+
+func (r *memoryAdminJobRepo) AddItem(ctx context.Context, item *model.AdminJobItem) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.items = append(r.items, item)
+	job := r.jobs[item.JobID]
+	job.Processed++
+	switch item.Status {
+	case model.AdminJobItemSucceeded:
+		job.Succeeded++
+	case model.AdminJobItemFailed:
+		job.Failed++
+	case model.AdminJobItemSkipped:
+		job.Skipped++
+	}
+	return nil
+}
+
+func (r *memoryAdminJobRepo) ListStale(ctx context.Context, before time.Time, limit int) ([]*model.AdminJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var jobs []*model.AdminJob
+	for _, job := range r.jobs {
+		unfinished := job.Status == model.AdminJobStatusPending || job.Status == model.AdminJobStatusRunning
+		if unfinished && job.UpdatedAt.Before(before) {
+			copied := *job
+			jobs = append(jobs, &copied)
+		}
+	}
+	return jobs, nil
+}
+
+func (r *memoryAdminJobRepo) ListItems(ctx context.Context, jobID uuid.UUID) ([]*model.AdminJobItem, error) {
+	return r.items, nil
+}
+
+type stubPendingTaskRepo struct {
+	repository.ApprovalTaskRepository
+	tasks []*model.ApprovalTask
+}
+
+func (r *stubPendingTaskRepo) ListPendingByAssignee(ctx context.Context, assigneeID uuid.UUID) ([]*model.ApprovalTask, error) {
+	return r.tasks, nil
+}
+
+type stubAdminApprovalService struct {
+	ApprovalService
+	reassigned []*dto.AdminReassignTaskRequest
+	results    map[uuid.UUID]error
+	panicOn    uuid.UUID
+}
+
+func (s *stubAdminApprovalService) AdminReassignTask(ctx context.Context, req *dto.AdminReassignTaskRequest) error {
+	s.reassigned = append(s.reassigned, req)
+	return s.results[req.TaskID]
+}
+
+func (s *stubAdminApprovalService) AdminCancelInstance(ctx context.Context, req *dto.AdminInstanceRequest) error {
+	return s.results[req.InstanceID]
+}
+
+func (s *stubAdminApprovalService) AdminForceCompleteInstance(ctx context.Context, req *dto.AdminInstanceRequest) error {
+	if req.InstanceID == s.panicOn {
+		panic("nil form data")
+	}
+	return s.results[req.InstanceID]
+}
+
+func (s *stubAdminApprovalService) AdminRetriggerTasks(ctx context.Context, req *dto.AdminInstanceRequest) (int, error) {
+	if err := s.results[req.InstanceID]; err != nil {
+		return 0, err
+	}
+	return 2, nil
+}
+
+// testAdminOperator 测试中拥有审批管理权限的操作人
+var testAdminOperator = uuid.New()
+
+func newTestAdminJobService(approval ApprovalService, jobRepo repository.AdminJobRepository, taskRepo repository.ApprovalTaskRepository, users map[uuid.UUID]*authModel.User) *processAdminJobService {
+	return &processAdminJobService{
+		approvalService: approval,
+		jobRepo:         jobRepo,
+		taskRepo:        taskRepo,
+		userRepo:        &stubUserRepo{users: users},
+		authzService:    newTestAuthz([]uuid.UUID{testAdminOperator}, adminJobResource, adminJobAction),
+		slots:           make(chan struct{}, 1),
+		async:           func(fn func()) { fn() },
+	}
+}
+
+func TestProcessAdminJobService_ReassignTasks(t *testing.T) {
+	tenantID, otherTenant := uuid.New(), uuid.New()
+	from, to, inactive, outsider := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	t1, t2, t3 := uuid.New(), uuid.New(), uuid.New()
+
+	taskRepo := &stubPendingTaskRepo{tasks: []*model.ApprovalTask{
+		{ID: t1, TenantID: tenantID},
+		{ID: t2, TenantID: tenantID},
+		{ID: t3, TenantID: otherTenant},
+	}}
+	approval := &stubAdminApprovalService{results: map[uuid.UUID]error{
+		t2: ErrTaskAlreadyProcessed,
+	}}
+	jobRepo := newMemoryAdminJobRepo()
+	svc := newTestAdminJobService(approval, jobRepo, taskRepo, map[uuid.UUID]*authModel.User{
+		to:       {ID: to, TenantID: tenantID, Status: authModel.UserStatusActive},
+		inactive: {ID: inactive, TenantID: tenantID, Status: authModel.UserStatusInactive},
+		outsider: {ID: outsider, TenantID: otherTenant, Status: authModel.UserStatusActive},
+	})
+
+	resp, err := svc.SubmitReassignTasks(context.Background(), &dto.SubmitReassignJobRequest{
+		TenantID:   tenantID,
+		OperatorID: testAdminOperator,
+		FromUserID: from,
+		ToUserID:   to,
+		ToUserName: "接任者",
+		Reason:     "离职交接",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.Total)
+
+	// 其他租户的任务不会被转交
+	require.Len(t, approval.reassigned, 2)
+	assert.Equal(t, to, approval.reassigned[0].ToUserID)
+	assert.Equal(t, resp.ID, *approval.reassigned[0].JobID)
+
+	job, err := svc.GetJob(context.Background(), tenantID, resp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.AdminJobStatusCompleted, job.Status)
+	assert.Equal(t, 1, job.Succeeded)
+	assert.Equal(t, 1, job.Skipped)
+	assert.Equal(t, 1.0, job.Progress)
+	assert.NotNil(t, job.FinishedAt)
+
+	t.Run("other tenant cannot read job", func(t *testing.T) {
+		_, err := svc.GetJob(context.Background(), otherTenant, resp.ID)
+		assert.ErrorIs(t, err, ErrAdminJobNotFound)
+	})
+
+	t.Run("successor must differ", func(t *testing.T) {
+		_, err := svc.SubmitReassignTasks(context.Background(), &dto.SubmitReassignJobRequest{
+			TenantID: tenantID, FromUserID: from, ToUserID: from,
+		})
+		assert.ErrorIs(t, err, ErrAdminJobSameTarget)
+	})
+
+	t.Run("successor must be an active user of the tenant", func(t *testing.T) {
+		for _, successor := range []uuid.UUID{inactive, outsider, uuid.New()} {
+			_, err := svc.SubmitReassignTasks(context.Background(), &dto.SubmitReassignJobRequest{
+				TenantID: tenantID, OperatorID: testAdminOperator, FromUserID: from, ToUserID: successor,
+			})
+			assert.ErrorIs(t, err, ErrAdminJobSuccessor)
+		}
+	})
+
+	t.Run("requires admin permission", func(t *testing.T) {
+		_, err := svc.SubmitReassignTasks(context.Background(), &dto.SubmitReassignJobRequest{
+			TenantID: tenantID, OperatorID: uuid.New(), FromUserID: from, ToUserID: to,
+		})
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+
+		svc.authzService = nil
+		defer func() {
+			svc.authzService = newTestAuthz([]uuid.UUID{testAdminOperator}, adminJobResource, adminJobAction)
+		}()
+		_, err = svc.SubmitReassignTasks(context.Background(), &dto.SubmitReassignJobRequest{
+			TenantID: tenantID, OperatorID: testAdminOperator, FromUserID: from, ToUserID: to,
+		})
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+	})
+}
+
+func TestProcessAdminJobService_InstanceJobs(t *testing.T) {
+	tenantID := uuid.New()
+	ok, done, broken := uuid.New(), uuid.New(), uuid.New()
+
+	approval := &stubAdminApprovalService{results: map[uuid.UUID]error{
+		done:   ErrInstanceNotPending,
+		broken: errors.New("no assignee found for node: n1"),
+	}}
+	jobRepo := newMemoryAdminJobRepo()
+	svc := newTestAdminJobService(approval, jobRepo, nil, nil)
+
+	resp, err := svc.SubmitCancelInstances(context.Background(), &dto.SubmitInstanceJobRequest{
+		TenantID:    tenantID,
+		OperatorID:  testAdminOperator,
+		InstanceIDs: []uuid.UUID{ok, done, broken, ok},
+		Reason:      "流程卡死",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, resp.Total, "duplicate ids are processed once")
+
+	items, err := svc.ListJobItems(context.Background(), tenantID, resp.ID)
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+	assert.Equal(t, model.AdminJobItemSucceeded, items[0].Status)
+	assert.Equal(t, model.AdminJobItemSkipped, items[1].Status)
+	assert.Equal(t, model.AdminJobItemFailed, items[2].Status)
+	assert.Contains(t, items[2].Message, "no assignee")
+
+	t.Run("retrigger reports created tasks", func(t *testing.T) {
+		jobRepo.items = nil
+		resp, err := svc.SubmitRetriggerTasks(context.Background(), &dto.SubmitInstanceJobRequest{
+			TenantID:    tenantID,
+			OperatorID:  testAdminOperator,
+			InstanceIDs: []uuid.UUID{ok},
+		})
+		require.NoError(t, err)
+
+		items, err := svc.ListJobItems(context.Background(), tenantID, resp.ID)
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, "created 2 tasks", items[0].Message)
+	})
+
+	t.Run("force complete requires final status", func(t *testing.T) {
+		_, err := svc.SubmitForceComplete(context.Background(), &dto.SubmitInstanceJobRequest{
+			TenantID:    tenantID,
+			OperatorID:  testAdminOperator,
+			InstanceIDs: []uuid.UUID{ok},
+			Status:      model.ProcessStatusCancelled,
+		})
+		assert.ErrorIs(t, err, ErrInvalidForceComplete)
+	})
+
+	t.Run("empty targets", func(t *testing.T) {
+		_, err := svc.SubmitCancelInstances(context.Background(), &dto.SubmitInstanceJobRequest{TenantID: tenantID, OperatorID: testAdminOperator})
+		assert.ErrorIs(t, err, ErrAdminJobNoTargets)
+	})
+}
+
+func TestProcessAdminJobService_RunDue(t *testing.T) {
+	tenantID, operatorID, toUserID := uuid.New(), uuid.New(), uuid.New()
+	t1, t2, t3 := uuid.New(), uuid.New(), uuid.New()
+
+	approval := &stubAdminApprovalService{}
+	jobRepo := newMemoryAdminJobRepo()
+	svc := newTestAdminJobService(approval, jobRepo, nil, nil)
+
+	// 服务重启前已处理 t1，作业停留在执行中
+	startedAt := time.Now().Add(-2 * time.Hour)
+	interrupted := &model.AdminJob{
+		ID:        uuid.New(),
+		TenantID:  tenantID,
+		Type:      model.AdminJobReassignTasks,
+		Status:    model.AdminJobStatusRunning,
+		Params:    map[string]interface{}{"to_user_id": toUserID.String(), "to_user_name": "接任者"},
+		Targets:   []uuid.UUID{t1, t2, t3},
+		Reason:    "离职交接",
+		Total:     3,
+		CreatedBy: operatorID,
+		StartedAt: &startedAt,
+		UpdatedAt: startedAt,
+	}
+	require.NoError(t, jobRepo.Create(context.Background(), interrupted))
+	require.NoError(t, jobRepo.AddItem(context.Background(), &model.AdminJobItem{
+		ID: uuid.New(), JobID: interrupted.ID, TargetID: t1, Status: model.AdminJobItemSucceeded,
+	}))
+
+	// 最近仍在更新的作业不续跑
+	fresh := &model.AdminJob{ID: uuid.New(), TenantID: tenantID, Type: model.AdminJobCancelInstances, Status: model.AdminJobStatusRunning, UpdatedAt: time.Now()}
+	require.NoError(t, jobRepo.Create(context.Background(), fresh))
+
+	resumed, err := svc.RunDue(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, resumed)
+
+	require.Len(t, approval.reassigned, 2, "processed items are not repeated")
+	assert.Equal(t, t2, approval.reassigned[0].TaskID)
+	assert.Equal(t, toUserID, approval.reassigned[0].ToUserID)
+	assert.Equal(t, operatorID, approval.reassigned[0].OperatorID)
+
+	job, err := svc.GetJob(context.Background(), tenantID, interrupted.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.AdminJobStatusCompleted, job.Status)
+	assert.Equal(t, 3, job.Processed)
+	assert.Equal(t, startedAt, *job.StartedAt)
+}
+
+func TestProcessAdminJobService_PanicFailsJob(t *testing.T) {
+	tenantID, instanceID := uuid.New(), uuid.New()
+	approval := &stubAdminApprovalService{panicOn: instanceID}
+	jobRepo := newMemoryAdminJobRepo()
+	svc := newTestAdminJobService(approval, jobRepo, nil, nil)
+
+	resp, err := svc.SubmitForceComplete(context.Background(), &dto.SubmitInstanceJobRequest{
+		TenantID:    tenantID,
+		OperatorID:  testAdminOperator,
+		InstanceIDs: []uuid.UUID{instanceID},
+		Status:      model.ProcessStatusApproved,
+	})
+	require.NoError(t, err)
+
+	job, err := svc.GetJob(context.Background(), tenantID, resp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.AdminJobStatusFailed, job.Status)
+	require.NotNil(t, job.Error)
+	assert.Contains(t, *job.Error, "panicked")
+}
+
+func TestApprovalAction_IsAdminAction(t *testing.T) {
+	assert.True(t, model.ApprovalActionAdminReassign.IsAdminAction())
+	assert.True(t, model.ApprovalActionAdminRetrigger.IsAdminAction())
+	assert.False(t, model.ApprovalActionApprove.IsAdminAction())
+}
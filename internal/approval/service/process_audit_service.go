@@ -0,0 +1,379 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/approval/dto"
+	"github.com/lk2023060901/go-next-erp/internal/approval/model"
+	"github.com/lk2023060901/go-next-erp/internal/approval/repository"
+	"github.com/lk2023060901/go-next-erp/internal/auth/authorization"
+	fileModel "github.com/lk2023060901/go-next-erp/internal/file/model"
+	fileService "github.com/lk2023060901/go-next-erp/internal/file/service"
+	formRepo "github.com/lk2023060901/go-next-erp/internal/form/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/pdf"
+)
+
+var (
+	ErrInvalidDossierFormat = errors.New("invalid dossier format")
+)
+
+const (
+	dossierFileCategory   = "approval_dossier"
+	dossierDownloadExpiry = 24 * time.Hour
+
+	// auditResource 校验历史链、导出档案所需的权限资源
+	auditResource = "approval_admin"
+	auditAction   = "audit"
+)
+
+// ProcessAuditService 审批审计服务接口（历史哈希链校验、档案导出）
+type ProcessAuditService interface {
+	VerifyHistoryChain(ctx context.Context, tenantID, instanceID, operatorID uuid.UUID) (*model.HistoryChainReport, error)
+	ExportDossier(ctx context.Context, req *dto.ExportDossierRequest) (*dto.DossierExportResult, error)
+}
+
+type processAuditService struct {
+	processInstRepo repository.ProcessInstanceRepository
+	taskRepo        repository.ApprovalTaskRepository
+	historyRepo     repository.ProcessHistoryRepository
+	formDataRepo    formRepo.FormDataRepository
+	uploadService   fileService.UploadService
+	relationService fileService.FileRelationService
+	downloadService fileService.DownloadService
+	authzService    *authorization.Service
+	chainKey        model.HistoryChainKey
+}
+
+// NewProcessAuditService 创建审批审计服务
+func NewProcessAuditService(
+	processInstRepo repository.ProcessInstanceRepository,
+	taskRepo repository.ApprovalTaskRepository,
+	historyRepo repository.ProcessHistoryRepository,
+	formDataRepo formRepo.FormDataRepository,
+	uploadService fileService.UploadService,
+	relationService fileService.FileRelationService,
+	downloadService fileService.DownloadService,
+	authzService *authorization.Service,
+	chainKey model.HistoryChainKey,
+) ProcessAuditService {
+	return &processAuditService{
+		processInstRepo: processInstRepo,
+		taskRepo:        taskRepo,
+		historyRepo:     historyRepo,
+		formDataRepo:    formDataRepo,
+		uploadService:   uploadService,
+		relationService: relationService,
+		downloadService: downloadService,
+		authzService:    authzService,
+		chainKey:        chainKey,
+	}
+}
+
+// VerifyHistoryChain 校验流程实例历史记录的哈希链（仅限本租户的实例，需审计权限）
+func (s *processAuditService) VerifyHistoryChain(ctx context.Context, tenantID, instanceID, operatorID uuid.UUID) (*model.HistoryChainReport, error) {
+	if err := s.authorize(ctx, tenantID, operatorID); err != nil {
+		return nil, err
+	}
+
+	instance, err := s.processInstRepo.FindByID(ctx, instanceID)
+	if err != nil || instance.TenantID != tenantID {
+		return nil, ErrProcessInstanceNotFound
+	}
+
+	headSeq, headHash, err := s.historyRepo.GetChainHead(ctx, instanceID)
+	if err != nil {
+		return nil, ErrProcessInstanceNotFound
+	}
+
+	histories, err := s.historyRepo.ListByInstance(ctx, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list histories: %w", err)
+	}
+
+	return model.VerifyHistoryChain(s.chainKey, instanceID, histories, headSeq, headHash), nil
+}
+
+// buildDossier 汇总流程实例档案（表单数据、操作记录、任务、附件清单、链校验结果）
+func (s *processAuditService) buildDossier(ctx context.Context, instanceID, operatorID uuid.UUID) (*dto.ProcessDossier, error) {
+	instance, err := s.processInstRepo.FindByID(ctx, instanceID)
+	if err != nil {
+		return nil, ErrProcessInstanceNotFound
+	}
+
+	headSeq, headHash, err := s.historyRepo.GetChainHead(ctx, instanceID)
+	if err != nil {
+		return nil, ErrProcessInstanceNotFound
+	}
+
+	// 档案内容与校验结果基于同一份历史记录
+	histories, err := s.historyRepo.ListByInstance(ctx, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list histories: %w", err)
+	}
+	chain := model.VerifyHistoryChain(s.chainKey, instanceID, histories, headSeq, headHash)
+
+	tasks, err := s.taskRepo.ListByInstance(ctx, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	dossier := &dto.ProcessDossier{
+		Instance:    instance,
+		Histories:   histories,
+		Tasks:       tasks,
+		Attachments: []*dto.DossierAttachment{},
+		Chain:       chain,
+		ExportedBy:  operatorID,
+		ExportedAt:  time.Now(),
+	}
+
+	if formData, err := s.formDataRepo.FindByID(ctx, instance.FormDataID); err == nil {
+		dossier.FormData = formData.Data
+	}
+
+	// 附件清单：表单附件 + 各审批任务附件
+	if s.relationService != nil {
+		files, err := s.relationService.GetEntityFiles(ctx, fileModel.EntityTypeFormData, instance.FormDataID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list form attachments: %w", err)
+		}
+		for _, f := range files {
+			dossier.Attachments = append(dossier.Attachments, fileAttachment(f, "form_data", nil))
+		}
+	}
+	for _, task := range tasks {
+		taskID := task.ID
+		if s.relationService != nil {
+			files, err := s.relationService.GetEntityFiles(ctx, fileModel.EntityTypeApprovalTask, task.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list task attachments: %w", err)
+			}
+			for _, f := range files {
+				dossier.Attachments = append(dossier.Attachments, fileAttachment(f, "task", &taskID))
+			}
+		}
+		for _, url := range task.Attachments {
+			dossier.Attachments = append(dossier.Attachments, &dto.DossierAttachment{
+				Filename: url,
+				Source:   "task",
+				TaskID:   &taskID,
+				URL:      url,
+			})
+		}
+	}
+
+	return dossier, nil
+}
+
+// ExportDossier 导出流程实例档案（JSON/PDF，需审计权限），存入文件模块并关联到流程实例
+func (s *processAuditService) ExportDossier(ctx context.Context, req *dto.ExportDossierRequest) (*dto.DossierExportResult, error) {
+	if req.Format == "" {
+		req.Format = dto.DossierFormatPDF
+	}
+	if err := s.authorize(ctx, req.TenantID, req.OperatorID); err != nil {
+		return nil, err
+	}
+
+	dossier, err := s.buildDossier(ctx, req.ProcessInstanceID, req.OperatorID)
+	if err != nil {
+		return nil, err
+	}
+	if dossier.Instance.TenantID != req.TenantID {
+		return nil, ErrProcessInstanceNotFound
+	}
+
+	var content []byte
+	var contentType string
+	switch req.Format {
+	case dto.DossierFormatJSON:
+		content, err = RenderDossierJSON(dossier)
+		contentType = "application/json"
+	case dto.DossierFormatPDF:
+		content = RenderDossierPDF(dossier)
+		contentType = "application/pdf"
+	default:
+		return nil, ErrInvalidDossierFormat
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to render dossier: %w", err)
+	}
+
+	filename := fmt.Sprintf("approval-dossier-%s-%s.%s",
+		req.ProcessInstanceID.String()[:8], dossier.ExportedAt.Format("20060102150405"), req.Format)
+	category := dossierFileCategory
+
+	file, err := s.uploadService.Upload(ctx, &fileService.UploadRequest{
+		TenantID:    req.TenantID,
+		UploadedBy:  req.OperatorID,
+		Filename:    filename,
+		Reader:      bytes.NewReader(content),
+		Size:        int64(len(content)),
+		ContentType: contentType,
+		Category:    &category,
+		Tags:        []string{"approval", "audit"},
+		Metadata: map[string]interface{}{
+			"process_instance_id": req.ProcessInstanceID.String(),
+			"chain_valid":         dossier.Chain.Valid,
+			"chain_head_hash":     dossier.Chain.HeadHash,
+		},
+		AccessLevel: fileModel.AccessLevelTenant,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to store dossier: %w", err)
+	}
+
+	if s.relationService != nil {
+		description := fmt.Sprintf("审批档案导出（%s）", req.Format)
+		if err := s.relationService.AttachFileToEntity(ctx, &fileService.AttachFileRequest{
+			FileID:       file.ID,
+			TenantID:     req.TenantID,
+			EntityType:   fileModel.EntityTypeApprovalInstance,
+			EntityID:     req.ProcessInstanceID,
+			RelationType: fileModel.RelationTypeReport,
+			Description:  &description,
+			CreatedBy:    req.OperatorID,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to attach dossier: %w", err)
+		}
+	}
+
+	result := &dto.DossierExportResult{
+		FileID:     file.ID,
+		Filename:   file.Filename,
+		Format:     req.Format,
+		Size:       file.Size,
+		ChainValid: dossier.Chain.Valid,
+	}
+
+	if s.downloadService != nil {
+		if url, err := s.downloadService.GetDownloadURL(ctx, file.ID, req.OperatorID, req.TenantID, dossierDownloadExpiry); err == nil {
+			result.DownloadURL = url
+		}
+	}
+
+	return result, nil
+}
+
+// authorize 校验操作人是否有审批审计权限
+func (s *processAuditService) authorize(ctx context.Context, tenantID, operatorID uuid.UUID) error {
+	if s.authzService == nil {
+		return ErrPermissionDenied
+	}
+	allowed, err := s.authzService.CheckPermission(ctx, operatorID, tenantID, auditResource, auditAction, nil)
+	if err != nil || !allowed {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// RenderDossierJSON 渲染 JSON 格式档案
+func RenderDossierJSON(dossier *dto.ProcessDossier) ([]byte, error) {
+	return json.MarshalIndent(dossier, "", "  ")
+}
+
+// RenderDossierPDF 渲染 PDF 格式档案
+func RenderDossierPDF(dossier *dto.ProcessDossier) []byte {
+	inst := dossier.Instance
+	doc := pdf.New("审批档案 - " + inst.Title)
+
+	doc.Heading("审批档案", 18)
+	doc.KeyValue("流程标题", inst.Title)
+	doc.KeyValue("流程名称", fmt.Sprintf("%s（%s）", inst.ProcessDefName, inst.ProcessDefCode))
+	doc.KeyValue("实例ID", inst.ID.String())
+	doc.KeyValue("申请人", inst.ApplicantName)
+	doc.KeyValue("当前状态", string(inst.Status))
+	doc.KeyValue("发起时间", formatDossierTime(inst.StartedAt))
+	if inst.CompletedAt != nil {
+		doc.KeyValue("完成时间", formatDossierTime(*inst.CompletedAt))
+	}
+	doc.KeyValue("导出时间", formatDossierTime(dossier.ExportedAt))
+	doc.KeyValue("导出人", dossier.ExportedBy.String())
+
+	doc.Heading("表单数据", 14)
+	doc.Line()
+	if len(dossier.FormData) == 0 {
+		doc.Text("（无）")
+	}
+	for _, key := range sortedKeys(dossier.FormData) {
+		doc.KeyValue(key, fmt.Sprint(dossier.FormData[key]))
+	}
+
+	doc.Heading("审批记录", 14)
+	doc.Line()
+	for _, h := range dossier.Histories {
+		doc.Text(fmt.Sprintf("#%d  %s  %s  %s（%s）", h.Sequence, formatDossierTime(h.CreatedAt), h.NodeName, h.OperatorName, h.Action))
+		if h.Comment != nil && *h.Comment != "" {
+			doc.TextIndent("意见："+*h.Comment, 20)
+		}
+		doc.TextIndent("状态："+historyStatusChange(h), 20)
+		doc.TextIndent("哈希："+h.Hash, 20)
+	}
+
+	doc.Heading("附件清单", 14)
+	doc.Line()
+	if len(dossier.Attachments) == 0 {
+		doc.Text("（无）")
+	}
+	for i, a := range dossier.Attachments {
+		line := fmt.Sprintf("%d. %s", i+1, a.Filename)
+		if a.Size > 0 {
+			line += fmt.Sprintf("（%d 字节）", a.Size)
+		}
+		doc.Text(line)
+	}
+
+	doc.Heading("防篡改校验", 14)
+	doc.Line()
+	chain := dossier.Chain
+	if chain.Valid {
+		doc.Text("校验结果：通过")
+	} else {
+		doc.Text(fmt.Sprintf("校验结果：未通过（%d 项问题）", len(chain.Issues)))
+	}
+	doc.KeyValue("记录数", fmt.Sprint(chain.Total))
+	doc.KeyValue("链头序号", fmt.Sprint(chain.HeadSequence))
+	doc.KeyValue("链头哈希", chain.HeadHash)
+	for _, issue := range chain.Issues {
+		doc.TextIndent(fmt.Sprintf("[%s] #%d %s", issue.Type, issue.Sequence, issue.Detail), 20)
+	}
+
+	return doc.Bytes()
+}
+
+func fileAttachment(f *fileModel.File, source string, taskID *uuid.UUID) *dto.DossierAttachment {
+	id := f.ID
+	return &dto.DossierAttachment{
+		FileID:   &id,
+		Filename: f.Filename,
+		Size:     f.Size,
+		Source:   source,
+		TaskID:   taskID,
+	}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func historyStatusChange(h *model.ProcessHistory) string {
+	if h.FromStatus == nil {
+		return string(h.ToStatus)
+	}
+	return string(*h.FromStatus) + " -> " + string(h.ToStatus)
+}
+
+func formatDossierTime(t time.Time) string {
+	return t.Local().Format("2006-01-02 15:04:05")
+}
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/approval/dto"
+	"github.com/lk2023060901/go-next-erp/internal/approval/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testChainKey = model.HistoryChainKey("test-chain-key")
+
+// buildChain 按仓储写入逻辑构造一条哈希链
+func buildChain(instanceID uuid.UUID, n int) []*model.ProcessHistory {
+	tenantID := uuid.New()
+	start := time.Date(2024, 3, 1, 9, 0, 0, 123456789, time.Local)
+
+	var histories []*model.ProcessHistory
+	prev := ""
+	for i := 0; i < n; i++ {
+		comment := "同意"
+		h := &model.ProcessHistory{
+			ID:                uuid.New(),
+			TenantID:          tenantID,
+			ProcessInstanceID: instanceID,
+			NodeID:            "node_1",
+			NodeName:          "部门审批",
+			OperatorID:        uuid.New(),
+			OperatorName:      "李四",
+			Action:            model.ApprovalActionApprove,
+			Comment:           &comment,
+			ToStatus:          model.ProcessStatusPending,
+			CreatedAt:         model.ChainTimestamp(start.Add(time.Duration(i) * time.Minute)),
+			Sequence:          int64(i + 1),
+			PrevHash:          prev,
+		}
+		h.Hash = h.ComputeHash(testChainKey)
+		prev = h.Hash
+		histories = append(histories, h)
+	}
+
+	return histories
+}
+
+func issueTypes(report *model.HistoryChainReport) []model.HistoryChainIssueType {
+	var types []model.HistoryChainIssueType
+	for _, issue := range report.Issues {
+		types = append(types, issue.Type)
+	}
+	return types
+}
+
+func TestVerifyHistoryChain(t *testing.T) {
+	instanceID := uuid.New()
+
+	t.Run("intact chain", func(t *testing.T) {
+		chain := buildChain(instanceID, 3)
+		report := model.VerifyHistoryChain(testChainKey, instanceID, chain, 3, chain[2].Hash)
+		assert.True(t, report.Valid)
+		assert.Empty(t, report.Issues)
+		assert.Equal(t, 3, report.Total)
+	})
+
+	t.Run("tampered comment", func(t *testing.T) {
+		chain := buildChain(instanceID, 3)
+		forged := "拒绝"
+		chain[1].Comment = &forged
+
+		report := model.VerifyHistoryChain(testChainKey, instanceID, chain, 3, chain[2].Hash)
+		assert.False(t, report.Valid)
+		assert.Equal(t, []model.HistoryChainIssueType{model.HistoryChainHashMismatch}, issueTypes(report))
+		assert.Equal(t, int64(2), report.Issues[0].Sequence)
+	})
+
+	t.Run("rehashed record breaks successor", func(t *testing.T) {
+		chain := buildChain(instanceID, 3)
+		chain[1].OperatorName = "王五"
+		chain[1].Hash = chain[1].ComputeHash(testChainKey)
+
+		report := model.VerifyHistoryChain(testChainKey, instanceID, chain, 3, chain[2].Hash)
+		assert.Equal(t, []model.HistoryChainIssueType{model.HistoryChainPrevMismatch}, issueTypes(report))
+		assert.Equal(t, int64(3), report.Issues[0].Sequence)
+	})
+
+	t.Run("chain rebuilt without the key", func(t *testing.T) {
+		chain := buildChain(instanceID, 3)
+		// 篡改后用其他密钥重算整条链和链头
+		chain[1].OperatorName = "王五"
+		prev := ""
+		for _, h := range chain {
+			h.PrevHash = prev
+			h.Hash = h.ComputeHash(model.HistoryChainKey("guessed-key"))
+			prev = h.Hash
+		}
+
+		report := model.VerifyHistoryChain(testChainKey, instanceID, chain, 3, chain[2].Hash)
+		assert.Equal(t, []model.HistoryChainIssueType{
+			model.HistoryChainHashMismatch, model.HistoryChainHashMismatch, model.HistoryChainHashMismatch,
+		}, issueTypes(report))
+	})
+
+	t.Run("deleted middle record", func(t *testing.T) {
+		chain := buildChain(instanceID, 3)
+		report := model.VerifyHistoryChain(testChainKey, instanceID, []*model.ProcessHistory{chain[0], chain[2]}, 3, chain[2].Hash)
+		assert.Equal(t, []model.HistoryChainIssueType{model.HistoryChainGap}, issueTypes(report))
+	})
+
+	t.Run("deleted tail record", func(t *testing.T) {
+		chain := buildChain(instanceID, 3)
+		report := model.VerifyHistoryChain(testChainKey, instanceID, chain[:2], 3, chain[2].Hash)
+		assert.Equal(t, []model.HistoryChainIssueType{model.HistoryChainHeadMismatch}, issueTypes(report))
+	})
+
+	t.Run("legacy records", func(t *testing.T) {
+		legacy := &model.ProcessHistory{ID: uuid.New(), ProcessInstanceID: instanceID}
+		report := model.VerifyHistoryChain(testChainKey, instanceID, []*model.ProcessHistory{legacy}, 0, "")
+		assert.Equal(t, []model.HistoryChainIssueType{model.HistoryChainUnchained}, issueTypes(report))
+	})
+}
+
+func TestComputeHashTimestampPrecision(t *testing.T) {
+	chain := buildChain(uuid.New(), 1)
+	h := chain[0]
+
+	// 数据库读回的时间为 UTC 微秒精度，哈希应保持一致
+	h.CreatedAt = h.CreatedAt.In(time.UTC)
+	assert.Equal(t, h.Hash, h.ComputeHash(testChainKey))
+}
+
+func TestRenderDossier(t *testing.T) {
+	instanceID := uuid.New()
+	chain := buildChain(instanceID, 2)
+	dossier := &dto.ProcessDossier{
+		Instance: &model.ProcessInstance{
+			ID:             instanceID,
+			ProcessDefName: "费用报销",
+			ProcessDefCode: "EXPENSE",
+			ApplicantName:  "张三",
+			Title:          "三月差旅报销",
+			Status:         model.ProcessStatusApproved,
+			StartedAt:      time.Now(),
+		},
+		FormData:    map[string]interface{}{"amount": 1200, "reason": "出差"},
+		Histories:   chain,
+		Attachments: []*dto.DossierAttachment{{Filename: "invoice.pdf", Size: 2048, Source: "form_data"}},
+		Chain:       model.VerifyHistoryChain(testChainKey, instanceID, chain, 2, chain[1].Hash),
+		ExportedAt:  time.Now(),
+	}
+
+	t.Run("json", func(t *testing.T) {
+		content, err := RenderDossierJSON(dossier)
+		require.NoError(t, err)
+
+		var decoded map[string]interface{}
+		require.NoError(t, json.Unmarshal(content, &decoded))
+		assert.Len(t, decoded["histories"], 2)
+		assert.Equal(t, true, decoded["chain"].(map[string]interface{})["valid"])
+	})
+
+	t.Run("pdf", func(t *testing.T) {
+		content := RenderDossierPDF(dossier)
+		assert.True(t, bytes.HasPrefix(content, []byte("%PDF-")))
+		assert.Contains(t, string(content), "/Type /Page ")
+		assert.True(t, bytes.HasSuffix(content, []byte("%%EOF\n")))
+	})
+}
+
+// chainHistoryRepo 返回固定哈希链的历史仓储
+type chainHistoryRepo struct {
+	memoryHistoryRepo
+	chain []*model.ProcessHistory
+}
+
+func (r *chainHistoryRepo) GetChainHead(ctx context.Context, instanceID uuid.UUID) (int64, string, error) {
+	last := r.chain[len(r.chain)-1]
+	return last.Sequence, last.Hash, nil
+}
+
+func (r *chainHistoryRepo) ListByInstance(ctx context.Context, instanceID uuid.UUID) ([]*model.ProcessHistory, error) {
+	return r.chain, nil
+}
+
+func TestProcessAuditService_Authorize(t *testing.T) {
+	ctx := context.Background()
+	tenantID, auditor := uuid.New(), uuid.New()
+	instanceID := uuid.New()
+
+	svc := &processAuditService{
+		processInstRepo: &memoryInstanceRepo{instances: map[uuid.UUID]*model.ProcessInstance{
+			instanceID: {ID: instanceID, TenantID: tenantID},
+		}},
+		historyRepo:  &chainHistoryRepo{chain: buildChain(instanceID, 2)},
+		authzService: newTestAuthz([]uuid.UUID{auditor}, auditResource, auditAction),
+		chainKey:     testChainKey,
+	}
+
+	t.Run("auditor verifies chain", func(t *testing.T) {
+		report, err := svc.VerifyHistoryChain(ctx, tenantID, instanceID, auditor)
+		require.NoError(t, err)
+		assert.True(t, report.Valid)
+	})
+
+	t.Run("other tenant cannot verify", func(t *testing.T) {
+		_, err := svc.VerifyHistoryChain(ctx, uuid.New(), instanceID, auditor)
+		assert.ErrorIs(t, err, ErrProcessInstanceNotFound)
+	})
+
+	t.Run("non auditor is denied", func(t *testing.T) {
+		_, err := svc.VerifyHistoryChain(ctx, tenantID, instanceID, uuid.New())
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = svc.ExportDossier(ctx, &dto.ExportDossierRequest{TenantID: tenantID, OperatorID: uuid.New(), ProcessInstanceID: instanceID})
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+	})
+
+	t.Run("denied without authorization service", func(t *testing.T) {
+		unconfigured := *svc
+		unconfigured.authzService = nil
+		_, err := unconfigured.VerifyHistoryChain(ctx, tenantID, instanceID, auditor)
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = unconfigured.ExportDossier(ctx, &dto.ExportDossierRequest{TenantID: tenantID, OperatorID: auditor, ProcessInstanceID: instanceID})
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+	})
+}
@@ -0,0 +1,409 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/approval/dto"
+	"github.com/lk2023060901/go-next-erp/internal/approval/model"
+	"github.com/lk2023060901/go-next-erp/internal/approval/repository"
+	"github.com/lk2023060901/go-next-erp/internal/auth/authorization"
+	"github.com/lk2023060901/go-next-erp/pkg/pagination"
+)
+
+var (
+	ErrInvalidSearchField = errors.New("invalid search field")
+	ErrInvalidSortField   = errors.New("invalid sort field")
+)
+
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 100
+
+	// searchResource 跨实例检索、配置检索字段所需的权限资源（可见租户内全部审批单）
+	searchResource = "approval_admin"
+	searchAction   = "search"
+)
+
+// ProcessSearchService 流程实例检索服务接口
+type ProcessSearchService interface {
+	// 可检索字段配置
+	ConfigureSearchFields(ctx context.Context, req *dto.ConfigureSearchFieldsRequest) ([]*model.ProcessSearchField, error)
+	GetSearchFields(ctx context.Context, tenantID, processDefID uuid.UUID) ([]*model.ProcessSearchField, error)
+
+	// 索引维护
+	IndexInstance(ctx context.Context, instanceID uuid.UUID) error
+	ReindexProcessDefinition(ctx context.Context, tenantID, operatorID, processDefID uuid.UUID) (int, error)
+
+	// 检索
+	SearchInstances(ctx context.Context, req *dto.SearchInstancesRequest) (*pagination.PageResponse[*dto.ProcessInstanceResponse], error)
+}
+
+type processSearchService struct {
+	searchRepo      repository.ProcessSearchRepository
+	processDefRepo  repository.ProcessDefinitionRepository
+	processInstRepo repository.ProcessInstanceRepository
+	taskRepo        repository.ApprovalTaskRepository
+	authzService    *authorization.Service
+}
+
+// NewProcessSearchService 创建流程实例检索服务
+func NewProcessSearchService(
+	searchRepo repository.ProcessSearchRepository,
+	processDefRepo repository.ProcessDefinitionRepository,
+	processInstRepo repository.ProcessInstanceRepository,
+	taskRepo repository.ApprovalTaskRepository,
+	authzService *authorization.Service,
+) ProcessSearchService {
+	return &processSearchService{
+		searchRepo:      searchRepo,
+		processDefRepo:  processDefRepo,
+		processInstRepo: processInstRepo,
+		taskRepo:        taskRepo,
+		authzService:    authzService,
+	}
+}
+
+// ConfigureSearchFields 配置流程的可检索表单字段（整体替换）
+func (s *processSearchService) ConfigureSearchFields(ctx context.Context, req *dto.ConfigureSearchFieldsRequest) ([]*model.ProcessSearchField, error) {
+	if err := s.authorize(ctx, req.TenantID, req.OperatorID); err != nil {
+		return nil, err
+	}
+	processDef, err := s.findProcessDef(ctx, req.TenantID, req.ProcessDefID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	seen := make(map[string]bool, len(req.Fields))
+	fields := make([]*model.ProcessSearchField, 0, len(req.Fields))
+	for i, f := range req.Fields {
+		key := strings.TrimSpace(f.FieldKey)
+		if key == "" || seen[key] {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidSearchField, f.FieldKey)
+		}
+		seen[key] = true
+
+		fieldType := f.FieldType
+		switch fieldType {
+		case "":
+			fieldType = model.SearchFieldTypeText
+		case model.SearchFieldTypeText, model.SearchFieldTypeKeyword, model.SearchFieldTypeNumber, model.SearchFieldTypeDate:
+		default:
+			return nil, fmt.Errorf("%w: unsupported type %q", ErrInvalidSearchField, f.FieldType)
+		}
+
+		label := f.FieldLabel
+		if label == "" {
+			label = key
+		}
+		sortNo := f.Sort
+		if sortNo == 0 {
+			sortNo = i + 1
+		}
+
+		fields = append(fields, &model.ProcessSearchField{
+			ID:           uuid.New(),
+			TenantID:     processDef.TenantID,
+			ProcessDefID: processDef.ID,
+			FieldKey:     key,
+			FieldLabel:   label,
+			FieldType:    fieldType,
+			Sort:         sortNo,
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		})
+	}
+
+	if err := s.searchRepo.ReplaceFields(ctx, processDef.ID, fields); err != nil {
+		return nil, fmt.Errorf("failed to save search fields: %w", err)
+	}
+
+	return fields, nil
+}
+
+// GetSearchFields 获取流程的可检索字段
+func (s *processSearchService) GetSearchFields(ctx context.Context, tenantID, processDefID uuid.UUID) ([]*model.ProcessSearchField, error) {
+	if _, err := s.findProcessDef(ctx, tenantID, processDefID); err != nil {
+		return nil, err
+	}
+	return s.searchRepo.ListFields(ctx, processDefID)
+}
+
+// IndexInstance 重建单个流程实例的检索索引
+func (s *processSearchService) IndexInstance(ctx context.Context, instanceID uuid.UUID) error {
+	instance, err := s.processInstRepo.FindByID(ctx, instanceID)
+	if err != nil {
+		return ErrProcessInstanceNotFound
+	}
+
+	fields, err := s.searchRepo.ListFields(ctx, instance.ProcessDefID)
+	if err != nil {
+		return fmt.Errorf("failed to load search fields: %w", err)
+	}
+
+	tasks, err := s.taskRepo.ListByInstance(ctx, instance.ID)
+	if err != nil {
+		return fmt.Errorf("failed to load tasks: %w", err)
+	}
+
+	return s.searchRepo.UpsertIndex(ctx, buildSearchIndex(instance, fields, tasks))
+}
+
+// ReindexProcessDefinition 重建某流程定义下所有实例的索引（修改检索字段后调用）
+func (s *processSearchService) ReindexProcessDefinition(ctx context.Context, tenantID, operatorID, processDefID uuid.UUID) (int, error) {
+	const batchSize = 200
+
+	if err := s.authorize(ctx, tenantID, operatorID); err != nil {
+		return 0, err
+	}
+	if _, err := s.findProcessDef(ctx, tenantID, processDefID); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for offset := 0; ; offset += batchSize {
+		instances, err := s.processInstRepo.ListByProcessDef(ctx, processDefID, batchSize, offset)
+		if err != nil {
+			return count, err
+		}
+
+		for _, instance := range instances {
+			if err := s.IndexInstance(ctx, instance.ID); err != nil {
+				return count, fmt.Errorf("failed to index instance %s: %w", instance.ID, err)
+			}
+			count++
+		}
+
+		if len(instances) < batchSize {
+			return count, nil
+		}
+	}
+}
+
+// SearchInstances 检索流程实例（可检索租户内全部审批单，需审批管理权限）
+func (s *processSearchService) SearchInstances(ctx context.Context, req *dto.SearchInstancesRequest) (*pagination.PageResponse[*dto.ProcessInstanceResponse], error) {
+	if err := s.authorize(ctx, req.TenantID, req.OperatorID); err != nil {
+		return nil, err
+	}
+
+	query, err := buildSearchQuery(req)
+	if err != nil {
+		return nil, err
+	}
+
+	results, hasMore, err := s.searchRepo.Search(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("search failed: %w", err)
+	}
+
+	items := make([]*dto.ProcessInstanceResponse, 0, len(results))
+	for _, r := range results {
+		items = append(items, &dto.ProcessInstanceResponse{
+			ID:            r.ProcessInstanceID,
+			TenantID:      r.TenantID,
+			ProcessDefID:  r.ProcessDefID,
+			ApplicantID:   r.ApplicantID,
+			ApplicantName: r.ApplicantName,
+			Title:         r.Title,
+			Status:        r.Status,
+			Variables:     r.FieldValues,
+			StartedAt:     r.StartedAt,
+			CompletedAt:   r.CompletedAt,
+			UpdatedAt:     r.UpdatedAt,
+		})
+	}
+
+	resp := &pagination.PageResponse[*dto.ProcessInstanceResponse]{
+		Items:    items,
+		Total:    -1,
+		PageSize: query.Limit,
+		HasNext:  hasMore,
+		HasPrev:  req.Cursor != "",
+	}
+
+	if hasMore && len(results) > 0 {
+		last := results[len(results)-1]
+		value := last.StartedAt
+		if query.SortBy == model.SearchSortByUpdatedAt {
+			value = last.UpdatedAt
+		}
+		resp.NextCursor, err = pagination.EncodeCursor(&pagination.CursorInfo{
+			LastID:    last.ProcessInstanceID,
+			LastValue: value.Format(time.RFC3339Nano),
+			Direction: "next",
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+// authorize 校验操作人是否有审批检索权限（未配置授权服务时拒绝）
+func (s *processSearchService) authorize(ctx context.Context, tenantID, operatorID uuid.UUID) error {
+	if s.authzService == nil {
+		return ErrPermissionDenied
+	}
+	allowed, err := s.authzService.CheckPermission(ctx, operatorID, tenantID, searchResource, searchAction, nil)
+	if err != nil || !allowed {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// findProcessDef 查找流程定义，其他租户的流程视为不存在
+func (s *processSearchService) findProcessDef(ctx context.Context, tenantID, processDefID uuid.UUID) (*model.ProcessDefinition, error) {
+	processDef, err := s.processDefRepo.FindByID(ctx, processDefID)
+	if err != nil || processDef.TenantID != tenantID {
+		return nil, ErrProcessNotFound
+	}
+	return processDef, nil
+}
+
+// buildSearchQuery 将请求转换为仓储检索条件并解析游标
+func buildSearchQuery(req *dto.SearchInstancesRequest) (*model.InstanceSearchQuery, error) {
+	sortBy := req.SortBy
+	switch sortBy {
+	case "":
+		sortBy = model.SearchSortByStartedAt
+	case model.SearchSortByStartedAt, model.SearchSortByUpdatedAt:
+	default:
+		return nil, ErrInvalidSortField
+	}
+
+	limit := req.PageSize
+	if limit <= 0 {
+		limit = defaultSearchPageSize
+	}
+	if limit > maxSearchPageSize {
+		limit = maxSearchPageSize
+	}
+
+	for _, f := range req.FieldFilters {
+		if strings.TrimSpace(f.Key) == "" {
+			return nil, ErrInvalidSearchField
+		}
+	}
+
+	query := &model.InstanceSearchQuery{
+		TenantID:     req.TenantID,
+		ProcessDefID: req.ProcessDefID,
+		Status:       req.Status,
+		ApplicantID:  req.ApplicantID,
+		Keyword:      strings.TrimSpace(req.Keyword),
+		FieldFilters: req.FieldFilters,
+		StartDate:    req.StartDate,
+		EndDate:      req.EndDate,
+		SortBy:       sortBy,
+		SortDesc:     req.SortDesc,
+		Limit:        limit,
+	}
+
+	cursor, err := pagination.DecodeCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	if cursor != nil {
+		raw, ok := cursor.LastValue.(string)
+		if !ok {
+			return nil, pagination.ErrInvalidCursor
+		}
+		value, err := time.Parse(time.RFC3339Nano, raw)
+		if err != nil {
+			return nil, pagination.ErrInvalidCursor
+		}
+		query.AfterValue = &value
+		query.AfterID = &cursor.LastID
+	}
+
+	return query, nil
+}
+
+// buildSearchIndex 由流程实例、可检索字段和审批意见组装索引记录
+func buildSearchIndex(instance *model.ProcessInstance, fields []*model.ProcessSearchField, tasks []*model.ApprovalTask) *model.InstanceSearchIndex {
+	values := make(map[string]interface{}, len(fields))
+	parts := []string{instance.Title, instance.ApplicantName}
+
+	for _, f := range fields {
+		v, ok := instance.Variables[f.FieldKey]
+		if !ok || v == nil {
+			continue
+		}
+		values[f.FieldKey] = normalizeSearchValue(f.FieldType, v)
+		if f.FieldType == model.SearchFieldTypeText || f.FieldType == model.SearchFieldTypeKeyword {
+			parts = append(parts, fmt.Sprint(v))
+		}
+	}
+
+	// 审批意见按时间顺序拼接
+	sort.SliceStable(tasks, func(i, j int) bool { return tasks[i].CreatedAt.Before(tasks[j].CreatedAt) })
+	comments := make([]string, 0, len(tasks))
+	for _, t := range tasks {
+		if t.Comment != nil && strings.TrimSpace(*t.Comment) != "" {
+			comments = append(comments, strings.TrimSpace(*t.Comment))
+		}
+	}
+	commentText := strings.Join(comments, "\n")
+	parts = append(parts, commentText)
+
+	updatedAt := instance.UpdatedAt
+	if updatedAt.IsZero() {
+		updatedAt = time.Now()
+	}
+
+	return &model.InstanceSearchIndex{
+		ProcessInstanceID: instance.ID,
+		TenantID:          instance.TenantID,
+		ProcessDefID:      instance.ProcessDefID,
+		ApplicantID:       instance.ApplicantID,
+		ApplicantName:     instance.ApplicantName,
+		Title:             instance.Title,
+		Status:            instance.Status,
+		FieldValues:       values,
+		Comments:          commentText,
+		Content:           strings.Join(nonEmpty(parts), " "),
+		StartedAt:         instance.StartedAt,
+		CompletedAt:       instance.CompletedAt,
+		UpdatedAt:         updatedAt,
+	}
+}
+
+// normalizeSearchValue 统一字段取值格式，日期截取为 YYYY-MM-DD 以便字符串比较
+func normalizeSearchValue(fieldType model.SearchFieldType, v interface{}) interface{} {
+	switch fieldType {
+	case model.SearchFieldTypeNumber:
+		if f, ok := v.(float64); ok {
+			return strconv.FormatFloat(f, 'f', -1, 64)
+		}
+		return fmt.Sprint(v)
+	case model.SearchFieldTypeDate:
+		if t, ok := v.(time.Time); ok {
+			return t.Format("2006-01-02")
+		}
+		s := fmt.Sprint(v)
+		if t, err := time.Parse(time.RFC3339, s); err == nil {
+			return t.Format("2006-01-02")
+		}
+		return s
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func nonEmpty(parts []string) []string {
+	out := parts[:0]
+	for _, p := range parts {
+		if strings.TrimSpace(p) != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
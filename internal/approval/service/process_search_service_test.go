@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/approval/dto"
+	"github.com/lk2023060901/go-next-erp/internal/approval/model"
+	"github.com/lk2023060901/go-next-erp/internal/approval/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubSearchProcessDefRepo struct {
+	repository.ProcessDefinitionRepository
+	defs map[uuid.UUID]*model.ProcessDefinition
+}
+
+func (r *stubSearchProcessDefRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.ProcessDefinition, error) {
+	if def, ok := r.defs[id]; ok {
+		return def, nil
+	}
+	return nil, errors.New("not found")
+}
+
+type stubSearchRepo struct {
+	repository.ProcessSearchRepository
+	listed bool
+}
+
+func (r *stubSearchRepo) ListFields(ctx context.Context, processDefID uuid.UUID) ([]*model.ProcessSearchField, error) {
+	r.listed = true
+	return nil, nil
+}
+
+func TestProcessSearchService_Access(t *testing.T) {
+	tenantID, otherTenant, userID := uuid.New(), uuid.New(), uuid.New()
+	def := &model.ProcessDefinition{ID: uuid.New(), TenantID: tenantID}
+	searchRepo := &stubSearchRepo{}
+	svc := NewProcessSearchService(searchRepo, &stubSearchProcessDefRepo{defs: map[uuid.UUID]*model.ProcessDefinition{def.ID: def}}, nil, nil, nil)
+
+	t.Run("other tenant's fields are not found", func(t *testing.T) {
+		_, err := svc.GetSearchFields(context.Background(), otherTenant, def.ID)
+		assert.ErrorIs(t, err, ErrProcessNotFound)
+		assert.False(t, searchRepo.listed)
+
+		_, err = svc.GetSearchFields(context.Background(), tenantID, def.ID)
+		require.NoError(t, err)
+		assert.True(t, searchRepo.listed)
+	})
+
+	t.Run("search and configuration require permission", func(t *testing.T) {
+		_, err := svc.SearchInstances(context.Background(), &dto.SearchInstancesRequest{TenantID: tenantID, OperatorID: userID})
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = svc.ConfigureSearchFields(context.Background(), &dto.ConfigureSearchFieldsRequest{TenantID: otherTenant, OperatorID: userID, ProcessDefID: def.ID})
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = svc.ReindexProcessDefinition(context.Background(), tenantID, userID, def.ID)
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+	})
+}
+
+func TestBuildSearchIndex(t *testing.T) {
+	comment1 := "同意，预算内"
+	comment2 := "  "
+	now := time.Now()
+
+	instance := &model.ProcessInstance{
+		ID:            uuid.New(),
+		TenantID:      uuid.New(),
+		ProcessDefID:  uuid.New(),
+		ApplicantID:   uuid.New(),
+		ApplicantName: "张三",
+		Title:         "采购申请",
+		Status:        model.ProcessStatusPending,
+		Variables: map[string]interface{}{
+			"supplier": "华为",
+			"amount":   float64(1200000),
+			"due_date": "2024-03-15T00:00:00Z",
+			"secret":   "not-indexed",
+		},
+		StartedAt: now,
+		UpdatedAt: now,
+	}
+	fields := []*model.ProcessSearchField{
+		{FieldKey: "supplier", FieldType: model.SearchFieldTypeText},
+		{FieldKey: "amount", FieldType: model.SearchFieldTypeNumber},
+		{FieldKey: "due_date", FieldType: model.SearchFieldTypeDate},
+		{FieldKey: "missing", FieldType: model.SearchFieldTypeKeyword},
+	}
+	tasks := []*model.ApprovalTask{
+		{Comment: &comment2, CreatedAt: now.Add(time.Minute)},
+		{Comment: &comment1, CreatedAt: now},
+	}
+
+	idx := buildSearchIndex(instance, fields, tasks)
+
+	assert.Equal(t, instance.ID, idx.ProcessInstanceID)
+	assert.Equal(t, "华为", idx.FieldValues["supplier"])
+	assert.Equal(t, "1200000", idx.FieldValues["amount"])
+	assert.Equal(t, "2024-03-15", idx.FieldValues["due_date"])
+	assert.NotContains(t, idx.FieldValues, "secret")
+	assert.NotContains(t, idx.FieldValues, "missing")
+	assert.Equal(t, comment1, idx.Comments)
+	assert.Contains(t, idx.Content, "采购申请")
+	assert.Contains(t, idx.Content, "张三")
+	assert.Contains(t, idx.Content, "华为")
+	assert.Contains(t, idx.Content, comment1)
+	assert.NotContains(t, idx.Content, "not-indexed")
+}
+
+func TestBuildSearchQuery(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		q, err := buildSearchQuery(&dto.SearchInstancesRequest{Keyword: "  报销 "})
+		require.NoError(t, err)
+		assert.Equal(t, model.SearchSortByStartedAt, q.SortBy)
+		assert.Equal(t, defaultSearchPageSize, q.Limit)
+		assert.Equal(t, "报销", q.Keyword)
+		assert.Nil(t, q.AfterID)
+	})
+
+	t.Run("page size capped", func(t *testing.T) {
+		q, err := buildSearchQuery(&dto.SearchInstancesRequest{PageSize: 5000})
+		require.NoError(t, err)
+		assert.Equal(t, maxSearchPageSize, q.Limit)
+	})
+
+	t.Run("invalid sort field", func(t *testing.T) {
+		_, err := buildSearchQuery(&dto.SearchInstancesRequest{SortBy: "title; DROP TABLE x"})
+		assert.ErrorIs(t, err, ErrInvalidSortField)
+	})
+
+	t.Run("empty filter key", func(t *testing.T) {
+		_, err := buildSearchQuery(&dto.SearchInstancesRequest{
+			FieldFilters: []model.FieldFilter{{Key: " ", Op: model.FieldFilterEq, Value: "x"}},
+		})
+		assert.ErrorIs(t, err, ErrInvalidSearchField)
+	})
+
+	t.Run("cursor round trip", func(t *testing.T) {
+		lastID := uuid.New()
+		lastValue := time.Date(2024, 5, 1, 8, 30, 0, 123456789, time.UTC)
+		cursor, err := pagination.EncodeCursor(&pagination.CursorInfo{
+			LastID:    lastID,
+			LastValue: lastValue.Format(time.RFC3339Nano),
+		})
+		require.NoError(t, err)
+
+		q, err := buildSearchQuery(&dto.SearchInstancesRequest{Cursor: cursor, SortBy: model.SearchSortByUpdatedAt})
+		require.NoError(t, err)
+		require.NotNil(t, q.AfterID)
+		assert.Equal(t, lastID, *q.AfterID)
+		assert.True(t, lastValue.Equal(*q.AfterValue))
+	})
+
+	t.Run("malformed cursor", func(t *testing.T) {
+		_, err := buildSearchQuery(&dto.SearchInstancesRequest{Cursor: "%%%"})
+		assert.ErrorIs(t, err, pagination.ErrInvalidCursor)
+	})
+}
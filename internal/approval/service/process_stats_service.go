@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/approval/dto"
+	"github.com/lk2023060901/go-next-erp/internal/approval/model"
+	"github.com/lk2023060901/go-next-erp/internal/approval/repository"
+	"github.com/lk2023060901/go-next-erp/internal/auth/authorization"
+)
+
+const (
+	defaultStatsWeeks    = 8
+	maxStatsWeeks        = 52
+	defaultStatsLimit    = 10
+	maxStatsLimit        = 100
+	defaultStatsMinTasks = 3
+
+	// statsResource 查看审批统计看板、重算统计所需的权限资源（含审批人处理速度排行）
+	statsResource = "approval_admin"
+	statsAction   = "stats"
+)
+
+// StatsConfig 审批统计刷新配置
+type StatsConfig struct {
+	Cron              string         // 刷新周期（Cron 表达式）
+	RefreshWeeks      int            // 每次重算最近几周（更早的周视为已定稿）
+	Location          *time.Location // 周划分时区
+	SnapshotRetention time.Duration  // 待办队列快照保留时长
+}
+
+// ProcessStatsService 审批统计服务接口（定时刷新的统计数据，供管理看板查询）
+type ProcessStatsService interface {
+	// 刷新（Refresh 供定时任务重算所有租户，Rebuild 仅重算操作人所在租户）
+	Refresh(ctx context.Context) (*dto.StatsRefreshResult, error)
+	Rebuild(ctx context.Context, req *dto.StatsRebuildRequest) (*dto.StatsRefreshResult, error)
+
+	// 看板查询
+	GetNodeStats(ctx context.Context, req *dto.StatsRequest) ([]*model.NodeWeeklyStats, error)
+	GetQueueTrend(ctx context.Context, req *dto.StatsRequest) ([]*model.QueueSnapshot, error)
+	GetSlowApprovers(ctx context.Context, req *dto.StatsRequest) ([]*model.ApproverWeeklyStats, error)
+	GetRejectionReasons(ctx context.Context, req *dto.StatsRequest) ([]*model.RejectionReasonStats, error)
+	GetProcessTrends(ctx context.Context, req *dto.StatsRequest) ([]*dto.ProcessTrend, error)
+
+	// CronSpec 定时刷新的 Cron 表达式
+	CronSpec() string
+}
+
+type processStatsService struct {
+	statsRepo    repository.ProcessStatsRepository
+	authzService *authorization.Service
+	config       *StatsConfig
+	now          func() time.Time
+}
+
+// NewProcessStatsService 创建审批统计服务
+func NewProcessStatsService(statsRepo repository.ProcessStatsRepository, authzService *authorization.Service, config *StatsConfig) ProcessStatsService {
+	return &processStatsService{
+		statsRepo:    statsRepo,
+		authzService: authzService,
+		config:       config,
+		now:          time.Now,
+	}
+}
+
+func (s *processStatsService) CronSpec() string {
+	return s.config.Cron
+}
+
+// Refresh 增量刷新（定时任务）：重算所有租户最近几周的周度统计，并记录一次待办队列快照
+func (s *processStatsService) Refresh(ctx context.Context) (*dto.StatsRefreshResult, error) {
+	now := s.now().In(s.config.Location)
+	since, err := s.refreshWeekly(ctx, nil, s.config.RefreshWeeks, now)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots, err := s.statsRepo.SnapshotQueues(ctx, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to snapshot queues: %w", err)
+	}
+
+	if s.config.SnapshotRetention > 0 {
+		if _, err := s.statsRepo.PruneQueueSnapshots(ctx, now.Add(-s.config.SnapshotRetention)); err != nil {
+			return nil, fmt.Errorf("failed to prune queue snapshots: %w", err)
+		}
+	}
+
+	return &dto.StatsRefreshResult{
+		Since:          since,
+		QueueSnapshots: snapshots,
+		RefreshedAt:    now,
+	}, nil
+}
+
+// Rebuild 重算操作人所在租户最近 weeks 周的统计（用于历史数据补算，不记录队列快照）
+func (s *processStatsService) Rebuild(ctx context.Context, req *dto.StatsRebuildRequest) (*dto.StatsRefreshResult, error) {
+	if err := s.authorize(ctx, req.TenantID, req.OperatorID); err != nil {
+		return nil, err
+	}
+
+	now := s.now().In(s.config.Location)
+	since, err := s.refreshWeekly(ctx, &req.TenantID, req.Weeks, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.StatsRefreshResult{
+		Since:       since,
+		RefreshedAt: now,
+	}, nil
+}
+
+// refreshWeekly 重算最近 weeks 周（含本周）的周度统计，返回起始周
+func (s *processStatsService) refreshWeekly(ctx context.Context, tenantID *uuid.UUID, weeks int, now time.Time) (time.Time, error) {
+	if weeks <= 0 {
+		weeks = 1
+	}
+	if weeks > maxStatsWeeks {
+		weeks = maxStatsWeeks
+	}
+
+	since := model.WeekStart(now).AddDate(0, 0, -7*(weeks-1))
+	if err := s.statsRepo.RefreshWeekly(ctx, tenantID, since, s.config.Location, now); err != nil {
+		return time.Time{}, fmt.Errorf("failed to refresh weekly stats: %w", err)
+	}
+	return since, nil
+}
+
+// GetNodeStats 节点周度处理时长（平均/中位数/P90）
+func (s *processStatsService) GetNodeStats(ctx context.Context, req *dto.StatsRequest) ([]*model.NodeWeeklyStats, error) {
+	if err := s.authorize(ctx, req.TenantID, req.OperatorID); err != nil {
+		return nil, err
+	}
+	return s.statsRepo.ListNodeStats(ctx, s.buildQuery(req))
+}
+
+// GetQueueTrend 节点待办队列长度变化
+func (s *processStatsService) GetQueueTrend(ctx context.Context, req *dto.StatsRequest) ([]*model.QueueSnapshot, error) {
+	if err := s.authorize(ctx, req.TenantID, req.OperatorID); err != nil {
+		return nil, err
+	}
+	query := s.buildQuery(req)
+	query.To = s.now()
+	return s.statsRepo.ListQueueSnapshots(ctx, query)
+}
+
+// GetSlowApprovers 处理最慢的审批人排行
+func (s *processStatsService) GetSlowApprovers(ctx context.Context, req *dto.StatsRequest) ([]*model.ApproverWeeklyStats, error) {
+	if err := s.authorize(ctx, req.TenantID, req.OperatorID); err != nil {
+		return nil, err
+	}
+	return s.statsRepo.ListSlowApprovers(ctx, s.buildQuery(req))
+}
+
+// GetRejectionReasons 各节点拒绝原因排行
+func (s *processStatsService) GetRejectionReasons(ctx context.Context, req *dto.StatsRequest) ([]*model.RejectionReasonStats, error) {
+	if err := s.authorize(ctx, req.TenantID, req.OperatorID); err != nil {
+		return nil, err
+	}
+	return s.statsRepo.ListRejectionReasons(ctx, s.buildQuery(req))
+}
+
+// GetProcessTrends 流程定义周度趋势与环比
+// 环比比较本周至今与上周同一时长的区间，避免不完整的本周与完整的上周相比
+func (s *processStatsService) GetProcessTrends(ctx context.Context, req *dto.StatsRequest) ([]*dto.ProcessTrend, error) {
+	if err := s.authorize(ctx, req.TenantID, req.OperatorID); err != nil {
+		return nil, err
+	}
+	query := s.buildQuery(req)
+	rows, err := s.statsRepo.ListProcessWeekly(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now().In(s.config.Location)
+	currentWeek := model.WeekStart(now)
+	elapsed := now.Sub(currentWeek)
+
+	current, err := s.statsRepo.SumProcessSpan(ctx, &model.StatsQuery{
+		TenantID:     query.TenantID,
+		ProcessDefID: query.ProcessDefID,
+		From:         currentWeek,
+		To:           now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	previousWeek := currentWeek.AddDate(0, 0, -7)
+	previous, err := s.statsRepo.SumProcessSpan(ctx, &model.StatsQuery{
+		TenantID:     query.TenantID,
+		ProcessDefID: query.ProcessDefID,
+		From:         previousWeek,
+		To:           previousWeek.Add(elapsed),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return buildProcessTrends(rows, current, previous), nil
+}
+
+// authorize 校验操作人是否有审批统计权限（未配置授权服务时拒绝）
+func (s *processStatsService) authorize(ctx context.Context, tenantID, operatorID uuid.UUID) error {
+	if s.authzService == nil {
+		return ErrPermissionDenied
+	}
+	allowed, err := s.authzService.CheckPermission(ctx, operatorID, tenantID, statsResource, statsAction, nil)
+	if err != nil || !allowed {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// buildQuery 将最近 N 周的请求转换为查询区间
+func (s *processStatsService) buildQuery(req *dto.StatsRequest) *model.StatsQuery {
+	weeks := req.Weeks
+	if weeks <= 0 {
+		weeks = defaultStatsWeeks
+	}
+	if weeks > maxStatsWeeks {
+		weeks = maxStatsWeeks
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultStatsLimit
+	}
+	if limit > maxStatsLimit {
+		limit = maxStatsLimit
+	}
+	minTasks := req.MinTasks
+	if minTasks <= 0 {
+		minTasks = defaultStatsMinTasks
+	}
+
+	currentWeek := model.WeekStart(s.now().In(s.config.Location))
+	return &model.StatsQuery{
+		TenantID:     req.TenantID,
+		ProcessDefID: req.ProcessDefID,
+		NodeID:       req.NodeID,
+		From:         currentWeek.AddDate(0, 0, -7*(weeks-1)),
+		To:           currentWeek,
+		MinTasks:     minTasks,
+		Limit:        limit,
+	}
+}
+
+// buildProcessTrends 按流程定义分组（rows 已按流程、周排序），并以本周至今与上周同期的汇总计算环比
+func buildProcessTrends(rows, current, previous []*model.ProcessWeeklyStats) []*dto.ProcessTrend {
+	var trends []*dto.ProcessTrend
+	index := make(map[uuid.UUID]*dto.ProcessTrend)
+	trendOf := func(row *model.ProcessWeeklyStats) *dto.ProcessTrend {
+		trend, ok := index[row.ProcessDefID]
+		if !ok {
+			trend = &dto.ProcessTrend{ProcessDefID: row.ProcessDefID, ProcessDefName: row.ProcessDefName}
+			index[row.ProcessDefID] = trend
+			trends = append(trends, trend)
+		}
+		return trend
+	}
+
+	for _, row := range rows {
+		trend := trendOf(row)
+		trend.ProcessDefName = row.ProcessDefName
+		trend.Weeks = append(trend.Weeks, row)
+	}
+	for _, row := range current {
+		trendOf(row).Current = row
+	}
+	for _, row := range previous {
+		trendOf(row).Previous = row
+	}
+
+	for _, trend := range trends {
+		cur := trend.Current
+		if cur == nil {
+			cur = &model.ProcessWeeklyStats{}
+		}
+		if trend.Previous == nil {
+			continue
+		}
+		trend.StartedChange = changeRate(float64(cur.StartedCount), float64(trend.Previous.StartedCount))
+		trend.CompletedChange = changeRate(float64(cur.CompletedCount), float64(trend.Previous.CompletedCount))
+		trend.CycleTimeChange = changeRate(cur.AvgCycleSeconds, trend.Previous.AvgCycleSeconds)
+	}
+
+	return trends
+}
+
+// changeRate 环比变化率，基数为 0 时无意义返回 nil
+func changeRate(current, previous float64) *float64 {
+	if previous == 0 {
+		return nil
+	}
+	rate := (current - previous) / previous
+	return &rate
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/approval/dto"
+	"github.com/lk2023060901/go-next-erp/internal/approval/model"
+	"github.com/lk2023060901/go-next-erp/internal/approval/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubStatsRepo struct {
+	repository.ProcessStatsRepository
+	since       time.Time
+	tenantID    *uuid.UUID
+	pruneBefore time.Time
+	query       *model.StatsQuery
+	spans       []*model.StatsQuery
+}
+
+func (r *stubStatsRepo) RefreshWeekly(ctx context.Context, tenantID *uuid.UUID, since time.Time, loc *time.Location, now time.Time) error {
+	r.since, r.tenantID = since, tenantID
+	return nil
+}
+
+func (r *stubStatsRepo) SnapshotQueues(ctx context.Context, at time.Time) (int64, error) {
+	return 4, nil
+}
+
+func (r *stubStatsRepo) PruneQueueSnapshots(ctx context.Context, before time.Time) (int64, error) {
+	r.pruneBefore = before
+	return 0, nil
+}
+
+func (r *stubStatsRepo) ListSlowApprovers(ctx context.Context, query *model.StatsQuery) ([]*model.ApproverWeeklyStats, error) {
+	r.query = query
+	return nil, nil
+}
+
+func (r *stubStatsRepo) ListProcessWeekly(ctx context.Context, query *model.StatsQuery) ([]*model.ProcessWeeklyStats, error) {
+	return nil, nil
+}
+
+func (r *stubStatsRepo) SumProcessSpan(ctx context.Context, query *model.StatsQuery) ([]*model.ProcessWeeklyStats, error) {
+	r.spans = append(r.spans, query)
+	return nil, nil
+}
+
+// testStatsAdmin 拥有审批统计权限的操作人
+var testStatsAdmin = uuid.New()
+
+func newTestStatsService(repo repository.ProcessStatsRepository, now time.Time) *processStatsService {
+	return &processStatsService{
+		statsRepo:    repo,
+		authzService: newTestAuthz([]uuid.UUID{testStatsAdmin}, statsResource, statsAction),
+		config: &StatsConfig{
+			Cron:              "*/30 * * * *",
+			RefreshWeeks:      2,
+			Location:          now.Location(),
+			SnapshotRetention: 90 * 24 * time.Hour,
+		},
+		now: func() time.Time { return now },
+	}
+}
+
+func TestWeekStart(t *testing.T) {
+	loc := time.FixedZone("CST", 8*3600)
+
+	// 2024-03-06 是周三
+	assert.Equal(t, time.Date(2024, 3, 4, 0, 0, 0, 0, loc), model.WeekStart(time.Date(2024, 3, 6, 15, 30, 0, 0, loc)))
+	// 周日归属上周一开始的周
+	assert.Equal(t, time.Date(2024, 3, 4, 0, 0, 0, 0, loc), model.WeekStart(time.Date(2024, 3, 10, 23, 59, 0, 0, loc)))
+	// 周一零点即本周开始
+	assert.Equal(t, time.Date(2024, 3, 11, 0, 0, 0, 0, loc), model.WeekStart(time.Date(2024, 3, 11, 0, 0, 0, 0, loc)))
+}
+
+func TestProcessStatsService_Refresh(t *testing.T) {
+	loc := time.FixedZone("CST", 8*3600)
+	now := time.Date(2024, 3, 6, 10, 0, 0, 0, loc)
+	repo := &stubStatsRepo{}
+	svc := newTestStatsService(repo, now)
+
+	result, err := svc.Refresh(context.Background())
+	require.NoError(t, err)
+
+	// 最近两周：本周一与上周一；定时任务重算所有租户
+	assert.Equal(t, time.Date(2024, 2, 26, 0, 0, 0, 0, loc), repo.since)
+	assert.Nil(t, repo.tenantID)
+	assert.Equal(t, int64(4), result.QueueSnapshots)
+	assert.Equal(t, now.Add(-90*24*time.Hour), repo.pruneBefore)
+
+	t.Run("rebuild caps weeks and stays in tenant", func(t *testing.T) {
+		tenantID := uuid.New()
+		_, err := svc.Rebuild(context.Background(), &dto.StatsRebuildRequest{TenantID: tenantID, OperatorID: testStatsAdmin, Weeks: 1000})
+		require.NoError(t, err)
+		assert.Equal(t, model.WeekStart(now).AddDate(0, 0, -7*(maxStatsWeeks-1)), repo.since)
+		assert.Equal(t, &tenantID, repo.tenantID)
+	})
+
+	t.Run("rebuild requires permission", func(t *testing.T) {
+		repo.since = time.Time{}
+		_, err := svc.Rebuild(context.Background(), &dto.StatsRebuildRequest{TenantID: uuid.New(), OperatorID: uuid.New(), Weeks: 4})
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+		assert.True(t, repo.since.IsZero())
+	})
+}
+
+func TestProcessStatsService_Authorize(t *testing.T) {
+	now := time.Now()
+	svc := newTestStatsService(&stubStatsRepo{}, now)
+
+	// 审批人处理速度排行等看板数据只对有统计权限的用户开放
+	_, err := svc.GetSlowApprovers(context.Background(), &dto.StatsRequest{TenantID: uuid.New(), OperatorID: uuid.New()})
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+
+	svc.authzService = nil
+	_, err = svc.GetNodeStats(context.Background(), &dto.StatsRequest{TenantID: uuid.New(), OperatorID: testStatsAdmin})
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+}
+
+func TestProcessStatsService_BuildQuery(t *testing.T) {
+	loc := time.FixedZone("CST", 8*3600)
+	now := time.Date(2024, 3, 6, 10, 0, 0, 0, loc)
+	repo := &stubStatsRepo{}
+	svc := newTestStatsService(repo, now)
+
+	_, err := svc.GetSlowApprovers(context.Background(), &dto.StatsRequest{OperatorID: testStatsAdmin, Weeks: 4, Limit: 500})
+	require.NoError(t, err)
+
+	assert.Equal(t, time.Date(2024, 2, 12, 0, 0, 0, 0, loc), repo.query.From)
+	assert.Equal(t, time.Date(2024, 3, 4, 0, 0, 0, 0, loc), repo.query.To)
+	assert.Equal(t, maxStatsLimit, repo.query.Limit)
+	assert.Equal(t, defaultStatsMinTasks, repo.query.MinTasks)
+}
+
+func TestProcessStatsService_TrendSpans(t *testing.T) {
+	loc := time.FixedZone("CST", 8*3600)
+	now := time.Date(2024, 3, 6, 10, 0, 0, 0, loc)
+	repo := &stubStatsRepo{}
+	svc := newTestStatsService(repo, now)
+
+	_, err := svc.GetProcessTrends(context.Background(), &dto.StatsRequest{OperatorID: testStatsAdmin})
+	require.NoError(t, err)
+
+	// 本周至今与上周同一时长的区间比较
+	require.Len(t, repo.spans, 2)
+	assert.Equal(t, time.Date(2024, 3, 4, 0, 0, 0, 0, loc), repo.spans[0].From)
+	assert.Equal(t, now, repo.spans[0].To)
+	assert.Equal(t, time.Date(2024, 2, 26, 0, 0, 0, 0, loc), repo.spans[1].From)
+	assert.Equal(t, time.Date(2024, 2, 28, 10, 0, 0, 0, loc), repo.spans[1].To)
+}
+
+func TestBuildProcessTrends(t *testing.T) {
+	currentWeek := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	previousWeek := currentWeek.AddDate(0, 0, -7)
+	defA, defB, defC := uuid.New(), uuid.New(), uuid.New()
+
+	rows := []*model.ProcessWeeklyStats{
+		{ProcessDefID: defA, ProcessDefName: "请假", WeekStart: previousWeek.AddDate(0, 0, -7), StartedCount: 3},
+		{ProcessDefID: defA, ProcessDefName: "请假", WeekStart: previousWeek, StartedCount: 30, CompletedCount: 24, AvgCycleSeconds: 3600},
+		{ProcessDefID: defA, ProcessDefName: "请假", WeekStart: currentWeek, StartedCount: 15, CompletedCount: 4, AvgCycleSeconds: 5400},
+		{ProcessDefID: defB, ProcessDefName: "报销", WeekStart: currentWeek, StartedCount: 2},
+	}
+	// 上周同期只取与本周已过去时长相同的区间，而非上周全周
+	current := []*model.ProcessWeeklyStats{
+		{ProcessDefID: defA, ProcessDefName: "请假", WeekStart: currentWeek, StartedCount: 15, CompletedCount: 4, AvgCycleSeconds: 5400},
+		{ProcessDefID: defB, ProcessDefName: "报销", WeekStart: currentWeek, StartedCount: 2},
+		{ProcessDefID: defC, ProcessDefName: "出差", WeekStart: currentWeek, StartedCount: 1},
+	}
+	previous := []*model.ProcessWeeklyStats{
+		{ProcessDefID: defA, ProcessDefName: "请假", WeekStart: previousWeek, StartedCount: 10, CompletedCount: 8, AvgCycleSeconds: 3600},
+	}
+
+	trends := buildProcessTrends(rows, current, previous)
+	require.Len(t, trends, 3)
+
+	a := trends[0]
+	assert.Equal(t, defA, a.ProcessDefID)
+	assert.Len(t, a.Weeks, 3)
+	require.NotNil(t, a.StartedChange)
+	assert.InDelta(t, 0.5, *a.StartedChange, 1e-9)
+	assert.InDelta(t, -0.5, *a.CompletedChange, 1e-9)
+	assert.InDelta(t, 0.5, *a.CycleTimeChange, 1e-9)
+
+	b := trends[1]
+	assert.NotNil(t, b.Current)
+	assert.Nil(t, b.Previous)
+	assert.Nil(t, b.StartedChange)
+
+	// 尚未刷新进周度表的流程仍有本周至今的数据
+	c := trends[2]
+	assert.Equal(t, "出差", c.ProcessDefName)
+	assert.Empty(t, c.Weeks)
+	assert.NotNil(t, c.Current)
+}
@@ -1,24 +1,43 @@
 package approval
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
 	"github.com/google/wire"
+	"github.com/lk2023060901/go-next-erp/internal/approval/model"
 	"github.com/lk2023060901/go-next-erp/internal/approval/repository"
 	"github.com/lk2023060901/go-next-erp/internal/approval/service"
+	"github.com/lk2023060901/go-next-erp/internal/conf"
 	"github.com/lk2023060901/go-next-erp/pkg/workflow"
 )
 
 // ProviderSet approval 模块的 Wire Provider Set
 var ProviderSet = wire.NewSet(
 	// Repositories
+	ProvideHistoryChainKey,
 	repository.NewProcessDefinitionRepository,
 	repository.NewProcessInstanceRepository,
 	repository.NewApprovalTaskRepository,
 	repository.NewProcessHistoryRepository,
+	repository.NewProcessSearchRepository,
+	repository.NewActionTokenRepository,
+	repository.NewProcessStatsRepository,
+	repository.NewAdminJobRepository,
 
 	// Services
 	ProvideWorkflowEngine,
 	service.NewAssigneeResolver,
 	service.NewApprovalService,
+	service.NewProcessSearchService,
+	service.NewProcessAuditService,
+	ProvideActionLinkConfig,
+	service.NewActionLinkSigner,
+	service.NewActionLinkService,
+	ProvideStatsConfig,
+	service.NewProcessStatsService,
+	service.NewProcessAdminJobService,
 )
 
 // ProvideWorkflowEngine 提供工作流引擎
@@ -29,3 +48,64 @@ func ProvideWorkflowEngine() *workflow.Engine {
 	}
 	return engine
 }
+
+// ProvideHistoryChainKey 提供审批历史哈希链密钥，未配置时拒绝启动
+func ProvideHistoryChainKey(cfg *conf.Config) (model.HistoryChainKey, error) {
+	secret := cfg.Approval.HistoryChain.Secret
+	if secret == "" {
+		return nil, errors.New("approval.history_chain.secret is required")
+	}
+	return model.HistoryChainKey(secret), nil
+}
+
+// ProvideActionLinkConfig 提供审批快捷链接配置
+func ProvideActionLinkConfig(cfg *conf.Config) *service.ActionLinkConfig {
+	linkCfg := cfg.Approval.ActionLink
+
+	ttl := time.Duration(linkCfg.Expire) * time.Second
+	if ttl <= 0 {
+		ttl = 72 * time.Hour
+	}
+
+	return &service.ActionLinkConfig{
+		Secret:        []byte(linkCfg.Secret),
+		BaseURL:       linkCfg.BaseURL,
+		TTL:           ttl,
+		ReplyDomain:   linkCfg.ReplyDomain,
+		InboundSecret: []byte(linkCfg.InboundSecret),
+	}
+}
+
+// ProvideStatsConfig 提供审批统计刷新配置
+// 时区会传给 Postgres 的 AT TIME ZONE，必须是 IANA 时区名；未配置时使用 UTC
+func ProvideStatsConfig(cfg *conf.Config) (*service.StatsConfig, error) {
+	statsCfg := cfg.Approval.Stats
+
+	cron := statsCfg.Cron
+	if cron == "" {
+		cron = "*/30 * * * *"
+	}
+	weeks := statsCfg.RefreshWeeks
+	if weeks <= 0 {
+		weeks = 2
+	}
+	timezone := statsCfg.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil || loc.String() == "Local" {
+		return nil, fmt.Errorf("invalid approval.stats.timezone %q: must be an IANA time zone name", timezone)
+	}
+	retention := statsCfg.RetentionDays
+	if retention <= 0 {
+		retention = 90
+	}
+
+	return &service.StatsConfig{
+		Cron:              cron,
+		RefreshWeeks:      weeks,
+		Location:          loc,
+		SnapshotRetention: time.Duration(retention) * 24 * time.Hour,
+	}, nil
+}
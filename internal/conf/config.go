@@ -15,6 +15,8 @@ type Config struct {
 	MinIO    MinIOConfig    `yaml:"minio"`
 	JWT      JWTConfig      `yaml:"jwt"`
 	Log      LogConfig      `yaml:"log"`
+	Approval ApprovalConfig `yaml:"approval"`
+	HRM      HRMConfig      `yaml:"hrm"`
 }
 
 // ServerConfig 服务器配置
@@ -82,6 +84,55 @@ type LogConfig struct {
 	Compress   bool   `yaml:"compress"`
 }
 
+// ApprovalConfig 审批模块配置
+type ApprovalConfig struct {
+	ActionLink   ActionLinkConfig    `yaml:"action_link"`
+	Stats        ApprovalStatsConfig `yaml:"stats"`
+	HistoryChain HistoryChainConfig  `yaml:"history_chain"`
+}
+
+// ActionLinkConfig 审批快捷链接配置（邮件/IM 一键审批）
+type ActionLinkConfig struct {
+	Secret        string `yaml:"secret"`         // 链接签名密钥
+	BaseURL       string `yaml:"base_url"`       // 确认页地址，token 以查询参数附加
+	Expire        int    `yaml:"expire"`         // 有效期（秒）
+	ReplyDomain   string `yaml:"reply_domain"`   // 邮件回复地址域名（approval+<token>@domain）
+	InboundSecret string `yaml:"inbound_secret"` // 入站邮件 Webhook 签名密钥
+}
+
+// ApprovalStatsConfig 审批统计看板配置
+type ApprovalStatsConfig struct {
+	Cron          string `yaml:"cron"`           // 刷新周期（Cron 表达式）
+	RefreshWeeks  int    `yaml:"refresh_weeks"`  // 每次重算最近几周
+	Timezone      string `yaml:"timezone"`       // 按周统计使用的时区
+	RetentionDays int    `yaml:"retention_days"` // 待办队列快照保留天数
+}
+
+// HistoryChainConfig 审批历史哈希链配置
+type HistoryChainConfig struct {
+	Secret string `yaml:"secret"` // HMAC 密钥，更换后已有链校验失败，需妥善保管
+}
+
+// HRMConfig 人力资源模块配置
+type HRMConfig struct {
+	FieldEncryption FieldEncryptionConfig `yaml:"field_encryption"`
+	ClockQR         ClockQRConfig         `yaml:"clock_qr"`
+}
+
+// FieldEncryptionConfig 敏感字段信封加密配置（密钥均为 base64 编码的 32 字节）
+type FieldEncryptionConfig struct {
+	MasterKeys      map[string]string `yaml:"master_keys"`       // 主密钥（ID -> 密钥），轮换期间保留旧主密钥
+	ActiveMasterKey string            `yaml:"active_master_key"` // 包装新数据密钥使用的主密钥ID
+	BlindIndexKey   string            `yaml:"blind_index_key"`   // 盲索引密钥（身份证号查重）
+}
+
+// ClockQRConfig 考勤屏动态二维码配置
+type ClockQRConfig struct {
+	Secret  string `yaml:"secret"`   // 二维码签名密钥
+	Rotate  int    `yaml:"rotate"`   // 轮换周期（秒），二维码在下一个周期内仍有效
+	ScanURL string `yaml:"scan_url"` // 员工 App 扫码落地页，token 以查询参数附加；为空时二维码内容即 token
+}
+
 // Load 加载配置文件
 func Load(path string) (*Config, error) {
 	data, err := os.ReadFile(path)
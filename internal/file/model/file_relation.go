@@ -39,6 +39,7 @@ RelationTypeEvidence   RelationType = "evidence"   // 证据
 RelationTypeReport     RelationType = "report"     // 报告
 RelationTypeDocument   RelationType = "document"   // 文档
 RelationTypeCertificate RelationType = "certificate" // 证书
+RelationTypeReceipt     RelationType = "receipt"     // 票据
 )
 
 // EntityType 实体类型
@@ -52,4 +53,6 @@ EntityTypeEmployee          EntityType = "employee"            // 员工
 EntityTypeOrganization      EntityType = "organization"        // 组织
 EntityTypeContract          EntityType = "contract"            // 合同
 EntityTypeProject           EntityType = "project"             // 项目
+EntityTypeApprovalInstance  EntityType = "approval_instance"   // 审批实例
+EntityTypeTripExpense       EntityType = "hrm_trip_expense"    // 出差报销明细
 )
@@ -5,36 +5,51 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/google/uuid"
 	pb "github.com/lk2023060901/go-next-erp/api/hrm/v1"
 	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
 	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
 	"github.com/lk2023060901/go-next-erp/internal/hrm/service"
+	"github.com/lk2023060901/go-next-erp/pkg/middleware"
+)
+
+var (
+	// ErrMissingTenant 上下文中缺少租户信息
+	ErrMissingTenant = errors.Unauthorized("MISSING_TENANT", "缺少租户信息")
+	// ErrMissingUser 上下文中缺少用户信息
+	ErrMissingUser = errors.Unauthorized("MISSING_USER", "缺少用户信息")
+	// ErrClockInNoEmployee 当前用户未关联员工，不能打卡
+	ErrClockInNoEmployee = errors.Forbidden("NO_LINKED_EMPLOYEE", "当前用户未关联员工")
+	// ErrClockInForOthers 只能为本人打卡
+	ErrClockInForOthers = errors.Forbidden("PERMISSION_DENIED", "cannot clock in for another employee")
 )
 
 // AttendanceHandler 考勤处理器
 type AttendanceHandler struct {
 	pb.UnimplementedAttendanceServiceServer
 	attendanceService service.AttendanceService
+	hrmEmpRepo        repository.HRMEmployeeRepository
 }
 
 // NewAttendanceHandler 创建考勤处理器
-func NewAttendanceHandler(attendanceService service.AttendanceService) *AttendanceHandler {
+func NewAttendanceHandler(attendanceService service.AttendanceService, hrmEmpRepo repository.HRMEmployeeRepository) *AttendanceHandler {
 	return &AttendanceHandler{
 		attendanceService: attendanceService,
+		hrmEmpRepo:        hrmEmpRepo,
 	}
 }
 
-// ClockIn 打卡
+// ClockIn 打卡，只能为当前登录用户关联的员工打卡，定位和 WiFi 由服务层按考勤规则校验
 func (h *AttendanceHandler) ClockIn(ctx context.Context, req *pb.ClockInRequest) (*pb.ClockInResponse, error) {
-	// 1. 参数验证
-	tenantID, err := uuid.Parse(req.TenantId)
+	// 1. 确定打卡员工，请求中的租户和员工ID只能是本人
+	tenantID, employeeID, err := h.employeeFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
-	employeeID, err := uuid.Parse(req.EmployeeId)
-	if err != nil {
-		return nil, err
+	if (req.TenantId != "" && req.TenantId != tenantID.String()) ||
+		(req.EmployeeId != "" && req.EmployeeId != employeeID.String()) {
+		return nil, ErrClockInForOthers
 	}
 
 	// 2. 解析打卡类型和方法
@@ -87,6 +102,23 @@ func (h *AttendanceHandler) ClockIn(ctx context.Context, req *pb.ClockInRequest)
 	}, nil
 }
 
+// employeeFromContext 当前登录用户所在租户及其关联的员工
+func (h *AttendanceHandler) employeeFromContext(ctx context.Context) (uuid.UUID, uuid.UUID, error) {
+	tenantID, ok := middleware.GetTenantID(ctx)
+	if !ok {
+		return uuid.Nil, uuid.Nil, ErrMissingTenant
+	}
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		return uuid.Nil, uuid.Nil, ErrMissingUser
+	}
+	employeeID, err := h.hrmEmpRepo.FindEmployeeIDByUser(ctx, tenantID, userID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, ErrClockInNoEmployee
+	}
+	return tenantID, employeeID, nil
+}
+
 // GetAttendanceRecord 获取考勤记录
 func (h *AttendanceHandler) GetAttendanceRecord(ctx context.Context, req *pb.GetAttendanceRecordRequest) (*pb.AttendanceRecordResponse, error) {
 	id, err := uuid.Parse(req.Id)
@@ -186,6 +186,16 @@ func convertAttendanceRuleToProto(rule *model.AttendanceRule) *pb.AttendanceRule
 		UpdatedAt:        rule.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
 
+	if rule.HolidayCalendarID != nil {
+		resp.HolidayCalendarId = rule.HolidayCalendarID.String()
+	}
+	if rule.OvertimePolicyID != nil {
+		resp.OvertimePolicyId = rule.OvertimePolicyID.String()
+	}
+	for _, id := range rule.ClockLocationIDs {
+		resp.ClockLocationIds = append(resp.ClockLocationIds, id.String())
+	}
+
 	// 转换允许地点
 	if len(rule.AllowedLocations) > 0 {
 		resp.AllowedLocations = make([]*pb.AllowedLocationInfo, 0, len(rule.AllowedLocations))
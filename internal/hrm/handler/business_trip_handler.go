@@ -328,7 +328,7 @@ func (h *BusinessTripHandler) SubmitTripReport(ctx context.Context, req *pb.Subm
 		return nil, fmt.Errorf("invalid id: %w", err)
 	}
 
-	if err := h.tripService.SubmitReport(ctx, tripID, req.Report, req.ActualCost); err != nil {
+	if err := h.tripService.SubmitReport(ctx, tripID, req.Report); err != nil {
 		return nil, err
 	}
 
@@ -22,6 +22,12 @@ type AttendanceIntegration interface {
 
 	// GetEmployeeAttendance 获取员工考勤数据
 	GetEmployeeAttendance(ctx context.Context, req *GetEmployeeAttendanceRequest) ([]*model.AttendanceRecord, error)
+
+	// PullAttendance 按游标增量拉取全部平台用户的打卡记录
+	PullAttendance(ctx context.Context, req *PullAttendanceRequest) (*PullAttendanceResult, error)
+
+	// HandleCallback 校验签名并解析平台事件回调
+	HandleCallback(ctx context.Context, req *CallbackRequest) (*CallbackResult, error)
 }
 
 // SyncAttendanceRequest 同步考勤记录请求
@@ -90,6 +96,15 @@ type DeviceAdapter interface {
 
 	// ClearRecords 清空设备记录
 	ClearRecords(ctx context.Context) error
+
+	// Reboot 重启设备
+	Reboot(ctx context.Context) error
+
+	// SetTime 校准设备时间
+	SetTime(ctx context.Context, t time.Time) error
+
+	// EnrollBiometric 在设备上为员工启动生物特征采集（手指编号或人脸模板序号），采集结果由设备上传
+	EnrollBiometric(ctx context.Context, employeeNo string, biometricType model.BiometricType, index int) error
 }
 
 // PlatformAdapter 第三方平台适配器接口
@@ -180,6 +195,17 @@ type EmployeeDTO struct {
 	FaceData     string `json:"face_data,omitempty"`   // 人脸数据
 	Fingerprint  string `json:"fingerprint,omitempty"` // 指纹数据
 	Status       string `json:"status"`                // 状态
+
+	// Biometrics 与目标设备算法兼容的生物特征模板，随员工一并下发
+	Biometrics []*BiometricDTO `json:"biometrics,omitempty"`
+}
+
+// BiometricDTO 生物特征模板数据传输对象
+type BiometricDTO struct {
+	Type             model.BiometricType `json:"type"`
+	Index            int                 `json:"index"`             // 手指编号或人脸模板序号
+	AlgorithmVersion string              `json:"algorithm_version"` // 算法主版本
+	Template         string              `json:"-"`                 // 模板数据
 }
 
 // DepartmentDTO 部门数据传输对象
@@ -0,0 +1,124 @@
+package integration
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"strings"
+)
+
+// msgCryptBlockSize 企业微信/钉钉回调加密使用 32 字节 PKCS#7 填充
+const msgCryptBlockSize = 32
+
+var ErrInvalidCallbackMessage = errors.New("invalid callback message")
+
+// MsgSignature 企业微信/钉钉回调签名：token、timestamp、nonce、密文字典序排序后拼接取 SHA1
+func MsgSignature(token, timestamp, nonce, encrypt string) string {
+	parts := []string{token, timestamp, nonce, encrypt}
+	sort.Strings(parts)
+	sum := sha1.Sum([]byte(strings.Join(parts, "")))
+	return hex.EncodeToString(sum[:])
+}
+
+// VerifyMsgSignature 常量时间比较回调签名
+func VerifyMsgSignature(token, timestamp, nonce, encrypt, signature string) bool {
+	expected := MsgSignature(token, timestamp, nonce, encrypt)
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(strings.ToLower(signature))) == 1
+}
+
+// DecryptMessage 解密企业微信/钉钉回调消息。
+// 明文结构：16 字节随机串 + 4 字节网络序消息长度 + 消息 + receiveID
+func DecryptMessage(encodingAESKey, encrypted string) ([]byte, string, error) {
+	key, err := msgCryptKey(encodingAESKey)
+	if err != nil {
+		return nil, "", err
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil || len(ciphertext) == 0 || len(ciphertext)%aes.BlockSize != 0 {
+		return nil, "", ErrInvalidCallbackMessage
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, "", err
+	}
+	plain := make([]byte, len(ciphertext))
+	cipher.NewCBCDecrypter(block, key[:aes.BlockSize]).CryptBlocks(plain, ciphertext)
+
+	plain, err = pkcs7Unpad(plain, msgCryptBlockSize)
+	if err != nil || len(plain) < 20 {
+		return nil, "", ErrInvalidCallbackMessage
+	}
+	size := int(binary.BigEndian.Uint32(plain[16:20]))
+	if size > len(plain)-20 {
+		return nil, "", ErrInvalidCallbackMessage
+	}
+	return plain[20 : 20+size], string(plain[20+size:]), nil
+}
+
+// EncryptMessage 加密应答消息（钉钉回调要求加密的 success 应答）
+func EncryptMessage(encodingAESKey, receiveID string, message []byte) (string, error) {
+	key, err := msgCryptKey(encodingAESKey)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	buf.Write(random)
+	size := make([]byte, 4)
+	binary.BigEndian.PutUint32(size, uint32(len(message)))
+	buf.Write(size)
+	buf.Write(message)
+	buf.WriteString(receiveID)
+
+	plain := pkcs7Pad(buf.Bytes(), msgCryptBlockSize)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return "", err
+	}
+	ciphertext := make([]byte, len(plain))
+	cipher.NewCBCEncrypter(block, key[:aes.BlockSize]).CryptBlocks(ciphertext, plain)
+	return base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+// msgCryptKey EncodingAESKey 为 43 位 Base64（省略末尾的 =），解码后为 32 字节 AES 密钥
+func msgCryptKey(encodingAESKey string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(encodingAESKey + "=")
+	if err != nil || len(key) != 32 {
+		return nil, ErrInvalidCallbackMessage
+	}
+	return key, nil
+}
+
+func pkcs7Pad(data []byte, blockSize int) []byte {
+	padding := blockSize - len(data)%blockSize
+	return append(data, bytes.Repeat([]byte{byte(padding)}, padding)...)
+}
+
+func pkcs7Unpad(data []byte, blockSize int) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, ErrInvalidCallbackMessage
+	}
+	padding := int(data[len(data)-1])
+	if padding == 0 || padding > blockSize || padding > len(data) {
+		return nil, ErrInvalidCallbackMessage
+	}
+	return data[:len(data)-padding], nil
+}
+
+// PKCS7Unpad 去除 PKCS#7 填充（飞书回调解密使用 16 字节块）
+func PKCS7Unpad(data []byte) ([]byte, error) {
+	return pkcs7Unpad(data, aes.BlockSize)
+}
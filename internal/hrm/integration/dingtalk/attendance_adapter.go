@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,65 +14,65 @@ import (
 	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
 )
 
+const (
+	// rootDepartmentID 钉钉根部门ID
+	rootDepartmentID = 1
+
+	// userPageSize 通讯录分页大小上限
+	userPageSize = 100
+
+	// attendanceUserBatch、attendancePageSize 打卡结果接口单次用户数、分页大小上限
+	attendanceUserBatch = 50
+	attendancePageSize  = 50
+
+	// attendanceMaxSpan 打卡结果接口单次查询跨度上限
+	attendanceMaxSpan = 7 * 24 * time.Hour
+
+	dingtalkTimeLayout = "2006-01-02 15:04:05"
+)
+
 // AttendanceAdapter 钉钉考勤适配器
 type AttendanceAdapter struct {
 	appKey    string
 	appSecret string
-	baseURL   string
+	client    *integration.PlatformClient
 }
 
 // NewAttendanceAdapter 创建钉钉考勤适配器
-func NewAttendanceAdapter(appKey, appSecret string) integration.AttendanceIntegration {
+func NewAttendanceAdapter(appKey, appSecret string, opts ...integration.ClientOption) integration.AttendanceIntegration {
 	return &AttendanceAdapter{
 		appKey:    appKey,
 		appSecret: appSecret,
-		baseURL:   "https://oapi.dingtalk.com",
+		client:    integration.NewPlatformClient(model.PlatformDingTalk, appKey, "https://oapi.dingtalk.com", opts...),
 	}
 }
 
 // SyncAttendanceRecords 同步考勤记录
 func (a *AttendanceAdapter) SyncAttendanceRecords(ctx context.Context, req *integration.SyncAttendanceRequest) ([]*model.AttendanceRecord, error) {
-	// 1. 获取 Access Token
-	token, err := a.getAccessToken(ctx)
+	records, _, err := a.pull(ctx, req.TenantID, req.StartDate, req.EndDate)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get access token: %w", err)
+		return nil, err
 	}
-
-	// 2. 调用钉钉考勤记录API
-	records, err := a.fetchAttendanceRecords(ctx, token, req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch attendance records: %w", err)
-	}
-
-	// 3. 转换为系统内部格式
-	result := make([]*model.AttendanceRecord, 0, len(records))
-	for _, record := range records {
-		converted, err := a.convertToAttendanceRecord(record, req.TenantID)
-		if err != nil {
-			// 记录错误但继续处理其他记录
-			continue
-		}
-		result = append(result, converted)
-	}
-
-	return result, nil
+	return unwrapRecords(records), nil
 }
 
 // SyncEmployees 同步员工信息
 func (a *AttendanceAdapter) SyncEmployees(ctx context.Context, req *integration.SyncEmployeeRequest) ([]*model.EmployeeSyncMapping, error) {
-	// 1. 获取 Access Token
-	token, err := a.getAccessToken(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get access token: %w", err)
+	deptID := int64(rootDepartmentID)
+	if req.DepartmentID != "" {
+		id, err := strconv.ParseInt(req.DepartmentID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dingtalk department id: %s", req.DepartmentID)
+		}
+		deptID = id
 	}
 
-	// 2. 获取部门用户列表
-	users, err := a.fetchDepartmentUsers(ctx, token, req.DepartmentID)
+	users, err := a.fetchUsers(ctx, deptID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch users: %w", err)
 	}
 
-	// 3. 转换为员工同步映射
+	// 转换为员工同步映射
 	result := make([]*model.EmployeeSyncMapping, 0, len(users))
 	for _, user := range users {
 		mapping := &model.EmployeeSyncMapping{
@@ -96,68 +99,280 @@ func (a *AttendanceAdapter) PushAttendanceRule(ctx context.Context, req *integra
 
 // GetEmployeeAttendance 获取员工考勤数据
 func (a *AttendanceAdapter) GetEmployeeAttendance(ctx context.Context, req *integration.GetEmployeeAttendanceRequest) ([]*model.AttendanceRecord, error) {
-	// 1. 获取 Access Token
-	token, err := a.getAccessToken(ctx)
+	records, _, err := a.pull(ctx, req.TenantID, req.StartDate, req.EndDate)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get access token: %w", err)
+		return nil, err
 	}
+	return unwrapRecords(records), nil
+}
 
-	// 2. 构造请求参数
-	syncReq := &integration.SyncAttendanceRequest{
-		TenantID:   req.TenantID,
-		EmployeeID: req.EmployeeID,
-		StartDate:  req.StartDate,
-		EndDate:    req.EndDate,
+// PullAttendance 增量拉取考勤记录
+func (a *AttendanceAdapter) PullAttendance(ctx context.Context, req *integration.PullAttendanceRequest) (*integration.PullAttendanceResult, error) {
+	start, end := req.Window()
+	records, users, err := a.pull(ctx, req.TenantID, start, end)
+	if err != nil {
+		return nil, err
 	}
+	return &integration.PullAttendanceResult{
+		Records:    records,
+		Users:      users,
+		NextCursor: integration.FormatTimeCursor(end),
+	}, nil
+}
 
-	// 3. 调用钉钉API获取考勤记录
-	records, err := a.fetchAttendanceRecords(ctx, token, syncReq)
+// HandleCallback 处理钉钉事件订阅回调：校验签名、解密事件，应答加密的 success
+func (a *AttendanceAdapter) HandleCallback(ctx context.Context, req *integration.CallbackRequest) (*integration.CallbackResult, error) {
+	var envelope struct {
+		Encrypt string `json:"encrypt"`
+	}
+	if err := json.Unmarshal(req.Body, &envelope); err != nil || envelope.Encrypt == "" {
+		return nil, integration.ErrInvalidCallbackMessage
+	}
+
+	timestamp, nonce := req.Query.Get("timestamp"), req.Query.Get("nonce")
+	signature := req.Query.Get("msg_signature")
+	if signature == "" {
+		signature = req.Query.Get("signature")
+	}
+	if !integration.VerifyMsgSignature(a.client.CallbackToken, timestamp, nonce, envelope.Encrypt, signature) {
+		return nil, integration.ErrInvalidSignature
+	}
+
+	plain, receiveID, err := integration.DecryptMessage(a.client.CallbackKey, envelope.Encrypt)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch attendance records: %w", err)
+		return nil, err
+	}
+	if receiveID != a.appKey {
+		return nil, integration.ErrInvalidSignature
 	}
 
-	// 4. 转换为系统内部格式
-	result := make([]*model.AttendanceRecord, 0, len(records))
-	for _, record := range records {
-		converted, err := a.convertToAttendanceRecord(record, req.TenantID)
-		if err != nil {
-			continue
-		}
-		result = append(result, converted)
+	var event struct {
+		EventType string `json:"EventType"`
+	}
+	if err := json.Unmarshal(plain, &event); err != nil {
+		return nil, integration.ErrInvalidCallbackMessage
 	}
 
-	return result, nil
+	encrypted, err := integration.EncryptMessage(a.client.CallbackKey, a.appKey, []byte("success"))
+	if err != nil {
+		return nil, err
+	}
+	reply, err := json.Marshal(map[string]string{
+		"msg_signature": integration.MsgSignature(a.client.CallbackToken, timestamp, nonce, encrypted),
+		"timeStamp":     timestamp,
+		"nonce":         nonce,
+		"encrypt":       encrypted,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &integration.CallbackResult{
+		EventType:   event.EventType,
+		Attendance:  event.EventType == "attendance_check_record",
+		Reply:       reply,
+		ContentType: "application/json",
+	}, nil
 }
 
 // ========== 内部辅助方法 ==========
 
-// getAccessToken 获取钉钉 Access Token
+// apiResult 钉钉接口通用应答
+type apiResult struct {
+	ErrCode int    `json:"errcode"`
+	ErrMsg  string `json:"errmsg"`
+}
+
+func (r *apiResult) result() *apiResult {
+	return r
+}
+
+type resultCarrier interface {
+	result() *apiResult
+}
+
+// getAccessToken 获取钉钉 Access Token（缓存，到期前刷新）
 func (a *AttendanceAdapter) getAccessToken(ctx context.Context) (string, error) {
-	url := fmt.Sprintf("%s/gettoken?appkey=%s&appsecret=%s", a.baseURL, a.appKey, a.appSecret)
+	return a.client.Token(ctx, func(ctx context.Context) (string, time.Duration, error) {
+		var resp struct {
+			apiResult
+			AccessToken string `json:"access_token"`
+			ExpiresIn   int    `json:"expires_in"`
+		}
+		query := url.Values{"appkey": {a.appKey}, "appsecret": {a.appSecret}}
+		err := a.client.Retry(ctx, func() error {
+			if err := a.client.Do(ctx, http.MethodGet, "/gettoken", query, nil, nil, &resp); err != nil {
+				return err
+			}
+			return a.checkResult(ctx, &resp.apiResult)
+		})
+		if err != nil {
+			return "", 0, err
+		}
+		return resp.AccessToken, time.Duration(resp.ExpiresIn) * time.Second, nil
+	})
+}
 
-	// 这里应该调用 HTTP Client
-	// 为了示例，返回模拟 token
-	// TODO: 实现实际的 HTTP 请求
-	_ = url // 避免未使用变量警告
+// call 调用需要 access_token 的接口：限流退避重试，令牌失效时刷新后重试
+func (a *AttendanceAdapter) call(ctx context.Context, path string, body interface{}, out resultCarrier) error {
+	return a.client.Retry(ctx, func() error {
+		token, err := a.getAccessToken(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get access token: %w", err)
+		}
+		query := url.Values{"access_token": {token}}
+		if err := a.client.Do(ctx, http.MethodPost, path, query, nil, body, out); err != nil {
+			return err
+		}
+		return a.checkResult(ctx, out.result())
+	})
+}
 
-	return "mock_access_token", nil
+// checkResult 解析钉钉错误码
+func (a *AttendanceAdapter) checkResult(ctx context.Context, result *apiResult) error {
+	switch result.ErrCode {
+	case 0:
+		return nil
+	case 90002, 90018: // 调用频率、并发超限
+		return &integration.RateLimitError{Platform: model.PlatformDingTalk, Message: result.ErrMsg}
+	case 40014, 42001: // access_token 不合法或已过期
+		if err := a.client.InvalidateToken(ctx); err != nil {
+			return err
+		}
+		return fmt.Errorf("%w: %s", integration.ErrTokenExpired, result.ErrMsg)
+	default:
+		return &integration.PlatformError{Platform: model.PlatformDingTalk, Code: result.ErrCode, Message: result.ErrMsg}
+	}
 }
 
-// fetchAttendanceRecords 获取钉钉考勤记录
-func (a *AttendanceAdapter) fetchAttendanceRecords(ctx context.Context, token string, req *integration.SyncAttendanceRequest) ([]*DingTalkAttendanceRecord, error) {
-	// 钉钉API: /attendance/listRecord
-	// TODO: 实现实际的 HTTP 请求
+// pull 拉取全部用户在时间范围内的打卡记录
+func (a *AttendanceAdapter) pull(ctx context.Context, tenantID uuid.UUID, start, end time.Time) ([]*integration.PlatformAttendanceRecord, int, error) {
+	users, err := a.fetchUsers(ctx, rootDepartmentID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch users: %w", err)
+	}
+	userIDs := make([]string, 0, len(users))
+	for _, user := range users {
+		userIDs = append(userIDs, user.UserID)
+	}
+
+	records, err := a.fetchAttendanceRecords(ctx, userIDs, start, end)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch attendance records: %w", err)
+	}
 
-	// 模拟返回数据
-	return []*DingTalkAttendanceRecord{}, nil
+	// 转换为系统内部格式
+	result := make([]*integration.PlatformAttendanceRecord, 0, len(records))
+	for _, record := range records {
+		converted, err := a.convertToAttendanceRecord(record, tenantID)
+		if err != nil {
+			// 记录错误但继续处理其他记录
+			continue
+		}
+		result = append(result, &integration.PlatformAttendanceRecord{PlatformUserID: record.UserID, Record: converted})
+	}
+	return result, len(userIDs), nil
 }
 
-// fetchDepartmentUsers 获取部门用户列表
-func (a *AttendanceAdapter) fetchDepartmentUsers(ctx context.Context, token string, deptID string) ([]*DingTalkUser, error) {
-	// 钉钉API: /user/listbypage
-	// TODO: 实现实际的 HTTP 请求
+// fetchAttendanceRecords 获取钉钉打卡结果
+// 钉钉API: /attendance/list，单次最多 50 人、7 天，按 offset 翻页
+func (a *AttendanceAdapter) fetchAttendanceRecords(ctx context.Context, userIDs []string, start, end time.Time) ([]*DingTalkAttendanceRecord, error) {
+	var records []*DingTalkAttendanceRecord
+	for _, window := range integration.SplitWindow(start, end, attendanceMaxSpan) {
+		for _, batch := range integration.ChunkStrings(userIDs, attendanceUserBatch) {
+			for offset := 0; ; {
+				var resp struct {
+					apiResult
+					RecordResult []*DingTalkAttendanceRecord `json:"recordresult"`
+					HasMore      bool                        `json:"hasMore"`
+				}
+				body := map[string]interface{}{
+					"workDateFrom": window.Start.Format(dingtalkTimeLayout),
+					"workDateTo":   window.End.Format(dingtalkTimeLayout),
+					"userIdList":   batch,
+					"offset":       offset,
+					"limit":        attendancePageSize,
+				}
+				if err := a.call(ctx, "/attendance/list", body, &resp); err != nil {
+					return nil, err
+				}
+
+				for _, record := range resp.RecordResult {
+					checkTime := time.UnixMilli(record.UserCheckTime)
+					if !checkTime.Before(window.Start) && checkTime.Before(window.End) {
+						records = append(records, record)
+					}
+				}
+				if !resp.HasMore || len(resp.RecordResult) == 0 {
+					break
+				}
+				offset += len(resp.RecordResult)
+			}
+		}
+	}
+	return records, nil
+}
+
+// fetchUsers 获取部门及其全部子部门的用户
+// 钉钉API: /topapi/v2/department/listsubid、/topapi/v2/user/list（按 cursor 翻页）
+func (a *AttendanceAdapter) fetchUsers(ctx context.Context, deptID int64) ([]*DingTalkUser, error) {
+	var users []*DingTalkUser
+	seen := make(map[string]bool)
+
+	queue := []int64{deptID}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		deptUsers, err := a.fetchDepartmentUsers(ctx, current)
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range deptUsers {
+			if !seen[user.UserID] {
+				seen[user.UserID] = true
+				users = append(users, user)
+			}
+		}
+
+		var resp struct {
+			apiResult
+			Result struct {
+				DeptIDList []int64 `json:"dept_id_list"`
+			} `json:"result"`
+		}
+		if err := a.call(ctx, "/topapi/v2/department/listsubid", map[string]interface{}{"dept_id": current}, &resp); err != nil {
+			return nil, err
+		}
+		queue = append(queue, resp.Result.DeptIDList...)
+	}
+	return users, nil
+}
+
+// fetchDepartmentUsers 获取部门直属用户列表
+func (a *AttendanceAdapter) fetchDepartmentUsers(ctx context.Context, deptID int64) ([]*DingTalkUser, error) {
+	var users []*DingTalkUser
+	cursor := int64(0)
+	for {
+		var resp struct {
+			apiResult
+			Result struct {
+				HasMore    bool            `json:"has_more"`
+				NextCursor int64           `json:"next_cursor"`
+				List       []*DingTalkUser `json:"list"`
+			} `json:"result"`
+		}
+		body := map[string]interface{}{"dept_id": deptID, "cursor": cursor, "size": userPageSize}
+		if err := a.call(ctx, "/topapi/v2/user/list", body, &resp); err != nil {
+			return nil, err
+		}
 
-	return []*DingTalkUser{}, nil
+		users = append(users, resp.Result.List...)
+		if !resp.Result.HasMore {
+			return users, nil
+		}
+		cursor = resp.Result.NextCursor
+	}
 }
 
 // convertToAttendanceRecord 转换钉钉考勤记录为系统格式
@@ -203,12 +418,13 @@ func (a *AttendanceAdapter) convertToAttendanceRecord(record *DingTalkAttendance
 
 	// 构造考勤记录
 	result := &model.AttendanceRecord{
-		ClockTime:     record.UserCheckTime,
+		TenantID:      tenantID,
+		ClockTime:     time.UnixMilli(record.UserCheckTime),
 		ClockType:     clockType,
 		Status:        status,
 		CheckInMethod: method,
 		SourceType:    model.SourceTypeDingTalk,
-		SourceID:      record.ID,
+		SourceID:      strconv.FormatInt(record.ID, 10),
 		IsException:   record.TimeResult != "Normal",
 		ExceptionType: record.TimeResult,
 		CreatedAt:     time.Now(),
@@ -232,24 +448,32 @@ func (a *AttendanceAdapter) convertToAttendanceRecord(record *DingTalkAttendance
 	return result, nil
 }
 
+func unwrapRecords(records []*integration.PlatformAttendanceRecord) []*model.AttendanceRecord {
+	result := make([]*model.AttendanceRecord, 0, len(records))
+	for _, record := range records {
+		result = append(result, record.Record)
+	}
+	return result
+}
+
 // ========== 钉钉数据结构 ==========
 
-// DingTalkAttendanceRecord 钉钉考勤记录
+// DingTalkAttendanceRecord 钉钉考勤记录（时间字段为毫秒时间戳）
 type DingTalkAttendanceRecord struct {
-	ID             string    `json:"id"`
-	UserID         string    `json:"userId"`
-	WorkDate       int64     `json:"workDate"`
-	UserCheckTime  time.Time `json:"userCheckTime"`
-	CheckType      string    `json:"checkType"`      // OnDuty, OffDuty
-	TimeResult     string    `json:"timeResult"`     // Normal, Late, Early, Absenteeism
-	LocationResult string    `json:"locationResult"` // Normal, Abnormal
-	LocationMethod string    `json:"locationMethod"` // WiFi, Bluetooth, GPS
-	Latitude       float64   `json:"latitude"`
-	Longitude      float64   `json:"longitude"`
-	BaseCheckTime  time.Time `json:"baseCheckTime"`
-	SourceType     string    `json:"sourceType"`
-	PlanID         int64     `json:"planId"`
-	GroupID        int64     `json:"groupId"`
+	ID             int64   `json:"id"`
+	UserID         string  `json:"userId"`
+	WorkDate       int64   `json:"workDate"`
+	UserCheckTime  int64   `json:"userCheckTime"`
+	CheckType      string  `json:"checkType"`      // OnDuty, OffDuty
+	TimeResult     string  `json:"timeResult"`     // Normal, Late, Early, Absenteeism
+	LocationResult string  `json:"locationResult"` // Normal, Outside, NotSigned
+	LocationMethod string  `json:"locationMethod"` // WiFi, Bluetooth, GPS
+	Latitude       float64 `json:"userLatitude"`
+	Longitude      float64 `json:"userLongitude"`
+	BaseCheckTime  int64   `json:"baseCheckTime"`
+	SourceType     string  `json:"sourceType"`
+	PlanID         int64   `json:"planId"`
+	GroupID        int64   `json:"groupId"`
 }
 
 // DingTalkUser 钉钉用户
@@ -259,7 +483,7 @@ type DingTalkUser struct {
 	Mobile     string  `json:"mobile"`
 	Email      string  `json:"email"`
 	DeptIDList []int64 `json:"dept_id_list"`
-	Position   string  `json:"position"`
+	Position   string  `json:"title"`
 	JobNumber  string  `json:"job_number"`
 	Active     bool    `json:"active"`
 }
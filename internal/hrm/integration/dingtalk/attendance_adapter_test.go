@@ -0,0 +1,191 @@
+package dingtalk
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/integration"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDingTalk 钉钉开放平台替身：两页通讯录、一个子部门、打卡结果分页，首次打卡查询返回限流
+type fakeDingTalk struct {
+	tokenCalls   int32
+	listCalls    int32
+	rateLimited  int32
+	expiredOnce  int32
+	attendance   []map[string]interface{}
+	lastListBody map[string]interface{}
+}
+
+func (f *fakeDingTalk) handler(t *testing.T) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/gettoken", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&f.tokenCalls, 1)
+		assert.Equal(t, "key", r.URL.Query().Get("appkey"))
+		writeJSON(w, map[string]interface{}{"errcode": 0, "access_token": "token-" + r.URL.Query().Get("appsecret"), "expires_in": 7200})
+	})
+	mux.HandleFunc("/topapi/v2/department/listsubid", func(w http.ResponseWriter, r *http.Request) {
+		body := decodeBody(t, r)
+		children := []int64{}
+		if body["dept_id"].(float64) == 1 {
+			children = []int64{2}
+		}
+		writeJSON(w, map[string]interface{}{"errcode": 0, "result": map[string]interface{}{"dept_id_list": children}})
+	})
+	mux.HandleFunc("/topapi/v2/user/list", func(w http.ResponseWriter, r *http.Request) {
+		body := decodeBody(t, r)
+		var list []map[string]interface{}
+		hasMore := false
+		switch {
+		case body["dept_id"].(float64) == 2:
+			// 同一用户在多个部门
+			list = []map[string]interface{}{{"userid": "u2", "name": "李四"}}
+		case body["cursor"].(float64) == 0:
+			list = []map[string]interface{}{{"userid": "u1", "name": "张三"}}
+			hasMore = true
+		default:
+			list = []map[string]interface{}{{"userid": "u2", "name": "李四"}}
+		}
+		writeJSON(w, map[string]interface{}{"errcode": 0, "result": map[string]interface{}{"has_more": hasMore, "next_cursor": 100, "list": list}})
+	})
+	mux.HandleFunc("/attendance/list", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&f.listCalls, 1)
+		if atomic.CompareAndSwapInt32(&f.rateLimited, 0, 1) {
+			writeJSON(w, map[string]interface{}{"errcode": 90018, "errmsg": "concurrency limit"})
+			return
+		}
+		if atomic.CompareAndSwapInt32(&f.expiredOnce, 1, 2) {
+			writeJSON(w, map[string]interface{}{"errcode": 42001, "errmsg": "access_token expired"})
+			return
+		}
+		body := decodeBody(t, r)
+		f.lastListBody = body
+		offset := int(body["offset"].(float64))
+		end := offset + 1
+		if end > len(f.attendance) {
+			end = len(f.attendance)
+		}
+		writeJSON(w, map[string]interface{}{"errcode": 0, "recordresult": f.attendance[offset:end], "hasMore": end < len(f.attendance)})
+	})
+	return mux
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func decodeBody(t *testing.T, r *http.Request) map[string]interface{} {
+	body := map[string]interface{}{}
+	require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+	return body
+}
+
+func TestPullAttendance(t *testing.T) {
+	ctx := context.Background()
+	tenantID := uuid.New()
+	checkIn := time.Date(2025, 3, 3, 8, 55, 0, 0, time.Local)
+	checkOut := time.Date(2025, 3, 3, 18, 5, 0, 0, time.Local)
+
+	fake := &fakeDingTalk{attendance: []map[string]interface{}{
+		{"id": 1001, "userId": "u1", "userCheckTime": checkIn.UnixMilli(), "checkType": "OnDuty", "timeResult": "Normal"},
+		{"id": 1002, "userId": "u1", "userCheckTime": checkOut.UnixMilli(), "checkType": "OffDuty", "timeResult": "Early"},
+		{"id": 1003, "userId": "u2", "userCheckTime": checkIn.UnixMilli(), "checkType": "Unknown"},
+	}}
+	server := httptest.NewServer(fake.handler(t))
+	defer server.Close()
+
+	adapter := NewAttendanceAdapter("key", "secret", integration.WithBaseURL(server.URL), integration.WithRetry(3, time.Millisecond))
+
+	t.Run("paged pull with rate limit retry", func(t *testing.T) {
+		until := time.Date(2025, 3, 4, 0, 0, 0, 0, time.Local)
+		result, err := adapter.PullAttendance(ctx, &integration.PullAttendanceRequest{
+			TenantID: tenantID,
+			Since:    time.Date(2025, 3, 3, 0, 0, 0, 0, time.Local),
+			Until:    until,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, result.Users)
+		assert.Equal(t, integration.FormatTimeCursor(until), result.NextCursor)
+		require.Len(t, result.Records, 2, "unknown check type is skipped")
+
+		assert.Equal(t, "u1", result.Records[0].PlatformUserID)
+		record := result.Records[1].Record
+		assert.Equal(t, tenantID, record.TenantID)
+		assert.Equal(t, "1002", record.SourceID)
+		assert.Equal(t, model.SourceTypeDingTalk, record.SourceType)
+		assert.Equal(t, model.ClockTypeCheckOut, record.ClockType)
+		assert.Equal(t, model.AttendanceStatusEarly, record.Status)
+		assert.True(t, record.ClockTime.Equal(checkOut))
+
+		// 1 次限流 + 3 页
+		assert.Equal(t, int32(4), fake.listCalls)
+		assert.Equal(t, int32(1), fake.tokenCalls)
+		assert.ElementsMatch(t, []interface{}{"u1", "u2"}, fake.lastListBody["userIdList"])
+	})
+
+	t.Run("cursor sets window start and expired token is refreshed", func(t *testing.T) {
+		atomic.StoreInt32(&fake.expiredOnce, 1)
+		cursor := time.Date(2025, 3, 3, 12, 0, 0, 0, time.Local)
+
+		_, err := adapter.PullAttendance(ctx, &integration.PullAttendanceRequest{
+			TenantID: tenantID,
+			Cursor:   integration.FormatTimeCursor(cursor),
+			Since:    cursor.AddDate(0, 0, -30),
+			Until:    cursor.Add(time.Hour),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, cursor.Add(-integration.CursorOverlap).Format(dingtalkTimeLayout), fake.lastListBody["workDateFrom"])
+		assert.Equal(t, int32(2), fake.tokenCalls)
+	})
+}
+
+func TestHandleCallback(t *testing.T) {
+	ctx := context.Background()
+	aesKey := strings.TrimRight(base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef")), "=")
+	adapter := NewAttendanceAdapter("key", "secret", integration.WithCallback("cb-token", aesKey))
+
+	encrypted, err := integration.EncryptMessage(aesKey, "key", []byte(`{"EventType":"attendance_check_record"}`))
+	require.NoError(t, err)
+	body, _ := json.Marshal(map[string]string{"encrypt": encrypted})
+
+	t.Run("valid signature", func(t *testing.T) {
+		query := url.Values{
+			"msg_signature": {integration.MsgSignature("cb-token", "1700000000", "n1", encrypted)},
+			"timestamp":     {"1700000000"},
+			"nonce":         {"n1"},
+		}
+		result, err := adapter.HandleCallback(ctx, &integration.CallbackRequest{Method: http.MethodPost, Query: query, Body: body})
+		require.NoError(t, err)
+		assert.Equal(t, "attendance_check_record", result.EventType)
+		assert.True(t, result.Attendance)
+
+		var reply map[string]string
+		require.NoError(t, json.Unmarshal(result.Reply, &reply))
+		plain, receiveID, err := integration.DecryptMessage(aesKey, reply["encrypt"])
+		require.NoError(t, err)
+		assert.Equal(t, "success", string(plain))
+		assert.Equal(t, "key", receiveID)
+		assert.Equal(t, integration.MsgSignature("cb-token", "1700000000", "n1", reply["encrypt"]), reply["msg_signature"])
+	})
+
+	t.Run("tampered signature", func(t *testing.T) {
+		query := url.Values{"msg_signature": {"deadbeef"}, "timestamp": {"1700000000"}, "nonce": {"n1"}}
+		_, err := adapter.HandleCallback(ctx, &integration.CallbackRequest{Method: http.MethodPost, Query: query, Body: body})
+		assert.ErrorIs(t, err, integration.ErrInvalidSignature)
+	})
+}
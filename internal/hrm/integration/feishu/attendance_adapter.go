@@ -2,8 +2,18 @@ package feishu
 
 import (
 	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,64 +21,58 @@ import (
 	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
 )
 
+const (
+	// rootDepartmentID 飞书根部门ID
+	rootDepartmentID = "0"
+
+	// pageSize 通讯录分页大小上限
+	pageSize = 50
+
+	// flowUserBatch 打卡流水接口单次用户数上限
+	flowUserBatch = 50
+
+	// flowMaxSpan 打卡流水接口单次查询跨度
+	flowMaxSpan = 7 * 24 * time.Hour
+)
+
 // AttendanceAdapter 飞书考勤适配器
 type AttendanceAdapter struct {
 	appID     string
 	appSecret string
-	baseURL   string
+	client    *integration.PlatformClient
 }
 
 // NewAttendanceAdapter 创建飞书考勤适配器
-func NewAttendanceAdapter(appID, appSecret string) integration.AttendanceIntegration {
+func NewAttendanceAdapter(appID, appSecret string, opts ...integration.ClientOption) integration.AttendanceIntegration {
 	return &AttendanceAdapter{
 		appID:     appID,
 		appSecret: appSecret,
-		baseURL:   "https://open.feishu.cn",
+		client:    integration.NewPlatformClient(model.PlatformFeishu, appID, "https://open.feishu.cn", opts...),
 	}
 }
 
 // SyncAttendanceRecords 同步考勤记录
 func (a *AttendanceAdapter) SyncAttendanceRecords(ctx context.Context, req *integration.SyncAttendanceRequest) ([]*model.AttendanceRecord, error) {
-	// 1. 获取 Tenant Access Token
-	token, err := a.getTenantAccessToken(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get tenant access token: %w", err)
-	}
-
-	// 2. 调用飞书考勤记录API
-	records, err := a.fetchAttendanceRecords(ctx, token, req)
+	records, _, err := a.pull(ctx, req.TenantID, req.StartDate, req.EndDate)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch attendance records: %w", err)
-	}
-
-	// 3. 转换为系统内部格式
-	result := make([]*model.AttendanceRecord, 0, len(records))
-	for _, record := range records {
-		converted, err := a.convertToAttendanceRecord(record, req.TenantID)
-		if err != nil {
-			continue
-		}
-		result = append(result, converted)
+		return nil, err
 	}
-
-	return result, nil
+	return unwrapRecords(records), nil
 }
 
 // SyncEmployees 同步员工信息
 func (a *AttendanceAdapter) SyncEmployees(ctx context.Context, req *integration.SyncEmployeeRequest) ([]*model.EmployeeSyncMapping, error) {
-	// 1. 获取 Tenant Access Token
-	token, err := a.getTenantAccessToken(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get tenant access token: %w", err)
+	deptID := req.DepartmentID
+	if deptID == "" {
+		deptID = rootDepartmentID
 	}
 
-	// 2. 获取部门用户列表
-	users, err := a.fetchDepartmentUsers(ctx, token, req.DepartmentID)
+	users, err := a.fetchUsers(ctx, deptID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch users: %w", err)
 	}
 
-	// 3. 转换为员工同步映射
+	// 转换为员工同步映射
 	result := make([]*model.EmployeeSyncMapping, 0, len(users))
 	for _, user := range users {
 		mapping := &model.EmployeeSyncMapping{
@@ -89,85 +93,340 @@ func (a *AttendanceAdapter) SyncEmployees(ctx context.Context, req *integration.
 
 // PushAttendanceRule 推送考勤规则
 func (a *AttendanceAdapter) PushAttendanceRule(ctx context.Context, req *integration.PushAttendanceRuleRequest) error {
-	// 飞书支持通过API创建考勤组
-	// 1. 获取 Tenant Access Token
-	token, err := a.getTenantAccessToken(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get tenant access token: %w", err)
-	}
-
-	// 2. 构造考勤组参数
-	// TODO: 实现实际的 HTTP 请求到 /open-apis/attendance/v1/groups
-	_ = token
-
-	return nil
+	// 考勤组需要先在系统侧定义字段映射，暂不支持从规则自动创建
+	return fmt.Errorf("feishu does not support pushing attendance rules")
 }
 
 // GetEmployeeAttendance 获取员工考勤数据
 func (a *AttendanceAdapter) GetEmployeeAttendance(ctx context.Context, req *integration.GetEmployeeAttendanceRequest) ([]*model.AttendanceRecord, error) {
-	// 1. 获取 Tenant Access Token
-	token, err := a.getTenantAccessToken(ctx)
+	records, _, err := a.pull(ctx, req.TenantID, req.StartDate, req.EndDate)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get tenant access token: %w", err)
+		return nil, err
 	}
+	return unwrapRecords(records), nil
+}
 
-	// 2. 构造请求参数
-	syncReq := &integration.SyncAttendanceRequest{
-		TenantID:   req.TenantID,
-		EmployeeID: req.EmployeeID,
-		StartDate:  req.StartDate,
-		EndDate:    req.EndDate,
+// PullAttendance 增量拉取考勤记录
+func (a *AttendanceAdapter) PullAttendance(ctx context.Context, req *integration.PullAttendanceRequest) (*integration.PullAttendanceResult, error) {
+	start, end := req.Window()
+	records, users, err := a.pull(ctx, req.TenantID, start, end)
+	if err != nil {
+		return nil, err
 	}
+	return &integration.PullAttendanceResult{
+		Records:    records,
+		Users:      users,
+		NextCursor: integration.FormatTimeCursor(end),
+	}, nil
+}
 
-	// 3. 调用飞书API获取考勤记录
-	records, err := a.fetchAttendanceRecords(ctx, token, syncReq)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch attendance records: %w", err)
+// HandleCallback 处理飞书事件订阅回调。
+// 配置了 Encrypt Key 时事件请求带签名头且消息体加密；URL 校验请求只校验 Verification Token
+func (a *AttendanceAdapter) HandleCallback(ctx context.Context, req *integration.CallbackRequest) (*integration.CallbackResult, error) {
+	signature := req.Header.Get("X-Lark-Signature")
+	if signature != "" {
+		expected := callbackSignature(req.Header.Get("X-Lark-Request-Timestamp"), req.Header.Get("X-Lark-Request-Nonce"), a.client.CallbackKey, req.Body)
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(signature)) != 1 {
+			return nil, integration.ErrInvalidSignature
+		}
 	}
 
-	// 4. 转换为系统内部格式
-	result := make([]*model.AttendanceRecord, 0, len(records))
-	for _, record := range records {
-		converted, err := a.convertToAttendanceRecord(record, req.TenantID)
+	body := req.Body
+	var envelope struct {
+		Encrypt string `json:"encrypt"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, integration.ErrInvalidCallbackMessage
+	}
+	if envelope.Encrypt != "" {
+		plain, err := decryptEvent(a.client.CallbackKey, envelope.Encrypt)
 		if err != nil {
-			continue
+			return nil, err
 		}
-		result = append(result, converted)
+		body = plain
 	}
 
-	return result, nil
+	var event struct {
+		Type      string `json:"type"`
+		Challenge string `json:"challenge"`
+		Token     string `json:"token"`
+		Header    struct {
+			EventType string `json:"event_type"`
+			Token     string `json:"token"`
+		} `json:"header"`
+	}
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, integration.ErrInvalidCallbackMessage
+	}
+
+	if event.Type == "url_verification" {
+		if !tokenMatches(a.client.CallbackToken, event.Token) {
+			return nil, integration.ErrInvalidSignature
+		}
+		reply, err := json.Marshal(map[string]string{"challenge": event.Challenge})
+		if err != nil {
+			return nil, err
+		}
+		return &integration.CallbackResult{EventType: event.Type, Reply: reply, ContentType: "application/json"}, nil
+	}
+
+	if !tokenMatches(a.client.CallbackToken, event.Header.Token) {
+		return nil, integration.ErrInvalidSignature
+	}
+	// 配置了 Encrypt Key 后平台总是签名事件请求，缺少签名视为伪造
+	if a.client.CallbackKey != "" && signature == "" {
+		return nil, integration.ErrInvalidSignature
+	}
+
+	return &integration.CallbackResult{
+		EventType:   event.Header.EventType,
+		Attendance:  strings.HasPrefix(event.Header.EventType, "attendance."),
+		Reply:       []byte(`{"msg":"success"}`),
+		ContentType: "application/json",
+	}, nil
 }
 
 // ========== 内部辅助方法 ==========
 
-// getTenantAccessToken 获取飞书 Tenant Access Token
+// apiResult 飞书接口通用应答
+type apiResult struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+func (r *apiResult) result() *apiResult {
+	return r
+}
+
+type resultCarrier interface {
+	result() *apiResult
+}
+
+// callbackSignature 飞书事件签名：SHA256(timestamp + nonce + encryptKey + body)
+func callbackSignature(timestamp, nonce, encryptKey string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(timestamp + nonce + encryptKey))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// decryptEvent 解密飞书事件：密钥为 SHA256(encryptKey)，密文前 16 字节为 IV
+func decryptEvent(encryptKey, encrypted string) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil || len(data) < 2*aes.BlockSize || len(data)%aes.BlockSize != 0 {
+		return nil, integration.ErrInvalidCallbackMessage
+	}
+
+	key := sha256.Sum256([]byte(encryptKey))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	plain := make([]byte, len(data)-aes.BlockSize)
+	cipher.NewCBCDecrypter(block, data[:aes.BlockSize]).CryptBlocks(plain, data[aes.BlockSize:])
+	return integration.PKCS7Unpad(plain)
+}
+
+func tokenMatches(expected, actual string) bool {
+	return expected != "" && subtle.ConstantTimeCompare([]byte(expected), []byte(actual)) == 1
+}
+
+// getTenantAccessToken 获取飞书 Tenant Access Token（缓存，到期前刷新）
 func (a *AttendanceAdapter) getTenantAccessToken(ctx context.Context) (string, error) {
-	url := fmt.Sprintf("%s/open-apis/auth/v3/tenant_access_token/internal", a.baseURL)
+	return a.client.Token(ctx, func(ctx context.Context) (string, time.Duration, error) {
+		var resp struct {
+			apiResult
+			TenantAccessToken string `json:"tenant_access_token"`
+			Expire            int    `json:"expire"`
+		}
+		body := map[string]string{"app_id": a.appID, "app_secret": a.appSecret}
+		err := a.client.Retry(ctx, func() error {
+			if err := a.client.Do(ctx, http.MethodPost, "/open-apis/auth/v3/tenant_access_token/internal", nil, nil, body, &resp); err != nil {
+				return err
+			}
+			return a.checkResult(ctx, &resp.apiResult)
+		})
+		if err != nil {
+			return "", 0, err
+		}
+		return resp.TenantAccessToken, time.Duration(resp.Expire) * time.Second, nil
+	})
+}
+
+// call 调用开放平台接口：限流退避重试，令牌失效时刷新后重试
+func (a *AttendanceAdapter) call(ctx context.Context, method, path string, query url.Values, body interface{}, out resultCarrier) error {
+	return a.client.Retry(ctx, func() error {
+		token, err := a.getTenantAccessToken(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get tenant access token: %w", err)
+		}
+		header := http.Header{"Authorization": {"Bearer " + token}}
+		if err := a.client.Do(ctx, method, path, query, header, body, out); err != nil {
+			return err
+		}
+		return a.checkResult(ctx, out.result())
+	})
+}
+
+// checkResult 解析飞书错误码
+func (a *AttendanceAdapter) checkResult(ctx context.Context, result *apiResult) error {
+	switch result.Code {
+	case 0:
+		return nil
+	case 99991400: // 请求频率超限
+		return &integration.RateLimitError{Platform: model.PlatformFeishu, Message: result.Msg}
+	case 99991663, 99991668: // tenant_access_token 无效或已过期
+		if err := a.client.InvalidateToken(ctx); err != nil {
+			return err
+		}
+		return fmt.Errorf("%w: %s", integration.ErrTokenExpired, result.Msg)
+	default:
+		return &integration.PlatformError{Platform: model.PlatformFeishu, Code: result.Code, Message: result.Msg}
+	}
+}
+
+// pull 拉取全部用户在时间范围内的打卡流水
+func (a *AttendanceAdapter) pull(ctx context.Context, tenantID uuid.UUID, start, end time.Time) ([]*integration.PlatformAttendanceRecord, int, error) {
+	users, err := a.fetchUsers(ctx, rootDepartmentID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch users: %w", err)
+	}
+	userIDs := make([]string, 0, len(users))
+	for _, user := range users {
+		userIDs = append(userIDs, user.UserID)
+	}
+
+	records, err := a.fetchAttendanceRecords(ctx, userIDs, start, end)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch attendance records: %w", err)
+	}
+
+	// 转换为系统内部格式
+	result := make([]*integration.PlatformAttendanceRecord, 0, len(records))
+	for _, record := range records {
+		converted, err := a.convertToAttendanceRecord(record, tenantID)
+		if err != nil {
+			continue
+		}
+		result = append(result, &integration.PlatformAttendanceRecord{PlatformUserID: record.UserID, Record: converted})
+	}
+	return result, len(userIDs), nil
+}
 
-	// TODO: 实现实际的 HTTP 请求
-	_ = url // 避免未使用变量警告
-	return "mock_tenant_access_token", nil
+// fetchAttendanceRecords 获取飞书打卡流水
+// 飞书API: /open-apis/attendance/v1/user_flows/query，单次最多 50 人
+func (a *AttendanceAdapter) fetchAttendanceRecords(ctx context.Context, userIDs []string, start, end time.Time) ([]*FeishuAttendanceRecord, error) {
+	var records []*FeishuAttendanceRecord
+	query := url.Values{"employee_type": {"employee_id"}}
+	for _, window := range integration.SplitWindow(start, end, flowMaxSpan) {
+		for _, batch := range integration.ChunkStrings(userIDs, flowUserBatch) {
+			var resp struct {
+				apiResult
+				Data struct {
+					UserFlowResults []*FeishuAttendanceRecord `json:"user_flow_results"`
+				} `json:"data"`
+			}
+			body := map[string]interface{}{
+				"user_ids":        batch,
+				"check_time_from": strconv.FormatInt(window.Start.Unix(), 10),
+				"check_time_to":   strconv.FormatInt(window.End.Unix(), 10),
+			}
+			if err := a.call(ctx, http.MethodPost, "/open-apis/attendance/v1/user_flows/query", query, body, &resp); err != nil {
+				return nil, err
+			}
+			records = append(records, resp.Data.UserFlowResults...)
+		}
+	}
+	return records, nil
 }
 
-// fetchAttendanceRecords 获取飞书考勤记录
-func (a *AttendanceAdapter) fetchAttendanceRecords(ctx context.Context, token string, req *integration.SyncAttendanceRequest) ([]*FeishuAttendanceRecord, error) {
-	// 飞书API: /open-apis/attendance/v1/user_flows/query
-	// TODO: 实现实际的 HTTP 请求
+// fetchUsers 获取部门及其全部子部门的用户
+// 飞书API: /open-apis/contact/v3/departments/:id/children、/open-apis/contact/v3/users/find_by_department，按 page_token 翻页
+func (a *AttendanceAdapter) fetchUsers(ctx context.Context, deptID string) ([]*FeishuUser, error) {
+	deptIDs := []string{deptID}
+	err := a.paginate(ctx, "/open-apis/contact/v3/departments/"+url.PathEscape(deptID)+"/children",
+		url.Values{"fetch_child": {"true"}, "department_id_type": {"open_department_id"}},
+		func(items json.RawMessage) error {
+			var departments []struct {
+				OpenDepartmentID string `json:"open_department_id"`
+			}
+			if err := json.Unmarshal(items, &departments); err != nil {
+				return err
+			}
+			for _, department := range departments {
+				deptIDs = append(deptIDs, department.OpenDepartmentID)
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
 
-	return []*FeishuAttendanceRecord{}, nil
+	var users []*FeishuUser
+	seen := make(map[string]bool)
+	for _, id := range deptIDs {
+		err := a.paginate(ctx, "/open-apis/contact/v3/users/find_by_department",
+			url.Values{"department_id": {id}, "department_id_type": {"open_department_id"}, "user_id_type": {"user_id"}},
+			func(items json.RawMessage) error {
+				var page []*FeishuUser
+				if err := json.Unmarshal(items, &page); err != nil {
+					return err
+				}
+				for _, user := range page {
+					if !seen[user.UserID] {
+						seen[user.UserID] = true
+						users = append(users, user)
+					}
+				}
+				return nil
+			})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return users, nil
 }
 
-// fetchDepartmentUsers 获取部门用户列表
-func (a *AttendanceAdapter) fetchDepartmentUsers(ctx context.Context, token string, deptID string) ([]*FeishuUser, error) {
-	// 飞书API: /open-apis/contact/v3/users
-	// TODO: 实现实际的 HTTP 请求
+// paginate 按 page_token 翻页调用列表接口
+func (a *AttendanceAdapter) paginate(ctx context.Context, path string, query url.Values, handle func(items json.RawMessage) error) error {
+	pageToken := ""
+	for {
+		params := url.Values{"page_size": {strconv.Itoa(pageSize)}}
+		for key, values := range query {
+			params[key] = values
+		}
+		if pageToken != "" {
+			params.Set("page_token", pageToken)
+		}
 
-	return []*FeishuUser{}, nil
+		var resp struct {
+			apiResult
+			Data struct {
+				HasMore   bool            `json:"has_more"`
+				PageToken string          `json:"page_token"`
+				Items     json.RawMessage `json:"items"`
+			} `json:"data"`
+		}
+		if err := a.call(ctx, http.MethodGet, path, params, nil, &resp); err != nil {
+			return err
+		}
+		if len(resp.Data.Items) > 0 {
+			if err := handle(resp.Data.Items); err != nil {
+				return err
+			}
+		}
+		if !resp.Data.HasMore || resp.Data.PageToken == "" {
+			return nil
+		}
+		pageToken = resp.Data.PageToken
+	}
 }
 
 // convertToAttendanceRecord 转换飞书考勤记录为系统格式
 func (a *AttendanceAdapter) convertToAttendanceRecord(record *FeishuAttendanceRecord, tenantID uuid.UUID) (*model.AttendanceRecord, error) {
-	// 解析打卡类型
+	checkTime := time.Unix(record.CheckTime, 0)
+
+	// 解析打卡类型（打卡流水不区分上下班时按时间判断）
 	var clockType model.AttendanceClockType
 	switch record.CheckType {
 	case "OnDuty":
@@ -175,13 +434,17 @@ func (a *AttendanceAdapter) convertToAttendanceRecord(record *FeishuAttendanceRe
 	case "OffDuty":
 		clockType = model.ClockTypeCheckOut
 	default:
-		clockType = model.ClockTypeCheckIn
+		if checkTime.Hour() < 12 {
+			clockType = model.ClockTypeCheckIn
+		} else {
+			clockType = model.ClockTypeCheckOut
+		}
 	}
 
 	// 解析考勤状态
 	var status model.AttendanceStatus
 	switch record.Result {
-	case "Normal":
+	case "", "Normal":
 		status = model.AttendanceStatusNormal
 	case "Late":
 		status = model.AttendanceStatusLate
@@ -197,14 +460,10 @@ func (a *AttendanceAdapter) convertToAttendanceRecord(record *FeishuAttendanceRe
 
 	// 解析打卡方式
 	var method model.AttendanceMethod
-	switch record.CheckInMethod {
-	case "WiFi":
-		method = model.MethodMobile
-	case "GPS":
-		method = model.MethodMobile
-	case "Face":
+	switch {
+	case record.CheckInMethod == "Face":
 		method = model.MethodFace
-	case "Device":
+	case record.CheckInMethod == "Device" || record.DeviceSN != "":
 		method = model.MethodDevice
 	default:
 		method = model.MethodMobile
@@ -213,13 +472,13 @@ func (a *AttendanceAdapter) convertToAttendanceRecord(record *FeishuAttendanceRe
 	// 构造考勤记录
 	result := &model.AttendanceRecord{
 		TenantID:      tenantID,
-		ClockTime:     time.Unix(record.CheckTime, 0),
+		ClockTime:     checkTime,
 		ClockType:     clockType,
 		Status:        status,
 		CheckInMethod: method,
 		SourceType:    model.SourceTypeFeishu,
 		SourceID:      record.RecordID,
-		IsException:   record.Result != "Normal",
+		IsException:   record.Result != "" && record.Result != "Normal",
 		ExceptionType: record.Result,
 		CreatedAt:     time.Now(),
 		UpdatedAt:     time.Now(),
@@ -254,6 +513,14 @@ func (a *AttendanceAdapter) convertToAttendanceRecord(record *FeishuAttendanceRe
 	return result, nil
 }
 
+func unwrapRecords(records []*integration.PlatformAttendanceRecord) []*model.AttendanceRecord {
+	result := make([]*model.AttendanceRecord, 0, len(records))
+	for _, record := range records {
+		result = append(result, record.Record)
+	}
+	return result
+}
+
 // ========== 飞书数据结构 ==========
 
 // FeishuAttendanceRecord 飞书考勤记录
@@ -262,7 +529,7 @@ type FeishuAttendanceRecord struct {
 	UserID          string   `json:"user_id"`
 	CreatorID       string   `json:"creator_id"`
 	LocationName    string   `json:"location_name"`
-	CheckTime       int64    `json:"check_time"`
+	CheckTime       int64    `json:"check_time,string"` // Unix 秒（字符串）
 	Comment         string   `json:"comment"`
 	RecordType      string   `json:"record_type"`
 	CheckType       string   `json:"check_type"`      // OnDuty, OffDuty
@@ -0,0 +1,162 @@
+package feishu
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/integration"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeFeishu 飞书开放平台替身：子部门分两页，打卡流水首次返回频率超限
+type fakeFeishu struct {
+	tokenCalls int32
+	flowCalls  int32
+	flowUsers  []interface{}
+}
+
+func (f *fakeFeishu) handler(t *testing.T, checkTime time.Time) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/open-apis/auth/v3/tenant_access_token/internal", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&f.tokenCalls, 1)
+		writeJSON(w, map[string]interface{}{"code": 0, "tenant_access_token": "t-token", "expire": 7200})
+	})
+	mux.HandleFunc("/open-apis/contact/v3/departments/0/children", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer t-token", r.Header.Get("Authorization"))
+		if r.URL.Query().Get("page_token") == "" {
+			writeJSON(w, listPage(true, "p2", []map[string]interface{}{{"open_department_id": "od-1"}}))
+			return
+		}
+		writeJSON(w, listPage(false, "", []map[string]interface{}{{"open_department_id": "od-2"}}))
+	})
+	mux.HandleFunc("/open-apis/contact/v3/users/find_by_department", func(w http.ResponseWriter, r *http.Request) {
+		users := map[string][]map[string]interface{}{
+			"0":    {{"user_id": "u1", "name": "张三"}},
+			"od-1": {{"user_id": "u1", "name": "张三"}, {"user_id": "u2", "name": "李四"}},
+			"od-2": {},
+		}
+		writeJSON(w, listPage(false, "", users[r.URL.Query().Get("department_id")]))
+	})
+	mux.HandleFunc("/open-apis/attendance/v1/user_flows/query", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&f.flowCalls, 1) == 1 {
+			writeJSON(w, map[string]interface{}{"code": 99991400, "msg": "request trigger frequency limit"})
+			return
+		}
+		assert.Equal(t, "employee_id", r.URL.Query().Get("employee_type"))
+		body := map[string]interface{}{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		f.flowUsers = body["user_ids"].([]interface{})
+		writeJSON(w, map[string]interface{}{"code": 0, "data": map[string]interface{}{"user_flow_results": []map[string]interface{}{
+			{"record_id": "r1", "user_id": "u2", "check_time": strconv.FormatInt(checkTime.Unix(), 10), "location_name": "园区", "latitude": 22.5, "longitude": 113.9},
+		}}})
+	})
+	return mux
+}
+
+func listPage(hasMore bool, pageToken string, items interface{}) map[string]interface{} {
+	return map[string]interface{}{"code": 0, "data": map[string]interface{}{"has_more": hasMore, "page_token": pageToken, "items": items}}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func TestPullAttendance(t *testing.T) {
+	ctx := context.Background()
+	checkTime := time.Date(2025, 3, 3, 18, 30, 0, 0, time.Local)
+	fake := &fakeFeishu{}
+	server := httptest.NewServer(fake.handler(t, checkTime))
+	defer server.Close()
+
+	adapter := NewAttendanceAdapter("cli_app", "secret", integration.WithBaseURL(server.URL), integration.WithRetry(2, time.Millisecond))
+
+	since := time.Date(2025, 3, 3, 0, 0, 0, 0, time.Local)
+	result, err := adapter.PullAttendance(ctx, &integration.PullAttendanceRequest{TenantID: uuid.New(), Since: since, Until: since.Add(24 * time.Hour)})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, result.Users)
+	assert.Equal(t, []interface{}{"u1", "u2"}, fake.flowUsers)
+	assert.Equal(t, int32(2), fake.flowCalls)
+	assert.Equal(t, int32(1), fake.tokenCalls)
+
+	require.Len(t, result.Records, 1)
+	record := result.Records[0].Record
+	assert.Equal(t, "u2", result.Records[0].PlatformUserID)
+	assert.Equal(t, "r1", record.SourceID)
+	assert.Equal(t, model.SourceTypeFeishu, record.SourceType)
+	assert.True(t, record.ClockTime.Equal(checkTime))
+	assert.Equal(t, model.ClockTypeCheckOut, record.ClockType)
+	assert.Equal(t, model.AttendanceStatusNormal, record.Status)
+	assert.False(t, record.IsException)
+	assert.Equal(t, "园区", record.Address)
+}
+
+func encryptEvent(t *testing.T, encryptKey string, plain []byte) string {
+	key := sha256.Sum256([]byte(encryptKey))
+	block, err := aes.NewCipher(key[:])
+	require.NoError(t, err)
+
+	padding := aes.BlockSize - len(plain)%aes.BlockSize
+	plain = append(plain, bytes.Repeat([]byte{byte(padding)}, padding)...)
+	iv := []byte("0123456789abcdef")
+	out := make([]byte, len(plain))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(out, plain)
+	return base64.StdEncoding.EncodeToString(append(iv, out...))
+}
+
+func TestHandleCallback(t *testing.T) {
+	ctx := context.Background()
+	adapter := NewAttendanceAdapter("cli_app", "secret", integration.WithCallback("verify-token", "encrypt-key"))
+
+	t.Run("url verification", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]string{
+			"encrypt": encryptEvent(t, "encrypt-key", []byte(`{"type":"url_verification","challenge":"c-1","token":"verify-token"}`)),
+		})
+		result, err := adapter.HandleCallback(ctx, &integration.CallbackRequest{Method: http.MethodPost, Header: http.Header{}, Body: body})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"challenge":"c-1"}`, string(result.Reply))
+	})
+
+	event, _ := json.Marshal(map[string]string{
+		"encrypt": encryptEvent(t, "encrypt-key", []byte(`{"schema":"2.0","header":{"event_type":"attendance.user_flow.created_v1","token":"verify-token"}}`)),
+	})
+
+	t.Run("signed event", func(t *testing.T) {
+		header := http.Header{}
+		header.Set("X-Lark-Request-Timestamp", "1700000000")
+		header.Set("X-Lark-Request-Nonce", "n1")
+		header.Set("X-Lark-Signature", callbackSignature("1700000000", "n1", "encrypt-key", event))
+
+		result, err := adapter.HandleCallback(ctx, &integration.CallbackRequest{Method: http.MethodPost, Header: header, Body: event})
+		require.NoError(t, err)
+		assert.Equal(t, "attendance.user_flow.created_v1", result.EventType)
+		assert.True(t, result.Attendance)
+	})
+
+	t.Run("unsigned or tampered event", func(t *testing.T) {
+		_, err := adapter.HandleCallback(ctx, &integration.CallbackRequest{Method: http.MethodPost, Header: http.Header{}, Body: event})
+		assert.ErrorIs(t, err, integration.ErrInvalidSignature)
+
+		header := http.Header{}
+		header.Set("X-Lark-Request-Timestamp", "1700000000")
+		header.Set("X-Lark-Request-Nonce", "n1")
+		header.Set("X-Lark-Signature", callbackSignature("1700000001", "n1", "encrypt-key", event))
+		_, err = adapter.HandleCallback(ctx, &integration.CallbackRequest{Method: http.MethodPost, Header: header, Body: event})
+		assert.ErrorIs(t, err, integration.ErrInvalidSignature)
+	})
+}
@@ -0,0 +1,268 @@
+package integration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/pkg/cache"
+)
+
+const (
+	defaultPlatformTimeout  = 15 * time.Second
+	defaultPlatformRetries  = 3
+	defaultPlatformBackoff  = time.Second
+	maxPlatformBackoff      = 30 * time.Second
+	maxPlatformResponseSize = 8 << 20
+)
+
+var (
+	ErrRateLimited      = errors.New("platform rate limited")
+	ErrTokenExpired     = errors.New("platform access token expired")
+	ErrInvalidSignature = errors.New("invalid callback signature")
+)
+
+// PlatformError 平台接口返回的业务错误
+type PlatformError struct {
+	Platform model.PlatformType
+	Code     int
+	Message  string
+}
+
+func (e *PlatformError) Error() string {
+	return fmt.Sprintf("%s api error %d: %s", e.Platform, e.Code, e.Message)
+}
+
+// RateLimitError 平台限流，RetryAfter 为平台建议的等待时间（未提供时为 0）
+type RateLimitError struct {
+	Platform   model.PlatformType
+	RetryAfter time.Duration
+	Message    string
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("%s rate limited: %s", e.Platform, e.Message)
+}
+
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// ClientOption 平台客户端选项
+type ClientOption func(*PlatformClient)
+
+// WithBaseURL 指定接口地址（私有化部署或测试替身）
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *PlatformClient) {
+		c.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithHTTPClient 指定 HTTP 客户端
+func WithHTTPClient(client *http.Client) ClientOption {
+	return func(c *PlatformClient) {
+		c.http = client
+	}
+}
+
+// WithTokenCache 指定令牌缓存，多实例部署时应使用 Redis 存储共享令牌
+func WithTokenCache(tokens *cache.TokenCache) ClientOption {
+	return func(c *PlatformClient) {
+		c.tokens = tokens
+	}
+}
+
+// WithRetry 指定限流重试次数和初始退避时间（按次数翻倍）
+func WithRetry(maxRetries int, backoff time.Duration) ClientOption {
+	return func(c *PlatformClient) {
+		c.maxRetries = maxRetries
+		c.backoff = backoff
+	}
+}
+
+// WithCallback 指定回调校验参数：token 用于签名，aesKey 用于解密（企微/钉钉为 EncodingAESKey，飞书为 Encrypt Key）
+func WithCallback(token, aesKey string) ClientOption {
+	return func(c *PlatformClient) {
+		c.CallbackToken = token
+		c.CallbackKey = aesKey
+	}
+}
+
+// PlatformClient 第三方平台 HTTP 客户端：令牌缓存、限流退避和 JSON 编解码，
+// 各平台适配器在此基础上处理自己的应答格式和错误码
+type PlatformClient struct {
+	platform   model.PlatformType
+	appKey     string
+	baseURL    string
+	http       *http.Client
+	tokens     *cache.TokenCache
+	maxRetries int
+	backoff    time.Duration
+
+	CallbackToken string
+	CallbackKey   string
+}
+
+// NewPlatformClient 创建平台客户端，appKey 用于区分令牌缓存
+func NewPlatformClient(platform model.PlatformType, appKey, baseURL string, opts ...ClientOption) *PlatformClient {
+	c := &PlatformClient{
+		platform:   platform,
+		appKey:     appKey,
+		baseURL:    baseURL,
+		maxRetries: defaultPlatformRetries,
+		backoff:    defaultPlatformBackoff,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.http == nil {
+		c.http = &http.Client{Timeout: defaultPlatformTimeout}
+	}
+	if c.tokens == nil {
+		c.tokens = cache.NewTokenCache(cache.NewMemoryTokenStore())
+	}
+	return c
+}
+
+// Platform 平台类型
+func (c *PlatformClient) Platform() model.PlatformType {
+	return c.platform
+}
+
+// Token 获取缓存的访问令牌，过期时调用 fetch 重新申请
+func (c *PlatformClient) Token(ctx context.Context, fetch cache.TokenFetcher) (string, error) {
+	return c.tokens.Get(ctx, c.tokenKey(), fetch)
+}
+
+// InvalidateToken 平台提示令牌失效时清除缓存
+func (c *PlatformClient) InvalidateToken(ctx context.Context) error {
+	return c.tokens.Invalidate(ctx, c.tokenKey())
+}
+
+func (c *PlatformClient) tokenKey() string {
+	return fmt.Sprintf("hrm:platform_token:%s:%s", c.platform, c.appKey)
+}
+
+// Do 发送请求并把 JSON 应答解码到 out；HTTP 429 返回 *RateLimitError，其他非 2xx 返回 *PlatformError
+func (c *PlatformClient) Do(ctx context.Context, method, path string, query url.Values, header http.Header, body, out interface{}) error {
+	endpoint := c.baseURL + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reader)
+	if err != nil {
+		return err
+	}
+	for key, values := range header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s request %s failed: %w", c.platform, path, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxPlatformResponseSize))
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return &RateLimitError{
+			Platform:   c.platform,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+			Message:    strings.TrimSpace(string(data)),
+		}
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		// 飞书等平台在 4xx 应答中也会返回业务错误码，交给调用方解析
+		if out != nil && json.Unmarshal(data, out) == nil {
+			return nil
+		}
+		return &PlatformError{Platform: c.platform, Code: resp.StatusCode, Message: strings.TrimSpace(string(data))}
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("%s decode %s response: %w", c.platform, path, err)
+	}
+	return nil
+}
+
+// Retry 执行 fn：限流时按指数退避重试，令牌失效时立即重试（调用方已清除缓存）
+func (c *PlatformClient) Retry(ctx context.Context, fn func() error) error {
+	var err error
+	for attempt := 0; ; attempt++ {
+		err = fn()
+		if err == nil || attempt >= c.maxRetries {
+			return err
+		}
+
+		var wait time.Duration
+		var rateLimited *RateLimitError
+		switch {
+		case errors.As(err, &rateLimited):
+			wait = rateLimited.RetryAfter
+			if wait <= 0 {
+				wait = c.backoff << attempt
+			}
+			if wait > maxPlatformBackoff {
+				wait = maxPlatformBackoff
+			}
+		case errors.Is(err, ErrRateLimited):
+			wait = c.backoff << attempt
+		case errors.Is(err, ErrTokenExpired):
+			wait = 0
+		default:
+			return err
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return time.Until(at)
+	}
+	return 0
+}
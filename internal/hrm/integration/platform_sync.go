@@ -0,0 +1,113 @@
+package integration
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+)
+
+// CursorOverlap 增量拉取时游标回退的时长，补上平台延迟入库的打卡（重复记录按来源ID去重）
+const CursorOverlap = 10 * time.Minute
+
+// PullAttendanceRequest 增量拉取考勤记录请求
+type PullAttendanceRequest struct {
+	TenantID uuid.UUID
+	Cursor   string    // 上次拉取返回的 NextCursor，为空表示首次同步
+	Since    time.Time // 首次同步的起点
+	Until    time.Time // 拉取截止时间
+}
+
+// Window 计算本次拉取的时间窗口
+func (r *PullAttendanceRequest) Window() (time.Time, time.Time) {
+	start := r.Since
+	if cursor, ok := ParseTimeCursor(r.Cursor); ok {
+		start = cursor.Add(-CursorOverlap)
+	}
+	if start.After(r.Until) {
+		start = r.Until
+	}
+	return start, r.Until
+}
+
+// PullAttendanceResult 增量拉取结果
+type PullAttendanceResult struct {
+	Records    []*PlatformAttendanceRecord
+	Users      int    // 拉取的平台用户数
+	NextCursor string // 下次拉取的游标，由调用方保存到集成配置
+}
+
+// PlatformAttendanceRecord 平台打卡记录，PlatformUserID 用于匹配员工同步映射
+type PlatformAttendanceRecord struct {
+	PlatformUserID string
+	Record         *model.AttendanceRecord
+}
+
+// FormatTimeCursor 以 Unix 秒表示的时间游标
+func FormatTimeCursor(t time.Time) string {
+	return strconv.FormatInt(t.Unix(), 10)
+}
+
+// ParseTimeCursor 解析时间游标，空值或格式错误返回 false
+func ParseTimeCursor(cursor string) (time.Time, bool) {
+	if cursor == "" {
+		return time.Time{}, false
+	}
+	seconds, err := strconv.ParseInt(cursor, 10, 64)
+	if err != nil || seconds <= 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(seconds, 0), true
+}
+
+// TimeWindow 时间窗口
+type TimeWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
+// SplitWindow 按平台单次查询的最大跨度切分时间窗口
+func SplitWindow(start, end time.Time, maxSpan time.Duration) []TimeWindow {
+	var windows []TimeWindow
+	for start.Before(end) {
+		next := start.Add(maxSpan)
+		if next.After(end) {
+			next = end
+		}
+		windows = append(windows, TimeWindow{Start: start, End: next})
+		start = next
+	}
+	return windows
+}
+
+// ChunkStrings 按平台接口的单次用户数上限分批
+func ChunkStrings(values []string, size int) [][]string {
+	var chunks [][]string
+	for len(values) > size {
+		chunks = append(chunks, values[:size])
+		values = values[size:]
+	}
+	if len(values) > 0 {
+		chunks = append(chunks, values)
+	}
+	return chunks
+}
+
+// CallbackRequest 平台事件回调请求
+type CallbackRequest struct {
+	Method string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// CallbackResult 回调解析结果
+type CallbackResult struct {
+	EventType   string
+	Attendance  bool   // 打卡相关事件，需要触发增量拉取
+	Reply       []byte // 应答平台的内容（URL 校验的明文、加密的 success 等）
+	ContentType string
+}
@@ -0,0 +1,217 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/pkg/workflow"
+)
+
+// ShiftSwapWorkflowEngine 换班工作流引擎
+type ShiftSwapWorkflowEngine struct {
+	engine *workflow.Engine
+}
+
+// 换班审批流程的节点类型，带 shift_swap. 前缀，避免占用共享引擎上其他流程使用的通用类型名
+const (
+	shiftSwapNodeStart      = "shift_swap.start"
+	shiftSwapNodeValidation = "shift_swap.validation"
+	shiftSwapNodeApproval   = "shift_swap.approval"
+	shiftSwapNodeExchange   = "shift_swap.exchange_schedule"
+	shiftSwapNodeNotify     = "shift_swap.notification"
+	shiftSwapNodeEnd        = "shift_swap.end"
+)
+
+var shiftSwapNodeTypes = []string{
+	shiftSwapNodeStart, shiftSwapNodeValidation, shiftSwapNodeApproval,
+	shiftSwapNodeExchange, shiftSwapNodeNotify, shiftSwapNodeEnd,
+}
+
+// NewShiftSwapWorkflowEngine 创建换班工作流引擎
+// 校验、交换排班和通知由换班服务完成，流程节点只记录进度
+func NewShiftSwapWorkflowEngine(engine *workflow.Engine) (*ShiftSwapWorkflowEngine, error) {
+	if engine != nil {
+		for _, nodeType := range shiftSwapNodeTypes {
+			if err := engine.RegisterNodeType(nodeType, newPassThroughNode); err != nil {
+				return nil, fmt.Errorf("failed to register shift swap node type: %w", err)
+			}
+		}
+	}
+	return &ShiftSwapWorkflowEngine{
+		engine: engine,
+	}, nil
+}
+
+// passThroughNode 原样输出输入的流程节点
+type passThroughNode struct {
+	*workflow.BaseNode
+}
+
+func newPassThroughNode(def *workflow.NodeDefinition) (workflow.Node, error) {
+	return &passThroughNode{BaseNode: workflow.NewBaseNode(def.ID, def.Name, def.Type, def.Config)}, nil
+}
+
+// Execute 不做处理，直接传递输入
+func (n *passThroughNode) Execute(ctx context.Context, input map[string]interface{}) (map[string]interface{}, error) {
+	return input, nil
+}
+
+// CreateShiftSwapApprovalWorkflow 创建换班审批工作流
+// 对方确认后才会进入本流程，因此只需排班主管一级审批
+func (e *ShiftSwapWorkflowEngine) CreateShiftSwapApprovalWorkflow(tenantID uuid.UUID) (*workflow.WorkflowDefinition, error) {
+	workflowID := fmt.Sprintf("shift-swap-approval-%s", tenantID.String())
+
+	nodes := make([]*workflow.NodeDefinition, 0)
+	edges := make([]*workflow.Edge, 0)
+
+	// 1. 开始节点
+	nodes = append(nodes, &workflow.NodeDefinition{
+		ID:   "start",
+		Name: "开始",
+		Type: shiftSwapNodeStart,
+	})
+
+	// 2. 验证节点
+	nodes = append(nodes, &workflow.NodeDefinition{
+		ID:   "validation",
+		Name: "验证换班申请",
+		Type: shiftSwapNodeValidation,
+		Config: map[string]interface{}{
+			"rules": []string{
+				"check_peer_accepted",   // 对方已同意
+				"check_leave_conflict",  // 接班人无请假
+				"check_trip_conflict",   // 接班人无出差
+				"check_schedule_change", // 排班未被改动
+			},
+		},
+	})
+	edges = append(edges, &workflow.Edge{Source: "start", Target: "validation"})
+
+	// 3. 主管审批
+	nodes = append(nodes, &workflow.NodeDefinition{
+		ID:   "approval-dept_manager",
+		Name: "排班主管审批",
+		Type: shiftSwapNodeApproval,
+		Config: map[string]interface{}{
+			"approver_type": "dept_manager",
+			"timeout":       "24h",
+		},
+		Timeout: 24 * time.Hour,
+	})
+	edges = append(edges, &workflow.Edge{Source: "validation", Target: "approval-dept_manager"})
+
+	// 4. 交换排班
+	nodes = append(nodes, &workflow.NodeDefinition{
+		ID:   "exchange",
+		Name: "交换排班",
+		Type: shiftSwapNodeExchange,
+	})
+	edges = append(edges, &workflow.Edge{Source: "approval-dept_manager", Target: "exchange"})
+
+	// 5. 通知双方
+	nodes = append(nodes, &workflow.NodeDefinition{
+		ID:   "notify",
+		Name: "发送通知",
+		Type: shiftSwapNodeNotify,
+		Config: map[string]interface{}{
+			"notify_requester": true,
+			"notify_target":    true,
+		},
+	})
+	edges = append(edges, &workflow.Edge{Source: "exchange", Target: "notify"})
+
+	// 6. 结束节点
+	nodes = append(nodes, &workflow.NodeDefinition{
+		ID:   "end",
+		Name: "结束",
+		Type: shiftSwapNodeEnd,
+	})
+	edges = append(edges, &workflow.Edge{Source: "notify", Target: "end"})
+
+	return &workflow.WorkflowDefinition{
+		ID:          workflowID,
+		Name:        "换班审批流程",
+		Description: "员工互换或代班，对方确认后由排班主管审批",
+		Version:     1,
+		Status:      workflow.WorkflowStatusActive,
+		Nodes:       nodes,
+		Edges:       edges,
+	}, nil
+}
+
+// ExecuteShiftSwapApproval 执行换班审批
+func (e *ShiftSwapWorkflowEngine) ExecuteShiftSwapApproval(
+	ctx context.Context,
+	request *model.ShiftSwapRequest,
+	submitterID string,
+) (string, error) {
+	workflowDef, err := e.CreateShiftSwapApprovalWorkflow(request.TenantID)
+	if err != nil {
+		return "", fmt.Errorf("failed to create workflow definition: %w", err)
+	}
+
+	// 注册工作流（如果尚未注册）
+	if err := e.engine.CreateWorkflow(workflowDef); err != nil && err != workflow.ErrWorkflowAlreadyExists {
+		return "", fmt.Errorf("failed to create workflow: %w", err)
+	}
+
+	params := map[string]interface{}{
+		"swap_request_id":       request.ID.String(),
+		"tenant_id":             request.TenantID.String(),
+		"swap_type":             string(request.Type),
+		"requester_id":          request.RequesterID.String(),
+		"requester_schedule_id": request.RequesterScheduleID.String(),
+		"requester_date":        request.RequesterDate.Format("2006-01-02"),
+		"target_employee_id":    request.TargetEmployeeID.String(),
+		"reason":                request.Reason,
+	}
+	if request.TargetScheduleID != nil {
+		params["target_schedule_id"] = request.TargetScheduleID.String()
+		params["target_date"] = request.TargetDate.Format("2006-01-02")
+	}
+
+	// 流程异步执行，不随请求结束而取消
+	executionID, err := e.engine.Execute(context.WithoutCancel(ctx), workflowDef.ID, params, submitterID)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute workflow: %w", err)
+	}
+
+	return executionID, nil
+}
+
+// HandleApproval 处理主管审批操作
+func (e *ShiftSwapWorkflowEngine) HandleApproval(
+	executionID string,
+	approved bool,
+	approverID uuid.UUID,
+	comment string,
+) error {
+	execCtx, err := e.engine.GetExecution(executionID)
+	if err != nil {
+		return fmt.Errorf("failed to get execution context: %w", err)
+	}
+
+	nodeState := execCtx.NodeStates["approval-dept_manager"]
+	if nodeState == nil {
+		return fmt.Errorf("node state not found: approval-dept_manager")
+	}
+
+	nodeState.Output = map[string]interface{}{
+		"approved":    approved,
+		"approver_id": approverID.String(),
+		"comment":     comment,
+		"approved_at": time.Now(),
+	}
+
+	if approved {
+		nodeState.Status = workflow.NodeStatusCompleted
+		return nil
+	}
+
+	nodeState.Status = workflow.NodeStatusFailed
+	nodeState.Error = "审批被拒绝"
+	return e.engine.CancelExecution(executionID)
+}
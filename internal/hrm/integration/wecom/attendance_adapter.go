@@ -3,7 +3,11 @@ package wecom
 import (
 	"context"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,64 +15,61 @@ import (
 	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
 )
 
+const (
+	// rootDepartmentID 企业微信根部门ID
+	rootDepartmentID = "1"
+
+	// userPageSize 成员ID列表分页大小
+	userPageSize = 1000
+
+	// checkinUserBatch 打卡数据接口单次用户数上限
+	checkinUserBatch = 100
+
+	// checkinMaxSpan 打卡数据接口单次查询跨度上限
+	checkinMaxSpan = 30 * 24 * time.Hour
+
+	// checkinDataTypeAll 获取全部打卡（上下班、外出）
+	checkinDataTypeAll = 3
+)
+
 // AttendanceAdapter 企业微信考勤适配器
 type AttendanceAdapter struct {
 	corpID     string
 	corpSecret string
-	baseURL    string
+	client     *integration.PlatformClient
 }
 
 // NewAttendanceAdapter 创建企业微信考勤适配器
-func NewAttendanceAdapter(corpID, corpSecret string) integration.AttendanceIntegration {
+func NewAttendanceAdapter(corpID, corpSecret string, opts ...integration.ClientOption) integration.AttendanceIntegration {
 	return &AttendanceAdapter{
 		corpID:     corpID,
 		corpSecret: corpSecret,
-		baseURL:    "https://qyapi.weixin.qq.com",
+		client:     integration.NewPlatformClient(model.PlatformWeCom, corpID, "https://qyapi.weixin.qq.com", opts...),
 	}
 }
 
 // SyncAttendanceRecords 同步考勤记录
 func (a *AttendanceAdapter) SyncAttendanceRecords(ctx context.Context, req *integration.SyncAttendanceRequest) ([]*model.AttendanceRecord, error) {
-	// 1. 获取 Access Token
-	token, err := a.getAccessToken(ctx)
+	records, _, err := a.pull(ctx, req.TenantID, req.StartDate, req.EndDate)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get access token: %w", err)
-	}
-
-	// 2. 调用企业微信考勤记录API
-	records, err := a.fetchAttendanceRecords(ctx, token, req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch attendance records: %w", err)
-	}
-
-	// 3. 转换为系统内部格式
-	result := make([]*model.AttendanceRecord, 0, len(records))
-	for _, record := range records {
-		converted, err := a.convertToAttendanceRecord(record, req.TenantID)
-		if err != nil {
-			continue
-		}
-		result = append(result, converted)
+		return nil, err
 	}
-
-	return result, nil
+	return unwrapRecords(records), nil
 }
 
 // SyncEmployees 同步员工信息
 func (a *AttendanceAdapter) SyncEmployees(ctx context.Context, req *integration.SyncEmployeeRequest) ([]*model.EmployeeSyncMapping, error) {
-	// 1. 获取 Access Token
-	token, err := a.getAccessToken(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get access token: %w", err)
+	deptID := req.DepartmentID
+	if deptID == "" {
+		deptID = rootDepartmentID
 	}
 
-	// 2. 获取部门用户列表
-	users, err := a.fetchDepartmentUsers(ctx, token, req.DepartmentID)
+	users, err := a.fetchDepartmentUsers(ctx, deptID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch users: %w", err)
 	}
 
-	// 3. 转换为员工同步映射
+	// 转换为员工同步映射
 	result := make([]*model.EmployeeSyncMapping, 0, len(users))
 	for _, user := range users {
 		mapping := &model.EmployeeSyncMapping{
@@ -95,127 +96,309 @@ func (a *AttendanceAdapter) PushAttendanceRule(ctx context.Context, req *integra
 
 // GetEmployeeAttendance 获取员工考勤数据
 func (a *AttendanceAdapter) GetEmployeeAttendance(ctx context.Context, req *integration.GetEmployeeAttendanceRequest) ([]*model.AttendanceRecord, error) {
-	// 1. 获取 Access Token
-	token, err := a.getAccessToken(ctx)
+	records, _, err := a.pull(ctx, req.TenantID, req.StartDate, req.EndDate)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get access token: %w", err)
-	}
-
-	// 2. 构造请求参数
-	syncReq := &integration.SyncAttendanceRequest{
-		TenantID:   req.TenantID,
-		EmployeeID: req.EmployeeID,
-		StartDate:  req.StartDate,
-		EndDate:    req.EndDate,
+		return nil, err
 	}
+	return unwrapRecords(records), nil
+}
 
-	// 3. 调用企业微信API获取考勤记录
-	records, err := a.fetchAttendanceRecords(ctx, token, syncReq)
+// PullAttendance 增量拉取考勤记录
+func (a *AttendanceAdapter) PullAttendance(ctx context.Context, req *integration.PullAttendanceRequest) (*integration.PullAttendanceResult, error) {
+	start, end := req.Window()
+	records, users, err := a.pull(ctx, req.TenantID, start, end)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch attendance records: %w", err)
+		return nil, err
 	}
+	return &integration.PullAttendanceResult{
+		Records:    records,
+		Users:      users,
+		NextCursor: integration.FormatTimeCursor(end),
+	}, nil
+}
 
-	// 4. 转换为系统内部格式
-	result := make([]*model.AttendanceRecord, 0, len(records))
-	for _, record := range records {
-		converted, err := a.convertToAttendanceRecord(record, req.TenantID)
+// HandleCallback 处理企业微信回调：GET 为 URL 校验（应答解密后的 echostr），POST 为加密的 XML 事件
+func (a *AttendanceAdapter) HandleCallback(ctx context.Context, req *integration.CallbackRequest) (*integration.CallbackResult, error) {
+	timestamp, nonce := req.Query.Get("timestamp"), req.Query.Get("nonce")
+	signature := req.Query.Get("msg_signature")
+
+	if req.Method == http.MethodGet {
+		echo := req.Query.Get("echostr")
+		plain, err := a.decrypt(timestamp, nonce, echo, signature)
 		if err != nil {
-			continue
+			return nil, err
 		}
-		result = append(result, converted)
+		return &integration.CallbackResult{EventType: "verify_url", Reply: plain, ContentType: "text/plain"}, nil
 	}
 
-	return result, nil
+	var envelope struct {
+		Encrypt string `xml:"Encrypt"`
+	}
+	if err := xml.Unmarshal(req.Body, &envelope); err != nil || envelope.Encrypt == "" {
+		return nil, integration.ErrInvalidCallbackMessage
+	}
+	plain, err := a.decrypt(timestamp, nonce, envelope.Encrypt, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	var event struct {
+		MsgType string `xml:"MsgType"`
+		Event   string `xml:"Event"`
+	}
+	if err := xml.Unmarshal(plain, &event); err != nil {
+		return nil, integration.ErrInvalidCallbackMessage
+	}
+
+	return &integration.CallbackResult{
+		EventType:   event.Event,
+		Attendance:  strings.Contains(strings.ToLower(event.Event), "checkin"),
+		Reply:       []byte("success"),
+		ContentType: "text/plain",
+	}, nil
 }
 
 // ========== 内部辅助方法 ==========
 
-// getAccessToken 获取企业微信 Access Token
+// apiResult 企业微信接口通用应答
+type apiResult struct {
+	ErrCode int    `json:"errcode"`
+	ErrMsg  string `json:"errmsg"`
+}
+
+func (r *apiResult) result() *apiResult {
+	return r
+}
+
+type resultCarrier interface {
+	result() *apiResult
+}
+
+// decrypt 校验签名并解密回调消息，receiveID 必须是本企业的 CorpID
+func (a *AttendanceAdapter) decrypt(timestamp, nonce, encrypted, signature string) ([]byte, error) {
+	if !integration.VerifyMsgSignature(a.client.CallbackToken, timestamp, nonce, encrypted, signature) {
+		return nil, integration.ErrInvalidSignature
+	}
+	plain, receiveID, err := integration.DecryptMessage(a.client.CallbackKey, encrypted)
+	if err != nil {
+		return nil, err
+	}
+	if receiveID != a.corpID {
+		return nil, integration.ErrInvalidSignature
+	}
+	return plain, nil
+}
+
+// getAccessToken 获取企业微信 Access Token（缓存，到期前刷新）
 func (a *AttendanceAdapter) getAccessToken(ctx context.Context) (string, error) {
-	url := fmt.Sprintf("%s/cgi-bin/gettoken?corpid=%s&corpsecret=%s", a.baseURL, a.corpID, a.corpSecret)
+	return a.client.Token(ctx, func(ctx context.Context) (string, time.Duration, error) {
+		var resp struct {
+			apiResult
+			AccessToken string `json:"access_token"`
+			ExpiresIn   int    `json:"expires_in"`
+		}
+		query := url.Values{"corpid": {a.corpID}, "corpsecret": {a.corpSecret}}
+		err := a.client.Retry(ctx, func() error {
+			if err := a.client.Do(ctx, http.MethodGet, "/cgi-bin/gettoken", query, nil, nil, &resp); err != nil {
+				return err
+			}
+			return a.checkResult(ctx, &resp.apiResult)
+		})
+		if err != nil {
+			return "", 0, err
+		}
+		return resp.AccessToken, time.Duration(resp.ExpiresIn) * time.Second, nil
+	})
+}
 
-	// TODO: 实现实际的 HTTP 请求
-	_ = url // 避免未使用变量警告
-	return "mock_access_token", nil
+// call 调用需要 access_token 的接口：限流退避重试，令牌失效时刷新后重试
+func (a *AttendanceAdapter) call(ctx context.Context, method, path string, query url.Values, body interface{}, out resultCarrier) error {
+	return a.client.Retry(ctx, func() error {
+		token, err := a.getAccessToken(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get access token: %w", err)
+		}
+		params := url.Values{"access_token": {token}}
+		for key, values := range query {
+			params[key] = values
+		}
+		if err := a.client.Do(ctx, method, path, params, nil, body, out); err != nil {
+			return err
+		}
+		return a.checkResult(ctx, out.result())
+	})
 }
 
-// fetchAttendanceRecords 获取企业微信考勤记录
-func (a *AttendanceAdapter) fetchAttendanceRecords(ctx context.Context, token string, req *integration.SyncAttendanceRequest) ([]*WeComAttendanceRecord, error) {
-	// 企业微信API: /cgi-bin/checkin/getcheckindata
-	// TODO: 实现实际的 HTTP 请求
+// checkResult 解析企业微信错误码
+func (a *AttendanceAdapter) checkResult(ctx context.Context, result *apiResult) error {
+	switch result.ErrCode {
+	case 0:
+		return nil
+	case 45009, 45033: // 调用频率、并发超限
+		return &integration.RateLimitError{Platform: model.PlatformWeCom, Message: result.ErrMsg}
+	case 40014, 42001: // access_token 不合法或已过期
+		if err := a.client.InvalidateToken(ctx); err != nil {
+			return err
+		}
+		return fmt.Errorf("%w: %s", integration.ErrTokenExpired, result.ErrMsg)
+	default:
+		return &integration.PlatformError{Platform: model.PlatformWeCom, Code: result.ErrCode, Message: result.ErrMsg}
+	}
+}
+
+// pull 拉取全部成员在时间范围内的打卡记录
+func (a *AttendanceAdapter) pull(ctx context.Context, tenantID uuid.UUID, start, end time.Time) ([]*integration.PlatformAttendanceRecord, int, error) {
+	userIDs, err := a.fetchUserIDs(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch users: %w", err)
+	}
 
-	return []*WeComAttendanceRecord{}, nil
+	records, err := a.fetchAttendanceRecords(ctx, userIDs, start, end)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch attendance records: %w", err)
+	}
+
+	// 转换为系统内部格式
+	result := make([]*integration.PlatformAttendanceRecord, 0, len(records))
+	for _, record := range records {
+		converted, err := a.convertToAttendanceRecord(record, tenantID)
+		if err != nil {
+			continue
+		}
+		result = append(result, &integration.PlatformAttendanceRecord{PlatformUserID: record.UserID, Record: converted})
+	}
+	return result, len(userIDs), nil
+}
+
+// fetchAttendanceRecords 获取企业微信打卡数据
+// 企业微信API: /cgi-bin/checkin/getcheckindata，单次最多 100 人、30 天
+func (a *AttendanceAdapter) fetchAttendanceRecords(ctx context.Context, userIDs []string, start, end time.Time) ([]*WeComAttendanceRecord, error) {
+	var records []*WeComAttendanceRecord
+	for _, window := range integration.SplitWindow(start, end, checkinMaxSpan) {
+		for _, batch := range integration.ChunkStrings(userIDs, checkinUserBatch) {
+			var resp struct {
+				apiResult
+				CheckinData []*WeComAttendanceRecord `json:"checkindata"`
+			}
+			body := map[string]interface{}{
+				"opencheckindatatype": checkinDataTypeAll,
+				"starttime":           window.Start.Unix(),
+				"endtime":             window.End.Unix(),
+				"useridlist":          batch,
+			}
+			if err := a.call(ctx, http.MethodPost, "/cgi-bin/checkin/getcheckindata", nil, body, &resp); err != nil {
+				return nil, err
+			}
+			records = append(records, resp.CheckinData...)
+		}
+	}
+	return records, nil
 }
 
-// fetchDepartmentUsers 获取部门用户列表
-func (a *AttendanceAdapter) fetchDepartmentUsers(ctx context.Context, token string, deptID string) ([]*WeComUser, error) {
-	// 企业微信API: /cgi-bin/user/list
-	// TODO: 实现实际的 HTTP 请求
+// fetchUserIDs 获取企业全部成员ID
+// 企业微信API: /cgi-bin/user/list_id，按 cursor 翻页
+func (a *AttendanceAdapter) fetchUserIDs(ctx context.Context) ([]string, error) {
+	var userIDs []string
+	seen := make(map[string]bool)
+	cursor := ""
+	for {
+		var resp struct {
+			apiResult
+			NextCursor string `json:"next_cursor"`
+			DeptUser   []struct {
+				UserID string `json:"userid"`
+			} `json:"dept_user"`
+		}
+		body := map[string]interface{}{"cursor": cursor, "limit": userPageSize}
+		if err := a.call(ctx, http.MethodPost, "/cgi-bin/user/list_id", nil, body, &resp); err != nil {
+			return nil, err
+		}
+
+		// 成员在多个部门时会出现多次
+		for _, user := range resp.DeptUser {
+			if !seen[user.UserID] {
+				seen[user.UserID] = true
+				userIDs = append(userIDs, user.UserID)
+			}
+		}
+		if resp.NextCursor == "" {
+			return userIDs, nil
+		}
+		cursor = resp.NextCursor
+	}
+}
 
-	return []*WeComUser{}, nil
+// fetchDepartmentUsers 获取部门用户详情（含子部门）
+// 企业微信API: /cgi-bin/user/list
+func (a *AttendanceAdapter) fetchDepartmentUsers(ctx context.Context, deptID string) ([]*WeComUser, error) {
+	var resp struct {
+		apiResult
+		UserList []*WeComUser `json:"userlist"`
+	}
+	query := url.Values{"department_id": {deptID}, "fetch_child": {"1"}}
+	if err := a.call(ctx, http.MethodGet, "/cgi-bin/user/list", query, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.UserList, nil
 }
 
 // convertToAttendanceRecord 转换企业微信考勤记录为系统格式
 func (a *AttendanceAdapter) convertToAttendanceRecord(record *WeComAttendanceRecord, tenantID uuid.UUID) (*model.AttendanceRecord, error) {
-	// 解析打卡类型 (企业微信没有明确的上下班类型,根据时间判断)
+	checkinTime := time.Unix(record.CheckinTime, 0)
+
+	// 解析打卡类型（外出打卡没有上下班类型,根据时间判断）
 	var clockType model.AttendanceClockType
-	hour := record.CheckinTime.Hour()
-	if hour < 12 {
+	hour := checkinTime.Hour()
+	switch record.CheckinType {
+	case "上班打卡":
 		clockType = model.ClockTypeCheckIn
-	} else {
+	case "下班打卡":
 		clockType = model.ClockTypeCheckOut
+	default:
+		if hour < 12 {
+			clockType = model.ClockTypeCheckIn
+		} else {
+			clockType = model.ClockTypeCheckOut
+		}
 	}
 
-	// 解析考勤状态
+	// 解析考勤状态（多个异常以分号分隔）
 	var status model.AttendanceStatus
-	switch record.ExceptionType {
-	case "":
+	switch {
+	case record.ExceptionType == "":
 		status = model.AttendanceStatusNormal
-	case "时间异常":
-		if hour < 12 {
+	case strings.Contains(record.ExceptionType, "未打卡"):
+		status = model.AttendanceStatusAbsent
+	case strings.Contains(record.ExceptionType, "时间异常"):
+		if clockType == model.ClockTypeCheckIn {
 			status = model.AttendanceStatusLate
 		} else {
 			status = model.AttendanceStatusEarly
 		}
-	case "地点异常":
-		status = model.AttendanceStatusNormal // 地点异常单独标记
-	default:
-		status = model.AttendanceStatusNormal
-	}
-
-	// 解析打卡方式
-	var method model.AttendanceMethod
-	switch record.CheckinType {
-	case "WiFi打卡":
-		method = model.MethodMobile
-	case "GPS打卡":
-		method = model.MethodMobile
-	case "摄像头打卡":
-		method = model.MethodFace
 	default:
-		method = model.MethodMobile
+		status = model.AttendanceStatusNormal // 地点异常等单独标记
 	}
 
-	// 构造考勤记录
+	// 构造考勤记录（企业微信打卡均来自手机端）
 	result := &model.AttendanceRecord{
 		TenantID:      tenantID,
-		ClockTime:     record.CheckinTime,
+		ClockTime:     checkinTime,
 		ClockType:     clockType,
 		Status:        status,
-		CheckInMethod: method,
+		CheckInMethod: model.MethodMobile,
 		SourceType:    model.SourceTypeWeCom,
-		SourceID:      fmt.Sprintf("%s_%d", record.UserID, record.CheckinTime.Unix()),
+		SourceID:      fmt.Sprintf("%s_%d", record.UserID, record.CheckinTime),
 		IsException:   record.ExceptionType != "",
 		ExceptionType: record.ExceptionType,
 		CreatedAt:     time.Now(),
 		UpdatedAt:     time.Now(),
 	}
 
-	// 处理位置信息
+	// 处理位置信息（经纬度为实际值乘以 1000000）
 	if record.Lat != 0 && record.Lng != 0 {
 		result.Location = &model.LocationInfo{
-			Latitude:  record.Lat,
-			Longitude: record.Lng,
+			Latitude:  float64(record.Lat) / 1e6,
+			Longitude: float64(record.Lng) / 1e6,
 		}
 		result.Address = record.LocationTitle
 	}
@@ -235,23 +418,32 @@ func (a *AttendanceAdapter) convertToAttendanceRecord(record *WeComAttendanceRec
 	return result, nil
 }
 
+func unwrapRecords(records []*integration.PlatformAttendanceRecord) []*model.AttendanceRecord {
+	result := make([]*model.AttendanceRecord, 0, len(records))
+	for _, record := range records {
+		result = append(result, record.Record)
+	}
+	return result
+}
+
 // ========== 企业微信数据结构 ==========
 
 // WeComAttendanceRecord 企业微信考勤记录
 type WeComAttendanceRecord struct {
-	UserID         string    `json:"userid"`
-	GroupName      string    `json:"groupname"`
-	CheckinType    string    `json:"checkin_type"`   // WiFi打卡、GPS打卡、摄像头打卡
-	ExceptionType  string    `json:"exception_type"` // 时间异常、地点异常
-	CheckinTime    time.Time `json:"checkin_time"`
-	LocationTitle  string    `json:"location_title"`
-	LocationDetail string    `json:"location_detail"`
-	WiFiName       string    `json:"wifiname"`
-	WiFiMAC        string    `json:"wifimac"`
-	Notes          string    `json:"notes"`
-	MediaIDs       []string  `json:"mediaids"`
-	Lat            float64   `json:"lat"`
-	Lng            float64   `json:"lng"`
+	UserID         string   `json:"userid"`
+	GroupName      string   `json:"groupname"`
+	CheckinType    string   `json:"checkin_type"`   // 上班打卡、下班打卡、外出打卡
+	ExceptionType  string   `json:"exception_type"` // 时间异常、地点异常、未打卡、wifi异常
+	CheckinTime    int64    `json:"checkin_time"`   // Unix 秒
+	LocationTitle  string   `json:"location_title"`
+	LocationDetail string   `json:"location_detail"`
+	WiFiName       string   `json:"wifiname"`
+	WiFiMAC        string   `json:"wifimac"`
+	Notes          string   `json:"notes"`
+	MediaIDs       []string `json:"mediaids"`
+	Lat            int64    `json:"lat"`
+	Lng            int64    `json:"lng"`
+	DeviceID       string   `json:"deviceid"`
 }
 
 // WeComUser 企业微信用户
@@ -0,0 +1,151 @@
+package wecom
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/integration"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeWeCom 企业微信替身：成员ID分两页返回，打卡接口首次返回 HTTP 429
+type fakeWeCom struct {
+	tokenCalls   int32
+	checkinCalls int32
+	checkinBody  map[string]interface{}
+}
+
+func (f *fakeWeCom) handler(t *testing.T, checkinTime time.Time) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/cgi-bin/gettoken", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&f.tokenCalls, 1)
+		assert.Equal(t, "corp", r.URL.Query().Get("corpid"))
+		writeJSON(w, map[string]interface{}{"errcode": 0, "access_token": "token", "expires_in": 7200})
+	})
+	mux.HandleFunc("/cgi-bin/user/list_id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "token", r.URL.Query().Get("access_token"))
+		body := map[string]interface{}{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		if body["cursor"] == "" {
+			writeJSON(w, map[string]interface{}{"errcode": 0, "next_cursor": "page2", "dept_user": []map[string]interface{}{
+				{"userid": "zhangsan", "department": 1},
+				{"userid": "zhangsan", "department": 2},
+			}})
+			return
+		}
+		writeJSON(w, map[string]interface{}{"errcode": 0, "next_cursor": "", "dept_user": []map[string]interface{}{
+			{"userid": "lisi", "department": 2},
+		}})
+	})
+	mux.HandleFunc("/cgi-bin/checkin/getcheckindata", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&f.checkinCalls, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		f.checkinBody = map[string]interface{}{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&f.checkinBody))
+		writeJSON(w, map[string]interface{}{"errcode": 0, "checkindata": []map[string]interface{}{
+			{
+				"userid": "zhangsan", "checkin_type": "上班打卡", "exception_type": "时间异常",
+				"checkin_time": checkinTime.Unix(), "location_title": "总部", "lat": 30547030, "lng": 104062890,
+			},
+			{"userid": "lisi", "checkin_type": "外出打卡", "checkin_time": checkinTime.Add(10 * time.Hour).Unix()},
+		}})
+	})
+	return mux
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func TestPullAttendance(t *testing.T) {
+	ctx := context.Background()
+	checkinTime := time.Date(2025, 3, 3, 9, 10, 0, 0, time.Local)
+	fake := &fakeWeCom{}
+	server := httptest.NewServer(fake.handler(t, checkinTime))
+	defer server.Close()
+
+	adapter := NewAttendanceAdapter("corp", "secret", integration.WithBaseURL(server.URL), integration.WithRetry(2, time.Millisecond))
+
+	since := time.Date(2025, 3, 3, 0, 0, 0, 0, time.Local)
+	until := since.Add(24 * time.Hour)
+	result, err := adapter.PullAttendance(ctx, &integration.PullAttendanceRequest{TenantID: uuid.New(), Since: since, Until: until})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, result.Users)
+	assert.Equal(t, int32(2), fake.checkinCalls, "429 is retried")
+	assert.Equal(t, int32(1), fake.tokenCalls)
+	assert.Equal(t, []interface{}{"zhangsan", "lisi"}, fake.checkinBody["useridlist"])
+	assert.Equal(t, float64(since.Unix()), fake.checkinBody["starttime"])
+
+	require.Len(t, result.Records, 2)
+	late := result.Records[0].Record
+	assert.Equal(t, "zhangsan", result.Records[0].PlatformUserID)
+	assert.Equal(t, fmt.Sprintf("zhangsan_%d", checkinTime.Unix()), late.SourceID)
+	assert.Equal(t, model.ClockTypeCheckIn, late.ClockType)
+	assert.Equal(t, model.AttendanceStatusLate, late.Status)
+	assert.InDelta(t, 30.54703, late.Location.Latitude, 1e-9)
+	assert.Equal(t, "总部", late.Address)
+
+	assert.Equal(t, model.ClockTypeCheckOut, result.Records[1].Record.ClockType)
+}
+
+func TestHandleCallback(t *testing.T) {
+	ctx := context.Background()
+	aesKey := strings.TrimRight(base64.StdEncoding.EncodeToString([]byte("abcdefghijklmnopqrstuvwxyz012345")), "=")
+	adapter := NewAttendanceAdapter("corp", "secret", integration.WithCallback("cb-token", aesKey))
+
+	sign := func(encrypted string) url.Values {
+		return url.Values{
+			"msg_signature": {integration.MsgSignature("cb-token", "1700000000", "n1", encrypted)},
+			"timestamp":     {"1700000000"},
+			"nonce":         {"n1"},
+		}
+	}
+
+	t.Run("url verification echoes decrypted echostr", func(t *testing.T) {
+		echo, err := integration.EncryptMessage(aesKey, "corp", []byte("12345"))
+		require.NoError(t, err)
+		query := sign(echo)
+		query.Set("echostr", echo)
+
+		result, err := adapter.HandleCallback(ctx, &integration.CallbackRequest{Method: http.MethodGet, Query: query})
+		require.NoError(t, err)
+		assert.Equal(t, "12345", string(result.Reply))
+	})
+
+	t.Run("checkin event", func(t *testing.T) {
+		encrypted, err := integration.EncryptMessage(aesKey, "corp", []byte("<xml><MsgType>event</MsgType><Event>checkin_data</Event></xml>"))
+		require.NoError(t, err)
+		body := fmt.Sprintf("<xml><ToUserName>corp</ToUserName><Encrypt><![CDATA[%s]]></Encrypt></xml>", encrypted)
+
+		result, err := adapter.HandleCallback(ctx, &integration.CallbackRequest{Method: http.MethodPost, Query: sign(encrypted), Body: []byte(body)})
+		require.NoError(t, err)
+		assert.True(t, result.Attendance)
+		assert.Equal(t, "success", string(result.Reply))
+	})
+
+	t.Run("message for another corp is rejected", func(t *testing.T) {
+		encrypted, err := integration.EncryptMessage(aesKey, "other", []byte("<xml/>"))
+		require.NoError(t, err)
+		body := fmt.Sprintf("<xml><Encrypt>%s</Encrypt></xml>", encrypted)
+
+		_, err = adapter.HandleCallback(ctx, &integration.CallbackRequest{Method: http.MethodPost, Query: sign(encrypted), Body: []byte(body)})
+		assert.ErrorIs(t, err, integration.ErrInvalidSignature)
+	})
+}
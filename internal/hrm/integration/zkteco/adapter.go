@@ -0,0 +1,177 @@
+package zkteco
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/integration"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+)
+
+// OfflineAfter 超过该时长未收到心跳视为离线
+const OfflineAfter = 5 * time.Minute
+
+var (
+	// ErrPullNotSupported 推送模式的设备主动上传记录，不支持服务器拉取
+	ErrPullNotSupported = errors.New("zkteco push device does not support pulling records")
+
+	// ErrDeviceOffline 设备未在心跳有效期内连接
+	ErrDeviceOffline = errors.New("zkteco device is offline")
+)
+
+// PushAdapter ZKTeco 推送协议设备适配器。
+// 设备通过心跳拉取命令，因此写操作只是把命令加入队列，由设备下次心跳时执行
+type PushAdapter struct {
+	device      *model.AttendanceDevice
+	commandRepo repository.DeviceCommandRepository
+	operatorID  *uuid.UUID
+	now         func() time.Time
+}
+
+// NewPushAdapter 创建 ZKTeco 推送协议设备适配器
+func NewPushAdapter(device *model.AttendanceDevice, commandRepo repository.DeviceCommandRepository, operatorID *uuid.UUID) integration.DeviceAdapter {
+	return &PushAdapter{
+		device:      device,
+		commandRepo: commandRepo,
+		operatorID:  operatorID,
+		now:         time.Now,
+	}
+}
+
+// GetType 获取设备类型
+func (a *PushAdapter) GetType() model.DeviceType {
+	return model.DeviceTypeZKTeco
+}
+
+// Connect 推送模式由设备发起连接，无需建立连接
+func (a *PushAdapter) Connect(ctx context.Context, config *integration.DeviceConfig) error {
+	return nil
+}
+
+// Disconnect 推送模式无需断开连接
+func (a *PushAdapter) Disconnect() error {
+	return nil
+}
+
+// Ping 根据最后心跳判断设备是否在线
+func (a *PushAdapter) Ping(ctx context.Context) error {
+	if !a.isOnline() {
+		return ErrDeviceOffline
+	}
+	return nil
+}
+
+// PullRecords 推送模式不支持拉取
+func (a *PushAdapter) PullRecords(ctx context.Context, startTime, endTime time.Time) ([]*integration.AttendanceRecordDTO, error) {
+	return nil, ErrPullNotSupported
+}
+
+// PushEmployee 下发员工（PIN 为工号），随后下发其生物特征模板
+func (a *PushAdapter) PushEmployee(ctx context.Context, employee *integration.EmployeeDTO) error {
+	if err := a.enqueue(ctx, model.DeviceCommandUserUpload, UserInfoCommand(employee.EmployeeNo, employee.Name, employee.CardNo)); err != nil {
+		return err
+	}
+	for _, biometric := range employee.Biometrics {
+		var content string
+		switch biometric.Type {
+		case model.BiometricFingerprint:
+			content = FingerTemplateCommand(employee.EmployeeNo, biometric.Index, biometric.Template)
+		case model.BiometricFace:
+			content = FaceTemplateCommand(employee.EmployeeNo, biometric.Index, biometric.Template)
+		default:
+			continue
+		}
+		if err := a.enqueue(ctx, model.DeviceCommandTemplateUpload, content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BatchPushEmployees 批量下发员工，每名员工一条命令
+func (a *PushAdapter) BatchPushEmployees(ctx context.Context, employees []*integration.EmployeeDTO) error {
+	for _, employee := range employees {
+		if err := a.PushEmployee(ctx, employee); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteEmployee 从设备删除员工，employeeID 为设备上的 PIN（工号）
+func (a *PushAdapter) DeleteEmployee(ctx context.Context, employeeID string) error {
+	return a.enqueue(ctx, model.DeviceCommandUserDelete, DeleteUserCommand(employeeID))
+}
+
+// GetDeviceInfo 获取设备信息（来自注册信息和心跳上报）
+func (a *PushAdapter) GetDeviceInfo(ctx context.Context) (*integration.DeviceInfo, error) {
+	return &integration.DeviceInfo{
+		DeviceSN:    a.device.DeviceSN,
+		DeviceModel: a.device.DeviceModel,
+		FirmwareVer: a.device.FirmwareVersion,
+		RecordCount: a.device.TotalRecords,
+	}, nil
+}
+
+// GetDeviceStatus 获取设备状态
+func (a *PushAdapter) GetDeviceStatus(ctx context.Context) (*integration.DeviceStatus, error) {
+	status := &integration.DeviceStatus{
+		IsOnline:     a.isOnline(),
+		ErrorMessage: a.device.ErrorMessage,
+	}
+	if a.device.LastHeartbeat != nil {
+		status.LastHeartbeat = *a.device.LastHeartbeat
+	}
+	return status, nil
+}
+
+// ClearRecords 清空设备考勤记录
+func (a *PushAdapter) ClearRecords(ctx context.Context) error {
+	return a.enqueue(ctx, model.DeviceCommandClearLog, ClearLogCommand())
+}
+
+// Reboot 重启设备
+func (a *PushAdapter) Reboot(ctx context.Context) error {
+	return a.enqueue(ctx, model.DeviceCommandReboot, RebootCommand())
+}
+
+// SetTime 校准设备时间，设备按本地时间计时
+func (a *PushAdapter) SetTime(ctx context.Context, t time.Time) error {
+	return a.enqueue(ctx, model.DeviceCommandSetTime, SetTimeCommand(t.In(time.Local)))
+}
+
+// EnrollBiometric 在设备上为员工启动指纹或人脸采集
+func (a *PushAdapter) EnrollBiometric(ctx context.Context, employeeNo string, biometricType model.BiometricType, index int) error {
+	switch biometricType {
+	case model.BiometricFingerprint:
+		return a.enqueue(ctx, model.DeviceCommandEnroll, EnrollFingerCommand(employeeNo, index))
+	case model.BiometricFace:
+		return a.enqueue(ctx, model.DeviceCommandEnroll, EnrollFaceCommand(employeeNo))
+	default:
+		return fmt.Errorf("unsupported biometric type %q", biometricType)
+	}
+}
+
+func (a *PushAdapter) isOnline() bool {
+	return a.device.LastHeartbeat != nil && a.now().Sub(*a.device.LastHeartbeat) <= OfflineAfter
+}
+
+func (a *PushAdapter) enqueue(ctx context.Context, commandType model.DeviceCommandType, content string) error {
+	now := a.now()
+	return a.commandRepo.Create(ctx, &model.DeviceCommand{
+		ID:          uuid.New(),
+		TenantID:    a.device.TenantID,
+		DeviceID:    a.device.ID,
+		DeviceSN:    a.device.DeviceSN,
+		CommandType: commandType,
+		Content:     content,
+		Status:      model.DeviceCommandPending,
+		CreatedBy:   a.operatorID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	})
+}
@@ -0,0 +1,371 @@
+package zkteco
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lk2023060901/go-next-erp/internal/hrm/integration"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+)
+
+// ZKTeco 推送协议（ADMS / iClock）
+//
+// 设备主动向服务器发起 HTTP 请求：
+//   - GET  /iclock/cdata?SN=xxx&options=all        握手，服务器返回参数配置
+//   - POST /iclock/cdata?SN=xxx&table=ATTLOG&Stamp=n 上传考勤记录，每行一条，字段以 Tab 分隔
+//   - POST /iclock/cdata?SN=xxx&table=OPERLOG       上传操作日志及新采集的用户、指纹、人脸模板
+//   - POST /iclock/cdata?SN=xxx&table=BIODATA       新固件以统一格式上传生物特征模板
+//   - GET  /iclock/getrequest?SN=xxx&INFO=...       心跳，服务器返回待执行命令（C:<编号>:<命令>）或 OK
+//   - POST /iclock/devicecmd?SN=xxx                 命令执行回执（ID=<编号>&Return=<结果>&CMD=<类型>）
+
+const (
+	// TableAttLog 考勤记录表
+	TableAttLog = "ATTLOG"
+
+	// TableOperLog 操作日志表，旧固件的指纹（FP）、人脸（FACE）模板随其上传
+	TableOperLog = "OPERLOG"
+
+	// TableBioData 生物特征模板表（新固件）
+	TableBioData = "BIODATA"
+
+	// ReplyOK 通用成功应答
+	ReplyOK = "OK"
+
+	attLogTimeLayout = "2006-01-02 15:04:05"
+)
+
+// ErrInvalidReply 命令回执格式错误
+var ErrInvalidReply = errors.New("invalid zkteco command reply")
+
+// 握手应答参数
+const (
+	errorDelaySeconds = 30 // 通讯失败后重连间隔
+	delaySeconds      = 10 // 心跳间隔
+	transFlag         = "TransData AttLog\tOpLog\tEnrollFP\tChgFP\tFACE"
+)
+
+// AttLog ATTLOG 中的一条考勤记录
+type AttLog struct {
+	PIN      string    // 考勤号码（员工工号）
+	Time     time.Time // 设备本地时间
+	Status   int       // 考勤状态：0 上班 1 下班 2 外出 3 外出返回 4 加班签到 5 加班签退
+	Verify   int       // 验证方式：0 密码 1 指纹 2 刷卡 15 人脸
+	WorkCode string
+	RawLine  string
+}
+
+// BioTemplate 设备上传的生物特征模板
+type BioTemplate struct {
+	PIN      string
+	Type     model.BiometricType
+	Index    int    // 手指编号或人脸模板序号
+	Version  string // 算法主版本，OPERLOG 不携带时为空（取设备心跳上报的版本）
+	Template string // Base64 模板数据
+}
+
+// CommandReply 设备命令回执
+type CommandReply struct {
+	Seq        int64  // 命令编号
+	ReturnCode int    // 0 成功，负数为错误码
+	Command    string // 命令类型，如 DATA、CLEAR_LOG
+}
+
+// ParseAttLog 解析 ATTLOG 上传内容，loc 为设备所在时区。
+// 返回解析成功的记录及无法解析的行
+func ParseAttLog(body string, loc *time.Location) ([]*AttLog, []string) {
+	if loc == nil {
+		loc = time.Local
+	}
+
+	var logs []*AttLog
+	var invalid []string
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		if len(fields) < 2 {
+			invalid = append(invalid, line)
+			continue
+		}
+
+		pin := strings.TrimSpace(fields[0])
+		clockTime, err := time.ParseInLocation(attLogTimeLayout, strings.TrimSpace(fields[1]), loc)
+		if pin == "" || err != nil {
+			invalid = append(invalid, line)
+			continue
+		}
+
+		log := &AttLog{PIN: pin, Time: clockTime, RawLine: line}
+		if len(fields) > 2 {
+			log.Status, _ = strconv.Atoi(strings.TrimSpace(fields[2]))
+		}
+		if len(fields) > 3 {
+			log.Verify, _ = strconv.Atoi(strings.TrimSpace(fields[3]))
+		}
+		if len(fields) > 4 {
+			log.WorkCode = strings.TrimSpace(fields[4])
+		}
+		logs = append(logs, log)
+	}
+
+	return logs, invalid
+}
+
+// ClockType 考勤状态映射为上下班
+func (l *AttLog) ClockType() model.AttendanceClockType {
+	switch l.Status {
+	case 1, 2, 5:
+		return model.ClockTypeCheckOut
+	default:
+		return model.ClockTypeCheckIn
+	}
+}
+
+// Method 验证方式映射为打卡方式
+func (l *AttLog) Method() model.AttendanceMethod {
+	switch l.Verify {
+	case 1:
+		return model.MethodFingerprint
+	case 2, 4:
+		return model.MethodCard
+	case 15:
+		return model.MethodFace
+	default:
+		return model.MethodDevice
+	}
+}
+
+// ToDTO 转换为通用考勤记录
+func (l *AttLog) ToDTO(deviceSN string) *integration.AttendanceRecordDTO {
+	return &integration.AttendanceRecordDTO{
+		UserID:        l.PIN,
+		ClockTime:     l.Time,
+		ClockType:     l.ClockType(),
+		CheckInMethod: l.Method(),
+		DeviceID:      deviceSN,
+		RawData: map[string]interface{}{
+			"status":    l.Status,
+			"verify":    l.Verify,
+			"work_code": l.WorkCode,
+			"line":      l.RawLine,
+		},
+	}
+}
+
+// ParseTemplates 解析 OPERLOG 或 BIODATA 上传内容中的生物特征模板，其他行（操作日志、用户信息）忽略。
+// OPERLOG: FP PIN=1001\tFID=0\tSize=1024\tValid=1\tTMP=...、FACE PIN=1001\tFID=0\tSIZE=..\tVALID=1\tTMP=...
+// BIODATA: BIODATA Pin=1001\tNo=0\tIndex=0\tValid=1\tDuress=0\tType=1\tMajorVer=10\tMinorVer=0\tFormat=0\tTmp=...
+func ParseTemplates(table, body string) []*BioTemplate {
+	var templates []*BioTemplate
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024) // 人脸模板单行可超过默认的 64KB
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		head, rest, ok := strings.Cut(line, " ")
+		if !ok {
+			continue
+		}
+
+		fields := parseKeyValues(rest)
+		if fields["valid"] == "0" {
+			continue
+		}
+		template := &BioTemplate{PIN: fields["pin"], Template: fields["tmp"]}
+		switch {
+		case strings.EqualFold(table, TableOperLog) && head == "FP":
+			template.Type = model.BiometricFingerprint
+			template.Index, _ = strconv.Atoi(fields["fid"])
+		case strings.EqualFold(table, TableOperLog) && head == "FACE":
+			template.Type = model.BiometricFace
+			template.Index, _ = strconv.Atoi(fields["fid"])
+		case strings.EqualFold(table, TableBioData) && head == "BIODATA":
+			switch fields["type"] {
+			case "1":
+				template.Type = model.BiometricFingerprint
+				template.Index, _ = strconv.Atoi(fields["no"])
+			case "2", "9":
+				template.Type = model.BiometricFace
+				template.Index, _ = strconv.Atoi(fields["index"])
+			default:
+				continue
+			}
+			template.Version = fields["majorver"]
+		default:
+			continue
+		}
+		if template.PIN == "" || template.Template == "" {
+			continue
+		}
+		templates = append(templates, template)
+	}
+	return templates
+}
+
+// parseKeyValues 解析以 Tab 分隔的 key=value 字段，key 统一为小写
+func parseKeyValues(line string) map[string]string {
+	values := make(map[string]string)
+	for _, field := range strings.Split(line, "\t") {
+		key, value, ok := strings.Cut(field, "=")
+		if ok {
+			values[strings.ToLower(strings.TrimSpace(key))] = strings.TrimSpace(value)
+		}
+	}
+	return values
+}
+
+// OptionsReply 握手应答，stamp 为服务器已接收的考勤记录上传戳
+func OptionsReply(sn, stamp string, tzOffsetHours int) string {
+	if stamp == "" {
+		stamp = "0"
+	}
+
+	lines := []string{
+		"GET OPTION FROM: " + sn,
+		"ATTLOGStamp=" + stamp,
+		"OPERLOGStamp=9999",
+		"ATTPHOTOStamp=None",
+		fmt.Sprintf("ErrorDelay=%d", errorDelaySeconds),
+		fmt.Sprintf("Delay=%d", delaySeconds),
+		"TransTimes=00:00;14:05",
+		"TransInterval=1",
+		"TransFlag=" + transFlag,
+		fmt.Sprintf("TimeZone=%d", tzOffsetHours),
+		"Realtime=1",
+		"Encrypt=None",
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// UserInfoCommand 下发/更新用户命令
+func UserInfoCommand(pin, name, cardNo string) string {
+	return fmt.Sprintf("DATA UPDATE USERINFO PIN=%s\tName=%s\tPri=0\tPasswd=\tCard=%s\tGrp=1\tTZ=0000000100000000\tVerify=0",
+		sanitize(pin), sanitize(name), sanitize(cardNo))
+}
+
+// DeleteUserCommand 删除用户命令
+func DeleteUserCommand(pin string) string {
+	return "DATA DELETE USERINFO PIN=" + sanitize(pin)
+}
+
+// FingerTemplateCommand 下发指纹模板命令
+func FingerTemplateCommand(pin string, fid int, template string) string {
+	return fmt.Sprintf("DATA UPDATE FINGERTMP PIN=%s\tFID=%d\tSize=%d\tValid=1\tTMP=%s",
+		sanitize(pin), fid, len(template), sanitize(template))
+}
+
+// FaceTemplateCommand 下发人脸模板命令
+func FaceTemplateCommand(pin string, fid int, template string) string {
+	return fmt.Sprintf("DATA UPDATE FACE PIN=%s\tFID=%d\tSize=%d\tValid=1\tTMP=%s",
+		sanitize(pin), fid, len(template), sanitize(template))
+}
+
+// EnrollFingerCommand 在设备上为用户登记指纹，登记成功后设备通过 OPERLOG 上传模板
+func EnrollFingerCommand(pin string, fid int) string {
+	return fmt.Sprintf("ENROLL_FP PIN=%s\tFID=%d\tRETRY=3\tOVERWRITE=1", sanitize(pin), fid)
+}
+
+// EnrollFaceCommand 在设备上为用户登记人脸
+func EnrollFaceCommand(pin string) string {
+	return fmt.Sprintf("ENROLL_BIO TYPE=9\tPIN=%s\tRETRY=3\tOVERWRITE=1", sanitize(pin))
+}
+
+// ClearLogCommand 清空考勤记录命令
+func ClearLogCommand() string {
+	return "CLEAR LOG"
+}
+
+// RebootCommand 重启设备命令
+func RebootCommand() string {
+	return "REBOOT"
+}
+
+// SetTimeCommand 设置设备时间命令，DateTime 为中控时间编码（2000 年起，每月按 31 天计）
+func SetTimeCommand(t time.Time) string {
+	days := (t.Year()-2000)*12*31 + (int(t.Month())-1)*31 + t.Day() - 1
+	seconds := days*24*60*60 + (t.Hour()*60+t.Minute())*60 + t.Second()
+	return fmt.Sprintf("SET OPTION DateTime=%d", seconds)
+}
+
+// FormatCommand 格式化心跳应答中的一条命令
+func FormatCommand(seq int64, content string) string {
+	return fmt.Sprintf("C:%d:%s\n", seq, content)
+}
+
+// ParseCommandReplies 解析 /iclock/devicecmd 回执，每行一条
+func ParseCommandReplies(body string) ([]*CommandReply, error) {
+	var replies []*CommandReply
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		values, err := url.ParseQuery(line)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidReply, line)
+		}
+		seq, err := strconv.ParseInt(values.Get("ID"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid id in %q", ErrInvalidReply, line)
+		}
+		code, err := strconv.Atoi(values.Get("Return"))
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid return code in %q", ErrInvalidReply, line)
+		}
+		replies = append(replies, &CommandReply{Seq: seq, ReturnCode: code, Command: values.Get("CMD")})
+	}
+
+	return replies, scanner.Err()
+}
+
+// HeartbeatInfo 心跳 INFO 参数：固件版本,用户数,指纹数,记录数,IP,指纹算法版本,人脸算法版本,...
+type HeartbeatInfo struct {
+	FirmwareVersion    string
+	UserCount          int
+	RecordCount        int
+	IPAddress          string
+	FingerprintVersion string
+	FaceVersion        string
+}
+
+// ParseHeartbeatInfo 解析心跳 INFO 参数，缺失字段保持零值
+func ParseHeartbeatInfo(info string) *HeartbeatInfo {
+	result := &HeartbeatInfo{}
+	if info == "" {
+		return result
+	}
+
+	fields := strings.Split(info, ",")
+	result.FirmwareVersion = strings.TrimSpace(fields[0])
+	if len(fields) > 1 {
+		result.UserCount, _ = strconv.Atoi(strings.TrimSpace(fields[1]))
+	}
+	if len(fields) > 3 {
+		result.RecordCount, _ = strconv.Atoi(strings.TrimSpace(fields[3]))
+	}
+	if len(fields) > 4 {
+		result.IPAddress = strings.TrimSpace(fields[4])
+	}
+	if len(fields) > 5 {
+		result.FingerprintVersion = strings.TrimSpace(fields[5])
+	}
+	if len(fields) > 6 {
+		result.FaceVersion = strings.TrimSpace(fields[6])
+	}
+	return result
+}
+
+// sanitize 去除会破坏命令格式的制表符和换行
+func sanitize(value string) string {
+	return strings.NewReplacer("\t", " ", "\r", "", "\n", "").Replace(value)
+}
@@ -0,0 +1,205 @@
+package zkteco
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/integration"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAttLog(t *testing.T) {
+	loc := time.FixedZone("CST", 8*3600)
+
+	t.Run("tab separated lines", func(t *testing.T) {
+		body := "1001\t2025-03-03 08:55:12\t0\t1\t0\t0\r\n" +
+			"1002\t2025-03-03 18:02:40\t1\t15\t\t0\n" +
+			"\n" +
+			"1003\t2025-03-03 09:10:00\t4\t2\n"
+
+		logs, invalid := ParseAttLog(body, loc)
+		require.Len(t, logs, 3)
+		assert.Empty(t, invalid)
+
+		assert.Equal(t, "1001", logs[0].PIN)
+		assert.Equal(t, time.Date(2025, 3, 3, 8, 55, 12, 0, loc), logs[0].Time)
+		assert.Equal(t, model.ClockTypeCheckIn, logs[0].ClockType())
+		assert.Equal(t, model.MethodFingerprint, logs[0].Method())
+
+		assert.Equal(t, model.ClockTypeCheckOut, logs[1].ClockType())
+		assert.Equal(t, model.MethodFace, logs[1].Method())
+
+		assert.Equal(t, model.ClockTypeCheckIn, logs[2].ClockType())
+		assert.Equal(t, model.MethodCard, logs[2].Method())
+	})
+
+	t.Run("invalid lines are reported", func(t *testing.T) {
+		body := "1001\t2025-03-03 08:55:12\t0\t1\n" +
+			"garbage\n" +
+			"\t2025-03-03 08:55:12\t0\t1\n" +
+			"1002\t03/03/2025 08:55\t0\t1\n"
+
+		logs, invalid := ParseAttLog(body, loc)
+		assert.Len(t, logs, 1)
+		assert.Len(t, invalid, 3)
+	})
+
+	t.Run("dto keeps raw fields", func(t *testing.T) {
+		logs, _ := ParseAttLog("1001\t2025-03-03 08:55:12\t5\t0\t7\n", loc)
+		require.Len(t, logs, 1)
+
+		dto := logs[0].ToDTO("CKJE201560001")
+		assert.Equal(t, "1001", dto.UserID)
+		assert.Equal(t, "CKJE201560001", dto.DeviceID)
+		assert.Equal(t, model.ClockTypeCheckOut, dto.ClockType)
+		assert.Equal(t, model.MethodDevice, dto.CheckInMethod)
+		assert.Equal(t, "7", dto.RawData["work_code"])
+	})
+}
+
+func TestCommands(t *testing.T) {
+	t.Run("format", func(t *testing.T) {
+		assert.Equal(t, "C:12:CLEAR LOG\n", FormatCommand(12, ClearLogCommand()))
+		assert.Equal(t, "DATA DELETE USERINFO PIN=1001", DeleteUserCommand("1001"))
+		assert.Equal(t,
+			"DATA UPDATE USERINFO PIN=1001\tName=张 三\tPri=0\tPasswd=\tCard=8899\tGrp=1\tTZ=0000000100000000\tVerify=0",
+			UserInfoCommand("1001", "张\t三\n", "8899"),
+		)
+		assert.Equal(t, "REBOOT", RebootCommand())
+		assert.Equal(t, "SET OPTION DateTime=809080200",
+			SetTimeCommand(time.Date(2025, 3, 3, 8, 30, 0, 0, time.UTC)))
+		assert.Equal(t, "DATA UPDATE FINGERTMP PIN=1001\tFID=6\tSize=8\tValid=1\tTMP=TVJTUzIx", FingerTemplateCommand("1001", 6, "TVJTUzIx"))
+		assert.Equal(t, "DATA UPDATE FACE PIN=1001\tFID=0\tSize=4\tValid=1\tTMP=QUJD", FaceTemplateCommand("1001", 0, "QUJD"))
+		assert.Equal(t, "ENROLL_FP PIN=1001\tFID=6\tRETRY=3\tOVERWRITE=1", EnrollFingerCommand("1001", 6))
+		assert.Equal(t, "ENROLL_BIO TYPE=9\tPIN=1001\tRETRY=3\tOVERWRITE=1", EnrollFaceCommand("1001"))
+	})
+
+	t.Run("parse templates", func(t *testing.T) {
+		operLog := "OPLOG 4\t0\t2025-03-03 08:50:00\t0\t0\t0\t0\n" +
+			"USER PIN=1001\tName=张三\tPri=0\tPasswd=\tCard=8899\tGrp=1\tTZ=0000000100000000\n" +
+			"FP PIN=1001\tFID=6\tSize=8\tValid=1\tTMP=TVJTUzIx\n" +
+			"FP PIN=1002\tFID=1\tSize=8\tValid=0\tTMP=TVJTUzIx\n" +
+			"FACE PIN=1001\tFID=0\tSIZE=4\tVALID=1\tTMP=QUJD\n"
+		templates := ParseTemplates(TableOperLog, operLog)
+		require.Len(t, templates, 2)
+		assert.Equal(t, &BioTemplate{PIN: "1001", Type: model.BiometricFingerprint, Index: 6, Template: "TVJTUzIx"}, templates[0])
+		assert.Equal(t, &BioTemplate{PIN: "1001", Type: model.BiometricFace, Index: 0, Template: "QUJD"}, templates[1])
+
+		bioData := "BIODATA Pin=1002\tNo=3\tIndex=0\tValid=1\tDuress=0\tType=1\tMajorVer=12\tMinorVer=0\tFormat=0\tTmp=RkdS\n" +
+			"BIODATA Pin=1002\tNo=0\tIndex=0\tValid=1\tDuress=0\tType=8\tMajorVer=5\tMinorVer=0\tFormat=0\tTmp=UEFMTQ==\n"
+		templates = ParseTemplates(TableBioData, bioData)
+		require.Len(t, templates, 1)
+		assert.Equal(t, &BioTemplate{PIN: "1002", Type: model.BiometricFingerprint, Index: 3, Version: "12", Template: "RkdS"}, templates[0])
+
+		assert.Empty(t, ParseTemplates(TableAttLog, operLog))
+	})
+
+	t.Run("parse replies", func(t *testing.T) {
+		replies, err := ParseCommandReplies("ID=12&Return=0&CMD=DATA\nID=13&Return=-1002&CMD=CLEAR_LOG\n")
+		require.NoError(t, err)
+		require.Len(t, replies, 2)
+		assert.Equal(t, &CommandReply{Seq: 12, ReturnCode: 0, Command: "DATA"}, replies[0])
+		assert.Equal(t, -1002, replies[1].ReturnCode)
+
+		_, err = ParseCommandReplies("ID=abc&Return=0")
+		assert.ErrorIs(t, err, ErrInvalidReply)
+	})
+
+	t.Run("options reply", func(t *testing.T) {
+		reply := OptionsReply("CKJE201560001", "", 8)
+		assert.True(t, strings.HasPrefix(reply, "GET OPTION FROM: CKJE201560001\n"))
+		assert.Contains(t, reply, "ATTLOGStamp=0\n")
+		assert.Contains(t, reply, "TimeZone=8\n")
+		assert.Contains(t, reply, "Realtime=1\n")
+	})
+
+	t.Run("heartbeat info", func(t *testing.T) {
+		info := ParseHeartbeatInfo("Ver 6.60 Apr 28 2017,25,18,1024,192.168.1.201,10,7,12")
+		assert.Equal(t, "Ver 6.60 Apr 28 2017", info.FirmwareVersion)
+		assert.Equal(t, 25, info.UserCount)
+		assert.Equal(t, 1024, info.RecordCount)
+		assert.Equal(t, "192.168.1.201", info.IPAddress)
+		assert.Equal(t, "10", info.FingerprintVersion)
+		assert.Equal(t, "7", info.FaceVersion)
+
+		assert.Equal(t, &HeartbeatInfo{}, ParseHeartbeatInfo(""))
+	})
+}
+
+type stubCommandRepo struct {
+	repository.DeviceCommandRepository
+	commands []*model.DeviceCommand
+}
+
+func (r *stubCommandRepo) Create(ctx context.Context, command *model.DeviceCommand) error {
+	command.Seq = int64(len(r.commands) + 1)
+	r.commands = append(r.commands, command)
+	return nil
+}
+
+func TestPushAdapter(t *testing.T) {
+	ctx := context.Background()
+	operator := uuid.New()
+	heartbeat := time.Now().Add(-time.Minute)
+	device := &model.AttendanceDevice{
+		ID:            uuid.New(),
+		TenantID:      uuid.New(),
+		DeviceSN:      "CKJE201560001",
+		LastHeartbeat: &heartbeat,
+	}
+
+	t.Run("write operations are queued", func(t *testing.T) {
+		repo := &stubCommandRepo{}
+		adapter := NewPushAdapter(device, repo, &operator)
+
+		require.NoError(t, adapter.BatchPushEmployees(ctx, []*integration.EmployeeDTO{
+			{EmployeeNo: "1001", Name: "张三", CardNo: "8899", Biometrics: []*integration.BiometricDTO{
+				{Type: model.BiometricFingerprint, Index: 6, AlgorithmVersion: "10", Template: "TVJTUzIx"},
+			}},
+			{EmployeeNo: "1002", Name: "李四"},
+		}))
+		require.NoError(t, adapter.DeleteEmployee(ctx, "1003"))
+		require.NoError(t, adapter.ClearRecords(ctx))
+		require.NoError(t, adapter.EnrollBiometric(ctx, "1002", model.BiometricFace, 0))
+
+		require.Len(t, repo.commands, 6)
+		assert.Equal(t, model.DeviceCommandUserUpload, repo.commands[0].CommandType)
+		assert.Contains(t, repo.commands[0].Content, "PIN=1001\tName=张三")
+		assert.Equal(t, model.DeviceCommandTemplateUpload, repo.commands[1].CommandType)
+		assert.Equal(t, FingerTemplateCommand("1001", 6, "TVJTUzIx"), repo.commands[1].Content)
+		assert.Equal(t, model.DeviceCommandUserDelete, repo.commands[3].CommandType)
+		assert.Equal(t, model.DeviceCommandClearLog, repo.commands[4].CommandType)
+		assert.Equal(t, model.DeviceCommandEnroll, repo.commands[5].CommandType)
+		for _, command := range repo.commands {
+			assert.Equal(t, device.ID, command.DeviceID)
+			assert.Equal(t, device.TenantID, command.TenantID)
+			assert.Equal(t, model.DeviceCommandPending, command.Status)
+			assert.Equal(t, &operator, command.CreatedBy)
+		}
+	})
+
+	t.Run("status follows heartbeat", func(t *testing.T) {
+		adapter := NewPushAdapter(device, &stubCommandRepo{}, nil)
+		assert.NoError(t, adapter.Ping(ctx))
+
+		_, err := adapter.PullRecords(ctx, time.Now().Add(-time.Hour), time.Now())
+		assert.ErrorIs(t, err, ErrPullNotSupported)
+
+		stale := time.Now().Add(-OfflineAfter - time.Minute)
+		offline := *device
+		offline.LastHeartbeat = &stale
+		adapter = NewPushAdapter(&offline, &stubCommandRepo{}, nil)
+		assert.ErrorIs(t, adapter.Ping(ctx), ErrDeviceOffline)
+
+		status, err := adapter.GetDeviceStatus(ctx)
+		require.NoError(t, err)
+		assert.False(t, status.IsOnline)
+		assert.Equal(t, stale, status.LastHeartbeat)
+	})
+}
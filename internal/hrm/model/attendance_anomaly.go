@@ -0,0 +1,96 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AttendanceAnomalyType 考勤异常（疑似作弊或数据问题）类型
+type AttendanceAnomalyType string
+
+const (
+	AnomalyImpossibleTravel    AttendanceAnomalyType = "impossible_travel"    // 相邻两次打卡距离过远、时间过短
+	AnomalyBuddyPunching       AttendanceAnomalyType = "buddy_punching"       // 同一设备数秒内为多名员工打卡
+	AnomalyDeviceClockDrift    AttendanceAnomalyType = "device_clock_drift"   // 设备时钟与服务器时间偏差
+	AnomalyRepeatedCoordinates AttendanceAnomalyType = "repeated_coordinates" // 多次打卡定位坐标完全相同（疑似虚拟定位）
+)
+
+// AttendanceAnomalySeverity 异常严重程度
+type AttendanceAnomalySeverity string
+
+const (
+	AnomalySeverityLow    AttendanceAnomalySeverity = "low"
+	AnomalySeverityMedium AttendanceAnomalySeverity = "medium"
+	AnomalySeverityHigh   AttendanceAnomalySeverity = "high"
+)
+
+// AttendanceAnomalyStatus 异常处理状态
+type AttendanceAnomalyStatus string
+
+const (
+	AnomalyStatusOpen         AttendanceAnomalyStatus = "open"         // 待处理
+	AnomalyStatusAcknowledged AttendanceAnomalyStatus = "acknowledged" // HR 确认属实
+	AnomalyStatusDismissed    AttendanceAnomalyStatus = "dismissed"    // HR 判定为误报
+)
+
+// AttendanceAnomaly 考勤异常分析结果，供 HR 确认或忽略
+type AttendanceAnomaly struct {
+	ID       uuid.UUID                 `json:"id"`
+	TenantID uuid.UUID                 `json:"tenant_id"`
+	Type     AttendanceAnomalyType     `json:"type"`
+	Severity AttendanceAnomalySeverity `json:"severity"`
+	Status   AttendanceAnomalyStatus   `json:"status"`
+
+	// 涉及的员工（设备类异常为空）和设备
+	EmployeeID   *uuid.UUID `json:"employee_id,omitempty"`
+	EmployeeName string     `json:"employee_name,omitempty"`
+	DeviceSN     string     `json:"device_sn,omitempty"`
+
+	// 涉及的打卡记录及首条记录的打卡时间
+	RecordIDs  []uuid.UUID `json:"record_ids"`
+	OccurredAt time.Time   `json:"occurred_at"`
+
+	Description string                 `json:"description"`
+	Details     map[string]interface{} `json:"details,omitempty"` // 距离、速度、偏差等分析数据
+
+	// Fingerprint 去重键（类型 + 涉及对象），重复分析同一时段不会重复生成
+	Fingerprint string `json:"-"`
+
+	// HR 处理
+	HandledBy     *uuid.UUID `json:"handled_by,omitempty"`
+	HandledAt     *time.Time `json:"handled_at,omitempty"`
+	HandleComment string     `json:"handle_comment,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// AnomalyThresholds 异常识别阈值
+type AnomalyThresholds struct {
+	// 异地打卡：两次打卡间距超过 MinTravelKm 且折算速度超过 MaxSpeedKmh
+	MaxSpeedKmh float64 `json:"max_speed_kmh"`
+	MinTravelKm float64 `json:"min_travel_km"`
+
+	// 代打卡：同一设备 BuddyWindowSeconds 秒内出现至少 BuddyMinEmployees 名员工
+	BuddyWindowSeconds int `json:"buddy_window_seconds"`
+	BuddyMinEmployees  int `json:"buddy_min_employees"`
+
+	// 设备时钟偏差超过该分钟数
+	ClockDriftMinutes int `json:"clock_drift_minutes"`
+
+	// 同一员工相同坐标（精确到 6 位小数）出现次数
+	RepeatedCoordinateCount int `json:"repeated_coordinate_count"`
+}
+
+// DefaultAnomalyThresholds 默认阈值
+func DefaultAnomalyThresholds() AnomalyThresholds {
+	return AnomalyThresholds{
+		MaxSpeedKmh:             120,
+		MinTravelKm:             1,
+		BuddyWindowSeconds:      10,
+		BuddyMinEmployees:       4,
+		ClockDriftMinutes:       5,
+		RepeatedCoordinateCount: 3,
+	}
+}
@@ -100,6 +100,7 @@ const (
 	MethodFingerprint AttendanceMethod = "fingerprint" // 指纹
 	MethodCard        AttendanceMethod = "card"        // 刷卡
 	MethodManual      AttendanceMethod = "manual"      // 手动补卡
+	MethodQRCode      AttendanceMethod = "qrcode"      // 扫描考勤屏动态二维码
 )
 
 // SourceType 数据来源类型
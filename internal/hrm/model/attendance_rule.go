@@ -29,8 +29,9 @@ type AttendanceRule struct {
 	DefaultShiftID *uuid.UUID `json:"default_shift_id,omitempty"`
 
 	// 打卡位置限制
-	LocationRequired bool              `json:"location_required"`           // 是否必须定位
-	AllowedLocations []AllowedLocation `json:"allowed_locations,omitempty"` // 允许的打卡位置
+	LocationRequired bool              `json:"location_required"`            // 是否必须定位
+	AllowedLocations []AllowedLocation `json:"allowed_locations,omitempty"`  // 允许的打卡位置
+	ClockLocationIDs []uuid.UUID       `json:"clock_location_ids,omitempty"` // 关联打卡地点（多边形围栏、时段、二维码）
 
 	// WiFi限制
 	WiFiRequired bool     `json:"wifi_required"`          // 是否必须连接指定WiFi
@@ -47,6 +48,9 @@ type AttendanceRule struct {
 	// 节假日设置
 	HolidayCalendarID *uuid.UUID `json:"holiday_calendar_id,omitempty"` // 关联假期日历
 
+	// 加班政策
+	OvertimePolicyID *uuid.UUID `json:"overtime_policy_id,omitempty"` // 关联加班政策
+
 	// 审批设置
 	RequireApprovalForLate  bool `json:"require_approval_for_late"`  // 迟到需要审批
 	RequireApprovalForEarly bool `json:"require_approval_for_early"` // 早退需要审批
@@ -0,0 +1,94 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AttendanceSummary 状态
+const (
+	AttendanceSummaryStatusDraft     = "draft"     // 草稿（可重算）
+	AttendanceSummaryStatusConfirmed = "confirmed" // HR 已确认，底层数据变动后退回草稿
+	AttendanceSummaryStatusLocked    = "locked"    // 已锁定（已结算），不再重算且拒绝修改底层数据
+)
+
+// IsLocked 是否已锁定
+func (s *AttendanceSummary) IsLocked() bool {
+	return s.Status == AttendanceSummaryStatusLocked
+}
+
+// AttendancePeriod 员工考勤月份（汇总重算的最小单位）
+type AttendancePeriod struct {
+	TenantID   uuid.UUID `json:"tenant_id"`
+	EmployeeID uuid.UUID `json:"employee_id"`
+	Year       int       `json:"year"`
+	Month      int       `json:"month"`
+}
+
+// MonthRange 月份的首日和末日
+func MonthRange(year, month int, loc *time.Location) (time.Time, time.Time) {
+	start := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, loc)
+	return start, start.AddDate(0, 1, -1)
+}
+
+// AttendanceDayCode 逐日考勤状态（月度考勤表中每个员工每天一格）
+type AttendanceDayCode string
+
+const (
+	AttendanceDayNormal    AttendanceDayCode = "normal"     // 正常出勤
+	AttendanceDayLate      AttendanceDayCode = "late"       // 迟到
+	AttendanceDayEarly     AttendanceDayCode = "early"      // 早退
+	AttendanceDayLateEarly AttendanceDayCode = "late_early" // 迟到且早退
+	AttendanceDayMissing   AttendanceDayCode = "missing"    // 缺卡
+	AttendanceDayAbsent    AttendanceDayCode = "absent"     // 旷工
+	AttendanceDayLeave     AttendanceDayCode = "leave"      // 请假
+	AttendanceDayTrip      AttendanceDayCode = "trip"       // 出差
+	AttendanceDayOvertime  AttendanceDayCode = "overtime"   // 休息日/节假日加班
+	AttendanceDayRest      AttendanceDayCode = "rest"       // 休息日
+	AttendanceDayHoliday   AttendanceDayCode = "holiday"    // 节假日
+	AttendanceDayPending   AttendanceDayCode = "pending"    // 尚未到统计截止日
+)
+
+// AttendanceDayStatus 员工某日的考勤状态
+type AttendanceDayStatus struct {
+	Date    time.Time         `json:"date"`
+	DayType DayType           `json:"day_type"`
+	Code    AttendanceDayCode `json:"code"`
+}
+
+// AttendanceLeaveDays 员工某月某请假类型的请假天数
+type AttendanceLeaveDays struct {
+	LeaveTypeID   uuid.UUID `json:"leave_type_id"`
+	LeaveTypeName string    `json:"leave_type_name"`
+	Days          float64   `json:"days"`
+}
+
+// AttendanceOvertimeHours 员工某月已批准加班按类型、补偿方式和倍率合并的计薪时长
+type AttendanceOvertimeHours struct {
+	OvertimeType OvertimeType `json:"overtime_type"`
+	PayType      string       `json:"pay_type"`
+	PayRate      float64      `json:"pay_rate"`
+	Hours        float64      `json:"hours"`
+}
+
+// AttendanceTripAllowance 员工某月已批准报销单中的出差补助（按币种合计）
+type AttendanceTripAllowance struct {
+	Currency string  `json:"currency"`
+	Amount   float64 `json:"amount"`
+}
+
+// AttendanceLockSnapshot 锁定时固化的请假、加班和出差补助明细，
+// 薪资对接只读取快照，锁定后底层单据的变动不影响导出结果
+type AttendanceLockSnapshot struct {
+	Leaves         []*AttendanceLeaveDays     `json:"leaves"`
+	Overtimes      []*AttendanceOvertimeHours `json:"overtimes"`
+	TripAllowances []*AttendanceTripAllowance `json:"trip_allowances"`
+}
+
+// AttendanceMonthSheet 员工月度考勤表（逐日状态 + 月度汇总 + 按类型拆分的请假天数）
+type AttendanceMonthSheet struct {
+	Summary *AttendanceSummary     `json:"summary"`
+	Days    []*AttendanceDayStatus `json:"days"`
+	Leaves  []*AttendanceLeaveDays `json:"leaves"`
+}
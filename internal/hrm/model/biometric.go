@@ -0,0 +1,140 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BiometricType 生物特征类型
+type BiometricType string
+
+const (
+	BiometricFingerprint BiometricType = "fingerprint" // 指纹
+	BiometricFace        BiometricType = "face"        // 人脸
+)
+
+// MaxFingerIndex 手指编号上限（0-9）
+const MaxFingerIndex = 9
+
+// IsValid 是否为支持的生物特征类型
+func (t BiometricType) IsValid() bool {
+	return t == BiometricFingerprint || t == BiometricFace
+}
+
+// BiometricTemplate 员工生物特征模板
+// 模板只能被同一设备类型、同一算法主版本的设备识别，因此按 (员工, 类型, 编号, 设备类型, 算法版本) 分别保存；
+// 同一组合重新采集时 Revision 递增，下发时使用最新修订
+type BiometricTemplate struct {
+	ID               uuid.UUID     `json:"id"`
+	TenantID         uuid.UUID     `json:"tenant_id"`
+	EmployeeID       uuid.UUID     `json:"employee_id"`
+	Type             BiometricType `json:"type"`
+	Index            int           `json:"index"`             // 手指编号 0-9，人脸为模板序号
+	DeviceType       DeviceType    `json:"device_type"`       // 采集设备的类型（设备族）
+	AlgorithmVersion string        `json:"algorithm_version"` // 算法主版本，如中控指纹 10、人脸 7
+	Revision         int           `json:"revision"`          // 采集修订号，从 1 递增
+	Template         string        `json:"-"`                 // 模板数据（加密存储，不对外返回）
+	SourceDeviceID   *uuid.UUID    `json:"source_device_id,omitempty"`
+	CreatedAt        time.Time     `json:"created_at"`
+}
+
+// CompatibleWith 模板能否被设备识别（设备类型和算法版本一致）
+func (t *BiometricTemplate) CompatibleWith(device *AttendanceDevice) bool {
+	return t.DeviceType == device.DeviceType && t.AlgorithmVersion != "" &&
+		t.AlgorithmVersion == device.BiometricVersion(t.Type)
+}
+
+// BiometricDistributionAction 生物特征下发操作
+type BiometricDistributionAction string
+
+const (
+	BiometricDistributionPush   BiometricDistributionAction = "push"   // 下发用户和模板
+	BiometricDistributionRemove BiometricDistributionAction = "remove" // 从设备删除用户（离职）
+)
+
+// BiometricDistributionStatus 生物特征在设备上的状态
+type BiometricDistributionStatus string
+
+const (
+	BiometricDistributionPending      BiometricDistributionStatus = "pending"      // 命令已入队，等待设备执行
+	BiometricDistributionSynced       BiometricDistributionStatus = "synced"       // 设备可识别该员工
+	BiometricDistributionIncompatible BiometricDistributionStatus = "incompatible" // 没有与设备算法兼容的模板，仅下发了用户信息
+	BiometricDistributionRemoving     BiometricDistributionStatus = "removing"     // 删除命令等待设备执行
+	BiometricDistributionRemoved      BiometricDistributionStatus = "removed"      // 已从设备删除
+	BiometricDistributionFailed       BiometricDistributionStatus = "failed"       // 设备执行命令失败
+)
+
+// BiometricDistribution 员工生物特征在单台设备上的下发记录
+// 每个员工、设备一行，保存最近一次操作；状态由该操作之后该员工在设备上的命令执行结果计算
+type BiometricDistribution struct {
+	ID           uuid.UUID `json:"id"`
+	TenantID     uuid.UUID `json:"tenant_id"`
+	EmployeeID   uuid.UUID `json:"employee_id"`
+	EmployeeNo   string    `json:"employee_no"`
+	EmployeeName string    `json:"employee_name"`
+	DeviceID     uuid.UUID `json:"device_id"`
+	DeviceSN     string    `json:"device_sn"`
+	DeviceName   string    `json:"device_name"`
+
+	Action    BiometricDistributionAction `json:"action"`
+	Templates int                         `json:"templates"` // 下发的模板数
+	QueuedAt  time.Time                   `json:"queued_at"`
+
+	PendingCommands int                         `json:"pending_commands"` // 未执行完的命令
+	FailedCommands  int                         `json:"failed_commands"`  // 执行失败的命令
+	Status          BiometricDistributionStatus `json:"status"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ResolveStatus 根据最近一次操作及其命令执行结果计算状态
+func (d *BiometricDistribution) ResolveStatus() BiometricDistributionStatus {
+	switch {
+	case d.FailedCommands > 0:
+		return BiometricDistributionFailed
+	case d.Action == BiometricDistributionRemove && d.PendingCommands > 0:
+		return BiometricDistributionRemoving
+	case d.Action == BiometricDistributionRemove:
+		return BiometricDistributionRemoved
+	case d.PendingCommands > 0:
+		return BiometricDistributionPending
+	case d.Templates == 0:
+		return BiometricDistributionIncompatible
+	default:
+		return BiometricDistributionSynced
+	}
+}
+
+// BiometricEnrollmentStatus 远程采集会话状态
+type BiometricEnrollmentStatus string
+
+const (
+	BiometricEnrollmentPending  BiometricEnrollmentStatus = "pending"  // 等待设备上传模板
+	BiometricEnrollmentCaptured BiometricEnrollmentStatus = "captured" // 已接收设备上传的模板
+)
+
+// BiometricEnrollment 远程采集会话
+// 设备上传的模板只有与未过期的待采集会话（设备、员工、类型、手指编号一致）匹配时才保存，每个会话只接收一次
+type BiometricEnrollment struct {
+	ID         uuid.UUID                 `json:"id"`
+	TenantID   uuid.UUID                 `json:"tenant_id"`
+	EmployeeID uuid.UUID                 `json:"employee_id"`
+	DeviceID   uuid.UUID                 `json:"device_id"`
+	Type       BiometricType             `json:"type"`
+	Index      int                       `json:"index"` // 手指编号，人脸忽略
+	Status     BiometricEnrollmentStatus `json:"status"`
+	ExpiresAt  time.Time                 `json:"expires_at"`
+	CapturedAt *time.Time                `json:"captured_at,omitempty"`
+	CreatedBy  uuid.UUID                 `json:"created_by"`
+	CreatedAt  time.Time                 `json:"created_at"`
+}
+
+// Accepts 会话是否接收该模板（人脸模板序号由设备决定，不比较编号）
+func (e *BiometricEnrollment) Accepts(t BiometricType, index int) bool {
+	if e.Type != t {
+		return false
+	}
+	return t == BiometricFace || e.Index == index
+}
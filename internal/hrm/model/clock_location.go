@@ -0,0 +1,169 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GeoPoint 经纬度坐标
+type GeoPoint struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// ClockTimeWindow 打卡地点允许打卡的时段，End 早于 Start 表示跨零点
+type ClockTimeWindow struct {
+	Weekdays []int  `json:"weekdays,omitempty"` // 0=周日, 1=周一, ..., 6=周六；为空表示每天
+	Start    string `json:"start"`              // HH:MM
+	End      string `json:"end"`                // HH:MM
+}
+
+// Contains 判断时间是否落在该时段内（跨零点时段按开始当天的星期匹配）
+func (w ClockTimeWindow) Contains(t time.Time) bool {
+	start, err1 := time.Parse("15:04", w.Start)
+	end, err2 := time.Parse("15:04", w.End)
+	if err1 != nil || err2 != nil {
+		return false
+	}
+
+	minute := t.Hour()*60 + t.Minute()
+	startMin := start.Hour()*60 + start.Minute()
+	endMin := end.Hour()*60 + end.Minute()
+
+	if startMin <= endMin {
+		return minute >= startMin && minute <= endMin && w.onWeekday(t.Weekday())
+	}
+	// 跨零点：零点前属于当天时段，零点后属于前一天时段
+	if minute >= startMin {
+		return w.onWeekday(t.Weekday())
+	}
+	if minute <= endMin {
+		return w.onWeekday(t.AddDate(0, 0, -1).Weekday())
+	}
+	return false
+}
+
+func (w ClockTimeWindow) onWeekday(day time.Weekday) bool {
+	if len(w.Weekdays) == 0 {
+		return true
+	}
+	for _, d := range w.Weekdays {
+		if d == int(day) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClockLocation 打卡地点：多边形（或圆形）地理围栏、允许打卡时段，以及门店/前台的动态二维码
+type ClockLocation struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID uuid.UUID `json:"tenant_id"`
+
+	Code    string `json:"code"`    // 地点编码
+	Name    string `json:"name"`    // 地点名称
+	Address string `json:"address"` // 地址
+
+	// 地理围栏：Polygon 至少 3 个顶点时按多边形判断，否则按圆心 + 半径判断；都未设置表示不限制位置
+	Polygon   []GeoPoint `json:"polygon,omitempty"`
+	Latitude  float64    `json:"latitude"`
+	Longitude float64    `json:"longitude"`
+	Radius    int        `json:"radius"` // 半径（米）
+
+	// 允许打卡时段，为空表示不限制
+	TimeWindows []ClockTimeWindow `json:"time_windows,omitempty"`
+
+	// 是否必须扫描该地点的动态二维码打卡
+	QRRequired bool `json:"qr_required"`
+
+	// 考勤屏密钥（仅保存 SHA-256），考勤屏凭密钥拉取动态二维码
+	KioskKeyHash      string     `json:"-"`
+	KioskKeyRotatedAt *time.Time `json:"kiosk_key_rotated_at,omitempty"`
+
+	IsActive bool `json:"is_active"`
+
+	CreatedBy uuid.UUID  `json:"created_by"`
+	UpdatedBy uuid.UUID  `json:"updated_by"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+// HasGeofence 是否配置了地理围栏
+func (l *ClockLocation) HasGeofence() bool {
+	return len(l.Polygon) >= 3 || l.Radius > 0
+}
+
+// InTimeWindow 判断时间是否在允许打卡时段内
+func (l *ClockLocation) InTimeWindow(t time.Time) bool {
+	if len(l.TimeWindows) == 0 {
+		return true
+	}
+	for _, w := range l.TimeWindows {
+		if w.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClockRejectReason 打卡被拒原因
+type ClockRejectReason string
+
+const (
+	ClockRejectLocationMissing ClockRejectReason = "location_missing"     // 未提供定位
+	ClockRejectOutsideGeofence ClockRejectReason = "outside_geofence"     // 不在地理围栏内
+	ClockRejectOutsideWindow   ClockRejectReason = "outside_time_window"  // 不在允许打卡时段
+	ClockRejectQRRequired      ClockRejectReason = "qr_required"          // 须扫码打卡
+	ClockRejectQRInvalid       ClockRejectReason = "qr_invalid"           // 二维码无效（签名错误、非本租户）
+	ClockRejectQRExpired       ClockRejectReason = "qr_expired"           // 二维码已过期
+	ClockRejectQRUsed          ClockRejectReason = "qr_used"              // 本周期二维码已扫码打卡过
+	ClockRejectQRLocation      ClockRejectReason = "qr_location_mismatch" // 二维码地点不在员工考勤规则内
+	ClockRejectWiFi            ClockRejectReason = "wifi_not_allowed"     // WiFi 不符合要求
+	ClockRejectFace            ClockRejectReason = "face_failed"          // 人脸识别不符合要求
+)
+
+// ClockAttemptStatus 被拒打卡的复核状态
+type ClockAttemptStatus string
+
+const (
+	ClockAttemptPending   ClockAttemptStatus = "pending"   // 待复核
+	ClockAttemptAccepted  ClockAttemptStatus = "accepted"  // HR 认定有效，已补记考勤
+	ClockAttemptDismissed ClockAttemptStatus = "dismissed" // HR 认定无效
+)
+
+// ClockAttempt 被拒绝的打卡尝试，供 HR 复核
+type ClockAttempt struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID uuid.UUID `json:"tenant_id"`
+
+	EmployeeID    uuid.UUID           `json:"employee_id"`
+	EmployeeName  string              `json:"employee_name"`
+	ClockTime     time.Time           `json:"clock_time"`
+	ClockType     AttendanceClockType `json:"clock_type"`
+	CheckInMethod AttendanceMethod    `json:"check_in_method"`
+	SourceType    SourceType          `json:"source_type"`
+
+	// 客户端提交的打卡信息
+	LocationID *uuid.UUID    `json:"location_id,omitempty"` // 二维码或匹配到的打卡地点
+	Location   *LocationInfo `json:"location,omitempty"`
+	Address    string        `json:"address,omitempty"`
+	WiFiSSID   string        `json:"wifi_ssid,omitempty"`
+	WiFiMAC    string        `json:"wifi_mac,omitempty"`
+	FaceScore  float64       `json:"face_score,omitempty"`
+
+	// 拒绝原因
+	Reason ClockRejectReason `json:"reason"`
+	Detail string            `json:"detail"`
+
+	// 复核
+	Status     ClockAttemptStatus `json:"status"`
+	ReviewedBy *uuid.UUID         `json:"reviewed_by,omitempty"`
+	ReviewedAt *time.Time         `json:"reviewed_at,omitempty"`
+	ReviewNote string             `json:"review_note,omitempty"`
+	RecordID   *uuid.UUID         `json:"record_id,omitempty"` // 认定有效后补记的考勤记录
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -1,6 +1,9 @@
 package model
 
 import (
+	"crypto/subtle"
+	"net"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -52,6 +55,15 @@ type AttendanceDevice struct {
 	LastHeartbeat *time.Time   `json:"last_heartbeat,omitempty"` // 最后心跳时间
 	ErrorMessage  string       `json:"error_message,omitempty"`  // 错误信息
 
+	// 推送协议（ADMS）
+	PushStamp          string `json:"push_stamp,omitempty"`          // 已接收的考勤记录上传戳，设备据此续传
+	FirmwareVersion    string `json:"firmware_version,omitempty"`    // 心跳上报的固件版本
+	FingerprintVersion string `json:"fingerprint_version,omitempty"` // 心跳上报的指纹算法版本
+	FaceVersion        string `json:"face_version,omitempty"`        // 心跳上报的人脸算法版本
+
+	// PushAllowedIPs 允许接入推送协议的来源地址（IP 或 CIDR），为空时只校验通讯密钥
+	PushAllowedIPs []string `json:"push_allowed_ips,omitempty"`
+
 	// 统计信息
 	TotalRecords int `json:"total_records"` // 总记录数
 	TodayRecords int `json:"today_records"` // 今日记录数
@@ -67,6 +79,74 @@ type AttendanceDevice struct {
 	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 }
 
+// BiometricVersion 设备识别该类生物特征的算法版本，未上报时为空
+func (d *AttendanceDevice) BiometricVersion(t BiometricType) string {
+	switch t {
+	case BiometricFingerprint:
+		return d.FingerprintVersion
+	case BiometricFace:
+		return d.FaceVersion
+	}
+	return ""
+}
+
+// CommKey 设备通讯密钥（优先取 SecretKey，未配置时取设备密码）
+func (d *AttendanceDevice) CommKey() string {
+	if d.SecretKey != "" {
+		return d.SecretKey
+	}
+	return d.Password
+}
+
+// HasPushCredentials 是否配置了推送协议的接入凭据（通讯密钥或来源地址）
+func (d *AttendanceDevice) HasPushCredentials() bool {
+	return d.CommKey() != "" || len(d.PushAllowedIPs) > 0
+}
+
+// AuthenticatePush 校验推送请求：配置了通讯密钥时必须一致，配置了来源地址时必须在其中；
+// 两者都未配置的设备一律拒绝，仅凭序列号不能接入
+func (d *AttendanceDevice) AuthenticatePush(commKey, remoteIP string) bool {
+	if !d.HasPushCredentials() {
+		return false
+	}
+	if key := d.CommKey(); key != "" && subtle.ConstantTimeCompare([]byte(key), []byte(commKey)) != 1 {
+		return false
+	}
+	if len(d.PushAllowedIPs) > 0 && !ipAllowed(d.PushAllowedIPs, remoteIP) {
+		return false
+	}
+	return true
+}
+
+// ValidPushAddress 是否为合法的来源地址（IP 或 CIDR）
+func ValidPushAddress(entry string) bool {
+	entry = strings.TrimSpace(entry)
+	if _, _, err := net.ParseCIDR(entry); err == nil {
+		return true
+	}
+	return net.ParseIP(entry) != nil
+}
+
+func ipAllowed(allowed []string, remoteIP string) bool {
+	ip := net.ParseIP(strings.TrimSpace(remoteIP))
+	if ip == nil {
+		return false
+	}
+	for _, entry := range allowed {
+		entry = strings.TrimSpace(entry)
+		if _, network, err := net.ParseCIDR(entry); err == nil {
+			if network.Contains(ip) {
+				return true
+			}
+			continue
+		}
+		if allowedIP := net.ParseIP(entry); allowedIP != nil && allowedIP.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
 // DeviceType 设备类型
 type DeviceType string
 
@@ -88,6 +168,94 @@ const (
 	DeviceStatusError   DeviceStatus = "error"   // 异常
 )
 
+// DeviceUser 考勤机上的用户，PIN 为员工工号
+type DeviceUser struct {
+	EmployeeID   uuid.UUID `json:"employee_id"`
+	PIN          string    `json:"pin"`
+	Name         string    `json:"name"`
+	DepartmentID uuid.UUID `json:"department_id"`
+	CardNo       string    `json:"card_no,omitempty"`
+}
+
+// DeviceCommandType 下发给设备的命令类型
+type DeviceCommandType string
+
+const (
+	DeviceCommandUserUpload DeviceCommandType = "user_upload" // 下发/更新用户
+	DeviceCommandUserDelete DeviceCommandType = "user_delete" // 删除用户
+	DeviceCommandClearLog   DeviceCommandType = "clear_log"   // 清空考勤记录
+	DeviceCommandReboot     DeviceCommandType = "reboot"      // 重启设备
+	DeviceCommandSetTime    DeviceCommandType = "set_time"    // 校准设备时间
+
+	DeviceCommandTemplateUpload DeviceCommandType = "template_upload" // 下发生物特征模板
+	DeviceCommandEnroll         DeviceCommandType = "enroll"          // 远程启动生物特征采集
+)
+
+// DeviceCommandStatus 设备命令状态
+type DeviceCommandStatus string
+
+const (
+	DeviceCommandPending   DeviceCommandStatus = "pending"   // 待设备拉取
+	DeviceCommandSent      DeviceCommandStatus = "sent"      // 设备已拉取，等待回执
+	DeviceCommandSucceeded DeviceCommandStatus = "succeeded" // 执行成功
+	DeviceCommandFailed    DeviceCommandStatus = "failed"    // 执行失败
+)
+
+// DeviceCommand 设备命令队列（推送协议的设备在心跳时拉取）
+type DeviceCommand struct {
+	ID       uuid.UUID `json:"id"`
+	Seq      int64     `json:"seq"` // 协议中的命令编号，设备回执时携带
+	TenantID uuid.UUID `json:"tenant_id"`
+	DeviceID uuid.UUID `json:"device_id"`
+	DeviceSN string    `json:"device_sn"`
+
+	// EmployeeID 命令涉及的员工（生物特征下发、删除），用于计算下发状态
+	EmployeeID *uuid.UUID `json:"employee_id,omitempty"`
+
+	CommandType DeviceCommandType   `json:"command_type"`
+	Content     string              `json:"content"` // 协议命令文本
+	Status      DeviceCommandStatus `json:"status"`
+	ReturnCode  *int                `json:"return_code,omitempty"` // 设备回执，0 为成功
+
+	SentAt      *time.Time `json:"sent_at,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+
+	CreatedBy *uuid.UUID `json:"created_by,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// DeviceHealth 设备运行状况（设备管理列表展示）
+type DeviceHealth struct {
+	DeviceID        uuid.UUID    `json:"device_id"`
+	DeviceSN        string       `json:"device_sn"`
+	DeviceName      string       `json:"device_name"`
+	DeviceModel     string       `json:"device_model,omitempty"`
+	FirmwareVersion string       `json:"firmware_version,omitempty"`
+	Status          DeviceStatus `json:"status"`
+	Online          bool         `json:"online"` // 适配器探测结果
+	IsActive        bool         `json:"is_active"`
+	ErrorMessage    string       `json:"error_message,omitempty"`
+
+	LastHeartbeat *time.Time `json:"last_heartbeat,omitempty"`
+	LastSyncAt    *time.Time `json:"last_sync_at,omitempty"`
+	SyncLag       *int64     `json:"sync_lag_seconds,omitempty"` // 距最后一次上传考勤记录的秒数，从未上传时为空
+
+	UserCount   int `json:"user_count"`
+	RecordCount int `json:"record_count"`
+
+	PendingCommands int        `json:"pending_commands"`            // 未执行完的命令（待拉取、已拉取未回执）
+	OldestPending   *time.Time `json:"oldest_pending_at,omitempty"` // 最早未执行完命令的创建时间
+	FailedCommands  int        `json:"failed_commands"`             // 统计窗口内执行失败的命令
+}
+
+// DeviceCommandStats 单台设备的命令执行统计
+type DeviceCommandStats struct {
+	Pending       int        `json:"pending"`
+	OldestPending *time.Time `json:"oldest_pending,omitempty"`
+	Failed        int        `json:"failed"`
+}
+
 // ThirdPartyIntegration 第三方平台集成配置
 type ThirdPartyIntegration struct {
 	ID       uuid.UUID `json:"id"`
@@ -120,6 +288,7 @@ type ThirdPartyIntegration struct {
 	SyncInterval   int        `json:"sync_interval"`   // 同步间隔（分钟）
 	SyncDirection  string     `json:"sync_direction"`  // both, pull, push
 	LastSyncAt     *time.Time `json:"last_sync_at,omitempty"`
+	SyncCursor     string     `json:"sync_cursor,omitempty"` // 考勤增量拉取游标（平台适配器返回，原样保存）
 
 	// 字段映射配置
 	FieldMapping map[string]string `json:"field_mapping,omitempty"` // 字段映射关系
@@ -0,0 +1,158 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LifecycleAction 员工生命周期操作
+type LifecycleAction string
+
+const (
+	LifecycleHire      LifecycleAction = "hire"      // 入职
+	LifecycleConfirm   LifecycleAction = "confirm"   // 试用期转正
+	LifecycleTransfer  LifecycleAction = "transfer"  // 调岗（组织/职位/上级）
+	LifecycleResign    LifecycleAction = "resign"    // 主动离职
+	LifecycleTerminate LifecycleAction = "terminate" // 辞退
+)
+
+// IsValid 是否为支持的生命周期操作
+func (a LifecycleAction) IsValid() bool {
+	switch a {
+	case LifecycleHire, LifecycleConfirm, LifecycleTransfer, LifecycleResign, LifecycleTerminate:
+		return true
+	}
+	return false
+}
+
+// IsOffboarding 是否为离职类操作
+func (a LifecycleAction) IsOffboarding() bool {
+	return a == LifecycleResign || a == LifecycleTerminate
+}
+
+// ProcessCode 对应的审批流程编码，租户需在审批模块中创建该编码的流程定义
+func (a LifecycleAction) ProcessCode() string {
+	return "hrm_employee_" + string(a)
+}
+
+// LifecycleRequestStatus 生命周期申请状态
+type LifecycleRequestStatus string
+
+const (
+	LifecycleRequestPending   LifecycleRequestStatus = "pending"   // 审批中
+	LifecycleRequestApproved  LifecycleRequestStatus = "approved"  // 已通过，等待生效日执行
+	LifecycleRequestCompleted LifecycleRequestStatus = "completed" // 已执行
+	LifecycleRequestRejected  LifecycleRequestStatus = "rejected"  // 已驳回
+	LifecycleRequestCancelled LifecycleRequestStatus = "cancelled" // 已撤回/取消
+	LifecycleRequestFailed    LifecycleRequestStatus = "failed"    // 执行失败（下次任务重试）
+)
+
+// IsOpen 申请是否仍未结束（同一员工同时只能有一个未结束的申请）
+func (s LifecycleRequestStatus) IsOpen() bool {
+	return s == LifecycleRequestPending || s == LifecycleRequestApproved || s == LifecycleRequestFailed
+}
+
+// LifecycleHireInfo 入职信息
+type LifecycleHireInfo struct {
+	UserID          uuid.UUID  `json:"user_id"` // 已创建的系统账号
+	EmployeeNo      string     `json:"employee_no"`
+	Name            string     `json:"name"`
+	Gender          string     `json:"gender,omitempty"`
+	Mobile          string     `json:"mobile,omitempty"`
+	Email           string     `json:"email,omitempty"`
+	OrgID           uuid.UUID  `json:"org_id"`
+	PositionID      *uuid.UUID `json:"position_id,omitempty"`
+	DirectLeaderID  *uuid.UUID `json:"direct_leader_id,omitempty"`
+	ProbationMonths int        `json:"probation_months"` // 试用期月数，0 表示直接转正
+}
+
+// LifecycleTransferInfo 调岗信息（为空的字段保持不变）
+type LifecycleTransferInfo struct {
+	FromOrgID      uuid.UUID  `json:"from_org_id"`
+	FromPositionID *uuid.UUID `json:"from_position_id,omitempty"`
+	ToOrgID        *uuid.UUID `json:"to_org_id,omitempty"`
+	ToPositionID   *uuid.UUID `json:"to_position_id,omitempty"`
+	ToLeaderID     *uuid.UUID `json:"to_leader_id,omitempty"`
+}
+
+// LifecycleSeparationInfo 离职信息
+type LifecycleSeparationInfo struct {
+	// 待办审批任务的接手人（员工ID），为空时转给直接上级
+	HandoverEmployeeID *uuid.UUID `json:"handover_employee_id,omitempty"`
+}
+
+// EmployeeLifecycleRequest 员工生命周期申请：经审批模块审批，通过后在生效日执行
+type EmployeeLifecycleRequest struct {
+	ID            uuid.UUID              `json:"id"`
+	TenantID      uuid.UUID              `json:"tenant_id"`
+	EmployeeID    *uuid.UUID             `json:"employee_id,omitempty"` // 入职申请在执行后回填
+	EmployeeName  string                 `json:"employee_name"`
+	Action        LifecycleAction        `json:"action"`
+	Status        LifecycleRequestStatus `json:"status"`
+	EffectiveDate time.Time              `json:"effective_date"` // 入职/转正/调岗/离职日期
+	Reason        string                 `json:"reason,omitempty"`
+
+	Hire       *LifecycleHireInfo       `json:"hire,omitempty"`
+	Transfer   *LifecycleTransferInfo   `json:"transfer,omitempty"`
+	Separation *LifecycleSeparationInfo `json:"separation,omitempty"`
+
+	ApprovalInstanceID *uuid.UUID `json:"approval_instance_id,omitempty"`
+	RequestedBy        uuid.UUID  `json:"requested_by"`
+	DecidedAt          *time.Time `json:"decided_at,omitempty"`
+	CompletedAt        *time.Time `json:"completed_at,omitempty"`
+	FailureReason      string     `json:"failure_reason,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// LifecycleEventType 生命周期领域事件类型
+type LifecycleEventType string
+
+const (
+	LifecycleEventRequested         LifecycleEventType = "lifecycle.requested"
+	LifecycleEventApproved          LifecycleEventType = "lifecycle.approved"
+	LifecycleEventRejected          LifecycleEventType = "lifecycle.rejected"
+	LifecycleEventCancelled         LifecycleEventType = "lifecycle.cancelled"
+	LifecycleEventFailed            LifecycleEventType = "lifecycle.failed"
+	LifecycleEventHired             LifecycleEventType = "employee.hired"
+	LifecycleEventConfirmed         LifecycleEventType = "employee.confirmed"
+	LifecycleEventTransferred       LifecycleEventType = "employee.transferred"
+	LifecycleEventOffboarded        LifecycleEventType = "employee.offboarded"
+	LifecycleEventProbationReminder LifecycleEventType = "employee.probation_reminder"
+)
+
+// EmployeeLifecycleEvent 生命周期领域事件（只追加，供审计和下游模块订阅）
+type EmployeeLifecycleEvent struct {
+	ID         uuid.UUID              `json:"id"`
+	TenantID   uuid.UUID              `json:"tenant_id"`
+	EmployeeID *uuid.UUID             `json:"employee_id,omitempty"`
+	RequestID  *uuid.UUID             `json:"request_id,omitempty"`
+	Type       LifecycleEventType     `json:"type"`
+	Action     LifecycleAction        `json:"action,omitempty"`
+	Payload    map[string]interface{} `json:"payload,omitempty"`
+	OccurredAt time.Time              `json:"occurred_at"`
+}
+
+// ProbationReminderStatus 试用期提醒状态
+type ProbationReminderStatus string
+
+const (
+	ProbationReminderPending   ProbationReminderStatus = "pending"
+	ProbationReminderSent      ProbationReminderStatus = "sent"
+	ProbationReminderCancelled ProbationReminderStatus = "cancelled" // 已转正或离职
+)
+
+// ProbationReminder 试用期到期提醒（入职时按试用期结束日期自动排期）
+type ProbationReminder struct {
+	ID           uuid.UUID               `json:"id"`
+	TenantID     uuid.UUID               `json:"tenant_id"`
+	EmployeeID   uuid.UUID               `json:"employee_id"`
+	ProbationEnd time.Time               `json:"probation_end"`
+	RemindOn     time.Time               `json:"remind_on"`
+	DaysBefore   int                     `json:"days_before"`
+	Status       ProbationReminderStatus `json:"status"`
+	SentAt       *time.Time              `json:"sent_at,omitempty"`
+	CreatedAt    time.Time               `json:"created_at"`
+}
@@ -0,0 +1,101 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HolidayCalendar 假期日历（租户维护，考勤规则通过 HolidayCalendarID 关联）
+type HolidayCalendar struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID uuid.UUID `json:"tenant_id"`
+
+	// 日历基本信息
+	Code        string `json:"code"`        // 日历编码
+	Name        string `json:"name"`        // 日历名称
+	Description string `json:"description"` // 描述
+	Region      string `json:"region"`      // 适用地区，如 CN
+
+	// 未关联日历的考勤规则使用租户默认日历
+	IsDefault bool `json:"is_default"`
+	IsActive  bool `json:"is_active"`
+
+	// 审计字段
+	CreatedBy uuid.UUID  `json:"created_by"`
+	UpdatedBy uuid.UUID  `json:"updated_by"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+// CalendarDayKind 日历特殊日类型
+type CalendarDayKind string
+
+const (
+	CalendarDayHoliday       CalendarDayKind = "holiday"        // 放假
+	CalendarDayMakeupWorkday CalendarDayKind = "makeup_workday" // 调休上班（通常落在周末）
+)
+
+// HolidayCalendarDay 日历特殊日（放假或调休上班），未登记的日期按考勤规则的周末设置判断
+type HolidayCalendarDay struct {
+	ID         uuid.UUID       `json:"id"`
+	TenantID   uuid.UUID       `json:"tenant_id"`
+	CalendarID uuid.UUID       `json:"calendar_id"`
+	Date       time.Time       `json:"date"`
+	Kind       CalendarDayKind `json:"kind"`
+	Name       string          `json:"name"` // 节日名称，如 春节
+
+	// 法定节假日当天加班按节假日计，其余调休放假日按休息日计
+	IsStatutory bool `json:"is_statutory"`
+
+	Remark    string    `json:"remark,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// DayType 日期类型（考勤状态、加班类型、请假天数的判定依据）
+type DayType string
+
+const (
+	DayTypeWorkday DayType = "workday" // 工作日（含调休上班日）
+	DayTypeWeekend DayType = "weekend" // 休息日
+	DayTypeHoliday DayType = "holiday" // 节假日
+)
+
+// DayTypeSource 日期类型判定来源
+type DayTypeSource string
+
+const (
+	DayTypeSourceSchedule DayTypeSource = "schedule" // 排班指定
+	DayTypeSourceCalendar DayTypeSource = "calendar" // 假期日历
+	DayTypeSourceRule     DayTypeSource = "rule"     // 考勤规则周末设置
+)
+
+// DayInfo 员工某日的日期类型
+type DayInfo struct {
+	Date        time.Time     `json:"date"`
+	Type        DayType       `json:"type"`
+	Name        string        `json:"name,omitempty"` // 节日名称
+	IsStatutory bool          `json:"is_statutory"`   // 是否法定节假日
+	IsMakeup    bool          `json:"is_makeup"`      // 是否调休上班日
+	Source      DayTypeSource `json:"source"`
+	CalendarID  *uuid.UUID    `json:"calendar_id,omitempty"`
+}
+
+// IsWorkday 是否需要上班
+func (d *DayInfo) IsWorkday() bool {
+	return d.Type == DayTypeWorkday
+}
+
+// OvertimeType 该日加班对应的加班类型：法定节假日按节假日，其余休息日按周末
+func (d *DayInfo) OvertimeType() OvertimeType {
+	switch {
+	case d.Type == DayTypeHoliday && d.IsStatutory:
+		return OvertimeTypeHoliday
+	case d.Type == DayTypeWorkday:
+		return OvertimeTypeWorkday
+	default:
+		return OvertimeTypeWeekend
+	}
+}
@@ -15,6 +15,23 @@ const (
 	LeaveUnitHour    LeaveUnit = "hour"     // 小时
 )
 
+// LeaveRoundingMode 请假时长取整方式
+type LeaveRoundingMode string
+
+const (
+	LeaveRoundingUp      LeaveRoundingMode = "up"      // 向上取整
+	LeaveRoundingDown    LeaveRoundingMode = "down"    // 向下取整
+	LeaveRoundingNearest LeaveRoundingMode = "nearest" // 四舍五入
+	LeaveRoundingNone    LeaveRoundingMode = "none"    // 不取整
+)
+
+// LeaveDurationPolicy 请假时长计算规则
+type LeaveDurationPolicy struct {
+	RoundingMode    LeaveRoundingMode `json:"rounding_mode"`     // 每日时长的取整方式
+	RoundingStep    float64           `json:"rounding_step"`     // 取整步长（按请假单位，如 0.5 天、0.5 小时）
+	IncludeRestDays bool              `json:"include_rest_days"` // 休息日、节假日是否计入（如产假按自然日）
+}
+
 // LeaveRequestStatus 请假申请状态
 type LeaveRequestStatus string
 
@@ -99,28 +116,30 @@ func (r *ApprovalRules) GetApprovalChain(duration float64) []*ApprovalNode {
 
 // LeaveType 请假类型
 type LeaveType struct {
-	ID               uuid.UUID      `json:"id"`
-	TenantID         uuid.UUID      `json:"tenant_id"`
-	Code             string         `json:"code"`              // 类型编码
-	Name             string         `json:"name"`              // 类型名称
-	Description      string         `json:"description"`       // 描述
-	IsPaid           bool           `json:"is_paid"`           // 是否带薪
-	RequiresApproval bool           `json:"requires_approval"` // 是否需要审批
-	RequiresProof    bool           `json:"requires_proof"`    // 是否需要证明材料
-	DeductQuota      bool           `json:"deduct_quota"`      // 是否扣除额度
-	Unit             LeaveUnit      `json:"unit"`              // 最小单位
-	MinDuration      float64        `json:"min_duration"`      // 最小请假时长
-	MaxDuration      *float64       `json:"max_duration"`      // 最大请假时长
-	AdvanceDays      int            `json:"advance_days"`      // 需要提前申请的天数
-	ApprovalRules    *ApprovalRules `json:"approval_rules"`    // 审批规则配置
-	Color            string         `json:"color"`             // UI显示颜色
-	IsActive         bool           `json:"is_active"`         // 是否启用
-	Sort             int            `json:"sort"`              // 排序
-	CreatedBy        *uuid.UUID     `json:"created_by"`
-	UpdatedBy        *uuid.UUID     `json:"updated_by"`
-	CreatedAt        time.Time      `json:"created_at"`
-	UpdatedAt        time.Time      `json:"updated_at"`
-	DeletedAt        *time.Time     `json:"deleted_at"`
+	ID               uuid.UUID            `json:"id"`
+	TenantID         uuid.UUID            `json:"tenant_id"`
+	Code             string               `json:"code"`              // 类型编码
+	Name             string               `json:"name"`              // 类型名称
+	Description      string               `json:"description"`       // 描述
+	IsPaid           bool                 `json:"is_paid"`           // 是否带薪
+	RequiresApproval bool                 `json:"requires_approval"` // 是否需要审批
+	RequiresProof    bool                 `json:"requires_proof"`    // 是否需要证明材料
+	DeductQuota      bool                 `json:"deduct_quota"`      // 是否扣除额度
+	Unit             LeaveUnit            `json:"unit"`              // 最小单位
+	MinDuration      float64              `json:"min_duration"`      // 最小请假时长
+	MaxDuration      *float64             `json:"max_duration"`      // 最大请假时长
+	AdvanceDays      int                  `json:"advance_days"`      // 需要提前申请的天数
+	ApprovalRules    *ApprovalRules       `json:"approval_rules"`    // 审批规则配置
+	DurationPolicy   *LeaveDurationPolicy `json:"duration_policy"`   // 时长计算规则，为空时按单位默认规则
+	AccrualPolicy    *LeaveAccrualPolicy  `json:"accrual_policy"`    // 额度发放规则，为空时按类型默认额度初始化
+	Color            string               `json:"color"`             // UI显示颜色
+	IsActive         bool                 `json:"is_active"`         // 是否启用
+	Sort             int                  `json:"sort"`              // 排序
+	CreatedBy        *uuid.UUID           `json:"created_by"`
+	UpdatedBy        *uuid.UUID           `json:"updated_by"`
+	CreatedAt        time.Time            `json:"created_at"`
+	UpdatedAt        time.Time            `json:"updated_at"`
+	DeletedAt        *time.Time           `json:"deleted_at"`
 }
 
 // LeaveQuota 请假额度
@@ -145,31 +164,75 @@ func (q *LeaveQuota) RemainingQuota() float64 {
 
 // LeaveRequest 请假申请
 type LeaveRequest struct {
-	ID                uuid.UUID          `json:"id"`
-	TenantID          uuid.UUID          `json:"tenant_id"`
-	EmployeeID        uuid.UUID          `json:"employee_id"`         // 申请人ID
-	EmployeeName      string             `json:"employee_name"`       // 申请人姓名
-	DepartmentID      *uuid.UUID         `json:"department_id"`       // 部门ID
-	LeaveTypeID       uuid.UUID          `json:"leave_type_id"`       // 请假类型ID
-	LeaveTypeName     string             `json:"leave_type_name"`     // 请假类型名称
-	StartTime         time.Time          `json:"start_time"`          // 开始时间
-	EndTime           time.Time          `json:"end_time"`            // 结束时间
-	Duration          float64            `json:"duration"`            // 请假时长
-	Unit              LeaveUnit          `json:"unit"`                // 单位
-	Reason            string             `json:"reason"`              // 请假原因
-	ProofURLs         []string           `json:"proof_urls"`          // 证明材料附件URL数组
-	Status            LeaveRequestStatus `json:"status"`              // 状态
-	CurrentApproverID *uuid.UUID         `json:"current_approver_id"` // 当前审批人ID
-	SubmittedAt       *time.Time         `json:"submitted_at"`        // 提交时间
-	ApprovedAt        *time.Time         `json:"approved_at"`         // 审批通过时间
-	RejectedAt        *time.Time         `json:"rejected_at"`         // 拒绝时间
-	CancelledAt       *time.Time         `json:"cancelled_at"`        // 取消时间
-	Remark            string             `json:"remark"`              // 备注
-	CreatedBy         *uuid.UUID         `json:"created_by"`
-	UpdatedBy         *uuid.UUID         `json:"updated_by"`
-	CreatedAt         time.Time          `json:"created_at"`
-	UpdatedAt         time.Time          `json:"updated_at"`
-	DeletedAt         *time.Time         `json:"deleted_at"`
+	ID                uuid.UUID           `json:"id"`
+	TenantID          uuid.UUID           `json:"tenant_id"`
+	EmployeeID        uuid.UUID           `json:"employee_id"`         // 申请人ID
+	EmployeeName      string              `json:"employee_name"`       // 申请人姓名
+	DepartmentID      *uuid.UUID          `json:"department_id"`       // 部门ID
+	LeaveTypeID       uuid.UUID           `json:"leave_type_id"`       // 请假类型ID
+	LeaveTypeName     string              `json:"leave_type_name"`     // 请假类型名称
+	StartTime         time.Time           `json:"start_time"`          // 开始时间
+	EndTime           time.Time           `json:"end_time"`            // 结束时间
+	Duration          float64             `json:"duration"`            // 请假时长
+	Unit              LeaveUnit           `json:"unit"`                // 单位
+	Reason            string              `json:"reason"`              // 请假原因
+	ProofURLs         []string            `json:"proof_urls"`          // 证明材料附件URL数组
+	DayBreakdown      []*LeaveDayDuration `json:"day_breakdown"`       // 按天拆分的请假时长（服务端计算）
+	Status            LeaveRequestStatus  `json:"status"`              // 状态
+	CurrentApproverID *uuid.UUID          `json:"current_approver_id"` // 当前审批人ID
+	SubmittedAt       *time.Time          `json:"submitted_at"`        // 提交时间
+	ApprovedAt        *time.Time          `json:"approved_at"`         // 审批通过时间
+	RejectedAt        *time.Time          `json:"rejected_at"`         // 拒绝时间
+	CancelledAt       *time.Time          `json:"cancelled_at"`        // 取消时间
+	Remark            string              `json:"remark"`              // 备注
+	CreatedBy         *uuid.UUID          `json:"created_by"`
+	UpdatedBy         *uuid.UUID          `json:"updated_by"`
+	CreatedAt         time.Time           `json:"created_at"`
+	UpdatedAt         time.Time           `json:"updated_at"`
+	DeletedAt         *time.Time          `json:"deleted_at"`
+}
+
+// LeaveDayDuration 请假在某一天的时长
+type LeaveDayDuration struct {
+	Date      time.Time  `json:"date"`
+	DayType   DayType    `json:"day_type"`
+	ShiftID   *uuid.UUID `json:"shift_id,omitempty"`
+	ShiftName string     `json:"shift_name,omitempty"`
+	StartTime *time.Time `json:"start_time,omitempty"` // 当天请假起止（已与班次求交）
+	EndTime   *time.Time `json:"end_time,omitempty"`
+	Hours     float64    `json:"hours"`    // 扣除休息时间后的请假小时数
+	Duration  float64    `json:"duration"` // 按请假单位取整后的时长
+}
+
+// LeaveMonthDuration 请假在某个月的时长（跨月请假按月拆分）
+type LeaveMonthDuration struct {
+	Year     int     `json:"year"`
+	Month    int     `json:"month"`
+	Hours    float64 `json:"hours"`
+	Duration float64 `json:"duration"`
+}
+
+// LeaveDurationResult 请假时长计算结果
+type LeaveDurationResult struct {
+	Unit     LeaveUnit             `json:"unit"`
+	Duration float64               `json:"duration"`
+	Hours    float64               `json:"hours"`
+	Days     []*LeaveDayDuration   `json:"days"`
+	Months   []*LeaveMonthDuration `json:"months"`
+}
+
+// DurationInMonth 请假在某个月的时长：有按天拆分时按拆分累计，否则返回 ok=false
+func (r *LeaveRequest) DurationInMonth(year, month int) (float64, bool) {
+	if len(r.DayBreakdown) == 0 {
+		return 0, false
+	}
+	total := 0.0
+	for _, day := range r.DayBreakdown {
+		if day.Date.Year() == year && int(day.Date.Month()) == month {
+			total += day.Duration
+		}
+	}
+	return total, true
 }
 
 // LeaveApproval 请假审批记录
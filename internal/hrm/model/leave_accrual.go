@@ -0,0 +1,101 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LeaveAccrualFrequency 额度发放频率
+type LeaveAccrualFrequency string
+
+const (
+	LeaveAccrualYearly  LeaveAccrualFrequency = "yearly"  // 每年一次性发放
+	LeaveAccrualMonthly LeaveAccrualFrequency = "monthly" // 按月累计发放
+)
+
+// SeniorityTier 工龄档位：工龄达到 MinYears 年时全年额度为 Days
+type SeniorityTier struct {
+	MinYears int     `json:"min_years"`
+	Days     float64 `json:"days"`
+}
+
+// LeaveAccrualPolicy 额度发放规则
+type LeaveAccrualPolicy struct {
+	Frequency       LeaveAccrualFrequency `json:"frequency"`         // 发放频率
+	BaseQuota       float64               `json:"base_quota"`        // 全年基础额度（未命中工龄档位时使用）
+	SeniorityTiers  []SeniorityTier       `json:"seniority_tiers"`   // 工龄档位
+	ProRate         bool                  `json:"pro_rate"`          // 是否按入职/离职日期折算
+	RoundingStep    float64               `json:"rounding_step"`     // 折算后向下取整的步长，默认 0.5
+	CarryOverCap    float64               `json:"carry_over_cap"`    // 年末可结转的上限，0 表示不结转
+	CarryOverMonths int                   `json:"carry_over_months"` // 结转额度在次年的有效月数，0 表示次年年底过期
+}
+
+// AnnualEntitlement 按工龄取全年额度：命中的最高档位优先，否则为基础额度
+func (p *LeaveAccrualPolicy) AnnualEntitlement(years int) float64 {
+	entitlement := p.BaseQuota
+	best := -1
+	for _, tier := range p.SeniorityTiers {
+		if years >= tier.MinYears && tier.MinYears > best {
+			best = tier.MinYears
+			entitlement = tier.Days
+		}
+	}
+	return entitlement
+}
+
+// CarryOverExpireAt 结转额度的过期时间（year 为结转来源年份）
+func (p *LeaveAccrualPolicy) CarryOverExpireAt(year int, loc *time.Location) time.Time {
+	months := p.CarryOverMonths
+	if months <= 0 {
+		months = 12
+	}
+	return time.Date(year+1, time.Month(months)+1, 1, 0, 0, 0, 0, loc).Add(-time.Second)
+}
+
+// LeaveLedgerEntryType 额度流水类型
+type LeaveLedgerEntryType string
+
+const (
+	LeaveLedgerGrant      LeaveLedgerEntryType = "grant"      // 初始化发放
+	LeaveLedgerAccrual    LeaveLedgerEntryType = "accrual"    // 按规则累计发放（含折算冲减）
+	LeaveLedgerCarryOver  LeaveLedgerEntryType = "carry_over" // 年末结转（转出为负、转入为正）
+	LeaveLedgerCompOff    LeaveLedgerEntryType = "comp_off"   // 加班调休入账
+	LeaveLedgerExpiry     LeaveLedgerEntryType = "expiry"     // 过期作废
+	LeaveLedgerAdjustment LeaveLedgerEntryType = "adjustment" // 人工调整
+	LeaveLedgerDeduction  LeaveLedgerEntryType = "deduction"  // 请假扣减
+	LeaveLedgerRefund     LeaveLedgerEntryType = "refund"     // 销假退还
+)
+
+// AdjustsTotal 是否变动总额度；扣减和退还只记录已用额度的变化
+func (t LeaveLedgerEntryType) AdjustsTotal() bool {
+	return t != LeaveLedgerDeduction && t != LeaveLedgerRefund
+}
+
+// LeaveQuotaLedgerEntry 额度流水
+type LeaveQuotaLedgerEntry struct {
+	ID             uuid.UUID            `json:"id"`
+	TenantID       uuid.UUID            `json:"tenant_id"`
+	EmployeeID     uuid.UUID            `json:"employee_id"`
+	LeaveTypeID    uuid.UUID            `json:"leave_type_id"`
+	QuotaID        uuid.UUID            `json:"quota_id"`
+	Year           int                  `json:"year"`
+	EntryType      LeaveLedgerEntryType `json:"entry_type"`
+	Amount         float64              `json:"amount"`        // 变动数量（正为增加，负为减少）
+	BalanceAfter   float64              `json:"balance_after"` // 变动后的剩余额度
+	ExpiresAt      *time.Time           `json:"expires_at"`    // 本笔额度的过期时间（结转、调休）
+	Reason         string               `json:"reason"`
+	SourceType     string               `json:"source_type"` // leave_request / overtime / ledger / policy / manual
+	SourceID       *uuid.UUID           `json:"source_id"`
+	OperatorID     *uuid.UUID           `json:"operator_id"`
+	IdempotencyKey string               `json:"idempotency_key"` // 幂等键，重复执行不会重复入账
+	CreatedAt      time.Time            `json:"created_at"`
+}
+
+// EmployeeTenure 员工在职区间（取自组织员工档案）
+type EmployeeTenure struct {
+	EmployeeID uuid.UUID  `json:"employee_id"`
+	Name       string     `json:"name"`
+	JoinDate   *time.Time `json:"join_date"`
+	LeaveDate  *time.Time `json:"leave_date"`
+}
@@ -25,8 +25,8 @@ type Overtime struct {
 	OvertimeType OvertimeType `json:"overtime_type"` // workday, weekend, holiday
 	PayType      string       `json:"pay_type"`      // money, leave（调休）
 
-	// 加班倍率（根据劳动法）
-	PayRate float64 `json:"pay_rate"` // 工作日1.5倍，周末2倍，节假日3倍
+	// 加班倍率（按加班政策，未配置时工作日1.5倍，周末2倍，节假日3倍）
+	PayRate float64 `json:"pay_rate"`
 
 	// 加班原因
 	Reason string   `json:"reason"`
@@ -39,6 +39,15 @@ type Overtime struct {
 	ApprovedAt     *time.Time `json:"approved_at,omitempty"`
 	RejectReason   string     `json:"reject_reason,omitempty"`
 
+	// 加班政策计算结果
+	PolicyID      *uuid.UUID `json:"policy_id,omitempty"` // 计算所用政策（法定标准时为空）
+	BillableHours float64    `json:"billable_hours"`      // 按最小计量单位取整后的计薪时长
+	Warnings      []string   `json:"warnings,omitempty"`  // 超出月度/年度上限等提示
+
+	// 来源
+	Source             OvertimeSource `json:"source"`                         // manual, auto
+	AttendanceRecordID *uuid.UUID     `json:"attendance_record_id,omitempty"` // 自动识别时对应的下班打卡
+
 	// 调休信息
 	CompOffDays     float64    `json:"comp_off_days"`                // 可调休天数
 	CompOffUsed     float64    `json:"comp_off_used"`                // 已调休天数
@@ -162,6 +171,9 @@ type AttendanceSummary struct {
 	// 状态
 	Status string `json:"status"` // draft, confirmed, locked
 
+	// 锁定快照（锁定时写入，解锁后清空）
+	LockSnapshot *AttendanceLockSnapshot `json:"lock_snapshot,omitempty"`
+
 	// 审计字段
 	CreatedAt   time.Time  `json:"created_at"`
 	UpdatedAt   time.Time  `json:"updated_at"`
@@ -271,3 +283,44 @@ type SupplementEvidence struct {
 	URL         string `json:"url"`         // 文件URL
 	Description string `json:"description"` // 描述
 }
+
+// PunchCardSupplementPolicy 补卡政策（每个租户一份，未配置时使用默认政策）
+type PunchCardSupplementPolicy struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID uuid.UUID `json:"tenant_id"`
+
+	MaxPerMonth         int                    `json:"max_per_month"`                   // 每月补卡次数上限（按补卡日期所在月份统计），0 表示不限
+	FilingDeadlineDays  int                    `json:"filing_deadline_days"`            // 须在缺卡日期后 N 天内提交，0 表示不限
+	AllowedMissingTypes []PunchCardMissingType `json:"allowed_missing_types,omitempty"` // 允许申请补卡的缺卡类型，为空表示不限
+
+	UpdatedBy uuid.UUID `json:"updated_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// DefaultPunchCardSupplementPolicy 租户未配置政策时使用：30 天内提交，不限次数和缺卡类型
+func DefaultPunchCardSupplementPolicy() *PunchCardSupplementPolicy {
+	return &PunchCardSupplementPolicy{FilingDeadlineDays: 30}
+}
+
+// AllowsMissingType 是否允许该缺卡类型申请补卡
+func (p *PunchCardSupplementPolicy) AllowsMissingType(missingType PunchCardMissingType) bool {
+	if len(p.AllowedMissingTypes) == 0 {
+		return true
+	}
+	for _, t := range p.AllowedMissingTypes {
+		if t == missingType {
+			return true
+		}
+	}
+	return false
+}
+
+// FilingDeadline 缺卡日期对应的最晚提交时间（不含），未限制时返回零值
+func (p *PunchCardSupplementPolicy) FilingDeadline(supplementDate time.Time) time.Time {
+	if p.FilingDeadlineDays <= 0 {
+		return time.Time{}
+	}
+	day := time.Date(supplementDate.Year(), supplementDate.Month(), supplementDate.Day(), 0, 0, 0, 0, supplementDate.Location())
+	return day.AddDate(0, 0, p.FilingDeadlineDays+1)
+}
@@ -0,0 +1,163 @@
+package model
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OvertimeCompensation 加班补偿方式
+type OvertimeCompensation string
+
+const (
+	OvertimeCompensationMoney  OvertimeCompensation = "money"  // 只发加班费
+	OvertimeCompensationLeave  OvertimeCompensation = "leave"  // 只给调休
+	OvertimeCompensationEither OvertimeCompensation = "either" // 员工申请时选择
+)
+
+// Allows 是否允许该补偿方式（pay_type 取值 money / leave）
+func (c OvertimeCompensation) Allows(payType string) bool {
+	return c == OvertimeCompensationEither || c == "" || string(c) == payType
+}
+
+// OvertimeRounding 加班时长取整方式
+type OvertimeRounding string
+
+const (
+	OvertimeRoundingDown    OvertimeRounding = "down"    // 向下取整（默认）
+	OvertimeRoundingUp      OvertimeRounding = "up"      // 向上取整
+	OvertimeRoundingNearest OvertimeRounding = "nearest" // 四舍五入
+)
+
+// OvertimeSource 加班记录来源
+type OvertimeSource string
+
+const (
+	OvertimeSourceManual OvertimeSource = "manual" // 员工申请
+	OvertimeSourceAuto   OvertimeSource = "auto"   // 下班打卡晚于班次结束自动识别
+)
+
+// OvertimeTypeRule 某一加班类型的计算规则
+type OvertimeTypeRule struct {
+	Type         OvertimeType         `json:"type"`
+	PayRate      float64              `json:"pay_rate"`      // 加班费倍率
+	CompOffRate  float64              `json:"comp_off_rate"` // 调休折算倍率：1 小时加班可调休的小时数
+	Compensation OvertimeCompensation `json:"compensation"`  // 可选补偿方式
+}
+
+// OvertimePolicy 加班政策（租户维护，考勤规则通过 OvertimePolicyID 关联）
+type OvertimePolicy struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID uuid.UUID `json:"tenant_id"`
+
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+
+	// 各加班类型的倍率与补偿方式，未配置的类型按法定标准
+	Rules []OvertimeTypeRule `json:"rules"`
+
+	// 计量
+	HoursPerDay        float64          `json:"hours_per_day"`        // 调休折算 1 天的小时数
+	MinDurationMinutes int              `json:"min_duration_minutes"` // 单次不足该时长不计加班
+	UnitMinutes        int              `json:"unit_minutes"`         // 最小计量单位，0 表示按实际时长
+	Rounding           OvertimeRounding `json:"rounding"`
+
+	// 上限（0 表示不限），超出时提示；BlockOverCap 为 true 时拒绝
+	MonthlyCapHours float64 `json:"monthly_cap_hours"`
+	YearlyCapHours  float64 `json:"yearly_cap_hours"`
+	BlockOverCap    bool    `json:"block_over_cap"`
+
+	// 自动识别：下班打卡晚于班次结束（含班次加班缓冲）时生成加班记录
+	AutoDetect          bool `json:"auto_detect"`
+	AutoRequireApproval bool `json:"auto_require_approval"` // 自动识别的加班是否需要审批
+
+	// 未关联政策的考勤规则使用租户默认政策
+	IsDefault bool `json:"is_default"`
+	IsActive  bool `json:"is_active"`
+
+	// 审计字段
+	CreatedBy uuid.UUID  `json:"created_by"`
+	UpdatedBy uuid.UUID  `json:"updated_by"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+// statutoryOvertimeRules 法定标准：工作日 1.5 倍、休息日 2 倍、法定节假日 3 倍，
+// 调休按同样倍率折算（工作日按实际时长）
+var statutoryOvertimeRules = map[OvertimeType]OvertimeTypeRule{
+	OvertimeTypeWorkday: {Type: OvertimeTypeWorkday, PayRate: 1.5, CompOffRate: 1, Compensation: OvertimeCompensationEither},
+	OvertimeTypeWeekend: {Type: OvertimeTypeWeekend, PayRate: 2, CompOffRate: 2, Compensation: OvertimeCompensationEither},
+	OvertimeTypeHoliday: {Type: OvertimeTypeHoliday, PayRate: 3, CompOffRate: 3, Compensation: OvertimeCompensationEither},
+}
+
+// DefaultOvertimePolicy 租户未配置政策时使用的法定标准政策
+func DefaultOvertimePolicy() *OvertimePolicy {
+	return &OvertimePolicy{
+		Code:        "statutory",
+		Name:        "法定标准",
+		HoursPerDay: 8,
+		Rounding:    OvertimeRoundingDown,
+		IsActive:    true,
+	}
+}
+
+// RuleFor 加班类型对应的规则，未配置或倍率为 0 的项按法定标准补齐
+func (p *OvertimePolicy) RuleFor(overtimeType OvertimeType) OvertimeTypeRule {
+	statutory, ok := statutoryOvertimeRules[overtimeType]
+	if !ok {
+		statutory = statutoryOvertimeRules[OvertimeTypeWorkday]
+		statutory.Type = overtimeType
+	}
+
+	for _, rule := range p.Rules {
+		if rule.Type != overtimeType {
+			continue
+		}
+		if rule.PayRate <= 0 {
+			rule.PayRate = statutory.PayRate
+		}
+		if rule.CompOffRate <= 0 {
+			rule.CompOffRate = statutory.CompOffRate
+		}
+		if rule.Compensation == "" {
+			rule.Compensation = statutory.Compensation
+		}
+		return rule
+	}
+	return statutory
+}
+
+// DayHours 调休折算 1 天的小时数
+func (p *OvertimePolicy) DayHours() float64 {
+	if p.HoursPerDay <= 0 {
+		return 8
+	}
+	return p.HoursPerDay
+}
+
+// BillableHours 按最短时长和计量单位取整后的计薪时长
+func (p *OvertimePolicy) BillableHours(hours float64) float64 {
+	minutes := hours * 60
+	if minutes <= 0 || minutes < float64(p.MinDurationMinutes) {
+		return 0
+	}
+	if p.UnitMinutes <= 0 {
+		return math.Round(hours*100) / 100
+	}
+
+	units := minutes / float64(p.UnitMinutes)
+	// 消除浮点误差，避免 1.5 小时被算成 89.999 分钟
+	units = math.Round(units*1e6) / 1e6
+	switch p.Rounding {
+	case OvertimeRoundingUp:
+		units = math.Ceil(units)
+	case OvertimeRoundingNearest:
+		units = math.Round(units)
+	default:
+		units = math.Floor(units)
+	}
+	return math.Round(units*float64(p.UnitMinutes)/60*100) / 100
+}
@@ -0,0 +1,161 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PayrollSchemaVersion 薪资对接数据结构版本：字段含义或明细编码变化时递增，
+// 下游按版本号解析，同一版本内只允许新增可选字段
+const PayrollSchemaVersion = "1.0"
+
+// PayrollItemCategory 薪资明细类别
+type PayrollItemCategory string
+
+const (
+	PayrollItemAttendance PayrollItemCategory = "attendance" // 出勤
+	PayrollItemLeave      PayrollItemCategory = "leave"      // 请假（按类型，区分带薪/无薪）
+	PayrollItemOvertime   PayrollItemCategory = "overtime"   // 加班（按类型、补偿方式和倍率）
+	PayrollItemAbsence    PayrollItemCategory = "absence"    // 缺勤扣款依据
+	PayrollItemTrip       PayrollItemCategory = "trip"       // 出差及补助
+)
+
+// PayrollItemUnit 薪资明细计量单位
+type PayrollItemUnit string
+
+const (
+	PayrollUnitDay    PayrollItemUnit = "day"
+	PayrollUnitHour   PayrollItemUnit = "hour"
+	PayrollUnitMinute PayrollItemUnit = "minute"
+	PayrollUnitCount  PayrollItemUnit = "count"
+	PayrollUnitAmount PayrollItemUnit = "amount"
+)
+
+// 出勤、缺勤、出差明细编码（请假明细编码为请假类型编码，加班明细编码为加班类型）
+const (
+	PayrollCodeWorkDays        = "work_days"         // 应出勤天数
+	PayrollCodeActualDays      = "actual_days"       // 实际出勤天数
+	PayrollCodeWorkHours       = "work_hours"        // 工作时长
+	PayrollCodeAbsentDays      = "absent_days"       // 旷工天数
+	PayrollCodeUnpaidLeaveDays = "unpaid_leave_days" // 无薪请假天数
+	PayrollCodeLateCount       = "late_count"        // 迟到次数
+	PayrollCodeLateMinutes     = "late_minutes"      // 迟到分钟
+	PayrollCodeEarlyCount      = "early_count"       // 早退次数
+	PayrollCodeEarlyMinutes    = "early_minutes"     // 早退分钟
+	PayrollCodeMissingCount    = "missing_count"     // 缺卡次数
+	PayrollCodeTripDays        = "trip_days"         // 出差天数
+	PayrollCodeTripAllowance   = "allowance"         // 出差补助金额
+)
+
+// PayrollItem 员工某期的一条薪资明细
+type PayrollItem struct {
+	Category   PayrollItemCategory `json:"category"`
+	Code       string              `json:"code"`
+	Name       string              `json:"name,omitempty"`
+	Paid       *bool               `json:"paid,omitempty"`       // 请假是否带薪
+	PayType    string              `json:"pay_type,omitempty"`   // 加班补偿方式：money, leave
+	Multiplier float64             `json:"multiplier,omitempty"` // 加班倍率
+	Quantity   float64             `json:"quantity"`
+	Unit       PayrollItemUnit     `json:"unit"`
+	Currency   string              `json:"currency,omitempty"`
+}
+
+// Key 明细在员工内的唯一键，用于与上一次导出比对
+func (i *PayrollItem) Key() string {
+	key := string(i.Category) + "." + i.Code
+	if i.PayType != "" {
+		key += "." + i.PayType
+	}
+	if i.Multiplier > 0 {
+		key += fmt.Sprintf(".x%g", i.Multiplier)
+	}
+	if i.Currency != "" {
+		key += "." + i.Currency
+	}
+	return key
+}
+
+// PayrollEmployee 员工某期的薪资输入
+type PayrollEmployee struct {
+	EmployeeID     uuid.UUID      `json:"employee_id"`
+	EmployeeNo     string         `json:"employee_no"`
+	EmployeeName   string         `json:"employee_name"`
+	DepartmentID   uuid.UUID      `json:"department_id"`
+	DepartmentName string         `json:"department_name"`
+	Items          []*PayrollItem `json:"items"`
+}
+
+// PayrollFeed 某期薪资对接数据（各导出格式的输入）
+type PayrollFeed struct {
+	SchemaVersion string             `json:"schema_version"`
+	TenantID      uuid.UUID          `json:"tenant_id"`
+	Year          int                `json:"year"`
+	Month         int                `json:"month"`
+	RunID         uuid.UUID          `json:"run_id"`
+	RunNo         int                `json:"run_no"`
+	GeneratedAt   time.Time          `json:"generated_at"`
+	Employees     []*PayrollEmployee `json:"employees"`
+	Changes       *PayrollDiff       `json:"changes,omitempty"` // 与上一次导出的差异（首次导出为空）
+}
+
+// PayrollEmployeeRef 差异中的员工
+type PayrollEmployeeRef struct {
+	EmployeeID   uuid.UUID `json:"employee_id"`
+	EmployeeNo   string    `json:"employee_no"`
+	EmployeeName string    `json:"employee_name"`
+}
+
+// PayrollItemChange 明细数量变化（新增明细 Previous 为 0，删除明细 Current 为 0）
+type PayrollItemChange struct {
+	Key      string       `json:"key"`
+	Item     *PayrollItem `json:"item"` // 本期明细，已删除时为上一次的明细
+	Previous float64      `json:"previous"`
+	Current  float64      `json:"current"`
+}
+
+// PayrollEmployeeChange 员工的明细变化
+type PayrollEmployeeChange struct {
+	PayrollEmployeeRef
+	Items []*PayrollItemChange `json:"items"`
+}
+
+// PayrollDiff 与上一次导出的差异
+type PayrollDiff struct {
+	PreviousRunID uuid.UUID                `json:"previous_run_id"`
+	PreviousRunNo int                      `json:"previous_run_no"`
+	Added         []*PayrollEmployeeRef    `json:"added"`
+	Removed       []*PayrollEmployeeRef    `json:"removed"`
+	Changed       []*PayrollEmployeeChange `json:"changed"`
+}
+
+// IsEmpty 是否与上一次导出完全一致
+func (d *PayrollDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// PayrollRun 薪资对接导出记录（同一期可多次导出，RunNo 递增）
+type PayrollRun struct {
+	ID            uuid.UUID    `json:"id"`
+	TenantID      uuid.UUID    `json:"tenant_id"`
+	Year          int          `json:"year"`
+	Month         int          `json:"month"`
+	RunNo         int          `json:"run_no"`
+	SchemaVersion string       `json:"schema_version"`
+	Exporter      string       `json:"exporter"`
+	EmployeeCount int          `json:"employee_count"`
+	Checksum      string       `json:"checksum"` // 员工明细的 SHA-256，相同表示数据未变
+	FileID        *uuid.UUID   `json:"file_id,omitempty"`
+	Filename      string       `json:"filename,omitempty"`
+	PreviousRunID *uuid.UUID   `json:"previous_run_id,omitempty"`
+	Diff          *PayrollDiff `json:"diff,omitempty"`
+	CreatedBy     uuid.UUID    `json:"created_by"`
+	CreatedAt     time.Time    `json:"created_at"`
+
+	// Employees 导出时的员工明细快照，供下一次导出比对（列表查询不返回）
+	Employees []*PayrollEmployee `json:"-"`
+
+	// DownloadURL 文件下载地址（查询时生成，不落库）
+	DownloadURL string `json:"download_url,omitempty"`
+}
@@ -0,0 +1,93 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReportType 报表类型
+type ReportType string
+
+const (
+	ReportAttendanceMonthly ReportType = "attendance_monthly" // 月度考勤表（逐日状态 + 月度汇总）
+	ReportLeaveBalance      ReportType = "leave_balance"      // 假期余额表（按假期类型）
+	ReportOvertimeLedger    ReportType = "overtime_ledger"    // 加班台账
+)
+
+// IsValid 是否为支持的报表类型
+func (t ReportType) IsValid() bool {
+	switch t {
+	case ReportAttendanceMonthly, ReportLeaveBalance, ReportOvertimeLedger:
+		return true
+	}
+	return false
+}
+
+// ExportFormat 导出文件格式
+type ExportFormat string
+
+const (
+	ExportFormatXLSX ExportFormat = "xlsx"
+	ExportFormatCSV  ExportFormat = "csv"
+)
+
+// IsValid 是否为支持的导出格式
+func (f ExportFormat) IsValid() bool {
+	return f == ExportFormatXLSX || f == ExportFormatCSV
+}
+
+// ContentType 文件 MIME 类型
+func (f ExportFormat) ContentType() string {
+	if f == ExportFormatCSV {
+		return "text/csv"
+	}
+	return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+}
+
+// 表头语言
+const (
+	ReportLocaleZH = "zh-CN"
+	ReportLocaleEN = "en-US"
+)
+
+// ReportExportStatus 导出任务状态
+type ReportExportStatus string
+
+const (
+	ReportExportPending   ReportExportStatus = "pending"   // 排队中
+	ReportExportRunning   ReportExportStatus = "running"   // 生成中
+	ReportExportCompleted ReportExportStatus = "completed" // 已完成，可下载
+	ReportExportFailed    ReportExportStatus = "failed"    // 失败
+)
+
+// ReportExport 报表导出任务：小报表同步生成，大报表在后台生成，结果文件存入文件模块
+type ReportExport struct {
+	ID           uuid.UUID          `json:"id"`
+	TenantID     uuid.UUID          `json:"tenant_id"`
+	ReportType   ReportType         `json:"report_type"`
+	Format       ExportFormat       `json:"format"`
+	Locale       string             `json:"locale"`
+	Columns      []string           `json:"columns,omitempty"`       // 选择的列，为空时导出全部默认列
+	DepartmentID *uuid.UUID         `json:"department_id,omitempty"` // 为空时导出全公司（含下级部门）
+	Year         int                `json:"year"`
+	Month        int                `json:"month,omitempty"` // 假期余额表按年度，不需要月份
+	Status       ReportExportStatus `json:"status"`
+	RowCount     int                `json:"row_count"`
+	FileID       *uuid.UUID         `json:"file_id,omitempty"`
+	Filename     string             `json:"filename,omitempty"`
+	Error        string             `json:"error,omitempty"`
+	RequestedBy  uuid.UUID          `json:"requested_by"`
+	StartedAt    *time.Time         `json:"started_at,omitempty"`
+	FinishedAt   *time.Time         `json:"finished_at,omitempty"`
+	CreatedAt    time.Time          `json:"created_at"`
+	UpdatedAt    time.Time          `json:"updated_at"`
+
+	// DownloadURL 查询时按需生成的下载地址（不落库）
+	DownloadURL string `json:"download_url,omitempty"`
+}
+
+// IsFinished 任务是否已结束
+func (e *ReportExport) IsFinished() bool {
+	return e.Status == ReportExportCompleted || e.Status == ReportExportFailed
+}
@@ -0,0 +1,105 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 排班状态
+const (
+	ScheduleStatusDraft     = "draft"     // 草稿（仅排班管理员可见）
+	ScheduleStatusPublished = "published" // 已发布（通知员工，参与考勤计算）
+	ScheduleStatusExecuted  = "executed"  // 已执行
+)
+
+// RotationSlot 轮班周期中的一天，ShiftID 为空表示休息
+type RotationSlot struct {
+	ShiftID *uuid.UUID `json:"shift_id"`
+}
+
+// IsRest 是否休息日
+func (s RotationSlot) IsRest() bool {
+	return s.ShiftID == nil
+}
+
+// ShiftHeadcount 班次每天的最低在岗人数
+type ShiftHeadcount struct {
+	ShiftID uuid.UUID `json:"shift_id"`
+	Min     int       `json:"min"`
+}
+
+// ScheduleConstraints 排班约束，取 0 的项不检查
+type ScheduleConstraints struct {
+	MinRestHours       float64          `json:"min_rest_hours"`       // 相邻两个班次之间的最短休息小时数
+	MaxConsecutiveDays int              `json:"max_consecutive_days"` // 最多连续上班天数
+	MinHeadcount       []ShiftHeadcount `json:"min_headcount"`        // 各班次最低在岗人数
+}
+
+// RotationTemplate 轮班模板（如做四休二、两白两夜两休），按周期循环展开为排班
+type RotationTemplate struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID uuid.UUID `json:"tenant_id"`
+
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+
+	Slots        []RotationSlot      `json:"slots"`         // 一个周期内每天的班次
+	SkipHolidays bool                `json:"skip_holidays"` // 节假日是否按休息处理（周期照常推进）
+	Constraints  ScheduleConstraints `json:"constraints"`   // 默认约束，生成/发布时可覆盖
+
+	IsActive bool `json:"is_active"`
+
+	CreatedBy uuid.UUID  `json:"created_by"`
+	UpdatedBy uuid.UUID  `json:"updated_by"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+// CycleLength 周期天数
+func (t *RotationTemplate) CycleLength() int {
+	return len(t.Slots)
+}
+
+// SlotAt 周期第 day 天（可为负数，按周期取模）
+func (t *RotationTemplate) SlotAt(day int) RotationSlot {
+	n := len(t.Slots)
+	return t.Slots[((day%n)+n)%n]
+}
+
+// ShiftIDs 模板用到的班次
+func (t *RotationTemplate) ShiftIDs() []uuid.UUID {
+	seen := make(map[uuid.UUID]bool)
+	var ids []uuid.UUID
+	for _, slot := range t.Slots {
+		if slot.ShiftID != nil && !seen[*slot.ShiftID] {
+			seen[*slot.ShiftID] = true
+			ids = append(ids, *slot.ShiftID)
+		}
+	}
+	return ids
+}
+
+// ScheduleViolationType 排班约束违规类型
+type ScheduleViolationType string
+
+const (
+	ScheduleViolationMinRest        ScheduleViolationType = "min_rest"        // 班次间休息不足
+	ScheduleViolationMaxConsecutive ScheduleViolationType = "max_consecutive" // 连续上班超限
+	ScheduleViolationMinHeadcount   ScheduleViolationType = "min_headcount"   // 在岗人数不足
+)
+
+// ScheduleViolation 排班约束违规项
+type ScheduleViolation struct {
+	Type         ScheduleViolationType `json:"type"`
+	Date         time.Time             `json:"date"`
+	EmployeeID   *uuid.UUID            `json:"employee_id,omitempty"`
+	EmployeeName string                `json:"employee_name,omitempty"`
+	ShiftID      *uuid.UUID            `json:"shift_id,omitempty"`
+	ShiftName    string                `json:"shift_name,omitempty"`
+	Actual       float64               `json:"actual"` // 实际值（休息小时数/连续天数/在岗人数）
+	Limit        float64               `json:"limit"`  // 约束值
+	Message      string                `json:"message"`
+}
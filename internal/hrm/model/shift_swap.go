@@ -0,0 +1,80 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ShiftSwapType 换班类型
+type ShiftSwapType string
+
+const (
+	ShiftSwapTypeSwap  ShiftSwapType = "swap"  // 互换：双方交换各自的排班
+	ShiftSwapTypeCover ShiftSwapType = "cover" // 代班：对方接替申请人的排班
+)
+
+// ShiftSwapStatus 换班申请状态
+type ShiftSwapStatus string
+
+const (
+	ShiftSwapStatusPendingPeer     ShiftSwapStatus = "pending_peer"     // 待对方确认
+	ShiftSwapStatusPeerRejected    ShiftSwapStatus = "peer_rejected"    // 对方拒绝
+	ShiftSwapStatusPendingApproval ShiftSwapStatus = "pending_approval" // 待主管审批
+	ShiftSwapStatusApproved        ShiftSwapStatus = "approved"         // 已批准（排班已交换）
+	ShiftSwapStatusRejected        ShiftSwapStatus = "rejected"         // 主管驳回
+	ShiftSwapStatusCancelled       ShiftSwapStatus = "cancelled"        // 申请人撤销
+)
+
+// IsOpen 是否仍在流转中
+func (s ShiftSwapStatus) IsOpen() bool {
+	return s == ShiftSwapStatusPendingPeer || s == ShiftSwapStatusPendingApproval
+}
+
+// ShiftSwapRequest 换班/代班申请
+type ShiftSwapRequest struct {
+	ID       uuid.UUID     `json:"id"`
+	TenantID uuid.UUID     `json:"tenant_id"`
+	Type     ShiftSwapType `json:"type"`
+
+	// 申请人及其排班（提交时快照，审批时校验未被改动）
+	RequesterID         uuid.UUID `json:"requester_id"`
+	RequesterName       string    `json:"requester_name"`
+	RequesterScheduleID uuid.UUID `json:"requester_schedule_id"`
+	RequesterDate       time.Time `json:"requester_date"`
+	RequesterShiftID    uuid.UUID `json:"requester_shift_id"`
+	RequesterShiftName  string    `json:"requester_shift_name"`
+
+	// 对方及其排班（代班时为空）
+	TargetEmployeeID   uuid.UUID  `json:"target_employee_id"`
+	TargetEmployeeName string     `json:"target_employee_name"`
+	TargetScheduleID   *uuid.UUID `json:"target_schedule_id,omitempty"`
+	TargetDate         *time.Time `json:"target_date,omitempty"`
+	TargetShiftID      *uuid.UUID `json:"target_shift_id,omitempty"`
+	TargetShiftName    string     `json:"target_shift_name,omitempty"`
+
+	Reason string          `json:"reason"`
+	Status ShiftSwapStatus `json:"status"`
+
+	// 对方确认
+	PeerRespondedAt *time.Time `json:"peer_responded_at,omitempty"`
+	PeerComment     string     `json:"peer_comment,omitempty"`
+
+	// 主管审批
+	WorkflowExecutionID string     `json:"workflow_execution_id,omitempty"`
+	ApproverID          *uuid.UUID `json:"approver_id,omitempty"`
+	ApprovedAt          *time.Time `json:"approved_at,omitempty"`
+	ApprovalComment     string     `json:"approval_comment,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ScheduleIDs 申请涉及的排班
+func (r *ShiftSwapRequest) ScheduleIDs() []uuid.UUID {
+	ids := []uuid.UUID{r.RequesterScheduleID}
+	if r.TargetScheduleID != nil {
+		ids = append(ids, *r.TargetScheduleID)
+	}
+	return ids
+}
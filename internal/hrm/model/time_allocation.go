@@ -0,0 +1,90 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TimeAllocationKind 时间占用类型
+type TimeAllocationKind string
+
+const (
+	TimeAllocationLeave    TimeAllocationKind = "leave"    // 请假
+	TimeAllocationTrip     TimeAllocationKind = "trip"     // 出差
+	TimeAllocationOvertime TimeAllocationKind = "overtime" // 加班
+	TimeAllocationOuting   TimeAllocationKind = "outing"   // 外出
+
+	// TimeAllocationSchedule 排班，仅出现在日历中，不占用时间
+	TimeAllocationSchedule TimeAllocationKind = "schedule"
+)
+
+// ConflictsWith 两类占用在时间重叠时是否冲突
+// 请假与任何占用冲突；加班可与出差、外出重叠（出差或外出期间加班）；其余同类或异类重叠均冲突
+func (k TimeAllocationKind) ConflictsWith(other TimeAllocationKind) bool {
+	if k == TimeAllocationSchedule || other == TimeAllocationSchedule {
+		return false
+	}
+	if k == TimeAllocationLeave || other == TimeAllocationLeave || k == other {
+		return true
+	}
+	return k != TimeAllocationOvertime && other != TimeAllocationOvertime
+}
+
+// ConflictingKinds 与该类占用冲突的全部占用类型
+func (k TimeAllocationKind) ConflictingKinds() []TimeAllocationKind {
+	var kinds []TimeAllocationKind
+	for _, other := range []TimeAllocationKind{TimeAllocationLeave, TimeAllocationTrip, TimeAllocationOvertime, TimeAllocationOuting} {
+		if k.ConflictsWith(other) {
+			kinds = append(kinds, other)
+		}
+	}
+	return kinds
+}
+
+// TimeAllocationStatus 时间占用状态
+type TimeAllocationStatus string
+
+const (
+	TimeAllocationPending  TimeAllocationStatus = "pending"  // 申请审批中
+	TimeAllocationApproved TimeAllocationStatus = "approved" // 申请已批准
+)
+
+// TimeAllocation 员工时间占用索引
+// 请假、出差、加班、外出申请在审批中或已批准期间各占一行，用于跨类型冲突检测和员工日历
+type TimeAllocation struct {
+	ID           uuid.UUID            `json:"id"`
+	TenantID     uuid.UUID            `json:"tenant_id"`
+	EmployeeID   uuid.UUID            `json:"employee_id"`
+	EmployeeName string               `json:"employee_name"`
+	Kind         TimeAllocationKind   `json:"kind"`
+	SourceID     uuid.UUID            `json:"source_id"` // 对应申请ID
+	Title        string               `json:"title"`     // 日历展示标题（请假类型、目的地等）
+	StartTime    time.Time            `json:"start_time"`
+	EndTime      time.Time            `json:"end_time"`
+	Status       TimeAllocationStatus `json:"status"`
+	CreatedAt    time.Time            `json:"created_at"`
+	UpdatedAt    time.Time            `json:"updated_at"`
+}
+
+// Overlaps 是否与 [start, end) 重叠
+func (a *TimeAllocation) Overlaps(start, end time.Time) bool {
+	return a.StartTime.Before(end) && start.Before(a.EndTime)
+}
+
+// CalendarEntry 员工日历条目
+type CalendarEntry struct {
+	Kind      TimeAllocationKind `json:"kind"`
+	SourceID  uuid.UUID          `json:"source_id"`
+	Title     string             `json:"title"`
+	StartTime time.Time          `json:"start_time"`
+	EndTime   time.Time          `json:"end_time"`
+	Status    string             `json:"status"`
+}
+
+// EmployeeCalendar 员工在某一时间段内的合并日历（排班、请假、出差、加班、外出）
+type EmployeeCalendar struct {
+	EmployeeID   uuid.UUID        `json:"employee_id"`
+	EmployeeName string           `json:"employee_name"`
+	Entries      []*CalendarEntry `json:"entries"`
+}
@@ -0,0 +1,166 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultDestinationTier 未配置目的地级别时使用的默认级别
+	DefaultDestinationTier = "default"
+
+	// DefaultExpenseCurrency 报销默认币种
+	DefaultExpenseCurrency = "CNY"
+)
+
+// TripExpenseCategory 出差费用类别
+type TripExpenseCategory string
+
+const (
+	TripExpenseTransport TripExpenseCategory = "transport" // 交通
+	TripExpenseHotel     TripExpenseCategory = "hotel"     // 住宿
+	TripExpenseMeal      TripExpenseCategory = "meal"      // 餐饮
+	TripExpensePerDiem   TripExpenseCategory = "per_diem"  // 出差补助
+	TripExpenseOther     TripExpenseCategory = "other"     // 其他
+)
+
+// IsValid 是否为支持的费用类别
+func (c TripExpenseCategory) IsValid() bool {
+	switch c {
+	case TripExpenseTransport, TripExpenseHotel, TripExpenseMeal, TripExpensePerDiem, TripExpenseOther:
+		return true
+	}
+	return false
+}
+
+// CountsTowardPerDiem 是否计入每日补助标准（餐饮与补助合并按日限额）
+func (c TripExpenseCategory) CountsTowardPerDiem() bool {
+	return c == TripExpenseMeal || c == TripExpensePerDiem
+}
+
+// RequiresReceipt 报销提交时是否必须上传票据（出差补助按标准发放，无需票据）
+func (c TripExpenseCategory) RequiresReceipt() bool {
+	return c != TripExpensePerDiem
+}
+
+// TripExpense 出差报销明细
+type TripExpense struct {
+	ID          uuid.UUID           `json:"id"`
+	TenantID    uuid.UUID           `json:"tenant_id"`
+	TripID      uuid.UUID           `json:"trip_id"`
+	Category    TripExpenseCategory `json:"category"`
+	Amount      float64             `json:"amount"`
+	Currency    string              `json:"currency"`
+	ExpenseDate time.Time           `json:"expense_date"`
+	Nights      int                 `json:"nights,omitempty"` // 住宿晚数（住宿类必填）
+	Description string              `json:"description,omitempty"`
+
+	// 票据（文件模块 FileRelation，不落本表）
+	ReceiptFileIDs []uuid.UUID `json:"receipt_file_ids"`
+
+	// 标准校验结果（每次明细变动后按适用标准重新计算）
+	PolicyCap        *float64 `json:"policy_cap,omitempty"`       // 适用限额（补助为当日限额，住宿为限额×晚数）
+	OverPolicy       bool     `json:"over_policy"`                // 是否超标
+	OverPolicyAmount float64  `json:"over_policy_amount"`         // 超标金额
+	OverPolicyNote   string   `json:"over_policy_note,omitempty"` // 超标说明（员工填写）
+
+	CreatedBy uuid.UUID `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TripExpenseClaimStatus 报销单状态
+type TripExpenseClaimStatus string
+
+const (
+	TripExpenseClaimDraft    TripExpenseClaimStatus = "draft"    // 草稿（可编辑明细）
+	TripExpenseClaimPending  TripExpenseClaimStatus = "pending"  // 审批中
+	TripExpenseClaimApproved TripExpenseClaimStatus = "approved" // 已通过
+	TripExpenseClaimRejected TripExpenseClaimStatus = "rejected" // 已驳回（可修改后重新提交）
+)
+
+// Editable 当前状态下是否允许修改明细
+func (s TripExpenseClaimStatus) Editable() bool {
+	return s == "" || s == TripExpenseClaimDraft || s == TripExpenseClaimRejected
+}
+
+// TripExpenseClaim 出差报销单（每次出差一张，明细汇总后经审批模块审批）
+type TripExpenseClaim struct {
+	TripID     uuid.UUID              `json:"trip_id"`
+	TenantID   uuid.UUID              `json:"tenant_id"`
+	EmployeeID uuid.UUID              `json:"employee_id"`
+	Status     TripExpenseClaimStatus `json:"status"`
+
+	// 汇总（提交时计算）
+	Currency         string  `json:"currency"`
+	TotalAmount      float64 `json:"total_amount"`
+	OverPolicyAmount float64 `json:"over_policy_amount"`
+	OverPolicyCount  int     `json:"over_policy_count"`
+
+	// 适用标准（提交时快照）
+	PolicyID        *uuid.UUID `json:"policy_id,omitempty"`
+	DestinationTier string     `json:"destination_tier,omitempty"`
+
+	// 审批
+	ApprovalInstanceID *uuid.UUID `json:"approval_instance_id,omitempty"`
+	SubmittedBy        *uuid.UUID `json:"submitted_by,omitempty"`
+	SubmittedAt        *time.Time `json:"submitted_at,omitempty"`
+	DecidedAt          *time.Time `json:"decided_at,omitempty"`
+
+	Items []*TripExpense `json:"items"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TripDestinationTier 目的地级别（如一线城市、二线城市），租户自行维护
+type TripDestinationTier struct {
+	ID          uuid.UUID `json:"id"`
+	TenantID    uuid.UUID `json:"tenant_id"`
+	Destination string    `json:"destination"` // 目的地关键字，出差目的地包含该关键字即匹配
+	Tier        string    `json:"tier"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// TripExpensePolicy 差旅标准：按目的地级别和职级设置每日补助、住宿上限
+type TripExpensePolicy struct {
+	ID              uuid.UUID `json:"id"`
+	TenantID        uuid.UUID `json:"tenant_id"`
+	Name            string    `json:"name"`
+	DestinationTier string    `json:"destination_tier"`
+
+	// 职级范围（对应组织职位职级，0 表示不限）
+	MinPositionLevel int `json:"min_position_level"`
+	MaxPositionLevel int `json:"max_position_level"`
+
+	PerDiemCap       float64 `json:"per_diem_cap"`        // 每日餐饮+补助上限（0 表示不限）
+	HotelCapPerNight float64 `json:"hotel_cap_per_night"` // 每晚住宿上限（0 表示不限）
+	Currency         string  `json:"currency"`
+
+	IsActive  bool      `json:"is_active"`
+	CreatedBy uuid.UUID `json:"created_by"`
+	UpdatedBy uuid.UUID `json:"updated_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// MatchesLevel 职级是否在适用范围内
+func (p *TripExpensePolicy) MatchesLevel(level int) bool {
+	if p.MinPositionLevel > 0 && level < p.MinPositionLevel {
+		return false
+	}
+	if p.MaxPositionLevel > 0 && level > p.MaxPositionLevel {
+		return false
+	}
+	return true
+}
+
+// LevelSpan 职级范围宽度，用于多条标准同时匹配时优先取范围最窄的
+func (p *TripExpensePolicy) LevelSpan() int {
+	min, max := p.MinPositionLevel, p.MaxPositionLevel
+	if max == 0 {
+		max = 1 << 16
+	}
+	return max - min
+}
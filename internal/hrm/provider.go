@@ -0,0 +1,36 @@
+package hrm
+
+import (
+	"time"
+
+	"github.com/lk2023060901/go-next-erp/internal/conf"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/service"
+	"github.com/lk2023060901/go-next-erp/pkg/fieldcrypt"
+)
+
+// ProvideFieldCipher 提供敏感字段加密器（身份证号、生物特征、设备凭据）
+func ProvideFieldCipher(cfg *conf.Config, store fieldcrypt.KeyStore) (fieldcrypt.Cipher, error) {
+	encCfg := cfg.HRM.FieldEncryption
+
+	return fieldcrypt.NewCipher(&fieldcrypt.Config{
+		MasterKeys:      encCfg.MasterKeys,
+		ActiveMasterKey: encCfg.ActiveMasterKey,
+		BlindIndexKey:   encCfg.BlindIndexKey,
+	}, store)
+}
+
+// ProvideClockQRConfig 提供考勤屏动态二维码配置
+func ProvideClockQRConfig(cfg *conf.Config) *service.ClockQRConfig {
+	qrCfg := cfg.HRM.ClockQR
+
+	rotate := time.Duration(qrCfg.Rotate) * time.Second
+	if rotate <= 0 {
+		rotate = 30 * time.Second
+	}
+
+	return &service.ClockQRConfig{
+		Secret:  []byte(qrCfg.Secret),
+		Rotate:  rotate,
+		ScanURL: qrCfg.ScanURL,
+	}
+}
@@ -16,6 +16,9 @@ type AttendanceRecordRepository interface {
 	// BatchCreate 批量创建考勤记录
 	BatchCreate(ctx context.Context, records []*model.AttendanceRecord) error
 
+	// CreateIfAbsent 创建设备上传的考勤记录，同一设备、员工、打卡时间的记录已存在时不写入并返回 false
+	CreateIfAbsent(ctx context.Context, record *model.AttendanceRecord) (bool, error)
+
 	// FindByID 根据ID查找
 	FindByID(ctx context.Context, id uuid.UUID) (*model.AttendanceRecord, error)
 
@@ -41,6 +44,12 @@ type AttendanceRecordRepository interface {
 	// FindByDateRange 按日期范围查询
 	FindByDateRange(ctx context.Context, tenantID uuid.UUID, startDate, endDate time.Time) ([]*model.AttendanceRecord, error)
 
+	// FindBySource 查询某来源（如设备）在时间范围内的记录，用于设备重复上传去重
+	FindBySource(ctx context.Context, tenantID uuid.UUID, sourceType model.SourceType, sourceID string, startTime, endTime time.Time) ([]*model.AttendanceRecord, error)
+
+	// FindSourceIDs 返回已导入的来源ID（如第三方平台记录ID），用于平台增量拉取去重
+	FindSourceIDs(ctx context.Context, tenantID uuid.UUID, sourceType model.SourceType, sourceIDs []string) (map[string]bool, error)
+
 	// CountByStatus 统计各状态考勤记录数
 	CountByStatus(ctx context.Context, tenantID uuid.UUID, startDate, endDate time.Time) (map[model.AttendanceStatus]int, error)
 
@@ -60,3 +69,28 @@ type AttendanceRecordFilter struct {
 	IsException  *bool
 	Keyword      string // 搜索关键词（员工姓名）
 }
+
+// AttendanceAnomalyRepository 考勤异常仓储接口
+type AttendanceAnomalyRepository interface {
+	// CreateBatch 批量写入分析结果，Fingerprint 已存在的跳过，返回新增数量
+	CreateBatch(ctx context.Context, anomalies []*model.AttendanceAnomaly) (int, error)
+
+	// FindByID 根据ID查找
+	FindByID(ctx context.Context, id uuid.UUID) (*model.AttendanceAnomaly, error)
+
+	// UpdateStatus 更新处理状态及处理人
+	UpdateStatus(ctx context.Context, anomaly *model.AttendanceAnomaly) error
+
+	// List 列表查询（按打卡时间倒序分页）
+	List(ctx context.Context, tenantID uuid.UUID, filter *AttendanceAnomalyFilter, offset, limit int) ([]*model.AttendanceAnomaly, int, error)
+}
+
+// AttendanceAnomalyFilter 考勤异常查询过滤器
+type AttendanceAnomalyFilter struct {
+	Type       *model.AttendanceAnomalyType
+	Status     *model.AttendanceAnomalyStatus
+	EmployeeID *uuid.UUID
+	DeviceSN   string
+	StartDate  *time.Time
+	EndDate    *time.Time
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+)
+
+// BiometricRepository 生物特征模板与下发记录仓储接口
+type BiometricRepository interface {
+	// CreateTemplate 保存一条模板修订
+	CreateTemplate(ctx context.Context, template *model.BiometricTemplate) error
+
+	// ListCurrentTemplates 查询员工各 (类型, 编号, 设备类型, 算法版本) 的最新修订
+	ListCurrentTemplates(ctx context.Context, tenantID, employeeID uuid.UUID) ([]*model.BiometricTemplate, error)
+
+	// UpsertDistribution 按 (员工, 设备) 写入最近一次下发或删除操作
+	UpsertDistribution(ctx context.Context, distribution *model.BiometricDistribution) error
+
+	// ListDistributions 查询下发记录，附带员工、设备信息及该操作之后的命令执行统计
+	ListDistributions(ctx context.Context, tenantID uuid.UUID, filter *BiometricDistributionFilter) ([]*model.BiometricDistribution, error)
+
+	// CreateEnrollment 创建远程采集会话
+	CreateEnrollment(ctx context.Context, enrollment *model.BiometricEnrollment) error
+
+	// ListPendingEnrollments 查询员工在设备上于 now 时仍有效的待采集会话
+	ListPendingEnrollments(ctx context.Context, deviceID, employeeID uuid.UUID, now time.Time) ([]*model.BiometricEnrollment, error)
+
+	// CompleteEnrollment 完成仍有效的待采集会话，会话已被完成或已过期时返回 false
+	CompleteEnrollment(ctx context.Context, id uuid.UUID, capturedAt time.Time) (bool, error)
+}
+
+// BiometricDistributionFilter 下发记录查询过滤器
+type BiometricDistributionFilter struct {
+	EmployeeID *uuid.UUID
+	DeviceID   *uuid.UUID
+}
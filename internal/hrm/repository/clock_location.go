@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+)
+
+// ClockLocationRepository 打卡地点仓储接口
+type ClockLocationRepository interface {
+	// Create 创建打卡地点
+	Create(ctx context.Context, location *model.ClockLocation) error
+
+	// Update 更新打卡地点（含考勤屏密钥）
+	Update(ctx context.Context, location *model.ClockLocation) error
+
+	// Delete 删除打卡地点（软删除）
+	Delete(ctx context.Context, tenantID, id uuid.UUID) error
+
+	// FindByID 根据ID查找
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (*model.ClockLocation, error)
+
+	// FindByCode 根据编码查找
+	FindByCode(ctx context.Context, tenantID uuid.UUID, code string) (*model.ClockLocation, error)
+
+	// FindByIDs 批量查找（考勤规则关联的地点），不存在的ID忽略
+	FindByIDs(ctx context.Context, tenantID uuid.UUID, ids []uuid.UUID) ([]*model.ClockLocation, error)
+
+	// FindByKioskKey 跨租户按考勤屏密钥哈希查找（考勤屏免登录拉取二维码）
+	FindByKioskKey(ctx context.Context, keyHash string) (*model.ClockLocation, error)
+
+	// List 租户打卡地点列表
+	List(ctx context.Context, tenantID uuid.UUID) ([]*model.ClockLocation, error)
+
+	// UseQRCode 记录员工使用某地点 issuedAt 周期的二维码，该周期已使用过时返回 false
+	UseQRCode(ctx context.Context, tenantID, employeeID, locationID uuid.UUID, issuedAt, scannedAt time.Time) (bool, error)
+}
+
+// ClockAttemptRepository 被拒打卡记录仓储接口
+type ClockAttemptRepository interface {
+	// Create 记录被拒打卡
+	Create(ctx context.Context, attempt *model.ClockAttempt) error
+
+	// Update 更新复核结果
+	Update(ctx context.Context, attempt *model.ClockAttempt) error
+
+	// FindByID 根据ID查找
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (*model.ClockAttempt, error)
+
+	// List 列表查询（按打卡时间倒序分页）
+	List(ctx context.Context, tenantID uuid.UUID, filter *ClockAttemptFilter, offset, limit int) ([]*model.ClockAttempt, int, error)
+}
+
+// ClockAttemptFilter 被拒打卡查询过滤器
+type ClockAttemptFilter struct {
+	EmployeeID *uuid.UUID
+	LocationID *uuid.UUID
+	Reason     *model.ClockRejectReason
+	Status     *model.ClockAttemptStatus
+	StartDate  *time.Time
+	EndDate    *time.Time
+}
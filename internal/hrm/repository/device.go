@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
@@ -41,6 +42,42 @@ type AttendanceDeviceRepository interface {
 
 	// UpdateStatus 更新设备状态
 	UpdateStatus(ctx context.Context, id uuid.UUID, status model.DeviceStatus, errorMsg string) error
+
+	// ListBySN 跨租户按序列号查询设备（推送协议的设备只上报序列号）
+	ListBySN(ctx context.Context, deviceSN string) ([]*model.AttendanceDevice, error)
+
+	// UpdateConnection 更新设备上报的 IP 地址和固件版本，空值不覆盖
+	UpdateConnection(ctx context.Context, id uuid.UUID, ipAddress, firmwareVersion string) error
+
+	// UpdateBiometricVersions 更新设备上报的指纹、人脸算法版本，空值不覆盖
+	UpdateBiometricVersions(ctx context.Context, id uuid.UUID, fingerprintVersion, faceVersion string) error
+
+	// UpdatePushStamp 更新考勤记录上传戳并累加记录数
+	UpdatePushStamp(ctx context.Context, id uuid.UUID, stamp string, records int) error
+
+	// ListAllActive 跨租户查询所有启用的设备（设备健康检查）
+	ListAllActive(ctx context.Context) ([]*model.AttendanceDevice, error)
+}
+
+// DeviceCommandRepository 设备命令队列仓储接口
+type DeviceCommandRepository interface {
+	// Create 创建命令，回填协议命令编号 Seq
+	Create(ctx context.Context, command *model.DeviceCommand) error
+
+	// ListPending 查询设备待拉取的命令（按 Seq 升序）
+	ListPending(ctx context.Context, deviceID uuid.UUID, limit int) ([]*model.DeviceCommand, error)
+
+	// MarkSent 标记命令已被设备拉取
+	MarkSent(ctx context.Context, ids []uuid.UUID) error
+
+	// Complete 根据设备回执更新命令结果，返回是否找到对应命令
+	Complete(ctx context.Context, deviceID uuid.UUID, seq int64, returnCode int) (bool, error)
+
+	// List 查询设备命令（分页，按创建时间倒序）
+	List(ctx context.Context, deviceID uuid.UUID, offset, limit int) ([]*model.DeviceCommand, int, error)
+
+	// StatsByDevice 按设备统计未执行完的命令和 failedSince 之后失败的命令，没有命令的设备不在结果中
+	StatsByDevice(ctx context.Context, tenantID uuid.UUID, failedSince time.Time) (map[uuid.UUID]*model.DeviceCommandStats, error)
 }
 
 // DeviceFilter 设备查询过滤器
@@ -80,6 +117,12 @@ type ThirdPartyIntegrationRepository interface {
 
 	// UpdateStatus 更新集成状态
 	UpdateStatus(ctx context.Context, id uuid.UUID, status model.IntegrationStatus, errorMsg string) error
+
+	// UpdateSyncCursor 保存考勤增量拉取游标
+	UpdateSyncCursor(ctx context.Context, id uuid.UUID, cursor string) error
+
+	// ListSyncDue 查询所有租户中已到同步间隔、启用考勤同步的集成
+	ListSyncDue(ctx context.Context, now time.Time) ([]*model.ThirdPartyIntegration, error)
 }
 
 // IntegrationFilter 集成查询过滤器
@@ -28,6 +28,9 @@ type HRMEmployeeRepository interface {
 	// FindByCardNo 根据考勤卡号查找
 	FindByCardNo(ctx context.Context, tenantID uuid.UUID, cardNo string) (*model.HRMEmployee, error)
 
+	// FindByIDCardNo 根据身份证号查找（按盲索引匹配，仅返回ID信息）
+	FindByIDCardNo(ctx context.Context, tenantID uuid.UUID, idCardNo string) (*model.HRMEmployee, error)
+
 	// FindByThirdPartyID 根据第三方平台ID查找
 	FindByThirdPartyID(ctx context.Context, tenantID uuid.UUID, platform model.PlatformType, platformID string) (*model.HRMEmployee, error)
 
@@ -43,6 +46,39 @@ type HRMEmployeeRepository interface {
 	// ListActive 查询启用考勤的员工
 	ListActive(ctx context.Context, tenantID uuid.UUID) ([]*model.HRMEmployee, error)
 
+	// ListActiveTenantIDs 查询存在启用考勤员工的租户
+	ListActiveTenantIDs(ctx context.Context) ([]uuid.UUID, error)
+
+	// ListTenures 查询租户内员工的入职、离职日期（含已离职但仍有考勤扩展记录的员工）
+	ListTenures(ctx context.Context, tenantID uuid.UUID) ([]*model.EmployeeTenure, error)
+
+	// FindTenure 查询单个员工的入职、离职日期
+	FindTenure(ctx context.Context, tenantID, employeeID uuid.UUID) (*model.EmployeeTenure, error)
+
+	// FindPositionLevel 查询员工主职位的职级（未设置职位时返回 0）
+	FindPositionLevel(ctx context.Context, tenantID, employeeID uuid.UUID) (int, error)
+
+	// ListTenuresByDepartment 查询部门内员工的入职、离职日期
+	ListTenuresByDepartment(ctx context.Context, tenantID, departmentID uuid.UUID) ([]*model.EmployeeTenure, error)
+
+	// FindEmployeeIDByUser 查询系统用户对应的在职员工ID（员工自助接口确定当前员工）
+	FindEmployeeIDByUser(ctx context.Context, tenantID, userID uuid.UUID) (uuid.UUID, error)
+
+	// FindManagerUserIDs 查询员工直属上级及所在组织负责人对应的系统用户ID（用于校验审批人）
+	FindManagerUserIDs(ctx context.Context, tenantID, employeeID uuid.UUID) ([]uuid.UUID, error)
+
+	// FindUserIDs 查询员工对应的系统用户ID（用于发送通知）
+	FindUserIDs(ctx context.Context, tenantID uuid.UUID, employeeIDs []uuid.UUID) (map[uuid.UUID]uuid.UUID, error)
+
+	// ListDeviceUsers 查询员工在考勤机上的用户信息（PIN 为工号）
+	ListDeviceUsers(ctx context.Context, tenantID uuid.UUID, employeeIDs []uuid.UUID) ([]*model.DeviceUser, error)
+
+	// ListActiveDeviceUsers 查询启用考勤的在职员工在考勤机上的用户信息，departmentID 为空时查询全租户
+	ListActiveDeviceUsers(ctx context.Context, tenantID uuid.UUID, departmentID *uuid.UUID) ([]*model.DeviceUser, error)
+
+	// FindDeviceUsersByPIN 按考勤机 PIN（工号）查询员工，返回以 PIN 为键的映射
+	FindDeviceUsersByPIN(ctx context.Context, tenantID uuid.UUID, pins []string) (map[string]*model.DeviceUser, error)
+
 	// UpdateFaceData 更新人脸数据
 	UpdateFaceData(ctx context.Context, id uuid.UUID, faceData string) error
 
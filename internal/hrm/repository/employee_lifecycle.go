@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+)
+
+// EmployeeLifecycleRepository 员工生命周期仓储接口（申请、领域事件、试用期提醒）
+type EmployeeLifecycleRepository interface {
+	// Create 创建生命周期申请
+	Create(ctx context.Context, req *model.EmployeeLifecycleRequest) error
+
+	// Update 更新申请状态、执行结果及回填的员工ID
+	Update(ctx context.Context, req *model.EmployeeLifecycleRequest) error
+
+	// FindByID 根据ID查找
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (*model.EmployeeLifecycleRequest, error)
+
+	// FindOpenByEmployee 查询员工未结束的申请，没有时返回 nil
+	FindOpenByEmployee(ctx context.Context, tenantID, employeeID uuid.UUID) (*model.EmployeeLifecycleRequest, error)
+
+	// FindByApprovalInstance 根据审批流程实例查找申请，没有时返回 nil
+	FindByApprovalInstance(ctx context.Context, tenantID, instanceID uuid.UUID) (*model.EmployeeLifecycleRequest, error)
+
+	// List 列表查询（按创建时间倒序分页）
+	List(ctx context.Context, tenantID uuid.UUID, filter *EmployeeLifecycleFilter, offset, limit int) ([]*model.EmployeeLifecycleRequest, int, error)
+
+	// ListOpen 查询全部租户未结束的申请（定时任务同步审批状态并执行）
+	ListOpen(ctx context.Context, limit int) ([]*model.EmployeeLifecycleRequest, error)
+
+	// AppendEvent 追加领域事件
+	AppendEvent(ctx context.Context, event *model.EmployeeLifecycleEvent) error
+
+	// ListEvents 查询员工的领域事件（按发生时间正序）
+	ListEvents(ctx context.Context, tenantID, employeeID uuid.UUID) ([]*model.EmployeeLifecycleEvent, error)
+
+	// CreateReminders 批量创建试用期提醒
+	CreateReminders(ctx context.Context, reminders []*model.ProbationReminder) error
+
+	// ListDueReminders 查询到期未发送的试用期提醒
+	ListDueReminders(ctx context.Context, asOf time.Time, limit int) ([]*model.ProbationReminder, error)
+
+	// MarkReminderSent 标记提醒已发送
+	MarkReminderSent(ctx context.Context, id uuid.UUID, sentAt time.Time) error
+
+	// CancelReminders 取消员工所有未发送的提醒，返回取消数量
+	CancelReminders(ctx context.Context, employeeID uuid.UUID) (int, error)
+}
+
+// EmployeeLifecycleFilter 生命周期申请查询过滤器
+type EmployeeLifecycleFilter struct {
+	EmployeeID *uuid.UUID
+	Action     *model.LifecycleAction
+	Status     *model.LifecycleRequestStatus
+}
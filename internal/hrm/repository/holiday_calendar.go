@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+)
+
+// HolidayCalendarRepository 假期日历仓储接口
+type HolidayCalendarRepository interface {
+	// Create 创建日历
+	Create(ctx context.Context, calendar *model.HolidayCalendar) error
+
+	// FindByID 根据ID查找
+	FindByID(ctx context.Context, id uuid.UUID) (*model.HolidayCalendar, error)
+
+	// FindByCode 根据编码查找
+	FindByCode(ctx context.Context, tenantID uuid.UUID, code string) (*model.HolidayCalendar, error)
+
+	// FindDefault 查找租户默认日历
+	FindDefault(ctx context.Context, tenantID uuid.UUID) (*model.HolidayCalendar, error)
+
+	// Update 更新日历
+	Update(ctx context.Context, calendar *model.HolidayCalendar) error
+
+	// Delete 删除日历（软删除）
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// List 查询租户日历
+	List(ctx context.Context, tenantID uuid.UUID) ([]*model.HolidayCalendar, error)
+
+	// ClearDefault 取消租户其他日历的默认标记
+	ClearDefault(ctx context.Context, tenantID, exceptID uuid.UUID) error
+
+	// UpsertDays 批量写入特殊日（同一日期覆盖）
+	UpsertDays(ctx context.Context, days []*model.HolidayCalendarDay) error
+
+	// DeleteDay 删除某个特殊日
+	DeleteDay(ctx context.Context, calendarID uuid.UUID, date time.Time) error
+
+	// DeleteDaysInRange 删除区间内的特殊日（导入覆盖时使用）
+	DeleteDaysInRange(ctx context.Context, calendarID uuid.UUID, start, end time.Time) (int64, error)
+
+	// ListDays 查询区间 [start, end] 内的特殊日
+	ListDays(ctx context.Context, calendarID uuid.UUID, start, end time.Time) ([]*model.HolidayCalendarDay, error)
+}
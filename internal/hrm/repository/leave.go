@@ -77,6 +77,24 @@ type LeaveQuotaRepository interface {
 
 	// BatchCreate 批量创建额度
 	BatchCreate(ctx context.Context, quotas []*model.LeaveQuota) error
+
+	// ListByTypeAndYear 查询某请假类型某年度的全部额度（年末结转）
+	ListByTypeAndYear(ctx context.Context, tenantID, leaveTypeID uuid.UUID, year int) ([]*model.LeaveQuota, error)
+}
+
+// LeaveQuotaLedgerRepository 请假额度流水仓储接口
+type LeaveQuotaLedgerRepository interface {
+	// Apply 写入流水并同步额度（同一事务），幂等键已存在时返回 false 且不做任何变更
+	Apply(ctx context.Context, entry *model.LeaveQuotaLedgerEntry) (bool, error)
+
+	// ListByQuota 查询某条额度的全部流水（按时间正序）
+	ListByQuota(ctx context.Context, quotaID uuid.UUID) ([]*model.LeaveQuotaLedgerEntry, error)
+
+	// ListByEmployee 查询员工某年度的流水（按时间倒序）
+	ListByEmployee(ctx context.Context, tenantID, employeeID uuid.UUID, year int) ([]*model.LeaveQuotaLedgerEntry, error)
+
+	// ListExpiring 查询截至 before 已到期但尚未作废处理的入账流水
+	ListExpiring(ctx context.Context, tenantID uuid.UUID, before time.Time) ([]*model.LeaveQuotaLedgerEntry, error)
 }
 
 // LeaveRequestRepository 请假申请仓储接口
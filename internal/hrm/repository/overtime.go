@@ -36,6 +36,39 @@ type OvertimeRepository interface {
 
 	// SumCompOffDays 统计可调休天数
 	SumCompOffDays(ctx context.Context, tenantID, employeeID uuid.UUID) (float64, error)
+
+	// SumCommittedHours 统计 [startDate, endDate) 内已批准和待审批的计薪加班时长，excludeID 不计入
+	SumCommittedHours(ctx context.Context, tenantID, employeeID uuid.UUID, startDate, endDate time.Time, excludeID *uuid.UUID) (float64, error)
+
+	// ExistsByAttendanceRecord 下班打卡是否已生成加班记录
+	ExistsByAttendanceRecord(ctx context.Context, recordID uuid.UUID) (bool, error)
+}
+
+// OvertimePolicyRepository 加班政策仓储接口
+type OvertimePolicyRepository interface {
+	// Create 创建加班政策
+	Create(ctx context.Context, policy *model.OvertimePolicy) error
+
+	// FindByID 根据ID查找
+	FindByID(ctx context.Context, id uuid.UUID) (*model.OvertimePolicy, error)
+
+	// FindByCode 根据编码查找
+	FindByCode(ctx context.Context, tenantID uuid.UUID, code string) (*model.OvertimePolicy, error)
+
+	// FindDefault 查找租户默认政策
+	FindDefault(ctx context.Context, tenantID uuid.UUID) (*model.OvertimePolicy, error)
+
+	// Update 更新加班政策
+	Update(ctx context.Context, policy *model.OvertimePolicy) error
+
+	// Delete 删除加班政策（软删除）
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// List 列出租户的加班政策
+	List(ctx context.Context, tenantID uuid.UUID) ([]*model.OvertimePolicy, error)
+
+	// ClearDefault 取消租户其他政策的默认标记
+	ClearDefault(ctx context.Context, tenantID, exceptID uuid.UUID) error
 }
 
 // OvertimeFilter 加班查询过滤器
@@ -149,8 +182,33 @@ type AttendanceSummaryRepository interface {
 	// ConfirmSummary 确认考勤汇总
 	ConfirmSummary(ctx context.Context, id, confirmedBy uuid.UUID) error
 
-	// LockSummary 锁定考勤汇总
-	LockSummary(ctx context.Context, tenantID uuid.UUID, year, month int) error
+	// LockSummary 在同一事务中锁定某月汇总、写入各汇总的锁定快照（按汇总ID）和租户级月份锁；
+	// 任一汇总已不是已确认状态或该月还有未包含的汇总时不做修改并返回 false
+	LockSummary(ctx context.Context, tenantID uuid.UUID, year, month int, snapshots map[uuid.UUID]*model.AttendanceLockSnapshot) (bool, error)
+
+	// Upsert 写入重算结果（按员工+月份覆盖统计数据，已锁定的汇总不覆盖）
+	Upsert(ctx context.Context, summary *model.AttendanceSummary) error
+
+	// ConfirmMonth 确认某月全部草稿汇总
+	ConfirmMonth(ctx context.Context, tenantID uuid.UUID, year, month int, confirmedBy uuid.UUID) (int64, error)
+
+	// UnlockSummary 解锁某月汇总（退回已确认、清空锁定快照并删除月份锁）
+	UnlockSummary(ctx context.Context, tenantID uuid.UUID, year, month int) error
+
+	// IsMonthLocked 租户某月是否已锁定
+	IsMonthLocked(ctx context.Context, tenantID uuid.UUID, year, month int) (bool, error)
+
+	// CountByStatus 统计某月各状态的汇总数
+	CountByStatus(ctx context.Context, tenantID uuid.UUID, year, month int) (map[string]int, error)
+
+	// MarkDirty 标记员工某月汇总待重算
+	MarkDirty(ctx context.Context, period *model.AttendancePeriod) error
+
+	// ListDirty 查询待重算的员工月份（按标记时间先后）
+	ListDirty(ctx context.Context, limit int) ([]*model.AttendancePeriod, error)
+
+	// ClearDirty 清除 before 之前的待重算标记（重算期间的新标记保留）
+	ClearDirty(ctx context.Context, period *model.AttendancePeriod, before time.Time) error
 }
 
 // AttendanceSummaryFilter 考勤汇总查询过滤器
@@ -189,10 +247,19 @@ type PunchCardSupplementRepository interface {
 	// FindByDate 查询指定日期的补卡申请
 	FindByDate(ctx context.Context, tenantID, employeeID uuid.UUID, date time.Time, supplementType model.SupplementType) (*model.PunchCardSupplement, error)
 
-	// CountByEmployee 统计员工补卡次数
+	// CountByEmployee 统计员工补卡次数（不含已拒绝、已取消的申请）
 	CountByEmployee(ctx context.Context, tenantID, employeeID uuid.UUID, startDate, endDate time.Time) (int, error)
 }
 
+// PunchCardSupplementPolicyRepository 补卡政策仓储接口
+type PunchCardSupplementPolicyRepository interface {
+	// FindByTenant 查询租户补卡政策，未配置时返回 nil
+	FindByTenant(ctx context.Context, tenantID uuid.UUID) (*model.PunchCardSupplementPolicy, error)
+
+	// Save 保存租户补卡政策（不存在则创建）
+	Save(ctx context.Context, policy *model.PunchCardSupplementPolicy) error
+}
+
 // PunchCardSupplementFilter 补卡申请查询过滤器
 type PunchCardSupplementFilter struct {
 	EmployeeID     *uuid.UUID
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+)
+
+// ErrPayrollRunNotFound 导出记录不存在
+var ErrPayrollRunNotFound = errors.New("payroll run not found")
+
+// PayrollRunRepository 薪资对接导出记录仓储接口
+type PayrollRunRepository interface {
+	// Create 创建导出记录（含员工明细快照）
+	Create(ctx context.Context, run *model.PayrollRun) error
+
+	// FindByID 根据ID查找（含员工明细快照）
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (*model.PayrollRun, error)
+
+	// FindLatest 查询某期最近一次导出（含员工明细快照），尚未导出时返回 ErrPayrollRunNotFound
+	FindLatest(ctx context.Context, tenantID uuid.UUID, year, month int) (*model.PayrollRun, error)
+
+	// List 列表查询（按创建时间倒序分页，不含员工明细快照），year/month 为 0 时不过滤
+	List(ctx context.Context, tenantID uuid.UUID, year, month int, offset, limit int) ([]*model.PayrollRun, int, error)
+}
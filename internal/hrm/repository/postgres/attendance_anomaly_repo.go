@@ -0,0 +1,181 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/database"
+)
+
+type attendanceAnomalyRepo struct {
+	db *database.DB
+}
+
+// NewAttendanceAnomalyRepository 创建考勤异常仓储
+func NewAttendanceAnomalyRepository(db *database.DB) repository.AttendanceAnomalyRepository {
+	return &attendanceAnomalyRepo{db: db}
+}
+
+const attendanceAnomalyColumns = `
+	id, tenant_id, anomaly_type, severity, status,
+	employee_id, COALESCE(employee_name, ''), COALESCE(device_sn, ''),
+	record_ids, occurred_at, description, details, fingerprint,
+	handled_by, handled_at, COALESCE(handle_comment, ''),
+	created_at, updated_at
+`
+
+func (r *attendanceAnomalyRepo) CreateBatch(ctx context.Context, anomalies []*model.AttendanceAnomaly) (int, error) {
+	if len(anomalies) == 0 {
+		return 0, nil
+	}
+
+	sql := `
+		INSERT INTO hrm_attendance_anomalies (
+			id, tenant_id, anomaly_type, severity, status,
+			employee_id, employee_name, device_sn,
+			record_ids, occurred_at, description, details, fingerprint,
+			created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		ON CONFLICT (tenant_id, fingerprint) DO NOTHING
+	`
+
+	created := 0
+	err := r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		for _, anomaly := range anomalies {
+			details, err := json.Marshal(anomaly.Details)
+			if err != nil {
+				return fmt.Errorf("failed to marshal anomaly details: %w", err)
+			}
+
+			tag, err := tx.Exec(ctx, sql,
+				anomaly.ID, anomaly.TenantID, anomaly.Type, anomaly.Severity, anomaly.Status,
+				anomaly.EmployeeID, anomaly.EmployeeName, anomaly.DeviceSN,
+				anomaly.RecordIDs, anomaly.OccurredAt, anomaly.Description, details, anomaly.Fingerprint,
+				anomaly.CreatedAt, anomaly.UpdatedAt,
+			)
+			if err != nil {
+				return err
+			}
+			created += int(tag.RowsAffected())
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return created, nil
+}
+
+func (r *attendanceAnomalyRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.AttendanceAnomaly, error) {
+	sql := `SELECT ` + attendanceAnomalyColumns + ` FROM hrm_attendance_anomalies WHERE id = $1`
+
+	return scanAttendanceAnomaly(r.db.QueryRow(ctx, sql, id))
+}
+
+func (r *attendanceAnomalyRepo) UpdateStatus(ctx context.Context, anomaly *model.AttendanceAnomaly) error {
+	sql := `
+		UPDATE hrm_attendance_anomalies SET
+			status = $1, handled_by = $2, handled_at = $3, handle_comment = $4, updated_at = $5
+		WHERE id = $6
+	`
+
+	_, err := r.db.Exec(ctx, sql,
+		anomaly.Status, anomaly.HandledBy, anomaly.HandledAt, anomaly.HandleComment, anomaly.UpdatedAt,
+		anomaly.ID,
+	)
+
+	return err
+}
+
+func (r *attendanceAnomalyRepo) List(ctx context.Context, tenantID uuid.UUID, filter *repository.AttendanceAnomalyFilter, offset, limit int) ([]*model.AttendanceAnomaly, int, error) {
+	where := "tenant_id = $1"
+	args := []interface{}{tenantID}
+
+	if filter != nil {
+		if filter.Type != nil {
+			args = append(args, *filter.Type)
+			where += fmt.Sprintf(" AND anomaly_type = $%d", len(args))
+		}
+		if filter.Status != nil {
+			args = append(args, *filter.Status)
+			where += fmt.Sprintf(" AND status = $%d", len(args))
+		}
+		if filter.EmployeeID != nil {
+			args = append(args, *filter.EmployeeID)
+			where += fmt.Sprintf(" AND employee_id = $%d", len(args))
+		}
+		if filter.DeviceSN != "" {
+			args = append(args, filter.DeviceSN)
+			where += fmt.Sprintf(" AND device_sn = $%d", len(args))
+		}
+		if filter.StartDate != nil {
+			args = append(args, *filter.StartDate)
+			where += fmt.Sprintf(" AND occurred_at >= $%d", len(args))
+		}
+		if filter.EndDate != nil {
+			args = append(args, *filter.EndDate)
+			where += fmt.Sprintf(" AND occurred_at < $%d", len(args))
+		}
+	}
+
+	var total int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM hrm_attendance_anomalies WHERE `+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	args = append(args, limit, offset)
+	sql := fmt.Sprintf(`
+		SELECT %s FROM hrm_attendance_anomalies
+		WHERE %s
+		ORDER BY occurred_at DESC, id DESC
+		LIMIT $%d OFFSET $%d
+	`, attendanceAnomalyColumns, where, len(args)-1, len(args))
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var anomalies []*model.AttendanceAnomaly
+	for rows.Next() {
+		anomaly, err := scanAttendanceAnomaly(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		anomalies = append(anomalies, anomaly)
+	}
+
+	return anomalies, total, rows.Err()
+}
+
+func scanAttendanceAnomaly(row pgx.Row) (*model.AttendanceAnomaly, error) {
+	anomaly := &model.AttendanceAnomaly{}
+	var details []byte
+	err := row.Scan(
+		&anomaly.ID, &anomaly.TenantID, &anomaly.Type, &anomaly.Severity, &anomaly.Status,
+		&anomaly.EmployeeID, &anomaly.EmployeeName, &anomaly.DeviceSN,
+		&anomaly.RecordIDs, &anomaly.OccurredAt, &anomaly.Description, &details, &anomaly.Fingerprint,
+		&anomaly.HandledBy, &anomaly.HandledAt, &anomaly.HandleComment,
+		&anomaly.CreatedAt, &anomaly.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("attendance anomaly not found")
+		}
+		return nil, err
+	}
+
+	if len(details) > 0 {
+		if err := json.Unmarshal(details, &anomaly.Details); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal anomaly details: %w", err)
+		}
+	}
+	return anomaly, nil
+}
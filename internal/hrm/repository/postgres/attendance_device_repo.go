@@ -0,0 +1,361 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/database"
+	"github.com/lk2023060901/go-next-erp/pkg/fieldcrypt"
+)
+
+// attendanceDeviceRepo 设备登录密码与通讯密钥加密存储
+type attendanceDeviceRepo struct {
+	db     *database.DB
+	cipher fieldcrypt.Cipher
+}
+
+// NewAttendanceDeviceRepository 创建考勤设备仓储
+func NewAttendanceDeviceRepository(db *database.DB, cipher fieldcrypt.Cipher) repository.AttendanceDeviceRepository {
+	return &attendanceDeviceRepo{db: db, cipher: cipher}
+}
+
+const attendanceDeviceColumns = `
+	id, tenant_id, device_type, device_sn, device_name, COALESCE(device_model, ''),
+	COALESCE(ip_address, ''), COALESCE(port, 0), COALESCE(mac_address, ''),
+	location, COALESCE(install_address, ''), department_id,
+	COALESCE(auth_type, ''), COALESCE(username, ''), COALESCE(password, ''), COALESCE(api_key, ''), COALESCE(secret_key, ''),
+	COALESCE(sync_enabled, TRUE), COALESCE(sync_interval, 15), COALESCE(sync_mode, 'pull'), last_sync_at,
+	COALESCE(support_face, FALSE), COALESCE(support_fingerprint, FALSE), COALESCE(support_card, FALSE), COALESCE(support_temperature, FALSE),
+	COALESCE(status, 'offline'), COALESCE(is_active, TRUE), last_heartbeat, COALESCE(error_message, ''),
+	COALESCE(push_stamp, ''), COALESCE(firmware_version, ''), COALESCE(fingerprint_version, ''), COALESCE(face_version, ''),
+	COALESCE(push_allowed_ips, '{}'),
+	COALESCE(total_records, 0), COALESCE(today_records, 0), COALESCE(remark, ''),
+	created_by, updated_by, created_at, updated_at, deleted_at
+`
+
+func (r *attendanceDeviceRepo) Create(ctx context.Context, device *model.AttendanceDevice) error {
+	location, err := json.Marshal(device.Location)
+	if err != nil {
+		return fmt.Errorf("failed to marshal device location: %w", err)
+	}
+	secrets, err := encryptFields(ctx, r.cipher, device.TenantID, device.Password, device.SecretKey)
+	if err != nil {
+		return err
+	}
+
+	sql := `
+		INSERT INTO hrm_attendance_devices (
+			id, tenant_id, device_type, device_sn, device_name, device_model,
+			ip_address, port, mac_address,
+			location, install_address, department_id,
+			auth_type, username, password, api_key, secret_key,
+			sync_enabled, sync_interval, sync_mode,
+			support_face, support_fingerprint, support_card, support_temperature,
+			status, is_active, remark, push_allowed_ips,
+			created_by, updated_by, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6,
+			$7, $8, $9,
+			$10, $11, $12,
+			$13, $14, $15, $16, $17,
+			$18, $19, $20,
+			$21, $22, $23, $24,
+			$25, $26, $27, $28,
+			$29, $30, $31, $32
+		)
+	`
+
+	_, err = r.db.Exec(ctx, sql,
+		device.ID, device.TenantID, device.DeviceType, device.DeviceSN, device.DeviceName, device.DeviceModel,
+		device.IPAddress, device.Port, device.MACAddress,
+		location, device.InstallAddress, device.DepartmentID,
+		device.AuthType, device.Username, secrets[0], device.APIKey, secrets[1],
+		device.SyncEnabled, device.SyncInterval, device.SyncMode,
+		device.SupportFace, device.SupportFingerprint, device.SupportCard, device.SupportTemperature,
+		device.Status, device.IsActive, device.Remark, device.PushAllowedIPs,
+		device.CreatedBy, device.UpdatedBy, device.CreatedAt, device.UpdatedAt,
+	)
+
+	return err
+}
+
+func (r *attendanceDeviceRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.AttendanceDevice, error) {
+	sql := `SELECT ` + attendanceDeviceColumns + ` FROM hrm_attendance_devices WHERE id = $1 AND deleted_at IS NULL`
+
+	return r.scanDevice(ctx, r.db.QueryRow(ctx, sql, id))
+}
+
+func (r *attendanceDeviceRepo) FindBySN(ctx context.Context, tenantID uuid.UUID, deviceSN string) (*model.AttendanceDevice, error) {
+	sql := `
+		SELECT ` + attendanceDeviceColumns + `
+		FROM hrm_attendance_devices
+		WHERE tenant_id = $1 AND device_sn = $2 AND deleted_at IS NULL
+	`
+
+	return r.scanDevice(ctx, r.db.QueryRow(ctx, sql, tenantID, deviceSN))
+}
+
+func (r *attendanceDeviceRepo) Update(ctx context.Context, device *model.AttendanceDevice) error {
+	location, err := json.Marshal(device.Location)
+	if err != nil {
+		return fmt.Errorf("failed to marshal device location: %w", err)
+	}
+	secrets, err := encryptFields(ctx, r.cipher, device.TenantID, device.Password, device.SecretKey)
+	if err != nil {
+		return err
+	}
+
+	sql := `
+		UPDATE hrm_attendance_devices SET
+			device_type = $1, device_name = $2, device_model = $3,
+			ip_address = $4, port = $5, mac_address = $6,
+			location = $7, install_address = $8, department_id = $9,
+			auth_type = $10, username = $11, password = $12, api_key = $13, secret_key = $14,
+			sync_enabled = $15, sync_interval = $16, sync_mode = $17,
+			support_face = $18, support_fingerprint = $19, support_card = $20, support_temperature = $21,
+			is_active = $22, remark = $23, push_allowed_ips = $24,
+			updated_by = $25, updated_at = $26
+		WHERE id = $27 AND deleted_at IS NULL
+	`
+
+	_, err = r.db.Exec(ctx, sql,
+		device.DeviceType, device.DeviceName, device.DeviceModel,
+		device.IPAddress, device.Port, device.MACAddress,
+		location, device.InstallAddress, device.DepartmentID,
+		device.AuthType, device.Username, secrets[0], device.APIKey, secrets[1],
+		device.SyncEnabled, device.SyncInterval, device.SyncMode,
+		device.SupportFace, device.SupportFingerprint, device.SupportCard, device.SupportTemperature,
+		device.IsActive, device.Remark, device.PushAllowedIPs,
+		device.UpdatedBy, device.UpdatedAt,
+		device.ID,
+	)
+
+	return err
+}
+
+func (r *attendanceDeviceRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	sql := `UPDATE hrm_attendance_devices SET deleted_at = NOW() WHERE id = $1`
+	_, err := r.db.Exec(ctx, sql, id)
+	return err
+}
+
+func (r *attendanceDeviceRepo) List(ctx context.Context, tenantID uuid.UUID, filter *repository.DeviceFilter, offset, limit int) ([]*model.AttendanceDevice, int, error) {
+	where := `WHERE tenant_id = $1 AND deleted_at IS NULL`
+	args := []interface{}{tenantID}
+	argIdx := 2
+
+	if filter != nil {
+		if filter.DeviceType != nil {
+			where += fmt.Sprintf(" AND device_type = $%d", argIdx)
+			args = append(args, *filter.DeviceType)
+			argIdx++
+		}
+		if filter.Status != nil {
+			where += fmt.Sprintf(" AND status = $%d", argIdx)
+			args = append(args, *filter.Status)
+			argIdx++
+		}
+		if filter.DepartmentID != nil {
+			where += fmt.Sprintf(" AND department_id = $%d", argIdx)
+			args = append(args, *filter.DepartmentID)
+			argIdx++
+		}
+		if filter.IsActive != nil {
+			where += fmt.Sprintf(" AND is_active = $%d", argIdx)
+			args = append(args, *filter.IsActive)
+			argIdx++
+		}
+		if filter.Keyword != "" {
+			where += fmt.Sprintf(" AND (device_name ILIKE $%d OR device_sn ILIKE $%d)", argIdx, argIdx)
+			args = append(args, "%"+filter.Keyword+"%")
+			argIdx++
+		}
+	}
+
+	var total int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM hrm_attendance_devices `+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	sql := fmt.Sprintf(`
+		SELECT %s FROM hrm_attendance_devices %s
+		ORDER BY device_name ASC
+		LIMIT $%d OFFSET $%d
+	`, attendanceDeviceColumns, where, argIdx, argIdx+1)
+	args = append(args, limit, offset)
+
+	devices, err := r.queryDevices(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return devices, total, nil
+}
+
+func (r *attendanceDeviceRepo) ListActive(ctx context.Context, tenantID uuid.UUID) ([]*model.AttendanceDevice, error) {
+	sql := `
+		SELECT ` + attendanceDeviceColumns + `
+		FROM hrm_attendance_devices
+		WHERE tenant_id = $1 AND is_active = TRUE AND deleted_at IS NULL
+		ORDER BY device_name ASC
+	`
+
+	return r.queryDevices(ctx, sql, tenantID)
+}
+
+func (r *attendanceDeviceRepo) ListOnline(ctx context.Context, tenantID uuid.UUID) ([]*model.AttendanceDevice, error) {
+	sql := `
+		SELECT ` + attendanceDeviceColumns + `
+		FROM hrm_attendance_devices
+		WHERE tenant_id = $1 AND is_active = TRUE AND status = $2 AND deleted_at IS NULL
+		ORDER BY device_name ASC
+	`
+
+	return r.queryDevices(ctx, sql, tenantID, model.DeviceStatusOnline)
+}
+
+func (r *attendanceDeviceRepo) UpdateHeartbeat(ctx context.Context, id uuid.UUID) error {
+	sql := `
+		UPDATE hrm_attendance_devices SET last_heartbeat = NOW(), status = $1, error_message = NULL
+		WHERE id = $2 AND deleted_at IS NULL
+	`
+	_, err := r.db.Exec(ctx, sql, model.DeviceStatusOnline, id)
+	return err
+}
+
+func (r *attendanceDeviceRepo) UpdateSyncTime(ctx context.Context, id uuid.UUID) error {
+	sql := `UPDATE hrm_attendance_devices SET last_sync_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
+	_, err := r.db.Exec(ctx, sql, id)
+	return err
+}
+
+func (r *attendanceDeviceRepo) UpdateStatus(ctx context.Context, id uuid.UUID, status model.DeviceStatus, errorMsg string) error {
+	sql := `
+		UPDATE hrm_attendance_devices SET status = $1, error_message = NULLIF($2, ''), updated_at = NOW()
+		WHERE id = $3 AND deleted_at IS NULL
+	`
+	_, err := r.db.Exec(ctx, sql, status, errorMsg, id)
+	return err
+}
+
+func (r *attendanceDeviceRepo) ListBySN(ctx context.Context, deviceSN string) ([]*model.AttendanceDevice, error) {
+	sql := `
+		SELECT ` + attendanceDeviceColumns + `
+		FROM hrm_attendance_devices
+		WHERE device_sn = $1 AND deleted_at IS NULL
+	`
+
+	return r.queryDevices(ctx, sql, deviceSN)
+}
+
+func (r *attendanceDeviceRepo) UpdateConnection(ctx context.Context, id uuid.UUID, ipAddress, firmwareVersion string) error {
+	sql := `
+		UPDATE hrm_attendance_devices SET
+			ip_address = COALESCE(NULLIF($1, ''), ip_address),
+			firmware_version = COALESCE(NULLIF($2, ''), firmware_version)
+		WHERE id = $3 AND deleted_at IS NULL
+	`
+	_, err := r.db.Exec(ctx, sql, ipAddress, firmwareVersion, id)
+	return err
+}
+
+func (r *attendanceDeviceRepo) UpdateBiometricVersions(ctx context.Context, id uuid.UUID, fingerprintVersion, faceVersion string) error {
+	sql := `
+		UPDATE hrm_attendance_devices SET
+			fingerprint_version = COALESCE(NULLIF($1, ''), fingerprint_version),
+			face_version = COALESCE(NULLIF($2, ''), face_version)
+		WHERE id = $3 AND deleted_at IS NULL
+	`
+	_, err := r.db.Exec(ctx, sql, fingerprintVersion, faceVersion, id)
+	return err
+}
+
+func (r *attendanceDeviceRepo) UpdatePushStamp(ctx context.Context, id uuid.UUID, stamp string, records int) error {
+	sql := `
+		UPDATE hrm_attendance_devices SET
+			push_stamp = COALESCE(NULLIF($1, ''), push_stamp),
+			total_records = COALESCE(total_records, 0) + $2,
+			today_records = COALESCE(today_records, 0) + $2,
+			last_sync_at = NOW()
+		WHERE id = $3 AND deleted_at IS NULL
+	`
+	_, err := r.db.Exec(ctx, sql, stamp, records, id)
+	return err
+}
+
+func (r *attendanceDeviceRepo) ListAllActive(ctx context.Context) ([]*model.AttendanceDevice, error) {
+	sql := `
+		SELECT ` + attendanceDeviceColumns + `
+		FROM hrm_attendance_devices
+		WHERE is_active = TRUE AND deleted_at IS NULL
+		ORDER BY tenant_id, device_name ASC
+	`
+
+	return r.queryDevices(ctx, sql)
+}
+
+func (r *attendanceDeviceRepo) queryDevices(ctx context.Context, sql string, args ...interface{}) ([]*model.AttendanceDevice, error) {
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var devices []*model.AttendanceDevice
+	for rows.Next() {
+		device, err := r.scanDevice(ctx, rows)
+		if err != nil {
+			return nil, err
+		}
+		devices = append(devices, device)
+	}
+
+	return devices, rows.Err()
+}
+
+// scanDevice 扫描设备并解密凭据
+func (r *attendanceDeviceRepo) scanDevice(ctx context.Context, row pgx.Row) (*model.AttendanceDevice, error) {
+	device, err := scanAttendanceDevice(row)
+	if err != nil {
+		return nil, err
+	}
+	if err := decryptFields(ctx, r.cipher, device.TenantID, &device.Password, &device.SecretKey); err != nil {
+		return nil, err
+	}
+	return device, nil
+}
+
+func scanAttendanceDevice(row pgx.Row) (*model.AttendanceDevice, error) {
+	device := &model.AttendanceDevice{}
+	var location []byte
+	err := row.Scan(
+		&device.ID, &device.TenantID, &device.DeviceType, &device.DeviceSN, &device.DeviceName, &device.DeviceModel,
+		&device.IPAddress, &device.Port, &device.MACAddress,
+		&location, &device.InstallAddress, &device.DepartmentID,
+		&device.AuthType, &device.Username, &device.Password, &device.APIKey, &device.SecretKey,
+		&device.SyncEnabled, &device.SyncInterval, &device.SyncMode, &device.LastSyncAt,
+		&device.SupportFace, &device.SupportFingerprint, &device.SupportCard, &device.SupportTemperature,
+		&device.Status, &device.IsActive, &device.LastHeartbeat, &device.ErrorMessage,
+		&device.PushStamp, &device.FirmwareVersion, &device.FingerprintVersion, &device.FaceVersion,
+		&device.PushAllowedIPs,
+		&device.TotalRecords, &device.TodayRecords, &device.Remark,
+		&device.CreatedBy, &device.UpdatedBy, &device.CreatedAt, &device.UpdatedAt, &device.DeletedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("attendance device not found")
+		}
+		return nil, err
+	}
+
+	if len(location) > 0 {
+		if err := json.Unmarshal(location, &device.Location); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal device location: %w", err)
+		}
+	}
+	return device, nil
+}
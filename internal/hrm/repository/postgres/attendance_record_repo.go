@@ -22,33 +22,47 @@ func NewAttendanceRecordRepository(db *database.DB) repository.AttendanceRecordR
 	return &attendanceRecordRepo{db: db}
 }
 
+const insertAttendanceRecordSQL = `
+	INSERT INTO hrm_attendance_records (
+		id, tenant_id, employee_id, employee_name, department_id,
+		shift_id, shift_name,
+		clock_time, clock_type, status, check_in_method, source_type, source_id,
+		location, address, wifi_ssid, wifi_mac,
+		photo_url, face_score, temperature,
+		is_exception, exception_reason, exception_type,
+		approval_id, raw_data, remark,
+		created_at, updated_at
+	) VALUES (
+		$1, $2, $3, $4, $5,
+		$6, $7,
+		$8, $9, $10, $11, $12, $13,
+		$14, $15, $16, $17,
+		$18, $19, $20,
+		$21, $22, $23,
+		$24, $25, $26,
+		$27, $28
+	)
+`
+
 func (r *attendanceRecordRepo) Create(ctx context.Context, record *model.AttendanceRecord) error {
-	sql := `
-		INSERT INTO hrm_attendance_records (
-			id, tenant_id, employee_id, employee_name, department_id,
-			shift_id, shift_name,
-			clock_time, clock_type, status, check_in_method, source_type, source_id,
-			location, address, wifi_ssid, wifi_mac,
-			photo_url, face_score, temperature,
-			is_exception, exception_reason, exception_type,
-			approval_id, raw_data, remark,
-			created_at, updated_at
-		) VALUES (
-			$1, $2, $3, $4, $5,
-			$6, $7,
-			$8, $9, $10, $11, $12, $13,
-			$14, $15, $16, $17,
-			$18, $19, $20,
-			$21, $22, $23,
-			$24, $25, $26,
-			$27, $28
-		)
-	`
+	_, err := r.db.Exec(ctx, insertAttendanceRecordSQL, attendanceRecordArgs(record)...)
+	return err
+}
+
+// CreateIfAbsent 依赖设备记录唯一索引，并发重传时只有一条写入
+func (r *attendanceRecordRepo) CreateIfAbsent(ctx context.Context, record *model.AttendanceRecord) (bool, error) {
+	tag, err := r.db.Exec(ctx, insertAttendanceRecordSQL+` ON CONFLICT DO NOTHING`, attendanceRecordArgs(record)...)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
 
+func attendanceRecordArgs(record *model.AttendanceRecord) []interface{} {
 	locationJSON, _ := json.Marshal(record.Location)
 	rawDataJSON, _ := json.Marshal(record.RawData)
 
-	_, err := r.db.Exec(ctx, sql,
+	return []interface{}{
 		record.ID, record.TenantID, record.EmployeeID, record.EmployeeName, record.DepartmentID,
 		record.ShiftID, record.ShiftName,
 		record.ClockTime, record.ClockType, record.Status, record.CheckInMethod, record.SourceType, record.SourceID,
@@ -57,9 +71,7 @@ func (r *attendanceRecordRepo) Create(ctx context.Context, record *model.Attenda
 		record.IsException, record.ExceptionReason, record.ExceptionType,
 		record.ApprovalID, rawDataJSON, record.Remark,
 		record.CreatedAt, record.UpdatedAt,
-	)
-
-	return err
+	}
 }
 
 func (r *attendanceRecordRepo) BatchCreate(ctx context.Context, records []*model.AttendanceRecord) error {
@@ -83,16 +95,18 @@ func (r *attendanceRecordRepo) BatchCreate(ctx context.Context, records []*model
 }
 
 func (r *attendanceRecordRepo) Update(ctx context.Context, record *model.AttendanceRecord) error {
+	rawDataJSON, _ := json.Marshal(record.RawData)
+
 	sql := `
 		UPDATE hrm_attendance_records SET
-			status = $1, is_exception = $2, exception_reason = $3,
-			exception_type = $4, approval_id = $5, remark = $6, updated_at = $7
-		WHERE id = $8 AND deleted_at IS NULL
+			clock_time = $1, status = $2, is_exception = $3, exception_reason = $4,
+			exception_type = $5, approval_id = $6, raw_data = $7, remark = $8, updated_at = $9
+		WHERE id = $10 AND deleted_at IS NULL
 	`
 
 	_, err := r.db.Exec(ctx, sql,
-		record.Status, record.IsException, record.ExceptionReason,
-		record.ExceptionType, record.ApprovalID, record.Remark, record.UpdatedAt,
+		record.ClockTime, record.Status, record.IsException, record.ExceptionReason,
+		record.ExceptionType, record.ApprovalID, rawDataJSON, record.Remark, record.UpdatedAt,
 		record.ID,
 	)
 
@@ -352,8 +366,9 @@ func (r *attendanceRecordRepo) ListWithCursor(
 
 func (r *attendanceRecordRepo) FindByDateRange(ctx context.Context, tenantID uuid.UUID, startDate, endDate time.Time) ([]*model.AttendanceRecord, error) {
 	sql := `
-		SELECT id, tenant_id, employee_id, employee_name,
-		       clock_time, clock_type, status, source_type, created_at
+		SELECT id, tenant_id, employee_id, employee_name, department_id,
+		       clock_time, clock_type, status, check_in_method, source_type, source_id,
+		       location, wifi_ssid, wifi_mac, created_at
 		FROM hrm_attendance_records
 		WHERE tenant_id = $1 AND clock_time >= $2 AND clock_time < $3 AND deleted_at IS NULL
 		ORDER BY clock_time DESC
@@ -368,19 +383,79 @@ func (r *attendanceRecordRepo) FindByDateRange(ctx context.Context, tenantID uui
 	var records []*model.AttendanceRecord
 	for rows.Next() {
 		record := &model.AttendanceRecord{}
+		var locationJSON []byte
 		err := rows.Scan(
-			&record.ID, &record.TenantID, &record.EmployeeID, &record.EmployeeName,
-			&record.ClockTime, &record.ClockType, &record.Status, &record.SourceType, &record.CreatedAt,
+			&record.ID, &record.TenantID, &record.EmployeeID, &record.EmployeeName, &record.DepartmentID,
+			&record.ClockTime, &record.ClockType, &record.Status, &record.CheckInMethod, &record.SourceType, &record.SourceID,
+			&locationJSON, &record.WiFiSSID, &record.WiFiMAC, &record.CreatedAt,
 		)
 		if err != nil {
 			return nil, err
 		}
+		if len(locationJSON) > 0 {
+			json.Unmarshal(locationJSON, &record.Location)
+		}
 		records = append(records, record)
 	}
 
 	return records, rows.Err()
 }
 
+func (r *attendanceRecordRepo) FindBySource(ctx context.Context, tenantID uuid.UUID, sourceType model.SourceType, sourceID string, startTime, endTime time.Time) ([]*model.AttendanceRecord, error) {
+	sql := `
+		SELECT id, employee_id, clock_time, clock_type
+		FROM hrm_attendance_records
+		WHERE tenant_id = $1 AND source_type = $2 AND source_id = $3
+		  AND clock_time >= $4 AND clock_time <= $5 AND deleted_at IS NULL
+	`
+
+	rows, err := r.db.Query(ctx, sql, tenantID, sourceType, sourceID, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var records []*model.AttendanceRecord
+	for rows.Next() {
+		record := &model.AttendanceRecord{TenantID: tenantID, SourceType: sourceType, SourceID: sourceID}
+		if err := rows.Scan(&record.ID, &record.EmployeeID, &record.ClockTime, &record.ClockType); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, rows.Err()
+}
+
+func (r *attendanceRecordRepo) FindSourceIDs(ctx context.Context, tenantID uuid.UUID, sourceType model.SourceType, sourceIDs []string) (map[string]bool, error) {
+	found := make(map[string]bool)
+	if len(sourceIDs) == 0 {
+		return found, nil
+	}
+
+	sql := `
+		SELECT DISTINCT source_id
+		FROM hrm_attendance_records
+		WHERE tenant_id = $1 AND source_type = $2 AND source_id = ANY($3) AND deleted_at IS NULL
+	`
+
+	rows, err := r.db.Query(ctx, sql, tenantID, sourceType, sourceIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var sourceID string
+		if err := rows.Scan(&sourceID); err != nil {
+			return nil, err
+		}
+		found[sourceID] = true
+	}
+
+	return found, rows.Err()
+}
+
 func (r *attendanceRecordRepo) List(ctx context.Context, tenantID uuid.UUID, filter *repository.AttendanceRecordFilter, offset, limit int) ([]*model.AttendanceRecord, int, error) {
 	// 构建查询条件
 	where := "tenant_id = $1 AND deleted_at IS NULL"
@@ -35,7 +35,8 @@ func (r *attendanceRuleRepo) Create(ctx context.Context, rule *model.AttendanceR
 			allow_field_work, holiday_calendar_id,
 			require_approval_for_late, require_approval_for_early,
 			is_active, priority,
-			created_by, updated_by, created_at, updated_at
+			created_by, updated_by, created_at, updated_at,
+			overtime_policy_id, clock_location_ids
 		) VALUES (
 			$1, $2, $3, $4, $5, $6,
 			$7, $8,
@@ -46,7 +47,8 @@ func (r *attendanceRuleRepo) Create(ctx context.Context, rule *model.AttendanceR
 			$19, $20,
 			$21, $22,
 			$23, $24,
-			$25, $26, $27, $28
+			$25, $26, $27, $28,
+			$29, $30
 		)
 	`
 
@@ -61,6 +63,7 @@ func (r *attendanceRuleRepo) Create(ctx context.Context, rule *model.AttendanceR
 		rule.RequireApprovalForLate, rule.RequireApprovalForEarly,
 		rule.IsActive, rule.Priority,
 		rule.CreatedBy, rule.UpdatedBy, rule.CreatedAt, rule.UpdatedAt,
+		rule.OvertimePolicyID, rule.ClockLocationIDs,
 	)
 
 	return err
@@ -80,7 +83,8 @@ func (r *attendanceRuleRepo) Update(ctx context.Context, rule *model.AttendanceR
 			allow_field_work = $16, holiday_calendar_id = $17,
 			require_approval_for_late = $18, require_approval_for_early = $19,
 			is_active = $20, priority = $21,
-			updated_by = $22, updated_at = $23
+			updated_by = $22, updated_at = $23,
+			overtime_policy_id = $25, clock_location_ids = $26
 		WHERE id = $24 AND deleted_at IS NULL
 	`
 
@@ -96,6 +100,7 @@ func (r *attendanceRuleRepo) Update(ctx context.Context, rule *model.AttendanceR
 		rule.IsActive, rule.Priority,
 		rule.UpdatedBy, rule.UpdatedAt,
 		rule.ID,
+		rule.OvertimePolicyID, rule.ClockLocationIDs,
 	)
 
 	return err
@@ -112,10 +117,10 @@ func (r *attendanceRuleRepo) FindByID(ctx context.Context, id uuid.UUID) (*model
 		SELECT id, tenant_id, code, name, description, apply_type,
 		       department_ids, employee_ids,
 		       workday_type, weekend_days, default_shift_id,
-		       location_required, allowed_locations,
+		       location_required, allowed_locations, clock_location_ids,
 		       wifi_required, allowed_wifi,
 		       face_required, face_threshold, face_anti_spoofing,
-		       allow_field_work, holiday_calendar_id,
+		       allow_field_work, holiday_calendar_id, overtime_policy_id,
 		       require_approval_for_late, require_approval_for_early,
 		       is_active, priority,
 		       created_by, updated_by, created_at, updated_at, deleted_at
@@ -130,10 +135,10 @@ func (r *attendanceRuleRepo) FindByID(ctx context.Context, id uuid.UUID) (*model
 		&rule.ID, &rule.TenantID, &rule.Code, &rule.Name, &rule.Description, &rule.ApplyType,
 		&rule.DepartmentIDs, &rule.EmployeeIDs,
 		&rule.WorkdayType, &rule.WeekendDays, &rule.DefaultShiftID,
-		&rule.LocationRequired, &allowedLocationsJSON,
+		&rule.LocationRequired, &allowedLocationsJSON, &rule.ClockLocationIDs,
 		&rule.WiFiRequired, &rule.AllowedWiFi,
 		&rule.FaceRequired, &rule.FaceThreshold, &rule.FaceAntiSpoofing,
-		&rule.AllowFieldWork, &rule.HolidayCalendarID,
+		&rule.AllowFieldWork, &rule.HolidayCalendarID, &rule.OvertimePolicyID,
 		&rule.RequireApprovalForLate, &rule.RequireApprovalForEarly,
 		&rule.IsActive, &rule.Priority,
 		&rule.CreatedBy, &rule.UpdatedBy, &rule.CreatedAt, &rule.UpdatedAt, &rule.DeletedAt,
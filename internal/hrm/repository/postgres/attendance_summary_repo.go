@@ -0,0 +1,488 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/database"
+)
+
+type attendanceSummaryRepo struct {
+	db *database.DB
+}
+
+// NewAttendanceSummaryRepository 创建考勤汇总仓储
+func NewAttendanceSummaryRepository(db *database.DB) repository.AttendanceSummaryRepository {
+	return &attendanceSummaryRepo{db: db}
+}
+
+const attendanceSummaryColumns = `
+	id, tenant_id, employee_id, COALESCE(employee_name, ''), department_id, year, month,
+	work_days, actual_days, late_count, late_duration, early_count, early_duration,
+	absent_count, absent_days, missing_count,
+	leave_count, leave_days,
+	overtime_count, overtime_hours, weekend_ot_hours, holiday_ot_hours, comp_off_days,
+	trip_count, trip_days, leave_office_count, leave_office_hours,
+	work_hours, standard_work_hours,
+	status, lock_snapshot, created_at, updated_at, confirmed_at, confirmed_by
+`
+
+func (r *attendanceSummaryRepo) Create(ctx context.Context, summary *model.AttendanceSummary) error {
+	sql := `
+		INSERT INTO hrm_attendance_summaries (
+			id, tenant_id, employee_id, employee_name, department_id, year, month,
+			work_days, actual_days, late_count, late_duration, early_count, early_duration,
+			absent_count, absent_days, missing_count,
+			leave_count, leave_days,
+			overtime_count, overtime_hours, weekend_ot_hours, holiday_ot_hours, comp_off_days,
+			trip_count, trip_days, leave_office_count, leave_office_hours,
+			work_hours, standard_work_hours,
+			status, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7,
+			$8, $9, $10, $11, $12, $13,
+			$14, $15, $16,
+			$17, $18,
+			$19, $20, $21, $22, $23,
+			$24, $25, $26, $27,
+			$28, $29,
+			$30, $31, $32
+		)
+	`
+
+	_, err := r.db.Exec(ctx, sql, summaryArgs(summary)...)
+	return err
+}
+
+func (r *attendanceSummaryRepo) Upsert(ctx context.Context, summary *model.AttendanceSummary) error {
+	// 姓名、部门缺失时取组织员工表的当前值
+	sql := `
+		INSERT INTO hrm_attendance_summaries (
+			id, tenant_id, employee_id, employee_name, department_id, year, month,
+			work_days, actual_days, late_count, late_duration, early_count, early_duration,
+			absent_count, absent_days, missing_count,
+			leave_count, leave_days,
+			overtime_count, overtime_hours, weekend_ot_hours, holiday_ot_hours, comp_off_days,
+			trip_count, trip_days, leave_office_count, leave_office_hours,
+			work_hours, standard_work_hours,
+			status, created_at, updated_at
+		) VALUES (
+			$1, $2, $3,
+			COALESCE(NULLIF($4, ''), (SELECT name FROM employees WHERE id = $3)),
+			COALESCE($5, (SELECT org_id FROM employees WHERE id = $3)),
+			$6, $7,
+			$8, $9, $10, $11, $12, $13,
+			$14, $15, $16,
+			$17, $18,
+			$19, $20, $21, $22, $23,
+			$24, $25, $26, $27,
+			$28, $29,
+			$30, $31, $32
+		)
+		ON CONFLICT (tenant_id, employee_id, year, month) DO UPDATE SET
+			employee_name = EXCLUDED.employee_name,
+			department_id = EXCLUDED.department_id,
+			work_days = EXCLUDED.work_days,
+			actual_days = EXCLUDED.actual_days,
+			late_count = EXCLUDED.late_count,
+			late_duration = EXCLUDED.late_duration,
+			early_count = EXCLUDED.early_count,
+			early_duration = EXCLUDED.early_duration,
+			absent_count = EXCLUDED.absent_count,
+			absent_days = EXCLUDED.absent_days,
+			missing_count = EXCLUDED.missing_count,
+			leave_count = EXCLUDED.leave_count,
+			leave_days = EXCLUDED.leave_days,
+			overtime_count = EXCLUDED.overtime_count,
+			overtime_hours = EXCLUDED.overtime_hours,
+			weekend_ot_hours = EXCLUDED.weekend_ot_hours,
+			holiday_ot_hours = EXCLUDED.holiday_ot_hours,
+			comp_off_days = EXCLUDED.comp_off_days,
+			trip_count = EXCLUDED.trip_count,
+			trip_days = EXCLUDED.trip_days,
+			leave_office_count = EXCLUDED.leave_office_count,
+			leave_office_hours = EXCLUDED.leave_office_hours,
+			work_hours = EXCLUDED.work_hours,
+			standard_work_hours = EXCLUDED.standard_work_hours,
+			status = EXCLUDED.status,
+			confirmed_at = CASE WHEN EXCLUDED.status = 'draft' THEN NULL ELSE hrm_attendance_summaries.confirmed_at END,
+			confirmed_by = CASE WHEN EXCLUDED.status = 'draft' THEN NULL ELSE hrm_attendance_summaries.confirmed_by END,
+			updated_at = EXCLUDED.updated_at
+		WHERE hrm_attendance_summaries.status <> 'locked'
+		RETURNING id, created_at
+	`
+
+	err := r.db.QueryRow(ctx, sql, summaryArgs(summary)...).Scan(&summary.ID, &summary.CreatedAt)
+	if err == pgx.ErrNoRows {
+		// 已锁定，保持原值
+		return nil
+	}
+	return err
+}
+
+func (r *attendanceSummaryRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.AttendanceSummary, error) {
+	sql := `SELECT ` + attendanceSummaryColumns + ` FROM hrm_attendance_summaries WHERE id = $1`
+
+	return scanAttendanceSummary(r.db.QueryRow(ctx, sql, id))
+}
+
+func (r *attendanceSummaryRepo) FindByEmployee(ctx context.Context, tenantID, employeeID uuid.UUID, year, month int) (*model.AttendanceSummary, error) {
+	sql := `
+		SELECT ` + attendanceSummaryColumns + `
+		FROM hrm_attendance_summaries
+		WHERE tenant_id = $1 AND employee_id = $2 AND year = $3 AND month = $4
+	`
+
+	return scanAttendanceSummary(r.db.QueryRow(ctx, sql, tenantID, employeeID, year, month))
+}
+
+func (r *attendanceSummaryRepo) Update(ctx context.Context, summary *model.AttendanceSummary) error {
+	sql := `
+		UPDATE hrm_attendance_summaries SET
+			work_days = $1, actual_days = $2, late_count = $3, late_duration = $4, early_count = $5, early_duration = $6,
+			absent_count = $7, absent_days = $8, missing_count = $9,
+			leave_count = $10, leave_days = $11,
+			overtime_count = $12, overtime_hours = $13, weekend_ot_hours = $14, holiday_ot_hours = $15, comp_off_days = $16,
+			trip_count = $17, trip_days = $18, leave_office_count = $19, leave_office_hours = $20,
+			work_hours = $21, standard_work_hours = $22,
+			status = $23, updated_at = $24
+		WHERE id = $25 AND status <> 'locked'
+	`
+
+	_, err := r.db.Exec(ctx, sql,
+		summary.WorkDays, summary.ActualDays, summary.LateCount, summary.LateDuration, summary.EarlyCount, summary.EarlyDuration,
+		summary.AbsentCount, summary.AbsentDays, summary.MissingCount,
+		summary.LeaveCount, summary.LeaveDays,
+		summary.OvertimeCount, summary.OvertimeHours, summary.WeekendOTHours, summary.HolidayOTHours, summary.CompOffDays,
+		summary.TripCount, summary.TripDays, summary.LeaveOfficeCount, summary.LeaveOfficeHours,
+		summary.WorkHours, summary.StandardWorkHours,
+		summary.Status, summary.UpdatedAt,
+		summary.ID,
+	)
+	return err
+}
+
+func (r *attendanceSummaryRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	sql := `DELETE FROM hrm_attendance_summaries WHERE id = $1 AND status <> 'locked'`
+	_, err := r.db.Exec(ctx, sql, id)
+	return err
+}
+
+func (r *attendanceSummaryRepo) List(ctx context.Context, tenantID uuid.UUID, filter *repository.AttendanceSummaryFilter, offset, limit int) ([]*model.AttendanceSummary, int, error) {
+	conditions := []string{"tenant_id = $1"}
+	args := []interface{}{tenantID}
+	argIndex := 2
+
+	if filter != nil {
+		if filter.EmployeeID != nil {
+			conditions = append(conditions, fmt.Sprintf("employee_id = $%d", argIndex))
+			args = append(args, *filter.EmployeeID)
+			argIndex++
+		}
+		if filter.DepartmentID != nil {
+			conditions = append(conditions, fmt.Sprintf("department_id = $%d", argIndex))
+			args = append(args, *filter.DepartmentID)
+			argIndex++
+		}
+		if filter.Year != nil {
+			conditions = append(conditions, fmt.Sprintf("year = $%d", argIndex))
+			args = append(args, *filter.Year)
+			argIndex++
+		}
+		if filter.Month != nil {
+			conditions = append(conditions, fmt.Sprintf("month = $%d", argIndex))
+			args = append(args, *filter.Month)
+			argIndex++
+		}
+		if filter.Status != nil {
+			conditions = append(conditions, fmt.Sprintf("status = $%d", argIndex))
+			args = append(args, *filter.Status)
+			argIndex++
+		}
+		if filter.Keyword != "" {
+			conditions = append(conditions, fmt.Sprintf("employee_name ILIKE $%d", argIndex))
+			args = append(args, "%"+filter.Keyword+"%")
+			argIndex++
+		}
+	}
+
+	whereClause := strings.Join(conditions, " AND ")
+
+	// 查询总数
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM hrm_attendance_summaries WHERE %s", whereClause)
+	var total int
+	if err := r.db.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	// 查询数据
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM hrm_attendance_summaries
+		WHERE %s
+		ORDER BY year DESC, month DESC, employee_name ASC
+		LIMIT $%d OFFSET $%d
+	`, attendanceSummaryColumns, whereClause, argIndex, argIndex+1)
+	args = append(args, limit, offset)
+
+	summaries, err := r.query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return summaries, total, nil
+}
+
+func (r *attendanceSummaryRepo) ListByDepartment(ctx context.Context, tenantID, departmentID uuid.UUID, year, month int) ([]*model.AttendanceSummary, error) {
+	sql := `
+		SELECT ` + attendanceSummaryColumns + `
+		FROM hrm_attendance_summaries
+		WHERE tenant_id = $1 AND department_id = $2 AND year = $3 AND month = $4
+		ORDER BY employee_name ASC
+	`
+
+	return r.query(ctx, sql, tenantID, departmentID, year, month)
+}
+
+func (r *attendanceSummaryRepo) ConfirmSummary(ctx context.Context, id, confirmedBy uuid.UUID) error {
+	sql := `
+		UPDATE hrm_attendance_summaries
+		SET status = 'confirmed', confirmed_at = NOW(), confirmed_by = $1, updated_at = NOW()
+		WHERE id = $2 AND status = 'draft'
+	`
+	_, err := r.db.Exec(ctx, sql, confirmedBy, id)
+	return err
+}
+
+func (r *attendanceSummaryRepo) ConfirmMonth(ctx context.Context, tenantID uuid.UUID, year, month int, confirmedBy uuid.UUID) (int64, error) {
+	sql := `
+		UPDATE hrm_attendance_summaries
+		SET status = 'confirmed', confirmed_at = NOW(), confirmed_by = $1, updated_at = NOW()
+		WHERE tenant_id = $2 AND year = $3 AND month = $4 AND status = 'draft'
+	`
+	tag, err := r.db.Exec(ctx, sql, confirmedBy, tenantID, year, month)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+func (r *attendanceSummaryRepo) LockSummary(ctx context.Context, tenantID uuid.UUID, year, month int, snapshots map[uuid.UUID]*model.AttendanceLockSnapshot) (bool, error) {
+	err := r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		for id, snapshot := range snapshots {
+			data, err := json.Marshal(snapshot)
+			if err != nil {
+				return fmt.Errorf("failed to marshal lock snapshot: %w", err)
+			}
+			tag, err := tx.Exec(ctx, `
+				UPDATE hrm_attendance_summaries SET status = 'locked', lock_snapshot = $4, updated_at = NOW()
+				WHERE id = $1 AND tenant_id = $2 AND status = 'confirmed' AND year = $3 AND month = $5
+			`, id, tenantID, year, data, month)
+			if err != nil {
+				return err
+			}
+			if tag.RowsAffected() == 0 {
+				return errLockAborted
+			}
+		}
+
+		// 生成快照期间新增或退回草稿的汇总
+		var remaining int
+		if err := tx.QueryRow(ctx, `
+			SELECT COUNT(*) FROM hrm_attendance_summaries
+			WHERE tenant_id = $1 AND year = $2 AND month = $3 AND status <> 'locked'
+		`, tenantID, year, month).Scan(&remaining); err != nil {
+			return err
+		}
+		if remaining > 0 {
+			return errLockAborted
+		}
+
+		_, err := tx.Exec(ctx, `
+			INSERT INTO hrm_attendance_period_locks (tenant_id, year, month, locked_at)
+			VALUES ($1, $2, $3, NOW())
+			ON CONFLICT (tenant_id, year, month) DO NOTHING
+		`, tenantID, year, month)
+		return err
+	})
+	if errors.Is(err, errLockAborted) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// errLockAborted 回滚锁定事务
+var errLockAborted = errors.New("attendance summary lock aborted")
+
+func (r *attendanceSummaryRepo) UnlockSummary(ctx context.Context, tenantID uuid.UUID, year, month int) error {
+	return r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `
+			UPDATE hrm_attendance_summaries SET status = 'confirmed', lock_snapshot = NULL, updated_at = NOW()
+			WHERE tenant_id = $1 AND year = $2 AND month = $3 AND status = 'locked'
+		`, tenantID, year, month); err != nil {
+			return err
+		}
+		_, err := tx.Exec(ctx, `
+			DELETE FROM hrm_attendance_period_locks WHERE tenant_id = $1 AND year = $2 AND month = $3
+		`, tenantID, year, month)
+		return err
+	})
+}
+
+func (r *attendanceSummaryRepo) IsMonthLocked(ctx context.Context, tenantID uuid.UUID, year, month int) (bool, error) {
+	sql := `
+		SELECT EXISTS (
+			SELECT 1 FROM hrm_attendance_period_locks
+			WHERE tenant_id = $1 AND year = $2 AND month = $3
+		)
+	`
+
+	var locked bool
+	if err := r.db.QueryRow(ctx, sql, tenantID, year, month).Scan(&locked); err != nil {
+		return false, err
+	}
+	return locked, nil
+}
+
+func (r *attendanceSummaryRepo) CountByStatus(ctx context.Context, tenantID uuid.UUID, year, month int) (map[string]int, error) {
+	sql := `
+		SELECT status, COUNT(*) FROM hrm_attendance_summaries
+		WHERE tenant_id = $1 AND year = $2 AND month = $3
+		GROUP BY status
+	`
+
+	rows, err := r.db.Query(ctx, sql, tenantID, year, month)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var status string
+		var count int
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, err
+		}
+		counts[status] = count
+	}
+	return counts, rows.Err()
+}
+
+func (r *attendanceSummaryRepo) MarkDirty(ctx context.Context, period *model.AttendancePeriod) error {
+	sql := `
+		INSERT INTO hrm_attendance_summary_dirty (tenant_id, employee_id, year, month, marked_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		ON CONFLICT (tenant_id, employee_id, year, month) DO UPDATE SET marked_at = NOW()
+	`
+	_, err := r.db.Exec(ctx, sql, period.TenantID, period.EmployeeID, period.Year, period.Month)
+	return err
+}
+
+func (r *attendanceSummaryRepo) ListDirty(ctx context.Context, limit int) ([]*model.AttendancePeriod, error) {
+	sql := `
+		SELECT tenant_id, employee_id, year, month
+		FROM hrm_attendance_summary_dirty
+		ORDER BY marked_at ASC
+		LIMIT $1
+	`
+
+	rows, err := r.db.Query(ctx, sql, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var periods []*model.AttendancePeriod
+	for rows.Next() {
+		period := &model.AttendancePeriod{}
+		if err := rows.Scan(&period.TenantID, &period.EmployeeID, &period.Year, &period.Month); err != nil {
+			return nil, err
+		}
+		periods = append(periods, period)
+	}
+	return periods, rows.Err()
+}
+
+func (r *attendanceSummaryRepo) ClearDirty(ctx context.Context, period *model.AttendancePeriod, before time.Time) error {
+	sql := `
+		DELETE FROM hrm_attendance_summary_dirty
+		WHERE tenant_id = $1 AND employee_id = $2 AND year = $3 AND month = $4 AND marked_at <= $5
+	`
+	_, err := r.db.Exec(ctx, sql, period.TenantID, period.EmployeeID, period.Year, period.Month, before)
+	return err
+}
+
+func (r *attendanceSummaryRepo) query(ctx context.Context, sql string, args ...interface{}) ([]*model.AttendanceSummary, error) {
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	summaries := make([]*model.AttendanceSummary, 0)
+	for rows.Next() {
+		summary, err := scanAttendanceSummary(rows)
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, rows.Err()
+}
+
+func summaryArgs(s *model.AttendanceSummary) []interface{} {
+	var departmentID *uuid.UUID
+	if s.DepartmentID != uuid.Nil {
+		departmentID = &s.DepartmentID
+	}
+
+	return []interface{}{
+		s.ID, s.TenantID, s.EmployeeID, s.EmployeeName, departmentID, s.Year, s.Month,
+		s.WorkDays, s.ActualDays, s.LateCount, s.LateDuration, s.EarlyCount, s.EarlyDuration,
+		s.AbsentCount, s.AbsentDays, s.MissingCount,
+		s.LeaveCount, s.LeaveDays,
+		s.OvertimeCount, s.OvertimeHours, s.WeekendOTHours, s.HolidayOTHours, s.CompOffDays,
+		s.TripCount, s.TripDays, s.LeaveOfficeCount, s.LeaveOfficeHours,
+		s.WorkHours, s.StandardWorkHours,
+		s.Status, s.CreatedAt, s.UpdatedAt,
+	}
+}
+
+func scanAttendanceSummary(row pgx.Row) (*model.AttendanceSummary, error) {
+	s := &model.AttendanceSummary{}
+	var departmentID *uuid.UUID
+	var snapshot []byte
+	err := row.Scan(
+		&s.ID, &s.TenantID, &s.EmployeeID, &s.EmployeeName, &departmentID, &s.Year, &s.Month,
+		&s.WorkDays, &s.ActualDays, &s.LateCount, &s.LateDuration, &s.EarlyCount, &s.EarlyDuration,
+		&s.AbsentCount, &s.AbsentDays, &s.MissingCount,
+		&s.LeaveCount, &s.LeaveDays,
+		&s.OvertimeCount, &s.OvertimeHours, &s.WeekendOTHours, &s.HolidayOTHours, &s.CompOffDays,
+		&s.TripCount, &s.TripDays, &s.LeaveOfficeCount, &s.LeaveOfficeHours,
+		&s.WorkHours, &s.StandardWorkHours,
+		&s.Status, &snapshot, &s.CreatedAt, &s.UpdatedAt, &s.ConfirmedAt, &s.ConfirmedBy,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("attendance summary not found")
+		}
+		return nil, err
+	}
+	if departmentID != nil {
+		s.DepartmentID = *departmentID
+	}
+	if len(snapshot) > 0 {
+		if err := json.Unmarshal(snapshot, &s.LockSnapshot); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal lock snapshot: %w", err)
+		}
+	}
+	return s, nil
+}
@@ -0,0 +1,217 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/database"
+	"github.com/lk2023060901/go-next-erp/pkg/fieldcrypt"
+)
+
+// biometricRepo 模板数据加密存储
+type biometricRepo struct {
+	db     *database.DB
+	cipher fieldcrypt.Cipher
+}
+
+// NewBiometricRepository 创建生物特征仓储
+func NewBiometricRepository(db *database.DB, cipher fieldcrypt.Cipher) repository.BiometricRepository {
+	return &biometricRepo{db: db, cipher: cipher}
+}
+
+const biometricTemplateColumns = `id, tenant_id, employee_id, biometric_type, biometric_index,
+	device_type, algorithm_version, revision, template, source_device_id, created_at`
+
+func (r *biometricRepo) CreateTemplate(ctx context.Context, template *model.BiometricTemplate) error {
+	encrypted, err := encryptFields(ctx, r.cipher, template.TenantID, template.Template)
+	if err != nil {
+		return err
+	}
+
+	sql := `
+		INSERT INTO hrm_biometric_templates (` + biometricTemplateColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	_, err = r.db.Exec(ctx, sql,
+		template.ID, template.TenantID, template.EmployeeID, template.Type, template.Index,
+		template.DeviceType, template.AlgorithmVersion, template.Revision, encrypted[0], template.SourceDeviceID, template.CreatedAt,
+	)
+	return err
+}
+
+func (r *biometricRepo) ListCurrentTemplates(ctx context.Context, tenantID, employeeID uuid.UUID) ([]*model.BiometricTemplate, error) {
+	sql := `
+		SELECT DISTINCT ON (biometric_type, biometric_index, device_type, algorithm_version) ` + biometricTemplateColumns + `
+		FROM hrm_biometric_templates
+		WHERE tenant_id = $1 AND employee_id = $2
+		ORDER BY biometric_type, biometric_index, device_type, algorithm_version, revision DESC
+	`
+
+	rows, err := r.db.Query(ctx, sql, tenantID, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var templates []*model.BiometricTemplate
+	for rows.Next() {
+		template := &model.BiometricTemplate{}
+		err := rows.Scan(
+			&template.ID, &template.TenantID, &template.EmployeeID, &template.Type, &template.Index,
+			&template.DeviceType, &template.AlgorithmVersion, &template.Revision, &template.Template, &template.SourceDeviceID, &template.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, template := range templates {
+		if err := decryptFields(ctx, r.cipher, tenantID, &template.Template); err != nil {
+			return nil, err
+		}
+	}
+	return templates, nil
+}
+
+func (r *biometricRepo) UpsertDistribution(ctx context.Context, distribution *model.BiometricDistribution) error {
+	sql := `
+		INSERT INTO hrm_biometric_distributions (
+			id, tenant_id, employee_id, device_id, action, templates, queued_at, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (employee_id, device_id) DO UPDATE SET
+			action = EXCLUDED.action,
+			templates = EXCLUDED.templates,
+			queued_at = EXCLUDED.queued_at,
+			updated_at = EXCLUDED.updated_at
+		RETURNING id, created_at
+	`
+
+	return r.db.QueryRow(ctx, sql,
+		distribution.ID, distribution.TenantID, distribution.EmployeeID, distribution.DeviceID,
+		distribution.Action, distribution.Templates, distribution.QueuedAt, distribution.CreatedAt, distribution.UpdatedAt,
+	).Scan(&distribution.ID, &distribution.CreatedAt)
+}
+
+func (r *biometricRepo) ListDistributions(ctx context.Context, tenantID uuid.UUID, filter *repository.BiometricDistributionFilter) ([]*model.BiometricDistribution, error) {
+	args := []interface{}{tenantID, model.DeviceCommandPending, model.DeviceCommandSent, model.DeviceCommandFailed}
+	where := `d.tenant_id = $1`
+	if filter != nil && filter.EmployeeID != nil {
+		args = append(args, *filter.EmployeeID)
+		where += fmt.Sprintf(` AND d.employee_id = $%d`, len(args))
+	}
+	if filter != nil && filter.DeviceID != nil {
+		args = append(args, *filter.DeviceID)
+		where += fmt.Sprintf(` AND d.device_id = $%d`, len(args))
+	}
+
+	// 只统计该员工在本次操作之后入队的命令，更早的失败不影响当前状态
+	sql := `
+		SELECT d.id, d.tenant_id, d.employee_id, COALESCE(e.employee_no, ''), COALESCE(e.name, ''),
+			d.device_id, COALESCE(dev.device_sn, ''), COALESCE(dev.device_name, ''),
+			d.action, d.templates, d.queued_at,
+			COUNT(c.id) FILTER (WHERE c.status IN ($2, $3)),
+			COUNT(c.id) FILTER (WHERE c.status = $4),
+			d.created_at, d.updated_at
+		FROM hrm_biometric_distributions d
+		LEFT JOIN employees e ON e.id = d.employee_id
+		LEFT JOIN hrm_attendance_devices dev ON dev.id = d.device_id
+		LEFT JOIN hrm_device_commands c
+			ON c.device_id = d.device_id AND c.employee_id = d.employee_id AND c.created_at >= d.queued_at
+		WHERE ` + where + `
+		GROUP BY d.id, e.employee_no, e.name, dev.device_sn, dev.device_name
+		ORDER BY e.employee_no, dev.device_name
+	`
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var distributions []*model.BiometricDistribution
+	for rows.Next() {
+		d := &model.BiometricDistribution{}
+		err := rows.Scan(
+			&d.ID, &d.TenantID, &d.EmployeeID, &d.EmployeeNo, &d.EmployeeName,
+			&d.DeviceID, &d.DeviceSN, &d.DeviceName,
+			&d.Action, &d.Templates, &d.QueuedAt,
+			&d.PendingCommands, &d.FailedCommands,
+			&d.CreatedAt, &d.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		d.Status = d.ResolveStatus()
+		distributions = append(distributions, d)
+	}
+	return distributions, rows.Err()
+}
+
+const biometricEnrollmentColumns = `id, tenant_id, employee_id, device_id, biometric_type, biometric_index,
+	status, expires_at, captured_at, created_by, created_at`
+
+func (r *biometricRepo) CreateEnrollment(ctx context.Context, enrollment *model.BiometricEnrollment) error {
+	sql := `
+		INSERT INTO hrm_biometric_enrollments (` + biometricEnrollmentColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	_, err := r.db.Exec(ctx, sql,
+		enrollment.ID, enrollment.TenantID, enrollment.EmployeeID, enrollment.DeviceID, enrollment.Type, enrollment.Index,
+		enrollment.Status, enrollment.ExpiresAt, enrollment.CapturedAt, enrollment.CreatedBy, enrollment.CreatedAt,
+	)
+	return err
+}
+
+func (r *biometricRepo) ListPendingEnrollments(ctx context.Context, deviceID, employeeID uuid.UUID, now time.Time) ([]*model.BiometricEnrollment, error) {
+	sql := `
+		SELECT ` + biometricEnrollmentColumns + `
+		FROM hrm_biometric_enrollments
+		WHERE device_id = $1 AND employee_id = $2 AND status = $3 AND expires_at > $4
+		ORDER BY created_at
+	`
+
+	rows, err := r.db.Query(ctx, sql, deviceID, employeeID, model.BiometricEnrollmentPending, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var enrollments []*model.BiometricEnrollment
+	for rows.Next() {
+		e := &model.BiometricEnrollment{}
+		err := rows.Scan(
+			&e.ID, &e.TenantID, &e.EmployeeID, &e.DeviceID, &e.Type, &e.Index,
+			&e.Status, &e.ExpiresAt, &e.CapturedAt, &e.CreatedBy, &e.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		enrollments = append(enrollments, e)
+	}
+	return enrollments, rows.Err()
+}
+
+func (r *biometricRepo) CompleteEnrollment(ctx context.Context, id uuid.UUID, capturedAt time.Time) (bool, error) {
+	// 条件更新保证同一会话并发上传时只有一次被接收
+	sql := `
+		UPDATE hrm_biometric_enrollments
+		SET status = $3, captured_at = $4
+		WHERE id = $1 AND status = $2 AND expires_at > $4
+	`
+
+	tag, err := r.db.Exec(ctx, sql, id, model.BiometricEnrollmentPending, model.BiometricEnrollmentCaptured, capturedAt)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
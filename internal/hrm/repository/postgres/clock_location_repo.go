@@ -0,0 +1,339 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/database"
+)
+
+type clockLocationRepo struct {
+	db *database.DB
+}
+
+// NewClockLocationRepository 创建打卡地点仓储
+func NewClockLocationRepository(db *database.DB) repository.ClockLocationRepository {
+	return &clockLocationRepo{db: db}
+}
+
+const clockLocationColumns = `
+	id, tenant_id, code, name, COALESCE(address, ''),
+	polygon, latitude, longitude, radius, time_windows,
+	qr_required, COALESCE(kiosk_key_hash, ''), kiosk_key_rotated_at, is_active,
+	created_by, updated_by, created_at, updated_at
+`
+
+func (r *clockLocationRepo) Create(ctx context.Context, location *model.ClockLocation) error {
+	polygon, windows, err := marshalClockLocation(location)
+	if err != nil {
+		return err
+	}
+
+	sql := `
+		INSERT INTO hrm_clock_locations (
+			id, tenant_id, code, name, address,
+			polygon, latitude, longitude, radius, time_windows,
+			qr_required, kiosk_key_hash, kiosk_key_rotated_at, is_active,
+			created_by, updated_by, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, NULLIF($12, ''), $13, $14, $15, $16, $17, $18)
+	`
+
+	_, err = r.db.Exec(ctx, sql,
+		location.ID, location.TenantID, location.Code, location.Name, location.Address,
+		polygon, location.Latitude, location.Longitude, location.Radius, windows,
+		location.QRRequired, location.KioskKeyHash, location.KioskKeyRotatedAt, location.IsActive,
+		location.CreatedBy, location.UpdatedBy, location.CreatedAt, location.UpdatedAt,
+	)
+	return err
+}
+
+func (r *clockLocationRepo) Update(ctx context.Context, location *model.ClockLocation) error {
+	polygon, windows, err := marshalClockLocation(location)
+	if err != nil {
+		return err
+	}
+
+	sql := `
+		UPDATE hrm_clock_locations SET
+			name = $1, address = $2,
+			polygon = $3, latitude = $4, longitude = $5, radius = $6, time_windows = $7,
+			qr_required = $8, kiosk_key_hash = NULLIF($9, ''), kiosk_key_rotated_at = $10, is_active = $11,
+			updated_by = $12, updated_at = $13
+		WHERE id = $14 AND tenant_id = $15 AND deleted_at IS NULL
+	`
+
+	_, err = r.db.Exec(ctx, sql,
+		location.Name, location.Address,
+		polygon, location.Latitude, location.Longitude, location.Radius, windows,
+		location.QRRequired, location.KioskKeyHash, location.KioskKeyRotatedAt, location.IsActive,
+		location.UpdatedBy, location.UpdatedAt,
+		location.ID, location.TenantID,
+	)
+	return err
+}
+
+func (r *clockLocationRepo) Delete(ctx context.Context, tenantID, id uuid.UUID) error {
+	sql := `UPDATE hrm_clock_locations SET deleted_at = NOW() WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+	_, err := r.db.Exec(ctx, sql, id, tenantID)
+	return err
+}
+
+func (r *clockLocationRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (*model.ClockLocation, error) {
+	sql := `SELECT ` + clockLocationColumns + ` FROM hrm_clock_locations WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+
+	return scanClockLocation(r.db.QueryRow(ctx, sql, id, tenantID))
+}
+
+func (r *clockLocationRepo) FindByCode(ctx context.Context, tenantID uuid.UUID, code string) (*model.ClockLocation, error) {
+	sql := `SELECT ` + clockLocationColumns + ` FROM hrm_clock_locations WHERE tenant_id = $1 AND code = $2 AND deleted_at IS NULL`
+
+	return scanClockLocation(r.db.QueryRow(ctx, sql, tenantID, code))
+}
+
+func (r *clockLocationRepo) FindByIDs(ctx context.Context, tenantID uuid.UUID, ids []uuid.UUID) ([]*model.ClockLocation, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	sql := `
+		SELECT ` + clockLocationColumns + ` FROM hrm_clock_locations
+		WHERE tenant_id = $1 AND id = ANY($2) AND deleted_at IS NULL
+		ORDER BY code
+	`
+
+	return r.query(ctx, sql, tenantID, ids)
+}
+
+func (r *clockLocationRepo) FindByKioskKey(ctx context.Context, keyHash string) (*model.ClockLocation, error) {
+	sql := `SELECT ` + clockLocationColumns + ` FROM hrm_clock_locations WHERE kiosk_key_hash = $1 AND deleted_at IS NULL`
+
+	return scanClockLocation(r.db.QueryRow(ctx, sql, keyHash))
+}
+
+func (r *clockLocationRepo) List(ctx context.Context, tenantID uuid.UUID) ([]*model.ClockLocation, error) {
+	sql := `SELECT ` + clockLocationColumns + ` FROM hrm_clock_locations WHERE tenant_id = $1 AND deleted_at IS NULL ORDER BY code`
+
+	return r.query(ctx, sql, tenantID)
+}
+
+func (r *clockLocationRepo) UseQRCode(ctx context.Context, tenantID, employeeID, locationID uuid.UUID, issuedAt, scannedAt time.Time) (bool, error) {
+	sql := `
+		INSERT INTO hrm_clock_qr_scans (tenant_id, employee_id, location_id, issued_at, scanned_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (employee_id, location_id, issued_at) DO NOTHING
+	`
+
+	tag, err := r.db.Exec(ctx, sql, tenantID, employeeID, locationID, issuedAt, scannedAt)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *clockLocationRepo) query(ctx context.Context, sql string, args ...interface{}) ([]*model.ClockLocation, error) {
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var locations []*model.ClockLocation
+	for rows.Next() {
+		location, err := scanClockLocation(rows)
+		if err != nil {
+			return nil, err
+		}
+		locations = append(locations, location)
+	}
+	return locations, rows.Err()
+}
+
+func marshalClockLocation(location *model.ClockLocation) ([]byte, []byte, error) {
+	polygon, err := json.Marshal(location.Polygon)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal clock location polygon: %w", err)
+	}
+	windows, err := json.Marshal(location.TimeWindows)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal clock location time windows: %w", err)
+	}
+	return polygon, windows, nil
+}
+
+func scanClockLocation(row pgx.Row) (*model.ClockLocation, error) {
+	location := &model.ClockLocation{}
+	var polygon, windows []byte
+	err := row.Scan(
+		&location.ID, &location.TenantID, &location.Code, &location.Name, &location.Address,
+		&polygon, &location.Latitude, &location.Longitude, &location.Radius, &windows,
+		&location.QRRequired, &location.KioskKeyHash, &location.KioskKeyRotatedAt, &location.IsActive,
+		&location.CreatedBy, &location.UpdatedBy, &location.CreatedAt, &location.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("clock location not found")
+		}
+		return nil, err
+	}
+
+	if len(polygon) > 0 {
+		if err := json.Unmarshal(polygon, &location.Polygon); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal clock location polygon: %w", err)
+		}
+	}
+	if len(windows) > 0 {
+		if err := json.Unmarshal(windows, &location.TimeWindows); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal clock location time windows: %w", err)
+		}
+	}
+	return location, nil
+}
+
+type clockAttemptRepo struct {
+	db *database.DB
+}
+
+// NewClockAttemptRepository 创建被拒打卡记录仓储
+func NewClockAttemptRepository(db *database.DB) repository.ClockAttemptRepository {
+	return &clockAttemptRepo{db: db}
+}
+
+const clockAttemptColumns = `
+	id, tenant_id, employee_id, COALESCE(employee_name, ''), clock_time, clock_type, check_in_method, source_type,
+	location_id, location, COALESCE(address, ''), COALESCE(wifi_ssid, ''), COALESCE(wifi_mac, ''), face_score,
+	reason, COALESCE(detail, ''), status, reviewed_by, reviewed_at, COALESCE(review_note, ''), record_id,
+	created_at, updated_at
+`
+
+func (r *clockAttemptRepo) Create(ctx context.Context, attempt *model.ClockAttempt) error {
+	location, err := json.Marshal(attempt.Location)
+	if err != nil {
+		return fmt.Errorf("failed to marshal clock attempt location: %w", err)
+	}
+
+	sql := `
+		INSERT INTO hrm_clock_attempts (
+			id, tenant_id, employee_id, employee_name, clock_time, clock_type, check_in_method, source_type,
+			location_id, location, address, wifi_ssid, wifi_mac, face_score,
+			reason, detail, status, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
+	`
+
+	_, err = r.db.Exec(ctx, sql,
+		attempt.ID, attempt.TenantID, attempt.EmployeeID, attempt.EmployeeName, attempt.ClockTime, attempt.ClockType, attempt.CheckInMethod, attempt.SourceType,
+		attempt.LocationID, location, attempt.Address, attempt.WiFiSSID, attempt.WiFiMAC, attempt.FaceScore,
+		attempt.Reason, attempt.Detail, attempt.Status, attempt.CreatedAt, attempt.UpdatedAt,
+	)
+	return err
+}
+
+func (r *clockAttemptRepo) Update(ctx context.Context, attempt *model.ClockAttempt) error {
+	sql := `
+		UPDATE hrm_clock_attempts SET
+			status = $1, reviewed_by = $2, reviewed_at = $3, review_note = $4, record_id = $5, updated_at = $6
+		WHERE id = $7 AND tenant_id = $8
+	`
+
+	_, err := r.db.Exec(ctx, sql,
+		attempt.Status, attempt.ReviewedBy, attempt.ReviewedAt, attempt.ReviewNote, attempt.RecordID, attempt.UpdatedAt,
+		attempt.ID, attempt.TenantID,
+	)
+	return err
+}
+
+func (r *clockAttemptRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (*model.ClockAttempt, error) {
+	sql := `SELECT ` + clockAttemptColumns + ` FROM hrm_clock_attempts WHERE id = $1 AND tenant_id = $2`
+
+	return scanClockAttempt(r.db.QueryRow(ctx, sql, id, tenantID))
+}
+
+func (r *clockAttemptRepo) List(ctx context.Context, tenantID uuid.UUID, filter *repository.ClockAttemptFilter, offset, limit int) ([]*model.ClockAttempt, int, error) {
+	where := "tenant_id = $1"
+	args := []interface{}{tenantID}
+
+	if filter != nil {
+		if filter.EmployeeID != nil {
+			args = append(args, *filter.EmployeeID)
+			where += fmt.Sprintf(" AND employee_id = $%d", len(args))
+		}
+		if filter.LocationID != nil {
+			args = append(args, *filter.LocationID)
+			where += fmt.Sprintf(" AND location_id = $%d", len(args))
+		}
+		if filter.Reason != nil {
+			args = append(args, *filter.Reason)
+			where += fmt.Sprintf(" AND reason = $%d", len(args))
+		}
+		if filter.Status != nil {
+			args = append(args, *filter.Status)
+			where += fmt.Sprintf(" AND status = $%d", len(args))
+		}
+		if filter.StartDate != nil {
+			args = append(args, *filter.StartDate)
+			where += fmt.Sprintf(" AND clock_time >= $%d", len(args))
+		}
+		if filter.EndDate != nil {
+			args = append(args, *filter.EndDate)
+			where += fmt.Sprintf(" AND clock_time < $%d", len(args))
+		}
+	}
+
+	var total int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM hrm_clock_attempts WHERE `+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	args = append(args, limit, offset)
+	sql := fmt.Sprintf(`
+		SELECT %s FROM hrm_clock_attempts
+		WHERE %s
+		ORDER BY clock_time DESC, id DESC
+		LIMIT $%d OFFSET $%d
+	`, clockAttemptColumns, where, len(args)-1, len(args))
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var attempts []*model.ClockAttempt
+	for rows.Next() {
+		attempt, err := scanClockAttempt(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		attempts = append(attempts, attempt)
+	}
+	return attempts, total, rows.Err()
+}
+
+func scanClockAttempt(row pgx.Row) (*model.ClockAttempt, error) {
+	attempt := &model.ClockAttempt{}
+	var location []byte
+	err := row.Scan(
+		&attempt.ID, &attempt.TenantID, &attempt.EmployeeID, &attempt.EmployeeName, &attempt.ClockTime, &attempt.ClockType, &attempt.CheckInMethod, &attempt.SourceType,
+		&attempt.LocationID, &location, &attempt.Address, &attempt.WiFiSSID, &attempt.WiFiMAC, &attempt.FaceScore,
+		&attempt.Reason, &attempt.Detail, &attempt.Status, &attempt.ReviewedBy, &attempt.ReviewedAt, &attempt.ReviewNote, &attempt.RecordID,
+		&attempt.CreatedAt, &attempt.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("clock attempt not found")
+		}
+		return nil, err
+	}
+
+	if len(location) > 0 && string(location) != "null" {
+		if err := json.Unmarshal(location, &attempt.Location); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal clock attempt location: %w", err)
+		}
+	}
+	return attempt, nil
+}
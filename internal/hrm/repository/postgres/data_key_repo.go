@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lk2023060901/go-next-erp/pkg/database"
+	"github.com/lk2023060901/go-next-erp/pkg/fieldcrypt"
+)
+
+type dataKeyRepo struct {
+	db *database.DB
+}
+
+// NewDataKeyStore 创建租户数据密钥存储（信封加密）
+func NewDataKeyStore(db *database.DB) fieldcrypt.KeyStore {
+	return &dataKeyRepo{db: db}
+}
+
+const dataKeyColumns = `tenant_id, version, master_key_id, wrapped_key, is_active, created_at`
+
+func (r *dataKeyRepo) FindActive(ctx context.Context, tenantID uuid.UUID) (*fieldcrypt.DataKey, error) {
+	sql := `SELECT ` + dataKeyColumns + ` FROM hrm_data_keys WHERE tenant_id = $1 AND is_active`
+
+	return scanDataKey(r.db.QueryRow(ctx, sql, tenantID))
+}
+
+func (r *dataKeyRepo) FindVersion(ctx context.Context, tenantID uuid.UUID, version int) (*fieldcrypt.DataKey, error) {
+	sql := `SELECT ` + dataKeyColumns + ` FROM hrm_data_keys WHERE tenant_id = $1 AND version = $2`
+
+	return scanDataKey(r.db.QueryRow(ctx, sql, tenantID, version))
+}
+
+func (r *dataKeyRepo) List(ctx context.Context, tenantID uuid.UUID) ([]*fieldcrypt.DataKey, error) {
+	sql := `SELECT ` + dataKeyColumns + ` FROM hrm_data_keys WHERE tenant_id = $1 ORDER BY version`
+
+	rows, err := r.db.Query(ctx, sql, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []*fieldcrypt.DataKey
+	for rows.Next() {
+		key, err := scanDataKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+func (r *dataKeyRepo) Create(ctx context.Context, key *fieldcrypt.DataKey) error {
+	return r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `UPDATE hrm_data_keys SET is_active = FALSE WHERE tenant_id = $1 AND is_active`, key.TenantID); err != nil {
+			return err
+		}
+
+		sql := `
+			INSERT INTO hrm_data_keys (tenant_id, version, master_key_id, wrapped_key, is_active, created_at)
+			VALUES ($1, $2, $3, $4, TRUE, $5)
+		`
+		_, err := tx.Exec(ctx, sql, key.TenantID, key.Version, key.MasterKeyID, key.WrappedKey, key.CreatedAt)
+		return err
+	})
+}
+
+func (r *dataKeyRepo) UpdateWrapping(ctx context.Context, tenantID uuid.UUID, version int, masterKeyID string, wrappedKey []byte) error {
+	sql := `UPDATE hrm_data_keys SET master_key_id = $1, wrapped_key = $2 WHERE tenant_id = $3 AND version = $4`
+	tag, err := r.db.Exec(ctx, sql, masterKeyID, wrappedKey, tenantID, version)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return fieldcrypt.ErrDataKeyNotFound
+	}
+	return nil
+}
+
+func scanDataKey(row pgx.Row) (*fieldcrypt.DataKey, error) {
+	key := &fieldcrypt.DataKey{}
+	err := row.Scan(&key.TenantID, &key.Version, &key.MasterKeyID, &key.WrappedKey, &key.Active, &key.CreatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fieldcrypt.ErrDataKeyNotFound
+		}
+		return nil, err
+	}
+	return key, nil
+}
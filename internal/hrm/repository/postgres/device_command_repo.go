@@ -0,0 +1,170 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/database"
+)
+
+type deviceCommandRepo struct {
+	db *database.DB
+}
+
+// NewDeviceCommandRepository 创建设备命令队列仓储
+func NewDeviceCommandRepository(db *database.DB) repository.DeviceCommandRepository {
+	return &deviceCommandRepo{db: db}
+}
+
+const deviceCommandColumns = `
+	id, seq, tenant_id, device_id, device_sn, employee_id,
+	command_type, content, status, return_code,
+	sent_at, completed_at, created_by, created_at, updated_at
+`
+
+func (r *deviceCommandRepo) Create(ctx context.Context, command *model.DeviceCommand) error {
+	sql := `
+		INSERT INTO hrm_device_commands (
+			id, tenant_id, device_id, device_sn, employee_id,
+			command_type, content, status,
+			created_by, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING seq
+	`
+
+	return r.db.QueryRow(ctx, sql,
+		command.ID, command.TenantID, command.DeviceID, command.DeviceSN, command.EmployeeID,
+		command.CommandType, command.Content, command.Status,
+		command.CreatedBy, command.CreatedAt, command.UpdatedAt,
+	).Scan(&command.Seq)
+}
+
+func (r *deviceCommandRepo) ListPending(ctx context.Context, deviceID uuid.UUID, limit int) ([]*model.DeviceCommand, error) {
+	sql := `
+		SELECT ` + deviceCommandColumns + `
+		FROM hrm_device_commands
+		WHERE device_id = $1 AND status = $2
+		ORDER BY seq ASC
+		LIMIT $3
+	`
+
+	return r.queryCommands(ctx, sql, deviceID, model.DeviceCommandPending, limit)
+}
+
+func (r *deviceCommandRepo) MarkSent(ctx context.Context, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	sql := `UPDATE hrm_device_commands SET status = $1, sent_at = NOW() WHERE id = ANY($2) AND status = $3`
+	_, err := r.db.Exec(ctx, sql, model.DeviceCommandSent, ids, model.DeviceCommandPending)
+	return err
+}
+
+func (r *deviceCommandRepo) Complete(ctx context.Context, deviceID uuid.UUID, seq int64, returnCode int) (bool, error) {
+	status := model.DeviceCommandSucceeded
+	if returnCode < 0 {
+		status = model.DeviceCommandFailed
+	}
+
+	sql := `
+		UPDATE hrm_device_commands SET status = $1, return_code = $2, completed_at = NOW()
+		WHERE device_id = $3 AND seq = $4
+	`
+	tag, err := r.db.Exec(ctx, sql, status, returnCode, deviceID, seq)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *deviceCommandRepo) List(ctx context.Context, deviceID uuid.UUID, offset, limit int) ([]*model.DeviceCommand, int, error) {
+	var total int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM hrm_device_commands WHERE device_id = $1`, deviceID).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	sql := `
+		SELECT ` + deviceCommandColumns + `
+		FROM hrm_device_commands
+		WHERE device_id = $1
+		ORDER BY created_at DESC, seq DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	commands, err := r.queryCommands(ctx, sql, deviceID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return commands, total, nil
+}
+
+func (r *deviceCommandRepo) StatsByDevice(ctx context.Context, tenantID uuid.UUID, failedSince time.Time) (map[uuid.UUID]*model.DeviceCommandStats, error) {
+	sql := `
+		SELECT device_id,
+			COUNT(*) FILTER (WHERE status IN ($2, $3)),
+			MIN(created_at) FILTER (WHERE status IN ($2, $3)),
+			COUNT(*) FILTER (WHERE status = $4 AND completed_at >= $5)
+		FROM hrm_device_commands
+		WHERE tenant_id = $1 AND (status IN ($2, $3) OR (status = $4 AND completed_at >= $5))
+		GROUP BY device_id
+	`
+
+	rows, err := r.db.Query(ctx, sql, tenantID,
+		model.DeviceCommandPending, model.DeviceCommandSent, model.DeviceCommandFailed, failedSince)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := make(map[uuid.UUID]*model.DeviceCommandStats)
+	for rows.Next() {
+		var deviceID uuid.UUID
+		stat := &model.DeviceCommandStats{}
+		if err := rows.Scan(&deviceID, &stat.Pending, &stat.OldestPending, &stat.Failed); err != nil {
+			return nil, err
+		}
+		stats[deviceID] = stat
+	}
+	return stats, rows.Err()
+}
+
+func (r *deviceCommandRepo) queryCommands(ctx context.Context, sql string, args ...interface{}) ([]*model.DeviceCommand, error) {
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var commands []*model.DeviceCommand
+	for rows.Next() {
+		command, err := scanDeviceCommand(rows)
+		if err != nil {
+			return nil, err
+		}
+		commands = append(commands, command)
+	}
+
+	return commands, rows.Err()
+}
+
+func scanDeviceCommand(row pgx.Row) (*model.DeviceCommand, error) {
+	command := &model.DeviceCommand{}
+	err := row.Scan(
+		&command.ID, &command.Seq, &command.TenantID, &command.DeviceID, &command.DeviceSN, &command.EmployeeID,
+		&command.CommandType, &command.Content, &command.Status, &command.ReturnCode,
+		&command.SentAt, &command.CompletedAt, &command.CreatedBy, &command.CreatedAt, &command.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("device command not found")
+		}
+		return nil, err
+	}
+	return command, nil
+}
@@ -0,0 +1,351 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/database"
+)
+
+type employeeLifecycleRepo struct {
+	db *database.DB
+}
+
+// NewEmployeeLifecycleRepository 创建员工生命周期仓储
+func NewEmployeeLifecycleRepository(db *database.DB) repository.EmployeeLifecycleRepository {
+	return &employeeLifecycleRepo{db: db}
+}
+
+const employeeLifecycleColumns = `
+	id, tenant_id, employee_id, employee_name, action, status, effective_date, COALESCE(reason, ''),
+	hire_info, transfer_info, separation_info,
+	approval_instance_id, requested_by, decided_at, completed_at, COALESCE(failure_reason, ''),
+	created_at, updated_at
+`
+
+func (r *employeeLifecycleRepo) Create(ctx context.Context, req *model.EmployeeLifecycleRequest) error {
+	hire, transfer, separation, err := marshalLifecyclePayloads(req)
+	if err != nil {
+		return err
+	}
+
+	sql := `
+		INSERT INTO hrm_employee_lifecycle_requests (
+			id, tenant_id, employee_id, employee_name, action, status, effective_date, reason,
+			hire_info, transfer_info, separation_info,
+			approval_instance_id, requested_by, decided_at, completed_at, failure_reason,
+			created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+	`
+
+	_, err = r.db.Exec(ctx, sql,
+		req.ID, req.TenantID, req.EmployeeID, req.EmployeeName, req.Action, req.Status, req.EffectiveDate, req.Reason,
+		hire, transfer, separation,
+		req.ApprovalInstanceID, req.RequestedBy, req.DecidedAt, req.CompletedAt, req.FailureReason,
+		req.CreatedAt, req.UpdatedAt,
+	)
+	return err
+}
+
+func (r *employeeLifecycleRepo) Update(ctx context.Context, req *model.EmployeeLifecycleRequest) error {
+	sql := `
+		UPDATE hrm_employee_lifecycle_requests SET
+			employee_id = $1, status = $2, approval_instance_id = $3,
+			decided_at = $4, completed_at = $5, failure_reason = $6, updated_at = $7
+		WHERE id = $8
+	`
+
+	_, err := r.db.Exec(ctx, sql,
+		req.EmployeeID, req.Status, req.ApprovalInstanceID,
+		req.DecidedAt, req.CompletedAt, req.FailureReason, req.UpdatedAt,
+		req.ID,
+	)
+	return err
+}
+
+func (r *employeeLifecycleRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (*model.EmployeeLifecycleRequest, error) {
+	sql := `SELECT ` + employeeLifecycleColumns + ` FROM hrm_employee_lifecycle_requests WHERE tenant_id = $1 AND id = $2`
+
+	return scanEmployeeLifecycleRequest(r.db.QueryRow(ctx, sql, tenantID, id))
+}
+
+func (r *employeeLifecycleRepo) FindOpenByEmployee(ctx context.Context, tenantID, employeeID uuid.UUID) (*model.EmployeeLifecycleRequest, error) {
+	sql := `
+		SELECT ` + employeeLifecycleColumns + ` FROM hrm_employee_lifecycle_requests
+		WHERE tenant_id = $1 AND employee_id = $2 AND status IN ('pending', 'approved', 'failed')
+		ORDER BY created_at DESC
+		LIMIT 1
+	`
+
+	req, err := scanEmployeeLifecycleRequest(r.db.QueryRow(ctx, sql, tenantID, employeeID))
+	if err != nil {
+		if err == errEmployeeLifecycleNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return req, nil
+}
+
+func (r *employeeLifecycleRepo) FindByApprovalInstance(ctx context.Context, tenantID, instanceID uuid.UUID) (*model.EmployeeLifecycleRequest, error) {
+	sql := `SELECT ` + employeeLifecycleColumns + ` FROM hrm_employee_lifecycle_requests WHERE tenant_id = $1 AND approval_instance_id = $2`
+
+	req, err := scanEmployeeLifecycleRequest(r.db.QueryRow(ctx, sql, tenantID, instanceID))
+	if err != nil {
+		if err == errEmployeeLifecycleNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return req, nil
+}
+
+func (r *employeeLifecycleRepo) List(ctx context.Context, tenantID uuid.UUID, filter *repository.EmployeeLifecycleFilter, offset, limit int) ([]*model.EmployeeLifecycleRequest, int, error) {
+	where := "tenant_id = $1"
+	args := []interface{}{tenantID}
+
+	if filter != nil {
+		if filter.EmployeeID != nil {
+			args = append(args, *filter.EmployeeID)
+			where += fmt.Sprintf(" AND employee_id = $%d", len(args))
+		}
+		if filter.Action != nil {
+			args = append(args, *filter.Action)
+			where += fmt.Sprintf(" AND action = $%d", len(args))
+		}
+		if filter.Status != nil {
+			args = append(args, *filter.Status)
+			where += fmt.Sprintf(" AND status = $%d", len(args))
+		}
+	}
+
+	var total int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM hrm_employee_lifecycle_requests WHERE `+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	args = append(args, limit, offset)
+	sql := fmt.Sprintf(`
+		SELECT %s FROM hrm_employee_lifecycle_requests
+		WHERE %s
+		ORDER BY created_at DESC, id DESC
+		LIMIT $%d OFFSET $%d
+	`, employeeLifecycleColumns, where, len(args)-1, len(args))
+
+	requests, err := r.queryRequests(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return requests, total, nil
+}
+
+func (r *employeeLifecycleRepo) ListOpen(ctx context.Context, limit int) ([]*model.EmployeeLifecycleRequest, error) {
+	sql := `
+		SELECT ` + employeeLifecycleColumns + ` FROM hrm_employee_lifecycle_requests
+		WHERE status IN ('pending', 'approved', 'failed')
+		ORDER BY effective_date, created_at
+		LIMIT $1
+	`
+
+	return r.queryRequests(ctx, sql, limit)
+}
+
+func (r *employeeLifecycleRepo) queryRequests(ctx context.Context, sql string, args ...interface{}) ([]*model.EmployeeLifecycleRequest, error) {
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var requests []*model.EmployeeLifecycleRequest
+	for rows.Next() {
+		req, err := scanEmployeeLifecycleRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, req)
+	}
+	return requests, rows.Err()
+}
+
+func (r *employeeLifecycleRepo) AppendEvent(ctx context.Context, event *model.EmployeeLifecycleEvent) error {
+	var payload []byte
+	if len(event.Payload) > 0 {
+		var err error
+		if payload, err = json.Marshal(event.Payload); err != nil {
+			return fmt.Errorf("failed to marshal lifecycle event payload: %w", err)
+		}
+	}
+
+	sql := `
+		INSERT INTO hrm_employee_lifecycle_events (
+			id, tenant_id, employee_id, request_id, event_type, action, payload, occurred_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	_, err := r.db.Exec(ctx, sql,
+		event.ID, event.TenantID, event.EmployeeID, event.RequestID, event.Type, event.Action, payload, event.OccurredAt,
+	)
+	return err
+}
+
+func (r *employeeLifecycleRepo) ListEvents(ctx context.Context, tenantID, employeeID uuid.UUID) ([]*model.EmployeeLifecycleEvent, error) {
+	sql := `
+		SELECT id, tenant_id, employee_id, request_id, event_type, COALESCE(action, ''), payload, occurred_at
+		FROM hrm_employee_lifecycle_events
+		WHERE tenant_id = $1 AND employee_id = $2
+		ORDER BY occurred_at, id
+	`
+
+	rows, err := r.db.Query(ctx, sql, tenantID, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*model.EmployeeLifecycleEvent
+	for rows.Next() {
+		event := &model.EmployeeLifecycleEvent{}
+		var payload []byte
+		if err := rows.Scan(
+			&event.ID, &event.TenantID, &event.EmployeeID, &event.RequestID, &event.Type, &event.Action, &payload, &event.OccurredAt,
+		); err != nil {
+			return nil, err
+		}
+		if len(payload) > 0 {
+			if err := json.Unmarshal(payload, &event.Payload); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal lifecycle event payload: %w", err)
+			}
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
+
+func (r *employeeLifecycleRepo) CreateReminders(ctx context.Context, reminders []*model.ProbationReminder) error {
+	if len(reminders) == 0 {
+		return nil
+	}
+
+	return r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		sql := `
+			INSERT INTO hrm_probation_reminders (
+				id, tenant_id, employee_id, probation_end, remind_on, days_before, status, sent_at, created_at
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			ON CONFLICT (employee_id, probation_end, days_before) DO NOTHING
+		`
+		for _, reminder := range reminders {
+			if _, err := tx.Exec(ctx, sql,
+				reminder.ID, reminder.TenantID, reminder.EmployeeID, reminder.ProbationEnd, reminder.RemindOn,
+				reminder.DaysBefore, reminder.Status, reminder.SentAt, reminder.CreatedAt,
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *employeeLifecycleRepo) ListDueReminders(ctx context.Context, asOf time.Time, limit int) ([]*model.ProbationReminder, error) {
+	sql := `
+		SELECT id, tenant_id, employee_id, probation_end, remind_on, days_before, status, sent_at, created_at
+		FROM hrm_probation_reminders
+		WHERE status = 'pending' AND remind_on <= $1
+		ORDER BY remind_on, id
+		LIMIT $2
+	`
+
+	rows, err := r.db.Query(ctx, sql, asOf, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reminders []*model.ProbationReminder
+	for rows.Next() {
+		reminder := &model.ProbationReminder{}
+		if err := rows.Scan(
+			&reminder.ID, &reminder.TenantID, &reminder.EmployeeID, &reminder.ProbationEnd, &reminder.RemindOn,
+			&reminder.DaysBefore, &reminder.Status, &reminder.SentAt, &reminder.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		reminders = append(reminders, reminder)
+	}
+	return reminders, rows.Err()
+}
+
+func (r *employeeLifecycleRepo) MarkReminderSent(ctx context.Context, id uuid.UUID, sentAt time.Time) error {
+	_, err := r.db.Exec(ctx, `UPDATE hrm_probation_reminders SET status = 'sent', sent_at = $1 WHERE id = $2`, sentAt, id)
+	return err
+}
+
+func (r *employeeLifecycleRepo) CancelReminders(ctx context.Context, employeeID uuid.UUID) (int, error) {
+	tag, err := r.db.Exec(ctx, `UPDATE hrm_probation_reminders SET status = 'cancelled' WHERE employee_id = $1 AND status = 'pending'`, employeeID)
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+var errEmployeeLifecycleNotFound = fmt.Errorf("employee lifecycle request not found")
+
+func scanEmployeeLifecycleRequest(row pgx.Row) (*model.EmployeeLifecycleRequest, error) {
+	req := &model.EmployeeLifecycleRequest{}
+	var hire, transfer, separation []byte
+	err := row.Scan(
+		&req.ID, &req.TenantID, &req.EmployeeID, &req.EmployeeName, &req.Action, &req.Status, &req.EffectiveDate, &req.Reason,
+		&hire, &transfer, &separation,
+		&req.ApprovalInstanceID, &req.RequestedBy, &req.DecidedAt, &req.CompletedAt, &req.FailureReason,
+		&req.CreatedAt, &req.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errEmployeeLifecycleNotFound
+		}
+		return nil, err
+	}
+
+	if len(hire) > 0 {
+		if err := json.Unmarshal(hire, &req.Hire); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal hire info: %w", err)
+		}
+	}
+	if len(transfer) > 0 {
+		if err := json.Unmarshal(transfer, &req.Transfer); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal transfer info: %w", err)
+		}
+	}
+	if len(separation) > 0 {
+		if err := json.Unmarshal(separation, &req.Separation); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal separation info: %w", err)
+		}
+	}
+	return req, nil
+}
+
+// marshalLifecyclePayloads 序列化申请附带的信息，未设置的保存为 NULL
+func marshalLifecyclePayloads(req *model.EmployeeLifecycleRequest) (hire, transfer, separation []byte, err error) {
+	if req.Hire != nil {
+		if hire, err = json.Marshal(req.Hire); err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to marshal hire info: %w", err)
+		}
+	}
+	if req.Transfer != nil {
+		if transfer, err = json.Marshal(req.Transfer); err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to marshal transfer info: %w", err)
+		}
+	}
+	if req.Separation != nil {
+		if separation, err = json.Marshal(req.Separation); err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to marshal separation info: %w", err)
+		}
+	}
+	return hire, transfer, separation, nil
+}
@@ -0,0 +1,227 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/database"
+)
+
+type holidayCalendarRepo struct {
+	db *database.DB
+}
+
+// NewHolidayCalendarRepository 创建假期日历仓储
+func NewHolidayCalendarRepository(db *database.DB) repository.HolidayCalendarRepository {
+	return &holidayCalendarRepo{db: db}
+}
+
+const holidayCalendarColumns = `
+	id, tenant_id, code, name, description, region, is_default, is_active,
+	created_by, updated_by, created_at, updated_at, deleted_at
+`
+
+func (r *holidayCalendarRepo) Create(ctx context.Context, calendar *model.HolidayCalendar) error {
+	sql := `
+		INSERT INTO hrm_holiday_calendars (
+			id, tenant_id, code, name, description, region, is_default, is_active,
+			created_by, updated_by, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+
+	_, err := r.db.Exec(ctx, sql,
+		calendar.ID, calendar.TenantID, calendar.Code, calendar.Name, calendar.Description, calendar.Region,
+		calendar.IsDefault, calendar.IsActive,
+		calendar.CreatedBy, calendar.UpdatedBy, calendar.CreatedAt, calendar.UpdatedAt,
+	)
+
+	return err
+}
+
+func (r *holidayCalendarRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.HolidayCalendar, error) {
+	sql := `SELECT ` + holidayCalendarColumns + ` FROM hrm_holiday_calendars WHERE id = $1 AND deleted_at IS NULL`
+
+	return scanHolidayCalendar(r.db.QueryRow(ctx, sql, id))
+}
+
+func (r *holidayCalendarRepo) FindByCode(ctx context.Context, tenantID uuid.UUID, code string) (*model.HolidayCalendar, error) {
+	sql := `
+		SELECT ` + holidayCalendarColumns + `
+		FROM hrm_holiday_calendars
+		WHERE tenant_id = $1 AND code = $2 AND deleted_at IS NULL
+	`
+
+	return scanHolidayCalendar(r.db.QueryRow(ctx, sql, tenantID, code))
+}
+
+func (r *holidayCalendarRepo) FindDefault(ctx context.Context, tenantID uuid.UUID) (*model.HolidayCalendar, error) {
+	sql := `
+		SELECT ` + holidayCalendarColumns + `
+		FROM hrm_holiday_calendars
+		WHERE tenant_id = $1 AND is_default = TRUE AND is_active = TRUE AND deleted_at IS NULL
+		LIMIT 1
+	`
+
+	return scanHolidayCalendar(r.db.QueryRow(ctx, sql, tenantID))
+}
+
+func (r *holidayCalendarRepo) Update(ctx context.Context, calendar *model.HolidayCalendar) error {
+	sql := `
+		UPDATE hrm_holiday_calendars SET
+			name = $1, description = $2, region = $3, is_default = $4, is_active = $5,
+			updated_by = $6, updated_at = $7
+		WHERE id = $8 AND deleted_at IS NULL
+	`
+
+	_, err := r.db.Exec(ctx, sql,
+		calendar.Name, calendar.Description, calendar.Region, calendar.IsDefault, calendar.IsActive,
+		calendar.UpdatedBy, calendar.UpdatedAt,
+		calendar.ID,
+	)
+
+	return err
+}
+
+func (r *holidayCalendarRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	sql := `UPDATE hrm_holiday_calendars SET deleted_at = NOW(), is_default = FALSE WHERE id = $1`
+	_, err := r.db.Exec(ctx, sql, id)
+	return err
+}
+
+func (r *holidayCalendarRepo) List(ctx context.Context, tenantID uuid.UUID) ([]*model.HolidayCalendar, error) {
+	sql := `
+		SELECT ` + holidayCalendarColumns + `
+		FROM hrm_holiday_calendars
+		WHERE tenant_id = $1 AND deleted_at IS NULL
+		ORDER BY is_default DESC, code ASC
+	`
+
+	rows, err := r.db.Query(ctx, sql, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var calendars []*model.HolidayCalendar
+	for rows.Next() {
+		calendar, err := scanHolidayCalendar(rows)
+		if err != nil {
+			return nil, err
+		}
+		calendars = append(calendars, calendar)
+	}
+
+	return calendars, rows.Err()
+}
+
+func (r *holidayCalendarRepo) ClearDefault(ctx context.Context, tenantID, exceptID uuid.UUID) error {
+	sql := `
+		UPDATE hrm_holiday_calendars SET is_default = FALSE
+		WHERE tenant_id = $1 AND id <> $2 AND is_default = TRUE
+	`
+	_, err := r.db.Exec(ctx, sql, tenantID, exceptID)
+	return err
+}
+
+func (r *holidayCalendarRepo) UpsertDays(ctx context.Context, days []*model.HolidayCalendarDay) error {
+	if len(days) == 0 {
+		return nil
+	}
+
+	return r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		batch := &pgx.Batch{}
+		for _, day := range days {
+			batch.Queue(`
+				INSERT INTO hrm_holiday_calendar_days (
+					id, tenant_id, calendar_id, day_date, kind, name, is_statutory, remark, created_at, updated_at
+				) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+				ON CONFLICT (calendar_id, day_date) DO UPDATE SET
+					kind = EXCLUDED.kind,
+					name = EXCLUDED.name,
+					is_statutory = EXCLUDED.is_statutory,
+					remark = EXCLUDED.remark,
+					updated_at = EXCLUDED.updated_at
+			`,
+				day.ID, day.TenantID, day.CalendarID, day.Date.Format("2006-01-02"), day.Kind, day.Name,
+				day.IsStatutory, day.Remark, day.CreatedAt, day.UpdatedAt,
+			)
+		}
+
+		results := tx.SendBatch(ctx, batch)
+		for range days {
+			if _, err := results.Exec(); err != nil {
+				results.Close()
+				return fmt.Errorf("upsert calendar day failed: %w", err)
+			}
+		}
+		return results.Close()
+	})
+}
+
+func (r *holidayCalendarRepo) DeleteDay(ctx context.Context, calendarID uuid.UUID, date time.Time) error {
+	sql := `DELETE FROM hrm_holiday_calendar_days WHERE calendar_id = $1 AND day_date = $2::date`
+	_, err := r.db.Exec(ctx, sql, calendarID, date.Format("2006-01-02"))
+	return err
+}
+
+func (r *holidayCalendarRepo) DeleteDaysInRange(ctx context.Context, calendarID uuid.UUID, start, end time.Time) (int64, error) {
+	sql := `
+		DELETE FROM hrm_holiday_calendar_days
+		WHERE calendar_id = $1 AND day_date >= $2::date AND day_date <= $3::date
+	`
+	tag, err := r.db.Exec(ctx, sql, calendarID, start.Format("2006-01-02"), end.Format("2006-01-02"))
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+func (r *holidayCalendarRepo) ListDays(ctx context.Context, calendarID uuid.UUID, start, end time.Time) ([]*model.HolidayCalendarDay, error) {
+	sql := `
+		SELECT id, tenant_id, calendar_id, day_date, kind, name, is_statutory, COALESCE(remark, ''), created_at, updated_at
+		FROM hrm_holiday_calendar_days
+		WHERE calendar_id = $1 AND day_date >= $2::date AND day_date <= $3::date
+		ORDER BY day_date ASC
+	`
+
+	rows, err := r.db.Query(ctx, sql, calendarID, start.Format("2006-01-02"), end.Format("2006-01-02"))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var days []*model.HolidayCalendarDay
+	for rows.Next() {
+		day := &model.HolidayCalendarDay{}
+		if err := rows.Scan(
+			&day.ID, &day.TenantID, &day.CalendarID, &day.Date, &day.Kind, &day.Name,
+			&day.IsStatutory, &day.Remark, &day.CreatedAt, &day.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		days = append(days, day)
+	}
+
+	return days, rows.Err()
+}
+
+func scanHolidayCalendar(row pgx.Row) (*model.HolidayCalendar, error) {
+	calendar := &model.HolidayCalendar{}
+	err := row.Scan(
+		&calendar.ID, &calendar.TenantID, &calendar.Code, &calendar.Name, &calendar.Description, &calendar.Region,
+		&calendar.IsDefault, &calendar.IsActive,
+		&calendar.CreatedBy, &calendar.UpdatedBy, &calendar.CreatedAt, &calendar.UpdatedAt, &calendar.DeletedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("holiday calendar not found")
+		}
+		return nil, err
+	}
+	return calendar, nil
+}
@@ -10,18 +10,28 @@ import (
 	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
 	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
 	"github.com/lk2023060901/go-next-erp/pkg/database"
+	"github.com/lk2023060901/go-next-erp/pkg/fieldcrypt"
 )
 
+// hrmEmployeeRepo 身份证号、人脸、指纹数据以信封加密密文存储，读写时透明加解密；
+// 身份证号另存盲索引用于查重
 type hrmEmployeeRepo struct {
-	db *database.DB
+	db     *database.DB
+	cipher fieldcrypt.Cipher
 }
 
 // NewHRMEmployeeRepository 创建HRM员工仓储
-func NewHRMEmployeeRepository(db *database.DB) repository.HRMEmployeeRepository {
-	return &hrmEmployeeRepo{db: db}
+func NewHRMEmployeeRepository(db *database.DB, cipher fieldcrypt.Cipher) repository.HRMEmployeeRepository {
+	return &hrmEmployeeRepo{db: db, cipher: cipher}
 }
 
 func (r *hrmEmployeeRepo) Create(ctx context.Context, emp *model.HRMEmployee) error {
+	idCardNo := normalizeIDCardNo(emp.IDCardNo)
+	encrypted, err := encryptFields(ctx, r.cipher, emp.TenantID, idCardNo, emp.FaceData, emp.Fingerprint)
+	if err != nil {
+		return err
+	}
+
 	sql := `
 		INSERT INTO hrm_employees (
 			id, tenant_id, employee_id, id_card_no, card_no, face_data, fingerprint,
@@ -29,24 +39,24 @@ func (r *hrmEmployeeRepo) Create(ctx context.Context, emp *model.HRMEmployee) er
 			work_location, work_schedule_type, attendance_rule_id, default_shift_id,
 			allow_field_work, require_face, require_location, require_wifi,
 			emergency_contact, emergency_phone, emergency_relation,
-			is_active, remark, created_at, updated_at
+			is_active, remark, created_at, updated_at, id_card_hash
 		) VALUES (
 			$1, $2, $3, $4, $5, $6, $7,
 			$8, $9, $10, $11,
 			$12, $13, $14, $15,
 			$16, $17, $18, $19,
 			$20, $21, $22,
-			$23, $24, $25, $26
+			$23, $24, $25, $26, NULLIF($27, '')
 		)
 	`
 
-	_, err := r.db.Exec(ctx, sql,
-		emp.ID, emp.TenantID, emp.EmployeeID, emp.IDCardNo, emp.CardNo, emp.FaceData, emp.Fingerprint,
+	_, err = r.db.Exec(ctx, sql,
+		emp.ID, emp.TenantID, emp.EmployeeID, encrypted[0], emp.CardNo, encrypted[1], encrypted[2],
 		emp.DingTalkUserID, emp.WeComUserID, emp.FeishuUserID, emp.FeishuOpenID,
 		emp.WorkLocation, emp.WorkScheduleType, emp.AttendanceRuleID, emp.DefaultShiftID,
 		emp.AllowFieldWork, emp.RequireFace, emp.RequireLocation, emp.RequireWiFi,
 		emp.EmergencyContact, emp.EmergencyPhone, emp.EmergencyRelation,
-		emp.IsActive, emp.Remark, emp.CreatedAt, emp.UpdatedAt,
+		emp.IsActive, emp.Remark, emp.CreatedAt, emp.UpdatedAt, r.cipher.BlindIndex(emp.TenantID, idCardNo),
 	)
 
 	return err
@@ -73,6 +83,11 @@ func (r *hrmEmployeeRepo) BatchCreate(ctx context.Context, employees []*model.HR
 }
 
 func (r *hrmEmployeeRepo) Update(ctx context.Context, emp *model.HRMEmployee) error {
+	encrypted, err := encryptFields(ctx, r.cipher, emp.TenantID, emp.FaceData, emp.Fingerprint)
+	if err != nil {
+		return err
+	}
+
 	sql := `
 		UPDATE hrm_employees SET
 			card_no = $1, face_data = $2, fingerprint = $3,
@@ -84,8 +99,8 @@ func (r *hrmEmployeeRepo) Update(ctx context.Context, emp *model.HRMEmployee) er
 		WHERE id = $21 AND deleted_at IS NULL
 	`
 
-	_, err := r.db.Exec(ctx, sql,
-		emp.CardNo, emp.FaceData, emp.Fingerprint,
+	_, err = r.db.Exec(ctx, sql,
+		emp.CardNo, encrypted[0], encrypted[1],
 		emp.DingTalkUserID, emp.WeComUserID, emp.FeishuUserID,
 		emp.WorkLocation, emp.WorkScheduleType, emp.AttendanceRuleID, emp.DefaultShiftID,
 		emp.AllowFieldWork, emp.RequireFace, emp.RequireLocation, emp.RequireWiFi,
@@ -132,6 +147,9 @@ func (r *hrmEmployeeRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.HR
 		return nil, err
 	}
 
+	if err := decryptFields(ctx, r.cipher, emp.TenantID, &emp.IDCardNo, &emp.FaceData, &emp.Fingerprint); err != nil {
+		return nil, err
+	}
 	return emp, nil
 }
 
@@ -163,6 +181,9 @@ func (r *hrmEmployeeRepo) FindByEmployeeID(ctx context.Context, tenantID, employ
 		return nil, err
 	}
 
+	if err := decryptFields(ctx, r.cipher, emp.TenantID, &emp.FaceData, &emp.Fingerprint); err != nil {
+		return nil, err
+	}
 	return emp, nil
 }
 
@@ -179,6 +200,24 @@ func (r *hrmEmployeeRepo) FindByCardNo(ctx context.Context, tenantID uuid.UUID,
 	return emp, nil
 }
 
+func (r *hrmEmployeeRepo) FindByIDCardNo(ctx context.Context, tenantID uuid.UUID, idCardNo string) (*model.HRMEmployee, error) {
+	hash := r.cipher.BlindIndex(tenantID, normalizeIDCardNo(idCardNo))
+	if hash == "" {
+		return nil, fmt.Errorf("hrm employee not found")
+	}
+
+	sql := `SELECT id, tenant_id, employee_id FROM hrm_employees WHERE tenant_id = $1 AND id_card_hash = $2 AND deleted_at IS NULL`
+	emp := &model.HRMEmployee{}
+	err := r.db.QueryRow(ctx, sql, tenantID, hash).Scan(&emp.ID, &emp.TenantID, &emp.EmployeeID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("hrm employee not found")
+		}
+		return nil, err
+	}
+	return emp, nil
+}
+
 func (r *hrmEmployeeRepo) FindByThirdPartyID(ctx context.Context, tenantID uuid.UUID, platform model.PlatformType, platformID string) (*model.HRMEmployee, error) {
 	var sql string
 	switch platform {
@@ -315,18 +354,260 @@ func (r *hrmEmployeeRepo) ListActive(ctx context.Context, tenantID uuid.UUID) ([
 	return emps, rows.Err()
 }
 
+func (r *hrmEmployeeRepo) ListActiveTenantIDs(ctx context.Context) ([]uuid.UUID, error) {
+	sql := `SELECT DISTINCT tenant_id FROM hrm_employees WHERE is_active = TRUE AND deleted_at IS NULL`
+	rows, err := r.db.Query(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tenantIDs []uuid.UUID
+	for rows.Next() {
+		var tenantID uuid.UUID
+		if err := rows.Scan(&tenantID); err != nil {
+			return nil, err
+		}
+		tenantIDs = append(tenantIDs, tenantID)
+	}
+	return tenantIDs, rows.Err()
+}
+
+func (r *hrmEmployeeRepo) ListTenures(ctx context.Context, tenantID uuid.UUID) ([]*model.EmployeeTenure, error) {
+	sql := `
+		SELECT e.id, e.name, e.join_date, e.leave_date
+		FROM hrm_employees h
+		INNER JOIN employees e ON e.id = h.employee_id AND e.deleted_at IS NULL
+		WHERE h.tenant_id = $1 AND h.deleted_at IS NULL
+		ORDER BY e.join_date ASC NULLS LAST
+	`
+	rows, err := r.db.Query(ctx, sql, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tenures []*model.EmployeeTenure
+	for rows.Next() {
+		tenure := &model.EmployeeTenure{}
+		if err := rows.Scan(&tenure.EmployeeID, &tenure.Name, &tenure.JoinDate, &tenure.LeaveDate); err != nil {
+			return nil, err
+		}
+		tenures = append(tenures, tenure)
+	}
+	return tenures, rows.Err()
+}
+
+func (r *hrmEmployeeRepo) ListTenuresByDepartment(ctx context.Context, tenantID, departmentID uuid.UUID) ([]*model.EmployeeTenure, error) {
+	sql := `
+		SELECT e.id, e.name, e.join_date, e.leave_date
+		FROM hrm_employees h
+		INNER JOIN employees e ON e.id = h.employee_id AND e.deleted_at IS NULL
+		WHERE h.tenant_id = $1 AND e.org_id = $2 AND h.deleted_at IS NULL
+		ORDER BY e.employee_no ASC, e.name ASC
+	`
+	rows, err := r.db.Query(ctx, sql, tenantID, departmentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tenures []*model.EmployeeTenure
+	for rows.Next() {
+		tenure := &model.EmployeeTenure{}
+		if err := rows.Scan(&tenure.EmployeeID, &tenure.Name, &tenure.JoinDate, &tenure.LeaveDate); err != nil {
+			return nil, err
+		}
+		tenures = append(tenures, tenure)
+	}
+	return tenures, rows.Err()
+}
+
+func (r *hrmEmployeeRepo) FindEmployeeIDByUser(ctx context.Context, tenantID, userID uuid.UUID) (uuid.UUID, error) {
+	sql := `SELECT id FROM employees WHERE tenant_id = $1 AND user_id = $2 AND deleted_at IS NULL`
+
+	var employeeID uuid.UUID
+	if err := r.db.QueryRow(ctx, sql, tenantID, userID).Scan(&employeeID); err != nil {
+		return uuid.Nil, err
+	}
+	return employeeID, nil
+}
+
+func (r *hrmEmployeeRepo) FindManagerUserIDs(ctx context.Context, tenantID, employeeID uuid.UUID) ([]uuid.UUID, error) {
+	sql := `
+		SELECT s.user_id
+		FROM employees e
+		JOIN employees s ON s.id = e.superior_id AND s.deleted_at IS NULL
+		WHERE e.tenant_id = $1 AND e.id = $2 AND e.deleted_at IS NULL
+		UNION
+		SELECT o.leader_id
+		FROM employees e
+		JOIN organizations o ON o.id = e.org_id
+		WHERE e.tenant_id = $1 AND e.id = $2 AND e.deleted_at IS NULL AND o.leader_id IS NOT NULL
+	`
+
+	rows, err := r.db.Query(ctx, sql, tenantID, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var userIDs []uuid.UUID
+	for rows.Next() {
+		var userID uuid.UUID
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+	return userIDs, rows.Err()
+}
+
+func (r *hrmEmployeeRepo) FindUserIDs(ctx context.Context, tenantID uuid.UUID, employeeIDs []uuid.UUID) (map[uuid.UUID]uuid.UUID, error) {
+	result := make(map[uuid.UUID]uuid.UUID, len(employeeIDs))
+	if len(employeeIDs) == 0 {
+		return result, nil
+	}
+
+	sql := `SELECT id, user_id FROM employees WHERE tenant_id = $1 AND id = ANY($2) AND deleted_at IS NULL`
+	rows, err := r.db.Query(ctx, sql, tenantID, employeeIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var employeeID, userID uuid.UUID
+		if err := rows.Scan(&employeeID, &userID); err != nil {
+			return nil, err
+		}
+		result[employeeID] = userID
+	}
+	return result, rows.Err()
+}
+
+const deviceUserQuery = `
+	SELECT e.id, e.employee_no, e.name, e.org_id, COALESCE(h.card_no, '')
+	FROM employees e
+	LEFT JOIN hrm_employees h ON h.employee_id = e.id AND h.deleted_at IS NULL
+	WHERE e.tenant_id = $1 AND e.deleted_at IS NULL
+`
+
+func (r *hrmEmployeeRepo) ListDeviceUsers(ctx context.Context, tenantID uuid.UUID, employeeIDs []uuid.UUID) ([]*model.DeviceUser, error) {
+	if len(employeeIDs) == 0 {
+		return nil, nil
+	}
+	return r.queryDeviceUsers(ctx, deviceUserQuery+` AND e.id = ANY($2) ORDER BY e.employee_no`, tenantID, employeeIDs)
+}
+
+func (r *hrmEmployeeRepo) ListActiveDeviceUsers(ctx context.Context, tenantID uuid.UUID, departmentID *uuid.UUID) ([]*model.DeviceUser, error) {
+	sql := deviceUserQuery + ` AND h.is_active = TRUE AND (e.leave_date IS NULL OR e.leave_date >= CURRENT_DATE)`
+	if departmentID == nil {
+		return r.queryDeviceUsers(ctx, sql+` ORDER BY e.employee_no`, tenantID)
+	}
+	return r.queryDeviceUsers(ctx, sql+` AND e.org_id = $2 ORDER BY e.employee_no`, tenantID, *departmentID)
+}
+
+func (r *hrmEmployeeRepo) FindDeviceUsersByPIN(ctx context.Context, tenantID uuid.UUID, pins []string) (map[string]*model.DeviceUser, error) {
+	result := make(map[string]*model.DeviceUser, len(pins))
+	if len(pins) == 0 {
+		return result, nil
+	}
+
+	users, err := r.queryDeviceUsers(ctx, deviceUserQuery+` AND e.employee_no = ANY($2)`, tenantID, pins)
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		result[user.PIN] = user
+	}
+	return result, nil
+}
+
+func (r *hrmEmployeeRepo) queryDeviceUsers(ctx context.Context, sql string, args ...interface{}) ([]*model.DeviceUser, error) {
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []*model.DeviceUser
+	for rows.Next() {
+		user := &model.DeviceUser{}
+		if err := rows.Scan(&user.EmployeeID, &user.PIN, &user.Name, &user.DepartmentID, &user.CardNo); err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	return users, rows.Err()
+}
+
+func (r *hrmEmployeeRepo) FindTenure(ctx context.Context, tenantID, employeeID uuid.UUID) (*model.EmployeeTenure, error) {
+	sql := `SELECT id, name, join_date, leave_date FROM employees WHERE tenant_id = $1 AND id = $2 AND deleted_at IS NULL`
+
+	tenure := &model.EmployeeTenure{}
+	err := r.db.QueryRow(ctx, sql, tenantID, employeeID).Scan(&tenure.EmployeeID, &tenure.Name, &tenure.JoinDate, &tenure.LeaveDate)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("employee not found")
+		}
+		return nil, err
+	}
+	return tenure, nil
+}
+
+func (r *hrmEmployeeRepo) FindPositionLevel(ctx context.Context, tenantID, employeeID uuid.UUID) (int, error) {
+	sql := `
+		SELECT COALESCE(p.level, 0)
+		FROM employees e
+		LEFT JOIN positions p ON p.id = e.position_id AND p.deleted_at IS NULL
+		WHERE e.tenant_id = $1 AND e.id = $2 AND e.deleted_at IS NULL
+	`
+
+	var level int
+	if err := r.db.QueryRow(ctx, sql, tenantID, employeeID).Scan(&level); err != nil {
+		if err == pgx.ErrNoRows {
+			return 0, fmt.Errorf("employee not found")
+		}
+		return 0, err
+	}
+	return level, nil
+}
+
 func (r *hrmEmployeeRepo) UpdateFaceData(ctx context.Context, id uuid.UUID, faceData string) error {
+	encrypted, err := r.encryptForEmployee(ctx, id, faceData)
+	if err != nil {
+		return err
+	}
+
 	sql := `UPDATE hrm_employees SET face_data = $1, updated_at = NOW() WHERE id = $2`
-	_, err := r.db.Exec(ctx, sql, faceData, id)
+	_, err = r.db.Exec(ctx, sql, encrypted, id)
 	return err
 }
 
 func (r *hrmEmployeeRepo) UpdateFingerprint(ctx context.Context, id uuid.UUID, fingerprint string) error {
+	encrypted, err := r.encryptForEmployee(ctx, id, fingerprint)
+	if err != nil {
+		return err
+	}
+
 	sql := `UPDATE hrm_employees SET fingerprint = $1, updated_at = NOW() WHERE id = $2`
-	_, err := r.db.Exec(ctx, sql, fingerprint, id)
+	_, err = r.db.Exec(ctx, sql, encrypted, id)
 	return err
 }
 
+// encryptForEmployee 按员工所属租户的数据密钥加密
+func (r *hrmEmployeeRepo) encryptForEmployee(ctx context.Context, id uuid.UUID, value string) (string, error) {
+	var tenantID uuid.UUID
+	if err := r.db.QueryRow(ctx, `SELECT tenant_id FROM hrm_employees WHERE id = $1`, id).Scan(&tenantID); err != nil {
+		if err == pgx.ErrNoRows {
+			return "", fmt.Errorf("hrm employee not found")
+		}
+		return "", err
+	}
+	return r.cipher.Encrypt(ctx, tenantID, value)
+}
+
 func (r *hrmEmployeeRepo) UpdateCardNo(ctx context.Context, id uuid.UUID, cardNo string) error {
 	sql := `UPDATE hrm_employees SET card_no = $1, updated_at = NOW() WHERE id = $2`
 	_, err := r.db.Exec(ctx, sql, cardNo, id)
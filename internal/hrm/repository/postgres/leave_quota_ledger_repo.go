@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/database"
+)
+
+type leaveQuotaLedgerRepo struct {
+	db *database.DB
+}
+
+// NewLeaveQuotaLedgerRepository 创建请假额度流水仓储
+func NewLeaveQuotaLedgerRepository(db *database.DB) repository.LeaveQuotaLedgerRepository {
+	return &leaveQuotaLedgerRepo{db: db}
+}
+
+const leaveQuotaLedgerColumns = `
+	id, tenant_id, employee_id, leave_type_id, quota_id, year,
+	entry_type, amount, balance_after, expires_at, reason,
+	source_type, source_id, operator_id, idempotency_key, created_at
+`
+
+func (r *leaveQuotaLedgerRepo) Apply(ctx context.Context, entry *model.LeaveQuotaLedgerEntry) (bool, error) {
+	applied := false
+	err := r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, `
+			INSERT INTO hrm_leave_quota_ledger (`+leaveQuotaLedgerColumns+`)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+			ON CONFLICT (tenant_id, idempotency_key) DO NOTHING
+		`,
+			entry.ID, entry.TenantID, entry.EmployeeID, entry.LeaveTypeID, entry.QuotaID, entry.Year,
+			entry.EntryType, entry.Amount, entry.BalanceAfter, entry.ExpiresAt, entry.Reason,
+			entry.SourceType, entry.SourceID, entry.OperatorID, entry.IdempotencyKey, entry.CreatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert ledger entry: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return nil
+		}
+
+		// 扣减、退还只记录余额快照，已用额度由请假流程维护
+		balanceSQL := `SELECT total_quota - used_quota - pending_quota FROM hrm_leave_quotas WHERE id = $1`
+		args := []interface{}{entry.QuotaID}
+		if entry.EntryType.AdjustsTotal() {
+			balanceSQL = `
+				UPDATE hrm_leave_quotas SET total_quota = total_quota + $2, updated_at = NOW()
+				WHERE id = $1
+				RETURNING total_quota - used_quota - pending_quota
+			`
+			args = append(args, entry.Amount)
+		}
+		if err := tx.QueryRow(ctx, balanceSQL, args...).Scan(&entry.BalanceAfter); err != nil {
+			return fmt.Errorf("failed to update quota balance: %w", err)
+		}
+
+		if _, err := tx.Exec(ctx, `UPDATE hrm_leave_quota_ledger SET balance_after = $1 WHERE id = $2`, entry.BalanceAfter, entry.ID); err != nil {
+			return err
+		}
+		applied = true
+		return nil
+	})
+	return applied, err
+}
+
+func (r *leaveQuotaLedgerRepo) ListByQuota(ctx context.Context, quotaID uuid.UUID) ([]*model.LeaveQuotaLedgerEntry, error) {
+	sql := `SELECT ` + leaveQuotaLedgerColumns + ` FROM hrm_leave_quota_ledger WHERE quota_id = $1 ORDER BY created_at ASC, id ASC`
+	return r.query(ctx, sql, quotaID)
+}
+
+func (r *leaveQuotaLedgerRepo) ListByEmployee(ctx context.Context, tenantID, employeeID uuid.UUID, year int) ([]*model.LeaveQuotaLedgerEntry, error) {
+	sql := `
+		SELECT ` + leaveQuotaLedgerColumns + `
+		FROM hrm_leave_quota_ledger
+		WHERE tenant_id = $1 AND employee_id = $2 AND year = $3
+		ORDER BY created_at DESC, id DESC
+	`
+	return r.query(ctx, sql, tenantID, employeeID, year)
+}
+
+func (r *leaveQuotaLedgerRepo) ListExpiring(ctx context.Context, tenantID uuid.UUID, before time.Time) ([]*model.LeaveQuotaLedgerEntry, error) {
+	sql := `
+		SELECT ` + leaveQuotaLedgerColumns + `
+		FROM hrm_leave_quota_ledger l
+		WHERE l.tenant_id = $1 AND l.expires_at IS NOT NULL AND l.expires_at <= $2 AND l.amount > 0
+		  AND NOT EXISTS (
+			SELECT 1 FROM hrm_leave_quota_ledger x
+			WHERE x.tenant_id = l.tenant_id AND x.entry_type = 'expiry'
+			  AND x.source_type = 'ledger' AND x.source_id = l.id
+		  )
+		ORDER BY l.expires_at ASC, l.created_at ASC
+	`
+	return r.query(ctx, sql, tenantID, before)
+}
+
+func (r *leaveQuotaLedgerRepo) query(ctx context.Context, sql string, args ...interface{}) ([]*model.LeaveQuotaLedgerEntry, error) {
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*model.LeaveQuotaLedgerEntry
+	for rows.Next() {
+		entry := &model.LeaveQuotaLedgerEntry{}
+		var sourceType *string
+		err := rows.Scan(
+			&entry.ID, &entry.TenantID, &entry.EmployeeID, &entry.LeaveTypeID, &entry.QuotaID, &entry.Year,
+			&entry.EntryType, &entry.Amount, &entry.BalanceAfter, &entry.ExpiresAt, &entry.Reason,
+			&sourceType, &entry.SourceID, &entry.OperatorID, &entry.IdempotencyKey, &entry.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if sourceType != nil {
+			entry.SourceType = *sourceType
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
@@ -256,3 +256,36 @@ func (r *leaveQuotaRepo) BatchCreate(ctx context.Context, quotas []*model.LeaveQ
 
 	return tx.Commit(ctx)
 }
+
+func (r *leaveQuotaRepo) ListByTypeAndYear(ctx context.Context, tenantID, leaveTypeID uuid.UUID, year int) ([]*model.LeaveQuota, error) {
+	sql := `
+		SELECT id, tenant_id, employee_id, leave_type_id, year,
+		       total_quota, used_quota, pending_quota, expired_at,
+		       created_at, updated_at
+		FROM hrm_leave_quotas
+		WHERE tenant_id = $1 AND leave_type_id = $2 AND year = $3
+		ORDER BY created_at ASC
+	`
+
+	rows, err := r.db.Query(ctx, sql, tenantID, leaveTypeID, year)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var quotas []*model.LeaveQuota
+	for rows.Next() {
+		quota := &model.LeaveQuota{}
+		err := rows.Scan(
+			&quota.ID, &quota.TenantID, &quota.EmployeeID, &quota.LeaveTypeID, &quota.Year,
+			&quota.TotalQuota, &quota.UsedQuota, &quota.PendingQuota, &quota.ExpiredAt,
+			&quota.CreatedAt, &quota.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		quotas = append(quotas, quota)
+	}
+
+	return quotas, rows.Err()
+}
@@ -24,27 +24,28 @@ func NewLeaveRequestRepository(db *database.DB) repository.LeaveRequestRepositor
 
 func (r *leaveRequestRepo) Create(ctx context.Context, request *model.LeaveRequest) error {
 	proofURLsJSON, _ := json.Marshal(request.ProofURLs)
+	dayBreakdownJSON, _ := json.Marshal(request.DayBreakdown)
 
 	sql := `
 		INSERT INTO hrm_leave_requests (
 			id, tenant_id, employee_id, employee_name, department_id,
 			leave_type_id, leave_type_name, start_time, end_time, duration, unit,
-			reason, proof_urls, status, current_approver_id,
+			reason, proof_urls, day_breakdown, status, current_approver_id,
 			submitted_at, remark,
 			created_by, updated_by, created_at, updated_at
 		) VALUES (
 			$1, $2, $3, $4, $5,
 			$6, $7, $8, $9, $10, $11,
-			$12, $13, $14, $15,
-			$16, $17,
-			$18, $19, $20, $21
+			$12, $13, $14, $15, $16,
+			$17, $18,
+			$19, $20, $21, $22
 		)
 	`
 
 	_, err := r.db.Exec(ctx, sql,
 		request.ID, request.TenantID, request.EmployeeID, request.EmployeeName, request.DepartmentID,
 		request.LeaveTypeID, request.LeaveTypeName, request.StartTime, request.EndTime, request.Duration, request.Unit,
-		request.Reason, proofURLsJSON, request.Status, request.CurrentApproverID,
+		request.Reason, proofURLsJSON, dayBreakdownJSON, request.Status, request.CurrentApproverID,
 		request.SubmittedAt, request.Remark,
 		request.CreatedBy, request.UpdatedBy, request.CreatedAt, request.UpdatedAt,
 	)
@@ -54,13 +55,14 @@ func (r *leaveRequestRepo) Create(ctx context.Context, request *model.LeaveReque
 
 func (r *leaveRequestRepo) Update(ctx context.Context, request *model.LeaveRequest) error {
 	proofURLsJSON, _ := json.Marshal(request.ProofURLs)
+	dayBreakdownJSON, _ := json.Marshal(request.DayBreakdown)
 
 	sql := `
 		UPDATE hrm_leave_requests SET
 			start_time = $1, end_time = $2, duration = $3, unit = $4,
 			reason = $5, proof_urls = $6, status = $7, current_approver_id = $8,
 			submitted_at = $9, approved_at = $10, rejected_at = $11, cancelled_at = $12,
-			remark = $13, updated_by = $14, updated_at = $15
+			remark = $13, updated_by = $14, updated_at = $15, day_breakdown = $17
 		WHERE id = $16 AND deleted_at IS NULL
 	`
 
@@ -69,7 +71,7 @@ func (r *leaveRequestRepo) Update(ctx context.Context, request *model.LeaveReque
 		request.Reason, proofURLsJSON, request.Status, request.CurrentApproverID,
 		request.SubmittedAt, request.ApprovedAt, request.RejectedAt, request.CancelledAt,
 		request.Remark, request.UpdatedBy, request.UpdatedAt,
-		request.ID,
+		request.ID, dayBreakdownJSON,
 	)
 
 	return err
@@ -85,7 +87,7 @@ func (r *leaveRequestRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.L
 	sql := `
 		SELECT id, tenant_id, employee_id, employee_name, department_id,
 		       leave_type_id, leave_type_name, start_time, end_time, duration, unit,
-		       reason, proof_urls, status, current_approver_id,
+		       reason, proof_urls, day_breakdown, status, current_approver_id,
 		       submitted_at, approved_at, rejected_at, cancelled_at, remark,
 		       created_by, updated_by, created_at, updated_at, deleted_at
 		FROM hrm_leave_requests
@@ -93,12 +95,12 @@ func (r *leaveRequestRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.L
 	`
 
 	request := &model.LeaveRequest{}
-	var proofURLsJSON []byte
+	var proofURLsJSON, dayBreakdownJSON []byte
 
 	err := r.db.QueryRow(ctx, sql, id).Scan(
 		&request.ID, &request.TenantID, &request.EmployeeID, &request.EmployeeName, &request.DepartmentID,
 		&request.LeaveTypeID, &request.LeaveTypeName, &request.StartTime, &request.EndTime, &request.Duration, &request.Unit,
-		&request.Reason, &proofURLsJSON, &request.Status, &request.CurrentApproverID,
+		&request.Reason, &proofURLsJSON, &dayBreakdownJSON, &request.Status, &request.CurrentApproverID,
 		&request.SubmittedAt, &request.ApprovedAt, &request.RejectedAt, &request.CancelledAt, &request.Remark,
 		&request.CreatedBy, &request.UpdatedBy, &request.CreatedAt, &request.UpdatedAt, &request.DeletedAt,
 	)
@@ -114,6 +116,9 @@ func (r *leaveRequestRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.L
 	if len(proofURLsJSON) > 0 {
 		json.Unmarshal(proofURLsJSON, &request.ProofURLs)
 	}
+	if len(dayBreakdownJSON) > 0 {
+		json.Unmarshal(dayBreakdownJSON, &request.DayBreakdown)
+	}
 
 	return request, nil
 }
@@ -281,7 +286,7 @@ func (r *leaveRequestRepo) ListByEmployee(ctx context.Context, tenantID, employe
 	// 查询数据
 	dataSQL := fmt.Sprintf(`
 		SELECT id, tenant_id, employee_id, employee_name, leave_type_id, leave_type_name,
-		       start_time, end_time, duration, unit, status, submitted_at, created_at
+		       start_time, end_time, duration, unit, day_breakdown, status, submitted_at, created_at
 		FROM hrm_leave_requests
 		WHERE %s
 		ORDER BY created_at DESC
@@ -298,13 +303,17 @@ func (r *leaveRequestRepo) ListByEmployee(ctx context.Context, tenantID, employe
 	var requests []*model.LeaveRequest
 	for rows.Next() {
 		req := &model.LeaveRequest{}
+		var dayBreakdownJSON []byte
 		err := rows.Scan(
 			&req.ID, &req.TenantID, &req.EmployeeID, &req.EmployeeName, &req.LeaveTypeID, &req.LeaveTypeName,
-			&req.StartTime, &req.EndTime, &req.Duration, &req.Unit, &req.Status, &req.SubmittedAt, &req.CreatedAt,
+			&req.StartTime, &req.EndTime, &req.Duration, &req.Unit, &dayBreakdownJSON, &req.Status, &req.SubmittedAt, &req.CreatedAt,
 		)
 		if err != nil {
 			return nil, 0, err
 		}
+		if len(dayBreakdownJSON) > 0 {
+			json.Unmarshal(dayBreakdownJSON, &req.DayBreakdown)
+		}
 		requests = append(requests, req)
 	}
 
@@ -33,27 +33,36 @@ func (r *leaveTypeRepo) Create(ctx context.Context, leaveType *model.LeaveType)
 		}
 	}
 
+	durationPolicyJSON, err := marshalDurationPolicy(leaveType.DurationPolicy)
+	if err != nil {
+		return err
+	}
+	accrualPolicyJSON, err := marshalAccrualPolicy(leaveType.AccrualPolicy)
+	if err != nil {
+		return err
+	}
+
 	sql := `
 		INSERT INTO hrm_leave_types (
 			id, tenant_id, code, name, description,
 			is_paid, requires_approval, requires_proof, deduct_quota,
 			unit, min_duration, max_duration, advance_days,
-			approval_rules, color, is_active, sort,
+			approval_rules, duration_policy, accrual_policy, color, is_active, sort,
 			created_by, updated_by, created_at, updated_at
 		) VALUES (
 			$1, $2, $3, $4, $5,
 			$6, $7, $8, $9,
 			$10, $11, $12, $13,
-			$14, $15, $16, $17,
-			$18, $19, $20, $21
+			$14, $15, $16, $17, $18, $19,
+			$20, $21, $22, $23
 		)
 	`
 
-	_, err := r.db.Exec(ctx, sql,
+	_, err = r.db.Exec(ctx, sql,
 		leaveType.ID, leaveType.TenantID, leaveType.Code, leaveType.Name, leaveType.Description,
 		leaveType.IsPaid, leaveType.RequiresApproval, leaveType.RequiresProof, leaveType.DeductQuota,
 		leaveType.Unit, leaveType.MinDuration, leaveType.MaxDuration, leaveType.AdvanceDays,
-		approvalRulesJSON, leaveType.Color, leaveType.IsActive, leaveType.Sort,
+		approvalRulesJSON, durationPolicyJSON, accrualPolicyJSON, leaveType.Color, leaveType.IsActive, leaveType.Sort,
 		leaveType.CreatedBy, leaveType.UpdatedBy, leaveType.CreatedAt, leaveType.UpdatedAt,
 	)
 
@@ -71,21 +80,30 @@ func (r *leaveTypeRepo) Update(ctx context.Context, leaveType *model.LeaveType)
 		}
 	}
 
+	durationPolicyJSON, err := marshalDurationPolicy(leaveType.DurationPolicy)
+	if err != nil {
+		return err
+	}
+	accrualPolicyJSON, err := marshalAccrualPolicy(leaveType.AccrualPolicy)
+	if err != nil {
+		return err
+	}
+
 	sql := `
 		UPDATE hrm_leave_types SET
 			name = $1, description = $2,
 			is_paid = $3, requires_approval = $4, requires_proof = $5, deduct_quota = $6,
 			unit = $7, min_duration = $8, max_duration = $9, advance_days = $10,
-			approval_rules = $11, color = $12, is_active = $13, sort = $14,
-			updated_by = $15, updated_at = $16
-		WHERE id = $17 AND deleted_at IS NULL
+			approval_rules = $11, duration_policy = $12, accrual_policy = $13, color = $14, is_active = $15, sort = $16,
+			updated_by = $17, updated_at = $18
+		WHERE id = $19 AND deleted_at IS NULL
 	`
 
-	_, err := r.db.Exec(ctx, sql,
+	_, err = r.db.Exec(ctx, sql,
 		leaveType.Name, leaveType.Description,
 		leaveType.IsPaid, leaveType.RequiresApproval, leaveType.RequiresProof, leaveType.DeductQuota,
 		leaveType.Unit, leaveType.MinDuration, leaveType.MaxDuration, leaveType.AdvanceDays,
-		approvalRulesJSON, leaveType.Color, leaveType.IsActive, leaveType.Sort,
+		approvalRulesJSON, durationPolicyJSON, accrualPolicyJSON, leaveType.Color, leaveType.IsActive, leaveType.Sort,
 		leaveType.UpdatedBy, leaveType.UpdatedAt,
 		leaveType.ID,
 	)
@@ -104,20 +122,20 @@ func (r *leaveTypeRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.Leav
 		SELECT id, tenant_id, code, name, description,
 		       is_paid, requires_approval, requires_proof, deduct_quota,
 		       unit, min_duration, max_duration, advance_days,
-		       approval_rules, color, is_active, sort,
+		       approval_rules, duration_policy, accrual_policy, color, is_active, sort,
 		       created_by, updated_by, created_at, updated_at, deleted_at
 		FROM hrm_leave_types
 		WHERE id = $1 AND deleted_at IS NULL
 	`
 
 	leaveType := &model.LeaveType{}
-	var approvalRulesJSON []byte
+	var approvalRulesJSON, durationPolicyJSON, accrualPolicyJSON []byte
 
 	err := r.db.QueryRow(ctx, sql, id).Scan(
 		&leaveType.ID, &leaveType.TenantID, &leaveType.Code, &leaveType.Name, &leaveType.Description,
 		&leaveType.IsPaid, &leaveType.RequiresApproval, &leaveType.RequiresProof, &leaveType.DeductQuota,
 		&leaveType.Unit, &leaveType.MinDuration, &leaveType.MaxDuration, &leaveType.AdvanceDays,
-		&approvalRulesJSON, &leaveType.Color, &leaveType.IsActive, &leaveType.Sort,
+		&approvalRulesJSON, &durationPolicyJSON, &accrualPolicyJSON, &leaveType.Color, &leaveType.IsActive, &leaveType.Sort,
 		&leaveType.CreatedBy, &leaveType.UpdatedBy, &leaveType.CreatedAt, &leaveType.UpdatedAt, &leaveType.DeletedAt,
 	)
 
@@ -136,6 +154,17 @@ func (r *leaveTypeRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.Leav
 		}
 	}
 
+	// 解析时长计算规则
+	if len(durationPolicyJSON) > 0 {
+		var policy model.LeaveDurationPolicy
+		if err := json.Unmarshal(durationPolicyJSON, &policy); err == nil {
+			leaveType.DurationPolicy = &policy
+		}
+	}
+
+	// 解析额度发放规则
+	leaveType.AccrualPolicy = unmarshalAccrualPolicy(accrualPolicyJSON)
+
 	return leaveType, nil
 }
 
@@ -144,20 +173,20 @@ func (r *leaveTypeRepo) FindByCode(ctx context.Context, tenantID uuid.UUID, code
 		SELECT id, tenant_id, code, name, description,
 		       is_paid, requires_approval, requires_proof, deduct_quota,
 		       unit, min_duration, max_duration, advance_days,
-		       approval_rules, color, is_active, sort,
+		       approval_rules, duration_policy, accrual_policy, color, is_active, sort,
 		       created_by, updated_by, created_at, updated_at, deleted_at
 		FROM hrm_leave_types
 		WHERE tenant_id = $1 AND code = $2 AND deleted_at IS NULL
 	`
 
 	leaveType := &model.LeaveType{}
-	var approvalRulesJSON []byte
+	var approvalRulesJSON, durationPolicyJSON, accrualPolicyJSON []byte
 
 	err := r.db.QueryRow(ctx, sql, tenantID, code).Scan(
 		&leaveType.ID, &leaveType.TenantID, &leaveType.Code, &leaveType.Name, &leaveType.Description,
 		&leaveType.IsPaid, &leaveType.RequiresApproval, &leaveType.RequiresProof, &leaveType.DeductQuota,
 		&leaveType.Unit, &leaveType.MinDuration, &leaveType.MaxDuration, &leaveType.AdvanceDays,
-		&approvalRulesJSON, &leaveType.Color, &leaveType.IsActive, &leaveType.Sort,
+		&approvalRulesJSON, &durationPolicyJSON, &accrualPolicyJSON, &leaveType.Color, &leaveType.IsActive, &leaveType.Sort,
 		&leaveType.CreatedBy, &leaveType.UpdatedBy, &leaveType.CreatedAt, &leaveType.UpdatedAt, &leaveType.DeletedAt,
 	)
 
@@ -176,6 +205,17 @@ func (r *leaveTypeRepo) FindByCode(ctx context.Context, tenantID uuid.UUID, code
 		}
 	}
 
+	// 解析时长计算规则
+	if len(durationPolicyJSON) > 0 {
+		var policy model.LeaveDurationPolicy
+		if err := json.Unmarshal(durationPolicyJSON, &policy); err == nil {
+			leaveType.DurationPolicy = &policy
+		}
+	}
+
+	// 解析额度发放规则
+	leaveType.AccrualPolicy = unmarshalAccrualPolicy(accrualPolicyJSON)
+
 	return leaveType, nil
 }
 
@@ -254,7 +294,7 @@ func (r *leaveTypeRepo) ListActive(ctx context.Context, tenantID uuid.UUID) ([]*
 		SELECT id, tenant_id, code, name, description,
 		       is_paid, requires_approval, requires_proof, deduct_quota,
 		       unit, min_duration, max_duration, advance_days,
-		       color, sort
+		       accrual_policy, color, sort
 		FROM hrm_leave_types
 		WHERE tenant_id = $1 AND is_active = true AND deleted_at IS NULL
 		ORDER BY sort ASC, created_at DESC
@@ -269,15 +309,17 @@ func (r *leaveTypeRepo) ListActive(ctx context.Context, tenantID uuid.UUID) ([]*
 	var leaveTypes []*model.LeaveType
 	for rows.Next() {
 		lt := &model.LeaveType{}
+		var accrualPolicyJSON []byte
 		err := rows.Scan(
 			&lt.ID, &lt.TenantID, &lt.Code, &lt.Name, &lt.Description,
 			&lt.IsPaid, &lt.RequiresApproval, &lt.RequiresProof, &lt.DeductQuota,
 			&lt.Unit, &lt.MinDuration, &lt.MaxDuration, &lt.AdvanceDays,
-			&lt.Color, &lt.Sort,
+			&accrualPolicyJSON, &lt.Color, &lt.Sort,
 		)
 		if err != nil {
 			return nil, err
 		}
+		lt.AccrualPolicy = unmarshalAccrualPolicy(accrualPolicyJSON)
 		leaveTypes = append(leaveTypes, lt)
 	}
 
@@ -380,3 +422,39 @@ func (r *leaveTypeRepo) ListWithCursor(
 
 	return leaveTypes, nextCursor, hasNext, nil
 }
+
+// marshalDurationPolicy 序列化时长计算规则，为空时存 NULL
+func marshalDurationPolicy(policy *model.LeaveDurationPolicy) ([]byte, error) {
+	if policy == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(policy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal duration policy: %w", err)
+	}
+	return data, nil
+}
+
+// marshalAccrualPolicy 序列化额度发放规则，为空时存 NULL
+func marshalAccrualPolicy(policy *model.LeaveAccrualPolicy) ([]byte, error) {
+	if policy == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(policy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal accrual policy: %w", err)
+	}
+	return data, nil
+}
+
+// unmarshalAccrualPolicy 解析额度发放规则，空值或格式错误时返回 nil
+func unmarshalAccrualPolicy(data []byte) *model.LeaveAccrualPolicy {
+	if len(data) == 0 {
+		return nil
+	}
+	var policy model.LeaveAccrualPolicy
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return nil
+	}
+	return &policy
+}
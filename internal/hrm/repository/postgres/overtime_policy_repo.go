@@ -0,0 +1,178 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/database"
+)
+
+type overtimePolicyRepo struct {
+	db *database.DB
+}
+
+// NewOvertimePolicyRepository 创建加班政策仓储
+func NewOvertimePolicyRepository(db *database.DB) repository.OvertimePolicyRepository {
+	return &overtimePolicyRepo{db: db}
+}
+
+const overtimePolicyColumns = `
+	id, tenant_id, code, name, COALESCE(description, ''), rules,
+	hours_per_day, min_duration_minutes, unit_minutes, rounding,
+	monthly_cap_hours, yearly_cap_hours, block_over_cap,
+	auto_detect, auto_require_approval, is_default, is_active,
+	created_by, updated_by, created_at, updated_at, deleted_at
+`
+
+func (r *overtimePolicyRepo) Create(ctx context.Context, policy *model.OvertimePolicy) error {
+	rules, err := json.Marshal(policy.Rules)
+	if err != nil {
+		return fmt.Errorf("failed to marshal overtime rules: %w", err)
+	}
+
+	sql := `
+		INSERT INTO hrm_overtime_policies (
+			id, tenant_id, code, name, description, rules,
+			hours_per_day, min_duration_minutes, unit_minutes, rounding,
+			monthly_cap_hours, yearly_cap_hours, block_over_cap,
+			auto_detect, auto_require_approval, is_default, is_active,
+			created_by, updated_by, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21)
+	`
+
+	_, err = r.db.Exec(ctx, sql,
+		policy.ID, policy.TenantID, policy.Code, policy.Name, policy.Description, rules,
+		policy.HoursPerDay, policy.MinDurationMinutes, policy.UnitMinutes, policy.Rounding,
+		policy.MonthlyCapHours, policy.YearlyCapHours, policy.BlockOverCap,
+		policy.AutoDetect, policy.AutoRequireApproval, policy.IsDefault, policy.IsActive,
+		policy.CreatedBy, policy.UpdatedBy, policy.CreatedAt, policy.UpdatedAt,
+	)
+
+	return err
+}
+
+func (r *overtimePolicyRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.OvertimePolicy, error) {
+	sql := `SELECT ` + overtimePolicyColumns + ` FROM hrm_overtime_policies WHERE id = $1 AND deleted_at IS NULL`
+
+	return scanOvertimePolicy(r.db.QueryRow(ctx, sql, id))
+}
+
+func (r *overtimePolicyRepo) FindByCode(ctx context.Context, tenantID uuid.UUID, code string) (*model.OvertimePolicy, error) {
+	sql := `
+		SELECT ` + overtimePolicyColumns + `
+		FROM hrm_overtime_policies
+		WHERE tenant_id = $1 AND code = $2 AND deleted_at IS NULL
+	`
+
+	return scanOvertimePolicy(r.db.QueryRow(ctx, sql, tenantID, code))
+}
+
+func (r *overtimePolicyRepo) FindDefault(ctx context.Context, tenantID uuid.UUID) (*model.OvertimePolicy, error) {
+	sql := `
+		SELECT ` + overtimePolicyColumns + `
+		FROM hrm_overtime_policies
+		WHERE tenant_id = $1 AND is_default = TRUE AND is_active = TRUE AND deleted_at IS NULL
+		LIMIT 1
+	`
+
+	return scanOvertimePolicy(r.db.QueryRow(ctx, sql, tenantID))
+}
+
+func (r *overtimePolicyRepo) Update(ctx context.Context, policy *model.OvertimePolicy) error {
+	rules, err := json.Marshal(policy.Rules)
+	if err != nil {
+		return fmt.Errorf("failed to marshal overtime rules: %w", err)
+	}
+
+	sql := `
+		UPDATE hrm_overtime_policies SET
+			name = $1, description = $2, rules = $3,
+			hours_per_day = $4, min_duration_minutes = $5, unit_minutes = $6, rounding = $7,
+			monthly_cap_hours = $8, yearly_cap_hours = $9, block_over_cap = $10,
+			auto_detect = $11, auto_require_approval = $12, is_default = $13, is_active = $14,
+			updated_by = $15, updated_at = $16
+		WHERE id = $17 AND deleted_at IS NULL
+	`
+
+	_, err = r.db.Exec(ctx, sql,
+		policy.Name, policy.Description, rules,
+		policy.HoursPerDay, policy.MinDurationMinutes, policy.UnitMinutes, policy.Rounding,
+		policy.MonthlyCapHours, policy.YearlyCapHours, policy.BlockOverCap,
+		policy.AutoDetect, policy.AutoRequireApproval, policy.IsDefault, policy.IsActive,
+		policy.UpdatedBy, policy.UpdatedAt,
+		policy.ID,
+	)
+
+	return err
+}
+
+func (r *overtimePolicyRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	sql := `UPDATE hrm_overtime_policies SET deleted_at = NOW(), is_default = FALSE WHERE id = $1`
+	_, err := r.db.Exec(ctx, sql, id)
+	return err
+}
+
+func (r *overtimePolicyRepo) List(ctx context.Context, tenantID uuid.UUID) ([]*model.OvertimePolicy, error) {
+	sql := `
+		SELECT ` + overtimePolicyColumns + `
+		FROM hrm_overtime_policies
+		WHERE tenant_id = $1 AND deleted_at IS NULL
+		ORDER BY is_default DESC, code ASC
+	`
+
+	rows, err := r.db.Query(ctx, sql, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var policies []*model.OvertimePolicy
+	for rows.Next() {
+		policy, err := scanOvertimePolicy(rows)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, policy)
+	}
+
+	return policies, rows.Err()
+}
+
+func (r *overtimePolicyRepo) ClearDefault(ctx context.Context, tenantID, exceptID uuid.UUID) error {
+	sql := `
+		UPDATE hrm_overtime_policies SET is_default = FALSE
+		WHERE tenant_id = $1 AND id <> $2 AND is_default = TRUE
+	`
+	_, err := r.db.Exec(ctx, sql, tenantID, exceptID)
+	return err
+}
+
+func scanOvertimePolicy(row pgx.Row) (*model.OvertimePolicy, error) {
+	policy := &model.OvertimePolicy{}
+	var rules []byte
+	err := row.Scan(
+		&policy.ID, &policy.TenantID, &policy.Code, &policy.Name, &policy.Description, &rules,
+		&policy.HoursPerDay, &policy.MinDurationMinutes, &policy.UnitMinutes, &policy.Rounding,
+		&policy.MonthlyCapHours, &policy.YearlyCapHours, &policy.BlockOverCap,
+		&policy.AutoDetect, &policy.AutoRequireApproval, &policy.IsDefault, &policy.IsActive,
+		&policy.CreatedBy, &policy.UpdatedBy, &policy.CreatedAt, &policy.UpdatedAt, &policy.DeletedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("overtime policy not found")
+		}
+		return nil, err
+	}
+
+	if len(rules) > 0 {
+		if err := json.Unmarshal(rules, &policy.Rules); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal overtime rules: %w", err)
+		}
+	}
+	return policy, nil
+}
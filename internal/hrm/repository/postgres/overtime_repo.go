@@ -27,17 +27,22 @@ func (r *overtimeRepository) Create(ctx context.Context, overtime *model.Overtim
 		INSERT INTO hrm_overtimes (
 			id, tenant_id, employee_id, employee_name, department_id,
 			start_time, end_time, duration, overtime_type, pay_type, pay_rate,
-			reason, tasks, approval_status, comp_off_days, comp_off_used,
+			reason, tasks, approval_status,
+			policy_id, billable_hours, warnings, source, attendance_record_id,
+			comp_off_days, comp_off_used,
 			remark, created_at, updated_at
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19,
+			$20, $21, $22, $23, $24
 		)
 	`
 
 	_, err := r.db.Exec(ctx, query,
 		overtime.ID, overtime.TenantID, overtime.EmployeeID, overtime.EmployeeName, overtime.DepartmentID,
 		overtime.StartTime, overtime.EndTime, overtime.Duration, overtime.OvertimeType, overtime.PayType, overtime.PayRate,
-		overtime.Reason, overtime.Tasks, overtime.ApprovalStatus, overtime.CompOffDays, overtime.CompOffUsed,
+		overtime.Reason, overtime.Tasks, overtime.ApprovalStatus,
+		overtime.PolicyID, overtime.BillableHours, overtime.Warnings, overtime.Source, overtime.AttendanceRecordID,
+		overtime.CompOffDays, overtime.CompOffUsed,
 		overtime.Remark, overtime.CreatedAt, overtime.UpdatedAt,
 	)
 
@@ -50,6 +55,7 @@ func (r *overtimeRepository) FindByID(ctx context.Context, id uuid.UUID) (*model
 		SELECT id, tenant_id, employee_id, employee_name, department_id,
 			start_time, end_time, duration, overtime_type, pay_type, pay_rate,
 			reason, tasks, approval_id, approval_status, approved_by, approved_at, reject_reason,
+			policy_id, COALESCE(billable_hours, duration), warnings, source, attendance_record_id,
 			comp_off_days, comp_off_used, comp_off_expire_at,
 			remark, created_at, updated_at, deleted_at
 		FROM hrm_overtimes
@@ -61,6 +67,7 @@ func (r *overtimeRepository) FindByID(ctx context.Context, id uuid.UUID) (*model
 		&overtime.ID, &overtime.TenantID, &overtime.EmployeeID, &overtime.EmployeeName, &overtime.DepartmentID,
 		&overtime.StartTime, &overtime.EndTime, &overtime.Duration, &overtime.OvertimeType, &overtime.PayType, &overtime.PayRate,
 		&overtime.Reason, &overtime.Tasks, &overtime.ApprovalID, &overtime.ApprovalStatus, &overtime.ApprovedBy, &overtime.ApprovedAt, &overtime.RejectReason,
+		&overtime.PolicyID, &overtime.BillableHours, &overtime.Warnings, &overtime.Source, &overtime.AttendanceRecordID,
 		&overtime.CompOffDays, &overtime.CompOffUsed, &overtime.CompOffExpireAt,
 		&overtime.Remark, &overtime.CreatedAt, &overtime.UpdatedAt, &overtime.DeletedAt,
 	)
@@ -95,7 +102,10 @@ func (r *overtimeRepository) Update(ctx context.Context, overtime *model.Overtim
 			comp_off_used = $18,
 			comp_off_expire_at = $19,
 			remark = $20,
-			updated_at = $21
+			updated_at = $21,
+			policy_id = $22,
+			billable_hours = $23,
+			warnings = $24
 		WHERE id = $1 AND deleted_at IS NULL
 	`
 
@@ -105,6 +115,7 @@ func (r *overtimeRepository) Update(ctx context.Context, overtime *model.Overtim
 		overtime.Reason, overtime.Tasks, overtime.ApprovalID, overtime.ApprovalStatus, overtime.ApprovedBy, overtime.ApprovedAt, overtime.RejectReason,
 		overtime.CompOffDays, overtime.CompOffUsed, overtime.CompOffExpireAt,
 		overtime.Remark, overtime.UpdatedAt,
+		overtime.PolicyID, overtime.BillableHours, overtime.Warnings,
 	)
 
 	return err
@@ -176,6 +187,7 @@ func (r *overtimeRepository) List(ctx context.Context, tenantID uuid.UUID, filte
 		SELECT id, tenant_id, employee_id, employee_name, department_id,
 			start_time, end_time, duration, overtime_type, pay_type, pay_rate,
 			reason, tasks, approval_id, approval_status, approved_by, approved_at, reject_reason,
+			policy_id, COALESCE(billable_hours, duration), warnings, source, attendance_record_id,
 			comp_off_days, comp_off_used, comp_off_expire_at,
 			remark, created_at, updated_at, deleted_at
 		FROM hrm_overtimes
@@ -199,6 +211,7 @@ func (r *overtimeRepository) List(ctx context.Context, tenantID uuid.UUID, filte
 			&overtime.ID, &overtime.TenantID, &overtime.EmployeeID, &overtime.EmployeeName, &overtime.DepartmentID,
 			&overtime.StartTime, &overtime.EndTime, &overtime.Duration, &overtime.OvertimeType, &overtime.PayType, &overtime.PayRate,
 			&overtime.Reason, &overtime.Tasks, &overtime.ApprovalID, &overtime.ApprovalStatus, &overtime.ApprovedBy, &overtime.ApprovedAt, &overtime.RejectReason,
+			&overtime.PolicyID, &overtime.BillableHours, &overtime.Warnings, &overtime.Source, &overtime.AttendanceRecordID,
 			&overtime.CompOffDays, &overtime.CompOffUsed, &overtime.CompOffExpireAt,
 			&overtime.Remark, &overtime.CreatedAt, &overtime.UpdatedAt, &overtime.DeletedAt,
 		)
@@ -217,6 +230,7 @@ func (r *overtimeRepository) FindByEmployee(ctx context.Context, tenantID, emplo
 		SELECT id, tenant_id, employee_id, employee_name, department_id,
 			start_time, end_time, duration, overtime_type, pay_type, pay_rate,
 			reason, tasks, approval_id, approval_status, approved_by, approved_at, reject_reason,
+			policy_id, COALESCE(billable_hours, duration), warnings, source, attendance_record_id,
 			comp_off_days, comp_off_used, comp_off_expire_at,
 			remark, created_at, updated_at, deleted_at
 		FROM hrm_overtimes
@@ -239,6 +253,7 @@ func (r *overtimeRepository) FindByEmployee(ctx context.Context, tenantID, emplo
 			&overtime.ID, &overtime.TenantID, &overtime.EmployeeID, &overtime.EmployeeName, &overtime.DepartmentID,
 			&overtime.StartTime, &overtime.EndTime, &overtime.Duration, &overtime.OvertimeType, &overtime.PayType, &overtime.PayRate,
 			&overtime.Reason, &overtime.Tasks, &overtime.ApprovalID, &overtime.ApprovalStatus, &overtime.ApprovedBy, &overtime.ApprovedAt, &overtime.RejectReason,
+			&overtime.PolicyID, &overtime.BillableHours, &overtime.Warnings, &overtime.Source, &overtime.AttendanceRecordID,
 			&overtime.CompOffDays, &overtime.CompOffUsed, &overtime.CompOffExpireAt,
 			&overtime.Remark, &overtime.CreatedAt, &overtime.UpdatedAt, &overtime.DeletedAt,
 		)
@@ -257,6 +272,7 @@ func (r *overtimeRepository) FindPending(ctx context.Context, tenantID uuid.UUID
 		SELECT id, tenant_id, employee_id, employee_name, department_id,
 			start_time, end_time, duration, overtime_type, pay_type, pay_rate,
 			reason, tasks, approval_id, approval_status, approved_by, approved_at, reject_reason,
+			policy_id, COALESCE(billable_hours, duration), warnings, source, attendance_record_id,
 			comp_off_days, comp_off_used, comp_off_expire_at,
 			remark, created_at, updated_at, deleted_at
 		FROM hrm_overtimes
@@ -277,6 +293,7 @@ func (r *overtimeRepository) FindPending(ctx context.Context, tenantID uuid.UUID
 			&overtime.ID, &overtime.TenantID, &overtime.EmployeeID, &overtime.EmployeeName, &overtime.DepartmentID,
 			&overtime.StartTime, &overtime.EndTime, &overtime.Duration, &overtime.OvertimeType, &overtime.PayType, &overtime.PayRate,
 			&overtime.Reason, &overtime.Tasks, &overtime.ApprovalID, &overtime.ApprovalStatus, &overtime.ApprovedBy, &overtime.ApprovedAt, &overtime.RejectReason,
+			&overtime.PolicyID, &overtime.BillableHours, &overtime.Warnings, &overtime.Source, &overtime.AttendanceRecordID,
 			&overtime.CompOffDays, &overtime.CompOffUsed, &overtime.CompOffExpireAt,
 			&overtime.Remark, &overtime.CreatedAt, &overtime.UpdatedAt, &overtime.DeletedAt,
 		)
@@ -321,3 +338,34 @@ func (r *overtimeRepository) SumCompOffDays(ctx context.Context, tenantID, emplo
 	err := r.db.QueryRow(ctx, query, tenantID, employeeID).Scan(&total)
 	return total, err
 }
+
+// SumCommittedHours 统计员工已批准和待审批的计薪加班时长（用于上限校验）
+func (r *overtimeRepository) SumCommittedHours(ctx context.Context, tenantID, employeeID uuid.UUID, startDate, endDate time.Time, excludeID *uuid.UUID) (float64, error) {
+	query := `
+		SELECT COALESCE(SUM(COALESCE(billable_hours, duration)), 0)
+		FROM hrm_overtimes
+		WHERE tenant_id = $1 AND employee_id = $2
+			AND start_time >= $3 AND start_time < $4
+			AND approval_status IN ('pending', 'approved')
+			AND ($5::uuid IS NULL OR id <> $5)
+			AND deleted_at IS NULL
+	`
+
+	var total float64
+	err := r.db.QueryRow(ctx, query, tenantID, employeeID, startDate, endDate, excludeID).Scan(&total)
+	return total, err
+}
+
+// ExistsByAttendanceRecord 下班打卡是否已生成加班记录
+func (r *overtimeRepository) ExistsByAttendanceRecord(ctx context.Context, recordID uuid.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM hrm_overtimes
+			WHERE attendance_record_id = $1 AND deleted_at IS NULL
+		)
+	`
+
+	var exists bool
+	err := r.db.QueryRow(ctx, query, recordID).Scan(&exists)
+	return exists, err
+}
@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/database"
+)
+
+type payrollRunRepo struct {
+	db *database.DB
+}
+
+// NewPayrollRunRepository 创建薪资对接导出记录仓储
+func NewPayrollRunRepository(db *database.DB) repository.PayrollRunRepository {
+	return &payrollRunRepo{db: db}
+}
+
+const payrollRunColumns = `
+	id, tenant_id, year, month, run_no, schema_version, exporter, employee_count, checksum,
+	file_id, COALESCE(filename, ''), previous_run_id, diff, created_by, created_at
+`
+
+func (r *payrollRunRepo) Create(ctx context.Context, run *model.PayrollRun) error {
+	diff, err := json.Marshal(run.Diff)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payroll diff: %w", err)
+	}
+	employees, err := json.Marshal(run.Employees)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payroll employees: %w", err)
+	}
+
+	sql := `
+		INSERT INTO hrm_payroll_runs (
+			id, tenant_id, year, month, run_no, schema_version, exporter, employee_count, checksum,
+			file_id, filename, previous_run_id, diff, employees, created_by, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+	`
+
+	_, err = r.db.Exec(ctx, sql,
+		run.ID, run.TenantID, run.Year, run.Month, run.RunNo, run.SchemaVersion, run.Exporter, run.EmployeeCount, run.Checksum,
+		run.FileID, run.Filename, run.PreviousRunID, diff, employees, run.CreatedBy, run.CreatedAt,
+	)
+	return err
+}
+
+func (r *payrollRunRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (*model.PayrollRun, error) {
+	sql := `SELECT ` + payrollRunColumns + `, employees FROM hrm_payroll_runs WHERE tenant_id = $1 AND id = $2`
+
+	return scanPayrollRun(r.db.QueryRow(ctx, sql, tenantID, id), true)
+}
+
+func (r *payrollRunRepo) FindLatest(ctx context.Context, tenantID uuid.UUID, year, month int) (*model.PayrollRun, error) {
+	sql := `
+		SELECT ` + payrollRunColumns + `, employees FROM hrm_payroll_runs
+		WHERE tenant_id = $1 AND year = $2 AND month = $3
+		ORDER BY run_no DESC
+		LIMIT 1
+	`
+
+	return scanPayrollRun(r.db.QueryRow(ctx, sql, tenantID, year, month), true)
+}
+
+func (r *payrollRunRepo) List(ctx context.Context, tenantID uuid.UUID, year, month int, offset, limit int) ([]*model.PayrollRun, int, error) {
+	where := "tenant_id = $1"
+	args := []interface{}{tenantID}
+	if year > 0 {
+		args = append(args, year)
+		where += fmt.Sprintf(" AND year = $%d", len(args))
+	}
+	if month > 0 {
+		args = append(args, month)
+		where += fmt.Sprintf(" AND month = $%d", len(args))
+	}
+
+	var total int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM hrm_payroll_runs WHERE `+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	args = append(args, limit, offset)
+	sql := fmt.Sprintf(`
+		SELECT `+payrollRunColumns+` FROM hrm_payroll_runs
+		WHERE %s
+		ORDER BY created_at DESC, id DESC
+		LIMIT $%d OFFSET $%d
+	`, where, len(args)-1, len(args))
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var runs []*model.PayrollRun
+	for rows.Next() {
+		run, err := scanPayrollRun(rows, false)
+		if err != nil {
+			return nil, 0, err
+		}
+		runs = append(runs, run)
+	}
+	return runs, total, rows.Err()
+}
+
+func scanPayrollRun(row pgx.Row, withEmployees bool) (*model.PayrollRun, error) {
+	run := &model.PayrollRun{}
+	var diff, employees []byte
+	dest := []interface{}{
+		&run.ID, &run.TenantID, &run.Year, &run.Month, &run.RunNo, &run.SchemaVersion, &run.Exporter, &run.EmployeeCount, &run.Checksum,
+		&run.FileID, &run.Filename, &run.PreviousRunID, &diff, &run.CreatedBy, &run.CreatedAt,
+	}
+	if withEmployees {
+		dest = append(dest, &employees)
+	}
+	if err := row.Scan(dest...); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, repository.ErrPayrollRunNotFound
+		}
+		return nil, err
+	}
+
+	if len(diff) > 0 && string(diff) != "null" {
+		if err := json.Unmarshal(diff, &run.Diff); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal payroll diff: %w", err)
+		}
+	}
+	if len(employees) > 0 {
+		if err := json.Unmarshal(employees, &run.Employees); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal payroll employees: %w", err)
+		}
+	}
+	return run, nil
+}
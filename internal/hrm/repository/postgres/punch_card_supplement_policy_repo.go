@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/database"
+)
+
+type punchCardSupplementPolicyRepo struct {
+	db *database.DB
+}
+
+// NewPunchCardSupplementPolicyRepository 创建补卡政策仓储
+func NewPunchCardSupplementPolicyRepository(db *database.DB) repository.PunchCardSupplementPolicyRepository {
+	return &punchCardSupplementPolicyRepo{db: db}
+}
+
+func (r *punchCardSupplementPolicyRepo) FindByTenant(ctx context.Context, tenantID uuid.UUID) (*model.PunchCardSupplementPolicy, error) {
+	sql := `
+		SELECT id, tenant_id, max_per_month, filing_deadline_days, allowed_missing_types,
+			updated_by, created_at, updated_at
+		FROM hrm_punch_card_supplement_policies
+		WHERE tenant_id = $1
+	`
+
+	policy := &model.PunchCardSupplementPolicy{}
+	var allowedTypes []byte
+	var updatedBy *uuid.UUID
+	err := r.db.QueryRow(ctx, sql, tenantID).Scan(
+		&policy.ID, &policy.TenantID, &policy.MaxPerMonth, &policy.FilingDeadlineDays, &allowedTypes,
+		&updatedBy, &policy.CreatedAt, &policy.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if updatedBy != nil {
+		policy.UpdatedBy = *updatedBy
+	}
+	if len(allowedTypes) > 0 {
+		if err := json.Unmarshal(allowedTypes, &policy.AllowedMissingTypes); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal allowed missing types: %w", err)
+		}
+	}
+	return policy, nil
+}
+
+func (r *punchCardSupplementPolicyRepo) Save(ctx context.Context, policy *model.PunchCardSupplementPolicy) error {
+	allowedTypes, err := json.Marshal(policy.AllowedMissingTypes)
+	if err != nil {
+		return fmt.Errorf("failed to marshal allowed missing types: %w", err)
+	}
+
+	sql := `
+		INSERT INTO hrm_punch_card_supplement_policies (
+			id, tenant_id, max_per_month, filing_deadline_days, allowed_missing_types,
+			updated_by, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (tenant_id) DO UPDATE SET
+			max_per_month = EXCLUDED.max_per_month,
+			filing_deadline_days = EXCLUDED.filing_deadline_days,
+			allowed_missing_types = EXCLUDED.allowed_missing_types,
+			updated_by = EXCLUDED.updated_by,
+			updated_at = EXCLUDED.updated_at
+		RETURNING id, created_at
+	`
+
+	return r.db.QueryRow(ctx, sql,
+		policy.ID, policy.TenantID, policy.MaxPerMonth, policy.FilingDeadlineDays, allowedTypes,
+		policy.UpdatedBy, policy.CreatedAt, policy.UpdatedAt,
+	).Scan(&policy.ID, &policy.CreatedAt)
+}
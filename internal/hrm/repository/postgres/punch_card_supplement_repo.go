@@ -2,12 +2,12 @@ package postgres
 
 import (
 	"context"
-	"database/sql"
 	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
 	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
 	"github.com/lk2023060901/go-next-erp/pkg/database"
@@ -32,7 +32,7 @@ func (r *PunchCardSupplementRepo) Create(ctx context.Context, supplement *model.
 	}
 
 	query := `
-		INSERT INTO hrm.punch_card_supplements (
+		INSERT INTO hrm_punch_card_supplements (
 			id, tenant_id, employee_id, employee_name, department_id,
 			supplement_date, supplement_type, supplement_time,
 			missing_type, reason, evidence,
@@ -80,7 +80,7 @@ func (r *PunchCardSupplementRepo) FindByID(ctx context.Context, id uuid.UUID) (*
 			approval_id, approval_status, approved_by, approved_at, reject_reason,
 			process_status, processed_at, processed_by,
 			remark, created_at, updated_at, deleted_at
-		FROM hrm.punch_card_supplements
+		FROM hrm_punch_card_supplements
 		WHERE id = $1 AND deleted_at IS NULL
 	`
 
@@ -98,7 +98,7 @@ func (r *PunchCardSupplementRepo) FindByID(ctx context.Context, id uuid.UUID) (*
 	)
 
 	if err != nil {
-		if err == sql.ErrNoRows {
+		if err == pgx.ErrNoRows {
 			return nil, fmt.Errorf("punch card supplement not found")
 		}
 		return nil, err
@@ -123,7 +123,7 @@ func (r *PunchCardSupplementRepo) Update(ctx context.Context, supplement *model.
 	}
 
 	query := `
-		UPDATE hrm.punch_card_supplements SET
+		UPDATE hrm_punch_card_supplements SET
 			supplement_date = $2,
 			supplement_type = $3,
 			supplement_time = $4,
@@ -162,7 +162,7 @@ func (r *PunchCardSupplementRepo) Update(ctx context.Context, supplement *model.
 // Delete 删除补卡申请（软删除）
 func (r *PunchCardSupplementRepo) Delete(ctx context.Context, id uuid.UUID) error {
 	query := `
-		UPDATE hrm.punch_card_supplements 
+		UPDATE hrm_punch_card_supplements 
 		SET deleted_at = $2, updated_at = $2
 		WHERE id = $1 AND deleted_at IS NULL
 	`
@@ -241,7 +241,7 @@ func (r *PunchCardSupplementRepo) List(ctx context.Context, tenantID uuid.UUID,
 	}
 
 	// 查询总数
-	countQuery := "SELECT COUNT(*) FROM hrm.punch_card_supplements " + whereClause
+	countQuery := "SELECT COUNT(*) FROM hrm_punch_card_supplements " + whereClause
 	var total int
 	if err := r.db.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
 		return nil, 0, err
@@ -257,7 +257,7 @@ func (r *PunchCardSupplementRepo) List(ctx context.Context, tenantID uuid.UUID,
 			approval_id, approval_status, approved_by, approved_at, reject_reason,
 			process_status, processed_at, processed_by,
 			remark, created_at, updated_at
-		FROM hrm.punch_card_supplements
+		FROM hrm_punch_card_supplements
 		` + whereClause + `
 		ORDER BY supplement_date DESC, created_at DESC
 		LIMIT $` + fmt.Sprintf("%d", argIndex) + ` OFFSET $` + fmt.Sprintf("%d", argIndex+1)
@@ -312,7 +312,7 @@ func (r *PunchCardSupplementRepo) FindByEmployee(ctx context.Context, tenantID,
 			approval_id, approval_status, approved_by, approved_at, reject_reason,
 			process_status, processed_at, processed_by,
 			remark, created_at, updated_at
-		FROM hrm.punch_card_supplements
+		FROM hrm_punch_card_supplements
 		WHERE tenant_id = $1 
 			AND employee_id = $2 
 			AND EXTRACT(YEAR FROM supplement_date) = $3
@@ -368,7 +368,7 @@ func (r *PunchCardSupplementRepo) FindPending(ctx context.Context, tenantID uuid
 			approval_id, approval_status, approved_by, approved_at, reject_reason,
 			process_status, processed_at, processed_by,
 			remark, created_at, updated_at
-		FROM hrm.punch_card_supplements
+		FROM hrm_punch_card_supplements
 		WHERE tenant_id = $1 
 			AND approval_status = 'pending'
 			AND deleted_at IS NULL
@@ -423,7 +423,7 @@ func (r *PunchCardSupplementRepo) FindByDate(ctx context.Context, tenantID, empl
 			approval_id, approval_status, approved_by, approved_at, reject_reason,
 			process_status, processed_at, processed_by,
 			remark, created_at, updated_at
-		FROM hrm.punch_card_supplements
+		FROM hrm_punch_card_supplements
 		WHERE tenant_id = $1 
 			AND employee_id = $2 
 			AND supplement_date = $3
@@ -448,7 +448,7 @@ func (r *PunchCardSupplementRepo) FindByDate(ctx context.Context, tenantID, empl
 	)
 
 	if err != nil {
-		if err == sql.ErrNoRows {
+		if err == pgx.ErrNoRows {
 			return nil, nil // 未找到返回nil，不是错误
 		}
 		return nil, err
@@ -464,15 +464,17 @@ func (r *PunchCardSupplementRepo) FindByDate(ctx context.Context, tenantID, empl
 	return supplement, nil
 }
 
-// CountByEmployee 统计员工补卡次数
+// CountByEmployee 统计员工补卡次数（不含已拒绝、已取消的申请）
 func (r *PunchCardSupplementRepo) CountByEmployee(ctx context.Context, tenantID, employeeID uuid.UUID, startDate, endDate time.Time) (int, error) {
 	query := `
 		SELECT COUNT(*)
-		FROM hrm.punch_card_supplements
+		FROM hrm_punch_card_supplements
 		WHERE tenant_id = $1 
 			AND employee_id = $2 
 			AND supplement_date >= $3 
 			AND supplement_date < $4
+			AND approval_status != 'rejected'
+			AND process_status != 'cancelled'
 			AND deleted_at IS NULL
 	`
 
@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/database"
+)
+
+type reportExportRepo struct {
+	db *database.DB
+}
+
+// NewReportExportRepository 创建报表导出任务仓储
+func NewReportExportRepository(db *database.DB) repository.ReportExportRepository {
+	return &reportExportRepo{db: db}
+}
+
+const reportExportColumns = `
+	id, tenant_id, report_type, format, locale, columns, department_id, year, month,
+	status, row_count, file_id, COALESCE(filename, ''), COALESCE(error, ''), requested_by,
+	started_at, finished_at, created_at, updated_at
+`
+
+func (r *reportExportRepo) Create(ctx context.Context, export *model.ReportExport) error {
+	columns, err := json.Marshal(export.Columns)
+	if err != nil {
+		return fmt.Errorf("failed to marshal export columns: %w", err)
+	}
+
+	sql := `
+		INSERT INTO hrm_report_exports (
+			id, tenant_id, report_type, format, locale, columns, department_id, year, month,
+			status, row_count, file_id, filename, error, requested_by,
+			started_at, finished_at, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
+	`
+
+	_, err = r.db.Exec(ctx, sql,
+		export.ID, export.TenantID, export.ReportType, export.Format, export.Locale, columns, export.DepartmentID, export.Year, export.Month,
+		export.Status, export.RowCount, export.FileID, export.Filename, export.Error, export.RequestedBy,
+		export.StartedAt, export.FinishedAt, export.CreatedAt, export.UpdatedAt,
+	)
+	return err
+}
+
+func (r *reportExportRepo) Update(ctx context.Context, export *model.ReportExport) error {
+	sql := `
+		UPDATE hrm_report_exports SET
+			status = $1, row_count = $2, file_id = $3, filename = $4, error = $5,
+			started_at = $6, finished_at = $7, updated_at = $8
+		WHERE id = $9
+	`
+
+	_, err := r.db.Exec(ctx, sql,
+		export.Status, export.RowCount, export.FileID, export.Filename, export.Error,
+		export.StartedAt, export.FinishedAt, export.UpdatedAt,
+		export.ID,
+	)
+	return err
+}
+
+func (r *reportExportRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (*model.ReportExport, error) {
+	sql := `SELECT ` + reportExportColumns + ` FROM hrm_report_exports WHERE tenant_id = $1 AND id = $2`
+
+	return scanReportExport(r.db.QueryRow(ctx, sql, tenantID, id))
+}
+
+func (r *reportExportRepo) List(ctx context.Context, tenantID uuid.UUID, offset, limit int) ([]*model.ReportExport, int, error) {
+	var total int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM hrm_report_exports WHERE tenant_id = $1`, tenantID).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	sql := `
+		SELECT ` + reportExportColumns + ` FROM hrm_report_exports
+		WHERE tenant_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	exports, err := r.queryExports(ctx, sql, tenantID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return exports, total, nil
+}
+
+func (r *reportExportRepo) ListStale(ctx context.Context, before time.Time, limit int) ([]*model.ReportExport, error) {
+	sql := `
+		SELECT ` + reportExportColumns + ` FROM hrm_report_exports
+		WHERE status IN ('pending', 'running') AND updated_at < $1
+		ORDER BY created_at
+		LIMIT $2
+	`
+
+	return r.queryExports(ctx, sql, before, limit)
+}
+
+func (r *reportExportRepo) queryExports(ctx context.Context, sql string, args ...interface{}) ([]*model.ReportExport, error) {
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var exports []*model.ReportExport
+	for rows.Next() {
+		export, err := scanReportExport(rows)
+		if err != nil {
+			return nil, err
+		}
+		exports = append(exports, export)
+	}
+	return exports, rows.Err()
+}
+
+func scanReportExport(row pgx.Row) (*model.ReportExport, error) {
+	export := &model.ReportExport{}
+	var columns []byte
+	err := row.Scan(
+		&export.ID, &export.TenantID, &export.ReportType, &export.Format, &export.Locale, &columns, &export.DepartmentID, &export.Year, &export.Month,
+		&export.Status, &export.RowCount, &export.FileID, &export.Filename, &export.Error, &export.RequestedBy,
+		&export.StartedAt, &export.FinishedAt, &export.CreatedAt, &export.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("report export not found")
+		}
+		return nil, err
+	}
+
+	if len(columns) > 0 {
+		if err := json.Unmarshal(columns, &export.Columns); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal export columns: %w", err)
+		}
+	}
+	return export, nil
+}
@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/database"
+)
+
+type rotationTemplateRepo struct {
+	db *database.DB
+}
+
+// NewRotationTemplateRepository 创建轮班模板仓储
+func NewRotationTemplateRepository(db *database.DB) repository.RotationTemplateRepository {
+	return &rotationTemplateRepo{db: db}
+}
+
+const rotationTemplateColumns = `
+	id, tenant_id, code, name, COALESCE(description, ''), slots, skip_holidays, constraints, is_active,
+	created_by, updated_by, created_at, updated_at, deleted_at
+`
+
+func (r *rotationTemplateRepo) Create(ctx context.Context, template *model.RotationTemplate) error {
+	slots, constraints, err := marshalRotation(template)
+	if err != nil {
+		return err
+	}
+
+	sql := `
+		INSERT INTO hrm_shift_rotation_templates (
+			id, tenant_id, code, name, description, slots, skip_holidays, constraints, is_active,
+			created_by, updated_by, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`
+
+	_, err = r.db.Exec(ctx, sql,
+		template.ID, template.TenantID, template.Code, template.Name, template.Description,
+		slots, template.SkipHolidays, constraints, template.IsActive,
+		template.CreatedBy, template.UpdatedBy, template.CreatedAt, template.UpdatedAt,
+	)
+
+	return err
+}
+
+func (r *rotationTemplateRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.RotationTemplate, error) {
+	sql := `SELECT ` + rotationTemplateColumns + ` FROM hrm_shift_rotation_templates WHERE id = $1 AND deleted_at IS NULL`
+
+	return scanRotationTemplate(r.db.QueryRow(ctx, sql, id))
+}
+
+func (r *rotationTemplateRepo) FindByCode(ctx context.Context, tenantID uuid.UUID, code string) (*model.RotationTemplate, error) {
+	sql := `
+		SELECT ` + rotationTemplateColumns + `
+		FROM hrm_shift_rotation_templates
+		WHERE tenant_id = $1 AND code = $2 AND deleted_at IS NULL
+	`
+
+	return scanRotationTemplate(r.db.QueryRow(ctx, sql, tenantID, code))
+}
+
+func (r *rotationTemplateRepo) Update(ctx context.Context, template *model.RotationTemplate) error {
+	slots, constraints, err := marshalRotation(template)
+	if err != nil {
+		return err
+	}
+
+	sql := `
+		UPDATE hrm_shift_rotation_templates SET
+			name = $1, description = $2, slots = $3, skip_holidays = $4, constraints = $5, is_active = $6,
+			updated_by = $7, updated_at = $8
+		WHERE id = $9 AND deleted_at IS NULL
+	`
+
+	_, err = r.db.Exec(ctx, sql,
+		template.Name, template.Description, slots, template.SkipHolidays, constraints, template.IsActive,
+		template.UpdatedBy, template.UpdatedAt,
+		template.ID,
+	)
+
+	return err
+}
+
+func (r *rotationTemplateRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	sql := `UPDATE hrm_shift_rotation_templates SET deleted_at = NOW() WHERE id = $1`
+	_, err := r.db.Exec(ctx, sql, id)
+	return err
+}
+
+func (r *rotationTemplateRepo) List(ctx context.Context, tenantID uuid.UUID) ([]*model.RotationTemplate, error) {
+	sql := `
+		SELECT ` + rotationTemplateColumns + `
+		FROM hrm_shift_rotation_templates
+		WHERE tenant_id = $1 AND deleted_at IS NULL
+		ORDER BY code ASC
+	`
+
+	rows, err := r.db.Query(ctx, sql, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var templates []*model.RotationTemplate
+	for rows.Next() {
+		template, err := scanRotationTemplate(rows)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+
+	return templates, rows.Err()
+}
+
+func marshalRotation(template *model.RotationTemplate) ([]byte, []byte, error) {
+	slots, err := json.Marshal(template.Slots)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal rotation slots: %w", err)
+	}
+	constraints, err := json.Marshal(template.Constraints)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal schedule constraints: %w", err)
+	}
+	return slots, constraints, nil
+}
+
+func scanRotationTemplate(row pgx.Row) (*model.RotationTemplate, error) {
+	template := &model.RotationTemplate{}
+	var slots, constraints []byte
+	err := row.Scan(
+		&template.ID, &template.TenantID, &template.Code, &template.Name, &template.Description,
+		&slots, &template.SkipHolidays, &constraints, &template.IsActive,
+		&template.CreatedBy, &template.UpdatedBy, &template.CreatedAt, &template.UpdatedAt, &template.DeletedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("rotation template not found")
+		}
+		return nil, err
+	}
+
+	if err := json.Unmarshal(slots, &template.Slots); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal rotation slots: %w", err)
+	}
+	if len(constraints) > 0 {
+		if err := json.Unmarshal(constraints, &template.Constraints); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal schedule constraints: %w", err)
+		}
+	}
+	return template, nil
+}
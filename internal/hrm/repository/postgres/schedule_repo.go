@@ -438,3 +438,130 @@ func (r *scheduleRepo) FindByDate(ctx context.Context, tenantID uuid.UUID, date
 
 	return schedules, rows.Err()
 }
+
+const scheduleRangeColumns = `
+	id, tenant_id, employee_id, COALESCE(employee_name, ''), department_id,
+	shift_id, COALESCE(shift_name, ''), schedule_date, COALESCE(workday_type, ''),
+	status, COALESCE(remark, ''), created_by, updated_by, created_at, updated_at
+`
+
+func (r *scheduleRepo) FindByDepartmentRange(ctx context.Context, tenantID, departmentID uuid.UUID, start, end time.Time) ([]*model.Schedule, error) {
+	sql := `
+		SELECT ` + scheduleRangeColumns + `
+		FROM hrm_schedules
+		WHERE tenant_id = $1 AND department_id = $2
+		  AND schedule_date >= $3::date AND schedule_date <= $4::date
+		  AND deleted_at IS NULL
+		ORDER BY employee_id ASC, schedule_date ASC
+	`
+	return r.queryRange(ctx, sql, tenantID, departmentID, start.Format("2006-01-02"), end.Format("2006-01-02"))
+}
+
+func (r *scheduleRepo) FindByEmployeesRange(ctx context.Context, tenantID uuid.UUID, employeeIDs []uuid.UUID, start, end time.Time) ([]*model.Schedule, error) {
+	if len(employeeIDs) == 0 {
+		return nil, nil
+	}
+
+	sql := `
+		SELECT ` + scheduleRangeColumns + `
+		FROM hrm_schedules
+		WHERE tenant_id = $1 AND employee_id = ANY($2)
+		  AND schedule_date >= $3::date AND schedule_date <= $4::date
+		  AND deleted_at IS NULL
+		ORDER BY employee_id ASC, schedule_date ASC
+	`
+	return r.queryRange(ctx, sql, tenantID, employeeIDs, start.Format("2006-01-02"), end.Format("2006-01-02"))
+}
+
+func (r *scheduleRepo) queryRange(ctx context.Context, sql string, args ...interface{}) ([]*model.Schedule, error) {
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var schedules []*model.Schedule
+	for rows.Next() {
+		schedule := &model.Schedule{}
+		var createdBy, updatedBy *uuid.UUID
+		err := rows.Scan(
+			&schedule.ID, &schedule.TenantID, &schedule.EmployeeID, &schedule.EmployeeName, &schedule.DepartmentID,
+			&schedule.ShiftID, &schedule.ShiftName, &schedule.ScheduleDate, &schedule.WorkdayType,
+			&schedule.Status, &schedule.Remark, &createdBy, &updatedBy, &schedule.CreatedAt, &schedule.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if createdBy != nil {
+			schedule.CreatedBy = *createdBy
+		}
+		if updatedBy != nil {
+			schedule.UpdatedBy = *updatedBy
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules, rows.Err()
+}
+
+func (r *scheduleRepo) ReplaceDrafts(ctx context.Context, tenantID uuid.UUID, employeeIDs []uuid.UUID, start, end time.Time, schedules []*model.Schedule) (int64, error) {
+	var replaced int64
+	err := r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, `
+			UPDATE hrm_schedules SET deleted_at = NOW()
+			WHERE tenant_id = $1 AND employee_id = ANY($2)
+			  AND schedule_date >= $3::date AND schedule_date <= $4::date
+			  AND status = 'draft' AND deleted_at IS NULL
+		`, tenantID, employeeIDs, start.Format("2006-01-02"), end.Format("2006-01-02"))
+		if err != nil {
+			return fmt.Errorf("failed to remove draft schedules: %w", err)
+		}
+		replaced = tag.RowsAffected()
+
+		if len(schedules) == 0 {
+			return nil
+		}
+
+		batch := &pgx.Batch{}
+		for _, schedule := range schedules {
+			batch.Queue(`
+				INSERT INTO hrm_schedules (
+					id, tenant_id, employee_id, employee_name, department_id,
+					shift_id, shift_name, schedule_date, workday_type,
+					status, remark, created_by, updated_by, created_at, updated_at
+				) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+			`,
+				schedule.ID, schedule.TenantID, schedule.EmployeeID, schedule.EmployeeName, schedule.DepartmentID,
+				schedule.ShiftID, schedule.ShiftName, schedule.ScheduleDate.Format("2006-01-02"), schedule.WorkdayType,
+				schedule.Status, schedule.Remark,
+				schedule.CreatedBy, schedule.UpdatedBy, schedule.CreatedAt, schedule.UpdatedAt,
+			)
+		}
+
+		results := tx.SendBatch(ctx, batch)
+		for range schedules {
+			if _, err := results.Exec(); err != nil {
+				results.Close()
+				return fmt.Errorf("failed to insert schedule: %w", err)
+			}
+		}
+		return results.Close()
+	})
+	return replaced, err
+}
+
+func (r *scheduleRepo) UpdateStatus(ctx context.Context, ids []uuid.UUID, status string, updatedBy uuid.UUID) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	sql := `
+		UPDATE hrm_schedules SET status = $1, updated_by = $2, updated_at = NOW()
+		WHERE id = ANY($3) AND deleted_at IS NULL
+	`
+	tag, err := r.db.Exec(ctx, sql, status, updatedBy, ids)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
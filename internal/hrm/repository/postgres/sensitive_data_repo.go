@@ -0,0 +1,252 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/database"
+	"github.com/lk2023060901/go-next-erp/pkg/fieldcrypt"
+)
+
+type sensitiveDataRepo struct {
+	db     *database.DB
+	cipher fieldcrypt.Cipher
+}
+
+// NewSensitiveDataRepository 创建敏感字段重新加密仓储
+func NewSensitiveDataRepository(db *database.DB, cipher fieldcrypt.Cipher) repository.SensitiveDataRepository {
+	return &sensitiveDataRepo{db: db, cipher: cipher}
+}
+
+func (r *sensitiveDataRepo) ListTenantIDs(ctx context.Context) ([]uuid.UUID, error) {
+	sql := `
+		SELECT tenant_id FROM hrm_employees
+		UNION
+		SELECT tenant_id FROM hrm_attendance_devices
+		ORDER BY tenant_id
+	`
+
+	rows, err := r.db.Query(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tenantIDs []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		tenantIDs = append(tenantIDs, id)
+	}
+	return tenantIDs, rows.Err()
+}
+
+// ReencryptEmployees 已删除记录同样处理，避免旧数据密钥无法下线
+func (r *sensitiveDataRepo) ReencryptEmployees(ctx context.Context, tenantID, afterID uuid.UUID, limit int) (*repository.ReencryptBatchResult, error) {
+	result := &repository.ReencryptBatchResult{LastID: afterID}
+
+	err := r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		sql := `
+			SELECT id, COALESCE(id_card_no, ''), COALESCE(id_card_hash, ''), COALESCE(face_data, ''), COALESCE(fingerprint, '')
+			FROM hrm_employees
+			WHERE tenant_id = $1 AND id > $2
+			ORDER BY id
+			LIMIT $3
+			FOR UPDATE
+		`
+		rows, err := tx.Query(ctx, sql, tenantID, afterID, limit)
+		if err != nil {
+			return err
+		}
+
+		type employeeSecrets struct {
+			id                                      uuid.UUID
+			idCardNo, idCardHash, face, fingerprint string
+		}
+		var batch []employeeSecrets
+		for rows.Next() {
+			var s employeeSecrets
+			if err := rows.Scan(&s.id, &s.idCardNo, &s.idCardHash, &s.face, &s.fingerprint); err != nil {
+				rows.Close()
+				return err
+			}
+			batch = append(batch, s)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		for _, s := range batch {
+			result.Scanned++
+			result.LastID = s.id
+
+			plainIDCard, err := r.cipher.Decrypt(ctx, tenantID, s.idCardNo)
+			if err != nil {
+				return err
+			}
+			hash := r.cipher.BlindIndex(tenantID, normalizeIDCardNo(plainIDCard))
+
+			idCardNo, idCardChanged, err := reencryptField(ctx, r.cipher, tenantID, s.idCardNo)
+			if err != nil {
+				return err
+			}
+			face, faceChanged, err := reencryptField(ctx, r.cipher, tenantID, s.face)
+			if err != nil {
+				return err
+			}
+			fingerprint, fpChanged, err := reencryptField(ctx, r.cipher, tenantID, s.fingerprint)
+			if err != nil {
+				return err
+			}
+			if !idCardChanged && !faceChanged && !fpChanged && hash == s.idCardHash {
+				continue
+			}
+
+			_, err = tx.Exec(ctx, `
+				UPDATE hrm_employees
+				SET id_card_no = $1, id_card_hash = NULLIF($2, ''), face_data = $3, fingerprint = $4
+				WHERE id = $5
+			`, idCardNo, hash, face, fingerprint, s.id)
+			if err != nil {
+				return err
+			}
+			result.Updated++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *sensitiveDataRepo) ReencryptDevices(ctx context.Context, tenantID, afterID uuid.UUID, limit int) (*repository.ReencryptBatchResult, error) {
+	result := &repository.ReencryptBatchResult{LastID: afterID}
+
+	err := r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		sql := `
+			SELECT id, COALESCE(password, ''), COALESCE(secret_key, '')
+			FROM hrm_attendance_devices
+			WHERE tenant_id = $1 AND id > $2
+			ORDER BY id
+			LIMIT $3
+			FOR UPDATE
+		`
+		rows, err := tx.Query(ctx, sql, tenantID, afterID, limit)
+		if err != nil {
+			return err
+		}
+
+		type deviceSecrets struct {
+			id                  uuid.UUID
+			password, secretKey string
+		}
+		var batch []deviceSecrets
+		for rows.Next() {
+			var s deviceSecrets
+			if err := rows.Scan(&s.id, &s.password, &s.secretKey); err != nil {
+				rows.Close()
+				return err
+			}
+			batch = append(batch, s)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		for _, s := range batch {
+			result.Scanned++
+			result.LastID = s.id
+
+			password, passwordChanged, err := reencryptField(ctx, r.cipher, tenantID, s.password)
+			if err != nil {
+				return err
+			}
+			secretKey, secretChanged, err := reencryptField(ctx, r.cipher, tenantID, s.secretKey)
+			if err != nil {
+				return err
+			}
+			if !passwordChanged && !secretChanged {
+				continue
+			}
+
+			_, err = tx.Exec(ctx, `UPDATE hrm_attendance_devices SET password = $1, secret_key = $2 WHERE id = $3`,
+				password, secretKey, s.id)
+			if err != nil {
+				return err
+			}
+			result.Updated++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *sensitiveDataRepo) ReencryptBiometricTemplates(ctx context.Context, tenantID, afterID uuid.UUID, limit int) (*repository.ReencryptBatchResult, error) {
+	result := &repository.ReencryptBatchResult{LastID: afterID}
+
+	err := r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		sql := `
+			SELECT id, template
+			FROM hrm_biometric_templates
+			WHERE tenant_id = $1 AND id > $2
+			ORDER BY id
+			LIMIT $3
+			FOR UPDATE
+		`
+		rows, err := tx.Query(ctx, sql, tenantID, afterID, limit)
+		if err != nil {
+			return err
+		}
+
+		type templateSecret struct {
+			id       uuid.UUID
+			template string
+		}
+		var batch []templateSecret
+		for rows.Next() {
+			var s templateSecret
+			if err := rows.Scan(&s.id, &s.template); err != nil {
+				rows.Close()
+				return err
+			}
+			batch = append(batch, s)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		for _, s := range batch {
+			result.Scanned++
+			result.LastID = s.id
+
+			template, changed, err := reencryptField(ctx, r.cipher, tenantID, s.template)
+			if err != nil {
+				return err
+			}
+			if !changed {
+				continue
+			}
+
+			if _, err := tx.Exec(ctx, `UPDATE hrm_biometric_templates SET template = $1 WHERE id = $2`, template, s.id); err != nil {
+				return err
+			}
+			result.Updated++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/pkg/fieldcrypt"
+)
+
+// normalizeIDCardNo 身份证号归一化（去空白、校验位 x 大写），盲索引按归一化值计算
+func normalizeIDCardNo(idCardNo string) string {
+	return strings.ToUpper(strings.TrimSpace(idCardNo))
+}
+
+// encryptFields 加密敏感字段，返回密文（顺序与入参一致），不修改调用方的模型
+func encryptFields(ctx context.Context, cipher fieldcrypt.Cipher, tenantID uuid.UUID, values ...string) ([]string, error) {
+	encrypted := make([]string, len(values))
+	for i, value := range values {
+		out, err := cipher.Encrypt(ctx, tenantID, value)
+		if err != nil {
+			return nil, err
+		}
+		encrypted[i] = out
+	}
+	return encrypted, nil
+}
+
+// decryptFields 原地解密敏感字段
+func decryptFields(ctx context.Context, cipher fieldcrypt.Cipher, tenantID uuid.UUID, fields ...*string) error {
+	for _, field := range fields {
+		plain, err := cipher.Decrypt(ctx, tenantID, *field)
+		if err != nil {
+			return err
+		}
+		*field = plain
+	}
+	return nil
+}
+
+// reencryptField 字段为明文或非当前数据密钥加密时重新加密，返回新值及是否变化
+func reencryptField(ctx context.Context, cipher fieldcrypt.Cipher, tenantID uuid.UUID, value string) (string, bool, error) {
+	stale, err := cipher.NeedsReencrypt(ctx, tenantID, value)
+	if err != nil || !stale {
+		return value, false, err
+	}
+
+	plain, err := cipher.Decrypt(ctx, tenantID, value)
+	if err != nil {
+		return "", false, err
+	}
+	encrypted, err := cipher.Encrypt(ctx, tenantID, plain)
+	if err != nil {
+		return "", false, err
+	}
+	return encrypted, true, nil
+}
@@ -0,0 +1,250 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/database"
+)
+
+// errSwapNotApplied 排班或申请已变化，回滚事务
+var errSwapNotApplied = errors.New("shift swap not applied")
+
+type shiftSwapRepo struct {
+	db *database.DB
+}
+
+// NewShiftSwapRepository 创建换班申请仓储
+func NewShiftSwapRepository(db *database.DB) repository.ShiftSwapRepository {
+	return &shiftSwapRepo{db: db}
+}
+
+const shiftSwapColumns = `
+	id, tenant_id, swap_type,
+	requester_id, requester_name, requester_schedule_id, requester_date, requester_shift_id, requester_shift_name,
+	target_employee_id, target_employee_name, target_schedule_id, target_date, target_shift_id, COALESCE(target_shift_name, ''),
+	COALESCE(reason, ''), status, peer_responded_at, COALESCE(peer_comment, ''),
+	COALESCE(workflow_execution_id, ''), approver_id, approved_at, COALESCE(approval_comment, ''),
+	created_at, updated_at
+`
+
+func (r *shiftSwapRepo) Create(ctx context.Context, request *model.ShiftSwapRequest) error {
+	sql := `
+		INSERT INTO hrm_shift_swap_requests (
+			id, tenant_id, swap_type,
+			requester_id, requester_name, requester_schedule_id, requester_date, requester_shift_id, requester_shift_name,
+			target_employee_id, target_employee_name, target_schedule_id, target_date, target_shift_id, target_shift_name,
+			reason, status, peer_responded_at, peer_comment,
+			workflow_execution_id, approver_id, approved_at, approval_comment,
+			created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25)
+	`
+
+	_, err := r.db.Exec(ctx, sql,
+		request.ID, request.TenantID, request.Type,
+		request.RequesterID, request.RequesterName, request.RequesterScheduleID, request.RequesterDate,
+		request.RequesterShiftID, request.RequesterShiftName,
+		request.TargetEmployeeID, request.TargetEmployeeName, request.TargetScheduleID, request.TargetDate,
+		request.TargetShiftID, request.TargetShiftName,
+		request.Reason, request.Status, request.PeerRespondedAt, request.PeerComment,
+		request.WorkflowExecutionID, request.ApproverID, request.ApprovedAt, request.ApprovalComment,
+		request.CreatedAt, request.UpdatedAt,
+	)
+
+	return err
+}
+
+func (r *shiftSwapRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.ShiftSwapRequest, error) {
+	sql := `SELECT ` + shiftSwapColumns + ` FROM hrm_shift_swap_requests WHERE id = $1`
+
+	return scanShiftSwap(r.db.QueryRow(ctx, sql, id))
+}
+
+func (r *shiftSwapRepo) Update(ctx context.Context, request *model.ShiftSwapRequest) error {
+	sql := `
+		UPDATE hrm_shift_swap_requests SET
+			status = $1, peer_responded_at = $2, peer_comment = $3,
+			workflow_execution_id = $4, approver_id = $5, approved_at = $6, approval_comment = $7,
+			updated_at = $8
+		WHERE id = $9
+	`
+
+	_, err := r.db.Exec(ctx, sql,
+		request.Status, request.PeerRespondedAt, request.PeerComment,
+		request.WorkflowExecutionID, request.ApproverID, request.ApprovedAt, request.ApprovalComment,
+		request.UpdatedAt,
+		request.ID,
+	)
+
+	return err
+}
+
+func (r *shiftSwapRepo) List(ctx context.Context, tenantID uuid.UUID, filter *repository.ShiftSwapFilter) ([]*model.ShiftSwapRequest, error) {
+	where := "tenant_id = $1"
+	args := []interface{}{tenantID}
+
+	if filter != nil {
+		if filter.EmployeeID != nil {
+			args = append(args, *filter.EmployeeID)
+			where += fmt.Sprintf(" AND (requester_id = $%d OR target_employee_id = $%d)", len(args), len(args))
+		}
+		if filter.Status != nil {
+			args = append(args, *filter.Status)
+			where += fmt.Sprintf(" AND status = $%d", len(args))
+		}
+	}
+
+	sql := `SELECT ` + shiftSwapColumns + ` FROM hrm_shift_swap_requests WHERE ` + where + ` ORDER BY created_at DESC`
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var requests []*model.ShiftSwapRequest
+	for rows.Next() {
+		request, err := scanShiftSwap(rows)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, request)
+	}
+
+	return requests, rows.Err()
+}
+
+func (r *shiftSwapRepo) CountOpenBySchedule(ctx context.Context, scheduleID uuid.UUID) (int, error) {
+	sql := `
+		SELECT COUNT(*) FROM hrm_shift_swap_requests
+		WHERE (requester_schedule_id = $1 OR target_schedule_id = $1)
+		  AND status IN ('pending_peer', 'pending_approval')
+	`
+
+	var count int
+	err := r.db.QueryRow(ctx, sql, scheduleID).Scan(&count)
+	return count, err
+}
+
+func (r *shiftSwapRepo) ApplyApproval(ctx context.Context, request *model.ShiftSwapRequest) (bool, error) {
+	ids := request.ScheduleIDs()
+
+	err := r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, `
+			SELECT id, employee_id, shift_id, schedule_date FROM hrm_schedules
+			WHERE id = ANY($1) AND deleted_at IS NULL
+			FOR UPDATE
+		`, ids)
+		if err != nil {
+			return err
+		}
+
+		type lockedSchedule struct {
+			employeeID, shiftID uuid.UUID
+			date                string
+		}
+		locked := make(map[uuid.UUID]lockedSchedule, len(ids))
+		for rows.Next() {
+			var id uuid.UUID
+			var s lockedSchedule
+			var date time.Time
+			if err := rows.Scan(&id, &s.employeeID, &s.shiftID, &date); err != nil {
+				rows.Close()
+				return err
+			}
+			s.date = date.Format("2006-01-02")
+			locked[id] = s
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		// 排班须与申请时的快照一致
+		mine, ok := locked[request.RequesterScheduleID]
+		if !ok || mine.employeeID != request.RequesterID || mine.shiftID != request.RequesterShiftID ||
+			mine.date != request.RequesterDate.Format("2006-01-02") {
+			return errSwapNotApplied
+		}
+
+		switch {
+		case request.Type == model.ShiftSwapTypeCover:
+			_, err = tx.Exec(ctx, `
+				UPDATE hrm_schedules SET employee_id = $1, employee_name = $2, updated_at = NOW() WHERE id = $3
+			`, request.TargetEmployeeID, request.TargetEmployeeName, request.RequesterScheduleID)
+
+		default:
+			theirs, ok := locked[*request.TargetScheduleID]
+			if !ok || theirs.employeeID != request.TargetEmployeeID || request.TargetShiftID == nil ||
+				theirs.shiftID != *request.TargetShiftID || theirs.date != request.TargetDate.Format("2006-01-02") {
+				return errSwapNotApplied
+			}
+
+			if mine.date == theirs.date {
+				// 同一天互换：交换班次
+				_, err = tx.Exec(ctx, `
+					UPDATE hrm_schedules s SET shift_id = o.shift_id, shift_name = o.shift_name, updated_at = NOW()
+					FROM hrm_schedules o
+					WHERE (s.id, o.id) IN (($1::uuid, $2::uuid), ($2::uuid, $1::uuid))
+				`, request.RequesterScheduleID, *request.TargetScheduleID)
+			} else {
+				// 不同日期互换：交换员工
+				_, err = tx.Exec(ctx, `
+					UPDATE hrm_schedules s SET employee_id = o.employee_id, employee_name = o.employee_name, updated_at = NOW()
+					FROM hrm_schedules o
+					WHERE (s.id, o.id) IN (($1::uuid, $2::uuid), ($2::uuid, $1::uuid))
+				`, request.RequesterScheduleID, *request.TargetScheduleID)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("failed to exchange schedules: %w", err)
+		}
+
+		tag, err := tx.Exec(ctx, `
+			UPDATE hrm_shift_swap_requests SET
+				status = $1, approver_id = $2, approved_at = $3, approval_comment = $4, updated_at = $5
+			WHERE id = $6 AND status = $7
+		`, model.ShiftSwapStatusApproved, request.ApproverID, request.ApprovedAt, request.ApprovalComment, request.UpdatedAt,
+			request.ID, model.ShiftSwapStatusPendingApproval)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return errSwapNotApplied
+		}
+		return nil
+	})
+
+	if errors.Is(err, errSwapNotApplied) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func scanShiftSwap(row pgx.Row) (*model.ShiftSwapRequest, error) {
+	request := &model.ShiftSwapRequest{}
+	err := row.Scan(
+		&request.ID, &request.TenantID, &request.Type,
+		&request.RequesterID, &request.RequesterName, &request.RequesterScheduleID, &request.RequesterDate,
+		&request.RequesterShiftID, &request.RequesterShiftName,
+		&request.TargetEmployeeID, &request.TargetEmployeeName, &request.TargetScheduleID, &request.TargetDate,
+		&request.TargetShiftID, &request.TargetShiftName,
+		&request.Reason, &request.Status, &request.PeerRespondedAt, &request.PeerComment,
+		&request.WorkflowExecutionID, &request.ApproverID, &request.ApprovedAt, &request.ApprovalComment,
+		&request.CreatedAt, &request.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("shift swap request not found")
+		}
+		return nil, err
+	}
+	return request, nil
+}
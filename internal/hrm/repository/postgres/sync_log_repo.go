@@ -0,0 +1,185 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/database"
+)
+
+type syncLogRepo struct {
+	db *database.DB
+}
+
+// NewSyncLogRepository 创建同步日志仓储
+func NewSyncLogRepository(db *database.DB) repository.SyncLogRepository {
+	return &syncLogRepo{db: db}
+}
+
+const syncLogColumns = `
+	id, tenant_id, source_type, source_id, sync_type, sync_direction,
+	start_time, end_time, duration, status,
+	COALESCE(total_count, 0), COALESCE(success_count, 0), COALESCE(failed_count, 0),
+	COALESCE(error_message, ''), details, created_at
+`
+
+func (r *syncLogRepo) Create(ctx context.Context, log *model.SyncLog) error {
+	details, err := json.Marshal(log.Details)
+	if err != nil {
+		return fmt.Errorf("failed to marshal sync log details: %w", err)
+	}
+
+	sql := `
+		INSERT INTO hrm_sync_logs (
+			id, tenant_id, source_type, source_id, sync_type, sync_direction,
+			start_time, end_time, duration, status,
+			total_count, success_count, failed_count, error_message, details, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, NULLIF($14, ''), $15, $16)
+	`
+
+	_, err = r.db.Exec(ctx, sql,
+		log.ID, log.TenantID, log.SourceType, log.SourceID, log.SyncType, log.SyncDirection,
+		log.StartTime, log.EndTime, log.Duration, log.Status,
+		log.TotalCount, log.SuccessCount, log.FailedCount, log.ErrorMessage, details, log.CreatedAt,
+	)
+
+	return err
+}
+
+func (r *syncLogRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.SyncLog, error) {
+	sql := `SELECT ` + syncLogColumns + ` FROM hrm_sync_logs WHERE id = $1`
+
+	return scanSyncLog(r.db.QueryRow(ctx, sql, id))
+}
+
+func (r *syncLogRepo) List(ctx context.Context, tenantID uuid.UUID, filter *repository.SyncLogFilter, offset, limit int) ([]*model.SyncLog, int, error) {
+	where := `WHERE tenant_id = $1`
+	args := []interface{}{tenantID}
+	argIdx := 2
+
+	if filter != nil {
+		if filter.SourceType != nil {
+			where += fmt.Sprintf(" AND source_type = $%d", argIdx)
+			args = append(args, *filter.SourceType)
+			argIdx++
+		}
+		if filter.SourceID != nil {
+			where += fmt.Sprintf(" AND source_id = $%d", argIdx)
+			args = append(args, *filter.SourceID)
+			argIdx++
+		}
+		if filter.SyncType != nil {
+			where += fmt.Sprintf(" AND sync_type = $%d", argIdx)
+			args = append(args, *filter.SyncType)
+			argIdx++
+		}
+		if filter.SyncDirection != nil {
+			where += fmt.Sprintf(" AND sync_direction = $%d", argIdx)
+			args = append(args, *filter.SyncDirection)
+			argIdx++
+		}
+		if filter.Status != nil {
+			where += fmt.Sprintf(" AND status = $%d", argIdx)
+			args = append(args, *filter.Status)
+			argIdx++
+		}
+		if filter.StartDate != nil {
+			where += fmt.Sprintf(" AND start_time >= $%d::date", argIdx)
+			args = append(args, *filter.StartDate)
+			argIdx++
+		}
+		if filter.EndDate != nil {
+			where += fmt.Sprintf(" AND start_time < $%d::date + 1", argIdx)
+			args = append(args, *filter.EndDate)
+			argIdx++
+		}
+	}
+
+	var total int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM hrm_sync_logs `+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	sql := fmt.Sprintf(`
+		SELECT %s FROM hrm_sync_logs %s
+		ORDER BY start_time DESC
+		LIMIT $%d OFFSET $%d
+	`, syncLogColumns, where, argIdx, argIdx+1)
+	args = append(args, limit, offset)
+
+	logs, err := r.queryLogs(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return logs, total, nil
+}
+
+func (r *syncLogRepo) ListBySource(ctx context.Context, tenantID uuid.UUID, sourceType string, sourceID uuid.UUID, limit int) ([]*model.SyncLog, error) {
+	sql := `
+		SELECT ` + syncLogColumns + `
+		FROM hrm_sync_logs
+		WHERE tenant_id = $1 AND source_type = $2 AND source_id = $3
+		ORDER BY start_time DESC
+		LIMIT $4
+	`
+
+	return r.queryLogs(ctx, sql, tenantID, sourceType, sourceID, limit)
+}
+
+func (r *syncLogRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.Exec(ctx, `DELETE FROM hrm_sync_logs WHERE id = $1`, id)
+	return err
+}
+
+func (r *syncLogRepo) DeleteBefore(ctx context.Context, tenantID uuid.UUID, beforeDate string) error {
+	_, err := r.db.Exec(ctx, `DELETE FROM hrm_sync_logs WHERE tenant_id = $1 AND start_time < $2::date`, tenantID, beforeDate)
+	return err
+}
+
+func (r *syncLogRepo) queryLogs(ctx context.Context, sql string, args ...interface{}) ([]*model.SyncLog, error) {
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var logs []*model.SyncLog
+	for rows.Next() {
+		log, err := scanSyncLog(rows)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, log)
+	}
+
+	return logs, rows.Err()
+}
+
+func scanSyncLog(row pgx.Row) (*model.SyncLog, error) {
+	log := &model.SyncLog{}
+	var details []byte
+	err := row.Scan(
+		&log.ID, &log.TenantID, &log.SourceType, &log.SourceID, &log.SyncType, &log.SyncDirection,
+		&log.StartTime, &log.EndTime, &log.Duration, &log.Status,
+		&log.TotalCount, &log.SuccessCount, &log.FailedCount,
+		&log.ErrorMessage, &details, &log.CreatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("sync log not found")
+		}
+		return nil, err
+	}
+
+	if len(details) > 0 {
+		if err := json.Unmarshal(details, &log.Details); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal sync log details: %w", err)
+		}
+	}
+	return log, nil
+}
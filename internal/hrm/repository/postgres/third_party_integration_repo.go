@@ -0,0 +1,280 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/database"
+)
+
+type thirdPartyIntegrationRepo struct {
+	db *database.DB
+}
+
+// NewThirdPartyIntegrationRepository 创建第三方集成仓储
+func NewThirdPartyIntegrationRepository(db *database.DB) repository.ThirdPartyIntegrationRepository {
+	return &thirdPartyIntegrationRepo{db: db}
+}
+
+const thirdPartyIntegrationColumns = `
+	id, tenant_id, platform, app_name, app_id, app_key, app_secret,
+	COALESCE(corp_id, ''), COALESCE(agent_id, ''), COALESCE(suite_key, ''), COALESCE(suite_secret, ''),
+	COALESCE(webhook_url, ''), COALESCE(webhook_token, ''), COALESCE(webhook_secret, ''),
+	COALESCE(sync_enabled, TRUE), COALESCE(sync_attendance, TRUE), COALESCE(sync_employee, FALSE),
+	COALESCE(sync_department, FALSE), COALESCE(sync_schedule, FALSE), COALESCE(sync_interval, 30),
+	COALESCE(sync_direction, 'pull'), last_sync_at, COALESCE(sync_cursor, ''),
+	field_mapping, COALESCE(status, 'inactive'), COALESCE(is_active, TRUE), COALESCE(error_message, ''),
+	COALESCE(total_sync_count, 0), COALESCE(last_sync_count, 0), COALESCE(remark, ''),
+	created_by, updated_by, created_at, updated_at, deleted_at
+`
+
+func (r *thirdPartyIntegrationRepo) Create(ctx context.Context, integration *model.ThirdPartyIntegration) error {
+	fieldMapping, err := json.Marshal(integration.FieldMapping)
+	if err != nil {
+		return fmt.Errorf("failed to marshal field mapping: %w", err)
+	}
+
+	sql := `
+		INSERT INTO hrm_third_party_integrations (
+			id, tenant_id, platform, app_name, app_id, app_key, app_secret,
+			corp_id, agent_id, suite_key, suite_secret,
+			webhook_url, webhook_token, webhook_secret,
+			sync_enabled, sync_attendance, sync_employee, sync_department, sync_schedule,
+			sync_interval, sync_direction, field_mapping,
+			status, is_active, remark,
+			created_by, updated_by, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7,
+			$8, $9, $10, $11,
+			$12, $13, $14,
+			$15, $16, $17, $18, $19,
+			$20, $21, $22,
+			$23, $24, $25,
+			$26, $27, $28, $29
+		)
+	`
+
+	_, err = r.db.Exec(ctx, sql,
+		integration.ID, integration.TenantID, integration.Platform, integration.AppName, integration.AppID, integration.AppKey, integration.AppSecret,
+		integration.CorpID, integration.AgentID, integration.SuiteKey, integration.SuiteSecret,
+		integration.WebhookURL, integration.WebhookToken, integration.WebhookSecret,
+		integration.SyncEnabled, integration.SyncAttendance, integration.SyncEmployee, integration.SyncDepartment, integration.SyncSchedule,
+		integration.SyncInterval, integration.SyncDirection, fieldMapping,
+		integration.Status, integration.IsActive, integration.Remark,
+		integration.CreatedBy, integration.UpdatedBy, integration.CreatedAt, integration.UpdatedAt,
+	)
+
+	return err
+}
+
+func (r *thirdPartyIntegrationRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.ThirdPartyIntegration, error) {
+	sql := `SELECT ` + thirdPartyIntegrationColumns + ` FROM hrm_third_party_integrations WHERE id = $1 AND deleted_at IS NULL`
+
+	return scanThirdPartyIntegration(r.db.QueryRow(ctx, sql, id))
+}
+
+func (r *thirdPartyIntegrationRepo) FindByPlatform(ctx context.Context, tenantID uuid.UUID, platform model.PlatformType) (*model.ThirdPartyIntegration, error) {
+	sql := `
+		SELECT ` + thirdPartyIntegrationColumns + `
+		FROM hrm_third_party_integrations
+		WHERE tenant_id = $1 AND platform = $2 AND deleted_at IS NULL
+	`
+
+	return scanThirdPartyIntegration(r.db.QueryRow(ctx, sql, tenantID, platform))
+}
+
+func (r *thirdPartyIntegrationRepo) Update(ctx context.Context, integration *model.ThirdPartyIntegration) error {
+	fieldMapping, err := json.Marshal(integration.FieldMapping)
+	if err != nil {
+		return fmt.Errorf("failed to marshal field mapping: %w", err)
+	}
+
+	sql := `
+		UPDATE hrm_third_party_integrations SET
+			app_name = $1, app_id = $2, app_key = $3, app_secret = $4,
+			corp_id = $5, agent_id = $6, suite_key = $7, suite_secret = $8,
+			webhook_url = $9, webhook_token = $10, webhook_secret = $11,
+			sync_enabled = $12, sync_attendance = $13, sync_employee = $14, sync_department = $15, sync_schedule = $16,
+			sync_interval = $17, sync_direction = $18, field_mapping = $19,
+			is_active = $20, remark = $21,
+			updated_by = $22, updated_at = $23
+		WHERE id = $24 AND deleted_at IS NULL
+	`
+
+	_, err = r.db.Exec(ctx, sql,
+		integration.AppName, integration.AppID, integration.AppKey, integration.AppSecret,
+		integration.CorpID, integration.AgentID, integration.SuiteKey, integration.SuiteSecret,
+		integration.WebhookURL, integration.WebhookToken, integration.WebhookSecret,
+		integration.SyncEnabled, integration.SyncAttendance, integration.SyncEmployee, integration.SyncDepartment, integration.SyncSchedule,
+		integration.SyncInterval, integration.SyncDirection, fieldMapping,
+		integration.IsActive, integration.Remark,
+		integration.UpdatedBy, integration.UpdatedAt,
+		integration.ID,
+	)
+
+	return err
+}
+
+func (r *thirdPartyIntegrationRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	sql := `UPDATE hrm_third_party_integrations SET deleted_at = NOW() WHERE id = $1`
+	_, err := r.db.Exec(ctx, sql, id)
+	return err
+}
+
+func (r *thirdPartyIntegrationRepo) List(ctx context.Context, tenantID uuid.UUID, filter *repository.IntegrationFilter, offset, limit int) ([]*model.ThirdPartyIntegration, int, error) {
+	where := `WHERE tenant_id = $1 AND deleted_at IS NULL`
+	args := []interface{}{tenantID}
+	argIdx := 2
+
+	if filter != nil {
+		if filter.Platform != nil {
+			where += fmt.Sprintf(" AND platform = $%d", argIdx)
+			args = append(args, *filter.Platform)
+			argIdx++
+		}
+		if filter.Status != nil {
+			where += fmt.Sprintf(" AND status = $%d", argIdx)
+			args = append(args, *filter.Status)
+			argIdx++
+		}
+		if filter.IsActive != nil {
+			where += fmt.Sprintf(" AND is_active = $%d", argIdx)
+			args = append(args, *filter.IsActive)
+			argIdx++
+		}
+		if filter.Keyword != "" {
+			where += fmt.Sprintf(" AND app_name ILIKE $%d", argIdx)
+			args = append(args, "%"+filter.Keyword+"%")
+			argIdx++
+		}
+	}
+
+	var total int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM hrm_third_party_integrations `+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	sql := fmt.Sprintf(`
+		SELECT %s FROM hrm_third_party_integrations %s
+		ORDER BY platform ASC
+		LIMIT $%d OFFSET $%d
+	`, thirdPartyIntegrationColumns, where, argIdx, argIdx+1)
+	args = append(args, limit, offset)
+
+	integrations, err := r.queryIntegrations(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return integrations, total, nil
+}
+
+func (r *thirdPartyIntegrationRepo) ListActive(ctx context.Context, tenantID uuid.UUID) ([]*model.ThirdPartyIntegration, error) {
+	sql := `
+		SELECT ` + thirdPartyIntegrationColumns + `
+		FROM hrm_third_party_integrations
+		WHERE tenant_id = $1 AND is_active = TRUE AND deleted_at IS NULL
+		ORDER BY platform ASC
+	`
+
+	return r.queryIntegrations(ctx, sql, tenantID)
+}
+
+func (r *thirdPartyIntegrationRepo) UpdateSyncTime(ctx context.Context, id uuid.UUID, syncCount int) error {
+	sql := `
+		UPDATE hrm_third_party_integrations SET
+			last_sync_at = NOW(),
+			last_sync_count = $1,
+			total_sync_count = COALESCE(total_sync_count, 0) + 1
+		WHERE id = $2 AND deleted_at IS NULL
+	`
+	_, err := r.db.Exec(ctx, sql, syncCount, id)
+	return err
+}
+
+func (r *thirdPartyIntegrationRepo) UpdateStatus(ctx context.Context, id uuid.UUID, status model.IntegrationStatus, errorMsg string) error {
+	sql := `
+		UPDATE hrm_third_party_integrations SET status = $1, error_message = NULLIF($2, ''), updated_at = NOW()
+		WHERE id = $3 AND deleted_at IS NULL
+	`
+	_, err := r.db.Exec(ctx, sql, status, errorMsg, id)
+	return err
+}
+
+func (r *thirdPartyIntegrationRepo) UpdateSyncCursor(ctx context.Context, id uuid.UUID, cursor string) error {
+	sql := `UPDATE hrm_third_party_integrations SET sync_cursor = $1 WHERE id = $2 AND deleted_at IS NULL`
+	_, err := r.db.Exec(ctx, sql, cursor, id)
+	return err
+}
+
+func (r *thirdPartyIntegrationRepo) ListSyncDue(ctx context.Context, now time.Time) ([]*model.ThirdPartyIntegration, error) {
+	sql := `
+		SELECT ` + thirdPartyIntegrationColumns + `
+		FROM hrm_third_party_integrations
+		WHERE is_active = TRUE AND sync_enabled = TRUE AND sync_attendance = TRUE AND deleted_at IS NULL
+		  AND (last_sync_at IS NULL OR last_sync_at + make_interval(mins => COALESCE(sync_interval, 30)) <= $1)
+		ORDER BY last_sync_at ASC NULLS FIRST
+	`
+
+	return r.queryIntegrations(ctx, sql, now)
+}
+
+func (r *thirdPartyIntegrationRepo) queryIntegrations(ctx context.Context, sql string, args ...interface{}) ([]*model.ThirdPartyIntegration, error) {
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var integrations []*model.ThirdPartyIntegration
+	for rows.Next() {
+		integration, err := scanThirdPartyIntegration(rows)
+		if err != nil {
+			return nil, err
+		}
+		integrations = append(integrations, integration)
+	}
+
+	return integrations, rows.Err()
+}
+
+func scanThirdPartyIntegration(row pgx.Row) (*model.ThirdPartyIntegration, error) {
+	integration := &model.ThirdPartyIntegration{}
+	var fieldMapping []byte
+	var createdBy, updatedBy *uuid.UUID
+	err := row.Scan(
+		&integration.ID, &integration.TenantID, &integration.Platform, &integration.AppName, &integration.AppID, &integration.AppKey, &integration.AppSecret,
+		&integration.CorpID, &integration.AgentID, &integration.SuiteKey, &integration.SuiteSecret,
+		&integration.WebhookURL, &integration.WebhookToken, &integration.WebhookSecret,
+		&integration.SyncEnabled, &integration.SyncAttendance, &integration.SyncEmployee,
+		&integration.SyncDepartment, &integration.SyncSchedule, &integration.SyncInterval,
+		&integration.SyncDirection, &integration.LastSyncAt, &integration.SyncCursor,
+		&fieldMapping, &integration.Status, &integration.IsActive, &integration.ErrorMessage,
+		&integration.TotalSyncCount, &integration.LastSyncCount, &integration.Remark,
+		&createdBy, &updatedBy, &integration.CreatedAt, &integration.UpdatedAt, &integration.DeletedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("third party integration not found")
+		}
+		return nil, err
+	}
+
+	if createdBy != nil {
+		integration.CreatedBy = *createdBy
+	}
+	if updatedBy != nil {
+		integration.UpdatedBy = *updatedBy
+	}
+	if len(fieldMapping) > 0 {
+		if err := json.Unmarshal(fieldMapping, &integration.FieldMapping); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal field mapping: %w", err)
+		}
+	}
+	return integration, nil
+}
@@ -0,0 +1,169 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/database"
+)
+
+const timeAllocationColumns = `id, tenant_id, employee_id, employee_name, kind, source_id, title,
+	start_time, end_time, status, created_at, updated_at`
+
+type timeAllocationRepo struct {
+	db *database.DB
+}
+
+// NewTimeAllocationRepository 创建员工时间占用索引仓储
+func NewTimeAllocationRepository(db *database.DB) repository.TimeAllocationRepository {
+	return &timeAllocationRepo{db: db}
+}
+
+const timeAllocationUpsertSQL = `
+	INSERT INTO hrm_time_allocations (` + timeAllocationColumns + `)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	ON CONFLICT (kind, source_id) DO UPDATE SET
+		employee_id = EXCLUDED.employee_id,
+		employee_name = EXCLUDED.employee_name,
+		title = EXCLUDED.title,
+		start_time = EXCLUDED.start_time,
+		end_time = EXCLUDED.end_time,
+		status = EXCLUDED.status,
+		updated_at = EXCLUDED.updated_at
+	RETURNING id, created_at
+`
+
+func (r *timeAllocationRepo) Upsert(ctx context.Context, allocation *model.TimeAllocation) error {
+	return r.db.QueryRow(ctx, timeAllocationUpsertSQL, timeAllocationArgs(allocation)...).Scan(&allocation.ID, &allocation.CreatedAt)
+}
+
+func (r *timeAllocationRepo) Reserve(ctx context.Context, allocation *model.TimeAllocation, conflictKinds []model.TimeAllocationKind) ([]*model.TimeAllocation, bool, error) {
+	var conflicts []*model.TimeAllocation
+	created := false
+
+	err := r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		// 锁定员工行，串行化同一员工的冲突检查和占用写入
+		var locked uuid.UUID
+		err := tx.QueryRow(ctx, `SELECT id FROM employees WHERE tenant_id = $1 AND id = $2 FOR UPDATE`,
+			allocation.TenantID, allocation.EmployeeID).Scan(&locked)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+
+		kinds := make([]string, len(conflictKinds))
+		for i, kind := range conflictKinds {
+			kinds[i] = string(kind)
+		}
+		rows, err := tx.Query(ctx, `
+			SELECT `+timeAllocationColumns+` FROM hrm_time_allocations
+			WHERE tenant_id = $1 AND employee_id = $2 AND start_time < $4 AND end_time > $3
+				AND kind = ANY($5) AND NOT (kind = $6 AND source_id = $7)
+			ORDER BY start_time
+		`, allocation.TenantID, allocation.EmployeeID, allocation.StartTime, allocation.EndTime, kinds, allocation.Kind, allocation.SourceID)
+		if err != nil {
+			return err
+		}
+		conflicts, err = collectTimeAllocations(rows)
+		if err != nil || len(conflicts) > 0 {
+			return err
+		}
+
+		var exists bool
+		err = tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM hrm_time_allocations WHERE kind = $1 AND source_id = $2)`,
+			allocation.Kind, allocation.SourceID).Scan(&exists)
+		if err != nil {
+			return err
+		}
+		created = !exists
+
+		return tx.QueryRow(ctx, timeAllocationUpsertSQL, timeAllocationArgs(allocation)...).Scan(&allocation.ID, &allocation.CreatedAt)
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return conflicts, created, nil
+}
+
+func (r *timeAllocationRepo) UpdateStatus(ctx context.Context, kind model.TimeAllocationKind, sourceID uuid.UUID, status model.TimeAllocationStatus) error {
+	sql := `UPDATE hrm_time_allocations SET status = $3, updated_at = $4 WHERE kind = $1 AND source_id = $2`
+
+	_, err := r.db.Exec(ctx, sql, kind, sourceID, status, time.Now())
+	return err
+}
+
+func (r *timeAllocationRepo) DeleteBySource(ctx context.Context, kind model.TimeAllocationKind, sourceID uuid.UUID) error {
+	sql := `DELETE FROM hrm_time_allocations WHERE kind = $1 AND source_id = $2`
+
+	_, err := r.db.Exec(ctx, sql, kind, sourceID)
+	return err
+}
+
+func (r *timeAllocationRepo) FindOverlapping(ctx context.Context, tenantID, employeeID uuid.UUID, start, end time.Time) ([]*model.TimeAllocation, error) {
+	sql := `
+		SELECT ` + timeAllocationColumns + ` FROM hrm_time_allocations
+		WHERE tenant_id = $1 AND employee_id = $2 AND start_time < $4 AND end_time > $3
+		ORDER BY start_time
+	`
+
+	return r.query(ctx, sql, tenantID, employeeID, start, end)
+}
+
+func (r *timeAllocationRepo) FindByEmployees(ctx context.Context, tenantID uuid.UUID, employeeIDs []uuid.UUID, start, end time.Time) ([]*model.TimeAllocation, error) {
+	if len(employeeIDs) == 0 {
+		return nil, nil
+	}
+
+	sql := `
+		SELECT ` + timeAllocationColumns + ` FROM hrm_time_allocations
+		WHERE tenant_id = $1 AND employee_id = ANY($2) AND start_time < $4 AND end_time > $3
+		ORDER BY start_time, kind
+	`
+
+	return r.query(ctx, sql, tenantID, employeeIDs, start, end)
+}
+
+func (r *timeAllocationRepo) query(ctx context.Context, sql string, args ...interface{}) ([]*model.TimeAllocation, error) {
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	return collectTimeAllocations(rows)
+}
+
+func collectTimeAllocations(rows pgx.Rows) ([]*model.TimeAllocation, error) {
+	defer rows.Close()
+
+	var allocations []*model.TimeAllocation
+	for rows.Next() {
+		allocation, err := scanTimeAllocation(rows)
+		if err != nil {
+			return nil, err
+		}
+		allocations = append(allocations, allocation)
+	}
+	return allocations, rows.Err()
+}
+
+func timeAllocationArgs(allocation *model.TimeAllocation) []interface{} {
+	return []interface{}{
+		allocation.ID, allocation.TenantID, allocation.EmployeeID, allocation.EmployeeName, allocation.Kind, allocation.SourceID, allocation.Title,
+		allocation.StartTime, allocation.EndTime, allocation.Status, allocation.CreatedAt, allocation.UpdatedAt,
+	}
+}
+
+func scanTimeAllocation(row pgx.Row) (*model.TimeAllocation, error) {
+	allocation := &model.TimeAllocation{}
+	err := row.Scan(
+		&allocation.ID, &allocation.TenantID, &allocation.EmployeeID, &allocation.EmployeeName, &allocation.Kind, &allocation.SourceID, &allocation.Title,
+		&allocation.StartTime, &allocation.EndTime, &allocation.Status, &allocation.CreatedAt, &allocation.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return allocation, nil
+}
@@ -0,0 +1,356 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/database"
+)
+
+type tripExpenseRepo struct {
+	db *database.DB
+}
+
+// NewTripExpenseRepository 创建出差报销仓储
+func NewTripExpenseRepository(db *database.DB) repository.TripExpenseRepository {
+	return &tripExpenseRepo{db: db}
+}
+
+const tripExpenseColumns = `
+	id, tenant_id, trip_id, category, amount, currency, expense_date, nights, COALESCE(description, ''),
+	policy_cap, over_policy, over_policy_amount, COALESCE(over_policy_note, ''),
+	created_by, created_at, updated_at
+`
+
+func (r *tripExpenseRepo) Create(ctx context.Context, expense *model.TripExpense) error {
+	sql := `
+		INSERT INTO hrm_trip_expenses (
+			id, tenant_id, trip_id, category, amount, currency, expense_date, nights, description,
+			policy_cap, over_policy, over_policy_amount, over_policy_note,
+			created_by, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+	`
+
+	_, err := r.db.Exec(ctx, sql,
+		expense.ID, expense.TenantID, expense.TripID, expense.Category, expense.Amount, expense.Currency,
+		expense.ExpenseDate, expense.Nights, expense.Description,
+		expense.PolicyCap, expense.OverPolicy, expense.OverPolicyAmount, expense.OverPolicyNote,
+		expense.CreatedBy, expense.CreatedAt, expense.UpdatedAt,
+	)
+	return err
+}
+
+func (r *tripExpenseRepo) Update(ctx context.Context, expense *model.TripExpense) error {
+	sql := `
+		UPDATE hrm_trip_expenses SET
+			category = $1, amount = $2, currency = $3, expense_date = $4, nights = $5, description = $6,
+			over_policy_note = $7, updated_at = $8
+		WHERE id = $9
+	`
+
+	_, err := r.db.Exec(ctx, sql,
+		expense.Category, expense.Amount, expense.Currency, expense.ExpenseDate, expense.Nights, expense.Description,
+		expense.OverPolicyNote, expense.UpdatedAt,
+		expense.ID,
+	)
+	return err
+}
+
+func (r *tripExpenseRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.Exec(ctx, `DELETE FROM hrm_trip_expenses WHERE id = $1`, id)
+	return err
+}
+
+func (r *tripExpenseRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.TripExpense, error) {
+	sql := `SELECT ` + tripExpenseColumns + ` FROM hrm_trip_expenses WHERE id = $1`
+
+	return scanTripExpense(r.db.QueryRow(ctx, sql, id))
+}
+
+func (r *tripExpenseRepo) ListByTrip(ctx context.Context, tripID uuid.UUID) ([]*model.TripExpense, error) {
+	sql := `SELECT ` + tripExpenseColumns + ` FROM hrm_trip_expenses WHERE trip_id = $1 ORDER BY expense_date, created_at`
+
+	rows, err := r.db.Query(ctx, sql, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var expenses []*model.TripExpense
+	for rows.Next() {
+		expense, err := scanTripExpense(rows)
+		if err != nil {
+			return nil, err
+		}
+		expenses = append(expenses, expense)
+	}
+	return expenses, rows.Err()
+}
+
+func (r *tripExpenseRepo) UpdatePolicyFlags(ctx context.Context, expenses []*model.TripExpense) error {
+	if len(expenses) == 0 {
+		return nil
+	}
+
+	return r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		sql := `UPDATE hrm_trip_expenses SET policy_cap = $1, over_policy = $2, over_policy_amount = $3 WHERE id = $4`
+		for _, expense := range expenses {
+			if _, err := tx.Exec(ctx, sql, expense.PolicyCap, expense.OverPolicy, expense.OverPolicyAmount, expense.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *tripExpenseRepo) FindClaim(ctx context.Context, tripID uuid.UUID) (*model.TripExpenseClaim, error) {
+	sql := `SELECT ` + tripExpenseClaimColumns + ` FROM hrm_trip_expense_claims WHERE trip_id = $1`
+
+	claim, err := scanTripExpenseClaim(r.db.QueryRow(ctx, sql, tripID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("trip expense claim not found")
+		}
+		return nil, err
+	}
+	return claim, nil
+}
+
+func (r *tripExpenseRepo) FindClaimByApprovalInstance(ctx context.Context, tenantID, instanceID uuid.UUID) (*model.TripExpenseClaim, error) {
+	sql := `SELECT ` + tripExpenseClaimColumns + ` FROM hrm_trip_expense_claims WHERE tenant_id = $1 AND approval_instance_id = $2`
+
+	claim, err := scanTripExpenseClaim(r.db.QueryRow(ctx, sql, tenantID, instanceID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return claim, nil
+}
+
+func (r *tripExpenseRepo) SaveClaim(ctx context.Context, claim *model.TripExpenseClaim) error {
+	sql := `
+		INSERT INTO hrm_trip_expense_claims (
+			trip_id, tenant_id, employee_id, status,
+			currency, total_amount, over_policy_amount, over_policy_count,
+			policy_id, destination_tier,
+			approval_instance_id, submitted_by, submitted_at, decided_at,
+			created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+		ON CONFLICT (trip_id) DO UPDATE SET
+			status = EXCLUDED.status,
+			currency = EXCLUDED.currency,
+			total_amount = EXCLUDED.total_amount,
+			over_policy_amount = EXCLUDED.over_policy_amount,
+			over_policy_count = EXCLUDED.over_policy_count,
+			policy_id = EXCLUDED.policy_id,
+			destination_tier = EXCLUDED.destination_tier,
+			approval_instance_id = EXCLUDED.approval_instance_id,
+			submitted_by = EXCLUDED.submitted_by,
+			submitted_at = EXCLUDED.submitted_at,
+			decided_at = EXCLUDED.decided_at,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.db.Exec(ctx, sql,
+		claim.TripID, claim.TenantID, claim.EmployeeID, claim.Status,
+		claim.Currency, claim.TotalAmount, claim.OverPolicyAmount, claim.OverPolicyCount,
+		claim.PolicyID, claim.DestinationTier,
+		claim.ApprovalInstanceID, claim.SubmittedBy, claim.SubmittedAt, claim.DecidedAt,
+		claim.CreatedAt, claim.UpdatedAt,
+	)
+	return err
+}
+
+func scanTripExpense(row pgx.Row) (*model.TripExpense, error) {
+	expense := &model.TripExpense{}
+	err := row.Scan(
+		&expense.ID, &expense.TenantID, &expense.TripID, &expense.Category, &expense.Amount, &expense.Currency,
+		&expense.ExpenseDate, &expense.Nights, &expense.Description,
+		&expense.PolicyCap, &expense.OverPolicy, &expense.OverPolicyAmount, &expense.OverPolicyNote,
+		&expense.CreatedBy, &expense.CreatedAt, &expense.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("trip expense not found")
+		}
+		return nil, err
+	}
+	return expense, nil
+}
+
+type tripExpensePolicyRepo struct {
+	db *database.DB
+}
+
+// NewTripExpensePolicyRepository 创建差旅标准仓储
+func NewTripExpensePolicyRepository(db *database.DB) repository.TripExpensePolicyRepository {
+	return &tripExpensePolicyRepo{db: db}
+}
+
+const tripExpensePolicyColumns = `
+	id, tenant_id, name, destination_tier, min_position_level, max_position_level,
+	per_diem_cap, hotel_cap_per_night, currency, is_active,
+	created_by, updated_by, created_at, updated_at
+`
+
+func (r *tripExpensePolicyRepo) Create(ctx context.Context, policy *model.TripExpensePolicy) error {
+	sql := `
+		INSERT INTO hrm_trip_expense_policies (
+			id, tenant_id, name, destination_tier, min_position_level, max_position_level,
+			per_diem_cap, hotel_cap_per_night, currency, is_active,
+			created_by, updated_by, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+	`
+
+	_, err := r.db.Exec(ctx, sql,
+		policy.ID, policy.TenantID, policy.Name, policy.DestinationTier, policy.MinPositionLevel, policy.MaxPositionLevel,
+		policy.PerDiemCap, policy.HotelCapPerNight, policy.Currency, policy.IsActive,
+		policy.CreatedBy, policy.UpdatedBy, policy.CreatedAt, policy.UpdatedAt,
+	)
+	return err
+}
+
+func (r *tripExpensePolicyRepo) Update(ctx context.Context, policy *model.TripExpensePolicy) error {
+	sql := `
+		UPDATE hrm_trip_expense_policies SET
+			name = $1, destination_tier = $2, min_position_level = $3, max_position_level = $4,
+			per_diem_cap = $5, hotel_cap_per_night = $6, currency = $7, is_active = $8,
+			updated_by = $9, updated_at = $10
+		WHERE id = $11 AND tenant_id = $12 AND deleted_at IS NULL
+	`
+
+	_, err := r.db.Exec(ctx, sql,
+		policy.Name, policy.DestinationTier, policy.MinPositionLevel, policy.MaxPositionLevel,
+		policy.PerDiemCap, policy.HotelCapPerNight, policy.Currency, policy.IsActive,
+		policy.UpdatedBy, policy.UpdatedAt,
+		policy.ID, policy.TenantID,
+	)
+	return err
+}
+
+func (r *tripExpensePolicyRepo) Delete(ctx context.Context, tenantID, id uuid.UUID) error {
+	sql := `UPDATE hrm_trip_expense_policies SET deleted_at = NOW() WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+	_, err := r.db.Exec(ctx, sql, id, tenantID)
+	return err
+}
+
+func (r *tripExpensePolicyRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (*model.TripExpensePolicy, error) {
+	sql := `
+		SELECT ` + tripExpensePolicyColumns + `
+		FROM hrm_trip_expense_policies
+		WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+	`
+
+	return scanTripExpensePolicy(r.db.QueryRow(ctx, sql, id, tenantID))
+}
+
+func (r *tripExpensePolicyRepo) List(ctx context.Context, tenantID uuid.UUID) ([]*model.TripExpensePolicy, error) {
+	sql := `
+		SELECT ` + tripExpensePolicyColumns + `
+		FROM hrm_trip_expense_policies
+		WHERE tenant_id = $1 AND deleted_at IS NULL
+		ORDER BY destination_tier, min_position_level
+	`
+
+	rows, err := r.db.Query(ctx, sql, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var policies []*model.TripExpensePolicy
+	for rows.Next() {
+		policy, err := scanTripExpensePolicy(rows)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, policy)
+	}
+	return policies, rows.Err()
+}
+
+func (r *tripExpensePolicyRepo) ListDestinationTiers(ctx context.Context, tenantID uuid.UUID) ([]*model.TripDestinationTier, error) {
+	sql := `
+		SELECT id, tenant_id, destination, tier, created_at
+		FROM hrm_trip_destination_tiers
+		WHERE tenant_id = $1
+		ORDER BY tier, destination
+	`
+
+	rows, err := r.db.Query(ctx, sql, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tiers []*model.TripDestinationTier
+	for rows.Next() {
+		tier := &model.TripDestinationTier{}
+		if err := rows.Scan(&tier.ID, &tier.TenantID, &tier.Destination, &tier.Tier, &tier.CreatedAt); err != nil {
+			return nil, err
+		}
+		tiers = append(tiers, tier)
+	}
+	return tiers, rows.Err()
+}
+
+func (r *tripExpensePolicyRepo) ReplaceDestinationTiers(ctx context.Context, tenantID uuid.UUID, tiers []*model.TripDestinationTier) error {
+	return r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `DELETE FROM hrm_trip_destination_tiers WHERE tenant_id = $1`, tenantID); err != nil {
+			return err
+		}
+
+		sql := `INSERT INTO hrm_trip_destination_tiers (id, tenant_id, destination, tier, created_at) VALUES ($1, $2, $3, $4, $5)`
+		for _, tier := range tiers {
+			if _, err := tx.Exec(ctx, sql, tier.ID, tenantID, tier.Destination, tier.Tier, tier.CreatedAt); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func scanTripExpensePolicy(row pgx.Row) (*model.TripExpensePolicy, error) {
+	policy := &model.TripExpensePolicy{}
+	err := row.Scan(
+		&policy.ID, &policy.TenantID, &policy.Name, &policy.DestinationTier, &policy.MinPositionLevel, &policy.MaxPositionLevel,
+		&policy.PerDiemCap, &policy.HotelCapPerNight, &policy.Currency, &policy.IsActive,
+		&policy.CreatedBy, &policy.UpdatedBy, &policy.CreatedAt, &policy.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("trip expense policy not found")
+		}
+		return nil, err
+	}
+	return policy, nil
+}
+
+const tripExpenseClaimColumns = `
+	trip_id, tenant_id, employee_id, status,
+	currency, total_amount, over_policy_amount, over_policy_count,
+	policy_id, COALESCE(destination_tier, ''),
+	approval_instance_id, submitted_by, submitted_at, decided_at,
+	created_at, updated_at
+`
+
+func scanTripExpenseClaim(row pgx.Row) (*model.TripExpenseClaim, error) {
+	claim := &model.TripExpenseClaim{}
+	err := row.Scan(
+		&claim.TripID, &claim.TenantID, &claim.EmployeeID, &claim.Status,
+		&claim.Currency, &claim.TotalAmount, &claim.OverPolicyAmount, &claim.OverPolicyCount,
+		&claim.PolicyID, &claim.DestinationTier,
+		&claim.ApprovalInstanceID, &claim.SubmittedBy, &claim.SubmittedAt, &claim.DecidedAt,
+		&claim.CreatedAt, &claim.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return claim, nil
+}
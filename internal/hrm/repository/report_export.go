@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+)
+
+// ReportExportRepository 报表导出任务仓储接口
+type ReportExportRepository interface {
+	// Create 创建导出任务
+	Create(ctx context.Context, export *model.ReportExport) error
+
+	// Update 更新任务状态与结果
+	Update(ctx context.Context, export *model.ReportExport) error
+
+	// FindByID 根据ID查找
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (*model.ReportExport, error)
+
+	// List 列表查询（按创建时间倒序分页）
+	List(ctx context.Context, tenantID uuid.UUID, offset, limit int) ([]*model.ReportExport, int, error)
+
+	// ListStale 查询 before 之前未再更新的未结束任务（服务重启后中断的任务）
+	ListStale(ctx context.Context, before time.Time, limit int) ([]*model.ReportExport, error)
+}
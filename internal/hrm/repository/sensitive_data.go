@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ReencryptBatchResult 批量重新加密结果
+type ReencryptBatchResult struct {
+	LastID  uuid.UUID // 本批最后一条记录ID（下一批游标）
+	Scanned int       // 扫描记录数
+	Updated int       // 实际重写记录数
+}
+
+// SensitiveDataRepository 敏感字段重新加密仓储接口（数据密钥轮换后使用）
+type SensitiveDataRepository interface {
+	// ListTenantIDs 列出存在敏感数据的租户
+	ListTenantIDs(ctx context.Context) ([]uuid.UUID, error)
+
+	// ReencryptEmployees 按ID游标重新加密员工身份证号与生物特征，并补齐身份证盲索引
+	ReencryptEmployees(ctx context.Context, tenantID, afterID uuid.UUID, limit int) (*ReencryptBatchResult, error)
+
+	// ReencryptDevices 按ID游标重新加密设备凭据
+	ReencryptDevices(ctx context.Context, tenantID, afterID uuid.UUID, limit int) (*ReencryptBatchResult, error)
+
+	// ReencryptBiometricTemplates 按ID游标重新加密生物特征模板
+	ReencryptBiometricTemplates(ctx context.Context, tenantID, afterID uuid.UUID, limit int) (*ReencryptBatchResult, error)
+}
@@ -79,6 +79,67 @@ type ScheduleRepository interface {
 
 	// 游标分页查询（高性能，适用于大数据量）
 	ListWithCursor(ctx context.Context, tenantID uuid.UUID, filter *ScheduleFilter, cursor *time.Time, limit int) ([]*model.Schedule, *time.Time, bool, error)
+
+	// FindByDepartmentRange 查询部门在 [start, end] 内的排班
+	FindByDepartmentRange(ctx context.Context, tenantID, departmentID uuid.UUID, start, end time.Time) ([]*model.Schedule, error)
+
+	// FindByEmployeesRange 查询多名员工在 [start, end] 内的排班（不限部门）
+	FindByEmployeesRange(ctx context.Context, tenantID uuid.UUID, employeeIDs []uuid.UUID, start, end time.Time) ([]*model.Schedule, error)
+
+	// ReplaceDrafts 在一个事务内删除员工在 [start, end] 内的草稿排班并写入新排班，返回删除条数
+	ReplaceDrafts(ctx context.Context, tenantID uuid.UUID, employeeIDs []uuid.UUID, start, end time.Time, schedules []*model.Schedule) (int64, error)
+
+	// UpdateStatus 批量更新排班状态
+	UpdateStatus(ctx context.Context, ids []uuid.UUID, status string, updatedBy uuid.UUID) (int64, error)
+}
+
+// RotationTemplateRepository 轮班模板仓储接口
+type RotationTemplateRepository interface {
+	// Create 创建模板
+	Create(ctx context.Context, template *model.RotationTemplate) error
+
+	// FindByID 根据ID查找
+	FindByID(ctx context.Context, id uuid.UUID) (*model.RotationTemplate, error)
+
+	// FindByCode 根据编码查找
+	FindByCode(ctx context.Context, tenantID uuid.UUID, code string) (*model.RotationTemplate, error)
+
+	// Update 更新模板
+	Update(ctx context.Context, template *model.RotationTemplate) error
+
+	// Delete 删除模板（软删除）
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// List 查询租户模板
+	List(ctx context.Context, tenantID uuid.UUID) ([]*model.RotationTemplate, error)
+}
+
+// ShiftSwapRepository 换班申请仓储接口
+type ShiftSwapRepository interface {
+	// Create 创建申请
+	Create(ctx context.Context, request *model.ShiftSwapRequest) error
+
+	// FindByID 根据ID查找
+	FindByID(ctx context.Context, id uuid.UUID) (*model.ShiftSwapRequest, error)
+
+	// Update 更新申请状态及确认、审批信息
+	Update(ctx context.Context, request *model.ShiftSwapRequest) error
+
+	// List 查询租户申请（按创建时间倒序）
+	List(ctx context.Context, tenantID uuid.UUID, filter *ShiftSwapFilter) ([]*model.ShiftSwapRequest, error)
+
+	// CountOpenBySchedule 统计涉及某条排班且仍在流转中的申请
+	CountOpenBySchedule(ctx context.Context, scheduleID uuid.UUID) (int, error)
+
+	// ApplyApproval 在一个事务内交换排班并将申请置为已批准；
+	// 排班在申请后被修改或删除时不做任何变更并返回 false
+	ApplyApproval(ctx context.Context, request *model.ShiftSwapRequest) (bool, error)
+}
+
+// ShiftSwapFilter 换班申请查询过滤器
+type ShiftSwapFilter struct {
+	EmployeeID *uuid.UUID // 申请人或对方
+	Status     *model.ShiftSwapStatus
 }
 
 // ScheduleFilter 排班查询过滤器
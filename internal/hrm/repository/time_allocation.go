@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+)
+
+// TimeAllocationRepository 员工时间占用索引仓储接口
+type TimeAllocationRepository interface {
+	// Upsert 按 (类型, 申请ID) 写入或更新占用
+	Upsert(ctx context.Context, allocation *model.TimeAllocation) error
+
+	// Reserve 锁定员工后检查与 conflictKinds 类型占用的重叠（不含申请自身），无冲突时写入或更新占用。
+	// 同一员工的并发提交在此串行化；返回冲突的占用，以及占用是否为本次新增
+	Reserve(ctx context.Context, allocation *model.TimeAllocation, conflictKinds []model.TimeAllocationKind) ([]*model.TimeAllocation, bool, error)
+
+	// UpdateStatus 更新占用状态，不存在时忽略
+	UpdateStatus(ctx context.Context, kind model.TimeAllocationKind, sourceID uuid.UUID, status model.TimeAllocationStatus) error
+
+	// DeleteBySource 释放申请的占用，不存在时忽略
+	DeleteBySource(ctx context.Context, kind model.TimeAllocationKind, sourceID uuid.UUID) error
+
+	// FindOverlapping 查询员工与 [start, end) 重叠的占用
+	FindOverlapping(ctx context.Context, tenantID, employeeID uuid.UUID, start, end time.Time) ([]*model.TimeAllocation, error)
+
+	// FindByEmployees 查询多名员工与 [start, end) 重叠的占用（按开始时间排序）
+	FindByEmployees(ctx context.Context, tenantID uuid.UUID, employeeIDs []uuid.UUID, start, end time.Time) ([]*model.TimeAllocation, error)
+}
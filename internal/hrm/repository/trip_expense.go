@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+)
+
+// TripExpenseRepository 出差报销仓储接口（明细与报销单）
+type TripExpenseRepository interface {
+	// Create 创建报销明细
+	Create(ctx context.Context, expense *model.TripExpense) error
+
+	// Update 更新报销明细
+	Update(ctx context.Context, expense *model.TripExpense) error
+
+	// Delete 删除报销明细
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// FindByID 根据ID查找
+	FindByID(ctx context.Context, id uuid.UUID) (*model.TripExpense, error)
+
+	// ListByTrip 查询出差的全部报销明细（按费用日期排序）
+	ListByTrip(ctx context.Context, tripID uuid.UUID) ([]*model.TripExpense, error)
+
+	// UpdatePolicyFlags 批量更新明细的标准校验结果
+	UpdatePolicyFlags(ctx context.Context, expenses []*model.TripExpense) error
+
+	// FindClaim 查询出差的报销单
+	FindClaim(ctx context.Context, tripID uuid.UUID) (*model.TripExpenseClaim, error)
+
+	// FindClaimByApprovalInstance 根据审批流程实例查询报销单，没有时返回 nil
+	FindClaimByApprovalInstance(ctx context.Context, tenantID, instanceID uuid.UUID) (*model.TripExpenseClaim, error)
+
+	// SaveClaim 保存报销单（按出差ID新增或覆盖）
+	SaveClaim(ctx context.Context, claim *model.TripExpenseClaim) error
+}
+
+// TripExpensePolicyRepository 差旅标准仓储接口
+type TripExpensePolicyRepository interface {
+	// Create 创建差旅标准
+	Create(ctx context.Context, policy *model.TripExpensePolicy) error
+
+	// Update 更新差旅标准
+	Update(ctx context.Context, policy *model.TripExpensePolicy) error
+
+	// Delete 删除差旅标准
+	Delete(ctx context.Context, tenantID, id uuid.UUID) error
+
+	// FindByID 根据ID查找
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (*model.TripExpensePolicy, error)
+
+	// List 查询租户的差旅标准
+	List(ctx context.Context, tenantID uuid.UUID) ([]*model.TripExpensePolicy, error)
+
+	// ListDestinationTiers 查询租户的目的地级别配置
+	ListDestinationTiers(ctx context.Context, tenantID uuid.UUID) ([]*model.TripDestinationTier, error)
+
+	// ReplaceDestinationTiers 整体替换租户的目的地级别配置
+	ReplaceDestinationTiers(ctx context.Context, tenantID uuid.UUID, tiers []*model.TripDestinationTier) error
+}
@@ -0,0 +1,517 @@
+package service
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+)
+
+const (
+	// attendanceAnomalyCron 夜间异常分析任务执行时间（排在考勤汇总之后）
+	attendanceAnomalyCron = "0 3 * * *"
+
+	// anomalyLookbackDays 夜间任务回看天数，补上迟到上传的设备记录；已生成的异常按指纹去重
+	anomalyLookbackDays = 7
+
+	// anomalyMaxRangeDays 手动分析的最大时间跨度
+	anomalyMaxRangeDays = 31
+
+	// earthRadiusKm 地球平均半径
+	earthRadiusKm = 6371.0
+)
+
+var (
+	ErrAttendanceAnomalyNotFound = errors.New("attendance anomaly not found")
+	ErrAnomalyAlreadyHandled     = errors.New("attendance anomaly already handled")
+	ErrInvalidAnomalyRange       = errors.New("invalid anomaly analysis range")
+)
+
+// AttendanceAnomalyService 考勤异常分析服务接口
+type AttendanceAnomalyService interface {
+	// Analyze 分析租户时间段内的打卡记录，写入新识别的异常
+	Analyze(ctx context.Context, tenantID uuid.UUID, start, end time.Time) (*AnomalyAnalysisResult, error)
+
+	// RunNightly 夜间任务：分析所有租户最近几天的打卡记录
+	RunNightly(ctx context.Context) (*AnomalyRunResult, error)
+
+	// CronSpec 夜间任务的 Cron 表达式
+	CronSpec() string
+
+	// 查询
+	Get(ctx context.Context, tenantID, id uuid.UUID) (*model.AttendanceAnomaly, error)
+	List(ctx context.Context, tenantID uuid.UUID, filter *repository.AttendanceAnomalyFilter, offset, limit int) ([]*model.AttendanceAnomaly, int, error)
+
+	// HR 处理：确认属实或作为误报忽略
+	Acknowledge(ctx context.Context, tenantID, id, operatorID uuid.UUID, comment string) (*model.AttendanceAnomaly, error)
+	Dismiss(ctx context.Context, tenantID, id, operatorID uuid.UUID, comment string) (*model.AttendanceAnomaly, error)
+}
+
+// AnomalyAnalysisResult 单个租户的分析结果
+type AnomalyAnalysisResult struct {
+	Records  int                                 `json:"records"`  // 分析的打卡记录数
+	Detected map[model.AttendanceAnomalyType]int `json:"detected"` // 各类型识别数量
+	Created  int                                 `json:"created"`  // 新增异常数（已存在的不重复生成）
+}
+
+// AnomalyRunResult 夜间分析任务执行结果
+type AnomalyRunResult struct {
+	Tenants int `json:"tenants"`
+	Created int `json:"created"`
+	Failed  int `json:"failed"`
+}
+
+type attendanceAnomalyService struct {
+	anomalyRepo repository.AttendanceAnomalyRepository
+	recordRepo  repository.AttendanceRecordRepository
+	deviceRepo  repository.AttendanceDeviceRepository
+	hrmEmpRepo  repository.HRMEmployeeRepository
+	thresholds  model.AnomalyThresholds
+	now         func() time.Time
+}
+
+// NewAttendanceAnomalyService 创建考勤异常分析服务
+func NewAttendanceAnomalyService(
+	anomalyRepo repository.AttendanceAnomalyRepository,
+	recordRepo repository.AttendanceRecordRepository,
+	deviceRepo repository.AttendanceDeviceRepository,
+	hrmEmpRepo repository.HRMEmployeeRepository,
+) AttendanceAnomalyService {
+	return &attendanceAnomalyService{
+		anomalyRepo: anomalyRepo,
+		recordRepo:  recordRepo,
+		deviceRepo:  deviceRepo,
+		hrmEmpRepo:  hrmEmpRepo,
+		thresholds:  model.DefaultAnomalyThresholds(),
+		now:         time.Now,
+	}
+}
+
+func (s *attendanceAnomalyService) CronSpec() string {
+	return attendanceAnomalyCron
+}
+
+func (s *attendanceAnomalyService) Analyze(ctx context.Context, tenantID uuid.UUID, start, end time.Time) (*AnomalyAnalysisResult, error) {
+	if !end.After(start) || end.Sub(start) > anomalyMaxRangeDays*24*time.Hour {
+		return nil, fmt.Errorf("%w: at most %d days", ErrInvalidAnomalyRange, anomalyMaxRangeDays)
+	}
+
+	records, err := s.recordRepo.FindByDateRange(ctx, tenantID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load attendance records: %w", err)
+	}
+	devices, err := s.deviceRepo.ListActive(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load attendance devices: %w", err)
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].ClockTime.Equal(records[j].ClockTime) {
+			return records[i].ID.String() < records[j].ID.String()
+		}
+		return records[i].ClockTime.Before(records[j].ClockTime)
+	})
+
+	var anomalies []*model.AttendanceAnomaly
+	anomalies = append(anomalies, detectImpossibleTravel(records, s.thresholds)...)
+	anomalies = append(anomalies, detectBuddyPunching(records, s.thresholds)...)
+	anomalies = append(anomalies, detectClockDrift(records, devices, s.thresholds)...)
+	anomalies = append(anomalies, detectRepeatedCoordinates(records, s.thresholds)...)
+
+	result := &AnomalyAnalysisResult{
+		Records:  len(records),
+		Detected: make(map[model.AttendanceAnomalyType]int),
+	}
+	now := s.now()
+	for _, anomaly := range anomalies {
+		anomaly.ID = uuid.Must(uuid.NewV7())
+		anomaly.TenantID = tenantID
+		anomaly.Status = model.AnomalyStatusOpen
+		anomaly.CreatedAt = now
+		anomaly.UpdatedAt = now
+		result.Detected[anomaly.Type]++
+	}
+
+	result.Created, err = s.anomalyRepo.CreateBatch(ctx, anomalies)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save attendance anomalies: %w", err)
+	}
+	return result, nil
+}
+
+func (s *attendanceAnomalyService) RunNightly(ctx context.Context) (*AnomalyRunResult, error) {
+	result := &AnomalyRunResult{}
+	end := truncateDate(s.now())
+	start := end.AddDate(0, 0, -anomalyLookbackDays)
+
+	tenantIDs, err := s.hrmEmpRepo.ListActiveTenantIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result.Tenants = len(tenantIDs)
+
+	var firstErr error
+	for _, tenantID := range tenantIDs {
+		analysis, err := s.Analyze(ctx, tenantID, start, end)
+		if err != nil {
+			result.Failed++
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		result.Created += analysis.Created
+	}
+
+	return result, firstErr
+}
+
+func (s *attendanceAnomalyService) Get(ctx context.Context, tenantID, id uuid.UUID) (*model.AttendanceAnomaly, error) {
+	anomaly, err := s.anomalyRepo.FindByID(ctx, id)
+	if err != nil || anomaly.TenantID != tenantID {
+		return nil, ErrAttendanceAnomalyNotFound
+	}
+	return anomaly, nil
+}
+
+func (s *attendanceAnomalyService) List(ctx context.Context, tenantID uuid.UUID, filter *repository.AttendanceAnomalyFilter, offset, limit int) ([]*model.AttendanceAnomaly, int, error) {
+	return s.anomalyRepo.List(ctx, tenantID, filter, offset, limit)
+}
+
+func (s *attendanceAnomalyService) Acknowledge(ctx context.Context, tenantID, id, operatorID uuid.UUID, comment string) (*model.AttendanceAnomaly, error) {
+	return s.handle(ctx, tenantID, id, operatorID, model.AnomalyStatusAcknowledged, comment)
+}
+
+func (s *attendanceAnomalyService) Dismiss(ctx context.Context, tenantID, id, operatorID uuid.UUID, comment string) (*model.AttendanceAnomaly, error) {
+	return s.handle(ctx, tenantID, id, operatorID, model.AnomalyStatusDismissed, comment)
+}
+
+func (s *attendanceAnomalyService) handle(ctx context.Context, tenantID, id, operatorID uuid.UUID, status model.AttendanceAnomalyStatus, comment string) (*model.AttendanceAnomaly, error) {
+	anomaly, err := s.Get(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if anomaly.Status != model.AnomalyStatusOpen {
+		return nil, fmt.Errorf("%w: %s", ErrAnomalyAlreadyHandled, anomaly.Status)
+	}
+
+	now := s.now()
+	anomaly.Status = status
+	anomaly.HandledBy = &operatorID
+	anomaly.HandledAt = &now
+	anomaly.HandleComment = comment
+	anomaly.UpdatedAt = now
+	if err := s.anomalyRepo.UpdateStatus(ctx, anomaly); err != nil {
+		return nil, err
+	}
+	return anomaly, nil
+}
+
+// detectImpossibleTravel 同一员工相邻两次定位打卡的距离（扣除定位精度）超过最小距离，
+// 且所需速度超过上限
+func detectImpossibleTravel(records []*model.AttendanceRecord, th model.AnomalyThresholds) []*model.AttendanceAnomaly {
+	var anomalies []*model.AttendanceAnomaly
+	last := make(map[uuid.UUID]*model.AttendanceRecord)
+
+	for _, record := range records {
+		if record.Location == nil || record.CheckInMethod == model.MethodManual {
+			continue
+		}
+		prev, ok := last[record.EmployeeID]
+		last[record.EmployeeID] = record
+		if !ok {
+			continue
+		}
+
+		distance := haversineKm(prev.Location, record.Location) - (prev.Location.Accuracy+record.Location.Accuracy)/1000
+		if distance < th.MinTravelKm {
+			continue
+		}
+		minutes := record.ClockTime.Sub(prev.ClockTime).Minutes()
+		speed := math.Inf(1)
+		if minutes > 0 {
+			speed = distance / (minutes / 60)
+		}
+		if speed <= th.MaxSpeedKmh {
+			continue
+		}
+
+		details := map[string]interface{}{
+			"distance_km": round2(distance),
+			"minutes":     round2(minutes),
+		}
+		severity := model.AnomalySeverityHigh
+		if !math.IsInf(speed, 1) {
+			details["speed_kmh"] = round2(speed)
+			if speed < 3*th.MaxSpeedKmh {
+				severity = model.AnomalySeverityMedium
+			}
+		}
+
+		employeeID := record.EmployeeID
+		anomalies = append(anomalies, &model.AttendanceAnomaly{
+			Type:         model.AnomalyImpossibleTravel,
+			Severity:     severity,
+			EmployeeID:   &employeeID,
+			EmployeeName: record.EmployeeName,
+			RecordIDs:    []uuid.UUID{prev.ID, record.ID},
+			OccurredAt:   prev.ClockTime,
+			Description:  fmt.Sprintf("%.0f 分钟内两次打卡相距 %.2f 公里", minutes, distance),
+			Details:      details,
+			Fingerprint:  anomalyFingerprint(model.AnomalyImpossibleTravel, prev.ID.String(), record.ID.String()),
+		})
+	}
+	return anomalies
+}
+
+// detectBuddyPunching 同一台设备在时间窗口内为多名不同员工打卡；
+// 人脸、指纹识别难以代打，严重程度较低
+func detectBuddyPunching(records []*model.AttendanceRecord, th model.AnomalyThresholds) []*model.AttendanceAnomaly {
+	window := time.Duration(th.BuddyWindowSeconds) * time.Second
+	var anomalies []*model.AttendanceAnomaly
+
+	for _, deviceRecords := range groupByDevice(records) {
+		for i := 0; i < len(deviceRecords); {
+			j := i
+			employees := make(map[uuid.UUID]bool)
+			for j < len(deviceRecords) && deviceRecords[j].ClockTime.Sub(deviceRecords[i].ClockTime) <= window {
+				employees[deviceRecords[j].EmployeeID] = true
+				j++
+			}
+			if len(employees) < th.BuddyMinEmployees {
+				i++
+				continue
+			}
+
+			burst := deviceRecords[i:j]
+			ids := make([]string, 0, len(burst))
+			recordIDs := make([]uuid.UUID, 0, len(burst))
+			severity := model.AnomalySeverityLow
+			for _, record := range burst {
+				ids = append(ids, record.ID.String())
+				recordIDs = append(recordIDs, record.ID)
+				if record.CheckInMethod != model.MethodFace && record.CheckInMethod != model.MethodFingerprint {
+					severity = model.AnomalySeverityMedium
+				}
+			}
+			span := burst[len(burst)-1].ClockTime.Sub(burst[0].ClockTime).Seconds()
+
+			anomalies = append(anomalies, &model.AttendanceAnomaly{
+				Type:        model.AnomalyBuddyPunching,
+				Severity:    severity,
+				DeviceSN:    burst[0].SourceID,
+				RecordIDs:   recordIDs,
+				OccurredAt:  burst[0].ClockTime,
+				Description: fmt.Sprintf("设备 %s 在 %.0f 秒内为 %d 名员工打卡", burst[0].SourceID, span, len(employees)),
+				Details: map[string]interface{}{
+					"employees":    len(employees),
+					"span_seconds": span,
+				},
+				Fingerprint: anomalyFingerprint(model.AnomalyBuddyPunching, ids...),
+			})
+			i = j
+		}
+	}
+	return anomalies
+}
+
+// detectClockDrift 设备时钟偏差，按设备和日期汇总：
+//   - 时钟偏快：打卡时间晚于服务器接收时间，或晚于设备最后一次心跳/同步
+//   - 时钟偏慢：推送模式的设备实时上传，接收时间普遍（中位数）滞后于打卡时间
+func detectClockDrift(records []*model.AttendanceRecord, devices []*model.AttendanceDevice, th model.AnomalyThresholds) []*model.AttendanceAnomaly {
+	tolerance := time.Duration(th.ClockDriftMinutes) * time.Minute
+	lookup := make(map[string]*model.AttendanceDevice, len(devices)*2)
+	for _, device := range devices {
+		lookup[device.DeviceSN] = device
+		lookup[device.ID.String()] = device
+	}
+
+	var anomalies []*model.AttendanceAnomaly
+	for sn, deviceRecords := range groupByDevice(records) {
+		device := lookup[sn]
+
+		days := make(map[string][]*model.AttendanceRecord)
+		var dayKeys []string
+		for _, record := range deviceRecords {
+			key := dateKey(record.ClockTime)
+			if _, ok := days[key]; !ok {
+				dayKeys = append(dayKeys, key)
+			}
+			days[key] = append(days[key], record)
+		}
+
+		for _, day := range dayKeys {
+			var ahead time.Duration
+			var aheadIDs []uuid.UUID
+			var lags []time.Duration
+			for _, record := range days[day] {
+				drift := time.Duration(0)
+				if !record.CreatedAt.IsZero() {
+					drift = record.ClockTime.Sub(record.CreatedAt)
+					lags = append(lags, -drift)
+				}
+				if contact := lastContact(device); contact != nil {
+					if d := record.ClockTime.Sub(*contact); d > drift {
+						drift = d
+					}
+				}
+				if drift > tolerance {
+					aheadIDs = append(aheadIDs, record.ID)
+					if drift > ahead {
+						ahead = drift
+					}
+				}
+			}
+
+			direction, drift, recordIDs := "", time.Duration(0), aheadIDs
+			switch {
+			case len(aheadIDs) > 0:
+				direction, drift = "ahead", ahead
+			case device != nil && device.SyncMode == "push" && len(lags) >= 3:
+				if median := medianDuration(lags); median > tolerance {
+					direction, drift = "behind", median
+					recordIDs = make([]uuid.UUID, 0, len(days[day]))
+					for _, record := range days[day] {
+						recordIDs = append(recordIDs, record.ID)
+					}
+				}
+			}
+			if direction == "" {
+				continue
+			}
+
+			severity := model.AnomalySeverityMedium
+			if drift >= time.Hour {
+				severity = model.AnomalySeverityHigh
+			}
+			label := "快"
+			if direction == "behind" {
+				label = "慢"
+			}
+
+			anomalies = append(anomalies, &model.AttendanceAnomaly{
+				Type:        model.AnomalyDeviceClockDrift,
+				Severity:    severity,
+				DeviceSN:    sn,
+				RecordIDs:   recordIDs,
+				OccurredAt:  days[day][0].ClockTime,
+				Description: fmt.Sprintf("设备 %s 时钟偏%s约 %.0f 分钟", sn, label, drift.Minutes()),
+				Details: map[string]interface{}{
+					"direction":     direction,
+					"drift_minutes": round2(drift.Minutes()),
+					"records":       len(recordIDs),
+				},
+				Fingerprint: anomalyFingerprint(model.AnomalyDeviceClockDrift, sn, day),
+			})
+		}
+	}
+	return anomalies
+}
+
+// detectRepeatedCoordinates 同一员工多次打卡的定位坐标完全相同（精确到 6 位小数），
+// 真实 GPS 定位总有漂移，完全一致通常是虚拟定位
+func detectRepeatedCoordinates(records []*model.AttendanceRecord, th model.AnomalyThresholds) []*model.AttendanceAnomaly {
+	type coordinateKey struct {
+		employeeID uuid.UUID
+		coordinate string
+	}
+	groups := make(map[coordinateKey][]*model.AttendanceRecord)
+	var keys []coordinateKey
+	for _, record := range records {
+		if record.Location == nil || record.CheckInMethod == model.MethodManual {
+			continue
+		}
+		key := coordinateKey{
+			employeeID: record.EmployeeID,
+			coordinate: fmt.Sprintf("%.6f,%.6f", record.Location.Latitude, record.Location.Longitude),
+		}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], record)
+	}
+
+	var anomalies []*model.AttendanceAnomaly
+	for _, key := range keys {
+		group := groups[key]
+		if len(group) < th.RepeatedCoordinateCount {
+			continue
+		}
+
+		recordIDs := make([]uuid.UUID, 0, len(group))
+		for _, record := range group {
+			recordIDs = append(recordIDs, record.ID)
+		}
+		employeeID := key.employeeID
+		anomalies = append(anomalies, &model.AttendanceAnomaly{
+			Type:         model.AnomalyRepeatedCoordinates,
+			Severity:     model.AnomalySeverityMedium,
+			EmployeeID:   &employeeID,
+			EmployeeName: group[0].EmployeeName,
+			RecordIDs:    recordIDs,
+			OccurredAt:   group[0].ClockTime,
+			Description:  fmt.Sprintf("%d 次打卡定位坐标完全相同（%s）", len(group), key.coordinate),
+			Details: map[string]interface{}{
+				"coordinate": key.coordinate,
+				"count":      len(group),
+			},
+			Fingerprint: anomalyFingerprint(model.AnomalyRepeatedCoordinates, employeeID.String(), key.coordinate),
+		})
+	}
+	return anomalies
+}
+
+// groupByDevice 按来源设备分组考勤机记录（保持时间顺序）
+func groupByDevice(records []*model.AttendanceRecord) map[string][]*model.AttendanceRecord {
+	groups := make(map[string][]*model.AttendanceRecord)
+	for _, record := range records {
+		if record.SourceType != model.SourceTypeDevice || record.SourceID == "" {
+			continue
+		}
+		groups[record.SourceID] = append(groups[record.SourceID], record)
+	}
+	return groups
+}
+
+// lastContact 服务器最后一次收到设备消息的时间（心跳或拉取同步）
+func lastContact(device *model.AttendanceDevice) *time.Time {
+	if device == nil {
+		return nil
+	}
+	contact := device.LastHeartbeat
+	if device.LastSyncAt != nil && (contact == nil || device.LastSyncAt.After(*contact)) {
+		contact = device.LastSyncAt
+	}
+	return contact
+}
+
+// haversineKm 两点间球面距离（公里）
+func haversineKm(a, b *model.LocationInfo) float64 {
+	lat1, lat2 := a.Latitude*math.Pi/180, b.Latitude*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (b.Longitude - a.Longitude) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+func medianDuration(values []time.Duration) time.Duration {
+	sorted := append([]time.Duration(nil), values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted[len(sorted)/2]
+}
+
+// anomalyFingerprint 异常去重键
+func anomalyFingerprint(anomalyType model.AttendanceAnomalyType, parts ...string) string {
+	sum := sha1.Sum([]byte(string(anomalyType) + "|" + strings.Join(parts, "|")))
+	return hex.EncodeToString(sum[:])
+}
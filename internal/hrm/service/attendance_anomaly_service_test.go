@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+)
+
+type stubAttendanceRecordRepo struct {
+	repository.AttendanceRecordRepository
+	records []*model.AttendanceRecord
+}
+
+func (r *stubAttendanceRecordRepo) FindByDateRange(ctx context.Context, tenantID uuid.UUID, startDate, endDate time.Time) ([]*model.AttendanceRecord, error) {
+	var records []*model.AttendanceRecord
+	for _, record := range r.records {
+		if !record.ClockTime.Before(startDate) && record.ClockTime.Before(endDate) {
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+type stubDeviceRepo struct {
+	repository.AttendanceDeviceRepository
+	devices []*model.AttendanceDevice
+}
+
+func (r *stubDeviceRepo) ListActive(ctx context.Context, tenantID uuid.UUID) ([]*model.AttendanceDevice, error) {
+	return r.devices, nil
+}
+
+// stubAnomalyRepo 内存异常仓储，按指纹去重
+type stubAnomalyRepo struct {
+	repository.AttendanceAnomalyRepository
+	anomalies []*model.AttendanceAnomaly
+}
+
+func (r *stubAnomalyRepo) CreateBatch(ctx context.Context, anomalies []*model.AttendanceAnomaly) (int, error) {
+	created := 0
+	for _, anomaly := range anomalies {
+		if r.byFingerprint(anomaly.Fingerprint) == nil {
+			r.anomalies = append(r.anomalies, anomaly)
+			created++
+		}
+	}
+	return created, nil
+}
+
+func (r *stubAnomalyRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.AttendanceAnomaly, error) {
+	for _, anomaly := range r.anomalies {
+		if anomaly.ID == id {
+			return anomaly, nil
+		}
+	}
+	return nil, errStubNotFound
+}
+
+func (r *stubAnomalyRepo) UpdateStatus(ctx context.Context, anomaly *model.AttendanceAnomaly) error {
+	return nil
+}
+
+func (r *stubAnomalyRepo) byFingerprint(fingerprint string) *model.AttendanceAnomaly {
+	for _, anomaly := range r.anomalies {
+		if anomaly.Fingerprint == fingerprint {
+			return anomaly
+		}
+	}
+	return nil
+}
+
+func (r *stubAnomalyRepo) ofType(anomalyType model.AttendanceAnomalyType) []*model.AttendanceAnomaly {
+	var anomalies []*model.AttendanceAnomaly
+	for _, anomaly := range r.anomalies {
+		if anomaly.Type == anomalyType {
+			anomalies = append(anomalies, anomaly)
+		}
+	}
+	return anomalies
+}
+
+func TestAttendanceAnomalyService_Analyze(t *testing.T) {
+	ctx := context.Background()
+	tenantID := uuid.New()
+	day := time.Date(2025, 6, 10, 0, 0, 0, 0, time.Local)
+	at := func(hour, minute, second int) time.Time {
+		return day.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute + time.Duration(second)*time.Second)
+	}
+
+	located := func(employeeID uuid.UUID, clockTime time.Time, lat, lng float64) *model.AttendanceRecord {
+		return &model.AttendanceRecord{
+			ID: uuid.New(), TenantID: tenantID, EmployeeID: employeeID, EmployeeName: "张三",
+			ClockTime: clockTime, CheckInMethod: model.MethodMobile, SourceType: model.SourceTypeSystem,
+			Location:  &model.LocationInfo{Latitude: lat, Longitude: lng, Accuracy: 30},
+			CreatedAt: clockTime,
+		}
+	}
+	punched := func(sn string, method model.AttendanceMethod, clockTime, receivedAt time.Time) *model.AttendanceRecord {
+		return &model.AttendanceRecord{
+			ID: uuid.New(), TenantID: tenantID, EmployeeID: uuid.New(),
+			ClockTime: clockTime, CheckInMethod: method, SourceType: model.SourceTypeDevice, SourceID: sn,
+			CreatedAt: receivedAt,
+		}
+	}
+	newService := func(records []*model.AttendanceRecord, devices ...*model.AttendanceDevice) (*attendanceAnomalyService, *stubAnomalyRepo) {
+		anomalies := &stubAnomalyRepo{}
+		return &attendanceAnomalyService{
+			anomalyRepo: anomalies,
+			recordRepo:  &stubAttendanceRecordRepo{records: records},
+			deviceRepo:  &stubDeviceRepo{devices: devices},
+			thresholds:  model.DefaultAnomalyThresholds(),
+			now:         func() time.Time { return at(23, 0, 0) },
+		}, anomalies
+	}
+
+	t.Run("impossible travel", func(t *testing.T) {
+		employeeID := uuid.New()
+		records := []*model.AttendanceRecord{
+			located(employeeID, at(9, 0, 0), 31.2304, 121.4737),  // 上海
+			located(employeeID, at(9, 40, 0), 39.9042, 116.4074), // 40 分钟后北京
+			located(employeeID, at(18, 0, 0), 39.9050, 116.4080), // 北京同城，距离小于阈值
+			located(uuid.New(), at(12, 0, 0), 22.5431, 114.0579), // 其他员工
+			located(employeeID, at(20, 0, 0), 39.9400, 116.4500), // 2 小时约 5 公里，速度正常
+		}
+		svc, anomalies := newService(records)
+
+		result, err := svc.Analyze(ctx, tenantID, day, day.AddDate(0, 0, 1))
+		require.NoError(t, err)
+		assert.Equal(t, 5, result.Records)
+
+		travel := anomalies.ofType(model.AnomalyImpossibleTravel)
+		require.Len(t, travel, 1)
+		assert.Equal(t, employeeID, *travel[0].EmployeeID)
+		assert.Equal(t, []uuid.UUID{records[0].ID, records[1].ID}, travel[0].RecordIDs)
+		assert.Equal(t, model.AnomalySeverityHigh, travel[0].Severity)
+		assert.Greater(t, travel[0].Details["distance_km"], 1000.0)
+		assert.Equal(t, model.AnomalyStatusOpen, travel[0].Status)
+	})
+
+	t.Run("buddy punching on one device", func(t *testing.T) {
+		records := []*model.AttendanceRecord{
+			punched("ZK001", model.MethodCard, at(8, 59, 0), at(8, 59, 5)),
+			punched("ZK001", model.MethodCard, at(8, 59, 2), at(8, 59, 5)),
+			punched("ZK001", model.MethodCard, at(8, 59, 4), at(8, 59, 5)),
+			punched("ZK001", model.MethodCard, at(8, 59, 7), at(8, 59, 8)),
+			punched("ZK001", model.MethodCard, at(9, 10, 0), at(9, 10, 0)),
+			// 人脸识别设备排队打卡同样识别，但严重程度低
+			punched("ZK002", model.MethodFace, at(8, 59, 0), at(8, 59, 0)),
+			punched("ZK002", model.MethodFace, at(8, 59, 3), at(8, 59, 3)),
+			punched("ZK002", model.MethodFace, at(8, 59, 6), at(8, 59, 6)),
+			punched("ZK002", model.MethodFace, at(8, 59, 9), at(8, 59, 9)),
+			// 窗口内人数不足
+			punched("ZK003", model.MethodCard, at(8, 59, 0), at(8, 59, 0)),
+			punched("ZK003", model.MethodCard, at(8, 59, 1), at(8, 59, 1)),
+		}
+		svc, anomalies := newService(records)
+
+		_, err := svc.Analyze(ctx, tenantID, day, day.AddDate(0, 0, 1))
+		require.NoError(t, err)
+
+		buddy := anomalies.ofType(model.AnomalyBuddyPunching)
+		require.Len(t, buddy, 2)
+		bySN := map[string]*model.AttendanceAnomaly{buddy[0].DeviceSN: buddy[0], buddy[1].DeviceSN: buddy[1]}
+		require.Contains(t, bySN, "ZK001")
+		assert.Len(t, bySN["ZK001"].RecordIDs, 4)
+		assert.Nil(t, bySN["ZK001"].EmployeeID)
+		assert.Equal(t, model.AnomalySeverityMedium, bySN["ZK001"].Severity)
+		assert.Equal(t, model.AnomalySeverityLow, bySN["ZK002"].Severity)
+	})
+
+	t.Run("device clock drift", func(t *testing.T) {
+		heartbeat := at(10, 0, 0)
+		pushDevice := &model.AttendanceDevice{ID: uuid.New(), DeviceSN: "ZK-PUSH", SyncMode: "push", LastHeartbeat: &heartbeat}
+		pullDevice := &model.AttendanceDevice{ID: uuid.New(), DeviceSN: "ZK-PULL", SyncMode: "pull", LastHeartbeat: &heartbeat}
+		records := []*model.AttendanceRecord{
+			// 打卡时间晚于服务器接收时间：时钟偏快 20 分钟
+			punched("ZK-FAST", model.MethodCard, at(9, 20, 0), at(9, 0, 0)),
+			// 推送设备普遍滞后 15 分钟上传：时钟偏慢
+			punched("ZK-PUSH", model.MethodCard, at(8, 0, 0), at(8, 15, 0)),
+			punched("ZK-PUSH", model.MethodCard, at(8, 30, 0), at(8, 45, 0)),
+			punched("ZK-PUSH", model.MethodCard, at(9, 0, 0), at(9, 15, 0)),
+			// 拉取模式的延迟属于正常的批量同步
+			punched("ZK-PULL", model.MethodCard, at(8, 0, 0), at(9, 0, 0)),
+			punched("ZK-PULL", model.MethodCard, at(8, 30, 0), at(9, 0, 0)),
+			punched("ZK-PULL", model.MethodCard, at(8, 45, 0), at(9, 0, 0)),
+		}
+		svc, anomalies := newService(records, pushDevice, pullDevice)
+
+		_, err := svc.Analyze(ctx, tenantID, day, day.AddDate(0, 0, 1))
+		require.NoError(t, err)
+
+		drift := anomalies.ofType(model.AnomalyDeviceClockDrift)
+		require.Len(t, drift, 2)
+		bySN := map[string]*model.AttendanceAnomaly{drift[0].DeviceSN: drift[0], drift[1].DeviceSN: drift[1]}
+		assert.Equal(t, "ahead", bySN["ZK-FAST"].Details["direction"])
+		assert.Equal(t, 20.0, bySN["ZK-FAST"].Details["drift_minutes"])
+		assert.Equal(t, "behind", bySN["ZK-PUSH"].Details["direction"])
+		assert.Len(t, bySN["ZK-PUSH"].RecordIDs, 3)
+		assert.NotContains(t, bySN, "ZK-PULL")
+	})
+
+	t.Run("punch later than last heartbeat", func(t *testing.T) {
+		heartbeat := at(9, 0, 0)
+		device := &model.AttendanceDevice{ID: uuid.New(), DeviceSN: "ZK-STALE", SyncMode: "pull", LastHeartbeat: &heartbeat}
+		record := punched(device.ID.String(), model.MethodCard, at(11, 30, 0), time.Time{})
+		svc, anomalies := newService([]*model.AttendanceRecord{record}, device)
+
+		_, err := svc.Analyze(ctx, tenantID, day, day.AddDate(0, 0, 1))
+		require.NoError(t, err)
+
+		drift := anomalies.ofType(model.AnomalyDeviceClockDrift)
+		require.Len(t, drift, 1)
+		assert.Equal(t, model.AnomalySeverityHigh, drift[0].Severity)
+		assert.Equal(t, 150.0, drift[0].Details["drift_minutes"])
+	})
+
+	t.Run("repeated coordinates and idempotent reruns", func(t *testing.T) {
+		employeeID := uuid.New()
+		records := []*model.AttendanceRecord{
+			located(employeeID, at(9, 0, 0), 31.230416, 121.473701),
+			located(employeeID, at(12, 0, 0), 31.230416, 121.473701),
+			located(employeeID, at(18, 0, 0), 31.230416, 121.473701),
+			located(uuid.New(), at(9, 0, 0), 31.230416, 121.473701),
+		}
+		manual := located(employeeID, at(19, 0, 0), 31.230416, 121.473701)
+		manual.CheckInMethod = model.MethodManual
+		records = append(records, manual)
+		svc, anomalies := newService(records)
+
+		result, err := svc.Analyze(ctx, tenantID, day, day.AddDate(0, 0, 1))
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Detected[model.AnomalyRepeatedCoordinates])
+		assert.Equal(t, 1, result.Created)
+
+		repeated := anomalies.ofType(model.AnomalyRepeatedCoordinates)
+		require.Len(t, repeated, 1)
+		assert.Equal(t, employeeID, *repeated[0].EmployeeID)
+		assert.Len(t, repeated[0].RecordIDs, 3)
+
+		again, err := svc.Analyze(ctx, tenantID, day, day.AddDate(0, 0, 1))
+		require.NoError(t, err)
+		assert.Equal(t, 1, again.Detected[model.AnomalyRepeatedCoordinates])
+		assert.Zero(t, again.Created)
+	})
+
+	t.Run("range limit", func(t *testing.T) {
+		svc, _ := newService(nil)
+
+		_, err := svc.Analyze(ctx, tenantID, day, day)
+		assert.ErrorIs(t, err, ErrInvalidAnomalyRange)
+		_, err = svc.Analyze(ctx, tenantID, day, day.AddDate(0, 2, 0))
+		assert.ErrorIs(t, err, ErrInvalidAnomalyRange)
+	})
+}
+
+func TestAttendanceAnomalyService_Handle(t *testing.T) {
+	ctx := context.Background()
+	tenantID, operatorID := uuid.New(), uuid.New()
+	anomaly := &model.AttendanceAnomaly{ID: uuid.New(), TenantID: tenantID, Status: model.AnomalyStatusOpen}
+	svc := &attendanceAnomalyService{
+		anomalyRepo: &stubAnomalyRepo{anomalies: []*model.AttendanceAnomaly{anomaly}},
+		now:         time.Now,
+	}
+
+	_, err := svc.Acknowledge(ctx, uuid.New(), anomaly.ID, operatorID, "")
+	assert.ErrorIs(t, err, ErrAttendanceAnomalyNotFound)
+
+	dismissed, err := svc.Dismiss(ctx, tenantID, anomaly.ID, operatorID, "前台公用平板，坐标固定")
+	require.NoError(t, err)
+	assert.Equal(t, model.AnomalyStatusDismissed, dismissed.Status)
+	assert.Equal(t, operatorID, *dismissed.HandledBy)
+	assert.NotNil(t, dismissed.HandledAt)
+
+	_, err = svc.Acknowledge(ctx, tenantID, anomaly.ID, operatorID, "")
+	assert.ErrorIs(t, err, ErrAnomalyAlreadyHandled)
+}
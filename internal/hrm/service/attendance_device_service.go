@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/integration"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/integration/zkteco"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+)
+
+const deviceSyncModePush = "push"
+
+var (
+	ErrAttendanceDeviceNotFound = errors.New("attendance device not found")
+	ErrDeviceSNExists           = errors.New("device serial number already registered")
+	ErrInvalidAttendanceDevice  = errors.New("invalid attendance device")
+	ErrDeviceTypeNotSupported   = errors.New("device type not supported")
+	ErrDeviceUsersNotFound      = errors.New("no matching employees for device")
+	ErrDevicePushCredentials    = errors.New("push device requires a comm key or allowed push addresses")
+)
+
+// AttendanceDeviceService 考勤设备管理服务接口
+type AttendanceDeviceService interface {
+	// 设备登记：推送协议的设备按序列号识别，序列号全局唯一
+	Create(ctx context.Context, device *model.AttendanceDevice) error
+	Update(ctx context.Context, device *model.AttendanceDevice) error
+	Delete(ctx context.Context, tenantID, id uuid.UUID) error
+	Get(ctx context.Context, tenantID, id uuid.UUID) (*model.AttendanceDevice, error)
+	List(ctx context.Context, tenantID uuid.UUID, filter *repository.DeviceFilter, offset, limit int) ([]*model.AttendanceDevice, int, error)
+
+	// PushEmployees 下发员工到设备，返回下发人数
+	PushEmployees(ctx context.Context, tenantID, deviceID uuid.UUID, employeeIDs []uuid.UUID, operatorID uuid.UUID) (int, error)
+
+	// RemoveEmployees 从设备删除员工，返回删除人数
+	RemoveEmployees(ctx context.Context, tenantID, deviceID uuid.UUID, employeeIDs []uuid.UUID, operatorID uuid.UUID) (int, error)
+
+	// ClearRecords 清空设备上的考勤记录（已上传的记录不受影响）
+	ClearRecords(ctx context.Context, tenantID, deviceID uuid.UUID, operatorID uuid.UUID) error
+
+	// ListCommands 查询设备命令及执行结果
+	ListCommands(ctx context.Context, tenantID, deviceID uuid.UUID, offset, limit int) ([]*model.DeviceCommand, int, error)
+}
+
+type attendanceDeviceService struct {
+	deviceRepo  repository.AttendanceDeviceRepository
+	commandRepo repository.DeviceCommandRepository
+	hrmEmpRepo  repository.HRMEmployeeRepository
+}
+
+// NewAttendanceDeviceService 创建考勤设备管理服务
+func NewAttendanceDeviceService(
+	deviceRepo repository.AttendanceDeviceRepository,
+	commandRepo repository.DeviceCommandRepository,
+	hrmEmpRepo repository.HRMEmployeeRepository,
+) AttendanceDeviceService {
+	return &attendanceDeviceService{
+		deviceRepo:  deviceRepo,
+		commandRepo: commandRepo,
+		hrmEmpRepo:  hrmEmpRepo,
+	}
+}
+
+func (s *attendanceDeviceService) Create(ctx context.Context, device *model.AttendanceDevice) error {
+	device.DeviceSN = strings.TrimSpace(device.DeviceSN)
+	if err := validateAttendanceDevice(device); err != nil {
+		return err
+	}
+
+	existing, err := s.deviceRepo.ListBySN(ctx, device.DeviceSN)
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 {
+		return ErrDeviceSNExists
+	}
+
+	if device.DeviceType == model.DeviceTypeZKTeco && device.SyncMode == "" {
+		device.SyncMode = deviceSyncModePush
+	}
+	if device.SyncInterval <= 0 {
+		device.SyncInterval = 15
+	}
+
+	device.ID = uuid.Must(uuid.NewV7())
+	device.Status = model.DeviceStatusOffline
+	now := time.Now()
+	device.CreatedAt = now
+	device.UpdatedAt = now
+
+	return s.deviceRepo.Create(ctx, device)
+}
+
+func (s *attendanceDeviceService) Update(ctx context.Context, device *model.AttendanceDevice) error {
+	existing, err := s.Get(ctx, device.TenantID, device.ID)
+	if err != nil {
+		return err
+	}
+
+	// 序列号是设备身份，不允许修改
+	device.DeviceSN = existing.DeviceSN
+	if err := validateAttendanceDevice(device); err != nil {
+		return err
+	}
+
+	device.UpdatedAt = time.Now()
+	return s.deviceRepo.Update(ctx, device)
+}
+
+func (s *attendanceDeviceService) Delete(ctx context.Context, tenantID, id uuid.UUID) error {
+	if _, err := s.Get(ctx, tenantID, id); err != nil {
+		return err
+	}
+	return s.deviceRepo.Delete(ctx, id)
+}
+
+func (s *attendanceDeviceService) Get(ctx context.Context, tenantID, id uuid.UUID) (*model.AttendanceDevice, error) {
+	device, err := s.deviceRepo.FindByID(ctx, id)
+	if err != nil || device.TenantID != tenantID {
+		return nil, ErrAttendanceDeviceNotFound
+	}
+	return device, nil
+}
+
+func (s *attendanceDeviceService) List(ctx context.Context, tenantID uuid.UUID, filter *repository.DeviceFilter, offset, limit int) ([]*model.AttendanceDevice, int, error) {
+	return s.deviceRepo.List(ctx, tenantID, filter, offset, limit)
+}
+
+func (s *attendanceDeviceService) PushEmployees(ctx context.Context, tenantID, deviceID uuid.UUID, employeeIDs []uuid.UUID, operatorID uuid.UUID) (int, error) {
+	adapter, users, err := s.prepareUserCommand(ctx, tenantID, deviceID, employeeIDs, operatorID)
+	if err != nil {
+		return 0, err
+	}
+
+	employees := deviceEmployees(users)
+	if err := adapter.BatchPushEmployees(ctx, employees); err != nil {
+		return 0, err
+	}
+	return len(employees), nil
+}
+
+func (s *attendanceDeviceService) RemoveEmployees(ctx context.Context, tenantID, deviceID uuid.UUID, employeeIDs []uuid.UUID, operatorID uuid.UUID) (int, error) {
+	adapter, users, err := s.prepareUserCommand(ctx, tenantID, deviceID, employeeIDs, operatorID)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, user := range users {
+		if err := adapter.DeleteEmployee(ctx, user.PIN); err != nil {
+			return 0, err
+		}
+	}
+	return len(users), nil
+}
+
+func (s *attendanceDeviceService) ClearRecords(ctx context.Context, tenantID, deviceID uuid.UUID, operatorID uuid.UUID) error {
+	device, err := s.Get(ctx, tenantID, deviceID)
+	if err != nil {
+		return err
+	}
+	adapter, err := s.adapterFor(device, operatorID)
+	if err != nil {
+		return err
+	}
+	return adapter.ClearRecords(ctx)
+}
+
+func (s *attendanceDeviceService) ListCommands(ctx context.Context, tenantID, deviceID uuid.UUID, offset, limit int) ([]*model.DeviceCommand, int, error) {
+	if _, err := s.Get(ctx, tenantID, deviceID); err != nil {
+		return nil, 0, err
+	}
+	return s.commandRepo.List(ctx, deviceID, offset, limit)
+}
+
+func (s *attendanceDeviceService) prepareUserCommand(ctx context.Context, tenantID, deviceID uuid.UUID, employeeIDs []uuid.UUID, operatorID uuid.UUID) (integration.DeviceAdapter, []*model.DeviceUser, error) {
+	device, err := s.Get(ctx, tenantID, deviceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	adapter, err := s.adapterFor(device, operatorID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	users, err := s.hrmEmpRepo.ListDeviceUsers(ctx, tenantID, employeeIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(users) == 0 {
+		return nil, nil, ErrDeviceUsersNotFound
+	}
+	return adapter, users, nil
+}
+
+func (s *attendanceDeviceService) adapterFor(device *model.AttendanceDevice, operatorID uuid.UUID) (integration.DeviceAdapter, error) {
+	return newDeviceAdapter(device, s.commandRepo, &operatorID)
+}
+
+// newDeviceAdapter 按设备类型和同步方式选择适配器，目前支持 ZKTeco 推送协议
+func newDeviceAdapter(device *model.AttendanceDevice, commandRepo repository.DeviceCommandRepository, operatorID *uuid.UUID) (integration.DeviceAdapter, error) {
+	if device.DeviceType == model.DeviceTypeZKTeco && device.SyncMode == deviceSyncModePush {
+		return zkteco.NewPushAdapter(device, commandRepo, operatorID), nil
+	}
+	return nil, ErrDeviceTypeNotSupported
+}
+
+// deviceEmployees 考勤机用户转换为下发的员工信息（PIN 为工号）
+func deviceEmployees(users []*model.DeviceUser) []*integration.EmployeeDTO {
+	employees := make([]*integration.EmployeeDTO, 0, len(users))
+	for _, user := range users {
+		employees = append(employees, &integration.EmployeeDTO{
+			EmployeeID:   user.EmployeeID.String(),
+			EmployeeNo:   user.PIN,
+			Name:         user.Name,
+			DepartmentID: user.DepartmentID.String(),
+			CardNo:       user.CardNo,
+		})
+	}
+	return employees
+}
+
+func validateAttendanceDevice(device *model.AttendanceDevice) error {
+	if device.DeviceSN == "" || strings.TrimSpace(device.DeviceName) == "" || device.DeviceType == "" {
+		return ErrInvalidAttendanceDevice
+	}
+	if device.SyncMode != "" && device.SyncMode != deviceSyncModePush && device.SyncMode != "pull" {
+		return ErrInvalidAttendanceDevice
+	}
+	for _, entry := range device.PushAllowedIPs {
+		if !model.ValidPushAddress(entry) {
+			return ErrInvalidAttendanceDevice
+		}
+	}
+	// 推送协议的设备不携带登录凭据，仅凭序列号接入会被伪造
+	if device.DeviceType == model.DeviceTypeZKTeco && !device.HasPushCredentials() {
+		return ErrDevicePushCredentials
+	}
+	return nil
+}
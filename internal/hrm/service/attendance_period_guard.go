@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+)
+
+// maxGuardMonths 单次校验的最大月份数（超长出差/请假）
+const maxGuardMonths = 24
+
+var ErrAttendancePeriodLocked = errors.New("attendance period is locked")
+
+// AttendancePeriodGuard 考勤月份守卫：考勤记录、请假、加班、出差、外出、补卡变更前调用
+type AttendancePeriodGuard interface {
+	// BeforeChange 区间 [start, end] 涉及的月份已锁定时拒绝修改；
+	// 已过去的月份登记为待重算，由夜间任务增量刷新汇总
+	BeforeChange(ctx context.Context, tenantID, employeeID uuid.UUID, start, end time.Time) error
+}
+
+type attendancePeriodGuard struct {
+	summaryRepo repository.AttendanceSummaryRepository
+	now         func() time.Time
+}
+
+// NewAttendancePeriodGuard 创建考勤月份守卫
+func NewAttendancePeriodGuard(summaryRepo repository.AttendanceSummaryRepository) AttendancePeriodGuard {
+	return &attendancePeriodGuard{
+		summaryRepo: summaryRepo,
+		now:         time.Now,
+	}
+}
+
+func (g *attendancePeriodGuard) BeforeChange(ctx context.Context, tenantID, employeeID uuid.UUID, start, end time.Time) error {
+	periods := periodsBetween(tenantID, employeeID, start, end)
+
+	// 按租户月份锁判断，尚未生成汇总的员工同样受限；查询失败时拒绝修改
+	for _, period := range periods {
+		locked, err := g.summaryRepo.IsMonthLocked(ctx, tenantID, period.Year, period.Month)
+		if err != nil {
+			return fmt.Errorf("failed to check attendance period lock: %w", err)
+		}
+		if locked {
+			return ErrAttendancePeriodLocked
+		}
+	}
+
+	now := g.now()
+	current := now.Year()*12 + int(now.Month())
+	for _, period := range periods {
+		if period.Year*12+period.Month >= current {
+			continue
+		}
+		if err := g.summaryRepo.MarkDirty(ctx, period); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// periodsBetween 区间 [start, end] 涉及的员工考勤月份
+func periodsBetween(tenantID, employeeID uuid.UUID, start, end time.Time) []*model.AttendancePeriod {
+	if end.Before(start) {
+		start, end = end, start
+	}
+
+	var periods []*model.AttendancePeriod
+	month := time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, start.Location())
+	for !month.After(end) && len(periods) < maxGuardMonths {
+		periods = append(periods, &model.AttendancePeriod{
+			TenantID:   tenantID,
+			EmployeeID: employeeID,
+			Year:       month.Year(),
+			Month:      int(month.Month()),
+		})
+		month = month.AddDate(0, 1, 0)
+	}
+	return periods
+}
+
+// checkPeriod 未注入守卫时放行
+func checkPeriod(ctx context.Context, guard AttendancePeriodGuard, tenantID, employeeID uuid.UUID, start, end time.Time) error {
+	if guard == nil {
+		return nil
+	}
+	return guard.BeforeChange(ctx, tenantID, employeeID, start, end)
+}
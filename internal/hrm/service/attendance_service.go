@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/google/uuid"
@@ -46,6 +48,9 @@ type AttendanceService interface {
 	// BatchImport 批量导入考勤记录（从第三方平台）
 	BatchImport(ctx context.Context, records []*model.AttendanceRecord) error
 
+	// ImportDeviceRecord 导入设备上传的一条记录，已导入过（设备重传）时返回 false
+	ImportDeviceRecord(ctx context.Context, record *model.AttendanceRecord) (bool, error)
+
 	// Update 更新考勤记录
 	Update(ctx context.Context, id uuid.UUID, req *UpdateAttendanceRequest) (*model.AttendanceRecord, error)
 
@@ -72,6 +77,8 @@ type ClockInRequest struct {
 	FaceScore     float64
 	Temperature   float64
 	Remark        string
+	QRToken       string     // 扫描考勤屏动态二维码得到的令牌
+	ApprovalID    *uuid.UUID // 补卡审批ID（补卡申请处理时生成的记录）
 }
 
 // UpdateAttendanceRequest 更新考勤记录请求
@@ -90,6 +97,12 @@ type attendanceService struct {
 	scheduleRepo   repository.ScheduleRepository
 	ruleRepo       repository.AttendanceRuleRepository
 	hrmEmpRepo     repository.HRMEmployeeRepository
+	dayResolver    DayTypeResolver
+	periodGuard    AttendancePeriodGuard
+	overtime       OvertimeService
+	locationRepo   repository.ClockLocationRepository
+	attemptRepo    repository.ClockAttemptRepository
+	qrSigner       *ClockQRSigner
 }
 
 // NewAttendanceService 创建考勤服务
@@ -99,6 +112,12 @@ func NewAttendanceService(
 	scheduleRepo repository.ScheduleRepository,
 	ruleRepo repository.AttendanceRuleRepository,
 	hrmEmpRepo repository.HRMEmployeeRepository,
+	dayResolver DayTypeResolver,
+	periodGuard AttendancePeriodGuard,
+	overtime OvertimeService,
+	locationRepo repository.ClockLocationRepository,
+	attemptRepo repository.ClockAttemptRepository,
+	qrSigner *ClockQRSigner,
 ) AttendanceService {
 	return &attendanceService{
 		attendanceRepo: attendanceRepo,
@@ -106,6 +125,12 @@ func NewAttendanceService(
 		scheduleRepo:   scheduleRepo,
 		ruleRepo:       ruleRepo,
 		hrmEmpRepo:     hrmEmpRepo,
+		dayResolver:    dayResolver,
+		periodGuard:    periodGuard,
+		overtime:       overtime,
+		locationRepo:   locationRepo,
+		attemptRepo:    attemptRepo,
+		qrSigner:       qrSigner,
 	}
 }
 
@@ -121,8 +146,14 @@ func (s *attendanceService) ClockIn(ctx context.Context, req *ClockInRequest) (*
 		return nil, fmt.Errorf("attendance is not active for this employee")
 	}
 
-	// 验证打卡规则
+	// 验证打卡规则，未通过的打卡留档供 HR 复核
 	if err := s.validateClockIn(ctx, hrmEmp, req); err != nil {
+		s.recordRejection(ctx, req, err)
+		return nil, err
+	}
+
+	// 设备补传的历史打卡可能落在已锁定月份
+	if err := checkPeriod(ctx, s.periodGuard, req.TenantID, req.EmployeeID, req.ClockTime, req.ClockTime); err != nil {
 		return nil, err
 	}
 
@@ -168,6 +199,7 @@ func (s *attendanceService) ClockIn(ctx context.Context, req *ClockInRequest) (*
 		PhotoURL:      req.PhotoURL,
 		FaceScore:     req.FaceScore,
 		Temperature:   req.Temperature,
+		ApprovalID:    req.ApprovalID,
 		Remark:        req.Remark,
 		CreatedAt:     time.Now(),
 		UpdatedAt:     time.Now(),
@@ -195,20 +227,29 @@ func (s *attendanceService) ClockIn(ctx context.Context, req *ClockInRequest) (*
 	record.Status = status
 
 	// 判断是否异常
-	if status != model.AttendanceStatusNormal {
+	if isExceptionStatus(status) {
 		record.IsException = true
 		record.ExceptionType = string(status)
-		record.ExceptionReason = s.getExceptionReason(status)
+		record.ExceptionReason = getExceptionReason(status)
 	}
 
 	// 保存记录
 	if err := s.attendanceRepo.Create(ctx, record); err != nil {
 		return nil, fmt.Errorf("create attendance record failed: %w", err)
 	}
+	s.detectOvertime(ctx, record)
 
 	return record, nil
 }
 
+// detectOvertime 下班打卡后按加班政策识别加班，识别失败不影响打卡
+func (s *attendanceService) detectOvertime(ctx context.Context, record *model.AttendanceRecord) {
+	if s.overtime == nil || record.ClockType != model.ClockTypeCheckOut {
+		return
+	}
+	_, _ = s.overtime.DetectFromClockOut(ctx, record)
+}
+
 func (s *attendanceService) ClockOut(ctx context.Context, req *ClockInRequest) (*model.AttendanceRecord, error) {
 	req.ClockType = model.ClockTypeCheckOut
 	return s.ClockIn(ctx, req)
@@ -244,6 +285,14 @@ func (s *attendanceService) CountByStatus(ctx context.Context, tenantID uuid.UUI
 }
 
 func (s *attendanceService) CalculateStatus(ctx context.Context, record *model.AttendanceRecord) (model.AttendanceStatus, error) {
+	// 休息日、节假日（含调休放假）打卡按加班处理，不判断迟到早退
+	if s.dayResolver != nil {
+		day, err := s.dayResolver.Resolve(ctx, record.TenantID, record.EmployeeID, record.ClockTime)
+		if err == nil && !day.IsWorkday() {
+			return model.AttendanceStatusOvertime, nil
+		}
+	}
+
 	// 如果没有班次信息，默认为正常
 	if record.ShiftID == nil {
 		return model.AttendanceStatusNormal, nil
@@ -271,18 +320,52 @@ func (s *attendanceService) CalculateStatus(ctx context.Context, record *model.A
 func (s *attendanceService) BatchImport(ctx context.Context, records []*model.AttendanceRecord) error {
 	// 批量计算状态
 	for _, record := range records {
+		if err := checkPeriod(ctx, s.periodGuard, record.TenantID, record.EmployeeID, record.ClockTime, record.ClockTime); err != nil {
+			return err
+		}
+
 		status, err := s.CalculateStatus(ctx, record)
 		if err == nil {
 			record.Status = status
-			if status != model.AttendanceStatusNormal {
+			if isExceptionStatus(status) {
 				record.IsException = true
 				record.ExceptionType = string(status)
-				record.ExceptionReason = s.getExceptionReason(status)
+				record.ExceptionReason = getExceptionReason(status)
 			}
 		}
 	}
 
-	return s.attendanceRepo.BatchCreate(ctx, records)
+	if err := s.attendanceRepo.BatchCreate(ctx, records); err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		s.detectOvertime(ctx, record)
+	}
+	return nil
+}
+
+func (s *attendanceService) ImportDeviceRecord(ctx context.Context, record *model.AttendanceRecord) (bool, error) {
+	if err := checkPeriod(ctx, s.periodGuard, record.TenantID, record.EmployeeID, record.ClockTime, record.ClockTime); err != nil {
+		return false, err
+	}
+
+	status, err := s.CalculateStatus(ctx, record)
+	if err == nil {
+		record.Status = status
+		if isExceptionStatus(status) {
+			record.IsException = true
+			record.ExceptionType = string(status)
+			record.ExceptionReason = getExceptionReason(status)
+		}
+	}
+
+	created, err := s.attendanceRepo.CreateIfAbsent(ctx, record)
+	if err != nil || !created {
+		return false, err
+	}
+	s.detectOvertime(ctx, record)
+	return true, nil
 }
 
 func (s *attendanceService) Update(ctx context.Context, id uuid.UUID, req *UpdateAttendanceRequest) (*model.AttendanceRecord, error) {
@@ -291,6 +374,10 @@ func (s *attendanceService) Update(ctx context.Context, id uuid.UUID, req *Updat
 		return nil, err
 	}
 
+	if err := checkPeriod(ctx, s.periodGuard, record.TenantID, record.EmployeeID, record.ClockTime, record.ClockTime); err != nil {
+		return nil, err
+	}
+
 	if req.Status != nil {
 		record.Status = *req.Status
 	}
@@ -320,50 +407,172 @@ func (s *attendanceService) Update(ctx context.Context, id uuid.UUID, req *Updat
 }
 
 func (s *attendanceService) Delete(ctx context.Context, id uuid.UUID) error {
+	record, err := s.attendanceRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := checkPeriod(ctx, s.periodGuard, record.TenantID, record.EmployeeID, record.ClockTime, record.ClockTime); err != nil {
+		return err
+	}
+
 	return s.attendanceRepo.Delete(ctx, id)
 }
 
 // validateClockIn 验证打卡规则
 func (s *attendanceService) validateClockIn(ctx context.Context, hrmEmp *model.HRMEmployee, req *ClockInRequest) error {
+	// 手动录入（HR 补记、被拒打卡复核通过）不做定位、WiFi、人脸校验
+	if req.SourceType == model.SourceTypeManual {
+		return nil
+	}
+
 	// 检查定位要求
 	if hrmEmp.RequireLocation && req.Location == nil {
-		return fmt.Errorf("location is required")
+		return clockRejected(model.ClockRejectLocationMissing, nil, "location is required")
 	}
 
 	// 检查WiFi要求
 	if hrmEmp.RequireWiFi && req.WiFiSSID == "" {
-		return fmt.Errorf("wifi connection is required")
+		return clockRejected(model.ClockRejectWiFi, nil, "wifi connection is required")
 	}
 
 	// 检查人脸识别要求
 	if hrmEmp.RequireFace && req.FaceScore == 0 {
-		return fmt.Errorf("face recognition is required")
+		return clockRejected(model.ClockRejectFace, nil, "face recognition is required")
 	}
 
 	// 获取考勤规则
+	var rule *model.AttendanceRule
 	if hrmEmp.AttendanceRuleID != nil {
-		rule, err := s.ruleRepo.FindByID(ctx, *hrmEmp.AttendanceRuleID)
+		found, err := s.ruleRepo.FindByID(ctx, *hrmEmp.AttendanceRuleID)
 		if err == nil {
+			rule = found
+
 			// 验证地理围栏
 			if rule.LocationRequired && !s.isInAllowedLocation(req.Location, rule.AllowedLocations) {
-				return fmt.Errorf("clock in location is not allowed")
+				return clockRejected(model.ClockRejectOutsideGeofence, nil, "clock in location is not allowed")
 			}
 
 			// 验证WiFi
 			if rule.WiFiRequired && !s.isAllowedWiFi(req.WiFiSSID, rule.AllowedWiFi) {
-				return fmt.Errorf("wifi '%s' is not allowed", req.WiFiSSID)
+				return clockRejected(model.ClockRejectWiFi, nil, "wifi '%s' is not allowed", req.WiFiSSID)
 			}
 
 			// 验证人脸识别阈值
 			if rule.FaceRequired && req.FaceScore < rule.FaceThreshold {
-				return fmt.Errorf("face recognition score too low")
+				return clockRejected(model.ClockRejectFace, nil, "face recognition score too low")
 			}
 		}
 	}
 
+	// 打卡地点（多边形围栏、时段、动态二维码）只校验员工自助打卡，考勤机和第三方平台数据不校验
+	if req.SourceType != model.SourceTypeSystem {
+		return nil
+	}
+	if req.CheckInMethod == model.MethodQRCode && req.QRToken == "" {
+		return clockRejected(model.ClockRejectQRRequired, nil, "qr code scan is required")
+	}
+	location, err := s.checkClockLocations(ctx, rule, req)
+	if err != nil {
+		return err
+	}
+	if location != nil && req.Address == "" {
+		req.Address = location.Name
+	}
+
 	return nil
 }
 
+// checkClockLocations 按考勤规则关联的打卡地点和二维码校验打卡，返回匹配到的地点；
+// 规则未关联地点时，有效二维码所属地点即为打卡地点
+func (s *attendanceService) checkClockLocations(ctx context.Context, rule *model.AttendanceRule, req *ClockInRequest) (*model.ClockLocation, error) {
+	if s.locationRepo == nil || ((rule == nil || len(rule.ClockLocationIDs) == 0) && req.QRToken == "") {
+		return nil, nil
+	}
+
+	var claims *ClockQRClaims
+	if req.QRToken != "" {
+		parsed, err := s.qrSigner.Parse(req.QRToken, req.ClockTime)
+		switch {
+		case errors.Is(err, ErrClockQRExpired) && parsed.TenantID == req.TenantID:
+			return nil, &ClockRejectedError{Reason: model.ClockRejectQRExpired, Detail: "qr code expired", LocationID: &parsed.LocationID}
+		case err != nil || parsed.TenantID != req.TenantID:
+			return nil, clockRejected(model.ClockRejectQRInvalid, nil, "invalid qr code")
+		}
+		claims = parsed
+	}
+
+	var locations []*model.ClockLocation
+	if rule != nil && len(rule.ClockLocationIDs) > 0 {
+		found, err := s.locationRepo.FindByIDs(ctx, req.TenantID, rule.ClockLocationIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, location := range found {
+			if location.IsActive {
+				locations = append(locations, location)
+			}
+		}
+	} else {
+		location, err := s.locationRepo.FindByID(ctx, req.TenantID, claims.LocationID)
+		if err != nil || !location.IsActive {
+			return nil, clockRejected(model.ClockRejectQRInvalid, nil, "invalid qr code")
+		}
+		locations = append(locations, location)
+	}
+	if len(locations) == 0 {
+		return nil, nil
+	}
+
+	location, rejection := matchClockLocation(locations, req, claims)
+	if rejection != nil {
+		return nil, rejection
+	}
+
+	// 二维码在有效期内可被转发，同一员工每个周期只能使用一次
+	if claims != nil {
+		fresh, err := s.locationRepo.UseQRCode(ctx, req.TenantID, req.EmployeeID, claims.LocationID, time.Unix(claims.IssuedAt, 0), req.ClockTime)
+		if err != nil {
+			return nil, err
+		}
+		if !fresh {
+			return nil, &ClockRejectedError{Reason: model.ClockRejectQRUsed, Detail: "qr code already used", LocationID: &claims.LocationID}
+		}
+	}
+	return location, nil
+}
+
+// recordRejection 记录被拒打卡，记录失败不影响打卡结果
+func (s *attendanceService) recordRejection(ctx context.Context, req *ClockInRequest, err error) {
+	var rejection *ClockRejectedError
+	if s.attemptRepo == nil || !errors.As(err, &rejection) {
+		return
+	}
+
+	now := time.Now()
+	_ = s.attemptRepo.Create(ctx, &model.ClockAttempt{
+		ID:            uuid.Must(uuid.NewV7()),
+		TenantID:      req.TenantID,
+		EmployeeID:    req.EmployeeID,
+		EmployeeName:  req.EmployeeName,
+		ClockTime:     req.ClockTime,
+		ClockType:     req.ClockType,
+		CheckInMethod: req.CheckInMethod,
+		SourceType:    req.SourceType,
+		LocationID:    rejection.LocationID,
+		Location:      req.Location,
+		Address:       req.Address,
+		WiFiSSID:      req.WiFiSSID,
+		WiFiMAC:       req.WiFiMAC,
+		FaceScore:     req.FaceScore,
+		Reason:        rejection.Reason,
+		Detail:        rejection.Detail,
+		Status:        model.ClockAttemptPending,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	})
+}
+
 // getTodaySchedule 获取当天排班
 func (s *attendanceService) getTodaySchedule(ctx context.Context, tenantID, employeeID uuid.UUID, date time.Time) (*model.Schedule, error) {
 	dateStr := date.Format("2006-01-02")
@@ -460,8 +669,18 @@ func (s *attendanceService) isAllowedWiFi(wifiSSID string, allowedWiFi []string)
 	return false
 }
 
+// isExceptionStatus 是否为异常考勤状态（加班、请假、出差不属于异常）
+func isExceptionStatus(status model.AttendanceStatus) bool {
+	switch status {
+	case model.AttendanceStatusLate, model.AttendanceStatusEarly, model.AttendanceStatusAbsent:
+		return true
+	default:
+		return false
+	}
+}
+
 // getExceptionReason 获取异常原因描述
-func (s *attendanceService) getExceptionReason(status model.AttendanceStatus) string {
+func getExceptionReason(status model.AttendanceStatus) string {
 	switch status {
 	case model.AttendanceStatusLate:
 		return "迟到"
@@ -493,31 +712,13 @@ func setDate(t time.Time, date time.Time) time.Time {
 func calculateDistance(lat1, lon1, lat2, lon2 float64) float64 {
 	const earthRadius = 6371000 // 地球半径（米）
 
-	lat1Rad := lat1 * 3.141592653589793 / 180
-	lat2Rad := lat2 * 3.141592653589793 / 180
-	deltaLat := (lat2 - lat1) * 3.141592653589793 / 180
-	deltaLon := (lon2 - lon1) * 3.141592653589793 / 180
-
-	a := 0.5 - 0.5*cosApprox(deltaLat) + cosApprox(lat1Rad)*cosApprox(lat2Rad)*(1-cosApprox(deltaLon))/2
+	lat1Rad := lat1 * math.Pi / 180
+	lat2Rad := lat2 * math.Pi / 180
+	deltaLat := (lat2 - lat1) * math.Pi / 180
+	deltaLon := (lon2 - lon1) * math.Pi / 180
 
-	return 2 * earthRadius * asinApprox(sqrtApprox(a))
-}
+	a := math.Sin(deltaLat/2)*math.Sin(deltaLat/2) +
+		math.Cos(lat1Rad)*math.Cos(lat2Rad)*math.Sin(deltaLon/2)*math.Sin(deltaLon/2)
 
-// 简化的数学函数
-func cosApprox(x float64) float64 {
-	// 简化实现，实际应使用 math.Cos
-	return 1 - x*x/2
-}
-
-func asinApprox(x float64) float64 {
-	// 简化实现，实际应使用 math.Asin
-	return x
-}
-
-func sqrtApprox(x float64) float64 {
-	// 简化实现，实际应使用 math.Sqrt
-	if x < 0 {
-		return 0
-	}
-	return x
+	return 2 * earthRadius * math.Asin(math.Sqrt(a))
 }
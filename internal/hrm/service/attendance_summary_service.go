@@ -0,0 +1,978 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+)
+
+const (
+	// attendanceSummaryCron 夜间汇总任务执行时间
+	attendanceSummaryCron = "30 1 * * *"
+
+	// dirtyBatchSize 每批处理的待重算月份数
+	dirtyBatchSize = 500
+
+	// defaultDailyHours 未配置班次时的标准日工时
+	defaultDailyHours = 8.0
+
+	// leaveLookbackMonths 跨月请假的回溯月数
+	leaveLookbackMonths = 6
+)
+
+var (
+	ErrSummaryNotFound     = errors.New("attendance summary not found")
+	ErrSummaryLocked       = errors.New("attendance summary is locked")
+	ErrSummaryHasDraft     = errors.New("attendance month has unconfirmed summaries")
+	ErrInvalidSummaryMonth = errors.New("invalid attendance summary month")
+)
+
+// AttendanceSummaryService 月度考勤汇总服务
+type AttendanceSummaryService interface {
+	// Compute 重算员工某月汇总（已锁定的月份返回 ErrSummaryLocked）
+	Compute(ctx context.Context, tenantID, employeeID uuid.UUID, year, month int) (*model.AttendanceSummary, error)
+
+	// ComputeMonth 重算租户某月所有启用考勤员工的草稿汇总，返回重算人数
+	ComputeMonth(ctx context.Context, tenantID uuid.UUID, year, month int) (int, error)
+
+	// RunNightly 夜间任务：重算昨日所在月份，并处理迟到变动登记的待重算月份
+	RunNightly(ctx context.Context) (*SummaryRunResult, error)
+
+	// CronSpec 夜间任务的 Cron 表达式
+	CronSpec() string
+
+	// 查询
+	Get(ctx context.Context, tenantID, employeeID uuid.UUID, year, month int) (*model.AttendanceSummary, error)
+	List(ctx context.Context, tenantID uuid.UUID, filter *repository.AttendanceSummaryFilter, offset, limit int) ([]*model.AttendanceSummary, int, error)
+
+	// MonthSheet 员工某月考勤表：逐日状态按原始数据实时判定，月度合计优先取已保存的汇总
+	MonthSheet(ctx context.Context, tenantID, employeeID uuid.UUID, year, month int) (*model.AttendanceMonthSheet, error)
+
+	// HR 确认与锁定
+	Confirm(ctx context.Context, tenantID, id, confirmedBy uuid.UUID) error
+	ConfirmMonth(ctx context.Context, tenantID uuid.UUID, year, month int, confirmedBy uuid.UUID) (int64, error)
+	LockMonth(ctx context.Context, tenantID uuid.UUID, year, month int) error // 锁定时固化请假、加班和出差补助快照
+	UnlockMonth(ctx context.Context, tenantID uuid.UUID, year, month int) error
+}
+
+// SummaryRunResult 夜间汇总任务执行结果
+type SummaryRunResult struct {
+	Tenants    int `json:"tenants"`
+	Computed   int `json:"computed"`   // 当月重算人数
+	Recomputed int `json:"recomputed"` // 待重算月份处理数
+	Failed     int `json:"failed"`
+}
+
+type attendanceSummaryService struct {
+	summaryRepo      repository.AttendanceSummaryRepository
+	recordRepo       repository.AttendanceRecordRepository
+	leaveRequestRepo repository.LeaveRequestRepository
+	overtimeRepo     repository.OvertimeRepository
+	tripRepo         repository.BusinessTripRepository
+	expenseRepo      repository.TripExpenseRepository
+	leaveOfficeRepo  repository.LeaveOfficeRepository
+	supplementRepo   repository.PunchCardSupplementRepository
+	shiftRepo        repository.ShiftRepository
+	hrmEmpRepo       repository.HRMEmployeeRepository
+	dayResolver      DayTypeResolver
+	now              func() time.Time
+}
+
+// NewAttendanceSummaryService 创建月度考勤汇总服务
+func NewAttendanceSummaryService(
+	summaryRepo repository.AttendanceSummaryRepository,
+	recordRepo repository.AttendanceRecordRepository,
+	leaveRequestRepo repository.LeaveRequestRepository,
+	overtimeRepo repository.OvertimeRepository,
+	tripRepo repository.BusinessTripRepository,
+	expenseRepo repository.TripExpenseRepository,
+	leaveOfficeRepo repository.LeaveOfficeRepository,
+	supplementRepo repository.PunchCardSupplementRepository,
+	shiftRepo repository.ShiftRepository,
+	hrmEmpRepo repository.HRMEmployeeRepository,
+	dayResolver DayTypeResolver,
+) AttendanceSummaryService {
+	return &attendanceSummaryService{
+		summaryRepo:      summaryRepo,
+		recordRepo:       recordRepo,
+		leaveRequestRepo: leaveRequestRepo,
+		overtimeRepo:     overtimeRepo,
+		tripRepo:         tripRepo,
+		expenseRepo:      expenseRepo,
+		leaveOfficeRepo:  leaveOfficeRepo,
+		supplementRepo:   supplementRepo,
+		shiftRepo:        shiftRepo,
+		hrmEmpRepo:       hrmEmpRepo,
+		dayResolver:      dayResolver,
+		now:              time.Now,
+	}
+}
+
+func (s *attendanceSummaryService) CronSpec() string {
+	return attendanceSummaryCron
+}
+
+func (s *attendanceSummaryService) Compute(ctx context.Context, tenantID, employeeID uuid.UUID, year, month int) (*model.AttendanceSummary, error) {
+	now := s.now()
+	start, end := model.MonthRange(year, month, time.Local)
+	if month < 1 || month > 12 || start.After(now) {
+		return nil, ErrInvalidSummaryMonth
+	}
+
+	existing, err := s.summaryRepo.FindByEmployee(ctx, tenantID, employeeID, year, month)
+	if err != nil {
+		existing = nil
+	}
+	if existing != nil && existing.IsLocked() {
+		return existing, ErrSummaryLocked
+	}
+
+	input, err := s.loadSummaryInput(ctx, tenantID, employeeID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	input.Cutoff = end
+	if yesterday := truncateDate(now).AddDate(0, 0, -1); yesterday.Before(end) {
+		input.Cutoff = yesterday
+	}
+
+	// 重算后一律退回草稿，由 HR 重新确认
+	summary := &model.AttendanceSummary{
+		ID:         uuid.Must(uuid.NewV7()),
+		TenantID:   tenantID,
+		EmployeeID: employeeID,
+		Year:       year,
+		Month:      month,
+		Status:     model.AttendanceSummaryStatusDraft,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if existing != nil {
+		summary.ID = existing.ID
+		summary.CreatedAt = existing.CreatedAt
+	}
+	buildAttendanceSummary(summary, input)
+
+	if err := s.summaryRepo.Upsert(ctx, summary); err != nil {
+		return nil, fmt.Errorf("failed to save attendance summary: %w", err)
+	}
+	return summary, nil
+}
+
+func (s *attendanceSummaryService) ComputeMonth(ctx context.Context, tenantID uuid.UUID, year, month int) (int, error) {
+	employees, err := s.hrmEmpRepo.ListActive(ctx, tenantID)
+	if err != nil {
+		return 0, err
+	}
+
+	computed := 0
+	var firstErr error
+	for _, emp := range employees {
+		// 已确认/已锁定的汇总只在底层数据变动时重算
+		if existing, err := s.summaryRepo.FindByEmployee(ctx, tenantID, emp.EmployeeID, year, month); err == nil &&
+			existing.Status != model.AttendanceSummaryStatusDraft {
+			continue
+		}
+
+		if _, err := s.Compute(ctx, tenantID, emp.EmployeeID, year, month); err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("employee %s: %w", emp.EmployeeID, err)
+			}
+			continue
+		}
+		computed++
+	}
+
+	return computed, firstErr
+}
+
+func (s *attendanceSummaryService) RunNightly(ctx context.Context) (*SummaryRunResult, error) {
+	result := &SummaryRunResult{}
+	yesterday := truncateDate(s.now()).AddDate(0, 0, -1)
+
+	tenantIDs, err := s.hrmEmpRepo.ListActiveTenantIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result.Tenants = len(tenantIDs)
+
+	var firstErr error
+	for _, tenantID := range tenantIDs {
+		n, err := s.ComputeMonth(ctx, tenantID, yesterday.Year(), int(yesterday.Month()))
+		result.Computed += n
+		if err != nil {
+			result.Failed++
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	// 迟到的变动：按登记先后逐批重算，失败的保留登记待下次处理
+	for {
+		periods, err := s.summaryRepo.ListDirty(ctx, dirtyBatchSize)
+		if err != nil {
+			return result, err
+		}
+
+		failed := 0
+		for _, period := range periods {
+			startedAt := s.now()
+			_, err := s.Compute(ctx, period.TenantID, period.EmployeeID, period.Year, period.Month)
+			if err != nil && !errors.Is(err, ErrSummaryLocked) && !errors.Is(err, ErrInvalidSummaryMonth) {
+				failed++
+				if firstErr == nil {
+					firstErr = err
+				}
+				continue
+			}
+			if err := s.summaryRepo.ClearDirty(ctx, period, startedAt); err != nil {
+				return result, err
+			}
+			result.Recomputed++
+		}
+		result.Failed += failed
+
+		if failed > 0 || len(periods) < dirtyBatchSize {
+			break
+		}
+	}
+
+	return result, firstErr
+}
+
+func (s *attendanceSummaryService) Get(ctx context.Context, tenantID, employeeID uuid.UUID, year, month int) (*model.AttendanceSummary, error) {
+	summary, err := s.summaryRepo.FindByEmployee(ctx, tenantID, employeeID, year, month)
+	if err != nil {
+		return nil, ErrSummaryNotFound
+	}
+	return summary, nil
+}
+
+func (s *attendanceSummaryService) List(ctx context.Context, tenantID uuid.UUID, filter *repository.AttendanceSummaryFilter, offset, limit int) ([]*model.AttendanceSummary, int, error) {
+	return s.summaryRepo.List(ctx, tenantID, filter, offset, limit)
+}
+
+func (s *attendanceSummaryService) MonthSheet(ctx context.Context, tenantID, employeeID uuid.UUID, year, month int) (*model.AttendanceMonthSheet, error) {
+	if month < 1 || month > 12 {
+		return nil, ErrInvalidSummaryMonth
+	}
+	start, end := model.MonthRange(year, month, time.Local)
+
+	input, err := s.loadSummaryInput(ctx, tenantID, employeeID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	input.Cutoff = end
+	if yesterday := truncateDate(s.now()).AddDate(0, 0, -1); yesterday.Before(end) {
+		input.Cutoff = yesterday
+	}
+
+	sheet := &model.AttendanceMonthSheet{Days: buildDailyStatuses(input), Leaves: buildLeaveBreakdown(input)}
+	if summary, err := s.summaryRepo.FindByEmployee(ctx, tenantID, employeeID, year, month); err == nil {
+		sheet.Summary = summary
+		return sheet, nil
+	}
+
+	// 尚未生成汇总的月份（如夜间任务未跑）按同一份数据临时计算，不落库
+	sheet.Summary = &model.AttendanceSummary{
+		TenantID:   tenantID,
+		EmployeeID: employeeID,
+		Year:       year,
+		Month:      month,
+		Status:     model.AttendanceSummaryStatusDraft,
+	}
+	buildAttendanceSummary(sheet.Summary, input)
+	return sheet, nil
+}
+
+func (s *attendanceSummaryService) Confirm(ctx context.Context, tenantID, id, confirmedBy uuid.UUID) error {
+	summary, err := s.summaryRepo.FindByID(ctx, id)
+	if err != nil || summary.TenantID != tenantID {
+		return ErrSummaryNotFound
+	}
+	if summary.IsLocked() {
+		return ErrSummaryLocked
+	}
+	return s.summaryRepo.ConfirmSummary(ctx, id, confirmedBy)
+}
+
+func (s *attendanceSummaryService) ConfirmMonth(ctx context.Context, tenantID uuid.UUID, year, month int, confirmedBy uuid.UUID) (int64, error) {
+	return s.summaryRepo.ConfirmMonth(ctx, tenantID, year, month, confirmedBy)
+}
+
+// LockMonth 锁定某月汇总：须全部确认后才能锁定，锁定后拒绝修改该月底层数据
+func (s *attendanceSummaryService) LockMonth(ctx context.Context, tenantID uuid.UUID, year, month int) error {
+	counts, err := s.summaryRepo.CountByStatus(ctx, tenantID, year, month)
+	if err != nil {
+		return err
+	}
+	if len(counts) == 0 {
+		return ErrSummaryNotFound
+	}
+	if counts[model.AttendanceSummaryStatusDraft] > 0 {
+		return ErrSummaryHasDraft
+	}
+
+	// 已锁定的汇总保留原快照，只为待锁定的汇总生成
+	confirmed := model.AttendanceSummaryStatusConfirmed
+	summaries, _, err := s.summaryRepo.List(ctx, tenantID, &repository.AttendanceSummaryFilter{
+		Year: &year, Month: &month, Status: &confirmed,
+	}, 0, counts[confirmed])
+	if err != nil {
+		return fmt.Errorf("failed to load attendance summaries: %w", err)
+	}
+
+	start, end := model.MonthRange(year, month, time.Local)
+	snapshots := make(map[uuid.UUID]*model.AttendanceLockSnapshot, len(summaries))
+	for _, summary := range summaries {
+		snapshot, err := s.lockSnapshot(ctx, tenantID, summary.EmployeeID, start, end)
+		if err != nil {
+			return fmt.Errorf("employee %s: %w", summary.EmployeeID, err)
+		}
+		snapshots[summary.ID] = snapshot
+	}
+
+	locked, err := s.summaryRepo.LockSummary(ctx, tenantID, year, month, snapshots)
+	if err != nil {
+		return err
+	}
+	if !locked {
+		// 生成快照期间有汇总被重算退回草稿
+		return ErrSummaryHasDraft
+	}
+	return nil
+}
+
+// lockSnapshot 员工某月按类型拆分的请假、加班和出差补助
+func (s *attendanceSummaryService) lockSnapshot(ctx context.Context, tenantID, employeeID uuid.UUID, start, end time.Time) (*model.AttendanceLockSnapshot, error) {
+	input, err := s.loadSummaryInput(ctx, tenantID, employeeID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	nextMonth := end.AddDate(0, 0, 1)
+	allowances, err := s.tripAllowances(ctx, input.Trips, start, nextMonth)
+	if err != nil {
+		return nil, err
+	}
+	return &model.AttendanceLockSnapshot{
+		Leaves:         buildLeaveBreakdown(input),
+		Overtimes:      buildOvertimeBreakdown(input.Overtimes, start, nextMonth),
+		TripAllowances: allowances,
+	}, nil
+}
+
+// tripAllowances 与当月有交集的已批准出差中，已批准报销单里费用日期在当月的出差补助（按币种合计）
+func (s *attendanceSummaryService) tripAllowances(ctx context.Context, trips []*model.BusinessTrip, monthStart, nextMonth time.Time) ([]*model.AttendanceTripAllowance, error) {
+	if s.expenseRepo == nil {
+		return nil, nil
+	}
+
+	var result []*model.AttendanceTripAllowance
+	byCurrency := make(map[string]*model.AttendanceTripAllowance)
+	for _, trip := range trips {
+		if trip.ApprovalStatus != "approved" || !overlaps(trip.StartTime, trip.EndTime, monthStart, nextMonth) {
+			continue
+		}
+		claim, err := s.expenseRepo.FindClaim(ctx, trip.ID)
+		if err != nil || claim.Status != model.TripExpenseClaimApproved {
+			continue
+		}
+		expenses, err := s.expenseRepo.ListByTrip(ctx, trip.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load trip expenses: %w", err)
+		}
+		for _, expense := range expenses {
+			if expense.Category != model.TripExpensePerDiem || !inRange(expense.ExpenseDate, monthStart, nextMonth) {
+				continue
+			}
+			currency := expense.Currency
+			if currency == "" {
+				currency = model.DefaultExpenseCurrency
+			}
+			entry, ok := byCurrency[currency]
+			if !ok {
+				entry = &model.AttendanceTripAllowance{Currency: currency}
+				byCurrency[currency] = entry
+				result = append(result, entry)
+			}
+			entry.Amount += expense.Amount
+		}
+	}
+	return result, nil
+}
+
+func (s *attendanceSummaryService) UnlockMonth(ctx context.Context, tenantID uuid.UUID, year, month int) error {
+	return s.summaryRepo.UnlockSummary(ctx, tenantID, year, month)
+}
+
+// loadSummaryInput 加载员工某月的考勤、请假、加班、出差、外出和补卡数据
+func (s *attendanceSummaryService) loadSummaryInput(ctx context.Context, tenantID, employeeID uuid.UUID, start, end time.Time) (*summaryInput, error) {
+	in := &summaryInput{
+		Shifts:     make(map[uuid.UUID]*model.Shift),
+		DailyHours: defaultDailyHours,
+	}
+	year := start.Year()
+
+	var err error
+	if in.Days, err = s.dayResolver.ResolveRange(ctx, tenantID, employeeID, start, end); err != nil {
+		return nil, fmt.Errorf("failed to resolve day types: %w", err)
+	}
+
+	if in.Records, err = s.recordRepo.FindByEmployee(ctx, tenantID, employeeID, start, end.AddDate(0, 0, 1)); err != nil {
+		return nil, fmt.Errorf("failed to load attendance records: %w", err)
+	}
+	for _, record := range in.Records {
+		if record.ShiftID == nil {
+			continue
+		}
+		if _, ok := in.Shifts[*record.ShiftID]; ok {
+			continue
+		}
+		shift, err := s.shiftRepo.FindByID(ctx, *record.ShiftID)
+		if err != nil {
+			shift = nil
+		}
+		in.Shifts[*record.ShiftID] = shift
+	}
+
+	if emp, err := s.hrmEmpRepo.FindByEmployeeID(ctx, tenantID, employeeID); err == nil && emp.DefaultShiftID != nil {
+		if shift, err := s.shiftRepo.FindByID(ctx, *emp.DefaultShiftID); err == nil {
+			in.DailyHours = shiftDailyHours(shift)
+		}
+	}
+
+	approved := model.LeaveRequestStatusApproved
+	since := start.AddDate(0, -leaveLookbackMonths, 0)
+	if in.Leaves, _, err = s.leaveRequestRepo.ListByEmployee(ctx, tenantID, employeeID, &repository.LeaveRequestFilter{
+		Status:    &approved,
+		StartDate: &since,
+	}, 0, 1000); err != nil {
+		return nil, fmt.Errorf("failed to load leave requests: %w", err)
+	}
+
+	if in.Overtimes, err = s.overtimeRepo.FindByEmployee(ctx, tenantID, employeeID, year); err != nil {
+		return nil, fmt.Errorf("failed to load overtimes: %w", err)
+	}
+
+	// 出差可能跨年
+	for _, y := range []int{year - 1, year} {
+		trips, err := s.tripRepo.FindByEmployee(ctx, tenantID, employeeID, y)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load business trips: %w", err)
+		}
+		in.Trips = append(in.Trips, trips...)
+	}
+
+	if in.LeaveOffices, err = s.leaveOfficeRepo.FindByEmployee(ctx, tenantID, employeeID, year); err != nil {
+		return nil, fmt.Errorf("failed to load leave offices: %w", err)
+	}
+
+	if in.Supplements, err = s.supplementRepo.FindByEmployee(ctx, tenantID, employeeID, year); err != nil {
+		return nil, fmt.Errorf("failed to load punch card supplements: %w", err)
+	}
+
+	return in, nil
+}
+
+// summaryInput 计算员工某月汇总的原始数据
+type summaryInput struct {
+	Days         []*model.DayInfo // 整月的日期类型
+	Cutoff       time.Time        // 统计截止日（含），之后的日期不计旷工、缺卡
+	DailyHours   float64          // 标准日工时
+	Records      []*model.AttendanceRecord
+	Shifts       map[uuid.UUID]*model.Shift
+	Leaves       []*model.LeaveRequest
+	Overtimes    []*model.Overtime
+	Trips        []*model.BusinessTrip
+	LeaveOffices []*model.LeaveOffice
+	Supplements  []*model.PunchCardSupplement
+}
+
+// dayPunches 某日的打卡情况（含已批准的补卡）
+type dayPunches struct {
+	firstIn *time.Time
+	lastOut *time.Time
+	absent  bool
+}
+
+// buildAttendanceSummary 根据原始数据填充汇总统计项
+func buildAttendanceSummary(summary *model.AttendanceSummary, in *summaryInput) {
+	if len(in.Days) == 0 {
+		return
+	}
+	loc := in.Days[0].Date.Location()
+	monthStart := in.Days[0].Date
+	monthEnd := in.Days[len(in.Days)-1].Date
+	nextMonth := monthEnd.AddDate(0, 0, 1)
+
+	dayTypes := make(map[string]*model.DayInfo, len(in.Days))
+	for _, day := range in.Days {
+		dayTypes[dateKey(day.Date)] = day
+		if day.IsWorkday() {
+			summary.WorkDays++
+		}
+	}
+	summary.StandardWorkHours = round2(float64(summary.WorkDays) * in.DailyHours)
+
+	// 打卡
+	punches := make(map[string]*dayPunches)
+	punchOf := func(t time.Time) *dayPunches {
+		key := dateKey(t.In(loc))
+		p, ok := punches[key]
+		if !ok {
+			p = &dayPunches{}
+			punches[key] = p
+		}
+		return p
+	}
+	for _, record := range in.Records {
+		if summary.EmployeeName == "" {
+			summary.EmployeeName = record.EmployeeName
+			summary.DepartmentID = record.DepartmentID
+		}
+
+		p := punchOf(record.ClockTime)
+		clockTime := record.ClockTime
+		var shift *model.Shift
+		if record.ShiftID != nil {
+			shift = in.Shifts[*record.ShiftID]
+		}
+
+		if record.Status == model.AttendanceStatusAbsent {
+			p.absent = true
+			continue
+		}
+
+		switch record.ClockType {
+		case model.ClockTypeCheckIn:
+			if p.firstIn == nil || clockTime.Before(*p.firstIn) {
+				p.firstIn = &clockTime
+			}
+			if record.Status == model.AttendanceStatusLate {
+				summary.LateCount++
+				summary.LateDuration += lateMinutes(clockTime, shift)
+			}
+		case model.ClockTypeCheckOut:
+			if p.lastOut == nil || clockTime.After(*p.lastOut) {
+				p.lastOut = &clockTime
+			}
+			if record.Status == model.AttendanceStatusEarly {
+				summary.EarlyCount++
+				summary.EarlyDuration += earlyMinutes(clockTime, shift)
+			}
+		}
+	}
+
+	for _, supplement := range in.Supplements {
+		if supplement.ApprovalStatus != "approved" || !inRange(supplement.SupplementDate, monthStart, nextMonth) {
+			continue
+		}
+		p := punchOf(supplement.SupplementDate)
+		supplementTime := supplement.SupplementTime
+		switch supplement.SupplementType {
+		case model.SupplementTypeCheckIn:
+			if p.firstIn == nil || supplementTime.Before(*p.firstIn) {
+				p.firstIn = &supplementTime
+			}
+		case model.SupplementTypeCheckOut:
+			if p.lastOut == nil || supplementTime.After(*p.lastOut) {
+				p.lastOut = &supplementTime
+			}
+		}
+	}
+
+	// 请假、出差覆盖的日期不计旷工、缺卡
+	covered := make(map[string]bool)
+	coverDays := func(start, end time.Time) []string {
+		var keys []string
+		from, to := truncateDate(start.In(loc)), truncateDate(end.In(loc))
+		if from.Before(monthStart) {
+			from = monthStart
+		}
+		if to.After(monthEnd) {
+			to = monthEnd
+		}
+		for d := from; !d.After(to); d = d.AddDate(0, 0, 1) {
+			key := dateKey(d)
+			covered[key] = true
+			keys = append(keys, key)
+		}
+		return keys
+	}
+
+	for _, leave := range in.Leaves {
+		if leave.Status != model.LeaveRequestStatusApproved || !overlaps(leave.StartTime, leave.EndTime, monthStart, nextMonth) {
+			continue
+		}
+		summary.LeaveCount++
+
+		workdays := 0
+		for _, key := range coverDays(leave.StartTime, leave.EndTime) {
+			if day := dayTypes[key]; day != nil && day.IsWorkday() {
+				workdays++
+			}
+		}
+		summary.LeaveDays += leaveDaysInMonth(leave, workdays, monthStart, nextMonth, in.DailyHours)
+	}
+
+	for _, trip := range in.Trips {
+		if trip.ApprovalStatus != "approved" || !overlaps(trip.StartTime, trip.EndTime, monthStart, nextMonth) {
+			continue
+		}
+		summary.TripCount++
+		summary.TripDays += float64(len(coverDays(trip.StartTime, trip.EndTime)))
+	}
+
+	for _, overtime := range in.Overtimes {
+		if overtime.ApprovalStatus != "approved" || !inRange(overtime.StartTime, monthStart, nextMonth) {
+			continue
+		}
+		summary.OvertimeCount++
+		summary.OvertimeHours += overtime.Duration
+		switch overtime.OvertimeType {
+		case model.OvertimeTypeWeekend:
+			summary.WeekendOTHours += overtime.Duration
+		case model.OvertimeTypeHoliday:
+			summary.HolidayOTHours += overtime.Duration
+		}
+		summary.CompOffDays += overtime.CompOffDays
+	}
+
+	for _, leaveOffice := range in.LeaveOffices {
+		if leaveOffice.ApprovalStatus != "approved" || !inRange(leaveOffice.StartTime, monthStart, nextMonth) {
+			continue
+		}
+		summary.LeaveOfficeCount++
+		summary.LeaveOfficeHours += leaveOffice.Duration
+	}
+
+	// 逐日统计出勤、旷工、缺卡和工时
+	for _, day := range in.Days {
+		key := dateKey(day.Date)
+		p := punches[key]
+		if p != nil && p.firstIn != nil && p.lastOut != nil && p.lastOut.After(*p.firstIn) {
+			summary.WorkHours += p.lastOut.Sub(*p.firstIn).Hours()
+		}
+
+		if !day.IsWorkday() || day.Date.After(in.Cutoff) || covered[key] {
+			continue
+		}
+
+		switch {
+		case p == nil || p.absent || (p.firstIn == nil && p.lastOut == nil):
+			summary.AbsentCount++
+			summary.AbsentDays++
+		case p.firstIn == nil || p.lastOut == nil:
+			summary.MissingCount++
+			summary.ActualDays++
+		default:
+			summary.ActualDays++
+		}
+	}
+
+	summary.LeaveDays = round2(summary.LeaveDays)
+	summary.OvertimeHours = round2(summary.OvertimeHours)
+	summary.WeekendOTHours = round2(summary.WeekendOTHours)
+	summary.HolidayOTHours = round2(summary.HolidayOTHours)
+	summary.CompOffDays = round2(summary.CompOffDays)
+	summary.LeaveOfficeHours = round2(summary.LeaveOfficeHours)
+	summary.WorkHours = round2(summary.WorkHours)
+}
+
+// buildDailyStatuses 按与汇总相同的口径逐日判定考勤状态：
+// 请假、出差优先，其次旷工、缺卡、迟到早退；休息日有已批准加班时标记加班
+func buildDailyStatuses(in *summaryInput) []*model.AttendanceDayStatus {
+	if len(in.Days) == 0 {
+		return nil
+	}
+	loc := in.Days[0].Date.Location()
+	monthStart := in.Days[0].Date
+	nextMonth := in.Days[len(in.Days)-1].Date.AddDate(0, 0, 1)
+
+	type dayFlags struct {
+		dayPunches
+		late  bool
+		early bool
+	}
+	flags := make(map[string]*dayFlags)
+	flagOf := func(t time.Time) *dayFlags {
+		key := dateKey(t.In(loc))
+		f, ok := flags[key]
+		if !ok {
+			f = &dayFlags{}
+			flags[key] = f
+		}
+		return f
+	}
+
+	for _, record := range in.Records {
+		f := flagOf(record.ClockTime)
+		clockTime := record.ClockTime
+		switch {
+		case record.Status == model.AttendanceStatusAbsent:
+			f.absent = true
+		case record.ClockType == model.ClockTypeCheckIn:
+			f.firstIn = &clockTime
+			f.late = f.late || record.Status == model.AttendanceStatusLate
+		case record.ClockType == model.ClockTypeCheckOut:
+			f.lastOut = &clockTime
+			f.early = f.early || record.Status == model.AttendanceStatusEarly
+		}
+	}
+	for _, supplement := range in.Supplements {
+		if supplement.ApprovalStatus != "approved" || !inRange(supplement.SupplementDate, monthStart, nextMonth) {
+			continue
+		}
+		f := flagOf(supplement.SupplementDate)
+		supplementTime := supplement.SupplementTime
+		switch supplement.SupplementType {
+		case model.SupplementTypeCheckIn:
+			f.firstIn = &supplementTime
+		case model.SupplementTypeCheckOut:
+			f.lastOut = &supplementTime
+		}
+	}
+
+	marks := make(map[string]model.AttendanceDayCode)
+	mark := func(start, end time.Time, code model.AttendanceDayCode) {
+		for d := truncateDate(start.In(loc)); !d.After(end); d = d.AddDate(0, 0, 1) {
+			if _, ok := marks[dateKey(d)]; !ok {
+				marks[dateKey(d)] = code
+			}
+		}
+	}
+	for _, leave := range in.Leaves {
+		if leave.Status == model.LeaveRequestStatusApproved && overlaps(leave.StartTime, leave.EndTime, monthStart, nextMonth) {
+			mark(leave.StartTime, leave.EndTime, model.AttendanceDayLeave)
+		}
+	}
+	for _, trip := range in.Trips {
+		if trip.ApprovalStatus == "approved" && overlaps(trip.StartTime, trip.EndTime, monthStart, nextMonth) {
+			mark(trip.StartTime, trip.EndTime, model.AttendanceDayTrip)
+		}
+	}
+	overtimeDays := make(map[string]bool)
+	for _, overtime := range in.Overtimes {
+		if overtime.ApprovalStatus == "approved" {
+			overtimeDays[dateKey(overtime.StartTime.In(loc))] = true
+		}
+	}
+
+	statuses := make([]*model.AttendanceDayStatus, 0, len(in.Days))
+	for _, day := range in.Days {
+		key := dateKey(day.Date)
+		status := &model.AttendanceDayStatus{Date: day.Date, DayType: day.Type}
+		statuses = append(statuses, status)
+
+		if !day.IsWorkday() {
+			switch {
+			case overtimeDays[key]:
+				status.Code = model.AttendanceDayOvertime
+			case day.Type == model.DayTypeHoliday:
+				status.Code = model.AttendanceDayHoliday
+			default:
+				status.Code = model.AttendanceDayRest
+			}
+			continue
+		}
+		if code, ok := marks[key]; ok {
+			status.Code = code
+			continue
+		}
+		if day.Date.After(in.Cutoff) {
+			status.Code = model.AttendanceDayPending
+			continue
+		}
+
+		f := flags[key]
+		switch {
+		case f == nil || f.absent || (f.firstIn == nil && f.lastOut == nil):
+			status.Code = model.AttendanceDayAbsent
+		case f.firstIn == nil || f.lastOut == nil:
+			status.Code = model.AttendanceDayMissing
+		case f.late && f.early:
+			status.Code = model.AttendanceDayLateEarly
+		case f.late:
+			status.Code = model.AttendanceDayLate
+		case f.early:
+			status.Code = model.AttendanceDayEarly
+		default:
+			status.Code = model.AttendanceDayNormal
+		}
+	}
+	return statuses
+}
+
+// buildLeaveBreakdown 按请假类型拆分当月请假天数，跨月请假的折算规则与汇总一致
+func buildLeaveBreakdown(in *summaryInput) []*model.AttendanceLeaveDays {
+	if len(in.Days) == 0 {
+		return nil
+	}
+	loc := in.Days[0].Date.Location()
+	monthStart := in.Days[0].Date
+	nextMonth := in.Days[len(in.Days)-1].Date.AddDate(0, 0, 1)
+
+	var result []*model.AttendanceLeaveDays
+	byType := make(map[uuid.UUID]*model.AttendanceLeaveDays)
+	for _, leave := range in.Leaves {
+		if leave.Status != model.LeaveRequestStatusApproved || !overlaps(leave.StartTime, leave.EndTime, monthStart, nextMonth) {
+			continue
+		}
+
+		from, to := truncateDate(leave.StartTime.In(loc)), truncateDate(leave.EndTime.In(loc))
+		workdays := 0
+		for _, day := range in.Days {
+			if day.IsWorkday() && !day.Date.Before(from) && !day.Date.After(to) {
+				workdays++
+			}
+		}
+
+		entry, ok := byType[leave.LeaveTypeID]
+		if !ok {
+			entry = &model.AttendanceLeaveDays{LeaveTypeID: leave.LeaveTypeID, LeaveTypeName: leave.LeaveTypeName}
+			byType[leave.LeaveTypeID] = entry
+			result = append(result, entry)
+		}
+		entry.Days += leaveDaysInMonth(leave, workdays, monthStart, nextMonth, in.DailyHours)
+	}
+
+	for _, entry := range result {
+		entry.Days = round2(entry.Days)
+	}
+	return result
+}
+
+// buildOvertimeBreakdown 当月开始的已批准加班按类型、补偿方式和倍率合并，计薪时长优先取加班政策取整后的时长
+func buildOvertimeBreakdown(overtimes []*model.Overtime, monthStart, nextMonth time.Time) []*model.AttendanceOvertimeHours {
+	type overtimeKey struct {
+		overtimeType model.OvertimeType
+		payType      string
+		payRate      float64
+	}
+
+	var result []*model.AttendanceOvertimeHours
+	byKey := make(map[overtimeKey]*model.AttendanceOvertimeHours)
+	for _, overtime := range overtimes {
+		if overtime.ApprovalStatus != "approved" || !inRange(overtime.StartTime, monthStart, nextMonth) {
+			continue
+		}
+		hours := overtime.BillableHours
+		if hours <= 0 {
+			hours = overtime.Duration
+		}
+
+		key := overtimeKey{overtime.OvertimeType, overtime.PayType, overtime.PayRate}
+		entry, ok := byKey[key]
+		if !ok {
+			entry = &model.AttendanceOvertimeHours{OvertimeType: overtime.OvertimeType, PayType: overtime.PayType, PayRate: overtime.PayRate}
+			byKey[key] = entry
+			result = append(result, entry)
+		}
+		entry.Hours += hours
+	}
+	return result
+}
+
+// leaveDaysInMonth 请假在本月的天数：有按天拆分时按拆分累计；
+// 否则整单落在本月按申请时长，跨月按本月覆盖的工作日
+func leaveDaysInMonth(leave *model.LeaveRequest, workdays int, monthStart, nextMonth time.Time, dailyHours float64) float64 {
+	toDays := func(duration float64) float64 {
+		if leave.Unit == model.LeaveUnitHour && dailyHours > 0 {
+			return duration / dailyHours
+		}
+		return duration
+	}
+
+	if duration, ok := leave.DurationInMonth(monthStart.Year(), int(monthStart.Month())); ok {
+		return toDays(duration)
+	}
+
+	days := toDays(leave.Duration)
+	if !leave.StartTime.Before(monthStart) && leave.EndTime.Before(nextMonth) {
+		return days
+	}
+	return math.Min(days, float64(workdays))
+}
+
+// lateMinutes 迟到分钟数（固定班次按上班时间计算，无法计算时为 0）
+func lateMinutes(clockTime time.Time, shift *model.Shift) int {
+	if shift == nil || shift.WorkStart == "" {
+		return 0
+	}
+	workStart, err := parseTime(shift.WorkStart)
+	if err != nil {
+		return 0
+	}
+	minutes := int(clockTime.Sub(setDate(workStart, clockTime)).Minutes())
+	if minutes < 0 {
+		return 0
+	}
+	return minutes
+}
+
+// earlyMinutes 早退分钟数
+func earlyMinutes(clockTime time.Time, shift *model.Shift) int {
+	if shift == nil || shift.WorkEnd == "" {
+		return 0
+	}
+	workEnd, err := parseTime(shift.WorkEnd)
+	if err != nil {
+		return 0
+	}
+	minutes := int(setDate(workEnd, clockTime).Sub(clockTime).Minutes())
+	if minutes < 0 {
+		return 0
+	}
+	return minutes
+}
+
+// shiftDailyHours 班次的标准日工时（扣除休息时间）
+func shiftDailyHours(shift *model.Shift) float64 {
+	if shift.WorkDuration > 0 {
+		return float64(shift.WorkDuration) / 60
+	}
+
+	workStart, err1 := parseTime(shift.WorkStart)
+	workEnd, err2 := parseTime(shift.WorkEnd)
+	if err1 != nil || err2 != nil {
+		return defaultDailyHours
+	}
+	if !workEnd.After(workStart) {
+		workEnd = workEnd.Add(24 * time.Hour)
+	}
+
+	minutes := workEnd.Sub(workStart).Minutes()
+	for _, rest := range shift.RestPeriods {
+		minutes -= float64(rest.Duration)
+	}
+	if minutes <= 0 {
+		return defaultDailyHours
+	}
+	return minutes / 60
+}
+
+func dateKey(t time.Time) string {
+	return t.Format("2006-01-02")
+}
+
+// inRange t 是否落在 [start, end)
+func inRange(t, start, end time.Time) bool {
+	return !t.Before(start) && t.Before(end)
+}
+
+// overlaps [aStart, aEnd] 与 [bStart, bEnd) 是否有交集
+func overlaps(aStart, aEnd, bStart, bEnd time.Time) bool {
+	return aStart.Before(bEnd) && !aEnd.Before(bStart)
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,446 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+)
+
+type stubSummaryRepo struct {
+	repository.AttendanceSummaryRepository
+	summaries map[string]*model.AttendanceSummary
+	dirty     []*model.AttendancePeriod
+	counts    map[string]int
+	confirmed []*model.AttendanceSummary
+	snapshots map[uuid.UUID]*model.AttendanceLockSnapshot
+	changed   bool // 模拟锁定前有汇总被退回草稿
+	locked    bool
+	months    map[string]bool // 已锁定的租户月份
+	lockErr   error
+}
+
+func summaryKey(year, month int) string {
+	return time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC).Format("2006-01")
+}
+
+func (r *stubSummaryRepo) FindByEmployee(ctx context.Context, tenantID, employeeID uuid.UUID, year, month int) (*model.AttendanceSummary, error) {
+	if s, ok := r.summaries[summaryKey(year, month)]; ok {
+		return s, nil
+	}
+	return nil, errStubNotFound
+}
+
+func (r *stubSummaryRepo) IsMonthLocked(ctx context.Context, tenantID uuid.UUID, year, month int) (bool, error) {
+	if r.lockErr != nil {
+		return false, r.lockErr
+	}
+	return r.months[summaryKey(year, month)], nil
+}
+
+func (r *stubSummaryRepo) MarkDirty(ctx context.Context, period *model.AttendancePeriod) error {
+	r.dirty = append(r.dirty, period)
+	return nil
+}
+
+func (r *stubSummaryRepo) CountByStatus(ctx context.Context, tenantID uuid.UUID, year, month int) (map[string]int, error) {
+	return r.counts, nil
+}
+
+func (r *stubSummaryRepo) List(ctx context.Context, tenantID uuid.UUID, filter *repository.AttendanceSummaryFilter, offset, limit int) ([]*model.AttendanceSummary, int, error) {
+	return r.confirmed, len(r.confirmed), nil
+}
+
+func (r *stubSummaryRepo) LockSummary(ctx context.Context, tenantID uuid.UUID, year, month int, snapshots map[uuid.UUID]*model.AttendanceLockSnapshot) (bool, error) {
+	if r.changed {
+		return false, nil
+	}
+	r.locked = true
+	r.snapshots = snapshots
+	return true, nil
+}
+
+type stubSnapshotLeaveRepo struct {
+	repository.LeaveRequestRepository
+	leaves []*model.LeaveRequest
+}
+
+func (r *stubSnapshotLeaveRepo) ListByEmployee(ctx context.Context, tenantID, employeeID uuid.UUID, filter *repository.LeaveRequestFilter, offset, limit int) ([]*model.LeaveRequest, int, error) {
+	var result []*model.LeaveRequest
+	for _, leave := range r.leaves {
+		if leave.EmployeeID == employeeID {
+			result = append(result, leave)
+		}
+	}
+	return result, len(result), nil
+}
+
+type stubSnapshotLeaveOfficeRepo struct {
+	repository.LeaveOfficeRepository
+}
+
+func (r *stubSnapshotLeaveOfficeRepo) FindByEmployee(ctx context.Context, tenantID, employeeID uuid.UUID, year int) ([]*model.LeaveOffice, error) {
+	return nil, nil
+}
+
+type stubSnapshotSupplementRepo struct {
+	repository.PunchCardSupplementRepository
+}
+
+func (r *stubSnapshotSupplementRepo) FindByEmployee(ctx context.Context, tenantID, employeeID uuid.UUID, year int) ([]*model.PunchCardSupplement, error) {
+	return nil, nil
+}
+
+type stubSnapshotOvertimeRepo struct {
+	repository.OvertimeRepository
+	overtimes []*model.Overtime
+}
+
+func (r *stubSnapshotOvertimeRepo) FindByEmployee(ctx context.Context, tenantID, employeeID uuid.UUID, year int) ([]*model.Overtime, error) {
+	var result []*model.Overtime
+	for _, overtime := range r.overtimes {
+		if overtime.EmployeeID == employeeID {
+			result = append(result, overtime)
+		}
+	}
+	return result, nil
+}
+
+type stubSnapshotTripRepo struct {
+	repository.BusinessTripRepository
+	trips []*model.BusinessTrip
+}
+
+func (r *stubSnapshotTripRepo) FindByEmployee(ctx context.Context, tenantID, employeeID uuid.UUID, year int) ([]*model.BusinessTrip, error) {
+	var result []*model.BusinessTrip
+	for _, trip := range r.trips {
+		if trip.EmployeeID == employeeID && trip.StartTime.Year() == year {
+			result = append(result, trip)
+		}
+	}
+	return result, nil
+}
+
+type stubSnapshotExpenseRepo struct {
+	repository.TripExpenseRepository
+	claims   map[uuid.UUID]*model.TripExpenseClaim
+	expenses map[uuid.UUID][]*model.TripExpense
+}
+
+func (r *stubSnapshotExpenseRepo) FindClaim(ctx context.Context, tripID uuid.UUID) (*model.TripExpenseClaim, error) {
+	claim, ok := r.claims[tripID]
+	if !ok {
+		return nil, errStubNotFound
+	}
+	return claim, nil
+}
+
+func (r *stubSnapshotExpenseRepo) ListByTrip(ctx context.Context, tripID uuid.UUID) ([]*model.TripExpense, error) {
+	return r.expenses[tripID], nil
+}
+
+// monthDays 生成整月日期类型（周六日休息）
+func monthDays(year, month int) []*model.DayInfo {
+	start, end := model.MonthRange(year, month, time.UTC)
+	var days []*model.DayInfo
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		dayType := model.DayTypeWorkday
+		if d.Weekday() == time.Saturday || d.Weekday() == time.Sunday {
+			dayType = model.DayTypeWeekend
+		}
+		days = append(days, &model.DayInfo{Date: d, Type: dayType})
+	}
+	return days
+}
+
+func punch(date string, clock string, clockType model.AttendanceClockType, status model.AttendanceStatus, shiftID uuid.UUID) *model.AttendanceRecord {
+	t, _ := time.ParseInLocation("2006-01-02 15:04", date+" "+clock, time.UTC)
+	return &model.AttendanceRecord{ClockTime: t, ClockType: clockType, Status: status, ShiftID: &shiftID, EmployeeName: "张三"}
+}
+
+func TestBuildAttendanceSummary(t *testing.T) {
+	shiftID := uuid.New()
+	shift := &model.Shift{ID: shiftID, WorkStart: "09:00", WorkEnd: "18:00"}
+
+	newInput := func() *summaryInput {
+		return &summaryInput{
+			Days:       monthDays(2025, 9),
+			Cutoff:     mustDate("2025-09-30"),
+			DailyHours: 8,
+			Shifts:     map[uuid.UUID]*model.Shift{shiftID: shift},
+			Records: []*model.AttendanceRecord{
+				punch("2025-09-01", "09:00", model.ClockTypeCheckIn, model.AttendanceStatusNormal, shiftID),
+				punch("2025-09-01", "18:00", model.ClockTypeCheckOut, model.AttendanceStatusNormal, shiftID),
+				punch("2025-09-02", "09:10", model.ClockTypeCheckIn, model.AttendanceStatusLate, shiftID),
+				punch("2025-09-02", "18:00", model.ClockTypeCheckOut, model.AttendanceStatusNormal, shiftID),
+				punch("2025-09-03", "09:00", model.ClockTypeCheckIn, model.AttendanceStatusNormal, shiftID),
+				punch("2025-09-04", "09:00", model.ClockTypeCheckIn, model.AttendanceStatusNormal, shiftID),
+			},
+			Supplements: []*model.PunchCardSupplement{{
+				SupplementDate: mustDate("2025-09-03"),
+				SupplementTime: mustDate("2025-09-03").Add(18 * time.Hour),
+				SupplementType: model.SupplementTypeCheckOut,
+				ApprovalStatus: "approved",
+			}},
+			Leaves: []*model.LeaveRequest{{
+				StartTime: mustDate("2025-09-08"),
+				EndTime:   mustDate("2025-09-09").Add(18 * time.Hour),
+				Duration:  2,
+				Unit:      model.LeaveUnitDay,
+				Status:    model.LeaveRequestStatusApproved,
+			}},
+			Trips: []*model.BusinessTrip{{
+				StartTime:      mustDate("2025-09-29"),
+				EndTime:        mustDate("2025-10-02"),
+				ApprovalStatus: "approved",
+			}},
+			Overtimes: []*model.Overtime{
+				{StartTime: mustDate("2025-09-06").Add(9 * time.Hour), Duration: 4, OvertimeType: model.OvertimeTypeWeekend, CompOffDays: 1, ApprovalStatus: "approved"},
+				{StartTime: mustDate("2025-09-13").Add(9 * time.Hour), Duration: 4, OvertimeType: model.OvertimeTypeWeekend, ApprovalStatus: "pending"},
+			},
+		}
+	}
+
+	t.Run("full month", func(t *testing.T) {
+		summary := &model.AttendanceSummary{}
+		buildAttendanceSummary(summary, newInput())
+
+		assert.Equal(t, "张三", summary.EmployeeName)
+		assert.Equal(t, 22, summary.WorkDays)
+		assert.Equal(t, 176.0, summary.StandardWorkHours)
+		assert.Equal(t, 4, summary.ActualDays)
+		assert.Equal(t, 1, summary.LateCount)
+		assert.Equal(t, 10, summary.LateDuration)
+		// 09-03 的下班卡由补卡补齐，只有 09-04 缺卡
+		assert.Equal(t, 1, summary.MissingCount)
+		// 22 个工作日 - 4 天出勤 - 2 天请假 - 2 天出差
+		assert.Equal(t, 14, summary.AbsentCount)
+		assert.Equal(t, 26.83, summary.WorkHours)
+		assert.Equal(t, 1, summary.LeaveCount)
+		assert.Equal(t, 2.0, summary.LeaveDays)
+		assert.Equal(t, 1, summary.TripCount)
+		assert.Equal(t, 2.0, summary.TripDays)
+		assert.Equal(t, 1, summary.OvertimeCount)
+		assert.Equal(t, 4.0, summary.WeekendOTHours)
+		assert.Equal(t, 1.0, summary.CompOffDays)
+	})
+
+	t.Run("days after cutoff are not absent", func(t *testing.T) {
+		input := newInput()
+		input.Cutoff = mustDate("2025-09-15")
+
+		summary := &model.AttendanceSummary{}
+		buildAttendanceSummary(summary, input)
+
+		assert.Equal(t, 5, summary.AbsentCount) // 09-05、09-10 至 09-12、09-15
+		assert.Equal(t, 22, summary.WorkDays)
+	})
+
+	t.Run("cross month leave counts covered workdays", func(t *testing.T) {
+		input := newInput()
+		input.Leaves = []*model.LeaveRequest{{
+			StartTime: mustDate("2025-08-28"),
+			EndTime:   mustDate("2025-09-02").Add(18 * time.Hour),
+			Duration:  4,
+			Unit:      model.LeaveUnitDay,
+			Status:    model.LeaveRequestStatusApproved,
+		}}
+
+		summary := &model.AttendanceSummary{}
+		buildAttendanceSummary(summary, input)
+
+		assert.Equal(t, 2.0, summary.LeaveDays)
+	})
+}
+
+func TestAttendancePeriodGuard_BeforeChange(t *testing.T) {
+	ctx := context.Background()
+	tenantID, employeeID := uuid.New(), uuid.New()
+	now := func() time.Time { return mustDate("2025-10-15") }
+
+	t.Run("marks past months dirty", func(t *testing.T) {
+		repo := &stubSummaryRepo{}
+		guard := &attendancePeriodGuard{summaryRepo: repo, now: now}
+
+		err := guard.BeforeChange(ctx, tenantID, employeeID, mustDate("2025-08-30"), mustDate("2025-10-02"))
+		require.NoError(t, err)
+		require.Len(t, repo.dirty, 2)
+		assert.Equal(t, 8, repo.dirty[0].Month)
+		assert.Equal(t, 9, repo.dirty[1].Month)
+	})
+
+	t.Run("rejects locked month even without employee summary", func(t *testing.T) {
+		repo := &stubSummaryRepo{months: map[string]bool{summaryKey(2025, 9): true}}
+		guard := &attendancePeriodGuard{summaryRepo: repo, now: now}
+
+		err := guard.BeforeChange(ctx, tenantID, employeeID, mustDate("2025-09-30"), mustDate("2025-10-01"))
+		assert.ErrorIs(t, err, ErrAttendancePeriodLocked)
+		assert.Empty(t, repo.dirty)
+	})
+
+	t.Run("lock lookup failure rejects change", func(t *testing.T) {
+		repo := &stubSummaryRepo{lockErr: errors.New("connection reset")}
+		guard := &attendancePeriodGuard{summaryRepo: repo, now: now}
+
+		err := guard.BeforeChange(ctx, tenantID, employeeID, mustDate("2025-09-30"), mustDate("2025-10-01"))
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrAttendancePeriodLocked)
+		assert.Empty(t, repo.dirty)
+	})
+}
+
+func TestAttendanceSummaryService_LockMonth(t *testing.T) {
+	ctx := context.Background()
+	tenantID := uuid.New()
+
+	t.Run("drafts block locking", func(t *testing.T) {
+		repo := &stubSummaryRepo{counts: map[string]int{
+			model.AttendanceSummaryStatusDraft:     1,
+			model.AttendanceSummaryStatusConfirmed: 9,
+		}}
+		svc := &attendanceSummaryService{summaryRepo: repo}
+
+		assert.ErrorIs(t, svc.LockMonth(ctx, tenantID, 2025, 9), ErrSummaryHasDraft)
+		assert.False(t, repo.locked)
+	})
+
+	t.Run("empty month", func(t *testing.T) {
+		svc := &attendanceSummaryService{summaryRepo: &stubSummaryRepo{counts: map[string]int{}}}
+		assert.ErrorIs(t, svc.LockMonth(ctx, tenantID, 2025, 9), ErrSummaryNotFound)
+	})
+
+	t.Run("all confirmed", func(t *testing.T) {
+		repo := &stubSummaryRepo{counts: map[string]int{model.AttendanceSummaryStatusConfirmed: 10}}
+		svc := &attendanceSummaryService{summaryRepo: repo}
+
+		require.NoError(t, svc.LockMonth(ctx, tenantID, 2025, 9))
+		assert.True(t, repo.locked)
+	})
+
+	t.Run("summary reverted to draft while locking", func(t *testing.T) {
+		repo := &stubSummaryRepo{counts: map[string]int{model.AttendanceSummaryStatusConfirmed: 10}, changed: true}
+		svc := &attendanceSummaryService{summaryRepo: repo}
+
+		assert.ErrorIs(t, svc.LockMonth(ctx, tenantID, 2025, 9), ErrSummaryHasDraft)
+		assert.False(t, repo.locked)
+	})
+
+	t.Run("snapshots leave, overtime and trip allowances", func(t *testing.T) {
+		employeeID, annual := uuid.New(), uuid.New()
+		at := func(month, day, hour int) time.Time {
+			return time.Date(2025, time.Month(month), day, hour, 0, 0, 0, time.Local)
+		}
+		trip := &model.BusinessTrip{ID: uuid.New(), EmployeeID: employeeID, StartTime: at(9, 10, 9), EndTime: at(9, 12, 18), ApprovalStatus: "approved"}
+		summary := &model.AttendanceSummary{ID: uuid.New(), EmployeeID: employeeID, Year: 2025, Month: 9, Status: model.AttendanceSummaryStatusConfirmed}
+
+		repo := &stubSummaryRepo{counts: map[string]int{model.AttendanceSummaryStatusConfirmed: 1}, confirmed: []*model.AttendanceSummary{summary}}
+		svc := &attendanceSummaryService{
+			summaryRepo: repo,
+			recordRepo:  &stubSupplementRecordRepo{},
+			leaveRequestRepo: &stubSnapshotLeaveRepo{leaves: []*model.LeaveRequest{{
+				EmployeeID: employeeID, LeaveTypeID: annual, LeaveTypeName: "年假", Status: model.LeaveRequestStatusApproved,
+				StartTime: at(9, 1, 9), EndTime: at(9, 1, 18), Duration: 1, Unit: model.LeaveUnitDay,
+			}}},
+			overtimeRepo: &stubSnapshotOvertimeRepo{overtimes: []*model.Overtime{
+				{EmployeeID: employeeID, StartTime: at(9, 6, 9), Duration: 8.2, BillableHours: 8, OvertimeType: model.OvertimeTypeWeekend, PayType: "money", PayRate: 2, ApprovalStatus: "approved"},
+				{EmployeeID: employeeID, StartTime: at(9, 13, 9), Duration: 4, OvertimeType: model.OvertimeTypeWeekend, PayType: "money", PayRate: 2, ApprovalStatus: "approved"},
+				{EmployeeID: employeeID, StartTime: at(9, 15, 19), Duration: 2, OvertimeType: model.OvertimeTypeWorkday, PayType: "leave", PayRate: 1.5, ApprovalStatus: "approved"},
+				{EmployeeID: employeeID, StartTime: at(9, 16, 19), Duration: 3, OvertimeType: model.OvertimeTypeWorkday, PayType: "money", PayRate: 1.5, ApprovalStatus: "pending"},
+				{EmployeeID: employeeID, StartTime: at(8, 30, 9), Duration: 6, OvertimeType: model.OvertimeTypeWeekend, PayType: "money", PayRate: 2, ApprovalStatus: "approved"},
+			}},
+			tripRepo: &stubSnapshotTripRepo{trips: []*model.BusinessTrip{trip}},
+			expenseRepo: &stubSnapshotExpenseRepo{
+				claims: map[uuid.UUID]*model.TripExpenseClaim{trip.ID: {TripID: trip.ID, Status: model.TripExpenseClaimApproved}},
+				expenses: map[uuid.UUID][]*model.TripExpense{trip.ID: {
+					{Category: model.TripExpensePerDiem, Amount: 100, Currency: "CNY", ExpenseDate: at(9, 10, 0)},
+					{Category: model.TripExpensePerDiem, Amount: 100, ExpenseDate: at(9, 11, 0)},
+					{Category: model.TripExpenseHotel, Amount: 400, Currency: "CNY", ExpenseDate: at(9, 10, 0)},
+				}},
+			},
+			leaveOfficeRepo: &stubSnapshotLeaveOfficeRepo{},
+			supplementRepo:  &stubSnapshotSupplementRepo{},
+			hrmEmpRepo:      &stubHRMEmployeeRepo{},
+			dayResolver:     &stubDayResolver{},
+			now:             time.Now,
+		}
+
+		require.NoError(t, svc.LockMonth(ctx, tenantID, 2025, 9))
+		snapshot := repo.snapshots[summary.ID]
+		require.NotNil(t, snapshot)
+
+		assert.Equal(t, []*model.AttendanceLeaveDays{{LeaveTypeID: annual, LeaveTypeName: "年假", Days: 1}}, snapshot.Leaves)
+		// 加班按类型、补偿方式和倍率合并，计薪时长优先；未批准和上月加班不计入
+		assert.Equal(t, []*model.AttendanceOvertimeHours{
+			{OvertimeType: model.OvertimeTypeWeekend, PayType: "money", PayRate: 2, Hours: 12},
+			{OvertimeType: model.OvertimeTypeWorkday, PayType: "leave", PayRate: 1.5, Hours: 2},
+		}, snapshot.Overtimes)
+		assert.Equal(t, []*model.AttendanceTripAllowance{{Currency: "CNY", Amount: 200}}, snapshot.TripAllowances)
+	})
+}
+
+func TestBuildDailyStatuses(t *testing.T) {
+	shiftID := uuid.New()
+	input := &summaryInput{
+		Days:   monthDays(2025, 9),
+		Cutoff: mustDate("2025-09-10"),
+		Records: []*model.AttendanceRecord{
+			punch("2025-09-01", "09:00", model.ClockTypeCheckIn, model.AttendanceStatusNormal, shiftID),
+			punch("2025-09-01", "18:00", model.ClockTypeCheckOut, model.AttendanceStatusNormal, shiftID),
+			punch("2025-09-02", "09:10", model.ClockTypeCheckIn, model.AttendanceStatusLate, shiftID),
+			punch("2025-09-02", "17:30", model.ClockTypeCheckOut, model.AttendanceStatusEarly, shiftID),
+			punch("2025-09-03", "09:00", model.ClockTypeCheckIn, model.AttendanceStatusNormal, shiftID),
+		},
+		Leaves: []*model.LeaveRequest{{
+			StartTime: mustDate("2025-09-08"),
+			EndTime:   mustDate("2025-09-08").Add(18 * time.Hour),
+			Status:    model.LeaveRequestStatusApproved,
+		}},
+		Overtimes: []*model.Overtime{
+			{StartTime: mustDate("2025-09-06").Add(9 * time.Hour), ApprovalStatus: "approved"},
+		},
+	}
+
+	statuses := buildDailyStatuses(input)
+	require.Len(t, statuses, 30)
+
+	codes := make(map[string]model.AttendanceDayCode)
+	for _, status := range statuses {
+		codes[dateKey(status.Date)] = status.Code
+	}
+	assert.Equal(t, model.AttendanceDayNormal, codes["2025-09-01"])
+	assert.Equal(t, model.AttendanceDayLateEarly, codes["2025-09-02"])
+	assert.Equal(t, model.AttendanceDayMissing, codes["2025-09-03"])
+	assert.Equal(t, model.AttendanceDayAbsent, codes["2025-09-04"])
+	assert.Equal(t, model.AttendanceDayOvertime, codes["2025-09-06"])
+	assert.Equal(t, model.AttendanceDayRest, codes["2025-09-07"])
+	assert.Equal(t, model.AttendanceDayLeave, codes["2025-09-08"])
+	// 统计截止日之后的工作日尚未判定
+	assert.Equal(t, model.AttendanceDayPending, codes["2025-09-11"])
+}
+
+func TestBuildLeaveBreakdown(t *testing.T) {
+	annual, sick := uuid.New(), uuid.New()
+	input := &summaryInput{
+		Days:       monthDays(2025, 9),
+		DailyHours: 8,
+		Leaves: []*model.LeaveRequest{
+			{LeaveTypeID: annual, LeaveTypeName: "年假", StartTime: mustDate("2025-09-08"), EndTime: mustDate("2025-09-09").Add(18 * time.Hour), Duration: 2, Unit: model.LeaveUnitDay, Status: model.LeaveRequestStatusApproved},
+			{LeaveTypeID: sick, LeaveTypeName: "病假", StartTime: mustDate("2025-09-15").Add(9 * time.Hour), EndTime: mustDate("2025-09-15").Add(13 * time.Hour), Duration: 4, Unit: model.LeaveUnitHour, Status: model.LeaveRequestStatusApproved},
+			// 跨月请假按本月覆盖的工作日计
+			{LeaveTypeID: annual, LeaveTypeName: "年假", StartTime: mustDate("2025-09-29"), EndTime: mustDate("2025-10-03").Add(18 * time.Hour), Duration: 5, Unit: model.LeaveUnitDay, Status: model.LeaveRequestStatusApproved},
+			{LeaveTypeID: sick, LeaveTypeName: "病假", StartTime: mustDate("2025-09-22"), EndTime: mustDate("2025-09-22").Add(18 * time.Hour), Duration: 1, Unit: model.LeaveUnitDay, Status: model.LeaveRequestStatusRejected},
+		},
+	}
+
+	leaves := buildLeaveBreakdown(input)
+	require.Len(t, leaves, 2)
+	assert.Equal(t, &model.AttendanceLeaveDays{LeaveTypeID: annual, LeaveTypeName: "年假", Days: 4}, leaves[0])
+	assert.Equal(t, &model.AttendanceLeaveDays{LeaveTypeID: sick, LeaveTypeName: "病假", Days: 0.5}, leaves[1])
+}
@@ -0,0 +1,498 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/integration"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+)
+
+// biometricEnrollmentTTL 远程采集会话有效期，超时后设备上传的模板不再接收
+const biometricEnrollmentTTL = 10 * time.Minute
+
+var (
+	ErrInvalidBiometric           = errors.New("invalid biometric type or index")
+	ErrBiometricTemplatesNotFound = errors.New("employee has no biometric templates")
+)
+
+// BiometricService 生物特征登记与下发服务：在一台设备上采集模板，按设备类型和算法版本保存修订，
+// 再下发到员工所在部门的全部设备；离职时从设备删除，并记录每台设备的下发状态
+type BiometricService interface {
+	// StartEnrollment 在指定设备上为员工启动采集（先下发用户信息）并创建采集会话，
+	// 采集结果由设备在会话有效期内上传后经 Capture 保存
+	StartEnrollment(ctx context.Context, req *BiometricEnrollRequest) (*model.BiometricEnrollment, error)
+
+	// Capture 保存与待采集会话匹配的模板（未发起或已过期的上传丢弃），模板有变化的员工下发到其他设备，返回新保存的模板数
+	Capture(ctx context.Context, device *model.AttendanceDevice, captures []*BiometricCapture) (int, error)
+
+	// Distribute 下发员工及兼容的模板到其部门的全部启用设备（设备未设置部门时视为全员设备），返回下发的设备数
+	Distribute(ctx context.Context, tenantID, employeeID uuid.UUID, operatorID *uuid.UUID) (int, error)
+
+	// Revoke 从员工部门的设备及曾下发过的设备删除员工，返回删除的设备数
+	Revoke(ctx context.Context, tenantID, employeeID uuid.UUID, operatorID *uuid.UUID) (int, error)
+
+	// ListTemplates 查询员工当前的模板（不含模板数据）
+	ListTemplates(ctx context.Context, tenantID, employeeID uuid.UUID) ([]*model.BiometricTemplate, error)
+
+	// ListDistributions 查询下发状态，HR 据此确认员工可以在哪些设备打卡
+	ListDistributions(ctx context.Context, tenantID uuid.UUID, filter *repository.BiometricDistributionFilter) ([]*model.BiometricDistribution, error)
+}
+
+// BiometricEnrollRequest 远程采集请求
+type BiometricEnrollRequest struct {
+	TenantID   uuid.UUID
+	EmployeeID uuid.UUID
+	DeviceID   uuid.UUID
+	Type       model.BiometricType
+	Index      int // 手指编号 0-9，人脸忽略
+	OperatorID uuid.UUID
+}
+
+// BiometricCapture 设备上传的一条模板
+type BiometricCapture struct {
+	PIN              string
+	Type             model.BiometricType
+	Index            int
+	AlgorithmVersion string // 为空时取设备上报的算法版本
+	Template         string
+}
+
+type biometricService struct {
+	biometricRepo repository.BiometricRepository
+	deviceRepo    repository.AttendanceDeviceRepository
+	commandRepo   repository.DeviceCommandRepository
+	hrmEmpRepo    repository.HRMEmployeeRepository
+	now           func() time.Time
+}
+
+// NewBiometricService 创建生物特征登记与下发服务
+func NewBiometricService(
+	biometricRepo repository.BiometricRepository,
+	deviceRepo repository.AttendanceDeviceRepository,
+	commandRepo repository.DeviceCommandRepository,
+	hrmEmpRepo repository.HRMEmployeeRepository,
+) BiometricService {
+	return &biometricService{
+		biometricRepo: biometricRepo,
+		deviceRepo:    deviceRepo,
+		commandRepo:   commandRepo,
+		hrmEmpRepo:    hrmEmpRepo,
+		now:           time.Now,
+	}
+}
+
+func (s *biometricService) StartEnrollment(ctx context.Context, req *BiometricEnrollRequest) (*model.BiometricEnrollment, error) {
+	if !validBiometric(req.Type, req.Index) {
+		return nil, ErrInvalidBiometric
+	}
+
+	device, err := s.deviceRepo.FindByID(ctx, req.DeviceID)
+	if err != nil || device.TenantID != req.TenantID {
+		return nil, ErrAttendanceDeviceNotFound
+	}
+	if !device.IsActive {
+		return nil, ErrDeviceDisabled
+	}
+	user, err := s.findUser(ctx, req.TenantID, req.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	adapter, err := newDeviceAdapter(device, &employeeCommandRepo{DeviceCommandRepository: s.commandRepo, employeeID: user.EmployeeID}, &req.OperatorID)
+	if err != nil {
+		return nil, err
+	}
+	// 设备只能为已存在的用户采集
+	if err := adapter.PushEmployee(ctx, deviceEmployees([]*model.DeviceUser{user})[0]); err != nil {
+		return nil, err
+	}
+	if err := adapter.EnrollBiometric(ctx, user.PIN, req.Type, req.Index); err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	enrollment := &model.BiometricEnrollment{
+		ID:         uuid.Must(uuid.NewV7()),
+		TenantID:   req.TenantID,
+		EmployeeID: user.EmployeeID,
+		DeviceID:   device.ID,
+		Type:       req.Type,
+		Index:      req.Index,
+		Status:     model.BiometricEnrollmentPending,
+		ExpiresAt:  now.Add(biometricEnrollmentTTL),
+		CreatedBy:  req.OperatorID,
+		CreatedAt:  now,
+	}
+	if err := s.biometricRepo.CreateEnrollment(ctx, enrollment); err != nil {
+		return nil, err
+	}
+	return enrollment, nil
+}
+
+func (s *biometricService) Capture(ctx context.Context, device *model.AttendanceDevice, captures []*BiometricCapture) (int, error) {
+	byPIN := make(map[string][]*BiometricCapture)
+	var pins []string
+	for _, capture := range captures {
+		if capture.AlgorithmVersion == "" {
+			capture.AlgorithmVersion = device.BiometricVersion(capture.Type)
+		}
+		// 算法版本未知的模板无法判断兼容性，不保存
+		if !validBiometric(capture.Type, capture.Index) || capture.AlgorithmVersion == "" || capture.Template == "" {
+			continue
+		}
+		if _, ok := byPIN[capture.PIN]; !ok {
+			pins = append(pins, capture.PIN)
+		}
+		byPIN[capture.PIN] = append(byPIN[capture.PIN], capture)
+	}
+	if len(pins) == 0 {
+		return 0, nil
+	}
+
+	users, err := s.hrmEmpRepo.FindDeviceUsersByPIN(ctx, device.TenantID, pins)
+	if err != nil {
+		return 0, err
+	}
+
+	stored := 0
+	for _, pin := range pins {
+		user, ok := users[pin]
+		if !ok {
+			continue
+		}
+		accepted, err := s.claimEnrollments(ctx, device, user, byPIN[pin])
+		if err != nil {
+			return stored, err
+		}
+		if len(accepted) == 0 {
+			continue
+		}
+		n, err := s.captureEmployee(ctx, device, user, accepted)
+		if err != nil {
+			return stored, err
+		}
+		stored += n
+	}
+	return stored, nil
+}
+
+// claimEnrollments 只保留与员工在该设备上待采集会话匹配的模板，并完成对应会话（每个会话只接收一次）；
+// 未经 StartEnrollment 发起、会话已过期或已被接收的上传一律丢弃
+func (s *biometricService) claimEnrollments(ctx context.Context, device *model.AttendanceDevice, user *model.DeviceUser, captures []*BiometricCapture) ([]*BiometricCapture, error) {
+	now := s.now()
+	enrollments, err := s.biometricRepo.ListPendingEnrollments(ctx, device.ID, user.EmployeeID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var accepted []*BiometricCapture
+	taken := make(map[*BiometricCapture]bool, len(captures))
+	for _, enrollment := range enrollments {
+		var matched *BiometricCapture
+		for _, capture := range captures {
+			if enrollment.Accepts(capture.Type, capture.Index) {
+				matched = capture
+				break
+			}
+		}
+		if matched == nil {
+			continue
+		}
+		// 同一位置重复发起的会话一并完成，模板只保存一次
+		claimed, err := s.biometricRepo.CompleteEnrollment(ctx, enrollment.ID, now)
+		if err != nil {
+			return nil, err
+		}
+		if claimed && !taken[matched] {
+			taken[matched] = true
+			accepted = append(accepted, matched)
+		}
+	}
+	return accepted, nil
+}
+
+// captureEmployee 保存单个员工的模板修订，有变化时同步旧字段并下发到其他设备
+func (s *biometricService) captureEmployee(ctx context.Context, device *model.AttendanceDevice, user *model.DeviceUser, captures []*BiometricCapture) (int, error) {
+	current, err := s.biometricRepo.ListCurrentTemplates(ctx, device.TenantID, user.EmployeeID)
+	if err != nil {
+		return 0, err
+	}
+
+	now := s.now()
+	var created []*model.BiometricTemplate
+	for _, capture := range captures {
+		template := &model.BiometricTemplate{
+			ID:               uuid.Must(uuid.NewV7()),
+			TenantID:         device.TenantID,
+			EmployeeID:       user.EmployeeID,
+			Type:             capture.Type,
+			Index:            capture.Index,
+			DeviceType:       device.DeviceType,
+			AlgorithmVersion: capture.AlgorithmVersion,
+			Revision:         1,
+			Template:         capture.Template,
+			SourceDeviceID:   &device.ID,
+			CreatedAt:        now,
+		}
+		if existing := findTemplate(current, template); existing != nil {
+			// 设备重传或再次上传同一模板时不产生新修订
+			if existing.Template == template.Template {
+				continue
+			}
+			template.Revision = existing.Revision + 1
+		}
+		if err := s.biometricRepo.CreateTemplate(ctx, template); err != nil {
+			return len(created), err
+		}
+		current = replaceTemplate(current, template)
+		created = append(created, template)
+	}
+	if len(created) == 0 {
+		return 0, nil
+	}
+
+	if err := s.updateEmployeeBiometrics(ctx, device.TenantID, user.EmployeeID, created); err != nil {
+		return len(created), err
+	}
+
+	// 采集设备上已有模板，直接记为已同步
+	source := &model.BiometricDistribution{
+		ID:         uuid.Must(uuid.NewV7()),
+		TenantID:   device.TenantID,
+		EmployeeID: user.EmployeeID,
+		DeviceID:   device.ID,
+		Action:     model.BiometricDistributionPush,
+		Templates:  len(compatibleBiometrics(current, device)),
+		QueuedAt:   now,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if err := s.biometricRepo.UpsertDistribution(ctx, source); err != nil {
+		return len(created), err
+	}
+
+	if _, err := s.distribute(ctx, device.TenantID, user, current, &device.ID, nil); err != nil {
+		return len(created), err
+	}
+	return len(created), nil
+}
+
+func (s *biometricService) Distribute(ctx context.Context, tenantID, employeeID uuid.UUID, operatorID *uuid.UUID) (int, error) {
+	user, err := s.findUser(ctx, tenantID, employeeID)
+	if err != nil {
+		return 0, err
+	}
+	templates, err := s.biometricRepo.ListCurrentTemplates(ctx, tenantID, employeeID)
+	if err != nil {
+		return 0, err
+	}
+	if len(templates) == 0 {
+		return 0, ErrBiometricTemplatesNotFound
+	}
+	return s.distribute(ctx, tenantID, user, templates, nil, operatorID)
+}
+
+// distribute 向员工部门的设备下发用户及兼容模板，skipDeviceID 为采集设备
+func (s *biometricService) distribute(ctx context.Context, tenantID uuid.UUID, user *model.DeviceUser, templates []*model.BiometricTemplate, skipDeviceID, operatorID *uuid.UUID) (int, error) {
+	devices, err := s.deviceRepo.ListActive(ctx, tenantID)
+	if err != nil {
+		return 0, err
+	}
+
+	queued := 0
+	for _, device := range devices {
+		if skipDeviceID != nil && device.ID == *skipDeviceID {
+			continue
+		}
+		if device.DepartmentID != nil && *device.DepartmentID != user.DepartmentID {
+			continue
+		}
+		adapter, err := newDeviceAdapter(device, &employeeCommandRepo{DeviceCommandRepository: s.commandRepo, employeeID: user.EmployeeID}, operatorID)
+		if err != nil {
+			continue
+		}
+
+		employee := deviceEmployees([]*model.DeviceUser{user})[0]
+		employee.Biometrics = compatibleBiometrics(templates, device)
+
+		now := s.now()
+		if err := adapter.BatchPushEmployees(ctx, []*integration.EmployeeDTO{employee}); err != nil {
+			return queued, err
+		}
+		err = s.biometricRepo.UpsertDistribution(ctx, &model.BiometricDistribution{
+			ID:         uuid.Must(uuid.NewV7()),
+			TenantID:   device.TenantID,
+			EmployeeID: user.EmployeeID,
+			DeviceID:   device.ID,
+			Action:     model.BiometricDistributionPush,
+			Templates:  len(employee.Biometrics),
+			QueuedAt:   now,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		})
+		if err != nil {
+			return queued, err
+		}
+		queued++
+	}
+	return queued, nil
+}
+
+func (s *biometricService) Revoke(ctx context.Context, tenantID, employeeID uuid.UUID, operatorID *uuid.UUID) (int, error) {
+	user, err := s.findUser(ctx, tenantID, employeeID)
+	if err != nil {
+		return 0, err
+	}
+
+	// 员工调岗后原部门设备上仍有该员工，按下发记录一并删除
+	distributions, err := s.biometricRepo.ListDistributions(ctx, tenantID, &repository.BiometricDistributionFilter{EmployeeID: &employeeID})
+	if err != nil {
+		return 0, err
+	}
+	pushed := make(map[uuid.UUID]bool, len(distributions))
+	for _, distribution := range distributions {
+		if distribution.Action == model.BiometricDistributionPush {
+			pushed[distribution.DeviceID] = true
+		}
+	}
+
+	devices, err := s.deviceRepo.ListActive(ctx, tenantID)
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, device := range devices {
+		inScope := device.DepartmentID == nil || *device.DepartmentID == user.DepartmentID
+		if !inScope && !pushed[device.ID] {
+			continue
+		}
+		adapter, err := newDeviceAdapter(device, &employeeCommandRepo{DeviceCommandRepository: s.commandRepo, employeeID: user.EmployeeID}, operatorID)
+		if err != nil {
+			continue
+		}
+
+		now := s.now()
+		if err := adapter.DeleteEmployee(ctx, user.PIN); err != nil {
+			return removed, err
+		}
+		err = s.biometricRepo.UpsertDistribution(ctx, &model.BiometricDistribution{
+			ID:         uuid.Must(uuid.NewV7()),
+			TenantID:   tenantID,
+			EmployeeID: user.EmployeeID,
+			DeviceID:   device.ID,
+			Action:     model.BiometricDistributionRemove,
+			QueuedAt:   now,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		})
+		if err != nil {
+			return removed, err
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+func (s *biometricService) ListTemplates(ctx context.Context, tenantID, employeeID uuid.UUID) ([]*model.BiometricTemplate, error) {
+	return s.biometricRepo.ListCurrentTemplates(ctx, tenantID, employeeID)
+}
+
+func (s *biometricService) ListDistributions(ctx context.Context, tenantID uuid.UUID, filter *repository.BiometricDistributionFilter) ([]*model.BiometricDistribution, error) {
+	return s.biometricRepo.ListDistributions(ctx, tenantID, filter)
+}
+
+// findUser 查询员工在考勤机上的用户信息（PIN 为工号）
+func (s *biometricService) findUser(ctx context.Context, tenantID, employeeID uuid.UUID) (*model.DeviceUser, error) {
+	users, err := s.hrmEmpRepo.ListDeviceUsers(ctx, tenantID, []uuid.UUID{employeeID})
+	if err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, ErrDeviceUsersNotFound
+	}
+	return users[0], nil
+}
+
+// updateEmployeeBiometrics 把最新采集的模板同步到员工档案的指纹、人脸字段，未建立考勤档案时跳过
+func (s *biometricService) updateEmployeeBiometrics(ctx context.Context, tenantID, employeeID uuid.UUID, templates []*model.BiometricTemplate) error {
+	hrmEmp, err := s.hrmEmpRepo.FindByEmployeeID(ctx, tenantID, employeeID)
+	if err != nil {
+		return nil
+	}
+	for _, template := range templates {
+		switch template.Type {
+		case model.BiometricFingerprint:
+			err = s.hrmEmpRepo.UpdateFingerprint(ctx, hrmEmp.ID, template.Template)
+		case model.BiometricFace:
+			err = s.hrmEmpRepo.UpdateFaceData(ctx, hrmEmp.ID, template.Template)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// employeeCommandRepo 为入队的设备命令标记涉及的员工，用于计算下发状态
+type employeeCommandRepo struct {
+	repository.DeviceCommandRepository
+	employeeID uuid.UUID
+}
+
+func (r *employeeCommandRepo) Create(ctx context.Context, command *model.DeviceCommand) error {
+	command.EmployeeID = &r.employeeID
+	return r.DeviceCommandRepository.Create(ctx, command)
+}
+
+// compatibleBiometrics 设备可识别的模板
+func compatibleBiometrics(templates []*model.BiometricTemplate, device *model.AttendanceDevice) []*integration.BiometricDTO {
+	var result []*integration.BiometricDTO
+	for _, template := range templates {
+		if template.CompatibleWith(device) {
+			result = append(result, &integration.BiometricDTO{
+				Type:             template.Type,
+				Index:            template.Index,
+				AlgorithmVersion: template.AlgorithmVersion,
+				Template:         template.Template,
+			})
+		}
+	}
+	return result
+}
+
+// findTemplate 查找同一 (类型, 编号, 设备类型, 算法版本) 的模板
+func findTemplate(templates []*model.BiometricTemplate, target *model.BiometricTemplate) *model.BiometricTemplate {
+	for _, template := range templates {
+		if sameBiometricSlot(template, target) {
+			return template
+		}
+	}
+	return nil
+}
+
+// replaceTemplate 用新修订替换当前模板列表中的旧修订
+func replaceTemplate(templates []*model.BiometricTemplate, revision *model.BiometricTemplate) []*model.BiometricTemplate {
+	for i, template := range templates {
+		if sameBiometricSlot(template, revision) {
+			templates[i] = revision
+			return templates
+		}
+	}
+	return append(templates, revision)
+}
+
+func sameBiometricSlot(a, b *model.BiometricTemplate) bool {
+	return a.Type == b.Type && a.Index == b.Index && a.DeviceType == b.DeviceType && a.AlgorithmVersion == b.AlgorithmVersion
+}
+
+func validBiometric(t model.BiometricType, index int) bool {
+	if !t.IsValid() || index < 0 {
+		return false
+	}
+	return t != model.BiometricFingerprint || index <= model.MaxFingerIndex
+}
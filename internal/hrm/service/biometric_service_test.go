@@ -0,0 +1,394 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/integration/zkteco"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubBiometricRepo struct {
+	repository.BiometricRepository
+	templates     []*model.BiometricTemplate
+	distributions map[uuid.UUID]*model.BiometricDistribution // 按设备
+	enrollments   []*model.BiometricEnrollment
+}
+
+func (r *stubBiometricRepo) CreateEnrollment(ctx context.Context, enrollment *model.BiometricEnrollment) error {
+	r.enrollments = append(r.enrollments, enrollment)
+	return nil
+}
+
+func (r *stubBiometricRepo) ListPendingEnrollments(ctx context.Context, deviceID, employeeID uuid.UUID, now time.Time) ([]*model.BiometricEnrollment, error) {
+	var result []*model.BiometricEnrollment
+	for _, enrollment := range r.enrollments {
+		if enrollment.DeviceID == deviceID && enrollment.EmployeeID == employeeID &&
+			enrollment.Status == model.BiometricEnrollmentPending && enrollment.ExpiresAt.After(now) {
+			copied := *enrollment
+			result = append(result, &copied)
+		}
+	}
+	return result, nil
+}
+
+func (r *stubBiometricRepo) CompleteEnrollment(ctx context.Context, id uuid.UUID, capturedAt time.Time) (bool, error) {
+	for _, enrollment := range r.enrollments {
+		if enrollment.ID == id && enrollment.Status == model.BiometricEnrollmentPending && enrollment.ExpiresAt.After(capturedAt) {
+			enrollment.Status = model.BiometricEnrollmentCaptured
+			enrollment.CapturedAt = &capturedAt
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *stubBiometricRepo) CreateTemplate(ctx context.Context, template *model.BiometricTemplate) error {
+	r.templates = append(r.templates, template)
+	return nil
+}
+
+func (r *stubBiometricRepo) ListCurrentTemplates(ctx context.Context, tenantID, employeeID uuid.UUID) ([]*model.BiometricTemplate, error) {
+	var current []*model.BiometricTemplate
+	for _, template := range r.templates {
+		if template.EmployeeID != employeeID {
+			continue
+		}
+		if existing := findTemplate(current, template); existing == nil || existing.Revision < template.Revision {
+			current = replaceTemplate(current, template)
+		}
+	}
+	return current, nil
+}
+
+func (r *stubBiometricRepo) UpsertDistribution(ctx context.Context, distribution *model.BiometricDistribution) error {
+	if r.distributions == nil {
+		r.distributions = make(map[uuid.UUID]*model.BiometricDistribution)
+	}
+	r.distributions[distribution.DeviceID] = distribution
+	return nil
+}
+
+func (r *stubBiometricRepo) ListDistributions(ctx context.Context, tenantID uuid.UUID, filter *repository.BiometricDistributionFilter) ([]*model.BiometricDistribution, error) {
+	var result []*model.BiometricDistribution
+	for _, distribution := range r.distributions {
+		if filter.EmployeeID == nil || distribution.EmployeeID == *filter.EmployeeID {
+			result = append(result, distribution)
+		}
+	}
+	return result, nil
+}
+
+type stubBiometricEmployeeRepo struct {
+	repository.HRMEmployeeRepository
+	user        *model.DeviceUser
+	hrmEmp      *model.HRMEmployee
+	fingerprint string
+	faceData    string
+}
+
+func (r *stubBiometricEmployeeRepo) ListDeviceUsers(ctx context.Context, tenantID uuid.UUID, employeeIDs []uuid.UUID) ([]*model.DeviceUser, error) {
+	if len(employeeIDs) == 1 && employeeIDs[0] == r.user.EmployeeID {
+		return []*model.DeviceUser{r.user}, nil
+	}
+	return nil, nil
+}
+
+func (r *stubBiometricEmployeeRepo) FindDeviceUsersByPIN(ctx context.Context, tenantID uuid.UUID, pins []string) (map[string]*model.DeviceUser, error) {
+	users := make(map[string]*model.DeviceUser)
+	for _, pin := range pins {
+		if pin == r.user.PIN {
+			users[pin] = r.user
+		}
+	}
+	return users, nil
+}
+
+func (r *stubBiometricEmployeeRepo) FindByEmployeeID(ctx context.Context, tenantID, employeeID uuid.UUID) (*model.HRMEmployee, error) {
+	if r.hrmEmp == nil {
+		return nil, errStubNotFound
+	}
+	return r.hrmEmp, nil
+}
+
+func (r *stubBiometricEmployeeRepo) UpdateFingerprint(ctx context.Context, id uuid.UUID, fingerprint string) error {
+	r.fingerprint = fingerprint
+	return nil
+}
+
+func (r *stubBiometricEmployeeRepo) UpdateFaceData(ctx context.Context, id uuid.UUID, faceData string) error {
+	r.faceData = faceData
+	return nil
+}
+
+type biometricFixture struct {
+	service     *biometricService
+	repo        *stubBiometricRepo
+	commands    *stubDeviceCommandRepo
+	employees   *stubBiometricEmployeeRepo
+	tenantID    uuid.UUID
+	user        *model.DeviceUser
+	source      *model.AttendanceDevice // 采集设备（指纹 10、人脸 7）
+	sameDept    *model.AttendanceDevice // 同部门，算法一致
+	newFirmware *model.AttendanceDevice // 全员设备，指纹算法 12
+	otherDept   *model.AttendanceDevice // 其他部门
+}
+
+func newBiometricFixture() *biometricFixture {
+	tenantID := uuid.New()
+	departmentID := uuid.New()
+	otherDepartment := uuid.New()
+	heartbeat := time.Now()
+	device := func(departmentID *uuid.UUID, fingerprintVersion string) *model.AttendanceDevice {
+		d := newFleetDevice(tenantID, model.DeviceStatusOnline, &heartbeat)
+		d.DepartmentID = departmentID
+		d.FingerprintVersion = fingerprintVersion
+		d.FaceVersion = "7"
+		return d
+	}
+
+	f := &biometricFixture{
+		repo:        &stubBiometricRepo{},
+		commands:    &stubDeviceCommandRepo{},
+		tenantID:    tenantID,
+		user:        &model.DeviceUser{EmployeeID: uuid.New(), PIN: "1001", Name: "张三", DepartmentID: departmentID},
+		source:      device(&departmentID, "10"),
+		sameDept:    device(&departmentID, "10"),
+		newFirmware: device(nil, "12"),
+		otherDept:   device(&otherDepartment, "10"),
+	}
+	f.employees = &stubBiometricEmployeeRepo{user: f.user, hrmEmp: &model.HRMEmployee{ID: uuid.New(), EmployeeID: f.user.EmployeeID}}
+	deviceRepo := &stubFleetDeviceRepo{devices: []*model.AttendanceDevice{f.source, f.sameDept, f.newFirmware, f.otherDept}}
+	f.service = NewBiometricService(f.repo, deviceRepo, f.commands, f.employees).(*biometricService)
+	return f
+}
+
+// enroll 在采集设备上为员工发起采集会话
+func (f *biometricFixture) enroll(t *testing.T, biometricType model.BiometricType, index int) *model.BiometricEnrollment {
+	enrollment, err := f.service.StartEnrollment(context.Background(), &BiometricEnrollRequest{
+		TenantID: f.tenantID, EmployeeID: f.user.EmployeeID, DeviceID: f.source.ID,
+		Type: biometricType, Index: index, OperatorID: uuid.New(),
+	})
+	require.NoError(t, err)
+	f.commands.commands = nil
+	return enrollment
+}
+
+// commandsFor 设备上入队的命令
+func (f *biometricFixture) commandsFor(device *model.AttendanceDevice) []*model.DeviceCommand {
+	var result []*model.DeviceCommand
+	for _, command := range f.commands.commands {
+		if command.DeviceID == device.ID {
+			result = append(result, command)
+		}
+	}
+	return result
+}
+
+func TestBiometricService_Capture(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("stores revisions and distributes to compatible department devices", func(t *testing.T) {
+		f := newBiometricFixture()
+		f.enroll(t, model.BiometricFingerprint, 6)
+		f.enroll(t, model.BiometricFace, 0)
+		stored, err := f.service.Capture(ctx, f.source, []*BiometricCapture{
+			{PIN: "1001", Type: model.BiometricFingerprint, Index: 6, Template: "fp-v1"},
+			{PIN: "1001", Type: model.BiometricFace, Index: 0, Template: "face-v1"},
+			{PIN: "9999", Type: model.BiometricFingerprint, Index: 1, Template: "unknown"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, stored)
+
+		require.Len(t, f.repo.templates, 2)
+		fingerprint := f.repo.templates[0]
+		assert.Equal(t, model.DeviceTypeZKTeco, fingerprint.DeviceType)
+		assert.Equal(t, "10", fingerprint.AlgorithmVersion)
+		assert.Equal(t, 1, fingerprint.Revision)
+		assert.Equal(t, &f.source.ID, fingerprint.SourceDeviceID)
+		assert.Equal(t, "fp-v1", f.employees.fingerprint)
+		assert.Equal(t, "face-v1", f.employees.faceData)
+
+		// 采集设备不重复下发，记为已同步
+		assert.Empty(t, f.commandsFor(f.source))
+		require.Contains(t, f.repo.distributions, f.source.ID)
+		assert.Equal(t, model.BiometricDistributionSynced, f.repo.distributions[f.source.ID].ResolveStatus())
+
+		// 同部门设备收到用户和全部模板
+		commands := f.commandsFor(f.sameDept)
+		require.Len(t, commands, 3)
+		assert.Equal(t, model.DeviceCommandUserUpload, commands[0].CommandType)
+		assert.Equal(t, zkteco.FingerTemplateCommand("1001", 6, "fp-v1"), commands[1].Content)
+		assert.Equal(t, zkteco.FaceTemplateCommand("1001", 0, "face-v1"), commands[2].Content)
+		for _, command := range commands {
+			assert.Equal(t, &f.user.EmployeeID, command.EmployeeID)
+		}
+		assert.Equal(t, 2, f.repo.distributions[f.sameDept.ID].Templates)
+
+		// 指纹算法不同的设备只收到人脸模板
+		commands = f.commandsFor(f.newFirmware)
+		require.Len(t, commands, 2)
+		assert.Equal(t, zkteco.FaceTemplateCommand("1001", 0, "face-v1"), commands[1].Content)
+		assert.Equal(t, 1, f.repo.distributions[f.newFirmware.ID].Templates)
+
+		assert.Empty(t, f.commandsFor(f.otherDept))
+	})
+
+	t.Run("unchanged upload is ignored and recapture bumps revision", func(t *testing.T) {
+		f := newBiometricFixture()
+		capture := func(template string) int {
+			f.enroll(t, model.BiometricFingerprint, 6)
+			stored, err := f.service.Capture(ctx, f.source, []*BiometricCapture{
+				{PIN: "1001", Type: model.BiometricFingerprint, Index: 6, Template: template},
+			})
+			require.NoError(t, err)
+			return stored
+		}
+
+		assert.Equal(t, 1, capture("fp-v1"))
+		assert.NotEmpty(t, f.commands.commands)
+		assert.Equal(t, 0, capture("fp-v1"))
+		assert.Empty(t, f.commands.commands)
+
+		assert.Equal(t, 1, capture("fp-v2"))
+		require.Len(t, f.repo.templates, 2)
+		assert.Equal(t, 2, f.repo.templates[1].Revision)
+
+		current, err := f.service.ListTemplates(ctx, f.tenantID, f.user.EmployeeID)
+		require.NoError(t, err)
+		require.Len(t, current, 1)
+		assert.Equal(t, "fp-v2", current[0].Template)
+	})
+
+	t.Run("templates without algorithm version are skipped", func(t *testing.T) {
+		f := newBiometricFixture()
+		f.enroll(t, model.BiometricFingerprint, 6)
+		f.source.FingerprintVersion = ""
+		stored, err := f.service.Capture(ctx, f.source, []*BiometricCapture{
+			{PIN: "1001", Type: model.BiometricFingerprint, Index: 6, Template: "fp-v1"},
+			{PIN: "1001", Type: model.BiometricFingerprint, Index: 12, AlgorithmVersion: "10", Template: "fp-v1"},
+		})
+		require.NoError(t, err)
+		assert.Zero(t, stored)
+		assert.Empty(t, f.repo.templates)
+	})
+
+	t.Run("uploads without a pending enrollment are rejected", func(t *testing.T) {
+		f := newBiometricFixture()
+		upload := func(device *model.AttendanceDevice, index int) int {
+			stored, err := f.service.Capture(ctx, device, []*BiometricCapture{
+				{PIN: "1001", Type: model.BiometricFingerprint, Index: index, Template: "fp-forged"},
+			})
+			require.NoError(t, err)
+			return stored
+		}
+
+		// 未发起采集
+		assert.Zero(t, upload(f.source, 6))
+
+		// 其他设备或其他手指
+		f.enroll(t, model.BiometricFingerprint, 6)
+		assert.Zero(t, upload(f.sameDept, 6))
+		assert.Zero(t, upload(f.source, 3))
+
+		// 会话只接收一次
+		assert.Equal(t, 1, upload(f.source, 6))
+		assert.Equal(t, model.BiometricEnrollmentCaptured, f.repo.enrollments[0].Status)
+		assert.Zero(t, upload(f.source, 6))
+		require.Len(t, f.repo.templates, 1)
+	})
+
+	t.Run("expired enrollments no longer accept uploads", func(t *testing.T) {
+		f := newBiometricFixture()
+		now := time.Now()
+		f.service.now = func() time.Time { return now }
+		enrollment := f.enroll(t, model.BiometricFingerprint, 6)
+		assert.Equal(t, now.Add(biometricEnrollmentTTL), enrollment.ExpiresAt)
+
+		now = now.Add(biometricEnrollmentTTL + time.Second)
+		stored, err := f.service.Capture(ctx, f.source, []*BiometricCapture{
+			{PIN: "1001", Type: model.BiometricFingerprint, Index: 6, Template: "fp-v1"},
+		})
+		require.NoError(t, err)
+		assert.Zero(t, stored)
+		assert.Empty(t, f.repo.templates)
+		assert.Equal(t, model.BiometricEnrollmentPending, f.repo.enrollments[0].Status)
+	})
+}
+
+func TestBiometricService_Enrollment(t *testing.T) {
+	ctx := context.Background()
+	f := newBiometricFixture()
+	operatorID := uuid.New()
+
+	enrollment, err := f.service.StartEnrollment(ctx, &BiometricEnrollRequest{
+		TenantID: f.tenantID, EmployeeID: f.user.EmployeeID, DeviceID: f.source.ID,
+		Type: model.BiometricFingerprint, Index: 6, OperatorID: operatorID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, model.BiometricEnrollmentPending, enrollment.Status)
+	assert.Equal(t, f.source.ID, enrollment.DeviceID)
+	assert.Equal(t, operatorID, enrollment.CreatedBy)
+	require.Len(t, f.repo.enrollments, 1)
+	require.Len(t, f.commands.commands, 2)
+	assert.Equal(t, model.DeviceCommandUserUpload, f.commands.commands[0].CommandType)
+	assert.Equal(t, zkteco.EnrollFingerCommand("1001", 6), f.commands.commands[1].Content)
+	assert.Equal(t, &operatorID, f.commands.commands[1].CreatedBy)
+
+	_, err = f.service.StartEnrollment(ctx, &BiometricEnrollRequest{
+		TenantID: f.tenantID, EmployeeID: f.user.EmployeeID, DeviceID: f.source.ID, Type: "iris",
+	})
+	assert.ErrorIs(t, err, ErrInvalidBiometric)
+
+	_, err = f.service.Distribute(ctx, f.tenantID, f.user.EmployeeID, &operatorID)
+	assert.ErrorIs(t, err, ErrBiometricTemplatesNotFound)
+}
+
+func TestBiometricService_Revoke(t *testing.T) {
+	ctx := context.Background()
+	f := newBiometricFixture()
+	f.enroll(t, model.BiometricFingerprint, 6)
+	_, err := f.service.Capture(ctx, f.source, []*BiometricCapture{
+		{PIN: "1001", Type: model.BiometricFingerprint, Index: 6, Template: "fp-v1"},
+	})
+	require.NoError(t, err)
+
+	// 员工调到其他部门，原部门设备上的用户同样删除
+	f.user.DepartmentID = *f.otherDept.DepartmentID
+	f.commands.commands = nil
+
+	removed, err := f.service.Revoke(ctx, f.tenantID, f.user.EmployeeID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 4, removed)
+	for _, device := range []*model.AttendanceDevice{f.source, f.sameDept, f.newFirmware, f.otherDept} {
+		commands := f.commandsFor(device)
+		require.Len(t, commands, 1)
+		assert.Equal(t, zkteco.DeleteUserCommand("1001"), commands[0].Content)
+		assert.Equal(t, model.BiometricDistributionRemove, f.repo.distributions[device.ID].Action)
+	}
+}
+
+func TestBiometricDistribution_ResolveStatus(t *testing.T) {
+	tests := []struct {
+		name         string
+		distribution model.BiometricDistribution
+		want         model.BiometricDistributionStatus
+	}{
+		{"pending push", model.BiometricDistribution{Action: model.BiometricDistributionPush, Templates: 2, PendingCommands: 1}, model.BiometricDistributionPending},
+		{"synced", model.BiometricDistribution{Action: model.BiometricDistributionPush, Templates: 2}, model.BiometricDistributionSynced},
+		{"no compatible templates", model.BiometricDistribution{Action: model.BiometricDistributionPush}, model.BiometricDistributionIncompatible},
+		{"failed", model.BiometricDistribution{Action: model.BiometricDistributionPush, Templates: 2, PendingCommands: 1, FailedCommands: 1}, model.BiometricDistributionFailed},
+		{"removing", model.BiometricDistribution{Action: model.BiometricDistributionRemove, PendingCommands: 1}, model.BiometricDistributionRemoving},
+		{"removed", model.BiometricDistribution{Action: model.BiometricDistributionRemove}, model.BiometricDistributionRemoved},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.distribution.ResolveStatus())
+		})
+	}
+}
@@ -46,8 +46,8 @@ type BusinessTripService interface {
 	// Reject 拒绝出差
 	Reject(ctx context.Context, tripID, approverID uuid.UUID, reason string) error
 
-	// SubmitReport 提交出差报告
-	SubmitReport(ctx context.Context, tripID uuid.UUID, report string, actualCost float64) error
+	// SubmitReport 提交出差报告，实际费用取已审批报销单合计
+	SubmitReport(ctx context.Context, tripID uuid.UUID, report string) error
 
 	// SumDaysByEmployee 统计员工出差天数
 	SumDaysByEmployee(ctx context.Context, tenantID, employeeID uuid.UUID, startDate, endDate time.Time) (float64, error)
@@ -59,19 +59,28 @@ type BusinessTripService interface {
 type businessTripService struct {
 	db             *database.DB
 	tripRepo       repository.BusinessTripRepository
+	periodGuard    AttendancePeriodGuard
 	workflowEngine *integration.BusinessTripWorkflowEngine
+	expenses       TripExpenseService
+	allocations    TimeAllocationService
 }
 
 // NewBusinessTripService 创建出差服务
 func NewBusinessTripService(
 	db *database.DB,
 	tripRepo repository.BusinessTripRepository,
+	periodGuard AttendancePeriodGuard,
 	workflowEngine *workflow.Engine,
+	expenses TripExpenseService,
+	allocations TimeAllocationService,
 ) BusinessTripService {
 	return &businessTripService{
 		db:             db,
 		tripRepo:       tripRepo,
+		periodGuard:    periodGuard,
 		workflowEngine: integration.NewBusinessTripWorkflowEngine(workflowEngine),
+		expenses:       expenses,
+		allocations:    allocations,
 	}
 }
 
@@ -82,6 +91,10 @@ func (s *businessTripService) Create(ctx context.Context, trip *model.BusinessTr
 		return err
 	}
 
+	if err := checkPeriod(ctx, s.periodGuard, trip.TenantID, trip.EmployeeID, trip.StartTime, trip.EndTime); err != nil {
+		return err
+	}
+
 	// 2. 检查时间冲突
 	hasConflict, err := s.CheckTimeConflict(ctx, trip.TenantID, trip.EmployeeID, trip.StartTime, trip.EndTime, nil)
 	if err != nil {
@@ -105,8 +118,18 @@ func (s *businessTripService) Create(ctx context.Context, trip *model.BusinessTr
 	}
 	trip.ActualCost = 0 // 初始实际费用为0
 
-	// 5. 创建出差申请
-	return s.tripRepo.Create(ctx, trip)
+	// 5. 检查与请假、加班、外出的冲突并占用时间
+	allocation := tripAllocation(trip)
+	created, err := reserveTimeAllocation(ctx, s.allocations, allocation)
+	if err != nil {
+		return err
+	}
+
+	// 6. 创建出差申请，失败时释放占用
+	if err := s.tripRepo.Create(ctx, trip); err != nil {
+		return undoTimeAllocation(ctx, s.allocations, allocation, created, nil, err)
+	}
+	return nil
 }
 
 // GetByID 根据ID获取出差记录
@@ -131,6 +154,10 @@ func (s *businessTripService) Update(ctx context.Context, trip *model.BusinessTr
 		return err
 	}
 
+	if err := checkPeriod(ctx, s.periodGuard, trip.TenantID, trip.EmployeeID, trip.StartTime, trip.EndTime); err != nil {
+		return err
+	}
+
 	// 检查时间冲突（排除当前记录）
 	hasConflict, err := s.CheckTimeConflict(ctx, trip.TenantID, trip.EmployeeID, trip.StartTime, trip.EndTime, &trip.ID)
 	if err != nil {
@@ -144,7 +171,17 @@ func (s *businessTripService) Update(ctx context.Context, trip *model.BusinessTr
 	trip.Duration = trip.EndTime.Sub(trip.StartTime).Hours() / 24
 	trip.UpdatedAt = time.Now()
 
-	return s.tripRepo.Update(ctx, trip)
+	allocation := tripAllocation(trip)
+	created, err := reserveTimeAllocation(ctx, s.allocations, allocation)
+	if err != nil {
+		return err
+	}
+
+	// 更新失败时恢复原占用
+	if err := s.tripRepo.Update(ctx, trip); err != nil {
+		return undoTimeAllocation(ctx, s.allocations, allocation, created, tripAllocation(existing), err)
+	}
+	return nil
 }
 
 // Delete 删除出差记录
@@ -159,7 +196,10 @@ func (s *businessTripService) Delete(ctx context.Context, id uuid.UUID) error {
 		return fmt.Errorf("only pending business trips can be deleted")
 	}
 
-	return s.tripRepo.Delete(ctx, id)
+	if err := s.tripRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	return releaseTimeAllocation(ctx, s.allocations, model.TimeAllocationTrip, id)
 }
 
 // List 列表查询
@@ -193,6 +233,13 @@ func (s *businessTripService) Submit(ctx context.Context, tripID, submitterID uu
 		return fmt.Errorf("business trip must be submitted at least 1 day in advance")
 	}
 
+	// 提交前再次检查与请假、加班、外出的冲突；提交失败时撤销本次新增的占用
+	allocation := tripAllocation(trip)
+	created, err := reserveTimeAllocation(ctx, s.allocations, allocation)
+	if err != nil {
+		return err
+	}
+
 	// 使用工作流引擎执行审批流程
 	workflowID := fmt.Sprintf("business-trip-approval-%s", trip.TenantID.String())
 
@@ -204,14 +251,17 @@ func (s *businessTripService) Submit(ctx context.Context, tripID, submitterID uu
 		submitterID.String(),
 	)
 	if err != nil {
-		return fmt.Errorf("failed to start workflow execution: %w", err)
+		return undoTimeAllocation(ctx, s.allocations, allocation, created, nil, fmt.Errorf("failed to start workflow execution: %w", err))
 	}
 
 	// 更新状态为待审批（已提交）
 	trip.ApprovalStatus = "pending"
 	trip.UpdatedAt = time.Now()
 
-	return s.tripRepo.Update(ctx, trip)
+	if err := s.tripRepo.Update(ctx, trip); err != nil {
+		return undoTimeAllocation(ctx, s.allocations, allocation, created, nil, err)
+	}
+	return nil
 }
 
 // Approve 批准出差
@@ -229,6 +279,10 @@ func (s *businessTripService) Approve(ctx context.Context, tripID, approverID uu
 		return fmt.Errorf("only pending business trips can be approved")
 	}
 
+	if err := checkPeriod(ctx, s.periodGuard, trip.TenantID, trip.EmployeeID, trip.StartTime, trip.EndTime); err != nil {
+		return err
+	}
+
 	// 使用事务处理审批
 	return s.db.Transaction(ctx, func(tx pgx.Tx) error {
 		now := time.Now()
@@ -241,7 +295,7 @@ func (s *businessTripService) Approve(ctx context.Context, tripID, approverID uu
 			return fmt.Errorf("failed to update business trip status: %w", err)
 		}
 
-		return nil
+		return confirmTimeAllocation(ctx, s.allocations, model.TimeAllocationTrip, trip.ID)
 	})
 }
 
@@ -273,12 +327,12 @@ func (s *businessTripService) Reject(ctx context.Context, tripID, approverID uui
 			return fmt.Errorf("failed to update business trip status: %w", err)
 		}
 
-		return nil
+		return releaseTimeAllocation(ctx, s.allocations, model.TimeAllocationTrip, trip.ID)
 	})
 }
 
 // SubmitReport 提交出差报告
-func (s *businessTripService) SubmitReport(ctx context.Context, tripID uuid.UUID, report string, actualCost float64) error {
+func (s *businessTripService) SubmitReport(ctx context.Context, tripID uuid.UUID, report string) error {
 	trip, err := s.tripRepo.FindByID(ctx, tripID)
 	if err != nil {
 		return fmt.Errorf("failed to get business trip: %w", err)
@@ -293,6 +347,12 @@ func (s *businessTripService) SubmitReport(ctx context.Context, tripID uuid.UUID
 		return fmt.Errorf("cannot submit report before trip ends")
 	}
 
+	// 有报销明细时必须先完成报销审批
+	actualCost, err := s.expenses.ApprovedTotal(ctx, trip.TenantID, trip.ID)
+	if err != nil {
+		return err
+	}
+
 	now := time.Now()
 	trip.Report = report
 	trip.ReportAt = &now
@@ -0,0 +1,581 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+)
+
+var (
+	ErrClockLocationNotFound   = errors.New("clock location not found")
+	ErrClockLocationCodeExists = errors.New("clock location code already exists")
+	ErrInvalidClockLocation    = errors.New("invalid clock location")
+	ErrClockKioskKeyInvalid    = errors.New("invalid kiosk key")
+	ErrClockQRDisabled         = errors.New("clock qr code is not configured")
+	ErrClockQRInvalid          = errors.New("invalid clock qr code")
+	ErrClockQRExpired          = errors.New("clock qr code expired")
+	ErrClockAttemptNotFound    = errors.New("clock attempt not found")
+	ErrClockAttemptReviewed    = errors.New("clock attempt already reviewed")
+	ErrClockInRejected         = errors.New("clock in rejected")
+)
+
+// clockQRSkew 允许的客户端与服务器时钟偏差（二维码签发时间晚于打卡时间）
+const clockQRSkew = 5 * time.Second
+
+// ClockRejectedError 打卡校验未通过，Reason 用于记录被拒打卡供 HR 复核
+type ClockRejectedError struct {
+	Reason     model.ClockRejectReason
+	Detail     string
+	LocationID *uuid.UUID
+}
+
+func (e *ClockRejectedError) Error() string {
+	return e.Detail
+}
+
+func (e *ClockRejectedError) Unwrap() error {
+	return ErrClockInRejected
+}
+
+func clockRejected(reason model.ClockRejectReason, location *model.ClockLocation, format string, args ...interface{}) *ClockRejectedError {
+	rejection := &ClockRejectedError{Reason: reason, Detail: fmt.Sprintf(format, args...)}
+	if location != nil {
+		rejection.LocationID = &location.ID
+	}
+	return rejection
+}
+
+// ClockQRConfig 考勤屏动态二维码配置
+type ClockQRConfig struct {
+	Secret  []byte
+	Rotate  time.Duration
+	ScanURL string
+}
+
+// ClockQRClaims 二维码签名载荷，绑定租户、打卡地点和签发时间
+type ClockQRClaims struct {
+	TenantID   uuid.UUID `json:"tid"`
+	LocationID uuid.UUID `json:"loc"`
+	IssuedAt   int64     `json:"iat"`
+	ExpiresAt  int64     `json:"exp"`
+}
+
+// ClockQRSigner 考勤屏二维码签发器：按轮换周期签发，签发后两个周期内有效
+// （考勤屏每个周期刷新一次，上一张二维码在刷新后仍可扫描一个周期）
+type ClockQRSigner struct {
+	config *ClockQRConfig
+}
+
+// NewClockQRSigner 创建考勤屏二维码签发器
+func NewClockQRSigner(config *ClockQRConfig) *ClockQRSigner {
+	return &ClockQRSigner{config: config}
+}
+
+// Enabled 是否已配置签名密钥
+func (s *ClockQRSigner) Enabled() bool {
+	return s != nil && s.config != nil && len(s.config.Secret) > 0
+}
+
+// Issue 为打卡地点签发当前周期的二维码令牌
+func (s *ClockQRSigner) Issue(location *model.ClockLocation, now time.Time) (string, *ClockQRClaims, error) {
+	if !s.Enabled() {
+		return "", nil, ErrClockQRDisabled
+	}
+
+	// 对齐到周期起点，同一周期内多个考勤屏展示相同的二维码
+	issuedAt := now.Truncate(s.config.Rotate)
+	claims := &ClockQRClaims{
+		TenantID:   location.TenantID,
+		LocationID: location.ID,
+		IssuedAt:   issuedAt.Unix(),
+		ExpiresAt:  issuedAt.Add(2 * s.config.Rotate).Unix(),
+	}
+
+	token, err := s.Sign(claims)
+	if err != nil {
+		return "", nil, err
+	}
+	return token, claims, nil
+}
+
+// Sign 生成令牌字符串：base64url(payload).base64url(hmac)
+func (s *ClockQRSigner) Sign(claims *ClockQRClaims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(s.mac(encoded)), nil
+}
+
+// Parse 校验签名，并校验打卡时间落在二维码有效期内
+func (s *ClockQRSigner) Parse(token string, clockTime time.Time) (*ClockQRClaims, error) {
+	if !s.Enabled() {
+		return nil, ErrClockQRDisabled
+	}
+
+	encoded, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, ErrClockQRInvalid
+	}
+
+	expected, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(expected, s.mac(encoded)) {
+		return nil, ErrClockQRInvalid
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrClockQRInvalid
+	}
+
+	var claims ClockQRClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, ErrClockQRInvalid
+	}
+
+	if clockTime.Unix() > claims.ExpiresAt || clockTime.Add(clockQRSkew).Unix() < claims.IssuedAt {
+		return &claims, ErrClockQRExpired
+	}
+
+	return &claims, nil
+}
+
+// Content 二维码内容：配置了扫码落地页时为带 token 的链接，否则为 token 本身
+func (s *ClockQRSigner) Content(token string) string {
+	if s.config.ScanURL == "" {
+		return token
+	}
+	sep := "?"
+	if strings.Contains(s.config.ScanURL, "?") {
+		sep = "&"
+	}
+	return s.config.ScanURL + sep + "token=" + url.QueryEscape(token)
+}
+
+// Refresh 考勤屏刷新间隔
+func (s *ClockQRSigner) Refresh() time.Duration {
+	return s.config.Rotate
+}
+
+func (s *ClockQRSigner) mac(data string) []byte {
+	h := hmac.New(sha256.New, s.config.Secret)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// matchClockLocation 按考勤规则关联的打卡地点校验打卡：
+// 扫码打卡时二维码地点必须属于规则，且打卡时间、定位须符合该地点的时段和围栏；
+// 未扫码时须至少有一个无需扫码的地点同时满足时段和围栏
+func matchClockLocation(locations []*model.ClockLocation, req *ClockInRequest, claims *ClockQRClaims) (*model.ClockLocation, *ClockRejectedError) {
+	if claims != nil {
+		var location *model.ClockLocation
+		for _, l := range locations {
+			if l.ID == claims.LocationID {
+				location = l
+				break
+			}
+		}
+		if location == nil {
+			return nil, &ClockRejectedError{Reason: model.ClockRejectQRLocation, Detail: "qr code location is not allowed for this employee"}
+		}
+		if !location.InTimeWindow(req.ClockTime) {
+			return nil, clockRejected(model.ClockRejectOutsideWindow, location, "clock in at '%s' is outside allowed time windows", location.Name)
+		}
+		if rejection := checkGeofence(location, req.Location); rejection != nil {
+			return nil, rejection
+		}
+		return location, nil
+	}
+
+	var candidates []*model.ClockLocation
+	for _, l := range locations {
+		if !l.QRRequired {
+			candidates = append(candidates, l)
+		}
+	}
+	if len(candidates) == 0 {
+		var only *model.ClockLocation
+		if len(locations) == 1 {
+			only = locations[0]
+		}
+		return nil, clockRejected(model.ClockRejectQRRequired, only, "qr code scan is required")
+	}
+
+	var last *ClockRejectedError
+	for _, location := range candidates {
+		if !location.InTimeWindow(req.ClockTime) {
+			if last == nil {
+				last = clockRejected(model.ClockRejectOutsideWindow, location, "clock in at '%s' is outside allowed time windows", location.Name)
+			}
+			continue
+		}
+		rejection := checkGeofence(location, req.Location)
+		if rejection == nil {
+			return location, nil
+		}
+		// 围栏失败比时段失败更能说明问题
+		last = rejection
+	}
+	if len(candidates) > 1 {
+		last.LocationID = nil
+	}
+	return nil, last
+}
+
+// checkGeofence 校验定位是否在地点的多边形或圆形围栏内
+func checkGeofence(location *model.ClockLocation, point *model.LocationInfo) *ClockRejectedError {
+	if !location.HasGeofence() {
+		return nil
+	}
+	if point == nil {
+		return clockRejected(model.ClockRejectLocationMissing, location, "location is required")
+	}
+
+	inside := false
+	if len(location.Polygon) >= 3 {
+		inside = pointInPolygon(point.Latitude, point.Longitude, location.Polygon)
+	} else {
+		inside = calculateDistance(point.Latitude, point.Longitude, location.Latitude, location.Longitude) <= float64(location.Radius)
+	}
+	if !inside {
+		return clockRejected(model.ClockRejectOutsideGeofence, location, "clock in location is outside '%s'", location.Name)
+	}
+	return nil
+}
+
+// pointInPolygon 射线法判断点是否在多边形内（办公区尺度下经纬度按平面处理）
+func pointInPolygon(lat, lng float64, polygon []model.GeoPoint) bool {
+	inside := false
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		pi, pj := polygon[i], polygon[j]
+		if (pi.Latitude > lat) != (pj.Latitude > lat) &&
+			lng < (pj.Longitude-pi.Longitude)*(lat-pi.Latitude)/(pj.Latitude-pi.Latitude)+pi.Longitude {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// hashKioskKey 考勤屏密钥只保存 SHA-256
+func hashKioskKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// ClockLocationService 打卡地点服务接口（地理围栏、打卡时段、考勤屏动态二维码、被拒打卡复核）
+type ClockLocationService interface {
+	// 地点管理
+	CreateLocation(ctx context.Context, location *model.ClockLocation) error
+	UpdateLocation(ctx context.Context, location *model.ClockLocation) error
+	DeleteLocation(ctx context.Context, tenantID, id uuid.UUID) error
+	GetLocation(ctx context.Context, tenantID, id uuid.UUID) (*model.ClockLocation, error)
+	ListLocations(ctx context.Context, tenantID uuid.UUID) ([]*model.ClockLocation, error)
+
+	// AssignToRule 设置考勤规则关联的打卡地点（为空表示不限制），规则适用范围内的员工自助打卡时校验
+	AssignToRule(ctx context.Context, tenantID, ruleID uuid.UUID, locationIDs []uuid.UUID, operatorID uuid.UUID) (*model.AttendanceRule, error)
+
+	// RotateKioskKey 生成新的考勤屏密钥，明文仅返回这一次，旧密钥立即失效
+	RotateKioskKey(ctx context.Context, tenantID, id, operatorID uuid.UUID) (string, error)
+
+	// KioskQR 考勤屏凭密钥获取当前二维码（免登录）
+	KioskQR(ctx context.Context, kioskKey string) (*ClockKioskQR, error)
+
+	// 被拒打卡复核
+	ListAttempts(ctx context.Context, tenantID uuid.UUID, filter *repository.ClockAttemptFilter, offset, limit int) ([]*model.ClockAttempt, int, error)
+	GetAttempt(ctx context.Context, tenantID, id uuid.UUID) (*model.ClockAttempt, error)
+
+	// AcceptAttempt 认定为有效打卡，按原打卡时间补记考勤
+	AcceptAttempt(ctx context.Context, tenantID, id, operatorID uuid.UUID, comment string) (*model.ClockAttempt, error)
+
+	// DismissAttempt 认定为无效打卡
+	DismissAttempt(ctx context.Context, tenantID, id, operatorID uuid.UUID, comment string) (*model.ClockAttempt, error)
+}
+
+// ClockKioskQR 考勤屏展示的二维码
+type ClockKioskQR struct {
+	LocationID   uuid.UUID `json:"location_id"`
+	LocationName string    `json:"location_name"`
+	Token        string    `json:"token"`
+	Content      string    `json:"content"` // 二维码内容
+	ExpiresAt    time.Time `json:"expires_at"`
+	RefreshIn    int       `json:"refresh_in"` // 距下次刷新的秒数
+}
+
+type clockLocationService struct {
+	locationRepo repository.ClockLocationRepository
+	attemptRepo  repository.ClockAttemptRepository
+	ruleRepo     repository.AttendanceRuleRepository
+	signer       *ClockQRSigner
+	attendance   AttendanceService
+}
+
+// NewClockLocationService 创建打卡地点服务
+func NewClockLocationService(
+	locationRepo repository.ClockLocationRepository,
+	attemptRepo repository.ClockAttemptRepository,
+	ruleRepo repository.AttendanceRuleRepository,
+	signer *ClockQRSigner,
+	attendance AttendanceService,
+) ClockLocationService {
+	return &clockLocationService{
+		locationRepo: locationRepo,
+		attemptRepo:  attemptRepo,
+		ruleRepo:     ruleRepo,
+		signer:       signer,
+		attendance:   attendance,
+	}
+}
+
+func (s *clockLocationService) CreateLocation(ctx context.Context, location *model.ClockLocation) error {
+	if err := validateClockLocation(location); err != nil {
+		return err
+	}
+	if _, err := s.locationRepo.FindByCode(ctx, location.TenantID, location.Code); err == nil {
+		return ErrClockLocationCodeExists
+	}
+
+	location.ID = uuid.Must(uuid.NewV7())
+	now := time.Now()
+	location.CreatedAt = now
+	location.UpdatedAt = now
+
+	return s.locationRepo.Create(ctx, location)
+}
+
+func (s *clockLocationService) UpdateLocation(ctx context.Context, location *model.ClockLocation) error {
+	existing, err := s.GetLocation(ctx, location.TenantID, location.ID)
+	if err != nil {
+		return err
+	}
+	if err := validateClockLocation(location); err != nil {
+		return err
+	}
+
+	// 考勤屏密钥只能通过 RotateKioskKey 变更
+	location.KioskKeyHash = existing.KioskKeyHash
+	location.KioskKeyRotatedAt = existing.KioskKeyRotatedAt
+	location.UpdatedAt = time.Now()
+
+	return s.locationRepo.Update(ctx, location)
+}
+
+func (s *clockLocationService) DeleteLocation(ctx context.Context, tenantID, id uuid.UUID) error {
+	if _, err := s.GetLocation(ctx, tenantID, id); err != nil {
+		return err
+	}
+	return s.locationRepo.Delete(ctx, tenantID, id)
+}
+
+func (s *clockLocationService) GetLocation(ctx context.Context, tenantID, id uuid.UUID) (*model.ClockLocation, error) {
+	location, err := s.locationRepo.FindByID(ctx, tenantID, id)
+	if err != nil {
+		return nil, ErrClockLocationNotFound
+	}
+	return location, nil
+}
+
+func (s *clockLocationService) ListLocations(ctx context.Context, tenantID uuid.UUID) ([]*model.ClockLocation, error) {
+	return s.locationRepo.List(ctx, tenantID)
+}
+
+func (s *clockLocationService) AssignToRule(ctx context.Context, tenantID, ruleID uuid.UUID, locationIDs []uuid.UUID, operatorID uuid.UUID) (*model.AttendanceRule, error) {
+	rule, err := s.ruleRepo.FindByID(ctx, ruleID)
+	if err != nil || rule.TenantID != tenantID {
+		return nil, fmt.Errorf("attendance rule not found")
+	}
+
+	seen := make(map[uuid.UUID]bool, len(locationIDs))
+	var ids []uuid.UUID
+	for _, id := range locationIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if _, err := s.GetLocation(ctx, tenantID, id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	rule.ClockLocationIDs = ids
+	rule.UpdatedBy = operatorID
+	rule.UpdatedAt = time.Now()
+	if err := s.ruleRepo.Update(ctx, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (s *clockLocationService) RotateKioskKey(ctx context.Context, tenantID, id, operatorID uuid.UUID) (string, error) {
+	location, err := s.GetLocation(ctx, tenantID, id)
+	if err != nil {
+		return "", err
+	}
+
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	key := base64.RawURLEncoding.EncodeToString(buf)
+
+	now := time.Now()
+	location.KioskKeyHash = hashKioskKey(key)
+	location.KioskKeyRotatedAt = &now
+	location.UpdatedBy = operatorID
+	location.UpdatedAt = now
+	if err := s.locationRepo.Update(ctx, location); err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+func (s *clockLocationService) KioskQR(ctx context.Context, kioskKey string) (*ClockKioskQR, error) {
+	if kioskKey == "" {
+		return nil, ErrClockKioskKeyInvalid
+	}
+	location, err := s.locationRepo.FindByKioskKey(ctx, hashKioskKey(kioskKey))
+	if err != nil || !location.IsActive {
+		return nil, ErrClockKioskKeyInvalid
+	}
+
+	now := time.Now()
+	token, claims, err := s.signer.Issue(location, now)
+	if err != nil {
+		return nil, err
+	}
+
+	nextRotate := time.Unix(claims.IssuedAt, 0).Add(s.signer.Refresh())
+	return &ClockKioskQR{
+		LocationID:   location.ID,
+		LocationName: location.Name,
+		Token:        token,
+		Content:      s.signer.Content(token),
+		ExpiresAt:    time.Unix(claims.ExpiresAt, 0),
+		RefreshIn:    int(nextRotate.Sub(now).Seconds()) + 1,
+	}, nil
+}
+
+func (s *clockLocationService) ListAttempts(ctx context.Context, tenantID uuid.UUID, filter *repository.ClockAttemptFilter, offset, limit int) ([]*model.ClockAttempt, int, error) {
+	return s.attemptRepo.List(ctx, tenantID, filter, offset, limit)
+}
+
+func (s *clockLocationService) GetAttempt(ctx context.Context, tenantID, id uuid.UUID) (*model.ClockAttempt, error) {
+	attempt, err := s.attemptRepo.FindByID(ctx, tenantID, id)
+	if err != nil {
+		return nil, ErrClockAttemptNotFound
+	}
+	return attempt, nil
+}
+
+func (s *clockLocationService) AcceptAttempt(ctx context.Context, tenantID, id, operatorID uuid.UUID, comment string) (*model.ClockAttempt, error) {
+	attempt, err := s.pendingAttempt(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 以手动录入补记，不再做位置校验
+	remark := "被拒打卡复核通过"
+	if comment != "" {
+		remark += "：" + comment
+	}
+	record, err := s.attendance.ClockIn(ctx, &ClockInRequest{
+		TenantID:      attempt.TenantID,
+		EmployeeID:    attempt.EmployeeID,
+		EmployeeName:  attempt.EmployeeName,
+		ClockTime:     attempt.ClockTime,
+		ClockType:     attempt.ClockType,
+		CheckInMethod: model.MethodManual,
+		SourceType:    model.SourceTypeManual,
+		SourceID:      attempt.ID.String(),
+		Location:      attempt.Location,
+		Address:       attempt.Address,
+		WiFiSSID:      attempt.WiFiSSID,
+		WiFiMAC:       attempt.WiFiMAC,
+		Remark:        remark,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	attempt.RecordID = &record.ID
+	return s.review(ctx, attempt, model.ClockAttemptAccepted, operatorID, comment)
+}
+
+func (s *clockLocationService) DismissAttempt(ctx context.Context, tenantID, id, operatorID uuid.UUID, comment string) (*model.ClockAttempt, error) {
+	attempt, err := s.pendingAttempt(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.review(ctx, attempt, model.ClockAttemptDismissed, operatorID, comment)
+}
+
+func (s *clockLocationService) pendingAttempt(ctx context.Context, tenantID, id uuid.UUID) (*model.ClockAttempt, error) {
+	attempt, err := s.GetAttempt(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if attempt.Status != model.ClockAttemptPending {
+		return nil, ErrClockAttemptReviewed
+	}
+	return attempt, nil
+}
+
+func (s *clockLocationService) review(ctx context.Context, attempt *model.ClockAttempt, status model.ClockAttemptStatus, operatorID uuid.UUID, comment string) (*model.ClockAttempt, error) {
+	now := time.Now()
+	attempt.Status = status
+	attempt.ReviewedBy = &operatorID
+	attempt.ReviewedAt = &now
+	attempt.ReviewNote = comment
+	attempt.UpdatedAt = now
+	if err := s.attemptRepo.Update(ctx, attempt); err != nil {
+		return nil, err
+	}
+	return attempt, nil
+}
+
+// validateClockLocation 校验围栏顶点、半径和时段格式
+func validateClockLocation(location *model.ClockLocation) error {
+	if location.Code == "" || location.Name == "" {
+		return fmt.Errorf("%w: code and name are required", ErrInvalidClockLocation)
+	}
+	if len(location.Polygon) > 0 && len(location.Polygon) < 3 {
+		return fmt.Errorf("%w: polygon needs at least 3 points", ErrInvalidClockLocation)
+	}
+	for _, p := range location.Polygon {
+		if p.Latitude < -90 || p.Latitude > 90 || p.Longitude < -180 || p.Longitude > 180 {
+			return fmt.Errorf("%w: polygon point out of range", ErrInvalidClockLocation)
+		}
+	}
+	if location.Radius < 0 {
+		return fmt.Errorf("%w: radius must not be negative", ErrInvalidClockLocation)
+	}
+	for _, w := range location.TimeWindows {
+		if _, err := parseTime(w.Start); err != nil {
+			return fmt.Errorf("%w: invalid time window start %q", ErrInvalidClockLocation, w.Start)
+		}
+		if _, err := parseTime(w.End); err != nil {
+			return fmt.Errorf("%w: invalid time window end %q", ErrInvalidClockLocation, w.End)
+		}
+		for _, d := range w.Weekdays {
+			if d < 0 || d > 6 {
+				return fmt.Errorf("%w: invalid weekday %d", ErrInvalidClockLocation, d)
+			}
+		}
+	}
+	return nil
+}
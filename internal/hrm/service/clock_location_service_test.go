@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+)
+
+type stubClockLocationRepo struct {
+	repository.ClockLocationRepository
+	locations []*model.ClockLocation
+	scans     map[string]bool
+}
+
+func (r *stubClockLocationRepo) UseQRCode(ctx context.Context, tenantID, employeeID, locationID uuid.UUID, issuedAt, scannedAt time.Time) (bool, error) {
+	key := employeeID.String() + locationID.String() + issuedAt.String()
+	if r.scans[key] {
+		return false, nil
+	}
+	if r.scans == nil {
+		r.scans = map[string]bool{}
+	}
+	r.scans[key] = true
+	return true, nil
+}
+
+func (r *stubClockLocationRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (*model.ClockLocation, error) {
+	for _, l := range r.locations {
+		if l.ID == id && l.TenantID == tenantID {
+			return l, nil
+		}
+	}
+	return nil, errStubNotFound
+}
+
+func (r *stubClockLocationRepo) FindByIDs(ctx context.Context, tenantID uuid.UUID, ids []uuid.UUID) ([]*model.ClockLocation, error) {
+	var found []*model.ClockLocation
+	for _, id := range ids {
+		if l, err := r.FindByID(ctx, tenantID, id); err == nil {
+			found = append(found, l)
+		}
+	}
+	return found, nil
+}
+
+type stubClockAttemptRepo struct {
+	repository.ClockAttemptRepository
+	attempts []*model.ClockAttempt
+}
+
+func (r *stubClockAttemptRepo) Create(ctx context.Context, attempt *model.ClockAttempt) error {
+	r.attempts = append(r.attempts, attempt)
+	return nil
+}
+
+type stubClockHRMEmpRepo struct {
+	repository.HRMEmployeeRepository
+	employee *model.HRMEmployee
+}
+
+func (r *stubClockHRMEmpRepo) FindByEmployeeID(ctx context.Context, tenantID, employeeID uuid.UUID) (*model.HRMEmployee, error) {
+	return r.employee, nil
+}
+
+type stubClockRecordRepo struct {
+	repository.AttendanceRecordRepository
+	records []*model.AttendanceRecord
+}
+
+func (r *stubClockRecordRepo) Create(ctx context.Context, record *model.AttendanceRecord) error {
+	r.records = append(r.records, record)
+	return nil
+}
+
+type stubClockScheduleRepo struct {
+	repository.ScheduleRepository
+}
+
+func (r *stubClockScheduleRepo) FindByDate(ctx context.Context, tenantID uuid.UUID, date string) ([]*model.Schedule, error) {
+	return nil, nil
+}
+
+// office 约 100m × 100m 的办公区（上海陆家嘴附近）
+var office = []model.GeoPoint{
+	{Latitude: 31.2400, Longitude: 121.5000},
+	{Latitude: 31.2400, Longitude: 121.5010},
+	{Latitude: 31.2409, Longitude: 121.5010},
+	{Latitude: 31.2409, Longitude: 121.5000},
+}
+
+func TestPointInPolygon(t *testing.T) {
+	assert.True(t, pointInPolygon(31.2404, 121.5005, office))
+	assert.False(t, pointInPolygon(31.2420, 121.5005, office))
+	assert.False(t, pointInPolygon(31.2404, 121.4990, office))
+
+	// 凹多边形（L 形）的缺口不算在内
+	lShape := []model.GeoPoint{
+		{Latitude: 0, Longitude: 0}, {Latitude: 0, Longitude: 2}, {Latitude: 1, Longitude: 2},
+		{Latitude: 1, Longitude: 1}, {Latitude: 2, Longitude: 1}, {Latitude: 2, Longitude: 0},
+	}
+	assert.True(t, pointInPolygon(0.5, 1.5, lShape))
+	assert.True(t, pointInPolygon(1.5, 0.5, lShape))
+	assert.False(t, pointInPolygon(1.5, 1.5, lShape))
+}
+
+func TestCheckGeofence_Circle(t *testing.T) {
+	location := &model.ClockLocation{Name: "前台", Latitude: 31.2400, Longitude: 121.5000, Radius: 100}
+
+	// 纬度 0.0005° ≈ 55m
+	assert.Nil(t, checkGeofence(location, &model.LocationInfo{Latitude: 31.2405, Longitude: 121.5000}))
+	// 纬度 0.002° ≈ 222m
+	rejection := checkGeofence(location, &model.LocationInfo{Latitude: 31.2420, Longitude: 121.5000})
+	require.NotNil(t, rejection)
+	assert.Equal(t, model.ClockRejectOutsideGeofence, rejection.Reason)
+
+	rejection = checkGeofence(location, nil)
+	require.NotNil(t, rejection)
+	assert.Equal(t, model.ClockRejectLocationMissing, rejection.Reason)
+
+	assert.Nil(t, checkGeofence(&model.ClockLocation{}, nil))
+}
+
+func TestClockTimeWindow_Contains(t *testing.T) {
+	weekday := model.ClockTimeWindow{Weekdays: []int{1, 2, 3, 4, 5}, Start: "08:00", End: "10:00"}
+	monday := time.Date(2025, 9, 1, 9, 0, 0, 0, time.Local)
+	assert.True(t, weekday.Contains(monday))
+	assert.False(t, weekday.Contains(monday.Add(2*time.Hour)))
+	assert.False(t, weekday.Contains(monday.AddDate(0, 0, 5))) // 周六
+
+	// 周五夜班跨零点，周六凌晨仍属于周五的时段
+	night := model.ClockTimeWindow{Weekdays: []int{5}, Start: "22:00", End: "02:00"}
+	friday := time.Date(2025, 9, 5, 23, 0, 0, 0, time.Local)
+	assert.True(t, night.Contains(friday))
+	assert.True(t, night.Contains(friday.Add(2*time.Hour)))
+	assert.False(t, night.Contains(friday.Add(4*time.Hour)))
+	assert.False(t, night.Contains(friday.AddDate(0, 0, -1).Add(2*time.Hour))) // 周五凌晨属于周四时段
+}
+
+func TestClockQRSigner(t *testing.T) {
+	signer := NewClockQRSigner(&ClockQRConfig{Secret: []byte("secret"), Rotate: 30 * time.Second, ScanURL: "https://app.example.com/clock"})
+	location := &model.ClockLocation{ID: uuid.New(), TenantID: uuid.New()}
+	now := time.Date(2025, 9, 1, 9, 0, 10, 0, time.Local)
+
+	token, claims, err := signer.Issue(location, now)
+	require.NoError(t, err)
+	assert.Equal(t, now.Truncate(30*time.Second).Unix(), claims.IssuedAt)
+	assert.True(t, strings.HasPrefix(signer.Content(token), "https://app.example.com/clock?token="))
+
+	parsed, err := signer.Parse(token, now.Add(40*time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, location.ID, parsed.LocationID)
+	assert.Equal(t, location.TenantID, parsed.TenantID)
+
+	// 下下个周期失效
+	_, err = signer.Parse(token, now.Add(time.Minute))
+	assert.ErrorIs(t, err, ErrClockQRExpired)
+	// 不能用于签发之前的打卡
+	_, err = signer.Parse(token, now.Add(-time.Minute))
+	assert.ErrorIs(t, err, ErrClockQRExpired)
+
+	other := NewClockQRSigner(&ClockQRConfig{Secret: []byte("other"), Rotate: 30 * time.Second})
+	_, err = other.Parse(token, now)
+	assert.ErrorIs(t, err, ErrClockQRInvalid)
+	_, err = signer.Parse(token+"x", now)
+	assert.ErrorIs(t, err, ErrClockQRInvalid)
+
+	_, _, err = NewClockQRSigner(&ClockQRConfig{}).Issue(location, now)
+	assert.ErrorIs(t, err, ErrClockQRDisabled)
+}
+
+func TestAttendanceService_ClockInWithClockLocations(t *testing.T) {
+	tenantID, employeeID := uuid.New(), uuid.New()
+	signer := NewClockQRSigner(&ClockQRConfig{Secret: []byte("secret"), Rotate: 30 * time.Second})
+	monday := time.Date(2025, 9, 1, 9, 0, 0, 0, time.Local)
+
+	lobby := &model.ClockLocation{
+		ID: uuid.New(), TenantID: tenantID, Name: "大堂考勤屏", Polygon: office, QRRequired: true, IsActive: true,
+		TimeWindows: []model.ClockTimeWindow{{Start: "07:00", End: "21:00"}},
+	}
+	warehouse := &model.ClockLocation{
+		ID: uuid.New(), TenantID: tenantID, Name: "仓库", Latitude: 31.3000, Longitude: 121.6000, Radius: 200, IsActive: true,
+		TimeWindows: []model.ClockTimeWindow{{Weekdays: []int{1, 2, 3, 4, 5}, Start: "08:00", End: "18:00"}},
+	}
+	elsewhere := &model.ClockLocation{ID: uuid.New(), TenantID: tenantID, Name: "分公司", QRRequired: true, IsActive: true}
+	rule := &model.AttendanceRule{ID: uuid.New(), TenantID: tenantID, ClockLocationIDs: []uuid.UUID{lobby.ID, warehouse.ID}}
+
+	newService := func() (*attendanceService, *stubClockRecordRepo, *stubClockAttemptRepo) {
+		records, attempts := &stubClockRecordRepo{}, &stubClockAttemptRepo{}
+		svc := NewAttendanceService(
+			records, nil, &stubClockScheduleRepo{}, &stubRuleRepo{rule: rule},
+			&stubClockHRMEmpRepo{employee: &model.HRMEmployee{IsActive: true, AttendanceRuleID: &rule.ID}},
+			nil, nil, nil,
+			&stubClockLocationRepo{locations: []*model.ClockLocation{lobby, warehouse, elsewhere}},
+			attempts, signer,
+		).(*attendanceService)
+		return svc, records, attempts
+	}
+	qr := func(location *model.ClockLocation, at time.Time) string {
+		token, _, err := signer.Issue(location, at)
+		require.NoError(t, err)
+		return token
+	}
+	inLobby := &model.LocationInfo{Latitude: 31.2404, Longitude: 121.5005}
+	request := func(method model.AttendanceMethod, token string, at time.Time, location *model.LocationInfo) *ClockInRequest {
+		return &ClockInRequest{
+			TenantID: tenantID, EmployeeID: employeeID, ClockTime: at, ClockType: model.ClockTypeCheckIn,
+			CheckInMethod: method, SourceType: model.SourceTypeSystem, Location: location, QRToken: token,
+		}
+	}
+
+	t.Run("valid qr inside polygon", func(t *testing.T) {
+		svc, records, attempts := newService()
+		record, err := svc.ClockIn(context.Background(), request(model.MethodQRCode, qr(lobby, monday), monday.Add(5*time.Second), inLobby))
+		require.NoError(t, err)
+		assert.Equal(t, "大堂考勤屏", record.Address)
+		assert.Len(t, records.records, 1)
+		assert.Empty(t, attempts.attempts)
+	})
+
+	t.Run("qr code is used once per employee per period", func(t *testing.T) {
+		svc, records, attempts := newService()
+		token := qr(lobby, monday)
+		_, err := svc.ClockIn(context.Background(), request(model.MethodQRCode, token, monday, inLobby))
+		require.NoError(t, err)
+
+		_, err = svc.ClockIn(context.Background(), request(model.MethodQRCode, token, monday.Add(10*time.Second), inLobby))
+		assert.ErrorIs(t, err, ErrClockInRejected)
+		assert.Len(t, records.records, 1)
+		require.Len(t, attempts.attempts, 1)
+		assert.Equal(t, model.ClockRejectQRUsed, attempts.attempts[0].Reason)
+
+		other := request(model.MethodQRCode, token, monday.Add(10*time.Second), inLobby)
+		other.EmployeeID = uuid.New()
+		_, err = svc.ClockIn(context.Background(), other)
+		require.NoError(t, err)
+		assert.Len(t, records.records, 2)
+	})
+
+	t.Run("geofence only location without qr", func(t *testing.T) {
+		svc, records, _ := newService()
+		_, err := svc.ClockIn(context.Background(), request(model.MethodMobile, "", monday, &model.LocationInfo{Latitude: 31.3005, Longitude: 121.6000}))
+		require.NoError(t, err)
+		assert.Len(t, records.records, 1)
+	})
+
+	cases := []struct {
+		name     string
+		req      *ClockInRequest
+		reason   model.ClockRejectReason
+		location *uuid.UUID
+	}{
+		{"qr location mismatch", request(model.MethodQRCode, qr(elsewhere, monday), monday, inLobby), model.ClockRejectQRLocation, nil},
+		{"expired qr", request(model.MethodQRCode, qr(lobby, monday), monday.Add(2*time.Minute), inLobby), model.ClockRejectQRExpired, &lobby.ID},
+		{"forged qr", request(model.MethodQRCode, "forged.token", monday, inLobby), model.ClockRejectQRInvalid, nil},
+		{"qr outside polygon", request(model.MethodQRCode, qr(lobby, monday), monday, &model.LocationInfo{Latitude: 31.25, Longitude: 121.5}), model.ClockRejectOutsideGeofence, &lobby.ID},
+		{"qr outside time window", request(model.MethodQRCode, qr(lobby, monday.Add(13*time.Hour)), monday.Add(13*time.Hour), inLobby), model.ClockRejectOutsideWindow, &lobby.ID},
+		{"spoofed gps without qr", request(model.MethodMobile, "", monday, inLobby), model.ClockRejectOutsideGeofence, &warehouse.ID},
+		{"weekend at warehouse", request(model.MethodMobile, "", monday.AddDate(0, 0, 5), &model.LocationInfo{Latitude: 31.3, Longitude: 121.6}), model.ClockRejectOutsideWindow, &warehouse.ID},
+		{"qr method without token", request(model.MethodQRCode, "", monday, inLobby), model.ClockRejectQRRequired, nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc, records, attempts := newService()
+			_, err := svc.ClockIn(context.Background(), tc.req)
+			assert.ErrorIs(t, err, ErrClockInRejected)
+			assert.Empty(t, records.records)
+
+			require.Len(t, attempts.attempts, 1)
+			attempt := attempts.attempts[0]
+			assert.Equal(t, tc.reason, attempt.Reason)
+			assert.Equal(t, tc.location, attempt.LocationID)
+			assert.Equal(t, model.ClockAttemptPending, attempt.Status)
+			assert.Equal(t, employeeID, attempt.EmployeeID)
+			assert.NotEmpty(t, attempt.Detail)
+		})
+	}
+
+	t.Run("device and manual records skip clock locations", func(t *testing.T) {
+		svc, records, attempts := newService()
+		req := request(model.MethodDevice, "", monday.AddDate(0, 0, 5), nil)
+		req.SourceType = model.SourceTypeDevice
+		_, err := svc.ClockIn(context.Background(), req)
+		require.NoError(t, err)
+
+		req = request(model.MethodManual, "", monday.AddDate(0, 0, 5), nil)
+		req.SourceType = model.SourceTypeManual
+		_, err = svc.ClockIn(context.Background(), req)
+		require.NoError(t, err)
+		assert.Len(t, records.records, 2)
+		assert.Empty(t, attempts.attempts)
+	})
+}
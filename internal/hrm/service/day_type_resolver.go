@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+)
+
+// maxResolveDays 单次解析的最大天数
+const maxResolveDays = 366
+
+var ErrDayRangeTooLarge = errors.New("date range too large")
+
+// DayTypeResolver 日期类型解析器：判断员工某天是工作日、休息日还是节假日
+//
+// 判定优先级：排班指定的工作日类型 > 假期日历（放假/调休上班） > 考勤规则的周末设置
+type DayTypeResolver interface {
+	// Resolve 解析员工某天的日期类型
+	Resolve(ctx context.Context, tenantID, employeeID uuid.UUID, date time.Time) (*model.DayInfo, error)
+
+	// ResolveRange 解析区间 [start, end] 内每天的日期类型
+	ResolveRange(ctx context.Context, tenantID, employeeID uuid.UUID, start, end time.Time) ([]*model.DayInfo, error)
+
+	// CountWorkdays 统计区间 [start, end] 内的工作日天数
+	CountWorkdays(ctx context.Context, tenantID, employeeID uuid.UUID, start, end time.Time) (int, error)
+}
+
+type dayTypeResolver struct {
+	calendarRepo repository.HolidayCalendarRepository
+	ruleRepo     repository.AttendanceRuleRepository
+	scheduleRepo repository.ScheduleRepository
+	hrmEmpRepo   repository.HRMEmployeeRepository
+}
+
+// NewDayTypeResolver 创建日期类型解析器
+func NewDayTypeResolver(
+	calendarRepo repository.HolidayCalendarRepository,
+	ruleRepo repository.AttendanceRuleRepository,
+	scheduleRepo repository.ScheduleRepository,
+	hrmEmpRepo repository.HRMEmployeeRepository,
+) DayTypeResolver {
+	return &dayTypeResolver{
+		calendarRepo: calendarRepo,
+		ruleRepo:     ruleRepo,
+		scheduleRepo: scheduleRepo,
+		hrmEmpRepo:   hrmEmpRepo,
+	}
+}
+
+func (r *dayTypeResolver) Resolve(ctx context.Context, tenantID, employeeID uuid.UUID, date time.Time) (*model.DayInfo, error) {
+	days, err := r.ResolveRange(ctx, tenantID, employeeID, date, date)
+	if err != nil {
+		return nil, err
+	}
+	return days[0], nil
+}
+
+func (r *dayTypeResolver) CountWorkdays(ctx context.Context, tenantID, employeeID uuid.UUID, start, end time.Time) (int, error) {
+	days, err := r.ResolveRange(ctx, tenantID, employeeID, start, end)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, day := range days {
+		if day.IsWorkday() {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *dayTypeResolver) ResolveRange(ctx context.Context, tenantID, employeeID uuid.UUID, start, end time.Time) ([]*model.DayInfo, error) {
+	start, end = truncateDate(start), truncateDate(end)
+	if end.Before(start) {
+		start, end = end, start
+	}
+	if end.Sub(start) > maxResolveDays*24*time.Hour {
+		return nil, ErrDayRangeTooLarge
+	}
+
+	rule := resolveEmployeeRule(ctx, r.hrmEmpRepo, r.ruleRepo, tenantID, employeeID)
+	weekend := weekendSet(rule)
+
+	calendar := r.employeeCalendar(ctx, tenantID, rule)
+	calendarDays := make(map[string]*model.HolidayCalendarDay)
+	if calendar != nil {
+		days, err := r.calendarRepo.ListDays(ctx, calendar.ID, start, end)
+		if err != nil {
+			return nil, err
+		}
+		for _, day := range days {
+			calendarDays[day.Date.Format("2006-01-02")] = day
+		}
+	}
+
+	scheduled, err := r.scheduledDayTypes(ctx, tenantID, employeeID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*model.DayInfo
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		key := d.Format("2006-01-02")
+		info := &model.DayInfo{Date: d}
+		calDay := calendarDays[key]
+		if calendar != nil && calDay != nil {
+			info.CalendarID = &calendar.ID
+		}
+
+		switch workdayType := scheduled[key]; {
+		case workdayType != "":
+			// 排班明确指定
+			info.Source = model.DayTypeSourceSchedule
+			info.Type = workdayType
+			if workdayType == model.DayTypeHoliday {
+				info.IsStatutory = true
+				if calDay != nil && calDay.Kind == model.CalendarDayHoliday {
+					info.Name = calDay.Name
+					info.IsStatutory = calDay.IsStatutory
+				}
+			}
+		case calDay != nil:
+			info.Source = model.DayTypeSourceCalendar
+			info.Name = calDay.Name
+			if calDay.Kind == model.CalendarDayMakeupWorkday {
+				info.Type = model.DayTypeWorkday
+				info.IsMakeup = true
+			} else {
+				info.Type = model.DayTypeHoliday
+				info.IsStatutory = calDay.IsStatutory
+			}
+		default:
+			info.Source = model.DayTypeSourceRule
+			info.Type = model.DayTypeWorkday
+			if weekend[int(d.Weekday())] {
+				info.Type = model.DayTypeWeekend
+			}
+		}
+
+		result = append(result, info)
+	}
+
+	return result, nil
+}
+
+// resolveEmployeeRule 员工适用的考勤规则：员工指定的规则优先，其次按适用范围匹配
+func resolveEmployeeRule(
+	ctx context.Context,
+	hrmEmpRepo repository.HRMEmployeeRepository,
+	ruleRepo repository.AttendanceRuleRepository,
+	tenantID, employeeID uuid.UUID,
+) *model.AttendanceRule {
+	var ruleID *uuid.UUID
+	if emp, err := hrmEmpRepo.FindByEmployeeID(ctx, tenantID, employeeID); err == nil {
+		ruleID = emp.AttendanceRuleID
+	}
+	if ruleID == nil {
+		matched, err := ruleRepo.FindByEmployee(ctx, tenantID, employeeID)
+		if err != nil {
+			return nil
+		}
+		ruleID = &matched.ID
+	}
+
+	rule, err := ruleRepo.FindByID(ctx, *ruleID)
+	if err != nil {
+		return nil
+	}
+	return rule
+}
+
+// employeeCalendar 规则关联的日历，未关联时使用租户默认日历
+func (r *dayTypeResolver) employeeCalendar(ctx context.Context, tenantID uuid.UUID, rule *model.AttendanceRule) *model.HolidayCalendar {
+	if rule != nil && rule.HolidayCalendarID != nil {
+		if calendar, err := r.calendarRepo.FindByID(ctx, *rule.HolidayCalendarID); err == nil && calendar.IsActive {
+			return calendar
+		}
+	}
+
+	calendar, err := r.calendarRepo.FindDefault(ctx, tenantID)
+	if err != nil {
+		return nil
+	}
+	return calendar
+}
+
+// scheduledDayTypes 区间内排班指定的日期类型
+func (r *dayTypeResolver) scheduledDayTypes(ctx context.Context, tenantID, employeeID uuid.UUID, start, end time.Time) (map[string]model.DayType, error) {
+	result := make(map[string]model.DayType)
+	if r.scheduleRepo == nil {
+		return result, nil
+	}
+
+	for month := time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, start.Location()); !month.After(end); month = month.AddDate(0, 1, 0) {
+		schedules, err := r.scheduleRepo.FindByEmployee(ctx, tenantID, employeeID, month.Format("2006-01"))
+		if err != nil {
+			return nil, err
+		}
+		for _, schedule := range schedules {
+			switch dayType := model.DayType(schedule.WorkdayType); dayType {
+			case model.DayTypeWorkday, model.DayTypeWeekend, model.DayTypeHoliday:
+				result[schedule.ScheduleDate.Format("2006-01-02")] = dayType
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// weekendSet 规则的周末设置，未配置时按工作制推断
+func weekendSet(rule *model.AttendanceRule) map[int]bool {
+	set := make(map[int]bool)
+	switch {
+	case rule != nil && len(rule.WeekendDays) > 0:
+		for _, d := range rule.WeekendDays {
+			set[d] = true
+		}
+	case rule != nil && rule.WorkdayType == model.WorkdayTypeSixDay:
+		set[int(time.Sunday)] = true
+	default:
+		set[int(time.Saturday)] = true
+		set[int(time.Sunday)] = true
+	}
+	return set
+}
+
+// truncateDate 保留日期部分（按时间自身的时区）
+func truncateDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
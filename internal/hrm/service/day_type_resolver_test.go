@@ -0,0 +1,210 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/ical"
+)
+
+var errStubNotFound = errors.New("not found")
+
+type stubCalendarRepo struct {
+	repository.HolidayCalendarRepository
+	calendar *model.HolidayCalendar
+	days     []*model.HolidayCalendarDay
+}
+
+func (r *stubCalendarRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.HolidayCalendar, error) {
+	if r.calendar == nil || r.calendar.ID != id {
+		return nil, errStubNotFound
+	}
+	return r.calendar, nil
+}
+
+func (r *stubCalendarRepo) FindDefault(ctx context.Context, tenantID uuid.UUID) (*model.HolidayCalendar, error) {
+	if r.calendar == nil || !r.calendar.IsDefault {
+		return nil, errStubNotFound
+	}
+	return r.calendar, nil
+}
+
+func (r *stubCalendarRepo) ListDays(ctx context.Context, calendarID uuid.UUID, start, end time.Time) ([]*model.HolidayCalendarDay, error) {
+	var days []*model.HolidayCalendarDay
+	for _, day := range r.days {
+		if !day.Date.Before(start) && !day.Date.After(end) {
+			days = append(days, day)
+		}
+	}
+	return days, nil
+}
+
+type stubRuleRepo struct {
+	repository.AttendanceRuleRepository
+	rule *model.AttendanceRule
+}
+
+func (r *stubRuleRepo) FindByEmployee(ctx context.Context, tenantID, employeeID uuid.UUID) (*model.AttendanceRule, error) {
+	if r.rule == nil {
+		return nil, errStubNotFound
+	}
+	return r.rule, nil
+}
+
+func (r *stubRuleRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.AttendanceRule, error) {
+	if r.rule == nil || r.rule.ID != id {
+		return nil, errStubNotFound
+	}
+	return r.rule, nil
+}
+
+type stubScheduleRepo struct {
+	repository.ScheduleRepository
+	schedules []*model.Schedule
+}
+
+func (r *stubScheduleRepo) FindByEmployee(ctx context.Context, tenantID, employeeID uuid.UUID, month string) ([]*model.Schedule, error) {
+	var schedules []*model.Schedule
+	for _, s := range r.schedules {
+		if s.ScheduleDate.Format("2006-01") == month {
+			schedules = append(schedules, s)
+		}
+	}
+	return schedules, nil
+}
+
+type stubHRMEmployeeRepo struct {
+	repository.HRMEmployeeRepository
+}
+
+func (r *stubHRMEmployeeRepo) FindByEmployeeID(ctx context.Context, tenantID, employeeID uuid.UUID) (*model.HRMEmployee, error) {
+	return nil, errStubNotFound
+}
+
+func mustDate(s string) time.Time {
+	t, _ := time.ParseInLocation("2006-01-02", s, time.UTC)
+	return t
+}
+
+func TestDayTypeResolver_ResolveRange(t *testing.T) {
+	ctx := context.Background()
+	tenantID, employeeID := uuid.New(), uuid.New()
+	calendar := &model.HolidayCalendar{ID: uuid.New(), TenantID: tenantID, IsDefault: true, IsActive: true}
+	calendarRepo := &stubCalendarRepo{
+		calendar: calendar,
+		days: []*model.HolidayCalendarDay{
+			{Date: mustDate("2025-10-01"), Kind: model.CalendarDayHoliday, Name: "国庆节", IsStatutory: true},
+			{Date: mustDate("2025-10-06"), Kind: model.CalendarDayHoliday, Name: "国庆节"},
+			{Date: mustDate("2025-10-11"), Kind: model.CalendarDayMakeupWorkday, Name: "国庆节调休上班"},
+		},
+	}
+
+	t.Run("calendar overrides weekend", func(t *testing.T) {
+		resolver := NewDayTypeResolver(calendarRepo, &stubRuleRepo{}, &stubScheduleRepo{}, &stubHRMEmployeeRepo{})
+
+		days, err := resolver.ResolveRange(ctx, tenantID, employeeID, mustDate("2025-10-01"), mustDate("2025-10-12"))
+		require.NoError(t, err)
+		require.Len(t, days, 12)
+
+		assert.Equal(t, model.DayTypeHoliday, days[0].Type)
+		assert.True(t, days[0].IsStatutory)
+		assert.Equal(t, model.OvertimeTypeHoliday, days[0].OvertimeType())
+
+		// 非法定的调休放假日按休息日计加班
+		assert.Equal(t, model.DayTypeHoliday, days[5].Type)
+		assert.Equal(t, model.OvertimeTypeWeekend, days[5].OvertimeType())
+
+		// 10-11 周六调休上班，10-12 周日正常休息
+		assert.True(t, days[10].IsWorkday())
+		assert.True(t, days[10].IsMakeup)
+		assert.Equal(t, model.DayTypeSourceCalendar, days[10].Source)
+		assert.Equal(t, model.DayTypeWeekend, days[11].Type)
+		assert.Equal(t, model.DayTypeSourceRule, days[11].Source)
+
+		count, err := resolver.CountWorkdays(ctx, tenantID, employeeID, mustDate("2025-10-01"), mustDate("2025-10-12"))
+		require.NoError(t, err)
+		assert.Equal(t, 7, count) // 10-02、10-03、10-07 至 10-10 以及 10-11 调休上班
+	})
+
+	t.Run("schedule takes precedence", func(t *testing.T) {
+		scheduleRepo := &stubScheduleRepo{schedules: []*model.Schedule{
+			{ScheduleDate: mustDate("2025-10-01"), WorkdayType: string(model.DayTypeWorkday)},
+		}}
+		resolver := NewDayTypeResolver(calendarRepo, &stubRuleRepo{}, scheduleRepo, &stubHRMEmployeeRepo{})
+
+		info, err := resolver.Resolve(ctx, tenantID, employeeID, mustDate("2025-10-01"))
+		require.NoError(t, err)
+		assert.Equal(t, model.DayTypeWorkday, info.Type)
+		assert.Equal(t, model.DayTypeSourceSchedule, info.Source)
+	})
+
+	t.Run("six day rule rests on sunday only", func(t *testing.T) {
+		rule := &model.AttendanceRule{ID: uuid.New(), WorkdayType: model.WorkdayTypeSixDay}
+		resolver := NewDayTypeResolver(&stubCalendarRepo{}, &stubRuleRepo{rule: rule}, &stubScheduleRepo{}, &stubHRMEmployeeRepo{})
+
+		days, err := resolver.ResolveRange(ctx, tenantID, employeeID, mustDate("2025-03-08"), mustDate("2025-03-09"))
+		require.NoError(t, err)
+		assert.Equal(t, model.DayTypeWorkday, days[0].Type)
+		assert.Equal(t, model.DayTypeWeekend, days[1].Type)
+	})
+
+	t.Run("range too large", func(t *testing.T) {
+		resolver := NewDayTypeResolver(calendarRepo, &stubRuleRepo{}, &stubScheduleRepo{}, &stubHRMEmployeeRepo{})
+
+		_, err := resolver.ResolveRange(ctx, tenantID, employeeID, mustDate("2024-01-01"), mustDate("2025-12-31"))
+		assert.ErrorIs(t, err, ErrDayRangeTooLarge)
+	})
+}
+
+func TestCalendarDaysFromICS_Preset(t *testing.T) {
+	data, err := holidayPresets.ReadFile("holiday_presets/CN-2025.ics")
+	require.NoError(t, err)
+	cal, err := ical.Parse(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	days, err := calendarDaysFromICS(cal, 2025)
+	require.NoError(t, err)
+
+	statutory, makeup := 0, 0
+	for _, d := range days {
+		switch {
+		case d.Kind == model.CalendarDayMakeupWorkday:
+			makeup++
+			assert.False(t, d.IsStatutory)
+		case d.IsStatutory:
+			statutory++
+		}
+	}
+	assert.Equal(t, 13, statutory)
+	assert.Equal(t, 5, makeup)
+
+	t.Run("export round trip", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, ical.Encode(&buf, &ical.Calendar{Events: calendarDaysToICS("CN", days)}))
+
+		parsed, err := ical.Parse(&buf)
+		require.NoError(t, err)
+		again, err := calendarDaysFromICS(parsed, 0)
+		require.NoError(t, err)
+		require.Len(t, again, len(days))
+		for i := range days {
+			assert.True(t, days[i].Date.Equal(again[i].Date))
+			assert.Equal(t, days[i].Kind, again[i].Kind)
+			assert.Equal(t, days[i].IsStatutory, again[i].IsStatutory)
+		}
+	})
+
+	t.Run("make-up day must be weekend", func(t *testing.T) {
+		err := validateCalendarDay(&model.HolidayCalendarDay{Date: mustDate("2025-10-10"), Kind: model.CalendarDayMakeupWorkday})
+		assert.ErrorIs(t, err, ErrMakeupDayNotWeekend)
+	})
+}
@@ -0,0 +1,296 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/integration"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	notificationDto "github.com/lk2023060901/go-next-erp/internal/notification/dto"
+	notificationService "github.com/lk2023060901/go-next-erp/internal/notification/service"
+)
+
+const (
+	// deviceHealthCron 设备健康检查频率
+	deviceHealthCron = "*/5 * * * *"
+
+	// deviceFailedCommandWindow 设备状况中统计失败命令的时间窗口
+	deviceFailedCommandWindow = 24 * time.Hour
+)
+
+var (
+	ErrInvalidDeviceCommand      = errors.New("invalid device command")
+	ErrDeviceCommandNotConfirmed = errors.New("clear_records deletes unsynced punches and must be confirmed")
+)
+
+// DeviceRemoteCommand 设备远程命令
+type DeviceRemoteCommand string
+
+const (
+	DeviceRemoteSyncEmployees DeviceRemoteCommand = "sync_employees" // 下发设备所属部门（未设置时为全租户）的在职员工
+	DeviceRemoteClearRecords  DeviceRemoteCommand = "clear_records"  // 清空设备考勤记录
+	DeviceRemoteReboot        DeviceRemoteCommand = "reboot"         // 重启设备
+	DeviceRemoteSetTime       DeviceRemoteCommand = "set_time"       // 校准设备时间
+)
+
+// DeviceFleetService 考勤设备运维服务：定时探测设备在线状态并告警，下发远程命令，汇总设备运行状况
+type DeviceFleetService interface {
+	// CheckHealth 定时任务：通过适配器探测所有启用的设备，新离线的设备标记为离线并通知设备管理员
+	CheckHealth(ctx context.Context) (*DeviceHealthRunResult, error)
+
+	// CronSpec 定时任务的 Cron 表达式
+	CronSpec() string
+
+	// SendCommand 下发远程命令，返回入队的命令数（推送协议的设备在下次心跳时执行）
+	SendCommand(ctx context.Context, req *DeviceCommandRequest) (int, error)
+
+	// ListHealth 查询租户内设备的运行状况（在线状态、固件、同步延迟、命令积压）
+	ListHealth(ctx context.Context, tenantID uuid.UUID) ([]*model.DeviceHealth, error)
+}
+
+// DeviceCommandRequest 远程命令请求
+type DeviceCommandRequest struct {
+	TenantID   uuid.UUID
+	DeviceID   uuid.UUID
+	Command    DeviceRemoteCommand
+	Time       *time.Time // set_time 的目标时间，为空时使用服务器当前时间
+	Confirmed  bool       // clear_records 会删除设备上尚未同步的打卡且无法恢复，须显式确认
+	OperatorID uuid.UUID
+}
+
+// DeviceHealthRunResult 设备健康检查结果
+type DeviceHealthRunResult struct {
+	Devices       int `json:"devices"`
+	Online        int `json:"online"`
+	Offline       int `json:"offline"`
+	MarkedOffline int `json:"marked_offline"` // 本次新标记为离线（已发送告警）
+	Unsupported   int `json:"unsupported"`    // 没有可用适配器，未探测
+}
+
+type deviceFleetService struct {
+	deviceRepo          repository.AttendanceDeviceRepository
+	commandRepo         repository.DeviceCommandRepository
+	hrmEmpRepo          repository.HRMEmployeeRepository
+	notificationService notificationService.NotificationService
+	now                 func() time.Time
+}
+
+// NewDeviceFleetService 创建考勤设备运维服务
+func NewDeviceFleetService(
+	deviceRepo repository.AttendanceDeviceRepository,
+	commandRepo repository.DeviceCommandRepository,
+	hrmEmpRepo repository.HRMEmployeeRepository,
+	notificationService notificationService.NotificationService,
+) DeviceFleetService {
+	return &deviceFleetService{
+		deviceRepo:          deviceRepo,
+		commandRepo:         commandRepo,
+		hrmEmpRepo:          hrmEmpRepo,
+		notificationService: notificationService,
+		now:                 time.Now,
+	}
+}
+
+func (s *deviceFleetService) CronSpec() string {
+	return deviceHealthCron
+}
+
+func (s *deviceFleetService) CheckHealth(ctx context.Context) (*DeviceHealthRunResult, error) {
+	devices, err := s.deviceRepo.ListAllActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DeviceHealthRunResult{Devices: len(devices)}
+	var firstErr error
+	for _, device := range devices {
+		adapter, err := newDeviceAdapter(device, s.commandRepo, nil)
+		if err != nil {
+			result.Unsupported++
+			continue
+		}
+
+		pingErr := adapter.Ping(ctx)
+		if pingErr == nil {
+			result.Online++
+			// 推送协议的设备心跳时已更新为在线，其他适配器在此恢复
+			if device.Status != model.DeviceStatusOnline {
+				if err := s.deviceRepo.UpdateStatus(ctx, device.ID, model.DeviceStatusOnline, ""); err != nil && firstErr == nil {
+					firstErr = err
+				}
+			}
+			continue
+		}
+
+		result.Offline++
+		// 已标记离线的设备不重复告警，设备恢复心跳后状态回到在线
+		if device.Status == model.DeviceStatusOffline {
+			continue
+		}
+		if err := s.deviceRepo.UpdateStatus(ctx, device.ID, model.DeviceStatusOffline, pingErr.Error()); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		result.MarkedOffline++
+		s.notifyOffline(ctx, device)
+	}
+	return result, firstErr
+}
+
+func (s *deviceFleetService) SendCommand(ctx context.Context, req *DeviceCommandRequest) (int, error) {
+	if req.Command == DeviceRemoteClearRecords && !req.Confirmed {
+		return 0, ErrDeviceCommandNotConfirmed
+	}
+
+	device, err := s.deviceRepo.FindByID(ctx, req.DeviceID)
+	if err != nil || device.TenantID != req.TenantID {
+		return 0, ErrAttendanceDeviceNotFound
+	}
+	if !device.IsActive {
+		return 0, ErrDeviceDisabled
+	}
+
+	adapter, err := newDeviceAdapter(device, s.commandRepo, &req.OperatorID)
+	if err != nil {
+		return 0, err
+	}
+
+	switch req.Command {
+	case DeviceRemoteSyncEmployees:
+		return s.syncEmployees(ctx, device, adapter)
+	case DeviceRemoteClearRecords:
+		return 1, adapter.ClearRecords(ctx)
+	case DeviceRemoteReboot:
+		return 1, adapter.Reboot(ctx)
+	case DeviceRemoteSetTime:
+		t := s.now()
+		if req.Time != nil {
+			t = *req.Time
+		}
+		return 1, adapter.SetTime(ctx, t)
+	default:
+		return 0, fmt.Errorf("%w: %q", ErrInvalidDeviceCommand, req.Command)
+	}
+}
+
+func (s *deviceFleetService) ListHealth(ctx context.Context, tenantID uuid.UUID) ([]*model.DeviceHealth, error) {
+	devices, err := s.deviceRepo.ListActive(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	stats, err := s.commandRepo.StatsByDevice(ctx, tenantID, now.Add(-deviceFailedCommandWindow))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.DeviceHealth, 0, len(devices))
+	for _, device := range devices {
+		health := &model.DeviceHealth{
+			DeviceID:        device.ID,
+			DeviceSN:        device.DeviceSN,
+			DeviceName:      device.DeviceName,
+			DeviceModel:     device.DeviceModel,
+			FirmwareVersion: device.FirmwareVersion,
+			Status:          device.Status,
+			IsActive:        device.IsActive,
+			ErrorMessage:    device.ErrorMessage,
+			LastHeartbeat:   device.LastHeartbeat,
+			LastSyncAt:      device.LastSyncAt,
+			RecordCount:     device.TotalRecords,
+		}
+		if device.LastSyncAt != nil {
+			lag := int64(now.Sub(*device.LastSyncAt) / time.Second)
+			health.SyncLag = &lag
+		}
+		if stat, ok := stats[device.ID]; ok {
+			health.PendingCommands = stat.Pending
+			health.OldestPending = stat.OldestPending
+			health.FailedCommands = stat.Failed
+		}
+
+		if adapter, err := newDeviceAdapter(device, s.commandRepo, nil); err == nil {
+			health.Online = adapter.Ping(ctx) == nil
+			if info, err := adapter.GetDeviceInfo(ctx); err == nil {
+				applyDeviceInfo(health, info)
+			}
+		}
+		result = append(result, health)
+	}
+	return result, nil
+}
+
+// syncEmployees 下发设备所属部门（未设置时为全租户）启用考勤的在职员工
+func (s *deviceFleetService) syncEmployees(ctx context.Context, device *model.AttendanceDevice, adapter integration.DeviceAdapter) (int, error) {
+	users, err := s.hrmEmpRepo.ListActiveDeviceUsers(ctx, device.TenantID, device.DepartmentID)
+	if err != nil {
+		return 0, err
+	}
+	if len(users) == 0 {
+		return 0, ErrDeviceUsersNotFound
+	}
+
+	employees := deviceEmployees(users)
+	if err := adapter.BatchPushEmployees(ctx, employees); err != nil {
+		return 0, err
+	}
+	return len(employees), nil
+}
+
+// notifyOffline 通知设备登记人和最后维护人，通知失败不影响健康检查
+func (s *deviceFleetService) notifyOffline(ctx context.Context, device *model.AttendanceDevice) {
+	if s.notificationService == nil {
+		return
+	}
+
+	lastSeen := "从未连接"
+	if device.LastHeartbeat != nil {
+		lastSeen = "最后心跳 " + device.LastHeartbeat.In(time.Local).Format("2006-01-02 15:04:05")
+	}
+	relatedType := "hrm_attendance_device"
+	relatedID := device.ID.String()
+	for _, userID := range deviceAdmins(device) {
+		_, _ = s.notificationService.SendNotification(ctx, device.TenantID, &notificationDto.SendNotificationRequest{
+			Type:        "system",
+			Channel:     "in_app",
+			RecipientID: userID.String(),
+			Title:       "考勤设备离线",
+			Content:     fmt.Sprintf("考勤设备 %s（%s）已离线，%s，请检查设备电源和网络。", device.DeviceName, device.DeviceSN, lastSeen),
+			Data: map[string]interface{}{
+				"device_id": device.ID.String(),
+				"device_sn": device.DeviceSN,
+			},
+			RelatedType: &relatedType,
+			RelatedID:   &relatedID,
+		})
+	}
+}
+
+// deviceAdmins 设备登记人和最后维护人（去重）
+func deviceAdmins(device *model.AttendanceDevice) []uuid.UUID {
+	var admins []uuid.UUID
+	for _, userID := range []uuid.UUID{device.CreatedBy, device.UpdatedBy} {
+		if userID != uuid.Nil && !containsUUID(admins, userID) {
+			admins = append(admins, userID)
+		}
+	}
+	return admins
+}
+
+func applyDeviceInfo(health *model.DeviceHealth, info *integration.DeviceInfo) {
+	if info.DeviceModel != "" {
+		health.DeviceModel = info.DeviceModel
+	}
+	if info.FirmwareVer != "" {
+		health.FirmwareVersion = info.FirmwareVer
+	}
+	health.UserCount = info.UserCount
+	health.RecordCount = info.RecordCount
+}
@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/integration/zkteco"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	notificationDto "github.com/lk2023060901/go-next-erp/internal/notification/dto"
+	notificationService "github.com/lk2023060901/go-next-erp/internal/notification/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubFleetDeviceRepo struct {
+	repository.AttendanceDeviceRepository
+	devices  []*model.AttendanceDevice
+	statuses map[uuid.UUID]model.DeviceStatus
+}
+
+func (r *stubFleetDeviceRepo) ListAllActive(ctx context.Context) ([]*model.AttendanceDevice, error) {
+	return r.devices, nil
+}
+
+func (r *stubFleetDeviceRepo) ListActive(ctx context.Context, tenantID uuid.UUID) ([]*model.AttendanceDevice, error) {
+	var devices []*model.AttendanceDevice
+	for _, device := range r.devices {
+		if device.TenantID == tenantID {
+			devices = append(devices, device)
+		}
+	}
+	return devices, nil
+}
+
+func (r *stubFleetDeviceRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.AttendanceDevice, error) {
+	for _, device := range r.devices {
+		if device.ID == id {
+			return device, nil
+		}
+	}
+	return nil, errStubNotFound
+}
+
+func (r *stubFleetDeviceRepo) UpdateStatus(ctx context.Context, id uuid.UUID, status model.DeviceStatus, errorMsg string) error {
+	if r.statuses == nil {
+		r.statuses = make(map[uuid.UUID]model.DeviceStatus)
+	}
+	r.statuses[id] = status
+	return nil
+}
+
+type stubDeviceCommandRepo struct {
+	repository.DeviceCommandRepository
+	commands []*model.DeviceCommand
+	stats    map[uuid.UUID]*model.DeviceCommandStats
+}
+
+func (r *stubDeviceCommandRepo) Create(ctx context.Context, command *model.DeviceCommand) error {
+	command.Seq = int64(len(r.commands) + 1)
+	r.commands = append(r.commands, command)
+	return nil
+}
+
+func (r *stubDeviceCommandRepo) StatsByDevice(ctx context.Context, tenantID uuid.UUID, failedSince time.Time) (map[uuid.UUID]*model.DeviceCommandStats, error) {
+	return r.stats, nil
+}
+
+type stubFleetEmployeeRepo struct {
+	repository.HRMEmployeeRepository
+	users      []*model.DeviceUser
+	department *uuid.UUID
+}
+
+func (r *stubFleetEmployeeRepo) ListActiveDeviceUsers(ctx context.Context, tenantID uuid.UUID, departmentID *uuid.UUID) ([]*model.DeviceUser, error) {
+	r.department = departmentID
+	return r.users, nil
+}
+
+type stubNotifier struct {
+	notificationService.NotificationService
+	sent []*notificationDto.SendNotificationRequest
+}
+
+func (n *stubNotifier) SendNotification(ctx context.Context, tenantID uuid.UUID, req *notificationDto.SendNotificationRequest) (*notificationDto.NotificationResponse, error) {
+	n.sent = append(n.sent, req)
+	return &notificationDto.NotificationResponse{}, nil
+}
+
+func newFleetDevice(tenantID uuid.UUID, status model.DeviceStatus, heartbeat *time.Time) *model.AttendanceDevice {
+	return &model.AttendanceDevice{
+		ID:            uuid.New(),
+		TenantID:      tenantID,
+		DeviceType:    model.DeviceTypeZKTeco,
+		DeviceSN:      "SN-" + uuid.NewString()[:8],
+		DeviceName:    "前台考勤机",
+		SyncMode:      deviceSyncModePush,
+		Status:        status,
+		IsActive:      true,
+		LastHeartbeat: heartbeat,
+		CreatedBy:     uuid.New(),
+	}
+}
+
+func TestDeviceFleetService_CheckHealth(t *testing.T) {
+	ctx := context.Background()
+	tenantID := uuid.New()
+	fresh := time.Now().Add(-time.Minute)
+	stale := time.Now().Add(-zkteco.OfflineAfter - time.Minute)
+
+	online := newFleetDevice(tenantID, model.DeviceStatusOnline, &fresh)
+	lost := newFleetDevice(tenantID, model.DeviceStatusOnline, &stale)
+	lost.UpdatedBy = uuid.New()
+	alerted := newFleetDevice(tenantID, model.DeviceStatusOffline, &stale)
+	pull := newFleetDevice(tenantID, model.DeviceStatusOffline, nil)
+	pull.DeviceType = model.DeviceTypeHikvision
+
+	deviceRepo := &stubFleetDeviceRepo{devices: []*model.AttendanceDevice{online, lost, alerted, pull}}
+	notifier := &stubNotifier{}
+	svc := NewDeviceFleetService(deviceRepo, &stubDeviceCommandRepo{}, &stubFleetEmployeeRepo{}, notifier)
+
+	result, err := svc.CheckHealth(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &DeviceHealthRunResult{Devices: 4, Online: 1, Offline: 2, MarkedOffline: 1, Unsupported: 1}, result)
+
+	// 只有新离线的设备更新状态并告警，已离线的设备不重复告警
+	assert.Equal(t, map[uuid.UUID]model.DeviceStatus{lost.ID: model.DeviceStatusOffline}, deviceRepo.statuses)
+	require.Len(t, notifier.sent, 2)
+	assert.Equal(t, lost.CreatedBy.String(), notifier.sent[0].RecipientID)
+	assert.Equal(t, lost.UpdatedBy.String(), notifier.sent[1].RecipientID)
+	assert.Contains(t, notifier.sent[0].Content, lost.DeviceSN)
+}
+
+func TestDeviceFleetService_SendCommand(t *testing.T) {
+	ctx := context.Background()
+	tenantID := uuid.New()
+	operatorID := uuid.New()
+	departmentID := uuid.New()
+	heartbeat := time.Now()
+
+	device := newFleetDevice(tenantID, model.DeviceStatusOnline, &heartbeat)
+	device.DepartmentID = &departmentID
+	disabled := newFleetDevice(tenantID, model.DeviceStatusOnline, &heartbeat)
+	disabled.IsActive = false
+
+	setup := func() (DeviceFleetService, *stubDeviceCommandRepo, *stubFleetEmployeeRepo) {
+		commandRepo := &stubDeviceCommandRepo{}
+		userRepo := &stubFleetEmployeeRepo{users: []*model.DeviceUser{
+			{EmployeeID: uuid.New(), PIN: "1001", Name: "张三"},
+			{EmployeeID: uuid.New(), PIN: "1002", Name: "李四"},
+		}}
+		deviceRepo := &stubFleetDeviceRepo{devices: []*model.AttendanceDevice{device, disabled}}
+		return NewDeviceFleetService(deviceRepo, commandRepo, userRepo, nil), commandRepo, userRepo
+	}
+	request := func(command DeviceRemoteCommand) *DeviceCommandRequest {
+		return &DeviceCommandRequest{TenantID: tenantID, DeviceID: device.ID, Command: command, OperatorID: operatorID}
+	}
+
+	t.Run("sync employees of device department", func(t *testing.T) {
+		svc, commandRepo, userRepo := setup()
+		queued, err := svc.SendCommand(ctx, request(DeviceRemoteSyncEmployees))
+		require.NoError(t, err)
+		assert.Equal(t, 2, queued)
+		assert.Equal(t, &departmentID, userRepo.department)
+		require.Len(t, commandRepo.commands, 2)
+		assert.Equal(t, model.DeviceCommandUserUpload, commandRepo.commands[0].CommandType)
+		assert.Equal(t, &operatorID, commandRepo.commands[0].CreatedBy)
+	})
+
+	t.Run("reboot and set time", func(t *testing.T) {
+		svc, commandRepo, _ := setup()
+		_, err := svc.SendCommand(ctx, request(DeviceRemoteReboot))
+		require.NoError(t, err)
+
+		req := request(DeviceRemoteSetTime)
+		target := time.Date(2025, 3, 3, 8, 30, 0, 0, time.Local)
+		req.Time = &target
+		_, err = svc.SendCommand(ctx, req)
+		require.NoError(t, err)
+
+		require.Len(t, commandRepo.commands, 2)
+		assert.Equal(t, model.DeviceCommandReboot, commandRepo.commands[0].CommandType)
+		assert.Equal(t, model.DeviceCommandSetTime, commandRepo.commands[1].CommandType)
+		assert.Equal(t, zkteco.SetTimeCommand(target), commandRepo.commands[1].Content)
+	})
+
+	t.Run("rejected commands", func(t *testing.T) {
+		svc, commandRepo, _ := setup()
+		_, err := svc.SendCommand(ctx, request("format"))
+		assert.ErrorIs(t, err, ErrInvalidDeviceCommand)
+
+		req := request(DeviceRemoteReboot)
+		req.TenantID = uuid.New()
+		_, err = svc.SendCommand(ctx, req)
+		assert.ErrorIs(t, err, ErrAttendanceDeviceNotFound)
+
+		req = request(DeviceRemoteReboot)
+		req.DeviceID = disabled.ID
+		_, err = svc.SendCommand(ctx, req)
+		assert.ErrorIs(t, err, ErrDeviceDisabled)
+
+		// 清空记录不可撤销，未确认时不入队
+		_, err = svc.SendCommand(ctx, request(DeviceRemoteClearRecords))
+		assert.ErrorIs(t, err, ErrDeviceCommandNotConfirmed)
+		assert.Empty(t, commandRepo.commands)
+	})
+
+	t.Run("confirmed clear records", func(t *testing.T) {
+		svc, commandRepo, _ := setup()
+		req := request(DeviceRemoteClearRecords)
+		req.Confirmed = true
+		_, err := svc.SendCommand(ctx, req)
+		require.NoError(t, err)
+		require.Len(t, commandRepo.commands, 1)
+		assert.Equal(t, model.DeviceCommandClearLog, commandRepo.commands[0].CommandType)
+	})
+}
+
+func TestDeviceFleetService_ListHealth(t *testing.T) {
+	ctx := context.Background()
+	tenantID := uuid.New()
+	now := time.Now()
+	heartbeat := now.Add(-time.Minute)
+	lastSync := now.Add(-90 * time.Minute)
+	oldest := now.Add(-time.Hour)
+
+	device := newFleetDevice(tenantID, model.DeviceStatusOnline, &heartbeat)
+	device.FirmwareVersion = "Ver 6.60"
+	device.LastSyncAt = &lastSync
+	device.TotalRecords = 320
+	idle := newFleetDevice(tenantID, model.DeviceStatusOffline, nil)
+
+	commandRepo := &stubDeviceCommandRepo{stats: map[uuid.UUID]*model.DeviceCommandStats{
+		device.ID: {Pending: 3, OldestPending: &oldest, Failed: 1},
+	}}
+	svc := NewDeviceFleetService(&stubFleetDeviceRepo{devices: []*model.AttendanceDevice{device, idle}}, commandRepo, &stubFleetEmployeeRepo{}, nil)
+	svc.(*deviceFleetService).now = func() time.Time { return now }
+
+	items, err := svc.ListHealth(ctx, tenantID)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+
+	health := items[0]
+	assert.True(t, health.Online)
+	assert.Equal(t, "Ver 6.60", health.FirmwareVersion)
+	assert.Equal(t, 320, health.RecordCount)
+	require.NotNil(t, health.SyncLag)
+	assert.Equal(t, int64(90*60), *health.SyncLag)
+	assert.Equal(t, 3, health.PendingCommands)
+	assert.Equal(t, &oldest, health.OldestPending)
+	assert.Equal(t, 1, health.FailedCommands)
+
+	assert.False(t, items[1].Online)
+	assert.Nil(t, items[1].SyncLag)
+	assert.Zero(t, items[1].PendingCommands)
+}
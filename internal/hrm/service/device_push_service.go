@@ -0,0 +1,355 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/integration/zkteco"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+)
+
+// devicePollBatch 每次心跳最多下发的命令数
+const devicePollBatch = 20
+
+var (
+	ErrDeviceNotRegistered = errors.New("device not registered")
+	ErrDeviceDisabled      = errors.New("device disabled")
+	ErrDeviceAuthFailed    = errors.New("device authentication failed")
+)
+
+// DevicePushService ZKTeco 推送协议（ADMS）服务：设备按序列号识别、按通讯密钥或来源地址认证后
+// 握手、上传考勤记录、心跳拉取命令并回执执行结果
+type DevicePushService interface {
+	// Handshake 设备握手，返回参数配置（含已接收的上传戳）
+	Handshake(ctx context.Context, creds *DeviceCredentials) (string, error)
+
+	// Upload 处理设备上传的数据表：导入 ATTLOG，保存 OPERLOG、BIODATA 中的生物特征模板，其他表直接确认
+	Upload(ctx context.Context, creds *DeviceCredentials, table, stamp, body string) (*DeviceUploadResult, error)
+
+	// Poll 设备心跳，返回待执行的命令或 OK
+	Poll(ctx context.Context, creds *DeviceCredentials, info string) (string, error)
+
+	// ReportCommands 处理命令回执，返回更新的命令数
+	ReportCommands(ctx context.Context, creds *DeviceCredentials, body string) (int, error)
+}
+
+// DeviceCredentials 设备请求携带的身份信息
+type DeviceCredentials struct {
+	SN       string // 设备序列号
+	CommKey  string // 通讯密钥
+	RemoteIP string // 连接来源地址（不取可伪造的转发头）
+}
+
+// DeviceUploadResult 上传处理结果
+type DeviceUploadResult struct {
+	Lines        int `json:"lines"`         // 设备上传的行数（应答给设备，确认已接收）
+	Imported     int `json:"imported"`      // 新导入的考勤记录
+	Duplicates   int `json:"duplicates"`    // 已导入过的记录（设备重传）
+	UnknownUsers int `json:"unknown_users"` // PIN 未匹配员工
+	Locked       int `json:"locked"`        // 所在考勤期间已锁定
+	Invalid      int `json:"invalid"`       // 无法解析的行
+	Templates    int `json:"templates"`     // 新保存的生物特征模板
+}
+
+type devicePushService struct {
+	deviceRepo  repository.AttendanceDeviceRepository
+	commandRepo repository.DeviceCommandRepository
+	hrmEmpRepo  repository.HRMEmployeeRepository
+	recordRepo  repository.AttendanceRecordRepository
+	attendance  AttendanceService
+	biometrics  BiometricService
+}
+
+// NewDevicePushService 创建 ZKTeco 推送协议服务
+func NewDevicePushService(
+	deviceRepo repository.AttendanceDeviceRepository,
+	commandRepo repository.DeviceCommandRepository,
+	hrmEmpRepo repository.HRMEmployeeRepository,
+	recordRepo repository.AttendanceRecordRepository,
+	attendance AttendanceService,
+	biometrics BiometricService,
+) DevicePushService {
+	return &devicePushService{
+		deviceRepo:  deviceRepo,
+		commandRepo: commandRepo,
+		hrmEmpRepo:  hrmEmpRepo,
+		recordRepo:  recordRepo,
+		attendance:  attendance,
+		biometrics:  biometrics,
+	}
+}
+
+func (s *devicePushService) Handshake(ctx context.Context, creds *DeviceCredentials) (string, error) {
+	device, err := s.resolveDevice(ctx, creds)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.deviceRepo.UpdateHeartbeat(ctx, device.ID); err != nil {
+		return "", err
+	}
+	if err := s.deviceRepo.UpdateConnection(ctx, device.ID, creds.RemoteIP, ""); err != nil {
+		return "", err
+	}
+
+	_, offset := time.Now().Zone()
+	return zkteco.OptionsReply(device.DeviceSN, device.PushStamp, offset/3600), nil
+}
+
+func (s *devicePushService) Upload(ctx context.Context, creds *DeviceCredentials, table, stamp, body string) (*DeviceUploadResult, error) {
+	device, err := s.resolveDevice(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.deviceRepo.UpdateHeartbeat(ctx, device.ID); err != nil {
+		return nil, err
+	}
+
+	result := &DeviceUploadResult{}
+	if strings.EqualFold(table, zkteco.TableOperLog) || strings.EqualFold(table, zkteco.TableBioData) {
+		result.Lines = countLines(body)
+		if s.biometrics == nil {
+			return result, nil
+		}
+		result.Templates, err = s.biometrics.Capture(ctx, device, biometricCaptures(zkteco.ParseTemplates(table, body)))
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+	if !strings.EqualFold(table, zkteco.TableAttLog) {
+		result.Lines = countLines(body)
+		return result, nil
+	}
+
+	logs, invalid := zkteco.ParseAttLog(body, time.Local)
+	result.Invalid = len(invalid)
+	result.Lines = len(logs) + len(invalid)
+
+	if len(logs) > 0 {
+		if err := s.importAttLogs(ctx, device, logs, result); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.deviceRepo.UpdatePushStamp(ctx, device.ID, stamp, result.Imported); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *devicePushService) Poll(ctx context.Context, creds *DeviceCredentials, info string) (string, error) {
+	device, err := s.resolveDevice(ctx, creds)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.deviceRepo.UpdateHeartbeat(ctx, device.ID); err != nil {
+		return "", err
+	}
+	heartbeat := zkteco.ParseHeartbeatInfo(info)
+	ip := heartbeat.IPAddress
+	if ip == "" {
+		ip = creds.RemoteIP
+	}
+	if err := s.deviceRepo.UpdateConnection(ctx, device.ID, ip, heartbeat.FirmwareVersion); err != nil {
+		return "", err
+	}
+	// 算法版本决定可下发的模板，只在变化时更新
+	if (heartbeat.FingerprintVersion != "" && heartbeat.FingerprintVersion != device.FingerprintVersion) ||
+		(heartbeat.FaceVersion != "" && heartbeat.FaceVersion != device.FaceVersion) {
+		if err := s.deviceRepo.UpdateBiometricVersions(ctx, device.ID, heartbeat.FingerprintVersion, heartbeat.FaceVersion); err != nil {
+			return "", err
+		}
+	}
+
+	commands, err := s.commandRepo.ListPending(ctx, device.ID, devicePollBatch)
+	if err != nil {
+		return "", err
+	}
+	if len(commands) == 0 {
+		return zkteco.ReplyOK, nil
+	}
+
+	var reply strings.Builder
+	ids := make([]uuid.UUID, 0, len(commands))
+	for _, command := range commands {
+		reply.WriteString(zkteco.FormatCommand(command.Seq, command.Content))
+		ids = append(ids, command.ID)
+	}
+	if err := s.commandRepo.MarkSent(ctx, ids); err != nil {
+		return "", err
+	}
+	return reply.String(), nil
+}
+
+func (s *devicePushService) ReportCommands(ctx context.Context, creds *DeviceCredentials, body string) (int, error) {
+	device, err := s.resolveDevice(ctx, creds)
+	if err != nil {
+		return 0, err
+	}
+
+	replies, err := zkteco.ParseCommandReplies(body)
+	if err != nil {
+		return 0, err
+	}
+
+	completed := 0
+	for _, reply := range replies {
+		found, err := s.commandRepo.Complete(ctx, device.ID, reply.Seq, reply.ReturnCode)
+		if err != nil {
+			return completed, err
+		}
+		if found {
+			completed++
+		}
+	}
+	return completed, nil
+}
+
+// resolveDevice 按序列号查找已登记的 ZKTeco 设备并校验通讯密钥、来源地址
+func (s *devicePushService) resolveDevice(ctx context.Context, creds *DeviceCredentials) (*model.AttendanceDevice, error) {
+	deviceSN := strings.TrimSpace(creds.SN)
+	if deviceSN == "" {
+		return nil, ErrDeviceNotRegistered
+	}
+
+	devices, err := s.deviceRepo.ListBySN(ctx, deviceSN)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []*model.AttendanceDevice
+	for _, device := range devices {
+		if device.DeviceType == model.DeviceTypeZKTeco {
+			matched = append(matched, device)
+		}
+	}
+	switch {
+	case len(matched) == 0:
+		return nil, ErrDeviceNotRegistered
+	case len(matched) > 1:
+		return nil, ErrDeviceSNExists
+	case !matched[0].AuthenticatePush(creds.CommKey, creds.RemoteIP):
+		return nil, ErrDeviceAuthFailed
+	case !matched[0].IsActive:
+		return nil, ErrDeviceDisabled
+	}
+	return matched[0], nil
+}
+
+// importAttLogs 按 PIN（工号）匹配员工，跳过已导入的记录后逐条导入（并发重传由唯一索引去重），
+// 单条记录所在期间已锁定时跳过该条，不影响其他记录
+func (s *devicePushService) importAttLogs(ctx context.Context, device *model.AttendanceDevice, logs []*zkteco.AttLog, result *DeviceUploadResult) error {
+	pins := make([]string, 0, len(logs))
+	seenPIN := make(map[string]bool, len(logs))
+	start, end := logs[0].Time, logs[0].Time
+	for _, log := range logs {
+		if !seenPIN[log.PIN] {
+			seenPIN[log.PIN] = true
+			pins = append(pins, log.PIN)
+		}
+		if log.Time.Before(start) {
+			start = log.Time
+		}
+		if log.Time.After(end) {
+			end = log.Time
+		}
+	}
+
+	users, err := s.hrmEmpRepo.FindDeviceUsersByPIN(ctx, device.TenantID, pins)
+	if err != nil {
+		return err
+	}
+
+	existing, err := s.recordRepo.FindBySource(ctx, device.TenantID, model.SourceTypeDevice, device.DeviceSN, start, end)
+	if err != nil {
+		return err
+	}
+	imported := make(map[string]bool, len(existing))
+	for _, record := range existing {
+		imported[deviceRecordKey(record.EmployeeID, record.ClockTime)] = true
+	}
+
+	for _, log := range logs {
+		user, ok := users[log.PIN]
+		if !ok {
+			result.UnknownUsers++
+			continue
+		}
+		key := deviceRecordKey(user.EmployeeID, log.Time)
+		if imported[key] {
+			result.Duplicates++
+			continue
+		}
+
+		dto := log.ToDTO(device.DeviceSN)
+		now := time.Now()
+		record := &model.AttendanceRecord{
+			ID:            uuid.New(),
+			TenantID:      device.TenantID,
+			EmployeeID:    user.EmployeeID,
+			EmployeeName:  user.Name,
+			DepartmentID:  user.DepartmentID,
+			ClockTime:     dto.ClockTime,
+			ClockType:     dto.ClockType,
+			CheckInMethod: dto.CheckInMethod,
+			SourceType:    model.SourceTypeDevice,
+			SourceID:      dto.DeviceID,
+			Location:      device.Location,
+			Address:       device.InstallAddress,
+			RawData:       dto.RawData,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		}
+
+		created, err := s.attendance.ImportDeviceRecord(ctx, record)
+		if err != nil {
+			if errors.Is(err, ErrAttendancePeriodLocked) {
+				result.Locked++
+				continue
+			}
+			return fmt.Errorf("failed to import attendance record for pin %s: %w", log.PIN, err)
+		}
+		imported[key] = true
+		if !created {
+			result.Duplicates++
+			continue
+		}
+		result.Imported++
+	}
+	return nil
+}
+
+func biometricCaptures(templates []*zkteco.BioTemplate) []*BiometricCapture {
+	captures := make([]*BiometricCapture, 0, len(templates))
+	for _, template := range templates {
+		captures = append(captures, &BiometricCapture{
+			PIN:              template.PIN,
+			Type:             template.Type,
+			Index:            template.Index,
+			AlgorithmVersion: template.Version,
+			Template:         template.Template,
+		})
+	}
+	return captures
+}
+
+func deviceRecordKey(employeeID uuid.UUID, clockTime time.Time) string {
+	return fmt.Sprintf("%s|%d", employeeID, clockTime.Unix())
+}
+
+func countLines(body string) int {
+	count := 0
+	for _, line := range strings.Split(body, "\n") {
+		if strings.TrimSpace(line) != "" {
+			count++
+		}
+	}
+	return count
+}
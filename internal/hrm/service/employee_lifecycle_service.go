@@ -0,0 +1,967 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	approvalDto "github.com/lk2023060901/go-next-erp/internal/approval/dto"
+	approvalModel "github.com/lk2023060901/go-next-erp/internal/approval/model"
+	approvalService "github.com/lk2023060901/go-next-erp/internal/approval/service"
+	authRepository "github.com/lk2023060901/go-next-erp/internal/auth/repository"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	notificationDto "github.com/lk2023060901/go-next-erp/internal/notification/dto"
+	notificationService "github.com/lk2023060901/go-next-erp/internal/notification/service"
+	orgModel "github.com/lk2023060901/go-next-erp/internal/organization/model"
+	orgRepository "github.com/lk2023060901/go-next-erp/internal/organization/repository"
+	orgservice "github.com/lk2023060901/go-next-erp/internal/organization/service"
+)
+
+const (
+	// employeeLifecycleCron 同步审批结果、执行到期申请、发送试用期提醒
+	employeeLifecycleCron = "*/10 * * * *"
+
+	// lifecycleBatchSize 每轮任务处理的申请/提醒上限
+	lifecycleBatchSize = 200
+
+	// lifecycleMaxProbationMonths 试用期最长月数
+	lifecycleMaxProbationMonths = 6
+
+	// lifecycleTaskPageSize 离职交接时每批转交的待办任务数
+	lifecycleTaskPageSize = 100
+)
+
+// probationReminderDays 试用期结束前提醒的天数
+var probationReminderDays = []int{14, 3}
+
+var (
+	ErrLifecycleRequestNotFound    = errors.New("employee lifecycle request not found")
+	ErrLifecycleRequestInvalid     = errors.New("invalid employee lifecycle request")
+	ErrLifecycleRequestConflict    = errors.New("employee already has an open lifecycle request")
+	ErrLifecycleRequestNotOpen     = errors.New("employee lifecycle request can no longer be cancelled")
+	ErrLifecycleEmployeeNotFound   = errors.New("employee not found")
+	ErrLifecycleEmployeeState      = errors.New("employee status does not allow this operation")
+	ErrLifecycleProcessNotDefined  = errors.New("employee lifecycle approval process is not configured")
+	ErrLifecycleHandoverNotAllowed = errors.New("handover employee must be another active employee")
+)
+
+// EmployeeLifecycleService 员工生命周期服务接口（入职、转正、调岗、离职）
+type EmployeeLifecycleService interface {
+	// Submit 提交申请并发起审批，审批通过后在生效日执行
+	Submit(ctx context.Context, req *SubmitLifecycleRequest) (*model.EmployeeLifecycleRequest, error)
+
+	// Get 查询申请（同步审批状态）
+	Get(ctx context.Context, tenantID, id uuid.UUID) (*model.EmployeeLifecycleRequest, error)
+
+	// List 列表查询
+	List(ctx context.Context, tenantID uuid.UUID, filter *repository.EmployeeLifecycleFilter, offset, limit int) ([]*model.EmployeeLifecycleRequest, int, error)
+
+	// Cancel 撤回未执行的申请（审批中的同时取消审批流程）
+	Cancel(ctx context.Context, tenantID, id, operatorID uuid.UUID) (*model.EmployeeLifecycleRequest, error)
+
+	// ListEvents 查询员工的生命周期事件
+	ListEvents(ctx context.Context, tenantID, employeeID uuid.UUID) ([]*model.EmployeeLifecycleEvent, error)
+
+	// RunDue 定时任务：同步审批结果、执行已到生效日的申请、发送到期的试用期提醒
+	RunDue(ctx context.Context) (*LifecycleRunResult, error)
+
+	// CronSpec 定时任务的 Cron 表达式
+	CronSpec() string
+}
+
+// SubmitLifecycleRequest 提交生命周期申请
+type SubmitLifecycleRequest struct {
+	TenantID      uuid.UUID
+	EmployeeID    *uuid.UUID // 入职申请为空
+	Action        model.LifecycleAction
+	EffectiveDate time.Time
+	Reason        string
+	Hire          *model.LifecycleHireInfo
+	Transfer      *model.LifecycleTransferInfo
+	Separation    *model.LifecycleSeparationInfo
+	RequestedBy   uuid.UUID
+}
+
+// LifecycleRunResult 定时任务执行结果
+type LifecycleRunResult struct {
+	Decided   int `json:"decided"`   // 审批已出结果的申请数
+	Applied   int `json:"applied"`   // 执行成功的申请数
+	Failed    int `json:"failed"`    // 执行失败的申请数
+	Reminders int `json:"reminders"` // 发送的试用期提醒数
+}
+
+type employeeLifecycleService struct {
+	lifecycleRepo       repository.EmployeeLifecycleRepository
+	hrmEmpRepo          repository.HRMEmployeeRepository
+	hrmEmployees        HRMEmployeeService
+	orgEmployees        orgservice.EmployeeService
+	orgEmpRepo          orgRepository.EmployeeRepository
+	positionRepo        orgRepository.EmployeePositionRepository
+	sessionRepo         authRepository.SessionRepository
+	approval            approvalService.ApprovalService
+	notificationService notificationService.NotificationService
+	biometrics          BiometricService
+	now                 func() time.Time
+}
+
+// NewEmployeeLifecycleService 创建员工生命周期服务
+func NewEmployeeLifecycleService(
+	lifecycleRepo repository.EmployeeLifecycleRepository,
+	hrmEmpRepo repository.HRMEmployeeRepository,
+	hrmEmployees HRMEmployeeService,
+	orgEmployees orgservice.EmployeeService,
+	orgEmpRepo orgRepository.EmployeeRepository,
+	positionRepo orgRepository.EmployeePositionRepository,
+	sessionRepo authRepository.SessionRepository,
+	approval approvalService.ApprovalService,
+	notificationService notificationService.NotificationService,
+	biometrics BiometricService,
+) EmployeeLifecycleService {
+	s := &employeeLifecycleService{
+		lifecycleRepo:       lifecycleRepo,
+		hrmEmpRepo:          hrmEmpRepo,
+		hrmEmployees:        hrmEmployees,
+		orgEmployees:        orgEmployees,
+		orgEmpRepo:          orgEmpRepo,
+		positionRepo:        positionRepo,
+		sessionRepo:         sessionRepo,
+		approval:            approval,
+		notificationService: notificationService,
+		biometrics:          biometrics,
+		now:                 time.Now,
+	}
+	if approval != nil {
+		approval.OnProcessClosed(s.onApprovalClosed)
+	}
+	return s
+}
+
+func (s *employeeLifecycleService) CronSpec() string {
+	return employeeLifecycleCron
+}
+
+func (s *employeeLifecycleService) Submit(ctx context.Context, req *SubmitLifecycleRequest) (*model.EmployeeLifecycleRequest, error) {
+	if !req.Action.IsValid() || req.EffectiveDate.IsZero() {
+		return nil, ErrLifecycleRequestInvalid
+	}
+
+	now := s.now()
+	request := &model.EmployeeLifecycleRequest{
+		ID:            uuid.New(),
+		TenantID:      req.TenantID,
+		Action:        req.Action,
+		Status:        model.LifecycleRequestPending,
+		EffectiveDate: truncateDate(req.EffectiveDate),
+		Reason:        req.Reason,
+		RequestedBy:   req.RequestedBy,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	if req.Action == model.LifecycleHire {
+		if err := validateHireInfo(req); err != nil {
+			return nil, err
+		}
+		request.Hire = req.Hire
+		request.EmployeeName = req.Hire.Name
+	} else {
+		if req.EmployeeID == nil {
+			return nil, fmt.Errorf("%w: employee_id is required", ErrLifecycleRequestInvalid)
+		}
+		emp, err := s.findEmployee(ctx, req.TenantID, *req.EmployeeID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.prepareEmployeeAction(ctx, req, emp, request); err != nil {
+			return nil, err
+		}
+		open, err := s.lifecycleRepo.FindOpenByEmployee(ctx, req.TenantID, emp.ID)
+		if err != nil {
+			return nil, err
+		}
+		if open != nil {
+			return nil, ErrLifecycleRequestConflict
+		}
+		request.EmployeeID = &emp.ID
+		request.EmployeeName = emp.Name
+	}
+
+	processDefID, err := findEnabledProcess(ctx, s.approval, req.TenantID, req.Action.ProcessCode(), ErrLifecycleProcessNotDefined)
+	if err != nil {
+		return nil, err
+	}
+	instance, err := s.approval.StartProcess(ctx, &approvalDto.StartProcessRequest{
+		TenantID:     req.TenantID,
+		ProcessDefID: processDefID,
+		ApplicantID:  req.RequestedBy,
+		FormData:     lifecycleFormData(request),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start approval process: %w", err)
+	}
+	request.ApprovalInstanceID = &instance.ID
+
+	if err := s.lifecycleRepo.Create(ctx, request); err != nil {
+		return nil, err
+	}
+	s.recordEvent(ctx, request, model.LifecycleEventRequested, map[string]interface{}{
+		"effective_date": dateKey(request.EffectiveDate),
+		"requested_by":   request.RequestedBy.String(),
+	})
+	return request, nil
+}
+
+// prepareEmployeeAction 校验员工当前状态并补全申请附带的信息
+func (s *employeeLifecycleService) prepareEmployeeAction(ctx context.Context, req *SubmitLifecycleRequest, emp *orgModel.Employee, request *model.EmployeeLifecycleRequest) error {
+	switch req.Action {
+	case model.LifecycleConfirm:
+		if !emp.IsProbation() {
+			return fmt.Errorf("%w: employee is not in probation", ErrLifecycleEmployeeState)
+		}
+
+	case model.LifecycleTransfer:
+		if !emp.IsActive() {
+			return fmt.Errorf("%w: employee is not active", ErrLifecycleEmployeeState)
+		}
+		info := req.Transfer
+		if info == nil || (info.ToOrgID == nil && info.ToPositionID == nil && info.ToLeaderID == nil) {
+			return fmt.Errorf("%w: transfer target is required", ErrLifecycleRequestInvalid)
+		}
+		if info.ToLeaderID != nil && *info.ToLeaderID == emp.ID {
+			return fmt.Errorf("%w: cannot set self as direct leader", ErrLifecycleRequestInvalid)
+		}
+		request.Transfer = &model.LifecycleTransferInfo{
+			FromOrgID:      emp.OrgID,
+			FromPositionID: emp.PositionID,
+			ToOrgID:        info.ToOrgID,
+			ToPositionID:   info.ToPositionID,
+			ToLeaderID:     info.ToLeaderID,
+		}
+
+	case model.LifecycleResign, model.LifecycleTerminate:
+		if emp.IsResigned() {
+			return fmt.Errorf("%w: employee has already left", ErrLifecycleEmployeeState)
+		}
+		request.Separation = &model.LifecycleSeparationInfo{}
+		if req.Separation != nil && req.Separation.HandoverEmployeeID != nil {
+			handoverID := *req.Separation.HandoverEmployeeID
+			if handoverID == emp.ID {
+				return ErrLifecycleHandoverNotAllowed
+			}
+			handover, err := s.findEmployee(ctx, req.TenantID, handoverID)
+			if err != nil || !handover.IsActive() {
+				return ErrLifecycleHandoverNotAllowed
+			}
+			request.Separation.HandoverEmployeeID = &handoverID
+		}
+	}
+	return nil
+}
+
+func (s *employeeLifecycleService) Get(ctx context.Context, tenantID, id uuid.UUID) (*model.EmployeeLifecycleRequest, error) {
+	request, err := s.findRequest(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.syncApproval(ctx, request); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func (s *employeeLifecycleService) List(ctx context.Context, tenantID uuid.UUID, filter *repository.EmployeeLifecycleFilter, offset, limit int) ([]*model.EmployeeLifecycleRequest, int, error) {
+	return s.lifecycleRepo.List(ctx, tenantID, filter, offset, limit)
+}
+
+func (s *employeeLifecycleService) Cancel(ctx context.Context, tenantID, id, operatorID uuid.UUID) (*model.EmployeeLifecycleRequest, error) {
+	request, err := s.Get(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !request.Status.IsOpen() {
+		return nil, ErrLifecycleRequestNotOpen
+	}
+
+	if request.Status == model.LifecycleRequestPending && request.ApprovalInstanceID != nil {
+		reason := "员工生命周期申请已撤回"
+		if err := s.approval.CancelProcess(ctx, *request.ApprovalInstanceID, operatorID, &reason); err != nil {
+			return nil, fmt.Errorf("failed to cancel approval process: %w", err)
+		}
+		// 流程结束回调可能已将申请同步为已取消
+		if request, err = s.findRequest(ctx, tenantID, id); err != nil {
+			return nil, err
+		}
+		if !request.Status.IsOpen() {
+			return request, nil
+		}
+	}
+
+	now := s.now()
+	request.Status = model.LifecycleRequestCancelled
+	request.DecidedAt = &now
+	request.UpdatedAt = now
+	if err := s.lifecycleRepo.Update(ctx, request); err != nil {
+		return nil, err
+	}
+	s.recordEvent(ctx, request, model.LifecycleEventCancelled, map[string]interface{}{
+		"operator_id": operatorID.String(),
+	})
+	return request, nil
+}
+
+func (s *employeeLifecycleService) ListEvents(ctx context.Context, tenantID, employeeID uuid.UUID) ([]*model.EmployeeLifecycleEvent, error) {
+	return s.lifecycleRepo.ListEvents(ctx, tenantID, employeeID)
+}
+
+func (s *employeeLifecycleService) RunDue(ctx context.Context) (*LifecycleRunResult, error) {
+	result := &LifecycleRunResult{}
+	today := truncateDate(s.now())
+
+	requests, err := s.lifecycleRepo.ListOpen(ctx, lifecycleBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var firstErr error
+	for _, request := range requests {
+		decided, err := s.syncApproval(ctx, request)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if decided {
+			result.Decided++
+		}
+		if request.Status != model.LifecycleRequestApproved && request.Status != model.LifecycleRequestFailed {
+			continue
+		}
+		if request.EffectiveDate.After(today) {
+			continue
+		}
+		if err := s.apply(ctx, request); err != nil {
+			result.Failed++
+			continue
+		}
+		result.Applied++
+	}
+
+	sent, err := s.sendProbationReminders(ctx, today)
+	result.Reminders = sent
+	if err != nil && firstErr == nil {
+		firstErr = err
+	}
+	return result, firstErr
+}
+
+// syncApproval 审批中的申请同步审批结果，返回本次是否出了结果
+func (s *employeeLifecycleService) syncApproval(ctx context.Context, request *model.EmployeeLifecycleRequest) (bool, error) {
+	if request.Status != model.LifecycleRequestPending || request.ApprovalInstanceID == nil {
+		return false, nil
+	}
+
+	instance, err := s.approval.GetProcessInstance(ctx, *request.ApprovalInstanceID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get approval instance: %w", err)
+	}
+	return s.settle(ctx, request, instance.Status, instance.CompletedAt)
+}
+
+// onApprovalClosed 审批流程结束时立即同步申请状态（失败时由定时任务兜底）
+func (s *employeeLifecycleService) onApprovalClosed(ctx context.Context, instance *approvalModel.ProcessInstance) {
+	request, err := s.lifecycleRepo.FindByApprovalInstance(ctx, instance.TenantID, instance.ID)
+	if err != nil || request == nil || request.Status != model.LifecycleRequestPending {
+		return
+	}
+	_, _ = s.settle(ctx, request, instance.Status, instance.CompletedAt)
+}
+
+// settle 按审批结果更新申请状态，流程尚未结束时返回 false
+func (s *employeeLifecycleService) settle(ctx context.Context, request *model.EmployeeLifecycleRequest, processStatus approvalModel.ProcessStatus, completedAt *time.Time) (bool, error) {
+	var (
+		status    model.LifecycleRequestStatus
+		eventType model.LifecycleEventType
+	)
+	switch processStatus {
+	case approvalModel.ProcessStatusApproved:
+		status, eventType = model.LifecycleRequestApproved, model.LifecycleEventApproved
+	case approvalModel.ProcessStatusRejected:
+		status, eventType = model.LifecycleRequestRejected, model.LifecycleEventRejected
+	case approvalModel.ProcessStatusWithdrawn, approvalModel.ProcessStatusCancelled:
+		status, eventType = model.LifecycleRequestCancelled, model.LifecycleEventCancelled
+	default:
+		return false, nil
+	}
+
+	now := s.now()
+	request.Status = status
+	request.DecidedAt = &now
+	if completedAt != nil {
+		request.DecidedAt = completedAt
+	}
+	request.UpdatedAt = now
+	if err := s.lifecycleRepo.Update(ctx, request); err != nil {
+		return false, err
+	}
+	s.recordEvent(ctx, request, eventType, nil)
+	if status == model.LifecycleRequestRejected {
+		s.notifyRequester(ctx, request, "员工异动申请已驳回",
+			fmt.Sprintf("%s 的%s申请未通过审批", request.EmployeeName, lifecycleActionName(request.Action)))
+	}
+	return true, nil
+}
+
+// apply 执行已审批通过的申请；失败时记录原因，下一轮任务重试（各步骤均可重复执行）
+func (s *employeeLifecycleService) apply(ctx context.Context, request *model.EmployeeLifecycleRequest) error {
+	var (
+		eventType model.LifecycleEventType
+		payload   map[string]interface{}
+		err       error
+	)
+	switch request.Action {
+	case model.LifecycleHire:
+		eventType = model.LifecycleEventHired
+		payload, err = s.applyHire(ctx, request)
+	case model.LifecycleConfirm:
+		eventType = model.LifecycleEventConfirmed
+		payload, err = s.applyConfirm(ctx, request)
+	case model.LifecycleTransfer:
+		eventType = model.LifecycleEventTransferred
+		payload, err = s.applyTransfer(ctx, request)
+	default:
+		eventType = model.LifecycleEventOffboarded
+		payload, err = s.applyOffboarding(ctx, request)
+	}
+
+	now := s.now()
+	request.UpdatedAt = now
+	if err != nil {
+		firstFailure := request.Status != model.LifecycleRequestFailed || request.FailureReason != err.Error()
+		request.Status = model.LifecycleRequestFailed
+		request.FailureReason = err.Error()
+		if updateErr := s.lifecycleRepo.Update(ctx, request); updateErr != nil {
+			return updateErr
+		}
+		if firstFailure {
+			s.recordEvent(ctx, request, model.LifecycleEventFailed, map[string]interface{}{"error": err.Error()})
+		}
+		return err
+	}
+
+	request.Status = model.LifecycleRequestCompleted
+	request.CompletedAt = &now
+	request.FailureReason = ""
+	if err := s.lifecycleRepo.Update(ctx, request); err != nil {
+		return err
+	}
+	s.recordEvent(ctx, request, eventType, payload)
+	s.notifyRequester(ctx, request, "员工异动已生效",
+		fmt.Sprintf("%s 的%s已于 %s 生效", request.EmployeeName, lifecycleActionName(request.Action), dateKey(request.EffectiveDate)))
+	return nil
+}
+
+// applyHire 创建员工档案、主任职记录和考勤档案，并排期试用期提醒
+func (s *employeeLifecycleService) applyHire(ctx context.Context, request *model.EmployeeLifecycleRequest) (map[string]interface{}, error) {
+	info := request.Hire
+	if info == nil {
+		return nil, fmt.Errorf("%w: hire info is missing", ErrLifecycleRequestInvalid)
+	}
+
+	var emp *orgModel.Employee
+	if request.EmployeeID == nil {
+		joinDate := request.EffectiveDate
+		status := "active"
+		var probationEnd *time.Time
+		if info.ProbationMonths > 0 {
+			end := joinDate.AddDate(0, info.ProbationMonths, -1)
+			probationEnd = &end
+			status = "probation"
+		}
+
+		created, err := s.orgEmployees.Create(ctx, &orgservice.CreateEmployeeRequest{
+			TenantID:       request.TenantID,
+			UserID:         info.UserID,
+			EmployeeNo:     info.EmployeeNo,
+			Name:           info.Name,
+			Gender:         info.Gender,
+			Mobile:         info.Mobile,
+			Email:          info.Email,
+			OrgID:          info.OrgID,
+			PositionID:     info.PositionID,
+			DirectLeaderID: info.DirectLeaderID,
+			JoinDate:       &joinDate,
+			ProbationEnd:   probationEnd,
+			Status:         status,
+			CreatedBy:      request.RequestedBy,
+		})
+		if err != nil {
+			return nil, err
+		}
+		// 员工创建时不写入入职/试用日期，这里补写
+		if err := s.orgEmpRepo.Update(ctx, created); err != nil {
+			return nil, fmt.Errorf("failed to save employment dates: %w", err)
+		}
+		emp = created
+
+		// 先回填员工ID，后续步骤失败重试时不会重复创建员工
+		request.EmployeeID = &emp.ID
+		request.UpdatedAt = s.now()
+		if err := s.lifecycleRepo.Update(ctx, request); err != nil {
+			return nil, err
+		}
+	} else {
+		found, err := s.orgEmployees.GetByID(ctx, *request.EmployeeID)
+		if err != nil {
+			return nil, err
+		}
+		emp = found
+	}
+
+	if info.PositionID != nil {
+		positions, err := s.positionRepo.ListByEmployee(ctx, emp.ID)
+		if err != nil {
+			return nil, err
+		}
+		if len(positions) == 0 {
+			if err := s.openPosition(ctx, request, emp.ID, *info.PositionID, info.OrgID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if _, err := s.hrmEmployees.InitializeForEmployee(ctx, request.TenantID, emp.ID, request.RequestedBy); err != nil {
+		return nil, err
+	}
+
+	payload := map[string]interface{}{
+		"employee_no": emp.EmployeeNo,
+		"org_id":      emp.OrgID.String(),
+		"join_date":   dateKey(request.EffectiveDate),
+	}
+	if emp.ProbationEnd != nil {
+		reminders := buildProbationReminders(request.TenantID, emp.ID, *emp.ProbationEnd, s.now())
+		if err := s.lifecycleRepo.CreateReminders(ctx, reminders); err != nil {
+			return nil, err
+		}
+		payload["probation_end"] = dateKey(*emp.ProbationEnd)
+	}
+	return payload, nil
+}
+
+// applyConfirm 试用期转正
+func (s *employeeLifecycleService) applyConfirm(ctx context.Context, request *model.EmployeeLifecycleRequest) (map[string]interface{}, error) {
+	emp, err := s.orgEmployees.GetByID(ctx, *request.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+	if emp.IsProbation() {
+		if err := s.orgEmployees.Regularize(ctx, emp.ID, request.EffectiveDate, request.RequestedBy); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := s.lifecycleRepo.CancelReminders(ctx, emp.ID); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"formal_date": dateKey(request.EffectiveDate)}, nil
+}
+
+// applyTransfer 调整组织/职位/上级，结束原任职记录并开启新任职记录
+func (s *employeeLifecycleService) applyTransfer(ctx context.Context, request *model.EmployeeLifecycleRequest) (map[string]interface{}, error) {
+	info := request.Transfer
+	if info == nil {
+		return nil, fmt.Errorf("%w: transfer info is missing", ErrLifecycleRequestInvalid)
+	}
+	emp, err := s.orgEmployees.GetByID(ctx, *request.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	toOrgID := emp.OrgID
+	if info.ToOrgID != nil {
+		toOrgID = *info.ToOrgID
+	}
+	if info.ToOrgID != nil || info.ToPositionID != nil {
+		if err := s.orgEmployees.Transfer(ctx, emp.ID, toOrgID, info.ToPositionID, request.RequestedBy); err != nil {
+			return nil, err
+		}
+	}
+	if info.ToLeaderID != nil {
+		if err := s.orgEmployees.ChangeLeader(ctx, emp.ID, *info.ToLeaderID, request.RequestedBy); err != nil {
+			return nil, err
+		}
+	}
+
+	positionID := emp.PositionID
+	if info.ToPositionID != nil {
+		positionID = info.ToPositionID
+	}
+	if positionID != nil && (info.ToOrgID != nil || info.ToPositionID != nil) {
+		if err := s.switchPosition(ctx, request, emp.ID, *positionID, toOrgID); err != nil {
+			return nil, err
+		}
+	}
+
+	payload := map[string]interface{}{"from_org_id": info.FromOrgID.String(), "to_org_id": toOrgID.String()}
+	if info.FromPositionID != nil {
+		payload["from_position_id"] = info.FromPositionID.String()
+	}
+	if positionID != nil {
+		payload["to_position_id"] = positionID.String()
+	}
+	if info.ToLeaderID != nil {
+		payload["to_leader_id"] = info.ToLeaderID.String()
+	}
+	return payload, nil
+}
+
+// switchPosition 结束生效日前的任职记录并开启新的主任职记录（已开启时跳过）
+func (s *employeeLifecycleService) switchPosition(ctx context.Context, request *model.EmployeeLifecycleRequest, employeeID, positionID, orgID uuid.UUID) error {
+	positions, err := s.positionRepo.ListByEmployee(ctx, employeeID)
+	if err != nil {
+		return err
+	}
+	for _, position := range positions {
+		if position.EndDate == nil && position.PositionID == positionID && position.OrgID == orgID &&
+			position.StartDate != nil && position.StartDate.Equal(request.EffectiveDate) {
+			return nil
+		}
+	}
+
+	if _, err := s.positionRepo.CloseActive(ctx, employeeID, request.EffectiveDate.AddDate(0, 0, -1)); err != nil {
+		return err
+	}
+	return s.openPosition(ctx, request, employeeID, positionID, orgID)
+}
+
+func (s *employeeLifecycleService) openPosition(ctx context.Context, request *model.EmployeeLifecycleRequest, employeeID, positionID, orgID uuid.UUID) error {
+	now := s.now()
+	startDate := request.EffectiveDate
+	return s.positionRepo.Create(ctx, &orgModel.EmployeePosition{
+		ID:         uuid.New(),
+		TenantID:   request.TenantID,
+		EmployeeID: employeeID,
+		PositionID: positionID,
+		OrgID:      orgID,
+		IsPrimary:  true,
+		StartDate:  &startDate,
+		CreatedBy:  request.RequestedBy,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	})
+}
+
+// applyOffboarding 离职：下属改挂上级、结束任职、停用考勤、注销会话、转交待办审批
+func (s *employeeLifecycleService) applyOffboarding(ctx context.Context, request *model.EmployeeLifecycleRequest) (map[string]interface{}, error) {
+	emp, err := s.orgEmployees.GetByID(ctx, *request.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !emp.IsResigned() {
+		if emp.DirectLeaderID != nil {
+			subordinates, err := s.orgEmpRepo.ListByLeader(ctx, emp.ID)
+			if err != nil {
+				return nil, err
+			}
+			for _, subordinate := range subordinates {
+				if err := s.orgEmployees.ChangeLeader(ctx, subordinate.ID, *emp.DirectLeaderID, request.RequestedBy); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if err := s.orgEmployees.Resign(ctx, emp.ID, request.EffectiveDate, request.RequestedBy); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := s.positionRepo.CloseActive(ctx, emp.ID, request.EffectiveDate); err != nil {
+		return nil, err
+	}
+	if hrmEmp, err := s.hrmEmpRepo.FindByEmployeeID(ctx, request.TenantID, emp.ID); err == nil && hrmEmp.IsActive {
+		if err := s.hrmEmployees.Deactivate(ctx, hrmEmp.ID); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := s.lifecycleRepo.CancelReminders(ctx, emp.ID); err != nil {
+		return nil, err
+	}
+	if err := s.sessionRepo.RevokeUserSessions(ctx, emp.UserID); err != nil {
+		return nil, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	payload := map[string]interface{}{"leave_date": dateKey(request.EffectiveDate)}
+
+	// 从考勤设备删除离职员工及其生物特征
+	if s.biometrics != nil {
+		revoked, err := s.biometrics.Revoke(ctx, request.TenantID, emp.ID, &request.RequestedBy)
+		if err != nil && !errors.Is(err, ErrDeviceUsersNotFound) {
+			return nil, fmt.Errorf("failed to revoke device access: %w", err)
+		}
+		payload["revoked_devices"] = revoked
+	}
+
+	handoverID := emp.DirectLeaderID
+	if request.Separation != nil && request.Separation.HandoverEmployeeID != nil {
+		handoverID = request.Separation.HandoverEmployeeID
+	}
+	if handoverID == nil {
+		return payload, nil
+	}
+	handover, err := s.orgEmployees.GetByID(ctx, *handoverID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get handover employee: %w", err)
+	}
+	reassigned, err := s.reassignPendingTasks(ctx, request, emp.UserID, handover)
+	if err != nil {
+		return nil, err
+	}
+	payload["handover_employee_id"] = handover.ID.String()
+	payload["reassigned_tasks"] = reassigned
+	return payload, nil
+}
+
+// reassignPendingTasks 将离职人员的待办审批任务转交给接手人，返回转交数量
+func (s *employeeLifecycleService) reassignPendingTasks(ctx context.Context, request *model.EmployeeLifecycleRequest, fromUserID uuid.UUID, handover *orgModel.Employee) (int, error) {
+	reason := fmt.Sprintf("%s%s，待办转交 %s", request.EmployeeName, lifecycleActionName(request.Action), handover.Name)
+	reassigned := 0
+	for {
+		// 转交后的任务不再出现在列表中，因此每批都从头查询
+		tasks, _, err := s.approval.ListPendingTasks(ctx, request.TenantID, nil, &fromUserID, lifecycleTaskPageSize, 0)
+		if err != nil {
+			return reassigned, fmt.Errorf("failed to list pending tasks: %w", err)
+		}
+		for _, task := range tasks {
+			if err := s.approval.AdminReassignTask(ctx, &approvalDto.AdminReassignTaskRequest{
+				TenantID:   request.TenantID,
+				TaskID:     task.ID,
+				ToUserID:   handover.UserID,
+				ToUserName: handover.Name,
+				OperatorID: request.RequestedBy,
+				Reason:     &reason,
+			}); err != nil {
+				return reassigned, fmt.Errorf("failed to reassign task %s: %w", task.ID, err)
+			}
+			reassigned++
+		}
+		if len(tasks) < lifecycleTaskPageSize {
+			return reassigned, nil
+		}
+	}
+}
+
+// sendProbationReminders 发送到期的试用期提醒给直接上级和办理入职的人
+func (s *employeeLifecycleService) sendProbationReminders(ctx context.Context, today time.Time) (int, error) {
+	reminders, err := s.lifecycleRepo.ListDueReminders(ctx, today, lifecycleBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	var firstErr error
+	for _, reminder := range reminders {
+		emp, err := s.orgEmployees.GetByID(ctx, reminder.EmployeeID)
+		if err != nil || !emp.IsProbation() {
+			// 员工已转正/离职/删除，不再提醒
+			if _, err := s.lifecycleRepo.CancelReminders(ctx, reminder.EmployeeID); err != nil && firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+
+		var recipients []uuid.UUID
+		if emp.DirectLeaderID != nil {
+			if leader, err := s.orgEmployees.GetByID(ctx, *emp.DirectLeaderID); err == nil {
+				recipients = append(recipients, leader.UserID)
+			}
+		}
+		if emp.CreatedBy != uuid.Nil && (len(recipients) == 0 || recipients[0] != emp.CreatedBy) {
+			recipients = append(recipients, emp.CreatedBy)
+		}
+
+		daysLeft := int(reminder.ProbationEnd.Sub(today).Hours() / 24)
+		content := fmt.Sprintf("%s 的试用期将于 %s 结束（剩余 %d 天），请及时发起转正评估", emp.Name, dateKey(reminder.ProbationEnd), daysLeft)
+		for _, userID := range recipients {
+			s.send(ctx, reminder.TenantID, userID, "试用期即将到期", content, "hrm_probation", emp.ID.String(), map[string]interface{}{
+				"employee_id":   emp.ID.String(),
+				"probation_end": dateKey(reminder.ProbationEnd),
+			})
+		}
+
+		if err := s.lifecycleRepo.MarkReminderSent(ctx, reminder.ID, s.now()); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		employeeID := emp.ID
+		s.appendEvent(ctx, &model.EmployeeLifecycleEvent{
+			TenantID:   reminder.TenantID,
+			EmployeeID: &employeeID,
+			Type:       model.LifecycleEventProbationReminder,
+			Payload: map[string]interface{}{
+				"probation_end": dateKey(reminder.ProbationEnd),
+				"days_before":   reminder.DaysBefore,
+				"recipients":    len(recipients),
+			},
+		})
+		sent++
+	}
+	return sent, firstErr
+}
+
+func (s *employeeLifecycleService) findEmployee(ctx context.Context, tenantID, employeeID uuid.UUID) (*orgModel.Employee, error) {
+	emp, err := s.orgEmployees.GetByID(ctx, employeeID)
+	if err != nil || emp.TenantID != tenantID {
+		return nil, ErrLifecycleEmployeeNotFound
+	}
+	return emp, nil
+}
+
+func (s *employeeLifecycleService) findRequest(ctx context.Context, tenantID, id uuid.UUID) (*model.EmployeeLifecycleRequest, error) {
+	request, err := s.lifecycleRepo.FindByID(ctx, tenantID, id)
+	if err != nil {
+		return nil, ErrLifecycleRequestNotFound
+	}
+	return request, nil
+}
+
+// recordEvent 追加申请相关的领域事件
+func (s *employeeLifecycleService) recordEvent(ctx context.Context, request *model.EmployeeLifecycleRequest, eventType model.LifecycleEventType, payload map[string]interface{}) {
+	requestID := request.ID
+	s.appendEvent(ctx, &model.EmployeeLifecycleEvent{
+		TenantID:   request.TenantID,
+		EmployeeID: request.EmployeeID,
+		RequestID:  &requestID,
+		Type:       eventType,
+		Action:     request.Action,
+		Payload:    payload,
+	})
+}
+
+// appendEvent 事件写入失败不影响主流程
+func (s *employeeLifecycleService) appendEvent(ctx context.Context, event *model.EmployeeLifecycleEvent) {
+	event.ID = uuid.New()
+	event.OccurredAt = s.now()
+	_ = s.lifecycleRepo.AppendEvent(ctx, event)
+}
+
+func (s *employeeLifecycleService) notifyRequester(ctx context.Context, request *model.EmployeeLifecycleRequest, title, content string) {
+	s.send(ctx, request.TenantID, request.RequestedBy, title, content, "hrm_employee_lifecycle", request.ID.String(), map[string]interface{}{
+		"request_id": request.ID.String(),
+		"action":     string(request.Action),
+		"status":     string(request.Status),
+	})
+}
+
+func (s *employeeLifecycleService) send(ctx context.Context, tenantID, userID uuid.UUID, title, content, relatedType, relatedID string, data map[string]interface{}) {
+	if s.notificationService == nil {
+		return
+	}
+	_, _ = s.notificationService.SendNotification(ctx, tenantID, &notificationDto.SendNotificationRequest{
+		Type:        "system",
+		Channel:     "in_app",
+		RecipientID: userID.String(),
+		Title:       title,
+		Content:     content,
+		Data:        data,
+		RelatedType: &relatedType,
+		RelatedID:   &relatedID,
+	})
+}
+
+func validateHireInfo(req *SubmitLifecycleRequest) error {
+	info := req.Hire
+	if req.EmployeeID != nil || info == nil {
+		return fmt.Errorf("%w: hire info is required", ErrLifecycleRequestInvalid)
+	}
+	if info.UserID == uuid.Nil || info.OrgID == uuid.Nil || info.EmployeeNo == "" || info.Name == "" {
+		return fmt.Errorf("%w: user_id, org_id, employee_no and name are required", ErrLifecycleRequestInvalid)
+	}
+	if info.ProbationMonths < 0 || info.ProbationMonths > lifecycleMaxProbationMonths {
+		return fmt.Errorf("%w: probation months must be between 0 and %d", ErrLifecycleRequestInvalid, lifecycleMaxProbationMonths)
+	}
+	return nil
+}
+
+// buildProbationReminders 按试用期结束日期生成提醒，已过期的提醒日不再生成
+func buildProbationReminders(tenantID, employeeID uuid.UUID, probationEnd, now time.Time) []*model.ProbationReminder {
+	today := truncateDate(now)
+	probationEnd = truncateDate(probationEnd)
+
+	var reminders []*model.ProbationReminder
+	for _, days := range probationReminderDays {
+		remindOn := probationEnd.AddDate(0, 0, -days)
+		if remindOn.Before(today) {
+			continue
+		}
+		reminders = append(reminders, &model.ProbationReminder{
+			ID:           uuid.New(),
+			TenantID:     tenantID,
+			EmployeeID:   employeeID,
+			ProbationEnd: probationEnd,
+			RemindOn:     remindOn,
+			DaysBefore:   days,
+			Status:       model.ProbationReminderPending,
+			CreatedAt:    now,
+		})
+	}
+	return reminders
+}
+
+// lifecycleFormData 审批表单数据
+func lifecycleFormData(request *model.EmployeeLifecycleRequest) map[string]interface{} {
+	data := map[string]interface{}{
+		"request_id":     request.ID.String(),
+		"action":         string(request.Action),
+		"employee_name":  request.EmployeeName,
+		"effective_date": dateKey(request.EffectiveDate),
+		"reason":         request.Reason,
+	}
+	if request.EmployeeID != nil {
+		data["employee_id"] = request.EmployeeID.String()
+	}
+	if info := request.Hire; info != nil {
+		data["employee_no"] = info.EmployeeNo
+		data["org_id"] = info.OrgID.String()
+		data["probation_months"] = info.ProbationMonths
+		if info.PositionID != nil {
+			data["position_id"] = info.PositionID.String()
+		}
+	}
+	if info := request.Transfer; info != nil {
+		data["from_org_id"] = info.FromOrgID.String()
+		if info.ToOrgID != nil {
+			data["to_org_id"] = info.ToOrgID.String()
+		}
+		if info.ToPositionID != nil {
+			data["to_position_id"] = info.ToPositionID.String()
+		}
+		if info.ToLeaderID != nil {
+			data["to_leader_id"] = info.ToLeaderID.String()
+		}
+	}
+	if info := request.Separation; info != nil && info.HandoverEmployeeID != nil {
+		data["handover_employee_id"] = info.HandoverEmployeeID.String()
+	}
+	return data
+}
+
+func lifecycleActionName(action model.LifecycleAction) string {
+	switch action {
+	case model.LifecycleHire:
+		return "入职"
+	case model.LifecycleConfirm:
+		return "转正"
+	case model.LifecycleTransfer:
+		return "调岗"
+	case model.LifecycleResign:
+		return "离职"
+	case model.LifecycleTerminate:
+		return "辞退"
+	}
+	return string(action)
+}
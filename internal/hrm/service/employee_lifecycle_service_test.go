@@ -0,0 +1,558 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	approvalDto "github.com/lk2023060901/go-next-erp/internal/approval/dto"
+	approvalModel "github.com/lk2023060901/go-next-erp/internal/approval/model"
+	approvalService "github.com/lk2023060901/go-next-erp/internal/approval/service"
+	authRepository "github.com/lk2023060901/go-next-erp/internal/auth/repository"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	orgModel "github.com/lk2023060901/go-next-erp/internal/organization/model"
+	orgRepository "github.com/lk2023060901/go-next-erp/internal/organization/repository"
+	orgservice "github.com/lk2023060901/go-next-erp/internal/organization/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubLifecycleRepo struct {
+	repository.EmployeeLifecycleRepository
+	requests  map[uuid.UUID]*model.EmployeeLifecycleRequest
+	events    []*model.EmployeeLifecycleEvent
+	reminders []*model.ProbationReminder
+	cancelled []uuid.UUID
+}
+
+func newStubLifecycleRepo() *stubLifecycleRepo {
+	return &stubLifecycleRepo{requests: make(map[uuid.UUID]*model.EmployeeLifecycleRequest)}
+}
+
+func (r *stubLifecycleRepo) Create(ctx context.Context, req *model.EmployeeLifecycleRequest) error {
+	r.requests[req.ID] = req
+	return nil
+}
+
+func (r *stubLifecycleRepo) Update(ctx context.Context, req *model.EmployeeLifecycleRequest) error {
+	r.requests[req.ID] = req
+	return nil
+}
+
+func (r *stubLifecycleRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (*model.EmployeeLifecycleRequest, error) {
+	if req, ok := r.requests[id]; ok && req.TenantID == tenantID {
+		return req, nil
+	}
+	return nil, errStubNotFound
+}
+
+func (r *stubLifecycleRepo) FindOpenByEmployee(ctx context.Context, tenantID, employeeID uuid.UUID) (*model.EmployeeLifecycleRequest, error) {
+	for _, req := range r.requests {
+		if req.EmployeeID != nil && *req.EmployeeID == employeeID && req.Status.IsOpen() {
+			return req, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *stubLifecycleRepo) FindByApprovalInstance(ctx context.Context, tenantID, instanceID uuid.UUID) (*model.EmployeeLifecycleRequest, error) {
+	for _, req := range r.requests {
+		if req.TenantID == tenantID && req.ApprovalInstanceID != nil && *req.ApprovalInstanceID == instanceID {
+			return req, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *stubLifecycleRepo) ListOpen(ctx context.Context, limit int) ([]*model.EmployeeLifecycleRequest, error) {
+	var open []*model.EmployeeLifecycleRequest
+	for _, req := range r.requests {
+		if req.Status.IsOpen() {
+			open = append(open, req)
+		}
+	}
+	return open, nil
+}
+
+func (r *stubLifecycleRepo) AppendEvent(ctx context.Context, event *model.EmployeeLifecycleEvent) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (r *stubLifecycleRepo) CreateReminders(ctx context.Context, reminders []*model.ProbationReminder) error {
+	r.reminders = append(r.reminders, reminders...)
+	return nil
+}
+
+func (r *stubLifecycleRepo) ListDueReminders(ctx context.Context, asOf time.Time, limit int) ([]*model.ProbationReminder, error) {
+	var due []*model.ProbationReminder
+	for _, reminder := range r.reminders {
+		if reminder.Status == model.ProbationReminderPending && !reminder.RemindOn.After(asOf) {
+			due = append(due, reminder)
+		}
+	}
+	return due, nil
+}
+
+func (r *stubLifecycleRepo) MarkReminderSent(ctx context.Context, id uuid.UUID, sentAt time.Time) error {
+	for _, reminder := range r.reminders {
+		if reminder.ID == id {
+			reminder.Status = model.ProbationReminderSent
+			reminder.SentAt = &sentAt
+		}
+	}
+	return nil
+}
+
+func (r *stubLifecycleRepo) CancelReminders(ctx context.Context, employeeID uuid.UUID) (int, error) {
+	r.cancelled = append(r.cancelled, employeeID)
+	return 0, nil
+}
+
+func (r *stubLifecycleRepo) eventTypes() []model.LifecycleEventType {
+	var types []model.LifecycleEventType
+	for _, event := range r.events {
+		types = append(types, event.Type)
+	}
+	return types
+}
+
+// stubLifecycleEmployees 组织员工服务桩，直接修改内存中的员工
+type stubLifecycleEmployees struct {
+	orgservice.EmployeeService
+	employees map[uuid.UUID]*orgModel.Employee
+	created   []*orgservice.CreateEmployeeRequest
+}
+
+func (s *stubLifecycleEmployees) GetByID(ctx context.Context, id uuid.UUID) (*orgModel.Employee, error) {
+	if emp, ok := s.employees[id]; ok {
+		return emp, nil
+	}
+	return nil, errStubNotFound
+}
+
+func (s *stubLifecycleEmployees) Create(ctx context.Context, req *orgservice.CreateEmployeeRequest) (*orgModel.Employee, error) {
+	s.created = append(s.created, req)
+	emp := &orgModel.Employee{
+		ID: uuid.New(), TenantID: req.TenantID, UserID: req.UserID, EmployeeNo: req.EmployeeNo, Name: req.Name,
+		OrgID: req.OrgID, PositionID: req.PositionID, DirectLeaderID: req.DirectLeaderID,
+		JoinDate: req.JoinDate, ProbationEnd: req.ProbationEnd, Status: req.Status, CreatedBy: req.CreatedBy,
+	}
+	s.employees[emp.ID] = emp
+	return emp, nil
+}
+
+func (s *stubLifecycleEmployees) Regularize(ctx context.Context, empID uuid.UUID, formalDate time.Time, operatorID uuid.UUID) error {
+	s.employees[empID].Status = "active"
+	s.employees[empID].FormalDate = &formalDate
+	return nil
+}
+
+func (s *stubLifecycleEmployees) ChangeLeader(ctx context.Context, empID, newLeaderID uuid.UUID, operatorID uuid.UUID) error {
+	s.employees[empID].DirectLeaderID = &newLeaderID
+	return nil
+}
+
+func (s *stubLifecycleEmployees) Resign(ctx context.Context, empID uuid.UUID, leaveDate time.Time, operatorID uuid.UUID) error {
+	s.employees[empID].Status = "resigned"
+	s.employees[empID].LeaveDate = &leaveDate
+	return nil
+}
+
+type stubLifecycleEmpRepo struct {
+	orgRepository.EmployeeRepository
+	employees *stubLifecycleEmployees
+	updated   int
+}
+
+func (r *stubLifecycleEmpRepo) Update(ctx context.Context, emp *orgModel.Employee) error {
+	r.updated++
+	return nil
+}
+
+func (r *stubLifecycleEmpRepo) ListByLeader(ctx context.Context, leaderID uuid.UUID) ([]*orgModel.Employee, error) {
+	var subordinates []*orgModel.Employee
+	for _, emp := range r.employees.employees {
+		if emp.DirectLeaderID != nil && *emp.DirectLeaderID == leaderID {
+			subordinates = append(subordinates, emp)
+		}
+	}
+	return subordinates, nil
+}
+
+type stubLifecyclePositionRepo struct {
+	orgRepository.EmployeePositionRepository
+	positions []*orgModel.EmployeePosition
+	closedAt  []time.Time
+}
+
+func (r *stubLifecyclePositionRepo) Create(ctx context.Context, ep *orgModel.EmployeePosition) error {
+	r.positions = append(r.positions, ep)
+	return nil
+}
+
+func (r *stubLifecyclePositionRepo) ListByEmployee(ctx context.Context, employeeID uuid.UUID) ([]*orgModel.EmployeePosition, error) {
+	var positions []*orgModel.EmployeePosition
+	for _, ep := range r.positions {
+		if ep.EmployeeID == employeeID {
+			positions = append(positions, ep)
+		}
+	}
+	return positions, nil
+}
+
+func (r *stubLifecyclePositionRepo) CloseActive(ctx context.Context, employeeID uuid.UUID, endDate time.Time) (int, error) {
+	r.closedAt = append(r.closedAt, endDate)
+	return 1, nil
+}
+
+type stubLifecycleHRMEmpRepo struct {
+	repository.HRMEmployeeRepository
+	hrmEmp *model.HRMEmployee
+}
+
+func (r *stubLifecycleHRMEmpRepo) FindByEmployeeID(ctx context.Context, tenantID, employeeID uuid.UUID) (*model.HRMEmployee, error) {
+	if r.hrmEmp != nil && r.hrmEmp.EmployeeID == employeeID {
+		return r.hrmEmp, nil
+	}
+	return nil, errStubNotFound
+}
+
+type stubLifecycleHRMEmployees struct {
+	HRMEmployeeService
+	initialized []uuid.UUID
+	deactivated []uuid.UUID
+}
+
+func (s *stubLifecycleHRMEmployees) InitializeForEmployee(ctx context.Context, tenantID, employeeID, operatorID uuid.UUID) (*model.HRMEmployee, error) {
+	s.initialized = append(s.initialized, employeeID)
+	return &model.HRMEmployee{ID: uuid.New(), EmployeeID: employeeID, IsActive: true}, nil
+}
+
+func (s *stubLifecycleHRMEmployees) Deactivate(ctx context.Context, id uuid.UUID) error {
+	s.deactivated = append(s.deactivated, id)
+	return nil
+}
+
+type stubLifecycleSessions struct {
+	authRepository.SessionRepository
+	revoked []uuid.UUID
+}
+
+func (r *stubLifecycleSessions) RevokeUserSessions(ctx context.Context, userID uuid.UUID) error {
+	r.revoked = append(r.revoked, userID)
+	return nil
+}
+
+// stubLifecycleApprovals 审批模块桩，tasks 为各用户的待办任务
+type stubLifecycleApprovals struct {
+	approvalService.ApprovalService
+	codes      []string
+	status     approvalModel.ProcessStatus
+	tasks      map[uuid.UUID][]uuid.UUID
+	reassigned []*approvalDto.AdminReassignTaskRequest
+	hooks      []approvalService.ProcessClosedHook
+}
+
+func (s *stubLifecycleApprovals) OnProcessClosed(hook approvalService.ProcessClosedHook) {
+	s.hooks = append(s.hooks, hook)
+}
+
+// close 模拟审批流程结束并触发回调
+func (s *stubLifecycleApprovals) close(ctx context.Context, tenantID, instanceID uuid.UUID, status approvalModel.ProcessStatus) {
+	s.status = status
+	now := time.Now()
+	for _, hook := range s.hooks {
+		hook(ctx, &approvalModel.ProcessInstance{ID: instanceID, TenantID: tenantID, Status: status, CompletedAt: &now})
+	}
+}
+
+func (s *stubLifecycleApprovals) ListProcessDefinitions(ctx context.Context, tenantID uuid.UUID) ([]*approvalDto.ProcessDefResponse, error) {
+	var defs []*approvalDto.ProcessDefResponse
+	for _, code := range s.codes {
+		defs = append(defs, &approvalDto.ProcessDefResponse{ID: uuid.New(), Code: code, Enabled: true})
+	}
+	return defs, nil
+}
+
+func (s *stubLifecycleApprovals) StartProcess(ctx context.Context, req *approvalDto.StartProcessRequest) (*approvalDto.ProcessInstanceResponse, error) {
+	s.status = approvalModel.ProcessStatusPending
+	return &approvalDto.ProcessInstanceResponse{ID: uuid.New(), Status: s.status}, nil
+}
+
+func (s *stubLifecycleApprovals) GetProcessInstance(ctx context.Context, id uuid.UUID) (*approvalDto.ProcessInstanceResponse, error) {
+	return &approvalDto.ProcessInstanceResponse{ID: id, Status: s.status}, nil
+}
+
+func (s *stubLifecycleApprovals) ListPendingTasks(ctx context.Context, tenantID uuid.UUID, processDefID, assigneeID *uuid.UUID, limit, offset int) ([]*approvalDto.ApprovalTaskResponse, int, error) {
+	var tasks []*approvalDto.ApprovalTaskResponse
+	for _, id := range s.tasks[*assigneeID] {
+		tasks = append(tasks, &approvalDto.ApprovalTaskResponse{ID: id, AssigneeID: *assigneeID})
+	}
+	return tasks, len(tasks), nil
+}
+
+func (s *stubLifecycleApprovals) AdminReassignTask(ctx context.Context, req *approvalDto.AdminReassignTaskRequest) error {
+	s.reassigned = append(s.reassigned, req)
+	for userID, ids := range s.tasks {
+		for i, id := range ids {
+			if id == req.TaskID {
+				s.tasks[userID] = append(ids[:i:i], ids[i+1:]...)
+				s.tasks[req.ToUserID] = append(s.tasks[req.ToUserID], id)
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+type stubLifecycleBiometrics struct {
+	BiometricService
+	revoked []uuid.UUID
+}
+
+func (s *stubLifecycleBiometrics) Revoke(ctx context.Context, tenantID, employeeID uuid.UUID, operatorID *uuid.UUID) (int, error) {
+	s.revoked = append(s.revoked, employeeID)
+	return 2, nil
+}
+
+type lifecycleFixture struct {
+	service    *employeeLifecycleService
+	repo       *stubLifecycleRepo
+	employees  *stubLifecycleEmployees
+	empRepo    *stubLifecycleEmpRepo
+	positions  *stubLifecyclePositionRepo
+	hrmEmpRepo *stubLifecycleHRMEmpRepo
+	hrm        *stubLifecycleHRMEmployees
+	sessions   *stubLifecycleSessions
+	approvals  *stubLifecycleApprovals
+	biometrics *stubLifecycleBiometrics
+}
+
+func newLifecycleFixture(today time.Time) *lifecycleFixture {
+	f := &lifecycleFixture{
+		repo:       newStubLifecycleRepo(),
+		employees:  &stubLifecycleEmployees{employees: make(map[uuid.UUID]*orgModel.Employee)},
+		positions:  &stubLifecyclePositionRepo{},
+		hrmEmpRepo: &stubLifecycleHRMEmpRepo{},
+		hrm:        &stubLifecycleHRMEmployees{},
+		sessions:   &stubLifecycleSessions{},
+		approvals: &stubLifecycleApprovals{
+			codes: []string{
+				model.LifecycleHire.ProcessCode(), model.LifecycleConfirm.ProcessCode(),
+				model.LifecycleTransfer.ProcessCode(), model.LifecycleResign.ProcessCode(),
+			},
+			tasks: make(map[uuid.UUID][]uuid.UUID),
+		},
+		biometrics: &stubLifecycleBiometrics{},
+	}
+	f.empRepo = &stubLifecycleEmpRepo{employees: f.employees}
+	f.service = NewEmployeeLifecycleService(
+		f.repo, f.hrmEmpRepo, f.hrm, f.employees, f.empRepo, f.positions, f.sessions, f.approvals, nil, f.biometrics,
+	).(*employeeLifecycleService)
+	f.service.now = func() time.Time { return today.Add(9 * time.Hour) }
+	return f
+}
+
+func (f *lifecycleFixture) addEmployee(tenantID uuid.UUID, status string, leaderID *uuid.UUID) *orgModel.Employee {
+	emp := &orgModel.Employee{
+		ID: uuid.New(), TenantID: tenantID, UserID: uuid.New(), Name: "员工", OrgID: uuid.New(),
+		Status: status, DirectLeaderID: leaderID,
+	}
+	f.employees.employees[emp.ID] = emp
+	return emp
+}
+
+func TestBuildProbationReminders(t *testing.T) {
+	tenantID, employeeID := uuid.New(), uuid.New()
+
+	t.Run("schedules reminders before probation end", func(t *testing.T) {
+		reminders := buildProbationReminders(tenantID, employeeID, mustDate("2026-03-31"), mustDate("2026-01-01"))
+		require.Len(t, reminders, 2)
+		assert.Equal(t, mustDate("2026-03-17"), reminders[0].RemindOn)
+		assert.Equal(t, 14, reminders[0].DaysBefore)
+		assert.Equal(t, mustDate("2026-03-28"), reminders[1].RemindOn)
+		assert.Equal(t, model.ProbationReminderPending, reminders[1].Status)
+	})
+
+	t.Run("skips reminder dates already passed", func(t *testing.T) {
+		reminders := buildProbationReminders(tenantID, employeeID, mustDate("2026-03-31"), mustDate("2026-03-20"))
+		require.Len(t, reminders, 1)
+		assert.Equal(t, 3, reminders[0].DaysBefore)
+	})
+}
+
+func TestEmployeeLifecycleService_Submit(t *testing.T) {
+	ctx := context.Background()
+	today := mustDate("2026-03-02")
+	tenantID, requester := uuid.New(), uuid.New()
+
+	t.Run("confirm requires probation status", func(t *testing.T) {
+		f := newLifecycleFixture(today)
+		emp := f.addEmployee(tenantID, "active", nil)
+
+		_, err := f.service.Submit(ctx, &SubmitLifecycleRequest{
+			TenantID: tenantID, EmployeeID: &emp.ID, Action: model.LifecycleConfirm, EffectiveDate: today, RequestedBy: requester,
+		})
+		assert.ErrorIs(t, err, ErrLifecycleEmployeeState)
+	})
+
+	t.Run("one open request per employee", func(t *testing.T) {
+		f := newLifecycleFixture(today)
+		emp := f.addEmployee(tenantID, "active", nil)
+		orgID := uuid.New()
+		req := &SubmitLifecycleRequest{
+			TenantID: tenantID, EmployeeID: &emp.ID, Action: model.LifecycleTransfer, EffectiveDate: today,
+			Transfer: &model.LifecycleTransferInfo{ToOrgID: &orgID}, RequestedBy: requester,
+		}
+
+		request, err := f.service.Submit(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, model.LifecycleRequestPending, request.Status)
+		assert.NotNil(t, request.ApprovalInstanceID)
+		assert.Equal(t, emp.OrgID, request.Transfer.FromOrgID)
+		assert.Equal(t, []model.LifecycleEventType{model.LifecycleEventRequested}, f.repo.eventTypes())
+
+		_, err = f.service.Submit(ctx, req)
+		assert.ErrorIs(t, err, ErrLifecycleRequestConflict)
+	})
+
+	t.Run("approval process must be configured", func(t *testing.T) {
+		f := newLifecycleFixture(today)
+		emp := f.addEmployee(tenantID, "probation", nil)
+
+		_, err := f.service.Submit(ctx, &SubmitLifecycleRequest{
+			TenantID: tenantID, EmployeeID: &emp.ID, Action: model.LifecycleTerminate, EffectiveDate: today, RequestedBy: requester,
+		})
+		assert.ErrorIs(t, err, ErrLifecycleProcessNotDefined)
+	})
+}
+
+func TestEmployeeLifecycleService_RunDue(t *testing.T) {
+	ctx := context.Background()
+	today := mustDate("2026-03-02")
+	tenantID, requester := uuid.New(), uuid.New()
+
+	t.Run("hire waits for effective date then onboards", func(t *testing.T) {
+		f := newLifecycleFixture(today)
+		positionID := uuid.New()
+		request, err := f.service.Submit(ctx, &SubmitLifecycleRequest{
+			TenantID: tenantID, Action: model.LifecycleHire, EffectiveDate: today.AddDate(0, 0, 1), RequestedBy: requester,
+			Hire: &model.LifecycleHireInfo{
+				UserID: uuid.New(), EmployeeNo: "E001", Name: "新员工", OrgID: uuid.New(), PositionID: &positionID, ProbationMonths: 3,
+			},
+		})
+		require.NoError(t, err)
+
+		f.approvals.status = approvalModel.ProcessStatusApproved
+		result, err := f.service.RunDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Decided)
+		assert.Equal(t, 0, result.Applied)
+		assert.Equal(t, model.LifecycleRequestApproved, request.Status)
+
+		f.service.now = func() time.Time { return today.AddDate(0, 0, 1).Add(9 * time.Hour) }
+		result, err = f.service.RunDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Applied)
+		assert.Equal(t, model.LifecycleRequestCompleted, request.Status)
+		require.NotNil(t, request.EmployeeID)
+
+		require.Len(t, f.employees.created, 1)
+		assert.Equal(t, "probation", f.employees.created[0].Status)
+		assert.Equal(t, mustDate("2026-06-02"), *f.employees.created[0].ProbationEnd)
+		assert.Equal(t, 1, f.empRepo.updated)
+		require.Len(t, f.positions.positions, 1)
+		assert.True(t, f.positions.positions[0].IsPrimary)
+		assert.Equal(t, []uuid.UUID{*request.EmployeeID}, f.hrm.initialized)
+		assert.Len(t, f.repo.reminders, 2)
+		assert.Contains(t, f.repo.eventTypes(), model.LifecycleEventHired)
+	})
+
+	t.Run("rejected request is not applied", func(t *testing.T) {
+		f := newLifecycleFixture(today)
+		emp := f.addEmployee(tenantID, "probation", nil)
+		request, err := f.service.Submit(ctx, &SubmitLifecycleRequest{
+			TenantID: tenantID, EmployeeID: &emp.ID, Action: model.LifecycleConfirm, EffectiveDate: today, RequestedBy: requester,
+		})
+		require.NoError(t, err)
+
+		f.approvals.status = approvalModel.ProcessStatusRejected
+		result, err := f.service.RunDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.Applied)
+		assert.Equal(t, model.LifecycleRequestRejected, request.Status)
+		assert.Equal(t, "probation", emp.Status)
+	})
+
+	t.Run("closed approval settles request without waiting for the job", func(t *testing.T) {
+		f := newLifecycleFixture(today)
+		emp := f.addEmployee(tenantID, "probation", nil)
+		request, err := f.service.Submit(ctx, &SubmitLifecycleRequest{
+			TenantID: tenantID, EmployeeID: &emp.ID, Action: model.LifecycleConfirm, EffectiveDate: today, RequestedBy: requester,
+		})
+		require.NoError(t, err)
+
+		f.approvals.close(ctx, tenantID, *request.ApprovalInstanceID, approvalModel.ProcessStatusCancelled)
+		assert.Equal(t, model.LifecycleRequestCancelled, request.Status)
+		assert.Equal(t, []model.LifecycleEventType{model.LifecycleEventRequested, model.LifecycleEventCancelled}, f.repo.eventTypes())
+
+		// 其他租户的同ID实例不影响本租户申请
+		f.approvals.close(ctx, uuid.New(), *request.ApprovalInstanceID, approvalModel.ProcessStatusApproved)
+		assert.Equal(t, model.LifecycleRequestCancelled, request.Status)
+	})
+
+	t.Run("offboarding revokes access and hands over tasks", func(t *testing.T) {
+		f := newLifecycleFixture(today)
+		leader := f.addEmployee(tenantID, "active", nil)
+		emp := f.addEmployee(tenantID, "active", &leader.ID)
+		subordinate := f.addEmployee(tenantID, "active", &emp.ID)
+		handover := f.addEmployee(tenantID, "active", &leader.ID)
+		f.hrmEmpRepo.hrmEmp = &model.HRMEmployee{ID: uuid.New(), EmployeeID: emp.ID, IsActive: true}
+		f.approvals.tasks[emp.UserID] = []uuid.UUID{uuid.New(), uuid.New()}
+
+		request, err := f.service.Submit(ctx, &SubmitLifecycleRequest{
+			TenantID: tenantID, EmployeeID: &emp.ID, Action: model.LifecycleResign, EffectiveDate: today, RequestedBy: requester,
+			Separation: &model.LifecycleSeparationInfo{HandoverEmployeeID: &handover.ID},
+		})
+		require.NoError(t, err)
+
+		f.approvals.status = approvalModel.ProcessStatusApproved
+		result, err := f.service.RunDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Applied)
+		assert.Equal(t, model.LifecycleRequestCompleted, request.Status)
+
+		assert.Equal(t, "resigned", emp.Status)
+		assert.Equal(t, leader.ID, *subordinate.DirectLeaderID)
+		assert.Equal(t, []time.Time{today}, f.positions.closedAt)
+		assert.Equal(t, []uuid.UUID{f.hrmEmpRepo.hrmEmp.ID}, f.hrm.deactivated)
+		assert.Equal(t, []uuid.UUID{emp.UserID}, f.sessions.revoked)
+		assert.Equal(t, []uuid.UUID{emp.ID}, f.repo.cancelled)
+		assert.Equal(t, []uuid.UUID{emp.ID}, f.biometrics.revoked)
+		require.Len(t, f.approvals.reassigned, 2)
+		assert.Equal(t, handover.UserID, f.approvals.reassigned[0].ToUserID)
+		assert.Empty(t, f.approvals.tasks[emp.UserID])
+	})
+
+	t.Run("probation reminders notify and skip confirmed employees", func(t *testing.T) {
+		f := newLifecycleFixture(today)
+		onProbation := f.addEmployee(tenantID, "probation", nil)
+		confirmed := f.addEmployee(tenantID, "active", nil)
+		f.repo.reminders = []*model.ProbationReminder{
+			{ID: uuid.New(), TenantID: tenantID, EmployeeID: onProbation.ID, ProbationEnd: today.AddDate(0, 0, 3), RemindOn: today, DaysBefore: 3, Status: model.ProbationReminderPending},
+			{ID: uuid.New(), TenantID: tenantID, EmployeeID: confirmed.ID, ProbationEnd: today.AddDate(0, 0, 3), RemindOn: today, DaysBefore: 3, Status: model.ProbationReminderPending},
+			{ID: uuid.New(), TenantID: tenantID, EmployeeID: onProbation.ID, ProbationEnd: today.AddDate(0, 0, 14), RemindOn: today.AddDate(0, 0, 1), DaysBefore: 14, Status: model.ProbationReminderPending},
+		}
+
+		result, err := f.service.RunDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Reminders)
+		assert.Equal(t, model.ProbationReminderSent, f.repo.reminders[0].Status)
+		assert.Equal(t, model.ProbationReminderPending, f.repo.reminders[2].Status)
+		assert.Equal(t, []uuid.UUID{confirmed.ID}, f.repo.cancelled)
+		assert.Equal(t, []model.LifecycleEventType{model.LifecycleEventProbationReminder}, f.repo.eventTypes())
+	})
+}
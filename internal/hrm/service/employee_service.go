@@ -113,6 +113,14 @@ func (s *hrmEmployeeService) Create(ctx context.Context, req *dto.CreateHRMEmplo
 		}
 	}
 
+	// 身份证号密文存储，按盲索引检查唯一性
+	if req.IDCardNo != "" {
+		existing, err := s.hrmEmpRepo.FindByIDCardNo(ctx, tenantID, req.IDCardNo)
+		if err == nil && existing != nil {
+			return nil, fmt.Errorf("id card no already exists")
+		}
+	}
+
 	// 创建HRM员工
 	hrmEmp := &model.HRMEmployee{
 		ID:                uuid.New(),
@@ -0,0 +1,369 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/ical"
+)
+
+// 内置法定节假日预设（iCalendar 格式，文件名即预设编码）
+//
+//go:embed holiday_presets/*.ics
+var holidayPresets embed.FS
+
+// iCalendar 扩展属性：区分放假/调休上班以及是否法定节假日
+const (
+	icsPropDayType   = "X-ERP-DAY-TYPE"
+	icsPropStatutory = "X-ERP-STATUTORY"
+)
+
+var (
+	ErrCalendarNotFound     = errors.New("holiday calendar not found")
+	ErrCalendarCodeExists   = errors.New("holiday calendar code already exists")
+	ErrPresetNotFound       = errors.New("holiday preset not found")
+	ErrMakeupDayNotWeekend  = errors.New("make-up workday must fall on a weekend")
+	ErrInvalidCalendarDay   = errors.New("invalid calendar day kind")
+	ErrCalendarImportFailed = errors.New("failed to parse icalendar file")
+)
+
+// HolidayCalendarService 假期日历服务接口
+type HolidayCalendarService interface {
+	// 日历管理
+	CreateCalendar(ctx context.Context, calendar *model.HolidayCalendar) error
+	UpdateCalendar(ctx context.Context, calendar *model.HolidayCalendar) error
+	DeleteCalendar(ctx context.Context, tenantID, id uuid.UUID) error
+	GetCalendar(ctx context.Context, tenantID, id uuid.UUID) (*model.HolidayCalendar, error)
+	ListCalendars(ctx context.Context, tenantID uuid.UUID) ([]*model.HolidayCalendar, error)
+
+	// 放假/调休上班日维护
+	SetDays(ctx context.Context, tenantID, calendarID uuid.UUID, days []*model.HolidayCalendarDay) error
+	DeleteDay(ctx context.Context, tenantID, calendarID uuid.UUID, date time.Time) error
+	ListDays(ctx context.Context, tenantID, calendarID uuid.UUID, year int) ([]*model.HolidayCalendarDay, error)
+
+	// iCalendar 导入导出
+	ImportICS(ctx context.Context, tenantID, calendarID uuid.UUID, r io.Reader, opts *ICSImportOptions) (*ICSImportResult, error)
+	ExportICS(ctx context.Context, tenantID, calendarID uuid.UUID, year int) ([]byte, error)
+
+	// 内置法定节假日预设
+	ListPresets() []string
+	ImportPreset(ctx context.Context, tenantID, calendarID uuid.UUID, code string, replace bool) (*ICSImportResult, error)
+}
+
+// ICSImportOptions iCalendar 导入选项
+type ICSImportOptions struct {
+	Year    int  // 仅导入指定年份（0 表示全部）
+	Replace bool // 先清空所涉年份的已有特殊日
+}
+
+// ICSImportResult iCalendar 导入结果
+type ICSImportResult struct {
+	Holidays       int   `json:"holidays"`
+	MakeupWorkdays int   `json:"makeup_workdays"`
+	Replaced       int64 `json:"replaced"`
+	Years          []int `json:"years"`
+}
+
+type holidayCalendarService struct {
+	calendarRepo repository.HolidayCalendarRepository
+}
+
+// NewHolidayCalendarService 创建假期日历服务
+func NewHolidayCalendarService(calendarRepo repository.HolidayCalendarRepository) HolidayCalendarService {
+	return &holidayCalendarService{calendarRepo: calendarRepo}
+}
+
+func (s *holidayCalendarService) CreateCalendar(ctx context.Context, calendar *model.HolidayCalendar) error {
+	if _, err := s.calendarRepo.FindByCode(ctx, calendar.TenantID, calendar.Code); err == nil {
+		return ErrCalendarCodeExists
+	}
+
+	calendar.ID = uuid.Must(uuid.NewV7())
+	now := time.Now()
+	calendar.CreatedAt = now
+	calendar.UpdatedAt = now
+
+	if calendar.IsDefault {
+		if err := s.calendarRepo.ClearDefault(ctx, calendar.TenantID, calendar.ID); err != nil {
+			return err
+		}
+	}
+
+	return s.calendarRepo.Create(ctx, calendar)
+}
+
+func (s *holidayCalendarService) UpdateCalendar(ctx context.Context, calendar *model.HolidayCalendar) error {
+	if _, err := s.GetCalendar(ctx, calendar.TenantID, calendar.ID); err != nil {
+		return err
+	}
+
+	calendar.UpdatedAt = time.Now()
+	if calendar.IsDefault {
+		if err := s.calendarRepo.ClearDefault(ctx, calendar.TenantID, calendar.ID); err != nil {
+			return err
+		}
+	}
+
+	return s.calendarRepo.Update(ctx, calendar)
+}
+
+func (s *holidayCalendarService) DeleteCalendar(ctx context.Context, tenantID, id uuid.UUID) error {
+	if _, err := s.GetCalendar(ctx, tenantID, id); err != nil {
+		return err
+	}
+	return s.calendarRepo.Delete(ctx, id)
+}
+
+func (s *holidayCalendarService) GetCalendar(ctx context.Context, tenantID, id uuid.UUID) (*model.HolidayCalendar, error) {
+	calendar, err := s.calendarRepo.FindByID(ctx, id)
+	if err != nil || calendar.TenantID != tenantID {
+		return nil, ErrCalendarNotFound
+	}
+	return calendar, nil
+}
+
+func (s *holidayCalendarService) ListCalendars(ctx context.Context, tenantID uuid.UUID) ([]*model.HolidayCalendar, error) {
+	return s.calendarRepo.List(ctx, tenantID)
+}
+
+// SetDays 批量设置放假/调休上班日（同一日期覆盖原设置）
+func (s *holidayCalendarService) SetDays(ctx context.Context, tenantID, calendarID uuid.UUID, days []*model.HolidayCalendarDay) error {
+	if _, err := s.GetCalendar(ctx, tenantID, calendarID); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, day := range days {
+		if err := validateCalendarDay(day); err != nil {
+			return err
+		}
+		day.ID = uuid.Must(uuid.NewV7())
+		day.TenantID = tenantID
+		day.CalendarID = calendarID
+		day.Date = truncateDate(day.Date)
+		day.CreatedAt = now
+		day.UpdatedAt = now
+	}
+
+	return s.calendarRepo.UpsertDays(ctx, days)
+}
+
+func (s *holidayCalendarService) DeleteDay(ctx context.Context, tenantID, calendarID uuid.UUID, date time.Time) error {
+	if _, err := s.GetCalendar(ctx, tenantID, calendarID); err != nil {
+		return err
+	}
+	return s.calendarRepo.DeleteDay(ctx, calendarID, date)
+}
+
+func (s *holidayCalendarService) ListDays(ctx context.Context, tenantID, calendarID uuid.UUID, year int) ([]*model.HolidayCalendarDay, error) {
+	if _, err := s.GetCalendar(ctx, tenantID, calendarID); err != nil {
+		return nil, err
+	}
+	start, end := yearRange(year)
+	return s.calendarRepo.ListDays(ctx, calendarID, start, end)
+}
+
+// ImportICS 从 iCalendar 文件导入放假/调休上班日
+func (s *holidayCalendarService) ImportICS(ctx context.Context, tenantID, calendarID uuid.UUID, r io.Reader, opts *ICSImportOptions) (*ICSImportResult, error) {
+	if opts == nil {
+		opts = &ICSImportOptions{}
+	}
+	if _, err := s.GetCalendar(ctx, tenantID, calendarID); err != nil {
+		return nil, err
+	}
+
+	cal, err := ical.Parse(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCalendarImportFailed, err)
+	}
+
+	days, err := calendarDaysFromICS(cal, opts.Year)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ICSImportResult{}
+	years := make(map[int]bool)
+	for _, day := range days {
+		years[day.Date.Year()] = true
+		if day.Kind == model.CalendarDayMakeupWorkday {
+			result.MakeupWorkdays++
+		} else {
+			result.Holidays++
+		}
+	}
+	for year := range years {
+		result.Years = append(result.Years, year)
+	}
+	sort.Ints(result.Years)
+
+	if opts.Replace {
+		for _, year := range result.Years {
+			start, end := yearRange(year)
+			n, err := s.calendarRepo.DeleteDaysInRange(ctx, calendarID, start, end)
+			if err != nil {
+				return nil, err
+			}
+			result.Replaced += n
+		}
+	}
+
+	if err := s.SetDays(ctx, tenantID, calendarID, days); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ExportICS 导出某年的放假/调休上班日（连续且属性相同的日期合并为一个事件）
+func (s *holidayCalendarService) ExportICS(ctx context.Context, tenantID, calendarID uuid.UUID, year int) ([]byte, error) {
+	calendar, err := s.GetCalendar(ctx, tenantID, calendarID)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := yearRange(year)
+	days, err := s.calendarRepo.ListDays(ctx, calendarID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	err = ical.Encode(&buf, &ical.Calendar{
+		Name:   calendar.Name,
+		Events: calendarDaysToICS(calendar.Code, days),
+	})
+	return buf.Bytes(), err
+}
+
+func (s *holidayCalendarService) ListPresets() []string {
+	entries, _ := holidayPresets.ReadDir("holiday_presets")
+
+	var codes []string
+	for _, entry := range entries {
+		codes = append(codes, strings.TrimSuffix(entry.Name(), ".ics"))
+	}
+	return codes
+}
+
+// ImportPreset 导入内置法定节假日预设，如 CN-2025
+func (s *holidayCalendarService) ImportPreset(ctx context.Context, tenantID, calendarID uuid.UUID, code string, replace bool) (*ICSImportResult, error) {
+	data, err := holidayPresets.ReadFile(path.Join("holiday_presets", path.Base(code)+".ics"))
+	if err != nil {
+		return nil, ErrPresetNotFound
+	}
+
+	return s.ImportICS(ctx, tenantID, calendarID, bytes.NewReader(data), &ICSImportOptions{Replace: replace})
+}
+
+// calendarDaysFromICS 将 iCalendar 事件展开为逐日的特殊日
+//
+// 未带扩展属性的第三方日历：标题含“班”视为调休上班，其余视为法定节假日
+func calendarDaysFromICS(cal *ical.Calendar, year int) ([]*model.HolidayCalendarDay, error) {
+	byDate := make(map[string]*model.HolidayCalendarDay)
+	var dates []string
+
+	for _, event := range cal.Events {
+		kind := model.CalendarDayKind(strings.ToLower(event.Props[icsPropDayType]))
+		if kind == "" {
+			kind = model.CalendarDayHoliday
+			if strings.Contains(event.Summary, "班") {
+				kind = model.CalendarDayMakeupWorkday
+			}
+		}
+		statutory := kind == model.CalendarDayHoliday && !strings.EqualFold(event.Props[icsPropStatutory], "FALSE")
+
+		for _, date := range event.Days() {
+			if year != 0 && date.Year() != year {
+				continue
+			}
+			day := &model.HolidayCalendarDay{
+				Date:        date,
+				Kind:        kind,
+				Name:        event.Summary,
+				IsStatutory: statutory,
+				Remark:      event.Description,
+			}
+			if err := validateCalendarDay(day); err != nil {
+				return nil, fmt.Errorf("%s %s: %w", date.Format("2006-01-02"), event.Summary, err)
+			}
+
+			key := date.Format("2006-01-02")
+			if _, ok := byDate[key]; !ok {
+				dates = append(dates, key)
+			}
+			byDate[key] = day
+		}
+	}
+
+	sort.Strings(dates)
+	days := make([]*model.HolidayCalendarDay, 0, len(dates))
+	for _, key := range dates {
+		days = append(days, byDate[key])
+	}
+	return days, nil
+}
+
+// calendarDaysToICS 合并连续且类型、名称、法定属性相同的日期为 iCalendar 事件
+func calendarDaysToICS(code string, days []*model.HolidayCalendarDay) []*ical.Event {
+	var events []*ical.Event
+	var last *model.HolidayCalendarDay
+	for _, day := range days {
+		date := time.Date(day.Date.Year(), day.Date.Month(), day.Date.Day(), 0, 0, 0, 0, time.UTC)
+
+		if last != nil && len(events) > 0 {
+			current := events[len(events)-1]
+			if current.End.Equal(date) && last.Kind == day.Kind && last.Name == day.Name && last.IsStatutory == day.IsStatutory {
+				current.End = date.AddDate(0, 0, 1)
+				last = day
+				continue
+			}
+		}
+
+		props := map[string]string{icsPropDayType: string(day.Kind)}
+		if day.Kind == model.CalendarDayHoliday {
+			props[icsPropStatutory] = strings.ToUpper(fmt.Sprint(day.IsStatutory))
+		}
+		events = append(events, &ical.Event{
+			UID:         fmt.Sprintf("%s-%s@go-next-erp", code, date.Format("20060102")),
+			Summary:     day.Name,
+			Description: day.Remark,
+			Start:       date,
+			End:         date.AddDate(0, 0, 1),
+			Props:       props,
+		})
+		last = day
+	}
+	return events
+}
+
+func validateCalendarDay(day *model.HolidayCalendarDay) error {
+	switch day.Kind {
+	case model.CalendarDayHoliday:
+	case model.CalendarDayMakeupWorkday:
+		if wd := day.Date.Weekday(); wd != time.Saturday && wd != time.Sunday {
+			return ErrMakeupDayNotWeekend
+		}
+		day.IsStatutory = false
+	default:
+		return ErrInvalidCalendarDay
+	}
+	return nil
+}
+
+// yearRange 某年的首尾日期
+func yearRange(year int) (time.Time, time.Time) {
+	start := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(1, 0, -1)
+}
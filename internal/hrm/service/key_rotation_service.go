@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/fieldcrypt"
+)
+
+// defaultReencryptBatchSize 重新加密每批处理的记录数（单批一个事务）
+const defaultReencryptBatchSize = 200
+
+// KeyRotationService 敏感字段密钥轮换服务：生成新数据密钥、按新主密钥重新包装，并批量重新加密存量数据
+type KeyRotationService interface {
+	// RotateTenant 轮换单个租户
+	RotateTenant(ctx context.Context, tenantID uuid.UUID, opts *KeyRotationOptions) (*KeyRotationResult, error)
+
+	// RotateAll 依次轮换全部租户，单个租户失败不影响其他租户
+	RotateAll(ctx context.Context, opts *KeyRotationOptions) ([]*KeyRotationResult, error)
+}
+
+// KeyRotationOptions 密钥轮换选项
+type KeyRotationOptions struct {
+	BatchSize  int  // 每批记录数，默认 200
+	NewDataKey bool // 生成新版本数据密钥（否则仅把旧版本密文、明文迁移到当前版本）
+	Rewrap     bool // 用当前主密钥重新包装全部历史数据密钥（主密钥轮换时使用）
+}
+
+// KeyRotationResult 单个租户的轮换结果
+type KeyRotationResult struct {
+	TenantID         uuid.UUID `json:"tenant_id"`
+	DataKeyVersion   int       `json:"data_key_version,omitempty"`
+	Rewrapped        int       `json:"rewrapped"`
+	EmployeesScanned int       `json:"employees_scanned"`
+	EmployeesUpdated int       `json:"employees_updated"`
+	DevicesScanned   int       `json:"devices_scanned"`
+	DevicesUpdated   int       `json:"devices_updated"`
+	TemplatesScanned int       `json:"templates_scanned"`
+	TemplatesUpdated int       `json:"templates_updated"`
+	Error            string    `json:"error,omitempty"`
+}
+
+type keyRotationService struct {
+	cipher        fieldcrypt.Cipher
+	sensitiveRepo repository.SensitiveDataRepository
+}
+
+// NewKeyRotationService 创建密钥轮换服务
+func NewKeyRotationService(cipher fieldcrypt.Cipher, sensitiveRepo repository.SensitiveDataRepository) KeyRotationService {
+	return &keyRotationService{cipher: cipher, sensitiveRepo: sensitiveRepo}
+}
+
+func (s *keyRotationService) RotateTenant(ctx context.Context, tenantID uuid.UUID, opts *KeyRotationOptions) (*KeyRotationResult, error) {
+	if opts == nil {
+		opts = &KeyRotationOptions{}
+	}
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultReencryptBatchSize
+	}
+
+	result := &KeyRotationResult{TenantID: tenantID}
+
+	if opts.Rewrap {
+		n, err := s.cipher.RewrapDataKeys(ctx, tenantID)
+		if err != nil {
+			return result, fmt.Errorf("failed to rewrap data keys: %w", err)
+		}
+		result.Rewrapped = n
+	}
+
+	if opts.NewDataKey {
+		key, err := s.cipher.RotateDataKey(ctx, tenantID)
+		if err != nil {
+			return result, fmt.Errorf("failed to rotate data key: %w", err)
+		}
+		result.DataKeyVersion = key.Version
+	}
+
+	scanned, updated, err := s.reencrypt(ctx, tenantID, batchSize, s.sensitiveRepo.ReencryptEmployees)
+	result.EmployeesScanned, result.EmployeesUpdated = scanned, updated
+	if err != nil {
+		return result, fmt.Errorf("failed to re-encrypt employees: %w", err)
+	}
+
+	scanned, updated, err = s.reencrypt(ctx, tenantID, batchSize, s.sensitiveRepo.ReencryptDevices)
+	result.DevicesScanned, result.DevicesUpdated = scanned, updated
+	if err != nil {
+		return result, fmt.Errorf("failed to re-encrypt devices: %w", err)
+	}
+
+	scanned, updated, err = s.reencrypt(ctx, tenantID, batchSize, s.sensitiveRepo.ReencryptBiometricTemplates)
+	result.TemplatesScanned, result.TemplatesUpdated = scanned, updated
+	if err != nil {
+		return result, fmt.Errorf("failed to re-encrypt biometric templates: %w", err)
+	}
+
+	return result, nil
+}
+
+func (s *keyRotationService) RotateAll(ctx context.Context, opts *KeyRotationOptions) ([]*KeyRotationResult, error) {
+	tenantIDs, err := s.sensitiveRepo.ListTenantIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*KeyRotationResult, 0, len(tenantIDs))
+	for _, tenantID := range tenantIDs {
+		result, err := s.RotateTenant(ctx, tenantID, opts)
+		if err != nil {
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// reencrypt 按ID游标分批处理，直到某批不足 batchSize
+func (s *keyRotationService) reencrypt(
+	ctx context.Context,
+	tenantID uuid.UUID,
+	batchSize int,
+	batch func(ctx context.Context, tenantID, afterID uuid.UUID, limit int) (*repository.ReencryptBatchResult, error),
+) (int, int, error) {
+	var scanned, updated int
+	cursor := uuid.Nil
+	for {
+		res, err := batch(ctx, tenantID, cursor, batchSize)
+		if err != nil {
+			return scanned, updated, err
+		}
+		scanned += res.Scanned
+		updated += res.Updated
+		if res.Scanned < batchSize {
+			return scanned, updated, nil
+		}
+		cursor = res.LastID
+	}
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/fieldcrypt"
+)
+
+// stubSensitiveRepo 以内存行模拟员工敏感字段，按ID游标分批重新加密
+type stubSensitiveRepo struct {
+	repository.SensitiveDataRepository
+	cipher  fieldcrypt.Cipher
+	tenants []uuid.UUID
+	rows    map[uuid.UUID]string
+	batches int
+}
+
+func (r *stubSensitiveRepo) ListTenantIDs(ctx context.Context) ([]uuid.UUID, error) {
+	return r.tenants, nil
+}
+
+func (r *stubSensitiveRepo) ReencryptEmployees(ctx context.Context, tenantID, afterID uuid.UUID, limit int) (*repository.ReencryptBatchResult, error) {
+	r.batches++
+	ids := make([]uuid.UUID, 0, len(r.rows))
+	for id := range r.rows {
+		if strings.Compare(id.String(), afterID.String()) > 0 {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	result := &repository.ReencryptBatchResult{LastID: afterID}
+	for _, id := range ids {
+		result.Scanned++
+		result.LastID = id
+		stale, err := r.cipher.NeedsReencrypt(ctx, tenantID, r.rows[id])
+		if err != nil {
+			return nil, err
+		}
+		if !stale {
+			continue
+		}
+		plain, err := r.cipher.Decrypt(ctx, tenantID, r.rows[id])
+		if err != nil {
+			return nil, err
+		}
+		if r.rows[id], err = r.cipher.Encrypt(ctx, tenantID, plain); err != nil {
+			return nil, err
+		}
+		result.Updated++
+	}
+	return result, nil
+}
+
+func (r *stubSensitiveRepo) ReencryptDevices(ctx context.Context, tenantID, afterID uuid.UUID, limit int) (*repository.ReencryptBatchResult, error) {
+	return &repository.ReencryptBatchResult{LastID: afterID}, nil
+}
+
+func (r *stubSensitiveRepo) ReencryptBiometricTemplates(ctx context.Context, tenantID, afterID uuid.UUID, limit int) (*repository.ReencryptBatchResult, error) {
+	return &repository.ReencryptBatchResult{LastID: afterID}, nil
+}
+
+func newRotationTestCipher(t *testing.T, store fieldcrypt.KeyStore) fieldcrypt.Cipher {
+	t.Helper()
+	key := func(b byte) string {
+		return base64.StdEncoding.EncodeToString([]byte(strings.Repeat(string(rune('a'+b)), 32)))
+	}
+	cipher, err := fieldcrypt.NewCipher(&fieldcrypt.Config{
+		MasterKeys:      map[string]string{"k1": key(1)},
+		ActiveMasterKey: "k1",
+		BlindIndexKey:   key(2),
+	}, store)
+	require.NoError(t, err)
+	return cipher
+}
+
+// TestKeyRotationService 测试密钥轮换与存量数据重新加密
+func TestKeyRotationService(t *testing.T) {
+	ctx := context.Background()
+	tenantID := uuid.New()
+
+	t.Run("Rotate and re-encrypt in batches", func(t *testing.T) {
+		cipher := newRotationTestCipher(t, fieldcrypt.NewMemoryKeyStore())
+		old, err := cipher.Encrypt(ctx, tenantID, "110101199003078888")
+		require.NoError(t, err)
+
+		repo := &stubSensitiveRepo{cipher: cipher, rows: map[uuid.UUID]string{
+			uuid.New(): old,
+			uuid.New(): "legacy-plaintext",
+			uuid.New(): "",
+		}}
+		svc := NewKeyRotationService(cipher, repo)
+
+		result, err := svc.RotateTenant(ctx, tenantID, &KeyRotationOptions{BatchSize: 2, NewDataKey: true})
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.DataKeyVersion)
+		assert.Equal(t, 3, result.EmployeesScanned)
+		assert.Equal(t, 2, result.EmployeesUpdated)
+		assert.Equal(t, 2, repo.batches)
+
+		for _, value := range repo.rows {
+			if value == "" {
+				continue
+			}
+			assert.True(t, strings.HasPrefix(value, "enc:v1:2:"), value)
+		}
+	})
+
+	t.Run("Second run is a no-op", func(t *testing.T) {
+		cipher := newRotationTestCipher(t, fieldcrypt.NewMemoryKeyStore())
+		repo := &stubSensitiveRepo{cipher: cipher, rows: map[uuid.UUID]string{uuid.New(): "plain"}}
+		svc := NewKeyRotationService(cipher, repo)
+
+		_, err := svc.RotateTenant(ctx, tenantID, nil)
+		require.NoError(t, err)
+		result, err := svc.RotateTenant(ctx, tenantID, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.EmployeesUpdated)
+	})
+
+	t.Run("Rotate all tenants", func(t *testing.T) {
+		cipher := newRotationTestCipher(t, fieldcrypt.NewMemoryKeyStore())
+		repo := &stubSensitiveRepo{cipher: cipher, tenants: []uuid.UUID{uuid.New(), uuid.New()}, rows: map[uuid.UUID]string{}}
+		svc := NewKeyRotationService(cipher, repo)
+
+		results, err := svc.RotateAll(ctx, &KeyRotationOptions{NewDataKey: true, Rewrap: true})
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		for _, result := range results {
+			assert.Empty(t, result.Error)
+			assert.Equal(t, 1, result.DataKeyVersion)
+		}
+	})
+}
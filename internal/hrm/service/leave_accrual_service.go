@@ -0,0 +1,665 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+)
+
+const (
+	// leaveAccrualCron 额度发放、结转、过期任务执行时间
+	leaveAccrualCron = "15 2 * * *"
+
+	// compOffLeaveTypeCode 调休假类型编码，审批通过的加班调休天数计入该类型额度
+	compOffLeaveTypeCode = "compensatory_leave"
+
+	// defaultAccrualStep 折算后向下取整的默认步长（天）
+	defaultAccrualStep = 0.5
+)
+
+var (
+	ErrLeaveQuotaNotFound   = errors.New("leave quota not found")
+	ErrAdjustReasonRequired = errors.New("quota adjustment reason is required")
+	ErrAdjustAmountZero     = errors.New("quota adjustment amount must not be zero")
+)
+
+// LeaveAccrualService 请假额度发放服务：按规则发放、年末结转、到期作废，并记录额度流水
+type LeaveAccrualService interface {
+	// AccrueEmployee 按发放规则补齐员工某年度的额度（多发的部分冲减），返回变动笔数
+	AccrueEmployee(ctx context.Context, tenantID, employeeID uuid.UUID, year int) (int, error)
+
+	// AccrueTenant 按发放规则补齐租户内全部员工某年度的额度
+	AccrueTenant(ctx context.Context, tenantID uuid.UUID, year int) (int, error)
+
+	// CarryOver 年末结转：未休额度在上限内转入次年（带过期时间），其余作废
+	CarryOver(ctx context.Context, tenantID uuid.UUID, fromYear int) (int, error)
+
+	// ExpireDue 作废已到期的结转、调休额度中未使用的部分
+	ExpireDue(ctx context.Context, tenantID uuid.UUID) (int, error)
+
+	// RunDaily 每日任务：依次处理到期作废、年初结转、额度发放
+	RunDaily(ctx context.Context) (*AccrualRunResult, error)
+
+	// CronSpec 每日任务的 Cron 表达式
+	CronSpec() string
+
+	// CreditCompOff 审批通过的加班按可调休天数计入调休额度（同一加班只入账一次）
+	CreditCompOff(ctx context.Context, overtime *model.Overtime) error
+
+	// Grant 发放初始额度
+	Grant(ctx context.Context, quota *model.LeaveQuota, amount float64, reason string) error
+
+	// Adjust 人工调整额度，必须填写原因；operatorID 为空表示系统调整
+	Adjust(ctx context.Context, tenantID, quotaID uuid.UUID, amount float64, reason string, operatorID uuid.UUID) (*model.LeaveQuotaLedgerEntry, error)
+
+	// RecordUsage 记录请假扣减或销假退还（已用额度由请假流程维护，这里只记流水）
+	RecordUsage(ctx context.Context, quota *model.LeaveQuota, request *model.LeaveRequest, entryType model.LeaveLedgerEntryType, operatorID uuid.UUID) error
+
+	// ListLedger 查询员工某年度的额度流水
+	ListLedger(ctx context.Context, tenantID, employeeID uuid.UUID, year int) ([]*model.LeaveQuotaLedgerEntry, error)
+}
+
+// AccrualRunResult 每日额度任务执行结果
+type AccrualRunResult struct {
+	Tenants   int `json:"tenants"`
+	Accrued   int `json:"accrued"`    // 发放/冲减笔数
+	CarriedIn int `json:"carried_in"` // 结转处理的额度数
+	Expired   int `json:"expired"`    // 到期处理笔数
+	Failed    int `json:"failed"`
+}
+
+type leaveAccrualService struct {
+	leaveTypeRepo  repository.LeaveTypeRepository
+	leaveQuotaRepo repository.LeaveQuotaRepository
+	ledgerRepo     repository.LeaveQuotaLedgerRepository
+	hrmEmpRepo     repository.HRMEmployeeRepository
+	now            func() time.Time
+}
+
+// NewLeaveAccrualService 创建请假额度发放服务
+func NewLeaveAccrualService(
+	leaveTypeRepo repository.LeaveTypeRepository,
+	leaveQuotaRepo repository.LeaveQuotaRepository,
+	ledgerRepo repository.LeaveQuotaLedgerRepository,
+	hrmEmpRepo repository.HRMEmployeeRepository,
+) LeaveAccrualService {
+	return &leaveAccrualService{
+		leaveTypeRepo:  leaveTypeRepo,
+		leaveQuotaRepo: leaveQuotaRepo,
+		ledgerRepo:     ledgerRepo,
+		hrmEmpRepo:     hrmEmpRepo,
+		now:            time.Now,
+	}
+}
+
+func (s *leaveAccrualService) CronSpec() string {
+	return leaveAccrualCron
+}
+
+// accrualTypes 需要按规则发放的请假类型
+func (s *leaveAccrualService) accrualTypes(ctx context.Context, tenantID uuid.UUID) ([]*model.LeaveType, error) {
+	leaveTypes, err := s.leaveTypeRepo.ListActive(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list leave types: %w", err)
+	}
+	var result []*model.LeaveType
+	for _, lt := range leaveTypes {
+		if lt.DeductQuota && lt.AccrualPolicy != nil {
+			result = append(result, lt)
+		}
+	}
+	return result, nil
+}
+
+func (s *leaveAccrualService) AccrueEmployee(ctx context.Context, tenantID, employeeID uuid.UUID, year int) (int, error) {
+	tenure, err := s.hrmEmpRepo.FindTenure(ctx, tenantID, employeeID)
+	if err != nil {
+		return 0, err
+	}
+	leaveTypes, err := s.accrualTypes(ctx, tenantID)
+	if err != nil {
+		return 0, err
+	}
+
+	changed := 0
+	for _, lt := range leaveTypes {
+		applied, err := s.accrue(ctx, tenantID, lt, tenure, year)
+		if err != nil {
+			return changed, err
+		}
+		if applied {
+			changed++
+		}
+	}
+	return changed, nil
+}
+
+func (s *leaveAccrualService) AccrueTenant(ctx context.Context, tenantID uuid.UUID, year int) (int, error) {
+	leaveTypes, err := s.accrualTypes(ctx, tenantID)
+	if err != nil || len(leaveTypes) == 0 {
+		return 0, err
+	}
+	tenures, err := s.hrmEmpRepo.ListTenures(ctx, tenantID)
+	if err != nil {
+		return 0, err
+	}
+
+	changed := 0
+	var firstErr error
+	for _, tenure := range tenures {
+		for _, lt := range leaveTypes {
+			applied, err := s.accrue(ctx, tenantID, lt, tenure, year)
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				continue
+			}
+			if applied {
+				changed++
+			}
+		}
+	}
+	return changed, firstErr
+}
+
+// accrue 将员工某类型的已发放额度补齐到规则应发数
+func (s *leaveAccrualService) accrue(ctx context.Context, tenantID uuid.UUID, lt *model.LeaveType, tenure *model.EmployeeTenure, year int) (bool, error) {
+	now := s.now()
+	target := accrualTarget(lt.AccrualPolicy, tenure, year, now)
+
+	quota, err := s.leaveQuotaRepo.FindByEmployeeAndType(ctx, tenantID, tenure.EmployeeID, lt.ID, year)
+	if err != nil {
+		if target <= 0 {
+			return false, nil
+		}
+		if quota, err = s.createQuota(ctx, tenantID, tenure.EmployeeID, lt.ID, year); err != nil {
+			return false, err
+		}
+	}
+
+	history, err := s.ledgerRepo.ListByQuota(ctx, quota.ID)
+	if err != nil {
+		return false, err
+	}
+	granted := 0.0
+	for _, entry := range history {
+		if entry.EntryType == model.LeaveLedgerAccrual {
+			granted += entry.Amount
+		}
+	}
+
+	delta := round2(target - granted)
+	if delta == 0 {
+		return false, nil
+	}
+
+	reason := fmt.Sprintf("按规则发放 %d 年额度，应发 %.2f", year, target)
+	if delta < 0 {
+		reason = fmt.Sprintf("按入职/离职日期重新折算 %d 年额度，应发 %.2f", year, target)
+	}
+	return s.apply(ctx, quota, &model.LeaveQuotaLedgerEntry{
+		EntryType:      model.LeaveLedgerAccrual,
+		Amount:         delta,
+		Reason:         reason,
+		SourceType:     "policy",
+		SourceID:       &lt.ID,
+		IdempotencyKey: fmt.Sprintf("accrual:%s:%s:%.2f", quota.ID, dateKey(now), target),
+	})
+}
+
+func (s *leaveAccrualService) CarryOver(ctx context.Context, tenantID uuid.UUID, fromYear int) (int, error) {
+	leaveTypes, err := s.accrualTypes(ctx, tenantID)
+	if err != nil {
+		return 0, err
+	}
+
+	processed := 0
+	var firstErr error
+	for _, lt := range leaveTypes {
+		quotas, err := s.leaveQuotaRepo.ListByTypeAndYear(ctx, tenantID, lt.ID, fromYear)
+		if err != nil {
+			return processed, err
+		}
+		for _, quota := range quotas {
+			done, err := s.carryOverQuota(ctx, lt.AccrualPolicy, quota)
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				continue
+			}
+			if done {
+				processed++
+			}
+		}
+	}
+	return processed, firstErr
+}
+
+// carryOverQuota 结转单条额度。每一步都带幂等键，中途失败后重跑可以接着完成：
+// 先转入次年、再从当年转出、最后作废当年剩余
+func (s *leaveAccrualService) carryOverQuota(ctx context.Context, policy *model.LeaveAccrualPolicy, quota *model.LeaveQuota) (bool, error) {
+	history, err := s.ledgerRepo.ListByQuota(ctx, quota.ID)
+	if err != nil {
+		return false, err
+	}
+
+	// 已经转出过的以流水为准，避免余额变化后重算出不同的结转数
+	carry, carriedOut := 0.0, false
+	for _, entry := range history {
+		if entry.EntryType == model.LeaveLedgerCarryOver && entry.Amount < 0 {
+			carry, carriedOut = -entry.Amount, true
+		}
+	}
+	if !carriedOut {
+		remaining := quota.RemainingQuota()
+		if remaining <= 0 {
+			return false, nil
+		}
+		carry = round2(math.Min(remaining, math.Max(policy.CarryOverCap, 0)))
+	}
+
+	loc := time.Local
+	expireAt := time.Date(quota.Year, 12, 31, 23, 59, 59, 0, loc)
+	if carry > 0 {
+		expireAt = policy.CarryOverExpireAt(quota.Year, loc)
+		next, err := s.leaveQuotaRepo.FindByEmployeeAndType(ctx, quota.TenantID, quota.EmployeeID, quota.LeaveTypeID, quota.Year+1)
+		if err != nil {
+			if next, err = s.createQuota(ctx, quota.TenantID, quota.EmployeeID, quota.LeaveTypeID, quota.Year+1); err != nil {
+				return false, err
+			}
+		}
+		if _, err := s.apply(ctx, next, &model.LeaveQuotaLedgerEntry{
+			EntryType:      model.LeaveLedgerCarryOver,
+			Amount:         carry,
+			ExpiresAt:      &expireAt,
+			Reason:         fmt.Sprintf("%d 年未休额度结转，%s 到期", quota.Year, expireAt.Format("2006-01-02")),
+			SourceType:     "leave_quota",
+			SourceID:       &quota.ID,
+			IdempotencyKey: "carry-in:" + quota.ID.String(),
+		}); err != nil {
+			return false, err
+		}
+		if _, err := s.apply(ctx, quota, &model.LeaveQuotaLedgerEntry{
+			EntryType:      model.LeaveLedgerCarryOver,
+			Amount:         -carry,
+			Reason:         fmt.Sprintf("结转至 %d 年", quota.Year+1),
+			SourceType:     "leave_quota",
+			SourceID:       &next.ID,
+			IdempotencyKey: "carry-out:" + quota.ID.String(),
+		}); err != nil {
+			return false, err
+		}
+	}
+
+	// 超出结转上限的部分作废
+	current, err := s.leaveQuotaRepo.FindByID(ctx, quota.ID)
+	if err != nil {
+		return false, err
+	}
+	if forfeit := round2(current.RemainingQuota()); forfeit > 0 {
+		if _, err := s.apply(ctx, current, &model.LeaveQuotaLedgerEntry{
+			EntryType:      model.LeaveLedgerExpiry,
+			Amount:         -forfeit,
+			Reason:         fmt.Sprintf("%d 年未休额度超出结转上限作废", quota.Year),
+			SourceType:     "leave_quota",
+			SourceID:       &quota.ID,
+			IdempotencyKey: "forfeit:" + quota.ID.String(),
+		}); err != nil {
+			return false, err
+		}
+		if current, err = s.leaveQuotaRepo.FindByID(ctx, quota.ID); err != nil {
+			return false, err
+		}
+	}
+
+	// 当年额度的最终有效期：有结转时延至结转到期日
+	current.ExpiredAt = &expireAt
+	current.UpdatedAt = s.now()
+	if err := s.leaveQuotaRepo.Update(ctx, current); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *leaveAccrualService) ExpireDue(ctx context.Context, tenantID uuid.UUID) (int, error) {
+	entries, err := s.ledgerRepo.ListExpiring(ctx, tenantID, s.now())
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, entry := range entries {
+		quota, err := s.leaveQuotaRepo.FindByID(ctx, entry.QuotaID)
+		if err != nil {
+			return expired, err
+		}
+		history, err := s.ledgerRepo.ListByQuota(ctx, quota.ID)
+		if err != nil {
+			return expired, err
+		}
+
+		// 未使用部分为 0 时也写一条 0 流水，标记该笔入账已处理
+		unused := unusedExpiring(entry, quota, history)
+		applied, err := s.apply(ctx, quota, &model.LeaveQuotaLedgerEntry{
+			EntryType:      model.LeaveLedgerExpiry,
+			Amount:         -unused,
+			Reason:         fmt.Sprintf("%s 入账的额度于 %s 到期", entry.EntryType, entry.ExpiresAt.Format("2006-01-02")),
+			SourceType:     "ledger",
+			SourceID:       &entry.ID,
+			IdempotencyKey: "expire:" + entry.ID.String(),
+		})
+		if err != nil {
+			return expired, err
+		}
+		if applied {
+			expired++
+		}
+	}
+	return expired, nil
+}
+
+func (s *leaveAccrualService) RunDaily(ctx context.Context) (*AccrualRunResult, error) {
+	result := &AccrualRunResult{}
+	today := truncateDate(s.now())
+
+	tenantIDs, err := s.hrmEmpRepo.ListActiveTenantIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result.Tenants = len(tenantIDs)
+
+	var firstErr error
+	fail := func(err error) {
+		result.Failed++
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	for _, tenantID := range tenantIDs {
+		// 一月份内先补齐上一年度的发放再结转，结转和发放都是幂等的，每天重跑可补上新入职或失败的员工
+		if today.Month() == time.January {
+			n, err := s.AccrueTenant(ctx, tenantID, today.Year()-1)
+			result.Accrued += n
+			if err != nil {
+				fail(err)
+			}
+		}
+
+		n, err := s.ExpireDue(ctx, tenantID)
+		result.Expired += n
+		if err != nil {
+			fail(err)
+		}
+
+		if today.Month() == time.January {
+			n, err := s.CarryOver(ctx, tenantID, today.Year()-1)
+			result.CarriedIn += n
+			if err != nil {
+				fail(err)
+			}
+		}
+
+		n, err = s.AccrueTenant(ctx, tenantID, today.Year())
+		result.Accrued += n
+		if err != nil {
+			fail(err)
+		}
+	}
+	return result, firstErr
+}
+
+func (s *leaveAccrualService) CreditCompOff(ctx context.Context, overtime *model.Overtime) error {
+	if overtime.CompOffDays <= 0 {
+		return nil
+	}
+
+	// 租户未配置调休假类型时不入账
+	lt, err := s.leaveTypeRepo.FindByCode(ctx, overtime.TenantID, compOffLeaveTypeCode)
+	if err != nil || !lt.DeductQuota {
+		return nil
+	}
+
+	year := overtime.StartTime.Year()
+	quota, err := s.leaveQuotaRepo.FindByEmployeeAndType(ctx, overtime.TenantID, overtime.EmployeeID, lt.ID, year)
+	if err != nil {
+		if quota, err = s.createQuota(ctx, overtime.TenantID, overtime.EmployeeID, lt.ID, year); err != nil {
+			return err
+		}
+	}
+
+	_, err = s.apply(ctx, quota, &model.LeaveQuotaLedgerEntry{
+		EntryType:      model.LeaveLedgerCompOff,
+		Amount:         overtime.CompOffDays,
+		ExpiresAt:      overtime.CompOffExpireAt,
+		Reason:         fmt.Sprintf("%s 加班 %.2f 小时转调休", overtime.StartTime.Format("2006-01-02"), overtime.Duration),
+		SourceType:     "overtime",
+		SourceID:       &overtime.ID,
+		OperatorID:     overtime.ApprovedBy,
+		IdempotencyKey: "comp-off:" + overtime.ID.String(),
+	})
+	return err
+}
+
+func (s *leaveAccrualService) Grant(ctx context.Context, quota *model.LeaveQuota, amount float64, reason string) error {
+	if amount == 0 {
+		return nil
+	}
+	_, err := s.apply(ctx, quota, &model.LeaveQuotaLedgerEntry{
+		EntryType:      model.LeaveLedgerGrant,
+		Amount:         amount,
+		Reason:         reason,
+		SourceType:     "policy",
+		SourceID:       &quota.LeaveTypeID,
+		IdempotencyKey: "grant:" + quota.ID.String(),
+	})
+	return err
+}
+
+func (s *leaveAccrualService) Adjust(ctx context.Context, tenantID, quotaID uuid.UUID, amount float64, reason string, operatorID uuid.UUID) (*model.LeaveQuotaLedgerEntry, error) {
+	if reason == "" {
+		return nil, ErrAdjustReasonRequired
+	}
+	amount = round2(amount)
+	if amount == 0 {
+		return nil, ErrAdjustAmountZero
+	}
+
+	quota, err := s.leaveQuotaRepo.FindByID(ctx, quotaID)
+	if err != nil || quota.TenantID != tenantID {
+		return nil, ErrLeaveQuotaNotFound
+	}
+
+	entry := &model.LeaveQuotaLedgerEntry{
+		EntryType:      model.LeaveLedgerAdjustment,
+		Amount:         amount,
+		Reason:         reason,
+		SourceType:     "manual",
+		IdempotencyKey: "adjust:" + uuid.Must(uuid.NewV7()).String(),
+	}
+	if operatorID != uuid.Nil {
+		entry.OperatorID = &operatorID
+	}
+	if _, err := s.apply(ctx, quota, entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+func (s *leaveAccrualService) RecordUsage(ctx context.Context, quota *model.LeaveQuota, request *model.LeaveRequest, entryType model.LeaveLedgerEntryType, operatorID uuid.UUID) error {
+	amount, reason := -request.Duration, "请假扣减"
+	if entryType == model.LeaveLedgerRefund {
+		amount, reason = request.Duration, "销假退还"
+	}
+	_, err := s.apply(ctx, quota, &model.LeaveQuotaLedgerEntry{
+		EntryType:      entryType,
+		Amount:         amount,
+		Reason:         fmt.Sprintf("%s：%s 至 %s", reason, request.StartTime.Format("2006-01-02 15:04"), request.EndTime.Format("2006-01-02 15:04")),
+		SourceType:     "leave_request",
+		SourceID:       &request.ID,
+		OperatorID:     &operatorID,
+		IdempotencyKey: fmt.Sprintf("%s:%s", entryType, request.ID),
+	})
+	return err
+}
+
+func (s *leaveAccrualService) ListLedger(ctx context.Context, tenantID, employeeID uuid.UUID, year int) ([]*model.LeaveQuotaLedgerEntry, error) {
+	return s.ledgerRepo.ListByEmployee(ctx, tenantID, employeeID, year)
+}
+
+// apply 补齐流水的归属字段后写入
+func (s *leaveAccrualService) apply(ctx context.Context, quota *model.LeaveQuota, entry *model.LeaveQuotaLedgerEntry) (bool, error) {
+	entry.ID = uuid.Must(uuid.NewV7())
+	entry.TenantID = quota.TenantID
+	entry.EmployeeID = quota.EmployeeID
+	entry.LeaveTypeID = quota.LeaveTypeID
+	entry.QuotaID = quota.ID
+	entry.Year = quota.Year
+	entry.CreatedAt = s.now()
+	return s.ledgerRepo.Apply(ctx, entry)
+}
+
+// createQuota 创建空额度，额度由流水写入；默认当年年底过期，结转时再延长
+func (s *leaveAccrualService) createQuota(ctx context.Context, tenantID, employeeID, leaveTypeID uuid.UUID, year int) (*model.LeaveQuota, error) {
+	now := s.now()
+	expiredAt := time.Date(year, 12, 31, 23, 59, 59, 0, time.Local)
+	quota := &model.LeaveQuota{
+		ID:          uuid.Must(uuid.NewV7()),
+		TenantID:    tenantID,
+		EmployeeID:  employeeID,
+		LeaveTypeID: leaveTypeID,
+		Year:        year,
+		ExpiredAt:   &expiredAt,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := s.leaveQuotaRepo.Create(ctx, quota); err != nil {
+		return nil, fmt.Errorf("failed to create leave quota: %w", err)
+	}
+	return quota, nil
+}
+
+// accrualTarget 截至 asOf 员工某年度按规则应发的额度
+func accrualTarget(policy *model.LeaveAccrualPolicy, tenure *model.EmployeeTenure, year int, asOf time.Time) float64 {
+	loc := asOf.Location()
+	today := truncateDate(asOf)
+	yearStart := time.Date(year, 1, 1, 0, 0, 0, 0, loc)
+	yearEnd := time.Date(year, 12, 31, 0, 0, 0, 0, loc)
+	if today.Before(yearStart) {
+		return 0
+	}
+	// 尚未入职的不提前发放
+	if join := localDate(tenure.JoinDate, loc); join != nil && join.After(today) {
+		return 0
+	}
+
+	total := 0.0
+	if policy.Frequency == model.LeaveAccrualMonthly {
+		lastMonth := 12
+		if today.Year() == year {
+			lastMonth = int(today.Month())
+		}
+		for m := 1; m <= lastMonth; m++ {
+			monthStart := time.Date(year, time.Month(m), 1, 0, 0, 0, 0, loc)
+			monthEnd := monthStart.AddDate(0, 1, -1)
+			days := employedDays(tenure, monthStart, monthEnd)
+			if days == 0 {
+				continue
+			}
+			share := policy.AnnualEntitlement(serviceYears(tenure.JoinDate, monthStart)) / 12
+			if policy.ProRate {
+				share = share * float64(days) / float64(monthEnd.Day())
+			}
+			total += share
+		}
+	} else {
+		days := employedDays(tenure, yearStart, yearEnd)
+		if days == 0 {
+			return 0
+		}
+		// 工龄按年初计算，年中跨档不调整当年额度
+		total = policy.AnnualEntitlement(serviceYears(tenure.JoinDate, yearStart))
+		if policy.ProRate {
+			total = total * float64(days) / float64(yearEnd.YearDay())
+		}
+	}
+
+	step := policy.RoundingStep
+	if step <= 0 {
+		step = defaultAccrualStep
+	}
+	return roundLeaveDuration(total, model.LeaveDurationPolicy{RoundingMode: model.LeaveRoundingDown, RoundingStep: step})
+}
+
+// employedDays [from, to] 内的在职天数（离职日当天计入）
+func employedDays(tenure *model.EmployeeTenure, from, to time.Time) int {
+	loc := from.Location()
+	if join := localDate(tenure.JoinDate, loc); join != nil && join.After(from) {
+		from = *join
+	}
+	if leave := localDate(tenure.LeaveDate, loc); leave != nil && leave.Before(to) {
+		to = *leave
+	}
+	if to.Before(from) {
+		return 0
+	}
+	return int(math.Round(to.Sub(from).Hours()/24)) + 1
+}
+
+// serviceYears 截至 at 的司龄（整年）
+func serviceYears(joinDate *time.Time, at time.Time) int {
+	if joinDate == nil {
+		return 0
+	}
+	years := at.Year() - joinDate.Year()
+	if at.Month() < joinDate.Month() || (at.Month() == joinDate.Month() && at.Day() < joinDate.Day()) {
+		years--
+	}
+	if years < 0 {
+		return 0
+	}
+	return years
+}
+
+// localDate 将档案中的日期换算为 loc 时区的零点
+func localDate(t *time.Time, loc *time.Location) *time.Time {
+	if t == nil {
+		return nil
+	}
+	d := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	return &d
+}
+
+// unusedExpiring 到期入账中尚未使用的部分。
+// 使用按先到期先使用：更早到期的入账先消耗已用和待审批额度，再轮到本笔，无过期时间的额度最后使用
+func unusedExpiring(entry *model.LeaveQuotaLedgerEntry, quota *model.LeaveQuota, history []*model.LeaveQuotaLedgerEntry) float64 {
+	expiredBySource := make(map[uuid.UUID]float64)
+	for _, h := range history {
+		if h.EntryType == model.LeaveLedgerExpiry && h.SourceType == "ledger" && h.SourceID != nil {
+			expiredBySource[*h.SourceID] += -h.Amount
+		}
+	}
+
+	consumed := quota.UsedQuota + quota.PendingQuota
+	for _, h := range history {
+		if h.ID == entry.ID || h.Amount <= 0 || h.ExpiresAt == nil {
+			continue
+		}
+		expired, settled := expiredBySource[h.ID]
+		if !settled || h.ExpiresAt.After(*entry.ExpiresAt) {
+			continue
+		}
+		consumed -= h.Amount - expired
+	}
+
+	unused := entry.Amount - math.Min(entry.Amount, math.Max(consumed, 0))
+	return round2(math.Max(0, math.Min(unused, quota.RemainingQuota())))
+}
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+)
+
+// stubQuotaRepo 内存额度仓储
+type stubQuotaRepo struct {
+	repository.LeaveQuotaRepository
+	quotas map[uuid.UUID]*model.LeaveQuota
+}
+
+func (r *stubQuotaRepo) Create(ctx context.Context, quota *model.LeaveQuota) error {
+	r.quotas[quota.ID] = quota
+	return nil
+}
+
+func (r *stubQuotaRepo) Update(ctx context.Context, quota *model.LeaveQuota) error {
+	copied := *quota
+	r.quotas[quota.ID] = &copied
+	return nil
+}
+
+func (r *stubQuotaRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.LeaveQuota, error) {
+	if q, ok := r.quotas[id]; ok {
+		copied := *q
+		return &copied, nil
+	}
+	return nil, errStubNotFound
+}
+
+func (r *stubQuotaRepo) FindByEmployeeAndType(ctx context.Context, tenantID, employeeID, leaveTypeID uuid.UUID, year int) (*model.LeaveQuota, error) {
+	for _, q := range r.quotas {
+		if q.EmployeeID == employeeID && q.LeaveTypeID == leaveTypeID && q.Year == year {
+			copied := *q
+			return &copied, nil
+		}
+	}
+	return nil, errStubNotFound
+}
+
+// stubLedgerRepo 内存流水仓储，按幂等键去重并同步总额度
+type stubLedgerRepo struct {
+	repository.LeaveQuotaLedgerRepository
+	quotas  *stubQuotaRepo
+	entries []*model.LeaveQuotaLedgerEntry
+}
+
+func (r *stubLedgerRepo) Apply(ctx context.Context, entry *model.LeaveQuotaLedgerEntry) (bool, error) {
+	for _, e := range r.entries {
+		if e.IdempotencyKey == entry.IdempotencyKey {
+			return false, nil
+		}
+	}
+	quota := r.quotas.quotas[entry.QuotaID]
+	if entry.EntryType.AdjustsTotal() {
+		quota.TotalQuota += entry.Amount
+	}
+	entry.BalanceAfter = quota.RemainingQuota()
+	r.entries = append(r.entries, entry)
+	return true, nil
+}
+
+func (r *stubLedgerRepo) ListByQuota(ctx context.Context, quotaID uuid.UUID) ([]*model.LeaveQuotaLedgerEntry, error) {
+	var result []*model.LeaveQuotaLedgerEntry
+	for _, e := range r.entries {
+		if e.QuotaID == quotaID {
+			result = append(result, e)
+		}
+	}
+	return result, nil
+}
+
+func TestAccrualTarget(t *testing.T) {
+	join := func(date string) *model.EmployeeTenure {
+		d := mustDate(date)
+		return &model.EmployeeTenure{EmployeeID: uuid.New(), JoinDate: &d}
+	}
+	tiered := &model.LeaveAccrualPolicy{
+		Frequency:      model.LeaveAccrualYearly,
+		BaseQuota:      5,
+		SeniorityTiers: []model.SeniorityTier{{MinYears: 10, Days: 10}, {MinYears: 20, Days: 15}},
+		ProRate:        true,
+	}
+
+	t.Run("yearly seniority tier at year start", func(t *testing.T) {
+		assert.Equal(t, 10.0, accrualTarget(tiered, join("2014-06-01"), 2025, mustDate("2025-03-01")))
+		assert.Equal(t, 5.0, accrualTarget(tiered, join("2015-06-01"), 2025, mustDate("2025-03-01")))
+	})
+
+	t.Run("yearly pro-rated by join date rounds down", func(t *testing.T) {
+		// 2025-07-01 入职：184/365 * 5 = 2.52 → 2.5
+		assert.Equal(t, 2.5, accrualTarget(tiered, join("2025-07-01"), 2025, mustDate("2025-07-01")))
+	})
+
+	t.Run("leave date reduces target", func(t *testing.T) {
+		tenure := join("2010-01-01")
+		leave := mustDate("2025-03-31")
+		tenure.LeaveDate = &leave
+		// 90/365 * 10 = 2.47 → 2
+		assert.Equal(t, 2.0, accrualTarget(tiered, tenure, 2025, mustDate("2025-04-15")))
+	})
+
+	t.Run("not joined yet", func(t *testing.T) {
+		assert.Zero(t, accrualTarget(tiered, join("2025-09-01"), 2025, mustDate("2025-08-01")))
+	})
+
+	t.Run("monthly accrues through current month", func(t *testing.T) {
+		policy := &model.LeaveAccrualPolicy{Frequency: model.LeaveAccrualMonthly, BaseQuota: 12, ProRate: true, RoundingStep: 0.5}
+		assert.Equal(t, 3.0, accrualTarget(policy, join("2020-01-01"), 2025, mustDate("2025-03-10")))
+		// 3 月 16 日入职：3 月折算 16/31，4 月整月
+		assert.Equal(t, 1.5, accrualTarget(policy, join("2025-03-16"), 2025, mustDate("2025-04-02")))
+		assert.Equal(t, 12.0, accrualTarget(policy, join("2020-01-01"), 2025, mustDate("2026-01-05")))
+	})
+}
+
+func TestUnusedExpiring(t *testing.T) {
+	expireAt := mustDate("2026-03-31")
+	carry := &model.LeaveQuotaLedgerEntry{ID: uuid.New(), EntryType: model.LeaveLedgerCarryOver, Amount: 3, ExpiresAt: &expireAt}
+	history := []*model.LeaveQuotaLedgerEntry{
+		carry,
+		{ID: uuid.New(), EntryType: model.LeaveLedgerAccrual, Amount: 5},
+	}
+
+	t.Run("usage consumes carried days first", func(t *testing.T) {
+		quota := &model.LeaveQuota{TotalQuota: 8, UsedQuota: 2}
+		assert.Equal(t, 1.0, unusedExpiring(carry, quota, history))
+	})
+
+	t.Run("fully used", func(t *testing.T) {
+		quota := &model.LeaveQuota{TotalQuota: 8, UsedQuota: 3, PendingQuota: 1}
+		assert.Zero(t, unusedExpiring(carry, quota, history))
+	})
+
+	t.Run("earlier settled grant absorbs usage", func(t *testing.T) {
+		earlierAt := mustDate("2026-02-28")
+		earlier := &model.LeaveQuotaLedgerEntry{ID: uuid.New(), EntryType: model.LeaveLedgerCompOff, Amount: 1, ExpiresAt: &earlierAt}
+		expired := &model.LeaveQuotaLedgerEntry{ID: uuid.New(), EntryType: model.LeaveLedgerExpiry, Amount: 0, SourceType: "ledger", SourceID: &earlier.ID}
+		quota := &model.LeaveQuota{TotalQuota: 9, UsedQuota: 2}
+
+		assert.Equal(t, 2.0, unusedExpiring(carry, quota, append(history, earlier, expired)))
+	})
+}
+
+func TestLeaveAccrualService_CarryOver(t *testing.T) {
+	ctx := context.Background()
+	policy := &model.LeaveAccrualPolicy{Frequency: model.LeaveAccrualYearly, BaseQuota: 10, CarryOverCap: 3, CarryOverMonths: 3}
+
+	newService := func(remaining float64) (*leaveAccrualService, *stubQuotaRepo, *stubLedgerRepo, *model.LeaveQuota) {
+		quotas := &stubQuotaRepo{quotas: map[uuid.UUID]*model.LeaveQuota{}}
+		ledger := &stubLedgerRepo{quotas: quotas}
+		quota := &model.LeaveQuota{ID: uuid.New(), TenantID: uuid.New(), EmployeeID: uuid.New(), LeaveTypeID: uuid.New(), Year: 2025, TotalQuota: 10, UsedQuota: 10 - remaining}
+		quotas.quotas[quota.ID] = quota
+		svc := &leaveAccrualService{
+			leaveQuotaRepo: quotas,
+			ledgerRepo:     ledger,
+			now:            func() time.Time { return mustDate("2026-01-02") },
+		}
+		return svc, quotas, ledger, quota
+	}
+
+	t.Run("caps carry and forfeits the rest", func(t *testing.T) {
+		svc, quotas, ledger, quota := newService(5)
+
+		done, err := svc.carryOverQuota(ctx, policy, quota)
+		require.NoError(t, err)
+		assert.True(t, done)
+
+		next, err := quotas.FindByEmployeeAndType(ctx, quota.TenantID, quota.EmployeeID, quota.LeaveTypeID, 2026)
+		require.NoError(t, err)
+		assert.Equal(t, 3.0, next.TotalQuota)
+
+		old := quotas.quotas[quota.ID]
+		assert.Zero(t, old.RemainingQuota())
+		require.NotNil(t, old.ExpiredAt)
+		assert.Equal(t, "2026-03-31", old.ExpiredAt.Format("2006-01-02"))
+
+		// carry-in、carry-out、forfeit 各一笔
+		require.Len(t, ledger.entries, 3)
+		assert.Equal(t, -2.0, ledger.entries[2].Amount)
+		assert.Equal(t, model.LeaveLedgerExpiry, ledger.entries[2].EntryType)
+		require.NotNil(t, ledger.entries[0].ExpiresAt)
+	})
+
+	t.Run("rerun is idempotent", func(t *testing.T) {
+		svc, quotas, ledger, quota := newService(5)
+
+		_, err := svc.carryOverQuota(ctx, policy, quota)
+		require.NoError(t, err)
+		_, err = svc.carryOverQuota(ctx, policy, quotas.quotas[quota.ID])
+		require.NoError(t, err)
+
+		assert.Len(t, ledger.entries, 3)
+		next, _ := quotas.FindByEmployeeAndType(ctx, quota.TenantID, quota.EmployeeID, quota.LeaveTypeID, 2026)
+		assert.Equal(t, 3.0, next.TotalQuota)
+	})
+
+	t.Run("nothing left", func(t *testing.T) {
+		svc, _, ledger, quota := newService(0)
+
+		done, err := svc.carryOverQuota(ctx, policy, quota)
+		require.NoError(t, err)
+		assert.False(t, done)
+		assert.Empty(t, ledger.entries)
+	})
+}
+
+func TestLeaveAccrualService_Adjust(t *testing.T) {
+	ctx := context.Background()
+	quotas := &stubQuotaRepo{quotas: map[uuid.UUID]*model.LeaveQuota{}}
+	ledger := &stubLedgerRepo{quotas: quotas}
+	quota := &model.LeaveQuota{ID: uuid.New(), TenantID: uuid.New(), Year: 2025, TotalQuota: 5}
+	quotas.quotas[quota.ID] = quota
+	svc := &leaveAccrualService{leaveQuotaRepo: quotas, ledgerRepo: ledger, now: time.Now}
+
+	_, err := svc.Adjust(ctx, quota.TenantID, quota.ID, 1, "", uuid.New())
+	assert.ErrorIs(t, err, ErrAdjustReasonRequired)
+
+	_, err = svc.Adjust(ctx, quota.TenantID, quota.ID, 0.001, "补发", uuid.New())
+	assert.ErrorIs(t, err, ErrAdjustAmountZero)
+
+	_, err = svc.Adjust(ctx, uuid.New(), quota.ID, 1, "补发", uuid.New())
+	assert.ErrorIs(t, err, ErrLeaveQuotaNotFound)
+
+	entry, err := svc.Adjust(ctx, quota.TenantID, quota.ID, -1.5, "考勤违规扣减", uuid.New())
+	require.NoError(t, err)
+	assert.Equal(t, 3.5, entry.BalanceAfter)
+	assert.Equal(t, model.LeaveLedgerAdjustment, entry.EntryType)
+}
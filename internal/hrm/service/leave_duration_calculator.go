@@ -0,0 +1,404 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+)
+
+// 未配置班次时的标准工作时间
+const (
+	fallbackWorkStart = "09:00"
+	fallbackWorkEnd   = "18:00"
+	fallbackRestStart = "12:00"
+	fallbackRestEnd   = "13:00"
+)
+
+var (
+	ErrInvalidLeavePeriod  = errors.New("leave end time must be after start time")
+	ErrLeaveNoWorkingTime  = errors.New("leave period contains no working time")
+	ErrLeaveDurationTooLow = errors.New("leave duration is below the minimum for this leave type")
+	ErrLeaveDurationTooBig = errors.New("leave duration exceeds the maximum for this leave type")
+)
+
+// LeaveDurationCalculator 请假时长计算器：按员工排班/班次（扣除休息时间）、
+// 日期类型和请假类型的单位与取整规则计算时长，并按天、按月拆分
+type LeaveDurationCalculator interface {
+	Calculate(ctx context.Context, tenantID, employeeID uuid.UUID, leaveType *model.LeaveType, start, end time.Time) (*model.LeaveDurationResult, error)
+}
+
+type leaveDurationCalculator struct {
+	scheduleRepo repository.ScheduleRepository
+	shiftRepo    repository.ShiftRepository
+	hrmEmpRepo   repository.HRMEmployeeRepository
+	dayResolver  DayTypeResolver
+}
+
+// NewLeaveDurationCalculator 创建请假时长计算器
+func NewLeaveDurationCalculator(
+	scheduleRepo repository.ScheduleRepository,
+	shiftRepo repository.ShiftRepository,
+	hrmEmpRepo repository.HRMEmployeeRepository,
+	dayResolver DayTypeResolver,
+) LeaveDurationCalculator {
+	return &leaveDurationCalculator{
+		scheduleRepo: scheduleRepo,
+		shiftRepo:    shiftRepo,
+		hrmEmpRepo:   hrmEmpRepo,
+		dayResolver:  dayResolver,
+	}
+}
+
+func (c *leaveDurationCalculator) Calculate(ctx context.Context, tenantID, employeeID uuid.UUID, leaveType *model.LeaveType, start, end time.Time) (*model.LeaveDurationResult, error) {
+	if !end.After(start) {
+		return nil, ErrInvalidLeavePeriod
+	}
+
+	// 前一天的跨天班次（夜班）可能延续到请假开始日
+	from := truncateDate(start).AddDate(0, 0, -1)
+	to := truncateDate(end)
+	if end.Equal(to) {
+		to = to.AddDate(0, 0, -1)
+	}
+
+	days, err := c.dayResolver.ResolveRange(ctx, tenantID, employeeID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &leaveShiftPlan{Shifts: make(map[string]*model.Shift)}
+	if err := c.loadShifts(ctx, tenantID, employeeID, from, to, plan); err != nil {
+		return nil, err
+	}
+
+	return calculateLeaveDuration(leaveType, start, end, days, plan)
+}
+
+// loadShifts 加载区间内每天的排班班次和员工默认班次
+func (c *leaveDurationCalculator) loadShifts(ctx context.Context, tenantID, employeeID uuid.UUID, from, to time.Time, plan *leaveShiftPlan) error {
+	cache := make(map[uuid.UUID]*model.Shift)
+	findShift := func(id uuid.UUID) *model.Shift {
+		if shift, ok := cache[id]; ok {
+			return shift
+		}
+		shift, err := c.shiftRepo.FindByID(ctx, id)
+		if err != nil {
+			shift = nil
+		}
+		cache[id] = shift
+		return shift
+	}
+
+	if emp, err := c.hrmEmpRepo.FindByEmployeeID(ctx, tenantID, employeeID); err == nil && emp.DefaultShiftID != nil {
+		plan.Default = findShift(*emp.DefaultShiftID)
+	}
+
+	for month := time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, from.Location()); !month.After(to); month = month.AddDate(0, 1, 0) {
+		schedules, err := c.scheduleRepo.FindByEmployee(ctx, tenantID, employeeID, month.Format("2006-01"))
+		if err != nil {
+			return err
+		}
+		for _, schedule := range schedules {
+			if shift := findShift(schedule.ShiftID); shift != nil {
+				plan.Shifts[dateKey(schedule.ScheduleDate)] = shift
+			}
+		}
+	}
+	return nil
+}
+
+// leaveShiftPlan 请假区间内的班次安排
+type leaveShiftPlan struct {
+	Shifts  map[string]*model.Shift // 按日期排班的班次
+	Default *model.Shift            // 员工默认班次
+}
+
+func (p *leaveShiftPlan) shiftOn(date time.Time) *model.Shift {
+	if shift, ok := p.Shifts[dateKey(date)]; ok {
+		return shift
+	}
+	return p.Default
+}
+
+// timeSpan 时间区间 [Start, End)
+type timeSpan struct {
+	Start time.Time
+	End   time.Time
+}
+
+func (s timeSpan) overlap(o timeSpan) timeSpan {
+	start, end := s.Start, s.End
+	if o.Start.After(start) {
+		start = o.Start
+	}
+	if o.End.Before(end) {
+		end = o.End
+	}
+	if !end.After(start) {
+		return timeSpan{}
+	}
+	return timeSpan{Start: start, End: end}
+}
+
+func (s timeSpan) hours() float64 {
+	return s.End.Sub(s.Start).Hours()
+}
+
+// shiftWindow 班次在某天的工作时间段和休息时间段
+type shiftWindow struct {
+	Work  timeSpan
+	Rests []timeSpan
+	// RestMinutes 只配置了时长、未配置起止时间的休息，按在岗比例扣除
+	RestMinutes int
+}
+
+func (w *shiftWindow) dailyHours() float64 {
+	hours := w.Work.hours() - float64(w.RestMinutes)/60
+	for _, rest := range w.Rests {
+		hours -= rest.overlap(w.Work).hours()
+	}
+	return hours
+}
+
+// workingHours 区间与工作时间段重叠部分扣除休息后的小时数
+func (w *shiftWindow) workingHours(span timeSpan) (timeSpan, float64) {
+	overlap := w.Work.overlap(span)
+	if overlap.Start.IsZero() {
+		return overlap, 0
+	}
+
+	hours := overlap.hours()
+	for _, rest := range w.Rests {
+		hours -= rest.overlap(overlap).hours()
+	}
+	if w.RestMinutes > 0 && w.Work.hours() > 0 {
+		hours -= float64(w.RestMinutes) / 60 * overlap.hours() / w.Work.hours()
+	}
+	if hours < 0 {
+		hours = 0
+	}
+	return overlap, hours
+}
+
+// halves 以最接近班次中点的休息时间为界把工作时间段分为上、下半天；没有固定休息时间时以中点为界
+func (w *shiftWindow) halves() [2]timeSpan {
+	mid := w.Work.Start.Add(w.Work.End.Sub(w.Work.Start) / 2)
+	split := timeSpan{Start: mid, End: mid}
+
+	var best time.Duration = -1
+	for _, rest := range w.Rests {
+		rest = rest.overlap(w.Work)
+		if rest.Start.IsZero() || !rest.Start.After(w.Work.Start) || !rest.End.Before(w.Work.End) {
+			continue
+		}
+		distance := rest.Start.Add(rest.End.Sub(rest.Start) / 2).Sub(mid)
+		if distance < 0 {
+			distance = -distance
+		}
+		if best < 0 || distance < best {
+			best, split = distance, rest
+		}
+	}
+
+	return [2]timeSpan{
+		{Start: w.Work.Start, End: split.Start},
+		{Start: split.End, End: w.Work.End},
+	}
+}
+
+// workingDays 区间按上、下半天折算的天数：每个半天按其自身工时占比计 0.5 天，
+// 避免下午 5 小时、上午 3 小时的班次把整个下午按 5/8 天折算
+func (w *shiftWindow) workingDays(span timeSpan) float64 {
+	days := 0.0
+	for _, half := range w.halves() {
+		_, halfHours := w.workingHours(half)
+		if halfHours <= 0 {
+			continue
+		}
+		_, hours := w.workingHours(half.overlap(span))
+		days += 0.5 * hours / halfHours
+	}
+	return days
+}
+
+// windowOn 班次在指定日期的工作时间段，未配置固定上下班时间时使用标准工作时间
+func windowOn(shift *model.Shift, date time.Time) *shiftWindow {
+	workStart, workEnd := fallbackWorkStart, fallbackWorkEnd
+	rests := []model.RestPeriod{{StartTime: fallbackRestStart, EndTime: fallbackRestEnd}}
+	if shift != nil && shift.WorkStart != "" && shift.WorkEnd != "" {
+		workStart, workEnd, rests = shift.WorkStart, shift.WorkEnd, shift.RestPeriods
+	}
+
+	at := func(clock string) time.Time {
+		t, err := parseTime(clock)
+		if err != nil {
+			return date
+		}
+		return setDate(t, date)
+	}
+
+	window := &shiftWindow{Work: timeSpan{Start: at(workStart), End: at(workEnd)}}
+	if !window.Work.End.After(window.Work.Start) {
+		window.Work.End = window.Work.End.AddDate(0, 0, 1)
+	}
+
+	for _, rest := range rests {
+		if rest.StartTime == "" || rest.EndTime == "" {
+			window.RestMinutes += rest.Duration
+			continue
+		}
+		span := timeSpan{Start: at(rest.StartTime), End: at(rest.EndTime)}
+		// 跨天班次中凌晨的休息属于次日
+		if span.Start.Before(window.Work.Start) {
+			span.Start, span.End = span.Start.AddDate(0, 0, 1), span.End.AddDate(0, 0, 1)
+		}
+		if !span.End.After(span.Start) {
+			span.End = span.End.AddDate(0, 0, 1)
+		}
+		window.Rests = append(window.Rests, span)
+	}
+	return window
+}
+
+// calculateLeaveDuration 按天计算请假时长：每天先按班次求交并扣除休息时间，
+// 再按请假单位折算（按天、半天以休息时间为界分上下半天折算）、按取整规则取整，最后按月汇总
+func calculateLeaveDuration(leaveType *model.LeaveType, start, end time.Time, days []*model.DayInfo, plan *leaveShiftPlan) (*model.LeaveDurationResult, error) {
+	policy := effectiveDurationPolicy(leaveType)
+	result := &model.LeaveDurationResult{Unit: leaveType.Unit}
+	span := timeSpan{Start: start, End: end}
+
+	for _, day := range days {
+		if !day.IsWorkday() && !policy.IncludeRestDays {
+			continue
+		}
+
+		shift := plan.shiftOn(day.Date)
+		window := windowOn(shift, day.Date)
+		dailyHours := window.dailyHours()
+		if dailyHours <= 0 {
+			continue
+		}
+
+		overlap, hours := window.workingHours(span)
+		if hours <= 0 {
+			continue
+		}
+
+		amount := hours
+		if leaveType.Unit != model.LeaveUnitHour {
+			amount = window.workingDays(span)
+		}
+		amount = roundLeaveDuration(amount, policy)
+		if amount <= 0 {
+			continue
+		}
+
+		item := &model.LeaveDayDuration{
+			Date:      day.Date,
+			DayType:   day.Type,
+			StartTime: &overlap.Start,
+			EndTime:   &overlap.End,
+			Hours:     round2(hours),
+			Duration:  amount,
+		}
+		if shift != nil {
+			item.ShiftID = &shift.ID
+			item.ShiftName = shift.Name
+		}
+		result.Days = append(result.Days, item)
+		result.Hours += hours
+		result.Duration += amount
+	}
+
+	if len(result.Days) == 0 {
+		return nil, ErrLeaveNoWorkingTime
+	}
+	result.Hours = round2(result.Hours)
+	result.Duration = round2(result.Duration)
+	result.Months = SplitLeaveByMonth(result.Days)
+
+	return result, nil
+}
+
+// effectiveDurationPolicy 请假类型的时长规则，未配置时按单位取默认值：
+// 按天向上取整到 1 天，按半天和小时向上取整到 0.5
+func effectiveDurationPolicy(leaveType *model.LeaveType) model.LeaveDurationPolicy {
+	policy := model.LeaveDurationPolicy{RoundingMode: model.LeaveRoundingUp, RoundingStep: 0.5}
+	if leaveType.Unit == model.LeaveUnitDay {
+		policy.RoundingStep = 1
+	}
+
+	if leaveType.DurationPolicy != nil {
+		if leaveType.DurationPolicy.RoundingMode != "" {
+			policy.RoundingMode = leaveType.DurationPolicy.RoundingMode
+		}
+		if leaveType.DurationPolicy.RoundingStep > 0 {
+			policy.RoundingStep = leaveType.DurationPolicy.RoundingStep
+		}
+		policy.IncludeRestDays = leaveType.DurationPolicy.IncludeRestDays
+	}
+	return policy
+}
+
+// roundLeaveDuration 按步长取整
+func roundLeaveDuration(v float64, policy model.LeaveDurationPolicy) float64 {
+	step := policy.RoundingStep
+	if step <= 0 || policy.RoundingMode == model.LeaveRoundingNone {
+		return round2(v)
+	}
+
+	// 消除浮点误差，避免 1.0000001 被向上取整为 1.5
+	units := round2(v/step*100) / 100
+	switch policy.RoundingMode {
+	case model.LeaveRoundingDown:
+		units = math.Floor(units)
+	case model.LeaveRoundingNearest:
+		units = math.Round(units)
+	default:
+		units = math.Ceil(units)
+	}
+	return round2(units * step)
+}
+
+// SplitLeaveByMonth 按月汇总每日时长（跨月请假按月拆分）
+func SplitLeaveByMonth(days []*model.LeaveDayDuration) []*model.LeaveMonthDuration {
+	byMonth := make(map[int]*model.LeaveMonthDuration)
+	for _, day := range days {
+		key := day.Date.Year()*100 + int(day.Date.Month())
+		month, ok := byMonth[key]
+		if !ok {
+			month = &model.LeaveMonthDuration{Year: day.Date.Year(), Month: int(day.Date.Month())}
+			byMonth[key] = month
+		}
+		month.Hours += day.Hours
+		month.Duration += day.Duration
+	}
+
+	months := make([]*model.LeaveMonthDuration, 0, len(byMonth))
+	for _, month := range byMonth {
+		month.Hours = round2(month.Hours)
+		month.Duration = round2(month.Duration)
+		months = append(months, month)
+	}
+	sort.Slice(months, func(i, j int) bool {
+		return months[i].Year*100+months[i].Month < months[j].Year*100+months[j].Month
+	})
+	return months
+}
+
+// validateLeaveDuration 校验时长是否在请假类型允许的范围内
+func validateLeaveDuration(leaveType *model.LeaveType, duration float64) error {
+	if leaveType.MinDuration > 0 && duration < leaveType.MinDuration {
+		return ErrLeaveDurationTooLow
+	}
+	if leaveType.MaxDuration != nil && *leaveType.MaxDuration > 0 && duration > *leaveType.MaxDuration {
+		return ErrLeaveDurationTooBig
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+)
+
+// rangeDays 生成区间内的日期类型（周六日休息）
+func rangeDays(from, to string) []*model.DayInfo {
+	var days []*model.DayInfo
+	for d := mustDate(from); !d.After(mustDate(to)); d = d.AddDate(0, 0, 1) {
+		dayType := model.DayTypeWorkday
+		if d.Weekday() == time.Saturday || d.Weekday() == time.Sunday {
+			dayType = model.DayTypeWeekend
+		}
+		days = append(days, &model.DayInfo{Date: d, Type: dayType})
+	}
+	return days
+}
+
+func mustTime(s string) time.Time {
+	t, _ := time.ParseInLocation("2006-01-02 15:04", s, time.UTC)
+	return t
+}
+
+func TestCalculateLeaveDuration(t *testing.T) {
+	dayShift := &model.Shift{
+		ID: uuid.New(), Name: "白班", WorkStart: "09:00", WorkEnd: "18:00",
+		RestPeriods: []model.RestPeriod{{StartTime: "12:00", EndTime: "13:00", Duration: 60}},
+	}
+	plan := &leaveShiftPlan{Shifts: map[string]*model.Shift{}, Default: dayShift}
+
+	calc := func(leaveType *model.LeaveType, start, end string, plan *leaveShiftPlan) (*model.LeaveDurationResult, error) {
+		s, e := mustTime(start), mustTime(end)
+		return calculateLeaveDuration(leaveType, s, e, rangeDays(s.AddDate(0, 0, -1).Format("2006-01-02"), e.Format("2006-01-02")), plan)
+	}
+
+	t.Run("whole days", func(t *testing.T) {
+		result, err := calc(&model.LeaveType{Unit: model.LeaveUnitDay}, "2025-09-01 09:00", "2025-09-02 18:00", plan)
+		require.NoError(t, err)
+		assert.Equal(t, 2.0, result.Duration)
+		assert.Equal(t, 16.0, result.Hours)
+		require.Len(t, result.Days, 2)
+		assert.Equal(t, "白班", result.Days[0].ShiftName)
+	})
+
+	t.Run("half day rounds up", func(t *testing.T) {
+		result, err := calc(&model.LeaveType{Unit: model.LeaveUnitHalfDay}, "2025-09-01 09:00", "2025-09-01 12:00", plan)
+		require.NoError(t, err)
+		assert.Equal(t, 0.5, result.Duration)
+		assert.Equal(t, 3.0, result.Hours)
+	})
+
+	t.Run("half day splits at rest period", func(t *testing.T) {
+		// 上午 3 小时、下午 5 小时，请整个下午按半天计
+		result, err := calc(&model.LeaveType{Unit: model.LeaveUnitHalfDay}, "2025-09-01 13:00", "2025-09-01 18:00", plan)
+		require.NoError(t, err)
+		assert.Equal(t, 0.5, result.Duration)
+		assert.Equal(t, 5.0, result.Hours)
+
+		result, err = calc(&model.LeaveType{Unit: model.LeaveUnitHalfDay, DurationPolicy: &model.LeaveDurationPolicy{RoundingMode: model.LeaveRoundingNone}},
+			"2025-09-01 09:00", "2025-09-01 15:30", plan)
+		require.NoError(t, err)
+		assert.Equal(t, 0.75, result.Duration)
+	})
+
+	t.Run("half day splits at midpoint without fixed rest", func(t *testing.T) {
+		shift := &model.Shift{ID: uuid.New(), Name: "弹性", WorkStart: "08:00", WorkEnd: "18:00", RestPeriods: []model.RestPeriod{{Duration: 60}}}
+		result, err := calc(&model.LeaveType{Unit: model.LeaveUnitHalfDay}, "2025-09-01 13:00", "2025-09-01 18:00",
+			&leaveShiftPlan{Shifts: map[string]*model.Shift{}, Default: shift})
+		require.NoError(t, err)
+		assert.Equal(t, 0.5, result.Duration)
+		assert.Equal(t, 4.5, result.Hours)
+	})
+
+	t.Run("hours exclude rest period", func(t *testing.T) {
+		result, err := calc(&model.LeaveType{Unit: model.LeaveUnitHour}, "2025-09-01 10:00", "2025-09-01 15:00", plan)
+		require.NoError(t, err)
+		assert.Equal(t, 4.0, result.Duration)
+	})
+
+	t.Run("weekend skipped and split by month", func(t *testing.T) {
+		// 2026-01-30 周五 至 2026-02-03 周二
+		result, err := calc(&model.LeaveType{Unit: model.LeaveUnitDay}, "2026-01-30 09:00", "2026-02-03 18:00", plan)
+		require.NoError(t, err)
+		assert.Equal(t, 3.0, result.Duration)
+		require.Len(t, result.Months, 2)
+		assert.Equal(t, model.LeaveMonthDuration{Year: 2026, Month: 1, Hours: 8, Duration: 1}, *result.Months[0])
+		assert.Equal(t, model.LeaveMonthDuration{Year: 2026, Month: 2, Hours: 16, Duration: 2}, *result.Months[1])
+
+		leave := &model.LeaveRequest{Unit: model.LeaveUnitDay, Duration: result.Duration, DayBreakdown: result.Days,
+			StartTime: mustTime("2026-01-30 09:00"), EndTime: mustTime("2026-02-03 18:00")}
+		assert.Equal(t, 2.0, leaveDaysInMonth(leave, 20, mustDate("2026-02-01"), mustDate("2026-03-01"), 8))
+	})
+
+	t.Run("rest days included by policy", func(t *testing.T) {
+		leaveType := &model.LeaveType{Unit: model.LeaveUnitDay, DurationPolicy: &model.LeaveDurationPolicy{IncludeRestDays: true}}
+		result, err := calc(leaveType, "2025-09-05 09:00", "2025-09-08 18:00", plan)
+		require.NoError(t, err)
+		assert.Equal(t, 4.0, result.Duration)
+	})
+
+	t.Run("night shift belongs to start day", func(t *testing.T) {
+		nightShift := &model.Shift{ID: uuid.New(), Name: "夜班", WorkStart: "22:00", WorkEnd: "06:00"}
+		nightPlan := &leaveShiftPlan{Shifts: map[string]*model.Shift{
+			"2025-09-01": nightShift,
+			"2025-09-02": nightShift,
+		}}
+
+		result, err := calc(&model.LeaveType{Unit: model.LeaveUnitHour}, "2025-09-01 22:00", "2025-09-02 06:00", nightPlan)
+		require.NoError(t, err)
+		assert.Equal(t, 8.0, result.Duration)
+		require.Len(t, result.Days, 1)
+		assert.True(t, result.Days[0].Date.Equal(mustDate("2025-09-01")))
+	})
+
+	t.Run("no working time", func(t *testing.T) {
+		_, err := calc(&model.LeaveType{Unit: model.LeaveUnitDay}, "2025-09-06 09:00", "2025-09-07 18:00", plan)
+		assert.ErrorIs(t, err, ErrLeaveNoWorkingTime)
+	})
+}
+
+func TestRoundLeaveDuration(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  float64
+		policy model.LeaveDurationPolicy
+		want   float64
+	}{
+		{"up to half", 0.3, model.LeaveDurationPolicy{RoundingMode: model.LeaveRoundingUp, RoundingStep: 0.5}, 0.5},
+		{"exact stays", 1.0000001, model.LeaveDurationPolicy{RoundingMode: model.LeaveRoundingUp, RoundingStep: 0.5}, 1},
+		{"down", 1.4, model.LeaveDurationPolicy{RoundingMode: model.LeaveRoundingDown, RoundingStep: 0.5}, 1},
+		{"nearest", 1.3, model.LeaveDurationPolicy{RoundingMode: model.LeaveRoundingNearest, RoundingStep: 0.5}, 1.5},
+		{"none", 1.234, model.LeaveDurationPolicy{RoundingMode: model.LeaveRoundingNone, RoundingStep: 0.5}, 1.23},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, roundLeaveDuration(tt.value, tt.policy))
+		})
+	}
+}
+
+func TestValidateLeaveDuration(t *testing.T) {
+	max := 3.0
+	leaveType := &model.LeaveType{MinDuration: 0.5, MaxDuration: &max}
+
+	assert.NoError(t, validateLeaveDuration(leaveType, 1))
+	assert.ErrorIs(t, validateLeaveDuration(leaveType, 4), ErrLeaveDurationTooBig)
+	assert.ErrorIs(t, validateLeaveDuration(&model.LeaveType{MinDuration: 1}, 0.5), ErrLeaveDurationTooLow)
+}
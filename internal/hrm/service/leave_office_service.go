@@ -49,19 +49,25 @@ type LeaveOfficeService interface {
 type leaveOfficeService struct {
 	db              *database.DB
 	leaveOfficeRepo repository.LeaveOfficeRepository
+	periodGuard     AttendancePeriodGuard
 	workflowEngine  *integration.LeaveOfficeWorkflowEngine
+	allocations     TimeAllocationService
 }
 
 // NewLeaveOfficeService 创建外出服务
 func NewLeaveOfficeService(
 	db *database.DB,
 	leaveOfficeRepo repository.LeaveOfficeRepository,
+	periodGuard AttendancePeriodGuard,
 	workflowEngine *workflow.Engine,
+	allocations TimeAllocationService,
 ) LeaveOfficeService {
 	return &leaveOfficeService{
 		db:              db,
 		leaveOfficeRepo: leaveOfficeRepo,
+		periodGuard:     periodGuard,
 		workflowEngine:  integration.NewLeaveOfficeWorkflowEngine(workflowEngine),
+		allocations:     allocations,
 	}
 }
 
@@ -72,6 +78,10 @@ func (s *leaveOfficeService) Create(ctx context.Context, leaveOffice *model.Leav
 		return err
 	}
 
+	if err := checkPeriod(ctx, s.periodGuard, leaveOffice.TenantID, leaveOffice.EmployeeID, leaveOffice.StartTime, leaveOffice.EndTime); err != nil {
+		return err
+	}
+
 	// 2. 检查时间冲突
 	hasConflict, err := s.CheckTimeConflict(ctx, leaveOffice.TenantID, leaveOffice.EmployeeID, leaveOffice.StartTime, leaveOffice.EndTime, nil)
 	if err != nil {
@@ -91,8 +101,18 @@ func (s *leaveOfficeService) Create(ctx context.Context, leaveOffice *model.Leav
 	leaveOffice.CreatedAt = now
 	leaveOffice.UpdatedAt = now
 
-	// 5. 创建记录
-	return s.leaveOfficeRepo.Create(ctx, leaveOffice)
+	// 5. 检查与请假、出差、加班的冲突并占用时间
+	allocation := outingAllocation(leaveOffice)
+	created, err := reserveTimeAllocation(ctx, s.allocations, allocation)
+	if err != nil {
+		return err
+	}
+
+	// 6. 创建记录，失败时释放占用
+	if err := s.leaveOfficeRepo.Create(ctx, leaveOffice); err != nil {
+		return undoTimeAllocation(ctx, s.allocations, allocation, created, nil, err)
+	}
+	return nil
 }
 
 // Update 更新外出申请
@@ -113,6 +133,10 @@ func (s *leaveOfficeService) Update(ctx context.Context, leaveOffice *model.Leav
 		return err
 	}
 
+	if err := checkPeriod(ctx, s.periodGuard, leaveOffice.TenantID, leaveOffice.EmployeeID, leaveOffice.StartTime, leaveOffice.EndTime); err != nil {
+		return err
+	}
+
 	// 4. 检查时间冲突（排除自己）
 	hasConflict, err := s.CheckTimeConflict(ctx, leaveOffice.TenantID, leaveOffice.EmployeeID, leaveOffice.StartTime, leaveOffice.EndTime, &leaveOffice.ID)
 	if err != nil {
@@ -128,7 +152,17 @@ func (s *leaveOfficeService) Update(ctx context.Context, leaveOffice *model.Leav
 	// 6. 更新时间戳
 	leaveOffice.UpdatedAt = time.Now()
 
-	return s.leaveOfficeRepo.Update(ctx, leaveOffice)
+	// 7. 检查与请假、出差、加班的冲突并更新占用，更新失败时恢复原占用
+	allocation := outingAllocation(leaveOffice)
+	created, err := reserveTimeAllocation(ctx, s.allocations, allocation)
+	if err != nil {
+		return err
+	}
+
+	if err := s.leaveOfficeRepo.Update(ctx, leaveOffice); err != nil {
+		return undoTimeAllocation(ctx, s.allocations, allocation, created, outingAllocation(existing), err)
+	}
+	return nil
 }
 
 // Delete 删除外出申请
@@ -144,7 +178,10 @@ func (s *leaveOfficeService) Delete(ctx context.Context, id uuid.UUID) error {
 		return fmt.Errorf("only pending leave office records can be deleted")
 	}
 
-	return s.leaveOfficeRepo.Delete(ctx, id)
+	if err := s.leaveOfficeRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	return releaseTimeAllocation(ctx, s.allocations, model.TimeAllocationOuting, id)
 }
 
 // GetByID 根据ID获取外出记录
@@ -180,19 +217,29 @@ func (s *leaveOfficeService) Submit(ctx context.Context, leaveOfficeID, submitte
 		return fmt.Errorf("leave office start time cannot be in the past")
 	}
 
-	// 4. 使用工作流引擎执行审批流程
+	// 4. 提交前再次检查与请假、出差、加班的冲突；提交失败时撤销本次新增的占用
+	allocation := outingAllocation(leaveOffice)
+	created, err := reserveTimeAllocation(ctx, s.allocations, allocation)
+	if err != nil {
+		return err
+	}
+
+	// 5. 使用工作流引擎执行审批流程
 	workflowID := fmt.Sprintf("leave-office-approval-%s", leaveOffice.TenantID.String())
 	executionID, err := s.workflowEngine.ExecuteLeaveOfficeApproval(ctx, workflowID, leaveOffice, submitterID.String())
 	if err != nil {
-		return fmt.Errorf("failed to execute workflow: %w", err)
+		return undoTimeAllocation(ctx, s.allocations, allocation, created, nil, fmt.Errorf("failed to execute workflow: %w", err))
 	}
 
-	// 5. 保存工作流执行ID到ApprovalID
+	// 6. 保存工作流执行ID到ApprovalID
 	approvalID, _ := uuid.Parse(executionID)
 	leaveOffice.ApprovalID = &approvalID
 	leaveOffice.UpdatedAt = time.Now()
 
-	return s.leaveOfficeRepo.Update(ctx, leaveOffice)
+	if err := s.leaveOfficeRepo.Update(ctx, leaveOffice); err != nil {
+		return undoTimeAllocation(ctx, s.allocations, allocation, created, nil, err)
+	}
+	return nil
 }
 
 // Approve 批准外出
@@ -208,6 +255,10 @@ func (s *leaveOfficeService) Approve(ctx context.Context, leaveOfficeID, approve
 		return fmt.Errorf("only pending leave office records can be approved")
 	}
 
+	if err := checkPeriod(ctx, s.periodGuard, leaveOffice.TenantID, leaveOffice.EmployeeID, leaveOffice.StartTime, leaveOffice.EndTime); err != nil {
+		return err
+	}
+
 	// 3. 更新状态
 	leaveOffice.ApprovalStatus = "approved"
 	leaveOffice.ApprovedBy = &approverID
@@ -215,7 +266,10 @@ func (s *leaveOfficeService) Approve(ctx context.Context, leaveOfficeID, approve
 	leaveOffice.ApprovedAt = &now
 	leaveOffice.UpdatedAt = now
 
-	return s.leaveOfficeRepo.Update(ctx, leaveOffice)
+	if err := s.leaveOfficeRepo.Update(ctx, leaveOffice); err != nil {
+		return err
+	}
+	return confirmTimeAllocation(ctx, s.allocations, model.TimeAllocationOuting, leaveOffice.ID)
 }
 
 // Reject 拒绝外出
@@ -237,7 +291,10 @@ func (s *leaveOfficeService) Reject(ctx context.Context, leaveOfficeID, approver
 	leaveOffice.RejectReason = reason
 	leaveOffice.UpdatedAt = time.Now()
 
-	return s.leaveOfficeRepo.Update(ctx, leaveOffice)
+	if err := s.leaveOfficeRepo.Update(ctx, leaveOffice); err != nil {
+		return err
+	}
+	return releaseTimeAllocation(ctx, s.allocations, model.TimeAllocationOuting, leaveOffice.ID)
 }
 
 // CheckTimeConflict 检查时间冲突
@@ -31,6 +31,7 @@ type LeaveService interface {
 	CalculateAnnualLeaveQuota(ctx context.Context, workYears int) float64
 
 	// 请假申请
+	CalculateLeaveDuration(ctx context.Context, tenantID, employeeID, leaveTypeID uuid.UUID, start, end time.Time) (*model.LeaveDurationResult, error)
 	CreateLeaveRequest(ctx context.Context, request *model.LeaveRequest) error
 	UpdateLeaveRequest(ctx context.Context, request *model.LeaveRequest) error
 	SubmitLeaveRequest(ctx context.Context, requestID, submitterID uuid.UUID) error
@@ -53,7 +54,11 @@ type leaveService struct {
 	leaveQuotaRepo    repository.LeaveQuotaRepository
 	leaveRequestRepo  repository.LeaveRequestRepository
 	leaveApprovalRepo repository.LeaveApprovalRepository
+	durationCalc      LeaveDurationCalculator
+	accrual           LeaveAccrualService
+	periodGuard       AttendancePeriodGuard
 	workflowEngine    *integration.LeaveWorkflowEngine
+	allocations       TimeAllocationService
 }
 
 // NewLeaveService 创建请假服务
@@ -63,7 +68,11 @@ func NewLeaveService(
 	leaveQuotaRepo repository.LeaveQuotaRepository,
 	leaveRequestRepo repository.LeaveRequestRepository,
 	leaveApprovalRepo repository.LeaveApprovalRepository,
+	durationCalc LeaveDurationCalculator,
+	accrual LeaveAccrualService,
+	periodGuard AttendancePeriodGuard,
 	workflowEngine *workflow.Engine,
+	allocations TimeAllocationService,
 ) LeaveService {
 	return &leaveService{
 		db:                db,
@@ -71,7 +80,11 @@ func NewLeaveService(
 		leaveQuotaRepo:    leaveQuotaRepo,
 		leaveRequestRepo:  leaveRequestRepo,
 		leaveApprovalRepo: leaveApprovalRepo,
+		durationCalc:      durationCalc,
+		accrual:           accrual,
+		periodGuard:       periodGuard,
 		workflowEngine:    integration.NewLeaveWorkflowEngine(workflowEngine),
+		allocations:       allocations,
 	}
 }
 
@@ -135,21 +148,23 @@ func (s *leaveService) InitEmployeeQuota(ctx context.Context, tenantID, employee
 
 	// 为每种请假类型创建额度
 	quotas := make([]*model.LeaveQuota, 0, len(leaveTypes))
+	defaults := make([]float64, 0, len(leaveTypes))
 	now := time.Now()
 
 	for _, lt := range leaveTypes {
-		// 只为需要扣除额度的类型创建额度记录
-		if !lt.DeductQuota {
+		// 只为需要扣除额度的类型创建额度记录；配置了发放规则的类型由发放服务按规则创建
+		if !lt.DeductQuota || lt.AccrualPolicy != nil {
 			continue
 		}
 
+		// 额度先建为 0，再以发放流水计入
 		quota := &model.LeaveQuota{
 			ID:           uuid.Must(uuid.NewV7()),
 			TenantID:     tenantID,
 			EmployeeID:   employeeID,
 			LeaveTypeID:  lt.ID,
 			Year:         year,
-			TotalQuota:   s.getDefaultQuota(lt.Code),
+			TotalQuota:   0,
 			UsedQuota:    0,
 			PendingQuota: 0,
 			CreatedAt:    now,
@@ -161,13 +176,22 @@ func (s *leaveService) InitEmployeeQuota(ctx context.Context, tenantID, employee
 		quota.ExpiredAt = &expiredAt
 
 		quotas = append(quotas, quota)
+		defaults = append(defaults, s.getDefaultQuota(lt.Code))
 	}
 
 	if len(quotas) > 0 {
-		return s.leaveQuotaRepo.BatchCreate(ctx, quotas)
+		if err := s.leaveQuotaRepo.BatchCreate(ctx, quotas); err != nil {
+			return err
+		}
+		for i, quota := range quotas {
+			if err := s.accrual.Grant(ctx, quota, defaults[i], "初始化默认额度"); err != nil {
+				return fmt.Errorf("failed to grant default quota: %w", err)
+			}
+		}
 	}
 
-	return nil
+	_, err = s.accrual.AccrueEmployee(ctx, tenantID, employeeID, year)
+	return err
 }
 
 // getDefaultQuota 获取默认额度
@@ -190,10 +214,25 @@ func (s *leaveService) getDefaultQuota(code string) float64 {
 	}
 }
 
-// UpdateQuota 更新请假额度
+// UpdateQuota 更新请假额度：总额度的变化以人工调整流水记录
 func (s *leaveService) UpdateQuota(ctx context.Context, quota *model.LeaveQuota) error {
+	current, err := s.leaveQuotaRepo.FindByID(ctx, quota.ID)
+	if err != nil {
+		return ErrLeaveQuotaNotFound
+	}
+	delta := quota.TotalQuota - current.TotalQuota
+
+	quota.TotalQuota = current.TotalQuota
 	quota.UpdatedAt = time.Now()
-	return s.leaveQuotaRepo.Update(ctx, quota)
+	if err := s.leaveQuotaRepo.Update(ctx, quota); err != nil {
+		return err
+	}
+	if delta == 0 {
+		return nil
+	}
+
+	_, err = s.accrual.Adjust(ctx, current.TenantID, quota.ID, delta, "修改总额度", uuid.Nil)
+	return err
 }
 
 // GetEmployeeQuotas 获取员工的所有假期额度
@@ -231,6 +270,10 @@ func (s *leaveService) CreateLeaveRequest(ctx context.Context, request *model.Le
 		return fmt.Errorf("start time must be before end time")
 	}
 
+	if err := checkPeriod(ctx, s.periodGuard, request.TenantID, request.EmployeeID, request.StartTime, request.EndTime); err != nil {
+		return err
+	}
+
 	// 检查时间冲突
 	hasConflict, err := s.leaveRequestRepo.CheckTimeConflict(ctx, request.TenantID, request.EmployeeID, request.StartTime, request.EndTime, nil)
 	if err != nil {
@@ -240,6 +283,11 @@ func (s *leaveService) CreateLeaveRequest(ctx context.Context, request *model.Le
 		return fmt.Errorf("leave time conflicts with existing approved leave")
 	}
 
+	// 检查与出差、加班、外出及审批中请假的冲突（草稿不占用时间）
+	if err := checkTimeAllocation(ctx, s.allocations, leaveAllocation(request)); err != nil {
+		return err
+	}
+
 	// 获取请假类型
 	leaveType, err := s.leaveTypeRepo.FindByID(ctx, request.LeaveTypeID)
 	if err != nil {
@@ -249,6 +297,11 @@ func (s *leaveService) CreateLeaveRequest(ctx context.Context, request *model.Le
 	request.LeaveTypeName = leaveType.Name
 	request.Unit = leaveType.Unit
 
+	// 时长由服务端按排班/班次、日期类型和取整规则计算，忽略客户端传入值
+	if err := s.applyDuration(ctx, request, leaveType); err != nil {
+		return err
+	}
+
 	// 如果需要扣除额度，检查额度是否足够
 	if leaveType.DeductQuota {
 		year := request.StartTime.Year()
@@ -278,10 +331,52 @@ func (s *leaveService) UpdateLeaveRequest(ctx context.Context, request *model.Le
 		return fmt.Errorf("only draft leave requests can be updated")
 	}
 
+	if err := checkPeriod(ctx, s.periodGuard, request.TenantID, request.EmployeeID, request.StartTime, request.EndTime); err != nil {
+		return err
+	}
+
+	if err := checkTimeAllocation(ctx, s.allocations, leaveAllocation(request)); err != nil {
+		return err
+	}
+
+	leaveType, err := s.leaveTypeRepo.FindByID(ctx, existing.LeaveTypeID)
+	if err != nil {
+		return fmt.Errorf("failed to get leave type: %w", err)
+	}
+	request.Unit = leaveType.Unit
+	if err := s.applyDuration(ctx, request, leaveType); err != nil {
+		return err
+	}
+
 	request.UpdatedAt = time.Now()
 	return s.leaveRequestRepo.Update(ctx, request)
 }
 
+// CalculateLeaveDuration 预览请假时长（含按天、按月拆分）
+func (s *leaveService) CalculateLeaveDuration(ctx context.Context, tenantID, employeeID, leaveTypeID uuid.UUID, start, end time.Time) (*model.LeaveDurationResult, error) {
+	leaveType, err := s.leaveTypeRepo.FindByID(ctx, leaveTypeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get leave type: %w", err)
+	}
+	return s.durationCalc.Calculate(ctx, tenantID, employeeID, leaveType, start, end)
+}
+
+// applyDuration 计算请假时长和按天拆分，并校验请假类型的时长范围
+func (s *leaveService) applyDuration(ctx context.Context, request *model.LeaveRequest, leaveType *model.LeaveType) error {
+	result, err := s.durationCalc.Calculate(ctx, request.TenantID, request.EmployeeID, leaveType, request.StartTime, request.EndTime)
+	if err != nil {
+		return fmt.Errorf("failed to calculate leave duration: %w", err)
+	}
+
+	if err := validateLeaveDuration(leaveType, result.Duration); err != nil {
+		return err
+	}
+
+	request.Duration = result.Duration
+	request.DayBreakdown = result.Days
+	return nil
+}
+
 // SubmitLeaveRequest 提交请假申请
 func (s *leaveService) SubmitLeaveRequest(ctx context.Context, requestID, submitterID uuid.UUID) error {
 	// 获取请假申请
@@ -301,6 +396,13 @@ func (s *leaveService) SubmitLeaveRequest(ctx context.Context, requestID, submit
 		return fmt.Errorf("failed to get leave type: %w", err)
 	}
 
+	// 提交后开始占用时间，与出差、加班、外出及其他请假冲突时拒绝提交；
+	// 草稿不占用时间，状态更新前任一步失败都释放占用
+	allocation := leaveAllocation(request)
+	if _, err := reserveTimeAllocation(ctx, s.allocations, allocation); err != nil {
+		return err
+	}
+
 	// 使用工作流引擎执行审批流程
 	workflowID := fmt.Sprintf("leave-approval-%s", leaveType.ID.String())
 
@@ -312,13 +414,13 @@ func (s *leaveService) SubmitLeaveRequest(ctx context.Context, requestID, submit
 		submitterID.String(),
 	)
 	if err != nil {
-		return fmt.Errorf("failed to start workflow execution: %w", err)
+		return undoTimeAllocation(ctx, s.allocations, allocation, true, nil, fmt.Errorf("failed to start workflow execution: %w", err))
 	}
 
 	// 保存executionID并更新状态为Pending
 	now := time.Now()
 	if err := s.leaveRequestRepo.UpdateStatus(ctx, requestID, model.LeaveRequestStatusPending, &now); err != nil {
-		return fmt.Errorf("failed to update leave request status: %w", err)
+		return undoTimeAllocation(ctx, s.allocations, allocation, true, nil, fmt.Errorf("failed to update leave request status: %w", err))
 	}
 
 	// 如果需要扣减额度，增加待审批额度
@@ -361,6 +463,9 @@ func (s *leaveService) WithdrawLeaveRequest(ctx context.Context, requestID, oper
 		if err := s.leaveRequestRepo.UpdateStatus(ctx, requestID, model.LeaveRequestStatusWithdrawn, &now); err != nil {
 			return fmt.Errorf("failed to withdraw leave request: %w", err)
 		}
+		if err := releaseTimeAllocation(ctx, s.allocations, model.TimeAllocationLeave, requestID); err != nil {
+			return err
+		}
 
 		// 减少待审批额度
 		leaveType, err := s.leaveTypeRepo.FindByID(ctx, request.LeaveTypeID)
@@ -407,6 +512,9 @@ func (s *leaveService) CancelLeaveRequest(ctx context.Context, requestID, operat
 		if err := s.leaveRequestRepo.UpdateStatus(ctx, requestID, model.LeaveRequestStatusCancelled, &now); err != nil {
 			return fmt.Errorf("failed to cancel leave request: %w", err)
 		}
+		if err := releaseTimeAllocation(ctx, s.allocations, model.TimeAllocationLeave, requestID); err != nil {
+			return err
+		}
 
 		// 退还已使用额度
 		leaveType, err := s.leaveTypeRepo.FindByID(ctx, request.LeaveTypeID)
@@ -423,6 +531,9 @@ func (s *leaveService) CancelLeaveRequest(ctx context.Context, requestID, operat
 			if err := s.leaveQuotaRepo.DecrementUsedQuota(ctx, quota.ID, request.Duration); err != nil {
 				return fmt.Errorf("failed to decrement used quota: %w", err)
 			}
+			if err := s.accrual.RecordUsage(ctx, quota, request, model.LeaveLedgerRefund, operatorID); err != nil {
+				return fmt.Errorf("failed to record quota refund: %w", err)
+			}
 		}
 
 		return nil
@@ -466,6 +577,10 @@ func (s *leaveService) ApproveLeaveRequest(ctx context.Context, requestID, appro
 		return fmt.Errorf("only pending leave requests can be approved")
 	}
 
+	if err := checkPeriod(ctx, s.periodGuard, request.TenantID, request.EmployeeID, request.StartTime, request.EndTime); err != nil {
+		return err
+	}
+
 	// 查找待审批记录
 	approval, err := s.leaveApprovalRepo.FindPendingApproval(ctx, requestID, approverID)
 	if err != nil {
@@ -509,6 +624,9 @@ func (s *leaveService) ApproveLeaveRequest(ctx context.Context, requestID, appro
 		if err := s.leaveRequestRepo.UpdateStatus(ctx, requestID, model.LeaveRequestStatusApproved, &now); err != nil {
 			return fmt.Errorf("failed to approve leave request: %w", err)
 		}
+		if err := confirmTimeAllocation(ctx, s.allocations, model.TimeAllocationLeave, requestID); err != nil {
+			return err
+		}
 
 		// 清除当前审批人
 		if err := s.leaveRequestRepo.SetCurrentApprover(ctx, requestID, nil); err != nil {
@@ -533,6 +651,9 @@ func (s *leaveService) ApproveLeaveRequest(ctx context.Context, requestID, appro
 			if err := s.leaveQuotaRepo.IncrementUsedQuota(ctx, quota.ID, request.Duration); err != nil {
 				return fmt.Errorf("failed to increment used quota: %w", err)
 			}
+			if err := s.accrual.RecordUsage(ctx, quota, request, model.LeaveLedgerDeduction, approverID); err != nil {
+				return fmt.Errorf("failed to record quota deduction: %w", err)
+			}
 		}
 
 		return nil
@@ -575,6 +696,9 @@ func (s *leaveService) RejectLeaveRequest(ctx context.Context, requestID, approv
 		if err := s.leaveRequestRepo.UpdateStatus(ctx, requestID, model.LeaveRequestStatusRejected, &now); err != nil {
 			return fmt.Errorf("failed to reject leave request: %w", err)
 		}
+		if err := releaseTimeAllocation(ctx, s.allocations, model.TimeAllocationLeave, requestID); err != nil {
+			return err
+		}
 
 		// 清除当前审批人
 		if err := s.leaveRequestRepo.SetCurrentApprover(ctx, requestID, nil); err != nil {
@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+)
+
+var (
+	ErrOvertimePolicyNotFound    = errors.New("overtime policy not found")
+	ErrOvertimePolicyCodeExists  = errors.New("overtime policy code already exists")
+	ErrInvalidOvertimePolicy     = errors.New("invalid overtime policy")
+	ErrOvertimePayTypeNotAllowed = errors.New("pay type not allowed by overtime policy")
+	ErrOvertimeBelowMinimum      = errors.New("overtime shorter than policy minimum")
+	ErrOvertimeCapExceeded       = errors.New("overtime exceeds policy cap")
+)
+
+// OvertimePolicyService 加班政策服务接口
+type OvertimePolicyService interface {
+	// 政策管理
+	CreatePolicy(ctx context.Context, policy *model.OvertimePolicy) error
+	UpdatePolicy(ctx context.Context, policy *model.OvertimePolicy) error
+	DeletePolicy(ctx context.Context, tenantID, id uuid.UUID) error
+	GetPolicy(ctx context.Context, tenantID, id uuid.UUID) (*model.OvertimePolicy, error)
+	ListPolicies(ctx context.Context, tenantID uuid.UUID) ([]*model.OvertimePolicy, error)
+
+	// AssignToRule 为考勤规则关联加班政策（policyID 为空表示取消关联），
+	// 规则适用范围内的员工按该政策计算加班
+	AssignToRule(ctx context.Context, tenantID, ruleID uuid.UUID, policyID *uuid.UUID, operatorID uuid.UUID) (*model.AttendanceRule, error)
+
+	// Resolve 员工适用的加班政策：考勤规则关联的政策 → 租户默认政策 → 法定标准
+	Resolve(ctx context.Context, tenantID, employeeID uuid.UUID) *model.OvertimePolicy
+
+	// Apply 按员工适用的政策计算计薪时长、倍率、补偿方式和可调休天数，并校验月度/年度上限
+	Apply(ctx context.Context, overtime *model.Overtime) (*model.OvertimePolicy, error)
+}
+
+type overtimePolicyService struct {
+	policyRepo   repository.OvertimePolicyRepository
+	ruleRepo     repository.AttendanceRuleRepository
+	hrmEmpRepo   repository.HRMEmployeeRepository
+	overtimeRepo repository.OvertimeRepository
+}
+
+// NewOvertimePolicyService 创建加班政策服务
+func NewOvertimePolicyService(
+	policyRepo repository.OvertimePolicyRepository,
+	ruleRepo repository.AttendanceRuleRepository,
+	hrmEmpRepo repository.HRMEmployeeRepository,
+	overtimeRepo repository.OvertimeRepository,
+) OvertimePolicyService {
+	return &overtimePolicyService{
+		policyRepo:   policyRepo,
+		ruleRepo:     ruleRepo,
+		hrmEmpRepo:   hrmEmpRepo,
+		overtimeRepo: overtimeRepo,
+	}
+}
+
+func (s *overtimePolicyService) CreatePolicy(ctx context.Context, policy *model.OvertimePolicy) error {
+	if err := validateOvertimePolicy(policy); err != nil {
+		return err
+	}
+	if _, err := s.policyRepo.FindByCode(ctx, policy.TenantID, policy.Code); err == nil {
+		return ErrOvertimePolicyCodeExists
+	}
+
+	policy.ID = uuid.Must(uuid.NewV7())
+	now := time.Now()
+	policy.CreatedAt = now
+	policy.UpdatedAt = now
+
+	if policy.IsDefault {
+		if err := s.policyRepo.ClearDefault(ctx, policy.TenantID, policy.ID); err != nil {
+			return err
+		}
+	}
+
+	return s.policyRepo.Create(ctx, policy)
+}
+
+func (s *overtimePolicyService) UpdatePolicy(ctx context.Context, policy *model.OvertimePolicy) error {
+	if _, err := s.GetPolicy(ctx, policy.TenantID, policy.ID); err != nil {
+		return err
+	}
+	if err := validateOvertimePolicy(policy); err != nil {
+		return err
+	}
+
+	policy.UpdatedAt = time.Now()
+	if policy.IsDefault {
+		if err := s.policyRepo.ClearDefault(ctx, policy.TenantID, policy.ID); err != nil {
+			return err
+		}
+	}
+
+	return s.policyRepo.Update(ctx, policy)
+}
+
+func (s *overtimePolicyService) DeletePolicy(ctx context.Context, tenantID, id uuid.UUID) error {
+	if _, err := s.GetPolicy(ctx, tenantID, id); err != nil {
+		return err
+	}
+	return s.policyRepo.Delete(ctx, id)
+}
+
+func (s *overtimePolicyService) GetPolicy(ctx context.Context, tenantID, id uuid.UUID) (*model.OvertimePolicy, error) {
+	policy, err := s.policyRepo.FindByID(ctx, id)
+	if err != nil || policy.TenantID != tenantID {
+		return nil, ErrOvertimePolicyNotFound
+	}
+	return policy, nil
+}
+
+func (s *overtimePolicyService) ListPolicies(ctx context.Context, tenantID uuid.UUID) ([]*model.OvertimePolicy, error) {
+	return s.policyRepo.List(ctx, tenantID)
+}
+
+func (s *overtimePolicyService) AssignToRule(ctx context.Context, tenantID, ruleID uuid.UUID, policyID *uuid.UUID, operatorID uuid.UUID) (*model.AttendanceRule, error) {
+	rule, err := s.ruleRepo.FindByID(ctx, ruleID)
+	if err != nil || rule.TenantID != tenantID {
+		return nil, fmt.Errorf("attendance rule not found")
+	}
+	if policyID != nil {
+		if _, err := s.GetPolicy(ctx, tenantID, *policyID); err != nil {
+			return nil, err
+		}
+	}
+
+	rule.OvertimePolicyID = policyID
+	rule.UpdatedBy = operatorID
+	rule.UpdatedAt = time.Now()
+	if err := s.ruleRepo.Update(ctx, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (s *overtimePolicyService) Resolve(ctx context.Context, tenantID, employeeID uuid.UUID) *model.OvertimePolicy {
+	rule := resolveEmployeeRule(ctx, s.hrmEmpRepo, s.ruleRepo, tenantID, employeeID)
+	if rule != nil && rule.OvertimePolicyID != nil {
+		if policy, err := s.policyRepo.FindByID(ctx, *rule.OvertimePolicyID); err == nil && policy.IsActive {
+			return policy
+		}
+	}
+
+	if policy, err := s.policyRepo.FindDefault(ctx, tenantID); err == nil {
+		return policy
+	}
+	return model.DefaultOvertimePolicy()
+}
+
+func (s *overtimePolicyService) Apply(ctx context.Context, overtime *model.Overtime) (*model.OvertimePolicy, error) {
+	policy := s.Resolve(ctx, overtime.TenantID, overtime.EmployeeID)
+	rule := policy.RuleFor(overtime.OvertimeType)
+
+	// 未指定补偿方式时按政策：只允许调休的给调休，其余发加班费
+	if overtime.PayType == "" {
+		overtime.PayType = string(model.OvertimeCompensationMoney)
+		if rule.Compensation == model.OvertimeCompensationLeave {
+			overtime.PayType = string(model.OvertimeCompensationLeave)
+		}
+	}
+	if !rule.Compensation.Allows(overtime.PayType) {
+		return nil, fmt.Errorf("%w: %s overtime is compensated by %s", ErrOvertimePayTypeNotAllowed, overtime.OvertimeType, rule.Compensation)
+	}
+
+	overtime.PolicyID = nil
+	if policy.ID != uuid.Nil {
+		overtime.PolicyID = &policy.ID
+	}
+	overtime.BillableHours = policy.BillableHours(overtime.Duration)
+	if overtime.BillableHours <= 0 {
+		return nil, fmt.Errorf("%w: %d minutes", ErrOvertimeBelowMinimum, policy.MinDurationMinutes)
+	}
+	overtime.PayRate = rule.PayRate
+
+	overtime.CompOffDays = 0
+	if overtime.PayType == string(model.OvertimeCompensationLeave) {
+		overtime.CompOffDays = round2(overtime.BillableHours * rule.CompOffRate / policy.DayHours())
+	}
+
+	warnings, err := s.checkCaps(ctx, policy, overtime)
+	if err != nil {
+		return nil, err
+	}
+	overtime.Warnings = warnings
+
+	return policy, nil
+}
+
+// checkCaps 已批准和待审批的加班加上本次超出月度/年度上限时给出提示，
+// 政策要求拦截时返回 ErrOvertimeCapExceeded
+func (s *overtimePolicyService) checkCaps(ctx context.Context, policy *model.OvertimePolicy, overtime *model.Overtime) ([]string, error) {
+	var excludeID *uuid.UUID
+	if overtime.ID != uuid.Nil {
+		excludeID = &overtime.ID
+	}
+
+	start := overtime.StartTime
+	monthStart := time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, start.Location())
+	yearStart := time.Date(start.Year(), 1, 1, 0, 0, 0, 0, start.Location())
+
+	caps := []struct {
+		label      string
+		cap        float64
+		start, end time.Time
+	}{
+		{"本月", policy.MonthlyCapHours, monthStart, monthStart.AddDate(0, 1, 0)},
+		{"本年", policy.YearlyCapHours, yearStart, yearStart.AddDate(1, 0, 0)},
+	}
+
+	var warnings []string
+	for _, c := range caps {
+		if c.cap <= 0 {
+			continue
+		}
+		committed, err := s.overtimeRepo.SumCommittedHours(ctx, overtime.TenantID, overtime.EmployeeID, c.start, c.end, excludeID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sum overtime hours: %w", err)
+		}
+
+		total := round2(committed + overtime.BillableHours)
+		if total <= c.cap {
+			continue
+		}
+		warning := fmt.Sprintf("%s加班累计 %.2f 小时，超出上限 %.2f 小时", c.label, total, c.cap)
+		if policy.BlockOverCap {
+			return nil, fmt.Errorf("%w: %s", ErrOvertimeCapExceeded, warning)
+		}
+		warnings = append(warnings, warning)
+	}
+	return warnings, nil
+}
+
+func validateOvertimePolicy(policy *model.OvertimePolicy) error {
+	if policy.Code == "" || policy.Name == "" {
+		return fmt.Errorf("%w: code and name are required", ErrInvalidOvertimePolicy)
+	}
+	if policy.HoursPerDay < 0 || policy.MinDurationMinutes < 0 || policy.UnitMinutes < 0 ||
+		policy.MonthlyCapHours < 0 || policy.YearlyCapHours < 0 {
+		return fmt.Errorf("%w: negative value", ErrInvalidOvertimePolicy)
+	}
+	if policy.HoursPerDay == 0 {
+		policy.HoursPerDay = 8
+	}
+
+	switch policy.Rounding {
+	case "":
+		policy.Rounding = model.OvertimeRoundingDown
+	case model.OvertimeRoundingDown, model.OvertimeRoundingUp, model.OvertimeRoundingNearest:
+	default:
+		return fmt.Errorf("%w: unknown rounding %q", ErrInvalidOvertimePolicy, policy.Rounding)
+	}
+
+	seen := make(map[model.OvertimeType]bool, len(policy.Rules))
+	for _, rule := range policy.Rules {
+		switch rule.Type {
+		case model.OvertimeTypeWorkday, model.OvertimeTypeWeekend, model.OvertimeTypeHoliday:
+		default:
+			return fmt.Errorf("%w: unknown overtime type %q", ErrInvalidOvertimePolicy, rule.Type)
+		}
+		if seen[rule.Type] {
+			return fmt.Errorf("%w: duplicate rule for %s", ErrInvalidOvertimePolicy, rule.Type)
+		}
+		seen[rule.Type] = true
+
+		if rule.PayRate < 0 || rule.CompOffRate < 0 {
+			return fmt.Errorf("%w: negative rate for %s", ErrInvalidOvertimePolicy, rule.Type)
+		}
+		switch rule.Compensation {
+		case "", model.OvertimeCompensationMoney, model.OvertimeCompensationLeave, model.OvertimeCompensationEither:
+		default:
+			return fmt.Errorf("%w: unknown compensation %q", ErrInvalidOvertimePolicy, rule.Compensation)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+)
+
+type stubOvertimePolicyRepo struct {
+	repository.OvertimePolicyRepository
+	policies      map[uuid.UUID]*model.OvertimePolicy
+	defaultPolicy *model.OvertimePolicy
+}
+
+func (r *stubOvertimePolicyRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.OvertimePolicy, error) {
+	if policy, ok := r.policies[id]; ok {
+		return policy, nil
+	}
+	return nil, errStubNotFound
+}
+
+func (r *stubOvertimePolicyRepo) FindDefault(ctx context.Context, tenantID uuid.UUID) (*model.OvertimePolicy, error) {
+	if r.defaultPolicy == nil {
+		return nil, errStubNotFound
+	}
+	return r.defaultPolicy, nil
+}
+
+// stubOvertimeRepo 内存加班仓储，committed 为上限校验时已占用的时长
+type stubOvertimeRepo struct {
+	repository.OvertimeRepository
+	committed float64
+	created   []*model.Overtime
+}
+
+func (r *stubOvertimeRepo) SumCommittedHours(ctx context.Context, tenantID, employeeID uuid.UUID, startDate, endDate time.Time, excludeID *uuid.UUID) (float64, error) {
+	return r.committed, nil
+}
+
+func (r *stubOvertimeRepo) ExistsByAttendanceRecord(ctx context.Context, recordID uuid.UUID) (bool, error) {
+	for _, overtime := range r.created {
+		if overtime.AttendanceRecordID != nil && *overtime.AttendanceRecordID == recordID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *stubOvertimeRepo) Create(ctx context.Context, overtime *model.Overtime) error {
+	r.created = append(r.created, overtime)
+	return nil
+}
+
+func TestOvertimePolicy_BillableHours(t *testing.T) {
+	policy := &model.OvertimePolicy{MinDurationMinutes: 60, UnitMinutes: 30}
+
+	assert.Zero(t, policy.BillableHours(0.9))
+	assert.Equal(t, 1.5, policy.BillableHours(1.5))
+	assert.Equal(t, 1.5, policy.BillableHours(1.9))
+
+	policy.Rounding = model.OvertimeRoundingUp
+	assert.Equal(t, 2.0, policy.BillableHours(1.6))
+
+	policy.Rounding = model.OvertimeRoundingNearest
+	assert.Equal(t, 1.5, policy.BillableHours(1.7))
+	assert.Equal(t, 2.0, policy.BillableHours(1.8))
+
+	// 未配置计量单位时按实际时长
+	assert.Equal(t, 1.33, model.DefaultOvertimePolicy().BillableHours(1.333))
+}
+
+func TestOvertimePolicyService_Apply(t *testing.T) {
+	ctx := context.Background()
+	tenantID, employeeID := uuid.New(), uuid.New()
+	start := time.Date(2025, 6, 14, 9, 0, 0, 0, time.UTC)
+
+	policy := &model.OvertimePolicy{
+		ID: uuid.New(), TenantID: tenantID, Code: "factory", IsActive: true,
+		Rules: []model.OvertimeTypeRule{
+			{Type: model.OvertimeTypeWeekend, PayRate: 2.5, CompOffRate: 1.5, Compensation: model.OvertimeCompensationLeave},
+			{Type: model.OvertimeTypeWorkday, Compensation: model.OvertimeCompensationMoney},
+		},
+		HoursPerDay: 7.5, UnitMinutes: 30,
+		MonthlyCapHours: 36,
+	}
+	rule := &model.AttendanceRule{ID: uuid.New(), TenantID: tenantID, OvertimePolicyID: &policy.ID}
+
+	newService := func(rule *model.AttendanceRule, committed float64) (*overtimePolicyService, *stubOvertimePolicyRepo) {
+		policies := &stubOvertimePolicyRepo{policies: map[uuid.UUID]*model.OvertimePolicy{policy.ID: policy}}
+		return &overtimePolicyService{
+			policyRepo:   policies,
+			ruleRepo:     &stubRuleRepo{rule: rule},
+			hrmEmpRepo:   &stubHRMEmployeeRepo{},
+			overtimeRepo: &stubOvertimeRepo{committed: committed},
+		}, policies
+	}
+	newOvertime := func(overtimeType model.OvertimeType, payType string, hours float64) *model.Overtime {
+		return &model.Overtime{
+			TenantID: tenantID, EmployeeID: employeeID, OvertimeType: overtimeType, PayType: payType,
+			StartTime: start, EndTime: start.Add(time.Duration(hours * float64(time.Hour))), Duration: hours,
+		}
+	}
+
+	t.Run("rule policy with rounding and comp-off rate", func(t *testing.T) {
+		svc, _ := newService(rule, 0)
+		overtime := newOvertime(model.OvertimeTypeWeekend, "", 4.2)
+
+		applied, err := svc.Apply(ctx, overtime)
+		require.NoError(t, err)
+		assert.Equal(t, policy.ID, applied.ID)
+		assert.Equal(t, policy.ID, *overtime.PolicyID)
+		assert.Equal(t, "leave", overtime.PayType)
+		assert.Equal(t, 4.0, overtime.BillableHours)
+		assert.Equal(t, 2.5, overtime.PayRate)
+		assert.Equal(t, 0.8, overtime.CompOffDays) // 4h × 1.5 / 7.5h
+		assert.Empty(t, overtime.Warnings)
+	})
+
+	t.Run("compensation not allowed", func(t *testing.T) {
+		svc, _ := newService(rule, 0)
+
+		_, err := svc.Apply(ctx, newOvertime(model.OvertimeTypeWorkday, "leave", 2))
+		assert.ErrorIs(t, err, ErrOvertimePayTypeNotAllowed)
+
+		// 未配置倍率的类型按法定标准补齐
+		overtime := newOvertime(model.OvertimeTypeWorkday, "money", 2)
+		_, err = svc.Apply(ctx, overtime)
+		require.NoError(t, err)
+		assert.Equal(t, 1.5, overtime.PayRate)
+		assert.Zero(t, overtime.CompOffDays)
+	})
+
+	t.Run("monthly cap warning and block", func(t *testing.T) {
+		svc, _ := newService(rule, 34)
+		overtime := newOvertime(model.OvertimeTypeWorkday, "money", 3)
+
+		_, err := svc.Apply(ctx, overtime)
+		require.NoError(t, err)
+		require.Len(t, overtime.Warnings, 1)
+		assert.Contains(t, overtime.Warnings[0], "37.00")
+
+		blocking := *policy
+		blocking.BlockOverCap = true
+		svc.policyRepo.(*stubOvertimePolicyRepo).policies[policy.ID] = &blocking
+		_, err = svc.Apply(ctx, newOvertime(model.OvertimeTypeWorkday, "money", 3))
+		assert.ErrorIs(t, err, ErrOvertimeCapExceeded)
+	})
+
+	t.Run("falls back to tenant default then statutory", func(t *testing.T) {
+		svc, policies := newService(nil, 0)
+		overtime := newOvertime(model.OvertimeTypeHoliday, "leave", 8)
+
+		_, err := svc.Apply(ctx, overtime)
+		require.NoError(t, err)
+		assert.Nil(t, overtime.PolicyID)
+		assert.Equal(t, 3.0, overtime.PayRate)
+		assert.Equal(t, 3.0, overtime.CompOffDays)
+
+		tenantDefault := &model.OvertimePolicy{ID: uuid.New(), TenantID: tenantID, IsDefault: true, IsActive: true, HoursPerDay: 8}
+		policies.defaultPolicy = tenantDefault
+		assert.Equal(t, tenantDefault.ID, svc.Resolve(ctx, tenantID, employeeID).ID)
+	})
+}
+
+func TestOvertimeService_DetectFromClockOut(t *testing.T) {
+	ctx := context.Background()
+	tenantID, employeeID := uuid.New(), uuid.New()
+	nightShift := &model.Shift{
+		ID: uuid.New(), Name: "夜班", WorkStart: "22:00", WorkEnd: "06:00",
+		AllowOvertime: true, OvertimeStartBuffer: 30, OvertimeMinDuration: 60,
+	}
+	policy := &model.OvertimePolicy{
+		ID: uuid.New(), TenantID: tenantID, IsDefault: true, IsActive: true,
+		HoursPerDay: 8, UnitMinutes: 30, AutoDetect: true, AutoRequireApproval: true,
+	}
+
+	newService := func(policy *model.OvertimePolicy) (*overtimeService, *stubOvertimeRepo) {
+		overtimes := &stubOvertimeRepo{}
+		policies := &stubOvertimePolicyRepo{defaultPolicy: policy}
+		return &overtimeService{
+			overtimeRepo: overtimes,
+			shiftRepo:    &stubShiftRepo{shifts: map[uuid.UUID]*model.Shift{nightShift.ID: nightShift}},
+			policyService: &overtimePolicyService{
+				policyRepo:   policies,
+				ruleRepo:     &stubRuleRepo{},
+				hrmEmpRepo:   &stubHRMEmployeeRepo{},
+				overtimeRepo: overtimes,
+			},
+		}, overtimes
+	}
+	clockOut := func(at time.Time) *model.AttendanceRecord {
+		return &model.AttendanceRecord{
+			ID: uuid.New(), TenantID: tenantID, EmployeeID: employeeID,
+			ClockTime: at, ClockType: model.ClockTypeCheckOut, ShiftID: &nightShift.ID,
+		}
+	}
+
+	t.Run("cross-day shift beyond end and buffer", func(t *testing.T) {
+		svc, overtimes := newService(policy)
+		record := clockOut(time.Date(2025, 6, 11, 8, 10, 0, 0, time.Local))
+
+		overtime, err := svc.DetectFromClockOut(ctx, record)
+		require.NoError(t, err)
+		require.NotNil(t, overtime)
+		assert.Equal(t, time.Date(2025, 6, 11, 6, 30, 0, 0, time.Local), overtime.StartTime)
+		assert.Equal(t, 1.67, overtime.Duration)
+		assert.Equal(t, 1.5, overtime.BillableHours)
+		assert.Equal(t, model.OvertimeSourceAuto, overtime.Source)
+		assert.Equal(t, "pending", overtime.ApprovalStatus)
+
+		// 同一打卡重复上传不重复识别
+		again, err := svc.DetectFromClockOut(ctx, record)
+		require.NoError(t, err)
+		assert.Nil(t, again)
+		assert.Len(t, overtimes.created, 1)
+	})
+
+	t.Run("shorter than shift minimum", func(t *testing.T) {
+		svc, overtimes := newService(policy)
+
+		overtime, err := svc.DetectFromClockOut(ctx, clockOut(time.Date(2025, 6, 11, 7, 20, 0, 0, time.Local)))
+		require.NoError(t, err)
+		assert.Nil(t, overtime)
+		assert.Empty(t, overtimes.created)
+	})
+
+	t.Run("auto approve when policy allows", func(t *testing.T) {
+		noApproval := *policy
+		noApproval.AutoRequireApproval = false
+		svc, _ := newService(&noApproval)
+
+		overtime, err := svc.DetectFromClockOut(ctx, clockOut(time.Date(2025, 6, 11, 9, 0, 0, 0, time.Local)))
+		require.NoError(t, err)
+		require.NotNil(t, overtime)
+		assert.Equal(t, "approved", overtime.ApprovalStatus)
+		assert.Equal(t, "money", overtime.PayType)
+	})
+
+	t.Run("policy without auto detection", func(t *testing.T) {
+		manual := *policy
+		manual.AutoDetect = false
+		svc, _ := newService(&manual)
+
+		overtime, err := svc.DetectFromClockOut(ctx, clockOut(time.Date(2025, 6, 11, 9, 0, 0, 0, time.Local)))
+		require.NoError(t, err)
+		assert.Nil(t, overtime)
+	})
+}
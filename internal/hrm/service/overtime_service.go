@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -54,79 +55,102 @@ type OvertimeService interface {
 
 	// UseCompOffDays 使用调休
 	UseCompOffDays(ctx context.Context, tenantID, employeeID uuid.UUID, days float64) error
+
+	// DetectFromClockOut 下班打卡晚于班次结束（含加班缓冲）时按加班政策自动生成加班记录，
+	// 政策未开启自动识别或不足最短时长时返回 nil
+	DetectFromClockOut(ctx context.Context, record *model.AttendanceRecord) (*model.Overtime, error)
 }
 
 type overtimeService struct {
 	db             *database.DB
 	overtimeRepo   repository.OvertimeRepository
+	shiftRepo      repository.ShiftRepository
+	policyService  OvertimePolicyService
+	dayResolver    DayTypeResolver
+	periodGuard    AttendancePeriodGuard
+	accrual        LeaveAccrualService
 	workflowEngine *integration.OvertimeWorkflowEngine
+	allocations    TimeAllocationService
 }
 
 // NewOvertimeService 创建加班服务
 func NewOvertimeService(
 	db *database.DB,
 	overtimeRepo repository.OvertimeRepository,
+	shiftRepo repository.ShiftRepository,
+	policyService OvertimePolicyService,
+	dayResolver DayTypeResolver,
+	periodGuard AttendancePeriodGuard,
+	accrual LeaveAccrualService,
 	workflowEngine *workflow.Engine,
+	allocations TimeAllocationService,
 ) OvertimeService {
 	return &overtimeService{
 		db:             db,
 		overtimeRepo:   overtimeRepo,
+		shiftRepo:      shiftRepo,
+		policyService:  policyService,
+		dayResolver:    dayResolver,
+		periodGuard:    periodGuard,
+		accrual:        accrual,
 		workflowEngine: integration.NewOvertimeWorkflowEngine(workflowEngine),
+		allocations:    allocations,
 	}
 }
 
 // Create 创建加班申请
 func (s *overtimeService) Create(ctx context.Context, overtime *model.Overtime) error {
+	if err := checkPeriod(ctx, s.periodGuard, overtime.TenantID, overtime.EmployeeID, overtime.StartTime, overtime.EndTime); err != nil {
+		return err
+	}
+
 	overtime.ID = uuid.Must(uuid.NewV7())
 	now := time.Now()
 	overtime.CreatedAt = now
 	overtime.UpdatedAt = now
 	overtime.ApprovalStatus = "pending" // 默认待审批
-	overtime.CompOffDays = 0
 	overtime.CompOffUsed = 0
+	if overtime.Source == "" {
+		overtime.Source = model.OvertimeSourceManual
+	}
+	s.detectOvertimeType(ctx, overtime)
 
-	// 根据加班类型计算倍率
-	if overtime.PayRate == 0 {
-		overtime.PayRate = s.calculatePayRate(overtime.OvertimeType)
+	// 按加班政策计算倍率、计薪时长和可调休天数
+	if _, err := s.policyService.Apply(ctx, overtime); err != nil {
+		return err
 	}
 
-	// 如果是调休类型，计算可调休天数
-	if overtime.PayType == "leave" {
-		overtime.CompOffDays = s.calculateCompOffDays(overtime.Duration, overtime.OvertimeType)
+	// 检查与请假、加班的冲突（出差、外出期间允许加班）并占用时间，创建失败时释放
+	allocation := overtimeAllocation(overtime)
+	created, err := reserveTimeAllocation(ctx, s.allocations, allocation)
+	if err != nil {
+		return err
 	}
 
-	return s.overtimeRepo.Create(ctx, overtime)
+	if err := s.overtimeRepo.Create(ctx, overtime); err != nil {
+		return undoTimeAllocation(ctx, s.allocations, allocation, created, nil, err)
+	}
+	return nil
 }
 
-// calculatePayRate 计算加班倍率
-func (s *overtimeService) calculatePayRate(overtimeType model.OvertimeType) float64 {
-	switch overtimeType {
-	case model.OvertimeTypeWorkday:
-		return 1.5 // 工作日加班1.5倍
-	case model.OvertimeTypeWeekend:
-		return 2.0 // 周末加班2倍
-	case model.OvertimeTypeHoliday:
-		return 3.0 // 节假日加班3倍
-	default:
-		return 1.5
+// detectOvertimeType 按加班开始日期的日期类型（工作日/休息日/法定节假日）确定加班类型，
+// 返回类型是否被修正
+func (s *overtimeService) detectOvertimeType(ctx context.Context, overtime *model.Overtime) bool {
+	if s.dayResolver == nil {
+		return false
+	}
+
+	day, err := s.dayResolver.Resolve(ctx, overtime.TenantID, overtime.EmployeeID, overtime.StartTime)
+	if err != nil {
+		return false
 	}
-}
 
-// calculateCompOffDays 计算可调休天数
-func (s *overtimeService) calculateCompOffDays(hours float64, overtimeType model.OvertimeType) float64 {
-	// 8小时=1天
-	// 工作日加班：按实际时长
-	// 周末/节假日加班：按倍率计算
-	days := hours / 8.0
-
-	switch overtimeType {
-	case model.OvertimeTypeWeekend:
-		return days * 2.0 // 周末加班可调休2倍
-	case model.OvertimeTypeHoliday:
-		return days * 3.0 // 节假日加班可调休3倍
-	default:
-		return days // 工作日按实际时长
+	detected := day.OvertimeType()
+	if overtime.OvertimeType == detected {
+		return false
 	}
+	overtime.OvertimeType = detected
+	return true
 }
 
 // GetByID 根据ID获取加班记录
@@ -146,18 +170,29 @@ func (s *overtimeService) Update(ctx context.Context, overtime *model.Overtime)
 		return fmt.Errorf("only pending overtime can be updated")
 	}
 
+	if err := checkPeriod(ctx, s.periodGuard, overtime.TenantID, overtime.EmployeeID, overtime.StartTime, overtime.EndTime); err != nil {
+		return err
+	}
+
 	overtime.UpdatedAt = time.Now()
+	s.detectOvertimeType(ctx, overtime)
 
-	// 重新计算倍率和调休天数
-	if overtime.PayRate == 0 {
-		overtime.PayRate = s.calculatePayRate(overtime.OvertimeType)
+	// 重新按加班政策计算
+	if _, err := s.policyService.Apply(ctx, overtime); err != nil {
+		return err
 	}
 
-	if overtime.PayType == "leave" {
-		overtime.CompOffDays = s.calculateCompOffDays(overtime.Duration, overtime.OvertimeType)
+	allocation := overtimeAllocation(overtime)
+	created, err := reserveTimeAllocation(ctx, s.allocations, allocation)
+	if err != nil {
+		return err
 	}
 
-	return s.overtimeRepo.Update(ctx, overtime)
+	// 更新失败时恢复原占用
+	if err := s.overtimeRepo.Update(ctx, overtime); err != nil {
+		return undoTimeAllocation(ctx, s.allocations, allocation, created, overtimeAllocation(existing), err)
+	}
+	return nil
 }
 
 // Delete 删除加班记录
@@ -172,7 +207,10 @@ func (s *overtimeService) Delete(ctx context.Context, id uuid.UUID) error {
 		return fmt.Errorf("only pending overtime can be deleted")
 	}
 
-	return s.overtimeRepo.Delete(ctx, id)
+	if err := s.overtimeRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	return releaseTimeAllocation(ctx, s.allocations, model.TimeAllocationOvertime, id)
 }
 
 // List 列表查询
@@ -201,6 +239,13 @@ func (s *overtimeService) Submit(ctx context.Context, overtimeID, submitterID uu
 		return fmt.Errorf("only pending overtime can be submitted")
 	}
 
+	// 提交前再次检查与请假、加班的冲突；提交失败时撤销本次新增的占用
+	allocation := overtimeAllocation(overtime)
+	created, err := reserveTimeAllocation(ctx, s.allocations, allocation)
+	if err != nil {
+		return err
+	}
+
 	// 使用工作流引擎执行审批流程
 	workflowID := fmt.Sprintf("overtime-approval-%s", overtime.TenantID.String())
 
@@ -212,14 +257,17 @@ func (s *overtimeService) Submit(ctx context.Context, overtimeID, submitterID uu
 		submitterID.String(),
 	)
 	if err != nil {
-		return fmt.Errorf("failed to start workflow execution: %w", err)
+		return undoTimeAllocation(ctx, s.allocations, allocation, created, nil, fmt.Errorf("failed to start workflow execution: %w", err))
 	}
 
 	// 更新状态为待审批
 	overtime.ApprovalStatus = "pending"
 	overtime.UpdatedAt = time.Now()
 
-	return s.overtimeRepo.Update(ctx, overtime)
+	if err := s.overtimeRepo.Update(ctx, overtime); err != nil {
+		return undoTimeAllocation(ctx, s.allocations, allocation, created, nil, err)
+	}
+	return nil
 }
 
 // Approve 批准加班（使用事务）
@@ -237,6 +285,10 @@ func (s *overtimeService) Approve(ctx context.Context, overtimeID, approverID uu
 		return fmt.Errorf("only pending overtime can be approved")
 	}
 
+	if err := checkPeriod(ctx, s.periodGuard, overtime.TenantID, overtime.EmployeeID, overtime.StartTime, overtime.EndTime); err != nil {
+		return err
+	}
+
 	// 使用事务处理审批
 	return s.db.Transaction(ctx, func(tx pgx.Tx) error {
 		now := time.Now()
@@ -255,7 +307,12 @@ func (s *overtimeService) Approve(ctx context.Context, overtimeID, approverID uu
 			return fmt.Errorf("failed to update overtime status: %w", err)
 		}
 
-		return nil
+		// 可调休天数计入调休假额度
+		if err := s.accrual.CreditCompOff(ctx, overtime); err != nil {
+			return fmt.Errorf("failed to credit comp-off quota: %w", err)
+		}
+
+		return confirmTimeAllocation(ctx, s.allocations, model.TimeAllocationOvertime, overtime.ID)
 	})
 }
 
@@ -287,7 +344,7 @@ func (s *overtimeService) Reject(ctx context.Context, overtimeID, approverID uui
 			return fmt.Errorf("failed to update overtime status: %w", err)
 		}
 
-		return nil
+		return releaseTimeAllocation(ctx, s.allocations, model.TimeAllocationOvertime, overtime.ID)
 	})
 }
 
@@ -369,3 +426,101 @@ func (s *overtimeService) UseCompOffDays(ctx context.Context, tenantID, employee
 		return nil
 	})
 }
+
+// DetectFromClockOut 下班打卡自动识别加班：加班从班次结束加缓冲时间起算，至下班打卡为止
+func (s *overtimeService) DetectFromClockOut(ctx context.Context, record *model.AttendanceRecord) (*model.Overtime, error) {
+	if record.ClockType != model.ClockTypeCheckOut || record.ShiftID == nil {
+		return nil, nil
+	}
+
+	policy := s.policyService.Resolve(ctx, record.TenantID, record.EmployeeID)
+	if !policy.AutoDetect {
+		return nil, nil
+	}
+
+	// 同一打卡只识别一次（设备重复上传、补传）
+	exists, err := s.overtimeRepo.ExistsByAttendanceRecord(ctx, record.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check detected overtime: %w", err)
+	}
+	if exists {
+		return nil, nil
+	}
+
+	shift, err := s.shiftRepo.FindByID(ctx, *record.ShiftID)
+	if err != nil || !shift.AllowOvertime {
+		return nil, nil
+	}
+
+	window := clockOutWindow(shift, record.ClockTime)
+	start := window.Work.End.Add(time.Duration(shift.OvertimeStartBuffer) * time.Minute)
+	minutes := record.ClockTime.Sub(start).Minutes()
+	if minutes <= 0 || minutes < float64(shift.OvertimeMinDuration) {
+		return nil, nil
+	}
+
+	now := time.Now()
+	overtime := &model.Overtime{
+		ID:                 uuid.Must(uuid.NewV7()),
+		TenantID:           record.TenantID,
+		EmployeeID:         record.EmployeeID,
+		EmployeeName:       record.EmployeeName,
+		DepartmentID:       record.DepartmentID,
+		StartTime:          start,
+		EndTime:            record.ClockTime,
+		Duration:           round2(minutes / 60),
+		OvertimeType:       model.OvertimeTypeWorkday,
+		Reason:             fmt.Sprintf("%s下班打卡晚于班次结束，系统自动识别", shift.Name),
+		ApprovalStatus:     "pending",
+		Source:             model.OvertimeSourceAuto,
+		AttendanceRecordID: &record.ID,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+	s.detectOvertimeType(ctx, overtime)
+
+	if _, err := s.policyService.Apply(ctx, overtime); err != nil {
+		if errors.Is(err, ErrOvertimeBelowMinimum) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// 政策不要求审批时直接生效
+	if !policy.AutoRequireApproval {
+		overtime.ApprovalStatus = "approved"
+		overtime.ApprovedAt = &now
+		if overtime.PayType == "leave" {
+			expireAt := now.AddDate(1, 0, 0)
+			overtime.CompOffExpireAt = &expireAt
+		}
+	}
+
+	if err := s.overtimeRepo.Create(ctx, overtime); err != nil {
+		return nil, fmt.Errorf("failed to create detected overtime: %w", err)
+	}
+
+	// 已实际发生的加班只登记占用，不做冲突检查
+	if err := holdTimeAllocation(ctx, s.allocations, overtimeAllocation(overtime)); err != nil {
+		return nil, err
+	}
+
+	if overtime.ApprovalStatus == "approved" && s.accrual != nil {
+		if err := s.accrual.CreditCompOff(ctx, overtime); err != nil {
+			return nil, fmt.Errorf("failed to credit comp-off quota: %w", err)
+		}
+	}
+
+	return overtime, nil
+}
+
+// clockOutWindow 下班打卡所属的班次时段：取开始时间不晚于打卡时间的最近一次班次，
+// 跨天班次次日凌晨的下班打卡归属前一天
+func clockOutWindow(shift *model.Shift, clockTime time.Time) *shiftWindow {
+	date := truncateDate(clockTime)
+	window := windowOn(shift, date)
+	if window.Work.Start.After(clockTime) {
+		window = windowOn(shift, date.AddDate(0, 0, -1))
+	}
+	return window
+}
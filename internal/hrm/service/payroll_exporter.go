@@ -0,0 +1,182 @@
+package service
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+)
+
+// 内置导出格式
+const (
+	PayrollExporterJSON = "json"
+	PayrollExporterCSV  = "csv"
+)
+
+// PayrollExporter 薪资对接导出格式：内置 json、csv，
+// 服务商格式实现该接口后通过 PayrollService.RegisterExporter 注册
+type PayrollExporter interface {
+	// Name 格式名称（导出请求中的 exporter 参数）
+	Name() string
+
+	// ContentType 文件 MIME 类型
+	ContentType() string
+
+	// FileExtension 文件扩展名（不含点）
+	FileExtension() string
+
+	// Export 将某期薪资输入写出为该格式
+	Export(w io.Writer, feed *model.PayrollFeed) error
+}
+
+// jsonPayrollExporter 完整的版本化 JSON（含与上一次导出的差异）
+type jsonPayrollExporter struct{}
+
+func (jsonPayrollExporter) Name() string          { return PayrollExporterJSON }
+func (jsonPayrollExporter) ContentType() string   { return "application/json" }
+func (jsonPayrollExporter) FileExtension() string { return "json" }
+
+func (jsonPayrollExporter) Export(w io.Writer, feed *model.PayrollFeed) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(feed)
+}
+
+// payrollCSVHeader CSV 列（同一 schema 版本内只在末尾追加列）
+var payrollCSVHeader = []string{
+	"schema_version", "year", "month", "run_no",
+	"employee_no", "employee_name", "department",
+	"category", "code", "name", "paid", "pay_type", "multiplier",
+	"quantity", "unit", "currency",
+	"previous_quantity", "change",
+}
+
+// csvPayrollExporter 每个员工每条明细一行；有上一次导出时附带上次数量和变化标记，
+// 已删除的明细以数量 0 输出，便于下游冲销
+type csvPayrollExporter struct{}
+
+func (csvPayrollExporter) Name() string          { return PayrollExporterCSV }
+func (csvPayrollExporter) ContentType() string   { return "text/csv" }
+func (csvPayrollExporter) FileExtension() string { return "csv" }
+
+func (csvPayrollExporter) Export(w io.Writer, feed *model.PayrollFeed) error {
+	// 与报表导出一致写入 BOM，Excel 打开不乱码
+	if _, err := io.WriteString(w, "\uFEFF"); err != nil {
+		return err
+	}
+
+	changes := payrollChangeIndex(feed.Changes)
+	writer := csv.NewWriter(w)
+	if err := writer.Write(payrollCSVHeader); err != nil {
+		return err
+	}
+
+	period := []string{feed.SchemaVersion, strconv.Itoa(feed.Year), strconv.Itoa(feed.Month), strconv.Itoa(feed.RunNo)}
+	for _, emp := range feed.Employees {
+		employee := []string{emp.EmployeeNo, emp.EmployeeName, emp.DepartmentName}
+		current := make(map[string]bool, len(emp.Items))
+		for _, item := range emp.Items {
+			key := item.Key()
+			current[key] = true
+			previous, change := "", ""
+			if feed.Changes != nil {
+				previous, change = formatPayrollQuantity(item.Quantity), "unchanged"
+				if changes.added[emp.EmployeeID] {
+					previous, change = "", "added"
+				} else if c, ok := changes.items[emp.EmployeeID][key]; ok {
+					previous, change = formatPayrollQuantity(c.Previous), "changed"
+					if c.Previous == 0 {
+						change = "added"
+					}
+				}
+			}
+			if err := writer.Write(payrollCSVRow(period, employee, item, previous, change)); err != nil {
+				return err
+			}
+		}
+
+		// 员工仍在但明细已删除
+		for _, c := range changes.ordered[emp.EmployeeID] {
+			if current[c.Key] {
+				continue
+			}
+			removed := *c.Item
+			removed.Quantity = 0
+			if err := writer.Write(payrollCSVRow(period, employee, &removed, formatPayrollQuantity(c.Previous), "removed")); err != nil {
+				return err
+			}
+		}
+	}
+
+	// 本期不再导出的员工
+	if feed.Changes != nil {
+		for _, ref := range feed.Changes.Removed {
+			row := append(append([]string{}, period...), ref.EmployeeNo, ref.EmployeeName, "")
+			row = append(row, "", "", "", "", "", "", "0", "", "", "", "removed")
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func payrollCSVRow(period, employee []string, item *model.PayrollItem, previous, change string) []string {
+	paid := ""
+	if item.Paid != nil {
+		paid = strconv.FormatBool(*item.Paid)
+	}
+	multiplier := ""
+	if item.Multiplier > 0 {
+		multiplier = strconv.FormatFloat(item.Multiplier, 'f', -1, 64)
+	}
+
+	row := make([]string, 0, len(payrollCSVHeader))
+	row = append(row, period...)
+	row = append(row, employee...)
+	row = append(row,
+		string(item.Category), item.Code, item.Name, paid, item.PayType, multiplier,
+		formatPayrollQuantity(item.Quantity), string(item.Unit), item.Currency,
+		previous, change,
+	)
+	return row
+}
+
+func formatPayrollQuantity(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// payrollChanges 按员工索引的差异
+type payrollChanges struct {
+	added   map[uuid.UUID]bool
+	items   map[uuid.UUID]map[string]*model.PayrollItemChange
+	ordered map[uuid.UUID][]*model.PayrollItemChange
+}
+
+func payrollChangeIndex(diff *model.PayrollDiff) *payrollChanges {
+	idx := &payrollChanges{
+		added:   make(map[uuid.UUID]bool),
+		items:   make(map[uuid.UUID]map[string]*model.PayrollItemChange),
+		ordered: make(map[uuid.UUID][]*model.PayrollItemChange),
+	}
+	if diff == nil {
+		return idx
+	}
+	for _, ref := range diff.Added {
+		idx.added[ref.EmployeeID] = true
+	}
+	for _, change := range diff.Changed {
+		byKey := make(map[string]*model.PayrollItemChange, len(change.Items))
+		for _, item := range change.Items {
+			byKey[item.Key] = item
+		}
+		idx.items[change.EmployeeID] = byKey
+		idx.ordered[change.EmployeeID] = change.Items
+	}
+	return idx
+}
@@ -0,0 +1,530 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	fileModel "github.com/lk2023060901/go-next-erp/internal/file/model"
+	fileService "github.com/lk2023060901/go-next-erp/internal/file/service"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	orgModel "github.com/lk2023060901/go-next-erp/internal/organization/model"
+	orgRepository "github.com/lk2023060901/go-next-erp/internal/organization/repository"
+)
+
+const (
+	payrollFileCategory = "hrm_payroll"
+
+	// payrollFileRetention 薪资对接文件保留时长（到期由文件模块清理，导出记录中的快照长期保留）
+	payrollFileRetention = 90 * 24 * time.Hour
+)
+
+var (
+	ErrPayrollRunNotFound      = repository.ErrPayrollRunNotFound
+	ErrPayrollPeriodInvalid    = errors.New("invalid payroll period")
+	ErrPayrollPeriodNotLocked  = errors.New("attendance period is not locked")
+	ErrPayrollSnapshotMissing  = errors.New("attendance summary was locked without a snapshot, unlock and lock the period again")
+	ErrPayrollExporterUnknown  = errors.New("unknown payroll exporter")
+	ErrPayrollExporterConflict = errors.New("payroll exporter already registered")
+)
+
+// PayrollService 薪资对接服务：按已锁定的月度考勤汇总及其锁定快照生成每个员工的请假、加班、缺勤和出差补助明细，
+// 以版本化结构交给导出格式输出；同一期可重复导出，每次记录快照并与上一次比对
+type PayrollService interface {
+	// Export 导出某期薪资输入并记录导出批次（该期考勤须已全部锁定）
+	Export(ctx context.Context, req *PayrollExportRequest) (*model.PayrollRun, error)
+
+	// Preview 预览某期薪资输入及与最近一次导出的差异（不记录批次、不生成文件）
+	Preview(ctx context.Context, tenantID uuid.UUID, year, month int) (*model.PayrollFeed, error)
+
+	// Get 查询导出批次并生成下载地址
+	Get(ctx context.Context, tenantID, id, userID uuid.UUID) (*model.PayrollRun, error)
+
+	// List 导出批次列表，year/month 为 0 时不过滤
+	List(ctx context.Context, tenantID uuid.UUID, year, month int, offset, limit int) ([]*model.PayrollRun, int, error)
+
+	// Exporters 已注册的导出格式
+	Exporters() []string
+
+	// RegisterExporter 注册服务商导出格式
+	RegisterExporter(exporter PayrollExporter) error
+}
+
+// PayrollExportRequest 薪资对接导出请求
+type PayrollExportRequest struct {
+	TenantID    uuid.UUID
+	RequestedBy uuid.UUID
+	Year        int
+	Month       int
+	Exporter    string // 为空时使用 json
+}
+
+type payrollService struct {
+	runRepo         repository.PayrollRunRepository
+	summaryRepo     repository.AttendanceSummaryRepository
+	leaveTypeRepo   repository.LeaveTypeRepository
+	orgRepo         orgRepository.OrganizationRepository
+	orgEmpRepo      orgRepository.EmployeeRepository
+	uploadService   fileService.UploadService
+	downloadService fileService.DownloadService
+
+	mu        sync.RWMutex
+	exporters map[string]PayrollExporter
+	now       func() time.Time
+}
+
+// NewPayrollService 创建薪资对接服务（内置 json、csv 导出格式）
+func NewPayrollService(
+	runRepo repository.PayrollRunRepository,
+	summaryRepo repository.AttendanceSummaryRepository,
+	leaveTypeRepo repository.LeaveTypeRepository,
+	orgRepo orgRepository.OrganizationRepository,
+	orgEmpRepo orgRepository.EmployeeRepository,
+	uploadService fileService.UploadService,
+	downloadService fileService.DownloadService,
+) PayrollService {
+	return &payrollService{
+		runRepo:         runRepo,
+		summaryRepo:     summaryRepo,
+		leaveTypeRepo:   leaveTypeRepo,
+		orgRepo:         orgRepo,
+		orgEmpRepo:      orgEmpRepo,
+		uploadService:   uploadService,
+		downloadService: downloadService,
+		exporters: map[string]PayrollExporter{
+			PayrollExporterJSON: jsonPayrollExporter{},
+			PayrollExporterCSV:  csvPayrollExporter{},
+		},
+		now: time.Now,
+	}
+}
+
+func (s *payrollService) Exporters() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	names := make([]string, 0, len(s.exporters))
+	for name := range s.exporters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s *payrollService) RegisterExporter(exporter PayrollExporter) error {
+	if exporter == nil || exporter.Name() == "" {
+		return ErrPayrollExporterUnknown
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.exporters[exporter.Name()]; ok {
+		return fmt.Errorf("%w: %s", ErrPayrollExporterConflict, exporter.Name())
+	}
+	s.exporters[exporter.Name()] = exporter
+	return nil
+}
+
+func (s *payrollService) exporter(name string) (PayrollExporter, error) {
+	if name == "" {
+		name = PayrollExporterJSON
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	exporter, ok := s.exporters[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrPayrollExporterUnknown, name)
+	}
+	return exporter, nil
+}
+
+func (s *payrollService) Export(ctx context.Context, req *PayrollExportRequest) (*model.PayrollRun, error) {
+	exporter, err := s.exporter(req.Exporter)
+	if err != nil {
+		return nil, err
+	}
+
+	feed, previous, err := s.buildFeed(ctx, req.TenantID, req.Year, req.Month)
+	if err != nil {
+		return nil, err
+	}
+
+	checksum, err := payrollChecksum(feed.Employees)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &model.PayrollRun{
+		ID:            uuid.Must(uuid.NewV7()),
+		TenantID:      req.TenantID,
+		Year:          req.Year,
+		Month:         req.Month,
+		RunNo:         1,
+		SchemaVersion: model.PayrollSchemaVersion,
+		Exporter:      exporter.Name(),
+		EmployeeCount: len(feed.Employees),
+		Checksum:      checksum,
+		Diff:          feed.Changes,
+		Employees:     feed.Employees,
+		CreatedBy:     req.RequestedBy,
+		CreatedAt:     feed.GeneratedAt,
+	}
+	if previous != nil {
+		run.RunNo = previous.RunNo + 1
+		run.PreviousRunID = &previous.ID
+	}
+	feed.RunID, feed.RunNo = run.ID, run.RunNo
+
+	var buf bytes.Buffer
+	if err := exporter.Export(&buf, feed); err != nil {
+		return nil, fmt.Errorf("failed to render payroll feed: %w", err)
+	}
+
+	period := fmt.Sprintf("%d-%02d", req.Year, req.Month)
+	category := payrollFileCategory
+	expiresAt := s.now().Add(payrollFileRetention)
+	file, err := s.uploadService.Upload(ctx, &fileService.UploadRequest{
+		TenantID:    req.TenantID,
+		UploadedBy:  req.RequestedBy,
+		Filename:    fmt.Sprintf("payroll-%s-run%d.%s", period, run.RunNo, exporter.FileExtension()),
+		Reader:      bytes.NewReader(buf.Bytes()),
+		Size:        int64(buf.Len()),
+		ContentType: exporter.ContentType(),
+		IsTemporary: true,
+		ExpiresAt:   &expiresAt,
+		Category:    &category,
+		Tags:        []string{"hrm", "payroll", exporter.Name()},
+		Metadata: map[string]interface{}{
+			"payroll_run_id": run.ID.String(),
+			"period":         period,
+			"schema_version": model.PayrollSchemaVersion,
+		},
+		AccessLevel: fileModel.AccessLevelTenant,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to store payroll feed: %w", err)
+	}
+	run.FileID = &file.ID
+	run.Filename = file.Filename
+
+	if err := s.runRepo.Create(ctx, run); err != nil {
+		return nil, fmt.Errorf("failed to create payroll run: %w", err)
+	}
+
+	s.fillDownloadURL(ctx, run, req.RequestedBy)
+	return run, nil
+}
+
+func (s *payrollService) Preview(ctx context.Context, tenantID uuid.UUID, year, month int) (*model.PayrollFeed, error) {
+	feed, _, err := s.buildFeed(ctx, tenantID, year, month)
+	return feed, err
+}
+
+func (s *payrollService) Get(ctx context.Context, tenantID, id, userID uuid.UUID) (*model.PayrollRun, error) {
+	run, err := s.runRepo.FindByID(ctx, tenantID, id)
+	if err != nil {
+		return nil, ErrPayrollRunNotFound
+	}
+	s.fillDownloadURL(ctx, run, userID)
+	return run, nil
+}
+
+func (s *payrollService) List(ctx context.Context, tenantID uuid.UUID, year, month int, offset, limit int) ([]*model.PayrollRun, int, error) {
+	return s.runRepo.List(ctx, tenantID, year, month, offset, limit)
+}
+
+func (s *payrollService) fillDownloadURL(ctx context.Context, run *model.PayrollRun, userID uuid.UUID) {
+	if run.FileID == nil || s.downloadService == nil {
+		return
+	}
+	if url, err := s.downloadService.GetDownloadURL(ctx, *run.FileID, userID, run.TenantID, exportDownloadExpiry); err == nil {
+		run.DownloadURL = url
+	}
+}
+
+// buildFeed 汇总某期全部员工的薪资明细，并与最近一次导出比对
+func (s *payrollService) buildFeed(ctx context.Context, tenantID uuid.UUID, year, month int) (*model.PayrollFeed, *model.PayrollRun, error) {
+	if year < 2000 || month < 1 || month > 12 {
+		return nil, nil, ErrPayrollPeriodInvalid
+	}
+
+	// 只有整月锁定后底层数据才不再变动，导出结果可复现
+	counts, err := s.summaryRepo.CountByStatus(ctx, tenantID, year, month)
+	if err != nil {
+		return nil, nil, err
+	}
+	total := 0
+	for _, n := range counts {
+		total += n
+	}
+	if total == 0 || counts[model.AttendanceSummaryStatusLocked] != total {
+		return nil, nil, ErrPayrollPeriodNotLocked
+	}
+
+	locked := model.AttendanceSummaryStatusLocked
+	summaries, _, err := s.summaryRepo.List(ctx, tenantID, &repository.AttendanceSummaryFilter{
+		Year: &year, Month: &month, Status: &locked,
+	}, 0, total)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load attendance summaries: %w", err)
+	}
+
+	ref, err := s.loadReference(ctx, tenantID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	employees := make([]*model.PayrollEmployee, 0, len(summaries))
+	for _, summary := range summaries {
+		emp := ref.employee(summary)
+		items, err := employeeItems(summary, ref)
+		if err != nil {
+			return nil, nil, fmt.Errorf("employee %s: %w", emp.EmployeeNo, err)
+		}
+		emp.Items = items
+		employees = append(employees, emp)
+	}
+	sort.SliceStable(employees, func(i, j int) bool {
+		if employees[i].EmployeeNo != employees[j].EmployeeNo {
+			return employees[i].EmployeeNo < employees[j].EmployeeNo
+		}
+		return employees[i].EmployeeID.String() < employees[j].EmployeeID.String()
+	})
+
+	feed := &model.PayrollFeed{
+		SchemaVersion: model.PayrollSchemaVersion,
+		TenantID:      tenantID,
+		Year:          year,
+		Month:         month,
+		RunNo:         1,
+		GeneratedAt:   s.now(),
+		Employees:     employees,
+	}
+
+	previous, err := s.runRepo.FindLatest(ctx, tenantID, year, month)
+	if errors.Is(err, repository.ErrPayrollRunNotFound) {
+		// 首次导出
+		return feed, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load previous payroll run: %w", err)
+	}
+	feed.RunNo = previous.RunNo + 1
+	feed.Changes = diffPayroll(previous, employees)
+	return feed, previous, nil
+}
+
+// payrollReference 员工、部门和请假类型的基础信息
+type payrollReference struct {
+	employees   map[uuid.UUID]*orgModel.Employee
+	departments map[uuid.UUID]string
+	leaveTypes  map[uuid.UUID]*model.LeaveType
+}
+
+// employee 员工信息以组织模块为准，已删除的员工退回汇总中的冗余字段
+func (r *payrollReference) employee(summary *model.AttendanceSummary) *model.PayrollEmployee {
+	emp := &model.PayrollEmployee{
+		EmployeeID:     summary.EmployeeID,
+		EmployeeName:   summary.EmployeeName,
+		DepartmentID:   summary.DepartmentID,
+		DepartmentName: r.departments[summary.DepartmentID],
+	}
+	if e, ok := r.employees[summary.EmployeeID]; ok {
+		emp.EmployeeNo = e.EmployeeNo
+		emp.EmployeeName = e.Name
+	}
+	return emp
+}
+
+func (s *payrollService) loadReference(ctx context.Context, tenantID uuid.UUID) (*payrollReference, error) {
+	ref := &payrollReference{
+		employees:   make(map[uuid.UUID]*orgModel.Employee),
+		departments: make(map[uuid.UUID]string),
+		leaveTypes:  make(map[uuid.UUID]*model.LeaveType),
+	}
+
+	orgs, err := s.orgRepo.List(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load organizations: %w", err)
+	}
+	for _, org := range orgs {
+		ref.departments[org.ID] = org.Name
+	}
+
+	employees, err := s.orgEmpRepo.List(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load employees: %w", err)
+	}
+	for _, emp := range employees {
+		ref.employees[emp.ID] = emp
+	}
+
+	// 含已停用类型，历史请假仍需判定是否带薪
+	leaveTypes, _, err := s.leaveTypeRepo.List(ctx, tenantID, nil, 0, 1000)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load leave types: %w", err)
+	}
+	for _, lt := range leaveTypes {
+		ref.leaveTypes[lt.ID] = lt
+	}
+	return ref, nil
+}
+
+// employeeItems 员工某期的薪资明细（按明细键排序，数量为 0 的不输出）；
+// 请假、加班和出差补助只取锁定快照，锁定后底层单据的变动不影响导出
+func employeeItems(summary *model.AttendanceSummary, ref *payrollReference) ([]*model.PayrollItem, error) {
+	snapshot := summary.LockSnapshot
+	if snapshot == nil {
+		return nil, ErrPayrollSnapshotMissing
+	}
+
+	var items []*model.PayrollItem
+	add := func(item *model.PayrollItem) {
+		item.Quantity = round2(item.Quantity)
+		if item.Quantity != 0 {
+			items = append(items, item)
+		}
+	}
+
+	// 出勤
+	add(&model.PayrollItem{Category: model.PayrollItemAttendance, Code: model.PayrollCodeWorkDays, Quantity: float64(summary.WorkDays), Unit: model.PayrollUnitDay})
+	add(&model.PayrollItem{Category: model.PayrollItemAttendance, Code: model.PayrollCodeActualDays, Quantity: float64(summary.ActualDays), Unit: model.PayrollUnitDay})
+	add(&model.PayrollItem{Category: model.PayrollItemAttendance, Code: model.PayrollCodeWorkHours, Quantity: summary.WorkHours, Unit: model.PayrollUnitHour})
+
+	// 请假（按类型拆分，区分带薪/无薪）
+	unpaidDays := 0.0
+	for _, leave := range snapshot.Leaves {
+		code, name, paid := leave.LeaveTypeID.String(), leave.LeaveTypeName, false
+		if lt, ok := ref.leaveTypes[leave.LeaveTypeID]; ok {
+			code, name, paid = lt.Code, lt.Name, lt.IsPaid
+		}
+		if !paid {
+			unpaidDays += leave.Days
+		}
+		add(&model.PayrollItem{Category: model.PayrollItemLeave, Code: code, Name: name, Paid: &paid, Quantity: leave.Days, Unit: model.PayrollUnitDay})
+	}
+
+	// 缺勤扣款依据
+	add(&model.PayrollItem{Category: model.PayrollItemAbsence, Code: model.PayrollCodeAbsentDays, Quantity: summary.AbsentDays, Unit: model.PayrollUnitDay})
+	add(&model.PayrollItem{Category: model.PayrollItemAbsence, Code: model.PayrollCodeUnpaidLeaveDays, Quantity: unpaidDays, Unit: model.PayrollUnitDay})
+	add(&model.PayrollItem{Category: model.PayrollItemAbsence, Code: model.PayrollCodeLateCount, Quantity: float64(summary.LateCount), Unit: model.PayrollUnitCount})
+	add(&model.PayrollItem{Category: model.PayrollItemAbsence, Code: model.PayrollCodeLateMinutes, Quantity: float64(summary.LateDuration), Unit: model.PayrollUnitMinute})
+	add(&model.PayrollItem{Category: model.PayrollItemAbsence, Code: model.PayrollCodeEarlyCount, Quantity: float64(summary.EarlyCount), Unit: model.PayrollUnitCount})
+	add(&model.PayrollItem{Category: model.PayrollItemAbsence, Code: model.PayrollCodeEarlyMinutes, Quantity: float64(summary.EarlyDuration), Unit: model.PayrollUnitMinute})
+	add(&model.PayrollItem{Category: model.PayrollItemAbsence, Code: model.PayrollCodeMissingCount, Quantity: float64(summary.MissingCount), Unit: model.PayrollUnitCount})
+
+	// 加班（按类型、补偿方式和倍率合并）
+	for _, overtime := range snapshot.Overtimes {
+		add(&model.PayrollItem{
+			Category:   model.PayrollItemOvertime,
+			Code:       string(overtime.OvertimeType),
+			PayType:    overtime.PayType,
+			Multiplier: overtime.PayRate,
+			Quantity:   overtime.Hours,
+			Unit:       model.PayrollUnitHour,
+		})
+	}
+
+	// 出差天数及已批准报销单中的出差补助
+	add(&model.PayrollItem{Category: model.PayrollItemTrip, Code: model.PayrollCodeTripDays, Quantity: summary.TripDays, Unit: model.PayrollUnitDay})
+	for _, allowance := range snapshot.TripAllowances {
+		add(&model.PayrollItem{Category: model.PayrollItemTrip, Code: model.PayrollCodeTripAllowance, Quantity: allowance.Amount, Unit: model.PayrollUnitAmount, Currency: allowance.Currency})
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Key() < items[j].Key()
+	})
+	return items, nil
+}
+
+// payrollChecksum 员工明细的 SHA-256，相同表示与上一次导出数据一致
+func payrollChecksum(employees []*model.PayrollEmployee) (string, error) {
+	data, err := json.Marshal(employees)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal payroll employees: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// diffPayroll 与上一次导出的员工明细快照比对
+func diffPayroll(previous *model.PayrollRun, current []*model.PayrollEmployee) *model.PayrollDiff {
+	diff := &model.PayrollDiff{
+		PreviousRunID: previous.ID,
+		PreviousRunNo: previous.RunNo,
+		Added:         []*model.PayrollEmployeeRef{},
+		Removed:       []*model.PayrollEmployeeRef{},
+		Changed:       []*model.PayrollEmployeeChange{},
+	}
+
+	before := make(map[uuid.UUID]*model.PayrollEmployee, len(previous.Employees))
+	for _, emp := range previous.Employees {
+		before[emp.EmployeeID] = emp
+	}
+	seen := make(map[uuid.UUID]bool, len(current))
+
+	for _, emp := range current {
+		seen[emp.EmployeeID] = true
+		prev, ok := before[emp.EmployeeID]
+		if !ok {
+			diff.Added = append(diff.Added, payrollEmployeeRef(emp))
+			continue
+		}
+		if items := diffPayrollItems(prev.Items, emp.Items); len(items) > 0 {
+			diff.Changed = append(diff.Changed, &model.PayrollEmployeeChange{PayrollEmployeeRef: *payrollEmployeeRef(emp), Items: items})
+		}
+	}
+	for _, emp := range previous.Employees {
+		if !seen[emp.EmployeeID] {
+			diff.Removed = append(diff.Removed, payrollEmployeeRef(emp))
+		}
+	}
+	return diff
+}
+
+// diffPayrollItems 明细数量变化（按明细键排序）
+func diffPayrollItems(previous, current []*model.PayrollItem) []*model.PayrollItemChange {
+	before := make(map[string]*model.PayrollItem, len(previous))
+	for _, item := range previous {
+		before[item.Key()] = item
+	}
+
+	var changes []*model.PayrollItemChange
+	seen := make(map[string]bool, len(current))
+	for _, item := range current {
+		key := item.Key()
+		seen[key] = true
+		prev, ok := before[key]
+		if !ok {
+			changes = append(changes, &model.PayrollItemChange{Key: key, Item: item, Current: item.Quantity})
+			continue
+		}
+		if prev.Quantity != item.Quantity {
+			changes = append(changes, &model.PayrollItemChange{Key: key, Item: item, Previous: prev.Quantity, Current: item.Quantity})
+		}
+	}
+	for _, item := range previous {
+		if key := item.Key(); !seen[key] {
+			changes = append(changes, &model.PayrollItemChange{Key: key, Item: item, Previous: item.Quantity})
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Key < changes[j].Key
+	})
+	return changes
+}
+
+func payrollEmployeeRef(emp *model.PayrollEmployee) *model.PayrollEmployeeRef {
+	return &model.PayrollEmployeeRef{EmployeeID: emp.EmployeeID, EmployeeNo: emp.EmployeeNo, EmployeeName: emp.EmployeeName}
+}
@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	orgModel "github.com/lk2023060901/go-next-erp/internal/organization/model"
+)
+
+type stubPayrollRunRepo struct {
+	repository.PayrollRunRepository
+	runs []*model.PayrollRun
+	err  error
+}
+
+func (r *stubPayrollRunRepo) Create(ctx context.Context, run *model.PayrollRun) error {
+	r.runs = append(r.runs, run)
+	return nil
+}
+
+func (r *stubPayrollRunRepo) FindLatest(ctx context.Context, tenantID uuid.UUID, year, month int) (*model.PayrollRun, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	if len(r.runs) == 0 {
+		return nil, repository.ErrPayrollRunNotFound
+	}
+	return r.runs[len(r.runs)-1], nil
+}
+
+type stubPayrollSummaryRepo struct {
+	repository.AttendanceSummaryRepository
+	counts    map[string]int
+	summaries []*model.AttendanceSummary
+}
+
+func (r *stubPayrollSummaryRepo) CountByStatus(ctx context.Context, tenantID uuid.UUID, year, month int) (map[string]int, error) {
+	return r.counts, nil
+}
+
+func (r *stubPayrollSummaryRepo) List(ctx context.Context, tenantID uuid.UUID, filter *repository.AttendanceSummaryFilter, offset, limit int) ([]*model.AttendanceSummary, int, error) {
+	return r.summaries, len(r.summaries), nil
+}
+
+type stubPayrollLeaveTypeRepo struct {
+	repository.LeaveTypeRepository
+	types []*model.LeaveType
+}
+
+func (r *stubPayrollLeaveTypeRepo) List(ctx context.Context, tenantID uuid.UUID, filter *repository.LeaveTypeFilter, offset, limit int) ([]*model.LeaveType, int, error) {
+	return r.types, len(r.types), nil
+}
+
+type vendorPayrollExporter struct{}
+
+func (vendorPayrollExporter) Name() string          { return "vendor" }
+func (vendorPayrollExporter) ContentType() string   { return "text/plain" }
+func (vendorPayrollExporter) FileExtension() string { return "txt" }
+
+func (vendorPayrollExporter) Export(w io.Writer, feed *model.PayrollFeed) error {
+	for _, emp := range feed.Employees {
+		if _, err := io.WriteString(w, emp.EmployeeNo+"\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestPayrollService_Export(t *testing.T) {
+	tenantID, userID, dept := uuid.New(), uuid.New(), uuid.New()
+	alice := &orgModel.Employee{ID: uuid.New(), EmployeeNo: "E001", Name: "张三", OrgID: dept}
+	bob := &orgModel.Employee{ID: uuid.New(), EmployeeNo: "E002", Name: "李四", OrgID: dept}
+	annual := &model.LeaveType{ID: uuid.New(), Code: "annual", Name: "年假", IsPaid: true}
+	personal := &model.LeaveType{ID: uuid.New(), Code: "personal", Name: "事假"}
+
+	summaryRepo := &stubPayrollSummaryRepo{
+		counts: map[string]int{model.AttendanceSummaryStatusLocked: 2},
+		summaries: []*model.AttendanceSummary{
+			{
+				EmployeeID: bob.ID, DepartmentID: dept, Year: 2025, Month: 9, WorkDays: 22, ActualDays: 20, AbsentDays: 1, LateCount: 2, LateDuration: 25,
+				LockSnapshot: &model.AttendanceLockSnapshot{Leaves: []*model.AttendanceLeaveDays{{LeaveTypeID: personal.ID, Days: 0.5}}},
+			},
+			{
+				EmployeeID: alice.ID, DepartmentID: dept, Year: 2025, Month: 9, WorkDays: 22, ActualDays: 19, OvertimeCount: 3, TripCount: 1, TripDays: 3,
+				LockSnapshot: &model.AttendanceLockSnapshot{
+					Leaves: []*model.AttendanceLeaveDays{{LeaveTypeID: annual.ID, Days: 1}},
+					Overtimes: []*model.AttendanceOvertimeHours{
+						{OvertimeType: model.OvertimeTypeWeekend, PayType: "money", PayRate: 2, Hours: 12},
+						{OvertimeType: model.OvertimeTypeWorkday, PayType: "leave", PayRate: 1.5, Hours: 2},
+					},
+					TripAllowances: []*model.AttendanceTripAllowance{{Currency: "CNY", Amount: 200}},
+				},
+			},
+		},
+	}
+
+	runRepo := &stubPayrollRunRepo{}
+	uploads := &stubReportUploads{}
+	svc := NewPayrollService(
+		runRepo,
+		summaryRepo,
+		&stubPayrollLeaveTypeRepo{types: []*model.LeaveType{annual, personal}},
+		&stubReportOrgRepo{orgs: []*orgModel.Organization{{ID: dept, Name: "研发部"}}},
+		&stubReportEmpRepo{employees: []*orgModel.Employee{bob, alice}},
+		uploads,
+		&stubReportDownloads{},
+	)
+
+	t.Run("requires locked period", func(t *testing.T) {
+		summaryRepo.counts = map[string]int{model.AttendanceSummaryStatusLocked: 1, model.AttendanceSummaryStatusConfirmed: 1}
+		defer func() { summaryRepo.counts = map[string]int{model.AttendanceSummaryStatusLocked: 2} }()
+
+		_, err := svc.Export(context.Background(), &PayrollExportRequest{TenantID: tenantID, Year: 2025, Month: 9})
+		assert.ErrorIs(t, err, ErrPayrollPeriodNotLocked)
+
+		_, err = svc.Export(context.Background(), &PayrollExportRequest{TenantID: tenantID, Year: 2025, Month: 9, Exporter: "unknown"})
+		assert.ErrorIs(t, err, ErrPayrollExporterUnknown)
+	})
+
+	t.Run("requires lock snapshot", func(t *testing.T) {
+		snapshot := summaryRepo.summaries[0].LockSnapshot
+		summaryRepo.summaries[0].LockSnapshot = nil
+		defer func() { summaryRepo.summaries[0].LockSnapshot = snapshot }()
+
+		_, err := svc.Export(context.Background(), &PayrollExportRequest{TenantID: tenantID, Year: 2025, Month: 9})
+		assert.ErrorIs(t, err, ErrPayrollSnapshotMissing)
+	})
+
+	t.Run("previous run lookup failure", func(t *testing.T) {
+		runRepo.err = errors.New("connection reset")
+		defer func() { runRepo.err = nil }()
+
+		_, err := svc.Export(context.Background(), &PayrollExportRequest{TenantID: tenantID, Year: 2025, Month: 9})
+		assert.ErrorContains(t, err, "connection reset")
+		assert.Empty(t, runRepo.runs)
+	})
+
+	t.Run("first export as json", func(t *testing.T) {
+		run, err := svc.Export(context.Background(), &PayrollExportRequest{TenantID: tenantID, RequestedBy: userID, Year: 2025, Month: 9})
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, run.RunNo)
+		assert.Nil(t, run.Diff)
+		assert.Equal(t, model.PayrollSchemaVersion, run.SchemaVersion)
+		assert.Equal(t, "payroll-2025-09-run1.json", run.Filename)
+		assert.Equal(t, "https://files.example.com/"+run.FileID.String(), run.DownloadURL)
+		assert.Len(t, run.Checksum, 64)
+
+		var feed model.PayrollFeed
+		require.NoError(t, json.Unmarshal([]byte(uploads.content), &feed))
+		assert.Equal(t, run.ID, feed.RunID)
+		require.Len(t, feed.Employees, 2)
+
+		// 按工号排序，员工信息取组织模块
+		first := feed.Employees[0]
+		assert.Equal(t, "E001", first.EmployeeNo)
+		assert.Equal(t, "研发部", first.DepartmentName)
+
+		items := make(map[string]*model.PayrollItem)
+		for _, item := range first.Items {
+			items[item.Key()] = item
+		}
+		assert.Equal(t, 1.0, items["leave.annual"].Quantity)
+		assert.True(t, *items["leave.annual"].Paid)
+		// 加班按类型、补偿方式和倍率合并，计薪时长优先；未批准和上月加班不计入
+		assert.Equal(t, 12.0, items["overtime.weekend.money.x2"].Quantity)
+		assert.Equal(t, 2.0, items["overtime.workday.leave.x1.5"].Quantity)
+		assert.Len(t, filterPayrollItems(first.Items, model.PayrollItemOvertime), 2)
+		assert.Equal(t, 3.0, items["trip.trip_days"].Quantity)
+		assert.Equal(t, 200.0, items["trip.allowance.CNY"].Quantity)
+		assert.NotContains(t, items, "absence.absent_days")
+
+		second := feed.Employees[1]
+		bobItems := make(map[string]*model.PayrollItem)
+		for _, item := range second.Items {
+			bobItems[item.Key()] = item
+		}
+		assert.False(t, *bobItems["leave.personal"].Paid)
+		assert.Equal(t, 0.5, bobItems["absence.unpaid_leave_days"].Quantity)
+		assert.Equal(t, 1.0, bobItems["absence.absent_days"].Quantity)
+		assert.Equal(t, 25.0, bobItems["absence.late_minutes"].Quantity)
+	})
+
+	t.Run("re-export as csv with diff", func(t *testing.T) {
+		summaryRepo.summaries[0].AbsentDays = 0
+		summaryRepo.summaries[0].ActualDays = 21
+		summaryRepo.summaries[1].LockSnapshot.Leaves[0].Days = 2
+
+		run, err := svc.Export(context.Background(), &PayrollExportRequest{TenantID: tenantID, RequestedBy: userID, Year: 2025, Month: 9, Exporter: PayrollExporterCSV})
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, run.RunNo)
+		assert.Equal(t, runRepo.runs[0].ID, *run.PreviousRunID)
+		assert.NotEqual(t, runRepo.runs[0].Checksum, run.Checksum)
+		require.NotNil(t, run.Diff)
+		assert.Empty(t, run.Diff.Added)
+		assert.Empty(t, run.Diff.Removed)
+		require.Len(t, run.Diff.Changed, 2)
+		assert.Equal(t, "E001", run.Diff.Changed[0].EmployeeNo)
+		assert.Equal(t, &model.PayrollItemChange{Key: "leave.annual", Item: run.Diff.Changed[0].Items[0].Item, Previous: 1, Current: 2}, run.Diff.Changed[0].Items[0])
+
+		lines := strings.Split(strings.TrimSpace(uploads.content), "\n")
+		assert.True(t, strings.HasPrefix(lines[0], "\uFEFFschema_version,year,month,run_no,employee_no,"))
+		assert.Contains(t, lines, "1.0,2025,9,2,E001,张三,研发部,leave,annual,年假,true,,,2,day,,1,changed")
+		assert.Contains(t, lines, "1.0,2025,9,2,E002,李四,研发部,absence,absent_days,,,,,0,day,,1,removed")
+		assert.Contains(t, lines, "1.0,2025,9,2,E002,李四,研发部,attendance,actual_days,,,,,21,day,,20,changed")
+		assert.Contains(t, lines, "1.0,2025,9,2,E002,李四,研发部,absence,late_count,,,,,2,count,,2,unchanged")
+	})
+
+	t.Run("vendor exporter", func(t *testing.T) {
+		require.NoError(t, svc.RegisterExporter(vendorPayrollExporter{}))
+		assert.ErrorIs(t, svc.RegisterExporter(vendorPayrollExporter{}), ErrPayrollExporterConflict)
+		assert.Equal(t, []string{"csv", "json", "vendor"}, svc.Exporters())
+
+		run, err := svc.Export(context.Background(), &PayrollExportRequest{TenantID: tenantID, RequestedBy: userID, Year: 2025, Month: 9, Exporter: "vendor"})
+		require.NoError(t, err)
+		assert.Equal(t, "payroll-2025-09-run3.txt", run.Filename)
+		assert.True(t, run.Diff.IsEmpty())
+		assert.Equal(t, "E001\nE002\n", uploads.content)
+	})
+}
+
+func filterPayrollItems(items []*model.PayrollItem, category model.PayrollItemCategory) []*model.PayrollItem {
+	var result []*model.PayrollItem
+	for _, item := range items {
+		if item.Category == category {
+			result = append(result, item)
+		}
+	}
+	return result
+}
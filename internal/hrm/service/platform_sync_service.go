@@ -0,0 +1,418 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/integration"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/integration/dingtalk"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/integration/feishu"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/integration/wecom"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/cache"
+)
+
+const (
+	// platformSyncCron 定时检查到期集成的频率，各集成按自己的 sync_interval 执行
+	platformSyncCron = "*/5 * * * *"
+
+	// platformInitialLookbackDays 首次同步（无游标）回看天数
+	platformInitialLookbackDays = 7
+
+	// platformCallbackSyncTimeout 回调触发的异步同步超时
+	platformCallbackSyncTimeout = 5 * time.Minute
+
+	// maxUnmappedUsersInLog 同步日志中记录的未映射平台用户数上限
+	maxUnmappedUsersInLog = 50
+
+	syncTypeAttendance = "attendance"
+	syncDirectionPull  = "pull"
+
+	syncStatusSuccess = "success"
+	syncStatusPartial = "partial"
+	syncStatusFailed  = "failed"
+)
+
+var (
+	ErrIntegrationNotFound  = errors.New("third party integration not found")
+	ErrIntegrationInactive  = errors.New("third party integration sync disabled")
+	ErrPlatformNotSupported = errors.New("platform not supported")
+	ErrSyncInProgress       = errors.New("platform sync already in progress")
+)
+
+// PlatformAdapterFactory 按集成配置创建平台考勤适配器
+type PlatformAdapterFactory interface {
+	Create(cfg *model.ThirdPartyIntegration) (integration.AttendanceIntegration, error)
+}
+
+type platformAdapterFactory struct {
+	tokens *cache.TokenCache
+}
+
+// NewPlatformAdapterFactory 创建平台适配器工厂，访问令牌缓存在 Redis 中由各实例共享
+func NewPlatformAdapterFactory(redis *cache.Redis) PlatformAdapterFactory {
+	store := cache.NewMemoryTokenStore()
+	if redis != nil {
+		store = cache.NewRedisTokenStore(redis)
+	}
+	return &platformAdapterFactory{tokens: cache.NewTokenCache(store)}
+}
+
+func (f *platformAdapterFactory) Create(cfg *model.ThirdPartyIntegration) (integration.AttendanceIntegration, error) {
+	opts := []integration.ClientOption{
+		integration.WithTokenCache(f.tokens),
+		integration.WithCallback(cfg.WebhookToken, cfg.WebhookSecret),
+	}
+
+	switch cfg.Platform {
+	case model.PlatformDingTalk:
+		return dingtalk.NewAttendanceAdapter(cfg.AppKey, cfg.AppSecret, opts...), nil
+	case model.PlatformWeCom:
+		return wecom.NewAttendanceAdapter(cfg.CorpID, cfg.AppSecret, opts...), nil
+	case model.PlatformFeishu:
+		return feishu.NewAttendanceAdapter(cfg.AppID, cfg.AppSecret, opts...), nil
+	default:
+		return nil, ErrPlatformNotSupported
+	}
+}
+
+// PlatformSyncService 第三方平台（钉钉、企业微信、飞书）考勤同步服务
+// 按集成保存的游标增量拉取打卡记录，每次执行写一条同步日志
+type PlatformSyncService interface {
+	// SyncAttendance 增量同步一个集成的考勤记录。平台调用失败记入同步日志（状态 failed）并返回日志，
+	// 仅存储层错误返回 error
+	SyncAttendance(ctx context.Context, tenantID, integrationID uuid.UUID) (*model.SyncLog, error)
+
+	// RunDue 定时任务：同步所有已到同步间隔的集成
+	RunDue(ctx context.Context) (*PlatformSyncRunResult, error)
+
+	// CronSpec 定时任务的 Cron 表达式
+	CronSpec() string
+
+	// HandleCallback 校验平台事件回调；打卡事件在后台触发一次增量同步
+	HandleCallback(ctx context.Context, integrationID uuid.UUID, req *integration.CallbackRequest) (*integration.CallbackResult, error)
+
+	// ListSyncLogs 查询集成的同步日志
+	ListSyncLogs(ctx context.Context, tenantID, integrationID uuid.UUID, offset, limit int) ([]*model.SyncLog, int, error)
+}
+
+// PlatformSyncRunResult 定时同步执行结果
+type PlatformSyncRunResult struct {
+	Integrations int `json:"integrations"`
+	Imported     int `json:"imported"`
+	Failed       int `json:"failed"`
+}
+
+type platformSyncService struct {
+	integrationRepo repository.ThirdPartyIntegrationRepository
+	syncLogRepo     repository.SyncLogRepository
+	syncMappingRepo repository.EmployeeSyncMappingRepository
+	recordRepo      repository.AttendanceRecordRepository
+	hrmEmpRepo      repository.HRMEmployeeRepository
+	attendance      AttendanceService
+	adapters        PlatformAdapterFactory
+	now             func() time.Time
+
+	// inflight 正在同步的集成，回调突发时同一集成只跑一次
+	inflight sync.Map
+	// async 执行回调触发的同步（测试中替换为同步执行）
+	async func(fn func())
+}
+
+// NewPlatformSyncService 创建第三方平台考勤同步服务
+func NewPlatformSyncService(
+	integrationRepo repository.ThirdPartyIntegrationRepository,
+	syncLogRepo repository.SyncLogRepository,
+	syncMappingRepo repository.EmployeeSyncMappingRepository,
+	recordRepo repository.AttendanceRecordRepository,
+	hrmEmpRepo repository.HRMEmployeeRepository,
+	attendance AttendanceService,
+	adapters PlatformAdapterFactory,
+) PlatformSyncService {
+	return &platformSyncService{
+		integrationRepo: integrationRepo,
+		syncLogRepo:     syncLogRepo,
+		syncMappingRepo: syncMappingRepo,
+		recordRepo:      recordRepo,
+		hrmEmpRepo:      hrmEmpRepo,
+		attendance:      attendance,
+		adapters:        adapters,
+		now:             time.Now,
+		async:           func(fn func()) { go fn() },
+	}
+}
+
+func (s *platformSyncService) CronSpec() string {
+	return platformSyncCron
+}
+
+func (s *platformSyncService) SyncAttendance(ctx context.Context, tenantID, integrationID uuid.UUID) (*model.SyncLog, error) {
+	cfg, err := s.integrationRepo.FindByID(ctx, integrationID)
+	if err != nil || cfg.TenantID != tenantID {
+		return nil, ErrIntegrationNotFound
+	}
+	return s.syncAttendance(ctx, cfg)
+}
+
+func (s *platformSyncService) RunDue(ctx context.Context) (*PlatformSyncRunResult, error) {
+	due, err := s.integrationRepo.ListSyncDue(ctx, s.now())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PlatformSyncRunResult{Integrations: len(due)}
+	var firstErr error
+	for _, cfg := range due {
+		log, err := s.syncAttendance(ctx, cfg)
+		if errors.Is(err, ErrSyncInProgress) {
+			// 回调触发的同步正在执行
+			continue
+		}
+		if err != nil {
+			result.Failed++
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if log.Status == syncStatusFailed {
+			result.Failed++
+		}
+		result.Imported += log.SuccessCount
+	}
+	return result, firstErr
+}
+
+func (s *platformSyncService) HandleCallback(ctx context.Context, integrationID uuid.UUID, req *integration.CallbackRequest) (*integration.CallbackResult, error) {
+	cfg, err := s.integrationRepo.FindByID(ctx, integrationID)
+	if err != nil {
+		return nil, ErrIntegrationNotFound
+	}
+	if !cfg.IsActive {
+		return nil, ErrIntegrationInactive
+	}
+
+	adapter, err := s.adapters.Create(cfg)
+	if err != nil {
+		return nil, err
+	}
+	result, err := adapter.HandleCallback(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// 平台要求回调在数秒内应答，同步放到后台执行
+	if result.Attendance && cfg.SyncEnabled && cfg.SyncAttendance {
+		s.async(func() {
+			syncCtx, cancel := context.WithTimeout(context.Background(), platformCallbackSyncTimeout)
+			defer cancel()
+			_, _ = s.syncAttendance(syncCtx, cfg)
+		})
+	}
+	return result, nil
+}
+
+func (s *platformSyncService) ListSyncLogs(ctx context.Context, tenantID, integrationID uuid.UUID, offset, limit int) ([]*model.SyncLog, int, error) {
+	cfg, err := s.integrationRepo.FindByID(ctx, integrationID)
+	if err != nil || cfg.TenantID != tenantID {
+		return nil, 0, ErrIntegrationNotFound
+	}
+
+	sourceType := string(cfg.Platform)
+	filter := &repository.SyncLogFilter{SourceType: &sourceType, SourceID: &cfg.ID}
+	return s.syncLogRepo.List(ctx, tenantID, filter, offset, limit)
+}
+
+// platformImportStats 单次同步的导入统计
+type platformImportStats struct {
+	imported      int
+	duplicates    int
+	unmapped      int
+	locked        int
+	failed        int
+	unmappedUsers []string
+	firstError    error
+}
+
+// syncAttendance 拉取、导入并记录同步日志。导入出现非锁定类错误时不推进游标，下次重新拉取（已导入的按来源ID去重）
+func (s *platformSyncService) syncAttendance(ctx context.Context, cfg *model.ThirdPartyIntegration) (*model.SyncLog, error) {
+	if !cfg.IsActive || !cfg.SyncEnabled || !cfg.SyncAttendance {
+		return nil, ErrIntegrationInactive
+	}
+	if _, running := s.inflight.LoadOrStore(cfg.ID, struct{}{}); running {
+		return nil, ErrSyncInProgress
+	}
+	defer s.inflight.Delete(cfg.ID)
+
+	started := s.now()
+	log := &model.SyncLog{
+		ID:            uuid.Must(uuid.NewV7()),
+		TenantID:      cfg.TenantID,
+		SourceType:    string(cfg.Platform),
+		SourceID:      cfg.ID,
+		SyncType:      syncTypeAttendance,
+		SyncDirection: syncDirectionPull,
+		StartTime:     started,
+	}
+
+	adapter, err := s.adapters.Create(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	pulled, err := adapter.PullAttendance(ctx, &integration.PullAttendanceRequest{
+		TenantID: cfg.TenantID,
+		Cursor:   cfg.SyncCursor,
+		Since:    truncateDate(started).AddDate(0, 0, -platformInitialLookbackDays),
+		Until:    started,
+	})
+	if err != nil {
+		log.Status = syncStatusFailed
+		log.ErrorMessage = err.Error()
+		log.Details = map[string]interface{}{"cursor_from": cfg.SyncCursor}
+		if err := s.finishLog(ctx, log); err != nil {
+			return nil, err
+		}
+		if err := s.integrationRepo.UpdateStatus(ctx, cfg.ID, model.IntegrationStatusError, log.ErrorMessage); err != nil {
+			return nil, err
+		}
+		return log, nil
+	}
+
+	stats, err := s.importRecords(ctx, cfg, pulled.Records)
+	if err != nil {
+		return nil, err
+	}
+
+	log.TotalCount = len(pulled.Records)
+	log.SuccessCount = stats.imported
+	log.FailedCount = stats.unmapped + stats.failed
+	log.Details = map[string]interface{}{
+		"cursor_from":    cfg.SyncCursor,
+		"cursor_to":      pulled.NextCursor,
+		"users":          pulled.Users,
+		"imported":       stats.imported,
+		"duplicates":     stats.duplicates,
+		"unmapped":       stats.unmapped,
+		"locked":         stats.locked,
+		"unmapped_users": stats.unmappedUsers,
+	}
+	switch {
+	case log.FailedCount == 0:
+		log.Status = syncStatusSuccess
+	case log.FailedCount < log.TotalCount:
+		log.Status = syncStatusPartial
+	default:
+		log.Status = syncStatusFailed
+	}
+	if stats.firstError != nil {
+		log.ErrorMessage = stats.firstError.Error()
+	}
+
+	if err := s.finishLog(ctx, log); err != nil {
+		return nil, err
+	}
+	if stats.firstError == nil {
+		if err := s.integrationRepo.UpdateSyncCursor(ctx, cfg.ID, pulled.NextCursor); err != nil {
+			return nil, err
+		}
+		cfg.SyncCursor = pulled.NextCursor
+	}
+	if err := s.integrationRepo.UpdateSyncTime(ctx, cfg.ID, stats.imported); err != nil {
+		return nil, err
+	}
+	if err := s.integrationRepo.UpdateStatus(ctx, cfg.ID, model.IntegrationStatusActive, log.ErrorMessage); err != nil {
+		return nil, err
+	}
+	return log, nil
+}
+
+// importRecords 按员工同步映射匹配员工，跳过已导入的记录后逐条导入
+func (s *platformSyncService) importRecords(ctx context.Context, cfg *model.ThirdPartyIntegration, records []*integration.PlatformAttendanceRecord) (*platformImportStats, error) {
+	stats := &platformImportStats{}
+	if len(records) == 0 {
+		return stats, nil
+	}
+
+	mappings, err := s.syncMappingRepo.ListSyncEnabled(ctx, cfg.TenantID, cfg.Platform)
+	if err != nil {
+		return nil, err
+	}
+	employeeByUser := make(map[string]uuid.UUID, len(mappings))
+	employeeIDs := make([]uuid.UUID, 0, len(mappings))
+	for _, mapping := range mappings {
+		employeeByUser[mapping.PlatformID] = mapping.EmployeeID
+		employeeIDs = append(employeeIDs, mapping.EmployeeID)
+	}
+
+	users, err := s.hrmEmpRepo.ListDeviceUsers(ctx, cfg.TenantID, employeeIDs)
+	if err != nil {
+		return nil, err
+	}
+	userByEmployee := make(map[uuid.UUID]*model.DeviceUser, len(users))
+	for _, user := range users {
+		userByEmployee[user.EmployeeID] = user
+	}
+
+	sourceIDs := make([]string, 0, len(records))
+	for _, record := range records {
+		sourceIDs = append(sourceIDs, record.Record.SourceID)
+	}
+	imported, err := s.recordRepo.FindSourceIDs(ctx, cfg.TenantID, model.SourceType(cfg.Platform), sourceIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	seenUnmapped := make(map[string]bool)
+	for _, pulled := range records {
+		record := pulled.Record
+		if imported[record.SourceID] {
+			stats.duplicates++
+			continue
+		}
+
+		employeeID, ok := employeeByUser[pulled.PlatformUserID]
+		if !ok {
+			stats.unmapped++
+			if !seenUnmapped[pulled.PlatformUserID] && len(stats.unmappedUsers) < maxUnmappedUsersInLog {
+				seenUnmapped[pulled.PlatformUserID] = true
+				stats.unmappedUsers = append(stats.unmappedUsers, pulled.PlatformUserID)
+			}
+			continue
+		}
+
+		record.ID = uuid.New()
+		record.TenantID = cfg.TenantID
+		record.EmployeeID = employeeID
+		if user, ok := userByEmployee[employeeID]; ok {
+			record.EmployeeName = user.Name
+			record.DepartmentID = user.DepartmentID
+		}
+
+		if err := s.attendance.BatchImport(ctx, []*model.AttendanceRecord{record}); err != nil {
+			if errors.Is(err, ErrAttendancePeriodLocked) {
+				stats.locked++
+				continue
+			}
+			stats.failed++
+			if stats.firstError == nil {
+				stats.firstError = err
+			}
+			continue
+		}
+		imported[record.SourceID] = true
+		stats.imported++
+	}
+	return stats, nil
+}
+
+func (s *platformSyncService) finishLog(ctx context.Context, log *model.SyncLog) error {
+	log.EndTime = s.now()
+	log.Duration = int(log.EndTime.Sub(log.StartTime).Milliseconds())
+	log.CreatedAt = log.EndTime
+	return s.syncLogRepo.Create(ctx, log)
+}
@@ -0,0 +1,287 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lk2023060901/go-next-erp/internal/hrm/integration"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+)
+
+type stubIntegrationRepo struct {
+	repository.ThirdPartyIntegrationRepository
+	cfg    *model.ThirdPartyIntegration
+	status model.IntegrationStatus
+	synced int
+}
+
+func (r *stubIntegrationRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.ThirdPartyIntegration, error) {
+	if r.cfg == nil || r.cfg.ID != id {
+		return nil, errStubNotFound
+	}
+	return r.cfg, nil
+}
+
+func (r *stubIntegrationRepo) ListSyncDue(ctx context.Context, now time.Time) ([]*model.ThirdPartyIntegration, error) {
+	return []*model.ThirdPartyIntegration{r.cfg}, nil
+}
+
+func (r *stubIntegrationRepo) UpdateSyncCursor(ctx context.Context, id uuid.UUID, cursor string) error {
+	r.cfg.SyncCursor = cursor
+	return nil
+}
+
+func (r *stubIntegrationRepo) UpdateSyncTime(ctx context.Context, id uuid.UUID, count int) error {
+	r.synced++
+	return nil
+}
+
+func (r *stubIntegrationRepo) UpdateStatus(ctx context.Context, id uuid.UUID, status model.IntegrationStatus, errorMsg string) error {
+	r.status = status
+	return nil
+}
+
+type stubSyncLogRepo struct {
+	repository.SyncLogRepository
+	logs []*model.SyncLog
+}
+
+func (r *stubSyncLogRepo) Create(ctx context.Context, log *model.SyncLog) error {
+	r.logs = append(r.logs, log)
+	return nil
+}
+
+type stubSyncMappingRepo struct {
+	repository.EmployeeSyncMappingRepository
+	mappings []*model.EmployeeSyncMapping
+}
+
+func (r *stubSyncMappingRepo) ListSyncEnabled(ctx context.Context, tenantID uuid.UUID, platform model.PlatformType) ([]*model.EmployeeSyncMapping, error) {
+	return r.mappings, nil
+}
+
+type stubSourceRecordRepo struct {
+	repository.AttendanceRecordRepository
+	existing map[string]bool
+}
+
+func (r *stubSourceRecordRepo) FindSourceIDs(ctx context.Context, tenantID uuid.UUID, sourceType model.SourceType, sourceIDs []string) (map[string]bool, error) {
+	found := make(map[string]bool)
+	for _, id := range sourceIDs {
+		if r.existing[id] {
+			found[id] = true
+		}
+	}
+	return found, nil
+}
+
+type stubDeviceUserRepo struct {
+	repository.HRMEmployeeRepository
+	users []*model.DeviceUser
+}
+
+func (r *stubDeviceUserRepo) ListDeviceUsers(ctx context.Context, tenantID uuid.UUID, employeeIDs []uuid.UUID) ([]*model.DeviceUser, error) {
+	return r.users, nil
+}
+
+// stubImportService 记录导入的考勤，lockedBefore 之前的记录视为已锁定
+type stubImportService struct {
+	AttendanceService
+	imported     []*model.AttendanceRecord
+	lockedBefore time.Time
+	failWith     error
+}
+
+func (s *stubImportService) BatchImport(ctx context.Context, records []*model.AttendanceRecord) error {
+	for _, record := range records {
+		if record.ClockTime.Before(s.lockedBefore) {
+			return ErrAttendancePeriodLocked
+		}
+	}
+	if s.failWith != nil {
+		return s.failWith
+	}
+	s.imported = append(s.imported, records...)
+	return nil
+}
+
+type stubPlatformAdapter struct {
+	integration.AttendanceIntegration
+	pullErr  error
+	records  []*integration.PlatformAttendanceRecord
+	requests []*integration.PullAttendanceRequest
+	callback *integration.CallbackResult
+}
+
+func (a *stubPlatformAdapter) PullAttendance(ctx context.Context, req *integration.PullAttendanceRequest) (*integration.PullAttendanceResult, error) {
+	a.requests = append(a.requests, req)
+	if a.pullErr != nil {
+		return nil, a.pullErr
+	}
+	return &integration.PullAttendanceResult{Records: a.records, Users: 2, NextCursor: integration.FormatTimeCursor(req.Until)}, nil
+}
+
+func (a *stubPlatformAdapter) HandleCallback(ctx context.Context, req *integration.CallbackRequest) (*integration.CallbackResult, error) {
+	return a.callback, nil
+}
+
+type stubAdapterFactory struct {
+	adapter *stubPlatformAdapter
+}
+
+func (f *stubAdapterFactory) Create(cfg *model.ThirdPartyIntegration) (integration.AttendanceIntegration, error) {
+	return f.adapter, nil
+}
+
+type platformSyncFixture struct {
+	svc          *platformSyncService
+	integrations *stubIntegrationRepo
+	logs         *stubSyncLogRepo
+	importer     *stubImportService
+	adapter      *stubPlatformAdapter
+	cfg          *model.ThirdPartyIntegration
+	employeeID   uuid.UUID
+	now          time.Time
+}
+
+func newPlatformSyncFixture() *platformSyncFixture {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	cfg := &model.ThirdPartyIntegration{
+		ID: uuid.New(), TenantID: uuid.New(), Platform: model.PlatformDingTalk,
+		IsActive: true, SyncEnabled: true, SyncAttendance: true,
+	}
+	employeeID := uuid.New()
+	record := func(sourceID, userID string, clockTime time.Time) *integration.PlatformAttendanceRecord {
+		return &integration.PlatformAttendanceRecord{
+			PlatformUserID: userID,
+			Record:         &model.AttendanceRecord{SourceType: model.SourceTypeDingTalk, SourceID: sourceID, ClockTime: clockTime},
+		}
+	}
+
+	f := &platformSyncFixture{
+		integrations: &stubIntegrationRepo{cfg: cfg},
+		logs:         &stubSyncLogRepo{},
+		importer:     &stubImportService{lockedBefore: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+		adapter: &stubPlatformAdapter{records: []*integration.PlatformAttendanceRecord{
+			record("1", "u1", now.Add(-3*time.Hour)),
+			record("2", "u1", now.Add(-2*time.Hour)),
+			record("3", "ghost", now.Add(-time.Hour)),
+			record("4", "u1", time.Date(2025, 2, 28, 9, 0, 0, 0, time.UTC)),
+		}},
+		cfg:        cfg,
+		employeeID: employeeID,
+		now:        now,
+	}
+	f.svc = NewPlatformSyncService(
+		f.integrations,
+		f.logs,
+		&stubSyncMappingRepo{mappings: []*model.EmployeeSyncMapping{{EmployeeID: employeeID, Platform: model.PlatformDingTalk, PlatformID: "u1"}}},
+		&stubSourceRecordRepo{existing: map[string]bool{"1": true}},
+		&stubDeviceUserRepo{users: []*model.DeviceUser{{EmployeeID: employeeID, Name: "张三"}}},
+		f.importer,
+		&stubAdapterFactory{adapter: f.adapter},
+	).(*platformSyncService)
+	f.svc.now = func() time.Time { return now }
+	return f
+}
+
+func TestPlatformSyncService_SyncAttendance(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("imports new mapped records and advances cursor", func(t *testing.T) {
+		f := newPlatformSyncFixture()
+
+		log, err := f.svc.SyncAttendance(ctx, f.cfg.TenantID, f.cfg.ID)
+		require.NoError(t, err)
+
+		require.Len(t, f.importer.imported, 1)
+		imported := f.importer.imported[0]
+		assert.Equal(t, "2", imported.SourceID)
+		assert.Equal(t, f.employeeID, imported.EmployeeID)
+		assert.Equal(t, f.cfg.TenantID, imported.TenantID)
+		assert.Equal(t, "张三", imported.EmployeeName)
+
+		assert.Equal(t, syncStatusPartial, log.Status)
+		assert.Equal(t, 4, log.TotalCount)
+		assert.Equal(t, 1, log.SuccessCount)
+		assert.Equal(t, 1, log.FailedCount, "unmapped user counts as failed")
+		assert.Equal(t, 1, log.Details["duplicates"])
+		assert.Equal(t, 1, log.Details["locked"])
+		assert.Equal(t, []string{"ghost"}, log.Details["unmapped_users"])
+		require.Len(t, f.logs.logs, 1)
+
+		assert.Equal(t, integration.FormatTimeCursor(f.now), f.cfg.SyncCursor)
+		assert.Equal(t, model.IntegrationStatusActive, f.integrations.status)
+		assert.Equal(t, 1, f.integrations.synced)
+
+		req := f.adapter.requests[0]
+		assert.Empty(t, req.Cursor)
+		assert.Equal(t, time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC), req.Since)
+	})
+
+	t.Run("import error keeps cursor for retry", func(t *testing.T) {
+		f := newPlatformSyncFixture()
+		f.cfg.SyncCursor = "1741000000"
+		f.importer.failWith = errors.New("db down")
+
+		log, err := f.svc.SyncAttendance(ctx, f.cfg.TenantID, f.cfg.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "db down", log.ErrorMessage)
+		assert.Equal(t, "1741000000", f.cfg.SyncCursor)
+		assert.Equal(t, "1741000000", f.adapter.requests[0].Cursor)
+	})
+
+	t.Run("platform error is logged and marks integration", func(t *testing.T) {
+		f := newPlatformSyncFixture()
+		f.adapter.pullErr = integration.ErrRateLimited
+
+		log, err := f.svc.SyncAttendance(ctx, f.cfg.TenantID, f.cfg.ID)
+		require.NoError(t, err)
+		assert.Equal(t, syncStatusFailed, log.Status)
+		assert.Equal(t, model.IntegrationStatusError, f.integrations.status)
+		assert.Empty(t, f.cfg.SyncCursor)
+		assert.Zero(t, f.integrations.synced)
+	})
+
+	t.Run("other tenant and disabled sync are rejected", func(t *testing.T) {
+		f := newPlatformSyncFixture()
+		_, err := f.svc.SyncAttendance(ctx, uuid.New(), f.cfg.ID)
+		assert.ErrorIs(t, err, ErrIntegrationNotFound)
+
+		f.cfg.SyncAttendance = false
+		_, err = f.svc.SyncAttendance(ctx, f.cfg.TenantID, f.cfg.ID)
+		assert.ErrorIs(t, err, ErrIntegrationInactive)
+	})
+}
+
+func TestPlatformSyncService_HandleCallback(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("attendance event triggers sync", func(t *testing.T) {
+		f := newPlatformSyncFixture()
+		f.svc.async = func(fn func()) { fn() }
+		f.adapter.callback = &integration.CallbackResult{EventType: "attendance_check_record", Attendance: true, Reply: []byte("success")}
+
+		result, err := f.svc.HandleCallback(ctx, f.cfg.ID, &integration.CallbackRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, "success", string(result.Reply))
+		assert.Len(t, f.logs.logs, 1)
+	})
+
+	t.Run("other events do not sync", func(t *testing.T) {
+		f := newPlatformSyncFixture()
+		f.svc.async = func(fn func()) { fn() }
+		f.adapter.callback = &integration.CallbackResult{EventType: "user_add_org"}
+
+		_, err := f.svc.HandleCallback(ctx, f.cfg.ID, &integration.CallbackRequest{})
+		require.NoError(t, err)
+		assert.Empty(t, f.logs.logs)
+	})
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,6 +11,21 @@ import (
 	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
 )
 
+var (
+	ErrSupplementMissingTypeNotAllowed = errors.New("missing type is not allowed by punch card supplement policy")
+	ErrSupplementFilingDeadlinePassed  = errors.New("punch card supplement filing deadline has passed")
+	ErrSupplementMonthlyLimitReached   = errors.New("monthly punch card supplement limit reached")
+	ErrSupplementPolicyInvalid         = errors.New("invalid punch card supplement policy")
+)
+
+// validMissingTypes 支持的缺卡类型
+var validMissingTypes = map[model.PunchCardMissingType]bool{
+	model.MissingTypeForgot:      true,
+	model.MissingTypeMalfunction: true,
+	model.MissingTypeOutside:     true,
+	model.MissingTypeOther:       true,
+}
+
 // PunchCardSupplementService 补卡申请服务接口
 type PunchCardSupplementService interface {
 	// Create 创建补卡申请
@@ -42,7 +58,7 @@ type PunchCardSupplementService interface {
 	// Reject 拒绝补卡申请
 	Reject(ctx context.Context, supplementID, approverID uuid.UUID, reason string) error
 
-	// Process 处理补卡（生成补卡记录）
+	// Process 处理补卡：生成或更正当天的考勤记录并重算考勤状态
 	Process(ctx context.Context, supplementID, processorID uuid.UUID) error
 
 	// Cancel 取消补卡申请
@@ -56,17 +72,37 @@ type PunchCardSupplementService interface {
 
 	// CountByEmployee 统计员工补卡次数
 	CountByEmployee(ctx context.Context, tenantID, employeeID uuid.UUID, startDate, endDate time.Time) (int, error)
+
+	// GetPolicy 查询租户补卡政策（未配置时返回默认政策）
+	GetPolicy(ctx context.Context, tenantID uuid.UUID) (*model.PunchCardSupplementPolicy, error)
+
+	// SavePolicy 保存租户补卡政策
+	SavePolicy(ctx context.Context, policy *model.PunchCardSupplementPolicy) error
 }
 
 // punchCardSupplementService 补卡申请服务实现
 type punchCardSupplementService struct {
-	repo repository.PunchCardSupplementRepository
+	repo        repository.PunchCardSupplementRepository
+	policyRepo  repository.PunchCardSupplementPolicyRepository
+	recordRepo  repository.AttendanceRecordRepository
+	attendance  AttendanceService
+	periodGuard AttendancePeriodGuard
 }
 
 // NewPunchCardSupplementService 创建补卡申请服务
-func NewPunchCardSupplementService(repo repository.PunchCardSupplementRepository) PunchCardSupplementService {
+func NewPunchCardSupplementService(
+	repo repository.PunchCardSupplementRepository,
+	policyRepo repository.PunchCardSupplementPolicyRepository,
+	recordRepo repository.AttendanceRecordRepository,
+	attendance AttendanceService,
+	periodGuard AttendancePeriodGuard,
+) PunchCardSupplementService {
 	return &punchCardSupplementService{
-		repo: repo,
+		repo:        repo,
+		policyRepo:  policyRepo,
+		recordRepo:  recordRepo,
+		attendance:  attendance,
+		periodGuard: periodGuard,
 	}
 }
 
@@ -77,6 +113,10 @@ func (s *punchCardSupplementService) Create(ctx context.Context, supplement *mod
 		return err
 	}
 
+	if err := checkPeriod(ctx, s.periodGuard, supplement.TenantID, supplement.EmployeeID, supplement.SupplementDate, supplement.SupplementDate); err != nil {
+		return err
+	}
+
 	// 2. 检查是否存在重复的补卡申请
 	duplicate, err := s.CheckDuplicate(ctx, supplement.TenantID, supplement.EmployeeID, supplement.SupplementDate, supplement.SupplementType)
 	if err != nil {
@@ -107,8 +147,15 @@ func (s *punchCardSupplementService) Update(ctx context.Context, supplement *mod
 		return fmt.Errorf("cannot update supplement with status: %s", existing.ApprovalStatus)
 	}
 
-	// 3. 验证补卡申请的合理性
-	if err := s.ValidateSupplement(ctx, supplement); err != nil {
+	// 3. 验证补卡申请的合理性（提交期限按原申请时间计算，次数不重复计入本申请）
+	if err := validateSupplement(supplement); err != nil {
+		return err
+	}
+	if err := s.checkPolicy(ctx, supplement, existing); err != nil {
+		return err
+	}
+
+	if err := checkPeriod(ctx, s.periodGuard, supplement.TenantID, supplement.EmployeeID, supplement.SupplementDate, supplement.SupplementDate); err != nil {
 		return err
 	}
 
@@ -186,6 +233,10 @@ func (s *punchCardSupplementService) Approve(ctx context.Context, supplementID,
 		return fmt.Errorf("supplement status is not pending")
 	}
 
+	if err := checkPeriod(ctx, s.periodGuard, supplement.TenantID, supplement.EmployeeID, supplement.SupplementDate, supplement.SupplementDate); err != nil {
+		return err
+	}
+
 	// 3. 更新审批信息
 	now := time.Now()
 	supplement.ApprovalStatus = "approved"
@@ -239,11 +290,19 @@ func (s *punchCardSupplementService) Process(ctx context.Context, supplementID,
 		return fmt.Errorf("supplement is already processed")
 	}
 
-	// 4. 这里应该创建考勤记录或更新现有记录
-	// TODO: 调用考勤服务创建补卡记录
+	if err := checkPeriod(ctx, s.periodGuard, supplement.TenantID, supplement.EmployeeID, supplement.SupplementDate, supplement.SupplementDate); err != nil {
+		return err
+	}
+
+	// 4. 生成或更正考勤记录，记录关联补卡申请
+	record, err := s.applyToRecord(ctx, supplement, processorID)
+	if err != nil {
+		return err
+	}
 
 	// 5. 更新处理状态
 	now := time.Now()
+	supplement.AttendanceRecordID = &record.ID
 	supplement.ProcessStatus = "processed"
 	supplement.ProcessedAt = &now
 	supplement.ProcessedBy = &processorID
@@ -272,55 +331,49 @@ func (s *punchCardSupplementService) Cancel(ctx context.Context, supplementID uu
 	return s.repo.Update(ctx, supplement)
 }
 
-// ValidateSupplement 验证补卡申请的合理性
+// ValidateSupplement 验证补卡申请的合理性（含租户补卡政策）
 func (s *punchCardSupplementService) ValidateSupplement(ctx context.Context, supplement *model.PunchCardSupplement) error {
-	// 1. 验证补卡日期不能是未来日期
-	now := time.Now()
-	if supplement.SupplementDate.After(now) {
-		return fmt.Errorf("supplement date cannot be in the future")
+	if err := validateSupplement(supplement); err != nil {
+		return err
 	}
+	return s.checkPolicy(ctx, supplement, nil)
+}
 
-	// 2. 验证补卡日期不能太久远（例如不超过30天）
-	maxDaysAgo := 30
-	minDate := now.AddDate(0, 0, -maxDaysAgo)
-	if supplement.SupplementDate.Before(minDate) {
-		return fmt.Errorf("supplement date cannot be more than %d days ago", maxDaysAgo)
+// validateSupplement 校验补卡申请的基本字段
+func validateSupplement(supplement *model.PunchCardSupplement) error {
+	// 1. 验证补卡日期不能是未来日期
+	if supplement.SupplementDate.After(time.Now()) {
+		return fmt.Errorf("supplement date cannot be in the future")
 	}
 
-	// 3. 验证补卡时间格式
+	// 2. 验证补卡时间格式
 	if supplement.SupplementTime.IsZero() {
 		return fmt.Errorf("supplement time is required")
 	}
 
-	// 4. 验证补卡时间在补卡日期当天
+	// 3. 验证补卡时间在补卡日期当天
 	supplementDate := supplement.SupplementDate.Format("2006-01-02")
 	supplementTimeDate := supplement.SupplementTime.Format("2006-01-02")
 	if supplementDate != supplementTimeDate {
 		return fmt.Errorf("supplement time must be on the supplement date")
 	}
 
-	// 5. 验证补卡类型
+	// 4. 验证补卡类型
 	if supplement.SupplementType != model.SupplementTypeCheckIn && supplement.SupplementType != model.SupplementTypeCheckOut {
 		return fmt.Errorf("invalid supplement type")
 	}
 
-	// 6. 验证缺卡类型
-	validMissingTypes := map[model.PunchCardMissingType]bool{
-		model.MissingTypeForgot:      true,
-		model.MissingTypeMalfunction: true,
-		model.MissingTypeOutside:     true,
-		model.MissingTypeOther:       true,
-	}
+	// 5. 验证缺卡类型
 	if !validMissingTypes[supplement.MissingType] {
 		return fmt.Errorf("invalid missing type")
 	}
 
-	// 7. 验证补卡原因不能为空
+	// 6. 验证补卡原因不能为空
 	if supplement.Reason == "" {
 		return fmt.Errorf("reason is required")
 	}
 
-	// 8. 验证补卡原因长度
+	// 7. 验证补卡原因长度
 	if len(supplement.Reason) > 500 {
 		return fmt.Errorf("reason is too long (max 500 characters)")
 	}
@@ -328,6 +381,145 @@ func (s *punchCardSupplementService) ValidateSupplement(ctx context.Context, sup
 	return nil
 }
 
+// checkPolicy 校验租户补卡政策：缺卡类型、提交期限、每月次数。
+// existing 为更新前的申请，新建时为 nil
+func (s *punchCardSupplementService) checkPolicy(ctx context.Context, supplement, existing *model.PunchCardSupplement) error {
+	policy, err := s.GetPolicy(ctx, supplement.TenantID)
+	if err != nil {
+		return err
+	}
+
+	if !policy.AllowsMissingType(supplement.MissingType) {
+		return fmt.Errorf("%w: %s", ErrSupplementMissingTypeNotAllowed, supplement.MissingType)
+	}
+
+	filedAt := time.Now()
+	if existing != nil {
+		filedAt = existing.CreatedAt
+	}
+	if deadline := policy.FilingDeadline(supplement.SupplementDate); !deadline.IsZero() && !filedAt.Before(deadline) {
+		return fmt.Errorf("%w: must be filed within %d days", ErrSupplementFilingDeadlinePassed, policy.FilingDeadlineDays)
+	}
+
+	if policy.MaxPerMonth > 0 {
+		date := supplement.SupplementDate
+		monthStart := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, date.Location())
+		count, err := s.repo.CountByEmployee(ctx, supplement.TenantID, supplement.EmployeeID, monthStart, monthStart.AddDate(0, 1, 0))
+		if err != nil {
+			return err
+		}
+		// 修改同月申请时不重复计入自身
+		if existing != nil && existing.ProcessStatus != "cancelled" &&
+			existing.SupplementDate.Year() == date.Year() && existing.SupplementDate.Month() == date.Month() {
+			count--
+		}
+		if count >= policy.MaxPerMonth {
+			return fmt.Errorf("%w: at most %d per month", ErrSupplementMonthlyLimitReached, policy.MaxPerMonth)
+		}
+	}
+
+	return nil
+}
+
+// applyToRecord 补卡生效：当天已有同类型打卡则更正打卡时间并重算状态，否则补记一条考勤记录。
+// 记录的 ApprovalID 指向补卡申请，更正前的打卡时间和状态保留在 RawData 中
+func (s *punchCardSupplementService) applyToRecord(ctx context.Context, supplement *model.PunchCardSupplement, processorID uuid.UUID) (*model.AttendanceRecord, error) {
+	clockType := model.ClockTypeCheckIn
+	if supplement.SupplementType == model.SupplementTypeCheckOut {
+		clockType = model.ClockTypeCheckOut
+	}
+
+	record, err := s.findRecordToCorrect(ctx, supplement, clockType)
+	if err != nil {
+		return nil, err
+	}
+	if record == nil {
+		return s.attendance.ClockIn(ctx, &ClockInRequest{
+			TenantID:      supplement.TenantID,
+			EmployeeID:    supplement.EmployeeID,
+			EmployeeName:  supplement.EmployeeName,
+			DepartmentID:  supplement.DepartmentID,
+			ClockTime:     supplement.SupplementTime,
+			ClockType:     clockType,
+			CheckInMethod: model.MethodManual,
+			SourceType:    model.SourceTypeManual,
+			SourceID:      supplement.ID.String(),
+			ApprovalID:    &supplement.ID,
+			Remark:        fmt.Sprintf("补卡：%s", supplement.Reason),
+		})
+	}
+
+	if err := checkPeriod(ctx, s.periodGuard, record.TenantID, record.EmployeeID, record.ClockTime, record.ClockTime); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if record.RawData == nil {
+		record.RawData = make(map[string]interface{})
+	}
+	corrections, _ := record.RawData["supplement_corrections"].([]interface{})
+	record.RawData["supplement_corrections"] = append(corrections, map[string]interface{}{
+		"supplement_id":       supplement.ID.String(),
+		"previous_clock_time": record.ClockTime.Format(time.RFC3339),
+		"previous_status":     string(record.Status),
+		"processed_by":        processorID.String(),
+		"processed_at":        now.Format(time.RFC3339),
+	})
+
+	record.ClockTime = supplement.SupplementTime
+	record.ApprovalID = &supplement.ID
+
+	status, err := s.attendance.CalculateStatus(ctx, record)
+	if err != nil {
+		// 计算失败，默认为正常
+		status = model.AttendanceStatusNormal
+	}
+	record.Status = status
+	record.IsException = isExceptionStatus(status)
+	record.ExceptionType, record.ExceptionReason = "", ""
+	if record.IsException {
+		record.ExceptionType = string(status)
+		record.ExceptionReason = getExceptionReason(status)
+	}
+	record.UpdatedAt = now
+
+	if err := s.recordRepo.Update(ctx, record); err != nil {
+		return nil, fmt.Errorf("update attendance record failed: %w", err)
+	}
+	return record, nil
+}
+
+// findRecordToCorrect 查找需要更正的考勤记录：优先使用申请关联的记录，
+// 否则取补卡当天同类型的打卡（上班取最早一次，下班取最晚一次），没有时返回 nil
+func (s *punchCardSupplementService) findRecordToCorrect(ctx context.Context, supplement *model.PunchCardSupplement, clockType model.AttendanceClockType) (*model.AttendanceRecord, error) {
+	if supplement.AttendanceRecordID != nil {
+		return s.recordRepo.FindByID(ctx, *supplement.AttendanceRecordID)
+	}
+
+	t := supplement.SupplementTime
+	dayStart := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	records, err := s.recordRepo.FindByEmployee(ctx, supplement.TenantID, supplement.EmployeeID, dayStart, dayStart.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	var target *model.AttendanceRecord
+	for _, record := range records {
+		if record.ClockType != clockType {
+			continue
+		}
+		// 记录按打卡时间升序
+		if target == nil || clockType == model.ClockTypeCheckOut {
+			target = record
+		}
+	}
+	if target == nil {
+		return nil, nil
+	}
+	// 按日期查询的结果不含班次等字段，重新加载完整记录
+	return s.recordRepo.FindByID(ctx, target.ID)
+}
+
 // CheckDuplicate 检查是否存在重复的补卡申请
 func (s *punchCardSupplementService) CheckDuplicate(ctx context.Context, tenantID, employeeID uuid.UUID, date time.Time, supplementType model.SupplementType) (bool, error) {
 	existing, err := s.repo.FindByDate(ctx, tenantID, employeeID, date, supplementType)
@@ -341,3 +533,36 @@ func (s *punchCardSupplementService) CheckDuplicate(ctx context.Context, tenantI
 func (s *punchCardSupplementService) CountByEmployee(ctx context.Context, tenantID, employeeID uuid.UUID, startDate, endDate time.Time) (int, error) {
 	return s.repo.CountByEmployee(ctx, tenantID, employeeID, startDate, endDate)
 }
+
+// GetPolicy 查询租户补卡政策（未配置时返回默认政策）
+func (s *punchCardSupplementService) GetPolicy(ctx context.Context, tenantID uuid.UUID) (*model.PunchCardSupplementPolicy, error) {
+	policy, err := s.policyRepo.FindByTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil {
+		policy = model.DefaultPunchCardSupplementPolicy()
+		policy.TenantID = tenantID
+	}
+	return policy, nil
+}
+
+// SavePolicy 保存租户补卡政策
+func (s *punchCardSupplementService) SavePolicy(ctx context.Context, policy *model.PunchCardSupplementPolicy) error {
+	if policy.MaxPerMonth < 0 || policy.FilingDeadlineDays < 0 {
+		return fmt.Errorf("%w: limits cannot be negative", ErrSupplementPolicyInvalid)
+	}
+	for _, missingType := range policy.AllowedMissingTypes {
+		if !validMissingTypes[missingType] {
+			return fmt.Errorf("%w: unknown missing type %q", ErrSupplementPolicyInvalid, missingType)
+		}
+	}
+
+	now := time.Now()
+	if policy.ID == uuid.Nil {
+		policy.ID = uuid.New()
+		policy.CreatedAt = now
+	}
+	policy.UpdatedAt = now
+	return s.policyRepo.Save(ctx, policy)
+}
@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+)
+
+type stubSupplementRepo struct {
+	repository.PunchCardSupplementRepository
+	supplements map[uuid.UUID]*model.PunchCardSupplement
+	monthCount  int
+}
+
+func (r *stubSupplementRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.PunchCardSupplement, error) {
+	if supplement, ok := r.supplements[id]; ok {
+		copied := *supplement
+		return &copied, nil
+	}
+	return nil, errStubNotFound
+}
+
+func (r *stubSupplementRepo) Update(ctx context.Context, supplement *model.PunchCardSupplement) error {
+	r.supplements[supplement.ID] = supplement
+	return nil
+}
+
+func (r *stubSupplementRepo) CountByEmployee(ctx context.Context, tenantID, employeeID uuid.UUID, startDate, endDate time.Time) (int, error) {
+	return r.monthCount, nil
+}
+
+type stubSupplementPolicyRepo struct {
+	repository.PunchCardSupplementPolicyRepository
+	policy *model.PunchCardSupplementPolicy
+}
+
+func (r *stubSupplementPolicyRepo) FindByTenant(ctx context.Context, tenantID uuid.UUID) (*model.PunchCardSupplementPolicy, error) {
+	return r.policy, nil
+}
+
+type stubSupplementRecordRepo struct {
+	repository.AttendanceRecordRepository
+	records []*model.AttendanceRecord
+	updated []*model.AttendanceRecord
+}
+
+func (r *stubSupplementRecordRepo) FindByEmployee(ctx context.Context, tenantID, employeeID uuid.UUID, startDate, endDate time.Time) ([]*model.AttendanceRecord, error) {
+	var found []*model.AttendanceRecord
+	for _, record := range r.records {
+		if record.EmployeeID == employeeID && !record.ClockTime.Before(startDate) && record.ClockTime.Before(endDate) {
+			found = append(found, record)
+		}
+	}
+	return found, nil
+}
+
+func (r *stubSupplementRecordRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.AttendanceRecord, error) {
+	for _, record := range r.records {
+		if record.ID == id {
+			return record, nil
+		}
+	}
+	return nil, errStubNotFound
+}
+
+func (r *stubSupplementRecordRepo) Update(ctx context.Context, record *model.AttendanceRecord) error {
+	r.updated = append(r.updated, record)
+	return nil
+}
+
+// stubSupplementAttendance 9:00 之后上班打卡算迟到
+type stubSupplementAttendance struct {
+	AttendanceService
+	clockIns []*ClockInRequest
+}
+
+func (s *stubSupplementAttendance) ClockIn(ctx context.Context, req *ClockInRequest) (*model.AttendanceRecord, error) {
+	s.clockIns = append(s.clockIns, req)
+	return &model.AttendanceRecord{ID: uuid.New(), ClockTime: req.ClockTime, ClockType: req.ClockType, ApprovalID: req.ApprovalID}, nil
+}
+
+func (s *stubSupplementAttendance) CalculateStatus(ctx context.Context, record *model.AttendanceRecord) (model.AttendanceStatus, error) {
+	if record.ClockType == model.ClockTypeCheckIn && record.ClockTime.Hour()*60+record.ClockTime.Minute() > 9*60 {
+		return model.AttendanceStatusLate, nil
+	}
+	return model.AttendanceStatusNormal, nil
+}
+
+func TestPunchCardSupplementService_Policy(t *testing.T) {
+	tenantID, employeeID := uuid.New(), uuid.New()
+	today := time.Now()
+	today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.Local)
+
+	newSupplement := func(daysAgo int, missingType model.PunchCardMissingType) *model.PunchCardSupplement {
+		date := today.AddDate(0, 0, -daysAgo)
+		return &model.PunchCardSupplement{
+			TenantID: tenantID, EmployeeID: employeeID,
+			SupplementDate: date, SupplementTime: date.Add(9 * time.Hour),
+			SupplementType: model.SupplementTypeCheckIn, MissingType: missingType, Reason: "忘记打卡",
+		}
+	}
+	newService := func(policy *model.PunchCardSupplementPolicy, monthCount int) *punchCardSupplementService {
+		return NewPunchCardSupplementService(
+			&stubSupplementRepo{supplements: map[uuid.UUID]*model.PunchCardSupplement{}, monthCount: monthCount},
+			&stubSupplementPolicyRepo{policy: policy}, nil, nil, nil,
+		).(*punchCardSupplementService)
+	}
+	ctx := context.Background()
+
+	// 未配置政策：30 天内提交，不限次数
+	svc := newService(nil, 100)
+	assert.NoError(t, svc.ValidateSupplement(ctx, newSupplement(0, model.MissingTypeForgot)))
+	assert.NoError(t, svc.ValidateSupplement(ctx, newSupplement(30, model.MissingTypeForgot)))
+	assert.ErrorIs(t, svc.ValidateSupplement(ctx, newSupplement(31, model.MissingTypeForgot)), ErrSupplementFilingDeadlinePassed)
+
+	policy := &model.PunchCardSupplementPolicy{
+		TenantID: tenantID, MaxPerMonth: 3, FilingDeadlineDays: 5,
+		AllowedMissingTypes: []model.PunchCardMissingType{model.MissingTypeForgot, model.MissingTypeMalfunction},
+	}
+	svc = newService(policy, 2)
+	assert.NoError(t, svc.ValidateSupplement(ctx, newSupplement(5, model.MissingTypeMalfunction)))
+	assert.ErrorIs(t, svc.ValidateSupplement(ctx, newSupplement(6, model.MissingTypeForgot)), ErrSupplementFilingDeadlinePassed)
+	assert.ErrorIs(t, svc.ValidateSupplement(ctx, newSupplement(0, model.MissingTypeOutside)), ErrSupplementMissingTypeNotAllowed)
+
+	svc = newService(policy, 3)
+	assert.ErrorIs(t, svc.ValidateSupplement(ctx, newSupplement(0, model.MissingTypeForgot)), ErrSupplementMonthlyLimitReached)
+
+	// 修改已计入本月次数的申请不受上限影响
+	existing := newSupplement(0, model.MissingTypeForgot)
+	existing.ID = uuid.New()
+	existing.ApprovalStatus = "pending"
+	existing.ProcessStatus = "pending"
+	existing.CreatedAt = today
+	svc.repo.(*stubSupplementRepo).supplements[existing.ID] = existing
+
+	updated := *existing
+	updated.SupplementTime = updated.SupplementDate.Add(8 * time.Hour)
+	require.NoError(t, svc.Update(ctx, &updated))
+
+	updated.MissingType = model.MissingTypeOther
+	assert.ErrorIs(t, svc.Update(ctx, &updated), ErrSupplementMissingTypeNotAllowed)
+
+	// 提交期限按原申请时间计算
+	svc = newService(policy, 0)
+	existing = newSupplement(6, model.MissingTypeForgot)
+	existing.ID = uuid.New()
+	existing.ApprovalStatus = "pending"
+	existing.ProcessStatus = "pending"
+	existing.CreatedAt = existing.SupplementDate.Add(24 * time.Hour)
+	svc.repo.(*stubSupplementRepo).supplements[existing.ID] = existing
+
+	updated = *existing
+	updated.Reason = "忘记打卡，补充说明"
+	assert.NoError(t, svc.Update(ctx, &updated))
+	assert.ErrorIs(t, svc.ValidateSupplement(ctx, &updated), ErrSupplementFilingDeadlinePassed)
+
+	assert.ErrorIs(t, svc.SavePolicy(ctx, &model.PunchCardSupplementPolicy{MaxPerMonth: -1}), ErrSupplementPolicyInvalid)
+	assert.ErrorIs(t, svc.SavePolicy(ctx, &model.PunchCardSupplementPolicy{AllowedMissingTypes: []model.PunchCardMissingType{"typo"}}), ErrSupplementPolicyInvalid)
+}
+
+func TestPunchCardSupplementService_Process(t *testing.T) {
+	tenantID, employeeID, processorID := uuid.New(), uuid.New(), uuid.New()
+	day := time.Date(2025, 9, 1, 0, 0, 0, 0, time.Local)
+
+	approved := func(supplementType model.SupplementType, at time.Time) *model.PunchCardSupplement {
+		return &model.PunchCardSupplement{
+			ID: uuid.New(), TenantID: tenantID, EmployeeID: employeeID,
+			SupplementDate: day, SupplementTime: at, SupplementType: supplementType,
+			MissingType: model.MissingTypeMalfunction, Reason: "考勤机故障",
+			ApprovalStatus: "approved", ProcessStatus: "pending",
+		}
+	}
+	newService := func(records []*model.AttendanceRecord, supplement *model.PunchCardSupplement) (*punchCardSupplementService, *stubSupplementRecordRepo, *stubSupplementAttendance) {
+		recordRepo := &stubSupplementRecordRepo{records: records}
+		attendance := &stubSupplementAttendance{}
+		svc := NewPunchCardSupplementService(
+			&stubSupplementRepo{supplements: map[uuid.UUID]*model.PunchCardSupplement{supplement.ID: supplement}},
+			&stubSupplementPolicyRepo{}, recordRepo, attendance, nil,
+		).(*punchCardSupplementService)
+		return svc, recordRepo, attendance
+	}
+	ctx := context.Background()
+
+	t.Run("corrects existing late check-in", func(t *testing.T) {
+		late := &model.AttendanceRecord{
+			ID: uuid.New(), TenantID: tenantID, EmployeeID: employeeID,
+			ClockTime: day.Add(9*time.Hour + 40*time.Minute), ClockType: model.ClockTypeCheckIn,
+			Status: model.AttendanceStatusLate, IsException: true, ExceptionType: "late", ExceptionReason: "迟到",
+		}
+		checkOut := &model.AttendanceRecord{
+			ID: uuid.New(), TenantID: tenantID, EmployeeID: employeeID,
+			ClockTime: day.Add(18 * time.Hour), ClockType: model.ClockTypeCheckOut, Status: model.AttendanceStatusNormal,
+		}
+		supplement := approved(model.SupplementTypeCheckIn, day.Add(8*time.Hour+55*time.Minute))
+		svc, recordRepo, attendance := newService([]*model.AttendanceRecord{late, checkOut}, supplement)
+
+		require.NoError(t, svc.Process(ctx, supplement.ID, processorID))
+		assert.Empty(t, attendance.clockIns)
+		require.Len(t, recordRepo.updated, 1)
+
+		record := recordRepo.updated[0]
+		assert.Equal(t, late.ID, record.ID)
+		assert.Equal(t, supplement.SupplementTime, record.ClockTime)
+		assert.Equal(t, model.AttendanceStatusNormal, record.Status)
+		assert.False(t, record.IsException)
+		assert.Empty(t, record.ExceptionReason)
+		assert.Equal(t, &supplement.ID, record.ApprovalID)
+
+		corrections := record.RawData["supplement_corrections"].([]interface{})
+		require.Len(t, corrections, 1)
+		correction := corrections[0].(map[string]interface{})
+		assert.Equal(t, supplement.ID.String(), correction["supplement_id"])
+		assert.Equal(t, "late", correction["previous_status"])
+
+		processed, err := svc.GetByID(ctx, supplement.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "processed", processed.ProcessStatus)
+		assert.Equal(t, &late.ID, processed.AttendanceRecordID)
+		assert.Equal(t, &processorID, processed.ProcessedBy)
+	})
+
+	t.Run("creates missing check-out", func(t *testing.T) {
+		checkIn := &model.AttendanceRecord{
+			ID: uuid.New(), TenantID: tenantID, EmployeeID: employeeID,
+			ClockTime: day.Add(9 * time.Hour), ClockType: model.ClockTypeCheckIn,
+		}
+		supplement := approved(model.SupplementTypeCheckOut, day.Add(18*time.Hour))
+		svc, recordRepo, attendance := newService([]*model.AttendanceRecord{checkIn}, supplement)
+
+		require.NoError(t, svc.Process(ctx, supplement.ID, processorID))
+		assert.Empty(t, recordRepo.updated)
+		require.Len(t, attendance.clockIns, 1)
+
+		req := attendance.clockIns[0]
+		assert.Equal(t, model.ClockTypeCheckOut, req.ClockType)
+		assert.Equal(t, model.SourceTypeManual, req.SourceType)
+		assert.Equal(t, supplement.ID.String(), req.SourceID)
+		assert.Equal(t, &supplement.ID, req.ApprovalID)
+
+		processed, err := svc.GetByID(ctx, supplement.ID)
+		require.NoError(t, err)
+		require.NotNil(t, processed.AttendanceRecordID)
+		assert.NotEqual(t, checkIn.ID, *processed.AttendanceRecordID)
+	})
+
+	t.Run("not approved", func(t *testing.T) {
+		supplement := approved(model.SupplementTypeCheckIn, day.Add(9*time.Hour))
+		supplement.ApprovalStatus = "pending"
+		svc, _, attendance := newService(nil, supplement)
+
+		assert.Error(t, svc.Process(ctx, supplement.ID, processorID))
+		assert.Empty(t, attendance.clockIns)
+	})
+}
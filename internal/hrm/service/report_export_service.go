@@ -0,0 +1,496 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	fileModel "github.com/lk2023060901/go-next-erp/internal/file/model"
+	fileService "github.com/lk2023060901/go-next-erp/internal/file/service"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	orgModel "github.com/lk2023060901/go-next-erp/internal/organization/model"
+	orgRepository "github.com/lk2023060901/go-next-erp/internal/organization/repository"
+)
+
+const (
+	// reportExportCron 恢复服务重启后中断的导出任务
+	reportExportCron = "*/5 * * * *"
+
+	// syncExportMaxEmployees 不超过该人数的报表在请求内同步生成，超过时转后台任务
+	syncExportMaxEmployees = 50
+
+	// maxRunningExports 同时生成的报表数
+	maxRunningExports = 2
+
+	// staleExportAfter 未结束任务超过该时长未更新视为已中断
+	staleExportAfter = 30 * time.Minute
+
+	// exportFileRetention 导出文件保留时长（到期由文件模块清理）
+	exportFileRetention = 7 * 24 * time.Hour
+
+	exportDownloadExpiry = 24 * time.Hour
+	exportFileCategory   = "hrm_report"
+	exportOvertimePage   = 500
+)
+
+var (
+	ErrReportExportNotFound     = errors.New("report export not found")
+	ErrReportExportInvalid      = errors.New("invalid report export request")
+	ErrReportColumnUnknown      = errors.New("unknown report column")
+	ErrReportDepartmentNotFound = errors.New("report department not found")
+)
+
+// ReportExportService 考勤/假期报表导出服务：渲染 XLSX/CSV，结果存入文件模块并返回下载地址
+type ReportExportService interface {
+	// Create 创建导出任务：小报表同步生成并直接返回下载地址，大报表返回排队中的任务
+	Create(ctx context.Context, req *ReportExportRequest) (*model.ReportExport, error)
+
+	// Get 查询任务，已完成时生成下载地址
+	Get(ctx context.Context, tenantID, id, userID uuid.UUID) (*model.ReportExport, error)
+
+	// List 列表查询
+	List(ctx context.Context, tenantID uuid.UUID, offset, limit int) ([]*model.ReportExport, int, error)
+
+	// Columns 报表可选列（按语言返回表头）
+	Columns(reportType model.ReportType, locale string) ([]*ReportColumn, error)
+
+	// RunDue 定时任务：重新执行中断的导出任务，返回处理数量
+	RunDue(ctx context.Context) (int, error)
+
+	// CronSpec 定时任务的 Cron 表达式
+	CronSpec() string
+}
+
+// ReportExportRequest 报表导出请求
+type ReportExportRequest struct {
+	TenantID     uuid.UUID
+	RequestedBy  uuid.UUID
+	ReportType   model.ReportType
+	Format       model.ExportFormat
+	Locale       string
+	Columns      []string
+	DepartmentID *uuid.UUID
+	Year         int
+	Month        int
+}
+
+// ReportColumn 报表可选列
+type ReportColumn struct {
+	Key   string `json:"key"`
+	Title string `json:"title"`
+}
+
+type reportExportService struct {
+	exportRepo      repository.ReportExportRepository
+	summaryRepo     repository.AttendanceSummaryRepository
+	leaveQuotaRepo  repository.LeaveQuotaRepository
+	overtimeRepo    repository.OvertimeRepository
+	summaryService  AttendanceSummaryService
+	orgRepo         orgRepository.OrganizationRepository
+	orgEmpRepo      orgRepository.EmployeeRepository
+	uploadService   fileService.UploadService
+	downloadService fileService.DownloadService
+	slots           chan struct{}
+	async           func(func())
+	now             func() time.Time
+}
+
+// NewReportExportService 创建报表导出服务
+func NewReportExportService(
+	exportRepo repository.ReportExportRepository,
+	summaryRepo repository.AttendanceSummaryRepository,
+	leaveQuotaRepo repository.LeaveQuotaRepository,
+	overtimeRepo repository.OvertimeRepository,
+	summaryService AttendanceSummaryService,
+	orgRepo orgRepository.OrganizationRepository,
+	orgEmpRepo orgRepository.EmployeeRepository,
+	uploadService fileService.UploadService,
+	downloadService fileService.DownloadService,
+) ReportExportService {
+	return &reportExportService{
+		exportRepo:      exportRepo,
+		summaryRepo:     summaryRepo,
+		leaveQuotaRepo:  leaveQuotaRepo,
+		overtimeRepo:    overtimeRepo,
+		summaryService:  summaryService,
+		orgRepo:         orgRepo,
+		orgEmpRepo:      orgEmpRepo,
+		uploadService:   uploadService,
+		downloadService: downloadService,
+		slots:           make(chan struct{}, maxRunningExports),
+		async:           func(fn func()) { go fn() },
+		now:             time.Now,
+	}
+}
+
+func (s *reportExportService) CronSpec() string {
+	return reportExportCron
+}
+
+func (s *reportExportService) Create(ctx context.Context, req *ReportExportRequest) (*model.ReportExport, error) {
+	if req.Format == "" {
+		req.Format = model.ExportFormatXLSX
+	}
+	if req.Locale != model.ReportLocaleEN {
+		req.Locale = model.ReportLocaleZH
+	}
+	if !req.ReportType.IsValid() || !req.Format.IsValid() || req.Year < 2000 || req.Month < 0 || req.Month > 12 {
+		return nil, ErrReportExportInvalid
+	}
+	if req.ReportType == model.ReportAttendanceMonthly && req.Month == 0 {
+		return nil, ErrReportExportInvalid
+	}
+	// 提前校验列名，避免排队后才失败
+	if err := validateReportColumns(req.ReportType, req.Columns); err != nil {
+		return nil, err
+	}
+
+	scope, err := s.loadScope(ctx, req.TenantID, req.DepartmentID, req.ReportType, req.Year, req.Month)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	export := &model.ReportExport{
+		ID:           uuid.Must(uuid.NewV7()),
+		TenantID:     req.TenantID,
+		ReportType:   req.ReportType,
+		Format:       req.Format,
+		Locale:       req.Locale,
+		Columns:      req.Columns,
+		DepartmentID: req.DepartmentID,
+		Year:         req.Year,
+		Month:        req.Month,
+		Status:       model.ReportExportPending,
+		RequestedBy:  req.RequestedBy,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if err := s.exportRepo.Create(ctx, export); err != nil {
+		return nil, fmt.Errorf("failed to create report export: %w", err)
+	}
+
+	if len(scope.employees) > syncExportMaxEmployees {
+		snapshot := *export
+		s.async(func() {
+			s.run(context.Background(), export, nil)
+		})
+		return &snapshot, nil
+	}
+
+	s.run(ctx, export, scope)
+	s.fillDownloadURL(ctx, export, req.RequestedBy)
+	return export, nil
+}
+
+func (s *reportExportService) Get(ctx context.Context, tenantID, id, userID uuid.UUID) (*model.ReportExport, error) {
+	export, err := s.exportRepo.FindByID(ctx, tenantID, id)
+	if err != nil {
+		return nil, ErrReportExportNotFound
+	}
+	s.fillDownloadURL(ctx, export, userID)
+	return export, nil
+}
+
+func (s *reportExportService) List(ctx context.Context, tenantID uuid.UUID, offset, limit int) ([]*model.ReportExport, int, error) {
+	return s.exportRepo.List(ctx, tenantID, offset, limit)
+}
+
+func (s *reportExportService) Columns(reportType model.ReportType, locale string) ([]*ReportColumn, error) {
+	return reportColumnCatalog(reportType, locale)
+}
+
+// reportColumnCatalog 报表的列目录（逐日状态列组与月份无关，任取一个整月展开）
+func reportColumnCatalog(reportType model.ReportType, locale string) ([]*ReportColumn, error) {
+	switch reportType {
+	case model.ReportAttendanceMonthly:
+		return columnCatalog(attendanceColumns(2000, 1, locale), locale), nil
+	case model.ReportLeaveBalance:
+		return columnCatalog(leaveBalanceColumns(), locale), nil
+	case model.ReportOvertimeLedger:
+		return columnCatalog(overtimeLedgerColumns(locale), locale), nil
+	}
+	return nil, ErrReportExportInvalid
+}
+
+func (s *reportExportService) RunDue(ctx context.Context) (int, error) {
+	exports, err := s.exportRepo.ListStale(ctx, s.now().Add(-staleExportAfter), maxRunningExports*10)
+	if err != nil {
+		return 0, err
+	}
+	for _, export := range exports {
+		s.run(ctx, export, nil)
+	}
+	return len(exports), nil
+}
+
+// validateReportColumns 校验选择的列是否属于该报表
+func validateReportColumns(reportType model.ReportType, columns []string) error {
+	known, err := reportColumnCatalog(reportType, model.ReportLocaleZH)
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[string]bool, len(known))
+	for _, col := range known {
+		keys[col.Key] = true
+	}
+	for _, key := range columns {
+		if !keys[key] {
+			return fmt.Errorf("%w: %s", ErrReportColumnUnknown, key)
+		}
+	}
+	return nil
+}
+
+// run 生成报表并上传，结果写回任务；scope 为空时重新加载
+func (s *reportExportService) run(ctx context.Context, export *model.ReportExport, scope *reportScope) {
+	s.slots <- struct{}{}
+	defer func() { <-s.slots }()
+
+	startedAt := s.now()
+	export.Status = model.ReportExportRunning
+	export.StartedAt = &startedAt
+	export.UpdatedAt = startedAt
+	if err := s.exportRepo.Update(ctx, export); err != nil {
+		s.finish(ctx, export, err)
+		return
+	}
+
+	s.finish(ctx, export, s.generate(ctx, export, scope))
+}
+
+func (s *reportExportService) finish(ctx context.Context, export *model.ReportExport, runErr error) {
+	finishedAt := s.now()
+	export.FinishedAt = &finishedAt
+	export.UpdatedAt = finishedAt
+	export.Status = model.ReportExportCompleted
+	export.Error = ""
+	if runErr != nil {
+		export.Status = model.ReportExportFailed
+		export.Error = runErr.Error()
+	}
+	_ = s.exportRepo.Update(ctx, export)
+}
+
+func (s *reportExportService) generate(ctx context.Context, export *model.ReportExport, scope *reportScope) error {
+	if scope == nil {
+		var err error
+		if scope, err = s.loadScope(ctx, export.TenantID, export.DepartmentID, export.ReportType, export.Year, export.Month); err != nil {
+			return err
+		}
+	}
+
+	title := reportTitles[export.ReportType].in(export.Locale)
+	var table *reportTable
+	var err error
+	switch export.ReportType {
+	case model.ReportAttendanceMonthly:
+		table, err = s.attendanceTable(ctx, export, scope, title)
+	case model.ReportLeaveBalance:
+		table, err = s.leaveBalanceTable(ctx, export, scope, title)
+	case model.ReportOvertimeLedger:
+		table, err = s.overtimeLedgerTable(ctx, export, scope, title)
+	default:
+		err = ErrReportExportInvalid
+	}
+	if err != nil {
+		return err
+	}
+
+	content, err := renderReport(export.Format, table)
+	if err != nil {
+		return fmt.Errorf("failed to render report: %w", err)
+	}
+
+	period := fmt.Sprintf("%d", export.Year)
+	if export.Month > 0 {
+		period = fmt.Sprintf("%d-%02d", export.Year, export.Month)
+	}
+	category := exportFileCategory
+	expiresAt := s.now().Add(exportFileRetention)
+
+	file, err := s.uploadService.Upload(ctx, &fileService.UploadRequest{
+		TenantID:    export.TenantID,
+		UploadedBy:  export.RequestedBy,
+		Filename:    fmt.Sprintf("%s-%s.%s", title, period, export.Format),
+		Reader:      bytes.NewReader(content),
+		Size:        int64(len(content)),
+		ContentType: export.Format.ContentType(),
+		IsTemporary: true,
+		ExpiresAt:   &expiresAt,
+		Category:    &category,
+		Tags:        []string{"hrm", "report", string(export.ReportType)},
+		Metadata: map[string]interface{}{
+			"report_export_id": export.ID.String(),
+			"report_type":      string(export.ReportType),
+			"period":           period,
+		},
+		AccessLevel: fileModel.AccessLevelTenant,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store report: %w", err)
+	}
+
+	export.FileID = &file.ID
+	export.Filename = file.Filename
+	export.RowCount = len(table.rows)
+	return nil
+}
+
+func (s *reportExportService) fillDownloadURL(ctx context.Context, export *model.ReportExport, userID uuid.UUID) {
+	if export.Status != model.ReportExportCompleted || export.FileID == nil || s.downloadService == nil {
+		return
+	}
+	if url, err := s.downloadService.GetDownloadURL(ctx, *export.FileID, userID, export.TenantID, exportDownloadExpiry); err == nil {
+		export.DownloadURL = url
+	}
+}
+
+// reportScope 报表覆盖的员工（按工号排序）及其部门名称
+type reportScope struct {
+	employees   []*orgModel.Employee
+	departments map[uuid.UUID]string
+}
+
+func (sc *reportScope) department(emp *orgModel.Employee) string {
+	return sc.departments[emp.OrgID]
+}
+
+// loadScope 加载部门（含下级部门）或全公司的员工：在职员工全部导出，
+// 已离职员工仅在月度考勤表当月有汇总时导出
+func (s *reportExportService) loadScope(ctx context.Context, tenantID uuid.UUID, departmentID *uuid.UUID, reportType model.ReportType, year, month int) (*reportScope, error) {
+	orgs, err := s.orgRepo.List(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load organizations: %w", err)
+	}
+	scope := &reportScope{departments: make(map[uuid.UUID]string, len(orgs))}
+	for _, org := range orgs {
+		scope.departments[org.ID] = org.Name
+	}
+	if departmentID != nil {
+		if _, ok := scope.departments[*departmentID]; !ok {
+			return nil, ErrReportDepartmentNotFound
+		}
+	}
+
+	employees, err := s.orgEmpRepo.List(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load employees: %w", err)
+	}
+
+	summarized := make(map[uuid.UUID]bool)
+	if reportType == model.ReportAttendanceMonthly {
+		summaries, _, err := s.summaryRepo.List(ctx, tenantID, &repository.AttendanceSummaryFilter{Year: &year, Month: &month}, 0, 100000)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load attendance summaries: %w", err)
+		}
+		for _, summary := range summaries {
+			summarized[summary.EmployeeID] = true
+		}
+	}
+
+	for _, emp := range employees {
+		if departmentID != nil && emp.OrgID != *departmentID && !strings.Contains(emp.OrgPath, "/"+departmentID.String()+"/") {
+			continue
+		}
+		if emp.Status == "resigned" && !summarized[emp.ID] {
+			continue
+		}
+		scope.employees = append(scope.employees, emp)
+	}
+	sort.SliceStable(scope.employees, func(i, j int) bool {
+		return scope.employees[i].EmployeeNo < scope.employees[j].EmployeeNo
+	})
+	return scope, nil
+}
+
+func (s *reportExportService) attendanceTable(ctx context.Context, export *model.ReportExport, scope *reportScope, title string) (*reportTable, error) {
+	rows := make([]*attendanceSheetRow, 0, len(scope.employees))
+	for _, emp := range scope.employees {
+		sheet, err := s.summaryService.MonthSheet(ctx, export.TenantID, emp.ID, export.Year, export.Month)
+		if err != nil {
+			return nil, fmt.Errorf("employee %s: %w", emp.EmployeeNo, err)
+		}
+		rows = append(rows, &attendanceSheetRow{employee: emp, department: scope.department(emp), sheet: sheet})
+	}
+	return buildReportTable(title, attendanceColumns(export.Year, export.Month, export.Locale), export.Columns, export.Locale, rows)
+}
+
+func (s *reportExportService) leaveBalanceTable(ctx context.Context, export *model.ReportExport, scope *reportScope, title string) (*reportTable, error) {
+	var rows []*leaveBalanceRow
+	for _, emp := range scope.employees {
+		quotas, err := s.leaveQuotaRepo.ListByEmployeeWithType(ctx, export.TenantID, emp.ID, export.Year)
+		if err != nil {
+			return nil, fmt.Errorf("employee %s: %w", emp.EmployeeNo, err)
+		}
+		sort.SliceStable(quotas, func(i, j int) bool {
+			return leaveTypeName(quotas[i]) < leaveTypeName(quotas[j])
+		})
+		for _, quota := range quotas {
+			rows = append(rows, &leaveBalanceRow{employee: emp, department: scope.department(emp), quota: quota})
+		}
+	}
+	return buildReportTable(title, leaveBalanceColumns(), export.Columns, export.Locale, rows)
+}
+
+func leaveTypeName(quota *model.LeaveQuotaWithType) string {
+	if quota.LeaveType == nil {
+		return ""
+	}
+	return quota.LeaveType.Name
+}
+
+// overtimeLedgerTable 已批准加班按员工、开始时间排序；未指定月份时导出全年
+func (s *reportExportService) overtimeLedgerTable(ctx context.Context, export *model.ReportExport, scope *reportScope, title string) (*reportTable, error) {
+	start := time.Date(export.Year, 1, 1, 0, 0, 0, 0, time.Local)
+	end := start.AddDate(1, 0, 0)
+	if export.Month > 0 {
+		start, _ = model.MonthRange(export.Year, export.Month, time.Local)
+		end = start.AddDate(0, 1, 0)
+	}
+	// 结束时间放宽一天，跨零点的加班按开始时间归属
+	endBound := end.AddDate(0, 0, 1)
+	approved := "approved"
+
+	employees := make(map[uuid.UUID]*orgModel.Employee, len(scope.employees))
+	for _, emp := range scope.employees {
+		employees[emp.ID] = emp
+	}
+
+	var rows []*overtimeLedgerRow
+	for offset := 0; ; offset += exportOvertimePage {
+		overtimes, total, err := s.overtimeRepo.List(ctx, export.TenantID, &repository.OvertimeFilter{
+			ApprovalStatus: &approved,
+			StartDate:      &start,
+			EndDate:        &endBound,
+		}, offset, exportOvertimePage)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load overtimes: %w", err)
+		}
+		for _, overtime := range overtimes {
+			emp, ok := employees[overtime.EmployeeID]
+			if !ok || !overtime.StartTime.Before(end) {
+				continue
+			}
+			rows = append(rows, &overtimeLedgerRow{employee: emp, department: scope.department(emp), overtime: overtime})
+		}
+		if len(overtimes) < exportOvertimePage || offset+exportOvertimePage >= total {
+			break
+		}
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].employee.EmployeeNo != rows[j].employee.EmployeeNo {
+			return rows[i].employee.EmployeeNo < rows[j].employee.EmployeeNo
+		}
+		return rows[i].overtime.StartTime.Before(rows[j].overtime.StartTime)
+	})
+	return buildReportTable(title, overtimeLedgerColumns(export.Locale), export.Columns, export.Locale, rows)
+}
@@ -0,0 +1,257 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	fileModel "github.com/lk2023060901/go-next-erp/internal/file/model"
+	fileService "github.com/lk2023060901/go-next-erp/internal/file/service"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	orgModel "github.com/lk2023060901/go-next-erp/internal/organization/model"
+	orgRepository "github.com/lk2023060901/go-next-erp/internal/organization/repository"
+)
+
+type stubReportExportRepo struct {
+	repository.ReportExportRepository
+	exports  map[uuid.UUID]*model.ReportExport
+	statuses []model.ReportExportStatus
+}
+
+func (r *stubReportExportRepo) Create(ctx context.Context, export *model.ReportExport) error {
+	r.exports[export.ID] = export
+	return nil
+}
+
+func (r *stubReportExportRepo) Update(ctx context.Context, export *model.ReportExport) error {
+	r.statuses = append(r.statuses, export.Status)
+	return nil
+}
+
+type stubReportSummaryRepo struct {
+	repository.AttendanceSummaryRepository
+	summaries []*model.AttendanceSummary
+}
+
+func (r *stubReportSummaryRepo) List(ctx context.Context, tenantID uuid.UUID, filter *repository.AttendanceSummaryFilter, offset, limit int) ([]*model.AttendanceSummary, int, error) {
+	return r.summaries, len(r.summaries), nil
+}
+
+type stubReportOrgRepo struct {
+	orgRepository.OrganizationRepository
+	orgs []*orgModel.Organization
+}
+
+func (r *stubReportOrgRepo) List(ctx context.Context, tenantID uuid.UUID) ([]*orgModel.Organization, error) {
+	return r.orgs, nil
+}
+
+type stubReportEmpRepo struct {
+	orgRepository.EmployeeRepository
+	employees []*orgModel.Employee
+}
+
+func (r *stubReportEmpRepo) List(ctx context.Context, tenantID uuid.UUID) ([]*orgModel.Employee, error) {
+	return r.employees, nil
+}
+
+type stubReportSheets struct {
+	AttendanceSummaryService
+}
+
+func (s *stubReportSheets) MonthSheet(ctx context.Context, tenantID, employeeID uuid.UUID, year, month int) (*model.AttendanceMonthSheet, error) {
+	start, end := model.MonthRange(year, month, time.Local)
+	sheet := &model.AttendanceMonthSheet{Summary: &model.AttendanceSummary{WorkDays: 22, ActualDays: 21, LeaveDays: 1.5}}
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		code := model.AttendanceDayNormal
+		if d.Day() == 2 {
+			code = model.AttendanceDayLate
+		}
+		sheet.Days = append(sheet.Days, &model.AttendanceDayStatus{Date: d, Code: code})
+	}
+	return sheet, nil
+}
+
+type stubReportUploads struct {
+	fileService.UploadService
+	req     *fileService.UploadRequest
+	content string
+}
+
+func (s *stubReportUploads) Upload(ctx context.Context, req *fileService.UploadRequest) (*fileModel.File, error) {
+	content, err := io.ReadAll(req.Reader)
+	if err != nil {
+		return nil, err
+	}
+	s.req, s.content = req, string(content)
+	return &fileModel.File{ID: uuid.New(), Filename: req.Filename, Size: req.Size}, nil
+}
+
+type stubReportDownloads struct {
+	fileService.DownloadService
+}
+
+func (s *stubReportDownloads) GetDownloadURL(ctx context.Context, fileID, userID, tenantID uuid.UUID, expiry time.Duration) (string, error) {
+	return "https://files.example.com/" + fileID.String(), nil
+}
+
+func TestReportExportService_Create(t *testing.T) {
+	tenantID, userID := uuid.New(), uuid.New()
+	company, sales, east := uuid.New(), uuid.New(), uuid.New()
+	orgs := []*orgModel.Organization{
+		{ID: company, Name: "总公司"},
+		{ID: sales, Name: "销售部"},
+		{ID: east, Name: "华东销售组"},
+	}
+	employee := func(no, name string, orgID uuid.UUID, path, status string) *orgModel.Employee {
+		return &orgModel.Employee{ID: uuid.New(), EmployeeNo: no, Name: name, OrgID: orgID, OrgPath: path, Status: status}
+	}
+	salesPath := fmt.Sprintf("/%s/%s/", company, sales)
+	leaver := employee("E004", "赵六", sales, salesPath, "resigned")
+	employees := []*orgModel.Employee{
+		employee("E003", "王五", east, fmt.Sprintf("%s%s/", salesPath, east), "active"),
+		employee("E001", "张三", sales, salesPath, "active"),
+		employee("E002", "李四", company, fmt.Sprintf("/%s/", company), "active"),
+		leaver,
+		employee("E005", "孙七", sales, salesPath, "resigned"),
+	}
+
+	newService := func(employees []*orgModel.Employee) (*reportExportService, *stubReportExportRepo, *stubReportUploads, *[]func()) {
+		repo := &stubReportExportRepo{exports: make(map[uuid.UUID]*model.ReportExport)}
+		uploads := &stubReportUploads{}
+		svc := NewReportExportService(
+			repo,
+			&stubReportSummaryRepo{summaries: []*model.AttendanceSummary{{EmployeeID: leaver.ID}}},
+			nil, nil,
+			&stubReportSheets{},
+			&stubReportOrgRepo{orgs: orgs},
+			&stubReportEmpRepo{employees: employees},
+			uploads,
+			&stubReportDownloads{},
+		).(*reportExportService)
+		var queued []func()
+		svc.async = func(fn func()) { queued = append(queued, fn) }
+		return svc, repo, uploads, &queued
+	}
+
+	t.Run("department csv with selected columns", func(t *testing.T) {
+		svc, repo, uploads, _ := newService(employees)
+
+		export, err := svc.Create(context.Background(), &ReportExportRequest{
+			TenantID:     tenantID,
+			RequestedBy:  userID,
+			ReportType:   model.ReportAttendanceMonthly,
+			Format:       model.ExportFormatCSV,
+			Columns:      []string{"employee_name", "department", "daily", "leave_days"},
+			DepartmentID: &sales,
+			Year:         2025,
+			Month:        9,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, model.ReportExportCompleted, export.Status)
+		assert.Equal(t, []model.ReportExportStatus{model.ReportExportRunning, model.ReportExportCompleted}, repo.statuses)
+		assert.Equal(t, 3, export.RowCount)
+		assert.Equal(t, "月度考勤表-2025-09.csv", export.Filename)
+		assert.Equal(t, "https://files.example.com/"+export.FileID.String(), export.DownloadURL)
+		assert.True(t, uploads.req.IsTemporary)
+		assert.Equal(t, "text/csv", uploads.req.ContentType)
+
+		lines := strings.Split(strings.TrimSpace(uploads.content), "\n")
+		require.Len(t, lines, 4)
+		assert.True(t, strings.HasPrefix(lines[0], "\uFEFF姓名,部门,1日,2日,"))
+		assert.True(t, strings.HasSuffix(lines[0], ",30日,请假天数"))
+		// 下级部门员工包含在内；已离职员工仅在当月有汇总时导出；按工号排序
+		assert.True(t, strings.HasPrefix(lines[1], "张三,销售部,√,迟,√,"))
+		assert.True(t, strings.HasPrefix(lines[2], "王五,华东销售组,"))
+		assert.True(t, strings.HasPrefix(lines[3], "赵六,销售部,"))
+		assert.True(t, strings.HasSuffix(lines[3], ",1.5"))
+	})
+
+	t.Run("english headers", func(t *testing.T) {
+		svc, _, uploads, _ := newService(employees)
+
+		_, err := svc.Create(context.Background(), &ReportExportRequest{
+			TenantID:    tenantID,
+			RequestedBy: userID,
+			ReportType:  model.ReportAttendanceMonthly,
+			Format:      model.ExportFormatCSV,
+			Locale:      model.ReportLocaleEN,
+			Columns:     []string{"employee_no", "work_days"},
+			Year:        2025,
+			Month:       9,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Monthly Attendance-2025-09.csv", uploads.req.Filename)
+		assert.True(t, strings.HasPrefix(uploads.content, "\uFEFFEmployee No.,Scheduled Days\nE001,22\n"))
+	})
+
+	t.Run("large exports run in background", func(t *testing.T) {
+		var many []*orgModel.Employee
+		for i := 0; i <= syncExportMaxEmployees; i++ {
+			many = append(many, employee(fmt.Sprintf("E%03d", i), "员工", company, fmt.Sprintf("/%s/", company), "active"))
+		}
+		svc, repo, uploads, queued := newService(many)
+
+		export, err := svc.Create(context.Background(), &ReportExportRequest{
+			TenantID:    tenantID,
+			RequestedBy: userID,
+			ReportType:  model.ReportAttendanceMonthly,
+			Year:        2025,
+			Month:       9,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, model.ReportExportPending, export.Status)
+		assert.Equal(t, model.ExportFormatXLSX, export.Format)
+		assert.Empty(t, export.DownloadURL)
+		require.Len(t, *queued, 1)
+
+		(*queued)[0]()
+		stored := repo.exports[export.ID]
+		assert.Equal(t, model.ReportExportCompleted, stored.Status)
+		assert.Equal(t, syncExportMaxEmployees+1, stored.RowCount)
+		assert.Equal(t, model.ExportFormatXLSX.ContentType(), uploads.req.ContentType)
+		assert.True(t, strings.HasPrefix(uploads.content, "PK"))
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		svc, _, _, _ := newService(employees)
+
+		_, err := svc.Create(context.Background(), &ReportExportRequest{
+			TenantID: tenantID, ReportType: model.ReportLeaveBalance, Year: 2025, Columns: []string{"daily"},
+		})
+		assert.ErrorIs(t, err, ErrReportColumnUnknown)
+
+		_, err = svc.Create(context.Background(), &ReportExportRequest{
+			TenantID: tenantID, ReportType: model.ReportAttendanceMonthly, Year: 2025,
+		})
+		assert.ErrorIs(t, err, ErrReportExportInvalid)
+
+		unknown := uuid.New()
+		_, err = svc.Create(context.Background(), &ReportExportRequest{
+			TenantID: tenantID, ReportType: model.ReportOvertimeLedger, Year: 2025, DepartmentID: &unknown,
+		})
+		assert.ErrorIs(t, err, ErrReportDepartmentNotFound)
+	})
+}
+
+func TestReportExportService_Columns(t *testing.T) {
+	svc := &reportExportService{}
+
+	columns, err := svc.Columns(model.ReportAttendanceMonthly, model.ReportLocaleEN)
+	require.NoError(t, err)
+	require.Greater(t, len(columns), 4)
+	// 逐日状态按列组只出现一次
+	assert.Equal(t, &ReportColumn{Key: "daily", Title: "Daily status"}, columns[3])
+
+	_, err = svc.Columns("payroll", model.ReportLocaleZH)
+	assert.ErrorIs(t, err, ErrReportExportInvalid)
+}
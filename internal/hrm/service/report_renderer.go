@@ -0,0 +1,311 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	orgModel "github.com/lk2023060901/go-next-erp/internal/organization/model"
+	"github.com/lk2023060901/go-next-erp/pkg/xlsx"
+)
+
+// localized 中英文文案
+type localized struct {
+	zh string
+	en string
+}
+
+func (l localized) in(locale string) string {
+	if locale == model.ReportLocaleEN {
+		return l.en
+	}
+	return l.zh
+}
+
+// reportTitles 报表名称（工作表名、文件名）
+var reportTitles = map[model.ReportType]localized{
+	model.ReportAttendanceMonthly: {"月度考勤表", "Monthly Attendance"},
+	model.ReportLeaveBalance:      {"假期余额表", "Leave Balances"},
+	model.ReportOvertimeLedger:    {"加班台账", "Overtime Ledger"},
+}
+
+// attendanceDayLabels 逐日状态在表格中的代码
+var attendanceDayLabels = map[model.AttendanceDayCode]localized{
+	model.AttendanceDayNormal:    {"√", "P"},
+	model.AttendanceDayLate:      {"迟", "L"},
+	model.AttendanceDayEarly:     {"早", "E"},
+	model.AttendanceDayLateEarly: {"迟早", "LE"},
+	model.AttendanceDayMissing:   {"缺", "M"},
+	model.AttendanceDayAbsent:    {"旷", "A"},
+	model.AttendanceDayLeave:     {"假", "LV"},
+	model.AttendanceDayTrip:      {"差", "T"},
+	model.AttendanceDayOvertime:  {"加", "OT"},
+	model.AttendanceDayRest:      {"休", "R"},
+	model.AttendanceDayHoliday:   {"节", "H"},
+	model.AttendanceDayPending:   {"", ""},
+}
+
+var overtimeTypeLabels = map[model.OvertimeType]localized{
+	model.OvertimeTypeWorkday: {"工作日", "Workday"},
+	model.OvertimeTypeWeekend: {"休息日", "Rest day"},
+	model.OvertimeTypeHoliday: {"节假日", "Holiday"},
+}
+
+var overtimePayTypeLabels = map[string]localized{
+	"money": {"加班费", "Pay"},
+	"leave": {"调休", "Comp-off"},
+}
+
+// reportColumnGroups 展开为多列的列组在列目录中的名称
+var reportColumnGroups = map[string]localized{
+	"daily": {"逐日状态", "Daily status"},
+}
+
+// reportColumn 报表列：同一 key 可对应多列（如逐日状态按天展开），选择时整组导出
+type reportColumn[T any] struct {
+	key   string
+	title localized
+	value func(T) interface{}
+}
+
+// reportTable 渲染前的表格数据
+type reportTable struct {
+	title   string
+	headers []string
+	rows    [][]interface{}
+}
+
+// buildReportTable 按选择的列（为空时全部列）生成表格，列顺序与选择顺序一致
+func buildReportTable[T any](title string, columns []reportColumn[T], selected []string, locale string, items []T) (*reportTable, error) {
+	picked := columns
+	if len(selected) > 0 {
+		picked = nil
+		seen := make(map[string]bool, len(selected))
+		for _, key := range selected {
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			found := false
+			for _, col := range columns {
+				if col.key == key {
+					picked = append(picked, col)
+					found = true
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("%w: %s", ErrReportColumnUnknown, key)
+			}
+		}
+	}
+
+	table := &reportTable{title: title, headers: make([]string, len(picked))}
+	for i, col := range picked {
+		table.headers[i] = col.title.in(locale)
+	}
+	for _, item := range items {
+		row := make([]interface{}, len(picked))
+		for i, col := range picked {
+			row[i] = col.value(item)
+		}
+		table.rows = append(table.rows, row)
+	}
+	return table, nil
+}
+
+// columnCatalog 列目录（按 key 去重，列组使用组名）
+func columnCatalog[T any](columns []reportColumn[T], locale string) []*ReportColumn {
+	var catalog []*ReportColumn
+	seen := make(map[string]bool)
+	for _, col := range columns {
+		if seen[col.key] {
+			continue
+		}
+		seen[col.key] = true
+
+		title := col.title.in(locale)
+		if group, ok := reportColumnGroups[col.key]; ok {
+			title = group.in(locale)
+		}
+		catalog = append(catalog, &ReportColumn{Key: col.key, Title: title})
+	}
+	return catalog
+}
+
+// attendanceSheetRow 月度考勤表的一行（一名员工）
+type attendanceSheetRow struct {
+	employee   *orgModel.Employee
+	department string
+	sheet      *model.AttendanceMonthSheet
+}
+
+// attendanceColumns 月度考勤表的列：员工信息、逐日状态（按当月天数展开）、月度合计
+func attendanceColumns(year, month int, locale string) []reportColumn[*attendanceSheetRow] {
+	summary := func(fn func(*model.AttendanceSummary) interface{}) func(*attendanceSheetRow) interface{} {
+		return func(r *attendanceSheetRow) interface{} { return fn(r.sheet.Summary) }
+	}
+
+	columns := []reportColumn[*attendanceSheetRow]{
+		{"employee_no", localized{"工号", "Employee No."}, func(r *attendanceSheetRow) interface{} { return r.employee.EmployeeNo }},
+		{"employee_name", localized{"姓名", "Name"}, func(r *attendanceSheetRow) interface{} { return r.employee.Name }},
+		{"department", localized{"部门", "Department"}, func(r *attendanceSheetRow) interface{} { return r.department }},
+	}
+
+	start, end := model.MonthRange(year, month, time.Local)
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		index := d.Day() - 1
+		columns = append(columns, reportColumn[*attendanceSheetRow]{
+			key:   "daily",
+			title: localized{fmt.Sprintf("%d日", d.Day()), strconv.Itoa(d.Day())},
+			value: func(r *attendanceSheetRow) interface{} {
+				if index >= len(r.sheet.Days) {
+					return nil
+				}
+				return attendanceDayLabels[r.sheet.Days[index].Code].in(locale)
+			},
+		})
+	}
+
+	return append(columns,
+		reportColumn[*attendanceSheetRow]{"work_days", localized{"应出勤天数", "Scheduled Days"}, summary(func(s *model.AttendanceSummary) interface{} { return s.WorkDays })},
+		reportColumn[*attendanceSheetRow]{"actual_days", localized{"实际出勤天数", "Attended Days"}, summary(func(s *model.AttendanceSummary) interface{} { return s.ActualDays })},
+		reportColumn[*attendanceSheetRow]{"late_count", localized{"迟到次数", "Late"}, summary(func(s *model.AttendanceSummary) interface{} { return s.LateCount })},
+		reportColumn[*attendanceSheetRow]{"late_minutes", localized{"迟到分钟", "Late Minutes"}, summary(func(s *model.AttendanceSummary) interface{} { return s.LateDuration })},
+		reportColumn[*attendanceSheetRow]{"early_count", localized{"早退次数", "Early Leave"}, summary(func(s *model.AttendanceSummary) interface{} { return s.EarlyCount })},
+		reportColumn[*attendanceSheetRow]{"early_minutes", localized{"早退分钟", "Early Minutes"}, summary(func(s *model.AttendanceSummary) interface{} { return s.EarlyDuration })},
+		reportColumn[*attendanceSheetRow]{"missing_count", localized{"缺卡次数", "Missing Punches"}, summary(func(s *model.AttendanceSummary) interface{} { return s.MissingCount })},
+		reportColumn[*attendanceSheetRow]{"absent_days", localized{"旷工天数", "Absent Days"}, summary(func(s *model.AttendanceSummary) interface{} { return s.AbsentDays })},
+		reportColumn[*attendanceSheetRow]{"leave_days", localized{"请假天数", "Leave Days"}, summary(func(s *model.AttendanceSummary) interface{} { return s.LeaveDays })},
+		reportColumn[*attendanceSheetRow]{"trip_days", localized{"出差天数", "Trip Days"}, summary(func(s *model.AttendanceSummary) interface{} { return s.TripDays })},
+		reportColumn[*attendanceSheetRow]{"overtime_hours", localized{"加班小时", "Overtime Hours"}, summary(func(s *model.AttendanceSummary) interface{} { return s.OvertimeHours })},
+		reportColumn[*attendanceSheetRow]{"work_hours", localized{"工作时长", "Work Hours"}, summary(func(s *model.AttendanceSummary) interface{} { return s.WorkHours })},
+		reportColumn[*attendanceSheetRow]{"summary_status", localized{"汇总状态", "Summary Status"}, summary(func(s *model.AttendanceSummary) interface{} { return s.Status })},
+	)
+}
+
+// leaveBalanceRow 假期余额表的一行（员工 × 假期类型）
+type leaveBalanceRow struct {
+	employee   *orgModel.Employee
+	department string
+	quota      *model.LeaveQuotaWithType
+}
+
+func leaveBalanceColumns() []reportColumn[*leaveBalanceRow] {
+	return []reportColumn[*leaveBalanceRow]{
+		{"employee_no", localized{"工号", "Employee No."}, func(r *leaveBalanceRow) interface{} { return r.employee.EmployeeNo }},
+		{"employee_name", localized{"姓名", "Name"}, func(r *leaveBalanceRow) interface{} { return r.employee.Name }},
+		{"department", localized{"部门", "Department"}, func(r *leaveBalanceRow) interface{} { return r.department }},
+		{"leave_type", localized{"假期类型", "Leave Type"}, func(r *leaveBalanceRow) interface{} {
+			if r.quota.LeaveType == nil {
+				return nil
+			}
+			return r.quota.LeaveType.Name
+		}},
+		{"unit", localized{"单位", "Unit"}, func(r *leaveBalanceRow) interface{} {
+			if r.quota.LeaveType == nil {
+				return nil
+			}
+			return string(r.quota.LeaveType.Unit)
+		}},
+		{"year", localized{"年度", "Year"}, func(r *leaveBalanceRow) interface{} { return r.quota.Year }},
+		{"total", localized{"总额度", "Entitled"}, func(r *leaveBalanceRow) interface{} { return round2(r.quota.TotalQuota) }},
+		{"used", localized{"已使用", "Used"}, func(r *leaveBalanceRow) interface{} { return round2(r.quota.UsedQuota) }},
+		{"pending", localized{"审批中", "Pending"}, func(r *leaveBalanceRow) interface{} { return round2(r.quota.PendingQuota) }},
+		{"remaining", localized{"剩余", "Remaining"}, func(r *leaveBalanceRow) interface{} { return round2(r.quota.RemainingQuota()) }},
+		{"expired_at", localized{"过期日期", "Expires On"}, func(r *leaveBalanceRow) interface{} {
+			if r.quota.ExpiredAt == nil {
+				return nil
+			}
+			return r.quota.ExpiredAt.Format("2006-01-02")
+		}},
+	}
+}
+
+// overtimeLedgerRow 加班台账的一行（一条已批准的加班）
+type overtimeLedgerRow struct {
+	employee   *orgModel.Employee
+	department string
+	overtime   *model.Overtime
+}
+
+func overtimeLedgerColumns(locale string) []reportColumn[*overtimeLedgerRow] {
+	return []reportColumn[*overtimeLedgerRow]{
+		{"employee_no", localized{"工号", "Employee No."}, func(r *overtimeLedgerRow) interface{} { return r.employee.EmployeeNo }},
+		{"employee_name", localized{"姓名", "Name"}, func(r *overtimeLedgerRow) interface{} { return r.employee.Name }},
+		{"department", localized{"部门", "Department"}, func(r *overtimeLedgerRow) interface{} { return r.department }},
+		{"date", localized{"日期", "Date"}, func(r *overtimeLedgerRow) interface{} { return r.overtime.StartTime.Format("2006-01-02") }},
+		{"start_time", localized{"开始时间", "Start"}, func(r *overtimeLedgerRow) interface{} { return r.overtime.StartTime.Format("15:04") }},
+		{"end_time", localized{"结束时间", "End"}, func(r *overtimeLedgerRow) interface{} { return r.overtime.EndTime.Format("15:04") }},
+		{"overtime_type", localized{"加班类型", "Type"}, func(r *overtimeLedgerRow) interface{} {
+			return labelOr(overtimeTypeLabels, r.overtime.OvertimeType, locale)
+		}},
+		{"pay_type", localized{"补偿方式", "Compensation"}, func(r *overtimeLedgerRow) interface{} {
+			return labelOr(overtimePayTypeLabels, r.overtime.PayType, locale)
+		}},
+		{"duration", localized{"加班时长", "Hours"}, func(r *overtimeLedgerRow) interface{} { return round2(r.overtime.Duration) }},
+		{"billable_hours", localized{"计薪时长", "Billable Hours"}, func(r *overtimeLedgerRow) interface{} { return round2(r.overtime.BillableHours) }},
+		{"pay_rate", localized{"倍率", "Pay Rate"}, func(r *overtimeLedgerRow) interface{} { return r.overtime.PayRate }},
+		{"comp_off_days", localized{"调休天数", "Comp-off Days"}, func(r *overtimeLedgerRow) interface{} { return round2(r.overtime.CompOffDays) }},
+		{"reason", localized{"加班原因", "Reason"}, func(r *overtimeLedgerRow) interface{} { return r.overtime.Reason }},
+	}
+}
+
+func labelOr[K comparable](labels map[K]localized, key K, locale string) string {
+	if label, ok := labels[key]; ok {
+		return label.in(locale)
+	}
+	return fmt.Sprint(key)
+}
+
+// renderReport 将表格渲染为 XLSX 或 CSV（CSV 带 UTF-8 BOM，Excel 打开中文不乱码）
+func renderReport(format model.ExportFormat, table *reportTable) ([]byte, error) {
+	switch format {
+	case model.ExportFormatCSV:
+		var buf bytes.Buffer
+		buf.WriteString("\uFEFF")
+		w := csv.NewWriter(&buf)
+		if err := w.Write(table.headers); err != nil {
+			return nil, err
+		}
+		record := make([]string, len(table.headers))
+		for _, row := range table.rows {
+			for i, value := range row {
+				record[i] = formatCSVValue(value)
+			}
+			if err := w.Write(record); err != nil {
+				return nil, err
+			}
+		}
+		w.Flush()
+		return buf.Bytes(), w.Error()
+
+	case model.ExportFormatXLSX:
+		wb := xlsx.New()
+		sheet := wb.AddSheet(table.title)
+		sheet.SetHeader(table.headers...)
+		for _, row := range table.rows {
+			sheet.AddRow(row...)
+		}
+		return wb.Bytes()
+	}
+	return nil, ErrReportExportInvalid
+}
+
+func formatCSVValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case time.Time:
+		return v.Format("2006-01-02 15:04:05")
+	default:
+		return fmt.Sprint(v)
+	}
+}
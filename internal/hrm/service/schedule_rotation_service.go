@@ -0,0 +1,631 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	notificationDto "github.com/lk2023060901/go-next-erp/internal/notification/dto"
+	notificationService "github.com/lk2023060901/go-next-erp/internal/notification/service"
+)
+
+var (
+	ErrRotationTemplateNotFound   = errors.New("rotation template not found")
+	ErrRotationTemplateCodeExists = errors.New("rotation template code already exists")
+	ErrRotationTemplateEmpty      = errors.New("rotation template must contain at least one shift")
+	ErrRotationShiftNotFound      = errors.New("rotation template references an unknown shift")
+	ErrRotationTemplateInactive   = errors.New("rotation template is disabled")
+	ErrScheduleNoEmployees        = errors.New("no employees to schedule")
+	ErrEmployeeNotInDepartment    = errors.New("employee does not belong to the department")
+	ErrNoDraftSchedules           = errors.New("no draft schedules to publish")
+)
+
+// ScheduleRotationService 轮班排班服务：模板维护、按模板批量生成、约束校验与发布
+type ScheduleRotationService interface {
+	// 轮班模板
+	CreateTemplate(ctx context.Context, template *model.RotationTemplate) error
+	UpdateTemplate(ctx context.Context, template *model.RotationTemplate) error
+	DeleteTemplate(ctx context.Context, tenantID, id uuid.UUID) error
+	GetTemplate(ctx context.Context, tenantID, id uuid.UUID) (*model.RotationTemplate, error)
+	ListTemplates(ctx context.Context, tenantID uuid.UUID) ([]*model.RotationTemplate, error)
+
+	// Generate 按模板生成部门某月的草稿排班（重新生成时覆盖草稿，保留已发布的排班）
+	Generate(ctx context.Context, req *GenerateSchedulesRequest) (*ScheduleGenerationResult, error)
+
+	// Validate 校验部门某月排班，返回违规清单
+	Validate(ctx context.Context, req *ValidateSchedulesRequest) ([]*model.ScheduleViolation, error)
+
+	// Publish 发布部门某月的草稿排班并通知相关员工；存在违规且未强制发布时不做修改
+	Publish(ctx context.Context, req *PublishSchedulesRequest) (*SchedulePublishResult, error)
+}
+
+// GenerateSchedulesRequest 按模板生成排班请求
+type GenerateSchedulesRequest struct {
+	TenantID     uuid.UUID
+	DepartmentID uuid.UUID
+	TemplateID   uuid.UUID
+	Year         int
+	Month        int
+	EmployeeIDs  []uuid.UUID                // 为空时取部门全部员工
+	AnchorDate   *time.Time                 // 周期第 0 天，默认当月 1 日；跨月沿用同一起点可保持轮转连续
+	StaggerDays  int                        // 相邻员工的周期错开天数，把人手分散到各班次
+	Constraints  *model.ScheduleConstraints // 为空时使用模板约束
+	DryRun       bool                       // 只预览不保存
+	OperatorID   uuid.UUID
+}
+
+// ScheduleGenerationResult 排班生成结果
+type ScheduleGenerationResult struct {
+	Schedules  []*model.Schedule          `json:"schedules"`
+	Created    int                        `json:"created"`
+	Replaced   int64                      `json:"replaced"` // 覆盖的草稿数
+	Skipped    int                        `json:"skipped"`  // 已发布/已执行而保留的天数
+	Violations []*model.ScheduleViolation `json:"violations"`
+}
+
+// ValidateSchedulesRequest 排班校验请求
+type ValidateSchedulesRequest struct {
+	TenantID     uuid.UUID
+	DepartmentID uuid.UUID
+	Year         int
+	Month        int
+	TemplateID   *uuid.UUID // 未指定约束时取模板约束
+	Constraints  *model.ScheduleConstraints
+}
+
+// PublishSchedulesRequest 排班发布请求
+type PublishSchedulesRequest struct {
+	ValidateSchedulesRequest
+	Force      bool // 存在违规时仍然发布
+	OperatorID uuid.UUID
+}
+
+// SchedulePublishResult 排班发布结果
+type SchedulePublishResult struct {
+	Published  int64                      `json:"published"`
+	Notified   int                        `json:"notified"`
+	Blocked    bool                       `json:"blocked"` // 因违规未发布
+	Violations []*model.ScheduleViolation `json:"violations"`
+}
+
+type scheduleRotationService struct {
+	templateRepo        repository.RotationTemplateRepository
+	scheduleRepo        repository.ScheduleRepository
+	shiftRepo           repository.ShiftRepository
+	hrmEmpRepo          repository.HRMEmployeeRepository
+	dayResolver         DayTypeResolver
+	periodGuard         AttendancePeriodGuard
+	notificationService notificationService.NotificationService
+}
+
+// NewScheduleRotationService 创建轮班排班服务
+func NewScheduleRotationService(
+	templateRepo repository.RotationTemplateRepository,
+	scheduleRepo repository.ScheduleRepository,
+	shiftRepo repository.ShiftRepository,
+	hrmEmpRepo repository.HRMEmployeeRepository,
+	dayResolver DayTypeResolver,
+	periodGuard AttendancePeriodGuard,
+	notificationService notificationService.NotificationService,
+) ScheduleRotationService {
+	return &scheduleRotationService{
+		templateRepo:        templateRepo,
+		scheduleRepo:        scheduleRepo,
+		shiftRepo:           shiftRepo,
+		hrmEmpRepo:          hrmEmpRepo,
+		dayResolver:         dayResolver,
+		periodGuard:         periodGuard,
+		notificationService: notificationService,
+	}
+}
+
+func (s *scheduleRotationService) CreateTemplate(ctx context.Context, template *model.RotationTemplate) error {
+	if _, err := s.templateRepo.FindByCode(ctx, template.TenantID, template.Code); err == nil {
+		return ErrRotationTemplateCodeExists
+	}
+	if _, err := s.loadTemplateShifts(ctx, template); err != nil {
+		return err
+	}
+
+	template.ID = uuid.Must(uuid.NewV7())
+	now := time.Now()
+	template.CreatedAt = now
+	template.UpdatedAt = now
+
+	return s.templateRepo.Create(ctx, template)
+}
+
+func (s *scheduleRotationService) UpdateTemplate(ctx context.Context, template *model.RotationTemplate) error {
+	if _, err := s.GetTemplate(ctx, template.TenantID, template.ID); err != nil {
+		return err
+	}
+	if _, err := s.loadTemplateShifts(ctx, template); err != nil {
+		return err
+	}
+
+	template.UpdatedAt = time.Now()
+	return s.templateRepo.Update(ctx, template)
+}
+
+func (s *scheduleRotationService) DeleteTemplate(ctx context.Context, tenantID, id uuid.UUID) error {
+	if _, err := s.GetTemplate(ctx, tenantID, id); err != nil {
+		return err
+	}
+	return s.templateRepo.Delete(ctx, id)
+}
+
+func (s *scheduleRotationService) GetTemplate(ctx context.Context, tenantID, id uuid.UUID) (*model.RotationTemplate, error) {
+	template, err := s.templateRepo.FindByID(ctx, id)
+	if err != nil || template.TenantID != tenantID {
+		return nil, ErrRotationTemplateNotFound
+	}
+	return template, nil
+}
+
+func (s *scheduleRotationService) ListTemplates(ctx context.Context, tenantID uuid.UUID) ([]*model.RotationTemplate, error) {
+	return s.templateRepo.List(ctx, tenantID)
+}
+
+// loadTemplateShifts 校验模板并加载其引用的班次
+func (s *scheduleRotationService) loadTemplateShifts(ctx context.Context, template *model.RotationTemplate) (map[uuid.UUID]*model.Shift, error) {
+	shiftIDs := template.ShiftIDs()
+	if len(shiftIDs) == 0 {
+		return nil, ErrRotationTemplateEmpty
+	}
+
+	shifts := make(map[uuid.UUID]*model.Shift, len(shiftIDs))
+	for _, id := range shiftIDs {
+		shift, err := s.shiftRepo.FindByID(ctx, id)
+		if err != nil || shift.TenantID != template.TenantID {
+			return nil, fmt.Errorf("%w: %s", ErrRotationShiftNotFound, id)
+		}
+		shifts[id] = shift
+	}
+	return shifts, nil
+}
+
+func (s *scheduleRotationService) Generate(ctx context.Context, req *GenerateSchedulesRequest) (*ScheduleGenerationResult, error) {
+	template, err := s.GetTemplate(ctx, req.TenantID, req.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+	if !template.IsActive {
+		return nil, ErrRotationTemplateInactive
+	}
+	shifts, err := s.loadTemplateShifts(ctx, template)
+	if err != nil {
+		return nil, err
+	}
+
+	employees, err := s.departmentEmployees(ctx, req.TenantID, req.DepartmentID, req.EmployeeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	constraints := template.Constraints
+	if req.Constraints != nil {
+		constraints = *req.Constraints
+	}
+
+	monthStart, monthEnd := monthBounds(req.Year, req.Month)
+	anchor := monthStart
+	if req.AnchorDate != nil {
+		anchor = truncateDate(*req.AnchorDate)
+	}
+
+	employeeIDs := make([]uuid.UUID, len(employees))
+	for i, emp := range employees {
+		employeeIDs[i] = emp.EmployeeID
+	}
+
+	lookbackStart := monthStart.AddDate(0, 0, -constraintLookbackDays(constraints))
+	existing, err := s.scheduleRepo.FindByEmployeesRange(ctx, req.TenantID, employeeIDs, lookbackStart, monthEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	// 当月草稿将被覆盖，其余（上月排班、当月已发布/已执行）保留
+	kept := make(map[string]*model.Schedule)
+	var checked []*model.Schedule
+	for _, schedule := range existing {
+		if !schedule.ScheduleDate.Before(monthStart) && schedule.Status == model.ScheduleStatusDraft {
+			continue
+		}
+		kept[schedule.EmployeeID.String()+dateKey(schedule.ScheduleDate)] = schedule
+		checked = append(checked, schedule)
+	}
+
+	result := &ScheduleGenerationResult{}
+	now := time.Now()
+	for i, emp := range employees {
+		days, err := s.dayResolver.ResolveRange(ctx, req.TenantID, emp.EmployeeID, monthStart, monthEnd)
+		if err != nil {
+			return nil, err
+		}
+
+		offset := i * req.StaggerDays
+		for _, day := range days {
+			if !employedOn(emp, day.Date) {
+				continue
+			}
+			if _, ok := kept[emp.EmployeeID.String()+dateKey(day.Date)]; ok {
+				result.Skipped++
+				continue
+			}
+
+			slot := template.SlotAt(daysBetween(anchor, day.Date) + offset)
+			if slot.IsRest() || (template.SkipHolidays && day.Type == model.DayTypeHoliday) {
+				continue
+			}
+
+			shift := shifts[*slot.ShiftID]
+			result.Schedules = append(result.Schedules, &model.Schedule{
+				ID:           uuid.Must(uuid.NewV7()),
+				TenantID:     req.TenantID,
+				EmployeeID:   emp.EmployeeID,
+				EmployeeName: emp.Name,
+				DepartmentID: req.DepartmentID,
+				ShiftID:      shift.ID,
+				ShiftName:    shift.Name,
+				ScheduleDate: day.Date,
+				WorkdayType:  string(day.Type),
+				Status:       model.ScheduleStatusDraft,
+				Remark:       fmt.Sprintf("轮班模板 %s", template.Code),
+				CreatedBy:    req.OperatorID,
+				UpdatedBy:    req.OperatorID,
+				CreatedAt:    now,
+				UpdatedAt:    now,
+			})
+		}
+	}
+	result.Created = len(result.Schedules)
+
+	s.fillShifts(ctx, shifts, checked)
+	result.Violations = checkScheduleConstraints(append(checked, result.Schedules...), shifts, constraints, monthStart, monthEnd)
+
+	if req.DryRun {
+		return result, nil
+	}
+
+	for _, id := range employeeIDs {
+		if err := checkPeriod(ctx, s.periodGuard, req.TenantID, id, monthStart, monthEnd); err != nil {
+			return nil, err
+		}
+	}
+
+	result.Replaced, err = s.scheduleRepo.ReplaceDrafts(ctx, req.TenantID, employeeIDs, monthStart, monthEnd, result.Schedules)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// departmentEmployees 部门员工；指定了员工时只取这些员工，且必须属于该部门
+func (s *scheduleRotationService) departmentEmployees(ctx context.Context, tenantID, departmentID uuid.UUID, employeeIDs []uuid.UUID) ([]*model.EmployeeTenure, error) {
+	tenures, err := s.hrmEmpRepo.ListTenuresByDepartment(ctx, tenantID, departmentID)
+	if err != nil {
+		return nil, err
+	}
+	if len(employeeIDs) == 0 {
+		if len(tenures) == 0 {
+			return nil, ErrScheduleNoEmployees
+		}
+		return tenures, nil
+	}
+
+	byID := make(map[uuid.UUID]*model.EmployeeTenure, len(tenures))
+	for _, tenure := range tenures {
+		byID[tenure.EmployeeID] = tenure
+	}
+
+	// 保持调用方给出的顺序，错开天数按此顺序分配
+	selected := make([]*model.EmployeeTenure, 0, len(employeeIDs))
+	for _, id := range employeeIDs {
+		tenure, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrEmployeeNotInDepartment, id)
+		}
+		selected = append(selected, tenure)
+	}
+	return selected, nil
+}
+
+func (s *scheduleRotationService) Validate(ctx context.Context, req *ValidateSchedulesRequest) ([]*model.ScheduleViolation, error) {
+	violations, _, err := s.validate(ctx, req)
+	return violations, err
+}
+
+// validate 校验部门当月排班，同时返回当月部门排班供发布使用
+func (s *scheduleRotationService) validate(ctx context.Context, req *ValidateSchedulesRequest) ([]*model.ScheduleViolation, []*model.Schedule, error) {
+	var constraints model.ScheduleConstraints
+	switch {
+	case req.Constraints != nil:
+		constraints = *req.Constraints
+	case req.TemplateID != nil:
+		template, err := s.GetTemplate(ctx, req.TenantID, *req.TemplateID)
+		if err != nil {
+			return nil, nil, err
+		}
+		constraints = template.Constraints
+	}
+
+	monthStart, monthEnd := monthBounds(req.Year, req.Month)
+	schedules, err := s.scheduleRepo.FindByDepartmentRange(ctx, req.TenantID, req.DepartmentID, monthStart, monthEnd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 补充员工月初之前的排班，用于校验跨月的休息间隔和连续上班
+	checked := schedules
+	if lookback := constraintLookbackDays(constraints); lookback > 0 && len(schedules) > 0 {
+		seen := make(map[uuid.UUID]bool)
+		var employeeIDs []uuid.UUID
+		for _, schedule := range schedules {
+			if !seen[schedule.EmployeeID] {
+				seen[schedule.EmployeeID] = true
+				employeeIDs = append(employeeIDs, schedule.EmployeeID)
+			}
+		}
+		previous, err := s.scheduleRepo.FindByEmployeesRange(ctx, req.TenantID, employeeIDs, monthStart.AddDate(0, 0, -lookback), monthStart.AddDate(0, 0, -1))
+		if err != nil {
+			return nil, nil, err
+		}
+		checked = append(append([]*model.Schedule{}, schedules...), previous...)
+	}
+
+	shifts := make(map[uuid.UUID]*model.Shift)
+	s.fillShifts(ctx, shifts, checked)
+	return checkScheduleConstraints(checked, shifts, constraints, monthStart, monthEnd), schedules, nil
+}
+
+func (s *scheduleRotationService) Publish(ctx context.Context, req *PublishSchedulesRequest) (*SchedulePublishResult, error) {
+	violations, schedules, err := s.validate(ctx, &req.ValidateSchedulesRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	var draftIDs []uuid.UUID
+	drafts := make(map[uuid.UUID]int)
+	for _, schedule := range schedules {
+		if schedule.Status == model.ScheduleStatusDraft {
+			draftIDs = append(draftIDs, schedule.ID)
+			drafts[schedule.EmployeeID]++
+		}
+	}
+	if len(draftIDs) == 0 {
+		return nil, ErrNoDraftSchedules
+	}
+
+	result := &SchedulePublishResult{Violations: violations}
+	if len(violations) > 0 && !req.Force {
+		result.Blocked = true
+		return result, nil
+	}
+
+	monthStart, monthEnd := monthBounds(req.Year, req.Month)
+	for employeeID := range drafts {
+		if err := checkPeriod(ctx, s.periodGuard, req.TenantID, employeeID, monthStart, monthEnd); err != nil {
+			return nil, err
+		}
+	}
+
+	result.Published, err = s.scheduleRepo.UpdateStatus(ctx, draftIDs, model.ScheduleStatusPublished, req.OperatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Notified = s.notifyPublished(ctx, req, drafts)
+	return result, nil
+}
+
+// notifyPublished 通知排班已发布的员工，通知失败不影响发布
+func (s *scheduleRotationService) notifyPublished(ctx context.Context, req *PublishSchedulesRequest, drafts map[uuid.UUID]int) int {
+	if s.notificationService == nil {
+		return 0
+	}
+
+	employeeIDs := make([]uuid.UUID, 0, len(drafts))
+	for id := range drafts {
+		employeeIDs = append(employeeIDs, id)
+	}
+	userIDs, err := s.hrmEmpRepo.FindUserIDs(ctx, req.TenantID, employeeIDs)
+	if err != nil {
+		return 0
+	}
+
+	relatedType := "hrm_schedule"
+	relatedID := req.DepartmentID.String()
+	notified := 0
+	for _, employeeID := range employeeIDs {
+		userID, ok := userIDs[employeeID]
+		if !ok {
+			continue
+		}
+		_, err := s.notificationService.SendNotification(ctx, req.TenantID, &notificationDto.SendNotificationRequest{
+			Type:        "system",
+			Channel:     "in_app",
+			RecipientID: userID.String(),
+			Title:       "排班已发布",
+			Content:     fmt.Sprintf("您 %d年%02d月 的排班已发布，共 %d 个班次，请及时查看。", req.Year, req.Month, drafts[employeeID]),
+			Data: map[string]interface{}{
+				"employee_id":   employeeID.String(),
+				"department_id": req.DepartmentID.String(),
+				"year":          req.Year,
+				"month":         req.Month,
+			},
+			RelatedType: &relatedType,
+			RelatedID:   &relatedID,
+		})
+		if err == nil {
+			notified++
+		}
+	}
+	return notified
+}
+
+// fillShifts 补充加载排班引用的班次，供休息间隔校验使用
+func (s *scheduleRotationService) fillShifts(ctx context.Context, shifts map[uuid.UUID]*model.Shift, schedules []*model.Schedule) {
+	for _, schedule := range schedules {
+		if _, ok := shifts[schedule.ShiftID]; ok {
+			continue
+		}
+		shift, err := s.shiftRepo.FindByID(ctx, schedule.ShiftID)
+		if err != nil {
+			// 班次已删除时按标准工作时间校验
+			shifts[schedule.ShiftID] = nil
+			continue
+		}
+		shifts[schedule.ShiftID] = shift
+	}
+}
+
+// monthBounds 某月的首日和末日
+func monthBounds(year, month int) (time.Time, time.Time) {
+	start := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.Local)
+	return start, start.AddDate(0, 1, -1)
+}
+
+// daysBetween 两个日期相差的天数（按日历日，不受夏令时影响）
+func daysBetween(from, to time.Time) int {
+	a := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	b := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(math.Round(b.Sub(a).Hours() / 24))
+}
+
+// employedOn 员工在该日是否在职
+func employedOn(tenure *model.EmployeeTenure, date time.Time) bool {
+	if tenure.JoinDate != nil && daysBetween(*tenure.JoinDate, date) < 0 {
+		return false
+	}
+	if tenure.LeaveDate != nil && daysBetween(*tenure.LeaveDate, date) > 0 {
+		return false
+	}
+	return true
+}
+
+// constraintLookbackDays 校验跨月约束需要回看的天数
+func constraintLookbackDays(c model.ScheduleConstraints) int {
+	days := 0
+	if c.MinRestHours > 0 {
+		days = 1
+	}
+	if c.MaxConsecutiveDays > days {
+		days = c.MaxConsecutiveDays
+	}
+	return days
+}
+
+// checkScheduleConstraints 按约束校验排班，只报告 [start, end] 内的违规；
+// schedules 可包含 start 之前的排班，用于判断跨月的休息间隔和连续上班天数
+func checkScheduleConstraints(schedules []*model.Schedule, shifts map[uuid.UUID]*model.Shift, c model.ScheduleConstraints, start, end time.Time) []*model.ScheduleViolation {
+	var violations []*model.ScheduleViolation
+	reported := func(date time.Time) bool {
+		return daysBetween(start, date) >= 0 && daysBetween(date, end) >= 0
+	}
+
+	byEmployee := make(map[uuid.UUID][]*model.Schedule)
+	var employeeIDs []uuid.UUID
+	for _, schedule := range schedules {
+		if _, ok := byEmployee[schedule.EmployeeID]; !ok {
+			employeeIDs = append(employeeIDs, schedule.EmployeeID)
+		}
+		byEmployee[schedule.EmployeeID] = append(byEmployee[schedule.EmployeeID], schedule)
+	}
+	sort.Slice(employeeIDs, func(i, j int) bool { return employeeIDs[i].String() < employeeIDs[j].String() })
+
+	for _, employeeID := range employeeIDs {
+		list := byEmployee[employeeID]
+		sort.Slice(list, func(i, j int) bool { return list[i].ScheduleDate.Before(list[j].ScheduleDate) })
+
+		streak := 0
+		var streakViolation *model.ScheduleViolation
+		for i, schedule := range list {
+			id := employeeID
+			date := truncateDate(schedule.ScheduleDate)
+
+			if i > 0 && daysBetween(list[i-1].ScheduleDate, date) == 1 {
+				streak++
+			} else {
+				streak, streakViolation = 1, nil
+			}
+
+			if c.MaxConsecutiveDays > 0 && streak > c.MaxConsecutiveDays {
+				if streakViolation != nil {
+					streakViolation.Actual = float64(streak)
+				} else if reported(date) {
+					streakViolation = &model.ScheduleViolation{
+						Type:         model.ScheduleViolationMaxConsecutive,
+						Date:         date,
+						EmployeeID:   &id,
+						EmployeeName: schedule.EmployeeName,
+						Actual:       float64(streak),
+						Limit:        float64(c.MaxConsecutiveDays),
+						Message:      fmt.Sprintf("连续上班超过 %d 天", c.MaxConsecutiveDays),
+					}
+					violations = append(violations, streakViolation)
+				}
+			}
+
+			if c.MinRestHours > 0 && i > 0 && reported(date) {
+				prev := list[i-1]
+				prevEnd := windowOn(shifts[prev.ShiftID], truncateDate(prev.ScheduleDate)).Work.End
+				curStart := windowOn(shifts[schedule.ShiftID], date).Work.Start
+				if rest := curStart.Sub(prevEnd).Hours(); rest < c.MinRestHours {
+					shiftID := schedule.ShiftID
+					violations = append(violations, &model.ScheduleViolation{
+						Type:         model.ScheduleViolationMinRest,
+						Date:         date,
+						EmployeeID:   &id,
+						EmployeeName: schedule.EmployeeName,
+						ShiftID:      &shiftID,
+						ShiftName:    schedule.ShiftName,
+						Actual:       round2(rest),
+						Limit:        c.MinRestHours,
+						Message:      fmt.Sprintf("与上一班次间隔 %.1f 小时，少于 %.1f 小时", rest, c.MinRestHours),
+					})
+				}
+			}
+		}
+	}
+
+	if len(c.MinHeadcount) > 0 {
+		counts := make(map[string]int)
+		names := make(map[uuid.UUID]string)
+		for _, schedule := range schedules {
+			counts[schedule.ShiftID.String()+dateKey(schedule.ScheduleDate)]++
+			names[schedule.ShiftID] = schedule.ShiftName
+		}
+
+		for date := truncateDate(start); !date.After(end); date = date.AddDate(0, 0, 1) {
+			for _, rule := range c.MinHeadcount {
+				actual := counts[rule.ShiftID.String()+dateKey(date)]
+				if rule.Min <= 0 || actual >= rule.Min {
+					continue
+				}
+				shiftID := rule.ShiftID
+				name := names[shiftID]
+				if shift := shifts[shiftID]; shift != nil {
+					name = shift.Name
+				}
+				violations = append(violations, &model.ScheduleViolation{
+					Type:      model.ScheduleViolationMinHeadcount,
+					Date:      date,
+					ShiftID:   &shiftID,
+					ShiftName: name,
+					Actual:    float64(actual),
+					Limit:     float64(rule.Min),
+					Message:   fmt.Sprintf("在岗 %d 人，少于 %d 人", actual, rule.Min),
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(violations, func(i, j int) bool { return violations[i].Date.Before(violations[j].Date) })
+	return violations
+}
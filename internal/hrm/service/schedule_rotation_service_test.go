@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+)
+
+type stubRotationTemplateRepo struct {
+	repository.RotationTemplateRepository
+	templates map[uuid.UUID]*model.RotationTemplate
+}
+
+func (r *stubRotationTemplateRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.RotationTemplate, error) {
+	if t, ok := r.templates[id]; ok {
+		return t, nil
+	}
+	return nil, errStubNotFound
+}
+
+type stubShiftRepo struct {
+	repository.ShiftRepository
+	shifts map[uuid.UUID]*model.Shift
+}
+
+func (r *stubShiftRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.Shift, error) {
+	if s, ok := r.shifts[id]; ok {
+		return s, nil
+	}
+	return nil, errStubNotFound
+}
+
+type stubDepartmentEmployeeRepo struct {
+	repository.HRMEmployeeRepository
+	tenures []*model.EmployeeTenure
+}
+
+func (r *stubDepartmentEmployeeRepo) ListTenuresByDepartment(ctx context.Context, tenantID, departmentID uuid.UUID) ([]*model.EmployeeTenure, error) {
+	return r.tenures, nil
+}
+
+// stubRotationScheduleRepo 内存排班仓储，ReplaceDrafts 记录写入的排班
+type stubRotationScheduleRepo struct {
+	repository.ScheduleRepository
+	existing []*model.Schedule
+	written  []*model.Schedule
+}
+
+func (r *stubRotationScheduleRepo) FindByEmployeesRange(ctx context.Context, tenantID uuid.UUID, employeeIDs []uuid.UUID, start, end time.Time) ([]*model.Schedule, error) {
+	return r.existing, nil
+}
+
+func (r *stubRotationScheduleRepo) ReplaceDrafts(ctx context.Context, tenantID uuid.UUID, employeeIDs []uuid.UUID, start, end time.Time, schedules []*model.Schedule) (int64, error) {
+	r.written = schedules
+	return 0, nil
+}
+
+// stubDayResolver 周六日休息，holidays 中的日期为节假日
+type stubDayResolver struct {
+	DayTypeResolver
+	holidays map[string]bool
+}
+
+func (r *stubDayResolver) ResolveRange(ctx context.Context, tenantID, employeeID uuid.UUID, start, end time.Time) ([]*model.DayInfo, error) {
+	days := rangeDays(dateKey(start), dateKey(end))
+	for _, day := range days {
+		if r.holidays[dateKey(day.Date)] {
+			day.Type = model.DayTypeHoliday
+		}
+	}
+	return days, nil
+}
+
+func TestRotationTemplate_SlotAt(t *testing.T) {
+	day := uuid.New()
+	template := &model.RotationTemplate{Slots: []model.RotationSlot{{ShiftID: &day}, {ShiftID: &day}, {}}}
+
+	assert.False(t, template.SlotAt(0).IsRest())
+	assert.True(t, template.SlotAt(2).IsRest())
+	assert.True(t, template.SlotAt(5).IsRest())
+	assert.True(t, template.SlotAt(-1).IsRest())
+	assert.Equal(t, []uuid.UUID{day}, template.ShiftIDs())
+}
+
+func TestCheckScheduleConstraints(t *testing.T) {
+	dayShift := &model.Shift{ID: uuid.New(), Name: "白班", WorkStart: "09:00", WorkEnd: "18:00"}
+	nightShift := &model.Shift{ID: uuid.New(), Name: "夜班", WorkStart: "22:00", WorkEnd: "06:00"}
+	shifts := map[uuid.UUID]*model.Shift{dayShift.ID: dayShift, nightShift.ID: nightShift}
+	employeeID := uuid.New()
+
+	schedule := func(shift *model.Shift, date string) *model.Schedule {
+		return &model.Schedule{EmployeeID: employeeID, ShiftID: shift.ID, ShiftName: shift.Name, ScheduleDate: mustDate(date)}
+	}
+	start, end := mustDate("2025-09-01"), mustDate("2025-09-30")
+
+	t.Run("rest after night shift", func(t *testing.T) {
+		schedules := []*model.Schedule{schedule(nightShift, "2025-09-01"), schedule(dayShift, "2025-09-02")}
+		violations := checkScheduleConstraints(schedules, shifts, model.ScheduleConstraints{MinRestHours: 11}, start, end)
+
+		require.Len(t, violations, 1)
+		assert.Equal(t, model.ScheduleViolationMinRest, violations[0].Type)
+		assert.Equal(t, 3.0, violations[0].Actual)
+		assert.Equal(t, "2025-09-02", dateKey(violations[0].Date))
+	})
+
+	t.Run("consecutive days reported once per streak", func(t *testing.T) {
+		var schedules []*model.Schedule
+		for d := mustDate("2025-08-29"); !d.After(mustDate("2025-09-05")); d = d.AddDate(0, 0, 1) {
+			schedules = append(schedules, schedule(dayShift, dateKey(d)))
+		}
+		violations := checkScheduleConstraints(schedules, shifts, model.ScheduleConstraints{MaxConsecutiveDays: 6}, start, end)
+
+		require.Len(t, violations, 1)
+		assert.Equal(t, model.ScheduleViolationMaxConsecutive, violations[0].Type)
+		// 8 月 29 日起连续上班，第 7 天（9 月 4 日）起违规
+		assert.Equal(t, "2025-09-04", dateKey(violations[0].Date))
+		assert.Equal(t, 8.0, violations[0].Actual)
+	})
+
+	t.Run("streak before range not reported", func(t *testing.T) {
+		var schedules []*model.Schedule
+		for d := mustDate("2025-08-20"); !d.After(mustDate("2025-08-31")); d = d.AddDate(0, 0, 1) {
+			schedules = append(schedules, schedule(dayShift, dateKey(d)))
+		}
+		assert.Empty(t, checkScheduleConstraints(schedules, shifts, model.ScheduleConstraints{MaxConsecutiveDays: 6}, start, end))
+	})
+
+	t.Run("headcount per day", func(t *testing.T) {
+		schedules := []*model.Schedule{schedule(nightShift, "2025-09-01")}
+		constraints := model.ScheduleConstraints{MinHeadcount: []model.ShiftHeadcount{{ShiftID: nightShift.ID, Min: 1}}}
+		violations := checkScheduleConstraints(schedules, shifts, constraints, start, mustDate("2025-09-03"))
+
+		require.Len(t, violations, 2)
+		assert.Equal(t, model.ScheduleViolationMinHeadcount, violations[0].Type)
+		assert.Equal(t, "2025-09-02", dateKey(violations[0].Date))
+		assert.Equal(t, "夜班", violations[0].ShiftName)
+	})
+}
+
+func TestScheduleRotationService_Generate(t *testing.T) {
+	ctx := context.Background()
+	tenantID, departmentID := uuid.New(), uuid.New()
+	dayShift := &model.Shift{ID: uuid.New(), TenantID: tenantID, Name: "白班", WorkStart: "09:00", WorkEnd: "18:00"}
+
+	// 做四休二
+	work := model.RotationSlot{ShiftID: &dayShift.ID}
+	template := &model.RotationTemplate{
+		ID: uuid.New(), TenantID: tenantID, Code: "4-2", IsActive: true,
+		Slots:       []model.RotationSlot{work, work, work, work, {}, {}},
+		Constraints: model.ScheduleConstraints{MaxConsecutiveDays: 4},
+	}
+
+	alice := &model.EmployeeTenure{EmployeeID: uuid.New(), Name: "Alice"}
+	join := mustDate("2025-09-10")
+	bob := &model.EmployeeTenure{EmployeeID: uuid.New(), Name: "Bob", JoinDate: &join}
+
+	newService := func(existing ...*model.Schedule) (*scheduleRotationService, *stubRotationScheduleRepo) {
+		schedules := &stubRotationScheduleRepo{existing: existing}
+		return &scheduleRotationService{
+			templateRepo: &stubRotationTemplateRepo{templates: map[uuid.UUID]*model.RotationTemplate{template.ID: template}},
+			scheduleRepo: schedules,
+			shiftRepo:    &stubShiftRepo{shifts: map[uuid.UUID]*model.Shift{dayShift.ID: dayShift}},
+			hrmEmpRepo:   &stubDepartmentEmployeeRepo{tenures: []*model.EmployeeTenure{alice, bob}},
+			dayResolver:  &stubDayResolver{holidays: map[string]bool{}},
+		}, schedules
+	}
+
+	t.Run("staggered cycle and join date", func(t *testing.T) {
+		svc, repo := newService()
+		result, err := svc.Generate(ctx, &GenerateSchedulesRequest{
+			TenantID: tenantID, DepartmentID: departmentID, TemplateID: template.ID,
+			Year: 2025, Month: 9, StaggerDays: 3,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, result.Violations)
+		assert.Equal(t, result.Schedules, repo.written)
+
+		byEmployee := make(map[uuid.UUID][]string)
+		for _, s := range result.Schedules {
+			assert.Equal(t, model.ScheduleStatusDraft, s.Status)
+			byEmployee[s.EmployeeID] = append(byEmployee[s.EmployeeID], dateKey(s.ScheduleDate))
+		}
+		// 30 天 = 5 个周期，每个周期 4 个班
+		assert.Len(t, byEmployee[alice.EmployeeID], 20)
+		assert.Equal(t, []string{"2025-09-01", "2025-09-02", "2025-09-03", "2025-09-04", "2025-09-07"}, byEmployee[alice.EmployeeID][:5])
+		// Bob 周期错开 3 天，9 月 10 日入职前不排班
+		assert.Equal(t, "2025-09-10", byEmployee[bob.EmployeeID][0])
+	})
+
+	t.Run("published schedules kept and checked", func(t *testing.T) {
+		published := &model.Schedule{
+			EmployeeID: alice.EmployeeID, ShiftID: dayShift.ID, ScheduleDate: mustDate("2025-09-05"),
+			Status: model.ScheduleStatusPublished,
+		}
+		draft := &model.Schedule{
+			EmployeeID: alice.EmployeeID, ShiftID: dayShift.ID, ScheduleDate: mustDate("2025-09-06"),
+			Status: model.ScheduleStatusDraft,
+		}
+		svc, _ := newService(published, draft)
+
+		result, err := svc.Generate(ctx, &GenerateSchedulesRequest{
+			TenantID: tenantID, DepartmentID: departmentID, TemplateID: template.ID,
+			Year: 2025, Month: 9, EmployeeIDs: []uuid.UUID{alice.EmployeeID}, DryRun: true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Skipped)
+		// 9 月 1-4 日模板班次加上已发布的 5 日，连续 5 天
+		require.Len(t, result.Violations, 1)
+		assert.Equal(t, model.ScheduleViolationMaxConsecutive, result.Violations[0].Type)
+		assert.Equal(t, "2025-09-05", dateKey(result.Violations[0].Date))
+	})
+
+	t.Run("skip holidays", func(t *testing.T) {
+		svc, _ := newService()
+		holidayTemplate := *template
+		holidayTemplate.ID = uuid.New()
+		holidayTemplate.SkipHolidays = true
+		svc.templateRepo.(*stubRotationTemplateRepo).templates[holidayTemplate.ID] = &holidayTemplate
+		svc.dayResolver = &stubDayResolver{holidays: map[string]bool{"2025-09-02": true}}
+
+		result, err := svc.Generate(ctx, &GenerateSchedulesRequest{
+			TenantID: tenantID, DepartmentID: departmentID, TemplateID: holidayTemplate.ID,
+			Year: 2025, Month: 9, EmployeeIDs: []uuid.UUID{alice.EmployeeID}, DryRun: true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 19, result.Created)
+	})
+
+	t.Run("employee outside department", func(t *testing.T) {
+		svc, _ := newService()
+		_, err := svc.Generate(ctx, &GenerateSchedulesRequest{
+			TenantID: tenantID, DepartmentID: departmentID, TemplateID: template.ID,
+			Year: 2025, Month: 9, EmployeeIDs: []uuid.UUID{uuid.New()},
+		})
+		assert.ErrorIs(t, err, ErrEmployeeNotInDepartment)
+	})
+}
@@ -0,0 +1,505 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/integration"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	notificationDto "github.com/lk2023060901/go-next-erp/internal/notification/dto"
+	notificationService "github.com/lk2023060901/go-next-erp/internal/notification/service"
+	"github.com/lk2023060901/go-next-erp/pkg/workflow"
+)
+
+var (
+	ErrShiftSwapNotFound        = errors.New("shift swap request not found")
+	ErrShiftSwapInvalidStatus   = errors.New("shift swap request is not in a valid status for this action")
+	ErrShiftSwapScheduleMissing = errors.New("schedule not found")
+	ErrShiftSwapNotParticipant  = errors.New("employee is not a participant of the shift swap request")
+	ErrShiftSwapNotApprover     = errors.New("operator is not allowed to approve the shift swap request")
+	ErrShiftSwapSameEmployee    = errors.New("cannot swap shifts with yourself")
+	ErrShiftSwapScheduleOwner   = errors.New("schedule does not belong to the employee")
+	ErrShiftSwapPastSchedule    = errors.New("only upcoming schedules can be swapped")
+	ErrShiftSwapDuplicate       = errors.New("schedule already has an open swap request")
+	ErrShiftSwapConflict        = errors.New("shift swap conflicts with leave, business trip or another schedule")
+	ErrShiftSwapScheduleChanged = errors.New("schedules have changed since the swap was requested")
+)
+
+// ShiftSwapService 换班服务：员工 A 发起互换或代班，B 确认后由主管审批，审批通过时交换排班
+type ShiftSwapService interface {
+	// Propose 发起换班；未指定对方排班时为代班
+	Propose(ctx context.Context, req *ProposeShiftSwapRequest) (*model.ShiftSwapRequest, error)
+
+	// Respond 对方同意或拒绝；同意后进入主管审批
+	Respond(ctx context.Context, tenantID, id, employeeID uuid.UUID, accept bool, comment string) (*model.ShiftSwapRequest, error)
+
+	// Cancel 申请人撤销（审批前）
+	Cancel(ctx context.Context, tenantID, id, employeeID uuid.UUID) (*model.ShiftSwapRequest, error)
+
+	// Approve 主管批准，重新校验冲突后原子交换两条排班；审批人须为双方的直属上级或组织负责人，且不能是换班双方
+	Approve(ctx context.Context, tenantID, id, approverID uuid.UUID, comment string) (*model.ShiftSwapRequest, error)
+
+	// Reject 主管驳回，审批人要求同 Approve
+	Reject(ctx context.Context, tenantID, id, approverID uuid.UUID, comment string) (*model.ShiftSwapRequest, error)
+
+	Get(ctx context.Context, tenantID, id uuid.UUID) (*model.ShiftSwapRequest, error)
+	List(ctx context.Context, tenantID uuid.UUID, filter *repository.ShiftSwapFilter) ([]*model.ShiftSwapRequest, error)
+}
+
+// ProposeShiftSwapRequest 发起换班请求
+type ProposeShiftSwapRequest struct {
+	TenantID            uuid.UUID
+	RequesterID         uuid.UUID
+	RequesterScheduleID uuid.UUID
+	TargetEmployeeID    uuid.UUID
+	TargetScheduleID    *uuid.UUID // 为空时为代班
+	Reason              string
+}
+
+type shiftSwapService struct {
+	swapRepo            repository.ShiftSwapRepository
+	scheduleRepo        repository.ScheduleRepository
+	shiftRepo           repository.ShiftRepository
+	hrmEmpRepo          repository.HRMEmployeeRepository
+	leaveRepo           repository.LeaveRequestRepository
+	tripRepo            repository.BusinessTripRepository
+	periodGuard         AttendancePeriodGuard
+	workflowEngine      *integration.ShiftSwapWorkflowEngine
+	notificationService notificationService.NotificationService
+}
+
+// NewShiftSwapService 创建换班服务
+func NewShiftSwapService(
+	swapRepo repository.ShiftSwapRepository,
+	scheduleRepo repository.ScheduleRepository,
+	shiftRepo repository.ShiftRepository,
+	hrmEmpRepo repository.HRMEmployeeRepository,
+	leaveRepo repository.LeaveRequestRepository,
+	tripRepo repository.BusinessTripRepository,
+	periodGuard AttendancePeriodGuard,
+	workflowEngine *workflow.Engine,
+	notificationService notificationService.NotificationService,
+) (ShiftSwapService, error) {
+	swapWorkflow, err := integration.NewShiftSwapWorkflowEngine(workflowEngine)
+	if err != nil {
+		return nil, err
+	}
+
+	return &shiftSwapService{
+		swapRepo:            swapRepo,
+		scheduleRepo:        scheduleRepo,
+		shiftRepo:           shiftRepo,
+		hrmEmpRepo:          hrmEmpRepo,
+		leaveRepo:           leaveRepo,
+		tripRepo:            tripRepo,
+		periodGuard:         periodGuard,
+		workflowEngine:      swapWorkflow,
+		notificationService: notificationService,
+	}, nil
+}
+
+func (s *shiftSwapService) Propose(ctx context.Context, req *ProposeShiftSwapRequest) (*model.ShiftSwapRequest, error) {
+	if req.RequesterID == req.TargetEmployeeID {
+		return nil, ErrShiftSwapSameEmployee
+	}
+
+	mine, err := s.loadSchedule(ctx, req.TenantID, req.RequesterScheduleID, req.RequesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &model.ShiftSwapRequest{
+		TenantID:            req.TenantID,
+		Type:                model.ShiftSwapTypeCover,
+		RequesterID:         req.RequesterID,
+		RequesterName:       mine.EmployeeName,
+		RequesterScheduleID: mine.ID,
+		RequesterDate:       mine.ScheduleDate,
+		RequesterShiftID:    mine.ShiftID,
+		RequesterShiftName:  mine.ShiftName,
+		TargetEmployeeID:    req.TargetEmployeeID,
+		Reason:              req.Reason,
+		Status:              model.ShiftSwapStatusPendingPeer,
+	}
+
+	if req.TargetScheduleID != nil {
+		theirs, err := s.loadSchedule(ctx, req.TenantID, *req.TargetScheduleID, req.TargetEmployeeID)
+		if err != nil {
+			return nil, err
+		}
+		if theirs.DepartmentID != mine.DepartmentID {
+			return nil, ErrEmployeeNotInDepartment
+		}
+		request.Type = model.ShiftSwapTypeSwap
+		request.TargetEmployeeName = theirs.EmployeeName
+		request.TargetScheduleID = &theirs.ID
+		request.TargetDate = &theirs.ScheduleDate
+		request.TargetShiftID = &theirs.ShiftID
+		request.TargetShiftName = theirs.ShiftName
+	} else {
+		// 代班人须是同部门在职员工
+		tenures, err := s.hrmEmpRepo.ListTenuresByDepartment(ctx, req.TenantID, mine.DepartmentID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list department employees: %w", err)
+		}
+		for _, tenure := range tenures {
+			if tenure.EmployeeID == req.TargetEmployeeID && employedOn(tenure, mine.ScheduleDate) {
+				request.TargetEmployeeName = tenure.Name
+				break
+			}
+		}
+		if request.TargetEmployeeName == "" {
+			return nil, ErrEmployeeNotInDepartment
+		}
+	}
+
+	for _, id := range request.ScheduleIDs() {
+		count, err := s.swapRepo.CountOpenBySchedule(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			return nil, ErrShiftSwapDuplicate
+		}
+	}
+
+	if err := s.checkConflicts(ctx, request); err != nil {
+		return nil, err
+	}
+
+	request.ID = uuid.Must(uuid.NewV7())
+	now := time.Now()
+	request.CreatedAt = now
+	request.UpdatedAt = now
+
+	if err := s.swapRepo.Create(ctx, request); err != nil {
+		return nil, fmt.Errorf("failed to create shift swap request: %w", err)
+	}
+
+	s.notify(ctx, request, "换班申请", fmt.Sprintf("%s 请求与您%s，请确认。", request.RequesterName, describeSwap(request)), request.TargetEmployeeID)
+	return request, nil
+}
+
+func (s *shiftSwapService) Respond(ctx context.Context, tenantID, id, employeeID uuid.UUID, accept bool, comment string) (*model.ShiftSwapRequest, error) {
+	request, err := s.Get(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if request.TargetEmployeeID != employeeID {
+		return nil, ErrShiftSwapNotParticipant
+	}
+	if request.Status != model.ShiftSwapStatusPendingPeer {
+		return nil, ErrShiftSwapInvalidStatus
+	}
+
+	now := time.Now()
+	request.PeerRespondedAt = &now
+	request.PeerComment = comment
+	request.UpdatedAt = now
+
+	if !accept {
+		request.Status = model.ShiftSwapStatusPeerRejected
+		if err := s.swapRepo.Update(ctx, request); err != nil {
+			return nil, err
+		}
+		s.notify(ctx, request, "换班申请被拒绝", fmt.Sprintf("%s 拒绝了您的换班申请。", request.TargetEmployeeName), request.RequesterID)
+		return request, nil
+	}
+
+	if err := s.checkConflicts(ctx, request); err != nil {
+		return nil, err
+	}
+
+	// 审批流程未能启动时保持待对方确认，由对方重新确认
+	if s.workflowEngine != nil {
+		executionID, err := s.workflowEngine.ExecuteShiftSwapApproval(ctx, request, employeeID.String())
+		if err != nil {
+			return nil, fmt.Errorf("failed to start approval workflow: %w", err)
+		}
+		request.WorkflowExecutionID = executionID
+	}
+	request.Status = model.ShiftSwapStatusPendingApproval
+
+	if err := s.swapRepo.Update(ctx, request); err != nil {
+		return nil, err
+	}
+	s.notify(ctx, request, "换班申请待审批", fmt.Sprintf("%s 已同意您的换班申请，等待主管审批。", request.TargetEmployeeName), request.RequesterID)
+	return request, nil
+}
+
+func (s *shiftSwapService) Cancel(ctx context.Context, tenantID, id, employeeID uuid.UUID) (*model.ShiftSwapRequest, error) {
+	request, err := s.Get(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if request.RequesterID != employeeID {
+		return nil, ErrShiftSwapNotParticipant
+	}
+	if !request.Status.IsOpen() {
+		return nil, ErrShiftSwapInvalidStatus
+	}
+
+	s.finishWorkflow(request, false, employeeID, "申请人撤销")
+	request.Status = model.ShiftSwapStatusCancelled
+	request.UpdatedAt = time.Now()
+	if err := s.swapRepo.Update(ctx, request); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func (s *shiftSwapService) Approve(ctx context.Context, tenantID, id, approverID uuid.UUID, comment string) (*model.ShiftSwapRequest, error) {
+	request, err := s.Get(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if request.Status != model.ShiftSwapStatusPendingApproval {
+		return nil, ErrShiftSwapInvalidStatus
+	}
+	if err := s.checkApprover(ctx, request, approverID); err != nil {
+		return nil, err
+	}
+
+	// 审批期间可能新增了请假、出差或排班
+	if err := s.checkConflicts(ctx, request); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	request.ApproverID = &approverID
+	request.ApprovedAt = &now
+	request.ApprovalComment = comment
+	request.UpdatedAt = now
+
+	applied, err := s.swapRepo.ApplyApproval(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange schedules: %w", err)
+	}
+	if !applied {
+		return nil, ErrShiftSwapScheduleChanged
+	}
+	request.Status = model.ShiftSwapStatusApproved
+
+	s.finishWorkflow(request, true, approverID, comment)
+	content := fmt.Sprintf("%s 与 %s 的换班申请已批准：%s。", request.RequesterName, request.TargetEmployeeName, describeSwap(request))
+	s.notify(ctx, request, "换班申请已批准", content, request.RequesterID, request.TargetEmployeeID)
+	return request, nil
+}
+
+func (s *shiftSwapService) Reject(ctx context.Context, tenantID, id, approverID uuid.UUID, comment string) (*model.ShiftSwapRequest, error) {
+	request, err := s.Get(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if request.Status != model.ShiftSwapStatusPendingApproval {
+		return nil, ErrShiftSwapInvalidStatus
+	}
+	if err := s.checkApprover(ctx, request, approverID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	request.Status = model.ShiftSwapStatusRejected
+	request.ApproverID = &approverID
+	request.ApprovedAt = &now
+	request.ApprovalComment = comment
+	request.UpdatedAt = now
+	if err := s.swapRepo.Update(ctx, request); err != nil {
+		return nil, err
+	}
+
+	s.finishWorkflow(request, false, approverID, comment)
+	content := fmt.Sprintf("%s 与 %s 的换班申请被驳回。", request.RequesterName, request.TargetEmployeeName)
+	if comment != "" {
+		content += "原因：" + comment
+	}
+	s.notify(ctx, request, "换班申请被驳回", content, request.RequesterID, request.TargetEmployeeID)
+	return request, nil
+}
+
+func (s *shiftSwapService) Get(ctx context.Context, tenantID, id uuid.UUID) (*model.ShiftSwapRequest, error) {
+	request, err := s.swapRepo.FindByID(ctx, id)
+	if err != nil || request.TenantID != tenantID {
+		return nil, ErrShiftSwapNotFound
+	}
+	return request, nil
+}
+
+func (s *shiftSwapService) List(ctx context.Context, tenantID uuid.UUID, filter *repository.ShiftSwapFilter) ([]*model.ShiftSwapRequest, error) {
+	return s.swapRepo.List(ctx, tenantID, filter)
+}
+
+// loadSchedule 加载员工本人尚未执行的排班
+func (s *shiftSwapService) loadSchedule(ctx context.Context, tenantID, scheduleID, employeeID uuid.UUID) (*model.Schedule, error) {
+	schedule, err := s.scheduleRepo.FindByID(ctx, scheduleID)
+	if err != nil || schedule.TenantID != tenantID {
+		return nil, ErrShiftSwapScheduleMissing
+	}
+	if schedule.EmployeeID != employeeID {
+		return nil, ErrShiftSwapScheduleOwner
+	}
+	if schedule.Status == model.ScheduleStatusExecuted || dateKey(schedule.ScheduleDate) < dateKey(time.Now()) {
+		return nil, ErrShiftSwapPastSchedule
+	}
+	return schedule, nil
+}
+
+// checkConflicts 校验接班双方在接手班次的时段内没有请假、出差或其他排班，且考勤期间未锁定
+func (s *shiftSwapService) checkConflicts(ctx context.Context, request *model.ShiftSwapRequest) error {
+	exchanged := request.ScheduleIDs()
+
+	// 对方接手申请人的班次
+	err := s.checkTakeover(ctx, request.TenantID, request.TargetEmployeeID, request.RequesterShiftID, request.RequesterDate, exchanged)
+	if err != nil {
+		return err
+	}
+	if err := s.checkLocked(ctx, request.TenantID, request.RequesterID, request.RequesterDate); err != nil {
+		return err
+	}
+
+	if request.Type == model.ShiftSwapTypeSwap {
+		// 申请人接手对方的班次
+		err := s.checkTakeover(ctx, request.TenantID, request.RequesterID, *request.TargetShiftID, *request.TargetDate, exchanged)
+		if err != nil {
+			return err
+		}
+		if err := s.checkLocked(ctx, request.TenantID, request.TargetEmployeeID, *request.TargetDate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *shiftSwapService) checkTakeover(ctx context.Context, tenantID, employeeID, shiftID uuid.UUID, date time.Time, exchanged []uuid.UUID) error {
+	// 班次缺失时按默认工作时段判断
+	shift, _ := s.shiftRepo.FindByID(ctx, shiftID)
+	work := windowOn(shift, date).Work
+
+	if err := checkPeriod(ctx, s.periodGuard, tenantID, employeeID, work.Start, work.End); err != nil {
+		return err
+	}
+
+	onLeave, err := s.leaveRepo.CheckTimeConflict(ctx, tenantID, employeeID, work.Start, work.End, nil)
+	if err != nil {
+		return fmt.Errorf("failed to check leave conflict: %w", err)
+	}
+	if onLeave {
+		return fmt.Errorf("%w: employee %s is on leave on %s", ErrShiftSwapConflict, employeeID, dateKey(date))
+	}
+
+	trips, err := s.tripRepo.FindOverlapping(ctx, tenantID, employeeID, work.Start, work.End)
+	if err != nil {
+		return fmt.Errorf("failed to check business trip conflict: %w", err)
+	}
+	if len(trips) > 0 {
+		return fmt.Errorf("%w: employee %s is on a business trip on %s", ErrShiftSwapConflict, employeeID, dateKey(date))
+	}
+
+	day := truncateDate(date)
+	schedules, err := s.scheduleRepo.FindByEmployeesRange(ctx, tenantID, []uuid.UUID{employeeID}, day, day)
+	if err != nil {
+		return fmt.Errorf("failed to check schedule conflict: %w", err)
+	}
+	for _, schedule := range schedules {
+		if !containsUUID(exchanged, schedule.ID) {
+			return fmt.Errorf("%w: employee %s already has shift %s on %s", ErrShiftSwapConflict, employeeID, schedule.ShiftName, dateKey(date))
+		}
+	}
+	return nil
+}
+
+// checkApprover 审批人须为换班任一方的直属上级或所在组织负责人，换班双方不能审批自己的申请
+func (s *shiftSwapService) checkApprover(ctx context.Context, request *model.ShiftSwapRequest, approverID uuid.UUID) error {
+	participants := []uuid.UUID{request.RequesterID, request.TargetEmployeeID}
+	userIDs, err := s.hrmEmpRepo.FindUserIDs(ctx, request.TenantID, participants)
+	if err != nil {
+		return fmt.Errorf("failed to find participant users: %w", err)
+	}
+	for _, userID := range userIDs {
+		if userID == approverID {
+			return ErrShiftSwapNotApprover
+		}
+	}
+
+	for _, employeeID := range participants {
+		managers, err := s.hrmEmpRepo.FindManagerUserIDs(ctx, request.TenantID, employeeID)
+		if err != nil {
+			return fmt.Errorf("failed to find managers: %w", err)
+		}
+		if containsUUID(managers, approverID) {
+			return nil
+		}
+	}
+	return ErrShiftSwapNotApprover
+}
+
+// checkLocked 交出班次的一方同样不能改动已锁定期间的排班
+func (s *shiftSwapService) checkLocked(ctx context.Context, tenantID, employeeID uuid.UUID, date time.Time) error {
+	day := truncateDate(date)
+	return checkPeriod(ctx, s.periodGuard, tenantID, employeeID, day, day.AddDate(0, 0, 1))
+}
+
+// finishWorkflow 同步审批流程状态，流程不存在或已结束时忽略
+func (s *shiftSwapService) finishWorkflow(request *model.ShiftSwapRequest, approved bool, operatorID uuid.UUID, comment string) {
+	if s.workflowEngine == nil || request.WorkflowExecutionID == "" {
+		return
+	}
+	_ = s.workflowEngine.HandleApproval(request.WorkflowExecutionID, approved, operatorID, comment)
+}
+
+// notify 通知换班相关员工，通知失败不影响流程
+func (s *shiftSwapService) notify(ctx context.Context, request *model.ShiftSwapRequest, title, content string, employeeIDs ...uuid.UUID) {
+	if s.notificationService == nil {
+		return
+	}
+
+	userIDs, err := s.hrmEmpRepo.FindUserIDs(ctx, request.TenantID, employeeIDs)
+	if err != nil {
+		return
+	}
+
+	relatedType := "hrm_shift_swap"
+	relatedID := request.ID.String()
+	for _, employeeID := range employeeIDs {
+		userID, ok := userIDs[employeeID]
+		if !ok {
+			continue
+		}
+		_, _ = s.notificationService.SendNotification(ctx, request.TenantID, &notificationDto.SendNotificationRequest{
+			Type:        "system",
+			Channel:     "in_app",
+			RecipientID: userID.String(),
+			Title:       title,
+			Content:     content,
+			Data: map[string]interface{}{
+				"swap_request_id": request.ID.String(),
+				"swap_type":       string(request.Type),
+				"status":          string(request.Status),
+			},
+			RelatedType: &relatedType,
+			RelatedID:   &relatedID,
+		})
+	}
+}
+
+// describeSwap 生成换班内容描述
+func describeSwap(request *model.ShiftSwapRequest) string {
+	if request.Type == model.ShiftSwapTypeCover {
+		return fmt.Sprintf("代 %s 的 %s", dateKey(request.RequesterDate), request.RequesterShiftName)
+	}
+	return fmt.Sprintf("以 %s 的 %s 换 %s 的 %s",
+		dateKey(request.RequesterDate), request.RequesterShiftName, dateKey(*request.TargetDate), request.TargetShiftName)
+}
+
+func containsUUID(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,349 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lk2023060901/go-next-erp/internal/hrm/integration"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+	"github.com/lk2023060901/go-next-erp/pkg/workflow"
+)
+
+// stubShiftSwapRepo 内存换班申请仓储，ApplyApproval 记录是否执行了交换
+type stubShiftSwapRepo struct {
+	repository.ShiftSwapRepository
+	requests map[uuid.UUID]*model.ShiftSwapRequest
+	stale    bool // 模拟排班已被改动
+	applied  int
+}
+
+func (r *stubShiftSwapRepo) Create(ctx context.Context, request *model.ShiftSwapRequest) error {
+	r.requests[request.ID] = request
+	return nil
+}
+
+func (r *stubShiftSwapRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.ShiftSwapRequest, error) {
+	if request, ok := r.requests[id]; ok {
+		copied := *request
+		return &copied, nil
+	}
+	return nil, errStubNotFound
+}
+
+func (r *stubShiftSwapRepo) Update(ctx context.Context, request *model.ShiftSwapRequest) error {
+	r.requests[request.ID] = request
+	return nil
+}
+
+func (r *stubShiftSwapRepo) CountOpenBySchedule(ctx context.Context, scheduleID uuid.UUID) (int, error) {
+	count := 0
+	for _, request := range r.requests {
+		if request.Status.IsOpen() && containsUUID(request.ScheduleIDs(), scheduleID) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *stubShiftSwapRepo) ApplyApproval(ctx context.Context, request *model.ShiftSwapRequest) (bool, error) {
+	if r.stale {
+		return false, nil
+	}
+	r.applied++
+	approved := *request
+	approved.Status = model.ShiftSwapStatusApproved
+	r.requests[request.ID] = &approved
+	return true, nil
+}
+
+type stubSwapScheduleRepo struct {
+	repository.ScheduleRepository
+	schedules []*model.Schedule
+}
+
+func (r *stubSwapScheduleRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.Schedule, error) {
+	for _, s := range r.schedules {
+		if s.ID == id {
+			return s, nil
+		}
+	}
+	return nil, errStubNotFound
+}
+
+func (r *stubSwapScheduleRepo) FindByEmployeesRange(ctx context.Context, tenantID uuid.UUID, employeeIDs []uuid.UUID, start, end time.Time) ([]*model.Schedule, error) {
+	var result []*model.Schedule
+	for _, s := range r.schedules {
+		if containsUUID(employeeIDs, s.EmployeeID) && dateKey(s.ScheduleDate) >= dateKey(start) && dateKey(s.ScheduleDate) <= dateKey(end) {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+type stubLeaveConflictRepo struct {
+	repository.LeaveRequestRepository
+	onLeave map[uuid.UUID]bool
+}
+
+func (r *stubLeaveConflictRepo) CheckTimeConflict(ctx context.Context, tenantID, employeeID uuid.UUID, startTime, endTime time.Time, excludeID *uuid.UUID) (bool, error) {
+	return r.onLeave[employeeID], nil
+}
+
+type stubTripRepo struct {
+	repository.BusinessTripRepository
+	trips map[uuid.UUID][]*model.BusinessTrip
+}
+
+func (r *stubTripRepo) FindOverlapping(ctx context.Context, tenantID, employeeID uuid.UUID, startTime, endTime time.Time) ([]*model.BusinessTrip, error) {
+	return r.trips[employeeID], nil
+}
+
+// stubSwapEmployeeRepo 员工对应的用户及其上级用户
+type stubSwapEmployeeRepo struct {
+	stubDepartmentEmployeeRepo
+	users    map[uuid.UUID]uuid.UUID
+	managers map[uuid.UUID][]uuid.UUID
+}
+
+func (r *stubSwapEmployeeRepo) FindUserIDs(ctx context.Context, tenantID uuid.UUID, employeeIDs []uuid.UUID) (map[uuid.UUID]uuid.UUID, error) {
+	result := make(map[uuid.UUID]uuid.UUID)
+	for _, employeeID := range employeeIDs {
+		if userID, ok := r.users[employeeID]; ok {
+			result[employeeID] = userID
+		}
+	}
+	return result, nil
+}
+
+func (r *stubSwapEmployeeRepo) FindManagerUserIDs(ctx context.Context, tenantID, employeeID uuid.UUID) ([]uuid.UUID, error) {
+	return r.managers[employeeID], nil
+}
+
+func TestShiftSwapService(t *testing.T) {
+	ctx := context.Background()
+	tenantID, departmentID := uuid.New(), uuid.New()
+	dayShift := &model.Shift{ID: uuid.New(), Name: "白班", WorkStart: "09:00", WorkEnd: "18:00"}
+	nightShift := &model.Shift{ID: uuid.New(), Name: "夜班", WorkStart: "22:00", WorkEnd: "06:00"}
+	alice := &model.EmployeeTenure{EmployeeID: uuid.New(), Name: "Alice"}
+	bob := &model.EmployeeTenure{EmployeeID: uuid.New(), Name: "Bob"}
+	aliceUser, bobUser, managerID := uuid.New(), uuid.New(), uuid.New()
+
+	today := truncateDate(time.Now().UTC())
+	schedule := func(employee *model.EmployeeTenure, shift *model.Shift, date time.Time) *model.Schedule {
+		return &model.Schedule{
+			ID: uuid.New(), TenantID: tenantID, DepartmentID: departmentID,
+			EmployeeID: employee.EmployeeID, EmployeeName: employee.Name,
+			ShiftID: shift.ID, ShiftName: shift.Name, ScheduleDate: date,
+			Status: model.ScheduleStatusPublished,
+		}
+	}
+
+	type fixture struct {
+		svc    *shiftSwapService
+		swaps  *stubShiftSwapRepo
+		leaves *stubLeaveConflictRepo
+		trips  *stubTripRepo
+	}
+	newService := func(schedules ...*model.Schedule) fixture {
+		f := fixture{
+			swaps:  &stubShiftSwapRepo{requests: map[uuid.UUID]*model.ShiftSwapRequest{}},
+			leaves: &stubLeaveConflictRepo{onLeave: map[uuid.UUID]bool{}},
+			trips:  &stubTripRepo{trips: map[uuid.UUID][]*model.BusinessTrip{}},
+		}
+		f.svc = &shiftSwapService{
+			swapRepo:     f.swaps,
+			scheduleRepo: &stubSwapScheduleRepo{schedules: schedules},
+			shiftRepo:    &stubShiftRepo{shifts: map[uuid.UUID]*model.Shift{dayShift.ID: dayShift, nightShift.ID: nightShift}},
+			hrmEmpRepo: &stubSwapEmployeeRepo{
+				stubDepartmentEmployeeRepo: stubDepartmentEmployeeRepo{tenures: []*model.EmployeeTenure{alice, bob}},
+				users:                      map[uuid.UUID]uuid.UUID{alice.EmployeeID: aliceUser, bob.EmployeeID: bobUser},
+				// Alice 同时是 Bob 的上级
+				managers: map[uuid.UUID][]uuid.UUID{alice.EmployeeID: {managerID}, bob.EmployeeID: {managerID, aliceUser}},
+			},
+			leaveRepo: f.leaves,
+			tripRepo:  f.trips,
+		}
+		return f
+	}
+
+	t.Run("swap flow", func(t *testing.T) {
+		mine := schedule(alice, dayShift, today.AddDate(0, 0, 3))
+		theirs := schedule(bob, nightShift, today.AddDate(0, 0, 4))
+		f := newService(mine, theirs)
+
+		request, err := f.svc.Propose(ctx, &ProposeShiftSwapRequest{
+			TenantID: tenantID, RequesterID: alice.EmployeeID, RequesterScheduleID: mine.ID,
+			TargetEmployeeID: bob.EmployeeID, TargetScheduleID: &theirs.ID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, model.ShiftSwapTypeSwap, request.Type)
+		assert.Equal(t, model.ShiftSwapStatusPendingPeer, request.Status)
+		assert.Equal(t, "夜班", request.TargetShiftName)
+
+		// 同一排班不能重复发起
+		_, err = f.svc.Propose(ctx, &ProposeShiftSwapRequest{
+			TenantID: tenantID, RequesterID: bob.EmployeeID, RequesterScheduleID: theirs.ID,
+			TargetEmployeeID: alice.EmployeeID, TargetScheduleID: &mine.ID,
+		})
+		assert.ErrorIs(t, err, ErrShiftSwapDuplicate)
+
+		// 只有对方可以确认，审批前需要对方同意
+		_, err = f.svc.Respond(ctx, tenantID, request.ID, alice.EmployeeID, true, "")
+		assert.ErrorIs(t, err, ErrShiftSwapNotParticipant)
+		_, err = f.svc.Approve(ctx, tenantID, request.ID, managerID, "")
+		assert.ErrorIs(t, err, ErrShiftSwapInvalidStatus)
+
+		request, err = f.svc.Respond(ctx, tenantID, request.ID, bob.EmployeeID, true, "ok")
+		require.NoError(t, err)
+		assert.Equal(t, model.ShiftSwapStatusPendingApproval, request.Status)
+
+		// 换班双方不能审批，即使是对方的上级；其他人不是上级也不能审批
+		for _, approverID := range []uuid.UUID{aliceUser, bobUser, uuid.New()} {
+			_, err = f.svc.Approve(ctx, tenantID, request.ID, approverID, "同意")
+			assert.ErrorIs(t, err, ErrShiftSwapNotApprover)
+			_, err = f.svc.Reject(ctx, tenantID, request.ID, approverID, "")
+			assert.ErrorIs(t, err, ErrShiftSwapNotApprover)
+		}
+		assert.Zero(t, f.swaps.applied)
+
+		request, err = f.svc.Approve(ctx, tenantID, request.ID, managerID, "同意")
+		require.NoError(t, err)
+		assert.Equal(t, model.ShiftSwapStatusApproved, request.Status)
+		assert.Equal(t, managerID, *request.ApproverID)
+		assert.Equal(t, 1, f.swaps.applied)
+	})
+
+	t.Run("cover by colleague on leave", func(t *testing.T) {
+		mine := schedule(alice, dayShift, today.AddDate(0, 0, 2))
+		f := newService(mine)
+		f.leaves.onLeave[bob.EmployeeID] = true
+
+		_, err := f.svc.Propose(ctx, &ProposeShiftSwapRequest{
+			TenantID: tenantID, RequesterID: alice.EmployeeID, RequesterScheduleID: mine.ID,
+			TargetEmployeeID: bob.EmployeeID,
+		})
+		assert.ErrorIs(t, err, ErrShiftSwapConflict)
+	})
+
+	t.Run("taking over a day already scheduled", func(t *testing.T) {
+		mine := schedule(alice, dayShift, today.AddDate(0, 0, 3))
+		theirs := schedule(bob, nightShift, today.AddDate(0, 0, 4))
+		// Alice 在 Bob 的班次当天已有排班
+		busy := schedule(alice, dayShift, today.AddDate(0, 0, 4))
+		f := newService(mine, theirs, busy)
+
+		_, err := f.svc.Propose(ctx, &ProposeShiftSwapRequest{
+			TenantID: tenantID, RequesterID: alice.EmployeeID, RequesterScheduleID: mine.ID,
+			TargetEmployeeID: bob.EmployeeID, TargetScheduleID: &theirs.ID,
+		})
+		assert.ErrorIs(t, err, ErrShiftSwapConflict)
+	})
+
+	t.Run("same day swap does not conflict with itself", func(t *testing.T) {
+		mine := schedule(alice, dayShift, today.AddDate(0, 0, 1))
+		theirs := schedule(bob, nightShift, today.AddDate(0, 0, 1))
+		f := newService(mine, theirs)
+
+		_, err := f.svc.Propose(ctx, &ProposeShiftSwapRequest{
+			TenantID: tenantID, RequesterID: alice.EmployeeID, RequesterScheduleID: mine.ID,
+			TargetEmployeeID: bob.EmployeeID, TargetScheduleID: &theirs.ID,
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("trip booked before approval", func(t *testing.T) {
+		mine := schedule(alice, dayShift, today.AddDate(0, 0, 5))
+		f := newService(mine)
+
+		request, err := f.svc.Propose(ctx, &ProposeShiftSwapRequest{
+			TenantID: tenantID, RequesterID: alice.EmployeeID, RequesterScheduleID: mine.ID,
+			TargetEmployeeID: bob.EmployeeID,
+		})
+		require.NoError(t, err)
+		_, err = f.svc.Respond(ctx, tenantID, request.ID, bob.EmployeeID, true, "")
+		require.NoError(t, err)
+
+		f.trips.trips[bob.EmployeeID] = []*model.BusinessTrip{{ID: uuid.New()}}
+		_, err = f.svc.Approve(ctx, tenantID, request.ID, managerID, "")
+		assert.ErrorIs(t, err, ErrShiftSwapConflict)
+		assert.Zero(t, f.swaps.applied)
+	})
+
+	t.Run("schedule changed before approval", func(t *testing.T) {
+		mine := schedule(alice, dayShift, today.AddDate(0, 0, 5))
+		f := newService(mine)
+
+		request, err := f.svc.Propose(ctx, &ProposeShiftSwapRequest{
+			TenantID: tenantID, RequesterID: alice.EmployeeID, RequesterScheduleID: mine.ID,
+			TargetEmployeeID: bob.EmployeeID,
+		})
+		require.NoError(t, err)
+		_, err = f.svc.Respond(ctx, tenantID, request.ID, bob.EmployeeID, true, "")
+		require.NoError(t, err)
+
+		f.swaps.stale = true
+		_, err = f.svc.Approve(ctx, tenantID, request.ID, managerID, "")
+		assert.ErrorIs(t, err, ErrShiftSwapScheduleChanged)
+		assert.Equal(t, model.ShiftSwapStatusPendingApproval, f.swaps.requests[request.ID].Status)
+	})
+
+	t.Run("approval workflow", func(t *testing.T) {
+		engine, err := workflow.New()
+		require.NoError(t, err)
+		propose := func(f fixture) *model.ShiftSwapRequest {
+			mine := schedule(alice, dayShift, today.AddDate(0, 0, 3))
+			f.svc.scheduleRepo = &stubSwapScheduleRepo{schedules: []*model.Schedule{mine}}
+			request, err := f.svc.Propose(ctx, &ProposeShiftSwapRequest{
+				TenantID: tenantID, RequesterID: alice.EmployeeID, RequesterScheduleID: mine.ID,
+				TargetEmployeeID: bob.EmployeeID,
+			})
+			require.NoError(t, err)
+			return request
+		}
+
+		f := newService()
+		f.svc.workflowEngine, err = integration.NewShiftSwapWorkflowEngine(engine)
+		require.NoError(t, err)
+		// 换班节点类型带前缀，通用类型名仍留给其他流程；重复注册返回错误
+		assert.NoError(t, engine.RegisterNodeType("approval", func(def *workflow.NodeDefinition) (workflow.Node, error) { return nil, nil }))
+		_, err = integration.NewShiftSwapWorkflowEngine(engine)
+		assert.Error(t, err)
+		request, err := f.svc.Respond(ctx, tenantID, propose(f).ID, bob.EmployeeID, true, "")
+		require.NoError(t, err)
+		assert.NotEmpty(t, request.WorkflowExecutionID)
+		assert.Equal(t, model.ShiftSwapStatusPendingApproval, request.Status)
+
+		// 流程启动失败时返回错误，申请仍待对方确认
+		stopped, err := workflow.New()
+		require.NoError(t, err)
+		f = newService()
+		f.svc.workflowEngine, err = integration.NewShiftSwapWorkflowEngine(stopped)
+		require.NoError(t, err)
+		definition, err := f.svc.workflowEngine.CreateShiftSwapApprovalWorkflow(tenantID)
+		require.NoError(t, err)
+		definition.Status = workflow.WorkflowStatusInactive
+		require.NoError(t, stopped.CreateWorkflow(definition))
+
+		pending := propose(f)
+		_, err = f.svc.Respond(ctx, tenantID, pending.ID, bob.EmployeeID, true, "")
+		assert.ErrorIs(t, err, workflow.ErrWorkflowInvalidState)
+		assert.Equal(t, model.ShiftSwapStatusPendingPeer, f.swaps.requests[pending.ID].Status)
+	})
+
+	t.Run("past schedule", func(t *testing.T) {
+		mine := schedule(alice, dayShift, today.AddDate(0, 0, -1))
+		f := newService(mine)
+
+		_, err := f.svc.Propose(ctx, &ProposeShiftSwapRequest{
+			TenantID: tenantID, RequesterID: alice.EmployeeID, RequesterScheduleID: mine.ID,
+			TargetEmployeeID: bob.EmployeeID,
+		})
+		assert.ErrorIs(t, err, ErrShiftSwapPastSchedule)
+	})
+}
@@ -0,0 +1,394 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+)
+
+var (
+	ErrTimeConflict         = errors.New("time conflict with another request")
+	ErrInvalidCalendarRange = errors.New("invalid calendar range")
+)
+
+// maxCalendarDays 单次查询日历的最大天数
+const maxCalendarDays = 62
+
+// TimeConflictError 申请与员工已有的请假、出差、加班或外出冲突
+type TimeConflictError struct {
+	Conflicts []*model.TimeAllocation
+}
+
+func (e *TimeConflictError) Error() string {
+	first := e.Conflicts[0]
+	return fmt.Sprintf("time conflict: employee already has %s (%s) from %s to %s",
+		first.Kind, first.Status, first.StartTime.Format(time.DateTime), first.EndTime.Format(time.DateTime))
+}
+
+func (e *TimeConflictError) Unwrap() error {
+	return ErrTimeConflict
+}
+
+// TimeAllocationService 员工时间占用服务：请假、出差、加班、外出统一做冲突检测，并提供合并日历
+type TimeAllocationService interface {
+	// Check 检查占用是否与员工其他申请冲突（不含申请自身），冲突时返回 *TimeConflictError
+	Check(ctx context.Context, allocation *model.TimeAllocation) error
+
+	// Hold 写入或更新申请的占用，不做冲突检查
+	Hold(ctx context.Context, allocation *model.TimeAllocation) error
+
+	// Reserve 在同一事务内做冲突检查并写入占用，同一员工的并发提交串行化；
+	// 冲突时返回 *TimeConflictError，否则返回占用是否为本次新增
+	Reserve(ctx context.Context, allocation *model.TimeAllocation) (bool, error)
+
+	// Confirm 申请批准后将占用置为已批准
+	Confirm(ctx context.Context, kind model.TimeAllocationKind, sourceID uuid.UUID) error
+
+	// Release 申请被拒绝、撤回、取消或删除后释放占用
+	Release(ctx context.Context, kind model.TimeAllocationKind, sourceID uuid.UUID) error
+
+	// EmployeeCalendar 员工在 [startDate, endDate] 内的合并日历
+	EmployeeCalendar(ctx context.Context, tenantID, employeeID uuid.UUID, startDate, endDate time.Time) (*model.EmployeeCalendar, error)
+
+	// TeamCalendar 部门在职员工在 [startDate, endDate] 内的合并日历
+	TeamCalendar(ctx context.Context, tenantID, departmentID uuid.UUID, startDate, endDate time.Time) ([]*model.EmployeeCalendar, error)
+}
+
+type timeAllocationService struct {
+	repo         repository.TimeAllocationRepository
+	scheduleRepo repository.ScheduleRepository
+	shiftRepo    repository.ShiftRepository
+	hrmEmpRepo   repository.HRMEmployeeRepository
+}
+
+// NewTimeAllocationService 创建员工时间占用服务
+func NewTimeAllocationService(
+	repo repository.TimeAllocationRepository,
+	scheduleRepo repository.ScheduleRepository,
+	shiftRepo repository.ShiftRepository,
+	hrmEmpRepo repository.HRMEmployeeRepository,
+) TimeAllocationService {
+	return &timeAllocationService{
+		repo:         repo,
+		scheduleRepo: scheduleRepo,
+		shiftRepo:    shiftRepo,
+		hrmEmpRepo:   hrmEmpRepo,
+	}
+}
+
+func (s *timeAllocationService) Check(ctx context.Context, allocation *model.TimeAllocation) error {
+	existing, err := s.repo.FindOverlapping(ctx, allocation.TenantID, allocation.EmployeeID, allocation.StartTime, allocation.EndTime)
+	if err != nil {
+		return fmt.Errorf("failed to check time conflict: %w", err)
+	}
+
+	var conflicts []*model.TimeAllocation
+	for _, other := range existing {
+		if other.Kind == allocation.Kind && other.SourceID == allocation.SourceID {
+			continue
+		}
+		if allocation.Kind.ConflictsWith(other.Kind) {
+			conflicts = append(conflicts, other)
+		}
+	}
+	if len(conflicts) > 0 {
+		return &TimeConflictError{Conflicts: conflicts}
+	}
+	return nil
+}
+
+func (s *timeAllocationService) Hold(ctx context.Context, allocation *model.TimeAllocation) error {
+	prepareTimeAllocation(allocation)
+
+	if err := s.repo.Upsert(ctx, allocation); err != nil {
+		return fmt.Errorf("failed to save time allocation: %w", err)
+	}
+	return nil
+}
+
+func (s *timeAllocationService) Reserve(ctx context.Context, allocation *model.TimeAllocation) (bool, error) {
+	prepareTimeAllocation(allocation)
+
+	conflicts, created, err := s.repo.Reserve(ctx, allocation, allocation.Kind.ConflictingKinds())
+	if err != nil {
+		return false, fmt.Errorf("failed to reserve time allocation: %w", err)
+	}
+	if len(conflicts) > 0 {
+		return false, &TimeConflictError{Conflicts: conflicts}
+	}
+	return created, nil
+}
+
+// prepareTimeAllocation 写入前补全ID、状态和时间戳
+func prepareTimeAllocation(allocation *model.TimeAllocation) {
+	now := time.Now()
+	if allocation.ID == uuid.Nil {
+		allocation.ID = uuid.Must(uuid.NewV7())
+	}
+	if allocation.Status == "" {
+		allocation.Status = model.TimeAllocationPending
+	}
+	allocation.CreatedAt = now
+	allocation.UpdatedAt = now
+}
+
+func (s *timeAllocationService) Confirm(ctx context.Context, kind model.TimeAllocationKind, sourceID uuid.UUID) error {
+	if err := s.repo.UpdateStatus(ctx, kind, sourceID, model.TimeAllocationApproved); err != nil {
+		return fmt.Errorf("failed to confirm time allocation: %w", err)
+	}
+	return nil
+}
+
+func (s *timeAllocationService) Release(ctx context.Context, kind model.TimeAllocationKind, sourceID uuid.UUID) error {
+	if err := s.repo.DeleteBySource(ctx, kind, sourceID); err != nil {
+		return fmt.Errorf("failed to release time allocation: %w", err)
+	}
+	return nil
+}
+
+func (s *timeAllocationService) EmployeeCalendar(ctx context.Context, tenantID, employeeID uuid.UUID, startDate, endDate time.Time) (*model.EmployeeCalendar, error) {
+	tenure, err := s.hrmEmpRepo.FindTenure(ctx, tenantID, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	calendars, err := s.calendars(ctx, tenantID, []*model.EmployeeTenure{tenure}, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	return calendars[0], nil
+}
+
+func (s *timeAllocationService) TeamCalendar(ctx context.Context, tenantID, departmentID uuid.UUID, startDate, endDate time.Time) ([]*model.EmployeeCalendar, error) {
+	tenures, err := s.hrmEmpRepo.ListTenuresByDepartment(ctx, tenantID, departmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 只展示区间内在职的员工
+	active := make([]*model.EmployeeTenure, 0, len(tenures))
+	for _, tenure := range tenures {
+		if tenure.JoinDate != nil && tenure.JoinDate.After(endDate) {
+			continue
+		}
+		if tenure.LeaveDate != nil && tenure.LeaveDate.Before(startDate) {
+			continue
+		}
+		active = append(active, tenure)
+	}
+	return s.calendars(ctx, tenantID, active, startDate, endDate)
+}
+
+// calendars 合并员工的排班和时间占用，每名员工一份日历（顺序与 tenures 一致）
+func (s *timeAllocationService) calendars(ctx context.Context, tenantID uuid.UUID, tenures []*model.EmployeeTenure, startDate, endDate time.Time) ([]*model.EmployeeCalendar, error) {
+	startDate, endDate = truncateDate(startDate), truncateDate(endDate)
+	if endDate.Before(startDate) || endDate.Sub(startDate) >= maxCalendarDays*24*time.Hour {
+		return nil, fmt.Errorf("%w: at most %d days", ErrInvalidCalendarRange, maxCalendarDays)
+	}
+
+	calendars := make([]*model.EmployeeCalendar, 0, len(tenures))
+	byEmployee := make(map[uuid.UUID]*model.EmployeeCalendar, len(tenures))
+	employeeIDs := make([]uuid.UUID, 0, len(tenures))
+	for _, tenure := range tenures {
+		calendar := &model.EmployeeCalendar{
+			EmployeeID:   tenure.EmployeeID,
+			EmployeeName: tenure.Name,
+			Entries:      []*model.CalendarEntry{},
+		}
+		calendars = append(calendars, calendar)
+		byEmployee[tenure.EmployeeID] = calendar
+		employeeIDs = append(employeeIDs, tenure.EmployeeID)
+	}
+	if len(employeeIDs) == 0 {
+		return calendars, nil
+	}
+
+	schedules, err := s.scheduleRepo.FindByEmployeesRange(ctx, tenantID, employeeIDs, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	shifts := make(map[uuid.UUID]*model.Shift)
+	for _, schedule := range schedules {
+		calendar, ok := byEmployee[schedule.EmployeeID]
+		if !ok || schedule.Status == model.ScheduleStatusDraft {
+			continue
+		}
+
+		shift, cached := shifts[schedule.ShiftID]
+		if !cached {
+			// 班次已删除时按默认工作时间展示
+			shift, _ = s.shiftRepo.FindByID(ctx, schedule.ShiftID)
+			shifts[schedule.ShiftID] = shift
+		}
+		window := windowOn(shift, truncateDate(schedule.ScheduleDate))
+		calendar.Entries = append(calendar.Entries, &model.CalendarEntry{
+			Kind:      model.TimeAllocationSchedule,
+			SourceID:  schedule.ID,
+			Title:     schedule.ShiftName,
+			StartTime: window.Work.Start,
+			EndTime:   window.Work.End,
+			Status:    schedule.WorkdayType,
+		})
+	}
+
+	allocations, err := s.repo.FindByEmployees(ctx, tenantID, employeeIDs, startDate, endDate.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+	for _, allocation := range allocations {
+		calendar, ok := byEmployee[allocation.EmployeeID]
+		if !ok {
+			continue
+		}
+		calendar.Entries = append(calendar.Entries, &model.CalendarEntry{
+			Kind:      allocation.Kind,
+			SourceID:  allocation.SourceID,
+			Title:     allocation.Title,
+			StartTime: allocation.StartTime,
+			EndTime:   allocation.EndTime,
+			Status:    string(allocation.Status),
+		})
+	}
+
+	for _, calendar := range calendars {
+		sort.SliceStable(calendar.Entries, func(i, j int) bool {
+			return calendar.Entries[i].StartTime.Before(calendar.Entries[j].StartTime)
+		})
+	}
+	return calendars, nil
+}
+
+// checkTimeAllocation 冲突检查，未注入时间占用服务时跳过
+func checkTimeAllocation(ctx context.Context, allocations TimeAllocationService, allocation *model.TimeAllocation) error {
+	if allocations == nil {
+		return nil
+	}
+	return allocations.Check(ctx, allocation)
+}
+
+// holdTimeAllocation 写入占用，未注入时间占用服务时跳过
+func holdTimeAllocation(ctx context.Context, allocations TimeAllocationService, allocation *model.TimeAllocation) error {
+	if allocations == nil {
+		return nil
+	}
+	return allocations.Hold(ctx, allocation)
+}
+
+// reserveTimeAllocation 冲突检查通过后写入占用，返回占用是否为本次新增；未注入时间占用服务时跳过
+func reserveTimeAllocation(ctx context.Context, allocations TimeAllocationService, allocation *model.TimeAllocation) (bool, error) {
+	if allocations == nil {
+		return false, nil
+	}
+	return allocations.Reserve(ctx, allocation)
+}
+
+// undoTimeAllocation 占用写入后的后续步骤失败时撤销本次占用并返回原错误：
+// 本次新增的占用直接释放，已存在的占用恢复为 previous（为 nil 时保持不变）
+func undoTimeAllocation(ctx context.Context, allocations TimeAllocationService, allocation *model.TimeAllocation, created bool, previous *model.TimeAllocation, cause error) error {
+	if allocations == nil {
+		return cause
+	}
+
+	// 请求已取消时仍需撤销
+	ctx = context.WithoutCancel(ctx)
+	var err error
+	switch {
+	case created:
+		err = allocations.Release(ctx, allocation.Kind, allocation.SourceID)
+	case previous != nil:
+		err = allocations.Hold(ctx, previous)
+	}
+	if err != nil {
+		return fmt.Errorf("%w (failed to undo time allocation: %v)", cause, err)
+	}
+	return cause
+}
+
+// confirmTimeAllocation 申请批准后确认占用，未注入时间占用服务时跳过
+func confirmTimeAllocation(ctx context.Context, allocations TimeAllocationService, kind model.TimeAllocationKind, sourceID uuid.UUID) error {
+	if allocations == nil {
+		return nil
+	}
+	return allocations.Confirm(ctx, kind, sourceID)
+}
+
+// releaseTimeAllocation 释放占用，未注入时间占用服务时跳过
+func releaseTimeAllocation(ctx context.Context, allocations TimeAllocationService, kind model.TimeAllocationKind, sourceID uuid.UUID) error {
+	if allocations == nil {
+		return nil
+	}
+	return allocations.Release(ctx, kind, sourceID)
+}
+
+// leaveAllocation 请假申请对应的占用
+func leaveAllocation(req *model.LeaveRequest) *model.TimeAllocation {
+	return &model.TimeAllocation{
+		TenantID:     req.TenantID,
+		EmployeeID:   req.EmployeeID,
+		EmployeeName: req.EmployeeName,
+		Kind:         model.TimeAllocationLeave,
+		SourceID:     req.ID,
+		Title:        req.LeaveTypeName,
+		StartTime:    req.StartTime,
+		EndTime:      req.EndTime,
+		Status:       approvalAllocationStatus(string(req.Status)),
+	}
+}
+
+// tripAllocation 出差申请对应的占用
+func tripAllocation(trip *model.BusinessTrip) *model.TimeAllocation {
+	return &model.TimeAllocation{
+		TenantID:     trip.TenantID,
+		EmployeeID:   trip.EmployeeID,
+		EmployeeName: trip.EmployeeName,
+		Kind:         model.TimeAllocationTrip,
+		SourceID:     trip.ID,
+		Title:        trip.Destination,
+		StartTime:    trip.StartTime,
+		EndTime:      trip.EndTime,
+		Status:       approvalAllocationStatus(trip.ApprovalStatus),
+	}
+}
+
+// overtimeAllocation 加班申请对应的占用
+func overtimeAllocation(overtime *model.Overtime) *model.TimeAllocation {
+	return &model.TimeAllocation{
+		TenantID:     overtime.TenantID,
+		EmployeeID:   overtime.EmployeeID,
+		EmployeeName: overtime.EmployeeName,
+		Kind:         model.TimeAllocationOvertime,
+		SourceID:     overtime.ID,
+		Title:        string(overtime.OvertimeType),
+		StartTime:    overtime.StartTime,
+		EndTime:      overtime.EndTime,
+		Status:       approvalAllocationStatus(overtime.ApprovalStatus),
+	}
+}
+
+// outingAllocation 外出申请对应的占用
+func outingAllocation(leaveOffice *model.LeaveOffice) *model.TimeAllocation {
+	return &model.TimeAllocation{
+		TenantID:     leaveOffice.TenantID,
+		EmployeeID:   leaveOffice.EmployeeID,
+		EmployeeName: leaveOffice.EmployeeName,
+		Kind:         model.TimeAllocationOuting,
+		SourceID:     leaveOffice.ID,
+		Title:        leaveOffice.Destination,
+		StartTime:    leaveOffice.StartTime,
+		EndTime:      leaveOffice.EndTime,
+		Status:       approvalAllocationStatus(leaveOffice.ApprovalStatus),
+	}
+}
+
+func approvalAllocationStatus(status string) model.TimeAllocationStatus {
+	if status == "approved" {
+		return model.TimeAllocationApproved
+	}
+	return model.TimeAllocationPending
+}
@@ -0,0 +1,343 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+)
+
+// stubTimeAllocationRepo 内存时间占用仓储，按 (类型, 申请ID) 去重
+type stubTimeAllocationRepo struct {
+	repository.TimeAllocationRepository
+	allocations []*model.TimeAllocation
+}
+
+func (r *stubTimeAllocationRepo) find(kind model.TimeAllocationKind, sourceID uuid.UUID) int {
+	for i, a := range r.allocations {
+		if a.Kind == kind && a.SourceID == sourceID {
+			return i
+		}
+	}
+	return -1
+}
+
+func (r *stubTimeAllocationRepo) Upsert(ctx context.Context, allocation *model.TimeAllocation) error {
+	if i := r.find(allocation.Kind, allocation.SourceID); i >= 0 {
+		r.allocations[i] = allocation
+		return nil
+	}
+	r.allocations = append(r.allocations, allocation)
+	return nil
+}
+
+func (r *stubTimeAllocationRepo) Reserve(ctx context.Context, allocation *model.TimeAllocation, conflictKinds []model.TimeAllocationKind) ([]*model.TimeAllocation, bool, error) {
+	existing, _ := r.FindOverlapping(ctx, allocation.TenantID, allocation.EmployeeID, allocation.StartTime, allocation.EndTime)
+	var conflicts []*model.TimeAllocation
+	for _, other := range existing {
+		if other.Kind == allocation.Kind && other.SourceID == allocation.SourceID {
+			continue
+		}
+		for _, kind := range conflictKinds {
+			if other.Kind == kind {
+				conflicts = append(conflicts, other)
+			}
+		}
+	}
+	if len(conflicts) > 0 {
+		return conflicts, false, nil
+	}
+
+	created := r.find(allocation.Kind, allocation.SourceID) < 0
+	return nil, created, r.Upsert(ctx, allocation)
+}
+
+func (r *stubTimeAllocationRepo) UpdateStatus(ctx context.Context, kind model.TimeAllocationKind, sourceID uuid.UUID, status model.TimeAllocationStatus) error {
+	if i := r.find(kind, sourceID); i >= 0 {
+		r.allocations[i].Status = status
+	}
+	return nil
+}
+
+func (r *stubTimeAllocationRepo) DeleteBySource(ctx context.Context, kind model.TimeAllocationKind, sourceID uuid.UUID) error {
+	if i := r.find(kind, sourceID); i >= 0 {
+		r.allocations = append(r.allocations[:i], r.allocations[i+1:]...)
+	}
+	return nil
+}
+
+func (r *stubTimeAllocationRepo) FindOverlapping(ctx context.Context, tenantID, employeeID uuid.UUID, start, end time.Time) ([]*model.TimeAllocation, error) {
+	return r.FindByEmployees(ctx, tenantID, []uuid.UUID{employeeID}, start, end)
+}
+
+func (r *stubTimeAllocationRepo) FindByEmployees(ctx context.Context, tenantID uuid.UUID, employeeIDs []uuid.UUID, start, end time.Time) ([]*model.TimeAllocation, error) {
+	var found []*model.TimeAllocation
+	for _, a := range r.allocations {
+		if containsUUID(employeeIDs, a.EmployeeID) && a.Overlaps(start, end) {
+			found = append(found, a)
+		}
+	}
+	return found, nil
+}
+
+// stubLeaveOfficeRepo 内存外出仓储
+type stubLeaveOfficeRepo struct {
+	repository.LeaveOfficeRepository
+	records map[uuid.UUID]*model.LeaveOffice
+	err     error // 模拟写入失败
+}
+
+func (r *stubLeaveOfficeRepo) Create(ctx context.Context, leaveOffice *model.LeaveOffice) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.records[leaveOffice.ID] = leaveOffice
+	return nil
+}
+
+func (r *stubLeaveOfficeRepo) Update(ctx context.Context, leaveOffice *model.LeaveOffice) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.records[leaveOffice.ID] = leaveOffice
+	return nil
+}
+
+func (r *stubLeaveOfficeRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.LeaveOffice, error) {
+	if record, ok := r.records[id]; ok {
+		copied := *record
+		return &copied, nil
+	}
+	return nil, errStubNotFound
+}
+
+func (r *stubLeaveOfficeRepo) FindOverlapping(ctx context.Context, tenantID, employeeID uuid.UUID, startTime, endTime time.Time) ([]*model.LeaveOffice, error) {
+	return nil, nil
+}
+
+func TestTimeAllocationKind_ConflictsWith(t *testing.T) {
+	tests := []struct {
+		a, b     model.TimeAllocationKind
+		conflict bool
+	}{
+		{model.TimeAllocationLeave, model.TimeAllocationTrip, true},
+		{model.TimeAllocationLeave, model.TimeAllocationOvertime, true},
+		{model.TimeAllocationLeave, model.TimeAllocationLeave, true},
+		{model.TimeAllocationTrip, model.TimeAllocationOuting, true},
+		{model.TimeAllocationTrip, model.TimeAllocationTrip, true},
+		{model.TimeAllocationOvertime, model.TimeAllocationOvertime, true},
+		{model.TimeAllocationOvertime, model.TimeAllocationTrip, false},
+		{model.TimeAllocationOuting, model.TimeAllocationOvertime, false},
+		{model.TimeAllocationSchedule, model.TimeAllocationLeave, false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.conflict, tt.a.ConflictsWith(tt.b), "%s vs %s", tt.a, tt.b)
+		assert.Equal(t, tt.conflict, tt.b.ConflictsWith(tt.a), "%s vs %s", tt.b, tt.a)
+	}
+}
+
+func TestTimeAllocationService_Check(t *testing.T) {
+	ctx := context.Background()
+	tenantID, employeeID := uuid.New(), uuid.New()
+	at := func(day, hour int) time.Time { return time.Date(2025, 10, day, hour, 0, 0, 0, time.Local) }
+
+	leave := &model.TimeAllocation{
+		TenantID: tenantID, EmployeeID: employeeID, Kind: model.TimeAllocationLeave, SourceID: uuid.New(),
+		StartTime: at(13, 9), EndTime: at(15, 18), Status: model.TimeAllocationApproved,
+	}
+	trip := &model.TimeAllocation{
+		TenantID: tenantID, EmployeeID: employeeID, Kind: model.TimeAllocationTrip, SourceID: uuid.New(),
+		StartTime: at(20, 9), EndTime: at(22, 18),
+	}
+	repo := &stubTimeAllocationRepo{allocations: []*model.TimeAllocation{leave, trip}}
+	svc := NewTimeAllocationService(repo, nil, nil, nil)
+
+	t.Run("trip during leave", func(t *testing.T) {
+		err := svc.Check(ctx, &model.TimeAllocation{
+			TenantID: tenantID, EmployeeID: employeeID, Kind: model.TimeAllocationTrip, SourceID: uuid.New(),
+			StartTime: at(14, 9), EndTime: at(16, 18),
+		})
+		require.True(t, errors.Is(err, ErrTimeConflict))
+
+		var conflict *TimeConflictError
+		require.True(t, errors.As(err, &conflict))
+		require.Len(t, conflict.Conflicts, 1)
+		assert.Equal(t, leave.SourceID, conflict.Conflicts[0].SourceID)
+	})
+
+	t.Run("overtime during trip", func(t *testing.T) {
+		err := svc.Check(ctx, &model.TimeAllocation{
+			TenantID: tenantID, EmployeeID: employeeID, Kind: model.TimeAllocationOvertime, SourceID: uuid.New(),
+			StartTime: at(21, 19), EndTime: at(21, 22),
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("adjacent periods", func(t *testing.T) {
+		err := svc.Check(ctx, &model.TimeAllocation{
+			TenantID: tenantID, EmployeeID: employeeID, Kind: model.TimeAllocationOuting, SourceID: uuid.New(),
+			StartTime: at(15, 18), EndTime: at(15, 20),
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("request itself is ignored", func(t *testing.T) {
+		moved := *trip
+		moved.StartTime, moved.EndTime = at(19, 9), at(21, 18)
+		assert.NoError(t, svc.Check(ctx, &moved))
+	})
+
+	t.Run("reserve", func(t *testing.T) {
+		outing := &model.TimeAllocation{
+			TenantID: tenantID, EmployeeID: employeeID, Kind: model.TimeAllocationOuting, SourceID: uuid.New(),
+			StartTime: at(14, 9), EndTime: at(14, 11),
+		}
+		_, err := svc.Reserve(ctx, outing)
+		require.True(t, errors.Is(err, ErrTimeConflict))
+		assert.Len(t, repo.allocations, 2)
+
+		outing.StartTime, outing.EndTime = at(16, 9), at(16, 11)
+		created, err := svc.Reserve(ctx, outing)
+		require.NoError(t, err)
+		assert.True(t, created)
+		assert.Len(t, repo.allocations, 3)
+
+		created, err = svc.Reserve(ctx, outing)
+		require.NoError(t, err)
+		assert.False(t, created)
+	})
+
+	t.Run("other employee", func(t *testing.T) {
+		err := svc.Check(ctx, &model.TimeAllocation{
+			TenantID: tenantID, EmployeeID: uuid.New(), Kind: model.TimeAllocationLeave, SourceID: uuid.New(),
+			StartTime: at(14, 9), EndTime: at(14, 18),
+		})
+		assert.NoError(t, err)
+	})
+}
+
+func TestLeaveOfficeService_TimeAllocation(t *testing.T) {
+	ctx := context.Background()
+	tenantID, employeeID := uuid.New(), uuid.New()
+	start := time.Now().AddDate(0, 0, 3).Truncate(time.Hour)
+
+	allocations := &stubTimeAllocationRepo{allocations: []*model.TimeAllocation{{
+		TenantID: tenantID, EmployeeID: employeeID, Kind: model.TimeAllocationLeave, SourceID: uuid.New(),
+		StartTime: start, EndTime: start.Add(8 * time.Hour), Status: model.TimeAllocationPending,
+	}}}
+	records := &stubLeaveOfficeRepo{records: map[uuid.UUID]*model.LeaveOffice{}}
+	svc := NewLeaveOfficeService(nil, records, nil, nil, NewTimeAllocationService(allocations, nil, nil, nil))
+
+	t.Run("outing during pending leave", func(t *testing.T) {
+		err := svc.Create(ctx, &model.LeaveOffice{
+			TenantID: tenantID, EmployeeID: employeeID,
+			StartTime: start.Add(2 * time.Hour), EndTime: start.Add(4 * time.Hour),
+		})
+		require.True(t, errors.Is(err, ErrTimeConflict))
+		assert.Empty(t, records.records)
+	})
+
+	t.Run("create, approve and reject", func(t *testing.T) {
+		approved := &model.LeaveOffice{
+			TenantID: tenantID, EmployeeID: employeeID, Destination: "客户现场",
+			StartTime: start.AddDate(0, 0, 1), EndTime: start.AddDate(0, 0, 1).Add(3 * time.Hour),
+		}
+		require.NoError(t, svc.Create(ctx, approved))
+		require.Len(t, allocations.allocations, 2)
+		assert.Equal(t, model.TimeAllocationPending, allocations.allocations[1].Status)
+		assert.Equal(t, "客户现场", allocations.allocations[1].Title)
+
+		require.NoError(t, svc.Approve(ctx, approved.ID, uuid.New(), ""))
+		assert.Equal(t, model.TimeAllocationApproved, allocations.allocations[1].Status)
+
+		rejected := &model.LeaveOffice{
+			TenantID: tenantID, EmployeeID: employeeID,
+			StartTime: start.AddDate(0, 0, 2), EndTime: start.AddDate(0, 0, 2).Add(time.Hour),
+		}
+		require.NoError(t, svc.Create(ctx, rejected))
+		require.Len(t, allocations.allocations, 3)
+
+		require.NoError(t, svc.Reject(ctx, rejected.ID, uuid.New(), "无需外出"))
+		require.Len(t, allocations.allocations, 2)
+		assert.Equal(t, approved.ID, allocations.allocations[1].SourceID)
+	})
+
+	t.Run("failed write undoes the hold", func(t *testing.T) {
+		pending := &model.LeaveOffice{
+			TenantID: tenantID, EmployeeID: employeeID,
+			StartTime: start.AddDate(0, 0, 3), EndTime: start.AddDate(0, 0, 3).Add(time.Hour),
+		}
+		require.NoError(t, svc.Create(ctx, pending))
+		require.Len(t, allocations.allocations, 3)
+
+		records.err = errors.New("connection reset")
+		defer func() { records.err = nil }()
+
+		// 创建失败：释放新增的占用
+		err := svc.Create(ctx, &model.LeaveOffice{
+			TenantID: tenantID, EmployeeID: employeeID,
+			StartTime: start.AddDate(0, 0, 4), EndTime: start.AddDate(0, 0, 4).Add(time.Hour),
+		})
+		require.ErrorIs(t, err, records.err)
+		require.Len(t, allocations.allocations, 3)
+
+		// 更新失败：恢复原来的占用时间
+		moved := *pending
+		moved.StartTime, moved.EndTime = start.AddDate(0, 0, 5), start.AddDate(0, 0, 5).Add(time.Hour)
+		require.ErrorIs(t, svc.Update(ctx, &moved), records.err)
+		require.Len(t, allocations.allocations, 3)
+		assert.True(t, allocations.allocations[2].StartTime.Equal(pending.StartTime))
+	})
+}
+
+func TestTimeAllocationService_TeamCalendar(t *testing.T) {
+	ctx := context.Background()
+	tenantID := uuid.New()
+	shift := &model.Shift{ID: uuid.New(), Name: "白班", WorkStart: "09:00", WorkEnd: "18:00"}
+	alice, bob, left := uuid.New(), uuid.New(), uuid.New()
+	leftDate := mustDate("2025-09-30")
+
+	employees := &stubDepartmentEmployeeRepo{tenures: []*model.EmployeeTenure{
+		{EmployeeID: alice, Name: "Alice"},
+		{EmployeeID: bob, Name: "Bob"},
+		{EmployeeID: left, Name: "Left", LeaveDate: &leftDate},
+	}}
+	schedules := &stubRotationScheduleRepo{existing: []*model.Schedule{
+		{ID: uuid.New(), EmployeeID: alice, ShiftID: shift.ID, ShiftName: shift.Name, ScheduleDate: mustDate("2025-10-14"), WorkdayType: "workday", Status: model.ScheduleStatusPublished},
+		{ID: uuid.New(), EmployeeID: alice, ShiftID: shift.ID, ShiftName: shift.Name, ScheduleDate: mustDate("2025-10-13"), WorkdayType: "workday", Status: model.ScheduleStatusPublished},
+		{ID: uuid.New(), EmployeeID: bob, ShiftID: shift.ID, ShiftName: shift.Name, ScheduleDate: mustDate("2025-10-13"), WorkdayType: "workday", Status: model.ScheduleStatusDraft},
+	}}
+	tripID := uuid.New()
+	allocations := &stubTimeAllocationRepo{allocations: []*model.TimeAllocation{{
+		TenantID: tenantID, EmployeeID: bob, Kind: model.TimeAllocationTrip, SourceID: tripID, Title: "上海",
+		StartTime: mustDate("2025-10-12"), EndTime: mustDate("2025-10-14"), Status: model.TimeAllocationApproved,
+	}}}
+	svc := NewTimeAllocationService(allocations, schedules, &stubShiftRepo{shifts: map[uuid.UUID]*model.Shift{shift.ID: shift}}, employees)
+
+	calendars, err := svc.TeamCalendar(ctx, tenantID, uuid.New(), mustDate("2025-10-13"), mustDate("2025-10-19"))
+	require.NoError(t, err)
+	require.Len(t, calendars, 2)
+
+	assert.Equal(t, alice, calendars[0].EmployeeID)
+	require.Len(t, calendars[0].Entries, 2)
+	first := calendars[0].Entries[0]
+	assert.Equal(t, model.TimeAllocationSchedule, first.Kind)
+	assert.Equal(t, time.Date(2025, 10, 13, 9, 0, 0, 0, time.UTC), first.StartTime)
+	assert.Equal(t, time.Date(2025, 10, 13, 18, 0, 0, 0, time.UTC), first.EndTime)
+
+	// 草稿排班不展示
+	assert.Equal(t, bob, calendars[1].EmployeeID)
+	require.Len(t, calendars[1].Entries, 1)
+	assert.Equal(t, model.TimeAllocationTrip, calendars[1].Entries[0].Kind)
+	assert.Equal(t, tripID, calendars[1].Entries[0].SourceID)
+
+	_, err = svc.TeamCalendar(ctx, tenantID, uuid.New(), mustDate("2025-10-01"), mustDate("2025-12-31"))
+	assert.True(t, errors.Is(err, ErrInvalidCalendarRange))
+}
@@ -0,0 +1,786 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	approvalDto "github.com/lk2023060901/go-next-erp/internal/approval/dto"
+	approvalModel "github.com/lk2023060901/go-next-erp/internal/approval/model"
+	approvalService "github.com/lk2023060901/go-next-erp/internal/approval/service"
+	fileModel "github.com/lk2023060901/go-next-erp/internal/file/model"
+	fileService "github.com/lk2023060901/go-next-erp/internal/file/service"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+)
+
+// tripExpenseProcessCode 出差报销在审批模块中的流程编码，租户需先创建该编码的流程定义
+const tripExpenseProcessCode = "hrm_trip_expense"
+
+var (
+	ErrTripNotFound                  = errors.New("business trip not found")
+	ErrTripNotApproved               = errors.New("business trip is not approved")
+	ErrTripExpenseNotFound           = errors.New("trip expense not found")
+	ErrTripExpenseInvalid            = errors.New("invalid trip expense")
+	ErrTripExpenseDateOutOfRange     = errors.New("expense date is outside the trip period")
+	ErrTripExpenseCurrencyMismatch   = errors.New("all expenses of a trip must use the same currency")
+	ErrTripExpenseClaimLocked        = errors.New("trip expense claim is under approval or approved")
+	ErrTripExpenseClaimEmpty         = errors.New("trip expense claim has no items")
+	ErrTripExpenseReceiptRequired    = errors.New("receipt is required for this expense")
+	ErrTripExpenseNoteRequired       = errors.New("over-policy expense requires a note")
+	ErrTripExpenseClaimNotApproved   = errors.New("trip expense claim is not approved")
+	ErrTripExpenseProcessNotDefined  = errors.New("trip expense approval process is not configured")
+	ErrTripExpensePolicyNotFound     = errors.New("trip expense policy not found")
+	ErrTripExpensePolicyInvalid      = errors.New("invalid trip expense policy")
+	ErrTripDestinationTierDuplicated = errors.New("destination tier keyword duplicated")
+)
+
+// TripExpenseService 出差报销服务：报销明细与票据、差旅标准校验、报销单审批
+type TripExpenseService interface {
+	// 报销明细
+	AddExpense(ctx context.Context, expense *model.TripExpense) error
+	UpdateExpense(ctx context.Context, expense *model.TripExpense) error
+	DeleteExpense(ctx context.Context, tenantID, expenseID uuid.UUID) error
+
+	// AttachReceipt 通过文件模块关联票据
+	AttachReceipt(ctx context.Context, tenantID, expenseID, fileID, operatorID uuid.UUID) error
+	// DetachReceipt 取消票据关联
+	DetachReceipt(ctx context.Context, tenantID, expenseID, fileID uuid.UUID) error
+
+	// GetClaim 查询报销单（含明细、票据），审批中的报销单同步审批结果
+	GetClaim(ctx context.Context, tenantID, tripID uuid.UUID) (*model.TripExpenseClaim, error)
+
+	// SubmitClaim 提交报销单到审批模块
+	SubmitClaim(ctx context.Context, tenantID, tripID, submitterID uuid.UUID) (*model.TripExpenseClaim, error)
+
+	// ApprovedTotal 已审批通过的报销合计（无明细时为 0），用于出差报告汇总实际费用
+	ApprovedTotal(ctx context.Context, tenantID, tripID uuid.UUID) (float64, error)
+
+	// 差旅标准
+	CreatePolicy(ctx context.Context, policy *model.TripExpensePolicy) error
+	UpdatePolicy(ctx context.Context, policy *model.TripExpensePolicy) error
+	DeletePolicy(ctx context.Context, tenantID, id uuid.UUID) error
+	GetPolicy(ctx context.Context, tenantID, id uuid.UUID) (*model.TripExpensePolicy, error)
+	ListPolicies(ctx context.Context, tenantID uuid.UUID) ([]*model.TripExpensePolicy, error)
+
+	// 目的地级别
+	ListDestinationTiers(ctx context.Context, tenantID uuid.UUID) ([]*model.TripDestinationTier, error)
+	SetDestinationTiers(ctx context.Context, tenantID uuid.UUID, tiers []*model.TripDestinationTier) ([]*model.TripDestinationTier, error)
+
+	// ResolvePolicy 出差适用的差旅标准（按目的地级别和员工职级匹配，未配置时返回 nil）
+	ResolvePolicy(ctx context.Context, tenantID, tripID uuid.UUID) (*model.TripExpensePolicy, string, error)
+}
+
+type tripExpenseService struct {
+	tripRepo     repository.BusinessTripRepository
+	expenseRepo  repository.TripExpenseRepository
+	policyRepo   repository.TripExpensePolicyRepository
+	hrmEmpRepo   repository.HRMEmployeeRepository
+	fileRelation fileService.FileRelationService
+	approval     approvalService.ApprovalService
+}
+
+// NewTripExpenseService 创建出差报销服务
+func NewTripExpenseService(
+	tripRepo repository.BusinessTripRepository,
+	expenseRepo repository.TripExpenseRepository,
+	policyRepo repository.TripExpensePolicyRepository,
+	hrmEmpRepo repository.HRMEmployeeRepository,
+	fileRelation fileService.FileRelationService,
+	approval approvalService.ApprovalService,
+) TripExpenseService {
+	s := &tripExpenseService{
+		tripRepo:     tripRepo,
+		expenseRepo:  expenseRepo,
+		policyRepo:   policyRepo,
+		hrmEmpRepo:   hrmEmpRepo,
+		fileRelation: fileRelation,
+		approval:     approval,
+	}
+	if approval != nil {
+		approval.OnProcessClosed(s.onApprovalClosed)
+	}
+	return s
+}
+
+func (s *tripExpenseService) AddExpense(ctx context.Context, expense *model.TripExpense) error {
+	trip, claim, err := s.editableTrip(ctx, expense.TenantID, expense.TripID)
+	if err != nil {
+		return err
+	}
+	if err := s.validateExpense(ctx, trip, expense); err != nil {
+		return err
+	}
+
+	expense.ID = uuid.Must(uuid.NewV7())
+	now := time.Now()
+	expense.CreatedAt = now
+	expense.UpdatedAt = now
+	if err := s.expenseRepo.Create(ctx, expense); err != nil {
+		return err
+	}
+
+	for _, fileID := range expense.ReceiptFileIDs {
+		if err := s.AttachReceipt(ctx, expense.TenantID, expense.ID, fileID, expense.CreatedBy); err != nil {
+			return err
+		}
+	}
+
+	return s.reevaluate(ctx, trip, claim)
+}
+
+func (s *tripExpenseService) UpdateExpense(ctx context.Context, expense *model.TripExpense) error {
+	existing, err := s.findExpense(ctx, expense.TenantID, expense.ID)
+	if err != nil {
+		return err
+	}
+	trip, claim, err := s.editableTrip(ctx, existing.TenantID, existing.TripID)
+	if err != nil {
+		return err
+	}
+
+	expense.TripID = existing.TripID
+	if err := s.validateExpense(ctx, trip, expense); err != nil {
+		return err
+	}
+
+	expense.UpdatedAt = time.Now()
+	if err := s.expenseRepo.Update(ctx, expense); err != nil {
+		return err
+	}
+	return s.reevaluate(ctx, trip, claim)
+}
+
+func (s *tripExpenseService) DeleteExpense(ctx context.Context, tenantID, expenseID uuid.UUID) error {
+	existing, err := s.findExpense(ctx, tenantID, expenseID)
+	if err != nil {
+		return err
+	}
+	trip, claim, err := s.editableTrip(ctx, tenantID, existing.TripID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.fileRelation.DetachAllFilesFromEntity(ctx, fileModel.EntityTypeTripExpense, expenseID); err != nil {
+		return err
+	}
+	if err := s.expenseRepo.Delete(ctx, expenseID); err != nil {
+		return err
+	}
+	return s.reevaluate(ctx, trip, claim)
+}
+
+func (s *tripExpenseService) AttachReceipt(ctx context.Context, tenantID, expenseID, fileID, operatorID uuid.UUID) error {
+	expense, err := s.findExpense(ctx, tenantID, expenseID)
+	if err != nil {
+		return err
+	}
+	if _, _, err := s.editableTrip(ctx, tenantID, expense.TripID); err != nil {
+		return err
+	}
+
+	return s.fileRelation.AttachFileToEntity(ctx, &fileService.AttachFileRequest{
+		FileID:       fileID,
+		TenantID:     tenantID,
+		EntityType:   fileModel.EntityTypeTripExpense,
+		EntityID:     expenseID,
+		RelationType: fileModel.RelationTypeReceipt,
+		CreatedBy:    operatorID,
+	})
+}
+
+func (s *tripExpenseService) DetachReceipt(ctx context.Context, tenantID, expenseID, fileID uuid.UUID) error {
+	expense, err := s.findExpense(ctx, tenantID, expenseID)
+	if err != nil {
+		return err
+	}
+	if _, _, err := s.editableTrip(ctx, tenantID, expense.TripID); err != nil {
+		return err
+	}
+
+	return s.fileRelation.DetachFileFromEntity(ctx, fileID, fileModel.EntityTypeTripExpense, expenseID)
+}
+
+func (s *tripExpenseService) GetClaim(ctx context.Context, tenantID, tripID uuid.UUID) (*model.TripExpenseClaim, error) {
+	trip, err := s.findTrip(ctx, tenantID, tripID)
+	if err != nil {
+		return nil, err
+	}
+	claim, err := s.syncClaim(ctx, trip)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.loadItems(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+	claim.Items = items
+	if claim.Status.Editable() {
+		summarizeClaim(claim, items)
+	}
+	return claim, nil
+}
+
+func (s *tripExpenseService) SubmitClaim(ctx context.Context, tenantID, tripID, submitterID uuid.UUID) (*model.TripExpenseClaim, error) {
+	trip, claim, err := s.editableTrip(ctx, tenantID, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.loadItems(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, ErrTripExpenseClaimEmpty
+	}
+
+	// 提交前按最新标准重新校验
+	policy, tier, err := s.resolvePolicy(ctx, trip)
+	if err != nil {
+		return nil, err
+	}
+	evaluateTripExpenses(items, policy)
+	if err := s.expenseRepo.UpdatePolicyFlags(ctx, items); err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if item.Category.RequiresReceipt() && len(item.ReceiptFileIDs) == 0 {
+			return nil, fmt.Errorf("%w: %s on %s", ErrTripExpenseReceiptRequired, item.Category, item.ExpenseDate.Format("2006-01-02"))
+		}
+		if item.OverPolicy && strings.TrimSpace(item.OverPolicyNote) == "" {
+			return nil, fmt.Errorf("%w: %s on %s", ErrTripExpenseNoteRequired, item.Category, item.ExpenseDate.Format("2006-01-02"))
+		}
+	}
+
+	summarizeClaim(claim, items)
+	claim.Items = items
+	claim.DestinationTier = tier
+	claim.PolicyID = nil
+	if policy != nil {
+		claim.PolicyID = &policy.ID
+	}
+
+	processDefID, err := findEnabledProcess(ctx, s.approval, tenantID, tripExpenseProcessCode, ErrTripExpenseProcessNotDefined)
+	if err != nil {
+		return nil, err
+	}
+	instance, err := s.approval.StartProcess(ctx, &approvalDto.StartProcessRequest{
+		TenantID:     tenantID,
+		ProcessDefID: processDefID,
+		ApplicantID:  submitterID,
+		FormData:     claimFormData(trip, claim),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start approval process: %w", err)
+	}
+
+	now := time.Now()
+	claim.Status = model.TripExpenseClaimPending
+	claim.ApprovalInstanceID = &instance.ID
+	claim.SubmittedBy = &submitterID
+	claim.SubmittedAt = &now
+	claim.DecidedAt = nil
+	claim.UpdatedAt = now
+	if err := s.expenseRepo.SaveClaim(ctx, claim); err != nil {
+		return nil, err
+	}
+	return claim, nil
+}
+
+func (s *tripExpenseService) ApprovedTotal(ctx context.Context, tenantID, tripID uuid.UUID) (float64, error) {
+	trip, err := s.findTrip(ctx, tenantID, tripID)
+	if err != nil {
+		return 0, err
+	}
+	claim, err := s.syncClaim(ctx, trip)
+	if err != nil {
+		return 0, err
+	}
+
+	items, err := s.expenseRepo.ListByTrip(ctx, tripID)
+	if err != nil {
+		return 0, err
+	}
+	if len(items) == 0 {
+		return 0, nil
+	}
+	if claim.Status != model.TripExpenseClaimApproved {
+		return 0, ErrTripExpenseClaimNotApproved
+	}
+	return claim.TotalAmount, nil
+}
+
+func (s *tripExpenseService) CreatePolicy(ctx context.Context, policy *model.TripExpensePolicy) error {
+	if err := validateTripExpensePolicy(policy); err != nil {
+		return err
+	}
+
+	policy.ID = uuid.Must(uuid.NewV7())
+	now := time.Now()
+	policy.CreatedAt = now
+	policy.UpdatedAt = now
+	policy.UpdatedBy = policy.CreatedBy
+	return s.policyRepo.Create(ctx, policy)
+}
+
+func (s *tripExpenseService) UpdatePolicy(ctx context.Context, policy *model.TripExpensePolicy) error {
+	if _, err := s.policyRepo.FindByID(ctx, policy.TenantID, policy.ID); err != nil {
+		return ErrTripExpensePolicyNotFound
+	}
+	if err := validateTripExpensePolicy(policy); err != nil {
+		return err
+	}
+
+	policy.UpdatedAt = time.Now()
+	return s.policyRepo.Update(ctx, policy)
+}
+
+func (s *tripExpenseService) DeletePolicy(ctx context.Context, tenantID, id uuid.UUID) error {
+	if _, err := s.policyRepo.FindByID(ctx, tenantID, id); err != nil {
+		return ErrTripExpensePolicyNotFound
+	}
+	return s.policyRepo.Delete(ctx, tenantID, id)
+}
+
+func (s *tripExpenseService) GetPolicy(ctx context.Context, tenantID, id uuid.UUID) (*model.TripExpensePolicy, error) {
+	policy, err := s.policyRepo.FindByID(ctx, tenantID, id)
+	if err != nil {
+		return nil, ErrTripExpensePolicyNotFound
+	}
+	return policy, nil
+}
+
+func (s *tripExpenseService) ListPolicies(ctx context.Context, tenantID uuid.UUID) ([]*model.TripExpensePolicy, error) {
+	return s.policyRepo.List(ctx, tenantID)
+}
+
+func (s *tripExpenseService) ListDestinationTiers(ctx context.Context, tenantID uuid.UUID) ([]*model.TripDestinationTier, error) {
+	return s.policyRepo.ListDestinationTiers(ctx, tenantID)
+}
+
+func (s *tripExpenseService) SetDestinationTiers(ctx context.Context, tenantID uuid.UUID, tiers []*model.TripDestinationTier) ([]*model.TripDestinationTier, error) {
+	seen := make(map[string]bool, len(tiers))
+	now := time.Now()
+	for _, tier := range tiers {
+		tier.Destination = strings.TrimSpace(tier.Destination)
+		tier.Tier = strings.TrimSpace(tier.Tier)
+		if tier.Destination == "" || tier.Tier == "" {
+			return nil, fmt.Errorf("%w: destination and tier are required", ErrTripExpensePolicyInvalid)
+		}
+		key := strings.ToLower(tier.Destination)
+		if seen[key] {
+			return nil, fmt.Errorf("%w: %s", ErrTripDestinationTierDuplicated, tier.Destination)
+		}
+		seen[key] = true
+
+		tier.ID = uuid.Must(uuid.NewV7())
+		tier.TenantID = tenantID
+		tier.CreatedAt = now
+	}
+
+	if err := s.policyRepo.ReplaceDestinationTiers(ctx, tenantID, tiers); err != nil {
+		return nil, err
+	}
+	return tiers, nil
+}
+
+func (s *tripExpenseService) ResolvePolicy(ctx context.Context, tenantID, tripID uuid.UUID) (*model.TripExpensePolicy, string, error) {
+	trip, err := s.findTrip(ctx, tenantID, tripID)
+	if err != nil {
+		return nil, "", err
+	}
+	return s.resolvePolicy(ctx, trip)
+}
+
+// resolvePolicy 目的地按关键字匹配级别，员工职级取所在职位的职级
+func (s *tripExpenseService) resolvePolicy(ctx context.Context, trip *model.BusinessTrip) (*model.TripExpensePolicy, string, error) {
+	tiers, err := s.policyRepo.ListDestinationTiers(ctx, trip.TenantID)
+	if err != nil {
+		return nil, "", err
+	}
+	tier := matchDestinationTier(trip.Destination, tiers)
+
+	policies, err := s.policyRepo.List(ctx, trip.TenantID)
+	if err != nil {
+		return nil, "", err
+	}
+	level, err := s.hrmEmpRepo.FindPositionLevel(ctx, trip.TenantID, trip.EmployeeID)
+	if err != nil {
+		level = 0
+	}
+
+	return selectTripExpensePolicy(policies, tier, level), tier, nil
+}
+
+// editableTrip 校验出差已批准且报销单可编辑，返回出差与报销单（不存在时返回草稿）
+func (s *tripExpenseService) editableTrip(ctx context.Context, tenantID, tripID uuid.UUID) (*model.BusinessTrip, *model.TripExpenseClaim, error) {
+	trip, err := s.findTrip(ctx, tenantID, tripID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if trip.ApprovalStatus != "approved" {
+		return nil, nil, ErrTripNotApproved
+	}
+
+	claim, err := s.syncClaim(ctx, trip)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !claim.Status.Editable() {
+		return nil, nil, ErrTripExpenseClaimLocked
+	}
+	return trip, claim, nil
+}
+
+// syncClaim 读取报销单，审批中时按审批实例状态更新
+func (s *tripExpenseService) syncClaim(ctx context.Context, trip *model.BusinessTrip) (*model.TripExpenseClaim, error) {
+	claim, err := s.expenseRepo.FindClaim(ctx, trip.ID)
+	if err != nil {
+		now := time.Now()
+		return &model.TripExpenseClaim{
+			TripID:     trip.ID,
+			TenantID:   trip.TenantID,
+			EmployeeID: trip.EmployeeID,
+			Status:     model.TripExpenseClaimDraft,
+			Currency:   model.DefaultExpenseCurrency,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		}, nil
+	}
+	if claim.Status != model.TripExpenseClaimPending || claim.ApprovalInstanceID == nil {
+		return claim, nil
+	}
+
+	instance, err := s.approval.GetProcessInstance(ctx, *claim.ApprovalInstanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get approval instance: %w", err)
+	}
+	if err := s.settleClaim(ctx, claim, instance.Status, instance.CompletedAt); err != nil {
+		return nil, err
+	}
+	return claim, nil
+}
+
+// onApprovalClosed 审批流程结束时立即同步报销单状态（失败时在下次读取报销单时兜底）
+func (s *tripExpenseService) onApprovalClosed(ctx context.Context, instance *approvalModel.ProcessInstance) {
+	claim, err := s.expenseRepo.FindClaimByApprovalInstance(ctx, instance.TenantID, instance.ID)
+	if err != nil || claim == nil || claim.Status != model.TripExpenseClaimPending {
+		return
+	}
+	_ = s.settleClaim(ctx, claim, instance.Status, instance.CompletedAt)
+}
+
+// settleClaim 按审批结果更新报销单状态，流程尚未结束时不做变更
+func (s *tripExpenseService) settleClaim(ctx context.Context, claim *model.TripExpenseClaim, processStatus approvalModel.ProcessStatus, completedAt *time.Time) error {
+	var status model.TripExpenseClaimStatus
+	switch processStatus {
+	case approvalModel.ProcessStatusApproved:
+		status = model.TripExpenseClaimApproved
+	case approvalModel.ProcessStatusRejected:
+		status = model.TripExpenseClaimRejected
+	case approvalModel.ProcessStatusWithdrawn, approvalModel.ProcessStatusCancelled:
+		status = model.TripExpenseClaimDraft
+	default:
+		return nil
+	}
+
+	now := time.Now()
+	claim.Status = status
+	claim.DecidedAt = &now
+	if completedAt != nil {
+		claim.DecidedAt = completedAt
+	}
+	claim.UpdatedAt = now
+	return s.expenseRepo.SaveClaim(ctx, claim)
+}
+
+// reevaluate 明细变动后按适用标准重新计算全部明细的超标标记，并保存草稿汇总
+func (s *tripExpenseService) reevaluate(ctx context.Context, trip *model.BusinessTrip, claim *model.TripExpenseClaim) error {
+	items, err := s.expenseRepo.ListByTrip(ctx, trip.ID)
+	if err != nil {
+		return err
+	}
+	policy, tier, err := s.resolvePolicy(ctx, trip)
+	if err != nil {
+		return err
+	}
+
+	evaluateTripExpenses(items, policy)
+	if err := s.expenseRepo.UpdatePolicyFlags(ctx, items); err != nil {
+		return err
+	}
+
+	summarizeClaim(claim, items)
+	claim.DestinationTier = tier
+	claim.PolicyID = nil
+	if policy != nil {
+		claim.PolicyID = &policy.ID
+	}
+	claim.UpdatedAt = time.Now()
+	return s.expenseRepo.SaveClaim(ctx, claim)
+}
+
+func (s *tripExpenseService) validateExpense(ctx context.Context, trip *model.BusinessTrip, expense *model.TripExpense) error {
+	if !expense.Category.IsValid() {
+		return fmt.Errorf("%w: unsupported category %q", ErrTripExpenseInvalid, expense.Category)
+	}
+	if expense.Amount <= 0 {
+		return fmt.Errorf("%w: amount must be positive", ErrTripExpenseInvalid)
+	}
+	if expense.Category == model.TripExpenseHotel && expense.Nights <= 0 {
+		return fmt.Errorf("%w: hotel expense requires nights", ErrTripExpenseInvalid)
+	}
+	if expense.Category != model.TripExpenseHotel {
+		expense.Nights = 0
+	}
+
+	expense.Currency = strings.ToUpper(strings.TrimSpace(expense.Currency))
+	if expense.Currency == "" {
+		expense.Currency = model.DefaultExpenseCurrency
+	}
+
+	expenseDay := truncateDate(expense.ExpenseDate)
+	if expenseDay.Before(truncateDate(trip.StartTime)) || expenseDay.After(truncateDate(trip.EndTime)) {
+		return ErrTripExpenseDateOutOfRange
+	}
+	expense.ExpenseDate = expenseDay
+
+	// 报销单只汇总一种币种
+	items, err := s.expenseRepo.ListByTrip(ctx, trip.ID)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if item.ID != expense.ID && item.Currency != expense.Currency {
+			return ErrTripExpenseCurrencyMismatch
+		}
+	}
+	return nil
+}
+
+func (s *tripExpenseService) findTrip(ctx context.Context, tenantID, tripID uuid.UUID) (*model.BusinessTrip, error) {
+	trip, err := s.tripRepo.FindByID(ctx, tripID)
+	if err != nil || trip.TenantID != tenantID {
+		return nil, ErrTripNotFound
+	}
+	return trip, nil
+}
+
+func (s *tripExpenseService) findExpense(ctx context.Context, tenantID, expenseID uuid.UUID) (*model.TripExpense, error) {
+	expense, err := s.expenseRepo.FindByID(ctx, expenseID)
+	if err != nil || expense.TenantID != tenantID {
+		return nil, ErrTripExpenseNotFound
+	}
+	return expense, nil
+}
+
+// loadItems 查询明细并填充票据文件ID
+func (s *tripExpenseService) loadItems(ctx context.Context, tripID uuid.UUID) ([]*model.TripExpense, error) {
+	items, err := s.expenseRepo.ListByTrip(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		files, err := s.fileRelation.GetEntityFiles(ctx, fileModel.EntityTypeTripExpense, item.ID)
+		if err != nil {
+			return nil, err
+		}
+		item.ReceiptFileIDs = make([]uuid.UUID, 0, len(files))
+		for _, file := range files {
+			item.ReceiptFileIDs = append(item.ReceiptFileIDs, file.ID)
+		}
+	}
+	return items, nil
+}
+
+// findEnabledProcess 按编码查找租户已启用的审批流程定义，未配置时返回 notDefined
+func findEnabledProcess(ctx context.Context, approval approvalService.ApprovalService, tenantID uuid.UUID, code string, notDefined error) (uuid.UUID, error) {
+	defs, err := approval.ListProcessDefinitions(ctx, tenantID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	for _, def := range defs {
+		if def.Code == code && def.Enabled {
+			return def.ID, nil
+		}
+	}
+	return uuid.Nil, notDefined
+}
+
+// matchDestinationTier 目的地包含关键字即匹配，多个关键字命中时取最长的
+func matchDestinationTier(destination string, tiers []*model.TripDestinationTier) string {
+	destination = strings.ToLower(destination)
+	matched, matchedLen := model.DefaultDestinationTier, 0
+	for _, tier := range tiers {
+		keyword := strings.ToLower(tier.Destination)
+		if keyword != "" && strings.Contains(destination, keyword) && len(keyword) > matchedLen {
+			matched, matchedLen = tier.Tier, len(keyword)
+		}
+	}
+	return matched
+}
+
+// selectTripExpensePolicy 按级别和职级匹配启用的标准，多条命中时取职级范围最窄的；
+// 级别无标准时回退到默认级别
+func selectTripExpensePolicy(policies []*model.TripExpensePolicy, tier string, level int) *model.TripExpensePolicy {
+	pick := func(tier string) *model.TripExpensePolicy {
+		var best *model.TripExpensePolicy
+		for _, policy := range policies {
+			if !policy.IsActive || policy.DestinationTier != tier || !policy.MatchesLevel(level) {
+				continue
+			}
+			if best == nil || policy.LevelSpan() < best.LevelSpan() {
+				best = policy
+			}
+		}
+		return best
+	}
+
+	if policy := pick(tier); policy != nil {
+		return policy
+	}
+	if tier != model.DefaultDestinationTier {
+		return pick(model.DefaultDestinationTier)
+	}
+	return nil
+}
+
+// evaluateTripExpenses 计算超标：餐饮与补助按日合并对比每日上限（按录入顺序累计，超出部分记在使其超标的明细上），
+// 住宿按每晚上限×晚数对比；币种与标准不一致的明细不做校验
+func evaluateTripExpenses(items []*model.TripExpense, policy *model.TripExpensePolicy) {
+	for _, item := range items {
+		item.PolicyCap = nil
+		item.OverPolicy = false
+		item.OverPolicyAmount = 0
+	}
+	if policy == nil {
+		return
+	}
+
+	sorted := make([]*model.TripExpense, len(items))
+	copy(sorted, items)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if !sorted[i].ExpenseDate.Equal(sorted[j].ExpenseDate) {
+			return sorted[i].ExpenseDate.Before(sorted[j].ExpenseDate)
+		}
+		return sorted[i].CreatedAt.Before(sorted[j].CreatedAt)
+	})
+
+	dailySpent := make(map[string]float64)
+	for _, item := range sorted {
+		if policy.Currency != "" && item.Currency != policy.Currency {
+			continue
+		}
+
+		switch {
+		case item.Category.CountsTowardPerDiem() && policy.PerDiemCap > 0:
+			cap := policy.PerDiemCap
+			item.PolicyCap = &cap
+
+			day := item.ExpenseDate.Format("2006-01-02")
+			before := dailySpent[day]
+			after := before + item.Amount
+			dailySpent[day] = after
+			if after > cap {
+				item.OverPolicy = true
+				item.OverPolicyAmount = roundCents(after - math.Max(before, cap))
+			}
+
+		case item.Category == model.TripExpenseHotel && policy.HotelCapPerNight > 0:
+			cap := roundCents(policy.HotelCapPerNight * float64(item.Nights))
+			item.PolicyCap = &cap
+			if item.Amount > cap {
+				item.OverPolicy = true
+				item.OverPolicyAmount = roundCents(item.Amount - cap)
+			}
+		}
+	}
+}
+
+// summarizeClaim 按明细汇总报销单金额
+func summarizeClaim(claim *model.TripExpenseClaim, items []*model.TripExpense) {
+	claim.TotalAmount = 0
+	claim.OverPolicyAmount = 0
+	claim.OverPolicyCount = 0
+	for _, item := range items {
+		claim.Currency = item.Currency
+		claim.TotalAmount += item.Amount
+		if item.OverPolicy {
+			claim.OverPolicyAmount += item.OverPolicyAmount
+			claim.OverPolicyCount++
+		}
+	}
+	claim.TotalAmount = roundCents(claim.TotalAmount)
+	claim.OverPolicyAmount = roundCents(claim.OverPolicyAmount)
+	if claim.Currency == "" {
+		claim.Currency = model.DefaultExpenseCurrency
+	}
+}
+
+// claimFormData 审批表单数据
+func claimFormData(trip *model.BusinessTrip, claim *model.TripExpenseClaim) map[string]interface{} {
+	items := make([]map[string]interface{}, 0, len(claim.Items))
+	for _, item := range claim.Items {
+		items = append(items, map[string]interface{}{
+			"id":                 item.ID.String(),
+			"category":           string(item.Category),
+			"amount":             item.Amount,
+			"currency":           item.Currency,
+			"expense_date":       item.ExpenseDate.Format("2006-01-02"),
+			"nights":             item.Nights,
+			"description":        item.Description,
+			"receipt_count":      len(item.ReceiptFileIDs),
+			"over_policy":        item.OverPolicy,
+			"over_policy_amount": item.OverPolicyAmount,
+			"over_policy_note":   item.OverPolicyNote,
+		})
+	}
+
+	return map[string]interface{}{
+		"trip_id":            trip.ID.String(),
+		"employee_id":        trip.EmployeeID.String(),
+		"employee_name":      trip.EmployeeName,
+		"destination":        trip.Destination,
+		"destination_tier":   claim.DestinationTier,
+		"start_date":         trip.StartTime.Format("2006-01-02"),
+		"end_date":           trip.EndTime.Format("2006-01-02"),
+		"currency":           claim.Currency,
+		"total_amount":       claim.TotalAmount,
+		"over_policy_amount": claim.OverPolicyAmount,
+		"over_policy_count":  claim.OverPolicyCount,
+		"items":              items,
+	}
+}
+
+func validateTripExpensePolicy(policy *model.TripExpensePolicy) error {
+	policy.Name = strings.TrimSpace(policy.Name)
+	policy.DestinationTier = strings.TrimSpace(policy.DestinationTier)
+	if policy.Name == "" || policy.DestinationTier == "" {
+		return fmt.Errorf("%w: name and destination tier are required", ErrTripExpensePolicyInvalid)
+	}
+	if policy.PerDiemCap < 0 || policy.HotelCapPerNight < 0 {
+		return fmt.Errorf("%w: caps must not be negative", ErrTripExpensePolicyInvalid)
+	}
+	if policy.MinPositionLevel < 0 || policy.MaxPositionLevel < 0 ||
+		(policy.MaxPositionLevel > 0 && policy.MaxPositionLevel < policy.MinPositionLevel) {
+		return fmt.Errorf("%w: invalid position level range", ErrTripExpensePolicyInvalid)
+	}
+
+	policy.Currency = strings.ToUpper(strings.TrimSpace(policy.Currency))
+	if policy.Currency == "" {
+		policy.Currency = model.DefaultExpenseCurrency
+	}
+	return nil
+}
+
+func roundCents(v float64) float64 {
+	return math.Round(v*100) / 100
+}
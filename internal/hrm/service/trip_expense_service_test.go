@@ -0,0 +1,350 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	approvalDto "github.com/lk2023060901/go-next-erp/internal/approval/dto"
+	approvalModel "github.com/lk2023060901/go-next-erp/internal/approval/model"
+	approvalService "github.com/lk2023060901/go-next-erp/internal/approval/service"
+	fileModel "github.com/lk2023060901/go-next-erp/internal/file/model"
+	fileService "github.com/lk2023060901/go-next-erp/internal/file/service"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/model"
+	"github.com/lk2023060901/go-next-erp/internal/hrm/repository"
+)
+
+type stubExpenseTripRepo struct {
+	repository.BusinessTripRepository
+	trip *model.BusinessTrip
+}
+
+func (r *stubExpenseTripRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.BusinessTrip, error) {
+	if r.trip == nil || r.trip.ID != id {
+		return nil, errStubNotFound
+	}
+	return r.trip, nil
+}
+
+// stubTripExpenseRepo 内存报销明细与报销单仓储
+type stubTripExpenseRepo struct {
+	repository.TripExpenseRepository
+	items []*model.TripExpense
+	claim *model.TripExpenseClaim
+}
+
+func (r *stubTripExpenseRepo) Create(ctx context.Context, expense *model.TripExpense) error {
+	r.items = append(r.items, expense)
+	return nil
+}
+
+func (r *stubTripExpenseRepo) FindByID(ctx context.Context, id uuid.UUID) (*model.TripExpense, error) {
+	for _, item := range r.items {
+		if item.ID == id {
+			return item, nil
+		}
+	}
+	return nil, errStubNotFound
+}
+
+func (r *stubTripExpenseRepo) ListByTrip(ctx context.Context, tripID uuid.UUID) ([]*model.TripExpense, error) {
+	return r.items, nil
+}
+
+func (r *stubTripExpenseRepo) UpdatePolicyFlags(ctx context.Context, expenses []*model.TripExpense) error {
+	return nil
+}
+
+func (r *stubTripExpenseRepo) FindClaim(ctx context.Context, tripID uuid.UUID) (*model.TripExpenseClaim, error) {
+	if r.claim == nil {
+		return nil, errStubNotFound
+	}
+	return r.claim, nil
+}
+
+func (r *stubTripExpenseRepo) FindClaimByApprovalInstance(ctx context.Context, tenantID, instanceID uuid.UUID) (*model.TripExpenseClaim, error) {
+	if r.claim == nil || r.claim.TenantID != tenantID || r.claim.ApprovalInstanceID == nil || *r.claim.ApprovalInstanceID != instanceID {
+		return nil, nil
+	}
+	return r.claim, nil
+}
+
+func (r *stubTripExpenseRepo) SaveClaim(ctx context.Context, claim *model.TripExpenseClaim) error {
+	r.claim = claim
+	return nil
+}
+
+type stubTripExpensePolicyRepo struct {
+	repository.TripExpensePolicyRepository
+	policies []*model.TripExpensePolicy
+	tiers    []*model.TripDestinationTier
+}
+
+func (r *stubTripExpensePolicyRepo) List(ctx context.Context, tenantID uuid.UUID) ([]*model.TripExpensePolicy, error) {
+	return r.policies, nil
+}
+
+func (r *stubTripExpensePolicyRepo) ListDestinationTiers(ctx context.Context, tenantID uuid.UUID) ([]*model.TripDestinationTier, error) {
+	return r.tiers, nil
+}
+
+type stubPositionLevelRepo struct {
+	repository.HRMEmployeeRepository
+	level int
+}
+
+func (r *stubPositionLevelRepo) FindPositionLevel(ctx context.Context, tenantID, employeeID uuid.UUID) (int, error) {
+	return r.level, nil
+}
+
+// stubReceiptRelations 内存票据关联，按报销明细ID记录文件
+type stubReceiptRelations struct {
+	fileService.FileRelationService
+	files map[uuid.UUID][]uuid.UUID
+}
+
+func (s *stubReceiptRelations) AttachFileToEntity(ctx context.Context, req *fileService.AttachFileRequest) error {
+	s.files[req.EntityID] = append(s.files[req.EntityID], req.FileID)
+	return nil
+}
+
+func (s *stubReceiptRelations) GetEntityFiles(ctx context.Context, entityType fileModel.EntityType, entityID uuid.UUID) ([]*fileModel.File, error) {
+	var files []*fileModel.File
+	for _, id := range s.files[entityID] {
+		files = append(files, &fileModel.File{ID: id})
+	}
+	return files, nil
+}
+
+// stubClaimApprovals 审批模块桩，status 为审批实例当前状态
+type stubClaimApprovals struct {
+	approvalService.ApprovalService
+	defs    []*approvalDto.ProcessDefResponse
+	started []*approvalDto.StartProcessRequest
+	status  approvalModel.ProcessStatus
+	hooks   []approvalService.ProcessClosedHook
+}
+
+func (s *stubClaimApprovals) OnProcessClosed(hook approvalService.ProcessClosedHook) {
+	s.hooks = append(s.hooks, hook)
+}
+
+func (s *stubClaimApprovals) ListProcessDefinitions(ctx context.Context, tenantID uuid.UUID) ([]*approvalDto.ProcessDefResponse, error) {
+	return s.defs, nil
+}
+
+func (s *stubClaimApprovals) StartProcess(ctx context.Context, req *approvalDto.StartProcessRequest) (*approvalDto.ProcessInstanceResponse, error) {
+	s.started = append(s.started, req)
+	s.status = approvalModel.ProcessStatusPending
+	return &approvalDto.ProcessInstanceResponse{ID: uuid.New(), Status: s.status}, nil
+}
+
+func (s *stubClaimApprovals) GetProcessInstance(ctx context.Context, id uuid.UUID) (*approvalDto.ProcessInstanceResponse, error) {
+	return &approvalDto.ProcessInstanceResponse{ID: id, Status: s.status}, nil
+}
+
+func TestSelectTripExpensePolicy(t *testing.T) {
+	general := &model.TripExpensePolicy{ID: uuid.New(), DestinationTier: "tier1", IsActive: true}
+	senior := &model.TripExpensePolicy{ID: uuid.New(), DestinationTier: "tier1", MinPositionLevel: 5, MaxPositionLevel: 8, IsActive: true}
+	fallback := &model.TripExpensePolicy{ID: uuid.New(), DestinationTier: model.DefaultDestinationTier, IsActive: true}
+	inactive := &model.TripExpensePolicy{ID: uuid.New(), DestinationTier: "tier2", IsActive: false}
+	policies := []*model.TripExpensePolicy{general, senior, fallback, inactive}
+
+	t.Run("narrowest level range wins", func(t *testing.T) {
+		assert.Equal(t, senior.ID, selectTripExpensePolicy(policies, "tier1", 6).ID)
+		assert.Equal(t, general.ID, selectTripExpensePolicy(policies, "tier1", 2).ID)
+	})
+
+	t.Run("falls back to default tier", func(t *testing.T) {
+		assert.Equal(t, fallback.ID, selectTripExpensePolicy(policies, "tier2", 6).ID)
+		assert.Nil(t, selectTripExpensePolicy(policies[:2], "tier2", 6))
+	})
+
+	t.Run("destination keyword matching", func(t *testing.T) {
+		tiers := []*model.TripDestinationTier{
+			{Destination: "上海", Tier: "tier1"},
+			{Destination: "上海浦东", Tier: "tier0"},
+		}
+		assert.Equal(t, "tier0", matchDestinationTier("上海浦东新区", tiers))
+		assert.Equal(t, "tier1", matchDestinationTier("上海市", tiers))
+		assert.Equal(t, model.DefaultDestinationTier, matchDestinationTier("成都", tiers))
+	})
+}
+
+func TestEvaluateTripExpenses(t *testing.T) {
+	day1 := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+	policy := &model.TripExpensePolicy{PerDiemCap: 200, HotelCapPerNight: 400, Currency: "CNY"}
+
+	expense := func(category model.TripExpenseCategory, amount float64, date time.Time, order int) *model.TripExpense {
+		return &model.TripExpense{
+			ID: uuid.New(), Category: category, Amount: amount, Currency: "CNY",
+			ExpenseDate: date, CreatedAt: day1.Add(time.Duration(order) * time.Minute),
+		}
+	}
+
+	t.Run("meal and per diem share the daily cap", func(t *testing.T) {
+		perDiem := expense(model.TripExpensePerDiem, 150, day1, 1)
+		lunch := expense(model.TripExpenseMeal, 80, day1, 2)
+		dinner := expense(model.TripExpenseMeal, 60, day1, 3)
+		nextDay := expense(model.TripExpenseMeal, 180, day2, 4)
+
+		evaluateTripExpenses([]*model.TripExpense{dinner, nextDay, lunch, perDiem}, policy)
+
+		assert.False(t, perDiem.OverPolicy)
+		assert.True(t, lunch.OverPolicy)
+		assert.Equal(t, 30.0, lunch.OverPolicyAmount)
+		assert.True(t, dinner.OverPolicy)
+		assert.Equal(t, 60.0, dinner.OverPolicyAmount)
+		assert.False(t, nextDay.OverPolicy)
+		require.NotNil(t, nextDay.PolicyCap)
+		assert.Equal(t, 200.0, *nextDay.PolicyCap)
+	})
+
+	t.Run("hotel cap per night", func(t *testing.T) {
+		hotel := expense(model.TripExpenseHotel, 900, day1, 1)
+		hotel.Nights = 2
+		transport := expense(model.TripExpenseTransport, 5000, day1, 2)
+
+		evaluateTripExpenses([]*model.TripExpense{hotel, transport}, policy)
+
+		require.NotNil(t, hotel.PolicyCap)
+		assert.Equal(t, 800.0, *hotel.PolicyCap)
+		assert.Equal(t, 100.0, hotel.OverPolicyAmount)
+		assert.False(t, transport.OverPolicy)
+		assert.Nil(t, transport.PolicyCap)
+	})
+
+	t.Run("other currency and no policy are not checked", func(t *testing.T) {
+		hotel := expense(model.TripExpenseHotel, 900, day1, 1)
+		hotel.Nights, hotel.Currency = 1, "USD"
+
+		evaluateTripExpenses([]*model.TripExpense{hotel}, policy)
+		assert.False(t, hotel.OverPolicy)
+
+		hotel.Currency = "CNY"
+		evaluateTripExpenses([]*model.TripExpense{hotel}, nil)
+		assert.False(t, hotel.OverPolicy)
+		assert.Nil(t, hotel.PolicyCap)
+	})
+}
+
+func TestTripExpenseService_Claim(t *testing.T) {
+	ctx := context.Background()
+	tenantID, employeeID := uuid.New(), uuid.New()
+	start := truncateDate(time.Now()).AddDate(0, 0, -5)
+	trip := &model.BusinessTrip{
+		ID: uuid.New(), TenantID: tenantID, EmployeeID: employeeID,
+		Destination: "上海", StartTime: start, EndTime: start.AddDate(0, 0, 2),
+		ApprovalStatus: "approved",
+	}
+
+	newService := func() (TripExpenseService, *stubTripExpenseRepo, *stubClaimApprovals) {
+		expenseRepo := &stubTripExpenseRepo{}
+		approvals := &stubClaimApprovals{
+			defs: []*approvalDto.ProcessDefResponse{{ID: uuid.New(), Code: tripExpenseProcessCode, Enabled: true}},
+		}
+		policyRepo := &stubTripExpensePolicyRepo{
+			policies: []*model.TripExpensePolicy{{ID: uuid.New(), DestinationTier: "tier1", HotelCapPerNight: 500, Currency: "CNY", IsActive: true}},
+			tiers:    []*model.TripDestinationTier{{Destination: "上海", Tier: "tier1"}},
+		}
+		svc := NewTripExpenseService(
+			&stubExpenseTripRepo{trip: trip}, expenseRepo, policyRepo, &stubPositionLevelRepo{level: 3},
+			&stubReceiptRelations{files: map[uuid.UUID][]uuid.UUID{}}, approvals,
+		)
+		return svc, expenseRepo, approvals
+	}
+
+	t.Run("expense date must fall within the trip", func(t *testing.T) {
+		svc, _, _ := newService()
+		err := svc.AddExpense(ctx, &model.TripExpense{
+			TenantID: tenantID, TripID: trip.ID, Category: model.TripExpenseMeal,
+			Amount: 50, ExpenseDate: start.AddDate(0, 0, 5),
+		})
+		assert.ErrorIs(t, err, ErrTripExpenseDateOutOfRange)
+	})
+
+	t.Run("submit requires receipts and notes for over-policy lines", func(t *testing.T) {
+		svc, _, approvals := newService()
+		hotel := &model.TripExpense{
+			TenantID: tenantID, TripID: trip.ID, Category: model.TripExpenseHotel,
+			Amount: 1200, Nights: 2, ExpenseDate: start,
+		}
+		require.NoError(t, svc.AddExpense(ctx, hotel))
+		assert.True(t, hotel.OverPolicy)
+		assert.Equal(t, 200.0, hotel.OverPolicyAmount)
+
+		_, err := svc.SubmitClaim(ctx, tenantID, trip.ID, employeeID)
+		assert.ErrorIs(t, err, ErrTripExpenseReceiptRequired)
+
+		require.NoError(t, svc.AttachReceipt(ctx, tenantID, hotel.ID, uuid.New(), employeeID))
+		_, err = svc.SubmitClaim(ctx, tenantID, trip.ID, employeeID)
+		assert.ErrorIs(t, err, ErrTripExpenseNoteRequired)
+		assert.Empty(t, approvals.started)
+	})
+
+	t.Run("approved claim totals into actual cost", func(t *testing.T) {
+		svc, expenseRepo, approvals := newService()
+		require.NoError(t, svc.AddExpense(ctx, &model.TripExpense{
+			TenantID: tenantID, TripID: trip.ID, Category: model.TripExpensePerDiem,
+			Amount: 100, ExpenseDate: start,
+		}))
+		require.NoError(t, svc.AddExpense(ctx, &model.TripExpense{
+			TenantID: tenantID, TripID: trip.ID, Category: model.TripExpenseTransport,
+			Amount: 553.5, ExpenseDate: start.AddDate(0, 0, 1), ReceiptFileIDs: []uuid.UUID{uuid.New()},
+		}))
+
+		claim, err := svc.SubmitClaim(ctx, tenantID, trip.ID, employeeID)
+		require.NoError(t, err)
+		assert.Equal(t, model.TripExpenseClaimPending, claim.Status)
+		assert.Equal(t, 653.5, claim.TotalAmount)
+		assert.Equal(t, "tier1", claim.DestinationTier)
+		require.Len(t, approvals.started, 1)
+		assert.Equal(t, 653.5, approvals.started[0].FormData["total_amount"])
+
+		// 审批中不能修改明细，也不能汇总实际费用
+		err = svc.AddExpense(ctx, &model.TripExpense{
+			TenantID: tenantID, TripID: trip.ID, Category: model.TripExpenseMeal, Amount: 10, ExpenseDate: start,
+		})
+		assert.ErrorIs(t, err, ErrTripExpenseClaimLocked)
+		_, err = svc.ApprovedTotal(ctx, tenantID, trip.ID)
+		assert.ErrorIs(t, err, ErrTripExpenseClaimNotApproved)
+
+		approvals.status = approvalModel.ProcessStatusApproved
+		total, err := svc.ApprovedTotal(ctx, tenantID, trip.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 653.5, total)
+		assert.Equal(t, model.TripExpenseClaimApproved, expenseRepo.claim.Status)
+		assert.NotNil(t, expenseRepo.claim.DecidedAt)
+	})
+
+	t.Run("cancelled approval reopens the claim", func(t *testing.T) {
+		svc, expenseRepo, approvals := newService()
+		require.NoError(t, svc.AddExpense(ctx, &model.TripExpense{
+			TenantID: tenantID, TripID: trip.ID, Category: model.TripExpensePerDiem,
+			Amount: 100, ExpenseDate: start,
+		}))
+		claim, err := svc.SubmitClaim(ctx, tenantID, trip.ID, employeeID)
+		require.NoError(t, err)
+
+		require.Len(t, approvals.hooks, 1)
+		approvals.hooks[0](ctx, &approvalModel.ProcessInstance{
+			ID: *claim.ApprovalInstanceID, TenantID: tenantID, Status: approvalModel.ProcessStatusCancelled,
+		})
+		assert.Equal(t, model.TripExpenseClaimDraft, expenseRepo.claim.Status)
+		require.NoError(t, svc.AddExpense(ctx, &model.TripExpense{
+			TenantID: tenantID, TripID: trip.ID, Category: model.TripExpenseMeal, Amount: 10, ExpenseDate: start,
+		}))
+	})
+
+	t.Run("trip without expenses reports zero cost", func(t *testing.T) {
+		svc, _, _ := newService()
+		total, err := svc.ApprovedTotal(ctx, tenantID, trip.ID)
+		require.NoError(t, err)
+		assert.Zero(t, total)
+	})
+}
@@ -5,9 +5,16 @@ package hrm
 
 import (
 	"github.com/google/wire"
+	approvalService "github.com/lk2023060901/go-next-erp/internal/approval/service"
+	authRepository "github.com/lk2023060901/go-next-erp/internal/auth/repository"
+	"github.com/lk2023060901/go-next-erp/internal/conf"
+	fileService "github.com/lk2023060901/go-next-erp/internal/file/service"
 	"github.com/lk2023060901/go-next-erp/internal/hrm/handler"
 	"github.com/lk2023060901/go-next-erp/internal/hrm/repository/postgres"
 	"github.com/lk2023060901/go-next-erp/internal/hrm/service"
+	notificationService "github.com/lk2023060901/go-next-erp/internal/notification/service"
+	orgRepository "github.com/lk2023060901/go-next-erp/internal/organization/repository"
+	orgService "github.com/lk2023060901/go-next-erp/internal/organization/service"
 	"github.com/lk2023060901/go-next-erp/pkg/database"
 	"github.com/lk2023060901/go-next-erp/pkg/workflow"
 )
@@ -22,23 +29,74 @@ var ProviderSet = wire.NewSet(
 	postgres.NewHRMEmployeeRepository,
 	postgres.NewLeaveTypeRepository,
 	postgres.NewLeaveQuotaRepository,
+	postgres.NewLeaveQuotaLedgerRepository,
 	postgres.NewLeaveRequestRepository,
 	postgres.NewLeaveApprovalRepository,
 	postgres.NewOvertimeRepository,
 	postgres.NewBusinessTripRepository,
 	postgres.NewLeaveOfficeRepository,
 	postgres.NewPunchCardSupplementRepo,
+	postgres.NewPunchCardSupplementPolicyRepository,
+	postgres.NewHolidayCalendarRepository,
+	postgres.NewAttendanceSummaryRepository,
+	postgres.NewRotationTemplateRepository,
+	postgres.NewShiftSwapRepository,
+	postgres.NewOvertimePolicyRepository,
+	postgres.NewAttendanceDeviceRepository,
+	postgres.NewAttendanceAnomalyRepository,
+	postgres.NewDeviceCommandRepository,
+	postgres.NewEmployeeSyncMappingRepository,
+	postgres.NewThirdPartyIntegrationRepository,
+	postgres.NewSyncLogRepository,
+	postgres.NewDataKeyStore,
+	postgres.NewSensitiveDataRepository,
+	postgres.NewTripExpenseRepository,
+	postgres.NewTripExpensePolicyRepository,
+	postgres.NewEmployeeLifecycleRepository,
+	postgres.NewReportExportRepository,
+	postgres.NewPayrollRunRepository,
+	postgres.NewClockLocationRepository,
+	postgres.NewClockAttemptRepository,
+	postgres.NewTimeAllocationRepository,
+	postgres.NewBiometricRepository,
+	ProvideFieldCipher,
+	ProvideClockQRConfig,
 
 	// Service
+	service.NewDayTypeResolver,
+	service.NewLeaveDurationCalculator,
+	service.NewLeaveAccrualService,
+	service.NewHolidayCalendarService,
+	service.NewAttendancePeriodGuard,
+	service.NewAttendanceSummaryService,
 	service.NewAttendanceService,
 	service.NewShiftService,
 	service.NewScheduleService,
+	service.NewScheduleRotationService,
+	service.NewShiftSwapService,
 	service.NewAttendanceRuleService,
 	service.NewLeaveService,
+	service.NewOvertimePolicyService,
+	service.NewAttendanceAnomalyService,
+	service.NewAttendanceDeviceService,
+	service.NewDevicePushService,
+	service.NewDeviceFleetService,
+	service.NewBiometricService,
+	service.NewPlatformAdapterFactory,
+	service.NewPlatformSyncService,
 	service.NewOvertimeService,
+	service.NewTripExpenseService,
 	service.NewBusinessTripService,
 	service.NewLeaveOfficeService,
 	service.NewPunchCardSupplementService,
+	service.NewKeyRotationService,
+	service.NewHRMEmployeeService,
+	service.NewEmployeeLifecycleService,
+	service.NewReportExportService,
+	service.NewPayrollService,
+	service.NewClockQRSigner,
+	service.NewClockLocationService,
+	service.NewTimeAllocationService,
 
 	// Handler
 	handler.NewAttendanceHandler,
@@ -53,7 +111,7 @@ var ProviderSet = wire.NewSet(
 )
 
 // InitHRMModule initializes the HRM module
-func InitHRMModule(db *database.DB, workflowEngine *workflow.Engine) (*HRMModule, error) {
+func InitHRMModule(cfg *conf.Config, db *database.DB, workflowEngine *workflow.Engine, notifier notificationService.NotificationService, approvals approvalService.ApprovalService, fileRelations fileService.FileRelationService, uploads fileService.UploadService, downloads fileService.DownloadService, orgEmployees orgService.EmployeeService, orgRepo orgRepository.OrganizationRepository, orgEmpRepo orgRepository.EmployeeRepository, positionRepo orgRepository.EmployeePositionRepository, sessionRepo authRepository.SessionRepository) (*HRMModule, error) {
 	panic(wire.Build(ProviderSet, wire.Struct(new(HRMModule), "*")))
 }
 
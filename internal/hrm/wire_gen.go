@@ -8,9 +8,16 @@ package hrm
 
 import (
 	"github.com/google/wire"
+	service2 "github.com/lk2023060901/go-next-erp/internal/approval/service"
+	repository2 "github.com/lk2023060901/go-next-erp/internal/auth/repository"
+	"github.com/lk2023060901/go-next-erp/internal/conf"
+	service3 "github.com/lk2023060901/go-next-erp/internal/file/service"
 	"github.com/lk2023060901/go-next-erp/internal/hrm/handler"
 	"github.com/lk2023060901/go-next-erp/internal/hrm/repository/postgres"
-	"github.com/lk2023060901/go-next-erp/internal/hrm/service"
+	service5 "github.com/lk2023060901/go-next-erp/internal/hrm/service"
+	"github.com/lk2023060901/go-next-erp/internal/notification/service"
+	"github.com/lk2023060901/go-next-erp/internal/organization/repository"
+	service4 "github.com/lk2023060901/go-next-erp/internal/organization/service"
 	"github.com/lk2023060901/go-next-erp/pkg/database"
 	"github.com/lk2023060901/go-next-erp/pkg/workflow"
 )
@@ -18,44 +25,72 @@ import (
 // Injectors from wire.go:
 
 // InitHRMModule initializes the HRM module
-func InitHRMModule(db *database.DB, workflowEngine *workflow.Engine) (*HRMModule, error) {
+func InitHRMModule(cfg *conf.Config, db *database.DB, workflowEngine *workflow.Engine, notifier service.NotificationService, approvals service2.ApprovalService, fileRelations service3.FileRelationService, uploads service3.UploadService, downloads service3.DownloadService, orgEmployees service4.EmployeeService, orgRepo repository.OrganizationRepository, orgEmpRepo repository.EmployeeRepository, positionRepo repository.EmployeePositionRepository, sessionRepo repository2.SessionRepository) (*HRMModule, error) {
 	attendanceRecordRepository := postgres.NewAttendanceRecordRepository(db)
 	shiftRepository := postgres.NewShiftRepository(db)
 	scheduleRepository := postgres.NewScheduleRepository(db)
 	attendanceRuleRepository := postgres.NewAttendanceRuleRepository(db)
-	hrmEmployeeRepository := postgres.NewHRMEmployeeRepository(db)
-	attendanceService := service.NewAttendanceService(attendanceRecordRepository, shiftRepository, scheduleRepository, attendanceRuleRepository, hrmEmployeeRepository)
-	attendanceHandler := handler.NewAttendanceHandler(attendanceService)
-	shiftService := service.NewShiftService(shiftRepository)
+	keyStore := postgres.NewDataKeyStore(db)
+	cipher, err := ProvideFieldCipher(cfg, keyStore)
+	if err != nil {
+		return nil, err
+	}
+	hrmEmployeeRepository := postgres.NewHRMEmployeeRepository(db, cipher)
+	holidayCalendarRepository := postgres.NewHolidayCalendarRepository(db)
+	dayTypeResolver := service5.NewDayTypeResolver(holidayCalendarRepository, attendanceRuleRepository, scheduleRepository, hrmEmployeeRepository)
+	attendanceSummaryRepository := postgres.NewAttendanceSummaryRepository(db)
+	attendancePeriodGuard := service5.NewAttendancePeriodGuard(attendanceSummaryRepository)
+	overtimeRepository := postgres.NewOvertimeRepository(db)
+	overtimePolicyRepository := postgres.NewOvertimePolicyRepository(db)
+	overtimePolicyService := service5.NewOvertimePolicyService(overtimePolicyRepository, attendanceRuleRepository, hrmEmployeeRepository, overtimeRepository)
+	leaveTypeRepository := postgres.NewLeaveTypeRepository(db)
+	leaveQuotaRepository := postgres.NewLeaveQuotaRepository(db)
+	leaveQuotaLedgerRepository := postgres.NewLeaveQuotaLedgerRepository(db)
+	leaveAccrualService := service5.NewLeaveAccrualService(leaveTypeRepository, leaveQuotaRepository, leaveQuotaLedgerRepository, hrmEmployeeRepository)
+	timeAllocationRepository := postgres.NewTimeAllocationRepository(db)
+	timeAllocationService := service5.NewTimeAllocationService(timeAllocationRepository, scheduleRepository, shiftRepository, hrmEmployeeRepository)
+	overtimeService := service5.NewOvertimeService(db, overtimeRepository, shiftRepository, overtimePolicyService, dayTypeResolver, attendancePeriodGuard, leaveAccrualService, workflowEngine, timeAllocationService)
+	clockLocationRepository := postgres.NewClockLocationRepository(db)
+	clockAttemptRepository := postgres.NewClockAttemptRepository(db)
+	clockQRConfig := ProvideClockQRConfig(cfg)
+	clockQRSigner := service5.NewClockQRSigner(clockQRConfig)
+	attendanceService := service5.NewAttendanceService(attendanceRecordRepository, shiftRepository, scheduleRepository, attendanceRuleRepository, hrmEmployeeRepository, dayTypeResolver, attendancePeriodGuard, overtimeService, clockLocationRepository, clockAttemptRepository, clockQRSigner)
+	attendanceHandler := handler.NewAttendanceHandler(attendanceService, hrmEmployeeRepository)
+	shiftService := service5.NewShiftService(shiftRepository)
 	shiftHandler := handler.NewShiftHandler(shiftService)
-	scheduleService := service.NewScheduleService(scheduleRepository, shiftRepository, hrmEmployeeRepository, db)
+	scheduleService := service5.NewScheduleService(scheduleRepository, shiftRepository, hrmEmployeeRepository, db)
 	scheduleHandler := handler.NewScheduleHandler(scheduleService)
-	attendanceRuleService := service.NewAttendanceRuleService(attendanceRuleRepository)
+	attendanceRuleService := service5.NewAttendanceRuleService(attendanceRuleRepository)
 	attendanceRuleHandler := handler.NewAttendanceRuleHandler(attendanceRuleService)
-	leaveTypeRepository := postgres.NewLeaveTypeRepository(db)
-	leaveQuotaRepository := postgres.NewLeaveQuotaRepository(db)
 	leaveRequestRepository := postgres.NewLeaveRequestRepository(db)
 	leaveApprovalRepository := postgres.NewLeaveApprovalRepository(db)
-	leaveService := service.NewLeaveService(db, leaveTypeRepository, leaveQuotaRepository, leaveRequestRepository, leaveApprovalRepository, workflowEngine)
+	leaveDurationCalculator := service5.NewLeaveDurationCalculator(scheduleRepository, shiftRepository, hrmEmployeeRepository, dayTypeResolver)
+	leaveService := service5.NewLeaveService(db, leaveTypeRepository, leaveQuotaRepository, leaveRequestRepository, leaveApprovalRepository, leaveDurationCalculator, leaveAccrualService, attendancePeriodGuard, workflowEngine, timeAllocationService)
 	leaveHandler := handler.NewLeaveHandler(leaveService)
-	overtimeRepository := postgres.NewOvertimeRepository(db)
-	overtimeService := service.NewOvertimeService(db, overtimeRepository, workflowEngine)
 	overtimeHandler := handler.NewOvertimeHandler(overtimeService)
 	businessTripRepository := postgres.NewBusinessTripRepository(db)
-	businessTripService := service.NewBusinessTripService(db, businessTripRepository, workflowEngine)
+	tripExpenseRepository := postgres.NewTripExpenseRepository(db)
+	tripExpensePolicyRepository := postgres.NewTripExpensePolicyRepository(db)
+	tripExpenseService := service5.NewTripExpenseService(businessTripRepository, tripExpenseRepository, tripExpensePolicyRepository, hrmEmployeeRepository, fileRelations, approvals)
+	businessTripService := service5.NewBusinessTripService(db, businessTripRepository, attendancePeriodGuard, workflowEngine, tripExpenseService, timeAllocationService)
 	businessTripHandler := handler.NewBusinessTripHandler(businessTripService)
 	leaveOfficeRepository := postgres.NewLeaveOfficeRepository(db)
-	leaveOfficeService := service.NewLeaveOfficeService(db, leaveOfficeRepository, workflowEngine)
+	leaveOfficeService := service5.NewLeaveOfficeService(db, leaveOfficeRepository, attendancePeriodGuard, workflowEngine, timeAllocationService)
 	leaveOfficeHandler := handler.NewLeaveOfficeHandler(leaveOfficeService)
+	punchCardSupplementRepository := postgres.NewPunchCardSupplementRepo(db)
+	punchCardSupplementPolicyRepository := postgres.NewPunchCardSupplementPolicyRepository(db)
+	punchCardSupplementService := service5.NewPunchCardSupplementService(punchCardSupplementRepository, punchCardSupplementPolicyRepository, attendanceRecordRepository, attendanceService, attendancePeriodGuard)
+	punchCardSupplementHandler := handler.NewPunchCardSupplementHandler(punchCardSupplementService)
 	hrmModule := &HRMModule{
-		AttendanceHandler:     attendanceHandler,
-		ShiftHandler:          shiftHandler,
-		ScheduleHandler:       scheduleHandler,
-		AttendanceRuleHandler: attendanceRuleHandler,
-		LeaveHandler:          leaveHandler,
-		OvertimeHandler:       overtimeHandler,
-		BusinessTripHandler:   businessTripHandler,
-		LeaveOfficeHandler:    leaveOfficeHandler,
+		AttendanceHandler:          attendanceHandler,
+		ShiftHandler:               shiftHandler,
+		ScheduleHandler:            scheduleHandler,
+		AttendanceRuleHandler:      attendanceRuleHandler,
+		LeaveHandler:               leaveHandler,
+		OvertimeHandler:            overtimeHandler,
+		BusinessTripHandler:        businessTripHandler,
+		LeaveOfficeHandler:         leaveOfficeHandler,
+		PunchCardSupplementHandler: punchCardSupplementHandler,
 	}
 	return hrmModule, nil
 }
@@ -63,16 +98,17 @@ func InitHRMModule(db *database.DB, workflowEngine *workflow.Engine) (*HRMModule
 // wire.go:
 
 // ProviderSet HRM 模块的 Wire Provider Set
-var ProviderSet = wire.NewSet(postgres.NewAttendanceRecordRepository, postgres.NewShiftRepository, postgres.NewScheduleRepository, postgres.NewAttendanceRuleRepository, postgres.NewHRMEmployeeRepository, postgres.NewLeaveTypeRepository, postgres.NewLeaveQuotaRepository, postgres.NewLeaveRequestRepository, postgres.NewLeaveApprovalRepository, postgres.NewOvertimeRepository, postgres.NewBusinessTripRepository, postgres.NewLeaveOfficeRepository, service.NewAttendanceService, service.NewShiftService, service.NewScheduleService, service.NewAttendanceRuleService, service.NewLeaveService, service.NewOvertimeService, service.NewBusinessTripService, service.NewLeaveOfficeService, handler.NewAttendanceHandler, handler.NewShiftHandler, handler.NewScheduleHandler, handler.NewAttendanceRuleHandler, handler.NewLeaveHandler, handler.NewOvertimeHandler, handler.NewBusinessTripHandler, handler.NewLeaveOfficeHandler)
+var ProviderSet = wire.NewSet(postgres.NewAttendanceRecordRepository, postgres.NewShiftRepository, postgres.NewScheduleRepository, postgres.NewAttendanceRuleRepository, postgres.NewHRMEmployeeRepository, postgres.NewLeaveTypeRepository, postgres.NewLeaveQuotaRepository, postgres.NewLeaveQuotaLedgerRepository, postgres.NewLeaveRequestRepository, postgres.NewLeaveApprovalRepository, postgres.NewOvertimeRepository, postgres.NewBusinessTripRepository, postgres.NewLeaveOfficeRepository, postgres.NewPunchCardSupplementRepo, postgres.NewPunchCardSupplementPolicyRepository, postgres.NewHolidayCalendarRepository, postgres.NewAttendanceSummaryRepository, postgres.NewRotationTemplateRepository, postgres.NewShiftSwapRepository, postgres.NewOvertimePolicyRepository, postgres.NewAttendanceDeviceRepository, postgres.NewAttendanceAnomalyRepository, postgres.NewDeviceCommandRepository, postgres.NewEmployeeSyncMappingRepository, postgres.NewThirdPartyIntegrationRepository, postgres.NewSyncLogRepository, postgres.NewDataKeyStore, postgres.NewSensitiveDataRepository, postgres.NewTripExpenseRepository, postgres.NewTripExpensePolicyRepository, postgres.NewEmployeeLifecycleRepository, postgres.NewReportExportRepository, postgres.NewPayrollRunRepository, postgres.NewClockLocationRepository, postgres.NewClockAttemptRepository, postgres.NewTimeAllocationRepository, postgres.NewBiometricRepository, ProvideFieldCipher, ProvideClockQRConfig, service5.NewDayTypeResolver, service5.NewLeaveDurationCalculator, service5.NewLeaveAccrualService, service5.NewHolidayCalendarService, service5.NewAttendancePeriodGuard, service5.NewAttendanceSummaryService, service5.NewAttendanceService, service5.NewShiftService, service5.NewScheduleService, service5.NewScheduleRotationService, service5.NewShiftSwapService, service5.NewAttendanceRuleService, service5.NewLeaveService, service5.NewOvertimePolicyService, service5.NewAttendanceAnomalyService, service5.NewAttendanceDeviceService, service5.NewDevicePushService, service5.NewDeviceFleetService, service5.NewBiometricService, service5.NewPlatformAdapterFactory, service5.NewPlatformSyncService, service5.NewOvertimeService, service5.NewTripExpenseService, service5.NewBusinessTripService, service5.NewLeaveOfficeService, service5.NewPunchCardSupplementService, service5.NewKeyRotationService, service5.NewHRMEmployeeService, service5.NewEmployeeLifecycleService, service5.NewReportExportService, service5.NewPayrollService, service5.NewClockQRSigner, service5.NewClockLocationService, service5.NewTimeAllocationService, handler.NewAttendanceHandler, handler.NewShiftHandler, handler.NewScheduleHandler, handler.NewAttendanceRuleHandler, handler.NewLeaveHandler, handler.NewOvertimeHandler, handler.NewBusinessTripHandler, handler.NewLeaveOfficeHandler, handler.NewPunchCardSupplementHandler)
 
 // HRMModule represents the HRM module
 type HRMModule struct {
-	AttendanceHandler     *handler.AttendanceHandler
-	ShiftHandler          *handler.ShiftHandler
-	ScheduleHandler       *handler.ScheduleHandler
-	AttendanceRuleHandler *handler.AttendanceRuleHandler
-	LeaveHandler          *handler.LeaveHandler
-	OvertimeHandler       *handler.OvertimeHandler
-	BusinessTripHandler   *handler.BusinessTripHandler
-	LeaveOfficeHandler    *handler.LeaveOfficeHandler
+	AttendanceHandler          *handler.AttendanceHandler
+	ShiftHandler               *handler.ShiftHandler
+	ScheduleHandler            *handler.ScheduleHandler
+	AttendanceRuleHandler      *handler.AttendanceRuleHandler
+	LeaveHandler               *handler.LeaveHandler
+	OvertimeHandler            *handler.OvertimeHandler
+	BusinessTripHandler        *handler.BusinessTripHandler
+	LeaveOfficeHandler         *handler.LeaveOfficeHandler
+	PunchCardSupplementHandler *handler.PunchCardSupplementHandler
 }
@@ -48,6 +48,9 @@ const (
 	NotificationPriorityUrgent NotificationPriority = "urgent" // 紧急
 )
 
+// DataKeyReplyTo 附加数据中的回复地址，邮件通知发送时写入 Reply-To 头
+const DataKeyReplyTo = "reply_to"
+
 // Notification 通知模型
 type Notification struct {
 	ID             uuid.UUID               `json:"id"`
@@ -31,23 +31,19 @@ func NewEmailSender(config *EmailConfig) *EmailSender {
 
 // SendEmail 发送邮件
 func (s *EmailSender) SendEmail(to, subject, body string) error {
+	return s.SendEmailWithReplyTo(to, "", subject, body)
+}
+
+// SendEmailWithReplyTo 发送邮件，replyTo 非空时设置 Reply-To 头，收件人直接回复即发往该地址
+func (s *EmailSender) SendEmailWithReplyTo(to, replyTo, subject, body string) error {
 	if s.config == nil {
 		return fmt.Errorf("email config is nil")
 	}
 
-	// 构建邮件头
-	headers := make(map[string]string)
-	headers["From"] = s.config.From
-	headers["To"] = to
-	headers["Subject"] = subject
-	headers["Content-Type"] = "text/html; charset=UTF-8"
-
-	// 组装邮件内容
-	message := ""
-	for k, v := range headers {
-		message += fmt.Sprintf("%s: %s\r\n", k, v)
+	message, err := s.buildMessage(to, replyTo, subject, body)
+	if err != nil {
+		return err
 	}
-	message += "\r\n" + body
 
 	// SMTP 认证
 	auth := smtp.PlainAuth(
@@ -61,7 +57,7 @@ func (s *EmailSender) SendEmail(to, subject, body string) error {
 	addr := fmt.Sprintf("%s:%d", s.config.Host, s.config.Port)
 
 	if s.config.UseTLS {
-		return s.sendEmailWithTLS(addr, auth, to, []byte(message))
+		return s.sendEmailWithTLS(addr, auth, to, message)
 	}
 
 	return smtp.SendMail(
@@ -69,10 +65,33 @@ func (s *EmailSender) SendEmail(to, subject, body string) error {
 		auth,
 		s.config.From,
 		[]string{to},
-		[]byte(message),
+		message,
 	)
 }
 
+// buildMessage 组装邮件头和正文，头字段值不允许换行以防注入额外的头
+func (s *EmailSender) buildMessage(to, replyTo, subject, body string) ([]byte, error) {
+	headers := [][2]string{
+		{"From", s.config.From},
+		{"To", to},
+		{"Subject", subject},
+		{"Content-Type", "text/html; charset=UTF-8"},
+	}
+	if replyTo != "" {
+		headers = append(headers, [2]string{"Reply-To", replyTo})
+	}
+
+	var message strings.Builder
+	for _, header := range headers {
+		if strings.ContainsAny(header[1], "\r\n") {
+			return nil, fmt.Errorf("invalid %s header", header[0])
+		}
+		message.WriteString(fmt.Sprintf("%s: %s\r\n", header[0], header[1]))
+	}
+	message.WriteString("\r\n" + body)
+	return []byte(message.String()), nil
+}
+
 // sendEmailWithTLS 使用 TLS 发送邮件
 func (s *EmailSender) sendEmailWithTLS(addr string, auth smtp.Auth, to string, msg []byte) error {
 	// 解析主机名（用于 TLS 验证）
@@ -140,10 +140,13 @@ func (s *notificationService) SendNotification(ctx context.Context, tenantID uui
 		return nil, fmt.Errorf("failed to create notification: %w", err)
 	}
 
+	// 异步发送会更新通知状态，先生成响应
+	resp := dto.ToNotificationResponse(notification)
+
 	// 异步发送通知（根据 channel 类型）
 	go s.sendNotification(context.Background(), notification)
 
-	return dto.ToNotificationResponse(notification), nil
+	return resp, nil
 }
 
 func (s *notificationService) GetNotification(ctx context.Context, id uuid.UUID) (*dto.NotificationResponse, error) {
@@ -272,8 +275,9 @@ func (s *notificationService) sendNotification(ctx context.Context, notification
 			return
 		}
 
-		// 发送邮件
-		err := s.emailSender.SendEmail(*notification.RecipientEmail, notification.Title, notification.Content)
+		// 发送邮件（附加数据带回复地址时设置 Reply-To）
+		replyTo, _ := notification.Data[model.DataKeyReplyTo].(string)
+		err := s.emailSender.SendEmailWithReplyTo(*notification.RecipientEmail, replyTo, notification.Title, notification.Content)
 		if err != nil {
 			errMsg := fmt.Sprintf("failed to send email: %v", err)
 			notification.Status = model.NotificationStatusFailed
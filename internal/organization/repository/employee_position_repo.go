@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lk2023060901/go-next-erp/internal/organization/model"
+	"github.com/lk2023060901/go-next-erp/pkg/database"
+)
+
+// EmployeePositionRepository 员工任职记录仓储接口
+type EmployeePositionRepository interface {
+	// Create 创建任职记录
+	Create(ctx context.Context, ep *model.EmployeePosition) error
+
+	// ListByEmployee 列出员工的全部任职记录（按开始日期倒序）
+	ListByEmployee(ctx context.Context, employeeID uuid.UUID) ([]*model.EmployeePosition, error)
+
+	// CloseActive 关闭员工所有未结束的任职记录，返回关闭的条数
+	CloseActive(ctx context.Context, employeeID uuid.UUID, endDate time.Time) (int, error)
+}
+
+type employeePositionRepo struct {
+	db *database.DB
+}
+
+// NewEmployeePositionRepository 创建员工任职记录仓储
+func NewEmployeePositionRepository(db *database.DB) EmployeePositionRepository {
+	return &employeePositionRepo{db: db}
+}
+
+func (r *employeePositionRepo) Create(ctx context.Context, ep *model.EmployeePosition) error {
+	sql := `
+		INSERT INTO employee_positions (
+			id, tenant_id, employee_id, position_id, org_id, is_primary,
+			start_date, end_date, created_by, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	_, err := r.db.Exec(ctx, sql,
+		ep.ID, ep.TenantID, ep.EmployeeID, ep.PositionID, ep.OrgID, ep.IsPrimary,
+		ep.StartDate, ep.EndDate, ep.CreatedBy, ep.CreatedAt, ep.UpdatedAt,
+	)
+
+	return err
+}
+
+func (r *employeePositionRepo) ListByEmployee(ctx context.Context, employeeID uuid.UUID) ([]*model.EmployeePosition, error) {
+	sql := `
+		SELECT id, tenant_id, employee_id, position_id, org_id, is_primary,
+		       start_date, end_date, created_by, created_at, updated_at, deleted_at
+		FROM employee_positions
+		WHERE employee_id = $1 AND deleted_at IS NULL
+		ORDER BY start_date DESC NULLS LAST, created_at DESC
+	`
+
+	rows, err := r.db.Query(ctx, sql, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var positions []*model.EmployeePosition
+	for rows.Next() {
+		ep := &model.EmployeePosition{}
+		err := rows.Scan(
+			&ep.ID, &ep.TenantID, &ep.EmployeeID, &ep.PositionID, &ep.OrgID, &ep.IsPrimary,
+			&ep.StartDate, &ep.EndDate, &ep.CreatedBy, &ep.CreatedAt, &ep.UpdatedAt, &ep.DeletedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		positions = append(positions, ep)
+	}
+
+	return positions, rows.Err()
+}
+
+func (r *employeePositionRepo) CloseActive(ctx context.Context, employeeID uuid.UUID, endDate time.Time) (int, error) {
+	sql := `
+		UPDATE employee_positions SET end_date = $1, updated_at = NOW()
+		WHERE employee_id = $2 AND deleted_at IS NULL AND (end_date IS NULL OR end_date > $1)
+	`
+
+	tag, err := r.db.Exec(ctx, sql, endDate, employeeID)
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
@@ -11,6 +11,7 @@ var ProviderSet = wire.NewSet(
 	repository.NewOrganizationRepository,
 	repository.NewEmployeeRepository,
 	repository.NewPositionRepository,
+	repository.NewEmployeePositionRepository,
 	repository.NewClosureRepository,
 	repository.NewOrganizationTypeRepository,
 	service.NewOrganizationService,
@@ -37,6 +37,8 @@ func NewHTTPServer(
 	approvalAdapter *adapter.ApprovalAdapter,
 	fileAdapter *adapter.FileAdapter,
 	hrmAdapter *adapter.HRMAdapter,
+	zktecoAdapter *adapter.ZKTecoHTTPAdapter,
+	platformCallbackAdapter *adapter.PlatformCallbackHTTPAdapter,
 	notifService service.NotificationService, // 通知服务
 	wsHub *ws.Hub, // WebSocket Hub
 	wsHandler *ws.Handler, // WebSocket 处理器
@@ -61,6 +63,8 @@ func NewHTTPServer(
 		"/api.auth.v1.AuthService/Register",
 		"/api.auth.v1.AuthService/RefreshToken",
 	}
+	noAuthPaths = append(noAuthPaths, adapter.ApprovalPublicOperations...)
+	noAuthPaths = append(noAuthPaths, adapter.HRMPublicOperations...)
 
 	var opts = []http.ServerOption{
 		http.Address(cfg.Server.HTTP.Addr),
@@ -109,6 +113,11 @@ func NewHTTPServer(
 	approvalv1.RegisterProcessDefinitionServiceHTTPServer(srv, approvalAdapter)
 	approvalv1.RegisterProcessInstanceServiceHTTPServer(srv, approvalAdapter)
 	approvalv1.RegisterApprovalTaskServiceHTTPServer(srv, approvalAdapter)
+	approvalv1.RegisterProcessSearchServiceHTTPServer(srv, approvalAdapter)
+	approvalv1.RegisterProcessAuditServiceHTTPServer(srv, approvalAdapter)
+	approvalv1.RegisterProcessStatsServiceHTTPServer(srv, approvalAdapter)
+	approvalv1.RegisterProcessAdminJobServiceHTTPServer(srv, approvalAdapter)
+	approvalv1.RegisterActionLinkServiceHTTPServer(srv, approvalAdapter)
 
 	// 注分 File 服务
 	filev1.RegisterFileServiceHTTPServer(srv, fileAdapter)
@@ -124,6 +133,28 @@ func NewHTTPServer(
 	hrmv1.RegisterLeaveQuotaServiceHTTPServer(srv, hrmAdapter)
 	hrmv1.RegisterBusinessTripServiceHTTPServer(srv, hrmAdapter)
 	hrmv1.RegisterLeaveOfficeServiceHTTPServer(srv, hrmAdapter)
+	hrmv1.RegisterHolidayCalendarServiceHTTPServer(srv, hrmAdapter)
+	hrmv1.RegisterAttendanceSummaryServiceHTTPServer(srv, hrmAdapter)
+	hrmv1.RegisterScheduleRotationServiceHTTPServer(srv, hrmAdapter)
+	hrmv1.RegisterShiftSwapServiceHTTPServer(srv, hrmAdapter)
+	hrmv1.RegisterOvertimePolicyServiceHTTPServer(srv, hrmAdapter)
+	hrmv1.RegisterAttendanceAnomalyServiceHTTPServer(srv, hrmAdapter)
+	hrmv1.RegisterAttendanceDeviceServiceHTTPServer(srv, hrmAdapter)
+	hrmv1.RegisterBiometricServiceHTTPServer(srv, hrmAdapter)
+	hrmv1.RegisterHRMEmployeeServiceHTTPServer(srv, hrmAdapter)
+	hrmv1.RegisterEmployeeLifecycleServiceHTTPServer(srv, hrmAdapter)
+	hrmv1.RegisterTripExpenseServiceHTTPServer(srv, hrmAdapter)
+	hrmv1.RegisterPlatformSyncServiceHTTPServer(srv, hrmAdapter)
+	hrmv1.RegisterReportExportServiceHTTPServer(srv, hrmAdapter)
+	hrmv1.RegisterPayrollServiceHTTPServer(srv, hrmAdapter)
+	hrmv1.RegisterClockLocationServiceHTTPServer(srv, hrmAdapter)
+	hrmv1.RegisterTimeAllocationServiceHTTPServer(srv, hrmAdapter)
+
+	// 注册考勤机推送协议接入点（设备按序列号识别，不走 JWT 认证）
+	zktecoAdapter.RegisterRoutes(srv)
+
+	// 注册钉钉、企业微信、飞书事件回调（按平台签名鉴权，不走 JWT 认证）
+	platformCallbackAdapter.RegisterRoutes(srv)
 
 	// 注册 WebSocket 通知推送路由
 	srv.HandleFunc("/api/v1/notifications/ws", wsHandler.ServeHTTP)
@@ -0,0 +1,152 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	approvalService "github.com/lk2023060901/go-next-erp/internal/approval/service"
+	hrmService "github.com/lk2023060901/go-next-erp/internal/hrm/service"
+	"github.com/lk2023060901/go-next-erp/pkg/scheduler"
+)
+
+// jobTimeout 单次定时任务执行超时
+const jobTimeout = 10 * time.Minute
+
+// JobServer 定时任务服务，实现 transport.Server 以随应用启停
+type JobServer struct {
+	sched  *scheduler.Scheduler
+	logger *log.Helper
+}
+
+// NewJobServer 创建定时任务服务并注册各模块的定时任务
+func NewJobServer(
+	sched *scheduler.Scheduler,
+	approvalStats approvalService.ProcessStatsService,
+	approvalAdminJobs approvalService.ProcessAdminJobService,
+	attendanceSummary hrmService.AttendanceSummaryService,
+	leaveAccrual hrmService.LeaveAccrualService,
+	attendanceAnomaly hrmService.AttendanceAnomalyService,
+	platformSync hrmService.PlatformSyncService,
+	employeeLifecycle hrmService.EmployeeLifecycleService,
+	reportExports hrmService.ReportExportService,
+	deviceFleet hrmService.DeviceFleetService,
+	logger log.Logger,
+) (*JobServer, error) {
+	s := &JobServer{
+		sched:  sched,
+		logger: log.NewHelper(log.With(logger, "module", "server/job")),
+	}
+
+	if err := s.register("approval-stats-refresh", approvalStats.CronSpec(), func(ctx context.Context) error {
+		_, err := approvalStats.Refresh(ctx)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.register("approval-admin-jobs", approvalAdminJobs.CronSpec(), func(ctx context.Context) error {
+		_, err := approvalAdminJobs.RunDue(ctx)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.register("hrm-attendance-summary", attendanceSummary.CronSpec(), func(ctx context.Context) error {
+		_, err := attendanceSummary.RunNightly(ctx)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.register("hrm-leave-accrual", leaveAccrual.CronSpec(), func(ctx context.Context) error {
+		_, err := leaveAccrual.RunDaily(ctx)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.register("hrm-attendance-anomaly", attendanceAnomaly.CronSpec(), func(ctx context.Context) error {
+		_, err := attendanceAnomaly.RunNightly(ctx)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.register("hrm-platform-sync", platformSync.CronSpec(), func(ctx context.Context) error {
+		_, err := platformSync.RunDue(ctx)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.register("hrm-employee-lifecycle", employeeLifecycle.CronSpec(), func(ctx context.Context) error {
+		_, err := employeeLifecycle.RunDue(ctx)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.register("hrm-report-export", reportExports.CronSpec(), func(ctx context.Context) error {
+		_, err := reportExports.RunDue(ctx)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.register("hrm-device-health", deviceFleet.CronSpec(), func(ctx context.Context) error {
+		_, err := deviceFleet.CheckHealth(ctx)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// register 注册定时任务：同名任务上一次未结束时跳过本次调度
+func (s *JobServer) register(name, spec string, run func(ctx context.Context) error) error {
+	_, err := s.sched.AddJob(name, spec, &namedJob{
+		name: name,
+		run: func() {
+			ctx, cancel := context.WithTimeout(context.Background(), jobTimeout)
+			defer cancel()
+
+			if err := run(ctx); err != nil {
+				s.logger.Errorf("job %s failed: %v", name, err)
+			}
+		},
+	})
+	return err
+}
+
+// Start 启动调度器
+func (s *JobServer) Start(ctx context.Context) error {
+	return s.sched.Start()
+}
+
+// Stop 停止调度器并等待运行中的任务结束
+func (s *JobServer) Stop(ctx context.Context) error {
+	return s.sched.Shutdown(ctx)
+}
+
+// namedJob 带名称、防重入的定时任务
+type namedJob struct {
+	name    string
+	run     func()
+	running sync.Mutex
+}
+
+func (j *namedJob) Name() string {
+	return j.name
+}
+
+func (j *namedJob) Run() {
+	if !j.running.TryLock() {
+		return
+	}
+	defer j.running.Unlock()
+
+	j.run()
+}
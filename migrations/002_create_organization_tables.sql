@@ -160,6 +160,26 @@ CREATE INDEX IF NOT EXISTS idx_user_positions_position ON user_positions(positio
 CREATE INDEX IF NOT EXISTS idx_user_positions_organization ON user_positions(organization_id);
 CREATE INDEX IF NOT EXISTS idx_user_positions_tenant ON user_positions(tenant_id);
 
+-- Employee Positions table (员工任职记录，支持一人多职；调岗/离职时关闭旧记录)
+CREATE TABLE IF NOT EXISTS employee_positions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+    employee_id UUID NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    position_id UUID NOT NULL REFERENCES positions(id),
+    org_id UUID NOT NULL REFERENCES organizations(id),
+    is_primary BOOLEAN NOT NULL DEFAULT FALSE,
+    start_date DATE,
+    end_date DATE,
+    created_by UUID,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_emp_position_employee ON employee_positions(employee_id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_emp_position_position ON employee_positions(position_id);
+CREATE INDEX IF NOT EXISTS idx_emp_position_org ON employee_positions(org_id);
+
 -- Organization Closures table (闭包表，用于组织树查询优化)
 CREATE TABLE IF NOT EXISTS organization_closures (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
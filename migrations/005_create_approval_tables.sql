@@ -42,6 +42,8 @@ CREATE TABLE IF NOT EXISTS approval_process_instances (
     current_node_id VARCHAR(50),
     current_node_name VARCHAR(100),
     variables JSONB,
+    history_seq BIGINT NOT NULL DEFAULT 0,
+    history_head_hash VARCHAR(64),
     started_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
     completed_at TIMESTAMPTZ,
     created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
@@ -92,15 +94,201 @@ CREATE TABLE IF NOT EXISTS approval_process_histories (
     comment TEXT,
     from_status VARCHAR(20),
     to_status VARCHAR(20) NOT NULL,
-    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    seq BIGINT NOT NULL DEFAULT 0,
+    prev_hash VARCHAR(64) NOT NULL DEFAULT '',
+    hash VARCHAR(64) NOT NULL DEFAULT ''
 );
 
 CREATE INDEX idx_approval_histories_process ON approval_process_histories(process_instance_id);
 CREATE INDEX idx_approval_histories_operator ON approval_process_histories(operator_id);
 CREATE INDEX idx_approval_histories_created ON approval_process_histories(created_at DESC);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_approval_histories_chain ON approval_process_histories(process_instance_id, seq) WHERE seq > 0;
+
+-- 全文检索（三元组索引用于中文等无分词文本的模糊匹配）
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- 创建流程可检索字段配置表
+CREATE TABLE IF NOT EXISTS approval_search_fields (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL,
+    process_def_id UUID NOT NULL REFERENCES approval_process_definitions(id),
+    field_key VARCHAR(100) NOT NULL,
+    field_label VARCHAR(100) NOT NULL,
+    field_type VARCHAR(20) NOT NULL DEFAULT 'text',
+    sort INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_approval_search_fields_def_key ON approval_search_fields(process_def_id, field_key);
+
+-- 创建流程实例检索索引表
+CREATE TABLE IF NOT EXISTS approval_instance_search_index (
+    process_instance_id UUID PRIMARY KEY REFERENCES approval_process_instances(id) ON DELETE CASCADE,
+    tenant_id UUID NOT NULL,
+    process_def_id UUID NOT NULL,
+    applicant_id UUID NOT NULL,
+    applicant_name VARCHAR(100) NOT NULL DEFAULT '',
+    title VARCHAR(200) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL,
+    field_values JSONB NOT NULL DEFAULT '{}',
+    comments TEXT NOT NULL DEFAULT '',
+    content TEXT NOT NULL DEFAULT '',
+    content_tsv TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', content)) STORED,
+    started_at TIMESTAMPTZ NOT NULL,
+    completed_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_approval_search_tsv ON approval_instance_search_index USING GIN(content_tsv);
+CREATE INDEX IF NOT EXISTS idx_approval_search_trgm ON approval_instance_search_index USING GIN(content gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_approval_search_fields_values ON approval_instance_search_index USING GIN(field_values jsonb_path_ops);
+CREATE INDEX IF NOT EXISTS idx_approval_search_started ON approval_instance_search_index(tenant_id, started_at DESC, process_instance_id DESC);
+CREATE INDEX IF NOT EXISTS idx_approval_search_updated ON approval_instance_search_index(tenant_id, updated_at DESC, process_instance_id DESC);
+
+-- 创建审批快捷链接令牌表（邮件/IM 一键审批，一次性使用）
+CREATE TABLE IF NOT EXISTS approval_action_tokens (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    task_id UUID NOT NULL REFERENCES approval_tasks(id) ON DELETE CASCADE,
+    assignee_id UUID NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ,
+    used_ip VARCHAR(64),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_approval_action_tokens_task ON approval_action_tokens(task_id);
+CREATE INDEX IF NOT EXISTS idx_approval_action_tokens_expires ON approval_action_tokens(expires_at) WHERE used_at IS NULL;
+
+-- 创建入站邮件 Webhook 随机数表（拒绝重放，超出时间戳允许偏差的记录可清理）
+CREATE TABLE IF NOT EXISTS approval_inbound_nonces (
+    nonce VARCHAR(128) PRIMARY KEY,
+    received_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_approval_inbound_nonces_received ON approval_inbound_nonces(received_at);
+
+-- 创建审批统计表（定时任务刷新，按周聚合，供管理看板查询）
+CREATE TABLE IF NOT EXISTS approval_stats_node_weekly (
+    tenant_id UUID NOT NULL,
+    process_def_id UUID NOT NULL,
+    node_id VARCHAR(50) NOT NULL,
+    node_name VARCHAR(100) NOT NULL,
+    week_start DATE NOT NULL,
+    task_count INT NOT NULL DEFAULT 0,
+    approved_count INT NOT NULL DEFAULT 0,
+    rejected_count INT NOT NULL DEFAULT 0,
+    avg_seconds DOUBLE PRECISION NOT NULL DEFAULT 0,
+    median_seconds DOUBLE PRECISION NOT NULL DEFAULT 0,
+    p90_seconds DOUBLE PRECISION NOT NULL DEFAULT 0,
+    refreshed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tenant_id, process_def_id, node_id, week_start)
+);
+
+CREATE TABLE IF NOT EXISTS approval_stats_approver_weekly (
+    tenant_id UUID NOT NULL,
+    assignee_id UUID NOT NULL,
+    assignee_name VARCHAR(100) NOT NULL,
+    week_start DATE NOT NULL,
+    task_count INT NOT NULL DEFAULT 0,
+    avg_seconds DOUBLE PRECISION NOT NULL DEFAULT 0,
+    p90_seconds DOUBLE PRECISION NOT NULL DEFAULT 0,
+    refreshed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tenant_id, assignee_id, week_start)
+);
+
+CREATE TABLE IF NOT EXISTS approval_stats_rejection_reasons (
+    tenant_id UUID NOT NULL,
+    process_def_id UUID NOT NULL,
+    node_id VARCHAR(50) NOT NULL,
+    node_name VARCHAR(100) NOT NULL,
+    week_start DATE NOT NULL,
+    reason VARCHAR(200) NOT NULL,
+    count INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (tenant_id, process_def_id, node_id, week_start, reason)
+);
+
+CREATE TABLE IF NOT EXISTS approval_stats_process_weekly (
+    tenant_id UUID NOT NULL,
+    process_def_id UUID NOT NULL,
+    process_def_name VARCHAR(100) NOT NULL,
+    week_start DATE NOT NULL,
+    started_count INT NOT NULL DEFAULT 0,
+    completed_count INT NOT NULL DEFAULT 0,
+    approved_count INT NOT NULL DEFAULT 0,
+    rejected_count INT NOT NULL DEFAULT 0,
+    avg_cycle_seconds DOUBLE PRECISION NOT NULL DEFAULT 0,
+    p90_cycle_seconds DOUBLE PRECISION NOT NULL DEFAULT 0,
+    refreshed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tenant_id, process_def_id, week_start)
+);
+
+CREATE TABLE IF NOT EXISTS approval_stats_queue_snapshots (
+    tenant_id UUID NOT NULL,
+    process_def_id UUID NOT NULL,
+    node_id VARCHAR(50) NOT NULL,
+    node_name VARCHAR(100) NOT NULL,
+    snapshot_at TIMESTAMPTZ NOT NULL,
+    pending_count INT NOT NULL DEFAULT 0,
+    oldest_pending_seconds DOUBLE PRECISION NOT NULL DEFAULT 0,
+    PRIMARY KEY (tenant_id, process_def_id, node_id, snapshot_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_approval_tasks_approved_at ON approval_tasks(approved_at) WHERE approved_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_approval_stats_queue_time ON approval_stats_queue_snapshots(tenant_id, snapshot_at);
+
+-- 创建审批管理批量作业表（离职转交、批量取消/完结、重新生成任务）
+CREATE TABLE IF NOT EXISTS approval_admin_jobs (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    type VARCHAR(30) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    params JSONB,
+    targets UUID[] NOT NULL DEFAULT '{}',
+    reason TEXT NOT NULL DEFAULT '',
+    total INT NOT NULL DEFAULT 0,
+    processed INT NOT NULL DEFAULT 0,
+    succeeded INT NOT NULL DEFAULT 0,
+    failed INT NOT NULL DEFAULT 0,
+    skipped INT NOT NULL DEFAULT 0,
+    error TEXT,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    started_at TIMESTAMPTZ,
+    finished_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_approval_admin_jobs_tenant ON approval_admin_jobs(tenant_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_approval_admin_jobs_unfinished ON approval_admin_jobs(updated_at) WHERE status IN ('pending', 'running');
+
+CREATE TABLE IF NOT EXISTS approval_admin_job_items (
+    id UUID PRIMARY KEY,
+    job_id UUID NOT NULL REFERENCES approval_admin_jobs(id) ON DELETE CASCADE,
+    target_id UUID NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    message TEXT NOT NULL DEFAULT '',
+    processed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_approval_admin_job_items_job ON approval_admin_job_items(job_id, processed_at);
 
 -- 添加注释
 COMMENT ON TABLE approval_process_definitions IS '审批流程定义表';
 COMMENT ON TABLE approval_process_instances IS '审批流程实例表';
 COMMENT ON TABLE approval_tasks IS '审批任务表';
 COMMENT ON TABLE approval_process_histories IS '审批流程历史表';
+COMMENT ON TABLE approval_search_fields IS '审批流程可检索字段配置表';
+COMMENT ON TABLE approval_instance_search_index IS '审批流程实例检索索引表';
+COMMENT ON TABLE approval_action_tokens IS '审批快捷链接令牌表';
+COMMENT ON TABLE approval_inbound_nonces IS '入站邮件 Webhook 随机数表';
+COMMENT ON TABLE approval_stats_node_weekly IS '审批节点周度处理时长统计表';
+COMMENT ON TABLE approval_stats_approver_weekly IS '审批人周度处理时长统计表';
+COMMENT ON TABLE approval_stats_rejection_reasons IS '审批节点拒绝原因统计表';
+COMMENT ON TABLE approval_stats_process_weekly IS '审批流程周度统计表';
+COMMENT ON TABLE approval_stats_queue_snapshots IS '审批节点待办队列快照表';
+COMMENT ON TABLE approval_admin_jobs IS '审批管理批量作业表';
+COMMENT ON TABLE approval_admin_job_items IS '审批管理批量作业条目结果表';
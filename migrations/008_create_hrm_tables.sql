@@ -11,12 +11,13 @@ CREATE TABLE IF NOT EXISTS hrm_employees (
     employee_id UUID NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
     
     -- 身份信息
-    id_card_no VARCHAR(50),  -- 身份证号（加密存储）
+    id_card_no TEXT,                 -- 身份证号（信封加密密文）
+    id_card_hash VARCHAR(64),        -- 身份证号盲索引（HMAC），用于查重
     
     -- 考勤设备信息
     card_no VARCHAR(50),     -- 考勤卡号
-    face_data TEXT,          -- 人脸特征数据（加密存储）
-    fingerprint TEXT,        -- 指纹数据（加密存储）
+    face_data TEXT,          -- 人脸特征数据（信封加密密文）
+    fingerprint TEXT,        -- 指纹数据（信封加密密文）
     
     -- 第三方平台映射
     dingtalk_user_id VARCHAR(100),   -- 钉钉 UserID
@@ -55,6 +56,7 @@ CREATE TABLE IF NOT EXISTS hrm_employees (
 
 CREATE UNIQUE INDEX IF NOT EXISTS idx_hrm_employees_employee ON hrm_employees(tenant_id, employee_id) WHERE deleted_at IS NULL;
 CREATE UNIQUE INDEX IF NOT EXISTS idx_hrm_employees_card_no ON hrm_employees(tenant_id, card_no) WHERE deleted_at IS NULL AND card_no IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_hrm_employees_id_card ON hrm_employees(tenant_id, id_card_hash) WHERE deleted_at IS NULL AND id_card_hash IS NOT NULL;
 CREATE INDEX IF NOT EXISTS idx_hrm_employees_tenant ON hrm_employees(tenant_id);
 CREATE INDEX IF NOT EXISTS idx_hrm_employees_rule ON hrm_employees(attendance_rule_id);
 CREATE INDEX IF NOT EXISTS idx_hrm_employees_shift ON hrm_employees(default_shift_id);
@@ -66,6 +68,8 @@ CREATE INDEX IF NOT EXISTS idx_hrm_employees_feishu ON hrm_employees(feishu_user
 COMMENT ON TABLE hrm_employees IS 'HRM员工扩展信息表';
 COMMENT ON COLUMN hrm_employees.employee_id IS '关联 employees 表的员工ID';
 COMMENT ON COLUMN hrm_employees.card_no IS '考勤卡号，全局唯一';
+COMMENT ON COLUMN hrm_employees.id_card_no IS '身份证号密文（enc:v1:<数据密钥版本>:<base64>）';
+COMMENT ON COLUMN hrm_employees.id_card_hash IS '身份证号盲索引（按租户派生的 HMAC-SHA256），等值查重用';
 COMMENT ON COLUMN hrm_employees.is_active IS '是否启用考勤，默认 true';
 
 -- =============================================================================
@@ -297,6 +301,7 @@ CREATE TABLE IF NOT EXISTS hrm_attendance_rules (
     -- 打卡位置限制
     location_required BOOLEAN DEFAULT FALSE,  -- 是否必须定位
     allowed_locations JSONB,          -- 允许的打卡位置数组
+    clock_location_ids UUID[],        -- 关联打卡地点（hrm_clock_locations：多边形围栏、时段、二维码）
     
     -- WiFi限制
     wifi_required BOOLEAN DEFAULT FALSE,  -- 是否必须连接指定WiFi
@@ -313,6 +318,9 @@ CREATE TABLE IF NOT EXISTS hrm_attendance_rules (
     -- 节假日设置
     holiday_calendar_id UUID,         -- 关联假期日历
     
+    -- 加班设置
+    overtime_policy_id UUID,          -- 关联加班政策（为空时使用租户默认政策）
+    
     -- 审批设置
     require_approval_for_late BOOLEAN DEFAULT FALSE,   -- 迟到需要审批
     require_approval_for_early BOOLEAN DEFAULT FALSE,  -- 早退需要审批
@@ -96,6 +96,8 @@ CREATE INDEX IF NOT EXISTS idx_attendance_records_status ON hrm_attendance_recor
 CREATE INDEX IF NOT EXISTS idx_attendance_records_source ON hrm_attendance_records(source_type, source_id);
 CREATE INDEX IF NOT EXISTS idx_attendance_records_exception ON hrm_attendance_records(is_exception) WHERE is_exception = TRUE;
 CREATE INDEX IF NOT EXISTS idx_attendance_records_shift ON hrm_attendance_records(shift_id);
+-- 设备上传的打卡去重：设备重传或并发上传同一条记录时只保留一条（含已删除的记录，避免重传后复活）
+CREATE UNIQUE INDEX IF NOT EXISTS idx_attendance_records_device_punch ON hrm_attendance_records(tenant_id, source_id, employee_id, clock_time) WHERE source_type = 'device';
 
 COMMENT ON TABLE hrm_attendance_records IS '考勤记录表（按月分区）';
 COMMENT ON COLUMN hrm_attendance_records.clock_type IS '打卡类型: check_in(上班), check_out(下班)';
@@ -286,6 +288,15 @@ CREATE TABLE IF NOT EXISTS hrm_overtimes (
     approved_at TIMESTAMP,
     reject_reason TEXT,
     
+    -- 加班政策计算结果
+    policy_id UUID,                   -- 计算所用加班政策（法定标准时为空）
+    billable_hours DECIMAL(5,2),      -- 按最小计量单位取整后的计薪时长
+    warnings TEXT[],                  -- 超出月度/年度上限等提示
+    
+    -- 来源
+    source VARCHAR(20) NOT NULL DEFAULT 'manual',  -- manual, auto
+    attendance_record_id UUID,        -- 自动识别时对应的下班打卡
+    
     -- 调休信息
     comp_off_days DECIMAL(5,2) DEFAULT 0,      -- 可调休天数
     comp_off_used DECIMAL(5,2) DEFAULT 0,      -- 已调休天数
@@ -306,11 +317,14 @@ CREATE INDEX IF NOT EXISTS idx_overtimes_type ON hrm_overtimes(overtime_type);
 CREATE INDEX IF NOT EXISTS idx_overtimes_status ON hrm_overtimes(approval_status);
 CREATE INDEX IF NOT EXISTS idx_overtimes_time ON hrm_overtimes(start_time, end_time);
 CREATE INDEX IF NOT EXISTS idx_overtimes_department ON hrm_overtimes(department_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_overtimes_attendance_record ON hrm_overtimes(attendance_record_id)
+    WHERE attendance_record_id IS NOT NULL AND deleted_at IS NULL;
 
 COMMENT ON TABLE hrm_overtimes IS '加班记录表';
 COMMENT ON COLUMN hrm_overtimes.overtime_type IS '加班类型: workday(工作日), weekend(周末), holiday(节假日)';
 COMMENT ON COLUMN hrm_overtimes.pay_type IS '补偿方式: money(加班费), leave(调休)';
-COMMENT ON COLUMN hrm_overtimes.pay_rate IS '加班倍率: 工作日1.5, 周末2.0, 节假日3.0';
+COMMENT ON COLUMN hrm_overtimes.pay_rate IS '加班倍率: 按加班政策，默认工作日1.5, 周末2.0, 节假日3.0';
+COMMENT ON COLUMN hrm_overtimes.source IS '来源: manual(员工申请), auto(下班打卡自动识别)';
 COMMENT ON COLUMN hrm_overtimes.comp_off_days IS '可调休天数，默认 0';
 COMMENT ON COLUMN hrm_overtimes.comp_off_used IS '已调休天数，默认 0';
 
@@ -17,6 +17,8 @@ CREATE TABLE IF NOT EXISTS hrm_leave_types (
     max_duration DECIMAL(10,2),
     advance_days INT DEFAULT 0,
     approval_rules JSONB,
+    duration_policy JSONB,
+    accrual_policy JSONB,
     color VARCHAR(20) DEFAULT '#1890ff',
     is_active BOOLEAN NOT NULL DEFAULT true,
     sort INT DEFAULT 0,
@@ -37,6 +39,8 @@ COMMENT ON COLUMN hrm_leave_types.deduct_quota IS '是否扣除额度';
 COMMENT ON COLUMN hrm_leave_types.unit IS '最小单位：day/half_day/hour';
 COMMENT ON COLUMN hrm_leave_types.advance_days IS '需要提前申请的天数';
 COMMENT ON COLUMN hrm_leave_types.approval_rules IS '审批规则配置（JSON格式），支持基于天数的动态审批链';
+COMMENT ON COLUMN hrm_leave_types.duration_policy IS '时长计算规则（JSON格式）：rounding_mode/rounding_step/include_rest_days';
+COMMENT ON COLUMN hrm_leave_types.accrual_policy IS '额度发放规则（JSON格式）：frequency/base_quota/seniority_tiers/pro_rate/carry_over_cap/carry_over_months';
 
 -- 审批规则JSON示例：
 -- {
@@ -106,6 +110,7 @@ CREATE TABLE IF NOT EXISTS hrm_leave_requests (
     unit VARCHAR(20) NOT NULL DEFAULT 'day',
     reason TEXT NOT NULL,
     proof_urls JSONB,
+    day_breakdown JSONB,
     status VARCHAR(20) NOT NULL DEFAULT 'draft',
     current_approver_id UUID,
     submitted_at TIMESTAMP,
@@ -123,6 +128,7 @@ CREATE TABLE IF NOT EXISTS hrm_leave_requests (
 COMMENT ON TABLE hrm_leave_requests IS '请假申请表';
 COMMENT ON COLUMN hrm_leave_requests.duration IS '请假时长（天或小时）';
 COMMENT ON COLUMN hrm_leave_requests.proof_urls IS '证明材料附件URL数组（JSON格式）';
+COMMENT ON COLUMN hrm_leave_requests.day_breakdown IS '按天拆分的请假时长（JSON格式，服务端按班次计算）';
 COMMENT ON COLUMN hrm_leave_requests.status IS '状态：draft/pending/approved/rejected/withdrawn/cancelled';
 
 -- 4. 请假审批记录表
@@ -146,6 +152,34 @@ COMMENT ON COLUMN hrm_leave_approvals.level IS '审批层级，1表示第一级'
 COMMENT ON COLUMN hrm_leave_approvals.status IS '审批状态：pending/approved/rejected/skipped';
 COMMENT ON COLUMN hrm_leave_approvals.action IS '审批动作：approve/reject';
 
+-- 5. 请假额度流水表
+CREATE TABLE IF NOT EXISTS hrm_leave_quota_ledger (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL,
+    employee_id UUID NOT NULL,
+    leave_type_id UUID NOT NULL REFERENCES hrm_leave_types(id),
+    quota_id UUID NOT NULL REFERENCES hrm_leave_quotas(id),
+    year INT NOT NULL,
+    entry_type VARCHAR(20) NOT NULL,
+    amount DECIMAL(10,2) NOT NULL,
+    balance_after DECIMAL(10,2) NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP,
+    reason TEXT NOT NULL,
+    source_type VARCHAR(30),
+    source_id UUID,
+    operator_id UUID,
+    idempotency_key VARCHAR(200) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uk_leave_quota_ledger_key UNIQUE (tenant_id, idempotency_key)
+);
+
+COMMENT ON TABLE hrm_leave_quota_ledger IS '请假额度流水表（发放、扣减、结转、过期、人工调整）';
+COMMENT ON COLUMN hrm_leave_quota_ledger.entry_type IS '流水类型：grant/accrual/carry_over/comp_off/expiry/adjustment/deduction/refund';
+COMMENT ON COLUMN hrm_leave_quota_ledger.amount IS '变动数量，正为增加，负为减少';
+COMMENT ON COLUMN hrm_leave_quota_ledger.expires_at IS '本笔额度过期时间（结转、调休入账）';
+COMMENT ON COLUMN hrm_leave_quota_ledger.source_type IS '来源：leave_request/overtime/ledger/policy/manual';
+COMMENT ON COLUMN hrm_leave_quota_ledger.idempotency_key IS '幂等键，重复执行任务不会重复入账';
+
 -- 创建索引
 CREATE INDEX idx_leave_types_tenant ON hrm_leave_types(tenant_id, is_active) WHERE deleted_at IS NULL;
 CREATE INDEX idx_leave_types_cursor ON hrm_leave_types(tenant_id, created_at DESC, id DESC) WHERE deleted_at IS NULL;
@@ -159,6 +193,10 @@ CREATE INDEX idx_leave_requests_approver ON hrm_leave_requests(tenant_id, curren
 CREATE INDEX idx_leave_requests_time ON hrm_leave_requests(tenant_id, start_time, end_time) WHERE deleted_at IS NULL;
 CREATE INDEX idx_leave_requests_cursor ON hrm_leave_requests(tenant_id, created_at DESC, id DESC) WHERE deleted_at IS NULL;
 
+CREATE INDEX idx_leave_quota_ledger_quota ON hrm_leave_quota_ledger(quota_id, created_at);
+CREATE INDEX idx_leave_quota_ledger_employee ON hrm_leave_quota_ledger(tenant_id, employee_id, year);
+CREATE INDEX idx_leave_quota_ledger_expiring ON hrm_leave_quota_ledger(tenant_id, expires_at) WHERE expires_at IS NOT NULL;
+
 CREATE INDEX idx_leave_approvals_request ON hrm_leave_approvals(leave_request_id, level);
 CREATE INDEX idx_leave_approvals_approver ON hrm_leave_approvals(tenant_id, approver_id, status);
 
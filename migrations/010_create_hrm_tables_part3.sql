@@ -105,12 +105,17 @@ CREATE TABLE IF NOT EXISTS hrm_attendance_summaries (
     trip_count INTEGER DEFAULT 0,     -- 出差次数
     trip_days DECIMAL(5,2) DEFAULT 0,   -- 出差天数
     
+    -- 外出统计
+    leave_office_count INTEGER DEFAULT 0,       -- 外出次数
+    leave_office_hours DECIMAL(6,2) DEFAULT 0,  -- 外出小时数
+    
     -- 工时统计
     work_hours DECIMAL(8,2) DEFAULT 0,        -- 工作总时长
     standard_work_hours DECIMAL(8,2) DEFAULT 0,  -- 标准工时
     
     -- 状态
     status VARCHAR(20) DEFAULT 'draft',  -- draft, confirmed, locked
+    lock_snapshot JSONB,                 -- 锁定时固化的请假、加班和出差补助明细（薪资对接的数据来源）
     
     -- 审计字段
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
@@ -158,15 +163,16 @@ CREATE TABLE IF NOT EXISTS hrm_attendance_devices (
     -- 认证信息
     auth_type VARCHAR(50),            -- 认证方式：password, apikey, certificate
     username VARCHAR(100),
-    password TEXT,                    -- 加密存储
+    password TEXT,                    -- 信封加密密文
     api_key TEXT,
-    secret_key TEXT,
+    secret_key TEXT,                  -- 信封加密密文
     
     -- 同步配置
     sync_enabled BOOLEAN DEFAULT TRUE,  -- 是否启用同步
     sync_interval INTEGER DEFAULT 15,   -- 同步间隔（分钟）
     sync_mode VARCHAR(20) DEFAULT 'pull',  -- push, pull
     last_sync_at TIMESTAMP,           -- 最后同步时间
+    sync_cursor VARCHAR(100),         -- 考勤增量拉取游标
     
     -- 功能支持
     support_face BOOLEAN DEFAULT FALSE,         -- 支持人脸识别
@@ -180,6 +186,13 @@ CREATE TABLE IF NOT EXISTS hrm_attendance_devices (
     last_heartbeat TIMESTAMP,         -- 最后心跳时间
     error_message TEXT,               -- 错误信息
     
+    -- 推送协议（ADMS）
+    push_stamp VARCHAR(50),           -- 已接收的考勤记录上传戳
+    firmware_version VARCHAR(100),    -- 固件版本
+    fingerprint_version VARCHAR(20),  -- 指纹算法版本（心跳上报）
+    face_version VARCHAR(20),         -- 人脸算法版本（心跳上报）
+    push_allowed_ips TEXT[],          -- 允许接入推送协议的来源地址（IP 或 CIDR）
+    
     -- 统计信息
     total_records INTEGER DEFAULT 0,  -- 总记录数
     today_records INTEGER DEFAULT 0,  -- 今日记录数
@@ -278,6 +291,7 @@ COMMENT ON TABLE hrm_third_party_integrations IS '第三方平台集成配置表
 COMMENT ON COLUMN hrm_third_party_integrations.platform IS '平台类型: dingtalk(钉钉), wecom(企业微信), feishu(飞书)';
 COMMENT ON COLUMN hrm_third_party_integrations.sync_enabled IS '是否启用同步，默认 true';
 COMMENT ON COLUMN hrm_third_party_integrations.sync_interval IS '同步间隔（分钟），默认 30';
+COMMENT ON COLUMN hrm_third_party_integrations.sync_cursor IS '考勤增量拉取游标，由平台适配器生成，下次拉取从此处继续';
 COMMENT ON COLUMN hrm_third_party_integrations.sync_direction IS '同步方向: both(双向), pull(拉取), push(推送)，默认 pull';
 COMMENT ON COLUMN hrm_third_party_integrations.status IS '状态: active(正常), inactive(未激活), error(异常)，默认 inactive';
 
@@ -329,6 +343,824 @@ COMMENT ON COLUMN hrm_sync_logs.total_count IS '总数，默认 0';
 COMMENT ON COLUMN hrm_sync_logs.success_count IS '成功数，默认 0';
 COMMENT ON COLUMN hrm_sync_logs.failed_count IS '失败数，默认 0';
 
+-- =============================================================================
+-- 17. 假期日历表 (Holiday Calendars)
+-- =============================================================================
+-- 租户维护的节假日日历，考勤规则通过 holiday_calendar_id 关联
+CREATE TABLE IF NOT EXISTS hrm_holiday_calendars (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+
+    code VARCHAR(50) NOT NULL,        -- 日历编码
+    name VARCHAR(100) NOT NULL,       -- 日历名称
+    description TEXT,
+    region VARCHAR(20),               -- 适用地区，如 CN
+
+    is_default BOOLEAN DEFAULT FALSE, -- 租户默认日历
+    is_active BOOLEAN DEFAULT TRUE,
+
+    -- 审计字段
+    created_by UUID,
+    updated_by UUID,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_holiday_calendars_code ON hrm_holiday_calendars(tenant_id, code) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_holiday_calendars_default ON hrm_holiday_calendars(tenant_id) WHERE is_default = TRUE AND deleted_at IS NULL;
+
+COMMENT ON TABLE hrm_holiday_calendars IS '假期日历表';
+COMMENT ON COLUMN hrm_holiday_calendars.is_default IS '租户默认日历（考勤规则未指定日历时使用），每个租户至多一个';
+
+-- 日历特殊日：放假日与调休上班日，未登记日期按考勤规则周末设置判断
+CREATE TABLE IF NOT EXISTS hrm_holiday_calendar_days (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+    calendar_id UUID NOT NULL REFERENCES hrm_holiday_calendars(id) ON DELETE CASCADE,
+
+    day_date DATE NOT NULL,
+    kind VARCHAR(20) NOT NULL,        -- holiday, makeup_workday
+    name VARCHAR(100),                -- 节日名称
+    is_statutory BOOLEAN DEFAULT FALSE,  -- 是否法定节假日（加班按节假日计）
+    remark TEXT,
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_holiday_calendar_days_unique ON hrm_holiday_calendar_days(calendar_id, day_date);
+CREATE INDEX IF NOT EXISTS idx_holiday_calendar_days_tenant ON hrm_holiday_calendar_days(tenant_id);
+
+COMMENT ON TABLE hrm_holiday_calendar_days IS '假期日历特殊日表（放假/调休上班）';
+COMMENT ON COLUMN hrm_holiday_calendar_days.kind IS '类型: holiday(放假), makeup_workday(调休上班)';
+
+-- =============================================================================
+-- 18. 考勤汇总待重算表 (Attendance Summary Dirty Queue)
+-- =============================================================================
+-- 已过去月份的底层数据变动后登记，由夜间任务增量重算
+CREATE TABLE IF NOT EXISTS hrm_attendance_summary_dirty (
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+    employee_id UUID NOT NULL,
+    year INTEGER NOT NULL,
+    month INTEGER NOT NULL,
+    marked_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,  -- 最近一次变动时间
+    PRIMARY KEY (tenant_id, employee_id, year, month)
+);
+
+CREATE INDEX IF NOT EXISTS idx_attendance_summary_dirty_marked ON hrm_attendance_summary_dirty(marked_at);
+
+COMMENT ON TABLE hrm_attendance_summary_dirty IS '考勤汇总待重算表（迟到的补卡、审批等变动）';
+
+-- 租户级考勤月份锁：锁定月份时写入，解锁时删除；没有汇总的员工同样受限
+CREATE TABLE IF NOT EXISTS hrm_attendance_period_locks (
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+    year INTEGER NOT NULL,
+    month INTEGER NOT NULL,
+    locked_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tenant_id, year, month)
+);
+
+-- 已锁定的历史月份补写月份锁
+INSERT INTO hrm_attendance_period_locks (tenant_id, year, month)
+SELECT DISTINCT tenant_id, year, month FROM hrm_attendance_summaries WHERE status = 'locked'
+ON CONFLICT DO NOTHING;
+
+COMMENT ON TABLE hrm_attendance_period_locks IS '考勤月份锁定表（租户级）';
+
+-- =============================================================================
+-- 19. 轮班模板表 (Shift Rotation Templates)
+-- =============================================================================
+-- 按周期循环的排班模板，用于批量生成部门月度排班
+CREATE TABLE IF NOT EXISTS hrm_shift_rotation_templates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+
+    code VARCHAR(50) NOT NULL,        -- 模板编码
+    name VARCHAR(100) NOT NULL,       -- 模板名称
+    description TEXT,
+
+    slots JSONB NOT NULL,             -- 周期内每天的班次 [{"shift_id": "..."}]，shift_id 为空表示休息
+    skip_holidays BOOLEAN DEFAULT FALSE,  -- 节假日按休息处理
+    constraints JSONB,                -- 默认约束（最短休息、最多连续上班、最低在岗人数）
+
+    is_active BOOLEAN DEFAULT TRUE,
+
+    -- 审计字段
+    created_by UUID,
+    updated_by UUID,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_shift_rotation_templates_code ON hrm_shift_rotation_templates(tenant_id, code) WHERE deleted_at IS NULL;
+
+COMMENT ON TABLE hrm_shift_rotation_templates IS '轮班模板表';
+COMMENT ON COLUMN hrm_shift_rotation_templates.slots IS '轮班周期，如做四休二为 4 个班次 + 2 个空班次';
+
+-- =============================================================================
+-- 20. 换班申请表 (Shift Swap Requests)
+-- =============================================================================
+-- 员工间互换或代班，对方确认后进入主管审批，审批通过时交换排班
+CREATE TABLE IF NOT EXISTS hrm_shift_swap_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+    swap_type VARCHAR(20) NOT NULL,   -- swap=互换, cover=代班
+
+    -- 申请人排班快照
+    requester_id UUID NOT NULL,
+    requester_name VARCHAR(100) NOT NULL,
+    requester_schedule_id UUID NOT NULL REFERENCES hrm_schedules(id),
+    requester_date DATE NOT NULL,
+    requester_shift_id UUID NOT NULL,
+    requester_shift_name VARCHAR(100) NOT NULL,
+
+    -- 对方排班快照（代班时为空）
+    target_employee_id UUID NOT NULL,
+    target_employee_name VARCHAR(100) NOT NULL,
+    target_schedule_id UUID REFERENCES hrm_schedules(id),
+    target_date DATE,
+    target_shift_id UUID,
+    target_shift_name VARCHAR(100),
+
+    reason TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending_peer',
+
+    peer_responded_at TIMESTAMP,
+    peer_comment TEXT,
+
+    workflow_execution_id VARCHAR(100),
+    approver_id UUID,
+    approved_at TIMESTAMP,
+    approval_comment TEXT,
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_shift_swap_requests_tenant ON hrm_shift_swap_requests(tenant_id, status);
+CREATE INDEX IF NOT EXISTS idx_shift_swap_requests_requester ON hrm_shift_swap_requests(requester_id);
+CREATE INDEX IF NOT EXISTS idx_shift_swap_requests_target ON hrm_shift_swap_requests(target_employee_id);
+CREATE INDEX IF NOT EXISTS idx_shift_swap_requests_requester_schedule ON hrm_shift_swap_requests(requester_schedule_id);
+CREATE INDEX IF NOT EXISTS idx_shift_swap_requests_target_schedule ON hrm_shift_swap_requests(target_schedule_id);
+
+COMMENT ON TABLE hrm_shift_swap_requests IS '换班/代班申请表';
+COMMENT ON COLUMN hrm_shift_swap_requests.status IS 'pending_peer=待对方确认, peer_rejected=对方拒绝, pending_approval=待审批, approved=已批准, rejected=已驳回, cancelled=已撤销';
+
+-- =============================================================================
+-- 21. 加班政策表 (Overtime Policies)
+-- =============================================================================
+-- 加班倍率、调休折算、计量取整与上限，考勤规则通过 overtime_policy_id 关联
+CREATE TABLE IF NOT EXISTS hrm_overtime_policies (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+
+    code VARCHAR(50) NOT NULL,        -- 政策编码
+    name VARCHAR(100) NOT NULL,       -- 政策名称
+    description TEXT,
+
+    rules JSONB NOT NULL DEFAULT '[]',  -- 各加班类型规则 [{"type": "weekend", "pay_rate": 2, "comp_off_rate": 2, "compensation": "either"}]
+
+    -- 计量
+    hours_per_day DECIMAL(4,2) NOT NULL DEFAULT 8,  -- 调休折算 1 天的小时数
+    min_duration_minutes INTEGER DEFAULT 0,         -- 单次不足该时长不计加班
+    unit_minutes INTEGER DEFAULT 0,                 -- 最小计量单位（分钟），0 表示按实际时长
+    rounding VARCHAR(20) DEFAULT 'down',            -- down, up, nearest
+
+    -- 上限（0 表示不限）
+    monthly_cap_hours DECIMAL(6,2) DEFAULT 0,
+    yearly_cap_hours DECIMAL(7,2) DEFAULT 0,
+    block_over_cap BOOLEAN DEFAULT FALSE,           -- 超出上限时拒绝（否则仅提示）
+
+    -- 自动识别
+    auto_detect BOOLEAN DEFAULT FALSE,              -- 下班打卡晚于班次结束时自动生成加班
+    auto_require_approval BOOLEAN DEFAULT TRUE,     -- 自动识别的加班是否需要审批
+
+    is_default BOOLEAN DEFAULT FALSE,               -- 租户默认政策
+    is_active BOOLEAN DEFAULT TRUE,
+
+    -- 审计字段
+    created_by UUID,
+    updated_by UUID,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_overtime_policies_code ON hrm_overtime_policies(tenant_id, code) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_overtime_policies_default ON hrm_overtime_policies(tenant_id) WHERE is_default = TRUE AND deleted_at IS NULL;
+
+COMMENT ON TABLE hrm_overtime_policies IS '加班政策表';
+COMMENT ON COLUMN hrm_overtime_policies.rules IS '未配置的加班类型按法定标准：工作日1.5、休息日2、法定节假日3';
+
+-- =============================================================================
+-- 22. 考勤异常表 (Attendance Anomalies)
+-- =============================================================================
+-- 夜间分析打卡记录识别的疑似作弊或数据问题，HR 确认或忽略
+CREATE TABLE IF NOT EXISTS hrm_attendance_anomalies (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+
+    anomaly_type VARCHAR(30) NOT NULL,   -- impossible_travel, buddy_punching, device_clock_drift, repeated_coordinates
+    severity VARCHAR(20) NOT NULL,       -- low, medium, high
+    status VARCHAR(20) NOT NULL DEFAULT 'open',  -- open, acknowledged, dismissed
+
+    employee_id UUID,                    -- 设备类异常为空
+    employee_name VARCHAR(100),
+    device_sn VARCHAR(100),
+
+    record_ids UUID[] NOT NULL,          -- 涉及的打卡记录
+    occurred_at TIMESTAMP NOT NULL,      -- 首条记录的打卡时间
+    description TEXT NOT NULL,
+    details JSONB,                       -- 距离、速度、偏差等分析数据
+    fingerprint VARCHAR(64) NOT NULL,    -- 去重键
+
+    -- HR 处理
+    handled_by UUID,
+    handled_at TIMESTAMP,
+    handle_comment TEXT,
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_attendance_anomalies_fingerprint ON hrm_attendance_anomalies(tenant_id, fingerprint);
+CREATE INDEX IF NOT EXISTS idx_attendance_anomalies_status ON hrm_attendance_anomalies(tenant_id, status, occurred_at DESC);
+CREATE INDEX IF NOT EXISTS idx_attendance_anomalies_employee ON hrm_attendance_anomalies(employee_id, occurred_at DESC);
+
+COMMENT ON TABLE hrm_attendance_anomalies IS '考勤异常表（异地打卡、代打卡、设备时钟偏差、重复坐标）';
+COMMENT ON COLUMN hrm_attendance_anomalies.fingerprint IS '类型 + 涉及记录/设备/员工的摘要，重复分析同一时段不重复生成';
+
+-- =============================================================================
+-- 23. 设备命令队列表 (Device Commands)
+-- =============================================================================
+-- 推送协议（ZKTeco ADMS）的设备在心跳时拉取待执行命令并回执结果
+CREATE TABLE IF NOT EXISTS hrm_device_commands (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    seq BIGSERIAL NOT NULL,              -- 协议命令编号（C:<seq>:<content>）
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+    device_id UUID NOT NULL,
+    device_sn VARCHAR(100) NOT NULL,
+    employee_id UUID,                    -- 命令涉及的员工（生物特征下发、删除）
+
+    command_type VARCHAR(30) NOT NULL,   -- user_upload, user_delete, clear_log, reboot, set_time, template_upload, enroll
+    content TEXT NOT NULL,               -- 协议命令文本
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',  -- pending, sent, succeeded, failed
+    return_code INTEGER,                 -- 设备回执，0 为成功
+
+    sent_at TIMESTAMP,
+    completed_at TIMESTAMP,
+
+    created_by UUID,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_device_commands_seq ON hrm_device_commands(seq);
+CREATE INDEX IF NOT EXISTS idx_device_commands_pending ON hrm_device_commands(device_id, status, seq);
+CREATE INDEX IF NOT EXISTS idx_device_commands_device ON hrm_device_commands(device_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_device_commands_tenant_status ON hrm_device_commands(tenant_id, status);
+CREATE INDEX IF NOT EXISTS idx_device_commands_employee ON hrm_device_commands(employee_id, device_id, created_at) WHERE employee_id IS NOT NULL;
+
+COMMENT ON TABLE hrm_device_commands IS '设备命令队列表（下发用户、删除用户、下发模板、远程采集、清空记录、重启、校时）';
+COMMENT ON COLUMN hrm_device_commands.seq IS '协议命令编号，设备通过 /iclock/devicecmd 回执时携带';
+
+-- =============================================================================
+-- 24. 租户数据密钥表 (Tenant Data Keys)
+-- =============================================================================
+-- 敏感字段（身份证号、生物特征、设备凭据）信封加密使用的租户数据密钥，以配置中的主密钥包装后存储
+CREATE TABLE IF NOT EXISTS hrm_data_keys (
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+    version INTEGER NOT NULL,            -- 数据密钥版本，密文中携带
+    master_key_id VARCHAR(50) NOT NULL,  -- 包装所用主密钥ID
+    wrapped_key BYTEA NOT NULL,          -- AES-GCM 包装后的数据密钥
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tenant_id, version)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_hrm_data_keys_active ON hrm_data_keys(tenant_id) WHERE is_active;
+
+COMMENT ON TABLE hrm_data_keys IS '租户数据密钥表（信封加密）';
+COMMENT ON COLUMN hrm_data_keys.master_key_id IS '主密钥轮换后由密钥轮换命令重新包装';
+
+-- =============================================================================
+-- 25. 出差报销明细表 (Trip Expenses)
+-- =============================================================================
+-- 票据通过文件模块 file_relations 关联（entity_type = 'hrm_trip_expense'）
+CREATE TABLE IF NOT EXISTS hrm_trip_expenses (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+    trip_id UUID NOT NULL REFERENCES hrm_business_trips(id),
+
+    category VARCHAR(20) NOT NULL,       -- transport, hotel, meal, per_diem, other
+    amount DECIMAL(12,2) NOT NULL,
+    currency VARCHAR(3) NOT NULL DEFAULT 'CNY',
+    expense_date DATE NOT NULL,
+    nights INTEGER NOT NULL DEFAULT 0,   -- 住宿晚数
+    description TEXT,
+
+    -- 标准校验结果
+    policy_cap DECIMAL(12,2),
+    over_policy BOOLEAN NOT NULL DEFAULT FALSE,
+    over_policy_amount DECIMAL(12,2) NOT NULL DEFAULT 0,
+    over_policy_note TEXT,
+
+    created_by UUID,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_trip_expenses_trip ON hrm_trip_expenses(trip_id, expense_date);
+
+COMMENT ON TABLE hrm_trip_expenses IS '出差报销明细表';
+COMMENT ON COLUMN hrm_trip_expenses.policy_cap IS '适用限额：餐饮/补助为当日限额，住宿为每晚限额×晚数';
+
+-- =============================================================================
+-- 26. 出差报销单表 (Trip Expense Claims)
+-- =============================================================================
+-- 每次出差一张报销单，提交后经审批模块审批，通过后出差报告按明细汇总实际费用
+CREATE TABLE IF NOT EXISTS hrm_trip_expense_claims (
+    trip_id UUID PRIMARY KEY REFERENCES hrm_business_trips(id),
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+    employee_id UUID NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'draft',  -- draft, pending, approved, rejected
+
+    currency VARCHAR(3) NOT NULL DEFAULT 'CNY',
+    total_amount DECIMAL(12,2) NOT NULL DEFAULT 0,
+    over_policy_amount DECIMAL(12,2) NOT NULL DEFAULT 0,
+    over_policy_count INTEGER NOT NULL DEFAULT 0,
+
+    policy_id UUID,
+    destination_tier VARCHAR(50),
+
+    approval_instance_id UUID,           -- 审批模块流程实例
+    submitted_by UUID,
+    submitted_at TIMESTAMP,
+    decided_at TIMESTAMP,
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_trip_expense_claims_status ON hrm_trip_expense_claims(tenant_id, status);
+CREATE INDEX IF NOT EXISTS idx_trip_expense_claims_approval ON hrm_trip_expense_claims(approval_instance_id) WHERE approval_instance_id IS NOT NULL;
+
+COMMENT ON TABLE hrm_trip_expense_claims IS '出差报销单表';
+
+-- =============================================================================
+-- 27. 差旅标准表 (Trip Expense Policies)
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS hrm_trip_expense_policies (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+    name VARCHAR(100) NOT NULL,
+    destination_tier VARCHAR(50) NOT NULL,
+
+    min_position_level INTEGER NOT NULL DEFAULT 0,  -- 0 表示不限
+    max_position_level INTEGER NOT NULL DEFAULT 0,  -- 0 表示不限
+
+    per_diem_cap DECIMAL(12,2) NOT NULL DEFAULT 0,        -- 每日餐饮+补助上限，0 表示不限
+    hotel_cap_per_night DECIMAL(12,2) NOT NULL DEFAULT 0, -- 每晚住宿上限，0 表示不限
+    currency VARCHAR(3) NOT NULL DEFAULT 'CNY',
+
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by UUID,
+    updated_by UUID,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_trip_expense_policies_tier ON hrm_trip_expense_policies(tenant_id, destination_tier) WHERE deleted_at IS NULL;
+
+COMMENT ON TABLE hrm_trip_expense_policies IS '差旅标准表（按目的地级别、职级设置补助与住宿上限）';
+
+-- 目的地级别：出差目的地包含关键字即归入对应级别，未匹配时使用 default
+CREATE TABLE IF NOT EXISTS hrm_trip_destination_tiers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+    destination VARCHAR(100) NOT NULL,
+    tier VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_trip_destination_tiers ON hrm_trip_destination_tiers(tenant_id, destination);
+
+COMMENT ON TABLE hrm_trip_destination_tiers IS '差旅目的地级别表';
+
+-- =============================================================================
+-- 28. 员工生命周期申请表 (Employee Lifecycle Requests)
+-- =============================================================================
+-- 入职/转正/调岗/离职均先经审批模块审批，通过后在生效日由定时任务执行
+CREATE TABLE IF NOT EXISTS hrm_employee_lifecycle_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+    employee_id UUID,                    -- 入职申请执行后回填
+    employee_name VARCHAR(100) NOT NULL,
+    action VARCHAR(20) NOT NULL,         -- hire, confirm, transfer, resign, terminate
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',  -- pending, approved, completed, rejected, cancelled, failed
+    effective_date DATE NOT NULL,
+    reason TEXT,
+
+    hire_info JSONB,
+    transfer_info JSONB,
+    separation_info JSONB,
+
+    approval_instance_id UUID,           -- 审批模块流程实例
+    requested_by UUID NOT NULL,
+    decided_at TIMESTAMP,
+    completed_at TIMESTAMP,
+    failure_reason TEXT,
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_employee_lifecycle_requests_employee ON hrm_employee_lifecycle_requests(tenant_id, employee_id);
+CREATE INDEX IF NOT EXISTS idx_employee_lifecycle_requests_approval ON hrm_employee_lifecycle_requests(approval_instance_id) WHERE approval_instance_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_employee_lifecycle_requests_open ON hrm_employee_lifecycle_requests(effective_date)
+    WHERE status IN ('pending', 'approved', 'failed');
+
+COMMENT ON TABLE hrm_employee_lifecycle_requests IS '员工生命周期申请表';
+
+-- =============================================================================
+-- 29. 员工生命周期事件表 (Employee Lifecycle Events)
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS hrm_employee_lifecycle_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+    employee_id UUID,
+    request_id UUID REFERENCES hrm_employee_lifecycle_requests(id),
+    event_type VARCHAR(50) NOT NULL,     -- lifecycle.requested, employee.hired, employee.offboarded ...
+    action VARCHAR(20),
+    payload JSONB,
+    occurred_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_employee_lifecycle_events_employee ON hrm_employee_lifecycle_events(tenant_id, employee_id, occurred_at);
+
+COMMENT ON TABLE hrm_employee_lifecycle_events IS '员工生命周期领域事件表（只追加）';
+
+-- =============================================================================
+-- 30. 试用期提醒表 (Probation Reminders)
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS hrm_probation_reminders (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+    employee_id UUID NOT NULL,
+    probation_end DATE NOT NULL,
+    remind_on DATE NOT NULL,
+    days_before INTEGER NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',  -- pending, sent, cancelled
+    sent_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_probation_reminders_unique ON hrm_probation_reminders(employee_id, probation_end, days_before);
+CREATE INDEX IF NOT EXISTS idx_probation_reminders_due ON hrm_probation_reminders(remind_on) WHERE status = 'pending';
+
+COMMENT ON TABLE hrm_probation_reminders IS '试用期到期提醒表';
+
+-- =============================================================================
+-- 31. 报表导出任务表 (Report Exports)
+-- =============================================================================
+-- 月度考勤表、假期余额表、加班台账导出为 XLSX/CSV，生成的文件存入文件模块
+CREATE TABLE IF NOT EXISTS hrm_report_exports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+    report_type VARCHAR(30) NOT NULL,    -- attendance_monthly, leave_balance, overtime_ledger
+    format VARCHAR(10) NOT NULL,         -- xlsx, csv
+    locale VARCHAR(10) NOT NULL DEFAULT 'zh-CN',
+    columns JSONB,                       -- 选择的列，为空时导出默认列
+    department_id UUID,                  -- 为空时导出全公司
+    year INTEGER NOT NULL,
+    month INTEGER NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',  -- pending, running, completed, failed
+    row_count INTEGER NOT NULL DEFAULT 0,
+    file_id UUID,                        -- 文件模块中的结果文件
+    filename VARCHAR(255),
+    error TEXT,
+    requested_by UUID NOT NULL,
+    started_at TIMESTAMP,
+    finished_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_report_exports_tenant ON hrm_report_exports(tenant_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_report_exports_unfinished ON hrm_report_exports(updated_at)
+    WHERE status IN ('pending', 'running');
+
+COMMENT ON TABLE hrm_report_exports IS '报表导出任务表';
+
+-- =============================================================================
+-- 32. 薪资对接导出记录表 (Payroll Runs)
+-- =============================================================================
+-- 按已锁定的考勤汇总生成薪资输入，同一期可重复导出，employees 快照用于与下一次导出比对
+CREATE TABLE IF NOT EXISTS hrm_payroll_runs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+    year INTEGER NOT NULL,
+    month INTEGER NOT NULL,
+    run_no INTEGER NOT NULL,             -- 同一期的导出序号，从 1 开始
+    schema_version VARCHAR(20) NOT NULL,
+    exporter VARCHAR(50) NOT NULL,       -- 导出格式：json, csv 或服务商格式
+    employee_count INTEGER NOT NULL DEFAULT 0,
+    checksum VARCHAR(64) NOT NULL,       -- 员工明细的 SHA-256
+    file_id UUID,                        -- 文件模块中的导出文件
+    filename VARCHAR(255),
+    previous_run_id UUID REFERENCES hrm_payroll_runs(id),
+    diff JSONB,                          -- 与上一次导出的差异
+    employees JSONB NOT NULL,            -- 员工明细快照
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE (tenant_id, year, month, run_no)
+);
+
+CREATE INDEX IF NOT EXISTS idx_payroll_runs_tenant ON hrm_payroll_runs(tenant_id, created_at DESC);
+
+COMMENT ON TABLE hrm_payroll_runs IS '薪资对接导出记录表';
+
+-- =============================================================================
+-- 33. 打卡地点表 (Clock Locations)
+-- =============================================================================
+-- 多边形/圆形地理围栏 + 允许打卡时段；考勤屏凭密钥拉取轮换的签名二维码，员工扫码打卡
+CREATE TABLE IF NOT EXISTS hrm_clock_locations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+    code VARCHAR(50) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    address VARCHAR(255),
+    polygon JSONB,                       -- 多边形顶点 [{latitude, longitude}]，至少 3 个顶点
+    latitude DOUBLE PRECISION NOT NULL DEFAULT 0,   -- 未设置多边形时的圆心
+    longitude DOUBLE PRECISION NOT NULL DEFAULT 0,
+    radius INTEGER NOT NULL DEFAULT 0,   -- 半径（米），0 表示不限制
+    time_windows JSONB,                  -- 允许打卡时段 [{weekdays, start, end}]
+    qr_required BOOLEAN NOT NULL DEFAULT FALSE,  -- 是否必须扫码打卡
+    kiosk_key_hash VARCHAR(64),          -- 考勤屏密钥 SHA-256
+    kiosk_key_rotated_at TIMESTAMP,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by UUID,
+    updated_by UUID,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_clock_locations_code ON hrm_clock_locations(tenant_id, code) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_clock_locations_kiosk_key ON hrm_clock_locations(kiosk_key_hash) WHERE kiosk_key_hash IS NOT NULL;
+
+COMMENT ON TABLE hrm_clock_locations IS '打卡地点表';
+
+-- 二维码使用记录：同一员工在同一地点的同一二维码周期内只能扫码打卡一次
+CREATE TABLE IF NOT EXISTS hrm_clock_qr_scans (
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+    employee_id UUID NOT NULL,
+    location_id UUID NOT NULL REFERENCES hrm_clock_locations(id),
+    issued_at TIMESTAMP NOT NULL,        -- 二维码周期起点
+    scanned_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (employee_id, location_id, issued_at)
+);
+
+COMMENT ON TABLE hrm_clock_qr_scans IS '打卡二维码使用记录表';
+
+-- =============================================================================
+-- 34. 被拒打卡记录表 (Clock Attempts)
+-- =============================================================================
+-- 围栏、时段、二维码、WiFi、人脸校验未通过的打卡，供 HR 复核
+CREATE TABLE IF NOT EXISTS hrm_clock_attempts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+    employee_id UUID NOT NULL,
+    employee_name VARCHAR(100),
+    clock_time TIMESTAMP NOT NULL,
+    clock_type VARCHAR(20) NOT NULL,
+    check_in_method VARCHAR(20) NOT NULL,
+    source_type VARCHAR(20) NOT NULL,
+    location_id UUID REFERENCES hrm_clock_locations(id),
+    location JSONB,                      -- 客户端上报的定位
+    address VARCHAR(255),
+    wifi_ssid VARCHAR(100),
+    wifi_mac VARCHAR(50),
+    face_score DECIMAL(5,4) NOT NULL DEFAULT 0,
+    reason VARCHAR(30) NOT NULL,         -- outside_geofence, outside_time_window, qr_expired ...
+    detail TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',  -- pending, accepted, dismissed
+    reviewed_by UUID,
+    reviewed_at TIMESTAMP,
+    review_note TEXT,
+    record_id UUID,                      -- 认定有效后补记的考勤记录
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_clock_attempts_tenant ON hrm_clock_attempts(tenant_id, clock_time DESC);
+CREATE INDEX IF NOT EXISTS idx_clock_attempts_pending ON hrm_clock_attempts(tenant_id, status) WHERE status = 'pending';
+
+COMMENT ON TABLE hrm_clock_attempts IS '被拒打卡记录表';
+
+-- =============================================================================
+-- 35. 补卡申请表 (Punch Card Supplements)
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS hrm_punch_card_supplements (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+    employee_id UUID NOT NULL,
+    employee_name VARCHAR(100),
+    department_id UUID,
+    supplement_date DATE NOT NULL,
+    supplement_type VARCHAR(20) NOT NULL,  -- checkin, checkout
+    supplement_time TIMESTAMP NOT NULL,
+    missing_type VARCHAR(20) NOT NULL,     -- forgot, malfunction, outside, other
+    reason TEXT NOT NULL,
+    evidence JSONB,
+    attendance_record_id UUID,             -- 处理后生成或更正的考勤记录
+    approval_id UUID,
+    approval_status VARCHAR(20) NOT NULL DEFAULT 'pending',  -- pending, approved, rejected
+    approved_by UUID,
+    approved_at TIMESTAMP,
+    reject_reason TEXT,
+    process_status VARCHAR(20) NOT NULL DEFAULT 'pending',   -- pending, processed, cancelled
+    processed_at TIMESTAMP,
+    processed_by UUID,
+    remark TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_punch_card_supplements_employee ON hrm_punch_card_supplements(tenant_id, employee_id, supplement_date) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_punch_card_supplements_pending ON hrm_punch_card_supplements(tenant_id, approval_status) WHERE approval_status = 'pending' AND deleted_at IS NULL;
+
+COMMENT ON TABLE hrm_punch_card_supplements IS '补卡申请表';
+
+-- =============================================================================
+-- 36. 补卡政策表 (Punch Card Supplement Policies)
+-- =============================================================================
+-- 每个租户一份：每月次数上限、提交期限、允许的缺卡类型
+CREATE TABLE IF NOT EXISTS hrm_punch_card_supplement_policies (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL UNIQUE REFERENCES tenants(id),
+    max_per_month INTEGER NOT NULL DEFAULT 0,          -- 0 表示不限
+    filing_deadline_days INTEGER NOT NULL DEFAULT 30,  -- 缺卡日期后 N 天内提交，0 表示不限
+    allowed_missing_types JSONB,                       -- 允许的缺卡类型，为空表示不限
+    updated_by UUID,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE hrm_punch_card_supplement_policies IS '补卡政策表';
+
+-- =============================================================================
+-- 37. 外出记录表 (Leave Offices)
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS hrm_leave_offices (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+    employee_id UUID NOT NULL,
+    employee_name VARCHAR(100),
+    department_id UUID,
+    start_time TIMESTAMP NOT NULL,
+    end_time TIMESTAMP NOT NULL,
+    duration DECIMAL(10,2) NOT NULL DEFAULT 0,  -- 小时
+    destination VARCHAR(200),
+    purpose TEXT,
+    contact VARCHAR(100),
+    approval_id UUID,
+    approval_status VARCHAR(20) NOT NULL DEFAULT 'pending',  -- pending, approved, rejected
+    approved_by UUID,
+    approved_at TIMESTAMP,
+    reject_reason TEXT,
+    remark TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_leave_offices_employee ON hrm_leave_offices(tenant_id, employee_id, start_time) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_leave_offices_status ON hrm_leave_offices(tenant_id, approval_status) WHERE deleted_at IS NULL;
+
+COMMENT ON TABLE hrm_leave_offices IS '外出记录表';
+
+-- =============================================================================
+-- 38. 员工时间占用索引表 (Time Allocations)
+-- =============================================================================
+-- 请假、出差、加班、外出申请在审批中或已批准期间各占一行，用于跨类型冲突检测和员工日历
+CREATE TABLE IF NOT EXISTS hrm_time_allocations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+    employee_id UUID NOT NULL,
+    employee_name VARCHAR(100),
+    kind VARCHAR(20) NOT NULL,      -- leave, trip, overtime, outing
+    source_id UUID NOT NULL,        -- 对应申请ID
+    title VARCHAR(200),
+    start_time TIMESTAMP NOT NULL,
+    end_time TIMESTAMP NOT NULL,
+    status VARCHAR(20) NOT NULL,    -- pending, approved
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (kind, source_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_time_allocations_employee ON hrm_time_allocations(tenant_id, employee_id, start_time, end_time);
+
+COMMENT ON TABLE hrm_time_allocations IS '员工时间占用索引表';
+
+-- 回填已有的审批中、已批准申请
+INSERT INTO hrm_time_allocations (tenant_id, employee_id, employee_name, kind, source_id, title, start_time, end_time, status)
+SELECT tenant_id, employee_id, employee_name, 'leave', id, leave_type_name, start_time, end_time, status
+FROM hrm_leave_requests
+WHERE status IN ('pending', 'approved') AND deleted_at IS NULL
+ON CONFLICT (kind, source_id) DO NOTHING;
+
+INSERT INTO hrm_time_allocations (tenant_id, employee_id, employee_name, kind, source_id, title, start_time, end_time, status)
+SELECT tenant_id, employee_id, employee_name, 'trip', id, destination, start_time, end_time, approval_status
+FROM hrm_business_trips
+WHERE approval_status IN ('pending', 'approved') AND deleted_at IS NULL
+ON CONFLICT (kind, source_id) DO NOTHING;
+
+INSERT INTO hrm_time_allocations (tenant_id, employee_id, employee_name, kind, source_id, title, start_time, end_time, status)
+SELECT tenant_id, employee_id, employee_name, 'overtime', id, overtime_type, start_time, end_time, approval_status
+FROM hrm_overtimes
+WHERE approval_status IN ('pending', 'approved') AND deleted_at IS NULL
+ON CONFLICT (kind, source_id) DO NOTHING;
+
+INSERT INTO hrm_time_allocations (tenant_id, employee_id, employee_name, kind, source_id, title, start_time, end_time, status)
+SELECT tenant_id, employee_id, employee_name, 'outing', id, destination, start_time, end_time, approval_status
+FROM hrm_leave_offices
+WHERE approval_status IN ('pending', 'approved') AND deleted_at IS NULL
+ON CONFLICT (kind, source_id) DO NOTHING;
+
+-- =============================================================================
+-- 39. 生物特征模板表 (Biometric Templates)
+-- =============================================================================
+-- 模板按设备类型和算法版本分别保存，同一组合重新采集时新增修订，下发时取最新修订
+CREATE TABLE IF NOT EXISTS hrm_biometric_templates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+    employee_id UUID NOT NULL,
+    biometric_type VARCHAR(20) NOT NULL,     -- fingerprint, face
+    biometric_index INTEGER NOT NULL DEFAULT 0,  -- 手指编号或人脸模板序号
+    device_type VARCHAR(50) NOT NULL,        -- 采集设备类型
+    algorithm_version VARCHAR(20) NOT NULL,  -- 算法主版本
+    revision INTEGER NOT NULL DEFAULT 1,
+    template TEXT NOT NULL,                  -- 模板数据（加密）
+    source_device_id UUID,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (employee_id, biometric_type, biometric_index, device_type, algorithm_version, revision)
+);
+
+CREATE INDEX IF NOT EXISTS idx_biometric_templates_tenant ON hrm_biometric_templates(tenant_id, employee_id);
+
+COMMENT ON TABLE hrm_biometric_templates IS '生物特征模板表';
+
+-- =============================================================================
+-- 40. 生物特征下发记录表 (Biometric Distributions)
+-- =============================================================================
+-- 每个员工、设备保存最近一次下发或删除操作，状态由该操作之后的设备命令执行结果计算
+CREATE TABLE IF NOT EXISTS hrm_biometric_distributions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+    employee_id UUID NOT NULL,
+    device_id UUID NOT NULL,
+    action VARCHAR(20) NOT NULL,          -- push, remove
+    templates INTEGER NOT NULL DEFAULT 0, -- 下发的模板数
+    queued_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (employee_id, device_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_biometric_distributions_device ON hrm_biometric_distributions(tenant_id, device_id);
+
+COMMENT ON TABLE hrm_biometric_distributions IS '生物特征下发记录表';
+
+-- =============================================================================
+-- 41. 生物特征采集会话表 (Biometric Enrollments)
+-- =============================================================================
+-- 设备上传的模板只有匹配未过期的待采集会话时才保存，每个会话只接收一次
+CREATE TABLE IF NOT EXISTS hrm_biometric_enrollments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id),
+    employee_id UUID NOT NULL,
+    device_id UUID NOT NULL,
+    biometric_type VARCHAR(20) NOT NULL,         -- fingerprint, face
+    biometric_index INTEGER NOT NULL DEFAULT 0,  -- 手指编号，人脸忽略
+    status VARCHAR(20) NOT NULL DEFAULT 'pending', -- pending, captured
+    expires_at TIMESTAMP NOT NULL,
+    captured_at TIMESTAMP,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_biometric_enrollments_pending ON hrm_biometric_enrollments(device_id, employee_id, expires_at)
+    WHERE status = 'pending';
+
+COMMENT ON TABLE hrm_biometric_enrollments IS '生物特征采集会话表';
+
 -- =============================================================================
 -- 创建视图和函数
 -- =============================================================================
@@ -418,6 +1250,60 @@ CREATE TRIGGER update_hrm_attendance_devices_updated_at BEFORE UPDATE ON hrm_att
 CREATE TRIGGER update_hrm_third_party_integrations_updated_at BEFORE UPDATE ON hrm_third_party_integrations
     FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
 
+CREATE TRIGGER update_hrm_holiday_calendars_updated_at BEFORE UPDATE ON hrm_holiday_calendars
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_hrm_shift_rotation_templates_updated_at BEFORE UPDATE ON hrm_shift_rotation_templates
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_hrm_shift_swap_requests_updated_at BEFORE UPDATE ON hrm_shift_swap_requests
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_hrm_overtime_policies_updated_at BEFORE UPDATE ON hrm_overtime_policies
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_hrm_attendance_anomalies_updated_at BEFORE UPDATE ON hrm_attendance_anomalies
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_hrm_device_commands_updated_at BEFORE UPDATE ON hrm_device_commands
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_hrm_trip_expenses_updated_at BEFORE UPDATE ON hrm_trip_expenses
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_hrm_trip_expense_claims_updated_at BEFORE UPDATE ON hrm_trip_expense_claims
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_hrm_trip_expense_policies_updated_at BEFORE UPDATE ON hrm_trip_expense_policies
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_hrm_employee_lifecycle_requests_updated_at BEFORE UPDATE ON hrm_employee_lifecycle_requests
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_hrm_report_exports_updated_at BEFORE UPDATE ON hrm_report_exports
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_hrm_clock_locations_updated_at BEFORE UPDATE ON hrm_clock_locations
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_hrm_clock_attempts_updated_at BEFORE UPDATE ON hrm_clock_attempts
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_hrm_punch_card_supplements_updated_at BEFORE UPDATE ON hrm_punch_card_supplements
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_hrm_punch_card_supplement_policies_updated_at BEFORE UPDATE ON hrm_punch_card_supplement_policies
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_hrm_leave_offices_updated_at BEFORE UPDATE ON hrm_leave_offices
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_hrm_time_allocations_updated_at BEFORE UPDATE ON hrm_time_allocations
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_hrm_biometric_distributions_updated_at BEFORE UPDATE ON hrm_biometric_distributions
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
 -- =============================================================================
 -- 迁移完成
 -- =============================================================================
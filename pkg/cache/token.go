@@ -0,0 +1,176 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// DefaultTokenRefreshSkew 令牌到期前提前刷新的时间
+const DefaultTokenRefreshSkew = 5 * time.Minute
+
+// ErrTokenNotFound 令牌不存在或已过期
+var ErrTokenNotFound = errors.New("token not found")
+
+// TokenStore 访问令牌存储
+type TokenStore interface {
+	Get(ctx context.Context, key string) (string, error)
+	Set(ctx context.Context, key, token string, ttl time.Duration) error
+	Delete(ctx context.Context, key string) error
+}
+
+// TokenFetcher 向签发方申请新令牌，返回令牌及有效期
+type TokenFetcher func(ctx context.Context) (token string, expiresIn time.Duration, err error)
+
+// TokenCache 第三方平台访问令牌缓存
+// 令牌在到期前 skew 时间内视为失效并重新申请；同一 key 的并发刷新只会请求一次签发方
+type TokenCache struct {
+	store TokenStore
+	skew  time.Duration
+
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// NewTokenCache 创建令牌缓存
+func NewTokenCache(store TokenStore) *TokenCache {
+	return &TokenCache{
+		store: store,
+		skew:  DefaultTokenRefreshSkew,
+		locks: make(map[string]*sync.Mutex),
+	}
+}
+
+// Get 获取令牌，缓存中没有时调用 fetch 申请并写入缓存
+func (c *TokenCache) Get(ctx context.Context, key string, fetch TokenFetcher) (string, error) {
+	if token, err := c.store.Get(ctx, key); err == nil {
+		return token, nil
+	} else if !errors.Is(err, ErrTokenNotFound) {
+		return "", err
+	}
+
+	lock := c.lockFor(key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	// 等锁期间可能已被其他请求刷新
+	if token, err := c.store.Get(ctx, key); err == nil {
+		return token, nil
+	}
+
+	token, expiresIn, err := fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if ttl := c.ttl(expiresIn); ttl > 0 {
+		if err := c.store.Set(ctx, key, token, ttl); err != nil {
+			return "", err
+		}
+	}
+	return token, nil
+}
+
+// Invalidate 删除缓存的令牌（签发方提示令牌失效时调用）
+func (c *TokenCache) Invalidate(ctx context.Context, key string) error {
+	return c.store.Delete(ctx, key)
+}
+
+// ttl 缓存时长：有效期短于两倍 skew 时只缓存一半有效期
+func (c *TokenCache) ttl(expiresIn time.Duration) time.Duration {
+	if expiresIn > 2*c.skew {
+		return expiresIn - c.skew
+	}
+	return expiresIn / 2
+}
+
+func (c *TokenCache) lockFor(key string) *sync.Mutex {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	lock, ok := c.locks[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		c.locks[key] = lock
+	}
+	return lock
+}
+
+// ============ 存储实现 ============
+
+// memoryTokenStore 进程内令牌存储（单实例部署或测试使用）
+type memoryTokenStore struct {
+	mu     sync.RWMutex
+	tokens map[string]memoryToken
+	now    func() time.Time
+}
+
+type memoryToken struct {
+	value     string
+	expiresAt time.Time
+}
+
+// NewMemoryTokenStore 创建进程内令牌存储
+func NewMemoryTokenStore() TokenStore {
+	return &memoryTokenStore{
+		tokens: make(map[string]memoryToken),
+		now:    time.Now,
+	}
+}
+
+func (s *memoryTokenStore) Get(ctx context.Context, key string) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	token, ok := s.tokens[key]
+	if !ok || !s.now().Before(token.expiresAt) {
+		return "", ErrTokenNotFound
+	}
+	return token.value, nil
+}
+
+func (s *memoryTokenStore) Set(ctx context.Context, key, token string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tokens[key] = memoryToken{value: token, expiresAt: s.now().Add(ttl)}
+	return nil
+}
+
+func (s *memoryTokenStore) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.tokens, key)
+	return nil
+}
+
+// redisTokenStore Redis 令牌存储，多实例共享同一令牌，避免各实例互相刷新导致旧令牌失效
+type redisTokenStore struct {
+	redis *Redis
+}
+
+// NewRedisTokenStore 创建 Redis 令牌存储
+func NewRedisTokenStore(r *Redis) TokenStore {
+	return &redisTokenStore{redis: r}
+}
+
+func (s *redisTokenStore) Get(ctx context.Context, key string) (string, error) {
+	// 令牌刚写入主节点时从节点可能尚未同步，固定读主节点
+	token, err := s.redis.Master().Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", ErrTokenNotFound
+	}
+	return token, err
+}
+
+func (s *redisTokenStore) Set(ctx context.Context, key, token string, ttl time.Duration) error {
+	return s.redis.SetRaw(ctx, key, token, ttl).Err()
+}
+
+func (s *redisTokenStore) Delete(ctx context.Context, key string) error {
+	return s.redis.Delete(ctx, key)
+}
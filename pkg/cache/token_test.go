@@ -0,0 +1,93 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestTokenCache 测试令牌缓存
+func TestTokenCache(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Fetch once and reuse", func(t *testing.T) {
+		c := NewTokenCache(NewMemoryTokenStore())
+		var calls int32
+		fetch := func(ctx context.Context) (string, time.Duration, error) {
+			atomic.AddInt32(&calls, 1)
+			return "token-1", 2 * time.Hour, nil
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				token, err := c.Get(ctx, "dingtalk:app", fetch)
+				if err != nil || token != "token-1" {
+					t.Errorf("Get() = %q, %v", token, err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if calls != 1 {
+			t.Errorf("Expected 1 fetch, got %d", calls)
+		}
+	})
+
+	t.Run("Invalidate forces refresh", func(t *testing.T) {
+		c := NewTokenCache(NewMemoryTokenStore())
+		var calls int
+		fetch := func(ctx context.Context) (string, time.Duration, error) {
+			calls++
+			return "token", time.Hour, nil
+		}
+
+		_, _ = c.Get(ctx, "key", fetch)
+		if err := c.Invalidate(ctx, "key"); err != nil {
+			t.Fatalf("Invalidate() error = %v", err)
+		}
+		_, _ = c.Get(ctx, "key", fetch)
+
+		if calls != 2 {
+			t.Errorf("Expected 2 fetches, got %d", calls)
+		}
+	})
+
+	t.Run("Expired token is refreshed", func(t *testing.T) {
+		store := NewMemoryTokenStore().(*memoryTokenStore)
+		now := time.Now()
+		store.now = func() time.Time { return now }
+		c := NewTokenCache(store)
+
+		var calls int
+		fetch := func(ctx context.Context) (string, time.Duration, error) {
+			calls++
+			return "token", 2 * time.Hour, nil
+		}
+
+		_, _ = c.Get(ctx, "key", fetch)
+		now = now.Add(2*time.Hour - DefaultTokenRefreshSkew + time.Second)
+		_, _ = c.Get(ctx, "key", fetch)
+
+		if calls != 2 {
+			t.Errorf("Expected refresh before expiry, got %d fetches", calls)
+		}
+	})
+
+	t.Run("Fetch error is returned", func(t *testing.T) {
+		c := NewTokenCache(NewMemoryTokenStore())
+		errFetch := errors.New("invalid credential")
+
+		_, err := c.Get(ctx, "key", func(ctx context.Context) (string, time.Duration, error) {
+			return "", 0, errFetch
+		})
+		if !errors.Is(err, errFetch) {
+			t.Errorf("Expected fetch error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,448 @@
+// Package fieldcrypt 字段级信封加密
+//
+// 每个租户持有若干版本的数据密钥（DEK），数据密钥由配置中的主密钥（KEK）以 AES-GCM 包装后存库；
+// 字段值用租户当前数据密钥加密，密文携带数据密钥版本，轮换后旧密文仍可解密。
+// 密文格式：enc:v1:<数据密钥版本>:<base64(nonce|密文|tag)>，附加数据为租户ID，密文不能跨租户挪用。
+//
+// 盲索引（blind index）用独立的索引密钥按租户派生 HMAC，用于等值查重，不随数据密钥轮换变化。
+package fieldcrypt
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ciphertextPrefix 密文前缀，不带前缀的值视为历史明文
+const ciphertextPrefix = "enc:v1:"
+
+// dataKeySize 数据密钥长度（AES-256）
+const dataKeySize = 32
+
+// activeKeyTTL 当前数据密钥版本的缓存时间，轮换命令执行后各实例在此时间内切换到新版本
+const activeKeyTTL = time.Minute
+
+var (
+	ErrNotConfigured      = errors.New("fieldcrypt: master key not configured")
+	ErrMasterKeyNotFound  = errors.New("fieldcrypt: master key not found")
+	ErrDataKeyNotFound    = errors.New("fieldcrypt: data key not found")
+	ErrInvalidCiphertext  = errors.New("fieldcrypt: invalid ciphertext")
+	ErrInvalidKeyMaterial = errors.New("fieldcrypt: invalid key material")
+	ErrDecryptionFailed   = errors.New("fieldcrypt: decryption failed")
+)
+
+// Config 加密配置，密钥均为 base64 编码的 32 字节
+type Config struct {
+	// MasterKeys 主密钥（ID -> 密钥），保留旧主密钥以解包尚未重新包装的数据密钥
+	MasterKeys map[string]string
+	// ActiveMasterKey 新数据密钥使用的主密钥ID
+	ActiveMasterKey string
+	// BlindIndexKey 盲索引密钥，更换后需重建所有盲索引
+	BlindIndexKey string
+}
+
+// DataKey 租户数据密钥（包装后）
+type DataKey struct {
+	TenantID    uuid.UUID
+	Version     int
+	MasterKeyID string
+	WrappedKey  []byte
+	Active      bool
+	CreatedAt   time.Time
+}
+
+// KeyStore 数据密钥存储
+type KeyStore interface {
+	// FindActive 租户当前数据密钥，不存在返回 ErrDataKeyNotFound
+	FindActive(ctx context.Context, tenantID uuid.UUID) (*DataKey, error)
+	// FindVersion 租户指定版本的数据密钥，不存在返回 ErrDataKeyNotFound
+	FindVersion(ctx context.Context, tenantID uuid.UUID, version int) (*DataKey, error)
+	// List 租户全部数据密钥
+	List(ctx context.Context, tenantID uuid.UUID) ([]*DataKey, error)
+	// Create 保存新版本数据密钥并设为当前版本（同一租户其余版本取消当前标记）
+	// 版本号冲突（并发创建）时返回错误，调用方重新读取当前版本
+	Create(ctx context.Context, key *DataKey) error
+	// UpdateWrapping 更新数据密钥的包装（主密钥轮换）
+	UpdateWrapping(ctx context.Context, tenantID uuid.UUID, version int, masterKeyID string, wrappedKey []byte) error
+}
+
+// Cipher 字段加解密
+type Cipher interface {
+	// Encrypt 用租户当前数据密钥加密，空串原样返回；租户尚无数据密钥时自动生成
+	Encrypt(ctx context.Context, tenantID uuid.UUID, plaintext string) (string, error)
+
+	// Decrypt 解密；不带密文前缀的历史明文原样返回
+	Decrypt(ctx context.Context, tenantID uuid.UUID, value string) (string, error)
+
+	// BlindIndex 盲索引（十六进制 HMAC-SHA256），空串返回空串；调用方负责归一化
+	BlindIndex(tenantID uuid.UUID, value string) string
+
+	// NeedsReencrypt 值是否为明文或非当前数据密钥加密
+	NeedsReencrypt(ctx context.Context, tenantID uuid.UUID, value string) (bool, error)
+
+	// RotateDataKey 为租户生成新版本数据密钥并设为当前版本
+	RotateDataKey(ctx context.Context, tenantID uuid.UUID) (*DataKey, error)
+
+	// RewrapDataKeys 用当前主密钥重新包装租户的全部数据密钥，返回重新包装的数量
+	RewrapDataKeys(ctx context.Context, tenantID uuid.UUID) (int, error)
+}
+
+type activeVersion struct {
+	version  int
+	loadedAt time.Time
+}
+
+type envelopeCipher struct {
+	store        KeyStore
+	masterKeys   map[string][]byte
+	activeMaster string
+	indexKey     []byte
+	now          func() time.Time
+
+	mu      sync.RWMutex
+	keys    map[string][]byte // tenantID:version -> 解包后的数据密钥
+	actives map[uuid.UUID]activeVersion
+	create  sync.Mutex
+}
+
+// NewCipher 创建信封加密器
+func NewCipher(cfg *Config, store KeyStore) (Cipher, error) {
+	if cfg == nil || len(cfg.MasterKeys) == 0 || cfg.ActiveMasterKey == "" || cfg.BlindIndexKey == "" {
+		return nil, ErrNotConfigured
+	}
+
+	masterKeys := make(map[string][]byte, len(cfg.MasterKeys))
+	for id, encoded := range cfg.MasterKeys {
+		key, err := decodeKey(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("master key %s: %w", id, err)
+		}
+		masterKeys[id] = key
+	}
+	if _, ok := masterKeys[cfg.ActiveMasterKey]; !ok {
+		return nil, ErrMasterKeyNotFound
+	}
+
+	indexKey, err := decodeKey(cfg.BlindIndexKey)
+	if err != nil {
+		return nil, fmt.Errorf("blind index key: %w", err)
+	}
+
+	return &envelopeCipher{
+		store:        store,
+		masterKeys:   masterKeys,
+		activeMaster: cfg.ActiveMasterKey,
+		indexKey:     indexKey,
+		now:          time.Now,
+		keys:         make(map[string][]byte),
+		actives:      make(map[uuid.UUID]activeVersion),
+	}, nil
+}
+
+func decodeKey(encoded string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil || len(key) != dataKeySize {
+		return nil, ErrInvalidKeyMaterial
+	}
+	return key, nil
+}
+
+// IsEncrypted 值是否为本包生成的密文
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, ciphertextPrefix)
+}
+
+func (c *envelopeCipher) Encrypt(ctx context.Context, tenantID uuid.UUID, plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+
+	version, key, err := c.activeKey(ctx, tenantID)
+	if err != nil {
+		return "", err
+	}
+	sealed, err := seal(key, []byte(plaintext), tenantID[:])
+	if err != nil {
+		return "", err
+	}
+	return ciphertextPrefix + strconv.Itoa(version) + ":" + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+func (c *envelopeCipher) Decrypt(ctx context.Context, tenantID uuid.UUID, value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	version, payload, err := parseCiphertext(value)
+	if err != nil {
+		return "", err
+	}
+	key, err := c.dataKey(ctx, tenantID, version)
+	if err != nil {
+		return "", err
+	}
+	plain, err := open(key, payload, tenantID[:])
+	if err != nil {
+		return "", err
+	}
+	return string(plain), nil
+}
+
+func (c *envelopeCipher) BlindIndex(tenantID uuid.UUID, value string) string {
+	if value == "" {
+		return ""
+	}
+
+	// 按租户派生索引密钥，不同租户的相同值索引不同
+	derive := hmac.New(sha256.New, c.indexKey)
+	derive.Write(tenantID[:])
+	mac := hmac.New(sha256.New, derive.Sum(nil))
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (c *envelopeCipher) NeedsReencrypt(ctx context.Context, tenantID uuid.UUID, value string) (bool, error) {
+	if value == "" {
+		return false, nil
+	}
+	if !IsEncrypted(value) {
+		return true, nil
+	}
+
+	version, _, err := parseCiphertext(value)
+	if err != nil {
+		return false, err
+	}
+	active, _, err := c.activeKey(ctx, tenantID)
+	if err != nil {
+		return false, err
+	}
+	return version != active, nil
+}
+
+func (c *envelopeCipher) RotateDataKey(ctx context.Context, tenantID uuid.UUID) (*DataKey, error) {
+	c.create.Lock()
+	defer c.create.Unlock()
+
+	next := 1
+	current, err := c.store.FindActive(ctx, tenantID)
+	switch {
+	case err == nil:
+		next = current.Version + 1
+	case !errors.Is(err, ErrDataKeyNotFound):
+		return nil, err
+	}
+
+	key, plain, err := c.newDataKey(tenantID, next)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.store.Create(ctx, key); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.keys[cacheKey(tenantID, key.Version)] = plain
+	c.actives[tenantID] = activeVersion{version: key.Version, loadedAt: c.now()}
+	c.mu.Unlock()
+	return key, nil
+}
+
+func (c *envelopeCipher) RewrapDataKeys(ctx context.Context, tenantID uuid.UUID) (int, error) {
+	keys, err := c.store.List(ctx, tenantID)
+	if err != nil {
+		return 0, err
+	}
+
+	rewrapped := 0
+	for _, key := range keys {
+		if key.MasterKeyID == c.activeMaster {
+			continue
+		}
+		plain, err := c.unwrap(key)
+		if err != nil {
+			return rewrapped, err
+		}
+		wrapped, err := seal(c.masterKeys[c.activeMaster], plain, wrapAAD(key.TenantID, key.Version))
+		if err != nil {
+			return rewrapped, err
+		}
+		if err := c.store.UpdateWrapping(ctx, key.TenantID, key.Version, c.activeMaster, wrapped); err != nil {
+			return rewrapped, err
+		}
+		rewrapped++
+	}
+	return rewrapped, nil
+}
+
+// activeKey 租户当前数据密钥，不存在时生成第一个版本
+func (c *envelopeCipher) activeKey(ctx context.Context, tenantID uuid.UUID) (int, []byte, error) {
+	c.mu.RLock()
+	active, ok := c.actives[tenantID]
+	c.mu.RUnlock()
+	if ok && c.now().Sub(active.loadedAt) < activeKeyTTL {
+		key, err := c.dataKey(ctx, tenantID, active.version)
+		return active.version, key, err
+	}
+
+	stored, err := c.store.FindActive(ctx, tenantID)
+	if errors.Is(err, ErrDataKeyNotFound) {
+		stored, err = c.RotateDataKey(ctx, tenantID)
+		if err != nil {
+			// 并发实例已先创建
+			stored, err = c.store.FindActive(ctx, tenantID)
+		}
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+
+	key, err := c.unwrapCached(stored)
+	if err != nil {
+		return 0, nil, err
+	}
+	c.mu.Lock()
+	c.actives[tenantID] = activeVersion{version: stored.Version, loadedAt: c.now()}
+	c.mu.Unlock()
+	return stored.Version, key, nil
+}
+
+// dataKey 解包后的指定版本数据密钥（进程内缓存）
+func (c *envelopeCipher) dataKey(ctx context.Context, tenantID uuid.UUID, version int) ([]byte, error) {
+	c.mu.RLock()
+	key, ok := c.keys[cacheKey(tenantID, version)]
+	c.mu.RUnlock()
+	if ok {
+		return key, nil
+	}
+
+	stored, err := c.store.FindVersion(ctx, tenantID, version)
+	if err != nil {
+		return nil, err
+	}
+	return c.unwrapCached(stored)
+}
+
+func (c *envelopeCipher) unwrapCached(stored *DataKey) ([]byte, error) {
+	c.mu.RLock()
+	key, ok := c.keys[cacheKey(stored.TenantID, stored.Version)]
+	c.mu.RUnlock()
+	if ok {
+		return key, nil
+	}
+
+	key, err := c.unwrap(stored)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.keys[cacheKey(stored.TenantID, stored.Version)] = key
+	c.mu.Unlock()
+	return key, nil
+}
+
+func (c *envelopeCipher) unwrap(stored *DataKey) ([]byte, error) {
+	master, ok := c.masterKeys[stored.MasterKeyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMasterKeyNotFound, stored.MasterKeyID)
+	}
+	return open(master, stored.WrappedKey, wrapAAD(stored.TenantID, stored.Version))
+}
+
+func (c *envelopeCipher) newDataKey(tenantID uuid.UUID, version int) (*DataKey, []byte, error) {
+	plain := make([]byte, dataKeySize)
+	if _, err := rand.Read(plain); err != nil {
+		return nil, nil, err
+	}
+	wrapped, err := seal(c.masterKeys[c.activeMaster], plain, wrapAAD(tenantID, version))
+	if err != nil {
+		return nil, nil, err
+	}
+	return &DataKey{
+		TenantID:    tenantID,
+		Version:     version,
+		MasterKeyID: c.activeMaster,
+		WrappedKey:  wrapped,
+		Active:      true,
+		CreatedAt:   c.now(),
+	}, plain, nil
+}
+
+func cacheKey(tenantID uuid.UUID, version int) string {
+	return tenantID.String() + ":" + strconv.Itoa(version)
+}
+
+// wrapAAD 数据密钥包装的附加数据：租户ID + 版本，防止包装后的密钥在租户/版本间替换
+func wrapAAD(tenantID uuid.UUID, version int) []byte {
+	aad := make([]byte, 0, len(tenantID)+8)
+	aad = append(aad, tenantID[:]...)
+	return binary.BigEndian.AppendUint64(aad, uint64(version))
+}
+
+func parseCiphertext(value string) (int, []byte, error) {
+	rest := strings.TrimPrefix(value, ciphertextPrefix)
+	versionPart, encoded, ok := strings.Cut(rest, ":")
+	if !ok {
+		return 0, nil, ErrInvalidCiphertext
+	}
+	version, err := strconv.Atoi(versionPart)
+	if err != nil || version < 1 {
+		return 0, nil, ErrInvalidCiphertext
+	}
+	payload, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil {
+		return 0, nil, ErrInvalidCiphertext
+	}
+	return version, payload, nil
+}
+
+// seal AES-GCM 加密，输出 nonce|密文|tag
+func seal(key, plaintext, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+func open(key, sealed, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize()+gcm.Overhead() {
+		return nil, ErrInvalidCiphertext
+	}
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	plain, err := gcm.Open(nil, nonce, ciphertext, aad)
+	if err != nil {
+		return nil, ErrDecryptionFailed
+	}
+	return plain, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
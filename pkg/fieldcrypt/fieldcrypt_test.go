@@ -0,0 +1,173 @@
+package fieldcrypt
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func testKey(b byte) string {
+	return base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{b}, dataKeySize))
+}
+
+func newTestCipher(t *testing.T, store KeyStore, active string) Cipher {
+	t.Helper()
+	c, err := NewCipher(&Config{
+		MasterKeys:      map[string]string{"k1": testKey(1), "k2": testKey(2)},
+		ActiveMasterKey: active,
+		BlindIndexKey:   testKey(9),
+	}, store)
+	if err != nil {
+		t.Fatalf("NewCipher() error = %v", err)
+	}
+	return c
+}
+
+// TestCipher 测试字段加解密
+func TestCipher(t *testing.T) {
+	ctx := context.Background()
+	tenantID := uuid.New()
+
+	t.Run("Round trip", func(t *testing.T) {
+		c := newTestCipher(t, NewMemoryKeyStore(), "k1")
+
+		encrypted, err := c.Encrypt(ctx, tenantID, "110101199003078888")
+		if err != nil {
+			t.Fatalf("Encrypt() error = %v", err)
+		}
+		if !strings.HasPrefix(encrypted, "enc:v1:1:") {
+			t.Errorf("Expected version 1 ciphertext, got %q", encrypted)
+		}
+
+		plain, err := c.Decrypt(ctx, tenantID, encrypted)
+		if err != nil || plain != "110101199003078888" {
+			t.Errorf("Decrypt() = %q, %v", plain, err)
+		}
+	})
+
+	t.Run("Empty and legacy plaintext", func(t *testing.T) {
+		c := newTestCipher(t, NewMemoryKeyStore(), "k1")
+
+		if encrypted, _ := c.Encrypt(ctx, tenantID, ""); encrypted != "" {
+			t.Errorf("Expected empty ciphertext, got %q", encrypted)
+		}
+		if plain, _ := c.Decrypt(ctx, tenantID, "legacy"); plain != "legacy" {
+			t.Errorf("Expected legacy plaintext passthrough, got %q", plain)
+		}
+	})
+
+	t.Run("Ciphertext bound to tenant", func(t *testing.T) {
+		store := NewMemoryKeyStore()
+		c := newTestCipher(t, store, "k1")
+
+		encrypted, _ := c.Encrypt(ctx, tenantID, "secret")
+		other := uuid.New()
+		if _, err := c.Encrypt(ctx, other, "x"); err != nil {
+			t.Fatalf("Encrypt() error = %v", err)
+		}
+		if _, err := c.Decrypt(ctx, other, encrypted); !errors.Is(err, ErrDecryptionFailed) {
+			t.Errorf("Expected ErrDecryptionFailed, got %v", err)
+		}
+	})
+
+	t.Run("Rotate data key", func(t *testing.T) {
+		store := NewMemoryKeyStore()
+		c := newTestCipher(t, store, "k1")
+
+		old, _ := c.Encrypt(ctx, tenantID, "secret")
+		if _, err := c.RotateDataKey(ctx, tenantID); err != nil {
+			t.Fatalf("RotateDataKey() error = %v", err)
+		}
+
+		stale, err := c.NeedsReencrypt(ctx, tenantID, old)
+		if err != nil || !stale {
+			t.Errorf("NeedsReencrypt(old) = %v, %v", stale, err)
+		}
+		fresh, _ := c.Encrypt(ctx, tenantID, "secret")
+		if !strings.HasPrefix(fresh, "enc:v1:2:") {
+			t.Errorf("Expected version 2 ciphertext, got %q", fresh)
+		}
+		if stale, _ := c.NeedsReencrypt(ctx, tenantID, fresh); stale {
+			t.Error("Fresh ciphertext should not need re-encryption")
+		}
+
+		// 新实例（无缓存）仍能解密旧版本密文
+		plain, err := newTestCipher(t, store, "k1").Decrypt(ctx, tenantID, old)
+		if err != nil || plain != "secret" {
+			t.Errorf("Decrypt(old) = %q, %v", plain, err)
+		}
+	})
+
+	t.Run("Rewrap under new master key", func(t *testing.T) {
+		store := NewMemoryKeyStore()
+		encrypted, _ := newTestCipher(t, store, "k1").Encrypt(ctx, tenantID, "secret")
+
+		c2 := newTestCipher(t, store, "k2")
+		n, err := c2.RewrapDataKeys(ctx, tenantID)
+		if err != nil || n != 1 {
+			t.Fatalf("RewrapDataKeys() = %d, %v", n, err)
+		}
+
+		// 旧主密钥下线后仍可解密
+		c3, err := NewCipher(&Config{
+			MasterKeys:      map[string]string{"k2": testKey(2)},
+			ActiveMasterKey: "k2",
+			BlindIndexKey:   testKey(9),
+		}, store)
+		if err != nil {
+			t.Fatalf("NewCipher() error = %v", err)
+		}
+		plain, err := c3.Decrypt(ctx, tenantID, encrypted)
+		if err != nil || plain != "secret" {
+			t.Errorf("Decrypt() after rewrap = %q, %v", plain, err)
+		}
+	})
+
+	t.Run("Blind index", func(t *testing.T) {
+		c := newTestCipher(t, NewMemoryKeyStore(), "k1")
+
+		a := c.BlindIndex(tenantID, "110101199003078888")
+		if a == "" || a != c.BlindIndex(tenantID, "110101199003078888") {
+			t.Error("Blind index should be deterministic")
+		}
+		if a == c.BlindIndex(uuid.New(), "110101199003078888") {
+			t.Error("Blind index should differ across tenants")
+		}
+		if c.BlindIndex(tenantID, "") != "" {
+			t.Error("Blind index of empty value should be empty")
+		}
+	})
+
+	t.Run("Invalid config", func(t *testing.T) {
+		if _, err := NewCipher(&Config{}, NewMemoryKeyStore()); !errors.Is(err, ErrNotConfigured) {
+			t.Errorf("Expected ErrNotConfigured, got %v", err)
+		}
+		_, err := NewCipher(&Config{MasterKeys: map[string]string{"k1": "short"}, ActiveMasterKey: "k1", BlindIndexKey: testKey(9)}, NewMemoryKeyStore())
+		if !errors.Is(err, ErrInvalidKeyMaterial) {
+			t.Errorf("Expected ErrInvalidKeyMaterial, got %v", err)
+		}
+	})
+}
+
+// TestMask 测试脱敏
+func TestMask(t *testing.T) {
+	tests := []struct {
+		value          string
+		prefix, suffix int
+		want           string
+	}{
+		{"110101199003078888", 3, 4, "110***********8888"},
+		{"abc", 2, 2, "***"},
+		{"", 1, 1, ""},
+	}
+	for _, tt := range tests {
+		if got := Mask(tt.value, tt.prefix, tt.suffix); got != tt.want {
+			t.Errorf("Mask(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package fieldcrypt
+
+// Mask 脱敏：保留前 keepPrefix 位和后 keepSuffix 位，其余替换为 *；过短时全部替换
+func Mask(value string, keepPrefix, keepSuffix int) string {
+	runes := []rune(value)
+	if len(runes) == 0 {
+		return ""
+	}
+	if len(runes) <= keepPrefix+keepSuffix {
+		keepPrefix, keepSuffix = 0, 0
+	}
+
+	masked := make([]rune, len(runes))
+	for i, r := range runes {
+		if i < keepPrefix || i >= len(runes)-keepSuffix {
+			masked[i] = r
+		} else {
+			masked[i] = '*'
+		}
+	}
+	return string(masked)
+}
@@ -0,0 +1,91 @@
+package fieldcrypt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+type memoryKeyStore struct {
+	mu   sync.Mutex
+	keys map[uuid.UUID][]*DataKey
+}
+
+// NewMemoryKeyStore 创建进程内数据密钥存储（单元测试用）
+func NewMemoryKeyStore() KeyStore {
+	return &memoryKeyStore{keys: make(map[uuid.UUID][]*DataKey)}
+}
+
+func (s *memoryKeyStore) FindActive(ctx context.Context, tenantID uuid.UUID) (*DataKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, key := range s.keys[tenantID] {
+		if key.Active {
+			copied := *key
+			return &copied, nil
+		}
+	}
+	return nil, ErrDataKeyNotFound
+}
+
+func (s *memoryKeyStore) FindVersion(ctx context.Context, tenantID uuid.UUID, version int) (*DataKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, key := range s.keys[tenantID] {
+		if key.Version == version {
+			copied := *key
+			return &copied, nil
+		}
+	}
+	return nil, ErrDataKeyNotFound
+}
+
+func (s *memoryKeyStore) List(ctx context.Context, tenantID uuid.UUID) ([]*DataKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]*DataKey, 0, len(s.keys[tenantID]))
+	for _, key := range s.keys[tenantID] {
+		copied := *key
+		keys = append(keys, &copied)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Version < keys[j].Version })
+	return keys, nil
+}
+
+func (s *memoryKeyStore) Create(ctx context.Context, key *DataKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, existing := range s.keys[key.TenantID] {
+		if existing.Version == key.Version {
+			return fmt.Errorf("data key version %d already exists", key.Version)
+		}
+	}
+	for _, existing := range s.keys[key.TenantID] {
+		existing.Active = false
+	}
+	copied := *key
+	copied.Active = true
+	s.keys[key.TenantID] = append(s.keys[key.TenantID], &copied)
+	return nil
+}
+
+func (s *memoryKeyStore) UpdateWrapping(ctx context.Context, tenantID uuid.UUID, version int, masterKeyID string, wrappedKey []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, key := range s.keys[tenantID] {
+		if key.Version == version {
+			key.MasterKeyID = masterKeyID
+			key.WrappedKey = wrappedKey
+			return nil
+		}
+	}
+	return ErrDataKeyNotFound
+}
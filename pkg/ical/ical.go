@@ -0,0 +1,287 @@
+// Package ical 提供 iCalendar（RFC 5545）日历文件的极简读写，
+// 仅覆盖全天事件（VEVENT + DATE 值），用于节假日日历的导入导出。
+package ical
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	dateLayout     = "20060102"
+	dateTimeLayout = "20060102T150405"
+	maxLineOctets  = 75
+)
+
+var (
+	ErrNoCalendar   = errors.New("ical: missing VCALENDAR")
+	ErrInvalidDate  = errors.New("ical: invalid date value")
+	ErrUnclosedComp = errors.New("ical: unclosed component")
+)
+
+// Calendar 日历
+type Calendar struct {
+	ProdID string
+	Name   string // X-WR-CALNAME
+	Events []*Event
+}
+
+// Event 全天事件，End 为不包含的结束日期（与 RFC 5545 DTEND 语义一致）
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	Start       time.Time
+	End         time.Time
+	Props       map[string]string // 其他属性（如 X- 扩展属性），键为大写属性名
+}
+
+// Days 事件覆盖的每一天
+func (e *Event) Days() []time.Time {
+	end := e.End
+	if !end.After(e.Start) {
+		end = e.Start.AddDate(0, 0, 1)
+	}
+
+	var days []time.Time
+	for d := e.Start; d.Before(end); d = d.AddDate(0, 0, 1) {
+		days = append(days, d)
+	}
+	return days
+}
+
+// Parse 解析 iCalendar 内容，日期统一为 UTC 零点
+func Parse(r io.Reader) (*Calendar, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var cal *Calendar
+	var event *Event
+	for i, line := range lines {
+		name, value, ok := splitLine(line)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VCALENDAR"):
+			cal = &Calendar{}
+		case name == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			if cal == nil {
+				return nil, ErrNoCalendar
+			}
+			event = &Event{Props: make(map[string]string)}
+		case name == "END" && strings.EqualFold(value, "VEVENT"):
+			if event == nil {
+				continue
+			}
+			if event.Start.IsZero() {
+				return nil, fmt.Errorf("ical: line %d: event without DTSTART", i+1)
+			}
+			if event.End.IsZero() {
+				event.End = event.Start.AddDate(0, 0, 1)
+			}
+			cal.Events = append(cal.Events, event)
+			event = nil
+		case event != nil:
+			if err := event.set(name, value); err != nil {
+				return nil, fmt.Errorf("ical: line %d: %w", i+1, err)
+			}
+		case cal != nil:
+			switch name {
+			case "PRODID":
+				cal.ProdID = value
+			case "X-WR-CALNAME":
+				cal.Name = unescape(value)
+			}
+		}
+	}
+
+	if cal == nil {
+		return nil, ErrNoCalendar
+	}
+	if event != nil {
+		return nil, ErrUnclosedComp
+	}
+	return cal, nil
+}
+
+func (e *Event) set(name, value string) error {
+	switch name {
+	case "UID":
+		e.UID = value
+	case "SUMMARY":
+		e.Summary = unescape(value)
+	case "DESCRIPTION":
+		e.Description = unescape(value)
+	case "DTSTART":
+		d, err := parseDate(value)
+		if err != nil {
+			return err
+		}
+		e.Start = d
+	case "DTEND":
+		d, err := parseDate(value)
+		if err != nil {
+			return err
+		}
+		e.End = d
+	case "DTSTAMP", "TRANSP", "SEQUENCE", "CREATED", "LAST-MODIFIED":
+		// 输出时重新生成
+	default:
+		e.Props[name] = unescape(value)
+	}
+	return nil
+}
+
+// Encode 输出 iCalendar 内容（CRLF 换行，按 75 字节折行）
+func Encode(w io.Writer, cal *Calendar) error {
+	var buf bytes.Buffer
+	write := func(line string) {
+		buf.WriteString(fold(line))
+	}
+
+	prodID := cal.ProdID
+	if prodID == "" {
+		prodID = "-//go-next-erp//holiday calendar//CN"
+	}
+
+	write("BEGIN:VCALENDAR")
+	write("VERSION:2.0")
+	write("PRODID:" + prodID)
+	write("CALSCALE:GREGORIAN")
+	if cal.Name != "" {
+		write("X-WR-CALNAME:" + escape(cal.Name))
+	}
+
+	for _, e := range cal.Events {
+		end := e.End
+		if !end.After(e.Start) {
+			end = e.Start.AddDate(0, 0, 1)
+		}
+
+		write("BEGIN:VEVENT")
+		if e.UID != "" {
+			write("UID:" + e.UID)
+		}
+		write("DTSTAMP:" + e.Start.UTC().Format(dateTimeLayout) + "Z")
+		write("DTSTART;VALUE=DATE:" + e.Start.Format(dateLayout))
+		write("DTEND;VALUE=DATE:" + end.Format(dateLayout))
+		write("SUMMARY:" + escape(e.Summary))
+		if e.Description != "" {
+			write("DESCRIPTION:" + escape(e.Description))
+		}
+		write("TRANSP:TRANSPARENT")
+
+		keys := make([]string, 0, len(e.Props))
+		for k := range e.Props {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			write(k + ":" + escape(e.Props[k]))
+		}
+		write("END:VEVENT")
+	}
+
+	write("END:VCALENDAR")
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// unfold 读取并合并折行（续行以空格或制表符开头）
+func unfold(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(lines) == 0 {
+			line = strings.TrimPrefix(line, "\uFEFF")
+		}
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, scanner.Err()
+}
+
+// splitLine 拆分内容行 NAME;PARAM=VALUE:VALUE，返回属性名与值（参数对全天事件无意义，忽略）
+func splitLine(line string) (string, string, bool) {
+	colon := -1
+	inQuote := false
+	for i, c := range line {
+		if c == '"' {
+			inQuote = !inQuote
+		}
+		if c == ':' && !inQuote {
+			colon = i
+			break
+		}
+	}
+	if colon < 0 {
+		return "", "", false
+	}
+
+	name, _, _ := strings.Cut(line[:colon], ";")
+	return strings.ToUpper(name), line[colon+1:], true
+}
+
+// parseDate 解析 DATE 或 DATE-TIME 值，只保留日期部分
+func parseDate(value string) (time.Time, error) {
+	if len(value) < len(dateLayout) {
+		return time.Time{}, ErrInvalidDate
+	}
+	d, err := time.Parse(dateLayout, value[:len(dateLayout)])
+	if err != nil {
+		return time.Time{}, ErrInvalidDate
+	}
+	return d, nil
+}
+
+var (
+	textEscaper   = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`)
+	textUnescaper = strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n")
+)
+
+func escape(s string) string {
+	return textEscaper.Replace(s)
+}
+
+func unescape(s string) string {
+	return textUnescaper.Replace(s)
+}
+
+// fold 按 75 字节折行，不拆分多字节字符
+func fold(line string) string {
+	var b strings.Builder
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		limit = maxLineOctets - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+	return b.String()
+}
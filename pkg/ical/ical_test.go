@@ -0,0 +1,93 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestParse(t *testing.T) {
+	content := "BEGIN:VCALENDAR\r\n" +
+		"VERSION:2.0\r\n" +
+		"X-WR-CALNAME:中国节假日\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:spring-2025\r\n" +
+		"DTSTART;VALUE=DATE:20250128\r\n" +
+		"DTEND;VALUE=DATE:20250205\r\n" +
+		"SUMMARY:春节\\, 放假\r\n" +
+		"DESCRIPTION:long descr\r\n" +
+		" iption\r\n" +
+		"X-ERP-DAY-TYPE:holiday\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"DTSTART:20250126T000000Z\r\n" +
+		"SUMMARY:春节补班\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	cal, err := Parse(strings.NewReader(content))
+	require.NoError(t, err)
+	assert.Equal(t, "中国节假日", cal.Name)
+	require.Len(t, cal.Events, 2)
+
+	spring := cal.Events[0]
+	assert.Equal(t, "春节, 放假", spring.Summary)
+	assert.Equal(t, "long description", spring.Description)
+	assert.Equal(t, "holiday", spring.Props["X-ERP-DAY-TYPE"])
+	days := spring.Days()
+	require.Len(t, days, 8)
+	assert.Equal(t, date(2025, 1, 28), days[0])
+	assert.Equal(t, date(2025, 2, 4), days[7])
+
+	// 缺少 DTEND 视为单日事件，DATE-TIME 只取日期
+	makeup := cal.Events[1]
+	assert.Equal(t, []time.Time{date(2025, 1, 26)}, makeup.Days())
+
+	t.Run("missing calendar", func(t *testing.T) {
+		_, err := Parse(strings.NewReader("BEGIN:VEVENT\r\nEND:VEVENT\r\n"))
+		assert.ErrorIs(t, err, ErrNoCalendar)
+	})
+
+	t.Run("invalid date", func(t *testing.T) {
+		_, err := Parse(strings.NewReader("BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nDTSTART:2025-01\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"))
+		assert.ErrorIs(t, err, ErrInvalidDate)
+	})
+}
+
+func TestEncodeRoundTrip(t *testing.T) {
+	cal := &Calendar{
+		Name: "测试日历",
+		Events: []*Event{
+			{
+				UID:     "a",
+				Summary: strings.Repeat("国庆节", 20),
+				Start:   date(2025, 10, 1),
+				End:     date(2025, 10, 9),
+				Props:   map[string]string{"X-ERP-STATUTORY": "TRUE"},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, Encode(&buf, cal))
+
+	for _, line := range strings.Split(buf.String(), "\r\n") {
+		assert.LessOrEqual(t, len(line), maxLineOctets)
+	}
+
+	parsed, err := Parse(&buf)
+	require.NoError(t, err)
+	require.Len(t, parsed.Events, 1)
+	assert.Equal(t, cal.Events[0].Summary, parsed.Events[0].Summary)
+	assert.Equal(t, cal.Events[0].End, parsed.Events[0].End)
+	assert.Equal(t, "TRUE", parsed.Events[0].Props["X-ERP-STATUTORY"])
+	assert.Equal(t, "测试日历", parsed.Name)
+}
@@ -2,6 +2,9 @@ package pagination
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -40,6 +43,46 @@ type CursorInfo struct {
 	Direction string      `json:"direction"`  // next/prev
 }
 
+// ErrInvalidCursor 游标格式错误
+var ErrInvalidCursor = errors.New("invalid pagination cursor")
+
+// EncodeCursor 编码游标（JSON + URL安全的base64）
+func EncodeCursor(info *CursorInfo) (string, error) {
+	if info == nil {
+		return "", nil
+	}
+
+	data, err := json.Marshal(info)
+	if err != nil {
+		return "", fmt.Errorf("encode cursor: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// DecodeCursor 解码游标，空字符串返回 nil
+func DecodeCursor(cursor string) (*CursorInfo, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var info CursorInfo
+	if err := json.Unmarshal(data, &info); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	if info.Direction == "" {
+		info.Direction = "next"
+	}
+
+	return &info, nil
+}
+
 // Paginator 分页器
 type Paginator struct {
 	ctx context.Context
@@ -0,0 +1,42 @@
+package pagination
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursorEncoding(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		id := uuid.New()
+		cursor, err := EncodeCursor(&CursorInfo{LastID: id, LastValue: "2024-01-01T00:00:00Z"})
+		require.NoError(t, err)
+		assert.NotEmpty(t, cursor)
+
+		info, err := DecodeCursor(cursor)
+		require.NoError(t, err)
+		assert.Equal(t, id, info.LastID)
+		assert.Equal(t, "2024-01-01T00:00:00Z", info.LastValue)
+		assert.Equal(t, "next", info.Direction)
+	})
+
+	t.Run("empty cursor", func(t *testing.T) {
+		info, err := DecodeCursor("")
+		assert.NoError(t, err)
+		assert.Nil(t, info)
+
+		cursor, err := EncodeCursor(nil)
+		assert.NoError(t, err)
+		assert.Empty(t, cursor)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		_, err := DecodeCursor("not base64!")
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+
+		_, err = DecodeCursor("bm90LWpzb24")
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+}
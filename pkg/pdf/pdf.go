@@ -0,0 +1,204 @@
+// Package pdf 提供一个无外部依赖的极简 PDF 文本文档生成器。
+//
+// 中文使用 Adobe 标准 CJK 字体 STSong-Light（UniGB-UCS2-H 编码），
+// 由阅读器提供字形，无需嵌入字体文件，适用于审计报告、导出单据等纯文本排版场景。
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// A4 页面尺寸（单位：pt）
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+)
+
+// Document PDF 文档
+type Document struct {
+	margin   float64
+	pages    []*bytes.Buffer
+	cursorY  float64
+	title    string
+	fontSize float64
+}
+
+// New 创建文档
+func New(title string) *Document {
+	d := &Document{
+		margin:   50,
+		title:    title,
+		fontSize: 10,
+	}
+	d.AddPage()
+	return d
+}
+
+// AddPage 新增页面
+func (d *Document) AddPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+	d.cursorY = PageHeight - d.margin
+}
+
+// PageCount 页数
+func (d *Document) PageCount() int {
+	return len(d.pages)
+}
+
+// Heading 输出标题行
+func (d *Document) Heading(text string, size float64) {
+	d.Space(size * 0.4)
+	d.write(text, size, 0)
+	d.Space(size * 0.3)
+}
+
+// Text 输出正文（自动换行、自动分页）
+func (d *Document) Text(text string) {
+	d.TextIndent(text, 0)
+}
+
+// TextIndent 输出带缩进的正文
+func (d *Document) TextIndent(text string, indent float64) {
+	for _, para := range strings.Split(text, "\n") {
+		d.write(para, d.fontSize, indent)
+	}
+}
+
+// KeyValue 输出 "键：值" 行
+func (d *Document) KeyValue(key, value string) {
+	d.Text(key + "：" + value)
+}
+
+// Space 输出垂直空白
+func (d *Document) Space(h float64) {
+	d.cursorY -= h
+}
+
+// Line 输出水平分隔线
+func (d *Document) Line() {
+	d.ensure(6)
+	y := d.cursorY - 3
+	fmt.Fprintf(d.current(), "0.5 w %.2f %.2f m %.2f %.2f l S\n", d.margin, y, PageWidth-d.margin, y)
+	d.cursorY -= 6
+}
+
+func (d *Document) current() *bytes.Buffer {
+	return d.pages[len(d.pages)-1]
+}
+
+func (d *Document) ensure(h float64) {
+	if d.cursorY-h < d.margin {
+		d.AddPage()
+	}
+}
+
+func (d *Document) write(text string, size, indent float64) {
+	lineHeight := size * 1.5
+	maxWidth := PageWidth - 2*d.margin - indent
+
+	for _, line := range wrap(text, size, maxWidth) {
+		d.ensure(lineHeight)
+		d.cursorY -= lineHeight
+		fmt.Fprintf(d.current(), "BT /F1 %.1f Tf %.2f %.2f Td <%s> Tj ET\n",
+			size, d.margin+indent, d.cursorY, encodeUCS2(line))
+	}
+}
+
+// wrap 按字符宽度折行（ASCII 半角，其余全角）
+func wrap(text string, size, maxWidth float64) []string {
+	if text == "" {
+		return []string{""}
+	}
+
+	var lines []string
+	var current strings.Builder
+	width := 0.0
+	for _, r := range text {
+		w := runeWidth(r) * size
+		if width+w > maxWidth && current.Len() > 0 {
+			lines = append(lines, current.String())
+			current.Reset()
+			width = 0
+		}
+		current.WriteRune(r)
+		width += w
+	}
+	lines = append(lines, current.String())
+
+	return lines
+}
+
+func runeWidth(r rune) float64 {
+	if r < 0x80 {
+		return 0.5
+	}
+	return 1
+}
+
+// encodeUCS2 编码为 UCS-2 BE 十六进制串（超出 BMP 的字符替换为 '?'）
+func encodeUCS2(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r == utf8.RuneError || r > 0xFFFF || r < 0x20 {
+			r = '?'
+		}
+		fmt.Fprintf(&b, "%04X", r)
+	}
+	return b.String()
+}
+
+// WriteTo 输出 PDF 字节流
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	var buf bytes.Buffer
+	var offsets []int
+
+	obj := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// 对象编号：1 Catalog, 2 Pages, 3 Font, 4 CIDFont, 5 FontDescriptor, 6 Info，之后每页 Page + Contents
+	const firstPageObj = 7
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPageObj+i*2)
+	}
+
+	obj("<< /Type /Catalog /Pages 2 0 R >>")
+	obj(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	obj("<< /Type /Font /Subtype /Type0 /BaseFont /STSong-Light /Encoding /UniGB-UCS2-H /DescendantFonts [4 0 R] >>")
+	obj("<< /Type /Font /Subtype /CIDFontType0 /BaseFont /STSong-Light " +
+		"/CIDSystemInfo << /Registry (Adobe) /Ordering (GB1) /Supplement 2 >> " +
+		"/FontDescriptor 5 0 R /DW 1000 /W [1 95 500] >>")
+	obj("<< /Type /FontDescriptor /FontName /STSong-Light /Flags 6 /FontBBox [-25 -254 1000 880] " +
+		"/ItalicAngle 0 /Ascent 880 /Descent -120 /CapHeight 880 /StemV 93 >>")
+	obj(fmt.Sprintf("<< /Title <FEFF%s> /Producer (go-next-erp) >>", encodeUCS2(d.title)))
+
+	for i, page := range d.pages {
+		obj(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] "+
+			"/Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>", PageWidth, PageHeight, firstPageObj+i*2+1))
+		obj(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.Len(), page.String()))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R /Info 6 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return buf.WriteTo(w)
+}
+
+// Bytes 输出 PDF 字节
+func (d *Document) Bytes() []byte {
+	var buf bytes.Buffer
+	_, _ = d.WriteTo(&buf)
+	return buf.Bytes()
+}
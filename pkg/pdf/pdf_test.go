@@ -0,0 +1,63 @@
+package pdf
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocument(t *testing.T) {
+	t.Run("structure", func(t *testing.T) {
+		doc := New("审批档案")
+		doc.Heading("审批档案", 16)
+		doc.KeyValue("申请人", "张三")
+		doc.Line()
+		doc.Text("Hello 世界")
+
+		out := doc.Bytes()
+		require.True(t, bytes.HasPrefix(out, []byte("%PDF-1.4")))
+		assert.True(t, bytes.HasSuffix(out, []byte("%%EOF\n")))
+		assert.Contains(t, string(out), "/Count 1")
+		assert.Contains(t, string(out), "/STSong-Light")
+		// "世界" 的 UCS-2 编码
+		assert.Contains(t, string(out), "4E16754C")
+	})
+
+	t.Run("xref offsets point at objects", func(t *testing.T) {
+		out := string(New("x").Bytes())
+
+		m := regexp.MustCompile(`startxref\n(\d+)`).FindStringSubmatch(out)
+		require.Len(t, m, 2)
+		xref, _ := strconv.Atoi(m[1])
+		require.True(t, strings.HasPrefix(out[xref:], "xref"))
+
+		entries := regexp.MustCompile(`(\d{10}) 00000 n`).FindAllStringSubmatch(out[xref:], -1)
+		require.NotEmpty(t, entries)
+		for i, e := range entries {
+			off, _ := strconv.Atoi(e[1])
+			assert.True(t, strings.HasPrefix(out[off:], strconv.Itoa(i+1)+" 0 obj"), "object %d", i+1)
+		}
+	})
+
+	t.Run("auto page break and wrap", func(t *testing.T) {
+		doc := New("long")
+		for i := 0; i < 200; i++ {
+			doc.Text(strings.Repeat("审批记录", 40))
+		}
+		assert.Greater(t, doc.PageCount(), 1)
+		assert.Contains(t, string(doc.Bytes()), "/Count "+strconv.Itoa(doc.PageCount()))
+	})
+
+	t.Run("wrap respects width", func(t *testing.T) {
+		lines := wrap(strings.Repeat("a", 100), 10, 100)
+		for _, l := range lines {
+			assert.LessOrEqual(t, float64(len(l))*5, 100.0)
+		}
+		assert.Equal(t, []string{""}, wrap("", 10, 100))
+	})
+}
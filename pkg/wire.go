@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/google/wire"
 	"github.com/lk2023060901/go-next-erp/internal/conf"
 	"github.com/lk2023060901/go-next-erp/pkg/cache"
 	"github.com/lk2023060901/go-next-erp/pkg/database"
 	"github.com/lk2023060901/go-next-erp/pkg/logger"
+	"github.com/lk2023060901/go-next-erp/pkg/scheduler"
 	"github.com/lk2023060901/go-next-erp/pkg/storage"
 )
 
@@ -20,6 +22,7 @@ var ProviderSet = wire.NewSet(
 	ProvideCache,
 	ProvideStorage,
 	ProvideLogger,
+	ProvideScheduler,
 )
 
 // ProvideDatabase 提供数据库连接
@@ -130,3 +133,13 @@ func ProvideLogger() (*logger.Logger, func(), error) {
 
 	return log, cleanup, nil
 }
+
+// ProvideScheduler 提供定时任务调度器（由 server.JobServer 随应用启停）
+func ProvideScheduler(log *logger.Logger) *scheduler.Scheduler {
+	return scheduler.New(
+		scheduler.WithLogger(log),
+		scheduler.WithLocation(time.Local),
+		scheduler.WithPanicRecovery(true),
+		scheduler.WithMiddlewares(scheduler.LoggingMiddleware(log)),
+	)
+}
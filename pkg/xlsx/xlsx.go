@@ -0,0 +1,325 @@
+// Package xlsx 提供一个无外部依赖的极简 XLSX（Office Open XML 电子表格）生成器。
+//
+// 只支持写入：单元格使用内联字符串和数值，表头加粗并冻结首行，
+// 适用于考勤报表、台账等纯数据导出场景，不支持公式、合并单元格和图表。
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxSheetNameLength 工作表名称最大长度
+const MaxSheetNameLength = 31
+
+// 单元格样式索引（对应 styles.xml 中的 cellXfs）
+const (
+	styleDefault = 0
+	styleHeader  = 1
+)
+
+// Workbook 工作簿
+type Workbook struct {
+	sheets []*Sheet
+}
+
+// Sheet 工作表
+type Sheet struct {
+	name      string
+	rows      []row
+	widths    map[int]float64
+	hasHeader bool
+}
+
+type row struct {
+	cells []interface{}
+	style int
+}
+
+// New 创建工作簿
+func New() *Workbook {
+	return &Workbook{}
+}
+
+// AddSheet 新增工作表，名称中的非法字符替换为下划线，超长截断，重名时追加序号
+func (w *Workbook) AddSheet(name string) *Sheet {
+	name = sanitizeSheetName(name)
+	base := name
+	for i := 2; w.hasSheet(name); i++ {
+		suffix := fmt.Sprintf(" (%d)", i)
+		name = truncateRunes(base, MaxSheetNameLength-len(suffix)) + suffix
+	}
+
+	sheet := &Sheet{name: name, widths: make(map[int]float64)}
+	w.sheets = append(w.sheets, sheet)
+	return sheet
+}
+
+func (w *Workbook) hasSheet(name string) bool {
+	for _, s := range w.sheets {
+		if strings.EqualFold(s.name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Name 工作表名称
+func (s *Sheet) Name() string {
+	return s.name
+}
+
+// SetHeader 设置表头（加粗并冻结），须在写入数据行之前调用
+func (s *Sheet) SetHeader(titles ...string) {
+	cells := make([]interface{}, len(titles))
+	for i, title := range titles {
+		cells[i] = title
+	}
+	s.rows = append(s.rows, row{cells: cells, style: styleHeader})
+	s.hasHeader = len(s.rows) == 1
+}
+
+// AddRow 写入数据行，支持字符串、整数、浮点数、布尔、time.Time 和 nil（空单元格）
+func (s *Sheet) AddRow(cells ...interface{}) {
+	s.rows = append(s.rows, row{cells: cells})
+}
+
+// SetColumnWidth 设置列宽（字符数），col 从 0 开始
+func (s *Sheet) SetColumnWidth(col int, width float64) {
+	s.widths[col] = width
+}
+
+// RowCount 已写入的行数（含表头）
+func (s *Sheet) RowCount() int {
+	return len(s.rows)
+}
+
+// Bytes 生成 XLSX 文件内容
+func (w *Workbook) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := w.Write(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Write 将 XLSX 文件写入 out，没有工作表时自动添加一个空表
+func (w *Workbook) Write(out io.Writer) error {
+	if len(w.sheets) == 0 {
+		w.AddSheet("Sheet1")
+	}
+
+	zw := zip.NewWriter(out)
+	parts := []struct {
+		name    string
+		content func(io.Writer)
+	}{
+		{"[Content_Types].xml", w.writeContentTypes},
+		{"_rels/.rels", writeRootRels},
+		{"xl/workbook.xml", w.writeWorkbook},
+		{"xl/_rels/workbook.xml.rels", w.writeWorkbookRels},
+		{"xl/styles.xml", writeStyles},
+	}
+	for _, part := range parts {
+		f, err := zw.Create(part.name)
+		if err != nil {
+			return err
+		}
+		part.content(f)
+	}
+
+	for i, sheet := range w.sheets {
+		f, err := zw.Create(fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1))
+		if err != nil {
+			return err
+		}
+		sheet.write(f)
+	}
+
+	return zw.Close()
+}
+
+const xmlHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+
+func (w *Workbook) writeContentTypes(out io.Writer) {
+	io.WriteString(out, xmlHeader)
+	io.WriteString(out, `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">`)
+	io.WriteString(out, `<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>`)
+	io.WriteString(out, `<Default Extension="xml" ContentType="application/xml"/>`)
+	io.WriteString(out, `<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>`)
+	io.WriteString(out, `<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>`)
+	for i := range w.sheets {
+		fmt.Fprintf(out, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, i+1)
+	}
+	io.WriteString(out, `</Types>`)
+}
+
+func writeRootRels(out io.Writer) {
+	io.WriteString(out, xmlHeader)
+	io.WriteString(out, `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+	io.WriteString(out, `<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>`)
+	io.WriteString(out, `</Relationships>`)
+}
+
+func (w *Workbook) writeWorkbook(out io.Writer) {
+	io.WriteString(out, xmlHeader)
+	io.WriteString(out, `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>`)
+	for i, sheet := range w.sheets {
+		io.WriteString(out, `<sheet name="`)
+		xml.EscapeText(out, []byte(sheet.name))
+		fmt.Fprintf(out, `" sheetId="%d" r:id="rId%d"/>`, i+1, i+1)
+	}
+	io.WriteString(out, `</sheets></workbook>`)
+}
+
+func (w *Workbook) writeWorkbookRels(out io.Writer) {
+	io.WriteString(out, xmlHeader)
+	io.WriteString(out, `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+	for i := range w.sheets {
+		fmt.Fprintf(out, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, i+1, i+1)
+	}
+	fmt.Fprintf(out, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>`, len(w.sheets)+1)
+	io.WriteString(out, `</Relationships>`)
+}
+
+// writeStyles 两种单元格样式：0 常规，1 加粗（表头）
+func writeStyles(out io.Writer) {
+	io.WriteString(out, xmlHeader)
+	io.WriteString(out, `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">`)
+	io.WriteString(out, `<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>`)
+	io.WriteString(out, `<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>`)
+	io.WriteString(out, `<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>`)
+	io.WriteString(out, `<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>`)
+	io.WriteString(out, `<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/><xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/></cellXfs>`)
+	io.WriteString(out, `</styleSheet>`)
+}
+
+func (s *Sheet) write(out io.Writer) {
+	io.WriteString(out, xmlHeader)
+	io.WriteString(out, `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">`)
+	if s.hasHeader {
+		io.WriteString(out, `<sheetViews><sheetView workbookViewId="0"><pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/></sheetView></sheetViews>`)
+	}
+	if len(s.widths) > 0 {
+		io.WriteString(out, `<cols>`)
+		for col := 0; col <= maxKey(s.widths); col++ {
+			if width, ok := s.widths[col]; ok {
+				fmt.Fprintf(out, `<col min="%d" max="%d" width="%s" customWidth="1"/>`, col+1, col+1, strconv.FormatFloat(width, 'f', -1, 64))
+			}
+		}
+		io.WriteString(out, `</cols>`)
+	}
+
+	io.WriteString(out, `<sheetData>`)
+	for r, row := range s.rows {
+		fmt.Fprintf(out, `<row r="%d">`, r+1)
+		for c, value := range row.cells {
+			writeCell(out, CellRef(c, r), value, row.style)
+		}
+		io.WriteString(out, `</row>`)
+	}
+	io.WriteString(out, `</sheetData></worksheet>`)
+}
+
+func writeCell(out io.Writer, ref string, value interface{}, style int) {
+	styleAttr := ""
+	if style != styleDefault {
+		styleAttr = fmt.Sprintf(` s="%d"`, style)
+	}
+
+	var number string
+	switch v := value.(type) {
+	case nil:
+		return
+	case int:
+		number = strconv.Itoa(v)
+	case int32:
+		number = strconv.FormatInt(int64(v), 10)
+	case int64:
+		number = strconv.FormatInt(v, 10)
+	case float32:
+		number = strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		number = strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		b := "0"
+		if v {
+			b = "1"
+		}
+		fmt.Fprintf(out, `<c r="%s" t="b"%s><v>%s</v></c>`, ref, styleAttr, b)
+		return
+	case time.Time:
+		writeInlineString(out, ref, styleAttr, v.Format("2006-01-02 15:04:05"))
+		return
+	case string:
+		writeInlineString(out, ref, styleAttr, v)
+		return
+	default:
+		writeInlineString(out, ref, styleAttr, fmt.Sprint(v))
+		return
+	}
+	fmt.Fprintf(out, `<c r="%s"%s><v>%s</v></c>`, ref, styleAttr, number)
+}
+
+func writeInlineString(out io.Writer, ref, styleAttr, text string) {
+	if text == "" {
+		return
+	}
+	fmt.Fprintf(out, `<c r="%s" t="inlineStr"%s><is><t xml:space="preserve">`, ref, styleAttr)
+	xml.EscapeText(out, []byte(text))
+	io.WriteString(out, `</t></is></c>`)
+}
+
+// CellRef 单元格引用（col、row 从 0 开始），如 (0,0) → A1，(27,9) → AB10
+func CellRef(col, row int) string {
+	return ColumnName(col) + strconv.Itoa(row+1)
+}
+
+// ColumnName 列名（从 0 开始），如 0 → A，25 → Z，26 → AA
+func ColumnName(col int) string {
+	name := ""
+	for col >= 0 {
+		name = string(rune('A'+col%26)) + name
+		col = col/26 - 1
+	}
+	return name
+}
+
+func sanitizeSheetName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		switch r {
+		case '[', ']', ':', '*', '?', '/', '\\':
+			return '_'
+		}
+		return r
+	}, strings.TrimSpace(name))
+	name = strings.Trim(name, "'")
+	if name == "" {
+		name = "Sheet"
+	}
+	return truncateRunes(name, MaxSheetNameLength)
+}
+
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n])
+}
+
+func maxKey(m map[int]float64) int {
+	max := -1
+	for k := range m {
+		if k > max {
+			max = k
+		}
+	}
+	return max
+}
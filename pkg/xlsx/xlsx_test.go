@@ -0,0 +1,96 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readParts(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	parts := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		require.NoError(t, err)
+		parts[f.Name] = string(content)
+	}
+	return parts
+}
+
+func TestWorkbook(t *testing.T) {
+	t.Run("structure", func(t *testing.T) {
+		wb := New()
+		sheet := wb.AddSheet("考勤月报")
+		sheet.SetHeader("工号", "姓名", "出勤天数")
+		sheet.AddRow("E001", "张三", 21.5)
+		sheet.AddRow("E002", "<李四 & 王五>", nil, true)
+		sheet.SetColumnWidth(1, 12)
+
+		data, err := wb.Bytes()
+		require.NoError(t, err)
+
+		parts := readParts(t, data)
+		for _, name := range []string{
+			"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml",
+			"xl/_rels/workbook.xml.rels", "xl/styles.xml", "xl/worksheets/sheet1.xml",
+		} {
+			require.Contains(t, parts, name)
+			// 每个部件都必须是格式良好的 XML
+			assert.NoError(t, xml.Unmarshal([]byte(parts[name]), new(interface{})), name)
+		}
+
+		assert.Contains(t, parts["xl/workbook.xml"], `name="考勤月报"`)
+		s := parts["xl/worksheets/sheet1.xml"]
+		assert.Contains(t, s, `state="frozen"`)
+		assert.Contains(t, s, `<c r="A1" t="inlineStr" s="1"><is><t xml:space="preserve">工号</t></is></c>`)
+		assert.Contains(t, s, `<c r="C2"><v>21.5</v></c>`)
+		assert.Contains(t, s, `&lt;李四 &amp; 王五&gt;`)
+		assert.Contains(t, s, `<c r="D3" t="b"><v>1</v></c>`)
+		assert.NotContains(t, s, `r="C3"`)
+		assert.Contains(t, s, `<col min="2" max="2" width="12" customWidth="1"/>`)
+	})
+
+	t.Run("empty workbook gets a sheet", func(t *testing.T) {
+		data, err := New().Bytes()
+		require.NoError(t, err)
+		assert.Contains(t, readParts(t, data), "xl/worksheets/sheet1.xml")
+	})
+
+	t.Run("time values", func(t *testing.T) {
+		wb := New()
+		wb.AddSheet("x").AddRow(time.Date(2025, 9, 1, 9, 30, 0, 0, time.UTC))
+		data, err := wb.Bytes()
+		require.NoError(t, err)
+		assert.Contains(t, readParts(t, data)["xl/worksheets/sheet1.xml"], "2025-09-01 09:30:00")
+	})
+}
+
+func TestSheetNames(t *testing.T) {
+	wb := New()
+	assert.Equal(t, "a_b_c", wb.AddSheet("a/b:c").Name())
+	assert.Equal(t, "Sheet", wb.AddSheet("  ").Name())
+	assert.Equal(t, "sheet (2)", wb.AddSheet("sheet").Name())
+
+	long := wb.AddSheet("0123456789012345678901234567890123456789").Name()
+	assert.Len(t, long, MaxSheetNameLength)
+}
+
+func TestColumnName(t *testing.T) {
+	cases := map[int]string{0: "A", 25: "Z", 26: "AA", 27: "AB", 51: "AZ", 52: "BA", 701: "ZZ", 702: "AAA"}
+	for col, want := range cases {
+		assert.Equal(t, want, ColumnName(col))
+	}
+	assert.Equal(t, "AB10", CellRef(27, 9))
+}